@@ -102,6 +102,11 @@ func Run() {
 	httpMiddlewareLoader.DefaultRegistry.Logger = log // Note this uses log on purpose
 
 	ctx := signals.Context()
+
+	if opts.TestComponents {
+		os.Exit(testComponents(ctx, opts))
+	}
+
 	ctxhupCh := signals.OnHUP(ctx)
 
 	defer log.Info("Daprd shutdown gracefully")
@@ -172,7 +177,9 @@ func runWithContext(ctx context.Context, opts *options.Options) error {
 				AppID:                         opts.AppID,
 				ActorsService:                 opts.ActorsService,
 				ActorsDisseminationTimeout:    opts.ActorsDisseminationTimeout,
+				PlacementHostLabels:           opts.PlacementHostLabels,
 				HotReloadReconcileInterval:    opts.HotReloadReconcileInterval,
+				ComponentInitConcurrency:      opts.ComponentInitConcurrency,
 				RemindersService:              opts.RemindersService,
 				SchedulerAddress:              opts.SchedulerAddress,
 				SchedulerStreams:              opts.SchedulerJobStreams,
@@ -184,6 +191,7 @@ func runWithContext(ctx context.Context, opts *options.Options) error {
 				DaprHTTPPort:                  opts.DaprHTTPPort,
 				DaprInternalGRPCPort:          opts.DaprInternalGRPCPort,
 				DaprInternalGRPCListenAddress: opts.DaprInternalGRPCListenAddress,
+				DaprInternalGRPCSocketDir:     opts.DaprInternalGRPCSocketDir,
 				DaprAPIGRPCPort:               opts.DaprAPIGRPCPort,
 				DaprAPIListenAddresses:        opts.DaprAPIListenAddresses,
 				DaprPublicPort:                opts.DaprPublicPort,
@@ -199,6 +207,7 @@ func runWithContext(ctx context.Context, opts *options.Options) error {
 				UnixDomainSocket:              opts.UnixDomainSocket,
 				DaprGracefulShutdownSeconds:   opts.DaprGracefulShutdownSeconds,
 				DaprBlockShutdownDuration:     opts.DaprBlockShutdownDuration,
+				DaprShutdownPhaseTimeouts:     opts.DaprShutdownPhaseTimeouts,
 				DisableBuiltinK8sSecretStore:  opts.DisableBuiltinK8sSecretStore,
 				EnableAppHealthCheck:          opts.EnableAppHealthCheck,
 				AppHealthCheckPath:            opts.AppHealthCheckPath,
@@ -209,6 +218,7 @@ func runWithContext(ctx context.Context, opts *options.Options) error {
 				EnableAPILogging:              opts.EnableAPILogging,
 				Config:                        opts.Config,
 				DisableInitEndpoints:          opts.DisableInitEndpoints,
+				JWTAudiences:                  opts.SentryRequestJwtAudiences,
 				Metrics: metrics.Options{
 					Enabled:       opts.Metrics.Enabled(),
 					Log:           log,
@@ -223,6 +233,7 @@ func runWithContext(ctx context.Context, opts *options.Options) error {
 				Registry:       reg,
 				Security:       sec,
 				Healthz:        healthz,
+				Logger:         &opts.Logger,
 			})
 			if rerr != nil {
 				return rerr
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	goruntime "runtime"
+
+	"github.com/dapr/dapr/cmd/daprd/options"
+	bindingsLoader "github.com/dapr/dapr/pkg/components/bindings"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	pubsubLoader "github.com/dapr/dapr/pkg/components/pubsub"
+	secretstoresLoader "github.com/dapr/dapr/pkg/components/secretstores"
+	"github.com/dapr/dapr/pkg/components/selftest"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+)
+
+// testComponents runs the conformance checks against the components of the
+// resources path, after discovering the pluggable components, and prints the
+// reports. It returns the exit code, which is 1 if any component failed.
+func testComponents(ctx context.Context, opts *options.Options) int {
+	if goruntime.GOOS != "windows" {
+		if err := pluggable.Discover(ctx); err != nil {
+			log.Errorf("Could not discover pluggable components: %s", err)
+			return 1
+		}
+	}
+
+	paths := opts.ResourcesPath
+	if len(paths) == 0 && opts.ComponentsPath != "" {
+		paths = []string{opts.ComponentsPath}
+	}
+	comps, err := selftest.Load(ctx, opts.AppID, paths)
+	if err != nil {
+		log.Errorf("Failed to load components: %s", err)
+		return 1
+	}
+	if len(comps) == 0 {
+		log.Error("No components to test were found in the resources path")
+		return 1
+	}
+
+	tester := selftest.New(selftest.Options{
+		StateStores:  stateLoader.DefaultRegistry,
+		PubSubs:      pubsubLoader.DefaultRegistry,
+		SecretStores: secretstoresLoader.DefaultRegistry,
+		Bindings:     bindingsLoader.DefaultRegistry,
+		Meta:         meta.New(meta.Options{ID: opts.AppID, Mode: modes.StandaloneMode}),
+	})
+
+	code := 0
+	reports := make([]selftest.Report, 0, len(comps))
+	for _, comp := range comps {
+		report := tester.Run(ctx, comp)
+		if !report.Passed {
+			code = 1
+		}
+		reports = append(reports, report)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(reports); err != nil {
+		log.Errorf("Failed to print the results: %s", err)
+		return 1
+	}
+	return code
+}
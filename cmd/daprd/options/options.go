@@ -31,7 +31,9 @@ import (
 	injectorconsts "github.com/dapr/dapr/pkg/injector/consts"
 	"github.com/dapr/dapr/pkg/metrics"
 	"github.com/dapr/dapr/pkg/modes"
+	placementlabels "github.com/dapr/dapr/pkg/placement/labels"
 	"github.com/dapr/dapr/pkg/runtime"
+	"github.com/dapr/dapr/pkg/runtime/shutdown"
 	"github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/kit/logger"
 )
@@ -56,19 +58,24 @@ type Options struct {
 	RuntimeVersion                bool
 	BuildInfo                     bool
 	WaitCommand                   bool
+	TestComponents                bool
 	DaprHTTPPort                  string
 	DaprAPIGRPCPort               string
 	ProfilePort                   string
 	DaprInternalGRPCPort          string
 	DaprInternalGRPCListenAddress string
+	DaprInternalGRPCSocketDir     string
 	DaprPublicPort                string
 	DaprPublicListenAddress       string
 	AppPort                       string
 	DaprGracefulShutdownSeconds   int
 	DaprBlockShutdownDuration     *time.Duration
+	DaprShutdownPhaseTimeouts     map[shutdown.Phase]time.Duration
 	ActorsService                 string
 	ActorsDisseminationTimeout    time.Duration
+	PlacementHostLabels           map[string]string
 	HotReloadReconcileInterval    time.Duration
+	ComponentInitConcurrency      int
 	RemindersService              string
 	SchedulerAddress              []string
 	SchedulerJobStreams           uint
@@ -131,6 +138,7 @@ func New(origArgs []string) (*Options, error) {
 	fs.StringVar(&opts.DaprAPIGRPCPort, "dapr-grpc-port", strconv.Itoa(runtime.DefaultDaprAPIGRPCPort), "gRPC port for the Dapr API to listen on")
 	fs.StringVar(&opts.DaprInternalGRPCPort, "dapr-internal-grpc-port", "", "gRPC port for the Dapr Internal API to listen on")
 	fs.StringVar(&opts.DaprInternalGRPCListenAddress, "dapr-internal-grpc-listen-address", "", "gRPC listen address for the Dapr Internal API")
+	fs.StringVar(&opts.DaprInternalGRPCSocketDir, "dapr-internal-grpc-socket-dir", "", "Path to a unix domain socket dir shared by the Dapr sidecars on the node. If specified, the Dapr Internal API also listens on a Unix Domain Socket, used by the sidecars on the same node")
 	fs.StringVar(&opts.AppPort, "app-port", "", "The port the application is listening on")
 	fs.StringVar(&opts.ProfilePort, "profile-port", strconv.Itoa(runtime.DefaultProfilePort), "The port for the profile server")
 	fs.StringVar(&opts.AppProtocol, "app-protocol", string(protocol.HTTPProtocol), "Protocol for the application: grpc, grpcs, http, https, h2c")
@@ -149,6 +157,7 @@ func New(origArgs []string) (*Options, error) {
 	fs.BoolVar(&opts.RuntimeVersion, "version", false, "Prints the runtime version")
 	fs.BoolVar(&opts.BuildInfo, "build-info", false, "Prints the build info")
 	fs.BoolVar(&opts.WaitCommand, "wait", false, "wait for Dapr outbound ready")
+	fs.BoolVar(&opts.TestComponents, "test-components", false, "Runs conformance checks against the components of the resources path, including pluggable components, prints the results and exits")
 	fs.IntVar(&opts.AppMaxConcurrency, "app-max-concurrency", -1, "Controls the concurrency level when forwarding requests to user code; set to -1 for no limits")
 	fs.BoolVar(&opts.EnableMTLS, "enable-mtls", false, "Enables automatic mTLS for daprd-to-daprd communication channels")
 	fs.BoolVar(&opts.AppSSL, "app-ssl", false, "Sets the URI scheme of the app to https and attempts a TLS connection")
@@ -162,6 +171,8 @@ func New(origArgs []string) (*Options, error) {
 	fs.StringVar(&opts.UnixDomainSocket, "unix-domain-socket", "", "Path to a unix domain socket dir mount. If specified, Dapr API servers will use Unix Domain Sockets")
 	fs.IntVar(&opts.DaprGracefulShutdownSeconds, "dapr-graceful-shutdown-seconds", int(runtime.DefaultGracefulShutdownDuration/time.Second), "Graceful shutdown time in seconds")
 	fs.DurationVar(opts.DaprBlockShutdownDuration, "dapr-block-shutdown-duration", 0, "If enabled, will block graceful shutdown after terminate signal is received until either the given duration has elapsed or the app reports unhealthy. Disabled by default")
+	var shutdownPhaseTimeouts string
+	fs.StringVar(&shutdownPhaseTimeouts, "dapr-shutdown-phase-timeouts", "", "Timeouts of the phases of the graceful shutdown, as comma separated phase=duration pairs. Phases are, in order: api, subscriptions, actors, flush, components. Phases without a timeout are bounded by the graceful shutdown time")
 	fs.BoolVar(opts.EnableAPILogging, "enable-api-logging", false, "Enable API logging for API calls")
 	fs.BoolVar(&opts.DisableBuiltinK8sSecretStore, "disable-builtin-k8s-secret-store", false, "Disable the built-in Kubernetes Secret Store")
 	fs.BoolVar(&opts.EnableAppHealthCheck, "enable-app-health-check", false, "Enable health checks for the application using the protocol defined with app-protocol")
@@ -179,7 +190,10 @@ func New(origArgs []string) (*Options, error) {
 	fs.StringSliceVar(&opts.SchedulerAddress, "scheduler-host-address", nil, "Addresses of the Scheduler service instance(s), as comma separated host:port pairs")
 	fs.UintVar(&opts.SchedulerJobStreams, "scheduler-job-streams", 3, "The number of active job streams to connect to the Scheduler service")
 	fs.DurationVar(&opts.ActorsDisseminationTimeout, "actors-disseminate-timeout", runtime.DefaultActorsDisseminationTimeout, "Timeout for the daprd-side actor placement dissemination round; if exceeded, daprd resets its placement stream and halts hosted actors. Should be greater than the placement service --disseminate-timeout (default 8s).")
+	var placementHostLabels string
+	fs.StringVar(&placementHostLabels, "placement-host-labels", "", "Labels reported to the Placement service for this host, as comma separated key=value pairs. Used by the Placement service --actor-type-host-selector to restrict actor types to matching hosts")
 	fs.DurationVar(&opts.HotReloadReconcileInterval, "hot-reload-reconcile-interval", 0, "Period of the hot-reload backup reconcile that lists resources and reconciles any the event watch missed, e.g. '30s'. Zero uses the default (60s)")
+	fs.IntVar(&opts.ComponentInitConcurrency, "component-init-concurrency", runtime.DefaultComponentInitConcurrency, "Maximum number of components initialized concurrently at startup; components are always initialized after the components they depend on")
 
 	// DEPRECATED.
 	fs.StringVar(&opts.RemindersService, "reminders-service", "", "Type and address of the reminders service, in the format 'type:address'")
@@ -280,6 +294,17 @@ func New(origArgs []string) (*Options, error) {
 		return nil, fmt.Errorf("invalid value for 'actors-disseminate-timeout' option: must be positive, got %s", opts.ActorsDisseminationTimeout)
 	}
 
+	hostLabels, err := placementlabels.Parse(placementHostLabels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'placement-host-labels' option: %w", err)
+	}
+	opts.PlacementHostLabels = hostLabels
+
+	opts.DaprShutdownPhaseTimeouts, err = shutdown.ParseTimeouts(shutdownPhaseTimeouts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'dapr-shutdown-phase-timeouts' option: %w", err)
+	}
+
 	return &opts, nil
 }
 
@@ -16,12 +16,14 @@ package options
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/runtime"
+	"github.com/dapr/dapr/pkg/runtime/shutdown"
 )
 
 func TestAppFlag(t *testing.T) {
@@ -387,3 +389,29 @@ func TestDisableInitEndpoints(t *testing.T) {
 		assert.Empty(t, opts.DisableInitEndpoints)
 	})
 }
+
+func TestShutdownPhaseTimeouts(t *testing.T) {
+	t.Run("flag is unset", func(t *testing.T) {
+		opts, err := New([]string{})
+		require.NoError(t, err)
+		assert.Empty(t, opts.DaprShutdownPhaseTimeouts)
+	})
+
+	t.Run("phase timeouts", func(t *testing.T) {
+		opts, err := New([]string{
+			"--dapr-shutdown-phase-timeouts", "subscriptions=30s,actors=1m",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[shutdown.Phase]time.Duration{
+			shutdown.PhaseSubscriptions: 30 * time.Second,
+			shutdown.PhaseActors:        time.Minute,
+		}, opts.DaprShutdownPhaseTimeouts)
+	})
+
+	t.Run("unknown phase", func(t *testing.T) {
+		_, err := New([]string{
+			"--dapr-shutdown-phase-timeouts", "outbox=30s",
+		})
+		require.Error(t, err)
+	})
+}
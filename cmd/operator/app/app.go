@@ -69,6 +69,7 @@ func Run() {
 		WebhookServerPort:                   opts.WebhookServerPort,
 		WebhookServerListenAddress:          opts.WebhookServerListenAddress,
 		CacheSyncPeriod:                     opts.CacheSyncPeriod,
+		ComponentSchemasDir:                 opts.ComponentSchemasDir,
 		Healthz:                             healthz,
 	})
 	if err != nil {
@@ -59,6 +59,7 @@ type Options struct {
 	WebhookServerPort                  int
 	WebhookServerListenAddress         string
 	CacheSyncPeriod                    time.Duration
+	ComponentSchemasDir                string
 }
 
 func New() *Options {
@@ -88,6 +89,7 @@ func New() *Options {
 	flag.StringVar(&opts.HealthzListenAddress, "healthz-listen-address", "", "The listening address for the healthz server")
 	flag.IntVar(&opts.WebhookServerPort, "webhook-server-port", 19443, "The port for the webhook server to listen on")
 	flag.StringVar(&opts.WebhookServerListenAddress, "webhook-server-listen-address", "", "The listening address for the webhook server")
+	flag.StringVar(&opts.ComponentSchemasDir, "component-schemas-dir", "", "Directory of the metadata schemas of the components. If set, components are validated against them when applied")
 	flag.DurationVar(&opts.CacheSyncPeriod, "cache-sync-period", 0, "Resync period for the controller-runtime informer cache, e.g. '10h'. Zero uses the controller-runtime default")
 
 	opts.Logger = logger.DefaultOptions()
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"github.com/dapr/dapr/pkg/placement"
+	securityConsts "github.com/dapr/dapr/pkg/security/consts"
+)
+
+const (
+	adminSimulatePath   = "/placement/admin/simulate"
+	adminNamespaceParam = "namespace"
+	adminMaxBodySize    = 1 << 20
+)
+
+// adminHandler serves the placement table of a namespace. GET returns the
+// current table, while POST accepts a placement.SimulateRequest and
+// additionally returns the effect of the requested host changes. Requests
+// must carry the admin token in the dapr-api-token header.
+func adminHandler(token string, ready <-chan struct{}, place func() *placement.Placement) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !adminAuthorized(token, r) {
+			http.Error(w, "invalid admin token", http.StatusUnauthorized)
+			return
+		}
+
+		var req placement.SimulateRequest
+		switch r.Method {
+		case http.MethodGet:
+			req.Namespace = r.URL.Query().Get(adminNamespaceParam)
+		case http.MethodPost:
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, adminMaxBodySize)).Decode(&req); err != nil {
+				http.Error(w, "invalid simulate request: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if req.Namespace == "" {
+			http.Error(w, "namespace is required", http.StatusBadRequest)
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ready:
+		}
+
+		sim, err := place().Simulate(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		writeAdminJSON(w, sim)
+	})
+}
+
+// adminFederationHandler serves the federation status, which the federation
+// peer polls to decide ownership of the federated actor types.
+func adminFederationHandler(token string, ready <-chan struct{}, place func() *placement.Placement) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !adminAuthorized(token, r) {
+			http.Error(w, "invalid admin token", http.StatusUnauthorized)
+			return
+		}
+
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ready:
+		}
+
+		status, err := place().FederationStatus(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		writeAdminJSON(w, status)
+	})
+}
+
+func adminAuthorized(token string, r *http.Request) bool {
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get(securityConsts.APITokenHeader)), []byte(token)) == 1
+}
+
+func writeAdminJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warnf("Failed to write placement admin response: %s", err)
+	}
+}
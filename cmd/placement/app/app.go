@@ -102,6 +102,33 @@ func Run() {
 		}
 	}
 
+	if opts.AdminToken != "" {
+		getPlace := func() *placement.Placement { return place }
+		handlers = append(handlers,
+			healthzserver.Handler{
+				Path:    adminSimulatePath,
+				Handler: adminHandler(opts.AdminToken, placeReady, getPlace),
+			},
+			healthzserver.Handler{
+				Path:    placement.FederationStatusPath,
+				Handler: adminFederationHandler(opts.AdminToken, placeReady, getPlace),
+			},
+		)
+	}
+
+	var federationOpts *placement.FederationOptions
+	if opts.FederationRole != "" {
+		federationOpts = &placement.FederationOptions{
+			Role:          placement.FederationRole(opts.FederationRole),
+			PeerAddresses: opts.FederationPeerAddresses,
+			Token:         opts.AdminToken,
+			ActorTypes:    opts.FederationActorTypes,
+			Lease:         opts.FederationLease,
+			TakeoverDelay: opts.FederationTakeoverDelay,
+			ReleaseDelay:  opts.FederationReleaseDelay,
+		}
+	}
+
 	healthSrv := healthzserver.New(healthzserver.Options{
 		Log:      log,
 		Port:     opts.HealthzPort,
@@ -128,6 +155,8 @@ func Run() {
 				KeepAliveTime:             opts.KeepAliveTime,
 				KeepAliveTimeout:          opts.KeepAliveTimeout,
 				ReplicationFactor:         int64(opts.ReplicationFactor),
+				Selectors:                 opts.ActorTypeSelectors,
+				Federation:                federationOpts,
 				Peers:                     opts.RaftPeers,
 				DisseminateTimeout:        opts.DisseminateTimeout,
 				DisseminateCoalesceWindow: opts.DisseminateCoalesceWindow,
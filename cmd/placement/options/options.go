@@ -14,6 +14,7 @@ limitations under the License.
 package options
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/metrics"
 	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/placement/labels"
 	"github.com/dapr/dapr/pkg/placement/peers"
 	"github.com/dapr/dapr/pkg/security"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
@@ -37,6 +39,8 @@ const (
 	defaultPlacementPort     = 50005
 	defaultReplicationFactor = 100
 	envMetadataEnabled       = "DAPR_PLACEMENT_METADATA_ENABLED"
+	//nolint:gosec
+	envAdminToken = "DAPR_PLACEMENT_ADMIN_TOKEN"
 
 	keepAliveTimeDefault = 2 * time.Second
 	keepAliveTimeMin     = 1 * time.Second
@@ -48,6 +52,10 @@ const (
 
 	disseminateTimeoutDefault        = 8 * time.Second
 	disseminateCoalesceWindowDefault = 0 * time.Second
+
+	federationLeaseDefault         = 10 * time.Second
+	federationTakeoverDelayDefault = 30 * time.Second
+	federationReleaseDelayDefault  = 10 * time.Second
 )
 
 type Options struct {
@@ -64,8 +72,11 @@ type Options struct {
 	HealthzPort            int
 	HealthzListenAddress   string
 	MetadataEnabled        bool
-	MaxAPILevel            int
-	MinAPILevel            int
+	// AdminToken enables the admin API on the healthz server, authenticated
+	// with this token.
+	AdminToken  string
+	MaxAPILevel int
+	MinAPILevel int
 
 	TLSEnabled       bool
 	TrustDomain      string
@@ -75,6 +86,17 @@ type Options struct {
 
 	ReplicationFactor int
 
+	actorTypeSelectorFlag []string
+	ActorTypeSelectors    labels.Selectors
+
+	// Federation configurations
+	FederationRole          string
+	FederationPeerAddresses []string
+	FederationActorTypes    []string
+	FederationLease         time.Duration
+	FederationTakeoverDelay time.Duration
+	FederationReleaseDelay  time.Duration
+
 	KeepAliveTime             time.Duration
 	KeepAliveTimeout          time.Duration
 	DisseminateTimeout        time.Duration
@@ -103,6 +125,7 @@ func New(origArgs []string) (*Options, error) {
 
 	opts := Options{
 		MetadataEnabled: kitstrings.IsTruthy(os.Getenv(envMetadataEnabled)),
+		AdminToken:      os.Getenv(envAdminToken),
 	}
 
 	// Create a flag set
@@ -125,6 +148,15 @@ func New(origArgs []string) (*Options, error) {
 	fs.DurationVar(&opts.DisseminateTimeout, "disseminate-timeout", disseminateTimeoutDefault, "sets the period of time in which a dissemination is considered failed if not completed. \nAny daprds which have not responded within this time will be considered non-responsive and kicked. \nHigher values will increase the grace period to complete dissemination in case of network issues, \nbut reduce the chance of skipping daprds which are slow to respond.")
 	fs.DurationVar(&opts.DisseminateCoalesceWindow, "disseminate-coalesce-window", disseminateCoalesceWindowDefault, "if >0, after a dissemination round completes, defer the next round by this window so additional host \nregisters/disconnects arriving inside the window collapse into a single round. \nKeeps cold-start of dissemination responsive (the first event fires immediately) while reducing per-event \ndrain+halt overhead during bulk scale events. Default 0 disables coalescing. Recommended 100-250ms for \nclusters with frequent rapid churn (eg. >50 replica scale-ups).")
 
+	fs.StringArrayVar(&opts.actorTypeSelectorFlag, "actor-type-host-selector", nil, "restricts an actor type to hosts whose daprd reports matching --placement-host-labels, in the format \nactorType:key=value[,key=value]. May be repeated for multiple actor types. \nActor types without a selector may be placed on any host.")
+
+	fs.StringVar(&opts.FederationRole, "federation-role", "", "enables federation of actor types with a placement service in another cluster, as either 'primary' or 'replica'. \nRequires DAPR_PLACEMENT_ADMIN_TOKEN to be set to the same value on both placement services.")
+	fs.StringSliceVar(&opts.FederationPeerAddresses, "federation-peer-address", nil, "comma separated healthz server addresses of the peer placement service instances, eg. https://dapr-placement-0.cluster-b:8080")
+	fs.StringSliceVar(&opts.FederationActorTypes, "federation-actor-types", nil, "comma separated actor types which are federated. If empty, all actor types are federated")
+	fs.DurationVar(&opts.FederationLease, "federation-lease", federationLeaseDefault, "period after which the primary fences the federated actor types if it cannot reach the replica")
+	fs.DurationVar(&opts.FederationTakeoverDelay, "federation-takeover-delay", federationTakeoverDelayDefault, "period after which the replica takes ownership of the federated actor types if it cannot reach the primary. \nMust be greater than the primary's --federation-lease plus --disseminate-timeout")
+	fs.DurationVar(&opts.FederationReleaseDelay, "federation-release-delay", federationReleaseDelayDefault, "period the replica waits after releasing the federated actor types before reporting standby, \nallowing its dissemination round to complete")
+
 	fs.StringVar(&opts.TrustDomain, "trust-domain", "localhost", "Trust domain for the Dapr control plane")
 	fs.StringVar(&opts.TrustAnchorsFile, "trust-anchors-file", securityConsts.ControlPlaneDefaultTrustAnchorsPath, "Filepath to the trust anchors for the Dapr control plane")
 	fs.StringVar(&opts.SentryAddress, "sentry-address", fmt.Sprintf("dapr-sentry.%s.svc:443", security.CurrentNamespace()), "Address of the Sentry service")
@@ -151,6 +183,12 @@ func New(origArgs []string) (*Options, error) {
 	}
 
 	opts.RaftPeers = parsePeersFromFlag(opts.raftPeerFlag)
+
+	selectors, err := labels.ParseSelectors(opts.actorTypeSelectorFlag)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for actor-type-host-selector: %w", err)
+	}
+	opts.ActorTypeSelectors = selectors
 	if opts.RaftLogStorePath != "" {
 		opts.RaftInMemEnabled = false
 	}
@@ -187,5 +225,21 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("invalid value for keepalive-timeout: value should be between %s and %s, got %s", keepAliveTimeoutMin, keepAliveTimeoutMax, o.KeepAliveTimeout)
 	}
 
+	switch o.FederationRole {
+	case "":
+	case "primary", "replica":
+		if len(o.FederationPeerAddresses) == 0 {
+			return errors.New("federation-peer-address is required when federation-role is set")
+		}
+		if o.AdminToken == "" {
+			return fmt.Errorf("%s is required when federation-role is set", envAdminToken)
+		}
+		if o.FederationTakeoverDelay <= o.FederationLease {
+			return fmt.Errorf("invalid value for federation-takeover-delay: must be greater than federation-lease (%s), got %s", o.FederationLease, o.FederationTakeoverDelay)
+		}
+	default:
+		return fmt.Errorf("invalid value for federation-role: must be 'primary' or 'replica', got %q", o.FederationRole)
+	}
+
 	return nil
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dapr/dapr/pkg/placement/labels"
 	"github.com/dapr/dapr/pkg/placement/peers"
 )
 
@@ -138,3 +139,18 @@ func TestValidateFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestActorTypeHostSelector(t *testing.T) {
+	opts, err := New([]string{
+		"--actor-type-host-selector", "heavy:size=large,zone=a",
+		"--actor-type-host-selector", "other:zone=b",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, labels.Selectors{
+		"heavy": {"size": "large", "zone": "a"},
+		"other": {"zone": "b"},
+	}, opts.ActorTypeSelectors)
+
+	_, err = New([]string{"--actor-type-host-selector", "heavy"})
+	require.Error(t, err)
+}
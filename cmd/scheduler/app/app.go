@@ -116,7 +116,11 @@ func Run() {
 					Healthz:    healthz,
 					Controller: ctrl,
 
-					KubeConfig:                     opts.KubeConfig,
+					KubeConfig: opts.KubeConfig,
+
+					Backend:       &opts.StorageBackend,
+					BackendConfig: backendConfig(opts.StorageBackendConfig),
+
 					EtcdEmbed:                      opts.EtcdEmbed,
 					EtcdDataDir:                    opts.EtcdDataDir,
 					EtcdName:                       opts.ID,
@@ -141,6 +145,13 @@ func Run() {
 					EtcdClientPassword:  opts.EtcdClientPassword,
 
 					Workers: opts.Workers,
+
+					JobTriggerHistorySize: opts.JobTriggerHistorySize,
+					JobTriggerHistoryTTL:  opts.JobTriggerHistoryTTL,
+
+					TriggerRateLimit:     opts.TriggerRateLimit,
+					AppTriggerRateLimit:  opts.AppTriggerRateLimit,
+					TriggerCatchUpPolicy: opts.TriggerCatchUpPolicy,
 				})
 				if serr != nil {
 					return nil, serr
@@ -171,3 +182,12 @@ func Run() {
 
 	log.Info("Scheduler service shut down gracefully")
 }
+
+// backendConfig returns the configuration passed to the storage backend, which
+// is omitted if not set.
+func backendConfig(config []byte) any {
+	if len(config) == 0 {
+		return nil
+	}
+	return config
+}
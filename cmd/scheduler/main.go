@@ -14,6 +14,10 @@ limitations under the License.
 package main
 
 import (
+	// Embed the time zone database, which may be missing from the image, to
+	// evaluate job schedules in time zones.
+	_ "time/tzdata"
+
 	"github.com/dapr/dapr/cmd/scheduler/app"
 )
 
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -49,6 +50,9 @@ type Options struct {
 
 	ID string
 
+	StorageBackend       string
+	StorageBackendConfig []byte
+
 	EtcdEmbed                      bool
 	EtcdInitialCluster             []string
 	EtcdDataDir                    string
@@ -74,6 +78,13 @@ type Options struct {
 
 	Workers uint32
 
+	JobTriggerHistorySize uint32
+	JobTriggerHistoryTTL  time.Duration
+
+	TriggerRateLimit     float64
+	AppTriggerRateLimit  float64
+	TriggerCatchUpPolicy string
+
 	IdentityDirectoryWrite string
 
 	Logger  logger.Options
@@ -83,6 +94,7 @@ type Options struct {
 	kubeconfig                string
 	etcdSpaceQuota            string
 	overrideBroadcastHostPort string
+	storageBackendConfigFile  string
 }
 
 func New(origArgs []string) (*Options, error) {
@@ -110,6 +122,9 @@ func New(origArgs []string) (*Options, error) {
 
 	fs.StringVar(&opts.ID, "id", "dapr-scheduler-server-0", "Scheduler server ID")
 
+	fs.StringVar(&opts.StorageBackend, "storage-backend", "etcd", "Storage backend of the scheduler jobs: 'etcd', 'postgres' or 'sqlite'. The 'etcd' backend stores jobs in the embedded Etcd database, or in an external Etcd cluster when --etcd-embed is false. The 'postgres' and 'sqlite' backends store jobs in a SQL database, configured with --storage-backend-config.")
+	fs.StringVar(&opts.storageBackendConfigFile, "storage-backend-config", "", "Filepath to the YAML configuration of the storage backend, with the 'connectionString' of the database and the optional 'tablePrefix', 'pollInterval', 'leaseDuration' and 'claimDuration'. Not supported with the 'etcd' backend, which is configured with the --etcd-* flags.")
+
 	fs.BoolVar(&opts.EtcdEmbed, "etcd-embed", true, "When enabled, the Etcd database will be embedded in the scheduler server. If false, the scheduler will connect to an external Etcd cluster using the --etcd-client-endpoints flag.")
 
 	fs.StringSliceVar(&opts.EtcdInitialCluster, "etcd-initial-cluster", []string{"dapr-scheduler-server-0=http://localhost:2380"}, "Initial etcd cluster peers")
@@ -137,6 +152,13 @@ func New(origArgs []string) (*Options, error) {
 
 	fs.Uint32Var(&opts.Workers, "workers", 2048, "Workers is the number of workers that handle job events. The higher the number the more go routines will be spawned, each working over a partition of the total job space. The higher the number, the higher the number of jobs which can be concurrently delivered to runtimes. Increasing this number increases the number of go routines for this instance. This number should be tuned to the bottleneck of job execution, i.e., CPU, I/O, memory, etc.")
 
+	fs.Uint32Var(&opts.JobTriggerHistorySize, "job-trigger-history-size", 0, "Number of most recent trigger attempts (time, outcome and error) retained per job, returned when getting a job. Recording trigger history adds etcd writes to every job trigger. Setting to 0 disables trigger history. Only supported with the etcd backend.")
+	fs.DurationVar(&opts.JobTriggerHistoryTTL, "job-trigger-history-ttl", 24*time.Hour, "Duration the trigger history of a job is retained after its last trigger attempt.")
+
+	fs.Float64Var(&opts.TriggerRateLimit, "trigger-rate-limit", 0, "Maximum number of job triggers per second delivered by this scheduler instance across all apps. Triggers exceeding the limit are delayed rather than dropped. Setting to 0 disables the limit.")
+	fs.Float64Var(&opts.AppTriggerRateLimit, "app-trigger-rate-limit", 0, "Maximum number of job triggers per second delivered by this scheduler instance to each app. Triggers exceeding the limit are delayed rather than dropped. Setting to 0 disables the limit.")
+	fs.StringVar(&opts.TriggerCatchUpPolicy, "trigger-catch-up-policy", "all", "Policy applied to overdue triggers of recurring jobs, for example after their app was unavailable. One of 'all' (deliver every missed trigger), 'skip' (drop missed triggers and resume on schedule) or 'latest-only' (deliver a single trigger in place of all missed triggers).")
+
 	if err := fs.MarkHidden("identity-directory-write"); err != nil {
 		log.Fatal(err)
 	}
@@ -170,6 +192,25 @@ func New(origArgs []string) (*Options, error) {
 		opts.OverrideBroadcastHostPort = &opts.overrideBroadcastHostPort
 	}
 
+	if opts.StorageBackend == "" {
+		return nil, errors.New("--storage-backend cannot be empty")
+	}
+
+	if opts.StorageBackend != "etcd" {
+		if opts.JobTriggerHistorySize > 0 {
+			return nil, fmt.Errorf("--job-trigger-history-size is not supported with the %q storage backend", opts.StorageBackend)
+		}
+
+		if fs.Changed("storage-backend-config") {
+			opts.StorageBackendConfig, err = os.ReadFile(opts.storageBackendConfigFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read storage backend config: %w", err)
+			}
+		}
+	} else if fs.Changed("storage-backend-config") {
+		return nil, errors.New("cannot use --storage-backend-config with the etcd storage backend")
+	}
+
 	if opts.EtcdEmbed {
 		if len(opts.EtcdClientEndpoints) > 0 {
 			return nil, errors.New("cannot use --etcd-client-endpoints with --etcd-embed")
@@ -184,7 +225,15 @@ func New(origArgs []string) (*Options, error) {
 		}
 	}
 
-	if !opts.EtcdEmbed && len(opts.EtcdClientEndpoints) == 0 {
+	if opts.TriggerRateLimit < 0 {
+		return nil, errors.New("--trigger-rate-limit must not be negative")
+	}
+
+	if opts.AppTriggerRateLimit < 0 {
+		return nil, errors.New("--app-trigger-rate-limit must not be negative")
+	}
+
+	if opts.StorageBackend == "etcd" && !opts.EtcdEmbed && len(opts.EtcdClientEndpoints) == 0 {
 		return nil, errors.New("must specify --etcd-client-endpoints when not using embedded etcd")
 	}
 
@@ -14,8 +14,11 @@ limitations under the License.
 package options
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,4 +57,40 @@ func TestNew(t *testing.T) {
 		})
 		require.NoError(t, err)
 	})
+
+	t.Run("error when storage backend config is set with the etcd backend", func(t *testing.T) {
+		_, err := New([]string{
+			"--storage-backend-config=" + filepath.Join(t.TempDir(), "config.yaml"),
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("other storage backends read the backend config", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("connectionString: postgres://localhost"), 0o600))
+
+		opts, err := New([]string{
+			"--storage-backend=postgres",
+			"--storage-backend-config=" + path,
+			"--etcd-embed=false",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "postgres", opts.StorageBackend)
+		assert.Equal(t, []byte("connectionString: postgres://localhost"), opts.StorageBackendConfig)
+	})
+
+	t.Run("error when storage backend is empty", func(t *testing.T) {
+		_, err := New([]string{
+			"--storage-backend=",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("error when trigger history is enabled with other storage backends", func(t *testing.T) {
+		_, err := New([]string{
+			"--storage-backend=postgres",
+			"--job-trigger-history-size=10",
+		})
+		require.Error(t, err)
+	})
 }
@@ -109,6 +109,7 @@ func Run() {
 		&jwksPath,
 		opts.OIDC.TLSCertFile,
 		opts.OIDC.TLSKeyFile,
+		opts.X509.AdditionalTrustAnchorsFile,
 	} {
 		if path != nil {
 			dir := filepath.Dir(*path)
@@ -131,6 +132,9 @@ func Run() {
 	cfg.IssuerCertPath = issuerCertPath
 	cfg.IssuerKeyPath = issuerKeyPath
 	cfg.RootCertPath = rootCertPath
+	if opts.X509.AdditionalTrustAnchorsFile != nil {
+		cfg.AdditionalTrustAnchorsPath = *opts.X509.AdditionalTrustAnchorsFile
+	}
 	cfg.JWT.SigningKeyPath = jwtKeyPath
 	cfg.JWT.Enabled = opts.JWT.Enabled
 	cfg.JWT.JWKSPath = jwksPath
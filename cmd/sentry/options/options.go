@@ -59,6 +59,14 @@ type X509Options struct {
 	RootCAFilename     string
 	IssuerCertFilename string
 	IssuerKeyFilename  string
+
+	// AdditionalTrustAnchorsFile is the PEM file of the roots distributed with
+	// the trust bundle in addition to the root of the issuer, such as the next
+	// root of a CA rotation.
+	AdditionalTrustAnchorsFile *string
+
+	// sentinel values used to bind to potentially nil pointers
+	additionalTrustAnchorsFile string
 }
 
 type JWTOptions struct {
@@ -121,6 +129,7 @@ func New(origArgs []string) *Options {
 	fs.StringVar(&opts.X509.RootCAFilename, "issuer-ca-filename", config.DefaultRootCertFilename, "Certificate Authority certificate filename")
 	fs.StringVar(&opts.X509.IssuerCertFilename, "issuer-certificate-filename", config.DefaultIssuerCertFilename, "Issuer certificate filename")
 	fs.StringVar(&opts.X509.IssuerKeyFilename, "issuer-key-filename", config.DefaultIssuerKeyFilename, "Issuer private key filename")
+	fs.StringVar(&opts.X509.additionalTrustAnchorsFile, "additional-trust-anchors-file", "", "Path to a PEM file of trust anchors to distribute with the trust bundle in addition to the issuer root, such as the next root of a CA rotation")
 	fs.StringVar(&opts.TrustDomain, "trust-domain", "localhost", "The CA trust domain")
 	fs.IntVar(&opts.Port, "port", config.DefaultPort, "The port for the sentry server to listen on")
 	fs.StringVar(&opts.ListenAddress, "listen-address", "", "The listen address for the sentry server")
@@ -177,6 +186,9 @@ func New(origArgs []string) *Options {
 	if fs.Changed("jwt-issuer") {
 		opts.JWT.Issuer = &opts.JWT.issuer
 	}
+	if fs.Changed("additional-trust-anchors-file") {
+		opts.X509.AdditionalTrustAnchorsFile = &opts.X509.additionalTrustAnchorsFile
+	}
 
 	return &opts
 }
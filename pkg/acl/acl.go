@@ -115,12 +115,20 @@ func ParseAccessControlSpec(accessControlSpec *config.AccessControlSpec, isHTTP
 
 			operationPolicy.PutOperationAction(operationName, &operationActions)
 		}
+
+		rules, err := compileRules(appPolicySpec.Rules, isHTTP)
+		if err != nil {
+			return nil, fmt.Errorf("invalid access control spec for app %s: %w", appPolicySpec.AppName, err)
+		}
+
 		aclPolicySpec := config.AccessControlListPolicySpec{
 			AppName:             appPolicySpec.AppName,
 			DefaultAction:       appPolicySpec.DefaultAction,
 			TrustDomain:         appPolicySpec.TrustDomain,
 			Namespace:           appPolicySpec.Namespace,
 			AppOperationActions: operationPolicy,
+			Rules:               rules,
+			DenyByDefault:       appPolicySpec.DenyByDefault,
 		}
 
 		// The policy spec can have the same appID which belongs to different namespaces
@@ -237,8 +245,14 @@ func isOperationAllowedByAccessControlPolicy(spiffeID *spiffe.Parsed, inputOpera
 		inputOperation = strings.ToLower(inputOperation)
 	}
 
+	// Rules take precedence over the operations
+	if rule := matchRule(appPolicy.Rules, inputOperation, httpVerb, isHTTP); rule != nil {
+		return isActionAllowed(rule.Action), config.ActionPolicyApp
+	}
+
 	operationPolicy := appPolicy.AppOperationActions.Search(inputOperation)
 
+	matched := false
 	if operationPolicy != nil {
 		// Operation prefix and postfix match. Now check the operation specific policy
 		if isHTTP {
@@ -254,6 +268,7 @@ func isOperationAllowedByAccessControlPolicy(spiffeID *spiffe.Parsed, inputOpera
 						action = verbAction
 					}
 				}
+				matched = found
 			} else {
 				// No matching verb found in the operation specific policies.
 				action = appPolicy.DefaultAction
@@ -261,9 +276,15 @@ func isOperationAllowedByAccessControlPolicy(spiffeID *spiffe.Parsed, inputOpera
 		} else {
 			// No http verb match is needed.
 			action = operationPolicy.OperationAction
+			matched = true
 		}
 	}
 
+	if appPolicy.DenyByDefault && !matched {
+		// Only the calls explicitly allowed are allowed in strict mode
+		return false, config.ActionPolicyApp
+	}
+
 	return isActionAllowed(action), actionPolicy
 }
 
@@ -628,3 +628,118 @@ func Test_isOperationAllowedByAccessControlPolicy(t *testing.T) {
 // runtime. Normalization is the responsibility of the service invocation
 // entry points (NormalizeMethod). The behavior previously tested here is
 // covered by pkg/method.TestNormalizeMethod.
+
+func TestAccessControlRules(t *testing.T) {
+	td := spiffeid.RequireTrustDomainFromString("public")
+	spiffeID, err := spiffe.FromStrings(td, "ns1", app1)
+	require.NoError(t, err)
+
+	parse := func(t *testing.T, isHTTP, denyByDefault bool, rules ...config.AppInvocationRule) *config.AccessControlList {
+		t.Helper()
+		accessControlList, err := ParseAccessControlSpec(&config.AccessControlSpec{
+			DefaultAction: config.DenyAccess,
+			TrustDomain:   "public",
+			AppPolicies: []config.AppPolicySpec{{
+				AppName:       app1,
+				DefaultAction: config.AllowAccess,
+				TrustDomain:   "public",
+				Namespace:     "ns1",
+				AppOperationActions: []config.AppOperation{
+					{Action: config.AllowAccess, HTTPVerb: []string{"GET"}, Operation: "/orders/*"},
+				},
+				Rules:         rules,
+				DenyByDefault: denyByDefault,
+			}},
+		}, isHTTP)
+		require.NoError(t, err)
+		return accessControlList
+	}
+
+	t.Run("invalid rules", func(t *testing.T) {
+		for _, rule := range []config.AppInvocationRule{
+			{Path: "/a", Action: "maybe"},
+			{Action: config.AllowAccess},
+			{Path: "/a", GRPCMethod: "/pkg.Svc/Method", Action: config.AllowAccess},
+			{GRPCMethod: "/pkg.Svc/Method", HTTPVerb: []string{"GET"}, Action: config.AllowAccess},
+			{Path: "/a/[b", Action: config.AllowAccess},
+		} {
+			_, err := ParseAccessControlSpec(&config.AccessControlSpec{
+				DefaultAction: config.AllowAccess,
+				AppPolicies: []config.AppPolicySpec{{
+					AppName:     app1,
+					TrustDomain: "public",
+					Namespace:   "ns1",
+					Rules:       []config.AppInvocationRule{rule},
+				}},
+			}, true)
+			require.Error(t, err, rule)
+		}
+	})
+
+	t.Run("most specific path wins", func(t *testing.T) {
+		acl := parse(t, true, false,
+			config.AppInvocationRule{Path: "/orders/**", Action: config.DenyAccess},
+			config.AppInvocationRule{Path: "/orders/*/items", Action: config.AllowAccess},
+			config.AppInvocationRule{Path: "/orders/admin*/items", Action: config.DenyAccess},
+			config.AppInvocationRule{Path: "/orders/*/items", HTTPVerb: []string{"delete"}, Action: config.DenyAccess},
+		)
+		for op, allowed := range map[string]bool{
+			"orders":               false,
+			"orders/1/items/2":     false,
+			"Orders/1/Items":       true,
+			"orders/admin1/items":  false,
+			"orders/1/items/":      false,
+			"customers/1/items":    true,
+			"orders/1/items/2/sub": false,
+		} {
+			isAllowed, _ := isOperationAllowedByAccessControlPolicy(spiffeID, op, common.HTTPExtension_GET, true, acl)
+			assert.Equal(t, allowed, isAllowed, op)
+		}
+		isAllowed, _ := isOperationAllowedByAccessControlPolicy(spiffeID, "orders/1/items", common.HTTPExtension_DELETE, true, acl)
+		assert.False(t, isAllowed)
+	})
+
+	t.Run("deny wins ties", func(t *testing.T) {
+		acl := parse(t, true, false,
+			config.AppInvocationRule{Path: "/a/*", Action: config.AllowAccess},
+			config.AppInvocationRule{Path: "/*/b", Action: config.DenyAccess},
+		)
+		isAllowed, _ := isOperationAllowedByAccessControlPolicy(spiffeID, "a/b", common.HTTPExtension_GET, true, acl)
+		assert.False(t, isAllowed)
+		isAllowed, _ = isOperationAllowedByAccessControlPolicy(spiffeID, "a/c", common.HTTPExtension_GET, true, acl)
+		assert.True(t, isAllowed)
+	})
+
+	t.Run("grpc method names", func(t *testing.T) {
+		acl := parse(t, false, false,
+			config.AppInvocationRule{Path: "/pkg.Svc/*", Action: config.DenyAccess},
+			config.AppInvocationRule{GRPCMethod: "pkg.Svc/Get", Action: config.AllowAccess},
+			config.AppInvocationRule{Path: "/pkg.Svc/Put", HTTPVerb: []string{"PUT"}, Action: config.AllowAccess},
+		)
+		isAllowed, _ := isOperationAllowedByAccessControlPolicy(spiffeID, "/pkg.Svc/Get", common.HTTPExtension_NONE, false, acl)
+		assert.True(t, isAllowed)
+		isAllowed, _ = isOperationAllowedByAccessControlPolicy(spiffeID, "/pkg.Svc/get", common.HTTPExtension_NONE, false, acl)
+		assert.False(t, isAllowed)
+		isAllowed, _ = isOperationAllowedByAccessControlPolicy(spiffeID, "/pkg.Svc/Put", common.HTTPExtension_NONE, false, acl)
+		assert.False(t, isAllowed)
+		isAllowed, _ = isOperationAllowedByAccessControlPolicy(spiffeID, "/pkg.Other/Get", common.HTTPExtension_NONE, false, acl)
+		assert.True(t, isAllowed)
+	})
+
+	t.Run("deny by default", func(t *testing.T) {
+		acl := parse(t, true, true,
+			config.AppInvocationRule{Path: "/health", Action: config.AllowAccess},
+		)
+		for op, allowed := range map[string]bool{
+			"health":   true,
+			"orders/1": true,
+			"other":    false,
+		} {
+			isAllowed, _ := isOperationAllowedByAccessControlPolicy(spiffeID, op, common.HTTPExtension_GET, true, acl)
+			assert.Equal(t, allowed, isAllowed, op)
+		}
+		// The operation only allows GET.
+		isAllowed, _ := isOperationAllowedByAccessControlPolicy(spiffeID, "orders/1", common.HTTPExtension_POST, true, acl)
+		assert.False(t, isAllowed)
+	})
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acl
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/config"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+)
+
+const anySegments = "**"
+
+// compileRules validates the invocation rules of an app policy and compiles
+// them for matching.
+func compileRules(rules []config.AppInvocationRule, isHTTP bool) ([]config.AccessControlListRule, error) {
+	compiled := make([]config.AccessControlListRule, 0, len(rules))
+	for i, rule := range rules {
+		action := strings.ToLower(rule.Action)
+		if action != config.AllowAccess && action != config.DenyAccess {
+			return nil, fmt.Errorf("rule %d: action must be either %q or %q, got %q", i, config.AllowAccess, config.DenyAccess, rule.Action)
+		}
+		if (rule.Path == "") == (rule.GRPCMethod == "") {
+			return nil, fmt.Errorf("rule %d: exactly one of path and grpcMethod must be set", i)
+		}
+
+		c := config.AccessControlListRule{
+			Action:    action,
+			HTTPVerbs: make([]string, len(rule.HTTPVerb)),
+		}
+		for j, verb := range rule.HTTPVerb {
+			c.HTTPVerbs[j] = strings.ToUpper(verb)
+		}
+
+		if rule.GRPCMethod != "" {
+			if len(rule.HTTPVerb) > 0 {
+				return nil, fmt.Errorf("rule %d: httpVerb cannot be set for a gRPC method", i)
+			}
+			c.GRPCMethod = rule.GRPCMethod
+			if !strings.HasPrefix(c.GRPCMethod, "/") {
+				c.GRPCMethod = "/" + c.GRPCMethod
+			}
+			compiled = append(compiled, c)
+			continue
+		}
+
+		p := rule.Path
+		if isHTTP {
+			p = strings.ToLower(p)
+		}
+		c.Segments = splitSegments(p)
+		for _, segment := range c.Segments {
+			if _, err := path.Match(segment, ""); err != nil {
+				return nil, fmt.Errorf("rule %d: invalid path %q: %w", i, rule.Path, err)
+			}
+		}
+		compiled = append(compiled, c)
+	}
+
+	return compiled, nil
+}
+
+// matchRule returns the most specific rule matching the operation, or nil if
+// no rule matches it. The operation is expected to be prefixed with "/" and,
+// for HTTP, to be lowercase.
+func matchRule(rules []config.AccessControlListRule, operation string, httpVerb commonv1pb.HTTPExtension_Verb, isHTTP bool) *config.AccessControlListRule {
+	var (
+		match     *config.AccessControlListRule
+		matchRank ruleRank
+		segments  []string
+	)
+	for i := range rules {
+		rule := &rules[i]
+		if rule.GRPCMethod != "" {
+			if isHTTP || rule.GRPCMethod != operation {
+				continue
+			}
+			// An exact gRPC method name is more specific than any path.
+			if match == nil || match.GRPCMethod == "" || rule.Action == config.DenyAccess {
+				match = rule
+			}
+			continue
+		}
+		if match != nil && match.GRPCMethod != "" {
+			continue
+		}
+
+		if len(rule.HTTPVerbs) > 0 {
+			if !isHTTP || httpVerb == commonv1pb.HTTPExtension_NONE {
+				continue
+			}
+			if !slices.Contains(rule.HTTPVerbs, httpVerb.String()) && !slices.Contains(rule.HTTPVerbs, "*") {
+				continue
+			}
+		}
+
+		if segments == nil {
+			segments = splitSegments(operation)
+		}
+		if !matchSegments(rule.Segments, segments) {
+			continue
+		}
+
+		rank := rankRule(rule)
+		switch cmp := rank.compare(matchRank); {
+		case match == nil, cmp > 0, cmp == 0 && rule.Action == config.DenyAccess:
+			match, matchRank = rule, rank
+		}
+	}
+
+	return match
+}
+
+// ruleRank is the specificity of a path rule.
+type ruleRank struct {
+	literals    int
+	partials    int
+	anySegments int
+	verbs       bool
+}
+
+func rankRule(rule *config.AccessControlListRule) ruleRank {
+	rank := ruleRank{verbs: len(rule.HTTPVerbs) > 0}
+	for _, segment := range rule.Segments {
+		switch {
+		case segment == anySegments:
+			rank.anySegments++
+		case segment == "*":
+		case strings.ContainsAny(segment, `*?[\`):
+			rank.partials++
+		default:
+			rank.literals++
+		}
+	}
+	return rank
+}
+
+// compare returns a positive number if r is more specific than o, a negative
+// number if it is less specific, and 0 if they are equally specific.
+func (r ruleRank) compare(o ruleRank) int {
+	switch {
+	case r.literals != o.literals:
+		return r.literals - o.literals
+	case r.partials != o.partials:
+		return r.partials - o.partials
+	case r.anySegments != o.anySegments:
+		return o.anySegments - r.anySegments
+	case r.verbs != o.verbs:
+		if r.verbs {
+			return 1
+		}
+		return -1
+	}
+	return 0
+}
+
+func splitSegments(p string) []string {
+	return strings.Split(strings.TrimPrefix(p, "/"), "/")
+}
+
+// matchSegments reports whether the segments of an operation match the
+// segments of a path glob.
+func matchSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == anySegments {
+			for i := len(segments); i >= 0; i-- {
+				if matchSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], segments[0]); err != nil || !ok {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
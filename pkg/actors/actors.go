@@ -51,6 +51,7 @@ import (
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/security"
 )
@@ -62,15 +63,20 @@ type Options struct {
 	Namespace          string
 	Port               int
 	PlacementAddresses []string
-	HealthEndpoint     string
-	Resiliency         resiliency.Provider
-	Security           security.Handler
-	Healthz            healthz.Healthz
-	CompStore          *compstore.ComponentStore
+	// PlacementHostLabels are reported to placement to select which actor
+	// types this host may be assigned.
+	PlacementHostLabels map[string]string
+	HealthEndpoint      string
+	Resiliency          resiliency.Provider
+	Security            security.Handler
+	Healthz             healthz.Healthz
+	CompStore           *compstore.ComponentStore
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	StateTTLEnabled    bool
 	MaxRequestBodySize int
 	Mode               modes.DaprMode
+	// PubSub is used to publish actor state changed events.
+	PubSub rtpubsub.Adapter
 
 	// DisseminationTimeout is the daprd-side timeout for a placement
 	// LOCK -> UPDATE -> UNLOCK round.
@@ -104,15 +110,17 @@ type Interface interface {
 }
 
 type actors struct {
-	appID              string
-	namespace          string
-	port               int
-	placementAddresses []string
-	healthEndpoint     string
-	resiliency         resiliency.Provider
-	security           security.Handler
-	healthz            healthz.Healthz
-	compStore          *compstore.ComponentStore
+	appID               string
+	namespace           string
+	port                int
+	placementAddresses  []string
+	placementHostLabels map[string]string
+	healthEndpoint      string
+	resiliency          resiliency.Provider
+	security            security.Handler
+	healthz             healthz.Healthz
+	compStore           *compstore.ComponentStore
+	pubsub              rtpubsub.Adapter
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	stateTTLEnabled      bool
 	maxRequestBodySize   int
@@ -155,10 +163,12 @@ func New(opts Options) Interface {
 		namespace:            opts.Namespace,
 		port:                 opts.Port,
 		placementAddresses:   opts.PlacementAddresses,
+		placementHostLabels:  opts.PlacementHostLabels,
 		healthEndpoint:       opts.HealthEndpoint,
 		resiliency:           opts.Resiliency,
 		security:             opts.Security,
 		compStore:            opts.CompStore,
+		pubsub:               opts.PubSub,
 		stateTTLEnabled:      opts.StateTTLEnabled,
 		clock:                clock.RealClock{},
 		disabled:             &disabled,
@@ -213,6 +223,7 @@ func (a *actors) Init(opts InitOptions) error {
 		Mode:                 a.mode,
 		Scheduler:            opts.SchedulerReloader,
 		DisseminationTimeout: a.disseminationTimeout,
+		HostLabels:           a.placementHostLabels,
 	})
 	if err != nil {
 		return err
@@ -227,6 +238,7 @@ func (a *actors) Init(opts InitOptions) error {
 			StateTTLEnabled: a.stateTTLEnabled,
 			Table:           a.table,
 			Placement:       a.placement,
+			PubSub:          a.pubsub,
 		})
 	}
 
@@ -301,6 +313,11 @@ func (a *actors) Run(ctx context.Context) error {
 	); err != nil {
 		return err
 	}
+	if a.state != nil {
+		if err := mngr.AddCloser(a.state); err != nil {
+			return err
+		}
+	}
 
 	defer log.Info("Actor runtime stopped")
 	return mngr.Run(ctx)
@@ -44,6 +44,7 @@ type EntityConfig struct {
 	DrainRebalancedActors      *bool
 	ReentrancyConfig           config.ReentrancyConfig
 	RemindersStoragePartitions int
+	StateEvents                *config.ActorStateEventsConfig
 }
 
 // TranslateEntityConfig converts a user-defined configuration into a
@@ -57,6 +58,7 @@ func TranslateEntityConfig(appConfig config.EntityConfig, disseminationTimeout t
 		DrainRebalancedActors:      appConfig.DrainRebalancedActors,
 		ReentrancyConfig:           appConfig.Reentrancy,
 		RemindersStoragePartitions: appConfig.RemindersStoragePartitions,
+		StateEvents:                appConfig.StateEvents,
 	}
 
 	if len(appConfig.ActorIdleTimeout) > 0 {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -43,6 +44,10 @@ type Reminder struct {
 	IsTimer        bool           `json:"-"`
 	IsRemote       bool           `json:"-"`
 	SkipLock       bool           `json:"-"`
+
+	// SpanContext is the span context of the request which scheduled this
+	// reminder or timer. Executions are linked to it.
+	SpanContext trace.SpanContext `json:"-"`
 }
 
 // ActorKey returns the key of the actor for this reminder.
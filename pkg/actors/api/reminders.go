@@ -50,6 +50,10 @@ type CreateReminderRequest struct {
 	// at the scheduler level. For activities this is the activity name, for
 	// workflows this is the workflow name.
 	ConcurrencyKey *string `json:"-"`
+
+	// Schedule is an optional cron schedule of the reminder, used instead of
+	// the period. Only set by internal actors.
+	Schedule string `json:"-"`
 }
 
 // ActorKey returns the key of the actor for this reminder.
@@ -239,6 +243,32 @@ type ListRemindersRequest struct {
 	ActorID   *string
 }
 
+// ListRemindersPageRequest is the request object to list a page of reminders
+// for an actor type, optionally scoped to a single actor ID.
+type ListRemindersPageRequest struct {
+	ActorType string
+	ActorID   *string
+
+	// DueAfter and DueBefore filter on the absolute due time of reminders.
+	// Reminders whose due time is relative to their (unknown) registration
+	// time are excluded when either filter is set.
+	DueAfter  *time.Time
+	DueBefore *time.Time
+
+	// PageSize is the maximum number of reminders returned. 0 means no limit.
+	PageSize uint32
+	// ContinuationToken is the opaque token returned by a previous page.
+	ContinuationToken string
+}
+
+// ListRemindersPageResponse is the response object containing a page of
+// reminders.
+type ListRemindersPageResponse struct {
+	Reminders []*Reminder
+	// ContinuationToken is set when there are more reminders to be listed.
+	ContinuationToken string
+}
+
 // DeleteTimerRequest is a request object for deleting a timer.
 type DeleteTimerRequest struct {
 	Name      string
@@ -142,17 +142,48 @@ func (i *Inflight) Open(ctx context.Context) {
 // removed types). The returned slice should be passed to LockTypes by the
 // caller.
 func (i *Inflight) Set(in *v1pb.PlacementTables, version uint64) []string {
-	oldEntries := i.hashTable.Entries
 	newEntries := make(map[string]*hashing.Consistent, len(in.GetEntries()))
-
 	for k, v := range in.GetEntries() {
-		loadMap := make(map[string]*hashing.Host, len(v.GetLoadMap()))
-		for lk, lv := range v.GetLoadMap() {
-			//nolint:staticcheck
-			loadMap[lk] = hashing.NewHost(lv.GetName(), lv.GetId(), lv.GetLoad(), lv.GetPort())
+		newEntries[k] = i.newRing(v, in.GetReplicationFactor())
+	}
+
+	return i.install(newEntries, version)
+}
+
+// SetDiff applies a placement table diff onto the current tables and returns
+// the actor types whose hash rings changed, as Set. The diff only contains
+// the actor types which changed, where an actor type with an empty load map
+// has been removed.
+func (i *Inflight) SetDiff(diff *v1pb.PlacementTables, version uint64) []string {
+	newEntries := maps.Clone(i.hashTable.Entries)
+	if newEntries == nil {
+		newEntries = make(map[string]*hashing.Consistent, len(diff.GetEntries()))
+	}
+
+	for k, v := range diff.GetEntries() {
+		if len(v.GetLoadMap()) == 0 {
+			delete(newEntries, k)
+			continue
 		}
-		newEntries[k] = hashing.NewFromExisting(loadMap, in.GetReplicationFactor(), i.virtualNodesCache)
+		newEntries[k] = i.newRing(v, diff.GetReplicationFactor())
+	}
+
+	return i.install(newEntries, version)
+}
+
+func (i *Inflight) newRing(table *v1pb.PlacementTable, replicationFactor int64) *hashing.Consistent {
+	loadMap := make(map[string]*hashing.Host, len(table.GetLoadMap()))
+	for lk, lv := range table.GetLoadMap() {
+		//nolint:staticcheck
+		loadMap[lk] = hashing.NewHost(lv.GetName(), lv.GetId(), lv.GetLoad(), lv.GetPort())
 	}
+	return hashing.NewFromExisting(loadMap, replicationFactor, i.virtualNodesCache)
+}
+
+// install replaces the current hash rings, returning the actor types whose
+// rings changed.
+func (i *Inflight) install(newEntries map[string]*hashing.Consistent, version uint64) []string {
+	oldEntries := i.hashTable.Entries
 
 	var changed []string
 	for k, newRing := range newEntries {
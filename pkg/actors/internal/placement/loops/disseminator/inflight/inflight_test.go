@@ -115,6 +115,37 @@ func TestSet_DiffsChangedTypes(t *testing.T) {
 	})
 }
 
+func TestSetDiff(t *testing.T) {
+	i := New(Options{Hostname: "h", Port: "1"})
+	i.Set(newTables(100, map[string]map[string]int64{
+		"a": {"h:1": 1},
+		"b": {"h:1": 1},
+		"c": {"h:1": 1},
+	}), 1)
+
+	got := i.SetDiff(newTables(100, map[string]map[string]int64{
+		"a": {"h:1": 1, "h:2": 2}, // host added to a
+		"b": {},                   // b removed
+		"d": {"h:2": 2},           // new type
+	}), 2)
+	assert.Equal(t, []string{"a", "b", "d"}, sortedCopy(got))
+
+	full := New(Options{Hostname: "h", Port: "1"})
+	full.Set(newTables(100, map[string]map[string]int64{
+		"a": {"h:1": 1, "h:2": 2},
+		"c": {"h:1": 1},
+		"d": {"h:2": 2},
+	}), 2)
+	assert.Equal(t, "2", i.hashTable.Version)
+	require.Len(t, i.hashTable.Entries, len(full.hashTable.Entries))
+	for k, ring := range full.hashTable.Entries {
+		require.Contains(t, i.hashTable.Entries, k)
+		assert.True(t, ring.Equal(i.hashTable.Entries[k]), k)
+	}
+
+	assert.Empty(t, i.SetDiff(newTables(100, nil), 3))
+}
+
 func TestLockUnlockTypes_Queueing(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -24,9 +24,10 @@ import (
 )
 
 const (
-	operationLock   = "lock"
-	operationUpdate = "update"
-	operationUnlock = "unlock"
+	operationLock       = "lock"
+	operationUpdate     = "update"
+	operationUpdateDiff = "update-diff"
+	operationUnlock     = "unlock"
 )
 
 func (d *disseminator) handleLookupRequest(req *loops.LookupRequest) {
@@ -79,7 +80,7 @@ func (d *disseminator) handleOrder(ctx context.Context, order *loops.StreamOrder
 			},
 		})
 
-	case operationUpdate:
+	case operationUpdate, operationUpdateDiff:
 		if d.currentVersion > version {
 			d.streamLoop.Close(&loops.Shutdown{
 				Error: fmt.Errorf("version mismatch: expected %d, got %d",
@@ -98,7 +99,12 @@ func (d *disseminator) handleOrder(ctx context.Context, order *loops.StreamOrder
 		// for unchanged types. Accumulate into roundChangedTypes so a
 		// later UNLOCK releases every type touched across compressed
 		// rounds (the placement server may elide intermediate UNLOCKs).
-		changed := d.inflight.Set(order.Order.GetTables(), version)
+		var changed []string
+		if order.Order.GetOperation() == operationUpdateDiff {
+			changed = d.inflight.SetDiff(order.Order.GetTables(), version)
+		} else {
+			changed = d.inflight.Set(order.Order.GetTables(), version)
+		}
 		for _, t := range changed {
 			d.roundChangedTypes[t] = struct{}{}
 		}
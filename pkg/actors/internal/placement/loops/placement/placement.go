@@ -20,6 +20,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/dapr/dapr/pkg/actors/internal/placement/connector"
@@ -28,6 +29,8 @@ import (
 	"github.com/dapr/dapr/pkg/actors/internal/placement/loops/disseminator/inflight"
 	"github.com/dapr/dapr/pkg/actors/table"
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/placement/apiversion"
+	"github.com/dapr/dapr/pkg/placement/labels"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 	"github.com/dapr/dapr/pkg/retry"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
@@ -53,6 +56,7 @@ type Options struct {
 	Scheduler  schedclient.Reloader
 
 	DisseminationTimeout time.Duration
+	HostLabels           map[string]string
 }
 
 type placement struct {
@@ -77,6 +81,7 @@ type placement struct {
 	host     *v1pb.Host
 
 	dissTimeout time.Duration
+	hostLabels  string
 
 	wg sync.WaitGroup
 }
@@ -96,6 +101,7 @@ func New(opts Options) loop.Interface[loops.EventPlace] {
 		actorTable:  opts.ActorTable,
 		scheduler:   opts.Scheduler,
 		dissTimeout: opts.DisseminationTimeout,
+		hostLabels:  labels.Encode(opts.HostLabels),
 	}
 	place.loop = loop.New[loops.EventPlace](8).NewLoop(place)
 	return place.loop
@@ -287,6 +293,11 @@ func (p *placement) tryConnect(ctx context.Context) (v1pb.Placement_ReportDaprSt
 		return nil, fmt.Errorf("failed to connect to placement service: %w", err)
 	}
 
+	ctx = metadata.AppendToOutgoingContext(ctx, apiversion.MetadataKey, apiversion.V2)
+	if p.hostLabels != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, labels.MetadataKey, p.hostLabels)
+	}
+
 	client, err := v1pb.NewPlacementClient(conn).ReportDaprStatus(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open stream to placement service: %w", err)
@@ -72,6 +72,9 @@ type Options struct {
 	// LOCK -> UPDATE -> UNLOCK round. If the round exceeds this, daprd
 	// resets its placement stream and halts hosted actors.
 	DisseminationTimeout time.Duration
+
+	// HostLabels are reported to placement when opening the stream.
+	HostLabels map[string]string
 }
 
 type placement struct {
@@ -153,6 +156,7 @@ func New(opts Options) (Interface, error) {
 				Namespace: opts.Namespace,
 			},
 			DisseminationTimeout: opts.DisseminationTimeout,
+			HostLabels:           opts.HostLabels,
 		}),
 	}, nil
 }
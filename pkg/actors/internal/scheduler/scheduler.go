@@ -15,16 +15,22 @@ package scheduler
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/dapr/dapr/pkg/actors/api"
 	"github.com/dapr/dapr/pkg/actors/table"
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	diagutils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/kit/logger"
 	kittime "github.com/dapr/kit/time"
@@ -32,6 +38,8 @@ import (
 
 var log = logger.NewLogger("dapr.runtime.actor.reminders.scheduler")
 
+var ErrInvalidContinuationToken = errors.New("invalid reminder list continuation token")
+
 // Interface is the interface for the object that provides reminders backend
 // storage.
 type Interface interface {
@@ -42,6 +50,7 @@ type Interface interface {
 	Delete(ctx context.Context, req *api.DeleteReminderRequest) error
 	DeleteByActorID(ctx context.Context, req *api.DeleteRemindersByActorIDRequest) error
 	List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
+	ListPage(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error)
 }
 
 type Options struct {
@@ -82,12 +91,25 @@ func (s *scheduler) Create(ctx context.Context, reminder *api.CreateReminderRequ
 	if err != nil {
 		return err
 	}
+	if len(reminder.Schedule) > 0 {
+		if schedule != nil {
+			return errors.New("a reminder cannot have both a period and a schedule")
+		}
+		schedule = new(reminder.Schedule)
+	}
 
 	overwrite := true
 	if reminder.Overwrite != nil {
 		overwrite = *reminder.Overwrite
 	}
 
+	// The trace context is stored with the reminder, so that its executions are
+	// linked to the span which created it on any host, and across restarts.
+	var traceparent *string
+	if sc := diagutils.SpanFromContext(ctx).SpanContext(); sc.IsValid() {
+		traceparent = new(diag.SpanContextToW3CString(sc))
+	}
+
 	internalScheduleJobReq := &schedulerv1pb.ScheduleJobRequest{
 		Name:      reminder.Name,
 		Overwrite: overwrite,
@@ -103,6 +125,7 @@ func (s *scheduler) Create(ctx context.Context, reminder *api.CreateReminderRequ
 			AppId:          s.appID,
 			Namespace:      s.namespace,
 			ConcurrencyKey: reminder.ConcurrencyKey,
+			Traceparent:    traceparent,
 			Target: &schedulerv1pb.JobTargetMetadata{
 				Type: &schedulerv1pb.JobTargetMetadata_Actor{
 					Actor: &schedulerv1pb.TargetActorReminder{
@@ -284,22 +307,98 @@ func (s *scheduler) List(ctx context.Context, req *api.ListRemindersRequest) ([]
 	}
 	reminders := make([]*api.Reminder, len(resp.GetJobs()))
 	for i, named := range resp.GetJobs() {
-		actor := named.GetMetadata().GetTarget().GetActor()
-		if actor == nil {
-			log.Warnf("Skipping reminder job %s with unsupported target type %s", named.GetName(), named.GetMetadata().GetTarget().String())
-			continue
+		reminders[i] = reminderFromJob(named)
+	}
+	return reminders, nil
+}
+
+// ListPage lists a page of reminders, filtered, ordered and paginated by the
+// scheduler. The continuation token is the encoded list position of the last
+// reminder listed, made of its actor ID and name.
+func (s *scheduler) ListPage(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error) {
+	var id string
+	if req.ActorID != nil {
+		id = *req.ActorID
+	}
+
+	listReq := &schedulerv1pb.ListJobsRequest{
+		Metadata: &schedulerv1pb.JobMetadata{
+			AppId:     s.appID,
+			Namespace: s.namespace,
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Actor{
+					Actor: &schedulerv1pb.TargetActorReminder{
+						Type: req.ActorType,
+						Id:   id,
+					},
+				},
+			},
+		},
+		PageSize: req.PageSize,
+	}
+	if len(req.ContinuationToken) > 0 {
+		b, err := base64.RawURLEncoding.DecodeString(req.ContinuationToken)
+		if err != nil || len(b) == 0 {
+			return nil, ErrInvalidContinuationToken
 		}
+		var pos schedulerv1pb.ListJobsPosition
+		if err = proto.Unmarshal(b, &pos); err != nil || len(pos.GetName()) == 0 {
+			return nil, ErrInvalidContinuationToken
+		}
+		listReq.StartAfter = &pos
+	}
+	if req.DueAfter != nil {
+		listReq.DueAfter = timestamppb.New(*req.DueAfter)
+	}
+	if req.DueBefore != nil {
+		listReq.DueBefore = timestamppb.New(*req.DueBefore)
+	}
 
-		job := named.GetJob()
+	resp, err := s.client.ListJobs(ctx, listReq)
+	if err != nil {
+		return nil, err
+	}
 
-		reminders[i] = &api.Reminder{
-			Name:      named.GetName(),
-			ActorID:   actor.GetId(),
-			ActorType: actor.GetType(),
-			Data:      job.GetData(),
-			Period:    api.NewSchedulerReminderPeriod(job.GetSchedule(), job.GetRepeats()),
-			DueTime:   job.GetDueTime(),
+	page := &api.ListRemindersPageResponse{
+		Reminders: make([]*api.Reminder, 0, len(resp.GetJobs())),
+	}
+	for _, named := range resp.GetJobs() {
+		if reminder := reminderFromJob(named); reminder != nil {
+			page.Reminders = append(page.Reminders, reminder)
 		}
 	}
-	return reminders, nil
+	if jobs := resp.GetJobs(); resp.GetHasMore() && len(jobs) > 0 {
+		last := jobs[len(jobs)-1]
+		b, err := proto.Marshal(&schedulerv1pb.ListJobsPosition{
+			Name:    last.GetName(),
+			ActorId: last.GetMetadata().GetTarget().GetActor().GetId(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		page.ContinuationToken = base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	return page, nil
+}
+
+// reminderFromJob returns the reminder of a listed job, or nil if the job
+// doesn't target an actor.
+func reminderFromJob(named *schedulerv1pb.NamedJob) *api.Reminder {
+	actor := named.GetMetadata().GetTarget().GetActor()
+	if actor == nil {
+		log.Warnf("Skipping reminder job %s with unsupported target type %s", named.GetName(), named.GetMetadata().GetTarget().String())
+		return nil
+	}
+
+	job := named.GetJob()
+
+	return &api.Reminder{
+		Name:      named.GetName(),
+		ActorID:   actor.GetId(),
+		ActorType: actor.GetType(),
+		Data:      job.GetData(),
+		Period:    api.NewSchedulerReminderPeriod(job.GetSchedule(), job.GetRepeats()),
+		DueTime:   job.GetDueTime(),
+	}
 }
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+type fakeClient struct {
+	schedulerv1pb.SchedulerClient
+
+	scheduled *schedulerv1pb.ScheduleJobRequest
+	req       *schedulerv1pb.ListJobsRequest
+	resp      *schedulerv1pb.ListJobsResponse
+}
+
+func (f *fakeClient) ScheduleJob(_ context.Context, req *schedulerv1pb.ScheduleJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.ScheduleJobResponse, error) {
+	f.scheduled = req
+	return new(schedulerv1pb.ScheduleJobResponse), nil
+}
+
+func (f *fakeClient) ListJobs(_ context.Context, req *schedulerv1pb.ListJobsRequest, _ ...grpc.CallOption) (*schedulerv1pb.ListJobsResponse, error) {
+	f.req = req
+	return f.resp, nil
+}
+
+func TestCreateTraceparent(t *testing.T) {
+	client := new(fakeClient)
+	s := New(Options{Namespace: "ns", AppID: "app", Client: client})
+	req := &api.CreateReminderRequest{ActorType: "mytype", ActorID: "a", Name: "r1"}
+
+	require.NoError(t, s.Create(t.Context(), req))
+	assert.Nil(t, client.scheduled.GetMetadata().Traceparent)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	require.NoError(t, s.Create(trace.ContextWithSpanContext(t.Context(), sc), req))
+	got, ok := diag.SpanContextFromW3CString(client.scheduled.GetMetadata().GetTraceparent())
+	require.True(t, ok)
+	assert.True(t, got.Equal(sc))
+}
+
+func token(t *testing.T, actorID, name string) string {
+	t.Helper()
+	b, err := proto.Marshal(&schedulerv1pb.ListJobsPosition{ActorId: actorID, Name: name})
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestListPage(t *testing.T) {
+	reminder := func(id, name string) *schedulerv1pb.NamedJob {
+		return &schedulerv1pb.NamedJob{
+			Name: name,
+			Metadata: &schedulerv1pb.JobMetadata{
+				Target: &schedulerv1pb.JobTargetMetadata{
+					Type: &schedulerv1pb.JobTargetMetadata_Actor{
+						Actor: &schedulerv1pb.TargetActorReminder{Type: "mytype", Id: id},
+					},
+				},
+			},
+			Job: new(schedulerv1pb.Job),
+		}
+	}
+
+	client := &fakeClient{resp: &schedulerv1pb.ListJobsResponse{
+		Jobs: []*schedulerv1pb.NamedJob{
+			reminder("a", "r2"),
+			{Name: "job", Metadata: new(schedulerv1pb.JobMetadata)},
+			reminder("b", "r1"),
+		},
+		HasMore: true,
+	}}
+	s := New(Options{Namespace: "ns", AppID: "app", Client: client})
+
+	t.Run("pushes the filters and the page down to the scheduler", func(t *testing.T) {
+		after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		before := after.Add(time.Hour)
+		resp, err := s.ListPage(t.Context(), &api.ListRemindersPageRequest{
+			ActorType:         "mytype",
+			ActorID:           new("a"),
+			DueAfter:          &after,
+			DueBefore:         &before,
+			PageSize:          3,
+			ContinuationToken: token(t, "a", "r1"),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(3), client.req.GetPageSize())
+		assert.Equal(t, "a", client.req.GetStartAfter().GetActorId())
+		assert.Equal(t, "r1", client.req.GetStartAfter().GetName())
+		assert.Equal(t, after, client.req.GetDueAfter().AsTime())
+		assert.Equal(t, before, client.req.GetDueBefore().AsTime())
+		assert.Equal(t, "a", client.req.GetMetadata().GetTarget().GetActor().GetId())
+		assert.Equal(t, "mytype", client.req.GetMetadata().GetTarget().GetActor().GetType())
+
+		require.Len(t, resp.Reminders, 2)
+		assert.Equal(t, "mytype||a||r2", resp.Reminders[0].Key())
+		assert.Equal(t, "mytype||b||r1", resp.Reminders[1].Key())
+		assert.Equal(t, token(t, "b", "r1"), resp.ContinuationToken)
+	})
+
+	t.Run("last page has no continuation token", func(t *testing.T) {
+		client.resp.HasMore = false
+		resp, err := s.ListPage(t.Context(), &api.ListRemindersPageRequest{ActorType: "mytype"})
+		require.NoError(t, err)
+		assert.Nil(t, client.req.StartAfter)
+		assert.Empty(t, resp.ContinuationToken)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := s.ListPage(t.Context(), &api.ListRemindersPageRequest{
+			ActorType:         "mytype",
+			ContinuationToken: "!!",
+		})
+		require.ErrorIs(t, err, ErrInvalidContinuationToken)
+
+		_, err = s.ListPage(t.Context(), &api.ListRemindersPageRequest{
+			ActorType:         "mytype",
+			ContinuationToken: base64.RawURLEncoding.EncodeToString([]byte("a||r1")),
+		})
+		require.ErrorIs(t, err, ErrInvalidContinuationToken)
+	})
+
+	t.Run("actor IDs with separators", func(t *testing.T) {
+		client.resp.HasMore = true
+		client.resp.Jobs = []*schedulerv1pb.NamedJob{reminder("a||b", "r1")}
+		resp, err := s.ListPage(t.Context(), &api.ListRemindersPageRequest{ActorType: "mytype", PageSize: 1})
+		require.NoError(t, err)
+		assert.Equal(t, token(t, "a||b", "r1"), resp.ContinuationToken)
+
+		_, err = s.ListPage(t.Context(), &api.ListRemindersPageRequest{ActorType: "mytype", ContinuationToken: resp.ContinuationToken})
+		require.NoError(t, err)
+		assert.Equal(t, "a||b", client.req.GetStartAfter().GetActorId())
+		assert.Equal(t, "r1", client.req.GetStartAfter().GetName())
+	})
+}
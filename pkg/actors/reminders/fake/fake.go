@@ -26,6 +26,7 @@ type Fake struct {
 	deleteFn          func(ctx context.Context, req *api.DeleteReminderRequest) error
 	deleteByActorIDFn func(ctx context.Context, req *api.DeleteRemindersByActorIDRequest) error
 	listFn            func(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
+	listPageFn        func(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error)
 	schedulerFn       func() (scheduler.Interface, error)
 }
 
@@ -46,6 +47,9 @@ func New() *Fake {
 		listFn: func(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
 			return nil, nil
 		},
+		listPageFn: func(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error) {
+			return new(api.ListRemindersPageResponse), nil
+		},
 		schedulerFn: func() (scheduler.Interface, error) {
 			return nil, nil
 		},
@@ -77,6 +81,11 @@ func (f *Fake) WithList(fn func(ctx context.Context, req *api.ListRemindersReque
 	return f
 }
 
+func (f *Fake) WithListPage(fn func(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error)) *Fake {
+	f.listPageFn = fn
+	return f
+}
+
 func (f *Fake) Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error) {
 	return f.getFn(ctx, req)
 }
@@ -97,6 +106,10 @@ func (f *Fake) List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.
 	return f.listFn(ctx, req)
 }
 
+func (f *Fake) ListPage(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error) {
+	return f.listPageFn(ctx, req)
+}
+
 func (f *Fake) Scheduler() (scheduler.Interface, error) {
 	return f.schedulerFn()
 }
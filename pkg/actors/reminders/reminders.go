@@ -26,6 +26,7 @@ import (
 var (
 	ErrReminderOpActorNotHosted = errors.New("operations on actor reminders are only possible on hosted actor types")
 	ErrReminderStorageNotSet    = errors.New("reminder scheduler is not configured")
+	ErrInvalidContinuationToken = scheduler.ErrInvalidContinuationToken
 )
 
 type Interface interface {
@@ -44,6 +45,11 @@ type Interface interface {
 	// List lists all reminders for a given actor type and actor ID.
 	List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
 
+	// ListPage lists a page of reminders for a given actor type, optionally
+	// filtered by actor ID and due time. Reminders are ordered by actor ID and
+	// then name.
+	ListPage(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error)
+
 	// Scheduler returns the underlying reminder scheduler.
 	// Used to bypass the actor hosted check.
 	Scheduler() (scheduler.Interface, error)
@@ -126,6 +132,18 @@ func (r *reminders) List(ctx context.Context, req *api.ListRemindersRequest) ([]
 	return r.scheduler.List(ctx, req)
 }
 
+func (r *reminders) ListPage(ctx context.Context, req *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error) {
+	if r.scheduler == nil {
+		return nil, ErrReminderStorageNotSet
+	}
+
+	if !r.table.IsActorTypeHosted(req.ActorType) {
+		return nil, ErrReminderOpActorNotHosted
+	}
+
+	return r.scheduler.ListPage(ctx, req)
+}
+
 func (r *reminders) Scheduler() (scheduler.Interface, error) {
 	if r.scheduler == nil {
 		return nil, ErrReminderStorageNotSet
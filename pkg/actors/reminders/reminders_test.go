@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reminders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/table/fake"
+)
+
+type fakeScheduler struct {
+	page *api.ListRemindersPageResponse
+}
+
+func (f *fakeScheduler) Close() error { return nil }
+
+func (f *fakeScheduler) Get(context.Context, *api.GetReminderRequest) (*api.Reminder, error) {
+	return nil, nil
+}
+
+func (f *fakeScheduler) Create(context.Context, *api.CreateReminderRequest) error { return nil }
+
+func (f *fakeScheduler) Delete(context.Context, *api.DeleteReminderRequest) error { return nil }
+
+func (f *fakeScheduler) DeleteByActorID(context.Context, *api.DeleteRemindersByActorIDRequest) error {
+	return nil
+}
+
+func (f *fakeScheduler) List(context.Context, *api.ListRemindersRequest) ([]*api.Reminder, error) {
+	return nil, nil
+}
+
+func (f *fakeScheduler) ListPage(context.Context, *api.ListRemindersPageRequest) (*api.ListRemindersPageResponse, error) {
+	return f.page, nil
+}
+
+func TestListPage(t *testing.T) {
+	sched := &fakeScheduler{page: &api.ListRemindersPageResponse{
+		Reminders:         []*api.Reminder{{ActorType: "mytype", ActorID: "a", Name: "r1"}},
+		ContinuationToken: "token",
+	}}
+
+	t.Run("lists the page of the scheduler", func(t *testing.T) {
+		r := New(Options{
+			Scheduler: sched,
+			Table:     fake.New().WithIsActorTypeHosted(func(string) bool { return true }),
+		})
+		resp, err := r.ListPage(t.Context(), &api.ListRemindersPageRequest{ActorType: "mytype", PageSize: 1})
+		require.NoError(t, err)
+		assert.Equal(t, sched.page, resp)
+	})
+
+	t.Run("actor type not hosted", func(t *testing.T) {
+		r := New(Options{Scheduler: sched, Table: fake.New()})
+		_, err := r.ListPage(t.Context(), &api.ListRemindersPageRequest{ActorType: "mytype"})
+		require.ErrorIs(t, err, ErrReminderOpActorNotHosted)
+	})
+
+	t.Run("scheduler not set", func(t *testing.T) {
+		r := New(Options{Table: fake.New()})
+		_, err := r.ListPage(t.Context(), &api.ListRemindersPageRequest{ActorType: "mytype"})
+		require.ErrorIs(t, err, ErrReminderStorageNotSet)
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"sync/atomic"
 
 	"github.com/cenkalti/backoff/v4"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -38,6 +39,7 @@ import (
 	targetserrors "github.com/dapr/dapr/pkg/actors/targets/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	diagutils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
@@ -144,6 +146,13 @@ func (r *router) CallReminder(ctx context.Context, req *api.Reminder) error {
 	ctx, cancel := r.withContext(ctx)
 	defer cancel()
 
+	// Remote executions are already traced by the host which forwarded them.
+	if !req.IsRemote {
+		var span trace.Span
+		ctx, span = r.startReminderSpan(ctx, req)
+		defer span.End()
+	}
+
 	if req.SkipLock {
 		return r.callReminder(ctx, req)
 	}
@@ -179,6 +188,19 @@ func (r *router) CallStream(ctx context.Context,
 	return err
 }
 
+// startReminderSpan starts the span for a reminder or timer execution, linked
+// to the span which scheduled it when known.
+func (r *router) startReminderSpan(ctx context.Context, req *api.Reminder) (context.Context, trace.Span) {
+	spanName := "CallActorReminder/" + req.ActorType
+	if req.IsTimer {
+		spanName = "CallActorTimer/" + req.ActorType
+	}
+
+	return diag.StartActorReminderSpan(ctx, spanName, req.SpanContext, map[string]string{
+		diagConsts.DaprAPIActorTypeID: req.ActorType + "." + req.ActorID,
+	})
+}
+
 func (r *router) callReminder(ctx context.Context, req *api.Reminder) error {
 	lar, cctx, cancel, err := r.placement.LookupActor(ctx, &api.LookupActorRequest{
 		ActorType: req.ActorType,
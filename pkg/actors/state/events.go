@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	contribstate "github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	diagutils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/resiliency"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// StateChangedEventType is the CloudEvent type of events published when an
+// actor state transaction commits.
+const StateChangedEventType = "com.dapr.actor.state.changed"
+
+// StateChangedEvent is the data of the CloudEvent published when an actor
+// state transaction commits.
+type StateChangedEvent struct {
+	ActorType string                 `json:"actorType"`
+	ActorID   string                 `json:"actorId"`
+	Keys      []StateChangedEventKey `json:"keys"`
+}
+
+// StateChangedEventKey describes a single key changed by a transaction.
+type StateChangedEventKey struct {
+	Key       string            `json:"key"`
+	Operation api.OperationType `json:"operation"`
+	// ETag is the etag of an upserted key once committed.
+	ETag *string `json:"etag,omitempty"`
+	// Value is only populated when the actor type is configured to include
+	// values.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// publishStateChanged publishes the state changed event for a committed
+// transaction, if the actor type has opted in. The etags of the upserted keys
+// are read back before returning, while the transaction still holds the
+// actor lock, and the event is then published in the background. The
+// transaction has already been committed, so failures are logged rather than
+// returned.
+func (s *state) publishStateChanged(ctx context.Context, req *api.TransactionalRequest, baseKey string, operations []contribstate.TransactionalStateOperation) {
+	if s.pubsub == nil {
+		return
+	}
+
+	entityConfig, ok := s.table.EntityConfig(req.ActorType)
+	if !ok || entityConfig.StateEvents == nil {
+		return
+	}
+	cfg := entityConfig.StateEvents
+
+	data, err := s.stateChangedEvent(ctx, req, baseKey, operations, cfg)
+	if err != nil {
+		log.Warnf("Failed to create state changed event for actor %s: %s", req.ActorKey(), err)
+		return
+	}
+
+	publish := func(ctx context.Context) {
+		err := s.pubsub.Publish(ctx, &contribpubsub.PublishRequest{
+			PubsubName: cfg.PubsubName,
+			Topic:      cfg.Topic,
+			Data:       data,
+		}, rtpubsub.TransportModeGRPC)
+		if err != nil {
+			log.Warnf("Failed to publish state changed event for actor %s to topic %s on pubsub %s: %s",
+				req.ActorKey(), cfg.Topic, cfg.PubsubName, err)
+		}
+	}
+
+	// Once closing, the event is published before returning rather than
+	// outliving the wait of Close.
+	s.lock.RLock()
+	if s.closed {
+		s.lock.RUnlock()
+		publish(ctx)
+		return
+	}
+	s.publishing.Add(1)
+	s.lock.RUnlock()
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer s.publishing.Done()
+		publish(ctx)
+	}()
+}
+
+// stateChangedEvent returns the CloudEvent of the state changed by the
+// operations. The values are those of the upsert operations, as written to
+// the store, and the etags are read back from the store.
+func (s *state) stateChangedEvent(ctx context.Context, req *api.TransactionalRequest, baseKey string, operations []contribstate.TransactionalStateOperation, cfg *config.ActorStateEventsConfig) ([]byte, error) {
+	event := StateChangedEvent{
+		ActorType: req.ActorType,
+		ActorID:   req.ActorID,
+		Keys:      make([]StateChangedEventKey, len(operations)),
+	}
+
+	var getReqs []contribstate.GetRequest
+	upserted := make(map[string]int, len(operations))
+	for i, op := range operations {
+		event.Keys[i] = StateChangedEventKey{
+			Key: strings.TrimPrefix(op.GetKey(), baseKey),
+		}
+		switch x := op.(type) {
+		case contribstate.SetRequest:
+			event.Keys[i].Operation = api.Upsert
+			if cfg.IncludeValues {
+				value, err := stateChangedValue(x.Value)
+				if err != nil {
+					return nil, fmt.Errorf("failed to encode the value of key %s: %w", event.Keys[i].Key, err)
+				}
+				event.Keys[i].Value = value
+			}
+			upserted[x.Key] = i
+			getReqs = append(getReqs, contribstate.GetRequest{Key: x.Key, Metadata: x.Metadata})
+		case contribstate.DeleteRequest:
+			event.Keys[i].Operation = api.Delete
+			delete(upserted, x.Key)
+		}
+	}
+
+	// Transactions don't return the etags the store assigned on commit.
+	if len(upserted) > 0 {
+		if err := s.readETags(ctx, getReqs, upserted, event.Keys); err != nil {
+			log.Warnf("Failed to read the etags of the state changed by actor %s: %s", req.ActorKey(), err)
+		}
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	traceID, traceState := diag.TraceIDAndStateFromSpan(diagutils.SpanFromContext(ctx))
+	envelope, err := rtpubsub.NewCloudEvent(&rtpubsub.CloudEvent{
+		Source:          s.appID,
+		Type:            StateChangedEventType,
+		Subject:         req.ActorKey(),
+		Topic:           cfg.Topic,
+		Pubsub:          cfg.PubsubName,
+		DataContentType: "application/json",
+		Data:            data,
+		TraceID:         traceID,
+		TraceState:      traceState,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(envelope)
+}
+
+// readETags sets the etags of the upserted keys, indexed by their key in the
+// store, to those now stored.
+func (s *state) readETags(ctx context.Context, getReqs []contribstate.GetRequest, upserted map[string]int, keys []StateChangedEventKey) error {
+	storeName, store, err := s.stateStore()
+	if err != nil {
+		return err
+	}
+
+	policyRunner := resiliency.NewRunner[[]contribstate.BulkGetResponse](ctx,
+		s.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateBulkGet),
+	)
+	res, err := policyRunner(func(ctx context.Context) ([]contribstate.BulkGetResponse, error) {
+		return store.BulkGet(ctx, getReqs, contribstate.BulkGetOpts{})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range res {
+		if i, ok := upserted[r.Key]; ok && r.Error == "" {
+			keys[i].ETag = r.ETag
+		}
+	}
+	return nil
+}
+
+// stateChangedValue returns the upserted value as JSON. Raw values which are
+// not valid JSON are encoded as a base64 string.
+func stateChangedValue(value any) (json.RawMessage, error) {
+	data, ok := value.([]byte)
+	if !ok {
+		return json.Marshal(value)
+	}
+	if json.Valid(data) {
+		return data, nil
+	}
+	return json.Marshal(data)
+}
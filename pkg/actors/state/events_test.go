@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/table/fake"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+type fakePublisher struct {
+	reqs    chan *contribpubsub.PublishRequest
+	err     error
+	release chan struct{}
+}
+
+func newFakePublisher(err error) *fakePublisher {
+	return &fakePublisher{reqs: make(chan *contribpubsub.PublishRequest, 1), err: err}
+}
+
+func (f *fakePublisher) Publish(_ context.Context, req *contribpubsub.PublishRequest, _ rtpubsub.TransportMode) error {
+	f.reqs <- req
+	if f.release != nil {
+		<-f.release
+	}
+	return f.err
+}
+
+// published returns the request published in the background.
+func (f *fakePublisher) published(t *testing.T) *contribpubsub.PublishRequest {
+	t.Helper()
+	select {
+	case req := <-f.reqs:
+		return req
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "state changed event was not published")
+		return nil
+	}
+}
+
+func (f *fakePublisher) BulkPublish(context.Context, *contribpubsub.BulkPublishRequest, rtpubsub.TransportMode) (contribpubsub.BulkPublishResponse, error) {
+	return contribpubsub.BulkPublishResponse{}, nil
+}
+
+func TestPublishStateChanged(t *testing.T) {
+	newState := func(t *testing.T, pub *fakePublisher, cfg *config.ActorStateEventsConfig) Interface {
+		t.Helper()
+		store := daprt.NewFakeStateStore()
+		cs := compstore.New()
+		cs.AddStateStore("store", store)
+		tbl := fake.New().
+			WithActorExists(func(string, string) bool { return true }).
+			WithEntityConfig(func(actorType string) (api.EntityConfig, bool) {
+				return api.EntityConfig{Entities: []string{actorType}, StateEvents: cfg}, cfg != nil
+			})
+		return New(Options{
+			AppID:      "myapp",
+			StoreName:  "store",
+			CompStore:  cs,
+			Resiliency: resiliency.New(logger.NewLogger("test")),
+			Table:      tbl,
+			PubSub:     pub,
+		})
+	}
+
+	req := &api.TransactionalRequest{
+		ActorType: "mytype",
+		ActorID:   "myid",
+		Operations: []api.TransactionalOperation{
+			{Operation: api.Upsert, Request: map[string]any{"key": "k1", "value": map[string]any{"a": 1}}},
+			{Operation: api.Delete, Request: map[string]any{"key": "k2"}},
+		},
+	}
+
+	t.Run("not configured does not publish", func(t *testing.T) {
+		pub := newFakePublisher(nil)
+		s := newState(t, pub, nil)
+		require.NoError(t, s.TransactionalStateOperation(t.Context(), false, req, false))
+		select {
+		case <-pub.reqs:
+			assert.Fail(t, "state changed event should not be published")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("publishes keys and values", func(t *testing.T) {
+		pub := newFakePublisher(nil)
+		s := newState(t, pub, &config.ActorStateEventsConfig{
+			PubsubName:    "mypubsub",
+			Topic:         "mytopic",
+			IncludeValues: true,
+		})
+		require.NoError(t, s.TransactionalStateOperation(t.Context(), false, req, false))
+		published := pub.published(t)
+		assert.Equal(t, "mypubsub", published.PubsubName)
+		assert.Equal(t, "mytopic", published.Topic)
+
+		var envelope struct {
+			Type   string            `json:"type"`
+			Source string            `json:"source"`
+			Data   StateChangedEvent `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(published.Data, &envelope))
+		assert.Equal(t, StateChangedEventType, envelope.Type)
+		assert.Equal(t, "myapp", envelope.Source)
+		assert.Equal(t, "mytype", envelope.Data.ActorType)
+		assert.Equal(t, "myid", envelope.Data.ActorID)
+		require.Len(t, envelope.Data.Keys, 2)
+
+		assert.Equal(t, "k1", envelope.Data.Keys[0].Key)
+		assert.Equal(t, api.Upsert, envelope.Data.Keys[0].Operation)
+		assert.JSONEq(t, `{"a":1}`, string(envelope.Data.Keys[0].Value))
+		require.NotNil(t, envelope.Data.Keys[0].ETag)
+		assert.NotEmpty(t, *envelope.Data.Keys[0].ETag)
+
+		assert.Equal(t, "k2", envelope.Data.Keys[1].Key)
+		assert.Equal(t, api.Delete, envelope.Data.Keys[1].Operation)
+		assert.Empty(t, envelope.Data.Keys[1].Value)
+		assert.Nil(t, envelope.Data.Keys[1].ETag)
+	})
+
+	t.Run("values are omitted unless requested", func(t *testing.T) {
+		pub := newFakePublisher(nil)
+		s := newState(t, pub, &config.ActorStateEventsConfig{PubsubName: "mypubsub", Topic: "mytopic"})
+		require.NoError(t, s.TransactionalStateOperation(t.Context(), false, req, false))
+		assert.NotContains(t, string(pub.published(t).Data), `"value"`)
+	})
+
+	t.Run("publish failure does not fail the transaction", func(t *testing.T) {
+		pub := newFakePublisher(errors.New("boom"))
+		s := newState(t, pub, &config.ActorStateEventsConfig{PubsubName: "mypubsub", Topic: "mytopic"})
+		require.NoError(t, s.TransactionalStateOperation(t.Context(), false, req, false))
+		pub.published(t)
+	})
+
+	t.Run("close waits for the events being published", func(t *testing.T) {
+		pub := newFakePublisher(nil)
+		pub.release = make(chan struct{})
+		s := newState(t, pub, &config.ActorStateEventsConfig{PubsubName: "mypubsub", Topic: "mytopic"})
+		require.NoError(t, s.TransactionalStateOperation(t.Context(), false, req, false))
+		pub.published(t)
+
+		closed := make(chan error)
+		go func() { closed <- s.Close() }()
+		select {
+		case <-closed:
+			assert.Fail(t, "close should wait for the event being published")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		close(pub.release)
+		select {
+		case err := <-closed:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "close did not return")
+		}
+	})
+}
+
+func TestStateChangedValue(t *testing.T) {
+	for name, test := range map[string]struct {
+		value any
+		exp   string
+	}{
+		"json bytes":     {value: []byte(`{"a":1}`), exp: `{"a":1}`},
+		"non json bytes": {value: []byte("hello"), exp: `"aGVsbG8="`},
+		"object":         {value: map[string]any{"a": 1}, exp: `{"a":1}`},
+		"string":         {value: "hello", exp: `"hello"`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := stateChangedValue(test.value)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.exp, string(got))
+		})
+	}
+}
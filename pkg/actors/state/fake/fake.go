@@ -65,3 +65,7 @@ func (f *Fake) GetBulk(ctx context.Context, req *api.GetBulkStateRequest, lock b
 func (f *Fake) TransactionalStateOperation(ctx context.Context, ignoreHosted bool, req *api.TransactionalRequest, lock bool) error {
 	return f.transactionalStateOperationFn(ctx, ignoreHosted, req, lock)
 }
+
+func (f *Fake) Close() error {
+	return nil
+}
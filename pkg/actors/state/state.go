@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	contribstate "github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors/api"
@@ -27,6 +28,8 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
 )
 
 const (
@@ -36,6 +39,8 @@ const (
 	errStateStoreNotConfigured = `actors: state store does not exist or incorrectly configured. Have you set the property '{"name": "actorStateStore", "value": "true"}' in your state store component file?`
 )
 
+var log = logger.NewLogger("dapr.runtime.actors.state")
+
 var ErrTransactionsTooManyOperations = errors.New("the transaction contains more operations than supported by the state store")
 
 type Interface interface {
@@ -47,6 +52,9 @@ type Interface interface {
 
 	// TransactionalStateOperation performs a transactional state operation with the actor state store.
 	TransactionalStateOperation(ctx context.Context, ignoreHosted bool, req *api.TransactionalRequest, lock bool) error
+
+	// Close waits for the state changed events being published.
+	Close() error
 }
 
 type Backend interface {
@@ -62,6 +70,10 @@ type Options struct {
 	Table      table.Interface
 	Placement  placement.Interface
 
+	// PubSub is used to publish state changed events for actor types which
+	// have opted in. Optional.
+	PubSub rtpubsub.Adapter
+
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	StateTTLEnabled bool
 }
@@ -73,6 +85,13 @@ type state struct {
 	resiliency resiliency.Provider
 	table      table.Interface
 	placement  placement.Interface
+	pubsub     rtpubsub.Adapter
+
+	// publishing tracks the state changed events published in the background.
+	// Once closed, events are published before the transaction returns.
+	publishing sync.WaitGroup
+	lock       sync.RWMutex
+	closed     bool
 
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	stateTTLEnabled bool
@@ -86,6 +105,7 @@ func New(opts Options) Interface {
 		resiliency:      opts.Resiliency,
 		table:           opts.Table,
 		placement:       opts.Placement,
+		pubsub:          opts.PubSub,
 		stateTTLEnabled: opts.StateTTLEnabled,
 	}
 }
@@ -113,7 +133,7 @@ func (s *state) Get(ctx context.Context, req *api.GetStateRequest, lock bool) (*
 	key := s.constructActorStateKey(actorKey, req.Key)
 
 	policyRunner := resiliency.NewRunner[*contribstate.GetResponse](ctx,
-		s.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		s.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateGet),
 	)
 	storeReq := &contribstate.GetRequest{
 		Key:      key,
@@ -167,7 +187,7 @@ func (s *state) GetBulk(ctx context.Context, req *api.GetBulkStateRequest, lock
 	}
 
 	policyRunner := resiliency.NewRunner[[]contribstate.BulkGetResponse](ctx,
-		s.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		s.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateBulkGet),
 	)
 	res, err := policyRunner(func(ctx context.Context) ([]contribstate.BulkGetResponse, error) {
 		return store.BulkGet(ctx, bulkReqs, contribstate.BulkGetOpts{})
@@ -226,7 +246,13 @@ func (s *state) TransactionalStateOperation(ctx context.Context, ignoreHosted bo
 		}
 	}
 
-	return s.executeStateStoreTransaction(ctx, operations, metadata)
+	if err = s.executeStateStoreTransaction(ctx, operations, metadata); err != nil {
+		return err
+	}
+
+	s.publishStateChanged(ctx, req, baseKey, operations)
+
+	return nil
 }
 
 func (s *state) executeStateStoreTransaction(ctx context.Context, operations []contribstate.TransactionalStateOperation, metadata map[string]string) error {
@@ -246,7 +272,7 @@ func (s *state) executeStateStoreTransaction(ctx context.Context, operations []c
 		Metadata:   metadata,
 	}
 	policyRunner := resiliency.NewRunner[struct{}](ctx,
-		s.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		s.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateTransaction),
 	)
 	_, err = policyRunner(func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, store.Multi(ctx, stateReq)
@@ -271,3 +297,11 @@ func (s *state) stateStore() (string, Backend, error) {
 func (s *state) constructActorStateKey(actorKey, actorID string) string {
 	return key.ConstructComposite(s.appID, actorKey, actorID)
 }
+
+func (s *state) Close() error {
+	s.lock.Lock()
+	s.closed = true
+	s.lock.Unlock()
+	s.publishing.Wait()
+	return nil
+}
@@ -33,6 +33,8 @@ type Fake struct {
 	haltAllFn                func(context.Context) error
 	haltNonHostedFn          func(context.Context, func(*api.LookupActorRequest) bool) error
 	lenFn                    func() map[string]int
+	actorIDsFn               func(string) []string
+	entityConfigFn           func(string) (api.EntityConfig, bool)
 }
 
 func New() *Fake {
@@ -48,6 +50,8 @@ func New() *Fake {
 		haltAllFn:                func(context.Context) error { return nil },
 		haltNonHostedFn:          func(context.Context, func(*api.LookupActorRequest) bool) error { return nil },
 		lenFn:                    func() map[string]int { return nil },
+		actorIDsFn:               func(string) []string { return nil },
+		entityConfigFn:           func(string) (api.EntityConfig, bool) { return api.EntityConfig{}, false },
 	}
 }
 
@@ -82,7 +86,12 @@ func (f *Fake) WithHaltNonHosted(fn func(context.Context, func(*api.LookupActorR
 	f.haltNonHostedFn = fn
 	return f
 }
-func (f *Fake) WithLen(fn func() map[string]int) *Fake { f.lenFn = fn; return f }
+func (f *Fake) WithLen(fn func() map[string]int) *Fake      { f.lenFn = fn; return f }
+func (f *Fake) WithActorIDs(fn func(string) []string) *Fake { f.actorIDsFn = fn; return f }
+func (f *Fake) WithEntityConfig(fn func(string) (api.EntityConfig, bool)) *Fake {
+	f.entityConfigFn = fn
+	return f
+}
 
 func (f *Fake) Close() error                            { return f.closeFn() }
 func (f *Fake) Types() []string                         { return f.typesFn() }
@@ -107,3 +116,9 @@ func (f *Fake) HaltNonHosted(ctx context.Context, fn func(*api.LookupActorReques
 	return f.haltNonHostedFn(ctx, fn)
 }
 func (f *Fake) Len() map[string]int { return f.lenFn() }
+func (f *Fake) ActorIDs(actorType string) []string {
+	return f.actorIDsFn(actorType)
+}
+func (f *Fake) EntityConfig(actorType string) (api.EntityConfig, bool) {
+	return f.entityConfigFn(actorType)
+}
@@ -45,6 +45,8 @@ type Interface interface {
 	HaltAll(ctx context.Context) error
 	HaltNonHosted(ctx context.Context, fn func(*api.LookupActorRequest) bool) error
 	Len() map[string]int
+	ActorIDs(actorType string) []string
+	EntityConfig(actorType string) (api.EntityConfig, bool)
 }
 
 type Options struct {
@@ -70,7 +72,8 @@ type table struct {
 	factories   sync.Map
 	typeUpdates *broadcaster.Broadcaster[[]string]
 
-	entityConfigs map[string]api.EntityConfig
+	entityConfigs     map[string]api.EntityConfig
+	entityConfigsLock sync.RWMutex
 
 	reentrancyStore *reentrancystore.Store
 	clock           clock.Clock
@@ -105,6 +108,16 @@ func (t *table) Len() map[string]int {
 	return alen
 }
 
+// ActorIDs returns the IDs of the active actors of the given type, in no
+// particular order.
+func (t *table) ActorIDs(actorType string) []string {
+	factory, ok := t.factories.Load(actorType)
+	if !ok {
+		return nil
+	}
+	return factory.(targets.Factory).ActorIDs()
+}
+
 func (t *table) HaltAll(ctx context.Context) error {
 	var wg sync.WaitGroup
 	errs := slice.New[error]()
@@ -166,7 +179,9 @@ func (t *table) RegisterActorTypes(opts RegisterActorTypeOptions) {
 	}
 
 	if opts := opts.HostOptions; opts != nil {
+		t.entityConfigsLock.Lock()
 		t.entityConfigs = opts.EntityConfigs
+		t.entityConfigsLock.Unlock()
 	}
 
 	for _, opt := range opts.Factories {
@@ -177,6 +192,15 @@ func (t *table) RegisterActorTypes(opts RegisterActorTypeOptions) {
 	t.typeUpdates.Broadcast(t.Types())
 }
 
+// EntityConfig returns the entity configuration for the given actor type, if
+// one was registered.
+func (t *table) EntityConfig(actorType string) (api.EntityConfig, bool) {
+	t.entityConfigsLock.RLock()
+	defer t.entityConfigsLock.RUnlock()
+	c, ok := t.entityConfigs[actorType]
+	return c, ok
+}
+
 func (t *table) UnRegisterActorTypes(actorTypes ...string) error {
 	if len(actorTypes) == 0 {
 		return nil
@@ -199,6 +199,12 @@ func (f *factory) Len() int {
 	return count
 }
 
+func (f *factory) ActorIDs() []string {
+	var ids []string
+	f.table.Range(func(key, _ any) bool { ids = append(ids, key.(string)); return true })
+	return ids
+}
+
 func (f *factory) handleIdleActor(target *app) {
 	ctx, cancel, err := f.placement.Lock(context.Background(), f.actorType)
 	if err != nil {
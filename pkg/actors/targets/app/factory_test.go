@@ -56,6 +56,7 @@ func Test_GetOrCreate(t *testing.T) {
 	assert.True(t, fact.Exists("foo2"))
 	assert.True(t, fact.Exists("foo3"))
 	assert.False(t, fact.Exists("foo4"))
+	assert.ElementsMatch(t, []string{"foo1", "foo2", "foo3"}, fact.ActorIDs())
 
 	act := fact.GetOrCreate("foo3")
 	require.NoError(t, act.Deactivate(t.Context()))
@@ -62,7 +62,7 @@ func (t *Transport) Invoke(ctx context.Context, req *internalv1pb.InternalInvoke
 	actorID := req.GetActor().GetActorId()
 	msg := req.GetMessage()
 
-	policyDef := t.resiliency.ActorPostLockPolicy(t.actorType, actorID)
+	policyDef := t.resiliency.ActorPostLockMethodPolicy(t.actorType, actorID, msg.GetMethod())
 	policyRunner := resiliency.NewRunner[*runtimev1pb.SubscribeActorEventsRequestInvokeResponseAlpha1](ctx, policyDef)
 
 	resp, err := policyRunner(func(ctx context.Context) (*runtimev1pb.SubscribeActorEventsRequestInvokeResponseAlpha1, error) {
@@ -97,7 +97,7 @@ func (t *Transport) Invoke(ctx context.Context, req *internalv1pb.InternalInvoke
 		return nil, fmt.Errorf("app channel for actor type %s is nil", t.actorType)
 	}
 
-	policyDef := t.resiliency.ActorPostLockPolicy(t.actorType, actorID)
+	policyDef := t.resiliency.ActorPostLockMethodPolicy(t.actorType, actorID, cleanedMethod)
 	if policyDef != nil && policyDef.HasRetries() {
 		imReq.WithReplay(true)
 	}
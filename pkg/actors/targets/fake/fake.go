@@ -40,6 +40,7 @@ type FakeFactory struct {
 	haltAllFn       func(context.Context) error
 	haltNonHostedFn func(context.Context, func(*api.LookupActorRequest) bool) error
 	lenFn           func() int
+	actorIDsFn      func() []string
 }
 
 func New(actorType string) targets.Interface {
@@ -150,6 +151,9 @@ func NewFactory() *FakeFactory {
 		lenFn: func() int {
 			return 0
 		},
+		actorIDsFn: func() []string {
+			return nil
+		},
 	}
 }
 
@@ -178,6 +182,11 @@ func (f *FakeFactory) WithLen(fn func() int) *FakeFactory {
 	return f
 }
 
+func (f *FakeFactory) WithActorIDs(fn func() []string) *FakeFactory {
+	f.actorIDsFn = fn
+	return f
+}
+
 func (f *FakeFactory) GetOrCreate(actorID string) targets.Interface {
 	return f.getOrCreateFn(actorID)
 }
@@ -197,3 +206,7 @@ func (f *FakeFactory) HaltNonHosted(ctx context.Context, fn func(*api.LookupActo
 func (f *FakeFactory) Len() int {
 	return f.lenFn()
 }
+
+func (f *FakeFactory) ActorIDs() []string {
+	return f.actorIDsFn()
+}
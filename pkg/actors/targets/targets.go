@@ -38,4 +38,5 @@ type Factory interface {
 	HaltAll(context.Context) error
 	HaltNonHosted(context.Context, func(*api.LookupActorRequest) bool) error
 	Len() int
+	ActorIDs() []string
 }
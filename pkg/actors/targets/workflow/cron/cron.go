@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cron implements the actor which starts a workflow on a cron
+// schedule. Every run reuses the instance ID of the schedule, so the previous
+// run must have completed before the next one can start.
+package cron
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.actors.targets.cron")
+
+// terminateReason is the output of a run terminated by the next scheduled
+// run with the terminate_previous overlap policy.
+const terminateReason = `"terminated by the next scheduled run"`
+
+type cron struct {
+	*factory
+	actorID string
+}
+
+func (c *cron) InvokeMethod(context.Context, *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+	return nil, errors.New("invoke not implemented")
+}
+
+// InvokeReminder starts the next run of the scheduled workflow, whose start
+// event is carried by the reminder. Returning an error retries the reminder,
+// which is how a run is queued behind the previous one.
+func (c *cron) InvokeReminder(ctx context.Context, reminder *actorapi.Reminder) error {
+	var template backend.HistoryEvent
+	if err := reminder.Data.UnmarshalTo(&template); err != nil || template.GetExecutionStarted() == nil {
+		// Retrying cannot fix the reminder data, so drop the run.
+		log.Errorf("Invalid cron reminder for workflow '%s', skipping run: %v", c.actorID, err)
+		return nil
+	}
+
+	es := template.GetExecutionStarted()
+	err := c.createRun(ctx, es)
+	if status.Code(err) != codes.AlreadyExists {
+		if err == nil {
+			log.Debugf("Started scheduled run of workflow '%s'", c.actorID)
+		}
+		return err
+	}
+
+	switch policy := es.GetTags()[todo.OptionOverlapPolicy]; policy {
+	case todo.OverlapPolicyQueue:
+		log.Debugf("Previous run of workflow '%s' is still running, queueing scheduled run", c.actorID)
+		return err
+
+	case todo.OverlapPolicyTerminatePrevious:
+		log.Infof("Previous run of workflow '%s' is still running, terminating it for the scheduled run", c.actorID)
+		if terr := c.terminatePrevious(ctx); terr != nil {
+			return terr
+		}
+		// The run is started once the previous run has terminated.
+		return err
+
+	default:
+		log.Infof("Previous run of workflow '%s' is still running, skipping scheduled run", c.actorID)
+		return nil
+	}
+}
+
+func (c *cron) createRun(ctx context.Context, es *protos.ExecutionStartedEvent) error {
+	tags := maps.Clone(es.GetTags())
+	delete(tags, todo.OptionOverlapPolicy)
+
+	data, err := proto.Marshal(&backend.CreateWorkflowInstanceRequest{
+		StartEvent: &backend.HistoryEvent{
+			EventId:   -1,
+			Timestamp: timestamppb.Now(),
+			EventType: &protos.HistoryEvent_ExecutionStarted{
+				ExecutionStarted: &protos.ExecutionStartedEvent{
+					Name:  es.GetName(),
+					Input: es.GetInput(),
+					Tags:  tags,
+					WorkflowInstance: &protos.WorkflowInstance{
+						InstanceId:  c.actorID,
+						ExecutionId: wrapperspb.String(uuid.New().String()),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.router.Call(ctx, internalsv1pb.
+		NewInternalInvokeRequest(todo.CreateWorkflowInstanceMethod).
+		WithActor(c.wfActorType, c.actorID).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType))
+	return err
+}
+
+func (c *cron) terminatePrevious(ctx context.Context) error {
+	data, err := proto.Marshal(&backend.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.Now(),
+		EventType: &protos.HistoryEvent_ExecutionTerminated{
+			ExecutionTerminated: &protos.ExecutionTerminatedEvent{
+				Input: wrapperspb.String(terminateReason),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.router.Call(ctx, internalsv1pb.
+		NewInternalInvokeRequest(todo.AddWorkflowEventMethod).
+		WithActor(c.wfActorType, c.actorID).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType))
+	return err
+}
+
+func (c *cron) InvokeTimer(ctx context.Context, reminder *actorapi.Reminder) error {
+	return errors.New("timers are not implemented")
+}
+
+func (c *cron) Deactivate(context.Context) error {
+	return nil
+}
+
+func (c *cron) InvokeStream(ctx context.Context,
+	req *internalsv1pb.InternalInvokeRequest,
+	stream func(*internalsv1pb.InternalInvokeResponse) (bool, error),
+) error {
+	return errors.New("invoke stream is not implemented")
+}
+
+func (c *cron) Key() string {
+	return c.actorType + actorapi.DaprSeparator + c.actorID
+}
+
+func (c *cron) Type() string {
+	return c.actorType
+}
+
+func (c *cron) ID() string {
+	return c.actorID
+}
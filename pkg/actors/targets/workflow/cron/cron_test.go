@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestInvokeReminder(t *testing.T) {
+	const wfActorType = "dapr.internal.default.testapp.workflow"
+
+	reminder := func(t *testing.T, policy string) *actorapi.Reminder {
+		t.Helper()
+		tags := map[string]string{todo.TagMaxDuration: "1m"}
+		if policy != "" {
+			tags[todo.OptionOverlapPolicy] = policy
+		}
+		data, err := anypb.New(&backend.HistoryEvent{
+			EventId: -1,
+			EventType: &protos.HistoryEvent_ExecutionStarted{
+				ExecutionStarted: &protos.ExecutionStartedEvent{
+					Name:             "report",
+					Input:            wrapperspb.String(`"daily"`),
+					Tags:             tags,
+					WorkflowInstance: &protos.WorkflowInstance{InstanceId: "wf1"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		return &actorapi.Reminder{Name: todo.CronReminderName, Data: data}
+	}
+
+	run := func(t *testing.T, r *actorapi.Reminder, createErr error) ([]*internalsv1pb.InternalInvokeRequest, error) {
+		t.Helper()
+		var calls []*internalsv1pb.InternalInvokeRequest
+		c := &cron{
+			factory: &factory{
+				wfActorType: wfActorType,
+				actorType:   "dapr.internal.default.testapp.cron",
+				router: routerfake.New().WithCallFn(func(_ context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+					calls = append(calls, req)
+					if req.GetMessage().GetMethod() == todo.CreateWorkflowInstanceMethod {
+						return nil, createErr
+					}
+					return nil, nil
+				}),
+			},
+			actorID: "wf1",
+		}
+		return calls, c.InvokeReminder(t.Context(), r)
+	}
+
+	running := status.Error(codes.AlreadyExists, "workflow 'wf1' is still running")
+
+	t.Run("starts run", func(t *testing.T) {
+		calls, err := run(t, reminder(t, todo.OverlapPolicyQueue), nil)
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, todo.CreateWorkflowInstanceMethod, calls[0].GetMessage().GetMethod())
+		assert.Equal(t, wfActorType, calls[0].GetActor().GetActorType())
+		assert.Equal(t, "wf1", calls[0].GetActor().GetActorId())
+
+		var req backend.CreateWorkflowInstanceRequest
+		require.NoError(t, proto.Unmarshal(calls[0].GetMessage().GetData().GetValue(), &req))
+		es := req.GetStartEvent().GetExecutionStarted()
+		assert.Equal(t, "report", es.GetName())
+		assert.Equal(t, `"daily"`, es.GetInput().GetValue())
+		assert.Equal(t, "wf1", es.GetWorkflowInstance().GetInstanceId())
+		assert.NotEmpty(t, es.GetWorkflowInstance().GetExecutionId().GetValue())
+		assert.NotNil(t, req.GetStartEvent().GetTimestamp())
+		assert.Equal(t, map[string]string{todo.TagMaxDuration: "1m"}, es.GetTags())
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		for _, policy := range []string{"", todo.OverlapPolicySkip} {
+			calls, err := run(t, reminder(t, policy), running)
+			require.NoError(t, err, policy)
+			assert.Len(t, calls, 1, policy)
+		}
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		calls, err := run(t, reminder(t, todo.OverlapPolicyQueue), running)
+		require.Error(t, err)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Len(t, calls, 1)
+	})
+
+	t.Run("terminate previous", func(t *testing.T) {
+		calls, err := run(t, reminder(t, todo.OverlapPolicyTerminatePrevious), running)
+		require.Error(t, err)
+		require.Len(t, calls, 2)
+		assert.Equal(t, todo.AddWorkflowEventMethod, calls[1].GetMessage().GetMethod())
+		assert.Equal(t, "wf1", calls[1].GetActor().GetActorId())
+
+		var e backend.HistoryEvent
+		require.NoError(t, proto.Unmarshal(calls[1].GetMessage().GetData().GetValue(), &e))
+		assert.Equal(t, terminateReason, e.GetExecutionTerminated().GetInput().GetValue())
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		data, err := anypb.New(&backend.HistoryEvent{
+			EventType: &protos.HistoryEvent_ExecutionTerminated{ExecutionTerminated: &protos.ExecutionTerminatedEvent{}},
+		})
+		require.NoError(t, err)
+		calls, err := run(t, &actorapi.Reminder{Name: todo.CronReminderName, Data: data}, nil)
+		require.NoError(t, err)
+		assert.Empty(t, calls)
+	})
+}
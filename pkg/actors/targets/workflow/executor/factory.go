@@ -131,3 +131,9 @@ func (f *factory) Len() int {
 	f.table.Range(func(_, _ any) bool { count++; return true })
 	return count
 }
+
+func (f *factory) ActorIDs() []string {
+	var ids []string
+	f.table.Range(func(key, _ any) bool { ids = append(ids, key.(string)); return true })
+	return ids
+}
@@ -29,6 +29,9 @@ const (
 	// Created on each child workflow actor by a recursively-terminated parent,
 	// carrying the ExecutionTerminated event as reminder data.
 	reminderCascadeTerminate = "cascade-terminate"
+	// Created on a workflow started with a max duration, terminating it once
+	// due, and carrying its compensation activity as reminder data.
+	reminderTimeout = "timeout"
 )
 
 func (o *orchestrator) addWorkflowEvent(ctx context.Context, e *backend.HistoryEvent) error {
@@ -125,6 +125,10 @@ func (o *orchestrator) createIfCompleted(ctx context.Context, rs *backend.Workfl
 
 	log.Infof("Workflow actor '%s': workflow was previously completed and is being recreated", o.actorID)
 
+	if err := o.deleteTimeoutReminder(ctx); err != nil {
+		return fmt.Errorf("failed to delete timeout reminder of previous workflow: %w", err)
+	}
+
 	state.Reset()
 
 	if propagatedHistory != nil {
@@ -154,6 +158,9 @@ func (o *orchestrator) scheduleWorkflowStart(ctx context.Context, startEvent *ba
 	if err := o.createWorkflowReminder(ctx, reminderName, nil, start, o.appID, &workflowName); err != nil {
 		return err
 	}
+	if err := o.createTimeoutReminder(ctx, startEvent.GetExecutionStarted(), start); err != nil {
+		return err
+	}
 	state.AddToInbox(startEvent)
 	if err := o.signAndSaveState(ctx, state); err != nil {
 		return err
@@ -69,6 +69,10 @@ type Options struct {
 
 	// May be nil when the feature is disabled.
 	WorkflowAccessPolicies *workflowacl.Holder
+
+	// FanOutLimits are the limits on the concurrent child workflows and
+	// activities of each workflow instance, keyed by workflow name.
+	FanOutLimits map[string]config.FanOutLimit
 }
 
 type factory struct {
@@ -89,6 +93,7 @@ type factory struct {
 	signer                 *signer.Signer
 	maxRequestBodySize     int
 	workflowAccessPolicies *workflowacl.Holder
+	fanOutLimits           map[string]config.FanOutLimit
 
 	scheduler todo.WorkflowScheduler
 
@@ -147,6 +152,7 @@ func New(ctx context.Context, opts Options) (targets.Factory, error) {
 		signer:                 opts.Signer,
 		maxRequestBodySize:     opts.MaxRequestBodySize,
 		workflowAccessPolicies: opts.WorkflowAccessPolicies,
+		fanOutLimits:           opts.FanOutLimits,
 		scheduler:              opts.Scheduler,
 		deactivateCh:           deactivateCh,
 	}, nil
@@ -268,6 +274,12 @@ func (f *factory) Len() int {
 	return count
 }
 
+func (f *factory) ActorIDs() []string {
+	var ids []string
+	f.table.Range(func(key, _ any) bool { ids = append(ids, key.(string)); return true })
+	return ids
+}
+
 func (f *factory) deactivate(orchestrator *orchestrator) {
 	if !orchestrator.closed.CompareAndSwap(false, true) {
 		return
@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+// stampFanOutLimits records the fan-out limits configured for the workflow on
+// the ExecutionStarted event of a new generation. The limits are read back
+// from the event rather than the configuration, so they stay fixed for the
+// lifetime of the generation: which queued tasks have been dispatched is
+// derived from the limit, so changing it mid-run could strand or duplicate
+// them.
+func (o *orchestrator) stampFanOutLimits(es *protos.ExecutionStartedEvent) {
+	if es == nil {
+		return
+	}
+	limit, ok := o.fanOutLimits[es.GetName()]
+	if !ok {
+		return
+	}
+
+	if es.Tags == nil {
+		es.Tags = make(map[string]string, 2)
+	}
+	if _, ok = es.Tags[todo.TagMaxConcurrentActivities]; !ok && limit.Activities > 0 {
+		es.Tags[todo.TagMaxConcurrentActivities] = strconv.Itoa(limit.Activities)
+	}
+	if _, ok = es.Tags[todo.TagMaxConcurrentChildWorkflows]; !ok && limit.ChildWorkflows > 0 {
+		es.Tags[todo.TagMaxConcurrentChildWorkflows] = strconv.Itoa(limit.ChildWorkflows)
+	}
+}
+
+// fanOutLimit returns the limit recorded in the given tag of the
+// ExecutionStarted event, or zero if there is no limit.
+func fanOutLimit(es *protos.ExecutionStartedEvent, tag string) int {
+	limit, err := strconv.Atoi(es.GetTags()[tag])
+	if err != nil || limit <= 0 {
+		return 0
+	}
+	return limit
+}
+
+// limitFanOut applies the fan-out limits of the workflow to the activities
+// and child workflows dispatched by this execution. Only the oldest
+// outstanding tasks, up to the limit, are ever dispatched. Tasks scheduled
+// beyond the limit stay queued in the history, and are dispatched by the
+// execution in which enough earlier tasks complete for them to come within
+// the limit. Detached workflows are not limited.
+//
+// Returns the activities and child workflow creations to dispatch, which
+// include queued tasks now within the limit, and the propagated history of
+// those activities.
+func (o *orchestrator) limitFanOut(rs *backend.WorkflowRuntimeState, incomingHistory *protos.PropagatedHistory, pendingTasks []*backend.HistoryEvent, createWorkflows []*backend.WorkflowRuntimeStateMessage, outgoingHistory map[int32]*protos.PropagatedHistory) ([]*backend.HistoryEvent, []*backend.WorkflowRuntimeStateMessage, map[int32]*protos.PropagatedHistory, error) {
+	if runtimestate.IsCompleted(rs) {
+		return pendingTasks, createWorkflows, outgoingHistory, nil
+	}
+
+	es := rs.GetStartEvent()
+	if limit := fanOutLimit(es, todo.TagMaxConcurrentActivities); limit > 0 {
+		window, queued := fanOutWindow(rs, limit, isActivity)
+
+		tasks := make([]*backend.HistoryEvent, 0, len(pendingTasks)+len(queued))
+		for _, e := range queued {
+			if scope := e.GetTaskScheduled().GetHistoryPropagationScope(); scope != protos.HistoryPropagationScope_HISTORY_PROPAGATION_SCOPE_NONE {
+				ph, err := runtimestate.AssembleProtoPropagatedHistory(rs, scope, incomingHistory, o.appID)
+				if err != nil {
+					return nil, nil, nil, fmt.Errorf("failed to assemble propagated history for queued activity: %w", err)
+				}
+				if outgoingHistory == nil {
+					outgoingHistory = make(map[int32]*protos.PropagatedHistory)
+				}
+				outgoingHistory[e.GetEventId()] = ph
+			}
+			tasks = append(tasks, e)
+		}
+
+		var held int
+		for _, e := range pendingTasks {
+			if _, ok := window[e.GetEventId()]; !ok {
+				held++
+				continue
+			}
+			tasks = append(tasks, e)
+		}
+		if held > 0 {
+			log.Debugf("Workflow actor '%s': queued %d activities beyond the fan-out limit of %d", o.actorID, held, limit)
+		}
+		pendingTasks = tasks
+	}
+
+	if limit := fanOutLimit(es, todo.TagMaxConcurrentChildWorkflows); limit > 0 {
+		window, queued := fanOutWindow(rs, limit, isChildWorkflow)
+
+		msgs := make([]*backend.WorkflowRuntimeStateMessage, 0, len(createWorkflows)+len(queued))
+		for _, e := range queued {
+			msg, err := o.queuedChildWorkflow(rs, incomingHistory, e)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			msgs = append(msgs, msg)
+		}
+
+		var held int
+		for _, msg := range createWorkflows {
+			if parent := msg.GetHistoryEvent().GetExecutionStarted().GetParentInstance(); parent != nil {
+				if _, ok := window[parent.GetTaskScheduledId()]; !ok {
+					held++
+					continue
+				}
+			}
+			msgs = append(msgs, msg)
+		}
+		if held > 0 {
+			log.Debugf("Workflow actor '%s': queued %d child workflows beyond the fan-out limit of %d", o.actorID, held, limit)
+		}
+		createWorkflows = msgs
+	}
+
+	return pendingTasks, createWorkflows, outgoingHistory, nil
+}
+
+// fanOutKind identifies the tasks of a fan-out limit. For events of the kind
+// it returns either the ID of the task scheduled by the event, or the ID of
+// the task resolved by the event, with the other ID set to -1.
+type fanOutKind func(e *backend.HistoryEvent) (scheduled, resolved int32, ok bool)
+
+func isActivity(e *backend.HistoryEvent) (int32, int32, bool) {
+	switch {
+	case e.GetTaskScheduled() != nil:
+		return e.GetEventId(), -1, true
+	case e.GetTaskCompleted() != nil:
+		return -1, e.GetTaskCompleted().GetTaskScheduledId(), true
+	case e.GetTaskFailed() != nil:
+		return -1, e.GetTaskFailed().GetTaskScheduledId(), true
+	default:
+		return -1, -1, false
+	}
+}
+
+func isChildWorkflow(e *backend.HistoryEvent) (int32, int32, bool) {
+	switch {
+	case e.GetChildWorkflowInstanceCreated() != nil:
+		return e.GetEventId(), -1, true
+	case e.GetChildWorkflowInstanceCompleted() != nil:
+		return -1, e.GetChildWorkflowInstanceCompleted().GetTaskScheduledId(), true
+	case e.GetChildWorkflowInstanceFailed() != nil:
+		return -1, e.GetChildWorkflowInstanceFailed().GetTaskScheduledId(), true
+	default:
+		return -1, -1, false
+	}
+}
+
+// fanOutWindow returns the IDs of the tasks within the limit once the events
+// of this execution are applied, and the events of the queued tasks which
+// came within the limit with them. A task is within the limit while it is
+// among the oldest outstanding tasks. As tasks only ever leave the
+// outstanding tasks when they are resolved, a task within the limit stays
+// within it until resolved, so each queued task comes within the limit, and
+// is dispatched, exactly once.
+func fanOutWindow(rs *backend.WorkflowRuntimeState, limit int, kind fanOutKind) (map[int32]struct{}, []*backend.HistoryEvent) {
+	before := outstandingTasks(rs.GetOldEvents(), nil, kind)
+	after := outstandingTasks(rs.GetOldEvents(), rs.GetNewEvents(), kind)
+
+	// Tasks outstanding before this execution which were not within the limit
+	// are queued. Tasks scheduled by this execution are not, as they are
+	// dispatched from the pending tasks and messages of the runtime state.
+	queuedBefore := make(map[int32]struct{}, max(len(before)-limit, 0))
+	for _, e := range before[min(limit, len(before)):] {
+		queuedBefore[e.GetEventId()] = struct{}{}
+	}
+
+	window := make(map[int32]struct{}, min(limit, len(after)))
+	var queued []*backend.HistoryEvent
+	for _, e := range after[:min(limit, len(after))] {
+		window[e.GetEventId()] = struct{}{}
+		if _, ok := queuedBefore[e.GetEventId()]; ok {
+			queued = append(queued, e)
+		}
+	}
+
+	return window, queued
+}
+
+// outstandingTasks returns the events scheduling the tasks which are not yet
+// resolved, oldest first.
+func outstandingTasks(oldEvents, newEvents []*backend.HistoryEvent, kind fanOutKind) []*backend.HistoryEvent {
+	resolved := make(map[int32]struct{})
+	var scheduled []*backend.HistoryEvent
+	for _, events := range [][]*backend.HistoryEvent{oldEvents, newEvents} {
+		for _, e := range events {
+			scheduledID, resolvedID, ok := kind(e)
+			switch {
+			case !ok:
+			case scheduledID >= 0:
+				scheduled = append(scheduled, e)
+			default:
+				resolved[resolvedID] = struct{}{}
+			}
+		}
+	}
+
+	scheduled = slices.DeleteFunc(scheduled, func(e *backend.HistoryEvent) bool {
+		_, ok := resolved[e.GetEventId()]
+		return ok
+	})
+	slices.SortStableFunc(scheduled, func(a, b *backend.HistoryEvent) int {
+		return int(a.GetEventId()) - int(b.GetEventId())
+	})
+	return scheduled
+}
+
+// queuedChildWorkflow builds the creation message of a queued child workflow
+// from the ChildWorkflowInstanceCreated event in the history, the same as the
+// engine builds it for a child workflow created by the current execution.
+func (o *orchestrator) queuedChildWorkflow(rs *backend.WorkflowRuntimeState, incomingHistory *protos.PropagatedHistory, e *backend.HistoryEvent) (*backend.WorkflowRuntimeStateMessage, error) {
+	created := e.GetChildWorkflowInstanceCreated()
+	start := rs.GetStartEvent()
+
+	parent := &protos.ParentInstanceInfo{
+		TaskScheduledId: e.GetEventId(),
+		Name:            wrapperspb.String(start.GetName()),
+		WorkflowInstance: &protos.WorkflowInstance{
+			InstanceId:  rs.GetInstanceId(),
+			ExecutionId: start.GetWorkflowInstance().GetExecutionId(),
+		},
+		AppID: new(e.GetRouter().GetSourceAppID()),
+	}
+	if o.namespace != "" {
+		parent.AppNamespace = new(o.namespace)
+	}
+
+	msg := &backend.WorkflowRuntimeStateMessage{
+		HistoryEvent: &backend.HistoryEvent{
+			EventId:   -1,
+			Timestamp: timestamppb.Now(),
+			EventType: &protos.HistoryEvent_ExecutionStarted{
+				ExecutionStarted: &protos.ExecutionStartedEvent{
+					Name:           created.GetName(),
+					ParentInstance: parent,
+					Input:          created.GetInput(),
+					WorkflowInstance: &protos.WorkflowInstance{
+						InstanceId:  created.GetInstanceId(),
+						ExecutionId: wrapperspb.String(uuid.New().String()),
+					},
+					ParentTraceContext: created.GetParentTraceContext(),
+				},
+			},
+			Router: e.GetRouter(),
+		},
+		TargetInstanceId: created.GetInstanceId(),
+	}
+
+	if scope := created.GetHistoryPropagationScope(); scope != protos.HistoryPropagationScope_HISTORY_PROPAGATION_SCOPE_NONE {
+		ph, err := runtimestate.AssembleProtoPropagatedHistory(rs, scope, incomingHistory, o.appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assemble propagated history for queued child workflow: %w", err)
+		}
+		msg.PropagatedHistory = ph
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+func Test_stampFanOutLimits(t *testing.T) {
+	o := &orchestrator{factory: &factory{fanOutLimits: map[string]config.FanOutLimit{
+		"batch": {ChildWorkflows: 2, Activities: 10},
+		"etl":   {Activities: 5},
+	}}}
+
+	es := &protos.ExecutionStartedEvent{Name: "batch"}
+	o.stampFanOutLimits(es)
+	assert.Equal(t, map[string]string{
+		todo.TagMaxConcurrentActivities:     "10",
+		todo.TagMaxConcurrentChildWorkflows: "2",
+	}, es.GetTags())
+
+	es = &protos.ExecutionStartedEvent{Name: "etl", Tags: map[string]string{todo.TagMaxConcurrentActivities: "1"}}
+	o.stampFanOutLimits(es)
+	assert.Equal(t, map[string]string{todo.TagMaxConcurrentActivities: "1"}, es.GetTags())
+
+	es = &protos.ExecutionStartedEvent{Name: "other"}
+	o.stampFanOutLimits(es)
+	assert.Nil(t, es.GetTags())
+
+	o.stampFanOutLimits(nil)
+}
+
+func Test_limitFanOut(t *testing.T) {
+	started := func(tags map[string]string) []*backend.HistoryEvent {
+		return []*backend.HistoryEvent{
+			{EventId: -1, Timestamp: timestamppb.Now(), EventType: &protos.HistoryEvent_WorkflowStarted{WorkflowStarted: &protos.WorkflowStartedEvent{}}},
+			{EventId: -1, Timestamp: timestamppb.Now(), EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: &protos.ExecutionStartedEvent{
+				Name:             "parent",
+				Tags:             tags,
+				WorkflowInstance: &protos.WorkflowInstance{InstanceId: "wf1", ExecutionId: wrapperspb.String("exec1")},
+			}}},
+		}
+	}
+	scheduled := func(id int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{EventId: id, Timestamp: timestamppb.Now(), EventType: &protos.HistoryEvent_TaskScheduled{TaskScheduled: &protos.TaskScheduledEvent{Name: "act"}}}
+	}
+	completed := func(id int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{EventId: -1, Timestamp: timestamppb.Now(), EventType: &protos.HistoryEvent_TaskCompleted{TaskCompleted: &protos.TaskCompletedEvent{TaskScheduledId: id}}}
+	}
+	created := func(id int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{
+			EventId:   id,
+			Timestamp: timestamppb.Now(),
+			Router:    &protos.TaskRouter{SourceAppID: "testapp"},
+			EventType: &protos.HistoryEvent_ChildWorkflowInstanceCreated{ChildWorkflowInstanceCreated: &protos.ChildWorkflowInstanceCreatedEvent{
+				Name:       "child",
+				InstanceId: "child-" + string(rune('a'+id)),
+				Input:      wrapperspb.String(`"in"`),
+			}},
+		}
+	}
+	childFailed := func(id int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{EventId: -1, Timestamp: timestamppb.Now(), EventType: &protos.HistoryEvent_ChildWorkflowInstanceFailed{ChildWorkflowInstanceFailed: &protos.ChildWorkflowInstanceFailedEvent{TaskScheduledId: id}}}
+	}
+	createMsg := func(id int32) *backend.WorkflowRuntimeStateMessage {
+		return &backend.WorkflowRuntimeStateMessage{
+			TargetInstanceId: "child-" + string(rune('a'+id)),
+			HistoryEvent: &backend.HistoryEvent{EventId: -1, EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: &protos.ExecutionStartedEvent{
+				ParentInstance: &protos.ParentInstanceInfo{TaskScheduledId: id},
+			}}},
+		}
+	}
+	runtimeState := func(t *testing.T, old, newEvents []*backend.HistoryEvent) *backend.WorkflowRuntimeState {
+		t.Helper()
+		rs := runtimestate.NewWorkflowRuntimeState("wf1", nil, old)
+		for _, e := range newEvents {
+			require.NoError(t, runtimestate.AddEvent(rs, e))
+		}
+		return rs
+	}
+	ids := func(es []*backend.HistoryEvent) []int32 {
+		var ids []int32
+		for _, e := range es {
+			ids = append(ids, e.GetEventId())
+		}
+		return ids
+	}
+
+	o := &orchestrator{factory: &factory{appID: "testapp", namespace: "default"}, actorID: "wf1"}
+
+	t.Run("no limits", func(t *testing.T) {
+		rs := runtimeState(t, nil, append(started(nil), scheduled(0), scheduled(1), scheduled(2)))
+		pending := []*backend.HistoryEvent{scheduled(0), scheduled(1), scheduled(2)}
+		tasks, creates, _, err := o.limitFanOut(rs, nil, pending, []*backend.WorkflowRuntimeStateMessage{createMsg(3)}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{0, 1, 2}, ids(tasks))
+		assert.Len(t, creates, 1)
+	})
+
+	tags := map[string]string{
+		todo.TagMaxConcurrentActivities:     "2",
+		todo.TagMaxConcurrentChildWorkflows: "1",
+	}
+
+	t.Run("holds back activities beyond the limit", func(t *testing.T) {
+		newEvents := append(started(tags), scheduled(0), scheduled(1), scheduled(2), scheduled(3))
+		rs := runtimeState(t, nil, newEvents)
+		tasks, _, _, err := o.limitFanOut(rs, nil, newEvents[2:], nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{0, 1}, ids(tasks))
+	})
+
+	t.Run("dispatches queued activities as earlier ones complete", func(t *testing.T) {
+		old := append(started(tags), scheduled(0), scheduled(1), scheduled(2), scheduled(3))
+		rs := runtimeState(t, old, []*backend.HistoryEvent{completed(1)})
+		tasks, _, _, err := o.limitFanOut(rs, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{2}, ids(tasks))
+
+		// Task 2 is now dispatched; completing task 0 dispatches task 3 only.
+		old = append(old, completed(1))
+		rs = runtimeState(t, old, []*backend.HistoryEvent{completed(0), scheduled(4)})
+		tasks, _, _, err = o.limitFanOut(rs, nil, []*backend.HistoryEvent{scheduled(4)}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{3}, ids(tasks))
+	})
+
+	t.Run("does not dispatch queued activities of completed workflows", func(t *testing.T) {
+		old := append(started(tags), scheduled(0), scheduled(1), scheduled(2))
+		rs := runtimeState(t, old, []*backend.HistoryEvent{completed(0), {
+			EventId:   -1,
+			Timestamp: timestamppb.Now(),
+			EventType: &protos.HistoryEvent_ExecutionCompleted{ExecutionCompleted: &protos.ExecutionCompletedEvent{
+				WorkflowStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED,
+			}},
+		}})
+		tasks, _, _, err := o.limitFanOut(rs, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+	})
+
+	t.Run("holds back and dispatches queued child workflows", func(t *testing.T) {
+		detached := &backend.WorkflowRuntimeStateMessage{
+			TargetInstanceId: "detached",
+			HistoryEvent:     &backend.HistoryEvent{EventId: -1, EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: &protos.ExecutionStartedEvent{}}},
+		}
+		newEvents := append(started(tags), created(0), created(1))
+		rs := runtimeState(t, nil, newEvents)
+		_, creates, _, err := o.limitFanOut(rs, nil, nil, []*backend.WorkflowRuntimeStateMessage{createMsg(0), createMsg(1), detached}, nil)
+		require.NoError(t, err)
+		require.Len(t, creates, 2)
+		assert.Equal(t, "child-a", creates[0].GetTargetInstanceId())
+		assert.Equal(t, "detached", creates[1].GetTargetInstanceId())
+
+		rs = runtimeState(t, newEvents, []*backend.HistoryEvent{childFailed(0)})
+		_, creates, _, err = o.limitFanOut(rs, nil, nil, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, creates, 1)
+		assert.Equal(t, "child-b", creates[0].GetTargetInstanceId())
+		es := creates[0].GetHistoryEvent().GetExecutionStarted()
+		assert.Equal(t, "child", es.GetName())
+		assert.Equal(t, `"in"`, es.GetInput().GetValue())
+		assert.Equal(t, "child-b", es.GetWorkflowInstance().GetInstanceId())
+		assert.NotEmpty(t, es.GetWorkflowInstance().GetExecutionId().GetValue())
+		parent := es.GetParentInstance()
+		assert.Equal(t, int32(1), parent.GetTaskScheduledId())
+		assert.Equal(t, "parent", parent.GetName().GetValue())
+		assert.Equal(t, "wf1", parent.GetWorkflowInstance().GetInstanceId())
+		assert.Equal(t, "exec1", parent.GetWorkflowInstance().GetExecutionId().GetValue())
+		assert.Equal(t, "testapp", parent.GetAppID())
+		assert.Equal(t, "default", parent.GetAppNamespace())
+	})
+}
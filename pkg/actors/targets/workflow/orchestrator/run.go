@@ -99,6 +99,19 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		state.Inbox = append(state.Inbox, &cascadeEvent)
 	}
 
+	// A workflow started with a max duration is terminated by its timeout
+	// reminder, which carries the compensation activity to invoke once the
+	// termination is persisted (see createTimeoutReminder).
+	var compensation *backend.HistoryEvent
+	timedOut := reminder.Name == reminderTimeout && !runtimestate.IsCompleted(o.rstate)
+	if timedOut {
+		if compensation, err = o.timeoutCompensation(reminder); err != nil {
+			return todo.RunCompletedTrue, err
+		}
+		log.Infof("Workflow actor '%s': terminating workflow as it exceeded its max duration", o.actorID)
+		state.Inbox = append(state.Inbox, timeoutTerminateEvent())
+	}
+
 	if len(state.Inbox) == 0 && !runtimestate.IsCompleted(o.rstate) {
 		// The in-memory cache may be stale: during a placement cluster failure
 		// daprds will roll over the actor, so a peer host may have written a new
@@ -140,6 +153,13 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 			if terr := o.terminateChildren(ctx, state); terr != nil {
 				return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("failed to (re)deliver recursive terminate to children on empty-inbox path: %w", terr))
 			}
+			// A timeout reminder firing on a terminal workflow is the retry
+			// path for compensation activity invocation failures.
+			if reminder.Name == reminderTimeout {
+				if cerr := o.callCompensation(ctx, state); cerr != nil {
+					return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("failed to (re)invoke compensation activity on empty-inbox path: %w", cerr))
+				}
+			}
 		}
 		log.Debugf("Workflow actor '%s': ignoring run request for reminder '%s' because the workflow inbox is empty", o.actorID, reminder.Name)
 		return todo.RunCompletedTrue, nil
@@ -149,6 +169,7 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 	for _, e := range state.Inbox {
 		if es := e.GetExecutionStarted(); es != nil {
 			esHistoryEvent = e
+			o.stampFanOutLimits(es)
 			if esHistoryEvent.Router == nil {
 				// Set the source app ID for cross-app routing in durabletask-go
 				esHistoryEvent.Router = &protos.TaskRouter{
@@ -309,6 +330,16 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 	o.stripUnmatchedResolutions(state, rs)
 
 	runtimeStatus := runtimestate.RuntimeStatus(rs)
+
+	// Record the compensation activity of a timed out workflow in its history,
+	// so it is persisted atomically with the termination and can be invoked
+	// again if the invocation below fails.
+	if timedOut && compensation != nil && runtimeStatus == api.RUNTIME_STATUS_TERMINATED {
+		compensation.Timestamp = timestamppb.Now()
+		compensation.GetTaskScheduled().Input = rs.GetStartEvent().GetInput()
+		rs.NewEvents = append(rs.NewEvents, compensation)
+	}
+
 	log.Debugf("Workflow actor '%s': workflow execution returned with status '%s' instanceId '%s'", o.actorID, runtimeStatus.String(), wi.InstanceID)
 
 	// Increment the generation counter if the workflow used continue-as-new. Subsequent actions below
@@ -316,6 +347,7 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 	if rs.GetContinuedAsNew() {
 		log.Debugf("Workflow actor '%s': workflow with instanceId '%s' continued as new", o.actorID, wi.InstanceID)
 		state.Generation += 1
+		o.stampFanOutLimits(rs.GetStartEvent())
 		// The engine carries the propagation chain across CAN by updating
 		// wi.IncomingHistory. Persist any change so the new generation sees
 		// the chain on its next run.
@@ -383,6 +415,14 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		}
 	}
 
+	// Hold back the activities and child workflows beyond the fan-out limits
+	// of the workflow, and dispatch those queued by earlier executions which
+	// are now within them.
+	pendingTasks, createWorkflows, wi.OutgoingHistory, err = o.limitFanOut(rs, wi.IncomingHistory, pendingTasks, createWorkflows, wi.OutgoingHistory)
+	if err != nil {
+		return todo.RunCompletedFalse, err
+	}
+
 	// Attach a fresh chunk-local signature + cert chain to the current-app
 	// chunk of every outbound PropagatedHistory so the receiver can
 	// cryptographically verify the chunk against this app's identity.
@@ -496,6 +536,17 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 				return todo.RunCompletedFalse, err
 			}
 		}
+		// Invoke the compensation activity only after deleting the reminders,
+		// which include those of activities. The timeout reminder may be among
+		// them, so re-create it to retry a failed invocation.
+		if timedOut {
+			if err = o.callCompensation(ctx, state); err != nil {
+				if rerr := o.createWorkflowReminder(ctx, reminderTimeout, nil, time.Now(), o.appID, nil); rerr != nil {
+					err = errors.Join(err, rerr)
+				}
+				return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("failed to invoke compensation activity: %w", err))
+			}
+		}
 		return todo.RunCompletedTrue, nil
 	}
 
@@ -575,19 +626,22 @@ func (o *orchestrator) handleRetention(ctx context.Context, status protos.Orches
 		return nil
 	}
 
+	name, _ := runtimestate.Name(o.rstate)
+	policy := o.retentionPolicy.ForWorkflow(name)
+
 	var dueTime *time.Duration
 	switch {
-	case o.retentionPolicy.Completed != nil &&
+	case policy.Completed != nil &&
 		status == protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED:
-		dueTime = o.retentionPolicy.Completed
-	case o.retentionPolicy.Terminated != nil &&
+		dueTime = policy.Completed
+	case policy.Terminated != nil &&
 		status == protos.OrchestrationStatus_ORCHESTRATION_STATUS_TERMINATED:
-		dueTime = o.retentionPolicy.Terminated
-	case o.retentionPolicy.Failed != nil &&
+		dueTime = policy.Terminated
+	case policy.Failed != nil &&
 		status == protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED:
-		dueTime = o.retentionPolicy.Failed
-	case o.retentionPolicy.AnyTerminal != nil:
-		dueTime = o.retentionPolicy.AnyTerminal
+		dueTime = policy.Failed
+	case policy.AnyTerminal != nil:
+		dueTime = policy.AnyTerminal
 	}
 
 	if dueTime == nil {
@@ -25,6 +25,7 @@ import (
 
 	contribstate "github.com/dapr/components-contrib/state"
 	actorsapi "github.com/dapr/dapr/pkg/actors/api"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
@@ -368,7 +369,19 @@ func (o *orchestrator) purgeWorkflowState(ctx context.Context, meta map[string]*
 	s, ok := meta[todo.MetadataPurgeRetentionCall]
 	retentionCall := ok && len(s.GetValues()) > 0 && s.GetValues()[0] == "true"
 
-	return o.cleanupWorkflowStateInternal(ctx, state, !retentionCall)
+	// Capture the name and status before cleanup resets the runtime state.
+	name, _ := runtimestate.Name(o.rstate)
+	status := executionStatusForRuntimeStatus(runtimestate.RuntimeStatus(o.rstate))
+
+	if err = o.cleanupWorkflowStateInternal(ctx, state, !retentionCall); err != nil {
+		return err
+	}
+
+	if retentionCall {
+		diag.DefaultWorkflowMonitoring.WorkflowRetentionPurged(ctx, name, status)
+	}
+
+	return nil
 }
 
 func (o *orchestrator) getExecutionStartedEvent(state *wfenginestate.State) *protos.ExecutionStartedEvent {
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+const (
+	// compensationTaskID is the task ID of the compensation activity of a
+	// timed out workflow. Reserved so it never collides with the sequential
+	// IDs of the tasks scheduled by the workflow itself.
+	compensationTaskID = math.MaxInt32
+
+	// timeoutReason is the output of a workflow terminated for exceeding its
+	// max duration.
+	timeoutReason = `"workflow exceeded its max duration"`
+)
+
+// createTimeoutReminder creates the timeout reminder of a workflow started
+// with a max duration, due once the workflow has run for that long. The
+// reminder carries the TaskScheduled event of the compensation activity, if
+// any, as the tags of the start event do not survive continue-as-new.
+func (o *orchestrator) createTimeoutReminder(ctx context.Context, es *protos.ExecutionStartedEvent, start time.Time) error {
+	maxDuration, ok := es.GetTags()[todo.TagMaxDuration]
+	if !ok {
+		return nil
+	}
+
+	d, err := time.ParseDuration(maxDuration)
+	if err != nil || d <= 0 {
+		return grpcstatus.Errorf(codes.InvalidArgument, "invalid workflow max duration %q: must be a positive duration", maxDuration)
+	}
+
+	var data proto.Message
+	if name := es.GetTags()[todo.TagCompensationActivity]; name != "" {
+		data = &backend.HistoryEvent{
+			EventId: compensationTaskID,
+			EventType: &protos.HistoryEvent_TaskScheduled{
+				TaskScheduled: &protos.TaskScheduledEvent{Name: name},
+			},
+		}
+	}
+
+	return o.createWorkflowReminder(ctx, reminderTimeout, data, start.Add(d), o.appID, nil)
+}
+
+// deleteTimeoutReminder deletes the timeout reminder left over by a previous
+// workflow with the same ID, so it cannot terminate the new one.
+func (o *orchestrator) deleteTimeoutReminder(ctx context.Context) error {
+	err := o.reminders.Delete(ctx, &actorapi.DeleteReminderRequest{
+		Name:      reminderTimeout,
+		ActorType: o.actorTypeBuilder.Workflow(o.appID),
+		ActorID:   o.actorID,
+	})
+	if s, ok := grpcstatus.FromError(err); ok && s.Code() == codes.NotFound {
+		return nil
+	}
+	return err
+}
+
+// timeoutCompensation returns the TaskScheduled event of the compensation
+// activity carried by the timeout reminder, or nil if there is none.
+func (o *orchestrator) timeoutCompensation(reminder *actorapi.Reminder) (*backend.HistoryEvent, error) {
+	if reminder.Data == nil {
+		return nil, nil
+	}
+
+	var e backend.HistoryEvent
+	if err := reminder.Data.UnmarshalTo(&e); err != nil {
+		return nil, err
+	}
+	// Validate the event. A crafted reminder could contain arbitrary events to
+	// record in the history.
+	if e.GetTaskScheduled() == nil || e.GetEventId() != compensationTaskID {
+		return nil, fmt.Errorf("workflow actor '%s': timeout reminder contains invalid compensation event %T", o.actorID, e.GetEventType())
+	}
+
+	return &e, nil
+}
+
+func timeoutTerminateEvent() *backend.HistoryEvent {
+	return &backend.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.Now(),
+		EventType: &protos.HistoryEvent_ExecutionTerminated{
+			ExecutionTerminated: &protos.ExecutionTerminatedEvent{
+				Input: wrapperspb.String(timeoutReason),
+			},
+		},
+	}
+}
+
+// callCompensation invokes the compensation activity recorded in the history
+// of a timed out workflow. Called only after the terminal state has been
+// persisted and the reminders of the workflow deleted, since those include
+// the reminder of the activity. No-op if no compensation activity was
+// recorded.
+func (o *orchestrator) callCompensation(ctx context.Context, state *wfenginestate.State) error {
+	var compensation *backend.HistoryEvent
+	for _, e := range state.History {
+		if e.GetEventId() == compensationTaskID && e.GetTaskScheduled() != nil {
+			compensation = e
+			break
+		}
+	}
+	if compensation == nil {
+		return nil
+	}
+
+	log.Infof("Workflow actor '%s': invoking compensation activity '%s' of timed out workflow", o.actorID, compensation.GetTaskScheduled().GetName())
+
+	workflowName := o.getExecutionStartedEvent(state).GetName()
+	err := o.callActivity(ctx, compensation, state.History[0].GetTimestamp().AsTime(), state.Generation, nil, workflowName)
+	if errors.Is(err, todo.ErrDuplicateInvocation) {
+		return nil
+	}
+	return err
+}
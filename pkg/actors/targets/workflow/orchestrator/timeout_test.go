@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	remindersfake "github.com/dapr/dapr/pkg/actors/reminders/fake"
+	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/common"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+func Test_createTimeoutReminder(t *testing.T) {
+	start := time.Now()
+
+	run := func(t *testing.T, tags map[string]string) (*actorapi.CreateReminderRequest, error) {
+		t.Helper()
+		var got *actorapi.CreateReminderRequest
+		o := &orchestrator{
+			factory: &factory{
+				appID: "testapp",
+				reminders: remindersfake.New().WithCreate(func(_ context.Context, req *actorapi.CreateReminderRequest) error {
+					got = req
+					return nil
+				}),
+				actorTypeBuilder: common.NewActorTypeBuilder("default"),
+			},
+			actorID: "wf1",
+		}
+		err := o.createTimeoutReminder(t.Context(), &protos.ExecutionStartedEvent{Tags: tags}, start)
+		return got, err
+	}
+
+	t.Run("no max duration", func(t *testing.T) {
+		got, err := run(t, nil)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("max duration", func(t *testing.T) {
+		got, err := run(t, map[string]string{todo.TagMaxDuration: "1h"})
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, reminderTimeout, got.Name)
+		assert.Equal(t, start.Add(time.Hour).UTC().Format(time.RFC3339Nano), got.DueTime)
+		assert.Nil(t, got.Data)
+		assert.Nil(t, got.ConcurrencyKey, "timeout must not wait for a concurrency slot")
+	})
+
+	t.Run("compensation activity", func(t *testing.T) {
+		got, err := run(t, map[string]string{
+			todo.TagMaxDuration:          "90s",
+			todo.TagCompensationActivity: "refund",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		var e backend.HistoryEvent
+		require.NoError(t, got.Data.UnmarshalTo(&e))
+		assert.Equal(t, int32(compensationTaskID), e.GetEventId())
+		assert.Equal(t, "refund", e.GetTaskScheduled().GetName())
+	})
+
+	t.Run("invalid max duration", func(t *testing.T) {
+		for _, d := range []string{"soon", "-1m", "0s"} {
+			_, err := run(t, map[string]string{todo.TagMaxDuration: d})
+			require.Error(t, err, d)
+			assert.Equal(t, codes.InvalidArgument, grpcstatus.Code(err), d)
+		}
+	})
+}
+
+func Test_timeoutCompensation(t *testing.T) {
+	o := &orchestrator{actorID: "wf1"}
+
+	e, err := o.timeoutCompensation(&actorapi.Reminder{Name: reminderTimeout})
+	require.NoError(t, err)
+	assert.Nil(t, e)
+
+	data, err := anypb.New(&backend.HistoryEvent{
+		EventId: compensationTaskID,
+		EventType: &protos.HistoryEvent_ExecutionTerminated{
+			ExecutionTerminated: &protos.ExecutionTerminatedEvent{},
+		},
+	})
+	require.NoError(t, err)
+	_, err = o.timeoutCompensation(&actorapi.Reminder{Name: reminderTimeout, Data: data})
+	require.Error(t, err)
+
+	data, err = anypb.New(&backend.HistoryEvent{
+		EventId: 0,
+		EventType: &protos.HistoryEvent_TaskScheduled{
+			TaskScheduled: &protos.TaskScheduledEvent{Name: "refund"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = o.timeoutCompensation(&actorapi.Reminder{Name: reminderTimeout, Data: data})
+	require.Error(t, err, "compensation must use the reserved task ID")
+}
+
+// Test_runWorkflow_timeoutRetriesCompensation verifies that a timeout
+// reminder firing on a workflow which already timed out re-invokes the
+// compensation activity recorded in its history, and that other reminders
+// don't.
+func Test_runWorkflow_timeoutRetriesCompensation(t *testing.T) {
+	const instanceID = "wf-timed-out"
+	now := time.Now()
+
+	history := []*backend.HistoryEvent{
+		{
+			EventId: -1, Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_WorkflowStarted{WorkflowStarted: &protos.WorkflowStartedEvent{}},
+		},
+		{
+			EventId: -1, Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: &protos.ExecutionStartedEvent{
+				Name:             "TestWorkflow",
+				Input:            wrapperspb.String(`"order"`),
+				WorkflowInstance: &protos.WorkflowInstance{InstanceId: instanceID},
+			}},
+		},
+		{
+			EventId: -1, Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_ExecutionTerminated{ExecutionTerminated: &protos.ExecutionTerminatedEvent{
+				Input: wrapperspb.String(timeoutReason),
+			}},
+		},
+		{
+			EventId: -1, Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_ExecutionCompleted{ExecutionCompleted: &protos.ExecutionCompletedEvent{
+				WorkflowStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_TERMINATED,
+				Result:         wrapperspb.String(timeoutReason),
+			}},
+		},
+		{
+			EventId: compensationTaskID, Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_TaskScheduled{TaskScheduled: &protos.TaskScheduledEvent{
+				Name:  "refund",
+				Input: wrapperspb.String(`"order"`),
+			}},
+		},
+	}
+
+	run := func(t *testing.T, reminderName string) []*internalsv1pb.InternalInvokeRequest {
+		t.Helper()
+
+		state := wfenginestate.NewState(wfenginestate.Options{
+			AppID:             "testapp",
+			WorkflowActorType: "dapr.internal.default.testapp.workflow",
+			ActivityActorType: "dapr.internal.default.testapp.activity",
+		})
+		for _, e := range history {
+			state.AddToHistory(e)
+		}
+		rstate := runtimestate.NewWorkflowRuntimeState(instanceID, nil, history)
+		require.True(t, runtimestate.IsCompleted(rstate))
+
+		var calls []*internalsv1pb.InternalInvokeRequest
+		o := &orchestrator{
+			factory: &factory{
+				appID:             "testapp",
+				actorType:         "dapr.internal.default.testapp.workflow",
+				activityActorType: "dapr.internal.default.testapp.activity",
+				reminders:         remindersfake.New(),
+				actorTypeBuilder:  common.NewActorTypeBuilder("default"),
+				router: routerfake.New().WithCallFn(func(_ context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+					calls = append(calls, req)
+					return nil, nil
+				}),
+			},
+			actorID: instanceID,
+			state:   state,
+			rstate:  rstate,
+		}
+
+		completed, err := o.runWorkflow(t.Context(), &actorapi.Reminder{Name: reminderName})
+		require.NoError(t, err)
+		assert.Equal(t, todo.RunCompletedTrue, completed)
+		return calls
+	}
+
+	t.Run("timeout reminder", func(t *testing.T) {
+		calls := run(t, reminderTimeout)
+		require.Len(t, calls, 1)
+		assert.Equal(t, todo.ExecuteActivityMethod, calls[0].GetMessage().GetMethod())
+		assert.Equal(t, "dapr.internal.default.testapp.activity", calls[0].GetActor().GetActorType())
+		assert.Equal(t, instanceID+"::2147483647::1", calls[0].GetActor().GetActorId())
+
+		var e backend.HistoryEvent
+		require.NoError(t, proto.Unmarshal(calls[0].GetMessage().GetData().GetValue(), &e))
+		assert.Equal(t, "refund", e.GetTaskScheduled().GetName())
+		assert.Equal(t, `"order"`, e.GetTaskScheduled().GetInput().GetValue())
+	})
+
+	t.Run("other reminder", func(t *testing.T) {
+		assert.Empty(t, run(t, "new-event-stale"))
+	})
+}
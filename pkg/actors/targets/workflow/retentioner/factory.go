@@ -70,3 +70,7 @@ func (f *factory) Exists(actorID string) bool {
 func (f *factory) Len() int {
 	return 0
 }
+
+func (f *factory) ActorIDs() []string {
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/api"
 	internaltimers "github.com/dapr/dapr/pkg/actors/internal/timers"
 	"github.com/dapr/dapr/pkg/actors/table"
+	diagutils "github.com/dapr/dapr/pkg/diagnostics/utils"
 )
 
 type Interface interface {
@@ -60,6 +61,7 @@ func (t *timers) Create(ctx context.Context, req *api.CreateTimerRequest) error
 	}
 
 	reminder.IsTimer = true
+	reminder.SpanContext = diagutils.SpanFromContext(ctx).SpanContext()
 
 	return t.storage.Create(ctx, reminder)
 }
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apitoken holds the API tokens authenticating the calls to the Dapr
+// APIs: the dapr-api-token, and the tokens read from secret stores.
+package apitoken
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/secretstores"
+	secretwatch "github.com/dapr/dapr/pkg/components/secretstores/watch"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/kit/concurrency"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.api.token")
+
+// Token is an API token.
+type Token struct {
+	// Name is the name of the token spec, or empty for the dapr-api-token.
+	Name string
+
+	// Allowed are the APIs the token is allowed to call. All APIs are allowed
+	// if empty.
+	Allowed config.APIAccessRules
+}
+
+// AllowsAll returns true if the token is allowed to call all APIs.
+func (t *Token) AllowsAll() bool {
+	return len(t.Allowed) == 0
+}
+
+type tokenCtxKey struct{}
+
+// NewContext returns a context carrying the token the request was
+// authenticated with.
+func NewContext(ctx context.Context, token *Token) context.Context {
+	return context.WithValue(ctx, tokenCtxKey{}, token)
+}
+
+// FromContext returns the token the request was authenticated with, if any.
+func FromContext(ctx context.Context) (*Token, bool) {
+	token, ok := ctx.Value(tokenCtxKey{}).(*Token)
+	return token, ok
+}
+
+type Options struct {
+	// Token is the dapr-api-token, which is allowed to call all APIs.
+	Token string
+
+	// Specs are the tokens read from secret stores.
+	Specs []config.APITokenSpec
+
+	// SecretStore returns the secret store component of the name, if loaded.
+	SecretStore func(name string) (secretstores.SecretStore, bool)
+
+	// PollInterval is the interval at which the secrets are polled, for the
+	// secret stores without native change notifications, and at which the
+	// secret stores are looked up until they are loaded. Defaults to
+	// secretwatch.DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Tokens are the API tokens of the sidecar.
+type Tokens struct {
+	static       string
+	specs        []config.APITokenSpec
+	secretStore  func(string) (secretstores.SecretStore, bool)
+	pollInterval time.Duration
+
+	lock sync.RWMutex
+	// values are the token values of each spec.
+	values [][]string
+	tokens map[string]*Token
+}
+
+func New(opts Options) *Tokens {
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = secretwatch.DefaultPollInterval
+	}
+
+	return &Tokens{
+		static:       opts.Token,
+		specs:        opts.Specs,
+		secretStore:  opts.SecretStore,
+		pollInterval: interval,
+		values:       make([][]string, len(opts.Specs)),
+		tokens:       make(map[string]*Token),
+	}
+}
+
+// Enabled returns true if calls to the APIs must be authenticated. This is
+// the case as soon as tokens are configured, even before the tokens of the
+// secret stores are loaded.
+func (t *Tokens) Enabled() bool {
+	return t != nil && (t.static != "" || len(t.specs) > 0)
+}
+
+// Authenticate returns the token of the value, or false if the value isn't
+// a valid token.
+func (t *Tokens) Authenticate(value string) (*Token, bool) {
+	if value == "" {
+		return nil, false
+	}
+	if t.static != "" && subtle.ConstantTimeCompare([]byte(value), []byte(t.static)) == 1 {
+		return &Token{}, true
+	}
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	token, ok := t.tokens[value]
+	return token, ok
+}
+
+// Run watches the secrets of the tokens until the context is canceled.
+func (t *Tokens) Run(ctx context.Context) error {
+	if len(t.specs) == 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	runners := make([]concurrency.Runner, len(t.specs))
+	for i := range t.specs {
+		runners[i] = func(ctx context.Context) error {
+			t.watch(ctx, i)
+			return nil
+		}
+	}
+	return concurrency.NewRunnerManager(runners...).Run(ctx)
+}
+
+// watch sets the values of the tokens of the i-th spec from its secret, whenever
+// the secret changes. The watch is re-established after errors until the
+// context is canceled.
+func (t *Tokens) watch(ctx context.Context, i int) {
+	spec := t.specs[i]
+	key := spec.SecretKey
+	if key == "" {
+		key = spec.SecretName
+	}
+
+	for {
+		store, ok := t.secretStore(spec.SecretStore)
+		if ok {
+			err := t.watcher(store).Watch(ctx, &secretwatch.Request{Names: []string{spec.SecretName}}, func(ctx context.Context, changes []secretwatch.Change) error {
+				if changes == nil {
+					// The watch is established: the changes from now on are
+					// delivered, so the current value can be read.
+					res, err := store.GetSecret(ctx, secretstores.GetSecretRequest{Name: spec.SecretName})
+					if err != nil {
+						return err
+					}
+					t.set(i, res.Data[key])
+					return nil
+				}
+				for _, change := range changes {
+					t.set(i, change.Data[key])
+				}
+				return nil
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Errorf("Failed to watch the secret of API tokens %s: %v", spec.Name, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.pollInterval):
+		}
+	}
+}
+
+// watcher returns the secret store if it has native change notifications, or
+// else a poller over the secret store.
+func (t *Tokens) watcher(store secretstores.SecretStore) secretwatch.Watcher {
+	if watcher, ok := store.(secretwatch.Watcher); ok {
+		return watcher
+	}
+	return secretwatch.NewPoller(secretwatch.PollerOptions{
+		Store:    store,
+		Interval: t.pollInterval,
+	})
+}
+
+// set replaces the values of the tokens of the i-th spec with the non-empty lines
+// of the secret value.
+func (t *Tokens) set(i int, secret string) {
+	spec := t.specs[i]
+	var values []string
+	for _, line := range strings.Split(secret, "\n") {
+		if v := strings.TrimSpace(line); v != "" {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		log.Warnf("The secret of API tokens %s has no tokens", spec.Name)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.values[i] = values
+	t.tokens = make(map[string]*Token, len(t.tokens))
+	for j, s := range t.specs {
+		token := &Token{Name: s.Name, Allowed: s.Allowed}
+		for _, v := range t.values[j] {
+			if _, ok := t.tokens[v]; !ok {
+				t.tokens[v] = token
+			}
+		}
+	}
+	log.Infof("Loaded %d API tokens of %s", len(values), spec.Name)
+}
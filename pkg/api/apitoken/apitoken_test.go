@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apitoken
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/dapr/pkg/config"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+type fakeStore struct {
+	daprt.FakeSecretStore
+
+	lock    sync.Mutex
+	secrets map[string]map[string]string
+}
+
+func (s *fakeStore) GetSecret(ctx context.Context, req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	data, ok := s.secrets[req.Name]
+	if !ok {
+		return secretstores.GetSecretResponse{}, errors.New("secret not found")
+	}
+	return secretstores.GetSecretResponse{Data: data}, nil
+}
+
+func (s *fakeStore) set(name string, data map[string]string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.secrets[name] = data
+}
+
+func TestTokens(t *testing.T) {
+	t.Run("no tokens", func(t *testing.T) {
+		tokens := New(Options{})
+		assert.False(t, tokens.Enabled())
+		_, ok := tokens.Authenticate("")
+		assert.False(t, ok)
+
+		var nilTokens *Tokens
+		assert.False(t, nilTokens.Enabled())
+	})
+
+	t.Run("dapr-api-token is allowed all APIs", func(t *testing.T) {
+		tokens := New(Options{Token: "rosebud"})
+		assert.True(t, tokens.Enabled())
+
+		token, ok := tokens.Authenticate("rosebud")
+		require.True(t, ok)
+		assert.True(t, token.AllowsAll())
+
+		_, ok = tokens.Authenticate("rosebu")
+		assert.False(t, ok)
+		_, ok = tokens.Authenticate("")
+		assert.False(t, ok)
+	})
+
+	t.Run("tokens of secret stores are watched", func(t *testing.T) {
+		store := &fakeStore{secrets: map[string]map[string]string{
+			"ci": {"tokens": "ci-1\n\n ci-2 \n"},
+		}}
+		var storeLock sync.Mutex
+		var loaded bool
+		scopes := config.APIAccessRules{{Name: "state", Version: "v1.0", Protocol: config.APIAccessRuleProtocolHTTP}}
+		tokens := New(Options{
+			Token: "rosebud",
+			Specs: []config.APITokenSpec{
+				{Name: "ci", SecretStore: "vault", SecretName: "ci", SecretKey: "tokens", Allowed: scopes},
+				{Name: "admin", SecretStore: "vault", SecretName: "admin"},
+			},
+			SecretStore: func(name string) (secretstores.SecretStore, bool) {
+				storeLock.Lock()
+				defer storeLock.Unlock()
+				if !loaded || name != "vault" {
+					return nil, false
+				}
+				return store, true
+			},
+			PollInterval: 10 * time.Millisecond,
+		})
+		require.True(t, tokens.Enabled())
+
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error, 1)
+		go func() { errCh <- tokens.Run(ctx) }()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-errCh)
+		})
+
+		// The secret store isn't loaded yet.
+		time.Sleep(30 * time.Millisecond)
+		_, ok := tokens.Authenticate("ci-1")
+		assert.False(t, ok)
+
+		storeLock.Lock()
+		loaded = true
+		storeLock.Unlock()
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			for _, v := range []string{"ci-1", "ci-2"} {
+				token, ok := tokens.Authenticate(v)
+				if assert.True(c, ok) {
+					assert.Equal(c, "ci", token.Name)
+					assert.Equal(c, scopes, token.Allowed)
+				}
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// The admin secret is missing until it's created.
+		store.set("admin", map[string]string{"admin": "admin-1"})
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			token, ok := tokens.Authenticate("admin-1")
+			if assert.True(c, ok) {
+				assert.True(c, token.AllowsAll())
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// Rotation: the old token is removed from the secret.
+		store.set("ci", map[string]string{"tokens": "ci-2\nci-3"})
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			_, ok := tokens.Authenticate("ci-1")
+			assert.False(c, ok)
+			_, ok = tokens.Authenticate("ci-3")
+			assert.True(c, ok)
+		}, 5*time.Second, 10*time.Millisecond)
+		_, ok = tokens.Authenticate("ci-2")
+		assert.True(t, ok)
+		_, ok = tokens.Authenticate("admin-1")
+		assert.True(t, ok)
+		_, ok = tokens.Authenticate("rosebud")
+		assert.True(t, ok)
+	})
+}
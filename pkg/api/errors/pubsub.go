@@ -93,6 +93,27 @@ func (p *PubSubError) PublishForbidden(topic, appID string, err error) error {
 	)
 }
 
+// SubscriptionNotFound is returned when the app isn't subscribed to the topic.
+func (p *PubSubError) SubscriptionNotFound(topic string) error {
+	return p.WithMetadata(map[string]string{"topic": topic}).build(
+		codes.NotFound,
+		http.StatusNotFound,
+		fmt.Sprintf("app is not subscribed to topic %s in pubsub %s", topic, p.name),
+		errorcodes.PubSubSubscriptionNotFound,
+	)
+}
+
+// SubscriptionUpdate is returned when pausing or resuming the subscription to
+// the topic failed.
+func (p *PubSubError) SubscriptionUpdate(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.Internal,
+		http.StatusInternalServerError,
+		fmt.Sprintf("error when updating the subscription to topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubSubSubscriptionUpdate,
+	)
+}
+
 // TestNotFound is specifically for the error we are expecting for the api_tests. The not found
 // expected error codes are different than the existing ones for PubSubNotFound, hence
 // why this one is needed
@@ -14,6 +14,7 @@ limitations under the License.
 package errors
 
 import (
+	"fmt"
 	"net/http"
 
 	"google.golang.org/grpc/codes"
@@ -37,6 +38,32 @@ func SchedulerURLName(metadata map[string]string) error {
 		Build()
 }
 
+func SchedulerJobNameReserved(metadata map[string]string, name string) error {
+	message := fmt.Sprintf("job name %q uses a prefix reserved for jobs of the runtime", name)
+	return kiterrors.NewBuilder(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		message,
+		"",
+		string(errorcodes.SchedulerJobName.Category),
+	).
+		WithErrorInfo(errorcodes.SchedulerJobName.Code, metadata).
+		Build()
+}
+
+func SchedulerJobCalendarNotFound(metadata map[string]string, name string) error {
+	message := fmt.Sprintf("job calendar %q is not defined in the jobs section of the Configuration", name)
+	return kiterrors.NewBuilder(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		message,
+		"",
+		string(errorcodes.SchedulerJobCalendar.Category),
+	).
+		WithErrorInfo(errorcodes.SchedulerJobCalendar.Code, metadata).
+		Build()
+}
+
 func SchedulerScheduleJob(metadata map[string]string, err error) error {
 	code := status.Code(err)
 	if code == codes.Unknown {
@@ -80,6 +107,18 @@ func SchedulerListJobs(metadata map[string]string, err error) error {
 		Build()
 }
 
+func SchedulerListJobsFilter(metadata map[string]string, err error) error {
+	return kiterrors.NewBuilder(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		"invalid list jobs filter: "+err.Error(),
+		"",
+		string(errorcodes.SchedulerListJobsFilter.Category),
+	).
+		WithErrorInfo(errorcodes.SchedulerListJobsFilter.Code, metadata).
+		Build()
+}
+
 func SchedulerDeleteJob(metadata map[string]string, err error) error {
 	return kiterrors.NewBuilder(
 		codes.Internal,
@@ -17,7 +17,9 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 
 	"github.com/dapr/components-contrib/metadata"
@@ -90,8 +92,71 @@ func (s *StateStoreError) InvalidKeyName(key string, msg string) error {
 	)
 }
 
+func (s *StateStoreError) KeyPrefixNotAllowed(keyPrefix string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.PermissionDenied,
+			http.StatusForbidden,
+			fmt.Sprintf("key prefix '%s' is not allowed for state store %s", keyPrefix, s.name),
+			errorcodes.StateKeyPrefixNotAllowed.Code,
+			string(errorcodes.StateKeyPrefixNotAllowed.Category),
+		),
+		errorcodes.StateKeyPrefixNotAllowed.GrpcCode,
+		map[string]string{
+			"keyPrefix": keyPrefix,
+		},
+	)
+}
+
 /**** Transactions ****/
 
+// TransactionConflict is an operation of a state transaction whose ETag did
+// not match the state in the store.
+type TransactionConflict struct {
+	// Operation is the index of the operation in the transaction.
+	Operation int
+	// Key is the key of the operation, as given by the app.
+	Key string
+}
+
+func (s *StateStoreError) TransactionETagMismatch(conflicts []TransactionConflict) error {
+	msg := fmt.Sprintf("state store %s transaction failed: etag mismatch", s.name)
+	violations := make([]*errdetails.PreconditionFailure_Violation, len(conflicts))
+	operations := make([]string, len(conflicts))
+	keys := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		violations[i] = &errdetails.PreconditionFailure_Violation{
+			Type:        "ETAG_MISMATCH",
+			Subject:     c.Key,
+			Description: fmt.Sprintf("etag of operation %d does not match key %s", c.Operation, c.Key),
+		}
+		operations[i] = strconv.Itoa(c.Operation)
+		keys[i] = c.Key
+	}
+	if len(keys) > 0 {
+		msg += " for keys: " + strings.Join(keys, ", ")
+	}
+
+	builder := errors.NewBuilder(
+		codes.Aborted,
+		http.StatusConflict,
+		msg,
+		errorcodes.StateTransactionETagMismatch.Code,
+		string(errorcodes.StateTransactionETagMismatch.Category),
+	)
+	if len(violations) > 0 {
+		builder = builder.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	}
+
+	return s.build(
+		builder,
+		errorcodes.StateTransactionETagMismatch.GrpcCode,
+		map[string]string{
+			"conflictingOperations": strings.Join(operations, ","),
+		},
+	)
+}
+
 func (s *StateStoreError) TransactionsNotSupported() error {
 	return s.build(
 		errors.NewBuilder(
@@ -153,6 +218,172 @@ func (s *StateStoreError) QueryFailed(detail string) error {
 	)
 }
 
+func (s *StateStoreError) QueryInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("state store %s query is invalid: %s", s.name, detail),
+			errorcodes.StateQueryInvalid.Code,
+			string(errorcodes.StateQueryInvalid.Category),
+		),
+		errorcodes.StateQueryInvalid.GrpcCode,
+		nil,
+	)
+}
+
+/**** Watch API ****/
+
+func (s *StateStoreError) WatchUnsupported(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Unimplemented,
+			http.StatusNotImplemented,
+			fmt.Sprintf("state store %s does not support the watch: %s", s.name, detail),
+			errorcodes.StateStoreWatchNotSupported.Code,
+			string(errorcodes.StateStoreWatchNotSupported.Category),
+		),
+		errorcodes.StateStoreWatchNotSupported.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) WatchInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("state store %s watch is invalid: %s", s.name, detail),
+			errorcodes.StateWatchInvalid.Code,
+			string(errorcodes.StateWatchInvalid.Category),
+		),
+		errorcodes.StateWatchInvalid.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) WatchFailed(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Internal,
+			http.StatusInternalServerError,
+			fmt.Sprintf("state store %s watch failed: %s", s.name, detail),
+			errorcodes.StateWatch.Code,
+			string(errorcodes.StateWatch.Category),
+		),
+		errorcodes.StateWatch.GrpcCode,
+		nil,
+	)
+}
+
+/**** TTL ****/
+
+func (s *StateStoreError) TTLNotSupported() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("state store %s does not support TTLs: enable the ttlEmulation metadata property to have the runtime delete expired state", s.name),
+			errorcodes.StateStoreTTLNotSupported.Code,
+			string(errorcodes.StateStoreTTLNotSupported.Category),
+		),
+		errorcodes.StateStoreTTLNotSupported.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) TTLInvalid(key string, detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid time to live of key %s: %s", key, detail),
+			errorcodes.StateTTLInvalid.Code,
+			string(errorcodes.StateTTLInvalid.Category),
+		),
+		errorcodes.StateTTLInvalid.GrpcCode,
+		map[string]string{
+			"key": key,
+		},
+	)
+}
+
+/**** Migration ****/
+
+func (s *StateStoreError) ListKeysNotSupported() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Unimplemented,
+			http.StatusNotImplemented,
+			fmt.Sprintf("state store %s cannot list its keys: it must support querying, or enable the queryIndex metadata property", s.name),
+			errorcodes.StateStoreListKeysNotSupported.Code,
+			string(errorcodes.StateStoreListKeysNotSupported.Category),
+		),
+		errorcodes.StateStoreListKeysNotSupported.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) MigrationUnauthenticated() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.PermissionDenied,
+			http.StatusForbidden,
+			"state migration requires API token authentication to be enabled",
+			errorcodes.StateMigrationUnauthenticated.Code,
+			string(errorcodes.StateMigrationUnauthenticated.Category),
+		),
+		errorcodes.StateMigrationUnauthenticated.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) MigrationInvalid(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.InvalidArgument,
+			http.StatusBadRequest,
+			fmt.Sprintf("state store %s migration is invalid: %s", s.name, detail),
+			errorcodes.StateMigrationInvalid.Code,
+			string(errorcodes.StateMigrationInvalid.Category),
+		),
+		errorcodes.StateMigrationInvalid.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) MigrationInProgress(id string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.AlreadyExists,
+			http.StatusConflict,
+			fmt.Sprintf("state store %s is already being migrated by migration %s", s.name, id),
+			errorcodes.StateMigrationInProgress.Code,
+			string(errorcodes.StateMigrationInProgress.Category),
+		),
+		errorcodes.StateMigrationInProgress.GrpcCode,
+		map[string]string{
+			"migrationID": id,
+		},
+	)
+}
+
+func (s *StateStoreError) MigrationFailed(id string, detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Internal,
+			http.StatusInternalServerError,
+			fmt.Sprintf("state store %s migration %s failed: %s", s.name, id, detail),
+			errorcodes.StateMigration.Code,
+			string(errorcodes.StateMigration.Category),
+		),
+		errorcodes.StateMigration.GrpcCode,
+		map[string]string{
+			"migrationID": id,
+		},
+	)
+}
+
 func (s *StateStoreError) build(err *errors.ErrorBuilder, errCode string, metadata map[string]string) error {
 	if !s.skipResourceInfo {
 		err = err.WithResourceInfo("state", s.name, "", "")
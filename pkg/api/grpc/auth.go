@@ -3,11 +3,15 @@ package grpc
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"google.golang.org/grpc"
 	grpc_metadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/dapr/dapr/pkg/api/apitoken"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/audit"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 )
 
@@ -20,17 +24,29 @@ func (s *wrappedStream) Context() context.Context {
 	return s.ctx
 }
 
-func getAPIAuthenticationMiddlewares(apiToken, authHeader string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+// getAPIAuthenticationMiddlewares returns the middlewares which check the API
+// token of requests, and that the token is allowed to call the method. With
+// healthExempt, the calls to the grpc.health.v1 health service don't require
+// the token, like the HTTP healthz endpoints.
+func getAPIAuthenticationMiddlewares(tokens *apitoken.Tokens, authHeader string, healthExempt bool) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-			authCtx, err := checkAPITokenInContext(ctx, apiToken, authHeader)
+			if healthExempt && isHealthServiceMethod(info.FullMethod) {
+				return handler(ctx, req)
+			}
+			authCtx, err := checkAPITokenInContext(ctx, tokens, authHeader, info.FullMethod)
 			if err != nil {
+				auditAPIToken(ctx, info.FullMethod, err)
 				return nil, err
 			}
 			return handler(authCtx, req)
 		},
 		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-			authCtx, err := checkAPITokenInContext(stream.Context(), apiToken, authHeader)
+			if healthExempt && isHealthServiceMethod(info.FullMethod) {
+				return handler(srv, stream)
+			}
+			authCtx, err := checkAPITokenInContext(stream.Context(), tokens, authHeader, info.FullMethod)
 			if err != nil {
+				auditAPIToken(stream.Context(), info.FullMethod, err)
 				return err
 			}
 
@@ -38,8 +54,26 @@ func getAPIAuthenticationMiddlewares(apiToken, authHeader string) (grpc.UnarySer
 		}
 }
 
-// Checks if the API token in the gRPC request's context is valid; returns an error otherwise.
-func checkAPITokenInContext(ctx context.Context, apiToken, authHeader string) (context.Context, error) {
+// auditAPIToken writes the API token failure of the call to the audit log.
+func auditAPIToken(ctx context.Context, method string, err error) {
+	reason := err.Error()
+	if s, ok := status.FromError(err); ok {
+		reason = s.Message()
+	}
+	audit.Emit(ctx, audit.Event{
+		Action:  audit.ActionAPITokenFailed,
+		Outcome: audit.OutcomeDenied,
+		Attributes: map[string]string{
+			"protocol": "grpc",
+			"method":   method,
+			"reason":   reason,
+		},
+	})
+}
+
+// Checks if the API token in the gRPC request's context is valid and allowed
+// to call the method; returns an error otherwise.
+func checkAPITokenInContext(ctx context.Context, tokens *apitoken.Tokens, authHeader, method string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ctx, invokev1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing metadata in request")
@@ -49,11 +83,31 @@ func checkAPITokenInContext(ctx context.Context, apiToken, authHeader string) (c
 		return ctx, invokev1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "missing api token in request metadata")
 	}
 
-	if md[authHeader][0] != apiToken {
+	token, ok := tokens.Authenticate(md[authHeader][0])
+	if !ok {
 		return ctx, invokev1.ErrorFromHTTPResponseCode(http.StatusUnauthorized, "authentication error: api token mismatch")
 	}
+	if !isMethodAllowedForToken(token, method) {
+		return ctx, invokev1.ErrorFromHTTPResponseCode(http.StatusForbidden, "api token is not allowed to call this API")
+	}
 
 	md.Delete(authHeader)
 	ctx = grpc_metadata.NewIncomingContext(ctx, md.Copy())
 	return ctx, nil
 }
+
+// isMethodAllowedForToken returns true if the token is allowed to call the
+// method. The methods outside of the Dapr API are proxied to apps, so they
+// are allowed to the tokens allowed to invoke services.
+func isMethodAllowedForToken(token *apitoken.Token, method string) bool {
+	if token.AllowsAll() {
+		return true
+	}
+
+	allowed := apiAccessRuleToMap(token.Allowed)
+	if !strings.HasPrefix(method, daprRuntimePrefix) {
+		method = daprRuntimePrefix + "v1.Dapr/InvokeService"
+	}
+	_, ok := allowed[method]
+	return ok
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/pkg/api/apitoken"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestIsMethodAllowedForToken(t *testing.T) {
+	const (
+		getState    = daprRuntimePrefix + "v1.Dapr/GetState"
+		getSecret   = daprRuntimePrefix + "v1.Dapr/GetSecret"
+		proxyMethod = "/myapp.v1.MyService/Hello"
+	)
+
+	t.Run("tokens without scopes are allowed all methods", func(t *testing.T) {
+		token := &apitoken.Token{}
+		assert.True(t, isMethodAllowedForToken(token, getState))
+		assert.True(t, isMethodAllowedForToken(token, proxyMethod))
+	})
+
+	t.Run("scoped tokens are allowed their methods only", func(t *testing.T) {
+		token := &apitoken.Token{Allowed: config.APIAccessRules{
+			{Name: "state", Version: "v1", Protocol: config.APIAccessRuleProtocolGRPC},
+			{Name: "secrets", Version: "v1", Protocol: config.APIAccessRuleProtocolHTTP},
+		}}
+		assert.True(t, isMethodAllowedForToken(token, getState))
+		assert.False(t, isMethodAllowedForToken(token, getSecret))
+		assert.False(t, isMethodAllowedForToken(token, proxyMethod))
+	})
+
+	t.Run("proxied methods are allowed with service invocation", func(t *testing.T) {
+		token := &apitoken.Token{Allowed: config.APIAccessRules{
+			{Name: "invoke", Version: "v1", Protocol: config.APIAccessRuleProtocolGRPC},
+		}}
+		assert.True(t, isMethodAllowedForToken(token, proxyMethod))
+		assert.False(t, isMethodAllowedForToken(token, getState))
+	})
+}
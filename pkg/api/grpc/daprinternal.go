@@ -29,6 +29,8 @@ import (
 	actorapi "github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/audit"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	"github.com/dapr/dapr/pkg/messages"
@@ -37,6 +39,7 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/security/spiffe"
 	"github.com/dapr/dapr/pkg/sse"
 )
 
@@ -46,6 +49,12 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 	if appChannel == nil {
 		return nil, status.Error(codes.Internal, messages.ErrChannelNotFound)
 	}
+	if !a.channels.AppCallbackAllowed(config.AppCallbackInvoke) {
+		return nil, status.Errorf(codes.PermissionDenied, messages.ErrAppCallbackDenied, config.AppCallbackInvoke)
+	}
+	if err := a.callLocalValidateAppHealth(); err != nil {
+		return nil, err
+	}
 
 	req, err := invokev1.FromInternalInvokeRequest(in)
 	if err != nil {
@@ -59,6 +68,11 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 		return nil, err
 	}
 
+	err = a.callLocalValidateRateLimit(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Diagnostics
 	callerAppID := a.callLocalRecordRequest(req.Proto())
 
@@ -87,6 +101,12 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 	if appChannel == nil {
 		return status.Error(codes.Internal, messages.ErrChannelNotFound)
 	}
+	if !a.channels.AppCallbackAllowed(config.AppCallbackInvoke) {
+		return status.Errorf(codes.PermissionDenied, messages.ErrAppCallbackDenied, config.AppCallbackInvoke)
+	}
+	if err := a.callLocalValidateAppHealth(); err != nil {
+		return err
+	}
 
 	// Read the first chunk of the incoming request
 	// This contains the metadata of the request
@@ -128,6 +148,11 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 		return err
 	}
 
+	err = a.callLocalValidateRateLimit(ctx, req)
+	if err != nil {
+		return err
+	}
+
 	// Diagnostics
 	callerAppID := a.callLocalRecordRequest(req.Proto())
 
@@ -384,6 +409,15 @@ func (a *api) CallActorStream(req *internalv1pb.InternalInvokeRequest, stream in
 	return nil
 }
 
+// Used by CallLocal and CallLocalStream to reject the requests right away while the app is unhealthy,
+// when configured to, instead of invoking it.
+func (a *api) callLocalValidateAppHealth() error {
+	if a.isAppUnhealthy != nil && a.isAppUnhealthy() {
+		return status.Error(codes.Unavailable, messages.ErrAppUnhealthy)
+	}
+	return nil
+}
+
 // Used by CallLocal and CallLocalStream to check the request against the access control list.
 // The method is normalized (forbidden characters rejected, path traversal resolved) as
 // defense-in-depth before ACL evaluation. The normalized form is written back to the
@@ -414,6 +448,18 @@ func (a *api) callLocalValidateACL(ctx context.Context, req *invokev1.InvokeMeth
 		callAllowed, errMsg := acl.ApplyAccessControlPolicies(ctx, operation, httpVerb, appProtocolIsHTTP, a.accessControlList)
 
 		if !callAllowed {
+			attrs := map[string]string{
+				"operation": operation,
+				"reason":    errMsg,
+			}
+			if id, ok, _ := spiffe.FromGRPCContext(ctx); ok {
+				attrs["caller"] = id.URL().String()
+			}
+			audit.Emit(ctx, audit.Event{
+				Action:     audit.ActionACLDenied,
+				Outcome:    audit.OutcomeDenied,
+				Attributes: attrs,
+			})
 			return status.Error(codes.PermissionDenied, errMsg)
 		}
 	}
@@ -421,6 +467,26 @@ func (a *api) callLocalValidateACL(ctx context.Context, req *invokev1.InvokeMeth
 	return nil
 }
 
+// Used by CallLocal and CallLocalStream to reject the requests which exceed the rate limits of the
+// caller. The caller is identified by its SPIFFE ID when mTLS is enabled, and by the caller ID header otherwise.
+func (a *api) callLocalValidateRateLimit(ctx context.Context, req *invokev1.InvokeMethodRequest) error {
+	if a.rateLimiter == nil {
+		return nil
+	}
+
+	var callerAppID string
+	if id, ok, _ := spiffe.FromGRPCContext(ctx); ok {
+		callerAppID = id.AppID()
+	} else if values := req.Metadata()[invokev1.CallerIDHeader].GetValues(); len(values) > 0 {
+		callerAppID = values[0]
+	}
+
+	if !a.rateLimiter.Allow(ctx, config.RateLimitTrafficInvocation, callerAppID, req.Message().GetMethod()) {
+		return status.Error(codes.ResourceExhausted, messages.ErrRateLimited)
+	}
+	return nil
+}
+
 // Internal function that records the received request for diagnostics
 // After invoking this method, make sure to `defer` a call like:
 //
@@ -26,9 +26,12 @@ import (
 
 	"github.com/dapr/dapr/pkg/api/universal"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 )
 
@@ -53,6 +56,30 @@ func TestCallLocal(t *testing.T) {
 		assert.Equal(t, codes.Internal, status.Code(err))
 	})
 
+	t.Run("invoke callbacks of the app are denied", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		fakeAPI := &api{
+			Universal: universal.New(universal.Options{
+				AppID: "fakeAPI",
+			}),
+			channels: (new(channels.Channels)).WithAppChannel(mockAppChannel).WithAPISpec(config.APISpec{
+				Denied: config.APIAccessRules{{Name: "invoke", Version: "v1", Protocol: config.APIAccessRuleProtocolApp}},
+			}),
+		}
+		server, lis := startInternalServer(fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(lis)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		request := invokev1.NewInvokeMethodRequest("method")
+		defer request.Close()
+
+		_, err := client.CallLocal(t.Context(), request.Proto())
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockAppChannel.AssertNotCalled(t, "InvokeMethod", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("parsing InternalInvokeRequest is failed", func(t *testing.T) {
 		mockAppChannel := new(channelt.MockAppChannel)
 		fakeAPI := &api{
@@ -99,6 +126,64 @@ func TestCallLocal(t *testing.T) {
 		_, err := client.CallLocal(t.Context(), request.Proto())
 		assert.Equal(t, codes.Internal, status.Code(err))
 	})
+
+	t.Run("app is unhealthy", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		fakeAPI := &api{
+			Universal: universal.New(universal.Options{
+				AppID: "fakeAPI",
+			}),
+			channels:       (new(channels.Channels)).WithAppChannel(mockAppChannel),
+			isAppUnhealthy: func() bool { return true },
+		}
+		server, lis := startInternalServer(fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(lis)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		request := invokev1.NewInvokeMethodRequest("method")
+		defer request.Close()
+
+		_, err := client.CallLocal(t.Context(), request.Proto())
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.True(t, messaging.IsAppUnhealthyError(err))
+		mockAppChannel.AssertNotCalled(t, "InvokeMethod", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rate limit exceeded", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		mockAppChannel.On("InvokeMethod",
+			mock.MatchedBy(matchContextInterface),
+			mock.AnythingOfType("*v1.InvokeMethodRequest"),
+		).Return(nil, status.Error(codes.Unknown, "unknown error"))
+		rateLimiter, err := ratelimit.New(ratelimit.Options{
+			Spec: &config.RateLimitSpec{Policies: []config.RateLimitPolicy{{RequestsPerSecond: 1}}},
+		})
+		require.NoError(t, err)
+		fakeAPI := &api{
+			Universal: universal.New(universal.Options{
+				AppID: "fakeAPI",
+			}),
+			channels:    (new(channels.Channels)).WithAppChannel(mockAppChannel),
+			rateLimiter: rateLimiter,
+		}
+		server, lis := startInternalServer(fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(lis)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewServiceInvocationClient(clientConn)
+		request := invokev1.NewInvokeMethodRequest("method")
+		defer request.Close()
+
+		_, err = client.CallLocal(t.Context(), request.Proto())
+		assert.Equal(t, codes.Internal, status.Code(err))
+		_, err = client.CallLocal(t.Context(), request.Proto())
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.True(t, messaging.IsRateLimitedError(err))
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	})
 }
 
 func TestCallLocalStream(t *testing.T) {
@@ -40,6 +40,12 @@ var endpoints = map[string][]string{
 	},
 	"state.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/QueryStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/WatchStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/GetBulkStateStreamAlpha1",
+		daprRuntimePrefix + "v1.Dapr/MigrateStateAlpha1",
+	},
+	"state.v1beta1": {
+		daprRuntimePrefix + "v1.Dapr/QueryStateBeta1",
 	},
 	"publish.v1": {
 		daprRuntimePrefix + "v1.Dapr/PublishEvent",
@@ -51,10 +57,19 @@ var endpoints = map[string][]string{
 	"bindings.v1": {
 		daprRuntimePrefix + "v1.Dapr/InvokeBinding",
 	},
+	"bindings.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/PauseInputBindingAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ResumeInputBindingAlpha1",
+	},
 	"secrets.v1": {
 		daprRuntimePrefix + "v1.Dapr/GetSecret",
 		daprRuntimePrefix + "v1.Dapr/GetBulkSecret",
 	},
+	"secrets.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/GetBulkSecretStreamAlpha1",
+		daprRuntimePrefix + "v1.Dapr/WatchSecretsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/PurgeSecretsCacheAlpha1",
+	},
 	"actors.v1": {
 		daprRuntimePrefix + "v1.Dapr/RegisterActorTimer",
 		daprRuntimePrefix + "v1.Dapr/UnregisterActorTimer",
@@ -74,6 +89,10 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/GetMetadata",
 		daprRuntimePrefix + "v1.Dapr/SetMetadata",
 	},
+	"metadata.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/GetActiveActorsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/GetResiliencyAlpha1",
+	},
 	"configuration.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/GetConfigurationAlpha1",
 		daprRuntimePrefix + "v1.Dapr/SubscribeConfigurationAlpha1",
@@ -86,6 +105,8 @@ var endpoints = map[string][]string{
 	},
 	"lock.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/TryLockAlpha1",
+		daprRuntimePrefix + "v1.Dapr/RenewLockAlpha1",
+		daprRuntimePrefix + "v1.Dapr/AcquireLockAlpha1",
 	},
 	"unlock.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/UnlockAlpha1",
@@ -120,6 +141,9 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/PurgeWorkflowBeta1",
 		daprRuntimePrefix + "v1.Dapr/PauseWorkflowBeta1",
 		daprRuntimePrefix + "v1.Dapr/ResumeWorkflowBeta1",
+		daprRuntimePrefix + "v1.Dapr/ListWorkflowInstancesBeta1",
+		daprRuntimePrefix + "v1.Dapr/GetWorkflowHistoryBeta1",
+		daprRuntimePrefix + "v1.Dapr/ReplayWorkflowBeta1",
 	},
 	"jobs.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/ScheduleJobAlpha1",
@@ -134,12 +158,23 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/GetJob",
 		daprRuntimePrefix + "v1.Dapr/DeleteJobsByPrefix",
 		daprRuntimePrefix + "v1.Dapr/ListJobs",
+		daprRuntimePrefix + "v1.Dapr/BulkScheduleJobs",
+		daprRuntimePrefix + "v1.Dapr/BulkDeleteJobs",
 	},
 	"shutdown.v1": {
 		daprRuntimePrefix + "v1.Dapr/Shutdown",
 	},
+	"reload.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/ReloadAlpha1",
+	},
+	"faults.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/SetFaultAlpha1",
+		daprRuntimePrefix + "v1.Dapr/DeleteFaultAlpha1",
+	},
 	"subscribe.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/SubscribeTopicEventsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/PauseSubscriptionAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ResumeSubscriptionAlpha1",
 	},
 	"conversation.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/ConverseAlpha1",
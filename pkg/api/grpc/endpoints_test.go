@@ -180,6 +180,30 @@ func TestSetAPIEndpointsMiddleware(t *testing.T) {
 		}
 	})
 
+	t.Run("state.v1beta1 endpoints allowed", func(t *testing.T) {
+		allowed := []config.APIAccessRule{
+			{
+				Name:     "state",
+				Version:  "v1beta1",
+				Protocol: "grpc",
+			},
+		}
+
+		tm := testMiddleware(setAPIEndpointsMiddlewares(allowed, nil))
+
+		for _, e := range endpoints["state.v1beta1"] {
+			tm(t, e, false)
+		}
+
+		for k, v := range endpoints {
+			if k != "state.v1beta1" {
+				for _, e := range v {
+					tm(t, e, true)
+				}
+			}
+		}
+	})
+
 	t.Run("publish endpoints allowed", func(t *testing.T) {
 		allowed := []config.APIAccessRule{
 			{
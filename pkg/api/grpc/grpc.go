@@ -28,6 +28,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	otelbaggage "go.opentelemetry.io/otel/baggage"
 	otelTrace "go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
@@ -48,6 +49,7 @@ import (
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
 	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/components/configuration/subscription"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -62,6 +64,7 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/dapr/pkg/runtime/channels"
@@ -75,6 +78,14 @@ import (
 const (
 	daprHTTPStatusHeader = "dapr-http-status"
 	metadataPrefix       = "metadata."
+
+	// defaultBulkStateStreamChunkSize is the number of items of each response
+	// of a streamed bulk get, unless requested otherwise.
+	defaultBulkStateStreamChunkSize = 100
+
+	// defaultBulkSecretStreamChunkSize is the number of secrets of each
+	// response of a streamed bulk secret get, unless requested otherwise.
+	defaultBulkSecretStreamChunkSize = 100
 )
 
 // API is the gRPC interface for the Dapr gRPC API. It implements both the internal and external proto definitions.
@@ -101,6 +112,8 @@ type api struct {
 	accessControlList      *config.AccessControlList
 	workflowAccessPolicies *workflowacl.Holder
 	processor              *processor.Processor
+	isAppUnhealthy         func() bool
+	rateLimiter            *ratelimit.Limiter
 	wg                     sync.WaitGroup
 
 	closeCh chan struct{}
@@ -121,6 +134,12 @@ type APIOpts struct {
 	AccessControlList      *config.AccessControlList
 	Processor              *processor.Processor
 	WorkflowAccessPolicies *workflowacl.Holder
+	// IsAppUnhealthy reports whether the app is unhealthy, in which case the
+	// invocations of the app are rejected. Nil never rejects them.
+	IsAppUnhealthy func() bool
+	// RateLimiter rejects the invocations of the app which exceed the rate
+	// limits. Nil never rejects them.
+	RateLimiter *ratelimit.Limiter
 }
 
 // NewAPI returns a new gRPC API.
@@ -138,6 +157,8 @@ func NewAPI(opts APIOpts) API {
 		accessControlList:      opts.AccessControlList,
 		processor:              opts.Processor,
 		workflowAccessPolicies: opts.WorkflowAccessPolicies,
+		isAppUnhealthy:         opts.IsAppUnhealthy,
+		rateLimiter:            opts.RateLimiter,
 		closeCh:                make(chan struct{}),
 	}
 }
@@ -282,6 +303,11 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 	if invokeServiceDeprecationNoticeShown.CompareAndSwap(false, true) {
 		apiServerLogger.Warn("[DEPRECATION NOTICE] InvokeService is deprecated and will be removed in the future, please use proxy mode instead.")
 	}
+	limits := a.Universal.InvocationLimits(in.GetId())
+	if limits.MaxRequestBodySize > 0 && len(in.GetMessage().GetData().GetValue()) > limits.MaxRequestBodySize {
+		return nil, messages.ErrDirectInvokeRequestTooLarge.WithFormat(in.GetId(), limits.MaxRequestBodySize)
+	}
+
 	policyDef := a.Universal.Resiliency().EndpointPolicy(in.GetId(), in.GetId()+":"+in.GetMessage().GetMethod())
 
 	req := invokev1.FromInvokeRequestMessage(in.GetMessage())
@@ -294,8 +320,21 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 		req.WithMetadata(incomingMD)
 	}
 
+	// Parallel attempts of hedged requests each need their own copy of the request.
+	hedged := policyDef != nil && policyDef.HasHedging()
+
 	policyRunner := resiliency.NewRunner[*invokeServiceResp](ctx, policyDef)
 	resp, err := policyRunner(func(ctx context.Context) (*invokeServiceResp, error) {
+		req := req
+		if hedged {
+			var cErr error
+			req, cErr = req.CloneWithData()
+			if cErr != nil {
+				return nil, messages.ErrDirectInvoke.WithFormat(in.GetId(), cErr)
+			}
+			defer req.Close()
+		}
+
 		rResp := &invokeServiceResp{}
 		imr, rErr := a.directMessaging.Invoke(ctx, in.GetId(), req)
 		if imr != nil {
@@ -314,6 +353,9 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 		if rErr != nil {
 			return rResp, messages.ErrDirectInvoke.WithFormat(in.GetId(), rErr)
 		}
+		if limits.MaxResponseBodySize > 0 && len(rResp.message.GetData().GetValue()) > limits.MaxResponseBodySize {
+			return nil, backoff.Permanent(messages.ErrDirectInvokeResponseTooLarge.WithFormat(in.GetId(), limits.MaxResponseBodySize))
+		}
 
 		rResp.headers = invokev1.InternalMetadataToGrpcMetadata(ctx, imr.Headers(), true)
 
@@ -595,37 +637,136 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 		return bulkResp, nil
 	}
 
-	var key string
-	reqs := make([]state.GetRequest, len(in.GetKeys()))
-	for i, k := range in.GetKeys() {
-		key, err = stateLoader.GetModifiedStateKey(k, in.GetStoreName(), a.AppID())
+	reqs, err := bulkStateGetRequests(in.GetStoreName(), a.AppID(), in.GetKeys(), in.GetMetadata())
+	if err != nil {
+		return &runtimev1pb.GetBulkStateResponse{}, err
+	}
+
+	bulkResp.Items, err = a.bulkGetState(ctx, in.GetStoreName(), store, reqs, in.GetParallelism())
+	if err != nil {
+		return bulkResp, err
+	}
+
+	return bulkResp, nil
+}
+
+func (a *api) GetBulkStateStreamAlpha1(in *runtimev1pb.GetBulkStateStreamRequest, stream runtimev1pb.Dapr_GetBulkStateStreamAlpha1Server) error { //nolint:nosnakecase
+	store, err := a.GetStateStore(in.GetStoreName())
+	if err != nil {
+		// Error has already been logged
+		return err
+	}
+
+	chunkSize := int(in.GetChunkSize())
+	switch {
+	case chunkSize < 0:
+		err = apierrors.Basic(codes.InvalidArgument, http.StatusBadRequest, errorcodes.CommonBadRequest, fmt.Sprintf("chunk size must not be negative, got %d", chunkSize))
+		a.logger.Debug(err)
+		return err
+	case chunkSize == 0:
+		chunkSize = defaultBulkStateStreamChunkSize
+	}
+
+	reqs, err := bulkStateGetRequests(in.GetStoreName(), a.AppID(), in.GetKeys(), in.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	// Each chunk is read from the state store once the previous chunk is sent,
+	// so that at most one chunk of values is held in memory.
+	for chunk := range slices.Chunk(reqs, chunkSize) {
+		items, err := a.bulkGetState(stream.Context(), in.GetStoreName(), store, chunk, in.GetParallelism())
 		if err != nil {
-			return &runtimev1pb.GetBulkStateResponse{}, err
+			return err
+		}
+		if err = stream.Send(&runtimev1pb.GetBulkStateStreamResponse{Items: items}); err != nil {
+			return err
 		}
-		r := state.GetRequest{
+	}
+
+	return nil
+}
+
+func (a *api) GetBulkSecretStreamAlpha1(in *runtimev1pb.GetBulkSecretStreamRequest, stream runtimev1pb.Dapr_GetBulkSecretStreamAlpha1Server) error { //nolint:nosnakecase
+	chunkSize := int(in.GetChunkSize())
+	switch {
+	case chunkSize < 0:
+		err := apierrors.Basic(codes.InvalidArgument, http.StatusBadRequest, errorcodes.CommonBadRequest, fmt.Sprintf("chunk size must not be negative, got %d", chunkSize))
+		a.logger.Debug(err)
+		return err
+	case chunkSize == 0:
+		chunkSize = defaultBulkSecretStreamChunkSize
+	}
+
+	resp, err := a.GetBulkSecret(stream.Context(), &runtimev1pb.GetBulkSecretRequest{
+		StoreName:    in.GetStoreName(),
+		Metadata:     in.GetMetadata(),
+		NamePrefixes: in.GetNamePrefixes(),
+		NameRegex:    in.GetNameRegex(),
+	})
+	if err != nil {
+		// Error has already been logged
+		return err
+	}
+
+	// Secret stores return all secrets at once, so the secrets are chunked to
+	// keep each response under the message size limits.
+	names := slices.Sorted(maps.Keys(resp.GetData()))
+	for chunk := range slices.Chunk(names, chunkSize) {
+		data := make(map[string]*runtimev1pb.SecretResponse, len(chunk))
+		for _, name := range chunk {
+			data[name] = resp.GetData()[name]
+		}
+		if err = stream.Send(&runtimev1pb.GetBulkSecretStreamResponse{Data: data}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WatchSecretsAlpha1 streams the changes to secrets of a secret store.
+func (a *api) WatchSecretsAlpha1(in *runtimev1pb.WatchSecretsRequest, stream runtimev1pb.Dapr_WatchSecretsAlpha1Server) error { //nolint:nosnakecase
+	return a.WatchSecrets(stream.Context(), in, stream.Send)
+}
+
+// bulkStateGetRequests returns the requests to get the keys from the state
+// store.
+func bulkStateGetRequests(storeName, appID string, keys []string, metadata map[string]string) ([]state.GetRequest, error) {
+	reqs := make([]state.GetRequest, len(keys))
+	for i, k := range keys {
+		key, err := stateLoader.GetModifiedStateKeyForRequest(k, storeName, appID, metadata)
+		if err != nil {
+			return nil, err
+		}
+		reqs[i] = state.GetRequest{
 			Key:      key,
-			Metadata: in.GetMetadata(),
+			Metadata: metadata,
 		}
-		reqs[i] = r
 	}
+	return reqs, nil
+}
 
+// bulkGetState gets the state of the requests from the state store, in one
+// bulk get operation, and returns the items of the response.
+func (a *api) bulkGetState(ctx context.Context, storeName string, store state.Store, reqs []state.GetRequest, parallelism int32) ([]*runtimev1pb.BulkStateItem, error) {
 	start := time.Now()
-	policyDef := a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore)
+	policyDef := a.Universal.Resiliency().ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateBulkGet)
 	bgrPolicyRunner := resiliency.NewRunner[[]state.BulkGetResponse](ctx, policyDef)
 	responses, err := bgrPolicyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
 		return store.BulkGet(ctx, reqs, state.BulkGetOpts{
-			Parallelism: int(in.GetParallelism()),
+			Parallelism: int(parallelism),
 		})
 	})
 
 	elapsed := diag.ElapsedSince(start)
-	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.BulkGet, err == nil, elapsed)
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.BulkGet, err == nil, elapsed)
 
 	if err != nil {
-		return bulkResp, err
+		return nil, err
 	}
 
-	bulkResp.Items = make([]*runtimev1pb.BulkStateItem, len(responses))
+	items := make([]*runtimev1pb.BulkStateItem, len(responses))
 	for i := range responses {
 		item := &runtimev1pb.BulkStateItem{
 			Key:      stateLoader.GetOriginalStateKey(responses[i].Key),
@@ -634,29 +775,44 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 			Metadata: responses[i].Metadata,
 			Error:    responses[i].Error,
 		}
-		bulkResp.Items[i] = item
+		items[i] = item
 	}
 
-	if encryption.EncryptedStateStore(in.GetStoreName()) {
-		for i := range bulkResp.GetItems() {
-			if bulkResp.GetItems()[i].GetError() != "" || len(bulkResp.GetItems()[i].GetData()) == 0 {
-				bulkResp.Items[i].Data = nil
+	if encryption.EncryptedStateStore(storeName) {
+		for i := range items {
+			if items[i].GetError() != "" || len(items[i].GetData()) == 0 {
+				items[i].Data = nil
 				continue
 			}
 
-			val, err := encryption.TryDecryptValue(in.GetStoreName(), bulkResp.GetItems()[i].GetData())
+			resp := &state.GetResponse{
+				Data:     responses[i].Data,
+				ETag:     responses[i].ETag,
+				Metadata: responses[i].Metadata,
+			}
+			val, err := a.DecryptStateValue(ctx, storeName, store, responses[i].Key, resp)
 			if err != nil {
 				apiServerLogger.Debugf("Bulk get error: %v", err)
-				bulkResp.Items[i].Data = nil
-				bulkResp.Items[i].Error = err.Error()
+				items[i].Data = nil
+				items[i].Error = err.Error()
 				continue
 			}
 
-			bulkResp.Items[i].Data = val
+			items[i].Data = val
+			items[i].Etag = stringValueOrEmpty(resp.ETag)
 		}
 	}
 
-	return bulkResp, nil
+	for i := range items {
+		if items[i].GetError() == "" {
+			items[i].TtlInSeconds = a.StateTTLRemaining(ctx, storeName, store, responses[i].Key, &state.GetResponse{
+				Data:     responses[i].Data,
+				Metadata: responses[i].Metadata,
+			})
+		}
+	}
+
+	return items, nil
 }
 
 func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error) {
@@ -665,7 +821,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 		// Error has already been logged
 		return &runtimev1pb.GetStateResponse{}, err
 	}
-	key, err := stateLoader.GetModifiedStateKey(in.GetKey(), in.GetStoreName(), a.AppID())
+	key, err := stateLoader.GetModifiedStateKeyForRequest(in.GetKey(), in.GetStoreName(), a.AppID(), in.GetMetadata())
 	if err != nil {
 		return &runtimev1pb.GetStateResponse{}, err
 	}
@@ -677,17 +833,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 		},
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*state.GetResponse](ctx,
-		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
-	)
-	getResponse, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
-		return store.Get(ctx, req)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Get, err == nil, elapsed)
-
+	getResponse, err := a.GetStateThroughCache(ctx, in.GetStoreName(), store, req)
 	if err != nil {
 		kerr, ok := kiterrors.FromError(err)
 		if ok {
@@ -704,7 +850,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 		getResponse = &state.GetResponse{}
 	}
 	if encryption.EncryptedStateStore(in.GetStoreName()) {
-		val, err := encryption.TryDecryptValue(in.GetStoreName(), getResponse.Data)
+		val, err := a.DecryptStateValue(ctx, in.GetStoreName(), store, key, getResponse)
 		if err != nil {
 			err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateGet, fmt.Sprintf(messages.ErrStateGet, in.GetKey(), in.GetStoreName(), err.Error()))
 			a.logger.Debug(err)
@@ -719,6 +865,7 @@ func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*r
 		response.Etag = stringValueOrEmpty(getResponse.ETag)
 		response.Data = getResponse.Data
 		response.Metadata = getResponse.Metadata
+		response.TtlInSeconds = a.StateTTLRemaining(ctx, in.GetStoreName(), store, key, getResponse)
 	}
 	return response, nil
 }
@@ -744,7 +891,7 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 		}
 
 		var key string
-		key, err = stateLoader.GetModifiedStateKey(s.GetKey(), in.GetStoreName(), a.AppID())
+		key, err = stateLoader.GetModifiedStateKeyForRequest(s.GetKey(), in.GetStoreName(), a.AppID(), s.GetMetadata())
 		if err != nil {
 			return empty, err
 		}
@@ -771,6 +918,10 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 				Concurrency: stateConcurrencyToString(s.GetOptions().GetConcurrency()),
 			}
 		}
+		if err = a.ApplyStateTTL(in.GetStoreName(), store, &req, s.TtlInSeconds); err != nil {
+			a.logger.Debug(err)
+			return empty, err
+		}
 		if encryption.EncryptedStateStore(in.GetStoreName()) {
 			val, encErr := encryption.TryEncryptValue(in.GetStoreName(), s.GetValue())
 			if encErr != nil {
@@ -784,9 +935,14 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 		reqs[i] = req
 	}
 
+	keys := make([]string, len(reqs))
+	for i := range reqs {
+		keys[i] = reqs[i].Key
+	}
+
 	start := time.Now()
 	err = stateLoader.PerformBulkStoreOperation(ctx, reqs,
-		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		a.Universal.Resiliency().ComponentOutboundOperationPolicy(in.GetStoreName(), resiliency.Statestore, resiliency.StateSet),
 		state.BulkStoreOpts{},
 		store.Set,
 		store.BulkSet,
@@ -794,6 +950,7 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Set, err == nil, elapsed)
+	a.InvalidateStateKeys(ctx, in.GetStoreName(), keys...)
 
 	if err != nil {
 		if kerr, ok := kiterrors.FromError(err); ok {
@@ -804,6 +961,12 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 		a.logger.Debug(err)
 		return empty, err
 	}
+
+	if err = a.ScheduleStateExpiry(ctx, in.GetStoreName(), store, reqs...); err != nil {
+		err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateSave, fmt.Sprintf(messages.ErrStateSave, in.GetStoreName(), err.Error()))
+		a.logger.Debug(err)
+		return empty, err
+	}
 	return empty, nil
 }
 
@@ -831,7 +994,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 		return empty, err
 	}
 
-	key, err := stateLoader.GetModifiedStateKey(in.GetKey(), in.GetStoreName(), a.AppID())
+	key, err := stateLoader.GetModifiedStateKeyForRequest(in.GetKey(), in.GetStoreName(), a.AppID(), in.GetMetadata())
 	if err != nil {
 		return empty, err
 	}
@@ -851,7 +1014,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[any](ctx,
-		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		a.Universal.Resiliency().ComponentOutboundOperationPolicy(in.GetStoreName(), resiliency.Statestore, resiliency.StateDelete),
 	)
 	_, err = policyRunner(func(ctx context.Context) (any, error) {
 		return nil, store.Delete(ctx, &req)
@@ -859,6 +1022,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.Delete, err == nil, elapsed)
+	a.InvalidateStateKeys(ctx, in.GetStoreName(), key)
 
 	if err != nil {
 		if kerr, ok := kiterrors.FromError(err); ok {
@@ -869,6 +1033,8 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 		a.logger.Debug(err)
 		return empty, err
 	}
+
+	a.CancelStateExpiry(ctx, in.GetStoreName(), store, key)
 	return empty, nil
 }
 
@@ -883,7 +1049,7 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 
 	reqs := make([]state.DeleteRequest, len(in.GetStates()))
 	for i, item := range in.GetStates() {
-		key, err1 := stateLoader.GetModifiedStateKey(item.GetKey(), in.GetStoreName(), a.AppID())
+		key, err1 := stateLoader.GetModifiedStateKeyForRequest(item.GetKey(), in.GetStoreName(), a.AppID(), item.GetMetadata())
 		if err1 != nil {
 			return empty, err1
 		}
@@ -905,7 +1071,7 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 
 	start := time.Now()
 	err = stateLoader.PerformBulkStoreOperation(ctx, reqs,
-		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		a.Universal.Resiliency().ComponentOutboundOperationPolicy(in.GetStoreName(), resiliency.Statestore, resiliency.StateBulkDelete),
 		state.BulkStoreOpts{},
 		store.Delete,
 		store.BulkDelete,
@@ -914,6 +1080,12 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.BulkDelete, err == nil, elapsed)
 
+	keys := make([]string, len(reqs))
+	for i := range reqs {
+		keys[i] = reqs[i].Key
+	}
+	a.InvalidateStateKeys(ctx, in.GetStoreName(), keys...)
+
 	if err != nil {
 		if kerr, ok := kiterrors.FromError(err); ok {
 			err = kerr
@@ -924,6 +1096,8 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 		return empty, err
 	}
 
+	a.CancelStateExpiry(ctx, in.GetStoreName(), store, keys...)
+
 	return empty, nil
 }
 
@@ -953,7 +1127,7 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		req := inputReq.GetRequest()
 
 		hasEtag, etag := extractEtag(req)
-		key, err := stateLoader.GetModifiedStateKey(req.GetKey(), in.GetStoreName(), a.AppID())
+		key, err := stateLoader.GetModifiedStateKeyForRequest(req.GetKey(), in.GetStoreName(), a.AppID(), req.GetMetadata(), in.GetMetadata())
 		if err != nil {
 			return &emptypb.Empty{}, err
 		}
@@ -977,6 +1151,10 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 					Consistency: stateConsistencyToString(req.GetOptions().GetConsistency()),
 				}
 			}
+			if err = a.ApplyStateTTL(in.GetStoreName(), store, &setReq, req.TtlInSeconds); err != nil {
+				apiServerLogger.Debug(err)
+				return &emptypb.Empty{}, err
+			}
 
 			operations = append(operations, setReq)
 
@@ -1032,11 +1210,12 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		}
 	}
 
+	appOperations := operations
 	outboxEnabled := a.outbox.Enabled(in.GetStoreName())
 	if outboxEnabled {
 		span := diagUtils.SpanFromContext(ctx)
 		traceID, traceState := diag.TraceIDAndStateFromSpan(span)
-		ops, err := a.outbox.PublishInternal(ctx, in.GetStoreName(), operations, a.AppID(), traceID, traceState)
+		ops, err := a.outbox.PublishInternal(ctx, in.GetStoreName(), operations, in.GetMetadata(), a.AppID(), traceID, traceState)
 		if err != nil {
 			nerr := apierrors.PubSubOutbox(a.AppID(), err)
 			apiServerLogger.Debug(nerr)
@@ -1048,7 +1227,7 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[struct{}](ctx,
-		a.Universal.Resiliency().ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		a.Universal.Resiliency().ComponentOutboundOperationPolicy(in.GetStoreName(), resiliency.Statestore, resiliency.StateTransaction),
 	)
 	storeReq := &state.TransactionalStateRequest{
 		Operations: operations,
@@ -1060,8 +1239,19 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(ctx, in.GetStoreName(), diag.StateTransaction, err == nil, elapsed)
+	a.InvalidateStateKeys(ctx, in.GetStoreName(), universal.TransactionKeys(appOperations)...)
 
 	if err != nil {
+		if conflictErr := a.StateTransactionConflictError(ctx, in.GetStoreName(), store, appOperations, err); conflictErr != nil {
+			err = conflictErr
+		} else {
+			err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateTransaction, fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
+		}
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	if err = a.ScheduleStateTransactionExpiry(ctx, in.GetStoreName(), store, appOperations); err != nil {
 		err = apierrors.Basic(codes.Internal, http.StatusInternalServerError, errorcodes.StateTransaction, fmt.Sprintf(messages.ErrStateTransaction, err.Error()))
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
@@ -1069,6 +1259,11 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 	return &emptypb.Empty{}, nil
 }
 
+// WatchStateAlpha1 streams the changes to keys of a state store.
+func (a *api) WatchStateAlpha1(in *runtimev1pb.WatchStateRequest, stream runtimev1pb.Dapr_WatchStateAlpha1Server) error { //nolint:nosnakecase
+	return a.WatchState(stream.Context(), in, stream.Send)
+}
+
 func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error) {
 	if err := a.RejectInternalActorType(in.GetActorType()); err != nil {
 		return nil, err
@@ -1218,7 +1413,7 @@ func (a *api) InvokeActor(ctx context.Context, in *runtimev1pb.InvokeActorReques
 
 	// Unlike other actor calls, resiliency is handled here for invocation.
 	// This is due to actor invocation involving a lookup for the host.
-	policyDef := a.Universal.Resiliency().ActorPreLockPolicy(in.GetActorType(), in.GetActorId())
+	policyDef := a.Universal.Resiliency().ActorPreLockMethodPolicy(in.GetActorType(), in.GetActorId(), in.GetMethod())
 	policyRunner := resiliency.NewRunner[*internalv1pb.InternalInvokeResponse](ctx, policyDef)
 	res, err := policyRunner(func(ctx context.Context) (*internalv1pb.InternalInvokeResponse, error) {
 		return router.Call(ctx, req)
@@ -1295,15 +1490,7 @@ func (a *api) GetConfiguration(ctx context.Context, in *runtimev1pb.GetConfigura
 	}
 
 	if getResponse != nil {
-		cachedItems := make(map[string]*commonv1pb.ConfigurationItem, len(getResponse.Items))
-		for k, v := range getResponse.Items {
-			cachedItems[k] = &commonv1pb.ConfigurationItem{
-				Metadata: v.Metadata,
-				Value:    v.Value,
-				Version:  v.Version,
-			}
-		}
-		response.Items = cachedItems
+		response.Items = universal.ConfigurationItems(getResponse.Items)
 	}
 
 	return response, nil
@@ -1338,17 +1525,8 @@ func (h *configurationEventHandler) updateEventHandler(ctx context.Context, e *c
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
-	items := make(map[string]*commonv1pb.ConfigurationItem, len(e.Items))
-	for k, v := range e.Items {
-		items[k] = &commonv1pb.ConfigurationItem{
-			Value:    v.Value,
-			Version:  v.Version,
-			Metadata: v.Metadata,
-		}
-	}
-
 	err := h.serverStream.Send(&runtimev1pb.SubscribeConfigurationResponse{
-		Items: items,
+		Items: universal.ConfigurationItems(e.Items),
 		Id:    e.ID,
 	})
 	if err != nil {
@@ -1365,6 +1543,18 @@ func (a *api) SubscribeConfiguration(request *runtimev1pb.SubscribeConfiguration
 		return err
 	}
 
+	sub, err := subscription.New(subscription.Options{
+		Keys:             request.GetKeys(),
+		KeyPrefixes:      request.GetKeyPrefixes(),
+		CoalesceInterval: time.Duration(request.GetCoalesceIntervalMs()) * time.Millisecond,
+	})
+	if err != nil {
+		err = apierrors.Basic(codes.InvalidArgument, http.StatusBadRequest, errorcodes.ConfigurationSubscribeInvalid, fmt.Sprintf(messages.ErrConfigurationSubscribeInvalid, request.GetStoreName(), err))
+		apiServerLogger.Debug(err)
+		return err
+	}
+	defer sub.Close()
+
 	handler := &configurationEventHandler{
 		readyCh:      make(chan struct{}),
 		api:          a,
@@ -1378,7 +1568,7 @@ func (a *api) SubscribeConfiguration(request *runtimev1pb.SubscribeConfiguration
 	subscribeCtx, subscribeCancel := context.WithCancel(stream.Context())
 	defer subscribeCancel()
 	slices.Sort(request.GetKeys())
-	subscribeID, err := a.subscribeConfiguration(subscribeCtx, request, handler, store)
+	subscribeID, err := a.subscribeConfiguration(subscribeCtx, request, sub, handler, store)
 	if err != nil {
 		// Error has already been logged
 		return err
@@ -1423,9 +1613,9 @@ func (a *api) SubscribeConfiguration(request *runtimev1pb.SubscribeConfiguration
 	return nil
 }
 
-func (a *api) subscribeConfiguration(ctx context.Context, request *runtimev1pb.SubscribeConfigurationRequest, handler *configurationEventHandler, store configuration.Store) (subscribeID string, err error) {
+func (a *api) subscribeConfiguration(ctx context.Context, request *runtimev1pb.SubscribeConfigurationRequest, sub *subscription.Subscription, handler *configurationEventHandler, store configuration.Store) (subscribeID string, err error) {
 	componentReq := &configuration.SubscribeRequest{
-		Keys:     request.GetKeys(),
+		Keys:     sub.StoreKeys(),
 		Metadata: request.GetMetadata(),
 	}
 
@@ -1435,7 +1625,7 @@ func (a *api) subscribeConfiguration(ctx context.Context, request *runtimev1pb.S
 		a.Universal.Resiliency().ComponentOutboundPolicy(request.GetStoreName(), resiliency.Configuration),
 	)
 	subscribeID, err = policyRunner(func(ctx context.Context) (string, error) {
-		return store.Subscribe(ctx, componentReq, handler.updateEventHandler)
+		return store.Subscribe(ctx, componentReq, sub.Handler(handler.updateEventHandler))
 	})
 	elapsed := diag.ElapsedSince(start)
 
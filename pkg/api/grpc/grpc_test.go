@@ -19,7 +19,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -49,14 +51,17 @@ import (
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
 	"github.com/dapr/dapr/pkg/actors/fake"
 	"github.com/dapr/dapr/pkg/actors/router"
+	"github.com/dapr/dapr/pkg/api/apitoken"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
 	"github.com/dapr/dapr/pkg/api/universal"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	componentsV1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	httpEndpointsV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -331,7 +336,7 @@ func startDaprAPIServer(t *testing.T, testAPIServer *api, token string) *bufconn
 	}
 	streamInterceptors := []grpc.StreamServerInterceptor{}
 	if token != "" {
-		unary, stream := getAPIAuthenticationMiddlewares(token, "dapr-api-token")
+		unary, stream := getAPIAuthenticationMiddlewares(apitoken.New(apitoken.Options{Token: token}), "dapr-api-token", false)
 		interceptors = append(interceptors, unary)
 		streamInterceptors = append(streamInterceptors, stream)
 	}
@@ -787,6 +792,76 @@ func TestInvokeServiceFromGRPCResponse(t *testing.T) {
 	})
 }
 
+func TestInvokeServiceLimits(t *testing.T) {
+	mockDirectMessaging := new(daprt.MockDirectMessaging)
+
+	fakeAPI := &api{
+		logger: logger.NewLogger("test"),
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Resiliency: resiliency.New(nil),
+			GlobalConfig: &config.Configuration{Spec: config.ConfigurationSpec{
+				ServiceInvocation: &config.ServiceInvocationSpec{
+					Limits: &config.InvocationLimitsSpec{
+						Apps: []config.AppInvocationLimits{{
+							AppID:            "fakeAppID",
+							InvocationLimits: config.InvocationLimits{MaxRequestBodySize: 8, MaxResponseBodySize: 8},
+						}},
+					},
+				},
+			}},
+		}),
+		directMessaging: mockDirectMessaging,
+	}
+
+	lis := startDaprAPIServer(t, fakeAPI, "")
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	invoke := func(appID string, data string) error {
+		_, err := client.InvokeService(t.Context(), &runtimev1pb.InvokeServiceRequest{
+			Id: appID,
+			Message: &commonv1pb.InvokeRequest{
+				Method: "fakeMethod",
+				Data:   &anypb.Any{Value: []byte(data)},
+			},
+		})
+		return err
+	}
+
+	t.Run("request too large", func(t *testing.T) {
+		err := invoke("fakeAppID", "request too large")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.ErrorContains(t, err, "exceeds the maximum size of 8 bytes")
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 0)
+	})
+
+	t.Run("response too large", func(t *testing.T) {
+		fakeResp := invokev1.NewInvokeMethodResponse(0, "", nil).WithRawDataString("response too large")
+		defer fakeResp.Close()
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"fakeAppID",
+			mock.AnythingOfType("*v1.InvokeMethodRequest")).Return(fakeResp, nil).Once()
+
+		err := invoke("fakeAppID", "small")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+	})
+
+	t.Run("other apps are not limited", func(t *testing.T) {
+		fakeResp := invokev1.NewInvokeMethodResponse(0, "", nil).WithRawDataString("response too large")
+		defer fakeResp.Close()
+		mockDirectMessaging.On("Invoke",
+			mock.MatchedBy(matchContextInterface),
+			"otherAppID",
+			mock.AnythingOfType("*v1.InvokeMethodRequest")).Return(fakeResp, nil).Once()
+
+		require.NoError(t, invoke("otherAppID", "request too large"))
+	})
+}
+
 func TestSecretStoreNotConfigured(t *testing.T) {
 	lis := startDaprAPIServer(t, &api{
 		logger: logger.NewLogger("grpc.api.test"),
@@ -1028,6 +1103,84 @@ func TestGetBulkSecret(t *testing.T) {
 	}
 }
 
+func TestGetBulkSecretStream(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddSecretStore("store1", daprt.NamedSecretStore{
+		Names: []string{"secret3", "secret1", "other", "secret2", "secret0"},
+	})
+
+	fakeAPI := &api{
+		logger: logger.NewLogger("grpc.api.test"),
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			Resiliency: resiliency.New(nil),
+			CompStore:  compStore,
+		}),
+	}
+	lis := startDaprAPIServer(t, fakeAPI, "")
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	recv := func(t *testing.T, req *runtimev1pb.GetBulkSecretStreamRequest) ([][]string, error) {
+		t.Helper()
+		stream, err := client.GetBulkSecretStreamAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		var chunks [][]string
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return chunks, nil
+			}
+			if err != nil {
+				return chunks, err
+			}
+			chunks = append(chunks, slices.Sorted(maps.Keys(resp.GetData())))
+		}
+	}
+
+	t.Run("secrets are streamed in chunks in the order of the names", func(t *testing.T) {
+		chunks, err := recv(t, &runtimev1pb.GetBulkSecretStreamRequest{
+			StoreName:    "store1",
+			NamePrefixes: []string{"secret"},
+			ChunkSize:    3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"secret0", "secret1", "secret2"}, {"secret3"}}, chunks)
+	})
+
+	t.Run("default chunk size", func(t *testing.T) {
+		chunks, err := recv(t, &runtimev1pb.GetBulkSecretStreamRequest{StoreName: "store1"})
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		assert.Len(t, chunks[0], 5)
+	})
+
+	t.Run("negative chunk size", func(t *testing.T) {
+		_, err := recv(t, &runtimev1pb.GetBulkSecretStreamRequest{
+			StoreName: "store1",
+			ChunkSize: -1,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := recv(t, &runtimev1pb.GetBulkSecretStreamRequest{
+			StoreName: "store1",
+			NameRegex: "(",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("non-existing store", func(t *testing.T) {
+		_, err := recv(t, &runtimev1pb.GetBulkSecretStreamRequest{StoreName: "no-store"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestGetStateWhenStoreNotConfigured(t *testing.T) {
 	lis := startDaprAPIServer(t, &api{
 		logger: logger.NewLogger("grpc.api.test"),
@@ -1419,6 +1572,7 @@ func TestGetConfiguration(t *testing.T) {
 				Items: map[string]*commonv1pb.ConfigurationItem{
 					goodKey: {
 						Value: "test-data",
+						Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 					},
 				},
 			},
@@ -1433,12 +1587,15 @@ func TestGetConfiguration(t *testing.T) {
 				Items: map[string]*commonv1pb.ConfigurationItem{
 					"good-key1": {
 						Value: "test-data",
+						Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 					},
 					goodKey2: {
 						Value: "test-data",
+						Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 					},
 					"good-key3": {
 						Value: "test-data",
+						Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 					},
 				},
 			},
@@ -1600,6 +1757,7 @@ func TestSubscribeConfiguration(t *testing.T) {
 			expectedResponse: map[string]*commonv1pb.ConfigurationItem{
 				goodKey: {
 					Value: "test-data",
+					Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 				},
 			},
 			expectedError: codes.OK,
@@ -1628,9 +1786,11 @@ func TestSubscribeConfiguration(t *testing.T) {
 			expectedResponse: map[string]*commonv1pb.ConfigurationItem{
 				goodKey: {
 					Value: "test-data",
+					Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 				},
 				goodKey2: {
 					Value: "test-data2",
+					Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 				},
 			},
 			expectedError: codes.OK,
@@ -1692,6 +1852,77 @@ func TestSubscribeConfiguration(t *testing.T) {
 	}
 }
 
+func TestSubscribeConfigurationKeyPrefixes(t *testing.T) {
+	fakeConfigurationStore := &daprt.MockConfigurationStore{}
+	fakeConfigurationStore.On("Subscribe",
+		mock.MatchedBy(matchContextInterface),
+		mock.MatchedBy(func(req *configuration.SubscribeRequest) bool {
+			// Subscriptions with key prefixes subscribe to all keys of the store.
+			return len(req.Keys) == 0
+		}),
+		mock.MatchedBy(func(f configuration.UpdateHandler) bool {
+			go f(t.Context(), &configuration.UpdateEvent{
+				Items: map[string]*configuration.Item{
+					"app.timeout": {Value: "10"},
+					"app.enabled": {Value: "true"},
+					"other.key":   {Value: "test-data"},
+				},
+			})
+			return true
+		}),
+	).Return("id1", nil)
+	fakeConfigurationStore.On("Unsubscribe", mock.Anything, mock.Anything).Return(nil)
+
+	compStore := compstore.New()
+	compStore.AddConfiguration("store1", fakeConfigurationStore)
+
+	fakeAPI := &api{
+		logger: logger.NewLogger("test"),
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	lis := startDaprAPIServer(t, fakeAPI, "")
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("items are filtered by key prefix", func(t *testing.T) {
+		resp, err := client.SubscribeConfiguration(t.Context(), &runtimev1pb.SubscribeConfigurationRequest{
+			StoreName:   "store1",
+			KeyPrefixes: []string{"app."},
+		})
+		require.NoError(t, err)
+
+		rsp, err := resp.Recv()
+		require.NoError(t, err)
+		require.NotEmpty(t, rsp.GetId())
+
+		rsp, err = resp.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*commonv1pb.ConfigurationItem{
+			"app.timeout": {Value: "10", Type: commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_INT},
+			"app.enabled": {Value: "true", Type: commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_BOOL},
+		}, rsp.GetItems())
+	})
+
+	t.Run("invalid subscriptions", func(t *testing.T) {
+		for _, req := range []*runtimev1pb.SubscribeConfigurationRequest{
+			{StoreName: "store1", KeyPrefixes: []string{""}},
+			{StoreName: "store1", Keys: []string{goodKey}, CoalesceIntervalMs: -1},
+		} {
+			resp, err := client.SubscribeConfiguration(t.Context(), req)
+			require.NoError(t, err)
+			_, err = resp.Recv()
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
+
 func TestUnSubscribeConfiguration(t *testing.T) {
 	fakeConfigurationStore := &daprt.MockConfigurationStore{}
 	stop := make(chan struct{})
@@ -1810,6 +2041,7 @@ func TestUnSubscribeConfiguration(t *testing.T) {
 			expectedResponse: map[string]*commonv1pb.ConfigurationItem{
 				goodKey: {
 					Value: "test-data",
+					Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 				},
 			},
 			expectedError: codes.OK,
@@ -1821,9 +2053,11 @@ func TestUnSubscribeConfiguration(t *testing.T) {
 			expectedResponse: map[string]*commonv1pb.ConfigurationItem{
 				goodKey: {
 					Value: "test-data",
+					Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 				},
 				goodKey2: {
 					Value: "test-data2",
+					Type:  commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING,
 				},
 			},
 			expectedError: codes.OK,
@@ -2116,6 +2350,105 @@ func TestGetBulkState(t *testing.T) {
 	}
 }
 
+func TestGetBulkStateStream(t *testing.T) {
+	fakeStore := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", fakeStore)
+
+	fakeAPI := &api{
+		logger: logger.NewLogger("grpc.api.test"),
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+	lis := startDaprAPIServer(t, fakeAPI, "")
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	keys := make([]string, 5)
+	for i := range keys {
+		keys[i] = "key" + strconv.Itoa(i)
+		require.NoError(t, fakeStore.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||" + keys[i], Value: "value" + strconv.Itoa(i)}))
+	}
+
+	recv := func(t *testing.T, req *runtimev1pb.GetBulkStateStreamRequest) ([][]*runtimev1pb.BulkStateItem, error) {
+		t.Helper()
+		stream, err := client.GetBulkStateStreamAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		var chunks [][]*runtimev1pb.BulkStateItem
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return chunks, nil
+			}
+			if err != nil {
+				return chunks, err
+			}
+			chunks = append(chunks, resp.GetItems())
+		}
+	}
+
+	t.Run("items are streamed in chunks in the order of the keys", func(t *testing.T) {
+		bulkGets := fakeStore.CallCount("BulkGet")
+		chunks, err := recv(t, &runtimev1pb.GetBulkStateStreamRequest{
+			StoreName: "store1",
+			Keys:      append(keys, "nokey"),
+			ChunkSize: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, chunks, 3)
+		assert.Equal(t, uint64(3), fakeStore.CallCount("BulkGet")-bulkGets)
+
+		var got []string
+		for _, chunk := range chunks {
+			assert.LessOrEqual(t, len(chunk), 2)
+			for _, item := range chunk {
+				got = append(got, item.GetKey()+"="+string(item.GetData()))
+			}
+		}
+		assert.Equal(t, []string{`key0="value0"`, `key1="value1"`, `key2="value2"`, `key3="value3"`, `key4="value4"`, "nokey="}, got)
+	})
+
+	t.Run("default chunk size", func(t *testing.T) {
+		chunks, err := recv(t, &runtimev1pb.GetBulkStateStreamRequest{
+			StoreName: "store1",
+			Keys:      keys,
+		})
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		assert.Len(t, chunks[0], 5)
+	})
+
+	t.Run("no keys", func(t *testing.T) {
+		chunks, err := recv(t, &runtimev1pb.GetBulkStateStreamRequest{StoreName: "store1"})
+		require.NoError(t, err)
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("negative chunk size", func(t *testing.T) {
+		_, err := recv(t, &runtimev1pb.GetBulkStateStreamRequest{
+			StoreName: "store1",
+			Keys:      keys,
+			ChunkSize: -1,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("non-existing store", func(t *testing.T) {
+		_, err := recv(t, &runtimev1pb.GetBulkStateStreamRequest{
+			StoreName: "no-store",
+			Keys:      keys,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestDeleteState(t *testing.T) {
 	etagMismatchErr := state.NewETagError(state.ETagMismatch, errors.New("simulated"))
 	etagInvalidErr := state.NewETagError(state.ETagInvalid, errors.New("simulated"))
@@ -3112,6 +3445,114 @@ func TestStateStoreQuerierEncrypted(t *testing.T) {
 	assert.Equal(t, codes.Internal, status.Code(err))
 }
 
+func TestQueryStateBeta1(t *testing.T) {
+	newStore := func(t *testing.T, name string) state.Store {
+		t.Helper()
+		store := inmemory.NewInMemoryStateStore(logger.NewLogger("grpc.api.test"))
+		require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+		require.NoError(t, stateLoader.SaveStateConfiguration(name, map[string]string{"queryIndex": "true"}))
+		return store
+	}
+
+	compStore := compstore.New()
+	compStore.AddStateStore("beta-east", newStore(t, "beta-east"))
+	compStore.AddStateStore("beta-west", newStore(t, "beta-west"))
+	compStore.AddStateStore("beta-noindex", &daprt.MockStateStore{})
+	lis := startTestServerAPI(t, &api{
+		Universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("grpc.api.test"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	})
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	save := func(t *testing.T, storeName string, values map[string]string) {
+		t.Helper()
+		var states []*commonv1pb.StateItem
+		for k, v := range values {
+			states = append(states, &commonv1pb.StateItem{Key: k, Value: []byte(v)})
+		}
+		_, err := client.SaveState(t.Context(), &runtimev1pb.SaveStateRequest{StoreName: storeName, States: states})
+		require.NoError(t, err)
+	}
+	save(t, "beta-east", map[string]string{"e1": `{"n":1}`, "e2": `{"n":3}`, "e3": `{"n":5}`})
+	save(t, "beta-west", map[string]string{"w1": `{"n":2}`, "w2": `{"n":4}`})
+
+	_, err := client.DeleteState(t.Context(), &runtimev1pb.DeleteStateRequest{StoreName: "beta-east", Key: "e3"})
+	require.NoError(t, err)
+
+	keys := func(resp *runtimev1pb.QueryStateResponse) []string {
+		var keys []string
+		for _, r := range resp.GetResults() {
+			keys = append(keys, r.GetStoreName()+"/"+r.GetKey())
+		}
+		return keys
+	}
+
+	t.Run("single store", func(t *testing.T) {
+		resp, err := client.QueryStateBeta1(t.Context(), &runtimev1pb.QueryStateRequest{
+			StoreName: "beta-east",
+			Query:     `{"sort": [{"key": "n", "order": "DESC"}]}`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"beta-east/e2", "beta-east/e1"}, keys(resp))
+		assert.Empty(t, resp.GetToken())
+	})
+
+	t.Run("federated with pagination", func(t *testing.T) {
+		req := &runtimev1pb.QueryStateRequest{
+			StoreName:  "beta-east",
+			StoreNames: []string{"beta-west"},
+			Query:      `{"filter": {"GT": {"n": 1}}, "sort": [{"key": "n"}], "page": {"limit": 2}}`,
+		}
+		resp, err := client.QueryStateBeta1(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"beta-west/w1", "beta-east/e2"}, keys(resp))
+		require.NotEmpty(t, resp.GetToken())
+
+		req.Query = `{"filter": {"GT": {"n": 1}}, "sort": [{"key": "n"}], "page": {"limit": 2, "token": "` + resp.GetToken() + `"}}`
+		resp, err = client.QueryStateBeta1(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"beta-west/w2"}, keys(resp))
+		assert.Empty(t, resp.GetToken())
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, err := client.QueryStateBeta1(t.Context(), &runtimev1pb.QueryStateRequest{
+			StoreName: "beta-east",
+			Query:     `{"filter": {"GT": {"n": true}}}`,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.QueryStateBeta1(t.Context(), &runtimev1pb.QueryStateRequest{
+			StoreName: "beta-east",
+			Query:     `{"page": {"token": "bad"}}`,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.QueryStateBeta1(t.Context(), &runtimev1pb.QueryStateRequest{
+			StoreName:  "beta-east",
+			StoreNames: []string{"beta-east"},
+			Query:      `{}`,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("store without query support", func(t *testing.T) {
+		_, err := client.QueryStateBeta1(t.Context(), &runtimev1pb.QueryStateRequest{
+			StoreName:  "beta-east",
+			StoreNames: []string{"beta-noindex"},
+			Query:      `{}`,
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
 // Interface that applies to both SubscribeConfigurationAlpha1 and SubscribeConfiguration
 type subscribeConfigurationFn func(ctx context.Context, in *runtimev1pb.SubscribeConfigurationRequest, opts ...grpc.CallOption) (interface {
 	Recv() (*runtimev1pb.SubscribeConfigurationResponse, error)
@@ -3991,6 +4432,54 @@ func TestTryLock(t *testing.T) {
 		resp, err := api.TryLockAlpha1(t.Context(), req)
 		require.NoError(t, err)
 		assert.True(t, resp.GetSuccess())
+		assert.Zero(t, resp.GetFencingToken())
+	})
+
+	t.Run("fencing tokens", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		mockLockStore := daprt.NewMockStore(ctl)
+		gomock.InOrder(
+			mockLockStore.EXPECT().TryLock(t.Context(), gomock.Any()).Return(&lock.TryLockResponse{Success: true}, nil).Times(2),
+			mockLockStore.EXPECT().TryLock(t.Context(), gomock.Any()).Return(&lock.TryLockResponse{
+				Success:  true,
+				Metadata: map[string]string{"fencingToken": "42"},
+			}, nil),
+			mockLockStore.EXPECT().TryLock(t.Context(), gomock.Any()).Return(&lock.TryLockResponse{Success: false}, nil),
+		)
+		compStore := compstore.New()
+		compStore.AddLock("mock", mockLockStore)
+		api := NewAPI(APIOpts{
+			Universal: universal.New(universal.Options{
+				Resiliency: resiliencyConfig,
+				Logger:     l,
+				CompStore:  compStore,
+			}),
+		})
+		req := &runtimev1pb.TryLockRequest{
+			StoreName:       "mock",
+			ResourceId:      "resource",
+			LockOwner:       "owner",
+			ExpiryInSeconds: 1,
+		}
+
+		// Fencing tokens are only provided by the lock store.
+		for range 2 {
+			resp, err := api.TryLockAlpha1(t.Context(), req)
+			require.NoError(t, err)
+			assert.True(t, resp.GetSuccess())
+			assert.Zero(t, resp.GetFencingToken())
+		}
+
+		resp, err := api.TryLockAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), resp.GetFencingToken())
+
+		resp, err = api.TryLockAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Zero(t, resp.GetFencingToken())
 	})
 }
 
@@ -4113,6 +4602,80 @@ func TestUnlock(t *testing.T) {
 	})
 }
 
+func TestRenewLock(t *testing.T) {
+	l := logger.NewLogger("fakeLogger")
+	resiliencyConfig := resiliency.FromConfigurations(l, testResiliency)
+
+	newAPI := func(t *testing.T, store lock.Store) *api {
+		compStore := compstore.New()
+		compStore.AddLock("mock", store)
+		return NewAPI(APIOpts{
+			Universal: universal.New(universal.Options{
+				Resiliency: resiliencyConfig,
+				Logger:     l,
+				CompStore:  compStore,
+			}),
+		}).(*api)
+	}
+	req := &runtimev1pb.RenewLockRequest{
+		StoreName:       "mock",
+		ResourceId:      "resource",
+		LockOwner:       "owner",
+		ExpiryInSeconds: 10,
+	}
+
+	t.Run("InvalidArgument: ExpiryInSeconds is not positive", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		_, err := newAPI(t, daprt.NewMockStore(ctl)).RenewLockAlpha1(t.Context(), &runtimev1pb.RenewLockRequest{
+			StoreName:  "mock",
+			ResourceId: "resource",
+			LockOwner:  "owner",
+		})
+		assert.Equal(t, "api error: code = InvalidArgument desc = ExpiryInSeconds is not positive in lock store mock", err.Error())
+	})
+
+	t.Run("lock store does not renew locks natively", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		// The lock is neither released nor acquired again.
+		resp, err := newAPI(t, daprt.NewMockStore(ctl)).RenewLockAlpha1(t.Context(), req)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		assert.Equal(t, runtimev1pb.RenewLockResponse_INTERNAL_ERROR, resp.GetStatus())
+		assert.Zero(t, resp.GetFencingToken())
+	})
+
+	t.Run("lock store renews locks natively", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+
+		store := &renewingLockStore{Store: daprt.NewMockStore(ctl)}
+		resp, err := newAPI(t, store).RenewLockAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.RenewLockResponse_SUCCESS, resp.GetStatus())
+		assert.Equal(t, int64(7), resp.GetFencingToken())
+		require.NotNil(t, store.renewed)
+		assert.Equal(t, "lock||resource", store.renewed.ResourceID)
+		assert.Equal(t, int32(10), store.renewed.ExpiryInSeconds)
+	})
+}
+
+type renewingLockStore struct {
+	lock.Store
+	renewed *lockLoader.RenewLockRequest
+}
+
+func (s *renewingLockStore) RenewLock(_ context.Context, req *lockLoader.RenewLockRequest) (*lockLoader.RenewLockResponse, error) {
+	s.renewed = req
+	return &lockLoader.RenewLockResponse{
+		Status:   lock.Success,
+		Metadata: map[string]string{lockLoader.FencingTokenMetadataKey: "7"},
+	}, nil
+}
+
 func TestMetadata(t *testing.T) {
 	compStore := compstore.New()
 	require.NoError(t, compStore.AddPendingComponentForCommit(componentsV1alpha1.Component{
@@ -4132,7 +4695,7 @@ func TestMetadata(t *testing.T) {
 			},
 		},
 	}))
-	require.NoError(t, compStore.CommitPendingComponent())
+	require.NoError(t, compStore.CommitPendingComponent("MockComponent1Name"))
 	require.NoError(t, compStore.AddPendingComponentForCommit(componentsV1alpha1.Component{
 		ObjectMeta: metaV1.ObjectMeta{
 			Name: "MockComponent2Name",
@@ -4150,7 +4713,7 @@ func TestMetadata(t *testing.T) {
 			},
 		},
 	}))
-	require.NoError(t, compStore.CommitPendingComponent())
+	require.NoError(t, compStore.CommitPendingComponent("MockComponent2Name"))
 	compStore.SetProgramaticSubscriptions(runtimePubsub.Subscription{
 		PubsubName:      "test",
 		Topic:           "topic",
@@ -4247,11 +4810,13 @@ func TestMetadata(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, "fakeAPI", res.GetId())
+		assert.NotEmpty(t, res.GetErrorCodes())
+		res.ErrorCodes = nil
 
 		bytes, err := json.Marshal(res)
 		require.NoError(t, err)
 
-		expectedResponse := `{"id":"fakeAPI","active_actors_count":[{"type":"abcd","count":10},{"type":"xyz","count":5}],"registered_components":[{"name":"MockComponent1Name","type":"mock.component1Type","version":"v1.0","capabilities":["mock.feat.MockComponent1Name"]},{"name":"MockComponent2Name","type":"mock.component2Type","version":"v1.0","capabilities":["mock.feat.MockComponent2Name"]}],"extended_metadata":{"daprRuntimeVersion":"edge","foo":"bar","test":"value"},"subscriptions":[{"pubsub_name":"test","topic":"topic","rules":{"rules":[{"path":"path"}]},"dead_letter_topic":"dead","type":2}],"http_endpoints":[{"name":"MockHTTPEndpoint"}],"app_connection_properties":{"port":5000,"protocol":"grpc","channel_address":"1.2.3.4","max_concurrency":10,"health":{"health_probe_interval":"10s","health_probe_timeout":"5s","health_threshold":3}},"runtime_version":"edge","actor_runtime":{"runtime_status":2,"active_actors":[{"type":"abcd","count":10},{"type":"xyz","count":5}],"host_ready":true},"workflows":{"connected_workers":1}}`
+		expectedResponse := `{"id":"fakeAPI","active_actors_count":[{"type":"abcd","count":10},{"type":"xyz","count":5}],"registered_components":[{"name":"MockComponent1Name","type":"mock.component1Type","version":"v1.0","capabilities":["mock.feat.MockComponent1Name"]},{"name":"MockComponent2Name","type":"mock.component2Type","version":"v1.0","capabilities":["mock.feat.MockComponent2Name"]}],"extended_metadata":{"daprRuntimeVersion":"edge","foo":"bar","test":"value"},"subscriptions":[{"pubsub_name":"test","topic":"topic","rules":{"rules":[{"path":"path"}]},"dead_letter_topic":"dead","type":2}],"http_endpoints":[{"name":"MockHTTPEndpoint"}],"app_connection_properties":{"port":5000,"protocol":"grpc","channel_address":"1.2.3.4","max_concurrency":10,"health":{"health_probe_interval":"10s","health_probe_timeout":"5s","health_threshold":3}},"runtime_version":"edge","actor_runtime":{"runtime_status":2,"active_actors":[{"type":"abcd","count":10},{"type":"xyz","count":5}],"host_ready":true},"workflows":{"connected_workers":1},"configuration":{"tracing":{},"metrics":{"enabled":true,"http_increased_cardinality":true},"api":{}}}`
 		assert.Equal(t, expectedResponse, string(bytes))
 	})
 }
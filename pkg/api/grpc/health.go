@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/healthz"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// healthServicePrefix is the prefix of the methods of the grpc.health.v1
+// health service.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// healthServer implements the grpc.health.v1 health service of the gRPC API
+// server. The sidecar, or the Dapr API service, is serving when all the
+// targets of the health checks of the sidecar are ready, as reported by the
+// HTTP healthz endpoint.
+type healthServer struct {
+	healthv1.UnimplementedHealthServer
+
+	healthz healthz.Healthz
+}
+
+func newHealthServer(h healthz.Healthz) *healthServer {
+	return &healthServer{healthz: h}
+}
+
+func (h *healthServer) Check(ctx context.Context, in *healthv1.HealthCheckRequest) (*healthv1.HealthCheckResponse, error) {
+	switch in.GetService() {
+	case "", runtimev1pb.Dapr_ServiceDesc.ServiceName:
+	default:
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", in.GetService())
+	}
+
+	return &healthv1.HealthCheckResponse{Status: h.status()}, nil
+}
+
+func (h *healthServer) List(ctx context.Context, in *healthv1.HealthListRequest) (*healthv1.HealthListResponse, error) {
+	res := &healthv1.HealthCheckResponse{Status: h.status()}
+	return &healthv1.HealthListResponse{
+		Statuses: map[string]*healthv1.HealthCheckResponse{
+			"":                                       res,
+			runtimev1pb.Dapr_ServiceDesc.ServiceName: res,
+		},
+	}, nil
+}
+
+func (h *healthServer) status() healthv1.HealthCheckResponse_ServingStatus {
+	if !h.healthz.IsReady() {
+		return healthv1.HealthCheckResponse_NOT_SERVING
+	}
+	return healthv1.HealthCheckResponse_SERVING
+}
+
+// isHealthServiceMethod returns true if the method is a method of the
+// grpc.health.v1 health service.
+func isHealthServiceMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, healthServicePrefix)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/dapr/dapr/pkg/api/apitoken"
+	"github.com/dapr/dapr/pkg/healthz"
+)
+
+func TestHealthServer(t *testing.T) {
+	h := healthz.New()
+	target := h.AddTarget("test")
+
+	unary, stream := getAPIAuthenticationMiddlewares(apitoken.New(apitoken.Options{Token: "token"}), "dapr-api-token", true)
+	server := grpc.NewServer(grpc.UnaryInterceptor(unary), grpc.StreamInterceptor(stream))
+	healthv1.RegisterHealthServer(server, newHealthServer(h))
+
+	lis := bufconn.Listen(bufconnBufSize)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	client := healthv1.NewHealthClient(conn)
+
+	check := func(t *testing.T, service string) healthv1.HealthCheckResponse_ServingStatus {
+		t.Helper()
+		// The health service doesn't require the API token.
+		resp, err := client.Check(t.Context(), &healthv1.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return resp.GetStatus()
+	}
+
+	t.Run("not serving while the sidecar is not ready", func(t *testing.T) {
+		assert.Equal(t, healthv1.HealthCheckResponse_NOT_SERVING, check(t, ""))
+	})
+
+	target.Ready()
+
+	t.Run("serving when the sidecar is ready", func(t *testing.T) {
+		assert.Equal(t, healthv1.HealthCheckResponse_SERVING, check(t, ""))
+		assert.Equal(t, healthv1.HealthCheckResponse_SERVING, check(t, "dapr.proto.runtime.v1.Dapr"))
+
+		resp, err := client.List(t.Context(), &healthv1.HealthListRequest{})
+		require.NoError(t, err)
+		assert.Len(t, resp.GetStatuses(), 2)
+	})
+
+	t.Run("unknown service", func(t *testing.T) {
+		_, err := client.Check(t.Context(), &healthv1.HealthCheckRequest{Service: "foo"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestIsHealthServiceMethod(t *testing.T) {
+	assert.True(t, isHealthServiceMethod("/grpc.health.v1.Health/Check"))
+	assert.True(t, isHealthServiceMethod("/grpc.health.v1.Health/Watch"))
+	assert.False(t, isHealthServiceMethod("/dapr.proto.runtime.v1.Dapr/GetState"))
+	assert.False(t, isHealthServiceMethod("/grpc.health.v1.HealthX/Check"))
+}
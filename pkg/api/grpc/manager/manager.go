@@ -30,7 +30,9 @@ import (
 	grpcKeepalive "google.golang.org/grpc/keepalive"
 	md "google.golang.org/grpc/metadata"
 
+	"github.com/dapr/dapr/pkg/api/grpc/quic"
 	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/channel/concurrency"
 	grpcChannel "github.com/dapr/dapr/pkg/channel/grpc"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -59,6 +61,9 @@ type AppChannelConfig struct {
 	ReadBufferSize     int // In bytes
 	BaseAddress        string
 	AppAPIToken        string
+	// Limiter limits the requests in flight toward the app instead of
+	// MaxConcurrency, if set.
+	Limiter *concurrency.Limiter
 }
 
 // Manager is a wrapper around gRPC connection pooling.
@@ -72,6 +77,8 @@ type Manager struct {
 	wg            sync.WaitGroup
 	closed        atomic.Bool
 	closeCh       chan struct{}
+	quicPeers     *quicPeers
+	socketDir     string
 }
 
 // NewManager returns a new grpc manager.
@@ -86,6 +93,18 @@ func NewManager(sec security.Handler, mode modes.DaprMode, channelConfig *AppCha
 	}
 }
 
+// EnableQUIC makes the connections to remote daprd sidecars use QUIC for the
+// sidecars which advertise a QUIC transport, falling back to TCP.
+func (g *Manager) EnableQUIC() {
+	g.quicPeers = newQUICPeers()
+}
+
+// SetConnectionPoolSpec configures the pools of connections to remote daprd
+// sidecars. It must be called before the manager is used.
+func (g *Manager) SetConnectionPoolSpec(spec config.ConnectionPoolSpec) {
+	g.remoteConns.SetOptions(spec)
+}
+
 // GetAppChannel returns a connection to the local channel.
 // If there's no active connection to the app, it creates one.
 func (g *Manager) GetAppChannel() (channel.AppChannel, error) {
@@ -110,6 +129,9 @@ func (g *Manager) GetAppChannel() (channel.AppChannel, error) {
 		g.channelConfig.BaseAddress,
 		g.channelConfig.AppAPIToken,
 	)
+	if g.channelConfig.Limiter != nil {
+		ch.SetLimiter(g.channelConfig.Limiter)
+	}
 	return ch, nil
 }
 
@@ -220,6 +242,15 @@ func (g *Manager) connectRemote(
 		)
 	}
 
+	if g.quicPeers != nil || g.socketDir != "" {
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, dialAddr string) (net.Conn, error) {
+			return g.dialRemote(ctx, address, dialAddr)
+		}))
+	}
+	if g.quicPeers != nil {
+		opts = append(opts, g.quicInterceptors(address)...)
+	}
+
 	opts = append(opts, customOpts...)
 
 	dialPrefix := GetDialAddressPrefix(g.mode)
@@ -234,6 +265,27 @@ func (g *Manager) connectRemote(
 	return conn, nil
 }
 
+// dialRemote connects to the daprd sidecar at address which resolved to
+// dialAddr, over the UNIX domain socket of the sidecar if it runs on the node,
+// over QUIC if the sidecar advertised it, and over TCP otherwise.
+func (g *Manager) dialRemote(ctx context.Context, address string, dialAddr string) (net.Conn, error) {
+	if conn, ok := g.dialSocket(ctx, dialAddr); ok {
+		return conn, nil
+	}
+	if g.quicPeers != nil {
+		if quicAddr, ok := g.quicPeers.dialAddress(address, dialAddr); ok {
+			conn, err := quic.Dial(ctx, quicAddr)
+			if err == nil {
+				return conn, nil
+			}
+			log.Warnf("Failed to connect to %s over QUIC, falling back to TCP: %v", quicAddr, err)
+			g.quicPeers.fallback(address)
+		}
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", dialAddr)
+}
+
 func (g *Manager) connTeardownFactory(address string, conn *grpc.ClientConn) func(destroy bool) {
 	return func(destroy bool) {
 		if destroy {
@@ -258,7 +310,9 @@ func (g *Manager) StartCollector() {
 				return
 			case <-t.C:
 				g.localConn.Purge()
+				g.remoteConns.Rebalance(context.Background())
 				g.remoteConns.Purge()
+				g.remoteConns.RecordMetrics()
 			}
 		}
 	})
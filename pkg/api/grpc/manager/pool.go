@@ -14,12 +14,19 @@ limitations under the License.
 package manager
 
 import (
+	"context"
+	"net"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
 	kclock "k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 )
 
 // Real-time clock (wrapper around time.Time) to allow mocking
@@ -37,6 +44,12 @@ type ConnectionPool struct {
 	// Minimum number of active connections to keep
 	minActiveConns int
 
+	// Maximum number of connections, or 0 if there's no limit
+	maxConns int
+
+	// Number of concurrent streams above which another connection is created
+	maxConcurrentStreams int32
+
 	connections []*connectionPoolConnection
 	lock        sync.RWMutex
 }
@@ -44,8 +57,21 @@ type ConnectionPool struct {
 // NewConnectionPool creates a new ConnectionPool object.
 func NewConnectionPool(maxConnIdle time.Duration, minActiveConns int) *ConnectionPool {
 	return &ConnectionPool{
-		maxConnIdle:    maxConnIdle,
-		minActiveConns: minActiveConns,
+		maxConnIdle:          maxConnIdle,
+		minActiveConns:       minActiveConns,
+		maxConcurrentStreams: grpcMaxConcurrentStreams,
+	}
+}
+
+// SetLimits sets the maximum number of connections in the pool, with 0 meaning no limit, and the number of concurrent streams on a connection above which another connection is created.
+// Zero or negative values of maxConcurrentStreams leave the default of 100.
+func (p *ConnectionPool) SetLimits(maxConns int, maxConcurrentStreams int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.maxConns = maxConns
+	if maxConcurrentStreams > 0 {
+		p.maxConcurrentStreams = int32(maxConcurrentStreams) //nolint:gosec
 	}
 }
 
@@ -69,6 +95,14 @@ func (p *ConnectionPool) Get(createFn func() (grpc.ClientConnInterface, error))
 		return conn, nil
 	}
 
+	// If the pool is full, share the least loaded connection even if it's above the limit of streams
+	if p.maxConns > 0 && p.doCountActive() >= p.maxConns {
+		conn = p.doShareLeastLoaded()
+		if conn != nil {
+			return conn, nil
+		}
+	}
+
 	// Create a connection using createFn
 	newConn, err := createFn()
 	if err != nil {
@@ -121,30 +155,85 @@ func (p *ConnectionPool) Share() grpc.ClientConnInterface {
 // doShare performs the sharing of the connection from the pool, incrementing its reference count.
 // This needs to be wrapped in a (read/write) lock.
 func (p *ConnectionPool) doShare() grpc.ClientConnInterface {
-	// If there's more than 1 connection, grab the first one whose reference count is less or equal than 100 (grpcMaxConcurrentStreams)
+	// If there's more than 1 connection, grab the first one whose reference count is less or equal than maxConcurrentStreams
 	for i := range len(p.connections) {
 		// Check if the connection is still valid first
-		// First we check if the referenceCount is 0, and then we check if the connection has expired
+		// First we check if the connection is draining or the referenceCount is 0, and then we check if the connection has expired
 		// This should be safe for concurrent use
-		if atomic.LoadInt32(&p.connections[i].referenceCount) == 0 && p.connections[i].Expired(p.maxConnIdle) {
+		if !p.connections[i].usable(p.maxConnIdle) {
 			continue
 		}
 
 		// Increment the reference counter to signal that we're using the connection
 		count := atomic.AddInt32(&p.connections[i].referenceCount, 1)
 
-		// If the reference count is less (or equal) than 100, we can use this connection
-		if count <= grpcMaxConcurrentStreams {
+		// If the reference count is less (or equal) than maxConcurrentStreams, we can use this connection
+		if count <= p.maxConcurrentStreams {
 			return p.connections[i].conn
 		}
 
 		atomic.AddInt32(&p.connections[i].referenceCount, -1)
 	}
 
-	// Could not find a connection with less than 100 active streams, so return nil
+	// Could not find a connection with less than maxConcurrentStreams active streams, so return nil
 	return nil
 }
 
+// doShareLeastLoaded shares the usable connection with the lowest reference count, whatever its count.
+// This needs to be wrapped in a write lock.
+func (p *ConnectionPool) doShareLeastLoaded() grpc.ClientConnInterface {
+	var least *connectionPoolConnection
+	for _, el := range p.connections {
+		if !el.usable(p.maxConnIdle) {
+			continue
+		}
+		if least == nil || atomic.LoadInt32(&el.referenceCount) < atomic.LoadInt32(&least.referenceCount) {
+			least = el
+		}
+	}
+	if least == nil {
+		return nil
+	}
+	atomic.AddInt32(&least.referenceCount, 1)
+	return least.conn
+}
+
+// doCountActive returns the number of connections which can still be shared.
+// This needs to be wrapped in a (read/write) lock.
+func (p *ConnectionPool) doCountActive() int {
+	n := 0
+	for _, el := range p.connections {
+		if el.usable(p.maxConnIdle) {
+			n++
+		}
+	}
+	return n
+}
+
+// Stats returns the number of connections in the pool and the number of streams active on them.
+func (p *ConnectionPool) Stats() (connections int, streams int) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	for _, el := range p.connections {
+		if count := atomic.LoadInt32(&el.referenceCount); count > 0 {
+			streams += int(count)
+		}
+	}
+	return len(p.connections), streams
+}
+
+// Drain stops sharing the connections currently in the pool, so new streams use new connections.
+// The drained connections are closed by Purge once they are no longer in use.
+func (p *ConnectionPool) Drain() {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	for _, el := range p.connections {
+		el.draining.Store(true)
+	}
+}
+
 // Release is called when the method has finished using the connection.
 // This decrements the reference counter for the connection.
 func (p *ConnectionPool) Release(conn grpc.ClientConnInterface) {
@@ -210,10 +299,10 @@ func (p *ConnectionPool) Purge() {
 	for i := 0; i < len(p.connections); i++ {
 		el := p.connections[i]
 
-		// If the connection has no use and the last usage was more than maxConnIdle ago, then close it and filter it out
+		// If the connection has no use and the last usage was more than maxConnIdle ago, or it is draining, then close it and filter it out
 		// Ok to load the reference count non-atomically because we have a write lock
-		// Also, don't remove connections until we reach minActiveConns
-		if i >= p.minActiveConns && el.referenceCount <= 0 && el.Expired(p.maxConnIdle) {
+		// Also, don't remove idle connections until we reach minActiveConns
+		if el.referenceCount <= 0 && (el.draining.Load() || (i >= p.minActiveConns && el.Expired(p.maxConnIdle))) {
 			if closer, ok := el.conn.(interface{ Close() error }); ok {
 				_ = closer.Close()
 			}
@@ -231,6 +320,15 @@ type connectionPoolConnection struct {
 	conn           grpc.ClientConnInterface
 	referenceCount int32
 	idleSince      atomic.Pointer[time.Time]
+	draining       atomic.Bool
+}
+
+// usable returns true if new streams can use the connection.
+func (pic *connectionPoolConnection) usable(maxConnIdle time.Duration) bool {
+	if pic.draining.Load() {
+		return false
+	}
+	return atomic.LoadInt32(&pic.referenceCount) != 0 || !pic.Expired(maxConnIdle)
 }
 
 // Expired returns true if the connection has Expired and should not be used.
@@ -247,13 +345,37 @@ func (pic *connectionPoolConnection) MarkIdle() {
 // RemoteConnectionPool is used to hold connections to remote addresses.
 type RemoteConnectionPool struct {
 	pool sync.Map
+
+	// Options of the pools of each address
+	maxConnIdle          time.Duration
+	maxConns             int
+	maxConcurrentStreams int
+
+	// If set, the connections to a host name are drained when the addresses it resolves to change
+	rebalanceOnDNSChange bool
+	lookupHost           func(ctx context.Context, host string) ([]string, error)
+	resolved             map[string]string
 }
 
 // NewRemoteConnectionPool creates a new RemoteConnectionPool object.
 func NewRemoteConnectionPool() *RemoteConnectionPool {
 	return &RemoteConnectionPool{
-		pool: sync.Map{},
+		pool:        sync.Map{},
+		maxConnIdle: maxConnIdle,
+		lookupHost:  net.DefaultResolver.LookupHost,
+		resolved:    make(map[string]string),
+	}
+}
+
+// SetOptions configures the pools of the addresses with the given spec.
+// It must be called before the pool is used.
+func (p *RemoteConnectionPool) SetOptions(spec config.ConnectionPoolSpec) {
+	if spec.IdleTimeout != nil && *spec.IdleTimeout > 0 {
+		p.maxConnIdle = *spec.IdleTimeout
 	}
+	p.maxConns = spec.MaxConnections
+	p.maxConcurrentStreams = spec.MaxConcurrentStreams
+	p.rebalanceOnDNSChange = spec.RebalanceOnDNSChange
 }
 
 // Get takes a connection from the pool or, if no connection exists, creates a new one using createFn, then stores it and returns it.
@@ -306,11 +428,55 @@ func (p *RemoteConnectionPool) Purge() {
 	})
 }
 
+// RecordMetrics records the number of connections and active streams of the pool of each address.
+func (p *RemoteConnectionPool) RecordMetrics() {
+	p.pool.Range(func(address any, item any) bool {
+		connections, streams := item.(*ConnectionPool).Stats()
+		diag.DefaultMonitoring.ServiceInvocationConnectionPool(address.(string), connections, streams)
+		return true
+	})
+}
+
+// Rebalance resolves the host names of the addresses in the pool and drains the connections to those whose resolved addresses changed since the last call.
+// It does nothing unless rebalancing on DNS changes is enabled.
+// Note that this method should not be called by multiple goroutines at the same time.
+func (p *RemoteConnectionPool) Rebalance(ctx context.Context) {
+	if !p.rebalanceOnDNSChange {
+		return
+	}
+
+	p.pool.Range(func(key any, item any) bool {
+		address := key.(string)
+		host, _, err := net.SplitHostPort(address)
+		if err != nil || net.ParseIP(host) != nil {
+			return true
+		}
+
+		addrs, err := p.lookupHost(ctx, host)
+		if err != nil {
+			log.Debugf("Failed to resolve %s to rebalance its connections: %v", host, err)
+			return true
+		}
+		slices.Sort(addrs)
+		resolved := strings.Join(addrs, ",")
+
+		prev, ok := p.resolved[address]
+		p.resolved[address] = resolved
+		if ok && prev != resolved {
+			log.Infof("Addresses of %s changed, draining its connections", host)
+			item.(*ConnectionPool).Drain()
+		}
+		return true
+	})
+}
+
 func (p *RemoteConnectionPool) loadOrStoreItem(address string) *ConnectionPool {
 	item, ok := p.pool.Load(address)
 	if !ok {
+		pool := NewConnectionPool(p.maxConnIdle, 0)
+		pool.SetLimits(p.maxConns, p.maxConcurrentStreams)
 		// Use LoadOrStore here in case another goroutine is in the exact same spot
-		item, _ = p.pool.LoadOrStore(address, NewConnectionPool(maxConnIdle, 0))
+		item, _ = p.pool.LoadOrStore(address, pool)
 	}
 	return item.(*ConnectionPool)
 }
@@ -24,6 +24,8 @@ import (
 	"google.golang.org/grpc"
 	kclock "k8s.io/utils/clock"
 	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/config"
 )
 
 func TestConnectionPoolConnection(t *testing.T) {
@@ -356,6 +358,88 @@ func TestConnectionPool(t *testing.T) {
 	t.Run("expired connection with 1 min", testExpiredConn(1))
 }
 
+func TestConnectionPoolLimits(t *testing.T) {
+	cp := NewConnectionPool(10*time.Second, 0)
+	cp.SetLimits(2, 2)
+
+	var created []*mockConnection
+	createFn := func() (grpc.ClientConnInterface, error) {
+		conn := &mockConnection{}
+		created = append(created, conn)
+		return conn, nil
+	}
+
+	// The first 4 streams use 2 connections with 2 streams each
+	for range 4 {
+		_, err := cp.Get(createFn)
+		require.NoError(t, err)
+	}
+	require.Len(t, created, 2)
+
+	// Once the pool is full, the least loaded connection is shared
+	cp.Release(created[1])
+	conn, err := cp.Get(createFn)
+	require.NoError(t, err)
+	assert.Equal(t, created[1], conn)
+	conn, err = cp.Get(createFn)
+	require.NoError(t, err)
+	require.Len(t, created, 2)
+
+	connections, streams := cp.Stats()
+	assert.Equal(t, 2, connections)
+	assert.Equal(t, 5, streams)
+	cp.Release(conn)
+}
+
+func TestConnectionPoolDrain(t *testing.T) {
+	cp := NewConnectionPool(10*time.Second, 1)
+	first := &mockConnection{}
+	cp.Register(first)
+
+	conn := cp.Share()
+	require.Equal(t, first, conn)
+
+	// Draining connections are no longer shared, but are kept while in use
+	cp.Drain()
+	assert.Nil(t, cp.Share())
+	cp.Purge()
+	require.Len(t, cp.connections, 1)
+	assert.False(t, first.Closed)
+
+	cp.Release(conn)
+	cp.Purge()
+	require.Empty(t, cp.connections)
+	assert.True(t, first.Closed)
+}
+
+func TestRemoteConnectionPoolRebalance(t *testing.T) {
+	p := NewRemoteConnectionPool()
+	p.SetOptions(config.ConnectionPoolSpec{RebalanceOnDNSChange: true})
+
+	addrs := []string{"10.0.0.1"}
+	p.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		return addrs, nil
+	}
+
+	byName := &mockConnection{}
+	byIP := &mockConnection{}
+	p.Register("myapp:50002", byName)
+	p.Register("10.0.0.1:50002", byIP)
+
+	p.Rebalance(t.Context())
+	p.Purge()
+	assert.Equal(t, byName, p.Share("myapp:50002"))
+	p.Release("myapp:50002", byName)
+
+	addrs = []string{"10.0.0.2", "10.0.0.1"}
+	p.Rebalance(t.Context())
+	assert.Nil(t, p.Share("myapp:50002"))
+	assert.Equal(t, byIP, p.Share("10.0.0.1:50002"))
+	p.Purge()
+	assert.True(t, byName.Closed)
+	assert.False(t, byIP.Closed)
+}
+
 // Mock that implements grpc.ClientConnInterface and the Close method
 type mockConnection struct {
 	Closed bool
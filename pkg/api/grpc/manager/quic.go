@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	md "google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/api/grpc/quic"
+	"github.com/dapr/kit/logger"
+)
+
+// quicFallbackInterval is the time during which connections to a peer use TCP
+// after a QUIC connection to it could not be established.
+const quicFallbackInterval = 5 * time.Minute
+
+var log = logger.NewLogger("dapr.runtime.grpc.manager")
+
+// quicPeers records the remote addresses whose internal gRPC server advertised
+// a QUIC transport, keyed by the address of the connection pool.
+type quicPeers struct {
+	lock  sync.RWMutex
+	peers map[string]*quicPeer
+}
+
+type quicPeer struct {
+	port          string
+	fallbackUntil time.Time
+}
+
+func newQUICPeers() *quicPeers {
+	return &quicPeers{peers: make(map[string]*quicPeer)}
+}
+
+// known returns true if the peer at address advertised a QUIC transport.
+func (p *quicPeers) known(address string) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	_, ok := p.peers[address]
+	return ok
+}
+
+func (p *quicPeers) advertise(address string, port string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if peer, ok := p.peers[address]; ok {
+		peer.port = port
+		return
+	}
+	p.peers[address] = &quicPeer{port: port}
+}
+
+// dialAddress returns the UDP address of the QUIC transport of the peer at
+// address which resolved to dialAddr, if QUIC is to be used for it.
+func (p *quicPeers) dialAddress(address string, dialAddr string) (string, bool) {
+	p.lock.RLock()
+	peer, ok := p.peers[address]
+	p.lock.RUnlock()
+	if !ok || clock.Now().Before(peer.fallbackUntil) {
+		return "", false
+	}
+	host, _, err := net.SplitHostPort(dialAddr)
+	if err != nil {
+		return "", false
+	}
+	return net.JoinHostPort(host, peer.port), true
+}
+
+func (p *quicPeers) fallback(address string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if peer, ok := p.peers[address]; ok {
+		peer.fallbackUntil = clock.Now().Add(quicFallbackInterval)
+	}
+}
+
+// quicInterceptors returns the interceptors of the connections to the peer
+// at address, which record whether the peer advertised a QUIC transport.
+// Connections use QUIC once the peer advertised it and TCP otherwise.
+func (g *Manager) quicInterceptors(address string) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if g.quicPeers.known(address) {
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}
+			var header md.MD
+			err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+			g.quicAdvertised(address, header)
+			return err
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			stream, err := streamer(ctx, desc, cc, method, opts...)
+			if err != nil || g.quicPeers.known(address) {
+				return stream, err
+			}
+			return &quicAdvertisedStream{ClientStream: stream, advertised: func(header md.MD) {
+				g.quicAdvertised(address, header)
+			}}, nil
+		}),
+	}
+}
+
+func (g *Manager) quicAdvertised(address string, header md.MD) {
+	if port := header.Get(quic.PortHeader); len(port) > 0 && port[0] != "" {
+		g.quicPeers.advertise(address, port[0])
+	}
+}
+
+// quicAdvertisedStream reads the QUIC advertisement in the header of the
+// stream once its first message was received.
+type quicAdvertisedStream struct {
+	grpc.ClientStream
+	advertised func(md.MD)
+	once       sync.Once
+}
+
+func (s *quicAdvertisedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil {
+		s.once.Do(func() {
+			if header, herr := s.ClientStream.Header(); herr == nil {
+				s.advertised(header)
+			}
+		})
+	}
+	return err
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	md "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/dapr/dapr/pkg/api/grpc/quic"
+	"github.com/dapr/dapr/pkg/modes"
+	securityfake "github.com/dapr/dapr/pkg/security/fake"
+)
+
+func TestQUICPeers(t *testing.T) {
+	p := newQUICPeers()
+
+	_, ok := p.dialAddress("app:50002", "10.0.0.1:50002")
+	assert.False(t, ok)
+	assert.False(t, p.known("app:50002"))
+
+	p.advertise("app:50002", "50003")
+	assert.True(t, p.known("app:50002"))
+	addr, ok := p.dialAddress("app:50002", "10.0.0.1:50002")
+	assert.True(t, ok)
+	assert.Equal(t, "10.0.0.1:50003", addr)
+
+	p.fallback("app:50002")
+	_, ok = p.dialAddress("app:50002", "10.0.0.1:50002")
+	assert.False(t, ok)
+	assert.True(t, p.known("app:50002"))
+}
+
+func TestConnectRemoteQUIC(t *testing.T) {
+	// serve starts a health server on TCP and, with QUIC, on the same UDP
+	// port. The server advertises QUIC in any case, and records the network
+	// of the calls.
+	serve := func(t *testing.T, withQUIC bool) (string, <-chan string) {
+		t.Helper()
+
+		tcp, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		_, tcpPort, err := net.SplitHostPort(tcp.Addr().String())
+		require.NoError(t, err)
+
+		networks := make(chan string, 10)
+		srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			p, _ := peer.FromContext(ctx)
+			networks <- p.Addr.Network()
+			_ = grpc.SetHeader(ctx, md.Pairs(quic.PortHeader, tcpPort))
+			return handler(ctx, req)
+		}))
+		healthpb.RegisterHealthServer(srv, health.NewServer())
+		go srv.Serve(tcp)
+		if withQUIC {
+			udp, err := quic.Listen(tcp.Addr().String())
+			require.NoError(t, err)
+			go srv.Serve(udp)
+		}
+		t.Cleanup(srv.Stop)
+
+		return tcp.Addr().String(), networks
+	}
+
+	check := func(t *testing.T, m *Manager, address string) {
+		t.Helper()
+		conn, teardown, err := m.GetGRPCConnection(t.Context(), address, "app", "default")
+		require.NoError(t, err)
+		_, err = healthpb.NewHealthClient(conn).Check(t.Context(), new(healthpb.HealthCheckRequest))
+		require.NoError(t, err)
+		teardown(true)
+	}
+
+	t.Run("connections use QUIC once advertised", func(t *testing.T) {
+		address, networks := serve(t, true)
+		m := NewManager(securityfake.New(), modes.StandaloneMode, &AppChannelConfig{})
+		m.EnableQUIC()
+
+		check(t, m, address)
+		assert.Equal(t, "tcp", <-networks)
+		assert.True(t, m.quicPeers.known(address))
+
+		check(t, m, address)
+		assert.Equal(t, "udp", <-networks)
+	})
+
+	t.Run("connections fall back to TCP if QUIC is unreachable", func(t *testing.T) {
+		address, networks := serve(t, false)
+		m := NewManager(securityfake.New(), modes.StandaloneMode, &AppChannelConfig{})
+		m.EnableQUIC()
+
+		check(t, m, address)
+		assert.Equal(t, "tcp", <-networks)
+
+		check(t, m, address)
+		assert.Equal(t, "tcp", <-networks)
+		_, ok := m.quicPeers.dialAddress(address, address)
+		assert.False(t, ok)
+	})
+
+	t.Run("connections use TCP without QUIC enabled", func(t *testing.T) {
+		address, networks := serve(t, true)
+		m := NewManager(securityfake.New(), modes.StandaloneMode, &AppChannelConfig{})
+
+		check(t, m, address)
+		check(t, m, address)
+		assert.Equal(t, "tcp", <-networks)
+		assert.Equal(t, "tcp", <-networks)
+	})
+}
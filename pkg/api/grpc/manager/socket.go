@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+)
+
+// InternalSocketPath returns the path of the UNIX domain socket in dir on
+// which the internal gRPC server advertised at address listens.
+func InternalSocketPath(dir string, address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		host, port = address, ""
+	}
+	return fmt.Sprintf("%s/dapr-internal-%s-%s.socket", dir, host, port)
+}
+
+// EnableInternalSocket makes the connections to the daprd sidecars which
+// listen on a UNIX domain socket in dir, the directory shared by the sidecars
+// running on the node, use the socket rather than TCP. The connections are
+// still authenticated with mTLS.
+func (g *Manager) EnableInternalSocket(dir string) {
+	g.socketDir = dir
+}
+
+// dialSocket connects to the UNIX domain socket of the internal gRPC server
+// at dialAddr, if the server is running on the node.
+func (g *Manager) dialSocket(ctx context.Context, dialAddr string) (net.Conn, bool) {
+	if g.socketDir == "" {
+		return nil, false
+	}
+	socket := InternalSocketPath(g.socketDir, dialAddr)
+	if _, err := os.Stat(socket); err != nil {
+		return nil, false
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", socket)
+	if err != nil {
+		log.Warnf("Failed to connect to %s over UNIX domain socket %s, falling back to the network: %v", dialAddr, socket, err)
+		return nil, false
+	}
+	return conn, true
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+
+	"github.com/dapr/dapr/pkg/modes"
+	securityfake "github.com/dapr/dapr/pkg/security/fake"
+)
+
+func TestInternalSocketPath(t *testing.T) {
+	assert.Equal(t, "/tmp/dapr-internal-10.0.0.1-50002.socket", InternalSocketPath("/tmp", "10.0.0.1:50002"))
+	assert.Equal(t, "/tmp/dapr-internal-::1-50002.socket", InternalSocketPath("/tmp", "[::1]:50002"))
+}
+
+func TestConnectRemoteSocket(t *testing.T) {
+	// serve starts a health server on TCP and, with the socket, on the UNIX
+	// domain socket of the TCP address in dir. The server records the network
+	// of the calls.
+	serve := func(t *testing.T, dir string, withSocket bool) (string, <-chan string) {
+		t.Helper()
+
+		tcp, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		networks := make(chan string, 10)
+		srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			p, _ := peer.FromContext(ctx)
+			networks <- p.Addr.Network()
+			return handler(ctx, req)
+		}))
+		healthpb.RegisterHealthServer(srv, health.NewServer())
+		go srv.Serve(tcp)
+		if withSocket {
+			uds, err := net.Listen("unix", InternalSocketPath(dir, tcp.Addr().String()))
+			require.NoError(t, err)
+			go srv.Serve(uds)
+		}
+		t.Cleanup(srv.Stop)
+
+		return tcp.Addr().String(), networks
+	}
+
+	check := func(t *testing.T, m *Manager, address string) {
+		t.Helper()
+		conn, teardown, err := m.GetGRPCConnection(t.Context(), address, "app", "default")
+		require.NoError(t, err)
+		_, err = healthpb.NewHealthClient(conn).Check(t.Context(), new(healthpb.HealthCheckRequest))
+		require.NoError(t, err)
+		teardown(true)
+	}
+
+	t.Run("connections use the socket of the sidecars on the node", func(t *testing.T) {
+		dir := socketDir(t)
+		address, networks := serve(t, dir, true)
+		m := NewManager(securityfake.New(), modes.StandaloneMode, &AppChannelConfig{})
+		m.EnableInternalSocket(dir)
+
+		check(t, m, address)
+		assert.Equal(t, "unix", <-networks)
+	})
+
+	t.Run("connections use TCP without a socket", func(t *testing.T) {
+		dir := socketDir(t)
+		address, networks := serve(t, dir, false)
+		m := NewManager(securityfake.New(), modes.StandaloneMode, &AppChannelConfig{})
+		m.EnableInternalSocket(dir)
+
+		check(t, m, address)
+		assert.Equal(t, "tcp", <-networks)
+	})
+
+	t.Run("connections fall back to TCP if the socket is stale", func(t *testing.T) {
+		dir := socketDir(t)
+		address, networks := serve(t, dir, false)
+		require.NoError(t, os.WriteFile(InternalSocketPath(dir, address), nil, 0o600))
+		m := NewManager(securityfake.New(), modes.StandaloneMode, &AppChannelConfig{})
+		m.EnableInternalSocket(dir)
+
+		check(t, m, address)
+		assert.Equal(t, "tcp", <-networks)
+	})
+
+	t.Run("connections use TCP without the socket enabled", func(t *testing.T) {
+		dir := socketDir(t)
+		address, networks := serve(t, dir, true)
+		m := NewManager(securityfake.New(), modes.StandaloneMode, &AppChannelConfig{})
+
+		check(t, m, address)
+		assert.Equal(t, "tcp", <-networks)
+	})
+}
+
+// socketDir returns a temporary directory with a short path, as the paths of
+// UNIX domain sockets are limited in length.
+func socketDir(t *testing.T) string {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "dapr")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
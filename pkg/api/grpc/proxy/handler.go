@@ -105,7 +105,8 @@ func (s *handler) handler(srv any, serverStream grpc.ServerStream) error {
 	if len(v) == 0 || s.getPolicyFn == nil {
 		policyDef = resiliency.NoOp{}.EndpointPolicy("", "")
 	} else {
-		policyDef = s.getPolicyFn(ctx, v[0], fullMethodName)
+		// Proxied calls are forwarded to a single target as they arrive, so they cannot be hedged.
+		policyDef = s.getPolicyFn(ctx, v[0], fullMethodName).WithoutHedging()
 		grpcDestinationAppID = v[0]
 	}
 
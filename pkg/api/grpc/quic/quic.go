@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quic implements a QUIC transport for the gRPC connections between
+// daprd sidecars. Each QUIC connection carries a single gRPC (HTTP/2)
+// connection on its first stream, so the gRPC credentials, including mTLS,
+// are unchanged and continue to authenticate the peers.
+package quic
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	quicgo "github.com/quic-go/quic-go"
+
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// PortHeader is the header with which the internal gRPC server of a daprd
+	// advertises the UDP port of its QUIC transport.
+	PortHeader = "dapr-quic-port"
+
+	// alpn is the application protocol negotiated in the QUIC handshake.
+	alpn = "dapr-grpc"
+
+	// handshakeTimeout is the time after which a QUIC connection which did not
+	// complete the handshake or open its stream is abandoned.
+	handshakeTimeout = 5 * time.Second
+)
+
+var log = logger.NewLogger("dapr.runtime.grpc.quic")
+
+var config = &quicgo.Config{
+	HandshakeIdleTimeout: handshakeTimeout,
+	// Ping the peer every 10s, like the gRPC keepalives do, as UDP flows are
+	// otherwise dropped by NATs and load balancers.
+	KeepAlivePeriod: 10 * time.Second,
+}
+
+// Listener is a net.Listener which accepts QUIC connections.
+type Listener struct {
+	ln     *quicgo.Listener
+	conns  chan net.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Listen announces the QUIC transport on the UDP address addr.
+func Listen(addr string) (*Listener, error) {
+	cert, err := selfSignedCertificate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create QUIC certificate: %w", err)
+	}
+
+	ln, err := quicgo.ListenAddr(addr, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{alpn},
+		MinVersion:   tls.VersionTLS13,
+	}, config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &Listener{
+		ln:     ln,
+		conns:  make(chan net.Conn),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	l.wg.Go(l.run)
+	return l, nil
+}
+
+func (l *Listener) run() {
+	for {
+		conn, err := l.ln.Accept(l.ctx)
+		if err != nil {
+			return
+		}
+		l.wg.Go(func() {
+			ctx, cancel := context.WithTimeout(l.ctx, handshakeTimeout)
+			stream, err := conn.AcceptStream(ctx)
+			cancel()
+			if err != nil {
+				log.Debugf("Failed to accept stream of QUIC connection from %s: %v", conn.RemoteAddr(), err)
+				_ = conn.CloseWithError(0, "")
+				return
+			}
+			select {
+			case l.conns <- &streamConn{Stream: stream, conn: conn}:
+			case <-l.ctx.Done():
+				_ = conn.CloseWithError(0, "")
+			}
+		})
+	}
+}
+
+// Accept waits for and returns the next connection to the listener.
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+// Close closes the listener and the connections which were not yet accepted.
+func (l *Listener) Close() error {
+	l.cancel()
+	err := l.ln.Close()
+	l.wg.Wait()
+	return err
+}
+
+// Addr returns the UDP address of the listener.
+func (l *Listener) Addr() net.Addr {
+	return l.ln.Addr()
+}
+
+// Dial connects to the QUIC transport on the UDP address addr.
+// The certificate of the QUIC handshake is not verified: the peer is
+// authenticated by the gRPC credentials of the connection.
+func Dial(ctx context.Context, addr string) (net.Conn, error) {
+	//nolint:gosec
+	conn, err := quicgo.DialAddr(ctx, addr, &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{alpn},
+		MinVersion:         tls.VersionTLS13,
+	}, config)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		_ = conn.CloseWithError(0, "")
+		return nil, err
+	}
+	return &streamConn{Stream: stream, conn: conn}, nil
+}
+
+// streamConn is a net.Conn over the stream of a QUIC connection.
+type streamConn struct {
+	*quicgo.Stream
+	conn *quicgo.Conn
+}
+
+func (c *streamConn) Close() error {
+	return errors.Join(c.Stream.Close(), c.conn.CloseWithError(0, ""))
+}
+
+func (c *streamConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *streamConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func selfSignedCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "dapr-quic"},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
@@ -20,6 +20,7 @@ import (
 	"io"
 	"math"
 	"net"
+	"os"
 	"strconv"
 	"sync"
 	"time"
@@ -28,17 +29,24 @@ import (
 	grpcGo "google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcInsecure "google.golang.org/grpc/credentials/insecure"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
 	grpcKeepalive "google.golang.org/grpc/keepalive"
+	grpcMetadata "google.golang.org/grpc/metadata"
 	grpcReflection "google.golang.org/grpc/reflection"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/dapr/dapr/pkg/api/apitoken"
+	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/api/grpc/quic"
 	"github.com/dapr/dapr/pkg/api/listen"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messaging"
+	"github.com/dapr/dapr/pkg/middleware"
+	grpcMiddlewarePipeline "github.com/dapr/dapr/pkg/middleware/grpc"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
@@ -69,6 +77,12 @@ type Options struct {
 	Proxy          messaging.Proxy
 	WorkflowEngine wfengine.Interface
 	Healthz        healthz.Healthz
+	// Middleware, when set, is the pipeline of middleware components the
+	// calls to the API and the proxied gRPC invocations run through.
+	Middleware middleware.HTTP
+	// APITokens are the tokens authenticating the calls to the API. Defaults
+	// to the dapr-api-token.
+	APITokens *apitoken.Tokens
 }
 
 type OptionsInternal struct {
@@ -84,6 +98,16 @@ type OptionsInternal struct {
 	// components are initialized) and hand the already-bound listener to the
 	// server, avoiding an ephemeral-source-port collision.
 	Listener net.Listener
+	// EnableQUIC serves a QUIC transport next to the TCP one and advertises it
+	// to the daprd sidecars which connect to the server.
+	EnableQUIC bool
+	// Zone is the zone of the sidecar, advertised to the daprd sidecars which
+	// connect to the server for zone-aware load balancing.
+	Zone string
+	// SocketDir, when set, is the directory shared by the daprd sidecars on
+	// the node in which the server also listens on a UNIX domain socket, used
+	// by the sidecars running on the same node.
+	SocketDir string
 }
 
 type server struct {
@@ -96,16 +120,27 @@ type server struct {
 	logger         logger.Logger
 	infoLogger     logger.Logger
 	grpcServerOpts []grpcGo.ServerOption
-	authToken      string
+	apiTokens      *apitoken.Tokens
 	apiSpec        config.APISpec
 	proxy          messaging.Proxy
 	workflowEngine wfengine.Interface
 	sec            security.Handler
 	wg             sync.WaitGroup
 	htarget        healthz.Target
+	healthz        healthz.Healthz
 	// listener, when set, is served directly instead of binding config.Port.
 	// See OptionsInternal.Listener and issue #6023.
-	listener net.Listener
+	listener   net.Listener
+	enableQUIC bool
+	// quicPort is the UDP port of the QUIC transport, if it is served.
+	quicPort string
+	// zone is the zone of the sidecar, if it is known.
+	zone string
+	// socketDir is the directory of the UNIX domain socket of the internal
+	// server, if it is served.
+	socketDir string
+	// middleware is the pipeline of middleware components, if it is set.
+	middleware middleware.HTTP
 }
 
 var (
@@ -118,6 +153,11 @@ var (
 func NewAPIServer(opts Options) Server {
 	apiServerInfoLogger.SetOutputLevel(logger.LogLevel("info"))
 
+	apiTokens := opts.APITokens
+	if apiTokens == nil {
+		apiTokens = apitoken.New(apitoken.Options{Token: security.GetAPIToken()})
+	}
+
 	// This is equivalent to "infinity" time (see: https://github.com/grpc/grpc-go/blob/master/internal/transport/defaults.go)
 	const infinity = time.Duration(math.MaxInt64)
 
@@ -151,12 +191,14 @@ func NewAPIServer(opts Options) Server {
 		kind:           apiServer,
 		logger:         apiServerLogger,
 		infoLogger:     apiServerInfoLogger,
-		authToken:      security.GetAPIToken(),
+		apiTokens:      apiTokens,
 		apiSpec:        opts.APISpec,
 		proxy:          opts.Proxy,
 		workflowEngine: opts.WorkflowEngine,
 		htarget:        opts.Healthz.AddTarget("grpc-api-server"),
+		healthz:        opts.Healthz,
 		grpcServerOpts: serverOpts,
+		middleware:     opts.Middleware,
 	}
 }
 
@@ -199,6 +241,9 @@ func NewInternalServer(opts OptionsInternal) Server {
 		sec:            opts.Security,
 		htarget:        opts.Healthz.AddTarget("grpc-internal-server"),
 		listener:       opts.Listener,
+		enableQUIC:     opts.EnableQUIC,
+		zone:           opts.Zone,
+		socketDir:      opts.SocketDir,
 	}
 }
 
@@ -234,6 +279,18 @@ func (s *server) StartNonBlocking(ctx context.Context) error {
 		return errors.New("could not listen on any endpoint")
 	}
 
+	if s.kind == internalServer && s.enableQUIC {
+		listeners = append(listeners, s.listenQUIC(listeners)...)
+	}
+
+	if s.kind == internalServer && s.socketDir != "" {
+		l, err := s.listenSocket(listeners[0])
+		if err != nil {
+			return err
+		}
+		listeners = append(listeners, l)
+	}
+
 	for _, listener := range listeners {
 		// server is created in a loop because each instance
 		// has a handle on the underlying listener.
@@ -241,13 +298,19 @@ func (s *server) StartNonBlocking(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		grpcReflection.Register(server)
 		s.servers = append(s.servers, server)
 
 		switch s.kind {
 		case internalServer:
+			grpcReflection.Register(server)
 			internalv1pb.RegisterServiceInvocationServer(server, s.api)
 		case apiServer:
+			if s.apiSpec.ReflectionEnabled() {
+				grpcReflection.Register(server)
+			}
+			if s.apiSpec.HealthServiceEnabled() {
+				healthv1.RegisterHealthServer(server, newHealthServer(s.healthz))
+			}
 			runtimev1pb.RegisterDaprServer(server, s.api)
 			s.logger.Infof("Registering workflow engine for gRPC endpoint: %s", listener.Addr())
 			s.workflowEngine.RegisterGrpcServer(server)
@@ -266,6 +329,48 @@ func (s *server) StartNonBlocking(ctx context.Context) error {
 	return nil
 }
 
+// listenQUIC listens for QUIC connections on the UDP addresses of the TCP
+// listeners. The TCP transport is still available to the sidecars which do
+// not support QUIC, so failing to listen is not fatal.
+func (s *server) listenQUIC(tcpListeners []net.Listener) []net.Listener {
+	listeners := make([]net.Listener, 0, len(tcpListeners))
+	for _, tcpListener := range tcpListeners {
+		l, err := quic.Listen(tcpListener.Addr().String())
+		if err != nil {
+			s.logger.Errorf("Failed to listen for gRPC server on QUIC address %s with error: %v", tcpListener.Addr(), err)
+			continue
+		}
+		s.logger.Infof("gRPC server listening on QUIC address: %s", l.Addr())
+		if s.quicPort == "" {
+			s.quicPort = strconv.Itoa(l.Addr().(*net.UDPAddr).Port)
+		}
+		listeners = append(listeners, l)
+	}
+	return listeners
+}
+
+// listenSocket listens on the UNIX domain socket of the internal server, named
+// after the address advertised to the sidecars so that the sidecars on the
+// node resolving the server can find it.
+func (s *server) listenSocket(tcpListener net.Listener) (net.Listener, error) {
+	port := strconv.Itoa(s.config.Port)
+	if addr, ok := tcpListener.Addr().(*net.TCPAddr); ok {
+		port = strconv.Itoa(addr.Port)
+	}
+	socket := manager.InternalSocketPath(s.socketDir, net.JoinHostPort(s.config.HostAddress, port))
+	// Remove the socket left behind by a sidecar which was not shut down
+	// gracefully.
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Infof("gRPC server listening on UNIX socket: %s", socket)
+	return l, nil
+}
+
 func (s *server) Close() error {
 	s.htarget.NotReady()
 
@@ -295,11 +400,17 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 	// We initialize these slices with an initial capacity to give the compiler a "hint" of how much memory we may use.
 	// These capacities are the worst-case scenario below (max number of items added to each slice).
 	// Specifying an initial capacity helps us reducing the risk that we may need to re-allocate the slice, which is wasteful both on the allocator and on the GC.
-	intr := make([]grpcGo.UnaryServerInterceptor, 0, 6)
-	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 5)
+	intr := make([]grpcGo.UnaryServerInterceptor, 0, 8)
+	intrStream := make([]grpcGo.StreamServerInterceptor, 0, 7)
 
 	intr = append(intr, metadata.SetMetadataInContextUnary)
 
+	if s.quicPort != "" || s.zone != "" {
+		unary, stream := s.getAdvertisementMiddlewares()
+		intr = append(intr, unary)
+		intrStream = append(intrStream, stream)
+	}
+
 	if len(s.apiSpec.Allowed) > 0 || len(s.apiSpec.Denied) > 0 {
 		s.logger.Info("Enabled API access list on gRPC server")
 		unary, stream := setAPIEndpointsMiddlewares(s.apiSpec.Allowed, s.apiSpec.Denied)
@@ -309,9 +420,9 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 		}
 	}
 
-	if s.authToken != "" {
+	if s.apiTokens.Enabled() {
 		s.logger.Info("Enabled token authentication on gRPC server")
-		unary, stream := getAPIAuthenticationMiddlewares(s.authToken, securityConsts.APITokenHeader)
+		unary, stream := getAPIAuthenticationMiddlewares(s.apiTokens, securityConsts.APITokenHeader, s.apiSpec.HealthServiceEnabled())
 		intr = append(intr, unary)
 		intrStream = append(intrStream, stream)
 	}
@@ -340,6 +451,13 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 		intrStream = append(intrStream, stream)
 	}
 
+	if s.middleware != nil {
+		s.logger.Info("Enabled gRPC middleware pipeline")
+		unary, stream := grpcMiddlewarePipeline.Interceptors(s.middleware)
+		intr = append(intr, unary)
+		intrStream = append(intrStream, stream)
+	}
+
 	return []grpcGo.ServerOption{
 		grpcGo.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(intr...)),
 		grpcGo.StreamInterceptor(grpcMiddleware.ChainStreamServer(intrStream...)),
@@ -374,6 +492,26 @@ func (s *server) getGRPCServer() (*grpcGo.Server, error) {
 	return grpcGo.NewServer(opts...), nil
 }
 
+// getAdvertisementMiddlewares returns the middlewares which advertise the QUIC
+// transport and the zone of the server in the response headers.
+func (s *server) getAdvertisementMiddlewares() (grpcGo.UnaryServerInterceptor, grpcGo.StreamServerInterceptor) {
+	header := grpcMetadata.MD{}
+	if s.quicPort != "" {
+		header.Set(quic.PortHeader, s.quicPort)
+	}
+	if s.zone != "" {
+		header.Set(messaging.ZoneHeader, s.zone)
+	}
+	return func(ctx context.Context, req any, info *grpcGo.UnaryServerInfo, handler grpcGo.UnaryHandler) (any, error) {
+			_ = grpcGo.SetHeader(ctx, header)
+			return handler(ctx, req)
+		},
+		func(srv any, stream grpcGo.ServerStream, info *grpcGo.StreamServerInfo, handler grpcGo.StreamHandler) error {
+			_ = stream.SetHeader(header)
+			return handler(srv, stream)
+		}
+}
+
 func (s *server) getGRPCAPILoggingMiddlewares() (grpcGo.UnaryServerInterceptor, grpcGo.StreamServerInterceptor) {
 	if s.infoLogger == nil {
 		return nil, nil
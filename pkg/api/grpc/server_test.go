@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"os"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -20,9 +22,11 @@ import (
 
 	"github.com/dapr/dapr/pkg/actors/fake"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
+	"github.com/dapr/dapr/pkg/api/grpc/quic"
 	"github.com/dapr/dapr/pkg/api/universal"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/messaging"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	wfenginefake "github.com/dapr/dapr/pkg/runtime/wfengine/fake"
 	dapr_testing "github.com/dapr/dapr/pkg/testing"
@@ -218,3 +222,56 @@ func TestGrpcAPILoggingMiddlewares(t *testing.T) {
 	t.Run("without user agent", runTest(""))
 	t.Run("with user agent", runTest("daprtest/1"))
 }
+
+type headerServerStream struct {
+	grpcGo.ServerStream
+	header grpcMetadata.MD
+}
+
+func (s *headerServerStream) SetHeader(md grpcMetadata.MD) error {
+	s.header = grpcMetadata.Join(s.header, md)
+	return nil
+}
+
+func TestQUICTransport(t *testing.T) {
+	s := &server{
+		kind:   internalServer,
+		logger: logger.NewLogger("dapr.runtime.grpc.test"),
+	}
+
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer tcp.Close()
+
+	listeners := s.listenQUIC([]net.Listener{tcp})
+	require.Len(t, listeners, 1)
+	defer listeners[0].Close()
+	assert.Equal(t, "udp", listeners[0].Addr().Network())
+	assert.Equal(t, strconv.Itoa(tcp.Addr().(*net.TCPAddr).Port), s.quicPort)
+
+	// The middlewares are only added if the QUIC transport is served.
+	assert.Len(t, (&server{logger: s.logger}).getMiddlewareOptions(), 3)
+	_, stream := s.getAdvertisementMiddlewares()
+	ss := &headerServerStream{}
+	require.NoError(t, stream(nil, ss, &grpcGo.StreamServerInfo{}, func(any, grpcGo.ServerStream) error {
+		return nil
+	}))
+	assert.Equal(t, []string{s.quicPort}, ss.header.Get(quic.PortHeader))
+	assert.Empty(t, ss.header.Get(messaging.ZoneHeader))
+}
+
+func TestZoneAdvertisement(t *testing.T) {
+	s := &server{
+		kind:   internalServer,
+		logger: logger.NewLogger("dapr.runtime.grpc.test"),
+		zone:   "zone-a",
+	}
+
+	_, stream := s.getAdvertisementMiddlewares()
+	ss := &headerServerStream{}
+	require.NoError(t, stream(nil, ss, &grpcGo.StreamServerInfo{}, func(any, grpcGo.ServerStream) error {
+		return nil
+	}))
+	assert.Equal(t, []string{"zone-a"}, ss.header.Get(messaging.ZoneHeader))
+	assert.Empty(t, ss.header.Get(quic.PortHeader))
+}
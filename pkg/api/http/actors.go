@@ -20,12 +20,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -185,6 +188,16 @@ func (a *api) constructActorEndpoints() []endpoints.Endpoint {
 				Name: "GetActorReminder",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "actors/{actorType}/reminders",
+			Version: apiVersionV1,
+			Group:   endpointGroupActorV1Misc,
+			Handler: a.onListActorReminders,
+			Settings: endpoints.EndpointSettings{
+				Name: "ListActorReminders",
+			},
+		},
 	}
 }
 
@@ -380,22 +393,11 @@ func (a *api) onGetActorReminder() http.HandlerFunc {
 					TTL:       out.Ttl,
 				}
 
+				var err error
 				//nolint:protogetter
-				if out.Data != nil {
-					msg, err := out.Data.UnmarshalNew()
-					if err != nil {
-						return nil, err
-					}
-					switch mm := msg.(type) {
-					case *wrapperspb.BytesValue:
-						m.Data = mm.GetValue()
-					default:
-						d, err := protojson.Marshal(mm)
-						if err != nil {
-							return nil, err
-						}
-						m.Data = json.RawMessage(d)
-					}
+				m.Data, err = reminderDataToJSON(out.Data)
+				if err != nil {
+					return nil, err
 				}
 
 				return m, nil
@@ -410,6 +412,145 @@ func (a *api) onGetActorReminder() http.HandlerFunc {
 	)
 }
 
+type listActorRemindersResponse struct {
+	Reminders         []listActorRemindersResponseItem `json:"reminders"`
+	ContinuationToken string                           `json:"continuationToken,omitempty"`
+}
+
+type listActorRemindersResponseItem struct {
+	Name      string          `json:"name"`
+	ActorID   string          `json:"actorID,omitempty"`
+	ActorType string          `json:"actorType,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	DueTime   string          `json:"dueTime,omitempty"`
+	Period    string          `json:"period,omitempty"`
+	TTL       string          `json:"ttl,omitempty"`
+}
+
+// onListActorReminders lists the reminders of an actor type, optionally
+// scoped to a single actor ID, with due time filters and pagination.
+func (a *api) onListActorReminders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	actorType := chi.URLParamFromCtx(ctx, actorTypeParam)
+	if err := a.universal.RejectInternalActorType(actorType); err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	req := &actorapi.ListRemindersPageRequest{
+		ActorType: actorType,
+	}
+
+	qs := r.URL.Query()
+	if qs.Has(actorIDParam) {
+		req.ActorID = new(qs.Get(actorIDParam))
+	}
+	for _, param := range []struct {
+		name string
+		val  **time.Time
+	}{
+		{dueAfterParam, &req.DueAfter}, {dueBeforeParam, &req.DueBefore},
+	} {
+		v := qs.Get(param.name)
+		if len(v) == 0 {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			msg := messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: %v", param.name, err))
+			respondWithError(w, msg)
+			log.Debug(msg)
+			return
+		}
+		*param.val = &t
+	}
+	if v := qs.Get(pageSizeParam); len(v) > 0 {
+		size, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			msg := messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: %v", pageSizeParam, err))
+			respondWithError(w, msg)
+			log.Debug(msg)
+			return
+		}
+		req.PageSize = uint32(size)
+	}
+	req.ContinuationToken = qs.Get(continuationTokenParam)
+
+	rem, err := a.universal.ActorReminders(ctx)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	page, err := rem.ListPage(ctx, req)
+	if err != nil {
+		var msg error
+		switch {
+		case errors.Is(err, reminders.ErrReminderOpActorNotHosted):
+			msg = messages.ErrActorReminderOpActorNotHosted
+		case errors.Is(err, reminders.ErrInvalidContinuationToken):
+			msg = messages.ErrBadRequest.WithFormat(err)
+		default:
+			msg = messages.ErrActorReminderList.WithFormat(err)
+		}
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
+	resp := listActorRemindersResponse{
+		Reminders:         make([]listActorRemindersResponseItem, len(page.Reminders)),
+		ContinuationToken: page.ContinuationToken,
+	}
+	for i, rm := range page.Reminders {
+		item := listActorRemindersResponseItem{
+			Name:      rm.Name,
+			ActorID:   rm.ActorID,
+			ActorType: rm.ActorType,
+			DueTime:   rm.DueTime,
+			Period:    rm.Period.String(),
+		}
+		if !rm.ExpirationTime.IsZero() {
+			item.TTL = rm.ExpirationTime.Format(time.RFC3339Nano)
+		}
+		item.Data, err = reminderDataToJSON(rm.Data)
+		if err != nil {
+			msg := messages.ErrActorReminderList.WithFormat(err)
+			respondWithError(w, msg)
+			log.Debug(msg)
+			return
+		}
+		resp.Reminders[i] = item
+	}
+
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// reminderDataToJSON converts the data of a reminder to its JSON
+// representation. Raw bytes are returned as-is.
+func reminderDataToJSON(data *anypb.Any) (json.RawMessage, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	msg, err := data.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+
+	switch mm := msg.(type) {
+	case *wrapperspb.BytesValue:
+		return mm.GetValue(), nil
+	default:
+		d, err := protojson.Marshal(mm)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(d), nil
+	}
+}
+
 func (a *api) onDeleteActorTimer() http.HandlerFunc {
 	return UniversalHTTPHandler(
 		a.universal.UnregisterActorTimer,
@@ -478,7 +619,7 @@ func (a *api) onDirectActorMessage(w http.ResponseWriter, r *http.Request) {
 
 	// Unlike other actor calls, resiliency is handled here for invocation.
 	// This is due to actor invocation involving a lookup for the host.
-	policyDef := a.universal.Resiliency().ActorPreLockPolicy(actorType, actorID)
+	policyDef := a.universal.Resiliency().ActorPreLockMethodPolicy(actorType, actorID, method)
 	policyRunner := resiliency.NewRunner[*internalsv1pb.InternalInvokeResponse](ctx, policyDef)
 	res, err := policyRunner(func(ctx context.Context) (*internalsv1pb.InternalInvokeResponse, error) {
 		return router.Call(ctx, req)
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// benchmarkPayloadSize is the size of the payloads of the benchmarks of the
+// handlers which forward large bodies.
+const benchmarkPayloadSize = 10 << 20
+
+func benchmarkRequest(method, target string, body []byte, params map[string]string) *nethttp.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	chiCtx := chi.NewRouteContext()
+	for k, v := range params {
+		chiCtx.URLParams.Add(k, v)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+}
+
+func BenchmarkOutputBindingLargePayload(b *testing.B) {
+	testAPI := &api{
+		sendToOutputBindingFn: func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+			return &bindings.InvokeResponse{Data: req.Data}, nil
+		},
+		maxRequestBodySize: 2 * benchmarkPayloadSize,
+	}
+
+	items := make([]map[string]string, benchmarkPayloadSize/64)
+	for i := range items {
+		items[i] = map[string]string{"key": "0123456789abcdef0123456789abcdef"}
+	}
+	body, err := json.Marshal(map[string]any{"operation": "create", "data": items})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	for b.Loop() {
+		w := httptest.NewRecorder()
+		testAPI.onOutputBindingMessage(w, benchmarkRequest(nethttp.MethodPost, "/v1.0/bindings/mybinding", body, map[string]string{nameParam: "mybinding"}))
+		if w.Code != nethttp.StatusOK {
+			b.Fatalf("unexpected status code %d", w.Code)
+		}
+	}
+}
+
+func BenchmarkPublishLargePayload(b *testing.B) {
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			CompStore: compstore.New(),
+		}),
+		pubsubAdapter: &daprt.MockPubSubAdapter{
+			PublishFn: func(ctx context.Context, req *pubsub.PublishRequest) error {
+				return nil
+			},
+		},
+		maxRequestBodySize: 2 * benchmarkPayloadSize,
+	}
+	mock := daprt.MockPubSub{}
+	mock.On("Features").Return([]pubsub.Feature{})
+	testAPI.universal.CompStore().AddPubSub("mypubsub", &runtimePubsub.PubsubItem{Component: &mock})
+
+	body := bytes.Repeat([]byte("a"), benchmarkPayloadSize)
+	params := map[string]string{pubsubnameparam: "mypubsub", wildcardParam: "mytopic"}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	for b.Loop() {
+		req := benchmarkRequest(nethttp.MethodPost, "/v1.0/publish/mypubsub/mytopic?metadata.rawPayload=true", body, params)
+		req.Header.Set("Content-Type", "application/octet-stream")
+		w := httptest.NewRecorder()
+		testAPI.onPublish(w, req)
+		if w.Code != nethttp.StatusNoContent {
+			b.Fatalf("unexpected status code %d", w.Code)
+		}
+	}
+}
+
+// discardResponseWriter is a flushing response writer discarding the body, so
+// that the benchmarks measure the copy of the body alone.
+type discardResponseWriter struct {
+	header nethttp.Header
+}
+
+func (w *discardResponseWriter) Header() nethttp.Header      { return w.header }
+func (w *discardResponseWriter) Write(p []byte) (int, error) { return len(p), nil }
+func (w *discardResponseWriter) WriteHeader(int)             {}
+func (w *discardResponseWriter) Flush()                      {}
+
+func BenchmarkInvokeResponseLargePayload(b *testing.B) {
+	body := bytes.Repeat([]byte("a"), benchmarkPayloadSize)
+	w := &discardResponseWriter{header: make(nethttp.Header)}
+	dst := &flushWriter{w: w, f: w}
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	for b.Loop() {
+		// The response of the app is read as a stream, which is not an
+		// io.WriterTo.
+		reader := struct{ io.Reader }{bytes.NewReader(body)}
+		if err := copyResponseBody(dst, reader); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package http
 
 import (
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -33,6 +34,8 @@ const (
 	cryptoHeaderOmitDecryptionKeyName = "dapr-omit-decryption-key-name"
 	cryptoHeaderDecryptionKeyName     = "dapr-decryption-key-name"
 	cryptoHeaderDataEncryptionCipher  = "dapr-data-encryption-cipher"
+	cryptoHeaderStream                = "dapr-stream"
+	cryptoTrailerError                = "dapr-error"
 )
 
 var endpointGroupCryptoV1Alpha1 = &endpoints.EndpointGroup{
@@ -74,6 +77,7 @@ func (a *api) constructCryptoEndpoints() []endpoints.Endpoint {
 // - dapr-omit-decryption-key-name
 // - dapr-decryption-key-name
 // - dapr-data-encryption-cipher
+// - dapr-stream
 func (a *api) onCryptoEncrypt(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -121,7 +125,7 @@ func (a *api) onCryptoEncrypt(w http.ResponseWriter, r *http.Request) {
 
 	// Perform the encryption on the body of the request
 	// Errors returned here, synchronously, are initialization errors, for example due to failed wrapping
-	enc, err := encv1.Encrypt(r.Body, encOpts)
+	enc, err := encv1.Encrypt(cryptoRequestBody(r), encOpts)
 	if err != nil {
 		err = messages.ErrCryptoOperation.WithFormat(err)
 		log.Debug(err)
@@ -130,19 +134,13 @@ func (a *api) onCryptoEncrypt(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Respond with the encrypted data
-	// We do not respond with a stream here so we can better catch and intercept errors
-	resBody, err := io.ReadAll(enc)
-	if err != nil {
-		respondWithError(w, err)
-		return
-	}
-	w.Header().Set(headerContentType, "application/octet-stream")
-	respondWithData(w, http.StatusOK, resBody)
+	respondWithCryptoResult(w, r, enc)
 }
 
 // Handler for crypto/<component-name>/decrypt
 // Headers:
 // - dapr-key-name
+// - dapr-stream
 func (a *api) onCryptoDecrypt(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -165,7 +163,7 @@ func (a *api) onCryptoDecrypt(w http.ResponseWriter, r *http.Request) {
 
 	// Perform the decryption on the body of the request
 	// Errors returned here, synchronously, are initialization errors, for example due to failed unwrapping
-	dec, err := encv1.Decrypt(r.Body, decOpts)
+	dec, err := encv1.Decrypt(cryptoRequestBody(r), decOpts)
 	if err != nil {
 		err = messages.ErrCryptoOperation.WithFormat(err)
 		log.Debug(err)
@@ -174,14 +172,67 @@ func (a *api) onCryptoDecrypt(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Respond with the decrypted data
-	// We do not respond with a stream here so we can better catch and intercept errors
-	resBody, err := io.ReadAll(dec)
-	if err != nil {
-		respondWithError(w, err)
+	respondWithCryptoResult(w, r, dec)
+}
+
+// cryptoRequestBody returns the body of the request. The bodies of streamed
+// requests are not limited by the max request body size, as they are never
+// buffered.
+func cryptoRequestBody(r *http.Request) io.Reader {
+	if kitstrings.IsTruthy(r.Header.Get(cryptoHeaderStream)) {
+		return unlimitedBody(r)
+	}
+	return r.Body
+}
+
+// respondWithCryptoResult responds with the result of a crypto operation.
+// Results are buffered, so we can better catch and intercept errors, unless
+// the request has the dapr-stream header: then the result is streamed in
+// chunks of at most one segment of the encryption scheme (64KiB of plaintext,
+// plus the overhead of each encrypted segment), and errors interrupting the
+// stream are reported in the dapr-error trailer.
+func respondWithCryptoResult(w http.ResponseWriter, r *http.Request, res io.Reader) {
+	if !kitstrings.IsTruthy(r.Header.Get(cryptoHeaderStream)) {
+		resBody, err := io.ReadAll(res)
+		if err != nil {
+			respondWithError(w, err)
+			return
+		}
+		w.Header().Set(headerContentType, "application/octet-stream")
+		respondWithData(w, http.StatusOK, resBody)
 		return
 	}
+
+	if c, ok := res.(io.Closer); ok {
+		// Stops the operation if the stream is interrupted
+		defer c.Close()
+	}
+
 	w.Header().Set(headerContentType, "application/octet-stream")
-	respondWithData(w, http.StatusOK, resBody)
+	w.Header().Set("Trailer", cryptoTrailerError)
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	buf := make([]byte, encv1.SegmentSize+encv1.SegmentOverhead)
+	for {
+		n, err := res.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				log.Debugf("Failed to write crypto stream: %v", werr)
+				return
+			}
+			_ = rc.Flush()
+		}
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			err = messages.ErrCryptoOperation.WithFormat(err)
+			log.Debug(err)
+			w.Header().Set(cryptoTrailerError, err.Error())
+			return
+		}
+	}
 }
 
 func (a *api) cryptoGetComponent(componentName string) (contribCrypto.SubtleCrypto, error) {
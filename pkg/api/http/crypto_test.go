@@ -16,7 +16,10 @@ package http
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,6 +29,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	daprt "github.com/dapr/dapr/pkg/testing"
+	encv1 "github.com/dapr/kit/schemes/enc/v1"
 )
 
 func TestCryptoEndpoints(t *testing.T) {
@@ -169,3 +173,104 @@ func TestCryptoEndpoints(t *testing.T) {
 		assert.Contains(t, resp.ErrorBody["message"], "failed to perform operation: invalid header")
 	})
 }
+
+func TestCryptoEndpointsStreaming(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	const cryptoComponentName = "myvault"
+	compStore.AddCryptoProvider(cryptoComponentName, &daprt.FakeSubtleCrypto{})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+
+	fakeServer.StartServer(testAPI.constructCryptoEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	// The message spans multiple segments of the encryption scheme
+	message := bytes.Repeat([]byte("dapr"), encv1.SegmentSize)
+
+	do := func(t *testing.T, op string, body []byte) *http.Response {
+		t.Helper()
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPut,
+			fmt.Sprintf("http://127.0.0.1/%s/crypto/%s/%s", apiVersionV1alpha1, cryptoComponentName, op),
+			bytes.NewReader(body),
+		)
+		require.NoError(t, err)
+		req.Header.Set(cryptoHeaderStream, "true")
+		req.Header.Set(cryptoHeaderKeyName, "aes-passthrough")
+		req.Header.Set(cryptoHeaderKeyWrapAlgorithm, "AES")
+		res, err := fakeServer.client.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+		return res
+	}
+
+	var encMessage []byte
+	t.Run("Encrypt stream successfully - 200", func(t *testing.T) {
+		res := do(t, "encrypt", message)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/octet-stream", res.Header.Get("Content-Type"))
+
+		var err error
+		encMessage, err = io.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(encMessage, []byte("dapr.io/enc/v1\n")))
+		assert.Empty(t, res.Trailer.Get(cryptoTrailerError))
+	})
+
+	t.Run("Decrypt stream successfully - 200", func(t *testing.T) {
+		res := do(t, "decrypt", encMessage)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		decMessage, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Equal(t, message, decMessage)
+		assert.Empty(t, res.Trailer.Get(cryptoTrailerError))
+	})
+
+	t.Run("Decrypt stream fails - error trailer", func(t *testing.T) {
+		// Tamper with the last segment, so the stream fails after the first segments are sent
+		tampered := bytes.Clone(encMessage)
+		tampered[len(tampered)-1] ^= 0xff
+
+		res := do(t, "decrypt", tampered)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		decMessage, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Less(t, len(decMessage), len(message))
+		assert.Contains(t, res.Trailer.Get(cryptoTrailerError), "failed to perform operation")
+	})
+}
+
+func TestCryptoRequestBody(t *testing.T) {
+	body := strings.Repeat("a", 100)
+	handler := func(stream bool) http.Handler {
+		return MaxBodySizeMiddleware(10)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if stream {
+				r.Header.Set(cryptoHeaderStream, "true")
+			}
+			_, err := io.ReadAll(cryptoRequestBody(r))
+			if err != nil {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+			}
+		}))
+	}
+
+	t.Run("bodies of requests are limited", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler(false).ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("bodies of streamed requests are not limited", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler(true).ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"errors"
@@ -34,6 +35,7 @@ import (
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
+	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/sse"
@@ -134,6 +136,16 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 		policyDef = a.universal.Resiliency().EndpointPolicy(targetID, targetID+":"+invokeMethodName)
 	}
 
+	limits := a.universal.InvocationLimits(targetID)
+	if limits.MaxRequestBodySize > 0 {
+		if r.ContentLength > int64(limits.MaxRequestBodySize) {
+			respondWithError(w, messages.ErrDirectInvokeRequestTooLarge.WithFormat(targetID, limits.MaxRequestBodySize))
+			return
+		}
+		// Bodies of unknown length are limited as they are read.
+		r.Body = http.MaxBytesReader(w, r.Body, int64(limits.MaxRequestBodySize))
+	}
+
 	req := invokev1.NewInvokeMethodRequest(invokeMethodName).
 		WithHTTPExtension(verb, r.URL.RawQuery).
 		WithRawData(r.Body).
@@ -144,7 +156,8 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 	// For streaming requests (chunked transfer / unknown content length),
 	// disable replay to prevent buffering the entire body in memory.
 	// ContentLength is -1 when Transfer-Encoding is chunked or Content-Length is absent.
-	if r.ContentLength < 0 {
+	// Bodies larger than the streaming threshold of the target app are streamed too.
+	if r.ContentLength < 0 || (limits.StreamingThreshold > 0 && r.ContentLength > int64(limits.StreamingThreshold)) {
 		req.SetStreamingRequest()
 	}
 	if policyDef != nil {
@@ -152,6 +165,23 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 	}
 	defer req.Close()
 
+	// Parallel attempts of hedged requests each need their own copy of the request, so the body is read into memory first.
+	// Streaming requests cannot be hedged.
+	var hedgedReq *invokev1.InvokeMethodRequest
+	if policyDef != nil && policyDef.HasHedging() {
+		if req.IsStreamingRequest() {
+			policyDef = policyDef.WithoutHedging()
+		} else {
+			var err error
+			hedgedReq, err = req.CloneWithData()
+			if err != nil {
+				respondWithError(w, messages.ErrBodyRead.WithFormat(err))
+				return
+			}
+			defer hedgedReq.Close()
+		}
+	}
+
 	policyRunner := resiliency.NewRunnerWithOptions(
 		r.Context(), policyDef,
 		resiliency.RunnerOpts[*invokev1.InvokeMethodResponse]{
@@ -161,8 +191,22 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 	success := atomic.Bool{}
 	// Since we don't want to return the actual error, we have to extract several things in order to construct our response.
 	resp, err := policyRunner(func(ctx context.Context) (*invokev1.InvokeMethodResponse, error) {
+		req := req
+		if hedgedReq != nil {
+			var cErr error
+			req, cErr = hedgedReq.CloneWithData()
+			if cErr != nil {
+				return nil, backoff.Permanent(cErr)
+			}
+			defer req.Close()
+		}
+
 		rResp, rErr := a.directMessaging.Invoke(ctx, targetID, req)
 		if rErr != nil {
+			if maxBytesErr := new(http.MaxBytesError); errors.As(rErr, &maxBytesErr) {
+				return rResp, backoff.Permanent(messages.ErrDirectInvokeRequestTooLarge.WithFormat(targetID, maxBytesErr.Limit))
+			}
+
 			// Allowlist policies that are applied on the callee side can return a Permission Denied error.
 			// For everything else, treat it as a gRPC transport error
 			apiErr := messages.ErrDirectInvoke.WithFormat(targetID, rErr)
@@ -171,8 +215,13 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 				msg:        apiErr.JSONErrorValue(),
 			}
 
-			if status.Code(rErr) == codes.PermissionDenied {
+			switch {
+			case status.Code(rErr) == codes.PermissionDenied:
 				invokeErr.statusCode = invokev1.HTTPStatusFromCode(codes.PermissionDenied)
+			case messaging.IsAppUnhealthyError(rErr):
+				invokeErr.statusCode = http.StatusServiceUnavailable
+			case messaging.IsRateLimitedError(rErr):
+				invokeErr.statusCode = http.StatusTooManyRequests
 			}
 
 			// If this is a streaming request, wrap transport errors as
@@ -230,6 +279,22 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 		// impossible so we fall through to stream the response directly
 		// to the caller.
 
+		reader := rResp.RawData()
+		isSSE := sse.IsSSEHttpRequest(r)
+
+		// Responses are read into memory to be checked against the limit of
+		// the target app before they are sent. Event streams are not limited.
+		if limits.MaxResponseBodySize > 0 && !isSSE {
+			body, rErr := io.ReadAll(io.LimitReader(reader, int64(limits.MaxResponseBodySize)+1))
+			if rErr != nil {
+				return nil, backoff.Permanent(rErr)
+			}
+			if len(body) > limits.MaxResponseBodySize {
+				return nil, backoff.Permanent(messages.ErrDirectInvokeResponseTooLarge.WithFormat(targetID, limits.MaxResponseBodySize))
+			}
+			reader = bytes.NewReader(body)
+		}
+
 		// If we get to this point, we must consider the operation as successful, so we invoke this only once and we consider all errors returned by this to be permanent (so the policy function doesn't retry)
 		// We still need to be within the policy function because if we return, the context passed to `Invoke` is canceled, so the `Copy` operation below can fail with a ContextCanceled error
 		if !success.CompareAndSwap(false, true) {
@@ -246,9 +311,6 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("content-type", ct)
 		}
 
-		reader := rResp.RawData()
-		isSSE := sse.IsSSEHttpRequest(r)
-
 		statusCode := int(rResp.Status().GetCode())
 
 		if !isSSE {
@@ -261,7 +323,7 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 			if f, ok := w.(http.Flusher); ok {
 				dst = &flushWriter{w: w, f: f}
 			}
-			_, rErr = io.Copy(dst, reader)
+			rErr = copyResponseBody(dst, reader)
 			if rErr != nil {
 				// Do not return rResp here, we already have a deferred `Close` call on it
 				return nil, backoff.Permanent(rErr)
@@ -336,6 +398,7 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 	var (
 		codeErr   codeError
 		invokeErr invokeError
+		apiErr    messages.APIError
 	)
 	switch {
 	case errors.As(err, &codeErr):
@@ -351,6 +414,9 @@ func (a *api) onDirectMessage(w http.ResponseWriter, r *http.Request) {
 	case errors.As(err, &invokeErr):
 		respondWithDataAndRecordError(w, invokeErr.statusCode, invokeErr.msg, messages.ErrDirectInvoke)
 		return
+	case errors.As(err, &apiErr):
+		respondWithError(w, apiErr)
+		return
 	default:
 		respondWithError(w, messages.ErrDirectInvoke.WithFormat(targetID, err))
 		return
@@ -464,6 +530,16 @@ func (ie invokeError) Error() string {
 	return fmt.Sprintf("invokeError (statusCode='%d') msg='%v'", ie.statusCode, string(ie.msg))
 }
 
+// copyResponseBody copies the body of the response to the writer through a
+// pooled buffer. The flushing writer hides the io.ReaderFrom of the response
+// writer, so io.Copy would allocate a buffer for each response.
+func copyResponseBody(dst io.Writer, src io.Reader) error {
+	buf := invokev1.BufPool.Get().(*[]byte)
+	defer invokev1.BufPool.Put(buf)
+	_, err := io.CopyBuffer(dst, src, *buf)
+	return err
+}
+
 // flushWriter wraps an http.ResponseWriter and flushes after every Write
 // call. This ensures chunked response data is sent to the client
 // immediately rather than being buffered.
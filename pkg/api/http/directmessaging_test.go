@@ -31,6 +31,7 @@ import (
 	"github.com/dapr/dapr/pkg/api/http/consts"
 	"github.com/dapr/dapr/pkg/api/universal"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
@@ -728,6 +729,33 @@ func TestV1DirectMessagingEndpoints(t *testing.T) {
 		assert.Equal(t, "ERR_DIRECT_INVOKE", resp.ErrorBody["errorCode"])
 	})
 
+	t.Run("Invoke returns error - 503 ERR_DIRECT_INVOKE for unhealthy app", func(t *testing.T) {
+		apiPath := "v1.0/invoke/fakeAppID/method/fakeMethod"
+		fakeData := []byte("fakeData")
+
+		mockDirectMessaging.Calls = nil // reset call count
+
+		mockDirectMessaging.
+			On(
+				"Invoke",
+				mock.MatchedBy(matchContextInterface),
+				mock.MatchedBy(func(b string) bool {
+					return b == "fakeAppID"
+				}),
+				mock.AnythingOfType("*v1.InvokeMethodRequest"),
+			).
+			Return(nil, status.Error(codes.Unavailable, messages.ErrAppUnhealthy)).
+			Once()
+
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, fakeData, nil)
+
+		// assert
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, 503, resp.StatusCode)
+		assert.Equal(t, "ERR_DIRECT_INVOKE", resp.ErrorBody["errorCode"])
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -898,6 +926,7 @@ func TestV1DirectMessagingEndpointsWithResiliency(t *testing.T) {
 				"failingKey":        1,
 				"extraFailingKey":   3,
 				"circuitBreakerKey": 10,
+				"hedgingKey":        1,
 			},
 			map[string]time.Duration{
 				"timeoutKey": time.Second * 30,
@@ -1000,6 +1029,18 @@ func TestV1DirectMessagingEndpointsWithResiliency(t *testing.T) {
 		assert.Equal(t, 2, failingDirectMessaging.Failure.CallCount("extraFailingKey"))
 	})
 
+	t.Run("Test invoke direct message sends a hedged attempt with resiliency", func(t *testing.T) {
+		apiPath := "v1.0/invoke/hedgingApp/method/fakeMethod"
+		fakeData := []byte("hedgingKey")
+
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, fakeData, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, 2, failingDirectMessaging.Failure.CallCount("hedgingKey"))
+		assert.Equal(t, fakeData, resp.RawBody)
+	})
+
 	t.Run("Test invoke direct messages can trip circuit breaker", func(t *testing.T) {
 		apiPath := "v1.0/invoke/circuitBreakerApp/method/fakeMethod"
 		fakeData := []byte("circuitBreakerKey")
@@ -1019,6 +1060,90 @@ func TestV1DirectMessagingEndpointsWithResiliency(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+func TestV1DirectMessagingEndpointsWithLimits(t *testing.T) {
+	mockDirectMessaging := new(daprt.MockDirectMessaging)
+
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		directMessaging: mockDirectMessaging,
+		universal: universal.New(universal.Options{
+			CompStore:  compstore.New(),
+			Resiliency: resiliency.New(nil),
+			GlobalConfig: &config.Configuration{Spec: config.ConfigurationSpec{
+				ServiceInvocation: &config.ServiceInvocationSpec{
+					Limits: &config.InvocationLimitsSpec{
+						InvocationLimits: config.InvocationLimits{StreamingThreshold: 4},
+						Apps: []config.AppInvocationLimits{{
+							AppID:            "limitedApp",
+							InvocationLimits: config.InvocationLimits{MaxRequestBodySize: 16, MaxResponseBodySize: 16},
+						}},
+					},
+				},
+			}},
+		}),
+	}
+	fakeServer.StartServer(testAPI.constructDirectMessagingEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("request too large - 413", func(t *testing.T) {
+		mockDirectMessaging.Calls = nil // reset call count
+
+		resp := fakeServer.DoRequest("POST", "v1.0/invoke/limitedApp/method/fakeMethod", []byte("a request which is too large"), nil)
+
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 0)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+		assert.Equal(t, "ERR_DIRECT_INVOKE_REQUEST_TOO_LARGE", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("response too large - 413", func(t *testing.T) {
+		fakeDirectMessageResponse := getFakeDirectMessageResponse()
+		defer fakeDirectMessageResponse.Close()
+
+		mockDirectMessaging.Calls = nil // reset call count
+		mockDirectMessaging.
+			On(
+				"Invoke",
+				mock.MatchedBy(matchContextInterface),
+				"limitedApp",
+				mock.AnythingOfType("*v1.InvokeMethodRequest"),
+			).
+			Return(fakeDirectMessageResponse, nil).
+			Once()
+
+		resp := fakeServer.DoRequest("POST", "v1.0/invoke/limitedApp/method/fakeMethod", []byte("fake"), nil)
+
+		mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+		assert.Equal(t, "ERR_DIRECT_INVOKE_RESPONSE_TOO_LARGE", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("requests above the streaming threshold are streamed", func(t *testing.T) {
+		for data, streamed := range map[string]bool{"fake": false, "fakeData": true} {
+			fakeDirectMessageResponse := getFakeDirectMessageResponse()
+			defer fakeDirectMessageResponse.Close()
+
+			mockDirectMessaging.Calls = nil // reset call count
+			mockDirectMessaging.
+				On(
+					"Invoke",
+					mock.MatchedBy(matchContextInterface),
+					"fakeAppID",
+					mock.MatchedBy(func(req *invokev1.InvokeMethodRequest) bool {
+						return req.IsStreamingRequest() == streamed
+					}),
+				).
+				Return(fakeDirectMessageResponse, nil).
+				Once()
+
+			resp := fakeServer.DoRequest("POST", "v1.0/invoke/fakeAppID/method/fakeMethod", []byte(data), nil)
+
+			mockDirectMessaging.AssertNumberOfCalls(t, "Invoke", 1)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "fakeDirectMessageResponse", string(resp.RawBody))
+		}
+	})
+}
+
 func TestPathHasPrefix(t *testing.T) {
 	tests := []struct {
 		name         string
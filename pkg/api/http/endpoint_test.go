@@ -15,18 +15,21 @@ package http
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
 	chi "github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/config"
 )
 
 func TestAPIAllowlist(t *testing.T) {
-	testFn := func(v1, v1alpha1 string) func(t *testing.T) {
+	testFn := func(v1, v1alpha1, v1beta1 string) func(t *testing.T) {
 		return func(t *testing.T) {
 			t.Run("state allowed", func(t *testing.T) {
 				allowed := config.APIAccessRules{
@@ -40,6 +43,11 @@ func TestAPIAllowlist(t *testing.T) {
 						Version:  v1alpha1,
 						Protocol: "http",
 					},
+					{
+						Name:     "state",
+						Version:  v1beta1,
+						Protocol: "http",
+					},
 				}.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
 
 				a := &api{}
@@ -80,6 +88,11 @@ func TestAPIAllowlist(t *testing.T) {
 						Version:  v1alpha1,
 						Protocol: "http",
 					},
+					{
+						Name:     "state",
+						Version:  v1beta1,
+						Protocol: "http",
+					},
 				}.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
 
 				a := &api{}
@@ -258,6 +271,11 @@ func TestAPIAllowlist(t *testing.T) {
 						Version:  v1,
 						Protocol: "http",
 					},
+					{
+						Name:     "secrets",
+						Version:  v1alpha1,
+						Protocol: "http",
+					},
 				}.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
 
 				a := &api{}
@@ -420,9 +438,9 @@ func TestAPIAllowlist(t *testing.T) {
 		}
 	}
 
-	t.Run("new format", testFn(string(endpoints.EndpointGroupVersion1), string(endpoints.EndpointGroupVersion1alpha1)))
+	t.Run("new format", testFn(string(endpoints.EndpointGroupVersion1), string(endpoints.EndpointGroupVersion1alpha1), string(endpoints.EndpointGroupVersion1beta1)))
 
-	t.Run("legacy format", testFn(apiVersionV1, apiVersionV1alpha1))
+	t.Run("legacy format", testFn(apiVersionV1, apiVersionV1alpha1, apiVersionV1beta1))
 
 	t.Run("no rules, all endpoints allowed", func(t *testing.T) {
 		a := &api{}
@@ -541,3 +559,40 @@ func TestAPIAllowlist(t *testing.T) {
 		}
 	})
 }
+
+func TestAPIVerbRules(t *testing.T) {
+	s := server{
+		apiSpec: config.APISpec{
+			Allowed: []config.APIAccessRule{
+				{Version: "v1", Name: "state", Protocol: "http"},
+				{Version: "v1", Name: "invoke", Protocol: "http", Verbs: []string{"GET"}},
+			},
+			Denied: []config.APIAccessRule{
+				{Version: "v1", Name: "state", Protocol: "http", Verbs: []string{"delete"}},
+			},
+		},
+	}
+
+	a := &api{}
+	eps := a.constructStateEndpoints()
+	eps = append(eps, a.constructSecretsEndpoints()...)
+	eps = append(eps, a.constructDirectMessagingEndpoints()...)
+	router := chi.NewRouter()
+	s.setupRoutes(router, eps)
+
+	t.Run("methods of the endpoints are restricted", func(t *testing.T) {
+		assert.True(t, router.Match(chi.NewRouteContext(), http.MethodGet, "/v1.0/state/mystore/mykey"))
+		assert.True(t, router.Match(chi.NewRouteContext(), http.MethodPost, "/v1.0/state/mystore"))
+		assert.False(t, router.Match(chi.NewRouteContext(), http.MethodDelete, "/v1.0/state/mystore/mykey"))
+		assert.False(t, router.Match(chi.NewRouteContext(), http.MethodGet, "/v1.0/secrets/mystore/mykey"))
+	})
+
+	t.Run("methods of the endpoints matching any method are checked on request", func(t *testing.T) {
+		require.True(t, router.Match(chi.NewRouteContext(), http.MethodPost, "/v1.0/invoke/myapp/method/mymethod"))
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1.0/invoke/myapp/method/mymethod", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		assert.Contains(t, w.Body.String(), "ERR_API_METHOD_DENIED")
+	})
+}
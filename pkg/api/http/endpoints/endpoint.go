@@ -16,6 +16,8 @@ package endpoints
 import (
 	"net/http"
 	"strings"
+
+	"github.com/dapr/dapr/pkg/config"
 )
 
 // Endpoint is a collection of route information for an Dapr API.
@@ -56,6 +58,28 @@ func (endpoint Endpoint) IsAllowed(allowedAPIs map[string]struct{}, deniedAPIs m
 	return endpointMatchesAPIAccessRule(endpoint, allowedAPIs)
 }
 
+// IsMethodAllowed returns true if the method of the endpoint is allowed given
+// the HTTP rules of the API allowlist/denylist, including the rules which
+// apply only to some HTTP verbs.
+func (endpoint Endpoint) IsMethodAllowed(method string, allowedAPIs config.APIAccessRules, deniedAPIs config.APIAccessRules) bool {
+	if endpoint.Settings.AlwaysAllowed {
+		return true
+	}
+
+	denied := deniedAPIs.GetRulesByProtocolAndVerb(config.APIAccessRuleProtocolHTTP, method)
+	if len(denied) > 0 && endpointMatchesAPIAccessRule(endpoint, denied) {
+		return false
+	}
+
+	// The allowlist is present if it has HTTP rules, even if none applies to
+	// the method
+	if !allowedAPIs.HasProtocol(config.APIAccessRuleProtocolHTTP) {
+		return true
+	}
+
+	return endpointMatchesAPIAccessRule(endpoint, allowedAPIs.GetRulesByProtocolAndVerb(config.APIAccessRuleProtocolHTTP, method))
+}
+
 func endpointMatchesAPIAccessRule(endpoint Endpoint, rules map[string]struct{}) (ok bool) {
 	var key string
 
@@ -44,6 +44,7 @@ const (
 	EndpointGroupServiceInvocation EndpointGroupName = "invoke"
 	EndpointGroupState             EndpointGroupName = "state"
 	EndpointGroupPubsub            EndpointGroupName = "publish"
+	EndpointGroupSubscribe         EndpointGroupName = "subscribe"
 	EndpointGroupBindings          EndpointGroupName = "bindings"
 	EndpointGroupSecrets           EndpointGroupName = "secrets"
 	EndpointGroupActors            EndpointGroupName = "actors"
@@ -57,7 +58,10 @@ const (
 	EndpointGroupHealth            EndpointGroupName = "healthz"
 	EndpointGroupJobs              EndpointGroupName = "jobs"
 	EndpointGroupShutdown          EndpointGroupName = "shutdown"
+	EndpointGroupReload            EndpointGroupName = "reload"
+	EndpointGroupFaults            EndpointGroupName = "faults"
 	EndpointGroupConversation      EndpointGroupName = "conversation"
+	EndpointGroupIdentity          EndpointGroupName = "identity"
 )
 
 // EndpointGroupVersion is the version of an endpoint group.
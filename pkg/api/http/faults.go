@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+var endpointGroupFaultsV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupFaults,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: nil, // TODO
+}
+
+// constructFaultsEndpoints returns the endpoints injecting faults in the calls
+// of the sidecar to the apps, actors and components.
+func (a *api) constructFaultsEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "faults",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupFaultsV1Alpha1,
+			Handler: a.onSetFault(),
+			Settings: endpoints.EndpointSettings{
+				Name: "SetFaultAlpha1",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "faults/{name}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupFaultsV1Alpha1,
+			Handler: a.onDeleteFault(),
+			Settings: endpoints.EndpointSettings{
+				Name: "DeleteFaultAlpha1",
+			},
+		},
+	}
+}
+
+func (a *api) onSetFault() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.SetFaultAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.SetFaultRequest, *emptypb.Empty]{
+			OutModifier: func(*emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
+
+func (a *api) onDeleteFault() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.DeleteFaultAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.DeleteFaultRequest, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.DeleteFaultRequest) (*runtimev1pb.DeleteFaultRequest, error) {
+				in.Name = chi.URLParam(r, "name")
+				return in, nil
+			},
+			OutModifier: func(*emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
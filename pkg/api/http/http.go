@@ -40,6 +40,7 @@ import (
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/api/universal"
 	"github.com/dapr/dapr/pkg/channel/http"
+	"github.com/dapr/dapr/pkg/components/configuration/subscription"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -55,6 +56,8 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/sse"
 	"github.com/dapr/dapr/utils"
 	kiterrors "github.com/dapr/kit/errors"
 )
@@ -79,35 +82,55 @@ type api struct {
 	maxRequestBodySize    int64 // In bytes
 	healthz               healthz.Healthz
 	outboundHealthz       healthz.Healthz
+	apiSpec               config.APISpec
+	security              security.Handler
+	jwtAudiences          []string
 }
 
 const (
-	apiVersionV1             = "v1.0"
-	apiVersionV1alpha1       = "v1.0-alpha1"
-	apiVersionV1alpha2       = "v1.0-alpha2"
-	apiVersionV1beta1        = "v1.0-beta1"
-	methodParam              = "method"
-	wildcardParam            = "*"
-	topicParam               = "topic"
-	actorTypeParam           = "actorType"
-	actorIDParam             = "actorId"
-	storeNameParam           = "storeName"
-	stateKeyParam            = "key"
-	configurationKeyParam    = "key"
-	configurationSubscribeID = "configurationSubscribeID"
-	secretStoreNameParam     = "secretStoreName"
-	secretNameParam          = "key"
-	nameParam                = "name"
-	workflowComponent        = "workflowComponent"
-	workflowName             = "workflowName"
-	instanceID               = "instanceID"
-	eventName                = "eventName"
-	consistencyParam         = "consistency"
-	concurrencyParam         = "concurrency"
-	pubsubnameparam          = "pubsubname"
-	traceparentHeader        = "traceparent"
-	tracestateHeader         = "tracestate"
-	daprRuntimeVersionKey    = "daprRuntimeVersion"
+	apiVersionV1               = "v1.0"
+	apiVersionV1alpha1         = "v1.0-alpha1"
+	apiVersionV1alpha2         = "v1.0-alpha2"
+	apiVersionV1beta1          = "v1.0-beta1"
+	methodParam                = "method"
+	wildcardParam              = "*"
+	topicParam                 = "topic"
+	actorTypeParam             = "actorType"
+	actorIDParam               = "actorId"
+	storeNameParam             = "storeName"
+	stateKeyParam              = "key"
+	configurationKeyParam      = "key"
+	configurationSubscribeID   = "configurationSubscribeID"
+	configurationPrefixParam   = "keyPrefix"
+	configurationCoalesceParam = "coalesceIntervalMs"
+	secretStoreNameParam       = "secretStoreName"
+	secretNameParam            = "key"
+	secretNamePrefixParam      = "namePrefix"
+	secretNameRegexParam       = "nameRegex"
+	secretWatchNameParam       = "name"
+	secretWatchIntervalParam   = "pollIntervalSeconds"
+	secretCacheNameParam       = "name"
+	nameParam                  = "name"
+	workflowComponent          = "workflowComponent"
+	workflowName               = "workflowName"
+	instanceID                 = "instanceID"
+	eventName                  = "eventName"
+	consistencyParam           = "consistency"
+	concurrencyParam           = "concurrency"
+	pubsubnameparam            = "pubsubname"
+	dueAfterParam              = "dueAfter"
+	dueBeforeParam             = "dueBefore"
+	pageSizeParam              = "pageSize"
+	runtimeStatusParam         = "runtimeStatus"
+	createdAfterParam          = "createdAfter"
+	createdBeforeParam         = "createdBefore"
+	continuationTokenParam     = "continuationToken"
+	federateParam              = "federate"
+	watchKeyParam              = "key"
+	watchPrefixParam           = "prefix"
+	traceparentHeader          = "traceparent"
+	tracestateHeader           = "tracestate"
+	daprRuntimeVersionKey      = "daprRuntimeVersion"
 )
 
 // APIOpts contains the options for NewAPI.
@@ -123,6 +146,14 @@ type APIOpts struct {
 	MaxRequestBodySize    int64 // In bytes
 	Healthz               healthz.Healthz
 	OutboundHealthz       healthz.Healthz
+	// APISpec contains the API access rules, applied to the endpoints listed
+	// in the OpenAPI document.
+	APISpec config.APISpec
+	// Security is the security handler of the sidecar, which provides the
+	// JWT-SVIDs of the identity API.
+	Security security.Handler
+	// JWTAudiences are the audiences of the JWT-SVIDs the identity API serves.
+	JWTAudiences []string
 }
 
 // NewAPI returns a new API.
@@ -139,6 +170,9 @@ func NewAPI(opts APIOpts) API {
 		maxRequestBodySize:    opts.MaxRequestBodySize,
 		healthz:               opts.Healthz,
 		outboundHealthz:       opts.OutboundHealthz,
+		apiSpec:               opts.APISpec,
+		security:              opts.Security,
+		jwtAudiences:          opts.JWTAudiences,
 	}
 
 	metadataEndpoints := api.constructMetadataEndpoints()
@@ -147,10 +181,15 @@ func NewAPI(opts APIOpts) API {
 	api.endpoints = append(api.endpoints, api.constructStateEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSecretsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructPubSubEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructSubscriptionsEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructInputBindingsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructActorEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructDirectMessagingEndpoints()...)
 	api.endpoints = append(api.endpoints, metadataEndpoints...)
+	api.endpoints = append(api.endpoints, api.constructActiveActorsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructShutdownEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructReloadEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructFaultsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructBindingsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructConfigurationEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSubtleCryptoEndpoints()...)
@@ -160,6 +199,8 @@ func NewAPI(opts APIOpts) API {
 	api.endpoints = append(api.endpoints, api.constructWorkflowEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructJobsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructConversationEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructIdentityEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructOpenAPIEndpoints()...)
 
 	api.publicEndpoints = append(api.publicEndpoints, metadataEndpoints...)
 	api.publicEndpoints = append(api.publicEndpoints, healthEndpoints...)
@@ -256,6 +297,48 @@ func (a *api) constructStateEndpoints() []endpoints.Endpoint {
 				Name: "QueryStateAlpha1",
 			},
 		},
+		{
+			Methods: []string{nethttp.MethodGet},
+			Route:   "state/{storeName}/watch",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupState,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendStateSpanAttributes,
+			},
+			Handler: a.onWatchState,
+			Settings: endpoints.EndpointSettings{
+				Name: "WatchStateAlpha1",
+			},
+		},
+		{
+			Methods: []string{nethttp.MethodPost, nethttp.MethodPut},
+			Route:   "state/{storeName}/query",
+			Version: apiVersionV1beta1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupState,
+				Version:              endpoints.EndpointGroupVersion1beta1,
+				AppendSpanAttributes: appendStateSpanAttributes,
+			},
+			Handler: a.onQueryStateBeta1Handler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "QueryStateBeta1",
+			},
+		},
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/migrate",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupState,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendStateSpanAttributes,
+			},
+			Handler: a.onMigrateStateHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "MigrateStateAlpha1",
+			},
+		},
 	}
 }
 
@@ -417,8 +500,18 @@ func (a *api) constructConfigurationEndpoints() []endpoints.Endpoint {
 func (a *api) onOutputBindingMessage(w nethttp.ResponseWriter, r *nethttp.Request) {
 	name := chi.URLParam(r, nameParam)
 
+	// Bindings are invoked with the whole of the data, so the body is read
+	// into memory rather than streamed.
+	body, err := a.readRequestBody(r)
+	if err != nil {
+		msg := messages.ErrBodyRead.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+
 	var req OutputBindingRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
+	err = json.Unmarshal(body, &req)
 	if err != nil {
 		msg := messages.ErrMalformedRequest.WithFormat(err)
 		respondWithError(w, msg)
@@ -529,9 +622,9 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	var key string
 	reqs := make([]state.GetRequest, len(req.Keys))
 	for i, k := range req.Keys {
-		key, err = stateLoader.GetModifiedStateKey(k, storeName, a.universal.AppID())
+		key, err = stateLoader.GetModifiedStateKeyForRequest(k, storeName, a.universal.AppID(), req.Metadata)
 		if err != nil {
-			status := apierrors.StateStore(storeName).InvalidKeyName(k, err.Error())
+			status := stateKeyError(storeName, k, err)
 			respondWithError(w, status)
 			log.Debug(status)
 			return
@@ -545,7 +638,7 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[[]state.BulkGetResponse](r.Context(),
-		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		a.universal.Resiliency().ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateBulkGet),
 	)
 	responses, err := policyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
 		return store.BulkGet(ctx, reqs, state.BulkGetOpts{
@@ -588,7 +681,12 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 				continue
 			}
 
-			val, err := encryption.TryDecryptValue(storeName, bulkResp[i].Data)
+			resp := &state.GetResponse{
+				Data:     responses[i].Data,
+				ETag:     responses[i].ETag,
+				Metadata: responses[i].Metadata,
+			}
+			val, err := a.universal.DecryptStateValue(r.Context(), storeName, store, responses[i].Key, resp)
 			if err != nil {
 				log.Debugf("Bulk get error: %v", err)
 				bulkResp[i].Data = nil
@@ -597,6 +695,16 @@ func (a *api) onBulkGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 			}
 
 			bulkResp[i].Data = val
+			bulkResp[i].ETag = resp.ETag
+		}
+	}
+
+	for i := range bulkResp {
+		if bulkResp[i].Error == "" {
+			bulkResp[i].TTLInSeconds = a.universal.StateTTLRemaining(r.Context(), storeName, store, responses[i].Key, &state.GetResponse{
+				Data:     responses[i].Data,
+				Metadata: responses[i].Metadata,
+			})
 		}
 	}
 
@@ -624,6 +732,16 @@ func (a *api) getStateStoreWithRequestValidation(w nethttp.ResponseWriter, r *ne
 	return stateStore, storeName, nil
 }
 
+// stateKeyError returns the error responded when the key of a state request
+// cannot be modified: key prefix overrides the state store does not allow are
+// responded as such, and any other error as an invalid key.
+func stateKeyError(storeName, key string, err error) error {
+	if errors.Is(err, apierrors.StateStore(storeName).KeyPrefixNotAllowed("")) {
+		return err
+	}
+	return apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
+}
+
 func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	store, storeName, err := a.getStateStoreWithRequestValidation(w, r)
 	if err != nil {
@@ -635,9 +753,9 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 
 	key := chi.URLParam(r, stateKeyParam)
 	consistency := r.URL.Query().Get(consistencyParam)
-	k, err := stateLoader.GetModifiedStateKey(key, storeName, a.universal.AppID())
+	k, err := stateLoader.GetModifiedStateKeyForRequest(key, storeName, a.universal.AppID(), metadata)
 	if err != nil {
-		status := apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
+		status := stateKeyError(storeName, key, err)
 		respondWithError(w, status)
 		log.Debug(status)
 
@@ -651,17 +769,7 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		Metadata: metadata,
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*state.GetResponse](r.Context(),
-		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
-	)
-	resp, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
-		return store.Get(ctx, req)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.Get, err == nil, elapsed)
-
+	resp, err := a.universal.GetStateThroughCache(r.Context(), storeName, store, req)
 	if err != nil {
 		code := nethttp.StatusInternalServerError
 		kerr, ok := kiterrors.FromError(err)
@@ -681,7 +789,7 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	}
 
 	if encryption.EncryptedStateStore(storeName) {
-		val, err := encryption.TryDecryptValue(storeName, resp.Data)
+		val, err := a.universal.DecryptStateValue(r.Context(), storeName, store, k, resp)
 		if err != nil {
 			resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateGet, key, storeName, err.Error()), errorcodes.StateGet, nethttp.StatusInternalServerError)
 			respondWithError(w, resp)
@@ -695,6 +803,9 @@ func (a *api) onGetState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	if resp.ETag != nil {
 		w.Header().Add(etagHeader, *resp.ETag)
 	}
+	if ttl := a.universal.StateTTLRemaining(r.Context(), storeName, store, k, resp); ttl != nil {
+		w.Header().Add(ttlHeader, strconv.FormatInt(*ttl, 10))
+	}
 
 	setResponseMetadataHeaders(w, resp.Metadata)
 
@@ -748,7 +859,10 @@ func (h *configurationEventHandler) updateEventHandler(ctx context.Context, e *c
 		policyDef := h.res.ComponentInboundPolicy(h.storeName, resiliency.Configuration)
 
 		eventBody := &bytes.Buffer{}
-		_ = json.NewEncoder(eventBody).Encode(e)
+		_ = json.NewEncoder(eventBody).Encode(&ConfigurationUpdateEvent{
+			ID:    e.ID,
+			Items: configurationItems(e.Items),
+		})
 
 		req := invokev1.NewInvokeMethodRequest("/configuration/"+h.storeName+"/"+key).
 			WithHTTPExtension(nethttp.MethodPost, "").
@@ -803,8 +917,16 @@ func (a *api) onSubscribeConfiguration(w nethttp.ResponseWriter, r *nethttp.Requ
 		subscribeKeys = append(subscribeKeys, keys...)
 	}
 
+	sub, err := newConfigurationSubscription(r, subscribeKeys)
+	if err != nil {
+		resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrConfigurationSubscribeInvalid, storeName, err), errorcodes.ConfigurationSubscribeInvalid, nethttp.StatusBadRequest)
+		respondWithError(w, resp)
+		log.Debug(resp)
+		return
+	}
+
 	req := &configuration.SubscribeRequest{
-		Keys:     subscribeKeys,
+		Keys:     sub.StoreKeys(),
 		Metadata: metadata,
 	}
 
@@ -823,7 +945,7 @@ func (a *api) onSubscribeConfiguration(w nethttp.ResponseWriter, r *nethttp.Requ
 		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Configuration),
 	)
 	subscribeID, err := policyRunner(func(ctx context.Context) (string, error) {
-		return store.Subscribe(ctx, req, handler.updateEventHandler)
+		return store.Subscribe(ctx, req, sub.Handler(handler.updateEventHandler))
 	})
 	elapsed := diag.ElapsedSince(start)
 
@@ -921,7 +1043,40 @@ func (a *api) onGetConfiguration(w nethttp.ResponseWriter, r *nethttp.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(getResponse.Items)
+	json.NewEncoder(w).Encode(configurationItems(getResponse.Items))
+}
+
+// newConfigurationSubscription returns the subscription to the keys, and to
+// the key prefixes and with the coalesce interval of the request.
+func newConfigurationSubscription(r *nethttp.Request, keys []string) (*subscription.Subscription, error) {
+	var interval time.Duration
+	if v := r.URL.Query().Get(configurationCoalesceParam); v != "" {
+		ms, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': must be an integer", configurationCoalesceParam, v)
+		}
+		interval = time.Duration(ms) * time.Millisecond
+	}
+	return subscription.New(subscription.Options{
+		Keys:             keys,
+		KeyPrefixes:      r.URL.Query()[configurationPrefixParam],
+		CoalesceInterval: interval,
+	})
+}
+
+// configurationItems returns the configuration items with the type hints of
+// their values.
+func configurationItems(items map[string]*configuration.Item) map[string]ConfigurationItem {
+	res := make(map[string]ConfigurationItem, len(items))
+	for k, v := range universal.ConfigurationItems(items) {
+		res[k] = ConfigurationItem{
+			Value:    v.GetValue(),
+			Version:  v.GetVersion(),
+			Metadata: v.GetMetadata(),
+			Type:     strings.ToLower(strings.TrimPrefix(v.GetType().String(), "CONFIGURATION_VALUE_TYPE_")),
+		}
+	}
+	return res
 }
 
 func extractEtag(r *nethttp.Request) (hasEtag bool, etag string) {
@@ -942,9 +1097,9 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	consistency := r.URL.Query().Get(consistencyParam)
 
 	metadata := getMetadataFromRequest(r)
-	k, err := stateLoader.GetModifiedStateKey(key, storeName, a.universal.AppID())
+	k, err := stateLoader.GetModifiedStateKeyForRequest(key, storeName, a.universal.AppID(), metadata)
 	if err != nil {
-		status := apierrors.StateStore(storeName).InvalidKeyName(key, err.Error())
+		status := stateKeyError(storeName, key, err)
 		respondWithError(w, status)
 		log.Debug(status)
 		return
@@ -965,7 +1120,7 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[any](r.Context(),
-		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		a.universal.Resiliency().ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateDelete),
 	)
 	_, err = policyRunner(func(ctx context.Context) (any, error) {
 		return nil, store.Delete(ctx, &req)
@@ -973,6 +1128,7 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(r.Context(), storeName, diag.Delete, err == nil, elapsed)
+	a.universal.InvalidateStateKeys(r.Context(), storeName, k)
 
 	if err != nil {
 		statusCode, errMsg := a.stateErrorResponse(err)
@@ -981,6 +1137,8 @@ func (a *api) onDeleteState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		log.Debug(apiResp)
 		return
 	}
+
+	a.universal.CancelStateExpiry(r.Context(), storeName, store, k)
 	respondWithEmpty(w)
 }
 
@@ -997,19 +1155,24 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		log.Debug(resp)
 		return
 	}
-	reqs := []state.SetRequest{}
-	err = json.NewDecoder(r.Body).Decode(&reqs)
+	items := []SetStateRequest{}
+	err = json.NewDecoder(r.Body).Decode(&items)
 	if err != nil {
 		resp := messages.NewAPIErrorHTTP(err.Error(), errorcodes.CommonMalformedRequest, nethttp.StatusBadRequest)
 		respondWithError(w, resp)
 		log.Debug(resp)
 		return
 	}
-	if len(reqs) == 0 {
+	if len(items) == 0 {
 		respondWithEmpty(w)
 		return
 	}
 
+	reqs := make([]state.SetRequest, len(items))
+	for i := range items {
+		reqs[i] = items[i].SetRequest
+	}
+
 	metadata := getMetadataFromRequest(r)
 
 	for i, r := range reqs {
@@ -1027,14 +1190,20 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 			maps.Copy(reqs[i].Metadata, metadata)
 		}
 
-		reqs[i].Key, err = stateLoader.GetModifiedStateKey(r.Key, storeName, a.universal.AppID())
+		reqs[i].Key, err = stateLoader.GetModifiedStateKeyForRequest(r.Key, storeName, a.universal.AppID(), reqs[i].Metadata)
 		if err != nil {
-			status := apierrors.StateStore(storeName).InvalidKeyName(r.Key, err.Error())
+			status := stateKeyError(storeName, r.Key, err)
 			respondWithError(w, status)
 			log.Debug(status)
 			return
 		}
 
+		if err = a.universal.ApplyStateTTL(storeName, store, &reqs[i], items[i].TTLInSeconds); err != nil {
+			respondWithError(w, err)
+			log.Debug(err)
+			return
+		}
+
 		if encryption.EncryptedStateStore(storeName) {
 			data := fmt.Appendf(nil, "%v", r.Value)
 			val, encErr := encryption.TryEncryptValue(storeName, data)
@@ -1050,9 +1219,14 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		}
 	}
 
+	keys := make([]string, len(reqs))
+	for i := range reqs {
+		keys[i] = reqs[i].Key
+	}
+
 	start := time.Now()
 	err = stateLoader.PerformBulkStoreOperation(r.Context(), reqs,
-		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		a.universal.Resiliency().ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateSet),
 		state.BulkStoreOpts{},
 		store.Set,
 		store.BulkSet,
@@ -1060,6 +1234,7 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(r.Context(), storeName, diag.Set, err == nil, elapsed)
+	a.universal.InvalidateStateKeys(r.Context(), storeName, keys...)
 
 	if err != nil {
 		statusCode, errMsg := a.stateErrorResponse(err)
@@ -1069,6 +1244,13 @@ func (a *api) onPostState(w nethttp.ResponseWriter, r *nethttp.Request) {
 		return
 	}
 
+	if err = a.universal.ScheduleStateExpiry(r.Context(), storeName, store, reqs...); err != nil {
+		apiResp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateSave, storeName, err.Error()), errorcodes.StateSave, nethttp.StatusInternalServerError)
+		respondWithError(w, apiResp)
+		log.Debug(apiResp)
+		return
+	}
+
 	respondWithEmpty(w)
 }
 
@@ -1113,8 +1295,9 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 		return
 	}
 
-	body, readErr := io.ReadAll(r.Body)
-	defer r.Body.Close()
+	// Pub/sub components publish the whole of the message, so the body is read
+	// into memory rather than streamed.
+	body, readErr := a.readRequestBody(r)
 	if readErr != nil {
 		err := apierrors.PubSub(pubsubName).PublishMessage(topic, readErr)
 		respondWithError(w, err)
@@ -1526,17 +1709,20 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 	for _, o := range req.Operations {
 		switch o.Operation {
 		case string(state.OperationUpsert):
-			var upsertReq state.SetRequest
-			err := mapstructure.Decode(o.Request, &upsertReq)
+			var upsertReq SetStateRequest
+			err := mapstructure.Decode(o.Request, &upsertReq.SetRequest)
+			if err == nil {
+				err = mapstructure.Decode(o.Request, &upsertReq)
+			}
 			if err != nil {
 				msg := messages.ErrMalformedRequest.WithFormat(err)
 				respondWithError(w, msg)
 				log.Debug(msg)
 				return
 			}
-			upsertReq.Key, err = stateLoader.GetModifiedStateKey(upsertReq.Key, storeName, a.universal.AppID())
+			upsertReq.Key, err = stateLoader.GetModifiedStateKeyForRequest(upsertReq.Key, storeName, a.universal.AppID(), upsertReq.Metadata, req.Metadata)
 			if err != nil {
-				status := apierrors.StateStore(storeName).InvalidKeyName(upsertReq.Key, err.Error())
+				status := stateKeyError(storeName, upsertReq.Key, err)
 				respondWithError(w, status)
 				log.Debug(status)
 				return
@@ -1550,7 +1736,13 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 				}
 			}
 
-			operations = append(operations, upsertReq)
+			if err = a.universal.ApplyStateTTL(storeName, store, &upsertReq.SetRequest, upsertReq.TTLInSeconds); err != nil {
+				respondWithError(w, err)
+				log.Debug(err)
+				return
+			}
+
+			operations = append(operations, upsertReq.SetRequest)
 		case string(state.OperationDelete):
 			var delReq state.DeleteRequest
 			err := mapstructure.Decode(o.Request, &delReq)
@@ -1560,9 +1752,9 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 				log.Debug(msg)
 				return
 			}
-			delReq.Key, err = stateLoader.GetModifiedStateKey(delReq.Key, storeName, a.universal.AppID())
+			delReq.Key, err = stateLoader.GetModifiedStateKeyForRequest(delReq.Key, storeName, a.universal.AppID(), delReq.Metadata, req.Metadata)
 			if err != nil {
-				status := apierrors.StateStore(storeName).InvalidKeyName(delReq.Key, err.Error())
+				status := stateKeyError(storeName, delReq.Key, err)
 				respondWithError(w, status)
 				log.Debug(status)
 
@@ -1615,11 +1807,12 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 		}
 	}
 
+	appOperations := operations
 	outboxEnabled := a.outbox.Enabled(storeName)
 	if outboxEnabled {
 		span := diagUtils.SpanFromContext(r.Context())
 		corID, traceState := diag.TraceIDAndStateFromSpan(span)
-		ops, err := a.outbox.PublishInternal(r.Context(), storeName, operations, a.universal.AppID(), corID, traceState)
+		ops, err := a.outbox.PublishInternal(r.Context(), storeName, operations, req.Metadata, a.universal.AppID(), corID, traceState)
 		if err != nil {
 			nerr := apierrors.PubSubOutbox(a.universal.AppID(), err)
 			respondWithError(w, nerr)
@@ -1632,7 +1825,7 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[any](r.Context(),
-		a.universal.Resiliency().ComponentOutboundPolicy(storeName, resiliency.Statestore),
+		a.universal.Resiliency().ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateTransaction),
 	)
 	storeReq := &state.TransactionalStateRequest{
 		Operations: operations,
@@ -1644,16 +1837,155 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.StateInvoked(context.Background(), storeName, diag.StateTransaction, err == nil, elapsed)
+	a.universal.InvalidateStateKeys(r.Context(), storeName, universal.TransactionKeys(appOperations)...)
 
 	if err != nil {
-		resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateTransaction, err.Error()), errorcodes.StateTransaction, nethttp.StatusInternalServerError)
+		var resp error
+		if conflictErr := a.universal.StateTransactionConflictError(r.Context(), storeName, store, appOperations, err); conflictErr != nil {
+			resp = conflictErr
+		} else {
+			resp = messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateTransaction, err.Error()), errorcodes.StateTransaction, nethttp.StatusInternalServerError)
+		}
 		respondWithError(w, resp)
 		log.Debug(resp)
 	} else {
+		if err = a.universal.ScheduleStateTransactionExpiry(r.Context(), storeName, store, appOperations); err != nil {
+			resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrStateTransaction, err.Error()), errorcodes.StateTransaction, nethttp.StatusInternalServerError)
+			respondWithError(w, resp)
+			log.Debug(resp)
+			return
+		}
 		respondWithEmpty(w)
 	}
 }
 
+// onWatchState streams the changes to keys of a state store as server-sent
+// events, each a batch of changes. The first event is sent once the watch is
+// established and has no changes. Errors once the stream has started are sent
+// as an "error" event, which ends the stream.
+func (a *api) onWatchState(w nethttp.ResponseWriter, r *nethttp.Request) {
+	query := r.URL.Query()
+	in := &runtimev1pb.WatchStateRequest{
+		StoreName:   chi.URLParam(r, storeNameParam),
+		Keys:        query[watchKeyParam],
+		KeyPrefixes: query[watchPrefixParam],
+		Metadata:    getMetadataFromRequest(r),
+	}
+
+	var started bool
+	err := a.universal.WatchState(r.Context(), in, func(resp *runtimev1pb.WatchStateResponse) error {
+		if !started {
+			sse.AddSSEHeaders(w)
+			w.WriteHeader(nethttp.StatusOK)
+			started = true
+		}
+
+		event := WatchStateResponse{
+			Changes: make([]StateChange, len(resp.GetChanges())),
+		}
+		for i, change := range resp.GetChanges() {
+			event.Changes[i] = StateChange{
+				Key:       change.GetKey(),
+				Operation: change.GetOperation(),
+				Metadata:  change.GetMetadata(),
+			}
+			if data := change.GetData(); len(data) > 0 {
+				if json.Valid(data) {
+					event.Changes[i].Data = json.RawMessage(data)
+				} else {
+					event.Changes[i].Data, _ = json.Marshal(string(data))
+				}
+			}
+			if etag := change.GetEtag(); etag != "" {
+				event.Changes[i].ETag = &etag
+			}
+		}
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return sse.WriteEvent(w, "", data)
+	})
+	if err == nil {
+		return
+	}
+
+	if !started {
+		respondWithError(w, err)
+		return
+	}
+	if kerr, ok := kiterrors.FromError(err); ok {
+		sse.WriteEvent(w, "error", kerr.JSONErrorValue())
+	} else {
+		data, _ := json.Marshal(map[string]string{"message": err.Error()})
+		sse.WriteEvent(w, "error", data)
+	}
+}
+
+func (a *api) onQueryStateBeta1Handler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.QueryStateBeta1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.QueryStateRequest, *runtimev1pb.QueryStateResponse]{
+			// We pass the input body manually rather than parsing it using protojson
+			SkipInputBody: true,
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.QueryStateRequest) (*runtimev1pb.QueryStateRequest, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				in.Metadata = getMetadataFromRequest(r)
+				if federate := r.URL.Query().Get(federateParam); federate != "" {
+					in.StoreNames = strings.Split(federate, ",")
+				}
+
+				body, err := io.ReadAll(r.Body)
+				defer r.Body.Close()
+				if err != nil {
+					return nil, messages.ErrBodyRead.WithFormat(err)
+				}
+				in.Query = string(body)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.QueryStateResponse) (any, error) {
+				// Unlike the alpha API, an empty page is returned as an empty
+				// list of results rather than no content.
+				qresp := &QueryResponse{
+					Results:  make([]QueryItem, len(out.GetResults())),
+					Token:    out.GetToken(),
+					Metadata: out.GetMetadata(),
+				}
+				for i, item := range out.GetResults() {
+					qresp.Results[i] = QueryItem{
+						Key:       item.GetKey(),
+						Data:      json.RawMessage(item.GetData()),
+						Error:     item.GetError(),
+						StoreName: item.GetStoreName(),
+					}
+					if item.GetEtag() != "" {
+						qresp.Results[i].ETag = &out.Results[i].Etag
+					}
+				}
+				return qresp, nil
+			},
+		},
+	)
+}
+
+func (a *api) onMigrateStateHandler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.MigrateStateAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.MigrateStateRequest, *runtimev1pb.MigrateStateResponse]{
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.MigrateStateRequest) (*runtimev1pb.MigrateStateRequest, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.MigrateStateResponse) (any, error) {
+				// The migration is returned like on the metadata API, with the
+				// status as string.
+				return newMetadataStateMigration(out.GetMigration()), nil
+			},
+		},
+	)
+}
+
 func (a *api) onQueryStateHandler() nethttp.HandlerFunc {
 	return UniversalHTTPHandler(
 		a.universal.QueryStateAlpha1,
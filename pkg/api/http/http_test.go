@@ -21,8 +21,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net"
 	nethttp "net/http"
+	"net/http/httptest"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -53,8 +56,11 @@ import (
 	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
 	actorsstate "github.com/dapr/dapr/pkg/actors/state"
 	statefake "github.com/dapr/dapr/pkg/actors/state/fake"
+	"github.com/dapr/dapr/pkg/actors/table"
+	tablefake "github.com/dapr/dapr/pkg/actors/table/fake"
 	"github.com/dapr/dapr/pkg/actors/timers"
 	timersfake "github.com/dapr/dapr/pkg/actors/timers/fake"
+	"github.com/dapr/dapr/pkg/api/apitoken"
 	daprerrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/api/universal"
@@ -63,10 +69,13 @@ import (
 	httpEndpointsV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	"github.com/dapr/dapr/pkg/channel/http"
+	lockLoader "github.com/dapr/dapr/pkg/components/lock"
+	secretcache "github.com/dapr/dapr/pkg/components/secretstores/cache"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/encryption"
 	"github.com/dapr/dapr/pkg/expr"
+	"github.com/dapr/dapr/pkg/faults"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
@@ -81,6 +90,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/dapr/pkg/security"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
 	"github.com/dapr/dapr/utils"
@@ -118,6 +128,12 @@ var testResiliency = &v1alpha1.Resiliency{
 					Trip:        "consecutiveFailures > 4",
 				},
 			},
+			Hedging: map[string]v1alpha1.Hedging{
+				"fastHedging": {
+					Delay:       "10ms",
+					MaxAttempts: 2,
+				},
+			},
 		},
 		Targets: v1alpha1.Targets{
 			Apps: map[string]v1alpha1.EndpointPolicyNames{
@@ -129,6 +145,9 @@ var testResiliency = &v1alpha1.Resiliency{
 					Retry:          "tenRetries",
 					CircuitBreaker: "simpleCB",
 				},
+				"hedgingApp": {
+					Hedging: "fastHedging",
+				},
 			},
 			Components: map[string]v1alpha1.ComponentPolicyNames{
 				"failSecret": {
@@ -1471,6 +1490,90 @@ func TestV1ActorEndpoints(t *testing.T) {
 		assert.Equal(t, "ERR_ACTOR_REMINDER_NON_HOSTED", resp.ErrorBody["errorCode"])
 	})
 
+	t.Run("Reminder List - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders"
+
+		var gotReq *actorsapi.ListRemindersPageRequest
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New().WithListPage(func(_ context.Context, req *actorsapi.ListRemindersPageRequest) (*actorsapi.ListRemindersPageResponse, error) {
+				gotReq = req
+				return &actorsapi.ListRemindersPageResponse{
+					Reminders: []*actorsapi.Reminder{
+						{Name: "reminder1", ActorType: "fakeActorType", ActorID: "fakeActorID", DueTime: "2026-01-01T00:00:00Z"},
+					},
+					ContinuationToken: "next",
+				}, nil
+			}), nil
+		})
+
+		// act
+		resp := fakeServer(t).DoRequest("GET", apiPath, nil, map[string]string{
+			"actorId":           "fakeActorID",
+			"dueAfter":          "2025-01-01T00:00:00Z",
+			"pageSize":          "10",
+			"continuationToken": "prev",
+		})
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		require.NotNil(t, gotReq)
+		assert.Equal(t, "fakeActorType", gotReq.ActorType)
+		require.NotNil(t, gotReq.ActorID)
+		assert.Equal(t, "fakeActorID", *gotReq.ActorID)
+		require.NotNil(t, gotReq.DueAfter)
+		assert.Nil(t, gotReq.DueBefore)
+		assert.Equal(t, uint32(10), gotReq.PageSize)
+		assert.Equal(t, "prev", gotReq.ContinuationToken)
+
+		var body struct {
+			Reminders []struct {
+				Name    string `json:"name"`
+				ActorID string `json:"actorID"`
+				DueTime string `json:"dueTime"`
+			} `json:"reminders"`
+			ContinuationToken string `json:"continuationToken"`
+		}
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		require.Len(t, body.Reminders, 1)
+		assert.Equal(t, "reminder1", body.Reminders[0].Name)
+		assert.Equal(t, "fakeActorID", body.Reminders[0].ActorID)
+		assert.Equal(t, "next", body.ContinuationToken)
+	})
+
+	t.Run("Reminder List - 400 on invalid due time filter", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders"
+
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New(), nil
+		})
+
+		// act
+		resp := fakeServer(t).DoRequest("GET", apiPath, nil, map[string]string{
+			"dueBefore": "tomorrow",
+		})
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Reminder List - 500 on upstream actor error", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders"
+
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New().WithListPage(func(context.Context, *actorsapi.ListRemindersPageRequest) (*actorsapi.ListRemindersPageResponse, error) {
+				return nil, errors.New("UPSTREAM_ERROR")
+			}), nil
+		})
+
+		// act
+		resp := fakeServer(t).DoRequest("GET", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_REMINDER_LIST", resp.ErrorBody["errorCode"])
+	})
+
 	t.Run("Timer Create - 204 No Content", func(t *testing.T) {
 		apiPath := "v1.0/actors/fakeActorType/fakeActorID/timers/timer1"
 
@@ -1600,7 +1703,7 @@ func TestV1MetadataEndpoint(t *testing.T) {
 			},
 		},
 	}))
-	require.NoError(t, compStore.CommitPendingComponent())
+	require.NoError(t, compStore.CommitPendingComponent("MockComponent1Name"))
 	require.NoError(t, compStore.AddPendingComponentForCommit(componentsV1alpha1.Component{
 		ObjectMeta: metaV1.ObjectMeta{
 			Name: "MockComponent2Name",
@@ -1618,7 +1721,8 @@ func TestV1MetadataEndpoint(t *testing.T) {
 			},
 		},
 	}))
-	require.NoError(t, compStore.CommitPendingComponent())
+	require.NoError(t, compStore.CommitPendingComponent("MockComponent2Name"))
+	compStore.SetComponentInitDuration("MockComponent2Name", 250*time.Millisecond)
 	compStore.SetProgramaticSubscriptions(runtimePubsub.Subscription{
 		PubsubName:      "test",
 		Topic:           "topic",
@@ -1630,6 +1734,10 @@ func TestV1MetadataEndpoint(t *testing.T) {
 				Path:  "path",
 			},
 		},
+		BulkSubscribe: &runtimePubsub.BulkSubscribe{
+			Enabled:            true,
+			MaxAwaitDurationMs: 500,
+		},
 	})
 	compStore.AddHTTPEndpoint(httpEndpointsV1alpha1.HTTPEndpoint{
 		ObjectMeta: metaV1.ObjectMeta{
@@ -1679,8 +1787,18 @@ func TestV1MetadataEndpoint(t *testing.T) {
 				"test": "value",
 			},
 			AppConnectionConfig: appConnectionConfig,
-			GlobalConfig:        &config.Configuration{},
-			Actors:              actors,
+			GlobalConfig: &config.Configuration{
+				Spec: config.ConfigurationSpec{
+					TracingSpec: &config.TracingSpec{SamplingRate: "1"},
+					APISpec: &config.APISpec{
+						Denied: config.APIAccessRules{
+							{Name: "state", Version: "v1.0", Protocol: config.APIAccessRuleProtocolHTTP},
+						},
+					},
+				},
+			},
+			Configurations: []string{"appconfig"},
+			Actors:         actors,
 			WorkflowEngine: fake.New().WithRuntimeMetadata(func() *runtimev1pb.MetadataWorkflows {
 				return &runtimev1pb.MetadataWorkflows{
 					ConnectedWorkers: 1,
@@ -1701,7 +1819,7 @@ func TestV1MetadataEndpoint(t *testing.T) {
 		assert.Equal(t, 204, resp.StatusCode)
 	})
 
-	const expectedBody = `{"id":"xyz","runtimeVersion":"edge","components":[{"name":"MockComponent1Name","type":"mock.component1Type","version":"v1.0","capabilities":["mock.feat.MockComponent1Name"]},{"name":"MockComponent2Name","type":"mock.component2Type","version":"v1.0","capabilities":["mock.feat.MockComponent2Name"]}],"extended":{"daprRuntimeVersion":"edge","foo":"bar","test":"value"},"subscriptions":[{"pubsubname":"test","topic":"topic","rules":[{"path":"path"}],"deadLetterTopic":"dead","type":"PROGRAMMATIC"}],"httpEndpoints":[{"name":"MockHTTPEndpoint"}],"appConnectionProperties":{"port":5000,"protocol":"http","channelAddress":"1.2.3.4","maxConcurrency":10,"health":{"healthCheckPath":"/healthz","healthProbeInterval":"10s","healthProbeTimeout":"5s","healthThreshold":3}},"actorRuntime":{"runtimeStatus":"INITIALIZING","hostReady":false},"workflows":{"connectedWorkers":1}}`
+	const expectedBody = `{"id":"xyz","runtimeVersion":"edge","components":[{"name":"MockComponent1Name","type":"mock.component1Type","version":"v1.0","capabilities":["mock.feat.MockComponent1Name"]},{"name":"MockComponent2Name","type":"mock.component2Type","version":"v1.0","capabilities":["mock.feat.MockComponent2Name"],"initDurationMs":250}],"extended":{"daprRuntimeVersion":"edge","foo":"bar","test":"value"},"subscriptions":[{"pubsubname":"test","topic":"topic","rules":[{"path":"path"}],"deadLetterTopic":"dead","type":"PROGRAMMATIC","bulkSubscribe":{"enabled":true,"maxAwaitDurationMs":500}}],"httpEndpoints":[{"name":"MockHTTPEndpoint"}],"appConnectionProperties":{"port":5000,"protocol":"http","channelAddress":"1.2.3.4","maxConcurrency":10,"health":{"healthCheckPath":"/healthz","healthProbeInterval":"10s","healthProbeTimeout":"5s","healthThreshold":3}},"actorRuntime":{"runtimeStatus":"INITIALIZING","hostReady":false},"workflows":{"connectedWorkers":1},"configuration":{"names":["appconfig"],"tracing":{"samplingRate":"1"},"metrics":{"enabled":true,"httpIncreasedCardinality":true},"api":{"denied":[{"name":"state","version":"v1.0","protocol":"http"}]}}}`
 
 	t.Run("Get Metadata", func(t *testing.T) {
 		var called atomic.Int64
@@ -1720,16 +1838,346 @@ func TestV1MetadataEndpoint(t *testing.T) {
 		}
 
 		assert.Equal(t, 200, resp.StatusCode)
-		assert.JSONEq(t, expectedBody, string(resp.RawBody))
+
+		// The registered error codes are checked separately to keep the
+		// expected body short.
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.NotEmpty(t, body["errorCodes"])
+		assert.Contains(t, string(resp.RawBody), `{"code":"ERR_STATE_GET","reason":"ERR_STATE_GET"}`)
+		delete(body, "errorCodes")
+		rawBody, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, expectedBody, string(rawBody))
 		assert.Equal(t, int64(1), called.Load())
 	})
 }
 
+func TestV1ActiveActorsEndpoint(t *testing.T) {
+	tbl := tablefake.New().
+		WithLen(func() map[string]int {
+			return map[string]int{"cart": 2, "order": 1}
+		}).
+		WithActorIDs(func(actorType string) []string {
+			return map[string][]string{"cart": {"c2", "c1"}, "order": {"o1"}}[actorType]
+		})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:  "fakeAPI",
+			Logger: logger.NewLogger("fakeLogger"),
+			Actors: actorsfake.New().WithTable(func(context.Context) (table.Interface, error) {
+				return tbl, nil
+			}),
+		}),
+	}
+
+	fakeServer := newFakeHTTPServer()
+	fakeServer.StartServer(testAPI.constructActiveActorsEndpoints(), nil)
+	t.Cleanup(fakeServer.Shutdown)
+
+	t.Run("Get active actors counts", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/metadata/actors", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"counts":[{"type":"cart","count":2},{"type":"order","count":1}]}`, string(resp.RawBody))
+	})
+
+	t.Run("Get a page of active actors", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/metadata/actors?actorType=cart&pageSize=1", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, []any{map[string]any{"type": "cart", "id": "c1"}}, body["actors"])
+		require.NotEmpty(t, body["continuationToken"])
+
+		resp = fakeServer.DoRequest("GET", "v1.0-alpha1/metadata/actors?actorType=cart&pageSize=1&continuationToken="+body["continuationToken"].(string), nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"counts":[{"type":"cart","count":2}],"actors":[{"type":"cart","id":"c2"}]}`, string(resp.RawBody))
+	})
+
+	t.Run("Get active actors - invalid page size", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/metadata/actors?pageSize=-1", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+}
+
+func TestV1ResiliencyEndpoint(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddResiliencyResource(v1alpha1.Resiliency{
+		ObjectMeta: metaV1.ObjectMeta{Name: "myresiliency"},
+		Spec: v1alpha1.ResiliencySpec{
+			DryRun: true,
+			Policies: v1alpha1.Policies{
+				CircuitBreakers: map[string]v1alpha1.CircuitBreaker{
+					"failFast": {Trip: "consecutiveFailures > 0"},
+				},
+			},
+			Targets: v1alpha1.Targets{
+				Components: map[string]v1alpha1.ComponentPolicyNames{
+					"statestore": {Outbound: v1alpha1.PolicyNames{CircuitBreaker: "failFast"}},
+				},
+			},
+		},
+	})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:      "fakeAPI",
+			Logger:     logger.NewLogger("fakeLogger"),
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+
+	fakeServer := newFakeHTTPServer()
+	fakeServer.StartServer(testAPI.constructActiveActorsEndpoints(), nil)
+	t.Cleanup(fakeServer.Shutdown)
+
+	resp := fakeServer.DoRequest("GET", "v1.0-alpha1/metadata/resiliency", nil, nil)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.JSONEq(t, `{"resiliencies":[{
+		"name":"myresiliency",
+		"dryRun":true,
+		"policies":[{"type":"circuitBreaker","name":"failFast"}],
+		"targets":[{"type":"component","name":"statestore","direction":"outbound","circuitBreaker":"failFast"}]
+	}]}`, string(resp.RawBody))
+}
+
+func TestV1ReloadEndpoint(t *testing.T) {
+	t.Run("Reload not supported", func(t *testing.T) {
+		testAPI := &api{
+			universal: universal.New(universal.Options{
+				AppID:  "fakeAPI",
+				Logger: logger.NewLogger("fakeLogger"),
+			}),
+		}
+
+		fakeServer := newFakeHTTPServer()
+		fakeServer.StartServer(testAPI.constructReloadEndpoints(), nil)
+		t.Cleanup(fakeServer.Shutdown)
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/reload", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_RELOAD", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Reload the configuration", func(t *testing.T) {
+		testAPI := &api{
+			universal: universal.New(universal.Options{
+				AppID:  "fakeAPI",
+				Logger: logger.NewLogger("fakeLogger"),
+				ReloadFn: func(context.Context) (compstore.ConfigurationReload, error) {
+					return compstore.ConfigurationReload{
+						Applied:         []string{"configuration/logging.level"},
+						RestartRequired: []string{"configuration/tracing"},
+					}, nil
+				},
+			}),
+		}
+
+		fakeServer := newFakeHTTPServer()
+		fakeServer.StartServer(testAPI.constructReloadEndpoints(), nil)
+		t.Cleanup(fakeServer.Shutdown)
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/reload", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"applied":["configuration/logging.level"],"restartRequired":["configuration/tracing"]}`, string(resp.RawBody))
+	})
+}
+
+func TestV1FaultsEndpoints(t *testing.T) {
+	t.Run("Fault injection disabled", func(t *testing.T) {
+		testAPI := &api{
+			universal: universal.New(universal.Options{
+				AppID:  "fakeAPI",
+				Logger: logger.NewLogger("fakeLogger"),
+			}),
+		}
+
+		fakeServer := newFakeHTTPServer()
+		fakeServer.StartServer(testAPI.constructFaultsEndpoints(), nil)
+		t.Cleanup(fakeServer.Shutdown)
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/faults", []byte(`{"fault":{"name":"myfault"}}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_FAULT_INJECTION", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Set and delete a fault", func(t *testing.T) {
+		injector, err := faults.New(faults.Options{Spec: &config.FaultInjectionSpec{}})
+		require.NoError(t, err)
+		testAPI := &api{
+			universal: universal.New(universal.Options{
+				AppID:         "fakeAPI",
+				Logger:        logger.NewLogger("fakeLogger"),
+				FaultInjector: injector,
+			}),
+		}
+
+		fakeServer := newFakeHTTPServer()
+		fakeServer.StartServer(testAPI.constructFaultsEndpoints(), nil)
+		t.Cleanup(fakeServer.Shutdown)
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/faults", []byte(`{"fault":{"name":"myfault","targetType":"app","delay":{"duration":"1s","percentage":50}},"ttl":"10m"}`), nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		list := injector.List()
+		require.Len(t, list, 1)
+		assert.Equal(t, config.FaultSpec{
+			Name:       "myfault",
+			TargetType: "app",
+			Delay:      &config.FaultDelaySpec{Duration: "1s", Percentage: 50},
+			TTL:        "10m",
+		}, list[0].Spec)
+
+		resp = fakeServer.DoRequest("POST", "v1.0-alpha1/faults", []byte(`{"fault":{"name":"myfault"}}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_FAULT_INJECTION", resp.ErrorBody["errorCode"])
+
+		resp = fakeServer.DoRequest("DELETE", "v1.0-alpha1/faults/myfault", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Empty(t, injector.List())
+
+		resp = fakeServer.DoRequest("DELETE", "v1.0-alpha1/faults/myfault", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+}
+
 func createExporters(buffer *string) {
 	exporter := testtrace.NewStringExporter(buffer, logger.NewLogger("fakeLogger"))
 	exporter.Register("fakeID")
 }
 
+type fakeSubscriptions struct {
+	paused map[string]bool
+}
+
+func (f *fakeSubscriptions) PauseSubscription(pubsubName, topic string) error {
+	f.paused[pubsubName+"||"+topic] = true
+	return nil
+}
+
+func (f *fakeSubscriptions) ResumeSubscription(pubsubName, topic string) error {
+	delete(f.paused, pubsubName+"||"+topic)
+	return nil
+}
+
+func (f *fakeSubscriptions) IsSubscriptionPaused(pubsubName, topic string) bool {
+	return f.paused[pubsubName+"||"+topic]
+}
+
+func TestV1SubscriptionsEndpoints(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddPubSub("pubsubname", &runtimePubsub.PubsubItem{Component: &daprt.MockPubSub{}})
+	compStore.SetProgramaticSubscriptions(runtimePubsub.Subscription{
+		PubsubName: "pubsubname",
+		Topic:      "topic",
+	})
+
+	subs := &fakeSubscriptions{paused: map[string]bool{}}
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:         "fakeAPI",
+			Logger:        logger.NewLogger("fakeLogger"),
+			CompStore:     compStore,
+			Subscriptions: subs,
+		}),
+	}
+
+	fakeServer := newFakeHTTPServer()
+	fakeServer.StartServer(testAPI.constructSubscriptionsEndpoints(), nil)
+	t.Cleanup(fakeServer.Shutdown)
+
+	t.Run("Pause and resume subscription", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/subscriptions/pubsubname/topic/pause", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.True(t, subs.IsSubscriptionPaused("pubsubname", "topic"))
+
+		resp = fakeServer.DoRequest("POST", "v1.0-alpha1/subscriptions/pubsubname/topic/resume", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.False(t, subs.IsSubscriptionPaused("pubsubname", "topic"))
+	})
+
+	t.Run("Pause subscription - topic not subscribed", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/subscriptions/pubsubname/other/pause", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Pause subscription - pubsub not found", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/subscriptions/missing/topic/pause", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
+
+type fakeInputBindings struct {
+	paused  map[string]bool
+	persist map[string]bool
+}
+
+func (f *fakeInputBindings) PauseInputBinding(_ context.Context, name string, persist bool) error {
+	f.paused[name] = true
+	f.persist[name] = persist
+	return nil
+}
+
+func (f *fakeInputBindings) ResumeInputBinding(_ context.Context, name string) error {
+	delete(f.paused, name)
+	delete(f.persist, name)
+	return nil
+}
+
+func (f *fakeInputBindings) PausedInputBindings() []string {
+	return slices.Sorted(maps.Keys(f.paused))
+}
+
+func TestV1InputBindingsEndpoints(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddInputBinding("orders", &daprt.MockBinding{})
+
+	inputs := &fakeInputBindings{paused: map[string]bool{}, persist: map[string]bool{}}
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			AppID:         "fakeAPI",
+			Logger:        logger.NewLogger("fakeLogger"),
+			CompStore:     compStore,
+			InputBindings: inputs,
+		}),
+	}
+
+	fakeServer := newFakeHTTPServer()
+	fakeServer.StartServer(testAPI.constructInputBindingsEndpoints(), nil)
+	t.Cleanup(fakeServer.Shutdown)
+
+	t.Run("Pause and resume input binding", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/orders/pause", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.True(t, inputs.paused["orders"])
+		assert.False(t, inputs.persist["orders"])
+
+		resp = fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/orders/resume", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.False(t, inputs.paused["orders"])
+	})
+
+	t.Run("Pause input binding with persistence", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/orders/pause", nil, map[string]string{"persist": "true"})
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.True(t, inputs.persist["orders"])
+
+		resp = fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/orders/pause", nil, map[string]string{"persist": "maybe"})
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Pause input binding - not found", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/bindings/payments/pause", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_INPUT_BINDING_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
+
 func TestV1ActorEndpointsWithTracer(t *testing.T) {
 	buffer := ""
 	spec := config.TracingSpec{SamplingRate: "1"}
@@ -2073,6 +2521,7 @@ func TestConfigurationGet(t *testing.T) {
 		goodkeyVal := rspMap["good-key1"].(map[string]any)
 		assert.Equal(t, "good-value1", goodkeyVal["value"].(string))
 		assert.Equal(t, "version1", goodkeyVal["version"].(string))
+		assert.Equal(t, "string", goodkeyVal["type"].(string))
 		metadata := goodkeyVal["metadata"].(map[string]any)
 		assert.Equal(t, "metadata-value1", metadata["metadata-key1"])
 	})
@@ -2226,6 +2675,32 @@ func TestConfigurationGet(t *testing.T) {
 	})
 }
 
+func TestNewConfigurationSubscription(t *testing.T) {
+	t.Run("key prefixes subscribe to all keys of the store", func(t *testing.T) {
+		r := httptest.NewRequest(nethttp.MethodGet, "/v1.0/configuration/store1/subscribe?keyPrefix=app.&coalesceIntervalMs=100", nil)
+		sub, err := newConfigurationSubscription(r, []string{"key1"})
+		require.NoError(t, err)
+		t.Cleanup(sub.Close)
+		assert.Nil(t, sub.StoreKeys())
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		r := httptest.NewRequest(nethttp.MethodGet, "/v1.0/configuration/store1/subscribe?key=key1", nil)
+		sub, err := newConfigurationSubscription(r, []string{"key1"})
+		require.NoError(t, err)
+		t.Cleanup(sub.Close)
+		assert.Equal(t, []string{"key1"}, sub.StoreKeys())
+	})
+
+	t.Run("invalid subscriptions", func(t *testing.T) {
+		for _, query := range []string{"coalesceIntervalMs=soon", "coalesceIntervalMs=-1", "keyPrefix="} {
+			r := httptest.NewRequest(nethttp.MethodGet, "/v1.0/configuration/store1/subscribe?"+query, nil)
+			_, err := newConfigurationSubscription(r, nil)
+			require.Error(t, err, query)
+		}
+	})
+}
+
 func TestV1Alpha1ConfigurationUnsubscribe(t *testing.T) {
 	var fakeConfigurationStore configuration.Store = &fakeConfigurationStore{}
 
@@ -2375,6 +2850,7 @@ func TestV1Alpha1DistributedLock(t *testing.T) {
 		rspMap := resp.JSONBody.(map[string]any)
 		assert.NotNil(t, rspMap)
 		assert.True(t, rspMap["success"].(bool))
+		assert.NotEmpty(t, rspMap["fencingToken"])
 	})
 
 	t.Run("Lock with invalid resource id", func(t *testing.T) {
@@ -2504,6 +2980,67 @@ func TestV1Alpha1DistributedLock(t *testing.T) {
 		assert.NotNil(t, rspMap)
 		assert.InDelta(t, float64(3), rspMap["status"], 0)
 	})
+
+	t.Run("Acquire lock with valid request", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/lock/store1/acquire"
+
+		b := []byte(`{"resourceId":"1","lockOwner":"palpatine","expiryInSeconds":5,"waitTimeoutInSeconds":5}`)
+
+		resp := fakeServer(t).DoRequest("POST", apiPath, b, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		// assert
+		assert.NotNil(t, resp.JSONBody)
+		rspMap := resp.JSONBody.(map[string]any)
+		assert.NotNil(t, rspMap)
+		assert.True(t, rspMap["success"].(bool))
+		assert.NotEmpty(t, rspMap["fencingToken"])
+	})
+
+	t.Run("Acquire lock with invalid wait timeout", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/lock/store1/acquire"
+
+		b := []byte(`{"resourceId":"1","lockOwner":"palpatine","expiryInSeconds":5,"waitTimeoutInSeconds":-1}`)
+
+		resp := fakeServer(t).DoRequest("POST", apiPath, b, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("Renew lock with valid request", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/lock/store1/renew"
+
+		req := lock.TryLockRequest{
+			ResourceID:      "1",
+			LockOwner:       "palpatine",
+			ExpiryInSeconds: 5,
+		}
+
+		b, _ := json.Marshal(&req)
+
+		resp := fakeServer(t).DoRequest("POST", apiPath, b, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		// assert
+		assert.NotNil(t, resp.JSONBody)
+		rspMap := resp.JSONBody.(map[string]any)
+		assert.NotNil(t, rspMap)
+		assert.InDelta(t, float64(0), rspMap["status"], 0)
+		assert.NotEmpty(t, rspMap["fencingToken"])
+	})
+
+	t.Run("Renew lock with invalid expiry", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/lock/store1/renew"
+
+		req := lock.TryLockRequest{
+			ResourceID: "1",
+			LockOwner:  "palpatine",
+		}
+
+		b, _ := json.Marshal(&req)
+
+		resp := fakeServer(t).DoRequest("POST", apiPath, b, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
 }
 
 func TestV1Workflow(t *testing.T) {
@@ -2904,7 +3441,9 @@ func (f *fakeHTTPServer) StartServer(endpoints []endpoints.Endpoint, opts *fakeH
 }
 
 func (f *fakeHTTPServer) getRouter(endpoints []endpoints.Endpoint, apiAuth bool) chi.Router {
-	srv := &server{}
+	srv := &server{
+		apiTokens: apitoken.New(apitoken.Options{Token: security.GetAPIToken()}),
+	}
 
 	r := srv.getRouter()
 
@@ -3961,6 +4500,38 @@ func TestV1SecretEndpoints(t *testing.T) {
 		assert.Equal(t, expectedOutput, body, "bulk secret response should be same as expected")
 	})
 
+	t.Run("Get Bulk secret - name filters", func(t *testing.T) {
+		apiPath := fmt.Sprintf("v1.0/secrets/%s/bulk", storeName)
+		// act
+		resp := fakeServer(t).DoRequest("GET", apiPath, nil, map[string]string{"namePrefix": "good-", "nameRegex": "key$"})
+		// assert
+		assert.Equal(t, 200, resp.StatusCode, "reading secrets should succeed")
+		assert.Equal(t, map[string]any{"good-key": map[string]any{"good-key": "life is good"}}, resp.JSONBody)
+
+		resp = fakeServer(t).DoRequest("GET", apiPath, nil, map[string]string{"namePrefix": "bad-"})
+		assert.Equal(t, 200, resp.StatusCode, "reading secrets should succeed")
+		assert.Equal(t, map[string]any{}, resp.JSONBody)
+
+		resp = fakeServer(t).DoRequest("GET", apiPath, nil, map[string]string{"nameRegex": "("})
+		assert.Equal(t, 400, resp.StatusCode, "invalid name regex should fail with 400")
+		assert.Equal(t, "ERR_SECRET_NAME_FILTER_INVALID", resp.ErrorBody["errorCode"], apiPath)
+	})
+
+	t.Run("Purge secrets cache", func(t *testing.T) {
+		compStore.AddSecretStoreCache("store4", secretcache.New(secretcache.Options{TTL: time.Minute}))
+		apiPath := fmt.Sprintf("v1.0-alpha1/secrets/%s/cache", "store4")
+		resp := fakeServer(t).DoRequest("GET", fmt.Sprintf("v1.0/secrets/%s/good-key", "store4"), nil, nil)
+		require.Equal(t, 200, resp.StatusCode)
+
+		resp = fakeServer(t).DoRequest("DELETE", apiPath, nil, map[string]string{"name": "good-key"})
+		assert.Equal(t, 200, resp.StatusCode, "purging the cache should succeed")
+		assert.Equal(t, map[string]any{"purged": float64(1)}, resp.JSONBody)
+
+		resp = fakeServer(t).DoRequest("DELETE", fmt.Sprintf("v1.0-alpha1/secrets/%s/cache", storeName), nil, nil)
+		assert.Equal(t, 400, resp.StatusCode, "purging a store without cache should fail with 400")
+		assert.Equal(t, "ERR_SECRET_CACHE_NOT_ENABLED", resp.ErrorBody["errorCode"], apiPath)
+	})
+
 	t.Run("Get secret - retries on initial failure with resiliency", func(t *testing.T) {
 		apiPath := fmt.Sprintf("v1.0/secrets/%s/key", "failSecret")
 
@@ -4128,7 +4699,15 @@ func (l *fakeLockStore) TryLock(ctx context.Context, req *lock.TryLockRequest) (
 	}
 
 	return &lock.TryLockResponse{
-		Success: true,
+		Success:  true,
+		Metadata: map[string]string{lockLoader.FencingTokenMetadataKey: "1"},
+	}, nil
+}
+
+func (l *fakeLockStore) RenewLock(ctx context.Context, req *lockLoader.RenewLockRequest) (*lockLoader.RenewLockResponse, error) {
+	return &lockLoader.RenewLockResponse{
+		Status:   lock.Success,
+		Metadata: map[string]string{lockLoader.FencingTokenMetadataKey: "1"},
 	}, nil
 }
 
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
+)
+
+const audienceParam = "audience"
+
+var endpointGroupIdentityV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupIdentity,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: nil, // TODO
+}
+
+func (a *api) constructIdentityEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "identity/jwt",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupIdentityV1Alpha1,
+			Handler: a.onGetJWTSVID,
+			Settings: endpoints.EndpointSettings{
+				Name: "GetJWTSVID",
+			},
+		},
+	}
+}
+
+type jwtSVIDResponse struct {
+	Token     string    `json:"token"`
+	Audience  string    `json:"audience"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// onGetJWTSVID returns the JWT-SVID of the sidecar minted by sentry for the
+// audience in the query string. Only the audiences the sidecar requested from
+// sentry are served, and the TTL of the token is set by sentry.
+func (a *api) onGetJWTSVID(w http.ResponseWriter, r *http.Request) {
+	audience := r.URL.Query().Get(audienceParam)
+	if audience == "" {
+		err := messages.ErrBadRequest.WithFormat("missing query parameter '" + audienceParam + "'")
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	if a.security == nil {
+		err := messages.ErrIdentityJWTNotAvailable.WithFormat("security is not configured")
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+	if !slices.Contains(a.jwtAudiences, audience) {
+		err := messages.ErrIdentityJWTAudience.WithFormat(audience)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	token, err := a.security.FetchJWT(r.Context(), audience)
+	if err != nil {
+		err = messages.ErrIdentityJWTNotAvailable.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	// The token was issued by sentry, so it is not verified again here.
+	parsed, err := jwt.ParseInsecure([]byte(token))
+	if err != nil {
+		err = messages.ErrIdentityJWTNotAvailable.WithFormat(err)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, jwtSVIDResponse{
+		Token:     token,
+		Audience:  audience,
+		ExpiresAt: parsed.Expiration().UTC(),
+	})
+}
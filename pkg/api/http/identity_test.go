@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	securityfake "github.com/dapr/dapr/pkg/security/fake"
+)
+
+func TestIdentityEndpoints(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	tkn, err := jwt.NewBuilder().Audience([]string{"sts.amazonaws.com"}).Expiration(exp).Build()
+	require.NoError(t, err)
+	signed, err := jwt.Sign(tkn, jwt.WithKey(jwa.HS256, []byte("key")))
+	require.NoError(t, err)
+
+	sec := securityfake.New().WithFetchJWT(func(ctx context.Context, audience string) (string, error) {
+		if audience == "broken" {
+			return "", errors.New("SPIFFE JWT source not available")
+		}
+		return string(signed), nil
+	})
+
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		security:     sec,
+		jwtAudiences: []string{"sts.amazonaws.com", "broken"},
+	}
+	fakeServer.StartServer(testAPI.constructIdentityEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("JWT-SVID of an allowed audience - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodGet, apiVersionV1alpha1+"/identity/jwt", nil, map[string]string{"audience": "sts.amazonaws.com"})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(resp.RawBody))
+
+		var got jwtSVIDResponse
+		require.NoError(t, json.Unmarshal(resp.RawBody, &got))
+		assert.Equal(t, string(signed), got.Token)
+		assert.Equal(t, "sts.amazonaws.com", got.Audience)
+		assert.True(t, exp.Equal(got.ExpiresAt))
+	})
+
+	t.Run("missing audience - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodGet, apiVersionV1alpha1+"/identity/jwt", nil, nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("audience not requested by the sidecar - 403", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodGet, apiVersionV1alpha1+"/identity/jwt", nil, map[string]string{"audience": "other"})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "ERR_IDENTITY_JWT_AUDIENCE", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("JWT-SVID not available - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodGet, apiVersionV1alpha1+"/identity/jwt", nil, map[string]string{"audience": "broken"})
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, "ERR_IDENTITY_JWT_NOT_AVAILABLE", resp.ErrorBody["errorCode"])
+	})
+}
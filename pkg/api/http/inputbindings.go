@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+var endpointGroupBindingsV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupBindings,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: appendBindingsSpanAttributes,
+}
+
+func (a *api) constructInputBindingsEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "bindings/{name}/pause",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupBindingsV1Alpha1,
+			Handler: a.onPauseInputBindingHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "PauseInputBindingAlpha1",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "bindings/{name}/resume",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupBindingsV1Alpha1,
+			Handler: a.onResumeInputBindingHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ResumeInputBindingAlpha1",
+			},
+		},
+	}
+}
+
+// Route: POST "bindings/{name}/pause?persist=true"
+func (a *api) onPauseInputBindingHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.PauseInputBindingAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.PauseInputBindingRequest, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.PauseInputBindingRequest) (*runtimev1pb.PauseInputBindingRequest, error) {
+				in.Name = chi.URLParam(r, nameParam)
+				if val := r.URL.Query().Get("persist"); val != "" {
+					persist, err := strconv.ParseBool(val)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat("invalid persist parameter: " + val)
+					}
+					in.Persist = persist
+				}
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		})
+}
+
+// Route: POST "bindings/{name}/resume"
+func (a *api) onResumeInputBindingHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ResumeInputBindingAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ResumeInputBindingRequest, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ResumeInputBindingRequest) (*runtimev1pb.ResumeInputBindingRequest, error) {
+				in.Name = chi.URLParam(r, nameParam)
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		})
+}
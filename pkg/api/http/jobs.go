@@ -14,18 +14,25 @@ limitations under the License.
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
 
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 )
 
+// jobLabelParamPrefix is the prefix of the query parameters selecting jobs by
+// label when listing jobs.
+const jobLabelParamPrefix = "label."
+
 var endpointGroupJobsV1Alpha1 = &endpoints.EndpointGroup{
 	Name:                 endpoints.EndpointGroupJobs,
 	Version:              endpoints.EndpointGroupVersion1alpha1,
@@ -102,6 +109,36 @@ func (a *api) constructJobsEndpoints() []endpoints.Endpoint {
 				Name: "GetJob",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "jobs",
+			Version: apiVersionV1,
+			Group:   endpointGroupJobsV1,
+			Handler: a.onListJobsHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ListJobs",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "jobs/bulk/schedule",
+			Version: apiVersionV1,
+			Group:   endpointGroupJobsV1,
+			Handler: a.onBulkScheduleJobsHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkScheduleJobs",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "jobs/bulk/delete",
+			Version: apiVersionV1,
+			Group:   endpointGroupJobsV1,
+			Handler: a.onBulkDeleteJobsHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "BulkDeleteJobs",
+			},
+		},
 	}
 }
 
@@ -168,3 +205,65 @@ func (a *api) onGetJobHandler() http.HandlerFunc {
 		},
 	)
 }
+
+// onListJobsHandler lists the jobs of the app. Jobs can be filtered with
+// `label.<key>=<value>` query parameters, which must all match, and the
+// `dueAfter` and `dueBefore` RFC3339 times. Results are paginated with
+// `pageSize` and `continuationToken`.
+func (a *api) onListJobsHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ListJobs,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ListJobsRequest, *runtimev1pb.ListJobsResponse]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ListJobsRequest) (*runtimev1pb.ListJobsRequest, error) {
+				qs := r.URL.Query()
+				for k, v := range qs {
+					label, ok := strings.CutPrefix(k, jobLabelParamPrefix)
+					if !ok || len(label) == 0 || len(v) == 0 {
+						continue
+					}
+					if in.LabelSelector == nil {
+						in.LabelSelector = make(map[string]string)
+					}
+					in.LabelSelector[label] = v[0]
+				}
+				if qs.Has(dueAfterParam) {
+					in.DueAfter = new(qs.Get(dueAfterParam))
+				}
+				if qs.Has(dueBeforeParam) {
+					in.DueBefore = new(qs.Get(dueBeforeParam))
+				}
+				if v := qs.Get(pageSizeParam); len(v) > 0 {
+					size, err := strconv.ParseUint(v, 10, 32)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: %v", pageSizeParam, err))
+					}
+					in.PageSize = new(uint32(size))
+				}
+				if qs.Has(continuationTokenParam) {
+					in.ContinuationToken = new(qs.Get(continuationTokenParam))
+				}
+				return in, nil
+			},
+		},
+	)
+}
+
+// onBulkScheduleJobsHandler schedules a batch of jobs. The response lists the
+// jobs which failed to be scheduled, and is successful even if some jobs
+// failed.
+func (a *api) onBulkScheduleJobsHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.BulkScheduleJobsHTTP,
+		UniversalHTTPHandlerOpts[*internalsv1pb.BulkJobsHTTPRequest, *runtimev1pb.BulkScheduleJobsResponse]{},
+	)
+}
+
+// onBulkDeleteJobsHandler deletes a batch of jobs by name. The response lists
+// the jobs which failed to be deleted.
+func (a *api) onBulkDeleteJobsHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.BulkDeleteJobs,
+		UniversalHTTPHandlerOpts[*runtimev1pb.BulkDeleteJobsRequest, *runtimev1pb.BulkDeleteJobsResponse]{},
+	)
+}
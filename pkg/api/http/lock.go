@@ -54,6 +54,34 @@ func (a *api) constructDistributedLockEndpoints() []endpoints.Endpoint {
 				Name: "Unlock",
 			},
 		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "lock/{storeName}/renew",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupLock,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: nil, // TODO
+			},
+			Handler: a.onRenewLockAlpha1(),
+			Settings: endpoints.EndpointSettings{
+				Name: "RenewLock",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "lock/{storeName}/acquire",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupLock,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: nil, // TODO
+			},
+			Handler: a.onAcquireLockAlpha1(),
+			Settings: endpoints.EndpointSettings{
+				Name: "AcquireLock",
+			},
+		},
 	}
 }
 
@@ -71,6 +99,20 @@ func (a *api) onTryLockAlpha1() http.HandlerFunc {
 	)
 }
 
+func (a *api) onAcquireLockAlpha1() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.AcquireLockAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.AcquireLockRequest, *runtimev1pb.AcquireLockResponse]{
+			InModifier: func(r *http.Request, in *runtimev1pb.AcquireLockRequest) (*runtimev1pb.AcquireLockRequest, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				return in, nil
+			},
+			// We need to emit unpopulated fields in the response
+			ProtoResponseEmitUnpopulated: true,
+		},
+	)
+}
+
 func (a *api) onUnlockAlpha1() http.HandlerFunc {
 	return UniversalHTTPHandler(
 		a.universal.UnlockAlpha1,
@@ -96,3 +138,29 @@ func (a *api) onUnlockAlpha1() http.HandlerFunc {
 		},
 	)
 }
+
+func (a *api) onRenewLockAlpha1() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.RenewLockAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.RenewLockRequest, *runtimev1pb.RenewLockResponse]{
+			InModifier: func(r *http.Request, in *runtimev1pb.RenewLockRequest) (*runtimev1pb.RenewLockRequest, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.RenewLockResponse) (any, error) {
+				// Report the status as a number, as for unlocking
+				b, err := protojson.MarshalOptions{
+					EmitUnpopulated: true,
+					UseEnumNumbers:  true,
+				}.Marshal(out)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response as JSON: %w", err)
+				}
+				return UniversalHTTPRawResponse{
+					Body:        b,
+					ContentType: jsonContentTypeHeader,
+				}, nil
+			},
+		},
+	)
+}
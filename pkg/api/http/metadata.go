@@ -14,8 +14,10 @@ limitations under the License.
 package http
 
 import (
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -56,6 +58,70 @@ func (a *api) constructMetadataEndpoints() []endpoints.Endpoint {
 	}
 }
 
+// constructActiveActorsEndpoints returns the endpoints listing the actors
+// active on this host. Unlike the other metadata endpoints, they aren't served
+// on the public port, as the actor IDs may be sensitive.
+func (a *api) constructActiveActorsEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "metadata/actors",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupMetadataV1Alpha1,
+			Handler: a.onGetActiveActors(),
+			Settings: endpoints.EndpointSettings{
+				Name: "GetActiveActorsAlpha1",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "metadata/resiliency",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupMetadataV1Alpha1,
+			Handler: a.onGetResiliency(),
+			Settings: endpoints.EndpointSettings{
+				Name: "GetResiliencyAlpha1",
+			},
+		},
+	}
+}
+
+// onGetResiliency lists the loaded resiliency policies, the targets they apply
+// to, and the state of their circuit breakers.
+func (a *api) onGetResiliency() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.GetResiliencyAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.GetResiliencyRequest, *runtimev1pb.GetResiliencyResponse]{
+			SkipInputBody: true,
+		})
+}
+
+// onGetActiveActors lists the counts per type of the actors active on this
+// host. The actors can be filtered by the `actorType` query parameter, and a
+// page of their IDs is listed with `pageSize` and `continuationToken`.
+func (a *api) onGetActiveActors() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.GetActiveActorsAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.GetActiveActorsRequest, *runtimev1pb.GetActiveActorsResponse]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.GetActiveActorsRequest) (*runtimev1pb.GetActiveActorsRequest, error) {
+				qs := r.URL.Query()
+				in.ActorType = qs.Get(actorTypeParam)
+				if v := qs.Get(pageSizeParam); len(v) > 0 {
+					size, err := strconv.ParseUint(v, 10, 32)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: %v", pageSizeParam, err))
+					}
+					in.PageSize = new(uint32(size))
+				}
+				if qs.Has(continuationTokenParam) {
+					in.ContinuationToken = new(qs.Get(continuationTokenParam))
+				}
+				return in, nil
+			},
+		})
+}
+
 func (a *api) onGetMetadata() http.HandlerFunc {
 	return UniversalHTTPHandler(
 		a.universal.GetMetadata,
@@ -67,14 +133,29 @@ func (a *api) onGetMetadata() http.HandlerFunc {
 					ID:       out.GetId(),
 					Extended: out.GetExtendedMetadata(),
 					// We can embed the proto object directly only for as long as the protojson key is == json key
-					ActiveActorsCount:    out.GetActiveActorsCount(), //nolint:staticcheck
-					RegisteredComponents: out.GetRegisteredComponents(),
-					HTTPEndpoints:        out.GetHttpEndpoints(),
-					RuntimeVersion:       out.GetRuntimeVersion(),
-					EnabledFeatures:      out.GetEnabledFeatures(),
+					ActiveActorsCount: out.GetActiveActorsCount(), //nolint:staticcheck
+					HTTPEndpoints:     out.GetHttpEndpoints(),
+					RuntimeVersion:    out.GetRuntimeVersion(),
+					EnabledFeatures:   out.GetEnabledFeatures(),
 					//nolint:protogetter
-					Scheduler:  out.Scheduler,
-					MCPServers: out.GetMcpServers(),
+					Scheduler:           out.Scheduler,
+					MCPServers:          out.GetMcpServers(),
+					PausedInputBindings: out.GetPausedInputBindings(),
+				}
+
+				// Copy the components into a custom struct, as the protojson key
+				// of the init duration isn't the json key
+				if len(out.GetRegisteredComponents()) > 0 {
+					res.RegisteredComponents = make([]metadataRegisteredComponent, len(out.GetRegisteredComponents()))
+					for i, c := range out.GetRegisteredComponents() {
+						res.RegisteredComponents[i] = metadataRegisteredComponent{
+							Name:           c.GetName(),
+							Type:           c.GetType(),
+							Version:        c.GetVersion(),
+							Capabilities:   c.GetCapabilities(),
+							InitDurationMs: c.GetInitDurationMs(),
+						}
+					}
 				}
 
 				// Copy the app connection properties into a custom struct
@@ -105,6 +186,15 @@ func (a *api) onGetMetadata() http.HandlerFunc {
 							Metadata:        v.GetMetadata(),
 							DeadLetterTopic: v.GetDeadLetterTopic(),
 							Type:            v.GetType().String(),
+							Paused:          v.GetPaused(),
+						}
+
+						if bulk := v.GetBulkSubscribe(); bulk != nil {
+							subs[i].BulkSubscribe = &metadataResponsePubsubBulkSubscribe{
+								Enabled:            bulk.GetEnabled(),
+								MaxMessagesCount:   bulk.GetMaxMessagesCount(),
+								MaxAwaitDurationMs: bulk.GetMaxAwaitDurationMs(),
+							}
 						}
 
 						if v.GetRules() != nil && len(v.GetRules().GetRules()) > 0 {
@@ -137,7 +227,114 @@ func (a *api) onGetMetadata() http.HandlerFunc {
 				}
 
 				res.WorkflowAccessPolicies = out.GetWorkflowAccessPolicies()
-				res.Resiliencies = out.GetResiliencies()
+				res.ErrorCodes = out.GetErrorCodes()
+
+				// Resiliencies and circuit breakers
+				// The protojson keys of the targets aren't the json keys
+				if len(out.GetResiliencies()) > 0 {
+					res.Resiliencies = make([]metadataResiliency, len(out.GetResiliencies()))
+					for i, r := range out.GetResiliencies() {
+						res.Resiliencies[i] = newMetadataResiliency(r)
+					}
+				}
+				if len(out.GetCircuitBreakers()) > 0 {
+					res.CircuitBreakers = make([]metadataCircuitBreaker, len(out.GetCircuitBreakers()))
+					for i, cb := range out.GetCircuitBreakers() {
+						res.CircuitBreakers[i] = metadataCircuitBreaker{
+							Name:       cb.GetName(),
+							Policy:     cb.GetPolicy(),
+							TargetType: cb.GetTargetType(),
+							TargetName: cb.GetTargetName(),
+							State:      cb.GetState(),
+						}
+					}
+				}
+
+				// Faults
+				// The protojson keys of the faults aren't the json keys
+				if len(out.GetFaults()) > 0 {
+					res.Faults = make([]metadataFault, len(out.GetFaults()))
+					for i, f := range out.GetFaults() {
+						res.Faults[i] = metadataFault{
+							Name:          f.GetFault().GetName(),
+							TargetType:    f.GetFault().GetTargetType(),
+							BuildingBlock: f.GetFault().GetBuildingBlock(),
+							Target:        f.GetFault().GetTarget(),
+							Delay:         f.GetFault().GetDelay(),
+							Error:         f.GetFault().GetError(),
+							ExpireTime:    f.GetExpireTime(),
+						}
+					}
+				}
+
+				// State migrations
+				// We need to include the status as string
+				if len(out.GetStateMigrations()) > 0 {
+					res.StateMigrations = make([]metadataStateMigration, len(out.GetStateMigrations()))
+					for i, m := range out.GetStateMigrations() {
+						res.StateMigrations[i] = newMetadataStateMigration(m)
+					}
+				}
+
+				// Component reloads
+				// We need to include the status as string
+				if len(out.GetComponentReloads()) > 0 {
+					res.ComponentReloads = make([]metadataComponentReload, len(out.GetComponentReloads()))
+					for i, r := range out.GetComponentReloads() {
+						res.ComponentReloads[i] = metadataComponentReload{
+							Name:    r.GetName(),
+							Type:    r.GetType(),
+							Version: r.GetVersion(),
+							Swapped: r.GetSwapped(),
+							Status:  r.GetStatus().String(),
+							Time:    r.GetTime(),
+							Error:   r.GetError(),
+						}
+					}
+				}
+
+				// Configuration reloads
+				if len(out.GetConfigurationReloads()) > 0 {
+					res.ConfigurationReloads = make([]metadataConfigurationReload, len(out.GetConfigurationReloads()))
+					for i, r := range out.GetConfigurationReloads() {
+						res.ConfigurationReloads[i] = metadataConfigurationReload{
+							Time:            r.GetTime(),
+							Applied:         r.GetApplied(),
+							RestartRequired: r.GetRestartRequired(),
+							Error:           r.GetError(),
+						}
+					}
+				}
+
+				// Configuration
+				// We need to include the tracing and metrics with json keys
+				if cfg := out.GetConfiguration(); cfg != nil {
+					res.Configuration = &metadataConfiguration{
+						Names:        cfg.GetNames(),
+						Features:     cfg.GetFeatures(),
+						API:          cfg.GetApi(),
+						Resiliencies: cfg.GetResiliencies(),
+					}
+					if tracing := cfg.GetTracing(); tracing != nil {
+						res.Configuration.Tracing = &metadataTracing{
+							SamplingRate:          tracing.GetSamplingRate(),
+							Stdout:                tracing.GetStdout(),
+							ZipkinEndpointAddress: tracing.GetZipkinEndpointAddress(),
+							OtelEndpointAddress:   tracing.GetOtelEndpointAddress(),
+							OtelProtocol:          tracing.GetOtelProtocol(),
+							OtelIsSecure:          tracing.GetOtelIsSecure(),
+						}
+					}
+					if metrics := cfg.GetMetrics(); metrics != nil {
+						res.Configuration.Metrics = &metadataMetrics{
+							Enabled:                    metrics.GetEnabled(),
+							RecordErrorCodes:           metrics.GetRecordErrorCodes(),
+							HTTPIncreasedCardinality:   metrics.GetHttpIncreasedCardinality(),
+							HTTPExcludeVerbs:           metrics.GetHttpExcludeVerbs(),
+							LatencyDistributionBuckets: metrics.GetLatencyDistributionBuckets(),
+						}
+					}
+				}
 
 				return res, nil
 			},
@@ -174,7 +371,7 @@ type metadataResponse struct {
 	RuntimeVersion          string                                      `json:"runtimeVersion,omitempty"`
 	EnabledFeatures         []string                                    `json:"enabledFeatures,omitempty"`
 	ActiveActorsCount       []*runtimev1pb.ActiveActorsCount            `json:"actors,omitempty"`
-	RegisteredComponents    []*runtimev1pb.RegisteredComponents         `json:"components,omitempty"`
+	RegisteredComponents    []metadataRegisteredComponent               `json:"components,omitempty"`
 	Extended                map[string]string                           `json:"extended,omitempty"`
 	Subscriptions           []metadataResponsePubsubSubscription        `json:"subscriptions,omitempty"`
 	HTTPEndpoints           []*runtimev1pb.MetadataHTTPEndpoint         `json:"httpEndpoints,omitempty"`
@@ -184,7 +381,156 @@ type metadataResponse struct {
 	Workflows               metadataWorkflows                           `json:"workflows,omitzero"`
 	MCPServers              []*runtimev1pb.MetadataMCPServer            `json:"mcpServers,omitempty"`
 	WorkflowAccessPolicies  []*runtimev1pb.MetadataWorkflowAccessPolicy `json:"workflowAccessPolicies,omitempty"`
-	Resiliencies            []*runtimev1pb.MetadataResiliency           `json:"resiliencies,omitempty"`
+	Resiliencies            []metadataResiliency                        `json:"resiliencies,omitempty"`
+	CircuitBreakers         []metadataCircuitBreaker                    `json:"circuitBreakers,omitempty"`
+	StateMigrations         []metadataStateMigration                    `json:"stateMigrations,omitempty"`
+	ErrorCodes              []*runtimev1pb.MetadataErrorCodes           `json:"errorCodes,omitempty"`
+	ComponentReloads        []metadataComponentReload                   `json:"componentReloads,omitempty"`
+	ConfigurationReloads    []metadataConfigurationReload               `json:"configurationReloads,omitempty"`
+	Configuration           *metadataConfiguration                      `json:"configuration,omitempty"`
+	Faults                  []metadataFault                             `json:"faults,omitempty"`
+	PausedInputBindings     []string                                    `json:"pausedInputBindings,omitempty"`
+}
+
+type metadataConfiguration struct {
+	Names        []string                       `json:"names,omitempty"`
+	Tracing      *metadataTracing               `json:"tracing,omitempty"`
+	Metrics      *metadataMetrics               `json:"metrics,omitempty"`
+	Features     []*runtimev1pb.MetadataFeature `json:"features,omitempty"`
+	API          *runtimev1pb.MetadataAPIAccess `json:"api,omitempty"`
+	Resiliencies []string                       `json:"resiliencies,omitempty"`
+}
+
+type metadataTracing struct {
+	SamplingRate          string `json:"samplingRate,omitempty"`
+	Stdout                bool   `json:"stdout,omitempty"`
+	ZipkinEndpointAddress string `json:"zipkinEndpointAddress,omitempty"`
+	OtelEndpointAddress   string `json:"otelEndpointAddress,omitempty"`
+	OtelProtocol          string `json:"otelProtocol,omitempty"`
+	OtelIsSecure          bool   `json:"otelIsSecure,omitempty"`
+}
+
+type metadataMetrics struct {
+	Enabled                    bool    `json:"enabled"`
+	RecordErrorCodes           bool    `json:"recordErrorCodes,omitempty"`
+	HTTPIncreasedCardinality   bool    `json:"httpIncreasedCardinality,omitempty"`
+	HTTPExcludeVerbs           bool    `json:"httpExcludeVerbs,omitempty"`
+	LatencyDistributionBuckets []int32 `json:"latencyDistributionBuckets,omitempty"`
+}
+
+type metadataRegisteredComponent struct {
+	Name           string   `json:"name,omitempty"`
+	Type           string   `json:"type,omitempty"`
+	Version        string   `json:"version,omitempty"`
+	Capabilities   []string `json:"capabilities,omitempty"`
+	InitDurationMs int64    `json:"initDurationMs,omitempty"`
+}
+
+type metadataComponentReload struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Version string `json:"version"`
+	Swapped bool   `json:"swapped"`
+	Status  string `json:"status"`
+	Time    string `json:"time"`
+	Error   string `json:"error,omitempty"`
+}
+
+type metadataConfigurationReload struct {
+	Time            string   `json:"time"`
+	Applied         []string `json:"applied,omitempty"`
+	RestartRequired []string `json:"restartRequired,omitempty"`
+	Error           string   `json:"error,omitempty"`
+}
+
+type metadataResiliency struct {
+	Name     string                                  `json:"name"`
+	DryRun   bool                                    `json:"dryRun,omitempty"`
+	Policies []*runtimev1pb.MetadataResiliencyPolicy `json:"policies,omitempty"`
+	Targets  []metadataResiliencyTarget              `json:"targets,omitempty"`
+}
+
+type metadataResiliencyTarget struct {
+	Type           string `json:"type"`
+	Name           string `json:"name"`
+	Direction      string `json:"direction,omitempty"`
+	Operation      string `json:"operation,omitempty"`
+	Timeout        string `json:"timeout,omitempty"`
+	Retry          string `json:"retry,omitempty"`
+	CircuitBreaker string `json:"circuitBreaker,omitempty"`
+	Hedging        string `json:"hedging,omitempty"`
+}
+
+func newMetadataResiliency(r *runtimev1pb.MetadataResiliency) metadataResiliency {
+	res := metadataResiliency{
+		Name:     r.GetName(),
+		DryRun:   r.GetDryRun(),
+		Policies: r.GetPolicies(),
+	}
+	if len(r.GetTargets()) > 0 {
+		res.Targets = make([]metadataResiliencyTarget, len(r.GetTargets()))
+		for i, t := range r.GetTargets() {
+			res.Targets[i] = metadataResiliencyTarget{
+				Type:           t.GetType(),
+				Name:           t.GetName(),
+				Direction:      t.GetDirection(),
+				Operation:      t.GetOperation(),
+				Timeout:        t.GetTimeout(),
+				Retry:          t.GetRetry(),
+				CircuitBreaker: t.GetCircuitBreaker(),
+				Hedging:        t.GetHedging(),
+			}
+		}
+	}
+	return res
+}
+
+type metadataCircuitBreaker struct {
+	Name       string `json:"name"`
+	Policy     string `json:"policy"`
+	TargetType string `json:"targetType"`
+	TargetName string `json:"targetName"`
+	State      string `json:"state"`
+}
+
+type metadataFault struct {
+	Name          string                  `json:"name"`
+	TargetType    string                  `json:"targetType,omitempty"`
+	BuildingBlock string                  `json:"buildingBlock,omitempty"`
+	Target        string                  `json:"target,omitempty"`
+	Delay         *runtimev1pb.FaultDelay `json:"delay,omitempty"`
+	Error         *runtimev1pb.FaultError `json:"error,omitempty"`
+	ExpireTime    string                  `json:"expireTime,omitempty"`
+}
+
+type metadataStateMigration struct {
+	ID              string `json:"id"`
+	StoreName       string `json:"storeName"`
+	TargetStoreName string `json:"targetStoreName"`
+	DryRun          bool   `json:"dryRun"`
+	Status          string `json:"status"`
+	KeysTotal       int64  `json:"keysTotal"`
+	KeysCopied      int64  `json:"keysCopied"`
+	KeysSkipped     int64  `json:"keysSkipped"`
+	StartTime       string `json:"startTime,omitempty"`
+	EndTime         string `json:"endTime,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+func newMetadataStateMigration(m *runtimev1pb.MetadataStateMigration) metadataStateMigration {
+	return metadataStateMigration{
+		ID:              m.GetId(),
+		StoreName:       m.GetStoreName(),
+		TargetStoreName: m.GetTargetStoreName(),
+		DryRun:          m.GetDryRun(),
+		Status:          m.GetStatus().String(),
+		KeysTotal:       m.GetKeysTotal(),
+		KeysCopied:      m.GetKeysCopied(),
+		KeysSkipped:     m.GetKeysSkipped(),
+		StartTime:       m.GetStartTime(),
+		EndTime:         m.GetEndTime(),
+		Error:           m.GetError(),
+	}
 }
 
 type metadataWorkflows struct {
@@ -205,6 +551,14 @@ type metadataResponsePubsubSubscription struct {
 	Rules           []metadataResponsePubsubSubscriptionRule `json:"rules,omitempty"`
 	DeadLetterTopic string                                   `json:"deadLetterTopic"`
 	Type            string                                   `json:"type"`
+	BulkSubscribe   *metadataResponsePubsubBulkSubscribe     `json:"bulkSubscribe,omitempty"`
+	Paused          bool                                     `json:"paused,omitempty"`
+}
+
+type metadataResponsePubsubBulkSubscribe struct {
+	Enabled            bool  `json:"enabled"`
+	MaxMessagesCount   int32 `json:"maxMessagesCount,omitempty"`
+	MaxAwaitDurationMs int32 `json:"maxAwaitDurationMs,omitempty"`
 }
 
 type metadataResponsePubsubSubscriptionRule struct {
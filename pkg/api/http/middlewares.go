@@ -14,6 +14,8 @@ limitations under the License.
 package http
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -21,32 +23,61 @@ import (
 
 	chi "github.com/go-chi/chi/v5"
 
+	"github.com/dapr/dapr/pkg/api/apitoken"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/audit"
+	"github.com/dapr/dapr/pkg/config"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/kit/streams"
 )
 
+type unlimitedBodyCtxKey struct{}
+
 // MaxBodySizeMiddleware limits the body size to the given size (in bytes).
 func MaxBodySizeMiddleware(maxSize int64) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.WithContext(context.WithValue(r.Context(), unlimitedBodyCtxKey{}, r.Body))
 			r.Body = streams.LimitReadCloser(r.Body, maxSize)
 			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+// unlimitedBody returns the body of the request without the limit of
+// MaxBodySizeMiddleware, for handlers which stream the body of the request
+// without buffering it.
+func unlimitedBody(r *http.Request) io.ReadCloser {
+	if body, ok := r.Context().Value(unlimitedBodyCtxKey{}).(io.ReadCloser); ok {
+		return body
+	}
+	return r.Body
+}
+
 // APITokenAuthMiddleware enforces authentication using the dapr-api-token header.
 func APITokenAuthMiddleware(token string) func(next http.Handler) http.Handler {
+	return APITokensAuthMiddleware(apitoken.New(apitoken.Options{Token: token}))
+}
+
+// APITokensAuthMiddleware enforces authentication with any of the tokens
+// using the dapr-api-token header. The token is added to the context of the
+// request, for authorizeAPIToken to check the APIs it is allowed to call.
+func APITokensAuthMiddleware(tokens *apitoken.Tokens) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		if token == "" {
+		if !tokens.Enabled() {
 			return next
 		}
 
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			v := r.Header.Get(securityConsts.APITokenHeader)
-			if v != token && !isRouteExcludedFromAPITokenAuth(r.Method, r.URL) {
-				http.Error(w, "invalid api token", http.StatusUnauthorized)
-				return
+			token, ok := tokens.Authenticate(r.Header.Get(securityConsts.APITokenHeader))
+			if !ok {
+				if !isRouteExcludedFromAPITokenAuth(r.Method, r.URL) {
+					auditAPIToken(r, "invalid api token")
+					http.Error(w, "invalid api token", http.StatusUnauthorized)
+					return
+				}
+			} else {
+				r = r.WithContext(apitoken.NewContext(r.Context(), token))
 			}
 
 			r.Header.Del(securityConsts.APITokenHeader)
@@ -55,6 +86,40 @@ func APITokenAuthMiddleware(token string) func(next http.Handler) http.Handler {
 	}
 }
 
+// authorizeAPIToken checks that the token the request was authenticated with
+// is allowed to call the API of the endpoint.
+func authorizeAPIToken(e endpoints.Endpoint, next http.HandlerFunc) http.HandlerFunc {
+	if e.Settings.AlwaysAllowed {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := apitoken.FromContext(r.Context())
+		if ok && !token.AllowsAll() {
+			if !token.Allowed.HasProtocol(config.APIAccessRuleProtocolHTTP) || !e.IsMethodAllowed(r.Method, token.Allowed, nil) {
+				http.Error(w, "api token is not allowed to call this API", http.StatusForbidden)
+				return
+			}
+		}
+
+		next(w, r)
+	}
+}
+
+// auditAPIToken writes the API token failure of the request to the audit log.
+func auditAPIToken(r *http.Request, reason string) {
+	audit.Emit(r.Context(), audit.Event{
+		Action:  audit.ActionAPITokenFailed,
+		Outcome: audit.OutcomeDenied,
+		Attributes: map[string]string{
+			"protocol": "http",
+			"method":   r.Method,
+			"path":     r.URL.Path,
+			"reason":   reason,
+		},
+	})
+}
+
 func isRouteExcludedFromAPITokenAuth(method string, u *url.URL) bool {
 	path := strings.Trim(u.Path, "/")
 	switch path {
@@ -27,6 +27,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/test/bufconn"
 
+	"github.com/dapr/dapr/pkg/api/apitoken"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/config"
 	securityConsts "github.com/dapr/dapr/pkg/security/consts"
 )
 
@@ -157,6 +160,60 @@ func TestAPITokenAuthMiddleware(t *testing.T) {
 	})
 }
 
+func TestAuthorizeAPIToken(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "👋")
+	})
+
+	stateEndpoint := endpoints.Endpoint{
+		Version: apiVersionV1,
+		Route:   "state/{storeName}/{key}",
+		Group: &endpoints.EndpointGroup{
+			Name:    endpoints.EndpointGroupState,
+			Version: endpoints.EndpointGroupVersion1,
+		},
+	}
+	healthzEndpoint := endpoints.Endpoint{
+		Settings: endpoints.EndpointSettings{AlwaysAllowed: true},
+	}
+
+	serve := func(e endpoints.Endpoint, token *apitoken.Token) int {
+		r := httptest.NewRequest(http.MethodGet, "/v1.0/foo", nil)
+		if token != nil {
+			r = r.WithContext(apitoken.NewContext(r.Context(), token))
+		}
+		w := httptest.NewRecorder()
+		authorizeAPIToken(e, handler)(w, r)
+		res := w.Result()
+		defer res.Body.Close()
+		return res.StatusCode
+	}
+
+	t.Run("tokens without scopes are allowed all APIs", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(stateEndpoint, &apitoken.Token{}))
+		assert.Equal(t, http.StatusOK, serve(stateEndpoint, nil))
+	})
+
+	t.Run("scoped tokens are allowed their APIs only", func(t *testing.T) {
+		state := &apitoken.Token{Name: "state", Allowed: config.APIAccessRules{
+			{Name: "state", Version: "v1", Protocol: config.APIAccessRuleProtocolHTTP},
+		}}
+		assert.Equal(t, http.StatusOK, serve(stateEndpoint, state))
+
+		secrets := &apitoken.Token{Name: "secrets", Allowed: config.APIAccessRules{
+			{Name: "secrets", Version: "v1", Protocol: config.APIAccessRuleProtocolHTTP},
+		}}
+		assert.Equal(t, http.StatusForbidden, serve(stateEndpoint, secrets))
+
+		grpcOnly := &apitoken.Token{Name: "grpc", Allowed: config.APIAccessRules{
+			{Name: "state", Version: "v1", Protocol: config.APIAccessRuleProtocolGRPC},
+		}}
+		assert.Equal(t, http.StatusForbidden, serve(stateEndpoint, grpcOnly))
+
+		assert.Equal(t, http.StatusOK, serve(healthzEndpoint, secrets))
+	})
+}
+
 // Below is a modified version of the code from https://github.com/go-chi/chi/blob/v5.0.8/middleware/strip_test.go
 // Original code Copyright (c) 2015-present Peter Kieltyka (https://github.com/pkieltyka), Google Inc.
 // Original code license: MIT: https://github.com/go-chi/chi/blob/v5.0.8/LICENSE
@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/buildinfo"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+const openAPIVersion = "3.1.0"
+
+var endpointGroupMetadataV1Alpha1 = &endpoints.EndpointGroup{
+	Name:    endpoints.EndpointGroupMetadata,
+	Version: endpoints.EndpointGroupVersion1alpha1,
+}
+
+// openAPIRouteParam matches the parameters of the routes of the endpoints,
+// such as "{storeName}" or "{topic:*}", and the trailing wildcards.
+var openAPIRouteParam = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}|\*$`)
+
+// openAPIMethods are the methods documented for the endpoints which match any
+// method.
+var openAPIMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch}
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Description string        `json:"description,omitempty"`
+	Required    bool          `json:"required"`
+	Schema      openAPISchema `json:"schema"`
+	Examples    []string      `json:"examples,omitempty"`
+}
+
+type openAPIRequestBody struct {
+	Content map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref        string                   `json:"$ref,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+	Enum       []string                 `json:"enum,omitempty"`
+	Items      *openAPISchema           `json:"items,omitempty"`
+	Properties map[string]openAPISchema `json:"properties,omitempty"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]openAPISchema `json:"schemas"`
+}
+
+func (a *api) constructOpenAPIEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "openapi",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupMetadataV1Alpha1,
+			Handler: a.onGetOpenAPI,
+			Settings: endpoints.EndpointSettings{
+				Name: "GetOpenAPI",
+			},
+		},
+	}
+}
+
+// onGetOpenAPI returns the OpenAPI document of the HTTP API of the sidecar.
+func (a *api) onGetOpenAPI(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.openAPIDocument())
+}
+
+// openAPIDocument returns the OpenAPI document of the endpoints of the HTTP
+// API which are allowed by the API access rules. The parameters naming
+// components list the names of the components which are loaded.
+func (a *api) openAPIDocument() openAPIDocument {
+	allowed := a.apiSpec.Allowed.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
+	denied := a.apiSpec.Denied.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
+	hasVerbs := a.apiSpec.Allowed.HasVerbs(config.APIAccessRuleProtocolHTTP) ||
+		a.apiSpec.Denied.HasVerbs(config.APIAccessRuleProtocolHTTP)
+
+	doc := openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       "Dapr sidecar HTTP API",
+			Description: "The HTTP API of the Dapr sidecar of the app " + a.universal.AppID() + ".",
+			Version:     buildinfo.Version(),
+		},
+		Paths: make(map[string]map[string]*openAPIOperation),
+		Components: openAPIComponents{
+			Schemas: map[string]openAPISchema{
+				"Error": {
+					Type: "object",
+					Properties: map[string]openAPISchema{
+						"errorCode": {Type: "string"},
+						"message":   {Type: "string"},
+						"details":   {Type: "array", Items: &openAPISchema{Type: "object"}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, e := range a.endpoints {
+		methods := e.Methods
+		if len(methods) == 0 {
+			methods = openAPIMethods
+		}
+		if hasVerbs {
+			methods = slices.DeleteFunc(slices.Clone(methods), func(m string) bool {
+				return !e.IsMethodAllowed(m, a.apiSpec.Allowed, a.apiSpec.Denied)
+			})
+			if len(methods) == 0 {
+				continue
+			}
+		} else if !e.IsAllowed(allowed, denied) {
+			continue
+		}
+
+		path, params := a.openAPIPath(e)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*openAPIOperation)
+		}
+
+		for _, method := range methods {
+			op := &openAPIOperation{
+				OperationID: e.Settings.Name,
+				Parameters:  params,
+				Responses: map[string]openAPIResponse{
+					"2XX": {Description: "Success"},
+					"default": {
+						Description: "Error",
+						Content: map[string]openAPIMediaType{
+							"application/json": {Schema: openAPISchema{Ref: "#/components/schemas/Error"}},
+						},
+					},
+				},
+			}
+			// Operation IDs must be unique in the document.
+			if len(methods) > 1 {
+				op.OperationID += "_" + strings.ToLower(method)
+			}
+			if e.Group != nil {
+				op.Tags = []string{string(e.Group.Name)}
+			}
+			switch method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch:
+				op.RequestBody = &openAPIRequestBody{
+					Content: map[string]openAPIMediaType{
+						"application/json": {Schema: openAPISchema{}},
+					},
+				}
+			}
+			doc.Paths[path][strings.ToLower(method)] = op
+		}
+	}
+
+	return doc
+}
+
+// openAPIPath returns the OpenAPI path of the route of the endpoint, and its
+// path parameters.
+func (a *api) openAPIPath(e endpoints.Endpoint) (string, []openAPIParameter) {
+	var params []openAPIParameter
+	path := openAPIRouteParam.ReplaceAllStringFunc(e.Route, func(match string) string {
+		sub := openAPIRouteParam.FindStringSubmatch(match)
+		param := openAPIParameter{
+			In:       "path",
+			Required: true,
+			Schema:   openAPISchema{Type: "string"},
+		}
+		switch {
+		case sub[1] == "":
+			param.Name = "path"
+			param.Description = "The rest of the path, which can contain slashes."
+		case sub[2] != "":
+			param.Name = sub[1]
+			param.Description = "Can contain slashes."
+		default:
+			param.Name = sub[1]
+		}
+
+		// The first parameter of the routes of the building blocks of
+		// components is the name of the component.
+		if len(params) == 0 && e.Group != nil {
+			if names := a.openAPIComponentNames(e.Group.Name); len(names) > 0 {
+				param.Description = "The name of the component."
+				param.Schema.Enum = names
+				param.Examples = names[:1]
+			}
+		}
+
+		params = append(params, param)
+		return "{" + param.Name + "}"
+	})
+	return "/" + e.Version + "/" + path, params
+}
+
+// openAPIComponentNames returns the names of the loaded components of the
+// building block of the endpoint group, sorted.
+func (a *api) openAPIComponentNames(group endpoints.EndpointGroupName) []string {
+	compStore := a.universal.CompStore()
+	if compStore == nil {
+		return nil
+	}
+
+	var names []string
+	switch group {
+	case endpoints.EndpointGroupState:
+		names = slices.Collect(maps.Keys(compStore.ListStateStores()))
+	case endpoints.EndpointGroupPubsub:
+		names = slices.Collect(maps.Keys(compStore.ListPubSubs()))
+	case endpoints.EndpointGroupBindings:
+		names = slices.Collect(maps.Keys(compStore.ListOutputBindings()))
+	case endpoints.EndpointGroupSecrets:
+		names = slices.Collect(maps.Keys(compStore.ListSecretStores()))
+	case endpoints.EndpointGroupConfiguration:
+		names = slices.Collect(maps.Keys(compStore.ListConfigurations()))
+	case endpoints.EndpointGroupLock, endpoints.EndpointGroupUnlock:
+		names = slices.Collect(maps.Keys(compStore.ListLocks()))
+	case endpoints.EndpointGroupCrypto, endpoints.EndpointGroupSubtleCrypto:
+		names = slices.Collect(maps.Keys(compStore.ListCryptoProviders()))
+	case endpoints.EndpointGroupConversation:
+		names = slices.Collect(maps.Keys(compStore.ListConversations()))
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestOpenAPIEndpoint(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddStateStore("storeb", new(daprt.MockStateStore))
+	compStore.AddStateStore("storea", new(daprt.MockStateStore))
+
+	testAPI := NewAPI(APIOpts{
+		Universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			Logger:    log,
+			CompStore: compStore,
+		}),
+		APISpec: config.APISpec{
+			Denied: []config.APIAccessRule{
+				{Name: "secrets", Version: apiVersionV1, Protocol: config.APIAccessRuleProtocolHTTP},
+			},
+		},
+	}).(*api)
+
+	fakeServer := newFakeHTTPServer()
+	fakeServer.StartServer(testAPI.constructOpenAPIEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	resp := fakeServer.DoRequest(nethttp.MethodGet, "v1.0-alpha1/openapi", nil, nil)
+	require.Equal(t, nethttp.StatusOK, resp.StatusCode)
+
+	var doc openAPIDocument
+	require.NoError(t, json.Unmarshal(resp.RawBody, &doc))
+	assert.Equal(t, openAPIVersion, doc.OpenAPI)
+	assert.Contains(t, doc.Info.Description, "fakeAPI")
+	assert.Contains(t, doc.Components.Schemas, "Error")
+
+	t.Run("component names are listed in the parameters", func(t *testing.T) {
+		op := doc.Paths["/v1.0/state/{storeName}/{key}"]["get"]
+		require.NotNil(t, op)
+		assert.Equal(t, "GetState", op.OperationID)
+		assert.Equal(t, []string{"state"}, op.Tags)
+		require.Len(t, op.Parameters, 2)
+		assert.Equal(t, "storeName", op.Parameters[0].Name)
+		assert.Equal(t, []string{"storea", "storeb"}, op.Parameters[0].Schema.Enum)
+		assert.Equal(t, []string{"storea"}, op.Parameters[0].Examples)
+		assert.Equal(t, "key", op.Parameters[1].Name)
+		assert.Empty(t, op.Parameters[1].Schema.Enum)
+		assert.Nil(t, op.RequestBody)
+	})
+
+	t.Run("building blocks without components have no examples", func(t *testing.T) {
+		op := doc.Paths["/v1.0/publish/{pubsubname}/{path}"]["post"]
+		require.NotNil(t, op)
+		assert.Equal(t, "PublishEvent_post", op.OperationID)
+		require.Len(t, op.Parameters, 2)
+		assert.Empty(t, op.Parameters[0].Schema.Enum)
+		assert.Equal(t, "path", op.Parameters[1].Name)
+		assert.NotNil(t, op.RequestBody)
+	})
+
+	t.Run("endpoints matching any method", func(t *testing.T) {
+		ops := doc.Paths["/v1.0/invoke/{path}"]
+		require.Len(t, ops, len(openAPIMethods))
+		assert.Equal(t, "InvokeService_delete", ops["delete"].OperationID)
+	})
+
+	t.Run("denied endpoints are not listed", func(t *testing.T) {
+		for path := range doc.Paths {
+			assert.NotContains(t, path, "/v1.0/secrets/")
+		}
+		assert.Contains(t, doc.Paths, "/v1.0-alpha1/openapi")
+	})
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// constructReloadEndpoints returns the endpoint reloading the configuration of
+// the sidecar. Like the shutdown endpoint, it isn't served on the public port.
+func (a *api) constructReloadEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "reload",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupReload,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: nil, // TODO
+			},
+			Handler: a.onReload(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ReloadAlpha1",
+			},
+		},
+	}
+}
+
+func (a *api) onReload() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ReloadAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ReloadRequest, *runtimev1pb.ReloadResponse]{
+			SkipInputBody: true,
+		},
+	)
+}
@@ -13,6 +13,10 @@ limitations under the License.
 
 package http
 
+import (
+	"github.com/dapr/components-contrib/state"
+)
+
 // OutputBindingRequest is the request object to invoke an output binding.
 type OutputBindingRequest struct {
 	Metadata  map[string]string `json:"metadata"`
@@ -26,3 +30,9 @@ type BulkGetRequest struct {
 	Keys        []string          `json:"keys"`
 	Parallelism int               `json:"parallelism"`
 }
+
+// SetStateRequest is the request object to save the value of a key in a state store.
+type SetStateRequest struct {
+	state.SetRequest
+	TTLInSeconds *int64 `json:"ttlInSeconds,omitempty" mapstructure:"ttlInSeconds"`
+}
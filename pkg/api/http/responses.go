@@ -31,6 +31,7 @@ import (
 const (
 	jsonContentTypeHeader = "application/json"
 	etagHeader            = "ETag"
+	ttlHeader             = "Dapr-Ttl-In-Seconds"
 	metadataPrefix        = "metadata."
 	headerContentType     = "content-type"
 	headerContentLength   = "content-length"
@@ -43,6 +44,51 @@ type BulkGetResponse struct {
 	ETag     *string           `json:"etag,omitempty"`
 	Metadata map[string]string `json:"metadata,omitempty"`
 	Error    string            `json:"error,omitempty"`
+
+	TTLInSeconds *int64 `json:"ttlInSeconds,omitempty"`
+}
+
+// WatchStateResponse is an object representing a batch of changes to watched
+// state keys.
+type WatchStateResponse struct {
+	Changes []StateChange `json:"changes"`
+}
+
+// StateChange is an object representing a change to the state of a key.
+type StateChange struct {
+	Key       string            `json:"key"`
+	Operation string            `json:"operation"`
+	Data      json.RawMessage   `json:"data,omitempty"`
+	ETag      *string           `json:"etag,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// WatchSecretsResponse is an object representing a batch of changes to
+// watched secrets.
+type WatchSecretsResponse struct {
+	Changes []SecretChange `json:"changes"`
+}
+
+// SecretChange is an object representing a change to the value of a secret.
+type SecretChange struct {
+	Name    string            `json:"name"`
+	Secrets map[string]string `json:"secrets"`
+}
+
+// ConfigurationItem is an object representing a configuration item, with a
+// hint of the type of its value.
+type ConfigurationItem struct {
+	Value    string            `json:"value,omitempty"`
+	Version  string            `json:"version,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Type     string            `json:"type,omitempty"`
+}
+
+// ConfigurationUpdateEvent is an object representing the changes to the
+// configuration items of a subscription, sent to the app.
+type ConfigurationUpdateEvent struct {
+	ID    string                       `json:"id"`
+	Items map[string]ConfigurationItem `json:"items"`
 }
 
 // BulkPublishResponseEntry is an object representing a single entry in bulk publish response
@@ -66,10 +112,11 @@ type QueryResponse struct {
 
 // QueryItem is an object representing a single entry in query results.
 type QueryItem struct {
-	Key   string          `json:"key"`
-	Data  json.RawMessage `json:"data"`
-	ETag  *string         `json:"etag,omitempty"`
-	Error string          `json:"error,omitempty"`
+	Key       string          `json:"key"`
+	Data      json.RawMessage `json:"data"`
+	ETag      *string         `json:"etag,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	StoreName string          `json:"storeName,omitempty"`
 }
 
 // respondWithJSON sends a response with an object that will be encoded as JSON.
@@ -14,13 +14,18 @@ limitations under the License.
 package http
 
 import (
+	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/sse"
+	kiterrors "github.com/dapr/kit/errors"
 )
 
 var endpointGroupSecretsV1 = &endpoints.EndpointGroup{
@@ -29,6 +34,12 @@ var endpointGroupSecretsV1 = &endpoints.EndpointGroup{
 	AppendSpanAttributes: appendSecretsSpanAttributes,
 }
 
+var endpointGroupSecretsV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupSecrets,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: appendSecretsSpanAttributes,
+}
+
 func appendSecretsSpanAttributes(r *http.Request, m map[string]string) {
 	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.SecretBuildingBlockType
 	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.SecretBuildingBlockType
@@ -48,6 +59,26 @@ func (a *api) constructSecretsEndpoints() []endpoints.Endpoint {
 				Name: "GetBulkSecret",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "secrets/{secretStoreName}/watch",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupSecretsV1Alpha1,
+			Handler: a.onWatchSecrets,
+			Settings: endpoints.EndpointSettings{
+				Name: "WatchSecretsAlpha1",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "secrets/{secretStoreName}/cache",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupSecretsV1Alpha1,
+			Handler: a.onPurgeSecretsCacheHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "PurgeSecretsCacheAlpha1",
+			},
+		},
 		{
 			Methods: []string{http.MethodGet},
 			Route:   "secrets/{secretStoreName}/{key}",
@@ -91,6 +122,8 @@ func (a *api) onBulkGetSecretHandler() http.HandlerFunc {
 			InModifier: func(r *http.Request, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretRequest, error) {
 				in.StoreName = chi.URLParam(r, secretStoreNameParam)
 				in.Metadata = getMetadataFromRequest(r)
+				in.NamePrefixes = r.URL.Query()[secretNamePrefixParam]
+				in.NameRegex = r.URL.Query().Get(secretNameRegexParam)
 				return in, nil
 			},
 			OutModifier: func(out *runtimev1pb.GetBulkSecretResponse) (any, error) {
@@ -110,3 +143,76 @@ func (a *api) onBulkGetSecretHandler() http.HandlerFunc {
 		},
 	)
 }
+
+func (a *api) onPurgeSecretsCacheHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.PurgeSecretsCacheAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.PurgeSecretsCacheRequest, *runtimev1pb.PurgeSecretsCacheResponse]{
+			InModifier: func(r *http.Request, in *runtimev1pb.PurgeSecretsCacheRequest) (*runtimev1pb.PurgeSecretsCacheRequest, error) {
+				in.StoreName = chi.URLParam(r, secretStoreNameParam)
+				in.Names = r.URL.Query()[secretCacheNameParam]
+				return in, nil
+			},
+		},
+	)
+}
+
+// onWatchSecrets streams the changes to secrets of a secret store as
+// server-sent events, each a batch of changes. The first event is sent once
+// the watch is established and has no changes. Errors once the stream has
+// started are sent as an "error" event, which ends the stream.
+func (a *api) onWatchSecrets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	in := &runtimev1pb.WatchSecretsRequest{
+		StoreName: chi.URLParam(r, secretStoreNameParam),
+		Names:     query[secretWatchNameParam],
+		Metadata:  getMetadataFromRequest(r),
+	}
+	if interval := query.Get(secretWatchIntervalParam); interval != "" {
+		seconds, err := strconv.ParseInt(interval, 10, 32)
+		if err != nil {
+			respondWithError(w, messages.ErrSecretWatchInvalid.WithFormat(in.GetStoreName(), "invalid poll interval: "+err.Error()))
+			return
+		}
+		in.PollIntervalSeconds = int32(seconds)
+	}
+
+	var started bool
+	err := a.universal.WatchSecrets(r.Context(), in, func(resp *runtimev1pb.WatchSecretsResponse) error {
+		if !started {
+			sse.AddSSEHeaders(w)
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+
+		event := WatchSecretsResponse{
+			Changes: make([]SecretChange, len(resp.GetChanges())),
+		}
+		for i, change := range resp.GetChanges() {
+			event.Changes[i] = SecretChange{
+				Name:    change.GetName(),
+				Secrets: change.GetSecrets(),
+			}
+		}
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return sse.WriteEvent(w, "", data)
+	})
+	if err == nil {
+		return
+	}
+
+	if !started {
+		respondWithError(w, err)
+		return
+	}
+	if kerr, ok := kiterrors.FromError(err); ok {
+		sse.WriteEvent(w, "error", kerr.JSONErrorValue())
+	} else {
+		data, _ := json.Marshal(map[string]string{"message": err.Error()})
+		sse.WriteEvent(w, "error", data)
+	}
+}
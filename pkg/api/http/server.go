@@ -23,6 +23,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -38,12 +39,14 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c" //nolint:staticcheck
 
+	"github.com/dapr/dapr/pkg/api/apitoken"
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/api/listen"
 	"github.com/dapr/dapr/pkg/config"
 	corsDapr "github.com/dapr/dapr/pkg/cors"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/middleware"
 	"github.com/dapr/dapr/pkg/responsewriter"
 	"github.com/dapr/dapr/pkg/security"
@@ -69,6 +72,7 @@ type server struct {
 	middleware         middleware.HTTP
 	api                API
 	apiSpec            config.APISpec
+	apiTokens          *apitoken.Tokens
 	servers            []*http.Server
 	profilingListeners []net.Listener
 	wg                 sync.WaitGroup
@@ -82,11 +86,18 @@ type NewServerOpts struct {
 	MetricSpec  config.MetricSpec
 	Middleware  middleware.HTTP
 	APISpec     config.APISpec
+	// APITokens are the tokens authenticating the calls to the API. Defaults
+	// to the dapr-api-token.
+	APITokens *apitoken.Tokens
 }
 
 // NewServer returns a new HTTP server.
 func NewServer(opts NewServerOpts) Server {
 	infoLog.SetOutputLevel(logger.LogLevel("info"))
+	apiTokens := opts.APITokens
+	if apiTokens == nil {
+		apiTokens = apitoken.New(apitoken.Options{Token: security.GetAPIToken()})
+	}
 	return &server{
 		api:         opts.API,
 		config:      opts.Config,
@@ -94,6 +105,7 @@ func NewServer(opts NewServerOpts) Server {
 		metricSpec:  opts.MetricSpec,
 		middleware:  opts.Middleware,
 		apiSpec:     opts.APISpec,
+		apiTokens:   apiTokens,
 	}
 }
 
@@ -387,13 +399,12 @@ func (s *server) useCors(r chi.Router) {
 }
 
 func (s *server) useAPIAuthentication(r chi.Router) {
-	token := security.GetAPIToken()
-	if token == "" {
+	if !s.apiTokens.Enabled() {
 		return
 	}
 
 	log.Info("Enabled token authentication on HTTP server")
-	r.Use(APITokenAuthMiddleware(token))
+	r.Use(APITokensAuthMiddleware(s.apiTokens))
 }
 
 func (s *server) unescapeRequestParametersHandler(next http.Handler) http.HandlerFunc {
@@ -430,9 +441,18 @@ func (s *server) setupRoutes(r chi.Router, endpoints []endpoints.Endpoint) {
 	// Build the API allowlist and denylist
 	allowedAPIs := s.apiSpec.Allowed.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
 	deniedAPIs := s.apiSpec.Denied.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
+	// Rules which apply only to some HTTP verbs are checked for each method
+	hasVerbs := s.apiSpec.Allowed.HasVerbs(config.APIAccessRuleProtocolHTTP) ||
+		s.apiSpec.Denied.HasVerbs(config.APIAccessRuleProtocolHTTP)
 
 	for _, e := range endpoints {
-		if !e.IsAllowed(allowedAPIs, deniedAPIs) {
+		if hasVerbs {
+			var ok bool
+			e, ok = s.allowMethods(e)
+			if !ok {
+				continue
+			}
+		} else if !e.IsAllowed(allowedAPIs, deniedAPIs) {
 			continue
 		}
 
@@ -444,6 +464,48 @@ func (s *server) setupRoutes(r chi.Router, endpoints []endpoints.Endpoint) {
 	}
 }
 
+// anyMethods are the methods checked against the API allowlist and denylist
+// for the endpoints which match any method.
+var anyMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+	http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace,
+}
+
+// allowMethods restricts the endpoint to the methods allowed by the API
+// allowlist and denylist, including the rules which apply only to some HTTP
+// verbs. The endpoints matching any method check the method of each request.
+// It returns false if no method of the endpoint is allowed.
+func (s *server) allowMethods(e endpoints.Endpoint) (endpoints.Endpoint, bool) {
+	allowed, denied := s.apiSpec.Allowed, s.apiSpec.Denied
+
+	if len(e.Methods) == 0 {
+		if !slices.ContainsFunc(anyMethods, func(m string) bool {
+			return e.IsMethodAllowed(m, allowed, denied)
+		}) {
+			return e, false
+		}
+
+		endpoint, next := e, e.Handler
+		e.Handler = func(w http.ResponseWriter, r *http.Request) {
+			if !endpoint.IsMethodAllowed(r.Method, allowed, denied) {
+				respondWithError(w, messages.ErrAPIMethodDenied.WithFormat(r.Method))
+				return
+			}
+			next(w, r)
+		}
+		return e, true
+	}
+
+	methods := make([]string, 0, len(e.Methods))
+	for _, m := range e.Methods {
+		if e.IsMethodAllowed(m, allowed, denied) {
+			methods = append(methods, m)
+		}
+	}
+	e.Methods = methods
+	return e, len(methods) > 0
+}
+
 // Add information about the route in the context's value.
 func (s *server) addEndpointCtx(e endpoints.Endpoint, next http.Handler) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -465,6 +527,7 @@ func (s *server) handle(e endpoints.Endpoint, path string, r chi.Router, unescap
 		handler = s.unescapeRequestParametersHandler(handler)
 	}
 
+	handler = authorizeAPIToken(e, handler)
 	handler = s.addEndpointCtx(e, handler)
 
 	// If no method is defined, match any method
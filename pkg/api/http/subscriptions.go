@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+var endpointGroupSubscribeV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupSubscribe,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: appendPubSubSpanAttributes,
+}
+
+func (a *api) constructSubscriptionsEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "subscriptions/{pubsubname}/{topic}/pause",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupSubscribeV1Alpha1,
+			Handler: a.onPauseSubscriptionHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "PauseSubscriptionAlpha1",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "subscriptions/{pubsubname}/{topic}/resume",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupSubscribeV1Alpha1,
+			Handler: a.onResumeSubscriptionHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ResumeSubscriptionAlpha1",
+			},
+		},
+	}
+}
+
+// Route: POST "subscriptions/{pubsubname}/{topic}/pause"
+func (a *api) onPauseSubscriptionHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.PauseSubscriptionAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.PauseSubscriptionRequest, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.PauseSubscriptionRequest) (*runtimev1pb.PauseSubscriptionRequest, error) {
+				in.PubsubName = chi.URLParam(r, pubsubnameparam)
+				in.Topic = chi.URLParam(r, topicParam)
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		})
+}
+
+// Route: POST "subscriptions/{pubsubname}/{topic}/resume"
+func (a *api) onResumeSubscriptionHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ResumeSubscriptionAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ResumeSubscriptionRequest, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ResumeSubscriptionRequest) (*runtimev1pb.ResumeSubscriptionRequest, error) {
+				in.PubsubName = chi.URLParam(r, pubsubnameparam)
+				in.Topic = chi.URLParam(r, topicParam)
+				return in, nil
+			},
+			OutModifier: func(out *emptypb.Empty) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		})
+}
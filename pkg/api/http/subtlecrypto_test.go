@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/universal"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestSubtleCryptoKeyEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	compStore := compstore.New()
+	const cryptoComponentName = "myvault"
+	compStore.AddCryptoProvider(cryptoComponentName, &daprt.FakeSubtleCrypto{})
+	testAPI := &api{
+		universal: universal.New(universal.Options{
+			Logger:     log,
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+	}
+
+	fakeServer.StartServer(testAPI.constructSubtleCryptoEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	apiPath := func(op string) string {
+		return fmt.Sprintf("%s/subtlecrypto/%s/%s", apiVersionV1alpha1, cryptoComponentName, op)
+	}
+
+	t.Run("sign and verify - 200", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiPath("sign"), []byte(`{"digest":"aGVsbG8=","keyName":"good"}`), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var signed struct {
+			Signature []byte `json:"signature"`
+		}
+		require.NoError(t, json.Unmarshal(resp.RawBody, &signed))
+		assert.NotEmpty(t, signed.Signature)
+
+		body, err := json.Marshal(map[string]any{"digest": []byte("hello"), "signature": signed.Signature, "keyName": "good"})
+		require.NoError(t, err)
+		resp = fakeServer.DoRequest(http.MethodPost, apiPath("verify"), body, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, `{"valid":true}`, string(resp.RawBody))
+	})
+
+	t.Run("missing key name - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest(http.MethodPost, apiPath("wrapkey"), []byte(`{"plaintextKey":"aGVsbG8="}`), nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("failed operations have specific error codes - 500", func(t *testing.T) {
+		body := []byte(`{"keyName":"error","digest":"aGVsbG8=","signature":"aGVsbG8=","plaintextKey":"AQIDBAUGBwgJCgsMDQ4PEA==","wrappedKey":"aGVsbG8="}`)
+		for op, expect := range map[string][2]string{
+			"sign":      {"ERR_CRYPTO_SIGN", "failed to sign with key error"},
+			"verify":    {"ERR_CRYPTO_VERIFY", "failed to verify signature with key error"},
+			"wrapkey":   {"ERR_CRYPTO_WRAP_KEY", "failed to wrap key with key error"},
+			"unwrapkey": {"ERR_CRYPTO_UNWRAP_KEY", "failed to unwrap key with key error"},
+		} {
+			resp := fakeServer.DoRequest(http.MethodPost, apiPath(op), body, nil)
+			assert.Equal(t, http.StatusInternalServerError, resp.StatusCode, op)
+			assert.Equal(t, expect[0], resp.ErrorBody["errorCode"], op)
+			assert.Equal(t, expect[1], resp.ErrorBody["message"], op)
+		}
+	})
+}
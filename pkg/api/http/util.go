@@ -18,6 +18,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 
 	contribContentType "github.com/dapr/components-contrib/contenttype"
 )
@@ -56,3 +58,21 @@ func ConvertEventToBytes(event any, contentType string) ([]byte, error) {
 
 	return []byte{}, errContentTypeMismatch
 }
+
+// readRequestBody reads the body of the request, for the handlers which pass
+// it to components taking the whole of the payload, such as the publish and
+// output binding handlers; service invocation streams the body instead.
+// Bodies whose length is known and within the limit of the request body size
+// are read at once into a buffer of their size, rather than into one grown as
+// they are read.
+func (a *api) readRequestBody(r *http.Request) ([]byte, error) {
+	defer r.Body.Close()
+	if r.ContentLength <= 0 || a.maxRequestBodySize <= 0 || r.ContentLength > a.maxRequestBodySize {
+		return io.ReadAll(r.Body)
+	}
+	body := make([]byte, r.ContentLength)
+	if _, err := io.ReadFull(r.Body, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
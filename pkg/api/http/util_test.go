@@ -14,7 +14,11 @@ limitations under the License.
 package http
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -109,3 +113,39 @@ func TestConvertEventToBytes(t *testing.T) {
 		assert.Equal(t, exp, res)
 	})
 }
+
+func TestReadRequestBody(t *testing.T) {
+	body := []byte("fake body")
+	a := &api{maxRequestBodySize: 16}
+
+	t.Run("body of known length", func(t *testing.T) {
+		r := httptest.NewRequest(nethttp.MethodPost, "/", bytes.NewReader(body))
+		res, err := a.readRequestBody(r)
+		require.NoError(t, err)
+		assert.Equal(t, body, res)
+		assert.Equal(t, len(body), cap(res))
+	})
+
+	t.Run("body of unknown length", func(t *testing.T) {
+		r := httptest.NewRequest(nethttp.MethodPost, "/", io.NopCloser(bytes.NewReader(body)))
+		r.ContentLength = -1
+		res, err := a.readRequestBody(r)
+		require.NoError(t, err)
+		assert.Equal(t, body, res)
+	})
+
+	t.Run("body shorter than its length", func(t *testing.T) {
+		r := httptest.NewRequest(nethttp.MethodPost, "/", bytes.NewReader(body))
+		r.ContentLength = int64(len(body)) + 1
+		_, err := a.readRequestBody(r)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("length over the limit is not preallocated", func(t *testing.T) {
+		r := httptest.NewRequest(nethttp.MethodPost, "/", bytes.NewReader(body))
+		r.ContentLength = 1 << 40
+		res, err := a.readRequestBody(r)
+		require.NoError(t, err)
+		assert.Equal(t, body, res)
+	})
+}
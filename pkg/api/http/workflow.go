@@ -14,11 +14,15 @@ limitations under the License.
 package http
 
 import (
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/messages"
@@ -258,9 +262,113 @@ func (a *api) constructWorkflowEndpoints() []endpoints.Endpoint {
 				Name: "PurgeWorkflow",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}/{instanceID}/history",
+			Version: apiVersionV1beta1,
+			Group:   endpointGroupWorkflowV1Beta1,
+			Handler: a.onGetWorkflowHistoryHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "GetWorkflowHistory",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}/{instanceID}/history",
+			Version: apiVersionV1,
+			Group:   endpointGroupWorkflowV1,
+			Handler: a.onGetWorkflowHistoryHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "GetWorkflowHistory",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/replay",
+			Version: apiVersionV1beta1,
+			Group:   endpointGroupWorkflowV1Beta1,
+			Handler: a.onReplayWorkflowHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ReplayWorkflow",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/replay",
+			Version: apiVersionV1,
+			Group:   endpointGroupWorkflowV1,
+			Handler: a.onReplayWorkflowHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ReplayWorkflow",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}",
+			Version: apiVersionV1beta1,
+			Group:   endpointGroupWorkflowV1Beta1,
+			Handler: a.onListWorkflowInstancesHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ListWorkflowInstances",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}",
+			Version: apiVersionV1,
+			Group:   endpointGroupWorkflowV1,
+			Handler: a.onListWorkflowInstancesHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ListWorkflowInstances",
+			},
+		},
 	}
 }
 
+// Route: GET "workflows/{workflowComponent}?runtimeStatus={status}&workflowName={name}&createdAfter={time}&createdBefore={time}&pageSize={size}&continuationToken={token}"
+// Runtime Status: may be repeated to list instances with any of the statuses
+// Created After/Before: RFC3339 times bounding the creation time of the instances
+func (a *api) onListWorkflowInstancesHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ListWorkflowInstances,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ListWorkflowInstancesRequest, *runtimev1pb.ListWorkflowInstancesResponse]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ListWorkflowInstancesRequest) (*runtimev1pb.ListWorkflowInstancesRequest, error) {
+				qs := r.URL.Query()
+				in.WorkflowComponent = chi.URLParam(r, workflowComponent)
+				in.RuntimeStatuses = qs[runtimeStatusParam]
+				if qs.Has(workflowName) {
+					in.WorkflowName = new(qs.Get(workflowName))
+				}
+				for _, p := range []struct {
+					param string
+					dst   **timestamppb.Timestamp
+				}{{createdAfterParam, &in.CreatedAfter}, {createdBeforeParam, &in.CreatedBefore}} {
+					v := qs.Get(p.param)
+					if len(v) == 0 {
+						continue
+					}
+					t, err := time.Parse(time.RFC3339, v)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: %v", p.param, err))
+					}
+					*p.dst = timestamppb.New(t)
+				}
+				if v := qs.Get(pageSizeParam); len(v) > 0 {
+					size, err := strconv.ParseUint(v, 10, 32)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat(fmt.Sprintf("invalid %s: %v", pageSizeParam, err))
+					}
+					in.PageSize = new(uint32(size))
+				}
+				if qs.Has(continuationTokenParam) {
+					in.ContinuationToken = new(qs.Get(continuationTokenParam))
+				}
+				return in, nil
+			},
+		})
+}
+
 // Route:   "workflows/{workflowComponent}/{workflowName}/start?instanceID={instanceID}",
 // Workflow Component: Component specified in yaml
 // Workflow Name: Name of the workflow to run
@@ -376,6 +484,24 @@ func (a *api) onPurgeWorkflowHandler() http.HandlerFunc {
 		})
 }
 
+// Route: GET "workflows/{workflowComponent}/{instanceID}/history"
+func (a *api) onGetWorkflowHistoryHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.GetWorkflowHistory,
+		UniversalHTTPHandlerOpts[*runtimev1pb.GetWorkflowHistoryRequest, *runtimev1pb.GetWorkflowHistoryResponse]{
+			InModifier: workflowInModifier[*runtimev1pb.GetWorkflowHistoryRequest],
+		})
+}
+
+// Route: POST "workflows/{workflowComponent}/{instanceID}/replay"
+func (a *api) onReplayWorkflowHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ReplayWorkflow,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ReplayWorkflowRequest, *runtimev1pb.ReplayWorkflowResponse]{
+			InModifier: workflowInModifier[*runtimev1pb.ReplayWorkflowRequest],
+		})
+}
+
 // Shared InModifier method for all universal handlers for workflows that adds the "WorkflowComponent" and "InstanceId" properties
 func workflowInModifier[T runtimev1pb.WorkflowRequests](r *http.Request, in T) (T, error) {
 	in.SetWorkflowComponent(chi.URLParam(r, workflowComponent))
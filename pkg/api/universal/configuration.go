@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/dapr/components-contrib/configuration"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+)
+
+// configurationValueTypeMetadataKey is the metadata of configuration items
+// with which configuration stores declare the type of their values.
+const configurationValueTypeMetadataKey = "valueType"
+
+// ConfigurationItems returns the configuration items with the type hints of
+// their values.
+func ConfigurationItems(items map[string]*configuration.Item) map[string]*commonv1pb.ConfigurationItem {
+	res := make(map[string]*commonv1pb.ConfigurationItem, len(items))
+	for k, v := range items {
+		if v == nil {
+			continue
+		}
+		res[k] = &commonv1pb.ConfigurationItem{
+			Value:    v.Value,
+			Version:  v.Version,
+			Metadata: v.Metadata,
+			Type:     ConfigurationValueType(v),
+		}
+	}
+	return res
+}
+
+// ConfigurationValueType returns the type hint of the value of the
+// configuration item. The type declared by the configuration store with the
+// "valueType" metadata is used if the value is of that type, or else the type
+// is inferred from the value.
+func ConfigurationValueType(item *configuration.Item) commonv1pb.ConfigurationValueType {
+	for k, v := range item.Metadata {
+		if !strings.EqualFold(k, configurationValueTypeMetadataKey) {
+			continue
+		}
+		declared, ok := commonv1pb.ConfigurationValueType_value["CONFIGURATION_VALUE_TYPE_"+strings.ToUpper(v)]
+		if ok && isConfigurationValueOfType(item.Value, commonv1pb.ConfigurationValueType(declared)) {
+			return commonv1pb.ConfigurationValueType(declared)
+		}
+	}
+
+	for _, t := range []commonv1pb.ConfigurationValueType{
+		commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_BOOL,
+		commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_INT,
+		commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_JSON,
+	} {
+		if isConfigurationValueOfType(item.Value, t) {
+			return t
+		}
+	}
+	return commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING
+}
+
+func isConfigurationValueOfType(value string, t commonv1pb.ConfigurationValueType) bool {
+	switch t {
+	case commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING:
+		return true
+	case commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_BOOL:
+		return value == "true" || value == "false"
+	case commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_INT:
+		// Values such as "007" are not hinted as integers, as they would not
+		// round trip.
+		i, err := strconv.ParseInt(value, 10, 64)
+		return err == nil && strconv.FormatInt(i, 10) == value
+	case commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_JSON:
+		value = strings.TrimSpace(value)
+		return (strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")) && json.Valid([]byte(value))
+	default:
+		return false
+	}
+}
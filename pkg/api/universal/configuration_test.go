@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/components-contrib/configuration"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+)
+
+func TestConfigurationValueType(t *testing.T) {
+	tests := map[string]struct {
+		item *configuration.Item
+		exp  commonv1pb.ConfigurationValueType
+	}{
+		"string":                     {&configuration.Item{Value: "hello"}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING},
+		"empty":                      {&configuration.Item{}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING},
+		"bool":                       {&configuration.Item{Value: "false"}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_BOOL},
+		"int":                        {&configuration.Item{Value: "-42"}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_INT},
+		"leading zeros":              {&configuration.Item{Value: "007"}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING},
+		"float":                      {&configuration.Item{Value: "1.5"}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING},
+		"json object":                {&configuration.Item{Value: ` {"a": 1}`}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_JSON},
+		"json array":                 {&configuration.Item{Value: `[1, 2]`}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_JSON},
+		"invalid json":               {&configuration.Item{Value: `{"a":`}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING},
+		"declared string":            {&configuration.Item{Value: "10", Metadata: map[string]string{"valueType": "string"}}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING},
+		"declared json":              {&configuration.Item{Value: "[]", Metadata: map[string]string{"VALUETYPE": "JSON"}}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_JSON},
+		"declared type not matching": {&configuration.Item{Value: "yes", Metadata: map[string]string{"valueType": "bool"}}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_STRING},
+		"unknown declared type":      {&configuration.Item{Value: "10", Metadata: map[string]string{"valueType": "number"}}, commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_INT},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, ConfigurationValueType(test.item))
+		})
+	}
+}
+
+func TestConfigurationItems(t *testing.T) {
+	items := ConfigurationItems(map[string]*configuration.Item{
+		"key1": {Value: "true", Version: "v1", Metadata: map[string]string{"a": "b"}},
+		"key2": nil,
+	})
+	assert.Equal(t, map[string]*commonv1pb.ConfigurationItem{
+		"key1": {Value: "true", Version: "v1", Metadata: map[string]string{"a": "b"}, Type: commonv1pb.ConfigurationValueType_CONFIGURATION_VALUE_TYPE_BOOL},
+	}, items)
+}
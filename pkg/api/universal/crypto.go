@@ -21,6 +21,7 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jwk"
 
 	contribCrypto "github.com/dapr/components-contrib/crypto"
+	"github.com/dapr/dapr/pkg/audit"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency"
 	encv1 "github.com/dapr/kit/schemes/enc/v1"
@@ -49,6 +50,7 @@ func (a *Universal) CryptoGetWrapKeyFn(ctx context.Context, componentName string
 		elapsed := diag.ElapsedSince(start)
 
 		diag.DefaultComponentMonitoring.CryptoInvoked(ctx, componentName, diag.CryptoOp, err == nil, elapsed)
+		auditCrypto(ctx, componentName, "wrapKey", keyName, err)
 
 		if err != nil {
 			return nil, nil, err
@@ -69,6 +71,7 @@ func (a *Universal) CryptoGetUnwrapKeyFn(ctx context.Context, componentName stri
 		elapsed := diag.ElapsedSince(start)
 
 		diag.DefaultComponentMonitoring.CryptoInvoked(ctx, componentName, diag.CryptoOp, err == nil, elapsed)
+		auditCrypto(ctx, componentName, "unwrapKey", keyName, err)
 
 		if err != nil {
 			return nil, err
@@ -82,3 +85,17 @@ func (a *Universal) CryptoGetUnwrapKeyFn(ctx context.Context, componentName stri
 		return plaintextKeyBytes, nil
 	}
 }
+
+// auditCrypto writes the operation with the key of the crypto component to
+// the audit log.
+func auditCrypto(ctx context.Context, componentName, operation, keyName string, err error) {
+	audit.Emit(ctx, audit.Event{
+		Action:  audit.ActionCrypto,
+		Outcome: audit.OutcomeOf(err),
+		Attributes: map[string]string{
+			"component": componentName,
+			"operation": operation,
+			"key":       keyName,
+		},
+	})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// SetFaultAlpha1 starts injecting a fault in the calls to the apps, actors and
+// components, replacing the fault with the same name. It's only enabled if the
+// configuration of the sidecar has a fault injection section.
+func (a *Universal) SetFaultAlpha1(ctx context.Context, in *runtimev1pb.SetFaultRequest) (*emptypb.Empty, error) {
+	if a.faultInjector == nil {
+		err := messages.ErrFaultInjectionDisabled
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if in.GetFault() == nil {
+		err := messages.ErrFaultInvalid.WithFormat(errors.New("fault is required"))
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	spec := faultSpec(in.GetFault())
+	spec.TTL = in.GetTtl()
+	if err := a.faultInjector.Set(spec); err != nil {
+		err = messages.ErrFaultInvalid.WithFormat(err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteFaultAlpha1 stops injecting a fault.
+func (a *Universal) DeleteFaultAlpha1(ctx context.Context, in *runtimev1pb.DeleteFaultRequest) (*emptypb.Empty, error) {
+	if a.faultInjector == nil {
+		err := messages.ErrFaultInjectionDisabled
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if !a.faultInjector.Delete(in.GetName()) {
+		err := messages.ErrFaultNotFound.WithFormat(in.GetName())
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// metadataFaults returns the faults being injected.
+func (a *Universal) metadataFaults() []*runtimev1pb.MetadataFault {
+	list := a.faultInjector.List()
+	res := make([]*runtimev1pb.MetadataFault, len(list))
+	for i, f := range list {
+		res[i] = &runtimev1pb.MetadataFault{
+			Fault: faultProto(f.Spec),
+		}
+		if !f.ExpiresAt.IsZero() {
+			res[i].ExpireTime = f.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+	}
+	return res
+}
+
+func faultSpec(f *runtimev1pb.Fault) config.FaultSpec {
+	spec := config.FaultSpec{
+		Name:          f.GetName(),
+		TargetType:    f.GetTargetType(),
+		BuildingBlock: f.GetBuildingBlock(),
+		Target:        f.GetTarget(),
+	}
+	if f.Delay != nil {
+		spec.Delay = &config.FaultDelaySpec{
+			Duration:   f.GetDelay().GetDuration(),
+			Percentage: int(f.GetDelay().GetPercentage()),
+		}
+	}
+	if f.Error != nil {
+		spec.Error = &config.FaultErrorSpec{
+			Message:    f.GetError().GetMessage(),
+			Percentage: int(f.GetError().GetPercentage()),
+		}
+	}
+	return spec
+}
+
+func faultProto(spec config.FaultSpec) *runtimev1pb.Fault {
+	f := &runtimev1pb.Fault{
+		Name:          spec.Name,
+		TargetType:    spec.TargetType,
+		BuildingBlock: spec.BuildingBlock,
+		Target:        spec.Target,
+	}
+	if spec.Delay != nil {
+		f.Delay = &runtimev1pb.FaultDelay{
+			Duration:   spec.Delay.Duration,
+			Percentage: int32(spec.Delay.Percentage), //nolint:gosec
+		}
+	}
+	if spec.Error != nil {
+		f.Error = &runtimev1pb.FaultError{
+			Message:    spec.Error.Message,
+			Percentage: int32(spec.Error.Percentage), //nolint:gosec
+		}
+	}
+	return f
+}
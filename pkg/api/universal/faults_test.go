@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/faults"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+func TestFaultsAlpha1(t *testing.T) {
+	t.Run("fault injection disabled", func(t *testing.T) {
+		fakeAPI := New(Options{Logger: logger.NewLogger("test")})
+
+		_, err := fakeAPI.SetFaultAlpha1(t.Context(), &runtimev1pb.SetFaultRequest{})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = fakeAPI.DeleteFaultAlpha1(t.Context(), &runtimev1pb.DeleteFaultRequest{Name: "myfault"})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Empty(t, fakeAPI.metadataFaults())
+	})
+
+	injector, err := faults.New(faults.Options{Spec: &config.FaultInjectionSpec{}})
+	require.NoError(t, err)
+	fakeAPI := New(Options{
+		Logger:        logger.NewLogger("test"),
+		FaultInjector: injector,
+	})
+
+	t.Run("invalid fault", func(t *testing.T) {
+		_, err := fakeAPI.SetFaultAlpha1(t.Context(), &runtimev1pb.SetFaultRequest{})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = fakeAPI.SetFaultAlpha1(t.Context(), &runtimev1pb.SetFaultRequest{
+			Fault: &runtimev1pb.Fault{Name: "myfault", TargetType: "service"},
+		})
+		require.ErrorContains(t, err, "unknown target type")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("set and delete a fault", func(t *testing.T) {
+		_, err := fakeAPI.SetFaultAlpha1(t.Context(), &runtimev1pb.SetFaultRequest{
+			Fault: &runtimev1pb.Fault{
+				Name:          "myfault",
+				BuildingBlock: "statestore",
+				Error:         &runtimev1pb.FaultError{Message: "unavailable", Percentage: 100},
+			},
+			Ttl: "1h",
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, injector.Inject(t.Context(), resiliency.TargetTypeComponent, resiliency.Statestore, "mystore"), faults.ErrFaultInjected)
+
+		list := fakeAPI.metadataFaults()
+		require.Len(t, list, 1)
+		assert.NotEmpty(t, list[0].GetExpireTime())
+		bytes, err := protojson.Marshal(list[0].GetFault())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"myfault","buildingBlock":"statestore","error":{"message":"unavailable","percentage":100}}`, string(bytes))
+
+		_, err = fakeAPI.DeleteFaultAlpha1(t.Context(), &runtimev1pb.DeleteFaultRequest{Name: "myfault"})
+		require.NoError(t, err)
+		require.NoError(t, injector.Inject(t.Context(), resiliency.TargetTypeComponent, resiliency.Statestore, "mystore"))
+
+		_, err = fakeAPI.DeleteFaultAlpha1(t.Context(), &runtimev1pb.DeleteFaultRequest{Name: "myfault"})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// InputBindings pauses and resumes reading the events of input bindings.
+type InputBindings interface {
+	PauseInputBinding(ctx context.Context, name string, persist bool) error
+	ResumeInputBinding(ctx context.Context, name string) error
+	PausedInputBindings() []string
+}
+
+// PauseInputBindingAlpha1 stops reading the events of an input binding, such
+// as to mitigate an incident downstream of the app, until it's resumed.
+func (a *Universal) PauseInputBindingAlpha1(ctx context.Context, in *runtimev1pb.PauseInputBindingRequest) (*emptypb.Empty, error) {
+	if err := a.inputBindingValidateRequest(in.GetName()); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if err := a.inputBindings.PauseInputBinding(ctx, in.GetName(), in.GetPersist()); err != nil {
+		err = messages.ErrInputBindingUpdate.WithFormat(in.GetName(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResumeInputBindingAlpha1 resumes reading the events of a paused input
+// binding.
+func (a *Universal) ResumeInputBindingAlpha1(ctx context.Context, in *runtimev1pb.ResumeInputBindingRequest) (*emptypb.Empty, error) {
+	if err := a.inputBindingValidateRequest(in.GetName()); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if err := a.inputBindings.ResumeInputBinding(ctx, in.GetName()); err != nil {
+		err = messages.ErrInputBindingUpdate.WithFormat(in.GetName(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// metadataPausedInputBindings returns the names of the paused input bindings.
+func (a *Universal) metadataPausedInputBindings() []string {
+	if a.inputBindings == nil {
+		return nil
+	}
+	return a.inputBindings.PausedInputBindings()
+}
+
+func (a *Universal) inputBindingValidateRequest(name string) error {
+	if a.inputBindings == nil {
+		return messages.ErrAPIUnimplemented
+	}
+
+	if _, ok := a.compStore.GetInputBinding(name); !ok {
+		return messages.ErrInputBindingNotFound.WithFormat(name)
+	}
+
+	return nil
+}
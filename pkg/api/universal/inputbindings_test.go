@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+type fakeInputBindings struct {
+	paused  map[string]bool
+	persist map[string]bool
+	err     error
+}
+
+func (f *fakeInputBindings) PauseInputBinding(_ context.Context, name string, persist bool) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.paused[name] = true
+	f.persist[name] = persist
+	return nil
+}
+
+func (f *fakeInputBindings) ResumeInputBinding(_ context.Context, name string) error {
+	if f.err != nil {
+		return f.err
+	}
+	delete(f.paused, name)
+	delete(f.persist, name)
+	return nil
+}
+
+func (f *fakeInputBindings) PausedInputBindings() []string {
+	names := make([]string, 0, len(f.paused))
+	for name := range f.paused {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestPauseResumeInputBinding(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddInputBinding("orders", new(daprt.MockBinding))
+
+	inputs := &fakeInputBindings{paused: map[string]bool{}, persist: map[string]bool{}}
+	fakeAPI := &Universal{
+		logger:        logger.NewLogger("test"),
+		compStore:     compStore,
+		inputBindings: inputs,
+	}
+
+	t.Run("pause and resume", func(t *testing.T) {
+		_, err := fakeAPI.PauseInputBindingAlpha1(t.Context(), &runtimev1pb.PauseInputBindingRequest{
+			Name:    "orders",
+			Persist: true,
+		})
+		require.NoError(t, err)
+		assert.True(t, inputs.paused["orders"])
+		assert.True(t, inputs.persist["orders"])
+		assert.Equal(t, []string{"orders"}, fakeAPI.metadataPausedInputBindings())
+
+		_, err = fakeAPI.ResumeInputBindingAlpha1(t.Context(), &runtimev1pb.ResumeInputBindingRequest{
+			Name: "orders",
+		})
+		require.NoError(t, err)
+		assert.False(t, inputs.paused["orders"])
+		assert.Empty(t, fakeAPI.metadataPausedInputBindings())
+	})
+
+	t.Run("input binding not found", func(t *testing.T) {
+		_, err := fakeAPI.PauseInputBindingAlpha1(t.Context(), &runtimev1pb.PauseInputBindingRequest{
+			Name: "payments",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("update fails", func(t *testing.T) {
+		inputs.err = errors.New("state store unavailable")
+		t.Cleanup(func() { inputs.err = nil })
+
+		_, err := fakeAPI.PauseInputBindingAlpha1(t.Context(), &runtimev1pb.PauseInputBindingRequest{
+			Name: "orders",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("unimplemented without input bindings", func(t *testing.T) {
+		api := &Universal{
+			logger:    logger.NewLogger("test"),
+			compStore: compStore,
+		}
+		_, err := api.ResumeInputBindingAlpha1(t.Context(), &runtimev1pb.ResumeInputBindingRequest{
+			Name: "orders",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		assert.Nil(t, api.metadataPausedInputBindings())
+	})
+}
@@ -15,13 +15,20 @@ package universal
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -30,6 +37,10 @@ import (
 
 const (
 	rpcTimeout = time.Second * 30
+
+	// bulkJobsMaxConcurrency is the maximum number of jobs of a bulk jobs call
+	// sent to the scheduler concurrently.
+	bulkJobsMaxConcurrency = 100
 )
 
 func (a *Universal) ScheduleJobAlpha1(ctx context.Context, inReq *runtimev1pb.ScheduleJobRequest) (*runtimev1pb.ScheduleJobResponse, error) {
@@ -41,12 +52,21 @@ func (a *Universal) ScheduleJob(ctx context.Context, inReq *runtimev1pb.Schedule
 }
 
 func (a *Universal) ScheduleJobAlpha1HTTP(ctx context.Context, job *internalsv1pb.JobHTTPRequest) (*runtimev1pb.ScheduleJobResponse, error) {
+	req, err := jobHTTPToScheduleRequest(job)
+	if err != nil {
+		return &runtimev1pb.ScheduleJobResponse{}, err
+	}
+
+	return a.scheduleJob(ctx, req)
+}
+
+func jobHTTPToScheduleRequest(job *internalsv1pb.JobHTTPRequest) (*runtimev1pb.ScheduleJobRequest, error) {
 	data, err := anypb.New(job.GetData())
 	if err != nil {
-		return &runtimev1pb.ScheduleJobResponse{}, fmt.Errorf("error creating storable job data from job: %w", err)
+		return nil, fmt.Errorf("error creating storable job data from job: %w", err)
 	}
 
-	return a.scheduleJob(ctx, &runtimev1pb.ScheduleJobRequest{
+	return &runtimev1pb.ScheduleJobRequest{
 		Job: &runtimev1pb.Job{
 			Name:          job.GetName(),
 			Schedule:      job.Schedule,
@@ -55,9 +75,13 @@ func (a *Universal) ScheduleJobAlpha1HTTP(ctx context.Context, job *internalsv1p
 			Ttl:           job.Ttl,
 			Data:          data,
 			FailurePolicy: job.GetFailurePolicy(),
+			Labels:        job.GetLabels(),
+			ExactlyOnce:   job.ExactlyOnce,
+			TimeZone:      job.TimeZone,
+			Calendar:      job.Calendar,
 		},
 		Overwrite: job.GetOverwrite(),
-	})
+	}, nil
 }
 
 func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.ScheduleJobRequest) (*runtimev1pb.ScheduleJobResponse, error) {
@@ -74,6 +98,10 @@ func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.Sch
 		return &runtimev1pb.ScheduleJobResponse{}, apierrors.Empty("Name", errMetadata, errorcodes.SchedulerJobNameEmpty)
 	}
 
+	if statettl.IsJobName(job.GetName()) {
+		return &runtimev1pb.ScheduleJobResponse{}, apierrors.SchedulerJobNameReserved(errMetadata, job.GetName())
+	}
+
 	if job.Schedule == nil && job.DueTime == nil {
 		return &runtimev1pb.ScheduleJobResponse{}, apierrors.Empty("Schedule", errMetadata, errorcodes.SchedulerScheduleEmpty)
 	}
@@ -88,6 +116,7 @@ func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.Sch
 					Job: new(schedulerv1pb.TargetJob),
 				},
 			},
+			Labels: job.GetLabels(),
 		},
 		Overwrite: jobRequest.GetOverwrite(),
 
@@ -98,9 +127,25 @@ func (a *Universal) scheduleJob(ctx context.Context, jobRequest *runtimev1pb.Sch
 			DueTime:       job.DueTime,
 			Ttl:           job.Ttl,
 			FailurePolicy: job.GetFailurePolicy(),
+			TimeZone:      job.TimeZone,
 		},
 	}
 
+	if job.Calendar != nil {
+		cal, ok := a.jobCalendar(job)
+		if !ok {
+			return &runtimev1pb.ScheduleJobResponse{}, apierrors.SchedulerJobCalendarNotFound(errMetadata, job.GetCalendar())
+		}
+		internalScheduleJobReq.Metadata.Calendar = cal
+	}
+
+	// The scheduler validates that exactly-once jobs are one-time jobs. The
+	// token is generated once per scheduled job, so redeliveries of its trigger
+	// carry the same token.
+	if job.GetExactlyOnce() {
+		internalScheduleJobReq.Metadata.IdempotencyToken = new(uuid.NewString())
+	}
+
 	schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
 	defer cancel()
 
@@ -200,17 +245,75 @@ func (a *Universal) getJob(ctx context.Context, inReq *runtimev1pb.GetJobRequest
 
 	return &runtimev1pb.GetJobResponse{
 		Job: &runtimev1pb.Job{
-			Name:          inReq.GetName(),
-			Schedule:      resp.GetJob().Schedule,
-			Data:          resp.GetJob().GetData(),
-			Repeats:       resp.GetJob().Repeats,
-			DueTime:       resp.GetJob().DueTime,
-			Ttl:           resp.GetJob().Ttl,
-			FailurePolicy: resp.GetJob().GetFailurePolicy(),
+			Name:           inReq.GetName(),
+			Schedule:       resp.GetJob().Schedule,
+			Data:           resp.GetJob().GetData(),
+			Repeats:        resp.GetJob().Repeats,
+			DueTime:        resp.GetJob().DueTime,
+			Ttl:            resp.GetJob().Ttl,
+			FailurePolicy:  resp.GetJob().GetFailurePolicy(),
+			Labels:         resp.GetLabels(),
+			TriggerHistory: triggerHistory(resp.GetHistory()),
+			ExactlyOnce:    exactlyOnce(resp.GetExactlyOnce()),
+			TimeZone:       resp.GetJob().TimeZone,
+			Calendar:       resp.Calendar,
 		},
 	}, nil
 }
 
+// jobCalendar resolves the exclusion calendar of the job from the jobs section
+// of the Configuration. Calendars without a time zone are evaluated in the
+// time zone of the job.
+func (a *Universal) jobCalendar(job *runtimev1pb.Job) (*schedulerv1pb.JobCalendar, bool) {
+	if a.globalConfig == nil {
+		return nil, false
+	}
+
+	cal, ok := a.globalConfig.GetJobCalendar(job.GetCalendar())
+	if !ok {
+		return nil, false
+	}
+
+	timeZone := cal.TimeZone
+	if timeZone == nil {
+		timeZone = job.TimeZone
+	}
+
+	return &schedulerv1pb.JobCalendar{
+		Name:          cal.Name,
+		ExcludedDates: cal.ExcludedDates,
+		TimeZone:      timeZone,
+	}, true
+}
+
+// exactlyOnce returns the exactly-once flag of a job, which is omitted unless
+// set.
+func exactlyOnce(set bool) *bool {
+	if !set {
+		return nil
+	}
+	return new(true)
+}
+
+// triggerHistory converts the scheduler job trigger history to the runtime
+// API representation.
+func triggerHistory(history []*schedulerv1pb.JobTriggerAttempt) []*runtimev1pb.JobTriggerAttempt {
+	if len(history) == 0 {
+		return nil
+	}
+
+	attempts := make([]*runtimev1pb.JobTriggerAttempt, len(history))
+	for i, attempt := range history {
+		attempts[i] = &runtimev1pb.JobTriggerAttempt{
+			Time:   attempt.GetTime().AsTime().Format(time.RFC3339Nano),
+			Result: strings.TrimPrefix(attempt.GetResult().String(), "JOB_TRIGGER_RESULT_"),
+			Error:  attempt.Error, //nolint:protogetter
+		}
+	}
+
+	return attempts
+}
+
 func (a *Universal) DeleteJobsByPrefix(ctx context.Context, req *runtimev1pb.DeleteJobsByPrefixRequest) (*runtimev1pb.DeleteJobsByPrefixResponse, error) {
 	return a.deleteJobsByPrefix(ctx, req)
 }
@@ -272,10 +375,7 @@ func (a *Universal) listJobs(ctx context.Context, req *runtimev1pb.ListJobsReque
 		"namespace": a.Namespace(),
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
-	defer cancel()
-
-	resp, err := a.scheduler.ListJobs(ctx, &schedulerv1pb.ListJobsRequest{
+	listReq := &schedulerv1pb.ListJobsRequest{
 		Metadata: &schedulerv1pb.JobMetadata{
 			AppId:     a.appID,
 			Namespace: a.Namespace(),
@@ -285,14 +385,54 @@ func (a *Universal) listJobs(ctx context.Context, req *runtimev1pb.ListJobsReque
 				},
 			},
 		},
-	})
+		LabelSelector: req.GetLabelSelector(),
+		PageSize:      req.GetPageSize(),
+	}
+
+	if token := req.GetContinuationToken(); len(token) > 0 {
+		b, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil || len(b) == 0 {
+			return nil, apierrors.SchedulerListJobsFilter(errMetadata, errors.New("invalid continuation token"))
+		}
+		listReq.StartAfter = &schedulerv1pb.ListJobsPosition{Name: string(b)}
+	}
+
+	for _, bound := range []struct {
+		name string
+		val  *string
+		out  **timestamppb.Timestamp
+	}{
+		{"dueAfter", req.DueAfter, &listReq.DueAfter}, {"dueBefore", req.DueBefore, &listReq.DueBefore},
+	} {
+		if bound.val == nil {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, *bound.val)
+		if err != nil {
+			return nil, apierrors.SchedulerListJobsFilter(errMetadata, fmt.Errorf("%s must be an RFC3339 time: %w", bound.name, err))
+		}
+		*bound.out = timestamppb.New(t)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	resp, err := a.scheduler.ListJobs(ctx, listReq)
 	if err != nil {
 		a.logger.Errorf("Error listing jobs due to: %s", err)
 		return nil, apierrors.SchedulerListJobs(errMetadata, err)
 	}
 
+	// The jobs the runtime schedules to delete expired state are not listed,
+	// but still continue the listing.
+	var lastName string
 	jobs := make([]*runtimev1pb.Job, 0, len(resp.GetJobs()))
 	for _, namedJob := range resp.GetJobs() {
+		lastName = namedJob.GetName()
+		if statettl.IsJobName(lastName) {
+			continue
+		}
+
 		job := namedJob.GetJob()
 		//nolint:protogetter
 		jobs = append(jobs, &runtimev1pb.Job{
@@ -303,10 +443,126 @@ func (a *Universal) listJobs(ctx context.Context, req *runtimev1pb.ListJobsReque
 			Ttl:           job.Ttl,
 			Data:          job.Data,
 			FailurePolicy: job.FailurePolicy,
+			Labels:        namedJob.GetMetadata().GetLabels(),
+			ExactlyOnce:   exactlyOnce(namedJob.GetMetadata().GetIdempotencyToken() != ""),
+			TimeZone:      job.TimeZone,
+			Calendar:      calendarName(namedJob.GetMetadata().GetCalendar()),
 		})
 	}
 
-	return &runtimev1pb.ListJobsResponse{
+	listResp := &runtimev1pb.ListJobsResponse{
 		Jobs: jobs,
+	}
+	if resp.GetHasMore() && lastName != "" {
+		listResp.ContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(lastName))
+	}
+
+	return listResp, nil
+}
+
+// calendarName returns the name of the exclusion calendar of a job, if any.
+func calendarName(cal *schedulerv1pb.JobCalendar) *string {
+	if cal == nil {
+		return nil
+	}
+	return new(cal.GetName())
+}
+
+func (a *Universal) BulkScheduleJobs(ctx context.Context, req *runtimev1pb.BulkScheduleJobsRequest) (*runtimev1pb.BulkScheduleJobsResponse, error) {
+	failed := make([]*runtimev1pb.BulkJobsFailedEntry, len(req.GetJobs()))
+
+	var eg errgroup.Group
+	eg.SetLimit(bulkJobsMaxConcurrency)
+
+	names := make(map[string]struct{}, len(req.GetJobs()))
+	for i, jobReq := range req.GetJobs() {
+		name := jobReq.GetJob().GetName()
+
+		// Scheduling the same job concurrently would race, so only the first
+		// job of a given name in the batch is scheduled.
+		if _, ok := names[name]; ok && name != "" {
+			failed[i] = &runtimev1pb.BulkJobsFailedEntry{
+				Name:  name,
+				Error: fmt.Sprintf("duplicate job name %q in batch", name),
+			}
+			continue
+		}
+		names[name] = struct{}{}
+
+		eg.Go(func() error {
+			if _, err := a.scheduleJob(ctx, jobReq); err != nil {
+				failed[i] = &runtimev1pb.BulkJobsFailedEntry{Name: name, Error: err.Error()}
+			}
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+
+	return &runtimev1pb.BulkScheduleJobsResponse{
+		FailedEntries: compactFailedEntries(failed),
 	}, nil
 }
+
+func (a *Universal) BulkScheduleJobsHTTP(ctx context.Context, req *internalsv1pb.BulkJobsHTTPRequest) (*runtimev1pb.BulkScheduleJobsResponse, error) {
+	var failed []*runtimev1pb.BulkJobsFailedEntry
+	bulkReq := &runtimev1pb.BulkScheduleJobsRequest{
+		Jobs: make([]*runtimev1pb.ScheduleJobRequest, 0, len(req.GetJobs())),
+	}
+	for _, job := range req.GetJobs() {
+		jobReq, err := jobHTTPToScheduleRequest(job)
+		if err != nil {
+			failed = append(failed, &runtimev1pb.BulkJobsFailedEntry{Name: job.GetName(), Error: err.Error()})
+			continue
+		}
+		bulkReq.Jobs = append(bulkReq.Jobs, jobReq)
+	}
+
+	resp, err := a.BulkScheduleJobs(ctx, bulkReq)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.FailedEntries = append(failed, resp.GetFailedEntries()...)
+	return resp, nil
+}
+
+func (a *Universal) BulkDeleteJobs(ctx context.Context, req *runtimev1pb.BulkDeleteJobsRequest) (*runtimev1pb.BulkDeleteJobsResponse, error) {
+	failed := make([]*runtimev1pb.BulkJobsFailedEntry, len(req.GetNames()))
+
+	var eg errgroup.Group
+	eg.SetLimit(bulkJobsMaxConcurrency)
+
+	names := make(map[string]struct{}, len(req.GetNames()))
+	for i, name := range req.GetNames() {
+		if _, ok := names[name]; ok {
+			continue
+		}
+		names[name] = struct{}{}
+
+		eg.Go(func() error {
+			if _, err := a.deleteJob(ctx, &runtimev1pb.DeleteJobRequest{Name: name}); err != nil {
+				failed[i] = &runtimev1pb.BulkJobsFailedEntry{Name: name, Error: err.Error()}
+			}
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+
+	return &runtimev1pb.BulkDeleteJobsResponse{
+		FailedEntries: compactFailedEntries(failed),
+	}, nil
+}
+
+// compactFailedEntries returns the failed entries of a bulk jobs call, in the
+// order of the jobs in the request.
+func compactFailedEntries(entries []*runtimev1pb.BulkJobsFailedEntry) []*runtimev1pb.BulkJobsFailedEntry {
+	var failed []*runtimev1pb.BulkJobsFailedEntry
+	for _, entry := range entries {
+		if entry != nil {
+			failed = append(failed, entry)
+		}
+	}
+	return failed
+}
@@ -15,6 +15,8 @@ package universal
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/dapr/components-contrib/lock"
 	lockLoader "github.com/dapr/dapr/pkg/components/lock"
@@ -50,25 +52,127 @@ func (a *Universal) TryLockAlpha1(ctx context.Context, req *runtimev1pb.TryLockR
 	}
 
 	// 3. delegate to the component
+	resp, err := a.tryLock(ctx, req.GetStoreName(), store, compReq)
+	if err != nil {
+		return &runtimev1pb.TryLockResponse{}, err
+	}
+
+	// 4. convert response
+	if resp == nil {
+		return &runtimev1pb.TryLockResponse{}, nil
+	}
+	res := &runtimev1pb.TryLockResponse{
+		Success: resp.Success,
+	}
+	if resp.Success {
+		res.FencingToken = fencingToken(resp.Metadata)
+	}
+	return res, nil
+}
+
+func (a *Universal) tryLock(ctx context.Context, storeName string, store lock.Store, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
 	policyRunner := resiliency.NewRunner[*lock.TryLockResponse](ctx,
-		a.resiliency.ComponentOutboundPolicy(req.GetStoreName(), resiliency.Lock),
+		a.resiliency.ComponentOutboundPolicy(storeName, resiliency.Lock),
 	)
 	resp, err := policyRunner(func(ctx context.Context) (*lock.TryLockResponse, error) {
-		return store.TryLock(ctx, compReq)
+		return store.TryLock(ctx, req)
 	})
 	if err != nil {
 		err = messages.ErrTryLockFailed.WithFormat(err)
 		a.logger.Debug(err)
-		return &runtimev1pb.TryLockResponse{}, err
+		return nil, err
 	}
+	return resp, nil
+}
 
-	// 4. convert response
-	if resp == nil {
-		return &runtimev1pb.TryLockResponse{}, nil
+// AcquireLockAlpha1 waits for the lock to be released to acquire it, until the
+// wait timeout. The requests of the sidecar for the same lock are queued, and
+// only the head of the queue tries to acquire the lock: when the lock is
+// released by the sidecar, or else periodically, as lock stores do not notify
+// of released locks.
+func (a *Universal) AcquireLockAlpha1(ctx context.Context, req *runtimev1pb.AcquireLockRequest) (*runtimev1pb.AcquireLockResponse, error) {
+	// 1. validate and find lock component
+	if req.GetExpiryInSeconds() <= 0 {
+		err := messages.ErrExpiryInSecondsNotPositive.WithFormat(req.GetStoreName())
+		a.logger.Debug(err)
+		return &runtimev1pb.AcquireLockResponse{}, err
 	}
-	return &runtimev1pb.TryLockResponse{
-		Success: resp.Success,
-	}, nil
+	if req.GetWaitTimeoutInSeconds() < 0 {
+		err := messages.ErrWaitTimeoutNegative.WithFormat(req.GetStoreName())
+		a.logger.Debug(err)
+		return &runtimev1pb.AcquireLockResponse{}, err
+	}
+	store, err := a.lockValidateRequest(req)
+	if err != nil {
+		return &runtimev1pb.AcquireLockResponse{}, err
+	}
+
+	// 2. convert request
+	compReq := &lock.TryLockRequest{
+		ResourceID:      req.GetResourceId(),
+		LockOwner:       req.GetLockOwner(),
+		ExpiryInSeconds: req.GetExpiryInSeconds(),
+	}
+	// modify key
+	compReq.ResourceID, err = lockLoader.GetModifiedLockKey(compReq.ResourceID, req.GetStoreName(), a.appID)
+	if err != nil {
+		err = messages.ErrTryLockFailed.WithFormat(err)
+		a.logger.Debug(err)
+		return &runtimev1pb.AcquireLockResponse{}, err
+	}
+
+	acquired := func(resp *lock.TryLockResponse) *runtimev1pb.AcquireLockResponse {
+		return &runtimev1pb.AcquireLockResponse{
+			Success:      true,
+			FencingToken: fencingToken(resp.Metadata),
+		}
+	}
+
+	if req.GetWaitTimeoutInSeconds() == 0 {
+		resp, err := a.tryLock(ctx, req.GetStoreName(), store, compReq)
+		if err != nil || resp == nil || !resp.Success {
+			return &runtimev1pb.AcquireLockResponse{}, err
+		}
+		return acquired(resp), nil
+	}
+
+	// 3. wait for the lock in the queue of the sidecar
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(req.GetWaitTimeoutInSeconds())*time.Second)
+	defer cancel()
+
+	key := lockWaitersKey(req.GetStoreName(), compReq.ResourceID)
+	waiter := a.lockWaiters.join(key)
+	defer a.lockWaiters.leave(key, waiter)
+
+	if waiter.waitHead(waitCtx) != nil {
+		return &runtimev1pb.AcquireLockResponse{}, ctx.Err()
+	}
+	for {
+		resp, err := a.tryLock(waitCtx, req.GetStoreName(), store, compReq)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return &runtimev1pb.AcquireLockResponse{}, ctx.Err()
+			}
+			return &runtimev1pb.AcquireLockResponse{}, err
+		}
+		if resp != nil && resp.Success {
+			return acquired(resp), nil
+		}
+
+		timer := time.NewTimer(lockAcquirePollInterval)
+		select {
+		case <-waiter.queue.released:
+		case <-timer.C:
+		case <-waitCtx.Done():
+			timer.Stop()
+			return &runtimev1pb.AcquireLockResponse{}, ctx.Err()
+		}
+		timer.Stop()
+	}
+}
+
+func lockWaitersKey(storeName, resourceID string) string {
+	return storeName + "||" + resourceID
 }
 
 func (a *Universal) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error) {
@@ -110,13 +214,95 @@ func (a *Universal) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockReq
 	if resp == nil {
 		return &runtimev1pb.UnlockResponse{}, nil
 	}
+	if resp.Status == lock.Success {
+		a.lockWaiters.released(lockWaitersKey(req.GetStoreName(), compReq.ResourceID))
+	}
 	return &runtimev1pb.UnlockResponse{
 		//nolint:nosnakecase
 		Status: runtimev1pb.UnlockResponse_Status(resp.Status),
 	}, nil
 }
 
-// Interface for both *runtimev1pb.TryLockRequest and *runtimev1pb.UnlockRequest
+func (a *Universal) RenewLockAlpha1(ctx context.Context, req *runtimev1pb.RenewLockRequest) (*runtimev1pb.RenewLockResponse, error) {
+	// 1. validate and find lock component
+	if req.GetExpiryInSeconds() <= 0 {
+		err := messages.ErrExpiryInSecondsNotPositive.WithFormat(req.GetStoreName())
+		a.logger.Debug(err)
+		return newInternalErrorRenewLockResponse(), err
+	}
+	store, err := a.lockValidateRequest(req)
+	if err != nil {
+		return newInternalErrorRenewLockResponse(), err
+	}
+	// Renewing a lock by releasing and acquiring it again would not be atomic,
+	// and would change its fencing token.
+	renewer, ok := store.(lockLoader.Renewer)
+	if !ok {
+		err = messages.ErrRenewLockUnsupported.WithFormat(req.GetStoreName())
+		a.logger.Debug(err)
+		return newInternalErrorRenewLockResponse(), err
+	}
+
+	// 2. convert request
+	compReq := &lockLoader.RenewLockRequest{
+		ResourceID:      req.GetResourceId(),
+		LockOwner:       req.GetLockOwner(),
+		ExpiryInSeconds: req.GetExpiryInSeconds(),
+	}
+	// modify key
+	compReq.ResourceID, err = lockLoader.GetModifiedLockKey(compReq.ResourceID, req.GetStoreName(), a.appID)
+	if err != nil {
+		err = messages.ErrRenewLockFailed.WithFormat(err)
+		a.logger.Debug(err)
+		return newInternalErrorRenewLockResponse(), err
+	}
+
+	// 3. delegate to the component
+	policyRunner := resiliency.NewRunner[*lockLoader.RenewLockResponse](ctx,
+		a.resiliency.ComponentOutboundPolicy(req.GetStoreName(), resiliency.Lock),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*lockLoader.RenewLockResponse, error) {
+		return renewer.RenewLock(ctx, compReq)
+	})
+	if err != nil {
+		err = messages.ErrRenewLockFailed.WithFormat(err)
+		a.logger.Debug(err)
+		return newInternalErrorRenewLockResponse(), err
+	}
+
+	// 4. convert response
+	if resp == nil {
+		return &runtimev1pb.RenewLockResponse{}, nil
+	}
+	res := &runtimev1pb.RenewLockResponse{
+		//nolint:nosnakecase
+		Status: runtimev1pb.RenewLockResponse_Status(resp.Status),
+	}
+	if resp.Status == lock.Success {
+		res.FencingToken = fencingToken(resp.Metadata)
+	}
+	return res, nil
+}
+
+// fencingToken returns the fencing token of an acquired lock, which lock
+// stores return in the metadata of their responses, or zero if the lock store
+// does not provide fencing tokens. Fencing tokens are only provided by the lock
+// store, as it is the only one to order the acquisitions of a lock across
+// sidecars.
+func fencingToken(metadata map[string]string) int64 {
+	if v, ok := metadata[lockLoader.FencingTokenMetadataKey]; ok {
+		if token, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return token
+		}
+	}
+	return 0
+}
+
+// lockAcquirePollInterval is the interval at which the heads of the queues of
+// the requests waiting for locks try to acquire them.
+const lockAcquirePollInterval = 250 * time.Millisecond
+
+// Interface for the requests of the lock API
 type tryLockUnlockRequest interface {
 	GetResourceId() string
 	GetLockOwner() string
@@ -160,3 +346,10 @@ func newInternalErrorUnlockResponse() *runtimev1pb.UnlockResponse {
 		Status: runtimev1pb.UnlockResponse_INTERNAL_ERROR,
 	}
 }
+
+func newInternalErrorRenewLockResponse() *runtimev1pb.RenewLockResponse {
+	return &runtimev1pb.RenewLockResponse{
+		//nolint:nosnakecase
+		Status: runtimev1pb.RenewLockResponse_INTERNAL_ERROR,
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/components-contrib/metadata"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+// memLockStore is a lock store of which the locks never expire.
+type memLockStore struct {
+	lock   sync.Mutex
+	owners map[string]string
+}
+
+func (m *memLockStore) InitLockStore(context.Context, lock.Metadata) error { return nil }
+
+func (m *memLockStore) TryLock(_ context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.owners[req.ResourceID]; ok {
+		return &lock.TryLockResponse{}, nil
+	}
+	m.owners[req.ResourceID] = req.LockOwner
+	return &lock.TryLockResponse{Success: true}, nil
+}
+
+func (m *memLockStore) Unlock(_ context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	owner, ok := m.owners[req.ResourceID]
+	switch {
+	case !ok:
+		return &lock.UnlockResponse{Status: lock.LockDoesNotExist}, nil
+	case owner != req.LockOwner:
+		return &lock.UnlockResponse{Status: lock.LockBelongsToOthers}, nil
+	}
+	delete(m.owners, req.ResourceID)
+	return &lock.UnlockResponse{Status: lock.Success}, nil
+}
+
+func (m *memLockStore) GetComponentMetadata() metadata.MetadataMap { return nil }
+
+func (m *memLockStore) Close() error { return nil }
+
+func TestAcquireLock(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddLock("mylock", &memLockStore{owners: make(map[string]string)})
+	fakeAPI := &Universal{
+		appID:      "fakeAPI",
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	acquire := func(owner string, wait int32) (*runtimev1pb.AcquireLockResponse, error) {
+		return fakeAPI.AcquireLockAlpha1(t.Context(), &runtimev1pb.AcquireLockRequest{
+			StoreName:            "mylock",
+			ResourceId:           "resource",
+			LockOwner:            owner,
+			ExpiryInSeconds:      10,
+			WaitTimeoutInSeconds: wait,
+		})
+	}
+	unlock := func(t *testing.T, owner string) {
+		t.Helper()
+		resp, err := fakeAPI.UnlockAlpha1(t.Context(), &runtimev1pb.UnlockRequest{
+			StoreName:  "mylock",
+			ResourceId: "resource",
+			LockOwner:  owner,
+		})
+		require.NoError(t, err)
+		require.Equal(t, runtimev1pb.UnlockResponse_SUCCESS, resp.GetStatus())
+	}
+	queued := func() int {
+		fakeAPI.lockWaiters.lock.Lock()
+		defer fakeAPI.lockWaiters.lock.Unlock()
+		q, ok := fakeAPI.lockWaiters.queues[lockWaitersKey("mylock", "lock||fakeAPI||resource")]
+		if !ok {
+			return 0
+		}
+		return len(q.waiters)
+	}
+
+	t.Run("invalid wait timeout", func(t *testing.T) {
+		_, err := acquire("owner1", -1)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("lock is acquired by waiters in order", func(t *testing.T) {
+		resp, err := acquire("owner1", 0)
+		require.NoError(t, err)
+		require.True(t, resp.GetSuccess())
+
+		resp, err = acquire("owner2", 0)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+
+		acquired := make(chan string, 2)
+		for i, owner := range []string{"owner2", "owner3"} {
+			go func() {
+				resp, err := acquire(owner, 10)
+				if assert.NoError(t, err) && assert.True(t, resp.GetSuccess()) {
+					acquired <- owner
+				}
+			}()
+			require.EventuallyWithT(t, func(c *assert.CollectT) {
+				assert.Equal(c, i+1, queued())
+			}, time.Second, time.Millisecond)
+		}
+
+		unlock(t, "owner1")
+		select {
+		case owner := <-acquired:
+			assert.Equal(t, "owner2", owner)
+		case <-time.After(lockAcquirePollInterval / 2):
+			require.Fail(t, "lock was not acquired once released")
+		}
+
+		unlock(t, "owner2")
+		select {
+		case owner := <-acquired:
+			assert.Equal(t, "owner3", owner)
+		case <-time.After(lockAcquirePollInterval / 2):
+			require.Fail(t, "lock was not acquired once released")
+		}
+		assert.Zero(t, queued())
+
+		unlock(t, "owner3")
+	})
+
+	t.Run("wait timeout", func(t *testing.T) {
+		resp, err := acquire("owner1", 0)
+		require.NoError(t, err)
+		require.True(t, resp.GetSuccess())
+
+		start := time.Now()
+		resp, err = acquire("owner2", 1)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Zero(t, queued())
+	})
+}
+
+func TestLockWaiters(t *testing.T) {
+	var l lockWaiters
+	w1 := l.join("key")
+	w2 := l.join("key")
+	w3 := l.join("key")
+
+	isHead := func(w *lockWaiter) bool {
+		select {
+		case <-w.head:
+			return true
+		default:
+			return false
+		}
+	}
+	assert.True(t, isHead(w1))
+	assert.False(t, isHead(w2))
+
+	// Waiters leaving the queue before they are the head do not make the next
+	// waiter the head.
+	l.leave("key", w2)
+	assert.False(t, isHead(w3))
+	l.leave("key", w1)
+	assert.True(t, isHead(w3))
+
+	l.released("key")
+	l.released("key")
+	assert.Len(t, w3.queue.released, 1)
+
+	l.leave("key", w3)
+	assert.Empty(t, l.queues)
+	l.released("key")
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// lockWaiters are the queues of the requests waiting on the sidecar to
+// acquire locks, by lock store and resource. Only the head of a queue tries
+// to acquire the lock, so that the requests of the sidecar acquire the lock
+// in the order in which they were made.
+type lockWaiters struct {
+	lock   sync.Mutex
+	queues map[string]*lockQueue
+}
+
+type lockQueue struct {
+	waiters []*lockWaiter
+	// released is signalled when the lock is released by the sidecar.
+	released chan struct{}
+}
+
+type lockWaiter struct {
+	queue *lockQueue
+	head  chan struct{}
+}
+
+// join adds a waiter to the end of the queue of the lock.
+func (l *lockWaiters) join(key string) *lockWaiter {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.queues == nil {
+		l.queues = make(map[string]*lockQueue)
+	}
+	q, ok := l.queues[key]
+	if !ok {
+		q = &lockQueue{released: make(chan struct{}, 1)}
+		l.queues[key] = q
+	}
+
+	w := &lockWaiter{queue: q, head: make(chan struct{})}
+	q.waiters = append(q.waiters, w)
+	if len(q.waiters) == 1 {
+		close(w.head)
+	}
+	return w
+}
+
+// leave removes the waiter from the queue of the lock, making the next waiter
+// the head of the queue.
+func (l *lockWaiters) leave(key string, w *lockWaiter) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	q := w.queue
+	i := slices.Index(q.waiters, w)
+	if i < 0 {
+		return
+	}
+	q.waiters = slices.Delete(q.waiters, i, i+1)
+	if len(q.waiters) == 0 {
+		delete(l.queues, key)
+		return
+	}
+	if i == 0 {
+		close(q.waiters[0].head)
+	}
+}
+
+// released wakes the head of the queue of the lock, once the lock is released
+// by the sidecar.
+func (l *lockWaiters) released(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if q, ok := l.queues[key]; ok {
+		select {
+		case q.released <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// waitHead blocks until the waiter is the head of the queue of the lock.
+func (w *lockWaiter) waitHead(ctx context.Context) error {
+	select {
+	case <-w.head:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
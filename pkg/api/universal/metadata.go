@@ -16,11 +16,16 @@ package universal
 import (
 	"context"
 	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/dapr/dapr/pkg/buildinfo"
+	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/config/protocol"
+	"github.com/dapr/dapr/pkg/messages/errorcodes"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
@@ -75,6 +80,9 @@ func (a *Universal) GetMetadata(ctx context.Context, in *runtimev1pb.GetMetadata
 			Type:         comp.Spec.Type,
 			Capabilities: metadataGetOrDefaultCapabilities(componentsCapabilities, comp.Name),
 		}
+		if d, ok := a.compStore.GetComponentInitDuration(comp.Name); ok {
+			registeredComponents[i].InitDurationMs = d.Milliseconds()
+		}
 	}
 
 	// Subscriptions
@@ -89,6 +97,16 @@ func (a *Universal) GetMetadata(ctx context.Context, in *runtimev1pb.GetMetadata
 			Rules:           metadataConvertPubSubSubscriptionRules(s.Rules),
 			Type:            s.Type,
 		}
+		if s.BulkSubscribe != nil {
+			ps[i].BulkSubscribe = &runtimev1pb.BulkSubscribeConfig{
+				Enabled:            s.BulkSubscribe.Enabled,
+				MaxMessagesCount:   s.BulkSubscribe.MaxMessagesCount,
+				MaxAwaitDurationMs: s.BulkSubscribe.MaxAwaitDurationMs,
+			}
+		}
+		if a.subscriptions != nil && s.Type != runtimev1pb.PubsubSubscriptionType_STREAMING {
+			ps[i].Paused = a.subscriptions.IsSubscriptionPaused(s.PubsubName, s.Topic)
+		}
 	}
 
 	// HTTP endpoints
@@ -119,11 +137,41 @@ func (a *Universal) GetMetadata(ctx context.Context, in *runtimev1pb.GetMetadata
 	}
 
 	// Resiliency resources
-	resiliencies := a.compStore.ListResiliencyResources()
-	registeredResiliencies := make([]*runtimev1pb.MetadataResiliency, len(resiliencies))
-	for i, r := range resiliencies {
-		registeredResiliencies[i] = &runtimev1pb.MetadataResiliency{
-			Name: r.Name,
+	registeredResiliencies := a.metadataResiliencies()
+	resiliencyNames := make([]string, len(registeredResiliencies))
+	for i, r := range registeredResiliencies {
+		resiliencyNames[i] = r.GetName()
+	}
+
+	// Component reloads
+	reloads := a.compStore.ListComponentReloads()
+	componentReloads := make([]*runtimev1pb.MetadataComponentReload, len(reloads))
+	for i, r := range reloads {
+		componentReloads[i] = &runtimev1pb.MetadataComponentReload{
+			Name:    r.Name,
+			Type:    r.Type,
+			Version: r.Version,
+			Swapped: r.Swapped,
+			Status:  runtimev1pb.MetadataComponentReload_SUCCEEDED,
+			Time:    r.Time.Format(time.RFC3339),
+		}
+		if r.Err != nil {
+			componentReloads[i].Status = runtimev1pb.MetadataComponentReload_FAILED
+			componentReloads[i].Error = r.Err.Error()
+		}
+	}
+
+	// Configuration reloads
+	cfgReloads := a.compStore.ListConfigurationReloads()
+	configurationReloads := make([]*runtimev1pb.MetadataConfigurationReload, len(cfgReloads))
+	for i, r := range cfgReloads {
+		configurationReloads[i] = &runtimev1pb.MetadataConfigurationReload{
+			Time:            r.Time.Format(time.RFC3339),
+			Applied:         r.Applied,
+			RestartRequired: r.RestartRequired,
+		}
+		if r.Err != nil {
+			configurationReloads[i].Error = r.Err.Error()
 		}
 	}
 
@@ -132,6 +180,7 @@ func (a *Universal) GetMetadata(ctx context.Context, in *runtimev1pb.GetMetadata
 		if addr := a.scheduler.Addresses(); len(addr) > 0 {
 			sched = &runtimev1pb.MetadataScheduler{
 				ConnectedAddresses: addr,
+				JobFailures:        a.scheduler.JobFailures(),
 			}
 		}
 	}
@@ -152,9 +201,116 @@ func (a *Universal) GetMetadata(ctx context.Context, in *runtimev1pb.GetMetadata
 		Workflows:               workflowsMetadata,
 		WorkflowAccessPolicies:  registeredWFACLs,
 		Resiliencies:            registeredResiliencies,
+		StateMigrations:         a.stateMigrations.list(),
+		ErrorCodes:              metadataErrorCodes(),
+		ComponentReloads:        componentReloads,
+		ConfigurationReloads:    configurationReloads,
+		Configuration:           a.metadataConfiguration(resiliencyNames),
+		CircuitBreakers:         a.metadataCircuitBreakers(),
+		Faults:                  a.metadataFaults(),
+		PausedInputBindings:     a.metadataPausedInputBindings(),
 	}, nil
 }
 
+// metadataConfiguration returns the effective configuration of the sidecar.
+func (a *Universal) metadataConfiguration(resiliencies []string) *runtimev1pb.MetadataConfiguration {
+	res := &runtimev1pb.MetadataConfiguration{
+		Names:        a.configurations,
+		Resiliencies: resiliencies,
+	}
+	if a.globalConfig == nil {
+		return res
+	}
+
+	tracing := a.globalConfig.GetTracingSpec()
+	res.Tracing = &runtimev1pb.MetadataTracing{
+		SamplingRate: tracing.SamplingRate,
+		Stdout:       tracing.Stdout,
+	}
+	if tracing.Zipkin != nil {
+		res.Tracing.ZipkinEndpointAddress = tracing.Zipkin.EndpointAddress
+	}
+	if tracing.Otel != nil {
+		res.Tracing.OtelEndpointAddress = tracing.Otel.EndpointAddress
+		res.Tracing.OtelProtocol = tracing.Otel.Protocol
+		res.Tracing.OtelIsSecure = tracing.Otel.GetIsSecure()
+	}
+
+	metrics := a.globalConfig.GetMetricsSpec()
+	res.Metrics = &runtimev1pb.MetadataMetrics{
+		Enabled:          metrics.GetEnabled(),
+		RecordErrorCodes: metrics.GetRecordErrorCodes(),
+		// Not using GetHTTPIncreasedCardinality, which warns about the default.
+		HttpIncreasedCardinality: metrics.HTTP == nil || metrics.HTTP.IncreasedCardinality == nil || *metrics.HTTP.IncreasedCardinality,
+		HttpExcludeVerbs:         metrics.GetHTTPExcludeVerbs(),
+	}
+	if metrics.LatencyDistributionBuckets != nil {
+		for _, b := range *metrics.LatencyDistributionBuckets {
+			//nolint:gosec
+			res.Metrics.LatencyDistributionBuckets = append(res.Metrics.LatencyDistributionBuckets, int32(b))
+		}
+	}
+
+	for _, f := range a.globalConfig.Spec.Features {
+		res.Features = append(res.Features, &runtimev1pb.MetadataFeature{
+			Name:    string(f.Name),
+			Enabled: a.globalConfig.IsFeatureEnabled(f.Name),
+		})
+	}
+
+	api := a.globalConfig.GetAPISpec()
+	res.Api = &runtimev1pb.MetadataAPIAccess{
+		Allowed: metadataAPIAccessRules(api.Allowed),
+		Denied:  metadataAPIAccessRules(api.Denied),
+	}
+
+	return res
+}
+
+func metadataAPIAccessRules(rules config.APIAccessRules) []*runtimev1pb.MetadataAPIAccessRule {
+	res := make([]*runtimev1pb.MetadataAPIAccessRule, len(rules))
+	for i, r := range rules {
+		res[i] = &runtimev1pb.MetadataAPIAccessRule{
+			Name:     r.Name,
+			Version:  r.Version,
+			Protocol: string(r.Protocol),
+			Verbs:    r.Verbs,
+		}
+	}
+	return res
+}
+
+// metadataErrorCodes returns the registered error codes of the Dapr APIs,
+// grouped by building block.
+func metadataErrorCodes() []*runtimev1pb.MetadataErrorCodes {
+	byAPI := make(map[errorcodes.Category]*runtimev1pb.MetadataErrorCodes)
+	seen := make(map[errorcodes.ErrorCode]struct{})
+	res := make([]*runtimev1pb.MetadataErrorCodes, 0)
+	for _, code := range errorcodes.Registered() {
+		// Some error codes are declared more than once.
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+
+		api, ok := byAPI[code.Category]
+		if !ok {
+			api = &runtimev1pb.MetadataErrorCodes{Api: string(code.Category)}
+			byAPI[code.Category] = api
+			res = append(res, api)
+		}
+		api.Codes = append(api.Codes, &runtimev1pb.MetadataErrorCode{
+			Code:   code.Code,
+			Reason: code.Reason(),
+		})
+	}
+
+	slices.SortFunc(res, func(a, b *runtimev1pb.MetadataErrorCodes) int {
+		return strings.Compare(a.GetApi(), b.GetApi())
+	})
+	return res
+}
+
 // SetMetadata Sets value in extended metadata of the sidecar.
 func (a *Universal) SetMetadata(ctx context.Context, in *runtimev1pb.SetMetadataRequest) (*emptypb.Empty, error) {
 	// Nop if the key is empty
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// GetActiveActorsAlpha1 lists the counts per type of the actors active on this
+// host and, if a page size is given, a page of the active actors ordered by
+// type then ID. It's used to debug the skew of actors across hosts.
+func (a *Universal) GetActiveActorsAlpha1(ctx context.Context, in *runtimev1pb.GetActiveActorsRequest) (*runtimev1pb.GetActiveActorsResponse, error) {
+	var afterType, afterID string
+	if token := in.GetContinuationToken(); len(token) > 0 {
+		b, err := base64.RawURLEncoding.DecodeString(token)
+		var ok bool
+		if err == nil {
+			afterType, afterID, ok = strings.Cut(string(b), api.DaprSeparator)
+		}
+		if !ok {
+			err = messages.ErrBadRequest.WithFormat(errors.New("invalid continuation token"))
+			a.logger.Debug(err)
+			return nil, err
+		}
+	}
+
+	tbl, err := a.actors.Table(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := tbl.Len()
+	types := make([]string, 0, len(counts))
+	for actorType := range counts {
+		if in.GetActorType() == "" || in.GetActorType() == actorType {
+			types = append(types, actorType)
+		}
+	}
+	slices.Sort(types)
+
+	res := &runtimev1pb.GetActiveActorsResponse{
+		Counts: make([]*runtimev1pb.ActiveActorsCount, len(types)),
+	}
+	for i, actorType := range types {
+		res.Counts[i] = &runtimev1pb.ActiveActorsCount{
+			Type:  actorType,
+			Count: int32(counts[actorType]), //nolint:gosec
+		}
+	}
+
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		return res, nil
+	}
+
+	for _, actorType := range types {
+		if afterType != "" && actorType < afterType {
+			continue
+		}
+		ids := tbl.ActorIDs(actorType)
+		slices.Sort(ids)
+		for _, id := range ids {
+			if actorType == afterType && id <= afterID {
+				continue
+			}
+			if len(res.GetActors()) == pageSize {
+				last := res.GetActors()[pageSize-1]
+				res.ContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(last.GetType() + api.DaprSeparator + last.GetId()))
+				return res, nil
+			}
+			res.Actors = append(res.Actors, &runtimev1pb.ActiveActor{Type: actorType, Id: id})
+		}
+	}
+
+	return res, nil
+}
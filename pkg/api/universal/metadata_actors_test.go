@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/actors/table"
+	tablefake "github.com/dapr/dapr/pkg/actors/table/fake"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/kit/logger"
+)
+
+func TestGetActiveActorsAlpha1(t *testing.T) {
+	ids := map[string][]string{
+		"cart":  {"c3", "c1", "c2"},
+		"order": {"o2", "o1"},
+	}
+	tbl := tablefake.New().
+		WithLen(func() map[string]int {
+			return map[string]int{"cart": 3, "order": 2}
+		}).
+		WithActorIDs(func(actorType string) []string {
+			return append([]string(nil), ids[actorType]...)
+		})
+	fakeAPI := &Universal{
+		logger: logger.NewLogger("test"),
+		actors: actorsfake.New().WithTable(func(context.Context) (table.Interface, error) {
+			return tbl, nil
+		}),
+	}
+
+	actorsOf := func(res *runtimev1pb.GetActiveActorsResponse) []string {
+		var got []string
+		for _, a := range res.GetActors() {
+			got = append(got, a.GetType()+"/"+a.GetId())
+		}
+		return got
+	}
+
+	t.Run("counts only", func(t *testing.T) {
+		res, err := fakeAPI.GetActiveActorsAlpha1(t.Context(), &runtimev1pb.GetActiveActorsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []*runtimev1pb.ActiveActorsCount{
+			{Type: "cart", Count: 3},
+			{Type: "order", Count: 2},
+		}, res.GetCounts())
+		assert.Empty(t, res.GetActors())
+		assert.Empty(t, res.GetContinuationToken())
+	})
+
+	t.Run("filtered by type", func(t *testing.T) {
+		res, err := fakeAPI.GetActiveActorsAlpha1(t.Context(), &runtimev1pb.GetActiveActorsRequest{
+			ActorType: "order",
+			PageSize:  new(uint32(10)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*runtimev1pb.ActiveActorsCount{{Type: "order", Count: 2}}, res.GetCounts())
+		assert.Equal(t, []string{"order/o1", "order/o2"}, actorsOf(res))
+		assert.Empty(t, res.GetContinuationToken())
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var got []string
+		req := &runtimev1pb.GetActiveActorsRequest{PageSize: new(uint32(2))}
+		for pages := 1; ; pages++ {
+			res, err := fakeAPI.GetActiveActorsAlpha1(t.Context(), req)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(res.GetActors()), 2)
+			got = append(got, actorsOf(res)...)
+			if res.GetContinuationToken() == "" {
+				assert.Equal(t, 3, pages)
+				break
+			}
+			req.ContinuationToken = new(res.GetContinuationToken())
+		}
+		assert.Equal(t, []string{"cart/c1", "cart/c2", "cart/c3", "order/o1", "order/o2"}, got)
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		_, err := fakeAPI.GetActiveActorsAlpha1(t.Context(), &runtimev1pb.GetActiveActorsRequest{
+			PageSize:          new(uint32(2)),
+			ContinuationToken: new("%%%"),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// Types of the policies listed in the metadata of the resiliency resources.
+const (
+	resiliencyPolicyTimeout        = "timeout"
+	resiliencyPolicyRetry          = "retry"
+	resiliencyPolicyCircuitBreaker = "circuitBreaker"
+	resiliencyPolicyHedging        = "hedging"
+)
+
+// GetResiliencyAlpha1 lists the resiliency resources loaded by the sidecar,
+// with their policies and the targets they apply to, and the current state of
+// the circuit breakers. It's used to find out why calls to a target fail fast.
+func (a *Universal) GetResiliencyAlpha1(ctx context.Context, in *runtimev1pb.GetResiliencyRequest) (*runtimev1pb.GetResiliencyResponse, error) {
+	return &runtimev1pb.GetResiliencyResponse{
+		Resiliencies:    a.metadataResiliencies(),
+		CircuitBreakers: a.metadataCircuitBreakers(),
+	}, nil
+}
+
+// metadataResiliencies returns the metadata of the loaded resiliency
+// resources.
+func (a *Universal) metadataResiliencies() []*runtimev1pb.MetadataResiliency {
+	resources := a.compStore.ListResiliencyResources()
+	res := make([]*runtimev1pb.MetadataResiliency, len(resources))
+	for i, r := range resources {
+		res[i] = metadataResiliency(r)
+	}
+	return res
+}
+
+func metadataResiliency(r resiliencyapi.Resiliency) *runtimev1pb.MetadataResiliency {
+	res := &runtimev1pb.MetadataResiliency{
+		Name:   r.Name,
+		DryRun: r.Spec.DryRun,
+	}
+
+	policies := r.Spec.Policies
+	addPolicies := func(policyType string, names []string) {
+		for _, name := range names {
+			res.Policies = append(res.Policies, &runtimev1pb.MetadataResiliencyPolicy{
+				Type: policyType,
+				Name: name,
+			})
+		}
+	}
+	addPolicies(resiliencyPolicyTimeout, slices.Sorted(maps.Keys(policies.Timeouts)))
+	addPolicies(resiliencyPolicyRetry, slices.Sorted(maps.Keys(policies.Retries)))
+	addPolicies(resiliencyPolicyCircuitBreaker, slices.Sorted(maps.Keys(policies.CircuitBreakers)))
+	addPolicies(resiliencyPolicyHedging, slices.Sorted(maps.Keys(policies.Hedging)))
+
+	targets := r.Spec.Targets
+	for _, app := range slices.Sorted(maps.Keys(targets.Apps)) {
+		names := targets.Apps[app]
+		res.Targets = append(res.Targets, &runtimev1pb.MetadataResiliencyTarget{
+			Type:           resiliency.TargetTypeApp,
+			Name:           app,
+			Timeout:        names.Timeout,
+			Retry:          names.Retry,
+			CircuitBreaker: names.CircuitBreaker,
+			Hedging:        names.Hedging,
+		})
+	}
+	for _, actorType := range slices.Sorted(maps.Keys(targets.Actors)) {
+		names := targets.Actors[actorType]
+		res.Targets = append(res.Targets, &runtimev1pb.MetadataResiliencyTarget{
+			Type:           resiliency.TargetTypeActor,
+			Name:           actorType,
+			Timeout:        names.Timeout,
+			Retry:          names.Retry,
+			CircuitBreaker: names.CircuitBreaker,
+		})
+		for _, method := range slices.Sorted(maps.Keys(names.Methods)) {
+			res.Targets = append(res.Targets, &runtimev1pb.MetadataResiliencyTarget{
+				Type:      resiliency.TargetTypeActor,
+				Name:      actorType,
+				Operation: method,
+				Timeout:   names.Methods[method].Timeout,
+				Retry:     names.Methods[method].Retry,
+			})
+		}
+	}
+	for _, component := range slices.Sorted(maps.Keys(targets.Components)) {
+		names := targets.Components[component]
+		addComponent := func(operation string, inbound, outbound resiliencyapi.PolicyNames) {
+			for _, direction := range []struct {
+				name     string
+				policies resiliencyapi.PolicyNames
+			}{{"inbound", inbound}, {"outbound", outbound}} {
+				if direction.policies == (resiliencyapi.PolicyNames{}) {
+					continue
+				}
+				res.Targets = append(res.Targets, &runtimev1pb.MetadataResiliencyTarget{
+					Type:           resiliency.TargetTypeComponent,
+					Name:           component,
+					Direction:      direction.name,
+					Operation:      operation,
+					Timeout:        direction.policies.Timeout,
+					Retry:          direction.policies.Retry,
+					CircuitBreaker: direction.policies.CircuitBreaker,
+				})
+			}
+		}
+		addComponent("", names.Inbound, names.Outbound)
+		for _, operation := range slices.Sorted(maps.Keys(names.Operations)) {
+			addComponent(operation, names.Operations[operation].Inbound, names.Operations[operation].Outbound)
+		}
+	}
+
+	return res
+}
+
+// metadataCircuitBreakers returns the current state of the circuit breakers
+// created for the targets of the resiliency policies.
+func (a *Universal) metadataCircuitBreakers() []*runtimev1pb.MetadataCircuitBreaker {
+	if a.resiliency == nil {
+		return nil
+	}
+
+	cbs := a.resiliency.CircuitBreakers()
+	res := make([]*runtimev1pb.MetadataCircuitBreaker, len(cbs))
+	for i, cb := range cbs {
+		res[i] = &runtimev1pb.MetadataCircuitBreaker{
+			Name:       cb.Name,
+			Policy:     cb.Policy,
+			TargetType: cb.TargetType,
+			TargetName: cb.TargetName,
+			State:      string(cb.State),
+		}
+	}
+	return res
+}
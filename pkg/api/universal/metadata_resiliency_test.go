@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+func TestGetResiliencyAlpha1(t *testing.T) {
+	resource := resiliencyapi.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{Name: "myresiliency"},
+		Spec: resiliencyapi.ResiliencySpec{
+			DryRun: true,
+			Policies: resiliencyapi.Policies{
+				Timeouts: map[string]string{"fast": "1s"},
+				Retries: map[string]resiliencyapi.Retry{
+					"retryForever": {Policy: "constant", Duration: "1s"},
+				},
+				CircuitBreakers: map[string]resiliencyapi.CircuitBreaker{
+					"failFast": {MaxRequests: 1, Timeout: "30s", Trip: "consecutiveFailures > 0"},
+				},
+			},
+			Targets: resiliencyapi.Targets{
+				Apps: map[string]resiliencyapi.EndpointPolicyNames{
+					"myapp": {Timeout: "fast", Retry: "retryForever"},
+				},
+				Actors: map[string]resiliencyapi.ActorPolicyNames{
+					"myactor": {
+						Timeout: "fast",
+						Methods: map[string]resiliencyapi.ActorMethodPolicyNames{
+							"slow": {Retry: "retryForever"},
+						},
+					},
+				},
+				Components: map[string]resiliencyapi.ComponentPolicyNames{
+					"statestore": {
+						Outbound: resiliencyapi.PolicyNames{CircuitBreaker: "failFast"},
+						Operations: map[string]resiliencyapi.ComponentOperationPolicyNames{
+							"get": {Outbound: resiliencyapi.PolicyNames{Timeout: "fast"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	compStore := compstore.New()
+	compStore.AddResiliencyResource(resource)
+	resource.Spec.DryRun = false
+	res := resiliency.New(logger.NewLogger("test"))
+	require.NoError(t, res.DecodeConfiguration(&resource))
+
+	// Trip the circuit breaker of the state store.
+	resiliency.NewRunner[any](t.Context(), res.ComponentOutboundPolicy("statestore", resiliency.Statestore))(
+		func(context.Context) (any, error) { return nil, errors.New("fake error") },
+	)
+
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		compStore:  compStore,
+		resiliency: res,
+	}
+
+	response, err := fakeAPI.GetResiliencyAlpha1(t.Context(), &runtimev1pb.GetResiliencyRequest{})
+	require.NoError(t, err)
+
+	bytes, err := protojson.Marshal(response)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"resiliencies": [{
+			"name": "myresiliency",
+			"dryRun": true,
+			"policies": [
+				{"type": "timeout", "name": "fast"},
+				{"type": "retry", "name": "retryForever"},
+				{"type": "circuitBreaker", "name": "failFast"}
+			],
+			"targets": [
+				{"type": "app", "name": "myapp", "timeout": "fast", "retry": "retryForever"},
+				{"type": "actor", "name": "myactor", "timeout": "fast"},
+				{"type": "actor", "name": "myactor", "operation": "slow", "retry": "retryForever"},
+				{"type": "component", "name": "statestore", "direction": "outbound", "circuitBreaker": "failFast"},
+				{"type": "component", "name": "statestore", "direction": "outbound", "operation": "get", "timeout": "fast"}
+			]
+		}],
+		"circuitBreakers": [{
+			"name": "failFast-statestore",
+			"policy": "failFast",
+			"targetType": "component",
+			"targetName": "statestore",
+			"state": "open"
+		}]
+	}`, string(bytes))
+
+	t.Run("without resiliency provider", func(t *testing.T) {
+		fakeAPI := &Universal{
+			logger:    logger.NewLogger("test"),
+			compStore: compstore.New(),
+		}
+
+		response, err := fakeAPI.GetResiliencyAlpha1(t.Context(), &runtimev1pb.GetResiliencyRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, response.GetResiliencies())
+		assert.Empty(t, response.GetCircuitBreakers())
+	})
+}
@@ -15,14 +15,18 @@ package universal
 
 import (
 	"encoding/json"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
 	componentsV1alpha "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/expr"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -47,7 +51,8 @@ func TestGetMetadata(t *testing.T) {
 	})
 	compStore := compstore.New()
 	require.NoError(t, compStore.AddPendingComponentForCommit(fakeComponent))
-	require.NoError(t, compStore.CommitPendingComponent())
+	require.NoError(t, compStore.CommitPendingComponent(fakeComponent.Name))
+	compStore.SetComponentInitDuration(fakeComponent.Name, 1500*time.Millisecond)
 	compStore.SetProgramaticSubscriptions(runtimePubsub.Subscription{
 		PubsubName:      "test",
 		Topic:           "topic",
@@ -59,6 +64,10 @@ func TestGetMetadata(t *testing.T) {
 				Path:  "path",
 			},
 		},
+		BulkSubscribe: &runtimePubsub.BulkSubscribe{
+			Enabled:          true,
+			MaxMessagesCount: 100,
+		},
 	})
 
 	testcases := []struct {
@@ -113,11 +122,16 @@ func TestGetMetadata(t *testing.T) {
 						ConnectedWorkers: 1,
 					}
 				}),
+				subscriptions: &fakeSubscriptions{paused: map[string]bool{"test||topic": true}},
 			}
 
 			response, err := fakeAPI.GetMetadata(t.Context(), &runtimev1pb.GetMetadataRequest{})
 			require.NoError(t, err, "Expected no error")
 
+			// The registered error codes are tested in TestMetadataErrorCodes.
+			assert.NotEmpty(t, response.GetErrorCodes())
+			response.ErrorCodes = nil
+
 			bytes, err := json.Marshal(response)
 			require.NoError(t, err)
 
@@ -128,17 +142,98 @@ func TestGetMetadata(t *testing.T) {
 
 			expectedResponse := `{"id":"fakeAPI",` +
 				`"active_actors_count":[{"type":"abcd","count":10},{"type":"xyz","count":5}],` +
-				`"registered_components":[{"name":"testComponent","capabilities":["mock.feat.testComponent"]}],` +
+				`"registered_components":[{"name":"testComponent","capabilities":["mock.feat.testComponent"],"init_duration_ms":1500}],` +
 				`"extended_metadata":{"daprRuntimeVersion":"edge","testKey":"testValue"},` +
-				`"subscriptions":[{"pubsub_name":"test","topic":"topic","rules":{"rules":[{"path":"path"}]},"dead_letter_topic":"dead","type":2}],` +
+				`"subscriptions":[{"pubsub_name":"test","topic":"topic","rules":{"rules":[{"path":"path"}]},"dead_letter_topic":"dead","type":2,"bulk_subscribe":{"enabled":true,"max_messages_count":100},"paused":true}],` +
 				`"app_connection_properties":{"port":1234,"protocol":"http","channel_address":"1.2.3.4","max_concurrency":10` +
 				healthCheckJSON +
-				`"runtime_version":"edge","actor_runtime":{"runtime_status":2,"active_actors":[{"type":"abcd","count":10},{"type":"xyz","count":5}],"host_ready":true},"workflows":{"connected_workers":1}}`
+				`"runtime_version":"edge","actor_runtime":{"runtime_status":2,"active_actors":[{"type":"abcd","count":10},{"type":"xyz","count":5}],"host_ready":true},"workflows":{"connected_workers":1},` +
+				`"configuration":{"tracing":{},"metrics":{"enabled":true,"http_increased_cardinality":true},"api":{}}}`
 			assert.Equal(t, expectedResponse, string(bytes))
 		})
 	}
 }
 
+func TestGetMetadataConfiguration(t *testing.T) {
+	globalConfig := config.LoadDefaultConfiguration()
+	globalConfig.Spec.TracingSpec.SamplingRate = "0.5"
+	globalConfig.Spec.TracingSpec.Otel.EndpointAddress = "otel-collector:4317"
+	globalConfig.Spec.TracingSpec.Otel.Protocol = "grpc"
+	globalConfig.Spec.TracingSpec.Otel.Headers = []string{"api-key=secret"}
+	globalConfig.Spec.MetricSpec.LatencyDistributionBuckets = &[]int{5, 50, 500}
+	globalConfig.Spec.MetricSpec.HTTP = &config.MetricHTTP{IncreasedCardinality: new(false)}
+	globalConfig.Spec.Features = []config.FeatureSpec{
+		{Name: "Test.Enabled", Enabled: true},
+		{Name: "Test.Disabled", Enabled: false},
+	}
+	globalConfig.Spec.APISpec = &config.APISpec{
+		Allowed: config.APIAccessRules{
+			{Name: "state", Version: "v1.0", Protocol: config.APIAccessRuleProtocolHTTP, Verbs: []string{"GET"}},
+		},
+	}
+	globalConfig.LoadFeatures()
+
+	compStore := compstore.New()
+	compStore.AddResiliencyResource(resiliencyapi.Resiliency{ObjectMeta: metav1.ObjectMeta{Name: "retries"}})
+
+	fakeAPI := &Universal{
+		appID:                       "fakeAPI",
+		actors:                      actorsfake.New(),
+		compStore:                   compStore,
+		getComponentsCapabilitiesFn: func() map[string][]string { return nil },
+		globalConfig:                globalConfig,
+		configurations:              []string{"base.yaml", "overrides.yaml"},
+		workflowEngine:              wfenginefake.New(),
+	}
+
+	response, err := fakeAPI.GetMetadata(t.Context(), &runtimev1pb.GetMetadataRequest{})
+	require.NoError(t, err)
+
+	bytes, err := json.Marshal(response.GetConfiguration())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"names": ["base.yaml", "overrides.yaml"],
+		"tracing": {
+			"sampling_rate": "0.5",
+			"otel_endpoint_address": "otel-collector:4317",
+			"otel_protocol": "grpc",
+			"otel_is_secure": true
+		},
+		"metrics": {
+			"enabled": true,
+			"latency_distribution_buckets": [5, 50, 500]
+		},
+		"features": [
+			{"name": "Test.Enabled", "enabled": true},
+			{"name": "Test.Disabled"}
+		],
+		"api": {
+			"allowed": [{"name": "state", "version": "v1.0", "protocol": "http", "verbs": ["GET"]}]
+		},
+		"resiliencies": ["retries"]
+	}`, string(bytes))
+}
+
+func TestMetadataErrorCodes(t *testing.T) {
+	apis := metadataErrorCodes()
+	require.NotEmpty(t, apis)
+	assert.True(t, slices.IsSortedFunc(apis, func(a, b *runtimev1pb.MetadataErrorCodes) int {
+		return strings.Compare(a.GetApi(), b.GetApi())
+	}))
+
+	codes := make(map[string][]string, len(apis))
+	for _, api := range apis {
+		assert.NotContains(t, codes, api.GetApi())
+		for _, code := range api.GetCodes() {
+			codes[api.GetApi()] = append(codes[api.GetApi()], code.GetCode()+"/"+code.GetReason())
+		}
+	}
+
+	assert.Contains(t, codes["state"], "ERR_STATE_GET/ERR_STATE_GET")
+	assert.Contains(t, codes["job"], "DAPR_SCHEDULER_GET_JOB/DAPR_SCHEDULER_GET_JOB")
+	assert.Contains(t, codes["common"], "ERR_BAD_REQUEST/ERR_BAD_REQUEST")
+}
+
 func TestSetMetadata(t *testing.T) {
 	fakeComponent := componentsV1alpha.Component{}
 	fakeComponent.Name = "testComponent"
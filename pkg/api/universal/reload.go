@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// ReloadAlpha1 reloads the configuration and the resiliency policies of the
+// sidecar without restarting it, returning the changes which were applied and
+// the ones which require a restart.
+func (a *Universal) ReloadAlpha1(ctx context.Context, _ *runtimev1pb.ReloadRequest) (*runtimev1pb.ReloadResponse, error) {
+	if a.reloadFn == nil {
+		err := messages.ErrReloadNotSupported
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	a.logger.Info("Configuration reload requested via API")
+	reload, err := a.reloadFn(ctx)
+	if err != nil {
+		err = messages.ErrReload.WithFormat(err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &runtimev1pb.ReloadResponse{
+		Applied:         reload.Applied,
+		RestartRequired: reload.RestartRequired,
+	}, nil
+}
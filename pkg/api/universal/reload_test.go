@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+func TestReloadAlpha1(t *testing.T) {
+	t.Run("not supported", func(t *testing.T) {
+		fakeAPI := New(Options{Logger: logger.NewLogger("test")})
+		_, err := fakeAPI.ReloadAlpha1(t.Context(), &runtimev1pb.ReloadRequest{})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("changes are returned", func(t *testing.T) {
+		fakeAPI := New(Options{
+			Logger: logger.NewLogger("test"),
+			ReloadFn: func(context.Context) (compstore.ConfigurationReload, error) {
+				return compstore.ConfigurationReload{
+					Applied:         []string{"resiliency/myresiliency updated"},
+					RestartRequired: []string{"configuration/tracing"},
+				}, nil
+			},
+		})
+		res, err := fakeAPI.ReloadAlpha1(t.Context(), &runtimev1pb.ReloadRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"resiliency/myresiliency updated"}, res.GetApplied())
+		assert.Equal(t, []string{"configuration/tracing"}, res.GetRestartRequired())
+	})
+
+	t.Run("reload fails", func(t *testing.T) {
+		fakeAPI := New(Options{
+			Logger: logger.NewLogger("test"),
+			ReloadFn: func(context.Context) (compstore.ConfigurationReload, error) {
+				return compstore.ConfigurationReload{}, errors.New("invalid log level")
+			},
+		})
+		_, err := fakeAPI.ReloadAlpha1(t.Context(), &runtimev1pb.ReloadRequest{})
+		require.ErrorContains(t, err, "invalid log level")
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
@@ -15,9 +15,14 @@ package universal
 
 import (
 	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/dapr/pkg/audit"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -35,6 +40,7 @@ func (a *Universal) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequ
 	if !a.isSecretAllowed(in.GetStoreName(), in.GetKey()) {
 		err = messages.ErrSecretPermissionDenied.WithFormat(in.GetKey(), in.GetStoreName())
 		a.logger.Debug(err)
+		auditSecret(ctx, in.GetStoreName(), audit.OutcomeDenied, "key", in.GetKey())
 		return response, err
 	}
 
@@ -43,18 +49,8 @@ func (a *Universal) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequ
 		Metadata: in.GetMetadata(),
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*secretstores.GetSecretResponse](ctx,
-		a.resiliency.ComponentOutboundPolicy(in.GetStoreName(), resiliency.Secretstore),
-	)
-	getResponse, err := policyRunner(func(ctx context.Context) (*secretstores.GetSecretResponse, error) {
-		rResp, rErr := component.GetSecret(ctx, req)
-		return &rResp, rErr
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.SecretInvoked(ctx, in.GetStoreName(), diag.Get, err == nil, elapsed)
-
+	getResponse, err := a.getSecretThroughCache(ctx, in.GetStoreName(), component, req)
+	auditSecret(ctx, in.GetStoreName(), audit.OutcomeOf(err), "key", in.GetKey())
 	if err != nil {
 		err = messages.ErrSecretGet.WithFormat(req.Name, in.GetStoreName(), err.Error())
 		a.logger.Debug(err)
@@ -77,6 +73,12 @@ func (a *Universal) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSe
 		return response, err
 	}
 
+	nameFilter, err := secretNameFilter(in.GetNamePrefixes(), in.GetNameRegex())
+	if err != nil {
+		a.logger.Debug(err)
+		return response, err
+	}
+
 	req := secretstores.BulkGetSecretRequest{
 		Metadata: in.GetMetadata(),
 	}
@@ -94,22 +96,34 @@ func (a *Universal) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSe
 	diag.DefaultComponentMonitoring.SecretInvoked(ctx, in.GetStoreName(), diag.BulkGet, err == nil, elapsed)
 
 	if err != nil {
+		auditSecret(ctx, in.GetStoreName(), audit.OutcomeFailure)
 		err = messages.ErrBulkSecretGet.WithFormat(in.GetStoreName(), err.Error())
 		a.logger.Debug(err)
 		return response, err
 	}
 
 	if getResponse == nil {
+		auditSecret(ctx, in.GetStoreName(), audit.OutcomeSuccess)
 		return response, nil
 	}
 	filteredSecrets := map[string]map[string]string{}
+	var deniedKeys []string
 	for key, v := range getResponse.Data {
+		if !nameFilter(key) {
+			continue
+		}
 		if a.isSecretAllowed(in.GetStoreName(), key) {
 			filteredSecrets[key] = v
 		} else {
+			deniedKeys = append(deniedKeys, key)
 			a.logger.Debug(messages.ErrSecretPermissionDenied.WithFormat(key, in.GetStoreName()).String())
 		}
 	}
+	slices.Sort(deniedKeys)
+	auditSecret(ctx, in.GetStoreName(), audit.OutcomeSuccess,
+		"keys", strings.Join(slices.Sorted(maps.Keys(filteredSecrets)), ","),
+		"deniedKeys", strings.Join(deniedKeys, ","),
+	)
 
 	if getResponse.Data != nil {
 		response = &runtimev1pb.GetBulkSecretResponse{
@@ -140,6 +154,29 @@ func (a *Universal) secretsValidateRequest(componentName string) (secretstores.S
 	return component, nil
 }
 
+// secretNameFilter returns a function reporting whether the name of a secret
+// starts with one of the prefixes, if any, and matches the regular
+// expression, if any.
+func secretNameFilter(prefixes []string, expr string) (func(string) bool, error) {
+	var re *regexp.Regexp
+	if expr != "" {
+		var err error
+		re, err = regexp.Compile(expr)
+		if err != nil {
+			return nil, messages.ErrSecretNameFilterInvalid.WithFormat(expr, err)
+		}
+	}
+
+	return func(name string) bool {
+		if len(prefixes) > 0 && !slices.ContainsFunc(prefixes, func(prefix string) bool {
+			return strings.HasPrefix(name, prefix)
+		}) {
+			return false
+		}
+		return re == nil || re.MatchString(name)
+	}, nil
+}
+
 func (a *Universal) isSecretAllowed(storeName, key string) bool {
 	if config, ok := a.compStore.GetSecretsConfiguration(storeName); ok {
 		return config.IsSecretAllowed(key)
@@ -147,3 +184,20 @@ func (a *Universal) isSecretAllowed(storeName, key string) bool {
 	// By default, if a configuration is not defined for a secret store, return true.
 	return true
 }
+
+// auditSecret writes the access to the secrets of the store to the audit log,
+// with the attributes given as key-value pairs.
+func auditSecret(ctx context.Context, storeName string, outcome audit.Outcome, kv ...string) {
+	attrs := make(map[string]string, 1+len(kv)/2)
+	attrs["store"] = storeName
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] != "" {
+			attrs[kv[i]] = kv[i+1]
+		}
+	}
+	audit.Emit(ctx, audit.Event{
+		Action:     audit.ActionSecretAccess,
+		Outcome:    outcome,
+		Attributes: attrs,
+	})
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"time"
+
+	"github.com/dapr/components-contrib/secretstores"
+	secretcache "github.com/dapr/dapr/pkg/components/secretstores/cache"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// PurgeSecretsCacheAlpha1 purges secrets from the cache of a secret store, so that
+// they are read again from the secret store, such as after they are rotated.
+func (a *Universal) PurgeSecretsCacheAlpha1(ctx context.Context, in *runtimev1pb.PurgeSecretsCacheRequest) (*runtimev1pb.PurgeSecretsCacheResponse, error) {
+	if _, err := a.secretsValidateRequest(in.GetStoreName()); err != nil {
+		return nil, err
+	}
+
+	cache := a.compStore.GetSecretStoreCache(in.GetStoreName())
+	if cache == nil {
+		err := messages.ErrSecretCacheNotEnabled.WithFormat(in.GetStoreName())
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	purged := cache.Purge(in.GetNames()...)
+	a.logger.Debugf("Purged %d secrets from the cache of secret store %s", purged, in.GetStoreName())
+	return &runtimev1pb.PurgeSecretsCacheResponse{Purged: int32(purged)}, nil
+}
+
+// getSecretThroughCache gets the secret from the secret store, serving it from
+// the cache of the secret store if it has one. Secrets served after their TTL,
+// within the stale-while-revalidate window of the cache, are read again from
+// the secret store in the background.
+func (a *Universal) getSecretThroughCache(ctx context.Context, storeName string, store secretstores.SecretStore, req secretstores.GetSecretRequest) (*secretstores.GetSecretResponse, error) {
+	cache := a.compStore.GetSecretStoreCache(storeName)
+	if cache != nil {
+		res, ok := cache.Get(req)
+		diag.DefaultComponentMonitoring.SecretCacheAccessed(ctx, storeName, ok)
+		if ok {
+			if res.Revalidate {
+				go a.revalidateSecret(context.WithoutCancel(ctx), storeName, store, cache, req)
+			}
+			if res.Err != nil {
+				return nil, res.Err
+			}
+			return &res.Response, nil
+		}
+	}
+
+	// The version is read before the secret store is, so that a purge of the
+	// cache while the secret is read keeps the response out of the cache.
+	version := cache.Version()
+	resp, err := a.getSecretFromStore(ctx, storeName, store, req)
+	cache.Set(req, resp, err, version)
+	return resp, err
+}
+
+// revalidateSecret reads the secret from the secret store again, to refresh it
+// in the cache.
+func (a *Universal) revalidateSecret(ctx context.Context, storeName string, store secretstores.SecretStore, cache *secretcache.Cache, req secretstores.GetSecretRequest) {
+	version := cache.Version()
+	resp, err := a.getSecretFromStore(ctx, storeName, store, req)
+	if err != nil {
+		a.logger.Warnf("Failed to revalidate cached secret %s of secret store %s: %v", req.Name, storeName, err)
+	}
+	cache.Set(req, resp, err, version)
+}
+
+func (a *Universal) getSecretFromStore(ctx context.Context, storeName string, store secretstores.SecretStore, req secretstores.GetSecretRequest) (*secretstores.GetSecretResponse, error) {
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[*secretstores.GetSecretResponse](ctx,
+		a.resiliency.ComponentOutboundPolicy(storeName, resiliency.Secretstore),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*secretstores.GetSecretResponse, error) {
+		rResp, rErr := store.GetSecret(ctx, req)
+		return &rResp, rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.SecretInvoked(ctx, storeName, diag.Get, err == nil, elapsed)
+	return resp, err
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/secretstores"
+	secretcache "github.com/dapr/dapr/pkg/components/secretstores/cache"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// countingSecretStore is a secret store which counts its gets, and returns
+// the count as the value of the secret.
+type countingSecretStore struct {
+	daprt.FakeSecretStore
+	gets *atomic.Int64
+}
+
+func (s countingSecretStore) GetSecret(ctx context.Context, req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	n := s.gets.Add(1)
+	if req.Name == "error-key" {
+		return s.FakeSecretStore.GetSecret(ctx, req)
+	}
+	return secretstores.GetSecretResponse{Data: map[string]string{req.Name: strconv.FormatInt(n, 10)}}, nil
+}
+
+func TestGetSecretThroughCache(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	store := countingSecretStore{gets: new(atomic.Int64)}
+	compStore := compstore.New()
+	compStore.AddSecretStore("cached", store)
+	compStore.AddSecretStore("uncached", store)
+	compStore.AddSecretStoreCache("cached", secretcache.New(secretcache.Options{
+		TTL:                  time.Minute,
+		NegativeTTL:          time.Second,
+		StaleWhileRevalidate: time.Minute,
+		Clock:                clock,
+	}))
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	get := func(t *testing.T, storeName, key string) string {
+		t.Helper()
+		resp, err := fakeAPI.GetSecret(t.Context(), &runtimev1pb.GetSecretRequest{StoreName: storeName, Key: key})
+		require.NoError(t, err)
+		return resp.GetData()[key]
+	}
+
+	t.Run("uncached store", func(t *testing.T) {
+		store.gets.Store(0)
+		assert.Equal(t, "1", get(t, "uncached", "key1"))
+		assert.Equal(t, "2", get(t, "uncached", "key1"))
+	})
+
+	t.Run("secrets are served from the cache", func(t *testing.T) {
+		store.gets.Store(0)
+		assert.Equal(t, "1", get(t, "cached", "key1"))
+		assert.Equal(t, "1", get(t, "cached", "key1"))
+		assert.Equal(t, int64(1), store.gets.Load())
+	})
+
+	t.Run("failures are cached", func(t *testing.T) {
+		store.gets.Store(0)
+		for range 2 {
+			_, err := fakeAPI.GetSecret(t.Context(), &runtimev1pb.GetSecretRequest{StoreName: "cached", Key: "error-key"})
+			require.ErrorContains(t, err, "error occurs with error-key")
+		}
+		assert.Equal(t, int64(1), store.gets.Load())
+	})
+
+	t.Run("stale secrets are revalidated in the background", func(t *testing.T) {
+		store.gets.Store(10)
+		clock.Step(time.Minute)
+		assert.Equal(t, "1", get(t, "cached", "key1"))
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			resp, err := fakeAPI.GetSecret(t.Context(), &runtimev1pb.GetSecretRequest{StoreName: "cached", Key: "key1"})
+			require.NoError(c, err)
+			assert.Equal(c, "11", resp.GetData()["key1"])
+		}, time.Second*5, time.Millisecond*10)
+		assert.Equal(t, int64(11), store.gets.Load())
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		resp, err := fakeAPI.PurgeSecretsCacheAlpha1(t.Context(), &runtimev1pb.PurgeSecretsCacheRequest{StoreName: "cached", Names: []string{"key1"}})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.GetPurged())
+
+		store.gets.Store(20)
+		assert.Equal(t, "21", get(t, "cached", "key1"))
+
+		resp, err = fakeAPI.PurgeSecretsCacheAlpha1(t.Context(), &runtimev1pb.PurgeSecretsCacheRequest{StoreName: "cached"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), resp.GetPurged())
+	})
+
+	t.Run("purge of store without cache", func(t *testing.T) {
+		_, err := fakeAPI.PurgeSecretsCacheAlpha1(t.Context(), &runtimev1pb.PurgeSecretsCacheRequest{StoreName: "uncached"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = fakeAPI.PurgeSecretsCacheAlpha1(t.Context(), &runtimev1pb.PurgeSecretsCacheRequest{StoreName: "nostore"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -14,6 +14,8 @@ limitations under the License.
 package universal
 
 import (
+	"maps"
+	"slices"
 	"testing"
 	"time"
 
@@ -251,6 +253,71 @@ func TestGetBulkSecret(t *testing.T) {
 	}
 }
 
+func TestGetBulkSecretNameFilters(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddSecretStore("store1", daprt.NamedSecretStore{
+		Names: []string{"db-password", "db-user", "api-key", "api-token", "other"},
+	})
+	compStore.AddSecretsConfiguration("store1", config.SecretsScope{
+		DefaultAccess: config.AllowAccess,
+		DeniedSecrets: []string{"db-user"},
+	})
+
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	testCases := map[string]struct {
+		prefixes []string
+		regex    string
+		expected []string
+	}{
+		"no filters": {
+			expected: []string{"db-password", "api-key", "api-token", "other"},
+		},
+		"prefixes": {
+			prefixes: []string{"db-", "api-"},
+			expected: []string{"db-password", "api-key", "api-token"},
+		},
+		"regex": {
+			regex:    "-(key|password)$",
+			expected: []string{"db-password", "api-key"},
+		},
+		"prefixes and regex": {
+			prefixes: []string{"api-"},
+			regex:    "token",
+			expected: []string{"api-token"},
+		},
+		"no match": {
+			prefixes: []string{"none-"},
+			expected: []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := fakeAPI.GetBulkSecret(t.Context(), &runtimev1pb.GetBulkSecretRequest{
+				StoreName:    "store1",
+				NamePrefixes: tc.prefixes,
+				NameRegex:    tc.regex,
+			})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, slices.Collect(maps.Keys(resp.GetData())))
+		})
+	}
+
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := fakeAPI.GetBulkSecret(t.Context(), &runtimev1pb.GetBulkSecretRequest{
+			StoreName: "store1",
+			NameRegex: "(",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestSecretAPIWithResiliency(t *testing.T) {
 	failingStore := daprt.FailingSecretStore{
 		Failure: daprt.NewFailure(
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dapr/components-contrib/secretstores"
+	secretwatch "github.com/dapr/dapr/pkg/components/secretstores/watch"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	kiterrors "github.com/dapr/kit/errors"
+)
+
+// WatchSecrets watches secrets of a secret store, sending the changes to them
+// until the context is canceled or sending fails. The secret store's native
+// change notifications are used if it has them, or else the runtime polls the
+// secret store.
+func (a *Universal) WatchSecrets(ctx context.Context, in *runtimev1pb.WatchSecretsRequest, send func(*runtimev1pb.WatchSecretsResponse) error) error {
+	storeName := in.GetStoreName()
+	store, err := a.secretsValidateRequest(storeName)
+	if err != nil {
+		return err
+	}
+
+	if len(in.GetNames()) == 0 {
+		err = messages.ErrSecretWatchInvalid.WithFormat(storeName, "at least one secret name is required")
+		a.logger.Debug(err)
+		return err
+	}
+	if in.GetPollIntervalSeconds() < 0 {
+		err = messages.ErrSecretWatchInvalid.WithFormat(storeName, "poll interval must not be negative")
+		a.logger.Debug(err)
+		return err
+	}
+	for _, name := range in.GetNames() {
+		if name == "" {
+			err = messages.ErrSecretWatchInvalid.WithFormat(storeName, "secret names cannot be empty")
+			a.logger.Debug(err)
+			return err
+		}
+		if !a.isSecretAllowed(storeName, name) {
+			err = messages.ErrSecretPermissionDenied.WithFormat(name, storeName)
+			a.logger.Debug(err)
+			return err
+		}
+	}
+
+	req := &secretwatch.Request{
+		Names:    in.GetNames(),
+		Metadata: in.GetMetadata(),
+	}
+	interval := time.Duration(in.GetPollIntervalSeconds()) * time.Second
+	err = a.secretWatcher(store, interval).Watch(ctx, req, func(ctx context.Context, changes []secretwatch.Change) error {
+		resp := &runtimev1pb.WatchSecretsResponse{
+			Changes: make([]*runtimev1pb.SecretChange, len(changes)),
+		}
+		for i, change := range changes {
+			resp.Changes[i] = &runtimev1pb.SecretChange{
+				Name:    change.Name,
+				Secrets: change.Data,
+			}
+		}
+		return send(resp)
+	})
+	switch {
+	case err == nil, errors.Is(err, context.Canceled):
+		return nil
+	default:
+		var apiErr messages.APIError
+		if _, ok := kiterrors.FromError(err); !ok && !errors.As(err, &apiErr) {
+			err = messages.ErrSecretWatch.WithFormat(storeName, err)
+		}
+	}
+	a.logger.Debug(err)
+	return err
+}
+
+// secretWatcher returns the watcher of the secret store: the secret store
+// itself if it has native change notifications, or else a poller over the
+// secret store.
+func (a *Universal) secretWatcher(store secretstores.SecretStore, interval time.Duration) secretwatch.Watcher {
+	if watcher, ok := store.(secretwatch.Watcher); ok {
+		return watcher
+	}
+	return secretwatch.NewPoller(secretwatch.PollerOptions{
+		Store:    store,
+		Interval: interval,
+	})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	secretwatch "github.com/dapr/dapr/pkg/components/secretstores/watch"
+	"github.com/dapr/dapr/pkg/config"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// watcherSecretStore is a secret store with native change notifications,
+// which delivers a change to each watched secret.
+type watcherSecretStore struct {
+	daprt.FakeSecretStore
+	err error
+}
+
+func (s watcherSecretStore) Watch(ctx context.Context, req *secretwatch.Request, handler secretwatch.Handler) error {
+	if s.err != nil {
+		return s.err
+	}
+	if err := handler(ctx, nil); err != nil {
+		return err
+	}
+	changes := make([]secretwatch.Change, len(req.Names))
+	for i, name := range req.Names {
+		changes[i] = secretwatch.Change{Name: name, Data: map[string]string{name: "value"}}
+	}
+	if err := handler(ctx, changes); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestWatchSecrets(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddSecretStore("store1", watcherSecretStore{})
+	compStore.AddSecretStore("failing", watcherSecretStore{err: errors.New("unavailable")})
+	compStore.AddSecretsConfiguration("store1", config.SecretsScope{
+		DefaultAccess: config.AllowAccess,
+		DeniedSecrets: []string{"not-allowed"},
+	})
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	t.Run("delivers changes", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		var responses []*runtimev1pb.WatchSecretsResponse
+		err := fakeAPI.WatchSecrets(ctx, &runtimev1pb.WatchSecretsRequest{
+			StoreName: "store1",
+			Names:     []string{"a", "b"},
+		}, func(resp *runtimev1pb.WatchSecretsResponse) error {
+			responses = append(responses, resp)
+			if len(responses) == 2 {
+				cancel()
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, responses, 2)
+		assert.Empty(t, responses[0].GetChanges())
+		require.Len(t, responses[1].GetChanges(), 2)
+		assert.Equal(t, "a", responses[1].GetChanges()[0].GetName())
+		assert.Equal(t, map[string]string{"a": "value"}, responses[1].GetChanges()[0].GetSecrets())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, req := range []*runtimev1pb.WatchSecretsRequest{
+			{StoreName: "store1"},
+			{StoreName: "store1", Names: []string{""}},
+			{StoreName: "store1", Names: []string{"a"}, PollIntervalSeconds: -1},
+			{StoreName: "nostore", Names: []string{"a"}},
+		} {
+			err := fakeAPI.WatchSecrets(t.Context(), req, nil)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+
+	t.Run("denied secret", func(t *testing.T) {
+		err := fakeAPI.WatchSecrets(t.Context(), &runtimev1pb.WatchSecretsRequest{
+			StoreName: "store1",
+			Names:     []string{"a", "not-allowed"},
+		}, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("failed watch", func(t *testing.T) {
+		err := fakeAPI.WatchSecrets(t.Context(), &runtimev1pb.WatchSecretsRequest{
+			StoreName: "failing",
+			Names:     []string{"a"},
+		}, nil)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.ErrorContains(t, err, "unavailable")
+	})
+}
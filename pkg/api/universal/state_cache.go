@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	statecache "github.com/dapr/dapr/pkg/components/state/cache"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// GetStateThroughCache gets the key from the state store, serving it from the
+// read-through cache of the state store if it has one and the request can be
+// cached.
+func (a *Universal) GetStateThroughCache(ctx context.Context, storeName string, store state.Store, req *state.GetRequest) (*state.GetResponse, error) {
+	cache := a.compStore.GetStateStoreCache(storeName)
+	cacheable := cache != nil && statecache.Cacheable(req, stateLoader.KeyPrefixMetadataKey)
+	if cacheable {
+		resp, ok := cache.Get(req.Key)
+		diag.DefaultComponentMonitoring.StateCacheAccessed(ctx, storeName, ok)
+		if ok {
+			return resp, nil
+		}
+	}
+
+	// The version is read before the state store is, so that a write of the
+	// key while it is read keeps the response out of the cache.
+	version := cache.Version()
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[*state.GetResponse](ctx,
+		a.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateGet),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*state.GetResponse, error) {
+		return store.Get(ctx, req)
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.Get, err == nil, elapsed)
+
+	if err == nil && cacheable {
+		cache.Set(req.Key, resp, version)
+	}
+	return resp, err
+}
+
+// InvalidateStateKeys drops the keys, as saved in the state store, from the
+// read-through cache of the state store once they are written, and publishes
+// them on its invalidation topic if it has one. It is a no-op if the state
+// store has no cache. Failures to publish are only logged, as the keys expire
+// from the caches of the other sidecars after their TTL.
+func (a *Universal) InvalidateStateKeys(ctx context.Context, storeName string, keys ...string) {
+	cache := a.compStore.GetStateStoreCache(storeName)
+	if cache == nil || len(keys) == 0 {
+		return
+	}
+	cache.Invalidate(keys...)
+
+	pubsubName, topic := cache.InvalidationTopic()
+	if pubsubName == "" {
+		return
+	}
+	ps, ok := a.compStore.GetPubSub(pubsubName)
+	if !ok {
+		a.logger.Warnf("Failed to publish cache invalidation of state store %s: pubsub %s not found", storeName, pubsubName)
+		return
+	}
+	data, err := json.Marshal(statecache.InvalidationEvent{StoreName: storeName, Keys: keys})
+	if err != nil {
+		a.logger.Warnf("Failed to publish cache invalidation of state store %s: %v", storeName, err)
+		return
+	}
+	err = ps.Component.Publish(ctx, &contribpubsub.PublishRequest{
+		Data:        data,
+		PubsubName:  pubsubName,
+		Topic:       topic,
+		ContentType: new("application/json"),
+	})
+	if err != nil {
+		a.logger.Warnf("Failed to publish cache invalidation of state store %s: %v", storeName, err)
+	}
+}
+
+// TransactionKeys returns the keys the operations of a transaction write, in
+// the order they are first operated on.
+func TransactionKeys(ops []state.TransactionalStateOperation) []string {
+	keys := make([]string, 0, len(ops))
+	seen := make(map[string]struct{}, len(ops))
+	for _, op := range ops {
+		key := op.GetKey()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/pubsub"
+	inmemorypubsub "github.com/dapr/components-contrib/pubsub/in-memory"
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	statecache "github.com/dapr/dapr/pkg/components/state/cache"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
+)
+
+func TestGetStateThroughCache(t *testing.T) {
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+
+	ps := inmemorypubsub.New(logger.NewLogger("test"))
+	require.NoError(t, ps.Init(t.Context(), pubsub.Metadata{}))
+	t.Cleanup(func() { ps.Close() })
+
+	compStore := compstore.New()
+	compStore.AddStateStore("cachestore", store)
+	compStore.AddStateStoreCache("cachestore", statecache.New(statecache.Options{
+		TTL:                time.Minute,
+		InvalidationPubsub: "invalidation",
+		InvalidationTopic:  "cache",
+	}))
+	compStore.AddPubSub("invalidation", &rtpubsub.PubsubItem{Component: ps})
+	fakeAPI := &Universal{
+		appID:      "fakeAPI",
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	events := make(chan statecache.InvalidationEvent, 10)
+	require.NoError(t, ps.Subscribe(t.Context(), pubsub.SubscribeRequest{Topic: "cache"}, func(_ context.Context, msg *pubsub.NewMessage) error {
+		var event statecache.InvalidationEvent
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			return err
+		}
+		events <- event
+		return nil
+	}))
+
+	get := func(t *testing.T, req *state.GetRequest) string {
+		t.Helper()
+		resp, err := fakeAPI.GetStateThroughCache(t.Context(), "cachestore", store, req)
+		require.NoError(t, err)
+		return string(resp.Data)
+	}
+
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "key1", Value: []byte("value1")}))
+	assert.Equal(t, "value1", get(t, &state.GetRequest{Key: "key1"}))
+
+	t.Run("serves cached values", func(t *testing.T) {
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "key1", Value: []byte("value2")}))
+		assert.Equal(t, "value1", get(t, &state.GetRequest{Key: "key1"}))
+	})
+
+	t.Run("bypasses the cache for strong consistency", func(t *testing.T) {
+		assert.Equal(t, "value2", get(t, &state.GetRequest{
+			Key:     "key1",
+			Options: state.GetStateOption{Consistency: state.Strong},
+		}))
+	})
+
+	t.Run("invalidation", func(t *testing.T) {
+		fakeAPI.InvalidateStateKeys(t.Context(), "cachestore", "key1")
+		assert.Equal(t, "value2", get(t, &state.GetRequest{Key: "key1"}))
+
+		select {
+		case event := <-events:
+			assert.Equal(t, statecache.InvalidationEvent{StoreName: "cachestore", Keys: []string{"key1"}}, event)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no invalidation event published")
+		}
+	})
+}
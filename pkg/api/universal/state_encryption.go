@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/dapr/components-contrib/state"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// DecryptStateValue decrypts the value of the key, as saved in the encrypted
+// state store. Values which are encrypted with a key other than the primary
+// key are counted in the metrics, and written back encrypted with the primary
+// key if the state store is configured to re-encrypt on read. As that changes
+// the ETag of the value, the ETag of the response is updated.
+func (a *Universal) DecryptStateValue(ctx context.Context, storeName string, store state.Store, key string, resp *state.GetResponse) ([]byte, error) {
+	val, stale, err := encryption.DecryptValue(storeName, resp.Data)
+	if err != nil || !stale {
+		return val, err
+	}
+
+	diag.DefaultComponentMonitoring.StateEncryptionStaleKeyRead(ctx, storeName)
+	if encryption.ReencryptOnRead(storeName) {
+		a.reencryptState(ctx, storeName, store, key, val, resp)
+	}
+	return val, nil
+}
+
+// reencryptState writes the value back to the state store encrypted with the
+// primary key. The write is conditional on the ETag of the value read, so
+// values written concurrently are not overwritten, and values without an
+// ETag, or which expire, are left to be re-encrypted when next saved as the
+// TTL would be lost. Failures are only logged, as the value can still be
+// decrypted.
+func (a *Universal) reencryptState(ctx context.Context, storeName string, store state.Store, key string, val []byte, resp *state.GetResponse) {
+	if resp.ETag == nil || resp.Metadata[statettl.ExpireTimeMetadataKey] != "" {
+		return
+	}
+
+	enc, err := encryption.TryEncryptValue(storeName, val)
+	if err != nil {
+		a.logger.Warnf("Failed to re-encrypt key %s of state store %s: %v", key, storeName, err)
+		diag.DefaultComponentMonitoring.StateEncryptionReencrypted(ctx, storeName, false)
+		return
+	}
+
+	policyRunner := resiliency.NewRunner[any](ctx,
+		a.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateSet),
+	)
+	_, err = policyRunner(func(ctx context.Context) (any, error) {
+		return nil, store.Set(ctx, &state.SetRequest{
+			Key:   key,
+			Value: enc,
+			ETag:  resp.ETag,
+		})
+	})
+	a.InvalidateStateKeys(ctx, storeName, key)
+	diag.DefaultComponentMonitoring.StateEncryptionReencrypted(ctx, storeName, err == nil)
+	if err != nil {
+		a.logger.Debugf("Failed to re-encrypt key %s of state store %s: %v", key, storeName, err)
+		return
+	}
+
+	// The new ETag is read back, unless the value was written again since.
+	res, err := store.Get(ctx, &state.GetRequest{Key: key})
+	if err != nil || res == nil || !bytes.Equal(res.Data, enc) {
+		resp.ETag = nil
+		return
+	}
+	resp.ETag = res.ETag
+}
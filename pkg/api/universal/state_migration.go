@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/components-contrib/state"
+	contribquery "github.com/dapr/components-contrib/state/query"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/security"
+)
+
+const (
+	// stateMigrationBatchSize is the maximum number of keys read from the
+	// source state store, and written to the target state store, at once.
+	stateMigrationBatchSize = 100
+
+	// stateMigrationsRetained is the number of finished migrations reported
+	// on the metadata API.
+	stateMigrationsRetained = 10
+)
+
+// stateMigrations are the running and recently finished state migrations.
+type stateMigrations struct {
+	lock       sync.RWMutex
+	migrations []*runtimev1pb.MetadataStateMigration
+}
+
+// start adds the migration, unless it conflicts with a running migration as
+// either of them writes to a state store of the other.
+func (s *stateMigrations) start(m *runtimev1pb.MetadataStateMigration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, r := range s.migrations {
+		if r.GetStatus() == runtimev1pb.MetadataStateMigration_RUNNING && (writesTo(m, r) || writesTo(r, m)) {
+			return apierrors.StateStore(m.GetTargetStoreName()).MigrationInProgress(r.GetId())
+		}
+	}
+
+	s.migrations = append(s.migrations, m)
+	return nil
+}
+
+// writesTo returns true if the migration m writes to a state store of the
+// migration r.
+func writesTo(m, r *runtimev1pb.MetadataStateMigration) bool {
+	if m.GetDryRun() {
+		return false
+	}
+	return m.GetTargetStoreName() == r.GetStoreName() || m.GetTargetStoreName() == r.GetTargetStoreName()
+}
+
+// update applies the change to the migration.
+func (s *stateMigrations) update(m *runtimev1pb.MetadataStateMigration, fn func(m *runtimev1pb.MetadataStateMigration)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	fn(m)
+}
+
+// finish records the end of the migration, and removes the oldest finished
+// migrations beyond those retained.
+func (s *stateMigrations) finish(m *runtimev1pb.MetadataStateMigration, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	m.EndTime = time.Now().UTC().Format(time.RFC3339)
+	if err != nil {
+		m.Status = runtimev1pb.MetadataStateMigration_FAILED
+		m.Error = err.Error()
+	} else {
+		m.Status = runtimev1pb.MetadataStateMigration_COMPLETED
+	}
+
+	var finished int
+	for i := len(s.migrations) - 1; i >= 0; i-- {
+		if s.migrations[i].GetStatus() == runtimev1pb.MetadataStateMigration_RUNNING {
+			continue
+		}
+		if finished++; finished > stateMigrationsRetained {
+			s.migrations = slices.Delete(s.migrations, i, i+1)
+		}
+	}
+}
+
+// get returns a copy of the migration.
+func (s *stateMigrations) get(m *runtimev1pb.MetadataStateMigration) *runtimev1pb.MetadataStateMigration {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return proto.CloneOf(m)
+}
+
+// list returns copies of the migrations, in the order they started.
+func (s *stateMigrations) list() []*runtimev1pb.MetadataStateMigration {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	res := make([]*runtimev1pb.MetadataStateMigration, len(s.migrations))
+	for i, m := range s.migrations {
+		res[i] = proto.CloneOf(m)
+	}
+	return res
+}
+
+// MigrateStateAlpha1 copies the state of this app from a state store to
+// another. The keys of the app are listed from the source state store when
+// the migration starts, and copied in batches, at most at the requested rate.
+// The progress is reported on the metadata API. As the migration copies all
+// state of the app, it is only allowed if API token authentication is
+// enabled, with the dapr-api-token or tokens read from secret stores.
+func (a *Universal) MigrateStateAlpha1(ctx context.Context, in *runtimev1pb.MigrateStateRequest) (*runtimev1pb.MigrateStateResponse, error) {
+	if security.GetAPIToken() == "" && (a.globalConfig == nil || len(a.globalConfig.GetAPISpec().Tokens) == 0) {
+		err := apierrors.StateStore(in.GetStoreName()).MigrationUnauthenticated()
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	source, err := a.GetStateStore(in.GetStoreName())
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+	target, err := a.GetStateStore(in.GetTargetStoreName())
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+	if in.GetStoreName() == in.GetTargetStoreName() {
+		err = apierrors.StateStore(in.GetStoreName()).MigrationInvalid("the target state store must be another state store")
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if _, ok := source.(state.Querier); !ok && a.StateQueryIndex(in.GetStoreName(), source) == nil {
+		err = apierrors.StateStore(in.GetStoreName()).ListKeysNotSupported()
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	m := &runtimev1pb.MetadataStateMigration{
+		Id:              uuid.NewString(),
+		StoreName:       in.GetStoreName(),
+		TargetStoreName: in.GetTargetStoreName(),
+		DryRun:          in.GetDryRun(),
+		Status:          runtimev1pb.MetadataStateMigration_RUNNING,
+		StartTime:       time.Now().UTC().Format(time.RFC3339),
+	}
+	if err = a.stateMigrations.start(m); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	a.logger.Infof("Migrating state of state store %s to state store %s (migration %s, dry run: %t)", m.GetStoreName(), m.GetTargetStoreName(), m.GetId(), m.GetDryRun())
+	err = a.migrateState(ctx, in, m, source, target)
+	a.stateMigrations.finish(m, err)
+	if err != nil {
+		a.logger.Warnf("Failed to migrate state of state store %s to state store %s (migration %s): %v", m.GetStoreName(), m.GetTargetStoreName(), m.GetId(), err)
+		return nil, apierrors.StateStore(in.GetStoreName()).MigrationFailed(m.GetId(), err.Error())
+	}
+
+	res := a.stateMigrations.get(m)
+	a.logger.Infof("Migrated state of state store %s to state store %s (migration %s): %d keys copied, %d skipped", res.GetStoreName(), res.GetTargetStoreName(), res.GetId(), res.GetKeysCopied(), res.GetKeysSkipped())
+	return &runtimev1pb.MigrateStateResponse{Migration: res}, nil
+}
+
+// migrateState lists the keys of the source state store, and copies them to
+// the target state store in batches.
+func (a *Universal) migrateState(ctx context.Context, in *runtimev1pb.MigrateStateRequest, m *runtimev1pb.MetadataStateMigration, source, target state.Store) error {
+	keys, err := a.listStateKeys(ctx, in.GetStoreName(), source)
+	if err != nil {
+		return err
+	}
+	a.stateMigrations.update(m, func(m *runtimev1pb.MetadataStateMigration) {
+		m.KeysTotal = int64(len(keys))
+	})
+
+	batchSize := stateMigrationBatchSize
+	limiter := rate.NewLimiter(rate.Inf, batchSize)
+	if kps := int(in.GetKeysPerSecond()); kps > 0 {
+		batchSize = min(batchSize, kps)
+		limiter = rate.NewLimiter(rate.Limit(kps), batchSize)
+	}
+
+	for batch := range slices.Chunk(keys, batchSize) {
+		if err = limiter.WaitN(ctx, len(batch)); err != nil {
+			return err
+		}
+		copied, skipped, err := a.migrateStateBatch(ctx, in, source, target, batch)
+		a.stateMigrations.update(m, func(m *runtimev1pb.MetadataStateMigration) {
+			m.KeysCopied += int64(copied)
+			m.KeysSkipped += int64(skipped)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateStateBatch copies the keys, as saved in the source state store, to
+// the target state store. Keys which no longer exist or which expired are
+// skipped, as are keys which exist in the target state store unless they are
+// to be overwritten. The values are decrypted and encrypted with the keys of
+// the state stores, and keep their time to live.
+func (a *Universal) migrateStateBatch(ctx context.Context, in *runtimev1pb.MigrateStateRequest, source, target state.Store, keys []string) (copied int, skipped int, err error) {
+	storeName, targetStoreName := in.GetStoreName(), in.GetTargetStoreName()
+
+	reqs := make([]state.GetRequest, len(keys))
+	for i, key := range keys {
+		reqs[i] = state.GetRequest{Key: key}
+	}
+	resps, err := a.bulkGetStateForMigration(ctx, storeName, source, reqs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sets := make([]state.SetRequest, 0, len(resps))
+	for _, resp := range resps {
+		if resp.Error != "" {
+			return copied, skipped, fmt.Errorf("failed to get key %s: %s", stateLoader.GetOriginalStateKey(resp.Key), resp.Error)
+		}
+		if len(resp.Data) == 0 {
+			skipped++
+			continue
+		}
+
+		ttl := a.StateTTLRemaining(ctx, storeName, source, resp.Key, &state.GetResponse{Data: resp.Data, Metadata: resp.Metadata})
+		if ttl != nil && *ttl == 0 {
+			skipped++
+			continue
+		}
+
+		key := stateLoader.GetOriginalStateKey(resp.Key)
+		targetKey, err := stateLoader.GetModifiedStateKey(key, targetStoreName, a.appID)
+		if err != nil {
+			return copied, skipped, err
+		}
+
+		val := resp.Data
+		if encryption.EncryptedStateStore(storeName) {
+			if val, err = encryption.TryDecryptValue(storeName, val); err != nil {
+				return copied, skipped, fmt.Errorf("failed to decrypt key %s: %w", key, err)
+			}
+		}
+		if encryption.EncryptedStateStore(targetStoreName) {
+			if val, err = encryption.TryEncryptValue(targetStoreName, val); err != nil {
+				return copied, skipped, fmt.Errorf("failed to encrypt key %s: %w", key, err)
+			}
+		}
+
+		req := state.SetRequest{Key: targetKey, Value: val}
+		if contentType, ok := resp.Metadata["contentType"]; ok {
+			req.ContentType = &contentType
+		}
+		if ttl != nil {
+			if err = a.ApplyStateTTL(targetStoreName, target, &req, ttl); err != nil {
+				return copied, skipped, err
+			}
+		}
+		sets = append(sets, req)
+	}
+
+	if !in.GetOverwrite() && len(sets) > 0 {
+		existing := make([]state.GetRequest, len(sets))
+		for i := range sets {
+			existing[i] = state.GetRequest{Key: sets[i].Key}
+		}
+		resps, err = a.bulkGetStateForMigration(ctx, targetStoreName, target, existing)
+		if err != nil {
+			return copied, skipped, err
+		}
+		exists := make(map[string]bool, len(resps))
+		for _, resp := range resps {
+			if resp.Error != "" {
+				return copied, skipped, fmt.Errorf("failed to get key %s of the target state store: %s", stateLoader.GetOriginalStateKey(resp.Key), resp.Error)
+			}
+			exists[resp.Key] = len(resp.Data) > 0
+		}
+		sets = slices.DeleteFunc(sets, func(req state.SetRequest) bool {
+			if exists[req.Key] {
+				skipped++
+				return true
+			}
+			return false
+		})
+	}
+
+	if in.GetDryRun() || len(sets) == 0 {
+		return copied + len(sets), skipped, nil
+	}
+
+	targetKeys := make([]string, len(sets))
+	for i := range sets {
+		targetKeys[i] = sets[i].Key
+	}
+
+	start := time.Now()
+	err = stateLoader.PerformBulkStoreOperation(ctx, sets,
+		a.resiliency.ComponentOutboundOperationPolicy(targetStoreName, resiliency.Statestore, resiliency.StateSet),
+		state.BulkStoreOpts{},
+		target.Set,
+		target.BulkSet,
+	)
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, targetStoreName, diag.Set, err == nil, elapsed)
+	a.InvalidateStateKeys(ctx, targetStoreName, targetKeys...)
+	if err != nil {
+		return copied, skipped, fmt.Errorf("failed to save keys to the target state store: %w", err)
+	}
+
+	if err = a.ScheduleStateExpiry(ctx, targetStoreName, target, sets...); err != nil {
+		return copied + len(sets), skipped, err
+	}
+	return copied + len(sets), skipped, nil
+}
+
+// bulkGetStateForMigration gets the keys from the state store with its
+// resiliency policy.
+func (a *Universal) bulkGetStateForMigration(ctx context.Context, storeName string, store state.Store, reqs []state.GetRequest) ([]state.BulkGetResponse, error) {
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[[]state.BulkGetResponse](ctx,
+		a.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateBulkGet),
+	)
+	resps, err := policyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
+		return store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.BulkGet, err == nil, elapsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keys of state store %s: %w", storeName, err)
+	}
+	return resps, nil
+}
+
+// listStateKeys returns the keys of this app, as saved in the state store.
+// They are listed by querying the state store, or from its query index.
+func (a *Universal) listStateKeys(ctx context.Context, storeName string, store state.Store) ([]string, error) {
+	prefix, err := stateLoader.GetModifiedStateKey("", storeName, a.appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	if querier, ok := store.(state.Querier); ok {
+		rq := &resilientQuerier{universal: a, storeName: storeName, querier: querier}
+		var token string
+		for {
+			var q contribquery.Query
+			q.Page = contribquery.Pagination{Limit: stateMigrationBatchSize, Token: token}
+			resp, err := rq.Query(ctx, &state.QueryRequest{Query: q})
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range resp.Results {
+				keys = append(keys, item.Key)
+			}
+			if resp.Token == "" || resp.Token == token || len(resp.Results) == 0 {
+				break
+			}
+			token = resp.Token
+		}
+	} else if index := a.StateQueryIndex(storeName, store); index != nil {
+		if keys, err = index.Keys(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, apierrors.StateStore(storeName).ListKeysNotSupported()
+	}
+
+	return slices.DeleteFunc(keys, func(key string) bool {
+		return !strings.HasPrefix(key, prefix)
+	}), nil
+}
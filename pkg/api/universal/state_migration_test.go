@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+func TestMigrateState(t *testing.T) {
+	newStore := func(t *testing.T) state.Store {
+		store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+		require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+		t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+		return store
+	}
+
+	source, target := newStore(t), newStore(t)
+	require.NoError(t, stateLoader.SaveStateConfiguration("migsource", map[string]string{"queryIndex": "true"}))
+
+	compStore := compstore.New()
+	compStore.AddStateStore("migsource", source)
+	compStore.AddStateStore("migtarget", target)
+	fakeAPI := &Universal{
+		appID:      "fakeAPI",
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	for key, val := range map[string]string{"fakeAPI||key1": "value1", "fakeAPI||key2": "value2", "otherapp||key3": "value3"} {
+		require.NoError(t, source.Set(t.Context(), &state.SetRequest{Key: key, Value: []byte(val)}))
+	}
+	require.NoError(t, target.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||key2", Value: []byte("old")}))
+
+	get := func(t *testing.T, key string) string {
+		t.Helper()
+		resp, err := target.Get(t.Context(), &state.GetRequest{Key: key})
+		require.NoError(t, err)
+		return string(resp.Data)
+	}
+
+	migrate := func(t *testing.T, req *runtimev1pb.MigrateStateRequest) *runtimev1pb.MetadataStateMigration {
+		t.Helper()
+		resp, err := fakeAPI.MigrateStateAlpha1(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, runtimev1pb.MetadataStateMigration_COMPLETED, resp.GetMigration().GetStatus())
+		assert.Equal(t, int64(2), resp.GetMigration().GetKeysTotal())
+		assert.NotEmpty(t, resp.GetMigration().GetEndTime())
+		return resp.GetMigration()
+	}
+
+	t.Run("requires api token authentication", func(t *testing.T) {
+		_, err := fakeAPI.MigrateStateAlpha1(t.Context(), &runtimev1pb.MigrateStateRequest{StoreName: "migsource", TargetStoreName: "migtarget"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Setenv("DAPR_API_TOKEN", "token")
+
+	t.Run("invalid migrations", func(t *testing.T) {
+		_, err := fakeAPI.MigrateStateAlpha1(t.Context(), &runtimev1pb.MigrateStateRequest{StoreName: "migsource", TargetStoreName: "migsource"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = fakeAPI.MigrateStateAlpha1(t.Context(), &runtimev1pb.MigrateStateRequest{StoreName: "migsource", TargetStoreName: "nostore"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = fakeAPI.MigrateStateAlpha1(t.Context(), &runtimev1pb.MigrateStateRequest{StoreName: "migtarget", TargetStoreName: "migsource"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		m := migrate(t, &runtimev1pb.MigrateStateRequest{StoreName: "migsource", TargetStoreName: "migtarget", DryRun: true})
+		assert.Equal(t, int64(1), m.GetKeysCopied())
+		assert.Equal(t, int64(1), m.GetKeysSkipped())
+		assert.Empty(t, get(t, "fakeAPI||key1"))
+	})
+
+	t.Run("existing keys are skipped", func(t *testing.T) {
+		m := migrate(t, &runtimev1pb.MigrateStateRequest{StoreName: "migsource", TargetStoreName: "migtarget", KeysPerSecond: 1})
+		assert.Equal(t, int64(1), m.GetKeysCopied())
+		assert.Equal(t, int64(1), m.GetKeysSkipped())
+		assert.Equal(t, "value1", get(t, "fakeAPI||key1"))
+		assert.Equal(t, "old", get(t, "fakeAPI||key2"))
+		assert.Empty(t, get(t, "otherapp||key3"))
+	})
+
+	t.Run("existing keys are overwritten", func(t *testing.T) {
+		m := migrate(t, &runtimev1pb.MigrateStateRequest{StoreName: "migsource", TargetStoreName: "migtarget", Overwrite: true})
+		assert.Equal(t, int64(2), m.GetKeysCopied())
+		assert.Equal(t, int64(0), m.GetKeysSkipped())
+		assert.Equal(t, "value2", get(t, "fakeAPI||key2"))
+	})
+
+	t.Run("migrations are reported on the metadata api", func(t *testing.T) {
+		migrations := fakeAPI.stateMigrations.list()
+		require.Len(t, migrations, 3)
+		assert.True(t, migrations[0].GetDryRun())
+		for _, m := range migrations {
+			assert.Equal(t, "migsource", m.GetStoreName())
+			assert.Equal(t, "migtarget", m.GetTargetStoreName())
+		}
+	})
+}
+
+func TestStateMigrationsStart(t *testing.T) {
+	var s stateMigrations
+	running := &runtimev1pb.MetadataStateMigration{Id: "1", StoreName: "a", TargetStoreName: "b"}
+	require.NoError(t, s.start(running))
+
+	require.NoError(t, s.start(&runtimev1pb.MetadataStateMigration{Id: "2", StoreName: "a", TargetStoreName: "c"}))
+	require.NoError(t, s.start(&runtimev1pb.MetadataStateMigration{Id: "3", StoreName: "a", TargetStoreName: "d", DryRun: true}))
+	err := s.start(&runtimev1pb.MetadataStateMigration{Id: "4", StoreName: "c", TargetStoreName: "b"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	err = s.start(&runtimev1pb.MetadataStateMigration{Id: "5", StoreName: "e", TargetStoreName: "a"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	s.finish(running, nil)
+	require.NoError(t, s.start(&runtimev1pb.MetadataStateMigration{Id: "6", StoreName: "e", TargetStoreName: "b"}))
+
+	for range stateMigrationsRetained + 2 {
+		m := &runtimev1pb.MetadataStateMigration{StoreName: "x", TargetStoreName: "y"}
+		require.NoError(t, s.start(m))
+		s.finish(m, nil)
+	}
+	migrations := s.list()
+	assert.Len(t, migrations, stateMigrationsRetained+3)
+	assert.Equal(t, "2", migrations[0].GetId())
+}
@@ -16,11 +16,13 @@ package universal
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/dapr/components-contrib/state"
-	"github.com/dapr/dapr/pkg/api/errors"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	statequery "github.com/dapr/dapr/pkg/components/state/query"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/encryption"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -30,14 +32,14 @@ import (
 
 func (a *Universal) GetStateStore(name string) (state.Store, error) {
 	if a.compStore.StateStoresLen() == 0 {
-		err := errors.StateStore(name).NotConfigured("")
+		err := apierrors.StateStore(name).NotConfigured("")
 		a.logger.Debug(err)
 		return nil, err
 	}
 
 	stateStore, ok := a.compStore.GetStateStore(name)
 	if !ok {
-		err := errors.StateStore(name).NotFound("")
+		err := apierrors.StateStore(name).NotFound("")
 		a.logger.Debug(err)
 		return nil, err
 	}
@@ -54,20 +56,20 @@ func (a *Universal) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryS
 
 	querier, ok := store.(state.Querier)
 	if !ok {
-		err = errors.StateStore(in.GetStoreName()).QueryUnsupported()
+		err = apierrors.StateStore(in.GetStoreName()).QueryUnsupported()
 		a.logger.Debug(err)
 		return nil, err
 	}
 
 	if encryption.EncryptedStateStore(in.GetStoreName()) {
-		err = errors.StateStore(in.GetStoreName()).QueryFailed("cannot query encrypted store")
+		err = apierrors.StateStore(in.GetStoreName()).QueryFailed("cannot query encrypted store")
 		a.logger.Debug(err)
 		return nil, err
 	}
 
 	var req state.QueryRequest
 	if err = json.Unmarshal([]byte(in.GetQuery()), &req.Query); err != nil {
-		err = errors.StateStore(in.GetStoreName()).QueryFailed("failed to parse JSON query body: " + err.Error())
+		err = apierrors.StateStore(in.GetStoreName()).QueryFailed("failed to parse JSON query body: " + err.Error())
 		a.logger.Debug(err)
 		return nil, err
 	}
@@ -76,7 +78,7 @@ func (a *Universal) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryS
 
 	start := time.Now()
 	policyRunner := resiliency.NewRunner[*state.QueryResponse](ctx,
-		a.resiliency.ComponentOutboundPolicy(in.GetStoreName(), resiliency.Statestore),
+		a.resiliency.ComponentOutboundOperationPolicy(in.GetStoreName(), resiliency.Statestore, resiliency.StateQuery),
 	)
 	resp, err := policyRunner(func(ctx context.Context) (*state.QueryResponse, error) {
 		return querier.Query(ctx, &req)
@@ -90,7 +92,7 @@ func (a *Universal) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryS
 			return nil, kerr
 		}
 
-		err = errors.StateStore(in.GetStoreName()).QueryFailed(err.Error())
+		err = apierrors.StateStore(in.GetStoreName()).QueryFailed(err.Error())
 		a.logger.Debug(err)
 		return nil, err
 	}
@@ -119,3 +121,153 @@ func (a *Universal) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryS
 
 	return ret, nil
 }
+
+// QueryStateBeta1 queries one or more state stores with the stable query
+// grammar. State stores without native query support are queried over the
+// keys they list natively, if their query index is enabled.
+func (a *Universal) QueryStateBeta1(ctx context.Context, in *runtimev1pb.QueryStateRequest) (*runtimev1pb.QueryStateResponse, error) {
+	storeNames := append([]string{in.GetStoreName()}, in.GetStoreNames()...)
+	targets := make([]statequery.Target, 0, len(storeNames))
+	seen := make(map[string]struct{}, len(storeNames))
+	for _, name := range storeNames {
+		if _, ok := seen[name]; ok {
+			err := apierrors.StateStore(name).QueryInvalid("state store is queried more than once")
+			a.logger.Debug(err)
+			return nil, err
+		}
+		seen[name] = struct{}{}
+
+		querier, err := a.stateQuerier(name)
+		if err != nil {
+			// Error has already been logged
+			return nil, err
+		}
+		targets = append(targets, statequery.Target{
+			Name:    name,
+			Querier: &resilientQuerier{universal: a, storeName: name, querier: querier},
+		})
+	}
+
+	q, err := statequery.Parse([]byte(in.GetQuery()))
+	if err != nil {
+		err = apierrors.StateStore(in.GetStoreName()).QueryInvalid(err.Error())
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	resp, err := statequery.Run(ctx, targets, q, in.GetMetadata())
+	if err != nil {
+		if kerr, ok := kiterrors.FromError(err); ok {
+			return nil, kerr
+		}
+
+		if errors.Is(err, statequery.ErrInvalidQuery) {
+			err = apierrors.StateStore(in.GetStoreName()).QueryInvalid(err.Error())
+		} else {
+			err = apierrors.StateStore(in.GetStoreName()).QueryFailed(err.Error())
+		}
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	ret := &runtimev1pb.QueryStateResponse{
+		Results:  make([]*runtimev1pb.QueryStateItem, len(resp.Results)),
+		Token:    resp.Token,
+		Metadata: resp.Metadata,
+	}
+	for i, r := range resp.Results {
+		row := &runtimev1pb.QueryStateItem{
+			Key:       stateLoader.GetOriginalStateKey(r.Key),
+			Data:      r.Data,
+			Error:     r.Error,
+			StoreName: r.Store,
+		}
+		if r.ETag != nil {
+			row.Etag = *r.ETag
+		}
+		ret.Results[i] = row
+	}
+
+	return ret, nil
+}
+
+// StateQueryIndex returns the query index of the state store, or nil if it
+// has none. State stores have a query index if they enable it with the
+// "queryIndex" metadata property, unless they support querying natively or are
+// encrypted. The index of state stores which cannot list their keys is
+// maintained by the runtime as state is saved and deleted.
+func (a *Universal) StateQueryIndex(storeName string, store state.Store) *statequery.Index {
+	if !stateLoader.QueryIndexEnabled(storeName) || encryption.EncryptedStateStore(storeName) {
+		return nil
+	}
+	if _, ok := store.(state.Querier); ok {
+		return nil
+	}
+
+	prefix, err := stateLoader.GetModifiedStateKey("", storeName, a.appID)
+	if err != nil {
+		return nil
+	}
+	return statequery.NewIndex(store, prefix)
+}
+
+// stateQuerier returns the querier of the state store: the state store itself
+// if it supports querying natively, or else the emulated querier over its
+// query index.
+func (a *Universal) stateQuerier(storeName string) (state.Querier, error) {
+	store, err := a.GetStateStore(storeName)
+	if err != nil {
+		return nil, err
+	}
+
+	if encryption.EncryptedStateStore(storeName) {
+		err = apierrors.StateStore(storeName).QueryFailed("cannot query encrypted store")
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if querier, ok := store.(state.Querier); ok {
+		return querier, nil
+	}
+
+	if index := a.StateQueryIndex(storeName, store); index != nil {
+		return statequery.NewEmulated(store, index), nil
+	}
+
+	err = apierrors.StateStore(storeName).QueryUnsupported()
+	a.logger.Debug(err)
+	return nil, err
+}
+
+// resilientQuerier queries a state store with its resiliency policy,
+// recording the query in the component metrics.
+type resilientQuerier struct {
+	universal *Universal
+	storeName string
+	querier   state.Querier
+}
+
+func (r *resilientQuerier) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[*state.QueryResponse](ctx,
+		r.universal.resiliency.ComponentOutboundOperationPolicy(r.storeName, resiliency.Statestore, resiliency.StateQuery),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*state.QueryResponse, error) {
+		return r.querier.Query(ctx, req)
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, r.storeName, diag.StateQuery, err == nil, elapsed)
+
+	switch {
+	case err == nil:
+		return resp, nil
+	case errors.Is(err, statequery.ErrInvalidQuery):
+		return nil, apierrors.StateStore(r.storeName).QueryInvalid(err.Error())
+	default:
+		if _, ok := kiterrors.FromError(err); ok {
+			return nil, err
+		}
+		return nil, apierrors.StateStore(r.storeName).QueryFailed(err.Error())
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// StateTransactionConflictError returns the error of a state transaction
+// which failed with an ETag mismatch, reporting which of the operations of the
+// app conflicted, or nil if the transaction failed otherwise.
+// State stores do not report which operation of a transaction failed, so the
+// conflicting operations are those whose ETag does not match the state in the
+// store once the transaction has been rolled back. This is best effort: the
+// state may have changed again since, in which case the error reports no or
+// other operations.
+func (a *Universal) StateTransactionConflictError(ctx context.Context, storeName string, store state.Store, operations []state.TransactionalStateOperation, err error) error {
+	var etagErr *state.ETagError
+	if !errors.As(err, &etagErr) || etagErr.Kind() != state.ETagMismatch {
+		return nil
+	}
+
+	reqs := make([]state.GetRequest, 0, len(operations))
+	seen := make(map[string]struct{}, len(operations))
+	for _, op := range operations {
+		if _, ok := seen[op.GetKey()]; ok {
+			continue
+		}
+		seen[op.GetKey()] = struct{}{}
+		reqs = append(reqs, state.GetRequest{Key: op.GetKey()})
+	}
+
+	policyRunner := resiliency.NewRunner[[]state.BulkGetResponse](ctx,
+		a.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateBulkGet),
+	)
+	res, err := policyRunner(func(ctx context.Context) ([]state.BulkGetResponse, error) {
+		return store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+	})
+	if err != nil {
+		a.logger.Debugf("Failed to look up the conflicting operations of the transaction on state store %s: %v", storeName, err)
+		return apierrors.StateStore(storeName).TransactionETagMismatch(nil)
+	}
+
+	// The ETags of the keys which exist in the state store.
+	etags := make(map[string]*string, len(res))
+	for _, r := range res {
+		if r.Error == "" && (len(r.Data) > 0 || r.ETag != nil) {
+			etags[r.Key] = r.ETag
+		}
+	}
+
+	var conflicts []apierrors.TransactionConflict
+	for i, op := range operations {
+		if operationConflicts(op, etags) {
+			conflicts = append(conflicts, apierrors.TransactionConflict{
+				Operation: i,
+				Key:       stateLoader.GetOriginalStateKey(op.GetKey()),
+			})
+		}
+	}
+
+	return apierrors.StateStore(storeName).TransactionETagMismatch(conflicts)
+}
+
+// operationConflicts returns true if the operation of a transaction cannot
+// succeed against the given ETags of the keys in the state store.
+func operationConflicts(op state.TransactionalStateOperation, etags map[string]*string) bool {
+	var (
+		etag       *string
+		firstWrite bool
+	)
+	switch req := op.(type) {
+	case state.SetRequest:
+		etag = req.ETag
+		firstWrite = req.Options.Concurrency == state.FirstWrite
+	case state.DeleteRequest:
+		etag = req.ETag
+	}
+
+	current, exists := etags[op.GetKey()]
+	switch {
+	case etag != nil:
+		return !exists || current == nil || *current != *etag
+	case firstWrite:
+		// A first-write upsert without an ETag requires the key to not exist.
+		return exists
+	default:
+		return false
+	}
+}
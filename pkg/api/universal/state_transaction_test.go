@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/dapr/pkg/resiliency"
+	kiterrors "github.com/dapr/kit/errors"
+	"github.com/dapr/kit/logger"
+)
+
+func TestStateTransactionConflictError(t *testing.T) {
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+
+	for _, key := range []string{"app||a", "app||b", "app||c"} {
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: key, Value: "v"}))
+	}
+	res, err := store.Get(t.Context(), &state.GetRequest{Key: "app||a"})
+	require.NoError(t, err)
+	etagA := *res.ETag
+	stale := "stale"
+
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+	}
+
+	t.Run("not an etag mismatch", func(t *testing.T) {
+		err := fakeAPI.StateTransactionConflictError(t.Context(), "store", store, nil, errors.New("failed"))
+		require.NoError(t, err)
+
+		err = fakeAPI.StateTransactionConflictError(t.Context(), "store", store, nil, state.NewETagError(state.ETagInvalid, nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("reports the conflicting operations", func(t *testing.T) {
+		operations := []state.TransactionalStateOperation{
+			state.SetRequest{Key: "app||a", ETag: &etagA},
+			state.SetRequest{Key: "app||b", ETag: &stale},
+			state.DeleteRequest{Key: "app||c", ETag: &stale},
+			state.DeleteRequest{Key: "app||d", ETag: &stale},
+			state.SetRequest{Key: "app||c", Options: state.SetStateOption{Concurrency: state.FirstWrite}},
+			state.SetRequest{Key: "app||e", Options: state.SetStateOption{Concurrency: state.FirstWrite}},
+			state.SetRequest{Key: "app||b"},
+		}
+		err := fakeAPI.StateTransactionConflictError(t.Context(), "store", store, operations, state.NewETagError(state.ETagMismatch, nil))
+		require.Error(t, err)
+
+		kerr, ok := kiterrors.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, kerr.HTTPStatusCode())
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.Contains(t, err.Error(), "etag mismatch for keys: b, c, d, c")
+
+		var subjects []string
+		for _, detail := range status.Convert(err).Details() {
+			switch d := detail.(type) {
+			case *errdetails.PreconditionFailure:
+				for _, v := range d.GetViolations() {
+					subjects = append(subjects, v.GetSubject())
+				}
+			case *errdetails.ErrorInfo:
+				assert.Equal(t, "DAPR_STATE_TRANSACTION_ETAG_MISMATCH", d.GetReason())
+				assert.Equal(t, "1,2,3,4", d.GetMetadata()["conflictingOperations"])
+			}
+		}
+		assert.Equal(t, []string{"b", "c", "d", "c"}, subjects)
+	})
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// EmulatesStateTTL returns true if the runtime deletes the state of the state
+// store once its time to live expires, as the state store does not support
+// TTLs natively but enables the "ttlEmulation" metadata property.
+func (a *Universal) EmulatesStateTTL(storeName string, store state.Store) bool {
+	return !state.FeatureTTL.IsPresent(store.Features()) && stateLoader.TTLEmulationEnabled(storeName)
+}
+
+// ApplyStateTTL sets the time to live of the state saved by the request, if
+// given, as the ttlInSeconds metadata property of the request, which it takes
+// precedence over. An error is returned if the time to live is invalid, or
+// the state store supports neither native nor emulated TTLs.
+func (a *Universal) ApplyStateTTL(storeName string, store state.Store, req *state.SetRequest, ttlInSeconds *int64) error {
+	key := stateLoader.GetOriginalStateKey(req.Key)
+	if ttlInSeconds == nil {
+		// The metadata property is passed through to state stores with native
+		// TTLs, which validate it themselves.
+		if a.EmulatesStateTTL(storeName, store) {
+			if _, err := statettl.FromMetadata(req.Metadata); err != nil {
+				return apierrors.StateStore(storeName).TTLInvalid(key, err.Error())
+			}
+		}
+		return nil
+	}
+
+	if err := statettl.Validate(*ttlInSeconds); err != nil {
+		return apierrors.StateStore(storeName).TTLInvalid(key, err.Error())
+	}
+	if !state.FeatureTTL.IsPresent(store.Features()) && !a.EmulatesStateTTL(storeName, store) {
+		return apierrors.StateStore(storeName).TTLNotSupported()
+	}
+
+	req.Metadata = statettl.WithMetadata(req.Metadata, *ttlInSeconds)
+	return nil
+}
+
+// ScheduleStateExpiry schedules the deletion of the keys saved with a time to
+// live in a state store with emulated TTLs, once saved. Keys saved without
+// one have their deletion cancelled, as saving state resets its TTL. It is a
+// no-op for other state stores.
+func (a *Universal) ScheduleStateExpiry(ctx context.Context, storeName string, store state.Store, reqs ...state.SetRequest) error {
+	if len(reqs) == 0 || !a.EmulatesStateTTL(storeName, store) {
+		return nil
+	}
+
+	now := time.Now()
+	var eg errgroup.Group
+	eg.SetLimit(bulkJobsMaxConcurrency)
+	for _, req := range reqs {
+		eg.Go(func() error {
+			ttlInSeconds, err := statettl.FromMetadata(req.Metadata)
+			if err != nil {
+				return err
+			}
+			if ttlInSeconds == nil || *ttlInSeconds == statettl.NeverExpire {
+				return a.cancelStateExpiry(ctx, storeName, req.Key)
+			}
+
+			dueTime := now.Add(time.Duration(*ttlInSeconds) * time.Second).UTC().Format(time.RFC3339)
+			schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+			defer cancel()
+			_, err = a.scheduler.ScheduleJob(schedCtx, &schedulerv1pb.ScheduleJobRequest{
+				Name:      statettl.JobName(storeName, req.Key),
+				Metadata:  a.stateExpiryJobMetadata(),
+				Overwrite: true,
+				Job: &schedulerv1pb.Job{
+					DueTime: &dueTime,
+					Repeats: new(uint32(1)),
+				},
+			}, grpc.WaitForReady(true))
+			if err != nil {
+				return fmt.Errorf("failed to schedule the expiry of key %s: %w", stateLoader.GetOriginalStateKey(req.Key), err)
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// ScheduleStateTransactionExpiry schedules or cancels the deletion of the keys
+// of the operations of a transaction, once executed, in a state store with
+// emulated TTLs.
+func (a *Universal) ScheduleStateTransactionExpiry(ctx context.Context, storeName string, store state.Store, operations []state.TransactionalStateOperation) error {
+	if !a.EmulatesStateTTL(storeName, store) {
+		return nil
+	}
+
+	var upserts []state.SetRequest
+	var deletes []string
+	for _, op := range operations {
+		switch req := op.(type) {
+		case state.SetRequest:
+			upserts = append(upserts, req)
+		case state.DeleteRequest:
+			deletes = append(deletes, req.Key)
+		}
+	}
+
+	a.CancelStateExpiry(ctx, storeName, store, deletes...)
+	return a.ScheduleStateExpiry(ctx, storeName, store, upserts...)
+}
+
+// CancelStateExpiry cancels the scheduled deletion of the keys, as saved in
+// a state store with emulated TTLs, once deleted. Failures are only logged,
+// as the deletion of a key which no longer exists is a no-op, and saving the
+// key again reschedules or cancels it.
+func (a *Universal) CancelStateExpiry(ctx context.Context, storeName string, store state.Store, keys ...string) {
+	if len(keys) == 0 || !a.EmulatesStateTTL(storeName, store) {
+		return
+	}
+
+	var eg errgroup.Group
+	eg.SetLimit(bulkJobsMaxConcurrency)
+	for _, key := range keys {
+		eg.Go(func() error {
+			if err := a.cancelStateExpiry(ctx, storeName, key); err != nil {
+				a.logger.Warnf("Failed to cancel the expiry of key %s of state store %s: %v", stateLoader.GetOriginalStateKey(key), storeName, err)
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+}
+
+func (a *Universal) cancelStateExpiry(ctx context.Context, storeName string, key string) error {
+	schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+	_, err := a.scheduler.DeleteJob(schedCtx, &schedulerv1pb.DeleteJobRequest{
+		Name:     statettl.JobName(storeName, key),
+		Metadata: a.stateExpiryJobMetadata(),
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("failed to cancel the expiry of key %s: %w", stateLoader.GetOriginalStateKey(key), err)
+	}
+	return nil
+}
+
+// StateTTLRemaining returns the remaining time to live of the key, as saved
+// in the state store, in seconds, or nil if the state does not expire. For
+// state stores with emulated TTLs it is read from the job scheduled to delete
+// the key, and for others from the expire time the state store reports.
+func (a *Universal) StateTTLRemaining(ctx context.Context, storeName string, store state.Store, key string, resp *state.GetResponse) *int64 {
+	if resp == nil || resp.Data == nil {
+		return nil
+	}
+	if !a.EmulatesStateTTL(storeName, store) {
+		return statettl.RemainingFromMetadata(resp.Metadata, time.Now())
+	}
+
+	schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+	job, err := a.scheduler.GetJob(schedCtx, &schedulerv1pb.GetJobRequest{
+		Name:     statettl.JobName(storeName, key),
+		Metadata: a.stateExpiryJobMetadata(),
+	})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			a.logger.Debugf("Failed to get the expiry of key %s of state store %s: %v", stateLoader.GetOriginalStateKey(key), storeName, err)
+		}
+		return nil
+	}
+	expireTime, err := time.Parse(time.RFC3339, job.GetJob().GetDueTime())
+	if err != nil {
+		return nil
+	}
+	return new(statettl.Remaining(expireTime, time.Now()))
+}
+
+// ExpireState deletes the key, as saved in the state store, once its emulated
+// time to live expired. It is called when the job scheduled to delete the key
+// is triggered.
+func (a *Universal) ExpireState(ctx context.Context, storeName string, key string) error {
+	store, ok := a.compStore.GetStateStore(storeName)
+	if !ok {
+		a.logger.Warnf("Ignoring the expiry of key %s of state store %s which is not found", stateLoader.GetOriginalStateKey(key), storeName)
+		return nil
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[any](ctx,
+		a.resiliency.ComponentOutboundOperationPolicy(storeName, resiliency.Statestore, resiliency.StateDelete),
+	)
+	_, err := policyRunner(func(ctx context.Context) (any, error) {
+		return nil, store.Delete(ctx, &state.DeleteRequest{Key: key})
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.Delete, err == nil, elapsed)
+	diag.DefaultComponentMonitoring.StateTTLExpired(ctx, storeName, err == nil)
+	a.InvalidateStateKeys(ctx, storeName, key)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete expired key %s of state store %s: %w", stateLoader.GetOriginalStateKey(key), storeName, err)
+	}
+
+	a.logger.Debugf("Deleted expired key %s of state store %s", stateLoader.GetOriginalStateKey(key), storeName)
+	return nil
+}
+
+// stateExpiryJobMetadata returns the metadata of the jobs scheduled to delete
+// expired state, which are jobs of the app.
+func (a *Universal) stateExpiryJobMetadata() *schedulerv1pb.JobMetadata {
+	return &schedulerv1pb.JobMetadata{
+		AppId:     a.appID,
+		Namespace: a.Namespace(),
+		Target: &schedulerv1pb.JobTargetMetadata{
+			Type: &schedulerv1pb.JobTargetMetadata_Job{
+				Job: new(schedulerv1pb.TargetJob),
+			},
+		},
+	}
+}
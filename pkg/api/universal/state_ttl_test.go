@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestApplyStateTTL(t *testing.T) {
+	native := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, native.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { native.(interface{ Close() error }).Close() })
+
+	emulated := daprt.NewFakeStateStore()
+	require.NoError(t, stateLoader.SaveStateConfiguration("ttlemulated", map[string]string{"ttlEmulation": "true"}))
+
+	fakeAPI := &Universal{
+		appID:  "fakeAPI",
+		logger: testLogger,
+	}
+
+	t.Run("field takes precedence over metadata", func(t *testing.T) {
+		req := &state.SetRequest{Key: "key1", Metadata: map[string]string{"ttlInSeconds": "10"}}
+		require.NoError(t, fakeAPI.ApplyStateTTL("native", native, req, new(int64(20))))
+		assert.Equal(t, "20", req.Metadata["ttlInSeconds"])
+	})
+
+	t.Run("never expire", func(t *testing.T) {
+		req := &state.SetRequest{Key: "key1"}
+		require.NoError(t, fakeAPI.ApplyStateTTL("ttlemulated", emulated, req, new(int64(-1))))
+		assert.Equal(t, "-1", req.Metadata["ttlInSeconds"])
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		for _, ttl := range []int64{0, -2} {
+			err := fakeAPI.ApplyStateTTL("native", native, &state.SetRequest{Key: "key1"}, &ttl)
+			require.ErrorContains(t, err, "time to live must be a positive number of seconds")
+		}
+	})
+
+	t.Run("invalid metadata ttl of emulated store", func(t *testing.T) {
+		req := &state.SetRequest{Key: "key1", Metadata: map[string]string{"ttlInSeconds": "soon"}}
+		require.Error(t, fakeAPI.ApplyStateTTL("ttlemulated", emulated, req, nil))
+	})
+
+	t.Run("store without ttls", func(t *testing.T) {
+		err := fakeAPI.ApplyStateTTL("nottl", daprt.NewFakeStateStore(), &state.SetRequest{Key: "key1"}, new(int64(10)))
+		require.ErrorContains(t, err, "does not support TTLs")
+	})
+}
+
+func TestStateTTLRemaining(t *testing.T) {
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+
+	fakeAPI := &Universal{
+		appID:  "fakeAPI",
+		logger: testLogger,
+	}
+
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "key1", Value: []byte("value1"), Metadata: map[string]string{"ttlInSeconds": "60"}}))
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "key2", Value: []byte("value2")}))
+
+	remaining := func(key string) *int64 {
+		resp, err := store.Get(t.Context(), &state.GetRequest{Key: key})
+		require.NoError(t, err)
+		return fakeAPI.StateTTLRemaining(t.Context(), "store", store, key, resp)
+	}
+
+	ttl := remaining("key1")
+	require.NotNil(t, ttl)
+	assert.InDelta(t, 60, *ttl, 2)
+	assert.Nil(t, remaining("key2"))
+	assert.Nil(t, remaining("key3"))
+}
+
+func TestExpireState(t *testing.T) {
+	store := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("ttlemulated", store)
+	fakeAPI := &Universal{
+		appID:      "fakeAPI",
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||key1", Value: []byte("value1")}))
+	require.NoError(t, fakeAPI.ExpireState(t.Context(), "ttlemulated", "fakeAPI||key1"))
+
+	resp, err := store.Get(t.Context(), &state.GetRequest{Key: "fakeAPI||key1"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Data)
+
+	// The expiry of keys of state stores which no longer exist is ignored.
+	require.NoError(t, fakeAPI.ExpireState(t.Context(), "nostore", "fakeAPI||key1"))
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dapr/components-contrib/state"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	statewatch "github.com/dapr/dapr/pkg/components/state/watch"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	kiterrors "github.com/dapr/kit/errors"
+)
+
+// WatchState watches keys of a state store, sending the changes to them until
+// the context is canceled or sending fails. The state store's native change
+// feed is used if it has one, or else the runtime polls the state store.
+func (a *Universal) WatchState(ctx context.Context, in *runtimev1pb.WatchStateRequest, send func(*runtimev1pb.WatchStateResponse) error) error {
+	storeName := in.GetStoreName()
+	store, err := a.GetStateStore(storeName)
+	if err != nil {
+		// Error has already been logged
+		return err
+	}
+
+	if len(in.GetKeys()) == 0 && len(in.GetKeyPrefixes()) == 0 {
+		err = apierrors.StateStore(storeName).WatchInvalid("at least one key or key prefix is required")
+		a.logger.Debug(err)
+		return err
+	}
+
+	req := &statewatch.Request{
+		Keys:     make([]string, len(in.GetKeys())),
+		Prefixes: make([]string, len(in.GetKeyPrefixes())),
+		Metadata: in.GetMetadata(),
+	}
+	for i, k := range in.GetKeys() {
+		if k == "" {
+			err = apierrors.StateStore(storeName).WatchInvalid("keys cannot be empty")
+			a.logger.Debug(err)
+			return err
+		}
+		if req.Keys[i], err = stateLoader.GetModifiedStateKeyForRequest(k, storeName, a.appID, in.GetMetadata()); err != nil {
+			a.logger.Debug(err)
+			return err
+		}
+	}
+	for i, p := range in.GetKeyPrefixes() {
+		if req.Prefixes[i], err = stateLoader.GetModifiedStateKeyForRequest(p, storeName, a.appID, in.GetMetadata()); err != nil {
+			a.logger.Debug(err)
+			return err
+		}
+	}
+
+	err = a.stateWatcher(storeName, store).Watch(ctx, req, func(ctx context.Context, changes []statewatch.Change) error {
+		resp := &runtimev1pb.WatchStateResponse{
+			Changes: make([]*runtimev1pb.StateChange, len(changes)),
+		}
+		for i, change := range changes {
+			res, cerr := a.stateChange(storeName, change)
+			if cerr != nil {
+				return cerr
+			}
+			resp.Changes[i] = res
+		}
+		return send(resp)
+	})
+	switch {
+	case err == nil, errors.Is(err, context.Canceled):
+		return nil
+	case errors.Is(err, statewatch.ErrPrefixUnsupported):
+		err = apierrors.StateStore(storeName).WatchUnsupported(err.Error())
+	default:
+		if _, ok := kiterrors.FromError(err); !ok {
+			err = apierrors.StateStore(storeName).WatchFailed(err.Error())
+		}
+	}
+	a.logger.Debug(err)
+	return err
+}
+
+// stateWatcher returns the watcher of the state store: the state store itself
+// if it has a native change feed, or else a poller over the state store.
+func (a *Universal) stateWatcher(storeName string, store state.Store) statewatch.Watcher {
+	if watcher, ok := store.(statewatch.Watcher); ok {
+		return watcher
+	}
+	return statewatch.NewPoller(statewatch.PollerOptions{
+		Store:    store,
+		Index:    a.StateQueryIndex(storeName, store),
+		Interval: stateLoader.WatchPollInterval(storeName),
+	})
+}
+
+func (a *Universal) stateChange(storeName string, change statewatch.Change) (*runtimev1pb.StateChange, error) {
+	res := &runtimev1pb.StateChange{
+		Key:       stateLoader.GetOriginalStateKey(change.Key),
+		Operation: string(change.Operation),
+		Data:      change.Data,
+		Metadata:  change.Metadata,
+	}
+	if change.ETag != nil {
+		res.Etag = *change.ETag
+	}
+
+	if change.Operation == state.OperationUpsert && encryption.EncryptedStateStore(storeName) {
+		val, err := encryption.TryDecryptValue(storeName, change.Data)
+		if err != nil {
+			return nil, apierrors.StateStore(storeName).WatchFailed("failed to decrypt value of key " + res.GetKey() + ": " + err.Error())
+		}
+		res.Data = val
+	}
+	return res, nil
+}
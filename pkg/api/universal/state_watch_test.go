@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+func TestWatchState(t *testing.T) {
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+	require.NoError(t, stateLoader.SaveStateConfiguration("watchstore", map[string]string{"watchPollInterval": "10ms"}))
+
+	compStore := compstore.New()
+	compStore.AddStateStore("watchstore", store)
+	fakeAPI := &Universal{
+		appID:      "fakeAPI",
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	t.Run("delivers changes", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		responses := make(chan *runtimev1pb.WatchStateResponse, 10)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- fakeAPI.WatchState(ctx, &runtimev1pb.WatchStateRequest{
+				StoreName: "watchstore",
+				Keys:      []string{"key1"},
+			}, func(resp *runtimev1pb.WatchStateResponse) error {
+				responses <- resp
+				return nil
+			})
+		}()
+
+		select {
+		case resp := <-responses:
+			assert.Empty(t, resp.GetChanges())
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "watch was not established")
+		}
+
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "fakeAPI||key1", Value: []byte("value1")}))
+		select {
+		case resp := <-responses:
+			require.Len(t, resp.GetChanges(), 1)
+			assert.Equal(t, "key1", resp.GetChanges()[0].GetKey())
+			assert.Equal(t, "upsert", resp.GetChanges()[0].GetOperation())
+			assert.Equal(t, "value1", string(resp.GetChanges()[0].GetData()))
+			assert.NotEmpty(t, resp.GetChanges()[0].GetEtag())
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no changes delivered")
+		}
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("no keys", func(t *testing.T) {
+		err := fakeAPI.WatchState(t.Context(), &runtimev1pb.WatchStateRequest{StoreName: "watchstore"}, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("prefixes without query index", func(t *testing.T) {
+		err := fakeAPI.WatchState(t.Context(), &runtimev1pb.WatchStateRequest{
+			StoreName:   "watchstore",
+			KeyPrefixes: []string{"key"},
+		}, nil)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// Subscriptions pauses and resumes the app subscriptions to topics.
+type Subscriptions interface {
+	PauseSubscription(pubsubName, topic string) error
+	ResumeSubscription(pubsubName, topic string) error
+	IsSubscriptionPaused(pubsubName, topic string) bool
+}
+
+// PauseSubscriptionAlpha1 pauses the delivery of the messages of a topic to the
+// app, such as to mitigate an incident, until the subscription is resumed.
+func (a *Universal) PauseSubscriptionAlpha1(ctx context.Context, in *runtimev1pb.PauseSubscriptionRequest) (*emptypb.Empty, error) {
+	if err := a.subscriptionValidateRequest(in.GetPubsubName(), in.GetTopic()); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if err := a.subscriptions.PauseSubscription(in.GetPubsubName(), in.GetTopic()); err != nil {
+		err = apierrors.PubSub(in.GetPubsubName()).SubscriptionUpdate(in.GetTopic(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResumeSubscriptionAlpha1 resumes the delivery of the messages of a paused
+// topic to the app.
+func (a *Universal) ResumeSubscriptionAlpha1(ctx context.Context, in *runtimev1pb.ResumeSubscriptionRequest) (*emptypb.Empty, error) {
+	if err := a.subscriptionValidateRequest(in.GetPubsubName(), in.GetTopic()); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if err := a.subscriptions.ResumeSubscription(in.GetPubsubName(), in.GetTopic()); err != nil {
+		err = apierrors.PubSub(in.GetPubsubName()).SubscriptionUpdate(in.GetTopic(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (a *Universal) subscriptionValidateRequest(pubsubName, topic string) error {
+	if a.subscriptions == nil {
+		return messages.ErrAPIUnimplemented
+	}
+
+	if pubsubName == "" {
+		return apierrors.PubSub(pubsubName).WithMetadata(nil).NameEmpty()
+	}
+
+	if _, ok := a.compStore.GetPubSub(pubsubName); !ok {
+		return apierrors.PubSub(pubsubName).WithMetadata(nil).NotFound()
+	}
+
+	if topic == "" {
+		return apierrors.PubSub(pubsubName).WithMetadata(nil).TopicEmpty()
+	}
+
+	for _, sub := range a.compStore.ListSubscriptionsAppByPubSub(pubsubName) {
+		if sub.Topic == topic {
+			return nil
+		}
+	}
+
+	return apierrors.PubSub(pubsubName).SubscriptionNotFound(topic)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+type fakeSubscriptions struct {
+	paused map[string]bool
+	err    error
+}
+
+func (f *fakeSubscriptions) PauseSubscription(pubsubName, topic string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.paused[pubsubName+"||"+topic] = true
+	return nil
+}
+
+func (f *fakeSubscriptions) ResumeSubscription(pubsubName, topic string) error {
+	if f.err != nil {
+		return f.err
+	}
+	delete(f.paused, pubsubName+"||"+topic)
+	return nil
+}
+
+func (f *fakeSubscriptions) IsSubscriptionPaused(pubsubName, topic string) bool {
+	return f.paused[pubsubName+"||"+topic]
+}
+
+func TestPauseResumeSubscription(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddPubSub("pubsub", &rtpubsub.PubsubItem{Component: new(daprt.MockPubSub)})
+	compStore.SetProgramaticSubscriptions(rtpubsub.Subscription{
+		PubsubName: "pubsub",
+		Topic:      "orders",
+	})
+
+	subs := &fakeSubscriptions{paused: map[string]bool{}}
+	fakeAPI := &Universal{
+		logger:        logger.NewLogger("test"),
+		compStore:     compStore,
+		subscriptions: subs,
+	}
+
+	t.Run("pause and resume", func(t *testing.T) {
+		_, err := fakeAPI.PauseSubscriptionAlpha1(t.Context(), &runtimev1pb.PauseSubscriptionRequest{
+			PubsubName: "pubsub",
+			Topic:      "orders",
+		})
+		require.NoError(t, err)
+		assert.True(t, subs.IsSubscriptionPaused("pubsub", "orders"))
+
+		_, err = fakeAPI.ResumeSubscriptionAlpha1(t.Context(), &runtimev1pb.ResumeSubscriptionRequest{
+			PubsubName: "pubsub",
+			Topic:      "orders",
+		})
+		require.NoError(t, err)
+		assert.False(t, subs.IsSubscriptionPaused("pubsub", "orders"))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		tests := map[string]struct {
+			req  *runtimev1pb.PauseSubscriptionRequest
+			code codes.Code
+		}{
+			"empty pubsub name":    {&runtimev1pb.PauseSubscriptionRequest{Topic: "orders"}, codes.InvalidArgument},
+			"pubsub not found":     {&runtimev1pb.PauseSubscriptionRequest{PubsubName: "missing", Topic: "orders"}, codes.InvalidArgument},
+			"empty topic":          {&runtimev1pb.PauseSubscriptionRequest{PubsubName: "pubsub"}, codes.InvalidArgument},
+			"topic not subscribed": {&runtimev1pb.PauseSubscriptionRequest{PubsubName: "pubsub", Topic: "payments"}, codes.NotFound},
+		}
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := fakeAPI.PauseSubscriptionAlpha1(t.Context(), test.req)
+				require.Error(t, err)
+				assert.Equal(t, test.code, status.Code(err))
+				assert.Empty(t, subs.paused)
+			})
+		}
+	})
+
+	t.Run("failing to pause", func(t *testing.T) {
+		subs.err = errors.New("subscriber is closed")
+		t.Cleanup(func() { subs.err = nil })
+
+		_, err := fakeAPI.PauseSubscriptionAlpha1(t.Context(), &runtimev1pb.PauseSubscriptionRequest{
+			PubsubName: "pubsub",
+			Topic:      "orders",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("without subscriptions the API is unimplemented", func(t *testing.T) {
+		api := &Universal{logger: logger.NewLogger("test"), compStore: compStore}
+		_, err := api.ResumeSubscriptionAlpha1(t.Context(), &runtimev1pb.ResumeSubscriptionRequest{
+			PubsubName: "pubsub",
+			Topic:      "orders",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
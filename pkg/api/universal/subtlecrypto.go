@@ -39,26 +39,6 @@ func (a *Universal) SubtleDecryptAlpha1(ctx context.Context, in *runtimev1pb.Sub
 	return nil, messages.ErrAPIUnimplemented
 }
 
-// SubtleWrapKeyAlpha1 wraps a key using a key stored in the vault.
-func (a *Universal) SubtleWrapKeyAlpha1(ctx context.Context, in *runtimev1pb.SubtleWrapKeyRequest) (*runtimev1pb.SubtleWrapKeyResponse, error) {
-	return nil, messages.ErrAPIUnimplemented
-}
-
-// SubtleUnwrapKeyAlpha1 unwraps a key using a key stored in the vault.
-func (a *Universal) SubtleUnwrapKeyAlpha1(ctx context.Context, in *runtimev1pb.SubtleUnwrapKeyRequest) (*runtimev1pb.SubtleUnwrapKeyResponse, error) {
-	return nil, messages.ErrAPIUnimplemented
-}
-
-// SubtleSignAlpha1 signs a message using a key stored in the vault.
-func (a *Universal) SubtleSignAlpha1(ctx context.Context, in *runtimev1pb.SubtleSignRequest) (*runtimev1pb.SubtleSignResponse, error) {
-	return nil, messages.ErrAPIUnimplemented
-}
-
-// SubtleVerifyAlpha1 verifies the signature of a message using a key stored in the vault.
-func (a *Universal) SubtleVerifyAlpha1(ctx context.Context, in *runtimev1pb.SubtleVerifyRequest) (*runtimev1pb.SubtleVerifyResponse, error) {
-	return nil, messages.ErrAPIUnimplemented
-}
-
 // CryptoValidateRequest is an internal method that checks if the request is for a valid crypto component.
 func (a *Universal) CryptoValidateRequest(componentName string) (contribCrypto.SubtleCrypto, error) {
 	if a.compStore.CryptoProvidersLen() == 0 {
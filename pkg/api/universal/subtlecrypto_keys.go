@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	kitCrypto "github.com/dapr/kit/crypto"
+)
+
+// SubtleWrapKeyAlpha1 wraps a key using a key stored in the vault.
+func (a *Universal) SubtleWrapKeyAlpha1(ctx context.Context, in *runtimev1pb.SubtleWrapKeyRequest) (*runtimev1pb.SubtleWrapKeyResponse, error) {
+	component, err := a.CryptoValidateRequest(in.GetComponentName())
+	if err != nil {
+		return &runtimev1pb.SubtleWrapKeyResponse{}, err
+	}
+	if err = a.cryptoValidateKeyName(in.GetKeyName()); err != nil {
+		return &runtimev1pb.SubtleWrapKeyResponse{}, err
+	}
+	if len(in.GetPlaintextKey()) == 0 {
+		err = messages.ErrBadRequest.WithFormat("missing plaintext key")
+		a.logger.Debug(err)
+		return &runtimev1pb.SubtleWrapKeyResponse{}, err
+	}
+
+	// Parse the plaintext key
+	// TODO: allow specifying the format of the input key
+	pk, err := kitCrypto.ParseKey(in.GetPlaintextKey(), "")
+	if err != nil {
+		err = messages.ErrBadRequest.WithFormat(fmt.Errorf("failed to parse plaintext key: %w", err))
+		a.logger.Debug(err)
+		return &runtimev1pb.SubtleWrapKeyResponse{}, err
+	}
+
+	policyRunner := resiliency.NewRunner[subtleWrapKeyRes](ctx,
+		a.resiliency.ComponentOutboundPolicy(in.GetComponentName(), resiliency.Crypto),
+	)
+	start := time.Now()
+	swkr, err := policyRunner(func(ctx context.Context) (r subtleWrapKeyRes, rErr error) {
+		r.wrappedKey, r.tag, rErr = component.WrapKey(ctx, pk, in.GetAlgorithm(), in.GetKeyName(), in.GetNonce(), in.GetAssociatedData())
+		return
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.GetComponentName(), diag.CryptoWrapKey, err == nil, elapsed)
+	auditCrypto(ctx, in.GetComponentName(), "wrapKey", in.GetKeyName(), err)
+
+	if err != nil {
+		// We are not going to return the exact error from the component to the user, because an error that is too specific could allow for various side channel attacks (e.g. AES-CBC and padding oracle attacks)
+		// We will log the full error as a debug log, but only return a generic one to the user
+		a.logger.Debugf("Failed to wrap key with key %s: %v", in.GetKeyName(), err)
+		return &runtimev1pb.SubtleWrapKeyResponse{}, messages.ErrCryptoWrapKey.WithFormat(in.GetKeyName())
+	}
+
+	return &runtimev1pb.SubtleWrapKeyResponse{
+		WrappedKey: swkr.wrappedKey,
+		Tag:        swkr.tag,
+	}, nil
+}
+
+// SubtleUnwrapKeyAlpha1 unwraps a key using a key stored in the vault.
+func (a *Universal) SubtleUnwrapKeyAlpha1(ctx context.Context, in *runtimev1pb.SubtleUnwrapKeyRequest) (*runtimev1pb.SubtleUnwrapKeyResponse, error) {
+	component, err := a.CryptoValidateRequest(in.GetComponentName())
+	if err != nil {
+		return &runtimev1pb.SubtleUnwrapKeyResponse{}, err
+	}
+	if err = a.cryptoValidateKeyName(in.GetKeyName()); err != nil {
+		return &runtimev1pb.SubtleUnwrapKeyResponse{}, err
+	}
+	if len(in.GetWrappedKey()) == 0 {
+		err = messages.ErrBadRequest.WithFormat("missing wrapped key")
+		a.logger.Debug(err)
+		return &runtimev1pb.SubtleUnwrapKeyResponse{}, err
+	}
+
+	policyRunner := resiliency.NewRunner[jwk.Key](ctx,
+		a.resiliency.ComponentOutboundPolicy(in.GetComponentName(), resiliency.Crypto),
+	)
+	start := time.Now()
+	plaintextKey, err := policyRunner(func(ctx context.Context) (jwk.Key, error) {
+		return component.UnwrapKey(ctx, in.GetWrappedKey(), in.GetAlgorithm(), in.GetKeyName(), in.GetNonce(), in.GetTag(), in.GetAssociatedData())
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.GetComponentName(), diag.CryptoUnwrapKey, err == nil, elapsed)
+	auditCrypto(ctx, in.GetComponentName(), "unwrapKey", in.GetKeyName(), err)
+
+	if err != nil {
+		// See SubtleWrapKeyAlpha1 for why the error of the component is not returned to the user
+		a.logger.Debugf("Failed to unwrap key with key %s: %v", in.GetKeyName(), err)
+		return &runtimev1pb.SubtleUnwrapKeyResponse{}, messages.ErrCryptoUnwrapKey.WithFormat(in.GetKeyName())
+	}
+
+	// Serialize the key
+	// TODO: Allow specifying the format to get a key as JSON or PEM
+	enc, err := kitCrypto.SerializeKey(plaintextKey)
+	if err != nil {
+		a.logger.Debugf("Failed to serialize key unwrapped with key %s: %v", in.GetKeyName(), err)
+		return &runtimev1pb.SubtleUnwrapKeyResponse{}, messages.ErrCryptoUnwrapKey.WithFormat(in.GetKeyName())
+	}
+
+	return &runtimev1pb.SubtleUnwrapKeyResponse{
+		PlaintextKey: enc,
+	}, nil
+}
+
+// SubtleSignAlpha1 signs a message using a key stored in the vault.
+func (a *Universal) SubtleSignAlpha1(ctx context.Context, in *runtimev1pb.SubtleSignRequest) (*runtimev1pb.SubtleSignResponse, error) {
+	component, err := a.CryptoValidateRequest(in.GetComponentName())
+	if err != nil {
+		return &runtimev1pb.SubtleSignResponse{}, err
+	}
+	if err = a.cryptoValidateKeyName(in.GetKeyName()); err != nil {
+		return &runtimev1pb.SubtleSignResponse{}, err
+	}
+	if len(in.GetDigest()) == 0 {
+		err = messages.ErrBadRequest.WithFormat("missing digest")
+		a.logger.Debug(err)
+		return &runtimev1pb.SubtleSignResponse{}, err
+	}
+
+	policyRunner := resiliency.NewRunner[[]byte](ctx,
+		a.resiliency.ComponentOutboundPolicy(in.GetComponentName(), resiliency.Crypto),
+	)
+	start := time.Now()
+	sig, err := policyRunner(func(ctx context.Context) ([]byte, error) {
+		return component.Sign(ctx, in.GetDigest(), in.GetAlgorithm(), in.GetKeyName())
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.GetComponentName(), diag.CryptoSign, err == nil, elapsed)
+	auditCrypto(ctx, in.GetComponentName(), "sign", in.GetKeyName(), err)
+
+	if err != nil {
+		// See SubtleWrapKeyAlpha1 for why the error of the component is not returned to the user
+		a.logger.Debugf("Failed to sign with key %s: %v", in.GetKeyName(), err)
+		return &runtimev1pb.SubtleSignResponse{}, messages.ErrCryptoSign.WithFormat(in.GetKeyName())
+	}
+
+	return &runtimev1pb.SubtleSignResponse{
+		Signature: sig,
+	}, nil
+}
+
+// SubtleVerifyAlpha1 verifies the signature of a message using a key stored in the vault.
+func (a *Universal) SubtleVerifyAlpha1(ctx context.Context, in *runtimev1pb.SubtleVerifyRequest) (*runtimev1pb.SubtleVerifyResponse, error) {
+	component, err := a.CryptoValidateRequest(in.GetComponentName())
+	if err != nil {
+		return &runtimev1pb.SubtleVerifyResponse{}, err
+	}
+	if err = a.cryptoValidateKeyName(in.GetKeyName()); err != nil {
+		return &runtimev1pb.SubtleVerifyResponse{}, err
+	}
+	if len(in.GetDigest()) == 0 || len(in.GetSignature()) == 0 {
+		err = messages.ErrBadRequest.WithFormat("missing digest or signature")
+		a.logger.Debug(err)
+		return &runtimev1pb.SubtleVerifyResponse{}, err
+	}
+
+	policyRunner := resiliency.NewRunner[bool](ctx,
+		a.resiliency.ComponentOutboundPolicy(in.GetComponentName(), resiliency.Crypto),
+	)
+	start := time.Now()
+	valid, err := policyRunner(func(ctx context.Context) (bool, error) {
+		return component.Verify(ctx, in.GetDigest(), in.GetSignature(), in.GetAlgorithm(), in.GetKeyName())
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.GetComponentName(), diag.CryptoVerify, err == nil, elapsed)
+	auditCrypto(ctx, in.GetComponentName(), "verify", in.GetKeyName(), err)
+
+	if err != nil {
+		// See SubtleWrapKeyAlpha1 for why the error of the component is not returned to the user
+		a.logger.Debugf("Failed to verify signature with key %s: %v", in.GetKeyName(), err)
+		return &runtimev1pb.SubtleVerifyResponse{}, messages.ErrCryptoVerify.WithFormat(in.GetKeyName())
+	}
+
+	return &runtimev1pb.SubtleVerifyResponse{
+		Valid: valid,
+	}, nil
+}
+
+func (a *Universal) cryptoValidateKeyName(keyName string) error {
+	if keyName == "" {
+		err := messages.ErrBadRequest.WithFormat("missing key name")
+		a.logger.Debug(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+var oneHundredTwentyEightBits = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+func TestSubtleWrapKeyAlpha1(t *testing.T) {
+	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
+	compStore := compstore.New()
+	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	t.Run("wrap key", func(t *testing.T) {
+		res, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
+			ComponentName: "myvault",
+			PlaintextKey:  []byte("hello world"),
+			KeyName:       "good-tag",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, oneHundredTwentyEightBits, res.GetWrappedKey())
+		assert.Len(t, res.GetTag(), 16)
+	})
+
+	t.Run("no provider configured", func(t *testing.T) {
+		fakeAPI.compStore.DeleteCryptoProvider("myvault")
+		defer func() {
+			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+		}()
+
+		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
+	})
+
+	t.Run("provider not found", func(t *testing.T) {
+		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
+			ComponentName: "notfound",
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
+	})
+
+	t.Run("missing key name", func(t *testing.T) {
+		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
+			ComponentName: "myvault",
+			PlaintextKey:  oneHundredTwentyEightBits,
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+		require.ErrorContains(t, err, "missing key name")
+	})
+
+	t.Run("key is empty", func(t *testing.T) {
+		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
+			ComponentName: "myvault",
+			KeyName:       "error",
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+		require.ErrorContains(t, err, "missing plaintext key")
+	})
+
+	t.Run("failed to wrap key", func(t *testing.T) {
+		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
+			ComponentName: "myvault",
+			KeyName:       "error",
+			PlaintextKey:  oneHundredTwentyEightBits,
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoWrapKey)
+		// The actual error is not returned to the user for security reasons
+		require.EqualError(t, err, "api error: code = Internal desc = failed to wrap key with key error")
+	})
+}
+
+func TestSubtleUnwrapKeyAlpha1(t *testing.T) {
+	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
+	compStore := compstore.New()
+	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	t.Run("unwrap key", func(t *testing.T) {
+		res, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{
+			ComponentName: "myvault",
+			WrappedKey:    []byte("hello world"),
+			KeyName:       "good",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		// Message isn't actually encrypted
+		assert.Equal(t, oneHundredTwentyEightBits, res.GetPlaintextKey())
+	})
+
+	t.Run("no provider configured", func(t *testing.T) {
+		fakeAPI.compStore.DeleteCryptoProvider("myvault")
+		defer func() {
+			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+		}()
+
+		_, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
+	})
+
+	t.Run("provider not found", func(t *testing.T) {
+		_, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{
+			ComponentName: "notfound",
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
+	})
+
+	t.Run("failed to unwrap key", func(t *testing.T) {
+		_, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{
+			ComponentName: "myvault",
+			KeyName:       "error",
+			WrappedKey:    oneHundredTwentyEightBits,
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoUnwrapKey)
+		// The actual error is not returned to the user for security reasons
+		require.EqualError(t, err, "api error: code = Internal desc = failed to unwrap key with key error")
+	})
+}
+
+func TestSubtleSignAlpha1(t *testing.T) {
+	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
+	compStore := compstore.New()
+	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	t.Run("sign message", func(t *testing.T) {
+		res, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{
+			ComponentName: "myvault",
+			Digest:        []byte("hello world"),
+			KeyName:       "good",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		// Message isn't actually signed
+		assert.Equal(t, oneHundredTwentyEightBits, res.GetSignature())
+	})
+
+	t.Run("no provider configured", func(t *testing.T) {
+		fakeAPI.compStore.DeleteCryptoProvider("myvault")
+		defer func() {
+			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+		}()
+
+		_, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
+	})
+
+	t.Run("provider not found", func(t *testing.T) {
+		_, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{
+			ComponentName: "notfound",
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
+	})
+
+	t.Run("missing digest", func(t *testing.T) {
+		_, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{
+			ComponentName: "myvault",
+			KeyName:       "good",
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+	})
+
+	t.Run("failed to sign", func(t *testing.T) {
+		_, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{
+			ComponentName: "myvault",
+			KeyName:       "error",
+			Digest:        oneHundredTwentyEightBits,
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoSign)
+		// The actual error is not returned to the user for security reasons
+		require.EqualError(t, err, "api error: code = Internal desc = failed to sign with key error")
+	})
+}
+
+func TestSubtleVerifyAlpha1(t *testing.T) {
+	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
+	compStore := compstore.New()
+	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+	fakeAPI := &Universal{
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	t.Run("signature is valid", func(t *testing.T) {
+		res, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
+			ComponentName: "myvault",
+			Digest:        oneHundredTwentyEightBits,
+			Signature:     oneHundredTwentyEightBits,
+			KeyName:       "good",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.True(t, res.GetValid())
+	})
+
+	t.Run("signature is invalid", func(t *testing.T) {
+		res, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
+			ComponentName: "myvault",
+			Digest:        oneHundredTwentyEightBits,
+			Signature:     oneHundredTwentyEightBits,
+			KeyName:       "bad",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.False(t, res.GetValid())
+	})
+
+	t.Run("no provider configured", func(t *testing.T) {
+		fakeAPI.compStore.DeleteCryptoProvider("myvault")
+		defer func() {
+			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
+		}()
+
+		_, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
+	})
+
+	t.Run("provider not found", func(t *testing.T) {
+		_, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
+			ComponentName: "notfound",
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		_, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "good",
+			Digest:        oneHundredTwentyEightBits,
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrBadRequest)
+	})
+
+	t.Run("failed to verify", func(t *testing.T) {
+		_, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
+			ComponentName: "myvault",
+			KeyName:       "error",
+			Digest:        oneHundredTwentyEightBits,
+			Signature:     oneHundredTwentyEightBits,
+		})
+		require.Error(t, err)
+		require.ErrorIs(t, err, messages.ErrCryptoVerify)
+		// The actual error is not returned to the user for security reasons
+		require.EqualError(t, err, "api error: code = Internal desc = failed to verify signature with key error")
+	})
+}
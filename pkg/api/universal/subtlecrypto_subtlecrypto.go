@@ -33,7 +33,6 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
-	kitCrypto "github.com/dapr/kit/crypto"
 )
 
 // TODO: Remove this when the build tag is removed
@@ -70,6 +69,7 @@ func (a *Universal) SubtleGetKeyAlpha1(ctx context.Context, in *runtimev1pb.Subt
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.Get, err == nil, elapsed)
+	auditCrypto(ctx, in.GetComponentName(), "getKey", in.GetName(), err)
 
 	if err != nil {
 		err = messages.ErrCryptoGetKey.WithFormat(in.Name, err)
@@ -153,6 +153,7 @@ func (a *Universal) SubtleEncryptAlpha1(ctx context.Context, in *runtimev1pb.Sub
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.CryptoOp, err == nil, elapsed)
+	auditCrypto(ctx, in.GetComponentName(), "encrypt", in.GetKeyName(), err)
 
 	if err != nil {
 		// We are not going to return the exact error from the component to the user, because an error that is too specific could allow for various side channel attacks (e.g. AES-CBC and padding oracle attacks)
@@ -186,6 +187,7 @@ func (a *Universal) SubtleDecryptAlpha1(ctx context.Context, in *runtimev1pb.Sub
 	elapsed := diag.ElapsedSince(start)
 
 	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.CryptoOp, err == nil, elapsed)
+	auditCrypto(ctx, in.GetComponentName(), "decrypt", in.GetKeyName(), err)
 
 	if err != nil {
 		// We are not going to return the exact error from the component to the user, because an error that is too specific could allow for various side channel attacks (e.g. AES-CBC and padding oracle attacks)
@@ -201,156 +203,6 @@ func (a *Universal) SubtleDecryptAlpha1(ctx context.Context, in *runtimev1pb.Sub
 	}, nil
 }
 
-// SubtleWrapKeyAlpha1 wraps a key using a key stored in the vault.
-func (a *Universal) SubtleWrapKeyAlpha1(ctx context.Context, in *runtimev1pb.SubtleWrapKeyRequest) (*runtimev1pb.SubtleWrapKeyResponse, error) {
-	component, err := a.CryptoValidateRequest(in.ComponentName)
-	if err != nil {
-		return &runtimev1pb.SubtleWrapKeyResponse{}, err
-	}
-
-	// Parse the plaintext key
-	// TODO: allow specifying the format of the input key
-	pk, err := kitCrypto.ParseKey(in.PlaintextKey, "")
-	if err != nil {
-		err = fmt.Errorf("failed to parse plaintext key: %w", err)
-		err = messages.ErrCryptoOperation.WithFormat(err)
-		a.logger.Debug(err)
-		return &runtimev1pb.SubtleWrapKeyResponse{}, err
-	}
-
-	policyRunner := resiliency.NewRunner[subtleWrapKeyRes](ctx,
-		a.resiliency.ComponentOutboundPolicy(in.ComponentName, resiliency.Crypto),
-	)
-	start := time.Now()
-	swkr, err := policyRunner(func(ctx context.Context) (r subtleWrapKeyRes, rErr error) {
-		r.wrappedKey, r.tag, rErr = component.WrapKey(ctx, pk, in.Algorithm, in.KeyName, in.Nonce, in.AssociatedData)
-		return
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.CryptoOp, err == nil, elapsed)
-
-	if err != nil {
-		// We are not going to return the exact error from the component to the user, because an error that is too specific could allow for various side channel attacks (e.g. AES-CBC and padding oracle attacks)
-		// We will log the full error as a debug log, but only return a generic one to the user
-		apiError := messages.ErrCryptoOperation
-		a.logger.Debug(apiError.WithFormat(err))
-		err = apiError.WithFormat("failed to wrap key")
-		return &runtimev1pb.SubtleWrapKeyResponse{}, err
-	}
-
-	return &runtimev1pb.SubtleWrapKeyResponse{
-		WrappedKey: swkr.wrappedKey,
-		Tag:        swkr.tag,
-	}, nil
-}
-
-// SubtleUnwrapKeyAlpha1 unwraps a key using a key stored in the vault.
-func (a *Universal) SubtleUnwrapKeyAlpha1(ctx context.Context, in *runtimev1pb.SubtleUnwrapKeyRequest) (*runtimev1pb.SubtleUnwrapKeyResponse, error) {
-	component, err := a.CryptoValidateRequest(in.ComponentName)
-	if err != nil {
-		return &runtimev1pb.SubtleUnwrapKeyResponse{}, err
-	}
-
-	policyRunner := resiliency.NewRunner[jwk.Key](ctx,
-		a.resiliency.ComponentOutboundPolicy(in.ComponentName, resiliency.Crypto),
-	)
-	start := time.Now()
-	plaintextText, err := policyRunner(func(ctx context.Context) (jwk.Key, error) {
-		return component.UnwrapKey(ctx, in.WrappedKey, in.Algorithm, in.KeyName, in.Nonce, in.Tag, in.AssociatedData)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.CryptoOp, err == nil, elapsed)
-
-	if err != nil {
-		// We are not going to return the exact error from the component to the user, because an error that is too specific could allow for various side channel attacks (e.g. AES-CBC and padding oracle attacks)
-		// We will log the full error as a debug log, but only return a generic one to the user
-		apiError := messages.ErrCryptoOperation
-		a.logger.Debug(apiError.WithFormat(err))
-		err = apiError.WithFormat("failed to unwrap key")
-		return &runtimev1pb.SubtleUnwrapKeyResponse{}, err
-	}
-
-	// Serialize the key
-	// TODO: Allow specifying the format to get a key as JSON or PEM
-	enc, err := kitCrypto.SerializeKey(plaintextText)
-	if err != nil {
-		err = fmt.Errorf("failed to serialize unwrapped key: %w", err)
-		err = messages.ErrCryptoOperation.WithFormat(err)
-		a.logger.Debug(err)
-		return &runtimev1pb.SubtleUnwrapKeyResponse{}, err
-	}
-
-	return &runtimev1pb.SubtleUnwrapKeyResponse{
-		PlaintextKey: enc,
-	}, nil
-}
-
-// SubtleSignAlpha1 signs a message using a key stored in the vault.
-func (a *Universal) SubtleSignAlpha1(ctx context.Context, in *runtimev1pb.SubtleSignRequest) (*runtimev1pb.SubtleSignResponse, error) {
-	component, err := a.CryptoValidateRequest(in.ComponentName)
-	if err != nil {
-		return &runtimev1pb.SubtleSignResponse{}, err
-	}
-
-	policyRunner := resiliency.NewRunner[[]byte](ctx,
-		a.resiliency.ComponentOutboundPolicy(in.ComponentName, resiliency.Crypto),
-	)
-	start := time.Now()
-	sig, err := policyRunner(func(ctx context.Context) ([]byte, error) {
-		return component.Sign(ctx, in.Digest, in.Algorithm, in.KeyName)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.CryptoOp, err == nil, elapsed)
-
-	if err != nil {
-		// We are not going to return the exact error from the component to the user, because an error that is too specific could allow for various side channel attacks (e.g. AES-CBC and padding oracle attacks)
-		// We will log the full error as a debug log, but only return a generic one to the user
-		apiError := messages.ErrCryptoOperation
-		a.logger.Debug(apiError.WithFormat(err))
-		err = apiError.WithFormat("failed to sign")
-		return &runtimev1pb.SubtleSignResponse{}, err
-	}
-
-	return &runtimev1pb.SubtleSignResponse{
-		Signature: sig,
-	}, nil
-}
-
-// SubtleVerifyAlpha1 verifies the signature of a message using a key stored in the vault.
-func (a *Universal) SubtleVerifyAlpha1(ctx context.Context, in *runtimev1pb.SubtleVerifyRequest) (*runtimev1pb.SubtleVerifyResponse, error) {
-	component, err := a.CryptoValidateRequest(in.ComponentName)
-	if err != nil {
-		return &runtimev1pb.SubtleVerifyResponse{}, err
-	}
-
-	policyRunner := resiliency.NewRunner[bool](ctx,
-		a.resiliency.ComponentOutboundPolicy(in.ComponentName, resiliency.Crypto),
-	)
-	start := time.Now()
-	valid, err := policyRunner(func(ctx context.Context) (bool, error) {
-		return component.Verify(ctx, in.Digest, in.Signature, in.Algorithm, in.KeyName)
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.CryptoInvoked(ctx, in.ComponentName, diag.CryptoOp, err == nil, elapsed)
-
-	if err != nil {
-		// We are not going to return the exact error from the component to the user, because an error that is too specific could allow for various side channel attacks (e.g. AES-CBC and padding oracle attacks)
-		// We will log the full error as a debug log, but only return a generic one to the user
-		apiError := messages.ErrCryptoOperation
-		a.logger.Debug(apiError.WithFormat(err))
-		err = apiError.WithFormat("failed to verify signature")
-		return &runtimev1pb.SubtleVerifyResponse{}, err
-	}
-
-	return &runtimev1pb.SubtleVerifyResponse{
-		Valid: valid,
-	}, nil
-}
-
 // CryptoValidateRequest is an internal method that checks if the request is for a valid crypto component.
 func (a *Universal) CryptoValidateRequest(componentName string) (contribCrypto.SubtleCrypto, error) {
 	if a.compStore.CryptoProvidersLen() == 0 {
@@ -31,8 +31,6 @@ import (
 	daprt "github.com/dapr/dapr/pkg/testing"
 )
 
-var oneHundredTwentyEightBits = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
-
 func TestSubtleGetKeyAlpha1(t *testing.T) {
 	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
 	compStore := compstore.New()
@@ -247,243 +245,6 @@ func TestSubtleDecryptAlpha1(t *testing.T) {
 	})
 }
 
-func TestSubtleWrapKeyAlpha1(t *testing.T) {
-	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
-	compStore := compstore.New()
-	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-	fakeAPI := &Universal{
-		logger:     testLogger,
-		resiliency: resiliency.New(nil),
-		compStore:  compStore,
-	}
-
-	t.Run("wrap key", func(t *testing.T) {
-		res, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
-			ComponentName: "myvault",
-			PlaintextKey:  []byte("hello world"),
-			KeyName:       "good-tag",
-		})
-		require.NoError(t, err)
-		require.NotNil(t, res)
-		assert.Equal(t, oneHundredTwentyEightBits, res.WrappedKey)
-		assert.Len(t, res.Tag, 16)
-	})
-
-	t.Run("no provider configured", func(t *testing.T) {
-		fakeAPI.compStore.DeleteCryptoProvider("myvault")
-		defer func() {
-			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-		}()
-
-		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
-	})
-
-	t.Run("provider not found", func(t *testing.T) {
-		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
-			ComponentName: "notfound",
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
-	})
-
-	t.Run("key is empty", func(t *testing.T) {
-		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
-			ComponentName: "myvault",
-			KeyName:       "error",
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoOperation)
-		require.ErrorContains(t, err, "key is empty")
-	})
-
-	t.Run("failed to wrap key", func(t *testing.T) {
-		_, err := fakeAPI.SubtleWrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleWrapKeyRequest{
-			ComponentName: "myvault",
-			KeyName:       "error",
-			PlaintextKey:  oneHundredTwentyEightBits,
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoOperation)
-		// The actual error is not returned to the user for security reasons
-		require.ErrorContains(t, err, "failed to wrap key")
-	})
-}
-
-func TestSubtleUnwrapKeyAlpha1(t *testing.T) {
-	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
-	compStore := compstore.New()
-	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-	fakeAPI := &Universal{
-		logger:     testLogger,
-		resiliency: resiliency.New(nil),
-		compStore:  compStore,
-	}
-
-	t.Run("unwrap key", func(t *testing.T) {
-		res, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{
-			ComponentName: "myvault",
-			WrappedKey:    []byte("hello world"),
-			KeyName:       "good",
-		})
-		require.NoError(t, err)
-		require.NotNil(t, res)
-		// Message isn't actually encrypted
-		assert.Equal(t, oneHundredTwentyEightBits, res.PlaintextKey)
-	})
-
-	t.Run("no provider configured", func(t *testing.T) {
-		fakeAPI.compStore.DeleteCryptoProvider("myvault")
-		defer func() {
-			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-		}()
-
-		_, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
-	})
-
-	t.Run("provider not found", func(t *testing.T) {
-		_, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{
-			ComponentName: "notfound",
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
-	})
-
-	t.Run("failed to unwrap key", func(t *testing.T) {
-		_, err := fakeAPI.SubtleUnwrapKeyAlpha1(t.Context(), &runtimev1pb.SubtleUnwrapKeyRequest{
-			ComponentName: "myvault",
-			KeyName:       "error",
-			WrappedKey:    oneHundredTwentyEightBits,
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoOperation)
-		// The actual error is not returned to the user for security reasons
-		require.ErrorContains(t, err, "failed to unwrap key")
-	})
-}
-
-func TestSubtleSignAlpha1(t *testing.T) {
-	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
-	compStore := compstore.New()
-	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-	fakeAPI := &Universal{
-		logger:     testLogger,
-		resiliency: resiliency.New(nil),
-		compStore:  compStore,
-	}
-
-	t.Run("sign message", func(t *testing.T) {
-		res, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{
-			ComponentName: "myvault",
-			Digest:        []byte("hello world"),
-			KeyName:       "good",
-		})
-		require.NoError(t, err)
-		require.NotNil(t, res)
-		// Message isn't actually signed
-		assert.Equal(t, oneHundredTwentyEightBits, res.Signature)
-	})
-
-	t.Run("no provider configured", func(t *testing.T) {
-		fakeAPI.compStore.DeleteCryptoProvider("myvault")
-		defer func() {
-			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-		}()
-
-		_, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
-	})
-
-	t.Run("provider not found", func(t *testing.T) {
-		_, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{
-			ComponentName: "notfound",
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
-	})
-
-	t.Run("failed to sign", func(t *testing.T) {
-		_, err := fakeAPI.SubtleSignAlpha1(t.Context(), &runtimev1pb.SubtleSignRequest{
-			ComponentName: "myvault",
-			KeyName:       "error",
-			Digest:        oneHundredTwentyEightBits,
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoOperation)
-		// The actual error is not returned to the user for security reasons
-		require.ErrorContains(t, err, "failed to sign")
-	})
-}
-
-func TestSubtleVerifyAlpha1(t *testing.T) {
-	fakeCryptoProvider := &daprt.FakeSubtleCrypto{}
-	compStore := compstore.New()
-	compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-	fakeAPI := &Universal{
-		logger:     testLogger,
-		resiliency: resiliency.New(nil),
-		compStore:  compStore,
-	}
-
-	t.Run("signature is valid", func(t *testing.T) {
-		res, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
-			ComponentName: "myvault",
-			Digest:        oneHundredTwentyEightBits,
-			Signature:     oneHundredTwentyEightBits,
-			KeyName:       "good",
-		})
-		require.NoError(t, err)
-		require.NotNil(t, res)
-		assert.True(t, res.Valid)
-	})
-
-	t.Run("signature is invalid", func(t *testing.T) {
-		res, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
-			ComponentName: "myvault",
-			Digest:        oneHundredTwentyEightBits,
-			Signature:     oneHundredTwentyEightBits,
-			KeyName:       "bad",
-		})
-		require.NoError(t, err)
-		require.NotNil(t, res)
-		assert.False(t, res.Valid)
-	})
-
-	t.Run("no provider configured", func(t *testing.T) {
-		fakeAPI.compStore.DeleteCryptoProvider("myvault")
-		defer func() {
-			compStore.AddCryptoProvider("myvault", fakeCryptoProvider)
-		}()
-
-		_, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProvidersNotConfigured)
-	})
-
-	t.Run("provider not found", func(t *testing.T) {
-		_, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
-			ComponentName: "notfound",
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoProviderNotFound)
-	})
-
-	t.Run("failed to verify", func(t *testing.T) {
-		_, err := fakeAPI.SubtleVerifyAlpha1(t.Context(), &runtimev1pb.SubtleVerifyRequest{
-			ComponentName: "myvault",
-			KeyName:       "error",
-		})
-		require.Error(t, err)
-		require.ErrorIs(t, err, messages.ErrCryptoOperation)
-		// The actual error is not returned to the user for security reasons
-		require.ErrorContains(t, err, "failed to verify")
-	})
-}
-
 func compareSHA256Hash(t *testing.T, data []byte, expect string) {
 	t.Helper()
 
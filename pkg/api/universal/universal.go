@@ -26,6 +26,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/state"
 	"github.com/dapr/dapr/pkg/actors/timers"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/faults"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
@@ -34,20 +35,35 @@ import (
 )
 
 type Options struct {
-	AppID                       string
-	Namespace                   string
-	Logger                      logger.Logger
-	Resiliency                  resiliency.Provider
-	CompStore                   *compstore.ComponentStore
-	ShutdownFn                  func()
+	AppID      string
+	Namespace  string
+	Logger     logger.Logger
+	Resiliency resiliency.Provider
+	CompStore  *compstore.ComponentStore
+	ShutdownFn func()
+	// ReloadFn reloads the configuration of the sidecar. Nil makes the reload
+	// API fail as unsupported.
+	ReloadFn                    func(context.Context) (compstore.ConfigurationReload, error)
 	ExitFn                      func(int)
 	GetComponentsCapabilitiesFn func() map[string][]string
 	ExtendedMetadata            map[string]string
 	AppConnectionConfig         config.AppConnectionConfig
 	GlobalConfig                *config.Configuration
-	Scheduler                   schedclient.Interface
-	Actors                      actors.Interface
-	WorkflowEngine              wfengine.Interface
+	// Configurations are the names of the Configuration resources, or the
+	// paths of the configuration files, merged into GlobalConfig.
+	Configurations []string
+	Scheduler      schedclient.Interface
+	Actors         actors.Interface
+	WorkflowEngine wfengine.Interface
+	// Subscriptions pauses and resumes the app subscriptions. Nil makes the
+	// subscription APIs unimplemented.
+	Subscriptions Subscriptions
+	// InputBindings pauses and resumes the input bindings. Nil makes the
+	// input binding APIs unimplemented.
+	InputBindings InputBindings
+	// FaultInjector injects faults in the calls to the targets. Nil disables
+	// the fault injection APIs.
+	FaultInjector *faults.Injector
 }
 
 // Universal contains the implementation of gRPC APIs that are also used by the HTTP server.
@@ -58,16 +74,23 @@ type Universal struct {
 	resiliency                  resiliency.Provider
 	compStore                   *compstore.ComponentStore
 	shutdownFn                  func()
+	reloadFn                    func(context.Context) (compstore.ConfigurationReload, error)
 	exitFn                      func(int)
 	getComponentsCapabilitiesFn func() map[string][]string
 	extendedMetadata            map[string]string
 	appConnectionConfig         config.AppConnectionConfig
 	globalConfig                *config.Configuration
+	configurations              []string
 	workflowEngine              wfengine.Interface
 	scheduler                   schedclient.Interface
+	subscriptions               Subscriptions
+	inputBindings               InputBindings
+	faultInjector               *faults.Injector
 
 	extendedMetadataLock sync.RWMutex
 	actors               actors.Interface
+	stateMigrations      stateMigrations
+	lockWaiters          lockWaiters
 }
 
 func New(opts Options) *Universal {
@@ -82,14 +105,19 @@ func New(opts Options) *Universal {
 		resiliency:                  opts.Resiliency,
 		compStore:                   opts.CompStore,
 		shutdownFn:                  opts.ShutdownFn,
+		reloadFn:                    opts.ReloadFn,
 		exitFn:                      exitFn,
 		getComponentsCapabilitiesFn: opts.GetComponentsCapabilitiesFn,
 		extendedMetadata:            opts.ExtendedMetadata,
 		appConnectionConfig:         opts.AppConnectionConfig,
 		globalConfig:                opts.GlobalConfig,
+		configurations:              opts.Configurations,
 		scheduler:                   opts.Scheduler,
 		actors:                      opts.Actors,
 		workflowEngine:              opts.WorkflowEngine,
+		subscriptions:               opts.Subscriptions,
+		inputBindings:               opts.InputBindings,
+		faultInjector:               opts.FaultInjector,
 	}
 }
 
@@ -113,6 +141,15 @@ func (a *Universal) AppConnectionConfig() config.AppConnectionConfig {
 	return a.appConnectionConfig
 }
 
+// InvocationLimits returns the size limits of the service invocation calls to
+// the app.
+func (a *Universal) InvocationLimits(appID string) config.InvocationLimits {
+	if a.globalConfig == nil {
+		return config.InvocationLimits{}
+	}
+	return a.globalConfig.GetInvocationLimits(appID)
+}
+
 // Actors returns the actor runtime. Callers (notably the gRPC API
 // handler for SubscribeActorEventsAlpha1) use it to drive
 // RegisterHosted/UnRegisterHosted imperatively from the stream
@@ -19,6 +19,8 @@ import (
 	"unicode"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -27,6 +29,7 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/durabletask-go/api"
 )
 
@@ -64,9 +67,163 @@ func (a *Universal) GetWorkflow(ctx context.Context, in *runtimev1pb.GetWorkflow
 		RuntimeStatus: response.Workflow.RuntimeStatus,
 		Properties:    response.Workflow.Properties,
 	}
+
+	if lister, ok := a.workflowEngine.Client().(wfengine.InterventionLister); ok {
+		interventions, err := lister.Interventions(ctx, in.GetInstanceId())
+		if err != nil {
+			err = messages.ErrWorkflowGetResponse.WithFormat(in.GetInstanceId(), err)
+			a.logger.Debug(err)
+			return &runtimev1pb.GetWorkflowResponse{
+				InstanceId: in.GetInstanceId(),
+			}, err
+		}
+		for _, i := range interventions {
+			res.Interventions = append(res.Interventions, &runtimev1pb.WorkflowIntervention{
+				Type:      i.Type,
+				Reason:    i.Reason,
+				Actor:     i.Actor,
+				Timestamp: timestamppb.New(i.Timestamp),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// ListWorkflowInstances is the API handler for listing workflow instances
+func (a *Universal) ListWorkflowInstances(ctx context.Context, in *runtimev1pb.ListWorkflowInstancesRequest) (*runtimev1pb.ListWorkflowInstancesResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+
+	lister, ok := a.workflowEngine.Client().(wfengine.InstanceLister)
+	if !ok {
+		err := messages.ErrWorkflowList.WithFormat("workflow client does not support listing instances")
+		a.logger.Debug(err)
+		return &runtimev1pb.ListWorkflowInstancesResponse{}, err
+	}
+
+	req := wfengine.ListRequest{
+		RuntimeStatuses:   in.GetRuntimeStatuses(),
+		WorkflowName:      in.WorkflowName,
+		PageSize:          in.PageSize,
+		ContinuationToken: in.ContinuationToken,
+	}
+	if in.GetCreatedAfter() != nil {
+		req.CreatedAfter = new(in.GetCreatedAfter().AsTime())
+	}
+	if in.GetCreatedBefore() != nil {
+		req.CreatedBefore = new(in.GetCreatedBefore().AsTime())
+	}
+
+	response, err := lister.List(ctx, &req)
+	if err != nil {
+		err = messages.ErrWorkflowList.WithFormat(err)
+		a.logger.Debug(err)
+		return &runtimev1pb.ListWorkflowInstancesResponse{}, err
+	}
+
+	res := &runtimev1pb.ListWorkflowInstancesResponse{
+		Instances:         make([]*runtimev1pb.GetWorkflowResponse, 0, len(response.Workflows)),
+		ContinuationToken: response.ContinuationToken,
+	}
+	for _, wf := range response.Workflows {
+		res.Instances = append(res.Instances, &runtimev1pb.GetWorkflowResponse{
+			InstanceId:    wf.InstanceID,
+			WorkflowName:  wf.WorkflowName,
+			CreatedAt:     timestamppb.New(wf.CreatedAt),
+			LastUpdatedAt: timestamppb.New(wf.LastUpdatedAt),
+			RuntimeStatus: wf.RuntimeStatus,
+			Properties:    wf.Properties,
+		})
+	}
+	return res, nil
+}
+
+// GetWorkflowHistory is the API handler for exporting the history of a workflow instance
+func (a *Universal) GetWorkflowHistory(ctx context.Context, in *runtimev1pb.GetWorkflowHistoryRequest) (*runtimev1pb.GetWorkflowHistoryResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return &runtimev1pb.GetWorkflowHistoryResponse{}, err
+	}
+
+	exporter, ok := a.workflowEngine.Client().(wfengine.HistoryExporter)
+	if !ok {
+		err := messages.ErrWorkflowHistory.WithFormat(in.GetInstanceId(), "workflow client does not support exporting history")
+		a.logger.Debug(err)
+		return &runtimev1pb.GetWorkflowHistoryResponse{}, err
+	}
+
+	events, err := exporter.History(ctx, in.GetInstanceId())
+	if err != nil {
+		err = workflowInstanceError(messages.ErrWorkflowHistory, in.GetInstanceId(), err)
+		a.logger.Debug(err)
+		return &runtimev1pb.GetWorkflowHistoryResponse{}, err
+	}
+
+	res := &runtimev1pb.GetWorkflowHistoryResponse{
+		Events: make([]*runtimev1pb.WorkflowHistoryEvent, 0, len(events)),
+	}
+	for _, e := range events {
+		res.Events = append(res.Events, &runtimev1pb.WorkflowHistoryEvent{
+			EventId:     e.EventID,
+			EventType:   e.EventType,
+			Timestamp:   timestamppb.New(e.Timestamp),
+			Name:        e.Name,
+			PayloadHash: e.PayloadHash,
+			Event:       e.Event,
+		})
+	}
 	return res, nil
 }
 
+// ReplayWorkflow is the API handler for replaying a completed workflow instance
+// against the current workflow code
+func (a *Universal) ReplayWorkflow(ctx context.Context, in *runtimev1pb.ReplayWorkflowRequest) (*runtimev1pb.ReplayWorkflowResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return &runtimev1pb.ReplayWorkflowResponse{}, err
+	}
+
+	replayer, ok := a.workflowEngine.Client().(wfengine.Replayer)
+	if !ok {
+		err := messages.ErrWorkflowReplay.WithFormat(in.GetInstanceId(), "workflow client does not support replaying instances")
+		a.logger.Debug(err)
+		return &runtimev1pb.ReplayWorkflowResponse{}, err
+	}
+
+	response, err := replayer.Replay(ctx, in.GetInstanceId())
+	if err != nil {
+		err = workflowInstanceError(messages.ErrWorkflowReplay, in.GetInstanceId(), err)
+		a.logger.Debug(err)
+		return &runtimev1pb.ReplayWorkflowResponse{}, err
+	}
+
+	return &runtimev1pb.ReplayWorkflowResponse{
+		Deterministic:  len(response.Divergences) == 0,
+		RecordedStatus: response.RecordedStatus,
+		ReplayedStatus: response.ReplayedStatus,
+		RecordedOutput: response.RecordedOutput,
+		ReplayedOutput: response.ReplayedOutput,
+		Divergences:    response.Divergences,
+	}, nil
+}
+
+// workflowInstanceError returns the API error for a failed operation on a
+// workflow instance, or not found if the instance does not exist.
+func workflowInstanceError(apiErr messages.APIError, instanceID string, err error) error {
+	if status.Code(err) == codes.NotFound || errors.Is(err, api.ErrInstanceNotFound) {
+		return messages.ErrWorkflowInstanceNotFound.WithFormat(instanceID)
+	}
+	return apiErr.WithFormat(instanceID, err)
+}
+
 // StartWorkflow is the API handler for starting a workflow
 func (a *Universal) StartWorkflow(ctx context.Context, in *runtimev1pb.StartWorkflowRequest) (*runtimev1pb.StartWorkflowResponse, error) {
 	if _, err := a.ActorRouter(ctx); err != nil {
@@ -128,6 +285,11 @@ func (a *Universal) TerminateWorkflow(ctx context.Context, in *runtimev1pb.Termi
 		return emptyResponse, err
 	}
 
+	ctx = wfengine.WithAudit(ctx, wfengine.Audit{
+		Reason: in.GetReason(),
+		Actor:  in.GetActor(),
+	})
+
 	req := &workflows.TerminateRequest{
 		InstanceID: in.GetInstanceId(),
 		Recursive:  new(true),
@@ -190,6 +352,11 @@ func (a *Universal) PauseWorkflow(ctx context.Context, in *runtimev1pb.PauseWork
 		return emptyResponse, err
 	}
 
+	ctx = wfengine.WithAudit(ctx, wfengine.Audit{
+		Reason: in.GetReason(),
+		Actor:  in.GetActor(),
+	})
+
 	req := &workflows.PauseRequest{
 		InstanceID: in.GetInstanceId(),
 	}
@@ -213,6 +380,11 @@ func (a *Universal) ResumeWorkflow(ctx context.Context, in *runtimev1pb.ResumeWo
 		return emptyResponse, err
 	}
 
+	ctx = wfengine.WithAudit(ctx, wfengine.Audit{
+		Reason: in.GetReason(),
+		Actor:  in.GetActor(),
+	})
+
 	req := &workflows.ResumeRequest{
 		InstanceID: in.GetInstanceId(),
 	}
@@ -259,6 +431,21 @@ func (a *Universal) GetWorkflowBeta1(ctx context.Context, in *runtimev1pb.GetWor
 	return a.GetWorkflow(ctx, in)
 }
 
+// ListWorkflowInstancesBeta1 is the API handler for listing workflow instances
+func (a *Universal) ListWorkflowInstancesBeta1(ctx context.Context, in *runtimev1pb.ListWorkflowInstancesRequest) (*runtimev1pb.ListWorkflowInstancesResponse, error) {
+	return a.ListWorkflowInstances(ctx, in)
+}
+
+// GetWorkflowHistoryBeta1 is the API handler for exporting the history of a workflow instance
+func (a *Universal) GetWorkflowHistoryBeta1(ctx context.Context, in *runtimev1pb.GetWorkflowHistoryRequest) (*runtimev1pb.GetWorkflowHistoryResponse, error) {
+	return a.GetWorkflowHistory(ctx, in)
+}
+
+// ReplayWorkflowBeta1 is the API handler for replaying a completed workflow instance
+func (a *Universal) ReplayWorkflowBeta1(ctx context.Context, in *runtimev1pb.ReplayWorkflowRequest) (*runtimev1pb.ReplayWorkflowResponse, error) {
+	return a.ReplayWorkflow(ctx, in)
+}
+
 // StartWorkflowBeta1 is the API handler for starting a workflow
 func (a *Universal) StartWorkflowBeta1(ctx context.Context, in *runtimev1pb.StartWorkflowRequest) (*runtimev1pb.StartWorkflowResponse, error) {
 	return a.StartWorkflow(ctx, in)
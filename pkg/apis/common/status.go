@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+// +kubebuilder:object:generate=true
+
+// ResourceStatus is the rollout of a resource across the Dapr sidecars, as
+// reported by the sidecars to the operator.
+type ResourceStatus struct {
+	// ObservedGeneration is the generation of the resource the counts are of.
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Sidecars is the number of connected sidecars reporting the resource.
+	Sidecars int32 `json:"sidecars"`
+	// Loaded is the number of sidecars which loaded the observed generation.
+	Loaded int32 `json:"loaded"`
+	// Failed is the number of sidecars which failed to load the observed
+	// generation.
+	Failed int32 `json:"failed"`
+	// Pods are the results of the sidecars of the pods.
+	//+optional
+	//+listType=map
+	//+listMapKey=pod
+	Pods []PodResourceStatus `json:"pods,omitempty"`
+}
+
+// PodResourceStatus is the result of loading a resource in the sidecar of a
+// pod.
+type PodResourceStatus struct {
+	// Pod is the name of the pod.
+	Pod string `json:"pod"`
+	// AppID is the ID of the app of the sidecar.
+	//+optional
+	AppID string `json:"appID,omitempty"`
+	// ObservedGeneration is the generation of the resource the sidecar loaded.
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Loaded is true if the sidecar loaded the resource.
+	Loaded bool `json:"loaded"`
+	// Error is the error of the sidecar loading the resource.
+	//+optional
+	Error string `json:"error,omitempty"`
+	// Operator is the operator replica the sidecar reports to.
+	//+optional
+	Operator string `json:"operator,omitempty"`
+}
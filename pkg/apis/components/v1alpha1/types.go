@@ -30,6 +30,11 @@ const (
 //+genclient
 //+genclient:noStatus
 //+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Sidecars",type=integer,JSONPath=`.status.sidecars`
+//+kubebuilder:printcolumn:name="Loaded",type=integer,JSONPath=`.status.loaded`
+//+kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Component describes an Dapr component type.
 //
@@ -43,6 +48,8 @@ type Component struct {
 	//+optional
 	Auth          `json:"auth,omitempty"`
 	common.Scoped `json:",inline"`
+	//+optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 // Kind returns the component kind.
@@ -96,6 +103,7 @@ func (c Component) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: components.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: c.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
@@ -108,6 +116,15 @@ type ComponentSpec struct {
 	Metadata     []common.NameValuePair `json:"metadata"`
 	//+optional
 	InitTimeout string `json:"initTimeout"`
+	// DrainTimeout is the time given to the operations in flight against the
+	// instance of the component replaced by a hot reload to complete, before
+	// the instance is closed.
+	//+optional
+	DrainTimeout string `json:"drainTimeout"`
+	// InitAfter are the names of the components which are initialized before
+	// the component, such as the secret stores its metadata references.
+	//+optional
+	InitAfter []string `json:"initAfter,omitempty"`
 }
 
 // Auth represents authentication details for the component.
@@ -48,6 +48,7 @@ func (in *Component) DeepCopyInto(out *Component) {
 	in.Spec.DeepCopyInto(&out.Spec)
 	out.Auth = in.Auth
 	in.Scoped.DeepCopyInto(&out.Scoped)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
@@ -110,6 +111,11 @@ func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InitAfter != nil {
+		in, out := &in.InitAfter, &out.InitAfter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSpec.
@@ -107,6 +107,15 @@ type ConfigurationSpec struct {
 	AppHTTPPipelineSpec *PipelineSpec `json:"appHttpPipeline,omitempty"`
 	// +optional
 	HTTPPipelineSpec *PipelineSpec `json:"httpPipeline,omitempty"`
+	// grpcPipeline applies the middleware components to the calls to the
+	// gRPC API and the proxied gRPC invocations.
+	// +optional
+	GRPCPipelineSpec *PipelineSpec `json:"grpcPipeline,omitempty"`
+	// outboundHttpPipeline applies the middleware components to the calls the
+	// sidecar makes to other apps and HTTPEndpoints. Handlers whose selector
+	// has "destination" fields apply only to the calls to those destinations.
+	// +optional
+	OutboundHTTPPipelineSpec *PipelineSpec `json:"outboundHttpPipeline,omitempty"`
 	// +optional
 	TracingSpec *TracingSpec `json:"tracing,omitempty"`
 	// +kubebuilder:default={enabled:true}
@@ -133,6 +142,429 @@ type ConfigurationSpec struct {
 	WasmSpec *WasmSpec `json:"wasm,omitempty"`
 	// +optional
 	WorkflowSpec *WorkflowSpec `json:"workflow,omitempty"`
+	// +optional
+	JobsSpec *JobsSpec `json:"jobs,omitempty"`
+	// +optional
+	ServiceInvocation *ServiceInvocationSpec `json:"serviceInvocation,omitempty"`
+	// +optional
+	AppHealthSpec *AppHealthSpec `json:"appHealth,omitempty"`
+	// +optional
+	RateLimitSpec *RateLimitSpec `json:"rateLimit,omitempty"`
+	// +optional
+	FaultInjectionSpec *FaultInjectionSpec `json:"faultInjection,omitempty"`
+	// +optional
+	BindingRoutingSpec *BindingRoutingSpec `json:"bindingRouting,omitempty"`
+	// +optional
+	BindingsSpec *BindingsSpec `json:"bindings,omitempty"`
+	// +optional
+	AppChannelSpec *AppChannelSpec `json:"appChannel,omitempty"`
+	// injector configures the sidecar injector. It is read from the
+	// configuration of the control plane only.
+	// +optional
+	InjectorSpec *InjectorSpec `json:"injector,omitempty"`
+}
+
+// InjectorSpec defines the configuration of the sidecar injector.
+type InjectorSpec struct {
+	// profiles are the named sets of default annotations of the injected pods.
+	// +optional
+	Profiles []InjectionProfile `json:"profiles,omitempty"`
+	// canary injects a new daprd image in a percentage of the new pods, to
+	// roll out sidecar upgrades progressively. The namespaces which pin the
+	// daprd image with the dapr.io/sidecar-image annotation are excluded.
+	// +optional
+	Canary *SidecarCanarySpec `json:"canary,omitempty"`
+}
+
+// SidecarCanarySpec defines the canary rollout of a daprd image.
+type SidecarCanarySpec struct {
+	Image string `json:"image"`
+	// percentage is the percentage of the new pods, from 0 to 100, injected
+	// with the image.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int `json:"percentage"`
+	// namespaces limits the canary to the pods of these namespaces. The
+	// canary applies to all namespaces if empty.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// InjectionProfile is a named set of default annotations of the injected
+// pods, selected with the dapr.io/injection-profile annotation of the pod or
+// of its namespace. The annotations of the pod override the annotations of
+// the profile.
+type InjectionProfile struct {
+	Name string `json:"name"`
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// RateLimitSpec defines the rate limits of the inbound traffic of the app.
+type RateLimitSpec struct {
+	// stateStore is the name of the state store which keeps the buckets of
+	// tokens shared by the replicas of the app.
+	// +optional
+	StateStore string `json:"stateStore,omitempty"`
+	// +optional
+	Policies []RateLimitPolicy `json:"policies,omitempty"`
+}
+
+// RateLimitPolicy is a token bucket rate limit of the inbound traffic of the
+// app.
+type RateLimitPolicy struct {
+	// +optional
+	Name string `json:"name,omitempty"`
+	// callers are the app IDs of the callers and publishers the policy applies
+	// to.
+	// +optional
+	Callers []string `json:"callers,omitempty"`
+	// routes are the invoked methods and the routes of the subscriptions the
+	// policy applies to.
+	// +optional
+	Routes []string `json:"routes,omitempty"`
+	// traffic is the inbound traffic the policy applies to: "invocation" and
+	// "pubsub".
+	// +optional
+	Traffic []string `json:"traffic,omitempty"`
+	// by is how the buckets of the policy are keyed: "caller", "route" or both.
+	// +optional
+	By                []string `json:"by,omitempty"`
+	RequestsPerSecond int      `json:"requestsPerSecond"`
+	// +optional
+	Burst int `json:"burst,omitempty"`
+}
+
+// FaultInjectionSpec defines the faults injected by the sidecar in its calls
+// to the apps, actors and components.
+type FaultInjectionSpec struct {
+	// +optional
+	Faults []FaultSpec `json:"faults,omitempty"`
+}
+
+// FaultSpec is a fault injected in a percentage of the calls to the targets it
+// applies to.
+type FaultSpec struct {
+	Name string `json:"name"`
+	// targetType is the type of the targets of the fault: "app", "actor" or
+	// "component".
+	// +optional
+	TargetType string `json:"targetType,omitempty"`
+	// buildingBlock is the type of the components the fault applies to, such
+	// as "statestore" or "pubsub".
+	// +optional
+	BuildingBlock string `json:"buildingBlock,omitempty"`
+	// target is the ID of the app, the type of the actors, or the name of the
+	// component the fault applies to.
+	// +optional
+	Target string `json:"target,omitempty"`
+	// +optional
+	Delay *FaultDelaySpec `json:"delay,omitempty"`
+	// +optional
+	Error *FaultErrorSpec `json:"error,omitempty"`
+	// ttl is the duration after which the fault is removed, from the time the
+	// sidecar starts.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+}
+
+// FaultDelaySpec delays a percentage of the calls.
+type FaultDelaySpec struct {
+	Duration string `json:"duration"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int `json:"percentage"`
+}
+
+// FaultErrorSpec fails a percentage of the calls.
+type FaultErrorSpec struct {
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int `json:"percentage"`
+}
+
+// AppChannelSpec defines how the sidecar sends the requests to the app.
+type AppChannelSpec struct {
+	// +optional
+	AdaptiveConcurrency *AdaptiveConcurrencySpec `json:"adaptiveConcurrency,omitempty"`
+	// +optional
+	HTTP2 *AppChannelHTTP2Spec `json:"http2,omitempty"`
+}
+
+// AppChannelHTTP2Spec defines how the sidecar sends the requests to the app
+// over HTTP/2 cleartext (h2c).
+type AppChannelHTTP2Spec struct {
+	// priorKnowledge sends the requests to an app with the "http" protocol
+	// over h2c with prior knowledge.
+	// +optional
+	PriorKnowledge bool `json:"priorKnowledge,omitempty"`
+	// connections is the number of h2c connections the requests are
+	// multiplexed over.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Connections int `json:"connections,omitempty"`
+}
+
+// AdaptiveConcurrencySpec limits the requests in flight toward the app with an
+// AIMD algorithm adapting the limit to the latency of the app.
+type AdaptiveConcurrencySpec struct {
+	// latencyThreshold is the latency of the app above which the limit
+	// shrinks, as a Go duration.
+	LatencyThreshold string `json:"latencyThreshold"`
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinLimit int `json:"minLimit,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxLimit int `json:"maxLimit,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	InitialLimit int `json:"initialLimit,omitempty"`
+	// backoffPercentage is the percentage of the limit kept when it shrinks.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	BackoffPercentage int `json:"backoffPercentage,omitempty"`
+}
+
+// BindingsSpec defines how the sidecar reads from the input bindings.
+type BindingsSpec struct {
+	// pauseStateStore is the name of the state store which keeps the input
+	// bindings paused with persistence.
+	// +optional
+	PauseStateStore string `json:"pauseStateStore,omitempty"`
+}
+
+// BindingRoutingSpec defines the routes of the events of input bindings which
+// are forwarded by the sidecar to topics or output bindings.
+type BindingRoutingSpec struct {
+	// +optional
+	Routes []BindingRouteSpec `json:"routes,omitempty"`
+}
+
+// BindingRouteSpec forwards the events of an input binding to either a topic
+// of a pub/sub or an output binding.
+type BindingRouteSpec struct {
+	Name    string `json:"name"`
+	Binding string `json:"binding"`
+	// +optional
+	PubsubName string `json:"pubsubName,omitempty"`
+	// +optional
+	Topic string `json:"topic,omitempty"`
+	// +optional
+	OutputBinding string `json:"outputBinding,omitempty"`
+	// operation is the operation the output binding is invoked with.
+	// +optional
+	Operation string `json:"operation,omitempty"`
+	// transform is a Go template which maps the event to the forwarded data.
+	// +optional
+	Transform string `json:"transform,omitempty"`
+}
+
+// AppHealthSpec defines how the sidecar behaves while the app health checks
+// report the app as unhealthy.
+type AppHealthSpec struct {
+	// rejectInvocations rejects the invocations of the app with 503 Service
+	// Unavailable while it's unhealthy.
+	// +optional
+	RejectInvocations bool `json:"rejectInvocations,omitempty"`
+	// pause are the building blocks which stop delivering to the app while it's
+	// unhealthy: "pubsub", "bindings", "actors" and "jobs", or "none".
+	// +optional
+	Pause []string `json:"pause,omitempty"`
+}
+
+// ServiceInvocationSpec defines the configuration for service invocation.
+type ServiceInvocationSpec struct {
+	// loadBalancing configures how the instance of the target app which
+	// receives a call is chosen.
+	// +optional
+	LoadBalancing *LoadBalancingSpec `json:"loadBalancing,omitempty"`
+	// responseCaching configures the caching of the responses to GET
+	// invocations of apps and HTTPEndpoints.
+	// +optional
+	ResponseCaching *ResponseCachingSpec `json:"responseCaching,omitempty"`
+	// limits configures the size limits of the calls to apps.
+	// +optional
+	Limits *InvocationLimitsSpec `json:"limits,omitempty"`
+	// federation configures the invocation of the apps of remote clusters.
+	// +optional
+	Federation *FederationSpec `json:"federation,omitempty"`
+	// headerPolicies filter the headers and the gRPC metadata of the calls to
+	// apps and HTTPEndpoints, in order.
+	// +optional
+	HeaderPolicies []InvocationHeaderPolicy `json:"headerPolicies,omitempty"`
+	// connectionPool configures the pools of gRPC connections to the sidecars
+	// of the target apps.
+	// +optional
+	ConnectionPool *ConnectionPoolSpec `json:"connectionPool,omitempty"`
+}
+
+// ConnectionPoolSpec defines the pool of gRPC connections to each address of
+// a target sidecar.
+type ConnectionPoolSpec struct {
+	// maxConnections is the maximum number of connections to an address. Once
+	// reached, the calls share the least loaded connection. Defaults to no
+	// limit.
+	// +optional
+	MaxConnections int `json:"maxConnections,omitempty"`
+	// maxConcurrentStreams is the number of calls in flight on a connection
+	// above which another connection is opened. Defaults to 100.
+	// +optional
+	MaxConcurrentStreams int `json:"maxConcurrentStreams,omitempty"`
+	// idleTimeout is the duration after which an unused connection is closed.
+	// Defaults to 3m.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+	// rebalanceOnDNSChange drains the connections to a host name when the
+	// addresses it resolves to change, so that the calls are spread over the
+	// new addresses.
+	// +optional
+	RebalanceOnDNSChange bool `json:"rebalanceOnDNSChange,omitempty"`
+}
+
+// InvocationHeaderPolicy filters the headers and the gRPC metadata of the
+// calls to apps and HTTPEndpoints.
+type InvocationHeaderPolicy struct {
+	// targets are the IDs of the apps and the names of the HTTPEndpoints the
+	// policy applies to.
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+	// allow are the only headers which are sent, if set.
+	// +optional
+	Allow []string `json:"allow,omitempty"`
+	// deny are the headers which are not sent.
+	// +optional
+	Deny []string `json:"deny,omitempty"`
+	// rename maps the names of headers to the names they are sent with.
+	// +optional
+	Rename map[string]string `json:"rename,omitempty"`
+	// set are headers sent with static values.
+	// +optional
+	Set map[string]string `json:"set,omitempty"`
+}
+
+// FederationSpec defines the gateways through which the apps of remote Dapr
+// clusters are invoked.
+type FederationSpec struct {
+	// gateways are the gateways of the remote clusters.
+	Gateways []FederationGateway `json:"gateways"`
+}
+
+// FederationGateway is the gateway of a remote Dapr cluster.
+type FederationGateway struct {
+	// httpEndpoint is the name of the HTTPEndpoint resource of the gateway.
+	HTTPEndpoint string `json:"httpEndpoint"`
+	// appIds are the IDs of the apps of the remote cluster.
+	// +optional
+	AppIDs []string `json:"appIds,omitempty"`
+}
+
+// InvocationLimitsSpec defines the size limits of service invocation calls.
+type InvocationLimitsSpec struct {
+	InvocationLimits `json:",inline"`
+	// apps overrides the limits for the given target apps.
+	// +optional
+	Apps []AppInvocationLimits `json:"apps,omitempty"`
+}
+
+// InvocationLimits are the size limits of service invocation calls.
+type InvocationLimits struct {
+	// maxRequestBodySize is the maximum size in bytes of the body of the
+	// requests to the app.
+	// +optional
+	MaxRequestBodySize int `json:"maxRequestBodySize,omitempty"`
+	// maxResponseBodySize is the maximum size in bytes of the body of the
+	// responses of the app.
+	// +optional
+	MaxResponseBodySize int `json:"maxResponseBodySize,omitempty"`
+	// streamingThreshold is the size in bytes above which the bodies of the
+	// requests to the app are streamed instead of buffered, which disables
+	// their retries.
+	// +optional
+	StreamingThreshold int `json:"streamingThreshold,omitempty"`
+}
+
+// AppInvocationLimits are the size limits of the calls to an app.
+type AppInvocationLimits struct {
+	// appId is the ID of the target app.
+	AppID            string `json:"appId"`
+	InvocationLimits `json:",inline"`
+}
+
+// ResponseCachingSpec defines the caching of the responses to GET
+// invocations, which are cached for as long as their Cache-Control header
+// allows.
+type ResponseCachingSpec struct {
+	// targets are the IDs of the apps and the names of the HTTPEndpoints
+	// whose responses are cached.
+	Targets []string `json:"targets"`
+	// maxEntries is the maximum number of cached responses. Defaults to 1000.
+	// +optional
+	MaxEntries int `json:"maxEntries,omitempty"`
+	// maxResponseSize is the maximum size in bytes of the body of a cached
+	// response. Defaults to 1MiB.
+	// +optional
+	MaxResponseSize int `json:"maxResponseSize,omitempty"`
+	// maxTTL is the maximum duration a response is cached for, whatever its
+	// Cache-Control header. Defaults to 5m.
+	// +optional
+	MaxTTL *metav1.Duration `json:"maxTTL,omitempty"`
+}
+
+// LoadBalancingSpec defines the load balancing of service invocation calls
+// between the instances of the target apps.
+type LoadBalancingSpec struct {
+	LoadBalancingPolicy `json:",inline"`
+	// apps overrides the load balancing policy for the given target apps.
+	// +optional
+	Apps []AppLoadBalancingPolicy `json:"apps,omitempty"`
+}
+
+// LoadBalancingPolicy is a load balancing policy of service invocation calls.
+type LoadBalancingPolicy struct {
+	// policy is one of "random" (the default), "roundRobin" or "leastLoaded".
+	// +optional
+	Policy string `json:"policy,omitempty"`
+	// zoneAware prefers the instances in the zone of the sidecar, set with the
+	// DAPR_ZONE environment variable.
+	// +optional
+	ZoneAware bool `json:"zoneAware,omitempty"`
+	// subsetSize limits the calls of the sidecar to a subset of the instances
+	// of the target app, of at most this size.
+	// +optional
+	SubsetSize int `json:"subsetSize,omitempty"`
+}
+
+// AppLoadBalancingPolicy is the load balancing policy of the calls to an app.
+type AppLoadBalancingPolicy struct {
+	// appId is the ID of the target app.
+	AppID               string `json:"appId"`
+	LoadBalancingPolicy `json:",inline"`
+}
+
+// JobsSpec defines the configuration for the jobs API.
+type JobsSpec struct {
+	// calendars are the exclusion calendars which jobs may reference by name.
+	// +optional
+	Calendars []JobCalendar `json:"calendars,omitempty"`
+}
+
+// JobCalendar is a named set of dates on which jobs referencing the calendar
+// are not triggered.
+type JobCalendar struct {
+	// name is the name of the calendar referenced by jobs.
+	Name string `json:"name"`
+	// excludedDates are the dates on which jobs are not triggered, either
+	// "YYYY-MM-DD" for a single date or "MM-DD" for a date every year.
+	// +optional
+	ExcludedDates []string `json:"excludedDates,omitempty"`
+	// timeZone is the IANA time zone the dates are evaluated in. Defaults to
+	// the time zone of the job.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -174,6 +606,53 @@ type WorkflowSpec struct {
 	// instances will not be automatically purged.
 	// +optional
 	StateRetentionPolicy *WorkflowStateRetentionPolicy `json:"stateRetentionPolicy,omitempty"`
+
+	// Partitioning defines how workflow work items are partitioned and
+	// dispatched by a single Dapr instance. If not set, all workflow work items
+	// share a single queue.
+	// +optional
+	Partitioning *WorkflowPartitioning `json:"partitioning,omitempty"`
+
+	// fanOutLimits defines per-workflow-name limits on the concurrent child
+	// workflows and activities of each workflow instance, enforced by the
+	// workflow engine.
+	// +optional
+	FanOutLimits []WorkflowFanOutLimit `json:"fanOutLimits,omitempty"`
+}
+
+// WorkflowFanOutLimit limits the number of concurrent child workflows and
+// activities of each instance of a workflow. Child workflows and activities
+// scheduled beyond the limit are queued, and dispatched in the order they
+// were scheduled as earlier ones complete.
+type WorkflowFanOutLimit struct {
+	// Name is the workflow name to limit.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// MaxConcurrentChildWorkflows is the maximum number of child workflows of
+	// a workflow instance which may be running at once.
+	// +optional
+	MaxConcurrentChildWorkflows *int32 `json:"maxConcurrentChildWorkflows,omitempty"`
+	// MaxConcurrentActivities is the maximum number of activities of a
+	// workflow instance which may be running at once.
+	// +optional
+	MaxConcurrentActivities *int32 `json:"maxConcurrentActivities,omitempty"`
+}
+
+// WorkflowPartitioning defines the partitioning of workflow work items. Work
+// items are assigned to a partition by hashing their workflow instance ID, so
+// all work items of a workflow instance are dispatched from the same
+// partition.
+type WorkflowPartitioning struct {
+	// Partitions is the number of workflow work item partitions. Defaults to 1.
+	// +optional
+	Partitions *int32 `json:"partitions,omitempty"`
+
+	// RebalanceStrategy is the order in which partitions are drained when
+	// workflow workers are ready for more work. One of "roundRobin" (default),
+	// which drains each partition in turn, or "backlog", which drains the
+	// partition with the largest backlog first.
+	// +optional
+	RebalanceStrategy *string `json:"rebalanceStrategy,omitempty"`
 }
 
 // NamedConcurrencyLimit defines a per-name concurrency limit for a specific
@@ -214,6 +693,38 @@ type WorkflowStateRetentionPolicy struct {
 	// Terminated terminal state.
 	// +optional
 	Terminated *metav1.Duration `json:"terminated,omitempty"`
+
+	// Workflows overrides the retention policy for the named workflows.
+	// +optional
+	Workflows []WorkflowNamedStateRetentionPolicy `json:"workflows,omitempty"`
+}
+
+// WorkflowNamedStateRetentionPolicy is the retention policy of the instances
+// of the named workflow. Its TTLs take precedence over those of the app. If
+// AnyTerminal is set, the TTLs of the app are not used for the workflow.
+type WorkflowNamedStateRetentionPolicy struct {
+	// Name is the name of the workflow.
+	Name string `json:"name"`
+
+	// AnyTerminal is the TTL for purging instances of the workflow that reach
+	// any terminal state.
+	// +optional
+	AnyTerminal *metav1.Duration `json:"anyTerminal,omitempty"`
+
+	// Completed is the TTL for purging instances of the workflow that reach
+	// the Completed terminal state.
+	// +optional
+	Completed *metav1.Duration `json:"completed,omitempty"`
+
+	// Failed is the TTL for purging instances of the workflow that reach the
+	// Failed terminal state.
+	// +optional
+	Failed *metav1.Duration `json:"failed,omitempty"`
+
+	// Terminated is the TTL for purging instances of the workflow that reach
+	// the Terminated terminal state.
+	// +optional
+	Terminated *metav1.Duration `json:"terminated,omitempty"`
 }
 
 // APISpec describes the configuration for Dapr APIs.
@@ -224,6 +735,39 @@ type APISpec struct {
 	// List of denied APIs. Can be used in conjunction with allowed.
 	// +optional
 	Denied []APIAccessRule `json:"denied,omitempty"`
+	// Services of the gRPC API server other than the Dapr API.
+	// +optional
+	GRPC *APIGRPCSpec `json:"grpc,omitempty"`
+	// API tokens read from secret stores, in addition to the dapr-api-token.
+	// +optional
+	Tokens []APITokenSpec `json:"tokens,omitempty"`
+}
+
+// APITokenSpec describes API tokens read from a secret of a secret store.
+// The secret is watched, so the tokens can be rotated without a restart.
+type APITokenSpec struct {
+	// Name of the tokens, used in logs.
+	Name string `json:"name"`
+	// Name of the secret store component.
+	SecretStore string `json:"secretStore"`
+	// Name of the secret.
+	SecretName string `json:"secretName"`
+	// Key of the secret holding the tokens, one per line. Defaults to the name of the secret.
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
+	// APIs the tokens are allowed to call. All APIs are allowed if empty.
+	// +optional
+	Allowed []APIAccessRule `json:"allowed,omitempty"`
+}
+
+// APIGRPCSpec describes the services served on the gRPC API port, next to the Dapr API.
+type APIGRPCSpec struct {
+	// Serve the gRPC server reflection service. Enabled by default.
+	// +optional
+	Reflection *bool `json:"reflection,omitempty"`
+	// Serve the grpc.health.v1 health service, reporting the health of the sidecar.
+	// +optional
+	HealthService bool `json:"healthService,omitempty"`
 }
 
 // WasmSpec describes the security profile for all Dapr Wasm components.
@@ -240,6 +784,9 @@ type APIAccessRule struct {
 	Version string `json:"version"`
 	// +optional
 	Protocol string `json:"protocol,omitempty"`
+	// HTTP verbs the rule applies to, for the http protocol. Applies to all verbs if empty.
+	// +optional
+	Verbs []string `json:"verbs,omitempty"`
 }
 
 // NameResolutionSpec is the spec for name resolution configuration.
@@ -247,6 +794,19 @@ type NameResolutionSpec struct {
 	Component     string        `json:"component"`
 	Version       string        `json:"version"`
 	Configuration *DynamicValue `json:"configuration"`
+	// failover are the name resolvers tried in order for the apps which the
+	// resolvers before them fail to resolve.
+	// +optional
+	Failover []NameResolverSpec `json:"failover,omitempty"`
+}
+
+// NameResolverSpec is the spec of a failover name resolver.
+type NameResolverSpec struct {
+	Component string `json:"component"`
+	// +optional
+	Version string `json:"version,omitempty"`
+	// +optional
+	Configuration *DynamicValue `json:"configuration,omitempty"`
 }
 
 // SecretsSpec is the spec for secrets configuration.
@@ -292,6 +852,27 @@ type MTLSSpec struct {
 	// In self-hosted mode, enabling a custom validator will disable the built-in "insecure" validator.
 	// +optional
 	TokenValidators []ValidatorSpec `json:"tokenValidators,omitempty"`
+	// Provider of the CA which signs the workload certificates.
+	// Sentry signs them with its own issuer certificate when not set.
+	// +optional
+	CAProvider *CAProviderSpec `json:"caProvider,omitempty"`
+	// Upper bound of the TTL of the workload certificates, including the TTLs
+	// of namespaces and apps and the TTLs requested by pods.
+	// +optional
+	MaxWorkloadCertTTL *string `json:"maxWorkloadCertTTL,omitempty"`
+	// TTLs of the workload certificates of namespaces or apps, overriding
+	// workloadCertTTL.
+	// +optional
+	WorkloadCertTTLs []WorkloadCertTTLSpec `json:"workloadCertTTLs,omitempty"`
+}
+
+// WorkloadCertTTLSpec is the TTL of the workload certificates of a namespace,
+// or of an app if the app ID is set.
+type WorkloadCertTTLSpec struct {
+	Namespace string `json:"namespace"`
+	// +optional
+	AppID string `json:"appID,omitempty"`
+	TTL   string `json:"ttl"`
 }
 
 // GetEnabled returns true if mTLS is enabled.
@@ -309,6 +890,15 @@ type ValidatorSpec struct {
 	Options *DynamicValue `json:"options,omitempty"`
 }
 
+// CAProviderSpec is the provider of the CA which signs the workload certificates.
+type CAProviderSpec struct {
+	// Name of the provider
+	// +kubebuilder:validation:Enum={"vault"}
+	Name string `json:"name"`
+	// Options for the provider, if any
+	Options *DynamicValue `json:"options,omitempty"`
+}
+
 // SelectorSpec selects target services to which the handler is to be applied.
 type SelectorSpec struct {
 	Fields []SelectorField `json:"fields"`
@@ -404,6 +994,22 @@ type AppPolicySpec struct {
 	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
 	// +optional
 	AppOperationActions []AppOperationAction `json:"operations,omitempty" yaml:"operations,omitempty"`
+	// +optional
+	Rules []AppInvocationRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// +optional
+	DenyByDefault bool `json:"denyByDefault,omitempty" yaml:"denyByDefault,omitempty"`
+}
+
+// AppInvocationRule is an access control rule matched on the HTTP verbs and
+// path globs, or the full gRPC method names, of the calls of an app.
+type AppInvocationRule struct {
+	// +optional
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	// +optional
+	HTTPVerb []string `json:"httpVerb,omitempty" yaml:"httpVerb,omitempty"`
+	// +optional
+	GRPCMethod string `json:"grpcMethod,omitempty" yaml:"grpcMethod,omitempty"`
+	Action     string `json:"action" yaml:"action"`
 }
 
 // AppOperationAction defines the data structure for each app operation.
@@ -435,6 +1041,23 @@ type ComponentsSpec struct {
 	// Denylist of component types that cannot be instantiated
 	// +optional
 	Deny []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+	// Remote pluggable components, served over TCP by endpoints outside of the pod
+	// +optional
+	Remote []RemoteComponentSpec `json:"remote,omitempty" yaml:"remote,omitempty"`
+}
+
+// RemoteComponentSpec describes a pluggable component served by a remote endpoint.
+// The endpoint is authenticated with mTLS as the Dapr app with the given ID.
+type RemoteComponentSpec struct {
+	// Name of the pluggable component, used in the type of the components
+	Name string `json:"name" yaml:"name"`
+	// Address of the endpoint, as host:port
+	Address string `json:"address" yaml:"address"`
+	// App ID of the endpoint identity
+	AppID string `json:"appId" yaml:"appId"`
+	// Namespace of the endpoint identity. Defaults to the namespace of the sidecar.
+	// +optional
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
 }
 
 // LoggingSpec defines the configuration for logging.
@@ -442,6 +1065,30 @@ type LoggingSpec struct {
 	// Configure API logging.
 	// +optional
 	APILogging *APILoggingSpec `json:"apiLogging,omitempty" yaml:"apiLogging,omitempty"`
+	// Configure the audit log of security-relevant events.
+	// +optional
+	AuditLog *AuditLogSpec `json:"auditLog,omitempty" yaml:"auditLog,omitempty"`
+	// Log level of the sidecar: debug, info, warn, error or fatal. Overrides the `--log-level` flag of the sidecar.
+	// In self-hosted mode, it's applied when the configuration is reloaded, without restarting the sidecar.
+	// +optional
+	Level string `json:"level,omitempty" yaml:"level,omitempty"`
+}
+
+// AuditLogSpec defines the configuration for the audit log of security-relevant events:
+// ACL denials, API token failures, secret accesses and crypto operations.
+type AuditLogSpec struct {
+	// Enables the audit log. The default value is false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Output of the audit log: stdout, file or otel. Default: stdout.
+	// +optional
+	Output string `json:"output,omitempty" yaml:"output,omitempty"`
+	// Path of the file the audit log is appended to, for the file output.
+	// +optional
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	// OTLP logs exporter, for the otel output.
+	// +optional
+	Otel *OtelSpec `json:"otel,omitempty" yaml:"otel,omitempty"`
 }
 
 // APILoggingSpec defines the configuration for API logging.
@@ -29,6 +29,11 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIAccessRule) DeepCopyInto(out *APIAccessRule) {
 	*out = *in
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIAccessRule.
@@ -41,6 +46,26 @@ func (in *APIAccessRule) DeepCopy() *APIAccessRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIGRPCSpec) DeepCopyInto(out *APIGRPCSpec) {
+	*out = *in
+	if in.Reflection != nil {
+		in, out := &in.Reflection, &out.Reflection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIGRPCSpec.
+func (in *APIGRPCSpec) DeepCopy() *APIGRPCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIGRPCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APILoggingSpec) DeepCopyInto(out *APILoggingSpec) {
 	*out = *in
@@ -77,12 +102,28 @@ func (in *APISpec) DeepCopyInto(out *APISpec) {
 	if in.Allowed != nil {
 		in, out := &in.Allowed, &out.Allowed
 		*out = make([]APIAccessRule, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Denied != nil {
 		in, out := &in.Denied, &out.Denied
 		*out = make([]APIAccessRule, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(APIGRPCSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tokens != nil {
+		in, out := &in.Tokens, &out.Tokens
+		*out = make([]APITokenSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -96,6 +137,28 @@ func (in *APISpec) DeepCopy() *APISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APITokenSpec) DeepCopyInto(out *APITokenSpec) {
+	*out = *in
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]APIAccessRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenSpec.
+func (in *APITokenSpec) DeepCopy() *APITokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APITokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessControlSpec) DeepCopyInto(out *AccessControlSpec) {
 	*out = *in
@@ -118,6 +181,133 @@ func (in *AccessControlSpec) DeepCopy() *AccessControlSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrencySpec) DeepCopyInto(out *AdaptiveConcurrencySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrencySpec.
+func (in *AdaptiveConcurrencySpec) DeepCopy() *AdaptiveConcurrencySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrencySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppChannelHTTP2Spec) DeepCopyInto(out *AppChannelHTTP2Spec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppChannelHTTP2Spec.
+func (in *AppChannelHTTP2Spec) DeepCopy() *AppChannelHTTP2Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppChannelHTTP2Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppChannelSpec) DeepCopyInto(out *AppChannelSpec) {
+	*out = *in
+	if in.AdaptiveConcurrency != nil {
+		in, out := &in.AdaptiveConcurrency, &out.AdaptiveConcurrency
+		*out = new(AdaptiveConcurrencySpec)
+		**out = **in
+	}
+	if in.HTTP2 != nil {
+		in, out := &in.HTTP2, &out.HTTP2
+		*out = new(AppChannelHTTP2Spec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppChannelSpec.
+func (in *AppChannelSpec) DeepCopy() *AppChannelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppChannelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppHealthSpec) DeepCopyInto(out *AppHealthSpec) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppHealthSpec.
+func (in *AppHealthSpec) DeepCopy() *AppHealthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppHealthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppInvocationRule) DeepCopyInto(out *AppInvocationRule) {
+	*out = *in
+	if in.HTTPVerb != nil {
+		in, out := &in.HTTPVerb, &out.HTTPVerb
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppInvocationRule.
+func (in *AppInvocationRule) DeepCopy() *AppInvocationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppInvocationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppInvocationLimits) DeepCopyInto(out *AppInvocationLimits) {
+	*out = *in
+	out.InvocationLimits = in.InvocationLimits
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppInvocationLimits.
+func (in *AppInvocationLimits) DeepCopy() *AppInvocationLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(AppInvocationLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppLoadBalancingPolicy) DeepCopyInto(out *AppLoadBalancingPolicy) {
+	*out = *in
+	out.LoadBalancingPolicy = in.LoadBalancingPolicy
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppLoadBalancingPolicy.
+func (in *AppLoadBalancingPolicy) DeepCopy() *AppLoadBalancingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AppLoadBalancingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppOperationAction) DeepCopyInto(out *AppOperationAction) {
 	*out = *in
@@ -148,6 +338,13 @@ func (in *AppPolicySpec) DeepCopyInto(out *AppPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AppInvocationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPolicySpec.
@@ -160,6 +357,101 @@ func (in *AppPolicySpec) DeepCopy() *AppPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogSpec) DeepCopyInto(out *AuditLogSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Otel != nil {
+		in, out := &in.Otel, &out.Otel
+		*out = new(OtelSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogSpec.
+func (in *AuditLogSpec) DeepCopy() *AuditLogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingRouteSpec) DeepCopyInto(out *BindingRouteSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingRouteSpec.
+func (in *BindingRouteSpec) DeepCopy() *BindingRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingRoutingSpec) DeepCopyInto(out *BindingRoutingSpec) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]BindingRouteSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingRoutingSpec.
+func (in *BindingRoutingSpec) DeepCopy() *BindingRoutingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingRoutingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingsSpec) DeepCopyInto(out *BindingsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingsSpec.
+func (in *BindingsSpec) DeepCopy() *BindingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAProviderSpec) DeepCopyInto(out *CAProviderSpec) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(DynamicValue)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAProviderSpec.
+func (in *CAProviderSpec) DeepCopy() *CAProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CAProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentsSpec) DeepCopyInto(out *ComponentsSpec) {
 	*out = *in
@@ -168,6 +460,11 @@ func (in *ComponentsSpec) DeepCopyInto(out *ComponentsSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = make([]RemoteComponentSpec, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentsSpec.
@@ -251,6 +548,16 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 		*out = new(PipelineSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GRPCPipelineSpec != nil {
+		in, out := &in.GRPCPipelineSpec, &out.GRPCPipelineSpec
+		*out = new(PipelineSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutboundHTTPPipelineSpec != nil {
+		in, out := &in.OutboundHTTPPipelineSpec, &out.OutboundHTTPPipelineSpec
+		*out = new(PipelineSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TracingSpec != nil {
 		in, out := &in.TracingSpec, &out.TracingSpec
 		*out = new(TracingSpec)
@@ -318,70 +625,466 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 		*out = new(WorkflowSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.JobsSpec != nil {
+		in, out := &in.JobsSpec, &out.JobsSpec
+		*out = new(JobsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceInvocation != nil {
+		in, out := &in.ServiceInvocation, &out.ServiceInvocation
+		*out = new(ServiceInvocationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppHealthSpec != nil {
+		in, out := &in.AppHealthSpec, &out.AppHealthSpec
+		*out = new(AppHealthSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimitSpec != nil {
+		in, out := &in.RateLimitSpec, &out.RateLimitSpec
+		*out = new(RateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FaultInjectionSpec != nil {
+		in, out := &in.FaultInjectionSpec, &out.FaultInjectionSpec
+		*out = new(FaultInjectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BindingRoutingSpec != nil {
+		in, out := &in.BindingRoutingSpec, &out.BindingRoutingSpec
+		*out = new(BindingRoutingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BindingsSpec != nil {
+		in, out := &in.BindingsSpec, &out.BindingsSpec
+		*out = new(BindingsSpec)
+		**out = **in
+	}
+	if in.AppChannelSpec != nil {
+		in, out := &in.AppChannelSpec, &out.AppChannelSpec
+		*out = new(AppChannelSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InjectorSpec != nil {
+		in, out := &in.InjectorSpec, &out.InjectorSpec
+		*out = new(InjectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationSpec.
+func (in *ConfigurationSpec) DeepCopy() *ConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolSpec) DeepCopyInto(out *ConnectionPoolSpec) {
+	*out = *in
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSpec.
+func (in *ConnectionPoolSpec) DeepCopy() *ConnectionPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicValue) DeepCopyInto(out *DynamicValue) {
+	*out = *in
+	in.JSON.DeepCopyInto(&out.JSON)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicValue.
+func (in *DynamicValue) DeepCopy() *DynamicValue {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelaySpec) DeepCopyInto(out *FaultDelaySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelaySpec.
+func (in *FaultDelaySpec) DeepCopy() *FaultDelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultErrorSpec) DeepCopyInto(out *FaultErrorSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultErrorSpec.
+func (in *FaultErrorSpec) DeepCopy() *FaultErrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultErrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionSpec) DeepCopyInto(out *FaultInjectionSpec) {
+	*out = *in
+	if in.Faults != nil {
+		in, out := &in.Faults, &out.Faults
+		*out = make([]FaultSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionSpec.
+func (in *FaultInjectionSpec) DeepCopy() *FaultInjectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultSpec) DeepCopyInto(out *FaultSpec) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelaySpec)
+		**out = **in
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(FaultErrorSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultSpec.
+func (in *FaultSpec) DeepCopy() *FaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureSpec) DeepCopyInto(out *FeatureSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureSpec.
+func (in *FeatureSpec) DeepCopy() *FeatureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederationGateway) DeepCopyInto(out *FederationGateway) {
+	*out = *in
+	if in.AppIDs != nil {
+		in, out := &in.AppIDs, &out.AppIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationGateway.
+func (in *FederationGateway) DeepCopy() *FederationGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(FederationGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederationSpec) DeepCopyInto(out *FederationSpec) {
+	*out = *in
+	if in.Gateways != nil {
+		in, out := &in.Gateways, &out.Gateways
+		*out = make([]FederationGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationSpec.
+func (in *FederationSpec) DeepCopy() *FederationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FederationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HandlerSpec) DeepCopyInto(out *HandlerSpec) {
+	*out = *in
+	if in.SelectorSpec != nil {
+		in, out := &in.SelectorSpec, &out.SelectorSpec
+		*out = new(SelectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HandlerSpec.
+func (in *HandlerSpec) DeepCopy() *HandlerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HandlerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InjectionProfile) DeepCopyInto(out *InjectionProfile) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectionProfile.
+func (in *InjectionProfile) DeepCopy() *InjectionProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InjectorSpec) DeepCopyInto(out *InjectorSpec) {
+	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]InjectionProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(SidecarCanarySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectorSpec.
+func (in *InjectorSpec) DeepCopy() *InjectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvocationLimits) DeepCopyInto(out *InvocationLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvocationLimits.
+func (in *InvocationLimits) DeepCopy() *InvocationLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(InvocationLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvocationHeaderPolicy) DeepCopyInto(out *InvocationHeaderPolicy) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rename != nil {
+		in, out := &in.Rename, &out.Rename
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvocationHeaderPolicy.
+func (in *InvocationHeaderPolicy) DeepCopy() *InvocationHeaderPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InvocationHeaderPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvocationLimitsSpec) DeepCopyInto(out *InvocationLimitsSpec) {
+	*out = *in
+	out.InvocationLimits = in.InvocationLimits
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]AppInvocationLimits, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvocationLimitsSpec.
+func (in *InvocationLimitsSpec) DeepCopy() *InvocationLimitsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InvocationLimitsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobCalendar) DeepCopyInto(out *JobCalendar) {
+	*out = *in
+	if in.ExcludedDates != nil {
+		in, out := &in.ExcludedDates, &out.ExcludedDates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationSpec.
-func (in *ConfigurationSpec) DeepCopy() *ConfigurationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobCalendar.
+func (in *JobCalendar) DeepCopy() *JobCalendar {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationSpec)
+	out := new(JobCalendar)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicValue) DeepCopyInto(out *DynamicValue) {
+func (in *JobsSpec) DeepCopyInto(out *JobsSpec) {
 	*out = *in
-	in.JSON.DeepCopyInto(&out.JSON)
+	if in.Calendars != nil {
+		in, out := &in.Calendars, &out.Calendars
+		*out = make([]JobCalendar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicValue.
-func (in *DynamicValue) DeepCopy() *DynamicValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobsSpec.
+func (in *JobsSpec) DeepCopy() *JobsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicValue)
+	out := new(JobsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FeatureSpec) DeepCopyInto(out *FeatureSpec) {
+func (in *LoadBalancingPolicy) DeepCopyInto(out *LoadBalancingPolicy) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureSpec.
-func (in *FeatureSpec) DeepCopy() *FeatureSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingPolicy.
+func (in *LoadBalancingPolicy) DeepCopy() *LoadBalancingPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(FeatureSpec)
+	out := new(LoadBalancingPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HandlerSpec) DeepCopyInto(out *HandlerSpec) {
+func (in *LoadBalancingSpec) DeepCopyInto(out *LoadBalancingSpec) {
 	*out = *in
-	if in.SelectorSpec != nil {
-		in, out := &in.SelectorSpec, &out.SelectorSpec
-		*out = new(SelectorSpec)
-		(*in).DeepCopyInto(*out)
+	out.LoadBalancingPolicy = in.LoadBalancingPolicy
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]AppLoadBalancingPolicy, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HandlerSpec.
-func (in *HandlerSpec) DeepCopy() *HandlerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingSpec.
+func (in *LoadBalancingSpec) DeepCopy() *LoadBalancingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HandlerSpec)
+	out := new(LoadBalancingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -394,6 +1097,11 @@ func (in *LoggingSpec) DeepCopyInto(out *LoggingSpec) {
 		*out = new(APILoggingSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AuditLog != nil {
+		in, out := &in.AuditLog, &out.AuditLog
+		*out = new(AuditLogSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSpec.
@@ -431,6 +1139,21 @@ func (in *MTLSSpec) DeepCopyInto(out *MTLSSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CAProvider != nil {
+		in, out := &in.CAProvider, &out.CAProvider
+		*out = new(CAProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxWorkloadCertTTL != nil {
+		in, out := &in.MaxWorkloadCertTTL, &out.MaxWorkloadCertTTL
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkloadCertTTLs != nil {
+		in, out := &in.WorkloadCertTTLs, &out.WorkloadCertTTLs
+		*out = make([]WorkloadCertTTLSpec, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSSpec.
@@ -571,6 +1294,13 @@ func (in *NameResolutionSpec) DeepCopyInto(out *NameResolutionSpec) {
 		*out = new(DynamicValue)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = make([]NameResolverSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameResolutionSpec.
@@ -583,6 +1313,26 @@ func (in *NameResolutionSpec) DeepCopy() *NameResolutionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NameResolverSpec) DeepCopyInto(out *NameResolverSpec) {
+	*out = *in
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(DynamicValue)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameResolverSpec.
+func (in *NameResolverSpec) DeepCopy() *NameResolverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NameResolverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedConcurrencyLimit) DeepCopyInto(out *NamedConcurrencyLimit) {
 	*out = *in
@@ -662,6 +1412,103 @@ func (in *PipelineSpec) DeepCopy() *PipelineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
+	*out = *in
+	if in.Callers != nil {
+		in, out := &in.Callers, &out.Callers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.By != nil {
+		in, out := &in.By, &out.By
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
+func (in *RateLimitPolicy) DeepCopy() *RateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]RateLimitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
+func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteComponentSpec) DeepCopyInto(out *RemoteComponentSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteComponentSpec.
+func (in *RemoteComponentSpec) DeepCopy() *RemoteComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCachingSpec) DeepCopyInto(out *ResponseCachingSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCachingSpec.
+func (in *ResponseCachingSpec) DeepCopy() *ResponseCachingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCachingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretsScope) DeepCopyInto(out *SecretsScope) {
 	*out = *in
@@ -744,6 +1591,73 @@ func (in *SelectorSpec) DeepCopy() *SelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInvocationSpec) DeepCopyInto(out *ServiceInvocationSpec) {
+	*out = *in
+	if in.LoadBalancing != nil {
+		in, out := &in.LoadBalancing, &out.LoadBalancing
+		*out = new(LoadBalancingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseCaching != nil {
+		in, out := &in.ResponseCaching, &out.ResponseCaching
+		*out = new(ResponseCachingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(InvocationLimitsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Federation != nil {
+		in, out := &in.Federation, &out.Federation
+		*out = new(FederationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeaderPolicies != nil {
+		in, out := &in.HeaderPolicies, &out.HeaderPolicies
+		*out = make([]InvocationHeaderPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectionPool != nil {
+		in, out := &in.ConnectionPool, &out.ConnectionPool
+		*out = new(ConnectionPoolSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInvocationSpec.
+func (in *ServiceInvocationSpec) DeepCopy() *ServiceInvocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceInvocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarCanarySpec) DeepCopyInto(out *SidecarCanarySpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarCanarySpec.
+func (in *SidecarCanarySpec) DeepCopy() *SidecarCanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarCanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
 	*out = *in
@@ -809,6 +1723,96 @@ func (in *WasmSpec) DeepCopy() *WasmSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowFanOutLimit) DeepCopyInto(out *WorkflowFanOutLimit) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxConcurrentChildWorkflows != nil {
+		in, out := &in.MaxConcurrentChildWorkflows, &out.MaxConcurrentChildWorkflows
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentActivities != nil {
+		in, out := &in.MaxConcurrentActivities, &out.MaxConcurrentActivities
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowFanOutLimit.
+func (in *WorkflowFanOutLimit) DeepCopy() *WorkflowFanOutLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowFanOutLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowNamedStateRetentionPolicy) DeepCopyInto(out *WorkflowNamedStateRetentionPolicy) {
+	*out = *in
+	if in.AnyTerminal != nil {
+		in, out := &in.AnyTerminal, &out.AnyTerminal
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Completed != nil {
+		in, out := &in.Completed, &out.Completed
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Terminated != nil {
+		in, out := &in.Terminated, &out.Terminated
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowNamedStateRetentionPolicy.
+func (in *WorkflowNamedStateRetentionPolicy) DeepCopy() *WorkflowNamedStateRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowNamedStateRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowPartitioning) DeepCopyInto(out *WorkflowPartitioning) {
+	*out = *in
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RebalanceStrategy != nil {
+		in, out := &in.RebalanceStrategy, &out.RebalanceStrategy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPartitioning.
+func (in *WorkflowPartitioning) DeepCopy() *WorkflowPartitioning {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowPartitioning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	*out = *in
@@ -841,6 +1845,18 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(WorkflowStateRetentionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Partitioning != nil {
+		in, out := &in.Partitioning, &out.Partitioning
+		*out = new(WorkflowPartitioning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FanOutLimits != nil {
+		in, out := &in.FanOutLimits, &out.FanOutLimits
+		*out = make([]WorkflowFanOutLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
@@ -876,6 +1892,13 @@ func (in *WorkflowStateRetentionPolicy) DeepCopyInto(out *WorkflowStateRetention
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Workflows != nil {
+		in, out := &in.Workflows, &out.Workflows
+		*out = make([]WorkflowNamedStateRetentionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStateRetentionPolicy.
@@ -902,3 +1925,18 @@ func (in *ZipkinSpec) DeepCopy() *ZipkinSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadCertTTLSpec) DeepCopyInto(out *WorkloadCertTTLSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadCertTTLSpec.
+func (in *WorkloadCertTTLSpec) DeepCopy() *WorkloadCertTTLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadCertTTLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
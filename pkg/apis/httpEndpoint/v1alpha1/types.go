@@ -96,6 +96,11 @@ func (h HTTPEndpoint) HasTLSRootCASecret() bool {
 	return h.Spec.ClientTLS != nil && h.Spec.ClientTLS.RootCA != nil && h.Spec.ClientTLS.RootCA.SecretKeyRef != nil && h.Spec.ClientTLS.RootCA.SecretKeyRef.Name != ""
 }
 
+// HasOAuth2ClientSecretRef returns a bool indicating if the OAuth2 client secret has a secret reference
+func (h HTTPEndpoint) HasOAuth2ClientSecretRef() bool {
+	return h.Spec.OAuth2 != nil && h.Spec.OAuth2.ClientSecret.SecretKeyRef != nil && h.Spec.OAuth2.ClientSecret.SecretKeyRef.Name != ""
+}
+
 // HasTLSRootCA returns a bool indicating if the HTTP endpoint contains a tls root ca
 func (h HTTPEndpoint) HasTLSRootCA() bool {
 	return h.Spec.ClientTLS != nil && h.Spec.ClientTLS.RootCA != nil && h.Spec.ClientTLS.RootCA.Value != nil
@@ -138,6 +143,35 @@ type HTTPEndpointSpec struct {
 	Headers []common.NameValuePair `json:"headers"`
 	//+optional
 	ClientTLS *common.TLS `json:"clientTLS,omitempty"`
+	//+optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
+}
+
+// OAuth2 describes how the sidecar gets the access tokens it sends to the endpoint,
+// with the OAuth2 client credentials flow.
+type OAuth2 struct {
+	// TokenURL is the URL of the token endpoint of the authorization server.
+	TokenURL string `json:"tokenUrl" validate:"required"`
+	// ClientID is the ID of the client.
+	ClientID string `json:"clientId" validate:"required"`
+	// ClientSecret is the secret of the client.
+	ClientSecret OAuth2ClientSecret `json:"clientSecret"`
+	// Scopes are the scopes of the access tokens.
+	//+optional
+	Scopes []string `json:"scopes,omitempty"`
+	// EndpointParams are additional parameters of the token requests, such as the audience.
+	//+optional
+	EndpointParams map[string]string `json:"endpointParams,omitempty"`
+}
+
+// OAuth2ClientSecret is the secret of an OAuth2 client, in plaintext or in a secret store.
+type OAuth2ClientSecret struct {
+	// Value of the secret, in plaintext.
+	//+optional
+	Value *common.DynamicValue `json:"value,omitempty"`
+	// SecretKeyRef is the reference of the secret in a secret store component.
+	//+optional
+	SecretKeyRef *common.SecretKeyRef `json:"secretKeyRef,omitempty"`
 }
 
 // Auth represents authentication details for the component.
@@ -115,6 +115,11 @@ func (in *HTTPEndpointSpec) DeepCopyInto(out *HTTPEndpointSpec) {
 		*out = new(common.TLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPEndpointSpec.
@@ -126,3 +131,56 @@ func (in *HTTPEndpointSpec) DeepCopy() *HTTPEndpointSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2) DeepCopyInto(out *OAuth2) {
+	*out = *in
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndpointParams != nil {
+		in, out := &in.EndpointParams, &out.EndpointParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2.
+func (in *OAuth2) DeepCopy() *OAuth2 {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientSecret) DeepCopyInto(out *OAuth2ClientSecret) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(common.DynamicValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(common.SecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientSecret.
+func (in *OAuth2ClientSecret) DeepCopy() *OAuth2ClientSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientSecret)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -109,12 +109,17 @@ func (r Resiliency) EmptyMetaDeepCopy() metav1.Object {
 type ResiliencySpec struct {
 	Policies Policies `json:"policies"`
 	Targets  Targets  `json:"targets" yaml:"targets"`
+	// DryRun evaluates the policies of the resource without enforcing them: the decisions they would take, such as
+	// retrying an operation or rejecting it with an open circuit breaker, are only logged and metered. It allows
+	// validating the timeouts and thresholds of new policies against production traffic before enabling them.
+	DryRun bool `json:"dryRun,omitempty" yaml:"dryRun,omitempty"`
 }
 
 type Policies struct {
 	Timeouts        map[string]string         `json:"timeouts,omitempty" yaml:"timeouts,omitempty"`
 	Retries         map[string]Retry          `json:"retries,omitempty" yaml:"retries,omitempty"`
 	CircuitBreakers map[string]CircuitBreaker `json:"circuitBreakers,omitempty" yaml:"circuitBreakers,omitempty"`
+	Hedging         map[string]Hedging        `json:"hedging,omitempty" yaml:"hedging,omitempty"`
 }
 
 type Retry struct {
@@ -123,6 +128,19 @@ type Retry struct {
 	MaxInterval string         `json:"maxInterval,omitempty" yaml:"maxInterval,omitempty"`
 	MaxRetries  *int           `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty"`
 	Matching    *RetryMatching `json:"matching,omitempty" yaml:"matching,omitempty"`
+	Budget      *RetryBudget   `json:"budget,omitempty" yaml:"budget,omitempty"`
+}
+
+// RetryBudget limits the retries to a target to a fraction of the requests to it, so that retries don't amplify
+// cascading failures.
+type RetryBudget struct {
+	// Ratio is the maximum fraction of the requests to a target which may be retries, such as "0.2". Defaults to "0.2".
+	Ratio string `json:"ratio,omitempty" yaml:"ratio,omitempty"`
+	// MinRetries is the number of retries in a window which are allowed regardless of the ratio, so that targets
+	// receiving few requests can still be retried. Defaults to 10.
+	MinRetries *int `json:"minRetries,omitempty" yaml:"minRetries,omitempty"`
+	// Window is the duration over which the requests and the retries are counted. Defaults to "10s".
+	Window string `json:"window,omitempty" yaml:"window,omitempty"`
 }
 
 // RetryMatching represents the rules to trigger retry in specific scenarios.
@@ -138,6 +156,27 @@ type CircuitBreaker struct {
 	Interval    string `json:"interval,omitempty" yaml:"interval,omitempty"`
 	Timeout     string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 	Trip        string `json:"trip,omitempty" yaml:"trip,omitempty"`
+	// Persistence shares the state of the circuit breaker with the other replicas of the app through a state store.
+	// If not set, the state is local to each replica.
+	Persistence *CircuitBreakerPersistence `json:"persistence,omitempty" yaml:"persistence,omitempty"`
+}
+
+// CircuitBreakerPersistence configures the state store in which the state of a circuit breaker is shared by the
+// replicas of an app, so they converge on one view of a failing dependency.
+type CircuitBreakerPersistence struct {
+	// StateStore is the name of the state store. It should support ETags, so that a single replica probes the
+	// dependency when the circuit breaker becomes half-open.
+	StateStore string `json:"stateStore" yaml:"stateStore"`
+	// SyncInterval is the interval at which the shared state is read from the state store. Defaults to "1s".
+	SyncInterval string `json:"syncInterval,omitempty" yaml:"syncInterval,omitempty"`
+}
+
+// Hedging represents a policy that sends speculative parallel attempts of a service invocation.
+type Hedging struct {
+	// Delay is the time to wait for a response before sending the next attempt.
+	Delay string `json:"delay,omitempty" yaml:"delay,omitempty"`
+	// MaxAttempts is the maximum number of attempts in flight, including the first one.
+	MaxAttempts int `json:"maxAttempts,omitempty" yaml:"maxAttempts,omitempty"`
 }
 
 type Targets struct {
@@ -149,6 +188,18 @@ type Targets struct {
 type ComponentPolicyNames struct {
 	Inbound  PolicyNames `json:"inbound,omitempty" yaml:"inbound,omitempty"`
 	Outbound PolicyNames `json:"outbound,omitempty" yaml:"outbound,omitempty"`
+	// Operations overrides the policies for specific operations of the component, keyed by the
+	// operation: the operation of a state store (such as "get" or "transaction"), the topic of a
+	// pub/sub, the operation of an output binding, or the route of an input binding for the
+	// inbound policies of its events. Policies which are not set fall back to the ones of the
+	// component.
+	Operations map[string]ComponentOperationPolicyNames `json:"operations,omitempty" yaml:"operations,omitempty"`
+}
+
+// ComponentOperationPolicyNames are the policies overridden for an operation of a component.
+type ComponentOperationPolicyNames struct {
+	Inbound  PolicyNames `json:"inbound,omitempty" yaml:"inbound,omitempty"`
+	Outbound PolicyNames `json:"outbound,omitempty" yaml:"outbound,omitempty"`
 }
 
 type PolicyNames struct {
@@ -162,6 +213,7 @@ type EndpointPolicyNames struct {
 	Retry                   string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	Hedging                 string `json:"hedging,omitempty" yaml:"hedging,omitempty"`
 }
 
 type ActorPolicyNames struct {
@@ -170,6 +222,15 @@ type ActorPolicyNames struct {
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	CircuitBreakerScope     string `json:"circuitBreakerScope,omitempty" yaml:"circuitBreakerScope,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	// Methods overrides the timeout and retry policies for specific methods of the actor type.
+	// Policies which are not set fall back to the ones of the actor type.
+	Methods map[string]ActorMethodPolicyNames `json:"methods,omitempty" yaml:"methods,omitempty"`
+}
+
+// ActorMethodPolicyNames are the policies overridden for a method of an actor type.
+type ActorMethodPolicyNames struct {
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	Retry   string `json:"retry,omitempty" yaml:"retry,omitempty"`
 }
 
 // ResiliencyList represents a list of `Resiliency` items.
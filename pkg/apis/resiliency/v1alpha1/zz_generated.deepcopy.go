@@ -24,9 +24,31 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActorMethodPolicyNames) DeepCopyInto(out *ActorMethodPolicyNames) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActorMethodPolicyNames.
+func (in *ActorMethodPolicyNames) DeepCopy() *ActorMethodPolicyNames {
+	if in == nil {
+		return nil
+	}
+	out := new(ActorMethodPolicyNames)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActorPolicyNames) DeepCopyInto(out *ActorPolicyNames) {
 	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make(map[string]ActorMethodPolicyNames, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActorPolicyNames.
@@ -42,6 +64,11 @@ func (in *ActorPolicyNames) DeepCopy() *ActorPolicyNames {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(CircuitBreakerPersistence)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreaker.
@@ -54,11 +81,50 @@ func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakerPersistence) DeepCopyInto(out *CircuitBreakerPersistence) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerPersistence.
+func (in *CircuitBreakerPersistence) DeepCopy() *CircuitBreakerPersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakerPersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentOperationPolicyNames) DeepCopyInto(out *ComponentOperationPolicyNames) {
+	*out = *in
+	out.Inbound = in.Inbound
+	out.Outbound = in.Outbound
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentOperationPolicyNames.
+func (in *ComponentOperationPolicyNames) DeepCopy() *ComponentOperationPolicyNames {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentOperationPolicyNames)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentPolicyNames) DeepCopyInto(out *ComponentPolicyNames) {
 	*out = *in
 	out.Inbound = in.Inbound
 	out.Outbound = in.Outbound
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make(map[string]ComponentOperationPolicyNames, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPolicyNames.
@@ -86,6 +152,21 @@ func (in *EndpointPolicyNames) DeepCopy() *EndpointPolicyNames {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hedging) DeepCopyInto(out *Hedging) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hedging.
+func (in *Hedging) DeepCopy() *Hedging {
+	if in == nil {
+		return nil
+	}
+	out := new(Hedging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Policies) DeepCopyInto(out *Policies) {
 	*out = *in
@@ -106,6 +187,13 @@ func (in *Policies) DeepCopyInto(out *Policies) {
 	if in.CircuitBreakers != nil {
 		in, out := &in.CircuitBreakers, &out.CircuitBreakers
 		*out = make(map[string]CircuitBreaker, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Hedging != nil {
+		in, out := &in.Hedging, &out.Hedging
+		*out = make(map[string]Hedging, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
@@ -230,6 +318,11 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 		*out = new(RetryMatching)
 		**out = **in
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(RetryBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -242,6 +335,26 @@ func (in *Retry) DeepCopy() *Retry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBudget) DeepCopyInto(out *RetryBudget) {
+	*out = *in
+	if in.MinRetries != nil {
+		in, out := &in.MinRetries, &out.MinRetries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudget.
+func (in *RetryBudget) DeepCopy() *RetryBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryMatching) DeepCopyInto(out *RetryMatching) {
 	*out = *in
@@ -271,14 +384,14 @@ func (in *Targets) DeepCopyInto(out *Targets) {
 		in, out := &in.Actors, &out.Actors
 		*out = make(map[string]ActorPolicyNames, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	if in.Components != nil {
 		in, out := &in.Components, &out.Components
 		*out = make(map[string]ComponentPolicyNames, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 }
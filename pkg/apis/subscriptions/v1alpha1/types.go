@@ -29,6 +29,11 @@ const (
 // +genclient
 // +genclient:noStatus
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Sidecars",type=integer,JSONPath=`.status.sidecars`
+// +kubebuilder:printcolumn:name="Loaded",type=integer,JSONPath=`.status.loaded`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Subscription describes an pub/sub event subscription.
 //
@@ -40,6 +45,8 @@ type Subscription struct {
 	Spec              SubscriptionSpec `json:"spec,omitempty"`
 	// +optional
 	Scopes []string `json:"scopes,omitempty"`
+	// +optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 // SubscriptionSpec is the spec for an event subscription.
@@ -50,6 +50,7 @@ func (in *Subscription) DeepCopyInto(out *Subscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subscription.
@@ -42,6 +42,9 @@ func (s *Subscription) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.DeadLetterTopic = s.Spec.DeadLetterTopic
 	dst.Spec.BulkSubscribe = *convertBulkSubscriptionV2alpha1ToV1alpha1(&s.Spec.BulkSubscribe)
 
+	// Status
+	dst.Status = s.Status
+
 	// +kubebuilder:docs-gen:collapse=rote conversion
 	return nil
 }
@@ -80,6 +83,9 @@ func (s *Subscription) ConvertFrom(srcRaw conversion.Hub) error {
 	s.Spec.DeadLetterTopic = src.Spec.DeadLetterTopic
 	s.Spec.BulkSubscribe = *convertBulkSubscriptionV1alpha1ToV2alpha1(&src.Spec.BulkSubscribe)
 
+	// Status
+	s.Status = src.Status
+
 	// +kubebuilder:docs-gen:collapse=rote conversion
 	return nil
 }
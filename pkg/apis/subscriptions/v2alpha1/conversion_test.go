@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dapr/dapr/pkg/apis/common"
 	"github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 )
@@ -30,6 +31,14 @@ func TestConversion(t *testing.T) {
 				MaxAwaitDurationMs: 1000,
 			},
 		},
+		Status: common.ResourceStatus{
+			ObservedGeneration: 2,
+			Sidecars:           1,
+			Loaded:             1,
+			Pods: []common.PodResourceStatus{
+				{Pod: "app1-0", AppID: "app1", ObservedGeneration: 2, Loaded: true, Operator: "dapr-operator-0"},
+			},
+		},
 	}
 
 	var subscriptionV1 v1alpha1.Subscription
@@ -29,6 +29,11 @@ const (
 // +genclient
 // +genclient:noStatus
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Sidecars",type=integer,JSONPath=`.status.sidecars`
+// +kubebuilder:printcolumn:name="Loaded",type=integer,JSONPath=`.status.loaded`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:storageversion
 
 // Subscription describes an pub/sub event subscription.
@@ -41,6 +46,8 @@ type Subscription struct {
 	Spec              SubscriptionSpec `json:"spec,omitempty"`
 	// +optional
 	Scopes []string `json:"scopes,omitempty"`
+	// +optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 // SubscriptionSpec is the spec for an event subscription.
@@ -58,6 +65,21 @@ type SubscriptionSpec struct {
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
 	// The option to enable bulk subscription for this topic.
 	BulkSubscribe BulkSubscribe `json:"bulkSubscribe,omitempty"`
+	// The optional workflow external event to raise for each message on the
+	// topic, instead of delivering the message to the app.
+	// +optional
+	WorkflowEvent *WorkflowEvent `json:"workflowEvent,omitempty"`
+}
+
+// WorkflowEvent raises an external event on the workflow instance correlated
+// to each message received on the topic.
+type WorkflowEvent struct {
+	// The name of the external event to raise.
+	EventName string `json:"eventName"`
+	// The CloudEvent field holding the workflow instance ID, either a
+	// CloudEvent attribute such as `subject` or a field of the event data
+	// such as `data.orderId`.
+	InstanceIDField string `json:"instanceIDField"`
 }
 
 // BulkSubscribe encapsulates the bulk subscription configuration for a topic.
@@ -119,6 +141,7 @@ func (s Subscription) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: subscriptions.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: s.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
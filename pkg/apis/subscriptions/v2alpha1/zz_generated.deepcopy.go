@@ -85,6 +85,7 @@ func (in *Subscription) DeepCopyInto(out *Subscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subscription.
@@ -149,6 +150,11 @@ func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
 	}
 	in.Routes.DeepCopyInto(&out.Routes)
 	out.BulkSubscribe = in.BulkSubscribe
+	if in.WorkflowEvent != nil {
+		in, out := &in.WorkflowEvent, &out.WorkflowEvent
+		*out = new(WorkflowEvent)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionSpec.
@@ -160,3 +166,18 @@ func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowEvent) DeepCopyInto(out *WorkflowEvent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowEvent.
+func (in *WorkflowEvent) DeepCopy() *WorkflowEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowEvent)
+	in.DeepCopyInto(out)
+	return out
+}
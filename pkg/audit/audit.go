@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit writes the audit log of the security-relevant events of the
+// sidecar, for compliance teams. The events are numbered and chained by their
+// hashes, so removed, reordered or modified events are detected by Verify.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dapr/dapr/pkg/api/apitoken"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.audit")
+
+// Action is the type of an audited event.
+type Action string
+
+const (
+	// ActionACLDenied is the denial of a service invocation by the access
+	// control list of the app.
+	ActionACLDenied Action = "acl.denied"
+	// ActionAPITokenFailed is a call to the Dapr APIs with a missing or
+	// invalid API token, or with a token not allowed to call the API.
+	ActionAPITokenFailed Action = "apitoken.failed"
+	// ActionSecretAccess is the access to secrets of a secret store.
+	ActionSecretAccess Action = "secret.access"
+	// ActionCrypto is an operation with the keys of a crypto component.
+	ActionCrypto Action = "crypto"
+)
+
+// Outcome is the outcome of an audited event.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+	OutcomeDenied  Outcome = "denied"
+)
+
+// Event is an entry of the audit log.
+type Event struct {
+	// Stream identifies the audit log of the sidecar process: the sequence
+	// numbers restart with each stream.
+	Stream string `json:"stream"`
+	// Seq is the sequence number of the event in the stream, starting at 1.
+	Seq        uint64            `json:"seq"`
+	Time       time.Time         `json:"time"`
+	Action     Action            `json:"action"`
+	Outcome    Outcome           `json:"outcome"`
+	AppID      string            `json:"appID"`
+	Namespace  string            `json:"namespace,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// PrevHash is the hash of the previous event of the stream, empty for the
+	// first event.
+	PrevHash string `json:"prevHash"`
+	// Hash is the hex SHA-256 of the event without the hash.
+	Hash string `json:"hash"`
+}
+
+// computeHash returns the hash of the event, computed without its hash.
+func (e Event) computeHash() (string, error) {
+	e.Hash = ""
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify checks that the events are consecutive events of a stream which
+// weren't modified.
+func Verify(events []Event) error {
+	for i, e := range events {
+		hash, err := e.computeHash()
+		if err != nil {
+			return err
+		}
+		if hash != e.Hash {
+			return fmt.Errorf("event %d of stream %s: hash mismatch", e.Seq, e.Stream)
+		}
+		if i == 0 {
+			if e.Seq == 1 && e.PrevHash != "" {
+				return fmt.Errorf("event %d of stream %s: first event has a previous hash", e.Seq, e.Stream)
+			}
+			continue
+		}
+		prev := events[i-1]
+		if e.Stream != prev.Stream {
+			return fmt.Errorf("event %d of stream %s: follows an event of stream %s", e.Seq, e.Stream, prev.Stream)
+		}
+		if e.Seq != prev.Seq+1 {
+			return fmt.Errorf("event %d of stream %s: follows event %d", e.Seq, e.Stream, prev.Seq)
+		}
+		if e.PrevHash != prev.Hash {
+			return fmt.Errorf("event %d of stream %s: previous hash mismatch", e.Seq, e.Stream)
+		}
+	}
+	return nil
+}
+
+type Options struct {
+	AppID     string
+	Namespace string
+	Spec      config.AuditLogSpec
+
+	// OtelHeaders are the headers of the requests of the OTLP logs exporter.
+	OtelHeaders map[string]string
+}
+
+// Log is the audit log of the sidecar.
+type Log struct {
+	appID     string
+	namespace string
+	stream    string
+	sink      sink
+
+	lock     sync.Mutex
+	seq      uint64
+	prevHash string
+}
+
+// New returns the audit log of the spec, or nil if the audit log is disabled.
+func New(opts Options) (*Log, error) {
+	if !opts.Spec.Enabled {
+		return nil, nil
+	}
+
+	var (
+		s   sink
+		err error
+	)
+	switch opts.Spec.Output {
+	case "", "stdout":
+		s = newStdoutSink()
+	case "file":
+		s, err = newFileSink(opts.Spec.Path)
+	case "otel":
+		s, err = newOtelSink(opts.AppID, opts.Spec.Otel, opts.OtelHeaders)
+	default:
+		err = fmt.Errorf("invalid output %q, must be one of stdout, file or otel", opts.Spec.Output)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	return &Log{
+		appID:     opts.AppID,
+		namespace: opts.Namespace,
+		stream:    uuid.NewString(),
+		sink:      s,
+	}, nil
+}
+
+// Run runs the output of the audit log until the context is canceled. It is
+// a no-op on a nil audit log.
+func (l *Log) Run(ctx context.Context) error {
+	if l == nil {
+		<-ctx.Done()
+		return nil
+	}
+	return l.sink.run(ctx)
+}
+
+// Emit numbers, chains and writes the event to the audit log. It is a no-op
+// on a nil audit log.
+func (l *Log) Emit(ctx context.Context, e Event) {
+	if l == nil {
+		return
+	}
+
+	if token, ok := apitoken.FromContext(ctx); ok && token.Name != "" {
+		attrs := maps.Clone(e.Attributes)
+		if attrs == nil {
+			attrs = make(map[string]string, 1)
+		}
+		attrs["apiToken"] = token.Name
+		e.Attributes = attrs
+	}
+
+	// The events are written under the lock, so they are written in the
+	// order of their sequence numbers.
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	e.Stream = l.stream
+	e.Seq = l.seq + 1
+	e.Time = time.Now().UTC()
+	e.AppID = l.appID
+	e.Namespace = l.namespace
+	e.PrevHash = l.prevHash
+
+	hash, err := e.computeHash()
+	if err != nil {
+		log.Errorf("Failed to hash audit event %s: %v", e.Action, err)
+		return
+	}
+	e.Hash = hash
+
+	if err = l.sink.write(&e); err != nil {
+		// The event keeps its sequence number, so the gap is detected.
+		log.Errorf("Failed to write audit event %d: %v", e.Seq, err)
+	}
+	l.seq = e.Seq
+	l.prevHash = e.Hash
+}
+
+var defaultLog atomic.Pointer[Log]
+
+// SetDefault sets the audit log to which Emit writes the events.
+func SetDefault(l *Log) {
+	defaultLog.Store(l)
+}
+
+// Emit writes the event to the audit log of the sidecar, if enabled.
+func Emit(ctx context.Context, e Event) {
+	defaultLog.Load().Emit(ctx, e)
+}
+
+// OutcomeOf returns the outcome of an operation which returned the error.
+func OutcomeOf(err error) Outcome {
+	if err != nil {
+		return OutcomeFailure
+	}
+	return OutcomeSuccess
+}
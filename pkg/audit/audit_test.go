@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/api/apitoken"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func readEvents(t *testing.T, path string) []Event {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var l struct {
+			Type string `json:"type"`
+			Event
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &l))
+		assert.Equal(t, "audit", l.Type)
+		events = append(events, l.Event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestNew(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		l, err := New(Options{})
+		require.NoError(t, err)
+		assert.Nil(t, l)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		_, err := New(Options{Spec: config.AuditLogSpec{Enabled: true, Output: "syslog"}})
+		require.Error(t, err)
+	})
+
+	t.Run("file output without path", func(t *testing.T) {
+		_, err := New(Options{Spec: config.AuditLogSpec{Enabled: true, Output: "file"}})
+		require.Error(t, err)
+	})
+
+	t.Run("otel output without endpoint", func(t *testing.T) {
+		_, err := New(Options{Spec: config.AuditLogSpec{Enabled: true, Output: "otel"}})
+		require.Error(t, err)
+	})
+}
+
+func TestNilLog(t *testing.T) {
+	var l *Log
+	l.Emit(t.Context(), Event{Action: ActionCrypto})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	require.NoError(t, l.Run(ctx))
+}
+
+func TestFileOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := New(Options{
+		AppID:     "myapp",
+		Namespace: "default",
+		Spec:      config.AuditLogSpec{Enabled: true, Output: "file", Path: path},
+	})
+	require.NoError(t, err)
+
+	ctx := apitoken.NewContext(t.Context(), &apitoken.Token{Name: "ops"})
+	l.Emit(ctx, Event{
+		Action:     ActionSecretAccess,
+		Outcome:    OutcomeSuccess,
+		Attributes: map[string]string{"store": "vault", "key": "db"},
+	})
+	l.Emit(t.Context(), Event{Action: ActionACLDenied, Outcome: OutcomeDenied})
+	l.Emit(t.Context(), Event{Action: ActionCrypto, Outcome: OutcomeOf(errors.New("fail"))})
+
+	runCtx, cancel := context.WithCancel(t.Context())
+	cancel()
+	require.NoError(t, l.Run(runCtx))
+
+	events := readEvents(t, path)
+	require.Len(t, events, 3)
+	require.NoError(t, Verify(events))
+
+	assert.Equal(t, uint64(1), events[0].Seq)
+	assert.Empty(t, events[0].PrevHash)
+	assert.Equal(t, "myapp", events[0].AppID)
+	assert.Equal(t, "default", events[0].Namespace)
+	assert.Equal(t, map[string]string{"store": "vault", "key": "db", "apiToken": "ops"}, events[0].Attributes)
+	assert.Equal(t, events[0].Hash, events[1].PrevHash)
+	assert.Equal(t, OutcomeFailure, events[2].Outcome)
+
+	t.Run("modified event", func(t *testing.T) {
+		tampered := append([]Event(nil), events...)
+		tampered[1].Outcome = OutcomeSuccess
+		require.ErrorContains(t, Verify(tampered), "hash mismatch")
+	})
+
+	t.Run("removed event", func(t *testing.T) {
+		require.ErrorContains(t, Verify([]Event{events[0], events[2]}), "follows event 1")
+	})
+
+	t.Run("reordered events", func(t *testing.T) {
+		require.Error(t, Verify([]Event{events[0], events[2], events[1]}))
+	})
+
+	t.Run("rehashed event", func(t *testing.T) {
+		tampered := append([]Event(nil), events...)
+		tampered[1].Outcome = OutcomeSuccess
+		tampered[1].Hash, err = tampered[1].computeHash()
+		require.NoError(t, err)
+		require.ErrorContains(t, Verify(tampered), "previous hash mismatch")
+	})
+}
+
+func TestOtelRequest(t *testing.T) {
+	s, err := newOtelSink("myapp", &config.OtelSpec{EndpointAddress: "localhost:4317"}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { s.close() })
+
+	e := &Event{
+		Stream:     "s",
+		Seq:        1,
+		Action:     ActionAPITokenFailed,
+		Outcome:    OutcomeDenied,
+		AppID:      "myapp",
+		Attributes: map[string]string{"protocol": "grpc"},
+		Hash:       "abc",
+	}
+	req := s.request([]*Event{e})
+	require.Len(t, req.GetResourceLogs(), 1)
+	rl := req.GetResourceLogs()[0]
+	assert.Equal(t, "myapp", rl.GetResource().GetAttributes()[0].GetValue().GetStringValue())
+
+	records := rl.GetScopeLogs()[0].GetLogRecords()
+	require.Len(t, records, 1)
+	assert.Equal(t, "WARN", records[0].GetSeverityText())
+
+	var body Event
+	require.NoError(t, json.Unmarshal([]byte(records[0].GetBody().GetStringValue()), &body))
+	assert.Equal(t, *e, body)
+
+	attrs := make(map[string]string)
+	for _, kv := range records[0].GetAttributes() {
+		attrs[kv.GetKey()] = kv.GetValue().GetStringValue()
+	}
+	assert.Equal(t, "1", attrs["audit.seq"])
+	assert.Equal(t, "apitoken.failed", attrs["audit.action"])
+	assert.Equal(t, "grpc", attrs["audit.attributes.protocol"])
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+const (
+	// otelQueueSize is the number of events queued for the OTLP logs exporter.
+	// The events are dropped when the queue is full.
+	otelQueueSize = 1024
+	// otelBatchSize is the maximum number of events of an export request.
+	otelBatchSize = 256
+	// otelFlushInterval is the interval at which the queued events are
+	// exported.
+	otelFlushInterval = time.Second
+	// otelDefaultTimeout is the timeout of the export requests, unless set in
+	// the spec.
+	otelDefaultTimeout = 10 * time.Second
+)
+
+// sink is the output of the audit log. Its write method is called under the
+// lock of the log.
+type sink interface {
+	write(e *Event) error
+	run(ctx context.Context) error
+}
+
+// line is an event of the audit log written as a JSON line, which is set apart
+// from the logs of the sidecar by its type.
+type line struct {
+	Type string `json:"type"`
+	*Event
+}
+
+// writerSink writes the events as JSON lines.
+type writerSink struct {
+	w     io.Writer
+	close func() error
+}
+
+func newStdoutSink() *writerSink {
+	return &writerSink{
+		w:     os.Stdout,
+		close: func() error { return nil },
+	}
+}
+
+func newFileSink(path string) (*writerSink, error) {
+	if path == "" {
+		return nil, errors.New("path is required for the file output")
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &writerSink{
+		w: f,
+		close: func() error {
+			return errors.Join(f.Sync(), f.Close())
+		},
+	}, nil
+}
+
+func (s *writerSink) write(e *Event) error {
+	b, err := json.Marshal(line{Type: "audit", Event: e})
+	if err != nil {
+		return err
+	}
+	_, err = s.w.Write(append(b, '\n'))
+	return err
+}
+
+func (s *writerSink) run(ctx context.Context) error {
+	<-ctx.Done()
+	return s.close()
+}
+
+// otelSink exports the events as OTLP log records, in batches.
+type otelSink struct {
+	resource *resourcepb.Resource
+	export   func(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+	close    func() error
+	timeout  time.Duration
+	queue    chan *Event
+}
+
+func newOtelSink(appID string, spec *config.OtelSpec, headers map[string]string) (*otelSink, error) {
+	if spec == nil || spec.EndpointAddress == "" {
+		return nil, errors.New("otel endpoint address is required for the otel output")
+	}
+
+	s := &otelSink{
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{stringAttr("service.name", appID)},
+		},
+		timeout: otelDefaultTimeout,
+		queue:   make(chan *Event, otelQueueSize),
+	}
+	if spec.Timeout != nil {
+		s.timeout = *spec.Timeout
+	}
+
+	switch spec.Protocol {
+	case "", "grpc":
+		creds := insecure.NewCredentials()
+		if spec.GetIsSecure() {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+		conn, err := grpc.NewClient(spec.EndpointAddress, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, err
+		}
+		client := collogspb.NewLogsServiceClient(conn)
+		s.export = func(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+			if len(headers) > 0 {
+				ctx = metadata.NewOutgoingContext(ctx, metadata.New(headers))
+			}
+			_, err := client.Export(ctx, req)
+			return err
+		}
+		s.close = conn.Close
+	case "http":
+		scheme := "https"
+		if !spec.GetIsSecure() {
+			scheme = "http"
+		}
+		url := scheme + "://" + spec.EndpointAddress + "/v1/logs"
+		client := &http.Client{}
+		s.export = func(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+			b, err := proto.Marshal(req)
+			if err != nil {
+				return err
+			}
+			httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+			if err != nil {
+				return err
+			}
+			httpReq.Header.Set("Content-Type", "application/x-protobuf")
+			for k, v := range headers {
+				httpReq.Header.Set(k, v)
+			}
+			resp, err := client.Do(httpReq)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			_, _ = io.Copy(io.Discard, resp.Body)
+			if resp.StatusCode < 200 || resp.StatusCode > 299 {
+				return fmt.Errorf("export returned status %d", resp.StatusCode)
+			}
+			return nil
+		}
+		s.close = func() error {
+			client.CloseIdleConnections()
+			return nil
+		}
+	default:
+		return nil, fmt.Errorf("invalid otel protocol %q, must be grpc or http", spec.Protocol)
+	}
+
+	return s, nil
+}
+
+func (s *otelSink) write(e *Event) error {
+	select {
+	case s.queue <- e:
+		return nil
+	default:
+		return errors.New("export queue is full, event dropped")
+	}
+}
+
+func (s *otelSink) run(ctx context.Context) error {
+	ticker := time.NewTicker(otelFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, otelBatchSize)
+	flush := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+		if err := s.export(ctx, s.request(batch)); err != nil {
+			log.Errorf("Failed to export %d audit events from %d: %v", len(batch), batch[0].Seq, err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case e := <-s.queue:
+			batch = append(batch, e)
+			if len(batch) == otelBatchSize {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		case <-ctx.Done():
+			// Export the queued events before closing.
+			for len(s.queue) > 0 {
+				batch = append(batch, <-s.queue)
+				if len(batch) == otelBatchSize {
+					flush(context.Background())
+				}
+			}
+			flush(context.Background())
+			return s.close()
+		}
+	}
+}
+
+// request returns the export request of the events. The body of the log
+// records is the JSON event, so the events can be verified, and its fields are
+// set as attributes too.
+func (s *otelSink) request(events []*Event) *collogspb.ExportLogsServiceRequest {
+	records := make([]*logspb.LogRecord, 0, len(events))
+	for _, e := range events {
+		body, err := json.Marshal(e)
+		if err != nil {
+			log.Errorf("Failed to encode audit event %d: %v", e.Seq, err)
+			continue
+		}
+
+		severity, severityText := logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+		if e.Outcome != OutcomeSuccess {
+			severity, severityText = logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "WARN"
+		}
+
+		attrs := []*commonpb.KeyValue{
+			stringAttr("audit.stream", e.Stream),
+			stringAttr("audit.seq", strconv.FormatUint(e.Seq, 10)),
+			stringAttr("audit.action", string(e.Action)),
+			stringAttr("audit.outcome", string(e.Outcome)),
+			stringAttr("audit.prev_hash", e.PrevHash),
+			stringAttr("audit.hash", e.Hash),
+			stringAttr("dapr.app_id", e.AppID),
+		}
+		if e.Namespace != "" {
+			attrs = append(attrs, stringAttr("dapr.namespace", e.Namespace))
+		}
+		keys := make([]string, 0, len(e.Attributes))
+		for k := range e.Attributes {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			attrs = append(attrs, stringAttr("audit.attributes."+k, e.Attributes[k]))
+		}
+
+		//nolint:gosec
+		ts := uint64(e.Time.UnixNano())
+		records = append(records, &logspb.LogRecord{
+			TimeUnixNano:         ts,
+			ObservedTimeUnixNano: ts,
+			SeverityNumber:       severity,
+			SeverityText:         severityText,
+			Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+			Attributes:           attrs,
+		})
+	}
+
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: s.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: "dapr.audit"},
+				LogRecords: records,
+			}},
+		}},
+	}
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
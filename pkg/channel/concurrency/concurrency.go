@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.channel.concurrency")
+
+const (
+	defaultMinLimit          = 1
+	defaultMaxLimit          = 1000
+	defaultInitialLimit      = 20
+	defaultBackoffPercentage = 90
+)
+
+type Options struct {
+	// MaxConcurrency is the maximum number of requests in flight, or 0 for no
+	// maximum.
+	MaxConcurrency int
+	// Spec adapts the limit of the requests in flight to the latency of the
+	// app, within MaxConcurrency.
+	Spec *config.AdaptiveConcurrencySpec
+}
+
+// Limiter limits the requests in flight toward the app, either to a fixed
+// maximum or to a limit which adapts to the latency of the app. The requests
+// above the limit wait for a slot, in order. A nil Limiter has no limit.
+type Limiter struct {
+	clock    clock.Clock
+	adaptive bool
+
+	minLimit  float64
+	maxLimit  float64
+	threshold time.Duration
+	backoff   float64
+
+	lock     sync.Mutex
+	limit    float64
+	inflight int
+	waiters  *list.List
+}
+
+type waiter struct {
+	ch      chan struct{}
+	granted bool
+}
+
+// Token is the slot of a request in flight. A nil Token is a no-op.
+type Token struct {
+	l        *Limiter
+	start    time.Time
+	inflight int
+
+	observed atomic.Bool
+	released atomic.Bool
+}
+
+// New returns the Limiter of the maximum concurrency and of the adaptive
+// concurrency spec, or nil if there's neither.
+func New(opts Options) (*Limiter, error) {
+	if opts.Spec == nil {
+		if opts.MaxConcurrency <= 0 {
+			return nil, nil
+		}
+		return &Limiter{
+			clock:   clock.RealClock{},
+			limit:   float64(opts.MaxConcurrency),
+			waiters: list.New(),
+		}, nil
+	}
+
+	spec := opts.Spec
+	if spec.LatencyThreshold == "" {
+		return nil, errors.New("adaptive concurrency: latency threshold is required")
+	}
+	threshold, err := time.ParseDuration(spec.LatencyThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("adaptive concurrency: invalid latency threshold: %w", err)
+	}
+	if threshold <= 0 {
+		return nil, errors.New("adaptive concurrency: latency threshold must be positive")
+	}
+
+	minLimit := valueOrDefault(spec.MinLimit, defaultMinLimit)
+	maxLimit := spec.MaxLimit
+	switch {
+	case maxLimit == 0 && opts.MaxConcurrency > 0:
+		maxLimit = opts.MaxConcurrency
+	case maxLimit == 0:
+		maxLimit = defaultMaxLimit
+	case opts.MaxConcurrency > 0 && maxLimit > opts.MaxConcurrency:
+		log.Warnf("Adaptive concurrency max limit %d is capped to the app max concurrency %d", maxLimit, opts.MaxConcurrency)
+		maxLimit = opts.MaxConcurrency
+	}
+	backoff := valueOrDefault(spec.BackoffPercentage, defaultBackoffPercentage)
+
+	switch {
+	case minLimit < 0 || maxLimit < 0 || spec.InitialLimit < 0:
+		return nil, errors.New("adaptive concurrency: limits must be positive")
+	case minLimit > maxLimit:
+		return nil, fmt.Errorf("adaptive concurrency: min limit %d is higher than the max limit %d", minLimit, maxLimit)
+	case backoff < 1 || backoff > 99:
+		return nil, fmt.Errorf("adaptive concurrency: backoff percentage %d must be between 1 and 99", backoff)
+	}
+
+	initialLimit := valueOrDefault(spec.InitialLimit, defaultInitialLimit)
+	initialLimit = min(max(initialLimit, minLimit), maxLimit)
+
+	l := &Limiter{
+		clock:     clock.RealClock{},
+		adaptive:  true,
+		minLimit:  float64(minLimit),
+		maxLimit:  float64(maxLimit),
+		threshold: threshold,
+		backoff:   float64(backoff) / 100,
+		limit:     float64(initialLimit),
+		waiters:   list.New(),
+	}
+	diag.DefaultMonitoring.AppChannelConcurrencyLimit(initialLimit)
+	return l, nil
+}
+
+func valueOrDefault(v, def int) int {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+// Limit returns the current limit of the requests in flight, or 0 if there's
+// no limit.
+func (l *Limiter) Limit() int {
+	if l == nil {
+		return 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return int(l.limit)
+}
+
+// Acquire waits for a slot for a request, until the context is done. The
+// Token must be released once the request completes.
+func (l *Limiter) Acquire(ctx context.Context) (*Token, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	l.lock.Lock()
+	if l.waiters.Len() == 0 && l.inflight < int(l.limit) {
+		l.inflight++
+		t := l.newToken()
+		l.lock.Unlock()
+		return t, nil
+	}
+	w := &waiter{ch: make(chan struct{})}
+	elem := l.waiters.PushBack(w)
+	l.lock.Unlock()
+
+	select {
+	case <-w.ch:
+		l.lock.Lock()
+		t := l.newToken()
+		l.lock.Unlock()
+		return t, nil
+	case <-ctx.Done():
+		l.lock.Lock()
+		if w.granted {
+			// The slot was granted concurrently: pass it on.
+			l.inflight--
+			l.admit()
+		} else {
+			l.waiters.Remove(elem)
+		}
+		l.lock.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// newToken must be called with the lock held, after the slot is taken.
+func (l *Limiter) newToken() *Token {
+	return &Token{
+		l:        l,
+		start:    l.clock.Now(),
+		inflight: l.inflight,
+	}
+}
+
+// admit grants the slots available under the limit to the waiting requests.
+// It must be called with the lock held.
+func (l *Limiter) admit() {
+	for l.waiters.Len() > 0 && l.inflight < int(l.limit) {
+		w := l.waiters.Remove(l.waiters.Front()).(*waiter)
+		w.granted = true
+		l.inflight++
+		close(w.ch)
+	}
+}
+
+// observe adapts the limit to the outcome of a request: it shrinks by the
+// backoff ratio when the app responded above the latency threshold or was
+// overloaded, and grows by one when the app responded in time while at least
+// half of the limit was in use.
+func (l *Limiter) observe(latency time.Duration, inflight int, overloaded bool) {
+	if !l.adaptive {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	prev := int(l.limit)
+	switch {
+	case overloaded || latency > l.threshold:
+		l.limit = math.Max(l.minLimit, math.Floor(l.limit*l.backoff))
+	case inflight*2 >= int(l.limit):
+		l.limit = math.Min(l.maxLimit, l.limit+1)
+	}
+
+	if limit := int(l.limit); limit != prev {
+		log.Debugf("Adaptive concurrency limit of the app changed from %d to %d", prev, limit)
+		diag.DefaultMonitoring.AppChannelConcurrencyLimit(limit)
+		l.admit()
+	}
+}
+
+func (l *Limiter) release() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.inflight--
+	l.admit()
+}
+
+// Observe records the outcome of the request to adapt the limit: the latency
+// of the app since the slot was acquired, and whether the app was overloaded.
+// Only the first outcome is recorded.
+func (t *Token) Observe(overloaded bool) {
+	if t == nil || !t.observed.CompareAndSwap(false, true) {
+		return
+	}
+	t.l.observe(t.l.clock.Since(t.start), t.inflight, overloaded)
+}
+
+// Release releases the slot of the request, recording it as successful if its
+// outcome wasn't observed. Releasing a Token more than once is a no-op.
+func (t *Token) Release() {
+	if t == nil || !t.released.CompareAndSwap(false, true) {
+		return
+	}
+	t.Observe(false)
+	t.l.release()
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestNew(t *testing.T) {
+	l, err := New(Options{})
+	require.NoError(t, err)
+	assert.Nil(t, l)
+	assert.Equal(t, 0, l.Limit())
+	token, err := l.Acquire(t.Context())
+	require.NoError(t, err)
+	token.Observe(true)
+	token.Release()
+
+	l, err = New(Options{MaxConcurrency: 5})
+	require.NoError(t, err)
+	assert.Equal(t, 5, l.Limit())
+
+	l, err = New(Options{Spec: &config.AdaptiveConcurrencySpec{LatencyThreshold: "1s"}})
+	require.NoError(t, err)
+	assert.Equal(t, defaultInitialLimit, l.Limit())
+	assert.InDelta(t, float64(defaultMaxLimit), l.maxLimit, 0)
+
+	l, err = New(Options{MaxConcurrency: 10, Spec: &config.AdaptiveConcurrencySpec{LatencyThreshold: "1s", MaxLimit: 50}})
+	require.NoError(t, err)
+	assert.Equal(t, 10, l.Limit())
+	assert.InDelta(t, float64(10), l.maxLimit, 0)
+
+	for _, spec := range []config.AdaptiveConcurrencySpec{
+		{},
+		{LatencyThreshold: "fast"},
+		{LatencyThreshold: "0s"},
+		{LatencyThreshold: "1s", MinLimit: -1},
+		{LatencyThreshold: "1s", MinLimit: 10, MaxLimit: 5},
+		{LatencyThreshold: "1s", BackoffPercentage: 100},
+	} {
+		_, err = New(Options{Spec: &spec})
+		require.Error(t, err, spec)
+	}
+}
+
+func TestAcquire(t *testing.T) {
+	l, err := New(Options{MaxConcurrency: 1})
+	require.NoError(t, err)
+
+	first, err := l.Acquire(t.Context())
+	require.NoError(t, err)
+
+	acquired := make(chan *Token)
+	go func() {
+		token, _ := l.Acquire(t.Context())
+		acquired <- token
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("the limit should be reached")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = l.Acquire(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	first.Release()
+	first.Release()
+	select {
+	case second := <-acquired:
+		require.NotNil(t, second)
+		second.Release()
+	case <-time.After(time.Second):
+		t.Fatal("the waiting request should have acquired the slot")
+	}
+
+	assert.Equal(t, 0, l.inflight)
+	assert.Equal(t, 0, l.waiters.Len())
+}
+
+func TestAdaptive(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	l, err := New(Options{Spec: &config.AdaptiveConcurrencySpec{
+		LatencyThreshold: "100ms",
+		MinLimit:         2,
+		MaxLimit:         5,
+		InitialLimit:     4,
+	}})
+	require.NoError(t, err)
+	l.clock = clock
+
+	acquire := func(n int) []*Token {
+		tokens := make([]*Token, n)
+		for i := range tokens {
+			tokens[i], err = l.Acquire(t.Context())
+			require.NoError(t, err)
+		}
+		return tokens
+	}
+
+	t.Run("grows while the app responds in time under load", func(t *testing.T) {
+		tokens := acquire(4)
+		for _, token := range tokens {
+			token.Release()
+		}
+		// The requests sent with at least half of the limit in use grow it,
+		// up to the max limit.
+		assert.Equal(t, 5, l.Limit())
+	})
+
+	t.Run("shrinks when the app is slow", func(t *testing.T) {
+		token := acquire(1)[0]
+		clock.Step(200 * time.Millisecond)
+		token.Release()
+		assert.Equal(t, 4, l.Limit())
+	})
+
+	t.Run("shrinks when the app is overloaded", func(t *testing.T) {
+		for range 5 {
+			token := acquire(1)[0]
+			token.Observe(true)
+			token.Release()
+		}
+		assert.Equal(t, 2, l.Limit())
+	})
+
+	t.Run("admits the waiting requests when the limit grows", func(t *testing.T) {
+		tokens := acquire(2)
+
+		acquired := make(chan *Token)
+		go func() {
+			token, _ := l.Acquire(t.Context())
+			acquired <- token
+		}()
+		assert.Eventually(t, func() bool {
+			l.lock.Lock()
+			defer l.lock.Unlock()
+			return l.waiters.Len() == 1
+		}, time.Second, 10*time.Millisecond)
+
+		tokens[0].Observe(false)
+		select {
+		case token := <-acquired:
+			assert.Equal(t, 3, l.Limit())
+			token.Release()
+		case <-time.After(time.Second):
+			t.Fatal("the waiting request should have acquired the slot")
+		}
+
+		tokens[0].Release()
+		tokens[1].Release()
+		assert.Equal(t, 0, l.inflight)
+	})
+}
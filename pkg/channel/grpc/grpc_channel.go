@@ -29,6 +29,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/actors/callbackstream"
 	"github.com/dapr/dapr/pkg/apphealth"
+	"github.com/dapr/dapr/pkg/channel/concurrency"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/messages"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
@@ -48,7 +49,7 @@ type Channel struct {
 	connFn              ConnFn
 	actorCallbackStream *callbackstream.Manager
 	baseAddress         string
-	ch                  chan struct{}
+	limiter             *concurrency.Limiter
 	tracingSpec         config.TracingSpec
 	appMetadataToken    string
 	maxRequestBodySize  int
@@ -65,12 +66,17 @@ func CreateLocalChannel(port, maxConcurrency int, connFn ConnFn, spec config.Tra
 		appMetadataToken:   appAPIToken,
 		maxRequestBodySize: maxRequestBodySize,
 	}
-	if maxConcurrency > 0 {
-		c.ch = make(chan struct{}, maxConcurrency)
-	}
+	// A fixed limit can't be invalid.
+	c.limiter, _ = concurrency.New(concurrency.Options{MaxConcurrency: maxConcurrency})
 	return c
 }
 
+// SetLimiter replaces the limit of the requests in flight toward the app with
+// the limiter, so the limit is shared by the channels of the app.
+func (g *Channel) SetLimiter(l *concurrency.Limiter) {
+	g.limiter = l
+}
+
 // SetActorCallbackStream attaches the runtime-owned stream manager that
 // the Dapr gRPC API handler registers SubscribeActorEventsAlpha1 streams
 // with, and that the actor transport consumes when sending callbacks.
@@ -118,24 +124,20 @@ func (g *Channel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRe
 }
 
 // TriggerJob sends the triggered job to the app via gRPC.
-func (g *Channel) TriggerJob(ctx context.Context, name string, data *anypb.Any) (*invokev1.InvokeMethodResponse, error) {
+func (g *Channel) TriggerJob(ctx context.Context, name string, data *anypb.Any, idempotencyToken string) (*invokev1.InvokeMethodResponse, error) {
 	if g.appHealth != nil && !g.appHealth.GetStatus().IsHealthy {
 		return nil, status.Error(codes.Internal, messages.ErrAppUnhealthy)
 	}
 
-	return g.sendJob(ctx, name, data)
+	return g.sendJob(ctx, name, data, idempotencyToken)
 }
 
-func (g *Channel) sendJob(ctx context.Context, name string, data *anypb.Any) (*invokev1.InvokeMethodResponse, error) {
-	if g.ch != nil {
-		g.ch <- struct{}{}
+func (g *Channel) sendJob(ctx context.Context, name string, data *anypb.Any, idempotencyToken string) (*invokev1.InvokeMethodResponse, error) {
+	token, err := g.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
 	}
-
-	defer func() {
-		if g.ch != nil {
-			<-g.ch
-		}
-	}()
+	defer token.Release()
 
 	conn, teardown, err := g.connFn()
 	if err != nil {
@@ -152,6 +154,8 @@ func (g *Channel) sendJob(ctx context.Context, name string, data *anypb.Any) (*i
 		Method:        "job/" + name,
 		ContentType:   data.GetTypeUrl(),
 		HttpExtension: &commonv1pb.HTTPExtension{Verb: commonv1pb.HTTPExtension_POST},
+
+		IdempotencyToken: idempotencyToken,
 	}
 
 	callOpts := []grpc.CallOption{
@@ -171,6 +175,7 @@ func (g *Channel) sendJob(ctx context.Context, name string, data *anypb.Any) (*i
 			_, err = runtimev1pb.NewAppCallbackAlphaClient(conn).OnJobEventAlpha1(ctx, req, callOpts...) //nolint:staticcheck
 		}
 	}
+	token.Observe(appOverloaded(err))
 
 	var rsp *invokev1.InvokeMethodResponse
 	if err != nil {
@@ -192,15 +197,11 @@ func (g *Channel) sendJob(ctx context.Context, name string, data *anypb.Any) (*i
 
 // invokeMethodV1 calls user applications using daprclient v1.
 func (g *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
-	if g.ch != nil {
-		g.ch <- struct{}{}
+	token, err := g.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
 	}
-
-	defer func() {
-		if g.ch != nil {
-			<-g.ch
-		}
-	}()
+	defer token.Release()
 
 	// Read the request, including the data
 	pd, err := req.ProtoWithData()
@@ -234,9 +235,8 @@ func (g *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 
 	resp, err := runtimev1pb.NewAppCallbackClient(conn).OnInvoke(ctx, pd.GetMessage(), opts...)
 
-	if g.ch != nil {
-		<-g.ch
-	}
+	token.Observe(appOverloaded(err))
+	token.Release()
 
 	var rsp *invokev1.InvokeMethodResponse
 	if err != nil {
@@ -304,3 +304,14 @@ func (g *Channel) AddAppTokenToContext(ctx context.Context) context.Context {
 	}
 	return ctx
 }
+
+// appOverloaded returns whether the error of a call to the app shows that the
+// app is overloaded.
+func appOverloaded(err error) bool {
+	switch status.Code(err) {
+	case codes.ResourceExhausted, codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
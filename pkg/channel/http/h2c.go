@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync/atomic"
+
+	"golang.org/x/net/http2"
+)
+
+// H2CTransport sends the requests to the app over HTTP/2 cleartext (h2c)
+// connections with prior knowledge. The requests are multiplexed as streams
+// over a fixed number of connections, in a round-robin order.
+type H2CTransport struct {
+	transports []*http2.Transport
+	next       atomic.Uint64
+}
+
+// NewH2CTransport returns an H2CTransport multiplexing the requests over the
+// number of connections, or over a single connection if it's not positive.
+func NewH2CTransport(connections int) *H2CTransport {
+	t := &H2CTransport{
+		transports: make([]*http2.Transport, max(connections, 1)),
+	}
+	for i := range t.transports {
+		// Each transport keeps its own connection to the app.
+		t.transports[i] = &http2.Transport{
+			AllowHTTP: true, // To enable using "http" as protocol
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				// Return the TCP socket without TLS
+				return net.Dial(network, addr)
+			},
+			// TODO: This may not be exactly the same as "MaxResponseHeaderBytes" so check before enabling this
+			// MaxHeaderListSize: uint32(a.runtimeConfig.readBufferSize),
+		}
+	}
+	return t
+}
+
+// RoundTrip sends the request on the next connection.
+func (t *H2CTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.transports) == 1 {
+		return t.transports[0].RoundTrip(req)
+	}
+	i := (t.next.Add(1) - 1) % uint64(len(t.transports))
+	return t.transports[i].RoundTrip(req)
+}
+
+// CloseIdleConnections closes the connections which have no streams.
+func (t *H2CTransport) CloseIdleConnections() {
+	for _, tr := range t.transports {
+		tr.CloseIdleConnections()
+	}
+}
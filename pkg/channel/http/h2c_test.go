@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestH2CTransport(t *testing.T) {
+	var (
+		lock    sync.Mutex
+		remotes = make(map[string]struct{})
+	)
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		remotes[r.RemoteAddr] = struct{}{}
+		lock.Unlock()
+		io.WriteString(w, r.Proto)
+	}), &http2.Server{}))
+	t.Cleanup(server.Close)
+
+	for _, connections := range []int{0, 1, 3} {
+		clear(remotes)
+		transport := NewH2CTransport(connections)
+		client := &http.Client{Transport: transport}
+
+		for range 6 {
+			resp, err := client.Get(server.URL)
+			require.NoError(t, err)
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			require.NoError(t, err)
+			assert.Equal(t, "HTTP/2.0", string(body))
+		}
+
+		assert.Len(t, remotes, max(connections, 1))
+		transport.CloseIdleConnections()
+	}
+}
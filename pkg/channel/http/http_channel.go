@@ -37,6 +37,7 @@ import (
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	"github.com/dapr/dapr/pkg/apphealth"
 	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/channel/concurrency"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
@@ -54,8 +55,11 @@ import (
 const (
 	// HTTPStatusCode is an dapr http channel status code.
 	HTTPStatusCode = "http.status_code"
-	httpScheme     = "http"
-	httpsScheme    = "https"
+	// JobIdempotencyTokenHeader is the header carrying the idempotency token
+	// of a triggered job scheduled with exactly-once semantics.
+	JobIdempotencyTokenHeader = "dapr-job-idempotency-token"
+	httpScheme                = "http"
+	httpsScheme               = "https"
 
 	appConfigEndpoint = "/dapr/config"
 
@@ -73,7 +77,7 @@ const (
 type Channel struct {
 	client              *http.Client
 	baseAddress         string
-	ch                  chan struct{}
+	limiter             *concurrency.Limiter
 	compStore           *compstore.ComponentStore
 	tracingSpec         *config.TracingSpec
 	appHeaderToken      string
@@ -97,6 +101,10 @@ type ChannelConfiguration struct {
 	TLSRootCA          string
 	TLSRenegotiation   string
 	AppAPIToken        string
+
+	// Limiter limits the requests in flight toward the app instead of
+	// MaxConcurrency, so the limit is shared by the channels of the app.
+	Limiter *concurrency.Limiter
 }
 
 // CreateHTTPChannel creates an HTTP AppChannel.
@@ -111,8 +119,13 @@ func CreateHTTPChannel(config ChannelConfiguration) (channel.AppChannel, error)
 		maxResponseBodySize: config.MaxRequestBodySize,
 	}
 
-	if config.MaxConcurrency > 0 {
-		c.ch = make(chan struct{}, config.MaxConcurrency)
+	c.limiter = config.Limiter
+	if c.limiter == nil {
+		var err error
+		c.limiter, err = concurrency.New(concurrency.Options{MaxConcurrency: config.MaxConcurrency})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return c, nil
@@ -188,12 +201,12 @@ func (h *Channel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRe
 }
 
 // TriggerJob sends the triggered job back to the app via HTTP.
-func (h *Channel) TriggerJob(ctx context.Context, name string, data *anypb.Any) (*invokev1.InvokeMethodResponse, error) {
+func (h *Channel) TriggerJob(ctx context.Context, name string, data *anypb.Any, idempotencyToken string) (*invokev1.InvokeMethodResponse, error) {
 	// passed in from the app
-	return h.sendJob(ctx, name, data)
+	return h.sendJob(ctx, name, data, idempotencyToken)
 }
 
-func (h *Channel) constructJobRequest(ctx context.Context, name string, data *anypb.Any) (*http.Request, error) {
+func (h *Channel) constructJobRequest(ctx context.Context, name string, data *anypb.Any, idempotencyToken string) (*http.Request, error) {
 	var value []byte
 	switch data.GetTypeUrl() {
 	case "type.googleapis.com/google.protobuf.Value":
@@ -218,6 +231,10 @@ func (h *Channel) constructJobRequest(ctx context.Context, name string, data *an
 
 	channelReq.Header.Set("content-type", "application/json")
 
+	if idempotencyToken != "" {
+		channelReq.Header.Set(JobIdempotencyTokenHeader, idempotencyToken)
+	}
+
 	channelReq.ContentLength = int64(len(value))
 
 	// HTTP client needs to inject trace parent header for proper tracing stack.
@@ -239,14 +256,15 @@ func (h *Channel) constructJobRequest(ctx context.Context, name string, data *an
 	return channelReq, nil
 }
 
-func (h *Channel) sendJob(ctx context.Context, name string, data *anypb.Any) (*invokev1.InvokeMethodResponse, error) {
-	channelReq, err := h.constructJobRequest(ctx, name, data)
+func (h *Channel) sendJob(ctx context.Context, name string, data *anypb.Any, idempotencyToken string) (*invokev1.InvokeMethodResponse, error) {
+	channelReq, err := h.constructJobRequest(ctx, name, data, idempotencyToken)
 	if err != nil {
 		return nil, err
 	}
 
-	if h.ch != nil {
-		h.ch <- struct{}{}
+	token, err := h.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Emit metric when request is sent
@@ -265,16 +283,13 @@ func (h *Channel) sendJob(ctx context.Context, name string, data *anypb.Any) (*i
 	go func() {
 		defer rw.signalReady()
 		defer pw.Close()
-		defer func() {
-			if h.ch != nil {
-				<-h.ch
-			}
-		}()
+		defer token.Release()
 
 		execPipeline := h.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Send request to user application
 			// (Body is closed below, but linter isn't detecting that)
 			clientResp, clientErr := h.client.Do(r)
+			token.Observe(appOverloaded(clientResp, clientErr))
 			if clientErr != nil {
 				handlerErr = clientErr
 			}
@@ -401,7 +416,8 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 	// HTTP/1.1 is unaffected because TCP reads don't check the context.
 	reqCtx := ctx
 	var reqCancel context.CancelFunc
-	if _, ok := h.client.Transport.(*http2.Transport); ok {
+	switch h.client.Transport.(type) {
+	case *http2.Transport, *H2CTransport:
 		reqCtx, reqCancel = context.WithCancel(context.WithoutCancel(ctx))
 	}
 
@@ -413,8 +429,12 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 		return nil, err
 	}
 
-	if h.ch != nil {
-		h.ch <- struct{}{}
+	token, err := h.limiter.Acquire(ctx)
+	if err != nil {
+		if reqCancel != nil {
+			reqCancel()
+		}
+		return nil, err
 	}
 
 	// Emit metric when request is sent
@@ -452,11 +472,7 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 		// finishes. Because io.Pipe is synchronous (no buffer), the goroutine
 		// naturally stays alive—and holds the slot—until the caller has
 		// consumed (or closed) the response body.
-		defer func() {
-			if h.ch != nil {
-				<-h.ch
-			}
-		}()
+		defer token.Release()
 
 		execPipeline := h.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			isSse = sse.IsSSEHttpRequest(r)
@@ -467,6 +483,7 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 			// Send request to user application
 			// (Body is closed below, but linter isn't detecting that)
 			clientResp, clientErr := h.client.Do(r)
+			token.Observe(appOverloaded(clientResp, clientErr))
 			if clientErr != nil {
 				handlerErr = clientErr
 				return
@@ -674,6 +691,15 @@ func (h *Channel) parseChannelResponse(channelResp *http.Response) (*invokev1.In
 	return rsp, nil
 }
 
+// appOverloaded returns whether the response of the app, or the error sending
+// the request to it, shows that the app is overloaded.
+func appOverloaded(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
 func copyHeader(dst http.Header, src http.Header) {
 	// Build set of headers nominated by Connection header per RFC 7230 Section 6.1.
 	connHeaders := make(map[string]struct{})
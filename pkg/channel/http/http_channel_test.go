@@ -25,10 +25,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	"github.com/dapr/dapr/pkg/apphealth"
+	"github.com/dapr/dapr/pkg/channel/concurrency"
 	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	httpMiddleware "github.com/dapr/dapr/pkg/middleware/http"
@@ -54,6 +57,13 @@ func (t *testConcurrencyHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	io.WriteString(w, r.URL.RawQuery)
 }
 
+func newTestLimiter(t *testing.T, maxConcurrency int) *concurrency.Limiter {
+	t.Helper()
+	l, err := concurrency.New(concurrency.Options{MaxConcurrency: maxConcurrency})
+	require.NoError(t, err)
+	return l
+}
+
 type testContentTypeHandler struct{}
 
 func (t *testContentTypeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -534,7 +544,7 @@ func TestInvokeMethodMaxConcurrency(t *testing.T) {
 			baseAddress: server.URL,
 			client:      http.DefaultClient,
 			compStore:   compstore.New(),
-			ch:          make(chan struct{}, 1),
+			limiter:     newTestLimiter(t, 1),
 			middleware:  httpMiddleware.New().BuildPipelineFromSpec("test", nil),
 		}
 
@@ -570,7 +580,7 @@ func TestInvokeMethodMaxConcurrency(t *testing.T) {
 			baseAddress: server.URL,
 			client:      http.DefaultClient,
 			compStore:   compstore.New(),
-			ch:          make(chan struct{}, 1),
+			limiter:     newTestLimiter(t, 1),
 			middleware:  httpMiddleware.New().BuildPipelineFromSpec("test", nil),
 		}
 
@@ -607,7 +617,7 @@ func TestInvokeMethodMaxConcurrency(t *testing.T) {
 			baseAddress: "http://0.0.0.0:0",
 			client:      http.DefaultClient,
 			compStore:   compstore.New(),
-			ch:          make(chan struct{}, 1),
+			limiter:     newTestLimiter(t, 1),
 			middleware:  httpMiddleware.New().BuildPipelineFromSpec("test", nil),
 		}
 
@@ -637,6 +647,37 @@ func TestInvokeMethodMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestInvokeMethodAdaptiveConcurrency(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	limiter, err := concurrency.New(concurrency.Options{Spec: &config.AdaptiveConcurrencySpec{
+		LatencyThreshold: "1m",
+		InitialLimit:     10,
+	}})
+	require.NoError(t, err)
+	c, err := CreateHTTPChannel(ChannelConfiguration{
+		Client:     http.DefaultClient,
+		CompStore:  compstore.New(),
+		Endpoint:   server.URL,
+		Middleware: httpMiddleware.New().BuildPipelineFromSpec("test", nil),
+		Limiter:    limiter,
+	})
+	require.NoError(t, err)
+
+	req := invokev1.NewInvokeMethodRequest("method").WithHTTPExtension("GET", "")
+	defer req.Close()
+	resp, err := c.InvokeMethod(t.Context(), req, "")
+	require.NoError(t, err)
+	defer resp.Close()
+	assert.Equal(t, int32(http.StatusServiceUnavailable), resp.Status().GetCode())
+
+	// The app is overloaded, so the limit shrinks.
+	assert.Equal(t, 9, limiter.Limit())
+}
+
 func TestInvokeWithHeaders(t *testing.T) {
 	ctx := t.Context()
 	testServer := httptest.NewServer(&testHandlerHeaders{})
@@ -851,6 +892,26 @@ func TestAppToken(t *testing.T) {
 	})
 }
 
+func TestJobIdempotencyToken(t *testing.T) {
+	c := Channel{baseAddress: "http://localhost:3000"}
+	data, err := anypb.New(wrapperspb.String("data"))
+	require.NoError(t, err)
+
+	t.Run("token present", func(t *testing.T) {
+		req, err := c.constructJobRequest(t.Context(), "job1", data, "token1")
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost:3000/job/job1", req.URL.String())
+		assert.Equal(t, "token1", req.Header.Get(JobIdempotencyTokenHeader))
+	})
+
+	t.Run("token not present", func(t *testing.T) {
+		req, err := c.constructJobRequest(t.Context(), "job1", data, "")
+		require.NoError(t, err)
+		_, hasToken := req.Header[http.CanonicalHeaderKey(JobIdempotencyTokenHeader)]
+		assert.False(t, hasToken)
+	})
+}
+
 func TestHealthProbe(t *testing.T) {
 	ctx := t.Context()
 	h := &testStatusCodeHandler{}
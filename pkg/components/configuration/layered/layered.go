@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package layered implements a configuration store which merges the
+// configuration items of other configuration stores, the layers, with the
+// items of later layers taking precedence over the items of earlier layers.
+package layered
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/metadata"
+)
+
+const (
+	// ComponentType is the type of the components of layered configuration
+	// stores.
+	ComponentType = "configuration.layered"
+
+	// storesMetadataKey is the metadata of layered configuration stores
+	// with the comma separated names of their layers, in order of increasing
+	// precedence.
+	storesMetadataKey = "stores"
+)
+
+// LookupFn returns the configuration store with the name.
+type LookupFn func(name string) (configuration.Store, bool)
+
+type subscription struct {
+	layers []string
+	ids    []string
+}
+
+// Store is a configuration store which merges the configuration items of its
+// layers.
+type Store struct {
+	name   string
+	lookup LookupFn
+	layers []string
+
+	lock          sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+// New returns a layered configuration store with the name, of which the
+// layers are looked up when used, so that the layers may be initialized after
+// the layered store.
+func New(name string, lookup LookupFn) *Store {
+	return &Store{
+		name:          name,
+		lookup:        lookup,
+		subscriptions: make(map[string]*subscription),
+	}
+}
+
+func (s *Store) Init(_ context.Context, meta configuration.Metadata) error {
+	var stores string
+	for k, v := range meta.Properties {
+		if strings.EqualFold(k, storesMetadataKey) {
+			stores = v
+		}
+	}
+
+	for _, name := range strings.Split(stores, ",") {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+			continue
+		case name == s.name:
+			return fmt.Errorf("layered configuration store %s cannot be a layer of itself", s.name)
+		}
+		s.layers = append(s.layers, name)
+	}
+	if len(s.layers) == 0 {
+		return fmt.Errorf("layered configuration store %s has no layers: the '%s' metadata is required", s.name, storesMetadataKey)
+	}
+	return nil
+}
+
+// Layers returns the names of the layers, in order of increasing precedence.
+func (s *Store) Layers() []string {
+	return s.layers
+}
+
+func (s *Store) layer(name string) (configuration.Store, error) {
+	store, ok := s.lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("layer %s of layered configuration store %s not found", name, s.name)
+	}
+	return store, nil
+}
+
+// Get returns the configuration items of all layers, of which the items of
+// later layers take precedence.
+func (s *Store) Get(ctx context.Context, req *configuration.GetRequest) (*configuration.GetResponse, error) {
+	items := make(map[string]*configuration.Item)
+	for _, name := range s.layers {
+		store, err := s.layer(name)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := store.Get(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get configuration from layer %s: %w", name, err)
+		}
+		for k, v := range resp.Items {
+			if v != nil {
+				items[k] = v
+			}
+		}
+	}
+	return &configuration.GetResponse{Items: items}, nil
+}
+
+// Subscribe subscribes to all layers. Changes of items are delivered only if
+// no later layer has an item with the same key.
+func (s *Store) Subscribe(ctx context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	id := uuid.NewString()
+	sub := &subscription{}
+
+	var lock sync.Mutex
+	// known are the keys of the items of each layer, with which the changes
+	// of items overridden by later layers are not delivered.
+	known := make([]map[string]struct{}, len(s.layers))
+	for i := range known {
+		known[i] = make(map[string]struct{})
+	}
+	for i, name := range s.layers {
+		store, err := s.layer(name)
+		if err != nil {
+			return "", errors.Join(err, s.unsubscribe(ctx, sub))
+		}
+		resp, err := store.Get(ctx, &configuration.GetRequest{Keys: req.Keys, Metadata: req.Metadata})
+		if err != nil {
+			return "", errors.Join(fmt.Errorf("failed to get configuration from layer %s: %w", name, err), s.unsubscribe(ctx, sub))
+		}
+		lock.Lock()
+		for k, v := range resp.Items {
+			if v != nil {
+				known[i][k] = struct{}{}
+			}
+		}
+		lock.Unlock()
+
+		layerID, err := store.Subscribe(ctx, req, func(ctx context.Context, e *configuration.UpdateEvent) error {
+			lock.Lock()
+			items := make(map[string]*configuration.Item, len(e.Items))
+			for k, v := range e.Items {
+				known[i][k] = struct{}{}
+				if !overridden(known[i+1:], k) {
+					items[k] = v
+				}
+			}
+			lock.Unlock()
+
+			if len(items) == 0 {
+				return nil
+			}
+			return handler(ctx, &configuration.UpdateEvent{ID: id, Items: items})
+		})
+		if err != nil {
+			return "", errors.Join(fmt.Errorf("failed to subscribe to layer %s: %w", name, err), s.unsubscribe(ctx, sub))
+		}
+		sub.layers = append(sub.layers, name)
+		sub.ids = append(sub.ids, layerID)
+	}
+
+	s.lock.Lock()
+	s.subscriptions[id] = sub
+	s.lock.Unlock()
+	return id, nil
+}
+
+func overridden(layers []map[string]struct{}, key string) bool {
+	for _, known := range layers {
+		if _, ok := known[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Unsubscribe unsubscribes from all layers.
+func (s *Store) Unsubscribe(ctx context.Context, req *configuration.UnsubscribeRequest) error {
+	s.lock.Lock()
+	sub, ok := s.subscriptions[req.ID]
+	delete(s.subscriptions, req.ID)
+	s.lock.Unlock()
+	if !ok {
+		return fmt.Errorf("subscription with id %s does not exist", req.ID)
+	}
+	return s.unsubscribe(ctx, sub)
+}
+
+func (s *Store) unsubscribe(ctx context.Context, sub *subscription) error {
+	var errs []error
+	for i, name := range sub.layers {
+		store, err := s.layer(name)
+		if err != nil {
+			// The subscriptions of layers which were closed were closed with
+			// them.
+			continue
+		}
+		if err := store.Unsubscribe(ctx, &configuration.UnsubscribeRequest{ID: sub.ids[i]}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to unsubscribe from layer %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Store) GetComponentMetadata() metadata.MetadataMap {
+	return metadata.MetadataMap{}
+}
+
+// Close closes the subscriptions of the layered configuration store. The
+// layers are not closed, as they are components of their own.
+func (s *Store) Close() error {
+	s.lock.Lock()
+	subs := s.subscriptions
+	s.subscriptions = make(map[string]*subscription)
+	s.lock.Unlock()
+
+	var errs []error
+	for _, sub := range subs {
+		errs = append(errs, s.unsubscribe(context.Background(), sub))
+	}
+	return errors.Join(errs...)
+}
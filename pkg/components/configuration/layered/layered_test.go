@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package layered
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/metadata"
+)
+
+type fakeStore struct {
+	lock     sync.Mutex
+	items    map[string]*configuration.Item
+	handlers map[string]configuration.UpdateHandler
+	getErr   error
+}
+
+func newFakeStore(items map[string]string) *fakeStore {
+	f := &fakeStore{
+		items:    make(map[string]*configuration.Item),
+		handlers: make(map[string]configuration.UpdateHandler),
+	}
+	for k, v := range items {
+		f.items[k] = &configuration.Item{Value: v}
+	}
+	return f
+}
+
+func (f *fakeStore) update(t *testing.T, key, value string) {
+	t.Helper()
+	f.lock.Lock()
+	f.items[key] = &configuration.Item{Value: value}
+	handlers := make(map[string]configuration.UpdateHandler, len(f.handlers))
+	for id, h := range f.handlers {
+		handlers[id] = h
+	}
+	f.lock.Unlock()
+	for id, h := range handlers {
+		require.NoError(t, h(t.Context(), &configuration.UpdateEvent{
+			ID:    id,
+			Items: map[string]*configuration.Item{key: {Value: value}},
+		}))
+	}
+}
+
+func (f *fakeStore) Init(context.Context, configuration.Metadata) error { return nil }
+
+func (f *fakeStore) Get(_ context.Context, req *configuration.GetRequest) (*configuration.GetResponse, error) {
+	if f.getErr != nil {
+		return nil, f.getErr
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	items := make(map[string]*configuration.Item)
+	for k, v := range f.items {
+		items[k] = v
+	}
+	if len(req.Keys) > 0 {
+		items = make(map[string]*configuration.Item)
+		for _, k := range req.Keys {
+			if v, ok := f.items[k]; ok {
+				items[k] = v
+			}
+		}
+	}
+	return &configuration.GetResponse{Items: items}, nil
+}
+
+func (f *fakeStore) Subscribe(_ context.Context, _ *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	id := strconv.Itoa(len(f.handlers))
+	f.handlers[id] = handler
+	return id, nil
+}
+
+func (f *fakeStore) Unsubscribe(_ context.Context, req *configuration.UnsubscribeRequest) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.handlers, req.ID)
+	return nil
+}
+
+func (f *fakeStore) GetComponentMetadata() metadata.MetadataMap { return nil }
+
+func (f *fakeStore) Close() error { return nil }
+
+func newLayered(t *testing.T, stores map[string]configuration.Store, layers string) *Store {
+	t.Helper()
+	s := New("layered", func(name string) (configuration.Store, bool) {
+		store, ok := stores[name]
+		return store, ok
+	})
+	require.NoError(t, s.Init(t.Context(), configuration.Metadata{Base: metadata.Base{
+		Properties: map[string]string{"stores": layers},
+	}}))
+	return s
+}
+
+func TestInit(t *testing.T) {
+	s := newLayered(t, nil, " base, ,overrides ")
+	assert.Equal(t, []string{"base", "overrides"}, s.Layers())
+
+	for _, stores := range []string{"", " , ", "base,layered"} {
+		s := New("layered", nil)
+		err := s.Init(t.Context(), configuration.Metadata{Base: metadata.Base{
+			Properties: map[string]string{"stores": stores},
+		}})
+		require.Error(t, err, stores)
+	}
+}
+
+func TestGet(t *testing.T) {
+	base := newFakeStore(map[string]string{"a": "base-a", "b": "base-b"})
+	overrides := newFakeStore(map[string]string{"b": "overrides-b", "c": "overrides-c"})
+	s := newLayered(t, map[string]configuration.Store{"base": base, "overrides": overrides}, "base,overrides")
+
+	t.Run("later layers take precedence", func(t *testing.T) {
+		resp, err := s.Get(t.Context(), &configuration.GetRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*configuration.Item{
+			"a": {Value: "base-a"},
+			"b": {Value: "overrides-b"},
+			"c": {Value: "overrides-c"},
+		}, resp.Items)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		resp, err := s.Get(t.Context(), &configuration.GetRequest{Keys: []string{"a", "b"}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*configuration.Item{
+			"a": {Value: "base-a"},
+			"b": {Value: "overrides-b"},
+		}, resp.Items)
+	})
+
+	t.Run("layer not found", func(t *testing.T) {
+		s := newLayered(t, map[string]configuration.Store{"base": base}, "base,overrides")
+		_, err := s.Get(t.Context(), &configuration.GetRequest{})
+		require.ErrorContains(t, err, "layer overrides of layered configuration store layered not found")
+	})
+
+	t.Run("layer error", func(t *testing.T) {
+		failing := newFakeStore(nil)
+		failing.getErr = errors.New("boom")
+		s := newLayered(t, map[string]configuration.Store{"base": base, "failing": failing}, "base,failing")
+		_, err := s.Get(t.Context(), &configuration.GetRequest{})
+		require.ErrorContains(t, err, "boom")
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	base := newFakeStore(map[string]string{"a": "base-a", "b": "base-b"})
+	overrides := newFakeStore(map[string]string{"b": "overrides-b"})
+	s := newLayered(t, map[string]configuration.Store{"base": base, "overrides": overrides}, "base,overrides")
+
+	var lock sync.Mutex
+	var events []*configuration.UpdateEvent
+	id, err := s.Subscribe(t.Context(), &configuration.SubscribeRequest{}, func(_ context.Context, e *configuration.UpdateEvent) error {
+		lock.Lock()
+		defer lock.Unlock()
+		events = append(events, e)
+		return nil
+	})
+	require.NoError(t, err)
+
+	base.update(t, "a", "base-a2")
+	// Changes of items overridden by later layers are not delivered.
+	base.update(t, "b", "base-b2")
+	overrides.update(t, "b", "overrides-b2")
+	overrides.update(t, "c", "overrides-c")
+	base.update(t, "c", "base-c")
+
+	lock.Lock()
+	assert.Equal(t, []*configuration.UpdateEvent{
+		{ID: id, Items: map[string]*configuration.Item{"a": {Value: "base-a2"}}},
+		{ID: id, Items: map[string]*configuration.Item{"b": {Value: "overrides-b2"}}},
+		{ID: id, Items: map[string]*configuration.Item{"c": {Value: "overrides-c"}}},
+	}, events)
+	lock.Unlock()
+
+	require.NoError(t, s.Unsubscribe(t.Context(), &configuration.UnsubscribeRequest{ID: id}))
+	assert.Empty(t, base.handlers)
+	assert.Empty(t, overrides.handlers)
+	require.Error(t, s.Unsubscribe(t.Context(), &configuration.UnsubscribeRequest{ID: id}))
+
+	t.Run("subscriptions are closed with the store", func(t *testing.T) {
+		_, err := s.Subscribe(t.Context(), &configuration.SubscribeRequest{}, func(context.Context, *configuration.UpdateEvent) error { return nil })
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+		assert.Empty(t, base.handlers)
+		assert.Empty(t, overrides.handlers)
+	})
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package subscription implements the runtime side of subscriptions to
+// configuration stores: subscriptions to key prefixes, which configuration
+// stores do not support, and the coalescing of rapid changes into a single
+// update.
+package subscription
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.configuration.subscription")
+
+// Options are the options for a Subscription.
+type Options struct {
+	// Keys are the keys of the configuration items subscribed to. All keys
+	// are subscribed to if both Keys and KeyPrefixes are empty.
+	Keys []string
+
+	// KeyPrefixes are the prefixes of the keys of the configuration items
+	// subscribed to, in addition to Keys.
+	KeyPrefixes []string
+
+	// CoalesceInterval is the interval over which changes are coalesced into
+	// a single update. Changes are delivered as they happen if zero.
+	CoalesceInterval time.Duration
+
+	Clock clock.WithDelayedExecution
+}
+
+// Subscription filters and coalesces the update events of a configuration
+// store for a subscriber.
+type Subscription struct {
+	keys             []string
+	keyPrefixes      []string
+	coalesceInterval time.Duration
+	clock            clock.WithDelayedExecution
+
+	lock    sync.Mutex
+	pending *configuration.UpdateEvent
+	timer   clock.Timer
+	closed  bool
+}
+
+// New returns a Subscription with the options. It returns an error if the
+// options are invalid.
+func New(opts Options) (*Subscription, error) {
+	if slices.Contains(opts.KeyPrefixes, "") {
+		return nil, errors.New("key prefixes cannot be empty")
+	}
+	if opts.CoalesceInterval < 0 {
+		return nil, errors.New("coalesce interval must not be negative")
+	}
+
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+	return &Subscription{
+		keys:             opts.Keys,
+		keyPrefixes:      opts.KeyPrefixes,
+		coalesceInterval: opts.CoalesceInterval,
+		clock:            cl,
+	}, nil
+}
+
+// StoreKeys returns the keys to subscribe the configuration store to. These
+// are all keys if the subscription has key prefixes, as the changes are then
+// filtered by Handler.
+func (s *Subscription) StoreKeys() []string {
+	if len(s.keyPrefixes) > 0 {
+		return nil
+	}
+	return s.keys
+}
+
+// Handler returns the handler of the update events of the configuration
+// store, which delivers the changes to the subscribed items to the handler.
+// Coalesced changes are delivered once the coalesce interval after the first
+// of them ends, and failures to deliver them are only logged, as the
+// configuration store has already moved on.
+func (s *Subscription) Handler(handler configuration.UpdateHandler) configuration.UpdateHandler {
+	return func(ctx context.Context, e *configuration.UpdateEvent) error {
+		e = s.filter(e)
+		if e == nil {
+			return nil
+		}
+		if s.coalesceInterval == 0 {
+			return handler(ctx, e)
+		}
+
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		if s.closed {
+			return nil
+		}
+		if s.pending != nil {
+			s.pending.ID = e.ID
+			maps.Copy(s.pending.Items, e.Items)
+			return nil
+		}
+
+		s.pending = e
+		s.timer = s.clock.AfterFunc(s.coalesceInterval, func() {
+			s.lock.Lock()
+			pending := s.pending
+			s.pending = nil
+			s.lock.Unlock()
+
+			if pending == nil {
+				return
+			}
+			if err := handler(context.WithoutCancel(ctx), pending); err != nil {
+				log.Warnf("Failed to deliver coalesced configuration update of subscription %s: %v", pending.ID, err)
+			}
+		})
+		return nil
+	}
+}
+
+// Close drops the changes not delivered yet, and stops delivering changes.
+func (s *Subscription) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.closed = true
+	s.pending = nil
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+}
+
+// filter returns the event with the changes to the subscribed items only, or
+// nil if there are none.
+func (s *Subscription) filter(e *configuration.UpdateEvent) *configuration.UpdateEvent {
+	if e == nil || len(e.Items) == 0 {
+		return nil
+	}
+
+	items := make(map[string]*configuration.Item, len(e.Items))
+	for key, item := range e.Items {
+		if s.subscribed(key) {
+			items[key] = item
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return &configuration.UpdateEvent{ID: e.ID, Items: items}
+}
+
+func (s *Subscription) subscribed(key string) bool {
+	if len(s.keyPrefixes) == 0 {
+		// The configuration store is subscribed to the keys only.
+		return true
+	}
+	return slices.Contains(s.keys, key) || slices.ContainsFunc(s.keyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
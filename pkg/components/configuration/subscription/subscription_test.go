@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/configuration"
+)
+
+// recorder records the update events delivered to it.
+type recorder struct {
+	lock   sync.Mutex
+	events []*configuration.UpdateEvent
+}
+
+func (r *recorder) handle(_ context.Context, e *configuration.UpdateEvent) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *recorder) delivered() []*configuration.UpdateEvent {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.events
+}
+
+func event(id string, keys ...string) *configuration.UpdateEvent {
+	e := &configuration.UpdateEvent{ID: id, Items: make(map[string]*configuration.Item, len(keys))}
+	for _, key := range keys {
+		e.Items[key] = &configuration.Item{Value: id}
+	}
+	return e
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Options{KeyPrefixes: []string{"a", ""}})
+	require.Error(t, err)
+	_, err = New(Options{CoalesceInterval: -time.Second})
+	require.Error(t, err)
+}
+
+func TestStoreKeys(t *testing.T) {
+	s, err := New(Options{Keys: []string{"a", "b"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, s.StoreKeys())
+
+	s, err = New(Options{Keys: []string{"a"}, KeyPrefixes: []string{"feature."}})
+	require.NoError(t, err)
+	assert.Empty(t, s.StoreKeys())
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("filters by key prefix", func(t *testing.T) {
+		s, err := New(Options{Keys: []string{"limit"}, KeyPrefixes: []string{"feature."}})
+		require.NoError(t, err)
+		var r recorder
+		handler := s.Handler(r.handle)
+
+		require.NoError(t, handler(t.Context(), event("1", "feature.a", "limit", "other")))
+		require.NoError(t, handler(t.Context(), event("2", "other")))
+
+		events := r.delivered()
+		require.Len(t, events, 1)
+		assert.Equal(t, "1", events[0].ID)
+		assert.Len(t, events[0].Items, 2)
+		assert.Contains(t, events[0].Items, "feature.a")
+		assert.Contains(t, events[0].Items, "limit")
+	})
+
+	t.Run("keys only are not filtered", func(t *testing.T) {
+		s, err := New(Options{Keys: []string{"a"}})
+		require.NoError(t, err)
+		var r recorder
+		require.NoError(t, s.Handler(r.handle)(t.Context(), event("1", "a", "b")))
+		require.Len(t, r.delivered(), 1)
+		assert.Len(t, r.delivered()[0].Items, 2)
+	})
+
+	t.Run("coalesces changes", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		s, err := New(Options{CoalesceInterval: time.Second, Clock: clock})
+		require.NoError(t, err)
+		var r recorder
+		handler := s.Handler(r.handle)
+
+		require.NoError(t, handler(t.Context(), event("1", "a", "b")))
+		require.NoError(t, handler(t.Context(), event("2", "a")))
+		assert.Empty(t, r.delivered())
+
+		clock.Step(time.Second)
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			events := r.delivered()
+			if assert.Len(c, events, 1) {
+				assert.Equal(c, "2", events[0].Items["a"].Value)
+				assert.Equal(c, "1", events[0].Items["b"].Value)
+			}
+		}, time.Second*5, time.Millisecond*10)
+
+		require.NoError(t, handler(t.Context(), event("3", "b")))
+		clock.Step(time.Second)
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Len(c, r.delivered(), 2)
+		}, time.Second*5, time.Millisecond*10)
+	})
+
+	t.Run("close drops pending changes", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		s, err := New(Options{CoalesceInterval: time.Second, Clock: clock})
+		require.NoError(t, err)
+		var r recorder
+		handler := s.Handler(r.handle)
+
+		require.NoError(t, handler(t.Context(), event("1", "a")))
+		s.Close()
+		clock.Step(time.Second)
+		require.NoError(t, handler(t.Context(), event("2", "a")))
+		clock.Step(time.Second)
+		assert.Empty(t, r.delivered())
+	})
+}
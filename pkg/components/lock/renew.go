@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/lock"
+)
+
+// FencingTokenMetadataKey is the metadata of the responses of lock stores with
+// which lock stores return the fencing tokens of acquired locks.
+const FencingTokenMetadataKey = "fencingToken"
+
+// RenewLockRequest is the request to extend the expiry of a lock.
+type RenewLockRequest struct {
+	ResourceID      string            `json:"resourceId"`
+	LockOwner       string            `json:"lockOwner"`
+	ExpiryInSeconds int32             `json:"expiryInSeconds"`
+	Metadata        map[string]string `json:"metadata"`
+}
+
+// RenewLockResponse is the response of the renewal of a lock.
+type RenewLockResponse struct {
+	Status   lock.Status       `json:"status"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// Renewer is implemented by lock stores which renew locks natively. Locks
+// cannot be renewed in other lock stores. Renewing a lock keeps its fencing
+// token.
+type Renewer interface {
+	RenewLock(ctx context.Context, req *RenewLockRequest) (*RenewLockResponse, error)
+}
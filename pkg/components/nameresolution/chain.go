@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nameresolution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.nameresolution")
+
+const (
+	// chainFailureThreshold is the number of consecutive failures after which
+	// a resolver of a chain is considered unhealthy.
+	chainFailureThreshold = 5
+	// chainCooldown is how long an unhealthy resolver of a chain is tried only
+	// after the healthy ones.
+	chainCooldown = 30 * time.Second
+)
+
+// ChainMember is a name resolver of a chain.
+type ChainMember struct {
+	// Name is the name of the resolver component, used in its metadata and
+	// in logs.
+	Name     string
+	Resolver nr.Resolver
+	// Configuration is the configuration of the resolver.
+	Configuration any
+}
+
+type chainMember struct {
+	ChainMember
+
+	lock           sync.Mutex
+	failures       int
+	unhealthyUntil time.Time
+}
+
+// chain is a name resolver which resolves the apps with the first of its
+// resolvers which can.
+type chain struct {
+	members []*chainMember
+	clock   clock.Clock
+}
+
+// multiChain is a chain of resolvers which all return multiple addresses.
+type multiChain struct {
+	*chain
+}
+
+// NewChain returns a name resolver which resolves the apps with the first of
+// the given resolvers which can, in order. Resolvers which fail repeatedly
+// are tried after the others for a while. The chain returns multiple
+// addresses if all the resolvers do.
+func NewChain(members ...ChainMember) nr.Resolver {
+	return newChain(clock.RealClock{}, members...)
+}
+
+func newChain(clock clock.Clock, members ...ChainMember) nr.Resolver {
+	c := &chain{
+		members: make([]*chainMember, len(members)),
+		clock:   clock,
+	}
+	multi := true
+	for i, m := range members {
+		c.members[i] = &chainMember{ChainMember: m}
+		if _, ok := m.Resolver.(nr.ResolverMulti); !ok {
+			multi = false
+		}
+	}
+	if multi {
+		return multiChain{chain: c}
+	}
+	return c
+}
+
+// Init initializes the resolvers of the chain with their configuration.
+func (c *chain) Init(ctx context.Context, metadata nr.Metadata) error {
+	for _, m := range c.members {
+		md := metadata
+		md.Name = m.Name
+		md.Configuration = m.Configuration
+		if err := m.Resolver.Init(ctx, md); err != nil {
+			return fmt.Errorf("failed to init name resolver %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+// ResolveID resolves the app with the first resolver which can.
+func (c *chain) ResolveID(ctx context.Context, req nr.ResolveRequest) (string, error) {
+	var address string
+	err := c.resolve(ctx, req, func(r nr.Resolver) (err error) {
+		address, err = r.ResolveID(ctx, req)
+		if err == nil && address == "" {
+			err = errors.New("resolver returned empty address")
+		}
+		return err
+	})
+	return address, err
+}
+
+// ResolveIDMulti resolves the app with the first resolver which can.
+func (c multiChain) ResolveIDMulti(ctx context.Context, req nr.ResolveRequest) (nr.AddressList, error) {
+	var addresses nr.AddressList
+	err := c.resolve(ctx, req, func(r nr.Resolver) (err error) {
+		addresses, err = r.(nr.ResolverMulti).ResolveIDMulti(ctx, req)
+		if err == nil && len(addresses) == 0 {
+			err = errors.New("resolver returned no addresses")
+		}
+		return err
+	})
+	return addresses, err
+}
+
+func (c *chain) resolve(ctx context.Context, req nr.ResolveRequest, fn func(nr.Resolver) error) error {
+	errs := make([]error, 0, len(c.members))
+	for _, m := range c.ordered() {
+		err := fn(m.Resolver)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		c.report(m, err)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", m.Name, err))
+	}
+	return fmt.Errorf("failed to resolve app id %s/%s: %w", req.Namespace, req.ID, errors.Join(errs...))
+}
+
+// ordered returns the healthy resolvers followed by the unhealthy ones.
+func (c *chain) ordered() []*chainMember {
+	now := c.clock.Now()
+	members := make([]*chainMember, 0, len(c.members))
+	var unhealthy []*chainMember
+	for _, m := range c.members {
+		m.lock.Lock()
+		healthy := !now.Before(m.unhealthyUntil)
+		m.lock.Unlock()
+		if healthy {
+			members = append(members, m)
+		} else {
+			unhealthy = append(unhealthy, m)
+		}
+	}
+	return append(members, unhealthy...)
+}
+
+func (c *chain) report(m *chainMember, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err == nil {
+		m.failures = 0
+		m.unhealthyUntil = time.Time{}
+		return
+	}
+
+	m.failures++
+	if m.failures >= chainFailureThreshold {
+		m.failures = 0
+		m.unhealthyUntil = c.clock.Now().Add(chainCooldown)
+		log.Warnf("Name resolver %s failed %d times in a row, trying the other resolvers first for %s: %v", m.Name, chainFailureThreshold, chainCooldown, err)
+	}
+}
+
+// Close closes the resolvers of the chain.
+func (c *chain) Close() error {
+	errs := make([]error, 0, len(c.members))
+	for _, m := range c.members {
+		errs = append(errs, m.Resolver.Close())
+	}
+	return errors.Join(errs...)
+}
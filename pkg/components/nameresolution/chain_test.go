@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nameresolution
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+)
+
+type fakeResolver struct {
+	name      string
+	addresses map[string]string
+	calls     int
+	md        nr.Metadata
+	closed    bool
+}
+
+func (f *fakeResolver) Init(_ context.Context, md nr.Metadata) error {
+	f.md = md
+	return nil
+}
+
+func (f *fakeResolver) ResolveID(_ context.Context, req nr.ResolveRequest) (string, error) {
+	f.calls++
+	address, ok := f.addresses[req.ID]
+	if !ok {
+		return "", errors.New(f.name + " can't resolve " + req.ID)
+	}
+	return address, nil
+}
+
+func (f *fakeResolver) Close() error {
+	f.closed = true
+	return nil
+}
+
+type fakeMultiResolver struct {
+	*fakeResolver
+}
+
+func (f fakeMultiResolver) ResolveIDMulti(ctx context.Context, req nr.ResolveRequest) (nr.AddressList, error) {
+	address, err := f.ResolveID(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return nr.AddressList{address}, nil
+}
+
+func TestChain(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	k8s := &fakeResolver{name: "kubernetes", addresses: map[string]string{"orders": "orders:50002"}}
+	consul := &fakeResolver{name: "consul", addresses: map[string]string{"orders": "10.0.0.1:50002", "legacy": "10.0.0.2:50002"}}
+	resolver := newChain(clock,
+		ChainMember{Name: "kubernetes", Resolver: k8s, Configuration: "k8s"},
+		ChainMember{Name: "consul", Resolver: consul, Configuration: "consul"},
+	)
+
+	require.NoError(t, resolver.Init(t.Context(), nr.Metadata{Instance: nr.Instance{AppID: "myapp"}}))
+	assert.Equal(t, "kubernetes", k8s.md.Name)
+	assert.Equal(t, "k8s", k8s.md.Configuration)
+	assert.Equal(t, "consul", consul.md.Name)
+	assert.Equal(t, "myapp", consul.md.Instance.AppID)
+
+	_, ok := resolver.(nr.ResolverMulti)
+	assert.False(t, ok)
+
+	resolve := func(id string) (string, error) {
+		return resolver.ResolveID(t.Context(), nr.ResolveRequest{ID: id, Namespace: "default"})
+	}
+
+	t.Run("first resolver which can resolves the app", func(t *testing.T) {
+		address, err := resolve("orders")
+		require.NoError(t, err)
+		assert.Equal(t, "orders:50002", address)
+
+		address, err = resolve("legacy")
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.2:50002", address)
+	})
+
+	t.Run("errors of all resolvers are returned", func(t *testing.T) {
+		_, err := resolve("unknown")
+		require.ErrorContains(t, err, "kubernetes can't resolve unknown")
+		require.ErrorContains(t, err, "consul can't resolve unknown")
+	})
+
+	t.Run("failing resolvers are tried last", func(t *testing.T) {
+		for range chainFailureThreshold {
+			_, err := resolve("legacy")
+			require.NoError(t, err)
+		}
+
+		k8s.calls = 0
+		address, err := resolve("legacy")
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.2:50002", address)
+		assert.Zero(t, k8s.calls)
+
+		// Unhealthy resolvers are still tried when the others fail.
+		address, err = resolve("orders")
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.1:50002", address)
+		_, err = resolve("unknown")
+		require.Error(t, err)
+		assert.Equal(t, 1, k8s.calls)
+
+		clock.Step(chainCooldown)
+		address, err = resolve("orders")
+		require.NoError(t, err)
+		assert.Equal(t, "orders:50002", address)
+		assert.Equal(t, 2, k8s.calls)
+	})
+
+	require.NoError(t, resolver.Close())
+	assert.True(t, k8s.closed)
+	assert.True(t, consul.closed)
+}
+
+func TestMultiChain(t *testing.T) {
+	k8s := fakeMultiResolver{&fakeResolver{name: "kubernetes", addresses: map[string]string{"orders": "orders:50002"}}}
+	consul := fakeMultiResolver{&fakeResolver{name: "consul", addresses: map[string]string{"legacy": "10.0.0.2:50002"}}}
+	resolver := NewChain(
+		ChainMember{Name: "kubernetes", Resolver: k8s},
+		ChainMember{Name: "consul", Resolver: consul},
+	)
+
+	multi, ok := resolver.(nr.ResolverMulti)
+	require.True(t, ok)
+	addresses, err := multi.ResolveIDMulti(t.Context(), nr.ResolveRequest{ID: "legacy"})
+	require.NoError(t, err)
+	assert.Equal(t, nr.AddressList{"10.0.0.2:50002"}, addresses)
+
+	_, err = multi.ResolveIDMulti(t.Context(), nr.ResolveRequest{ID: "unknown"})
+	require.Error(t, err)
+}
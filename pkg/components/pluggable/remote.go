@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluggable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"google.golang.org/grpc"
+	grpcbackoff "google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/keepalive"
+	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+const (
+	// remoteDialTimeout bounds establishing the connection to a remote
+	// endpoint, so components fail to initialize rather than hang when the
+	// endpoint is unreachable.
+	remoteDialTimeout = 10 * time.Second
+
+	// remoteDiscoveryTimeout bounds retrying listing the services of a
+	// remote endpoint which isn't reachable when the sidecar starts.
+	remoteDiscoveryTimeout = 30 * time.Second
+)
+
+// RemoteEndpoint is a pluggable component served over TCP by an endpoint
+// which isn't in the pod, e.g. a component shared by the sidecars of a
+// cluster.
+type RemoteEndpoint struct {
+	// Name is the name of the pluggable component, which is used in the type
+	// of the components, as the name of the socket is for local components.
+	Name string
+	// Address is the address of the endpoint, as host:port.
+	Address string
+	// Credentials is the dial option of the transport credentials, usually
+	// authenticating the endpoint with mTLS.
+	Credentials grpc.DialOption
+}
+
+// remoteDialOptions returns the dial options of the connections to remote
+// endpoints. Unlike sockets, the connections to remote endpoints are expected
+// to break, so they are health checked with keepalives and re-established
+// with an exponential backoff.
+func remoteDialOptions(endpoint RemoteEndpoint) []grpc.DialOption {
+	return []grpc.DialOption{
+		endpoint.Credentials,
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                10 * time.Second,
+			Timeout:             5 * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: grpcbackoff.Config{
+				BaseDelay:  time.Second,
+				Multiplier: 1.6,
+				Jitter:     0.2,
+				MaxDelay:   30 * time.Second,
+			},
+			MinConnectTimeout: 5 * time.Second,
+		}),
+	}
+}
+
+// remoteDialer creates a dialer for the given remote endpoint.
+func remoteDialer(endpoint RemoteEndpoint) GRPCConnectionDialer {
+	return func(ctx context.Context, name string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+		additionalOpts := append(remoteDialOptions(endpoint),
+			grpc.WithBlock(), //nolint:staticcheck
+			grpc.WithStreamInterceptor(instanceIDStreamInterceptor(name)),
+			grpc.WithUnaryInterceptor(instanceIDUnaryInterceptor(name)),
+		)
+
+		dialCtx, cancel := context.WithTimeout(ctx, remoteDialTimeout)
+		defer cancel()
+		log.Debugf("using remote endpoint '%s' of pluggable component '%s'", endpoint.Address, endpoint.Name)
+		//nolint:staticcheck
+		conn, err := grpc.DialContext(dialCtx, endpoint.Address, append(additionalOpts, opts...)...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open GRPC connection to remote endpoint '%s': %w", endpoint.Address, err)
+		}
+		return conn, nil
+	}
+}
+
+// remoteServiceDiscovery returns the services implemented by the remote
+// endpoints. The endpoints whose services can't be listed are skipped, so
+// an unreachable endpoint only fails the components using it.
+func remoteServiceDiscovery(endpoints []RemoteEndpoint, reflectClientFactory func(RemoteEndpoint) (reflectServiceClient, func(), error), bo func() backoff.BackOff) []service {
+	services := []service{}
+	for _, endpoint := range endpoints {
+		var serviceList []string
+		err := backoff.Retry(func() error {
+			refctClient, cleanup, err := reflectClientFactory(endpoint)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			serviceList, err = refctClient.ListServices()
+			if err != nil {
+				return fmt.Errorf("unable to list services: %w", err)
+			}
+			return nil
+		}, bo())
+		if err != nil {
+			discoveryLog.Errorf("could not discover the services of the remote endpoint '%s' of pluggable component '%s': %s", endpoint.Address, endpoint.Name, err)
+			continue
+		}
+
+		dialer := remoteDialer(endpoint)
+		for _, svc := range serviceList {
+			services = append(services, service{
+				componentName: endpoint.Name,
+				protoRef:      svc,
+				dialer:        dialer,
+			})
+		}
+	}
+	return services
+}
+
+// DiscoverRemote discovers the pluggable components served by the remote
+// endpoints and callbacks the service discovery with the given component name
+// and grpc dialer.
+func DiscoverRemote(ctx context.Context, endpoints []RemoteEndpoint) {
+	services := remoteServiceDiscovery(endpoints, func(endpoint RemoteEndpoint) (reflectServiceClient, func(), error) {
+		dialCtx, cancel := context.WithTimeout(ctx, remoteDialTimeout)
+		defer cancel()
+		//nolint:staticcheck
+		conn, err := grpc.DialContext(dialCtx, endpoint.Address, append(remoteDialOptions(endpoint), grpc.WithBlock())...)
+		if err != nil {
+			return nil, nil, err
+		}
+		client := grpcreflect.NewClientV1Alpha(ctx, reflectpb.NewServerReflectionClient(conn))
+		return client, reflectServiceConnectionCloser(conn, client), nil
+	}, func() backoff.BackOff {
+		return backoff.WithContext(backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(remoteDiscoveryTimeout)), ctx)
+	})
+
+	callback(services)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluggable
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+)
+
+func TestRemoteServiceDiscovery(t *testing.T) {
+	noRetries := func() backoff.BackOff {
+		return &backoff.StopBackOff{}
+	}
+
+	t.Run("services of the remote endpoints are discovered", func(t *testing.T) {
+		endpoints := []RemoteEndpoint{
+			{Name: "cache", Address: "cache.infra:50001"},
+			{Name: "queue", Address: "queue.infra:50001"},
+		}
+		cleanups := 0
+		services := remoteServiceDiscovery(endpoints, func(endpoint RemoteEndpoint) (reflectServiceClient, func(), error) {
+			svc := &fakeReflectService{listServicesResp: []string{"svc-" + endpoint.Name}}
+			return svc, func() { cleanups++ }, nil
+		}, noRetries)
+
+		require.Len(t, services, 2)
+		assert.Equal(t, "cache", services[0].componentName)
+		assert.Equal(t, "svc-cache", services[0].protoRef)
+		assert.Equal(t, "queue", services[1].componentName)
+		assert.Equal(t, "svc-queue", services[1].protoRef)
+		assert.Equal(t, 2, cleanups)
+	})
+
+	t.Run("unreachable endpoints are retried, then skipped", func(t *testing.T) {
+		endpoints := []RemoteEndpoint{
+			{Name: "down", Address: "down.infra:50001"},
+			{Name: "flaky", Address: "flaky.infra:50001"},
+		}
+		attempts := map[string]int{}
+		services := remoteServiceDiscovery(endpoints, func(endpoint RemoteEndpoint) (reflectServiceClient, func(), error) {
+			attempts[endpoint.Name]++
+			if endpoint.Name == "down" || attempts[endpoint.Name] < 3 {
+				return nil, nil, errors.New("connection refused")
+			}
+			return &fakeReflectService{listServicesResp: []string{"svc"}}, func() {}, nil
+		}, func() backoff.BackOff {
+			return backoff.WithMaxRetries(&backoff.ZeroBackOff{}, 4)
+		})
+
+		require.Len(t, services, 1)
+		assert.Equal(t, "flaky", services[0].componentName)
+		assert.Equal(t, 5, attempts["down"])
+		assert.Equal(t, 3, attempts["flaky"])
+	})
+}
+
+type pingServer struct {
+	proto.UnimplementedStateStoreServer
+}
+
+func (pingServer) Ping(context.Context, *proto.PingRequest) (*proto.PingResponse, error) {
+	return &proto.PingResponse{}, nil
+}
+
+func TestRemoteDialer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	proto.RegisterStateStoreServer(s, pingServer{})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	dialer := remoteDialer(RemoteEndpoint{
+		Name:        "cache",
+		Address:     lis.Addr().String(),
+		Credentials: grpc.WithTransportCredentials(insecure.NewCredentials()),
+	})
+	connector := NewGRPCConnectorWithDialer(dialer, proto.NewStateStoreClient)
+	require.NoError(t, connector.Dial("statestore"))
+	t.Cleanup(func() { connector.Close() })
+	require.NoError(t, connector.Ping())
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema validates the specs of components against the metadata
+// schemas of the components, in the format of the metadata.yaml files of
+// components-contrib.
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.components.schema")
+
+// Field is a metadata field of a component.
+type Field struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required,omitempty"`
+	// AllowedValues are the values the field may have, if not empty.
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// AuthenticationProfile is a set of metadata fields authenticating the
+// component.
+type AuthenticationProfile struct {
+	Title    string  `json:"title,omitempty"`
+	Metadata []Field `json:"metadata,omitempty"`
+}
+
+// BuiltinAuthenticationProfile is a reference to an authentication profile
+// shared by components, whose fields aren't part of the schema.
+type BuiltinAuthenticationProfile struct {
+	Name string `json:"name"`
+}
+
+// Schema is the metadata schema of a component.
+type Schema struct {
+	Type                          string                         `json:"type"`
+	Name                          string                         `json:"name"`
+	Version                       string                         `json:"version"`
+	Metadata                      []Field                        `json:"metadata,omitempty"`
+	AuthenticationProfiles        []AuthenticationProfile        `json:"authenticationProfiles,omitempty"`
+	BuiltinAuthenticationProfiles []BuiltinAuthenticationProfile `json:"builtinAuthenticationProfiles,omitempty"`
+}
+
+// key returns the key of the schema: the type of the components, such as
+// "state.redis", and their version.
+func (s *Schema) key() string {
+	return s.Type + "." + s.Name + "/" + s.Version
+}
+
+// SecretLookup returns whether the key exists in the Kubernetes secret of the
+// namespace.
+type SecretLookup func(ctx context.Context, namespace, name, key string) (bool, error)
+
+// Registry holds the schemas of the components.
+type Registry struct {
+	schemas map[string]*Schema
+}
+
+// NewRegistry returns a registry of the schemas.
+func NewRegistry(schemas ...*Schema) *Registry {
+	r := &Registry{schemas: make(map[string]*Schema, len(schemas))}
+	for _, s := range schemas {
+		r.schemas[s.key()] = s
+	}
+	return r
+}
+
+// LoadDir returns a registry of the schemas of the YAML files of the
+// directory and of its subdirectories.
+func LoadDir(dir string) (*Registry, error) {
+	var schemas []*Schema
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Skip the hidden directories of mounted ConfigMaps, which hold the
+		// same files as the links of the directory.
+		if d.IsDir() && path != dir && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || (filepath.Ext(path) != ".yaml" && filepath.Ext(path) != ".yml") {
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var s Schema
+		if err = yaml.Unmarshal(b, &s); err != nil {
+			return fmt.Errorf("failed to parse component schema %s: %w", path, err)
+		}
+		if s.Type == "" || s.Name == "" || s.Version == "" {
+			log.Warnf("Ignoring component schema %s without type, name or version", path)
+			return nil
+		}
+		schemas = append(schemas, &s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Loaded %d component schemas from %s", len(schemas), dir)
+	return NewRegistry(schemas...), nil
+}
+
+// Len returns the number of schemas of the registry.
+func (r *Registry) Len() int {
+	return len(r.schemas)
+}
+
+// Validate checks the spec of the component against the schema of its type
+// and version: the required fields must be set, the fields with allowed
+// values must have one of them, and the secrets referenced from the
+// Kubernetes secret store must exist. Components without schema, such as
+// pluggable components, are valid.
+func (r *Registry) Validate(ctx context.Context, comp *componentsapi.Component, secretExists SecretLookup) error {
+	s, ok := r.schemas[comp.Spec.Type+"/"+comp.Spec.Version]
+	if !ok {
+		return nil
+	}
+
+	items := make(map[string]int, len(comp.Spec.Metadata))
+	for i, item := range comp.Spec.Metadata {
+		items[strings.ToLower(item.Name)] = i
+	}
+	isSet := func(name string) bool {
+		_, ok := items[strings.ToLower(name)]
+		return ok
+	}
+
+	var errs []error
+	for _, f := range s.Metadata {
+		i, ok := items[strings.ToLower(f.Name)]
+		if !ok {
+			if f.Required {
+				errs = append(errs, fmt.Errorf("required metadata field %q is missing", f.Name))
+			}
+			continue
+		}
+
+		item := comp.Spec.Metadata[i]
+		if len(f.AllowedValues) > 0 && item.HasValue() {
+			v := item.Value.String()
+			if !slices.ContainsFunc(f.AllowedValues, func(allowed string) bool {
+				return strings.EqualFold(allowed, v)
+			}) {
+				errs = append(errs, fmt.Errorf("metadata field %q has value %q, allowed values are %s", f.Name, v, strings.Join(f.AllowedValues, ", ")))
+			}
+		}
+	}
+
+	// The fields of builtin profiles aren't known, so the authentication is
+	// only checked without them.
+	if len(s.AuthenticationProfiles) > 0 && len(s.BuiltinAuthenticationProfiles) == 0 {
+		var missing []string
+		satisfied := slices.ContainsFunc(s.AuthenticationProfiles, func(p AuthenticationProfile) bool {
+			for _, f := range p.Metadata {
+				if f.Required && !isSet(f.Name) {
+					missing = append(missing, f.Name)
+					return false
+				}
+			}
+			return true
+		})
+		if !satisfied {
+			errs = append(errs, fmt.Errorf("no authentication profile is satisfied, missing required metadata fields: %s", strings.Join(missing, ", ")))
+		}
+	}
+
+	if secretExists != nil && (comp.Auth.SecretStore == "" || comp.Auth.SecretStore == "kubernetes") {
+		for _, item := range comp.Spec.Metadata {
+			ref := item.SecretKeyRef
+			if ref.Name == "" {
+				continue
+			}
+			key := ref.Key
+			if key == "" {
+				key = ref.Name
+			}
+			exists, err := secretExists(ctx, comp.Namespace, ref.Name, key)
+			if err != nil {
+				return fmt.Errorf("failed to look up secret %q of metadata field %q: %w", ref.Name, item.Name, err)
+			}
+			if !exists {
+				errs = append(errs, fmt.Errorf("metadata field %q references key %q of secret %q, which doesn't exist", item.Name, key, ref.Name))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("component %s is invalid: %w", comp.LogName(), errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+)
+
+const redisSchema = `
+type: state
+name: redis
+version: v1
+metadata:
+  - name: redisHost
+    required: true
+    type: string
+  - name: redisType
+    type: string
+    allowedValues:
+      - "node"
+      - "cluster"
+  - name: redisPassword
+    sensitive: true
+`
+
+const blobSchema = `
+type: state
+name: azure.blobstorage
+version: v2
+authenticationProfiles:
+  - title: "Account Key"
+    metadata:
+      - name: accountKey
+        required: true
+  - title: "Connection string"
+    metadata:
+      - name: connectionString
+        required: true
+metadata:
+  - name: containerName
+    required: true
+`
+
+func item(name, value string) commonapi.NameValuePair {
+	return commonapi.NameValuePair{
+		Name:  name,
+		Value: commonapi.DynamicValue{JSON: v1.JSON{Raw: []byte(`"` + value + `"`)}},
+	}
+}
+
+func secretItem(name, secret, key string) commonapi.NameValuePair {
+	return commonapi.NameValuePair{
+		Name:         name,
+		SecretKeyRef: commonapi.SecretKeyRef{Name: secret, Key: key},
+	}
+}
+
+func component(typ, version string, items ...commonapi.NameValuePair) *componentsapi.Component {
+	return &componentsapi.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "mycomp", Namespace: "default"},
+		Spec: componentsapi.ComponentSpec{
+			Type:     typ,
+			Version:  version,
+			Metadata: items,
+		},
+	}
+}
+
+func loadRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "state", "redis"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "state", "azure", "blobstorage", "v2"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "..data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "state", "redis", "metadata.yaml"), []byte(redisSchema), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "state", "azure", "blobstorage", "v2", "metadata.yaml"), []byte(blobSchema), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..data", "metadata.yaml"), []byte(redisSchema), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a schema"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "empty.yaml"), []byte("foo: bar"), 0o600))
+
+	r, err := LoadDir(dir)
+	require.NoError(t, err)
+	return r
+}
+
+func TestLoadDir(t *testing.T) {
+	t.Run("loads the schemas of the subdirectories", func(t *testing.T) {
+		r := loadRegistry(t)
+		assert.Equal(t, 2, r.Len())
+		assert.Contains(t, r.schemas, "state.redis/v1")
+		assert.Contains(t, r.schemas, "state.azure.blobstorage/v2")
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "metadata.yaml"), []byte("metadata: {"), 0o600))
+		_, err := LoadDir(dir)
+		require.Error(t, err)
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		_, err := LoadDir(filepath.Join(t.TempDir(), "missing"))
+		require.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	r := loadRegistry(t)
+
+	secrets := map[string]map[string]bool{
+		"redis": {"password": true},
+	}
+	secretExists := func(_ context.Context, namespace, name, key string) (bool, error) {
+		assert.Equal(t, "default", namespace)
+		return secrets[name][key], nil
+	}
+
+	tests := map[string]struct {
+		comp   *componentsapi.Component
+		expErr []string
+	}{
+		"valid": {
+			comp: component("state.redis", "v1", item("redisHost", "localhost:6379"), item("redisType", "cluster"), secretItem("redisPassword", "redis", "password")),
+		},
+		"field names are case insensitive": {
+			comp: component("state.redis", "v1", item("RedisHost", "localhost:6379"), item("REDISTYPE", "Node")),
+		},
+		"required field set from a secret": {
+			comp: component("state.redis", "v1", secretItem("redisHost", "redis", "password")),
+		},
+		"unknown type": {
+			comp: component("state.mine", "v1"),
+		},
+		"unknown version": {
+			comp: component("state.redis", "v2"),
+		},
+		"missing required field": {
+			comp:   component("state.redis", "v1", item("redisType", "node")),
+			expErr: []string{`required metadata field "redisHost" is missing`},
+		},
+		"value not allowed": {
+			comp:   component("state.redis", "v1", item("redisHost", "localhost:6379"), item("redisType", "sentinel")),
+			expErr: []string{`metadata field "redisType" has value "sentinel", allowed values are node, cluster`},
+		},
+		"missing secret key": {
+			comp:   component("state.redis", "v1", item("redisHost", "localhost:6379"), secretItem("redisPassword", "redis", "pass")),
+			expErr: []string{`metadata field "redisPassword" references key "pass" of secret "redis", which doesn't exist`},
+		},
+		"missing secret": {
+			comp:   component("state.redis", "v1", item("redisHost", "localhost:6379"), secretItem("redisPassword", "other", "")),
+			expErr: []string{`references key "other" of secret "other"`},
+		},
+		"authentication profile satisfied": {
+			comp: component("state.azure.blobstorage", "v2", item("containerName", "c"), item("connectionString", "cs")),
+		},
+		"no authentication profile satisfied": {
+			comp:   component("state.azure.blobstorage", "v2", item("containerName", "c")),
+			expErr: []string{"no authentication profile is satisfied", "accountKey", "connectionString"},
+		},
+		"all errors are reported": {
+			comp:   component("state.redis", "v1", item("redisType", "sentinel")),
+			expErr: []string{`"redisHost" is missing`, `"redisType" has value "sentinel"`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := r.Validate(t.Context(), test.comp, secretExists)
+			if len(test.expErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, exp := range test.expErr {
+				assert.ErrorContains(t, err, exp)
+			}
+		})
+	}
+
+	t.Run("secrets of other stores aren't looked up", func(t *testing.T) {
+		comp := component("state.redis", "v1", item("redisHost", "localhost:6379"), secretItem("redisPassword", "vault-secret", ""))
+		comp.Auth.SecretStore = "vault"
+		require.NoError(t, r.Validate(t.Context(), comp, secretExists))
+	})
+
+	t.Run("secret lookup error", func(t *testing.T) {
+		comp := component("state.redis", "v1", item("redisHost", "localhost:6379"), secretItem("redisPassword", "redis", "password"))
+		err := r.Validate(t.Context(), comp, func(context.Context, string, string, string) (bool, error) {
+			return false, errors.New("forbidden")
+		})
+		require.ErrorContains(t, err, "forbidden")
+	})
+}
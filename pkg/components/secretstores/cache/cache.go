@@ -0,0 +1,336 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache implements the cache of the runtime for secret stores, which
+// serves secret gets without calling the secret store, to cut the latency of
+// and the rate limit pressure on cloud secret managers.
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/secretstores"
+)
+
+const (
+	ttlKey                  = "cachettl"
+	maxEntriesKey           = "cachemaxentries"
+	negativeTTLKey          = "cachenegativettl"
+	staleWhileRevalidateKey = "cachestalewhilerevalidate"
+
+	// DefaultMaxEntries is the maximum number of secrets in the cache, unless
+	// configured otherwise.
+	DefaultMaxEntries = 1000
+)
+
+// Options are the options for a Cache.
+type Options struct {
+	// TTL is how long a secret is served from the cache after it is read from
+	// the secret store.
+	TTL time.Duration
+
+	// MaxEntries is the maximum number of secrets in the cache, after which
+	// the least recently used secrets are evicted. Defaults to
+	// DefaultMaxEntries.
+	MaxEntries int
+
+	// NegativeTTL is how long a failure to get a secret is served from the
+	// cache, so that gets of missing secrets are not retried against the
+	// secret store on every call. Failures are not cached if zero.
+	NegativeTTL time.Duration
+
+	// StaleWhileRevalidate is how long a secret is still served from the
+	// cache after its TTL, while it is read again from the secret store in
+	// the background.
+	StaleWhileRevalidate time.Duration
+
+	Clock clock.Clock
+}
+
+// OptionsFromMetadata returns the cache options of the secret store from its
+// metadata, or nil if the cache is not enabled with the "cacheTTL" property.
+func OptionsFromMetadata(storeName string, metadata map[string]string) (*Options, error) {
+	var opts Options
+	for k, v := range metadata {
+		switch strings.ToLower(k) {
+		case ttlKey:
+			ttl, err := parseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cacheTTL '%s' for secret store %s: %w", v, storeName, err)
+			}
+			opts.TTL = ttl
+		case maxEntriesKey:
+			maxEntries, err := strconv.Atoi(v)
+			if err != nil || maxEntries <= 0 {
+				return nil, fmt.Errorf("invalid cacheMaxEntries '%s' for secret store %s: must be a positive integer", v, storeName)
+			}
+			opts.MaxEntries = maxEntries
+		case negativeTTLKey:
+			ttl, err := parseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cacheNegativeTTL '%s' for secret store %s: %w", v, storeName, err)
+			}
+			opts.NegativeTTL = ttl
+		case staleWhileRevalidateKey:
+			window, err := parseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cacheStaleWhileRevalidate '%s' for secret store %s: %w", v, storeName, err)
+			}
+			opts.StaleWhileRevalidate = window
+		}
+	}
+
+	if opts.TTL == 0 {
+		return nil, nil
+	}
+	return &opts, nil
+}
+
+func parseDuration(v string) (time.Duration, error) {
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("must be a non-negative duration")
+	}
+	return d, nil
+}
+
+// Result is a secret get served from the cache.
+type Result struct {
+	// Response is the cached response of the secret store, which is empty if
+	// Err is set.
+	Response secretstores.GetSecretResponse
+
+	// Err is the cached failure to get the secret.
+	Err error
+
+	// Revalidate is true if the secret is served after its TTL, and the
+	// caller must read it again from the secret store and Set it. It is only
+	// true for one caller at a time.
+	Revalidate bool
+}
+
+// Cache is a least recently used cache of the secrets of a secret store, which
+// expire after a TTL. The methods of a nil Cache are no-ops, so that callers
+// need not check whether the cache is enabled.
+type Cache struct {
+	ttl                  time.Duration
+	maxEntries           int
+	negativeTTL          time.Duration
+	staleWhileRevalidate time.Duration
+	clock                clock.Clock
+
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	version uint64
+}
+
+type entry struct {
+	key      string
+	name     string
+	resp     secretstores.GetSecretResponse
+	err      error
+	expireAt time.Time
+	staleAt  time.Time
+
+	revalidating bool
+}
+
+// New returns a Cache with the options.
+func New(opts Options) *Cache {
+	maxEntries := opts.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+	return &Cache{
+		ttl:                  opts.TTL,
+		maxEntries:           maxEntries,
+		negativeTTL:          opts.NegativeTTL,
+		staleWhileRevalidate: opts.StaleWhileRevalidate,
+		clock:                cl,
+		entries:              make(map[string]*list.Element),
+		lru:                  list.New(),
+	}
+}
+
+// Get returns the cached result of the get request, if it has not expired.
+// Secrets within their stale-while-revalidate window are returned, with
+// Revalidate set for the first caller.
+func (c *Cache) Get(req secretstores.GetSecretRequest) (Result, bool) {
+	if c == nil {
+		return Result{}, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	el, ok := c.entries[cacheKey(req)]
+	if !ok {
+		return Result{}, false
+	}
+	e := el.Value.(*entry)
+	now := c.clock.Now()
+	if !now.Before(e.expireAt) {
+		c.remove(el)
+		return Result{}, false
+	}
+	c.lru.MoveToFront(el)
+
+	res := Result{
+		Response: secretstores.GetSecretResponse{Data: maps.Clone(e.resp.Data)},
+		Err:      e.err,
+	}
+	if !now.Before(e.staleAt) && !e.revalidating {
+		e.revalidating = true
+		res.Revalidate = true
+	}
+	return res, true
+}
+
+// Version returns the current version of the cache, which changes whenever
+// secrets are purged. It must be read before the secret store is, for the
+// response to be passed to Set.
+func (c *Cache) Version() uint64 {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.version
+}
+
+// Set caches the response of the get request, or the failure to get the
+// secret, read from the secret store at the version of the cache. It is
+// dropped if secrets were purged since, as it could predate the purge. A
+// failure to revalidate a secret leaves the stale secret in the cache until
+// its stale-while-revalidate window ends.
+func (c *Cache) Set(req secretstores.GetSecretRequest, resp *secretstores.GetSecretResponse, err error, version uint64) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := cacheKey(req)
+	el, exists := c.entries[key]
+	if exists {
+		el.Value.(*entry).revalidating = false
+	}
+	if c.version != version {
+		return
+	}
+
+	now := c.clock.Now()
+	e := &entry{key: key, name: req.Name}
+	switch {
+	case err != nil && exists && el.Value.(*entry).err == nil:
+		return
+	case err != nil:
+		if c.negativeTTL == 0 {
+			return
+		}
+		e.err = err
+		e.staleAt = now.Add(c.negativeTTL)
+		e.expireAt = e.staleAt
+	default:
+		if resp != nil {
+			e.resp.Data = maps.Clone(resp.Data)
+		}
+		e.staleAt = now.Add(c.ttl)
+		e.expireAt = e.staleAt.Add(c.staleWhileRevalidate)
+	}
+
+	if exists {
+		el.Value = e
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(e)
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Purge drops the secrets with the names from the cache, for gets with any
+// metadata, or all secrets if no names are given. It returns the number of
+// entries dropped.
+func (c *Cache) Purge(names ...string) int {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.version++
+	var purged int
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if len(names) == 0 || slices.Contains(names, el.Value.(*entry).name) {
+			c.remove(el)
+			purged++
+		}
+		el = next
+	}
+	return purged
+}
+
+// Len returns the number of secrets in the cache, including expired ones not
+// evicted yet.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.lru.Len()
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*entry).key)
+}
+
+// cacheKey returns the key of the get request in the cache. The metadata is
+// part of the key, as secret stores may use it to read a different secret,
+// such as another version of it.
+func cacheKey(req secretstores.GetSecretRequest) string {
+	if len(req.Metadata) == 0 {
+		return req.Name
+	}
+	var b strings.Builder
+	b.WriteString(req.Name)
+	for _, k := range slices.Sorted(maps.Keys(req.Metadata)) {
+		b.WriteString("\x00")
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(req.Metadata[k])
+	}
+	return b.String()
+}
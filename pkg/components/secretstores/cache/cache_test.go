@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/secretstores"
+)
+
+func TestOptionsFromMetadata(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		opts, err := OptionsFromMetadata("store", map[string]string{"cacheMaxEntries": "10"})
+		require.NoError(t, err)
+		assert.Nil(t, opts)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		opts, err := OptionsFromMetadata("store", map[string]string{
+			"cacheTTL":                  "5m",
+			"cachemaxentries":           "10",
+			"cacheNegativeTTL":          "10s",
+			"cacheStaleWhileRevalidate": "1m",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, opts)
+		assert.Equal(t, 5*time.Minute, opts.TTL)
+		assert.Equal(t, 10, opts.MaxEntries)
+		assert.Equal(t, 10*time.Second, opts.NegativeTTL)
+		assert.Equal(t, time.Minute, opts.StaleWhileRevalidate)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, md := range []map[string]string{
+			{"cacheTTL": "soon"},
+			{"cacheTTL": "-1s"},
+			{"cacheTTL": "1s", "cacheMaxEntries": "0"},
+			{"cacheTTL": "1s", "cacheNegativeTTL": "-1s"},
+			{"cacheTTL": "1s", "cacheStaleWhileRevalidate": "later"},
+		} {
+			_, err := OptionsFromMetadata("store", md)
+			require.Error(t, err, md)
+		}
+	})
+}
+
+func TestCache(t *testing.T) {
+	req := secretstores.GetSecretRequest{Name: "a"}
+	resp := &secretstores.GetSecretResponse{Data: map[string]string{"a": "1"}}
+
+	t.Run("expires after TTL", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		c := New(Options{TTL: time.Second, Clock: clock})
+
+		c.Set(req, resp, nil, c.Version())
+		res, ok := c.Get(req)
+		require.True(t, ok)
+		assert.Equal(t, resp.Data, res.Response.Data)
+		assert.False(t, res.Revalidate)
+
+		clock.Step(time.Second)
+		_, ok = c.Get(req)
+		assert.False(t, ok)
+		assert.Zero(t, c.Len())
+	})
+
+	t.Run("metadata is part of the key", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		c.Set(req, resp, nil, c.Version())
+		_, ok := c.Get(secretstores.GetSecretRequest{Name: "a", Metadata: map[string]string{"version_id": "2"}})
+		assert.False(t, ok)
+	})
+
+	t.Run("returns copies", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		c.Set(req, resp, nil, c.Version())
+		res, _ := c.Get(req)
+		res.Response.Data["a"] = "2"
+		res, _ = c.Get(req)
+		assert.Equal(t, "1", res.Response.Data["a"])
+	})
+
+	t.Run("stale while revalidate", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		c := New(Options{TTL: time.Second, StaleWhileRevalidate: time.Minute, Clock: clock})
+		c.Set(req, resp, nil, c.Version())
+
+		clock.Step(2 * time.Second)
+		res, ok := c.Get(req)
+		require.True(t, ok)
+		assert.True(t, res.Revalidate)
+		assert.Equal(t, "1", res.Response.Data["a"])
+
+		// Only one caller revalidates the secret.
+		res, ok = c.Get(req)
+		require.True(t, ok)
+		assert.False(t, res.Revalidate)
+
+		// A failed revalidation keeps the stale secret.
+		c.Set(req, nil, errors.New("unavailable"), c.Version())
+		res, ok = c.Get(req)
+		require.True(t, ok)
+		require.NoError(t, res.Err)
+		assert.True(t, res.Revalidate)
+
+		c.Set(req, &secretstores.GetSecretResponse{Data: map[string]string{"a": "2"}}, nil, c.Version())
+		res, ok = c.Get(req)
+		require.True(t, ok)
+		assert.False(t, res.Revalidate)
+		assert.Equal(t, "2", res.Response.Data["a"])
+
+		clock.Step(time.Minute + time.Second)
+		_, ok = c.Get(req)
+		assert.False(t, ok)
+	})
+
+	t.Run("negative caching", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		c := New(Options{TTL: time.Minute, Clock: clock})
+		c.Set(req, nil, errors.New("not found"), c.Version())
+		_, ok := c.Get(req)
+		assert.False(t, ok)
+
+		c = New(Options{TTL: time.Minute, NegativeTTL: time.Second, StaleWhileRevalidate: time.Minute, Clock: clock})
+		c.Set(req, nil, errors.New("not found"), c.Version())
+		res, ok := c.Get(req)
+		require.True(t, ok)
+		require.EqualError(t, res.Err, "not found")
+
+		clock.Step(time.Second)
+		_, ok = c.Get(req)
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute, MaxEntries: 2})
+		for _, name := range []string{"a", "b"} {
+			c.Set(secretstores.GetSecretRequest{Name: name}, resp, nil, c.Version())
+		}
+		c.Get(secretstores.GetSecretRequest{Name: "a"})
+		c.Set(secretstores.GetSecretRequest{Name: "c"}, resp, nil, c.Version())
+
+		assert.Equal(t, 2, c.Len())
+		_, ok := c.Get(secretstores.GetSecretRequest{Name: "b"})
+		assert.False(t, ok)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		c.Set(req, resp, nil, c.Version())
+		c.Set(secretstores.GetSecretRequest{Name: "a", Metadata: map[string]string{"version_id": "2"}}, resp, nil, c.Version())
+		c.Set(secretstores.GetSecretRequest{Name: "b"}, resp, nil, c.Version())
+
+		assert.Equal(t, 2, c.Purge("a", "other"))
+		assert.Equal(t, 1, c.Len())
+		assert.Equal(t, 1, c.Purge())
+		assert.Zero(t, c.Len())
+	})
+
+	t.Run("responses read before a purge are not cached", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		version := c.Version()
+		c.Purge()
+		c.Set(req, resp, nil, version)
+		_, ok := c.Get(req)
+		assert.False(t, ok)
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *Cache
+		c.Set(req, resp, nil, c.Version())
+		_, ok := c.Get(req)
+		assert.False(t, ok)
+		assert.Zero(t, c.Purge())
+		assert.Zero(t, c.Len())
+	})
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/kit/logger"
+)
+
+// DefaultPollInterval is the interval at which the secret store is polled for
+// changes, unless requested otherwise. Secret stores are often rate limited,
+// so it is longer than the poll interval of state stores.
+const DefaultPollInterval = 30 * time.Second
+
+var log = logger.NewLogger("dapr.secretstores.watch")
+
+// PollerOptions are the options for a Poller.
+type PollerOptions struct {
+	Store secretstores.SecretStore
+
+	// Interval is the interval at which the secret store is polled. Defaults
+	// to DefaultPollInterval.
+	Interval time.Duration
+
+	Clock clock.WithTicker
+}
+
+// Poller emulates change notifications for secret stores without them, by
+// polling the watched secrets and comparing them with the previous poll.
+type Poller struct {
+	store    secretstores.SecretStore
+	interval time.Duration
+	clock    clock.WithTicker
+}
+
+// version is the version of the value of a secret, to detect changes.
+type version struct {
+	tag  string
+	data map[string]string
+}
+
+// NewPoller returns a Poller over the secret store.
+func NewPoller(opts PollerOptions) *Poller {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+	return &Poller{
+		store:    opts.Store,
+		interval: interval,
+		clock:    cl,
+	}
+}
+
+// Watch implements Watcher. Failed polls are retried at the next interval,
+// so changes which are reverted in between are not delivered.
+func (p *Poller) Watch(ctx context.Context, req *Request, handler Handler) error {
+	snapshot, err := p.poll(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err = handler(ctx, nil); err != nil {
+		return err
+	}
+
+	ticker := p.clock.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+
+		next, err := p.poll(ctx, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Warnf("Failed to poll secret store for changes: %v", err)
+			continue
+		}
+
+		if changes := diff(snapshot, next); len(changes) > 0 {
+			if err = handler(ctx, changes); err != nil {
+				return err
+			}
+		}
+		snapshot = next
+	}
+}
+
+// poll returns the versions of the watched secrets.
+func (p *Poller) poll(ctx context.Context, req *Request) (map[string]version, error) {
+	versions := make(map[string]version, len(req.Names))
+	for _, name := range req.Names {
+		res, err := p.store.GetSecret(ctx, secretstores.GetSecretRequest{
+			Name:     name,
+			Metadata: req.Metadata,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get watched secret '%s': %w", name, err)
+		}
+		tag, err := versionTag(res.Data)
+		if err != nil {
+			return nil, err
+		}
+		versions[name] = version{tag: tag, data: res.Data}
+	}
+	return versions, nil
+}
+
+// versionTag returns a digest of the value of a secret.
+func versionTag(data map[string]string) (string, error) {
+	// Maps are marshaled with sorted keys, so the digest is stable.
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// diff returns the changes from the previous to the next versions, sorted by
+// name.
+func diff(prev, next map[string]version) []Change {
+	var changes []Change
+	for name, v := range next {
+		if p, ok := prev[name]; ok && p.tag == v.tag {
+			continue
+		}
+		changes = append(changes, Change{Name: name, Data: v.data})
+	}
+	slices.SortFunc(changes, func(a, b Change) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return changes
+}
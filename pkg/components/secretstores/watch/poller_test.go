@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/secretstores"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+// fakeStore is a secret store whose secrets can be changed.
+type fakeStore struct {
+	daprt.FakeSecretStore
+
+	lock    sync.Mutex
+	secrets map[string]map[string]string
+	err     error
+}
+
+func (s *fakeStore) GetSecret(ctx context.Context, req secretstores.GetSecretRequest) (secretstores.GetSecretResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.err != nil {
+		return secretstores.GetSecretResponse{}, s.err
+	}
+	data, ok := s.secrets[req.Name]
+	if !ok {
+		return secretstores.GetSecretResponse{}, errors.New("secret not found")
+	}
+	return secretstores.GetSecretResponse{Data: data}, nil
+}
+
+func (s *fakeStore) set(name string, data map[string]string, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if data != nil {
+		s.secrets[name] = data
+	}
+	s.err = err
+}
+
+// watch runs the watch in the background, returning the batches of changes
+// it delivers once it is established.
+func watch(t *testing.T, poller *Poller, req *Request) <-chan []Change {
+	t.Helper()
+	ctx, cancel := context.WithCancel(t.Context())
+	batches := make(chan []Change, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- poller.Watch(ctx, req, func(_ context.Context, changes []Change) error {
+			batches <- changes
+			return nil
+		})
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.ErrorIs(t, <-errCh, context.Canceled)
+	})
+
+	select {
+	case changes := <-batches:
+		require.Nil(t, changes)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "watch was not established")
+	}
+	return batches
+}
+
+// step advances the clock to the next poll once the poller waits for it.
+func step(t *testing.T, clock *clocktesting.FakeClock) {
+	t.Helper()
+	assert.Eventually(t, clock.HasWaiters, 5*time.Second, 10*time.Millisecond)
+	clock.Step(DefaultPollInterval)
+}
+
+func next(t *testing.T, clock *clocktesting.FakeClock, batches <-chan []Change) []Change {
+	t.Helper()
+	step(t, clock)
+	select {
+	case changes := <-batches:
+		return changes
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no changes delivered")
+		return nil
+	}
+}
+
+func TestPoller(t *testing.T) {
+	t.Run("changes", func(t *testing.T) {
+		store := &fakeStore{secrets: map[string]map[string]string{
+			"a": {"a": "1"},
+			"b": {"b": "1"},
+			"c": {"c": "1"},
+		}}
+		clock := clocktesting.NewFakeClock(time.Now())
+
+		poller := NewPoller(PollerOptions{Store: store, Clock: clock})
+		batches := watch(t, poller, &Request{Names: []string{"b", "a"}})
+
+		store.set("a", map[string]string{"a": "2"}, nil)
+		store.set("b", map[string]string{"b": "2", "c": "1"}, nil)
+		store.set("c", map[string]string{"c": "2"}, nil)
+		assert.Equal(t, []Change{
+			{Name: "a", Data: map[string]string{"a": "2"}},
+			{Name: "b", Data: map[string]string{"b": "2", "c": "1"}},
+		}, next(t, clock, batches))
+
+		// Unchanged secrets are not delivered again.
+		store.set("a", map[string]string{"a": "3"}, nil)
+		assert.Equal(t, []Change{{Name: "a", Data: map[string]string{"a": "3"}}}, next(t, clock, batches))
+	})
+
+	t.Run("failed polls are retried", func(t *testing.T) {
+		store := &fakeStore{secrets: map[string]map[string]string{"a": {"a": "1"}}}
+		clock := clocktesting.NewFakeClock(time.Now())
+
+		poller := NewPoller(PollerOptions{Store: store, Clock: clock})
+		batches := watch(t, poller, &Request{Names: []string{"a"}})
+
+		store.set("a", map[string]string{"a": "2"}, errors.New("unavailable"))
+		step(t, clock)
+		store.set("a", nil, nil)
+		assert.Equal(t, []Change{{Name: "a", Data: map[string]string{"a": "2"}}}, next(t, clock, batches))
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		poller := NewPoller(PollerOptions{Store: &fakeStore{}})
+		err := poller.Watch(t.Context(), &Request{Names: []string{"a"}}, func(context.Context, []Change) error {
+			return nil
+		})
+		require.ErrorContains(t, err, "secret not found")
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		poller := NewPoller(PollerOptions{Store: &fakeStore{secrets: map[string]map[string]string{"a": {"a": "1"}}}})
+		handlerErr := errors.New("handler failed")
+		err := poller.Watch(t.Context(), &Request{Names: []string{"a"}}, func(context.Context, []Change) error {
+			return handlerErr
+		})
+		require.ErrorIs(t, err, handlerErr)
+	})
+}
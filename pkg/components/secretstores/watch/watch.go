@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package watch implements watching secrets of secret stores for changes.
+package watch
+
+import (
+	"context"
+)
+
+// Change is a change to the value of a secret.
+type Change struct {
+	// Name is the name of the secret.
+	Name string
+	// Data is the value of the secret after the change.
+	Data map[string]string
+}
+
+// Request is a request to watch secrets of a secret store.
+type Request struct {
+	// Names are the names of the secrets to watch.
+	Names []string
+	// Metadata is the metadata of the request.
+	Metadata map[string]string
+}
+
+// Handler handles a batch of changes to the watched secrets.
+type Handler func(ctx context.Context, changes []Change) error
+
+// Watcher is implemented by secret stores with native change notifications.
+type Watcher interface {
+	// Watch watches the secrets of the request until the context is canceled
+	// or the handler returns an error, which is returned. The handler is first
+	// called with no changes once the watch is established.
+	Watch(ctx context.Context, req *Request, handler Handler) error
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package selftest runs a subset of the components conformance tests against
+// components, so the authors of pluggable components can validate them with
+// daprd alone.
+package selftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	contribbindings "github.com/dapr/components-contrib/bindings"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	contribsecretstores "github.com/dapr/components-contrib/secretstores"
+	contribstate "github.com/dapr/components-contrib/state"
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/components"
+	"github.com/dapr/dapr/pkg/components/bindings"
+	"github.com/dapr/dapr/pkg/components/pubsub"
+	"github.com/dapr/dapr/pkg/components/secretstores"
+	"github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/internal/loader/disk"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type Options struct {
+	StateStores  *state.Registry
+	PubSubs      *pubsub.Registry
+	SecretStores *secretstores.Registry
+	Bindings     *bindings.Registry
+	Meta         *meta.Meta
+
+	// Timeout bounds every check. Defaults to 10s.
+	Timeout time.Duration
+}
+
+// Check is the result of a conformance check.
+type Check struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the result of testing a component.
+type Report struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Version string `json:"version"`
+	// Capabilities are the features, or operations for output bindings, the
+	// component reports.
+	Capabilities []string `json:"capabilities"`
+	Checks       []Check  `json:"checks"`
+	Passed       bool     `json:"passed"`
+}
+
+func (r *Report) check(name string, err error) bool {
+	c := Check{Name: name, Passed: err == nil}
+	if err != nil {
+		c.Error = err.Error()
+	}
+	r.Checks = append(r.Checks, c)
+	return c.Passed
+}
+
+// Tester tests components against the conformance checks of their category.
+type Tester struct {
+	stateStores  *state.Registry
+	pubsubs      *pubsub.Registry
+	secretStores *secretstores.Registry
+	bindings     *bindings.Registry
+	meta         *meta.Meta
+	timeout      time.Duration
+}
+
+func New(opts Options) *Tester {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Tester{
+		stateStores:  opts.StateStores,
+		pubsubs:      opts.PubSubs,
+		secretStores: opts.SecretStores,
+		bindings:     opts.Bindings,
+		meta:         opts.Meta,
+		timeout:      timeout,
+	}
+}
+
+// Load loads the components to test from the resources paths. Unlike daprd,
+// the manifests which can't be parsed fail loading.
+func Load(ctx context.Context, appID string, paths []string) ([]compapi.Component, error) {
+	return disk.NewComponents(disk.Options{AppID: appID, Paths: paths, Strict: true}).Load(ctx)
+}
+
+// Run tests the component. The component is passed if all the checks passed.
+func (t *Tester) Run(ctx context.Context, comp compapi.Component) Report {
+	report := Report{
+		Name:         comp.Name,
+		Type:         comp.Spec.Type,
+		Version:      comp.Spec.Version,
+		Capabilities: []string{},
+		Checks:       []Check{},
+	}
+
+	typ := comp.Spec.Type[strings.Index(comp.Spec.Type, ".")+1:]
+	switch components.Category(strings.Split(comp.Spec.Type, ".")[0]) {
+	case components.CategoryStateStore:
+		t.testStateStore(ctx, comp, typ, &report)
+	case components.CategoryPubSub:
+		t.testPubSub(ctx, comp, typ, &report)
+	case components.CategorySecretStore:
+		t.testSecretStore(ctx, comp, &report)
+	case components.CategoryBindings:
+		t.testBindings(ctx, comp, &report)
+	default:
+		report.check("category", fmt.Errorf("components of type %s are not supported", comp.Spec.Type))
+	}
+
+	report.Passed = true
+	for _, c := range report.Checks {
+		report.Passed = report.Passed && c.Passed
+	}
+	return report
+}
+
+// do runs a check bounded by the timeout.
+func (t *Tester) do(ctx context.Context, fn func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+func (t *Tester) close(report *Report, closer io.Closer) {
+	report.check("close", closer.Close())
+}
+
+func (t *Tester) testStateStore(ctx context.Context, comp compapi.Component, typ string, report *Report) {
+	store, err := t.stateStores.Create(comp.Spec.Type, comp.Spec.Version, comp.LogName())
+	if !report.check("create", err) {
+		return
+	}
+	if !report.check("init", t.do(ctx, func(ctx context.Context) error {
+		base, err := t.meta.ToBaseMetadata(comp)
+		if err != nil {
+			return err
+		}
+		return store.Init(ctx, contribstate.Metadata{Base: base})
+	})) {
+		return
+	}
+	defer t.close(report, store)
+
+	for _, f := range store.Features() {
+		report.Capabilities = append(report.Capabilities, string(f))
+	}
+
+	key := "selftest-" + uuid.NewString()
+	value := []byte(`"` + typ + `"`)
+	var etag *string
+
+	report.check("set", t.do(ctx, func(ctx context.Context) error {
+		return store.Set(ctx, &contribstate.SetRequest{Key: key, Value: value})
+	}))
+
+	report.check("get", t.do(ctx, func(ctx context.Context) error {
+		res, err := store.Get(ctx, &contribstate.GetRequest{Key: key})
+		if err != nil {
+			return err
+		}
+		if res == nil || string(res.Data) != string(value) {
+			return fmt.Errorf("expected the value %s which was set", value)
+		}
+		etag = res.ETag
+		return nil
+	}))
+
+	if contribstate.FeatureETag.IsPresent(store.Features()) {
+		report.check("etag mismatch", t.do(ctx, func(ctx context.Context) error {
+			if etag == nil {
+				return errors.New("no etag was returned by get")
+			}
+			wrong := *etag + "-mismatch"
+			err := store.Set(ctx, &contribstate.SetRequest{Key: key, Value: value, ETag: &wrong})
+			var etagErr *contribstate.ETagError
+			if !errors.As(err, &etagErr) {
+				return fmt.Errorf("expected an etag mismatch error, got: %v", err)
+			}
+			return nil
+		}))
+	}
+
+	report.check("bulk get", t.do(ctx, func(ctx context.Context) error {
+		res, err := store.BulkGet(ctx, []contribstate.GetRequest{{Key: key}}, contribstate.BulkGetOpts{})
+		if err != nil {
+			return err
+		}
+		if len(res) != 1 || res[0].Key != key || string(res[0].Data) != string(value) {
+			return fmt.Errorf("expected the value %s of key %s", value, key)
+		}
+		return nil
+	}))
+
+	report.check("delete", t.do(ctx, func(ctx context.Context) error {
+		if err := store.Delete(ctx, &contribstate.DeleteRequest{Key: key}); err != nil {
+			return err
+		}
+		res, err := store.Get(ctx, &contribstate.GetRequest{Key: key})
+		if err != nil {
+			return err
+		}
+		if res != nil && len(res.Data) > 0 {
+			return errors.New("expected no value once deleted")
+		}
+		return nil
+	}))
+}
+
+func (t *Tester) testPubSub(ctx context.Context, comp compapi.Component, typ string, report *Report) {
+	ps, err := t.pubsubs.Create(comp.Spec.Type, comp.Spec.Version, comp.LogName())
+	if !report.check("create", err) {
+		return
+	}
+	if !report.check("init", t.do(ctx, func(ctx context.Context) error {
+		base, err := t.meta.ToBaseMetadata(comp)
+		if err != nil {
+			return err
+		}
+		return ps.Init(ctx, contribpubsub.Metadata{Base: base})
+	})) {
+		return
+	}
+	defer t.close(report, ps)
+
+	for _, f := range ps.Features() {
+		report.Capabilities = append(report.Capabilities, string(f))
+	}
+
+	topic := "selftest-" + uuid.NewString()
+	data := []byte(typ)
+	received := make(chan []byte, 1)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if !report.check("subscribe", t.do(ctx, func(context.Context) error {
+		return ps.Subscribe(subCtx, contribpubsub.SubscribeRequest{Topic: topic}, func(_ context.Context, msg *contribpubsub.NewMessage) error {
+			select {
+			case received <- msg.Data:
+			default:
+			}
+			return nil
+		})
+	})) {
+		return
+	}
+
+	if !report.check("publish", t.do(ctx, func(ctx context.Context) error {
+		return ps.Publish(ctx, &contribpubsub.PublishRequest{Topic: topic, PubsubName: comp.Name, Data: data})
+	})) {
+		return
+	}
+
+	report.check("deliver", t.do(ctx, func(ctx context.Context) error {
+		select {
+		case got := <-received:
+			if string(got) != string(data) {
+				return fmt.Errorf("expected the published data %q, got %q", data, got)
+			}
+			return nil
+		case <-ctx.Done():
+			return errors.New("the published message wasn't delivered")
+		}
+	}))
+}
+
+func (t *Tester) testSecretStore(ctx context.Context, comp compapi.Component, report *Report) {
+	store, err := t.secretStores.Create(comp.Spec.Type, comp.Spec.Version, comp.LogName())
+	if !report.check("create", err) {
+		return
+	}
+	if !report.check("init", t.do(ctx, func(ctx context.Context) error {
+		base, err := t.meta.ToBaseMetadata(comp)
+		if err != nil {
+			return err
+		}
+		return store.Init(ctx, contribsecretstores.Metadata{Base: base})
+	})) {
+		return
+	}
+	defer t.close(report, store)
+
+	for _, f := range store.Features() {
+		report.Capabilities = append(report.Capabilities, string(f))
+	}
+
+	report.check("bulk get", t.do(ctx, func(ctx context.Context) error {
+		_, err := store.BulkGetSecret(ctx, contribsecretstores.BulkGetSecretRequest{})
+		return err
+	}))
+}
+
+func (t *Tester) testBindings(ctx context.Context, comp compapi.Component, report *Report) {
+	base, err := t.meta.ToBaseMetadata(comp)
+	if !report.check("metadata", err) {
+		return
+	}
+
+	tested := false
+	if t.bindings.HasOutputBinding(comp.Spec.Type, comp.Spec.Version) {
+		tested = true
+		binding, err := t.bindings.CreateOutputBinding(comp.Spec.Type, comp.Spec.Version, comp.LogName())
+		if report.check("create output", err) && report.check("init output", t.do(ctx, func(ctx context.Context) error {
+			return binding.Init(ctx, contribbindings.Metadata{Base: base})
+		})) {
+			for _, op := range binding.Operations() {
+				report.Capabilities = append(report.Capabilities, string(op))
+			}
+			report.check("close output", binding.Close())
+		}
+	}
+
+	if t.bindings.HasInputBinding(comp.Spec.Type, comp.Spec.Version) {
+		tested = true
+		binding, err := t.bindings.CreateInputBinding(comp.Spec.Type, comp.Spec.Version, comp.LogName())
+		if report.check("create input", err) && report.check("init input", t.do(ctx, func(ctx context.Context) error {
+			return binding.Init(ctx, contribbindings.Metadata{Base: base})
+		})) {
+			report.check("close input", binding.Close())
+		}
+	}
+
+	if !tested {
+		report.check("create", fmt.Errorf("couldn't find binding %s/%s", comp.Spec.Type, comp.Spec.Version))
+	}
+}
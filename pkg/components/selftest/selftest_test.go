@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selftest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	inmemorypubsub "github.com/dapr/components-contrib/pubsub/in-memory"
+	contribstate "github.com/dapr/components-contrib/state"
+	inmemorystate "github.com/dapr/components-contrib/state/in-memory"
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/components/bindings"
+	"github.com/dapr/dapr/pkg/components/pubsub"
+	"github.com/dapr/dapr/pkg/components/secretstores"
+	"github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func component(name, typ string) compapi.Component {
+	return compapi.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       compapi.ComponentSpec{Type: typ, Version: "v1"},
+	}
+}
+
+func checkNames(report Report) []string {
+	names := make([]string, 0, len(report.Checks))
+	for _, c := range report.Checks {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestRun(t *testing.T) {
+	stateStores := state.NewRegistry()
+	stateStores.RegisterComponent(inmemorystate.NewInMemoryStateStore, "in-memory")
+	pubsubs := pubsub.NewRegistry()
+	pubsubs.RegisterComponent(inmemorypubsub.New, "in-memory")
+
+	tester := New(Options{
+		StateStores:  stateStores,
+		PubSubs:      pubsubs,
+		SecretStores: secretstores.NewRegistry(),
+		Bindings:     bindings.NewRegistry(),
+		Meta:         meta.New(meta.Options{ID: "selftest", Mode: modes.StandaloneMode}),
+		Timeout:      5 * time.Second,
+	})
+
+	t.Run("conforming state store", func(t *testing.T) {
+		report := tester.Run(t.Context(), component("statestore", "state.in-memory"))
+		assert.True(t, report.Passed, report.Checks)
+		assert.Equal(t, []string{"create", "init", "set", "get", "etag mismatch", "bulk get", "delete", "close"}, checkNames(report))
+		assert.Contains(t, report.Capabilities, string(contribstate.FeatureETag))
+	})
+
+	t.Run("conforming pubsub", func(t *testing.T) {
+		report := tester.Run(t.Context(), component("pubsub", "pubsub.in-memory"))
+		assert.True(t, report.Passed, report.Checks)
+		assert.Equal(t, []string{"create", "init", "subscribe", "publish", "deliver", "close"}, checkNames(report))
+	})
+
+	t.Run("state store failing to init", func(t *testing.T) {
+		store := new(daprt.MockStateStore)
+		store.On("Init", mock.Anything).Return(assert.AnError)
+		stateStores.RegisterComponent(func(logger.Logger) contribstate.Store { return store }, "failing")
+
+		report := tester.Run(t.Context(), component("failing", "state.failing"))
+		assert.False(t, report.Passed)
+		assert.Equal(t, []string{"create", "init"}, checkNames(report))
+		assert.Equal(t, assert.AnError.Error(), report.Checks[1].Error)
+	})
+
+	t.Run("unregistered component", func(t *testing.T) {
+		report := tester.Run(t.Context(), component("missing", "state.missing"))
+		assert.False(t, report.Passed)
+		assert.Equal(t, []string{"create"}, checkNames(report))
+	})
+
+	t.Run("unsupported category", func(t *testing.T) {
+		report := tester.Run(t.Context(), component("lock", "lock.redis"))
+		assert.False(t, report.Passed)
+		assert.Equal(t, []string{"category"}, checkNames(report))
+	})
+}
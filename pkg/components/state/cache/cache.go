@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache implements the read-through cache of the runtime for state
+// stores, which serves state gets of hot keys without calling the state store.
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	ttlKey                = "cachettl"
+	maxEntriesKey         = "cachemaxentries"
+	invalidationPubsubKey = "cacheinvalidationpubsub"
+	invalidationTopicKey  = "cacheinvalidationtopic"
+
+	// DefaultMaxEntries is the maximum number of keys in the cache, unless
+	// configured otherwise.
+	DefaultMaxEntries = 1000
+)
+
+// Options are the options for a Cache.
+type Options struct {
+	// TTL is how long a value is served from the cache after it is read from
+	// the state store.
+	TTL time.Duration
+
+	// MaxEntries is the maximum number of keys in the cache, after which the
+	// least recently used keys are evicted. Defaults to DefaultMaxEntries.
+	MaxEntries int
+
+	// InvalidationPubsub and InvalidationTopic are the pub/sub component and
+	// topic on which the keys written by any sidecar are published, so that
+	// the caches of the other sidecars sharing the state store drop them.
+	// The pub/sub component must deliver each message to every sidecar.
+	InvalidationPubsub string
+	InvalidationTopic  string
+
+	Clock clock.Clock
+}
+
+// OptionsFromMetadata returns the cache options of the state store from its
+// metadata, or nil if the cache is not enabled with the "cacheTTL" property.
+func OptionsFromMetadata(storeName string, metadata map[string]string) (*Options, error) {
+	var opts Options
+	for k, v := range metadata {
+		switch strings.ToLower(k) {
+		case ttlKey:
+			ttl, err := time.ParseDuration(v)
+			if err != nil || ttl < 0 {
+				return nil, fmt.Errorf("invalid cacheTTL '%s' for state store %s: must be a non-negative duration", v, storeName)
+			}
+			opts.TTL = ttl
+		case maxEntriesKey:
+			maxEntries, err := strconv.Atoi(v)
+			if err != nil || maxEntries <= 0 {
+				return nil, fmt.Errorf("invalid cacheMaxEntries '%s' for state store %s: must be a positive integer", v, storeName)
+			}
+			opts.MaxEntries = maxEntries
+		case invalidationPubsubKey:
+			opts.InvalidationPubsub = v
+		case invalidationTopicKey:
+			opts.InvalidationTopic = v
+		}
+	}
+
+	if opts.TTL == 0 {
+		return nil, nil
+	}
+	if (opts.InvalidationPubsub == "") != (opts.InvalidationTopic == "") {
+		return nil, fmt.Errorf("both cacheInvalidationPubsub and cacheInvalidationTopic must be set for state store %s", storeName)
+	}
+	return &opts, nil
+}
+
+// InvalidationEvent is the message published on the invalidation topic of
+// the cache when keys of the state store are written.
+type InvalidationEvent struct {
+	StoreName string   `json:"storeName"`
+	Keys      []string `json:"keys"`
+}
+
+// Cache is a least recently used cache of the values of state store keys,
+// which expire after a TTL. The methods of a nil Cache are no-ops, so that
+// callers need not check whether the cache is enabled.
+type Cache struct {
+	ttl                time.Duration
+	maxEntries         int
+	invalidationPubsub string
+	invalidationTopic  string
+	clock              clock.Clock
+
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	version uint64
+}
+
+type entry struct {
+	key      string
+	resp     state.GetResponse
+	expireAt time.Time
+}
+
+// New returns a Cache with the options.
+func New(opts Options) *Cache {
+	maxEntries := opts.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+	return &Cache{
+		ttl:                opts.TTL,
+		maxEntries:         maxEntries,
+		invalidationPubsub: opts.InvalidationPubsub,
+		invalidationTopic:  opts.InvalidationTopic,
+		clock:              cl,
+		entries:            make(map[string]*list.Element),
+		lru:                list.New(),
+	}
+}
+
+// Cacheable returns true if the get request can be served from the cache.
+// Gets with strong consistency, or with metadata which the state store may
+// use to read the key differently, always go to the state store.
+func Cacheable(req *state.GetRequest, ignoredMetadata ...string) bool {
+	if req.Options.Consistency == state.Strong {
+		return false
+	}
+	for k := range req.Metadata {
+		if !slices.Contains(ignoredMetadata, k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns a copy of the cached response for the key, if it has not
+// expired.
+func (c *Cache) Get(key string) (*state.GetResponse, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !c.clock.Now().Before(e.expireAt) {
+		c.remove(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	resp := e.resp
+	return &resp, true
+}
+
+// Version returns the current version of the cache, which changes whenever
+// keys are invalidated. It must be read before the state store is, for the
+// response to be passed to Set.
+func (c *Cache) Version() uint64 {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.version
+}
+
+// Set caches the response for the key, read from the state store at the
+// version of the cache. It is dropped if keys were invalidated since, as the
+// response could predate a write of the key.
+func (c *Cache) Set(key string, resp *state.GetResponse, version uint64) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.version != version {
+		return
+	}
+
+	e := &entry{key: key, expireAt: c.clock.Now().Add(c.ttl)}
+	if resp != nil {
+		e.resp = *resp
+	}
+	if el, ok := c.entries[key]; ok {
+		el.Value = e
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(e)
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Invalidate drops the keys from the cache.
+func (c *Cache) Invalidate(keys ...string) {
+	if c == nil || len(keys) == 0 {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.version++
+	for _, key := range keys {
+		if el, ok := c.entries[key]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+// Len returns the number of keys in the cache, including expired ones not
+// evicted yet.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.lru.Len()
+}
+
+// InvalidationTopic returns the pub/sub component and topic of the
+// invalidation events of the cache, which are empty if not configured.
+func (c *Cache) InvalidationTopic() (pubsubName, topic string) {
+	if c == nil {
+		return "", ""
+	}
+	return c.invalidationPubsub, c.invalidationTopic
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*entry).key)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+func TestOptionsFromMetadata(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		opts, err := OptionsFromMetadata("store", map[string]string{"cacheMaxEntries": "10"})
+		require.NoError(t, err)
+		assert.Nil(t, opts)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		opts, err := OptionsFromMetadata("store", map[string]string{
+			"cacheTTL":                "5s",
+			"cachemaxentries":         "10",
+			"cacheInvalidationPubsub": "pubsub",
+			"cacheInvalidationTopic":  "invalidation",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, opts)
+		assert.Equal(t, 5*time.Second, opts.TTL)
+		assert.Equal(t, 10, opts.MaxEntries)
+		assert.Equal(t, "pubsub", opts.InvalidationPubsub)
+		assert.Equal(t, "invalidation", opts.InvalidationTopic)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, md := range []map[string]string{
+			{"cacheTTL": "soon"},
+			{"cacheTTL": "-1s"},
+			{"cacheTTL": "1s", "cacheMaxEntries": "0"},
+			{"cacheTTL": "1s", "cacheInvalidationPubsub": "pubsub"},
+		} {
+			_, err := OptionsFromMetadata("store", md)
+			require.Error(t, err, md)
+		}
+	})
+}
+
+func TestCacheable(t *testing.T) {
+	assert.True(t, Cacheable(&state.GetRequest{Key: "a"}))
+	assert.True(t, Cacheable(&state.GetRequest{Key: "a", Metadata: map[string]string{"keyPrefix": "none"}}, "keyPrefix"))
+	assert.False(t, Cacheable(&state.GetRequest{Key: "a", Metadata: map[string]string{"partitionKey": "p"}}, "keyPrefix"))
+	assert.False(t, Cacheable(&state.GetRequest{Key: "a", Options: state.GetStateOption{Consistency: state.Strong}}))
+}
+
+func TestCache(t *testing.T) {
+	t.Run("expires after TTL", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		c := New(Options{TTL: time.Second, Clock: clock})
+
+		c.Set("a", &state.GetResponse{Data: []byte("1")}, c.Version())
+		resp, ok := c.Get("a")
+		require.True(t, ok)
+		assert.Equal(t, "1", string(resp.Data))
+
+		clock.Step(time.Second)
+		_, ok = c.Get("a")
+		assert.False(t, ok)
+		assert.Zero(t, c.Len())
+	})
+
+	t.Run("caches missing keys", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		c.Set("a", nil, c.Version())
+		resp, ok := c.Get("a")
+		require.True(t, ok)
+		assert.Nil(t, resp.Data)
+	})
+
+	t.Run("returns copies", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		c.Set("a", &state.GetResponse{Data: []byte("1")}, c.Version())
+		resp, _ := c.Get("a")
+		resp.Data = []byte("2")
+		resp, _ = c.Get("a")
+		assert.Equal(t, "1", string(resp.Data))
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute, MaxEntries: 2})
+		c.Set("a", &state.GetResponse{}, c.Version())
+		c.Set("b", &state.GetResponse{}, c.Version())
+		c.Get("a")
+		c.Set("c", &state.GetResponse{}, c.Version())
+
+		assert.Equal(t, 2, c.Len())
+		_, ok := c.Get("b")
+		assert.False(t, ok)
+		_, ok = c.Get("a")
+		assert.True(t, ok)
+		_, ok = c.Get("c")
+		assert.True(t, ok)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		c.Set("a", &state.GetResponse{}, c.Version())
+		c.Set("b", &state.GetResponse{}, c.Version())
+		c.Invalidate("a", "other")
+
+		_, ok := c.Get("a")
+		assert.False(t, ok)
+		_, ok = c.Get("b")
+		assert.True(t, ok)
+	})
+
+	t.Run("responses read before an invalidation are not cached", func(t *testing.T) {
+		c := New(Options{TTL: time.Minute})
+		version := c.Version()
+		c.Invalidate("a")
+		c.Set("a", &state.GetResponse{Data: []byte("stale")}, version)
+
+		_, ok := c.Get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *Cache
+		c.Set("a", &state.GetResponse{}, c.Version())
+		c.Invalidate("a")
+		_, ok := c.Get("a")
+		assert.False(t, ok)
+		pubsubName, topic := c.InvalidationTopic()
+		assert.Empty(t, pubsubName)
+		assert.Empty(t, topic)
+	})
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+	contribquery "github.com/dapr/components-contrib/state/query"
+)
+
+// Emulated queries a state store without native query support. The keys to
+// query are read from the index of the store, and the filter and sort of the
+// query are evaluated by the runtime over their JSON values. Values which are
+// not JSON never match.
+type Emulated struct {
+	store state.Store
+	index *Index
+}
+
+// NewEmulated returns the querier of the state store over the given index.
+func NewEmulated(store state.Store, index *Index) *Emulated {
+	return &Emulated{store: store, index: index}
+}
+
+// position is where a page of the results of a query continues, which the
+// pagination token holds. Unsorted queries continue at the cursor of the
+// index, so that each page only reads the keys it lists. Sorted queries read
+// every key, as the index is not sorted by value, and continue after the key
+// and sort values of the last result, so keys saved or deleted between pages
+// do not shift the results.
+type position struct {
+	Cursor string `json:"c,omitempty"`
+	Key    string `json:"k,omitempty"`
+	Sort   []any  `json:"s,omitempty"`
+}
+
+// match is a result of a query, with its decoded JSON value.
+type match struct {
+	item  state.QueryItem
+	value any
+}
+
+// Query implements state.Querier. The pagination token is the opaque position
+// at which the next page continues.
+func (e *Emulated) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	after, err := decodePosition(req.Query.Page.Token, len(req.Query.Sort))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Query.Sort) == 0 {
+		return e.queryUnsorted(ctx, req, after)
+	}
+	return e.querySorted(ctx, req, after)
+}
+
+// queryUnsorted lists the keys of the index from the position until the page
+// is full, only reading as many keys at once as the page has room for.
+func (e *Emulated) queryUnsorted(ctx context.Context, req *state.QueryRequest, after *position) (*state.QueryResponse, error) {
+	var cursor string
+	if after != nil {
+		cursor = after.Cursor
+	}
+
+	limit := req.Query.Page.Limit
+	resp := &state.QueryResponse{Results: []state.QueryItem{}}
+	for {
+		size := indexPageSize
+		if limit > 0 {
+			size = min(size, limit-len(resp.Results))
+		}
+
+		keys, next, err := e.index.list(ctx, cursor, size)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := e.matches(ctx, req, keys, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			resp.Results = append(resp.Results, m.item)
+		}
+
+		if next == "" {
+			return resp, nil
+		}
+		cursor = next
+		if limit > 0 && len(resp.Results) >= limit {
+			resp.Token = encodePosition(position{Cursor: cursor})
+			return resp, nil
+		}
+	}
+}
+
+// querySorted reads every key of the index, keeping the results after the
+// position which sort first.
+func (e *Emulated) querySorted(ctx context.Context, req *state.QueryRequest, after *position) (*state.QueryResponse, error) {
+	sortMatches := func(matches []match) {
+		slices.SortStableFunc(matches, func(a, b match) int {
+			if c := compareSort(req.Query.Sort, a.value, b.value); c != 0 {
+				return c
+			}
+			return strings.Compare(a.item.Key, b.item.Key)
+		})
+	}
+
+	// Only the results of the page, and one more to know whether there is a
+	// next page, are kept once sorted.
+	limit := req.Query.Page.Limit
+	var matches []match
+	var cursor string
+	for {
+		keys, next, err := e.index.list(ctx, cursor, indexPageSize)
+		if err != nil {
+			return nil, err
+		}
+		page, err := e.matches(ctx, req, keys, after)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, page...)
+		if limit > 0 && len(matches) > 2*limit {
+			sortMatches(matches)
+			matches = matches[:limit+1]
+		}
+
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	sortMatches(matches)
+
+	end := len(matches)
+	if limit > 0 {
+		end = min(limit, end)
+	}
+
+	resp := &state.QueryResponse{Results: make([]state.QueryItem, 0, end)}
+	for _, m := range matches[:end] {
+		resp.Results = append(resp.Results, m.item)
+	}
+	if end < len(matches) {
+		last := matches[end-1]
+		pos := position{Key: last.item.Key}
+		for _, s := range req.Query.Sort {
+			pos.Sort = append(pos.Sort, Lookup(last.value, s.Key))
+		}
+		resp.Token = encodePosition(pos)
+	}
+
+	return resp, nil
+}
+
+// matches returns the state of the keys which matches the filter of the query
+// and, for sorted queries, sorts after the position.
+func (e *Emulated) matches(ctx context.Context, req *state.QueryRequest, keys []string, after *position) ([]match, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	reqs := make([]state.GetRequest, len(keys))
+	for i, key := range keys {
+		reqs[i] = state.GetRequest{Key: key, Metadata: req.Metadata}
+	}
+	res, err := e.store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	// The results are returned in the order of the keys, as listed.
+	order := make(map[string]int, len(keys))
+	for i, key := range keys {
+		order[key] = i
+	}
+
+	matches := make([]match, 0, len(res))
+	for _, r := range res {
+		// Keys in the index whose state has expired or was removed outside of
+		// the state API are skipped.
+		if r.Error != "" || len(r.Data) == 0 {
+			continue
+		}
+		var value any
+		if json.Unmarshal(r.Data, &value) != nil {
+			continue
+		}
+		if !Match(req.Query.Filter, value) {
+			continue
+		}
+		if after != nil && comparePosition(req.Query.Sort, r.Key, value, after) <= 0 {
+			continue
+		}
+		matches = append(matches, match{
+			item:  state.QueryItem{Key: r.Key, Data: r.Data, ETag: r.ETag, ContentType: r.ContentType},
+			value: value,
+		})
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		return order[a.item.Key] - order[b.item.Key]
+	})
+
+	return matches, nil
+}
+
+func encodePosition(pos position) string {
+	b, _ := json.Marshal(pos)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePosition(tok string, sorts int) (*position, error) {
+	if tok == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(tok)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed pagination token", ErrInvalidQuery)
+	}
+	var pos position
+	if err = json.Unmarshal(b, &pos); err != nil {
+		return nil, fmt.Errorf("%w: malformed pagination token", ErrInvalidQuery)
+	}
+	if sorts == 0 && pos.Cursor == "" || sorts > 0 && (pos.Key == "" || len(pos.Sort) != sorts) {
+		return nil, fmt.Errorf("%w: malformed pagination token", ErrInvalidQuery)
+	}
+	return &pos, nil
+}
+
+// comparePosition compares a result, by its key and decoded JSON value, to a
+// position in the sorted results of a query.
+func comparePosition(sort []contribquery.Sorting, key string, value any, pos *position) int {
+	for i, s := range sort {
+		c := Compare(Lookup(value, s.Key), pos.Sort[i])
+		if s.Order == contribquery.DESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(key, pos.Key)
+}
+
+// compareSort compares two decoded JSON values by the sort of a query.
+func compareSort(sort []contribquery.Sorting, a, b any) int {
+	for _, s := range sort {
+		c := Compare(Lookup(a, s.Key), Lookup(b, s.Key))
+		if s.Order == contribquery.DESC {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// save saves the values in the order of their keys.
+func save(t *testing.T, store state.Store, values map[string]string) {
+	t.Helper()
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: key, Value: []byte(values[key])}))
+	}
+}
+
+func keysOf(items []state.QueryItem) []string {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = item.Key
+	}
+	return keys
+}
+
+func TestEmulated(t *testing.T) {
+	for name, newQueriedStore := range map[string]func(t *testing.T) state.Store{
+		"native listing": newStore,
+		"tracked index": func(t *testing.T) state.Store {
+			return NewIndexedStore(struct{ state.Store }{newStore(t)}, "app||")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testEmulated(t, newQueriedStore(t))
+		})
+	}
+}
+
+func testEmulated(t *testing.T, store state.Store) {
+	save(t, store, map[string]string{
+		"app||1":          `{"city": "Seattle", "age": 30}`,
+		"app||2":          `{"city": "Portland", "age": 25}`,
+		"app||3":          `{"city": "Seattle", "age": 41}`,
+		"app||4":          `{"city": "Seattle", "age": 30}`,
+		"app||5":          `not json`,
+		"app||actor||1||": `{"city": "Seattle", "age": 30}`,
+	})
+
+	querier := NewEmulated(store, NewIndex(store, "app||"))
+	query := func(t *testing.T, q string) *state.QueryResponse {
+		t.Helper()
+		parsed, err := Parse([]byte(q))
+		require.NoError(t, err)
+		resp, err := querier.Query(t.Context(), &state.QueryRequest{Query: *parsed})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		resp := query(t, `{}`)
+		assert.ElementsMatch(t, []string{"app||1", "app||2", "app||3", "app||4"}, keysOf(resp.Results))
+		assert.Empty(t, resp.Token)
+	})
+
+	t.Run("filter and sort", func(t *testing.T) {
+		resp := query(t, `{"filter": {"EQ": {"city": "Seattle"}}, "sort": [{"key": "age", "order": "DESC"}]}`)
+		assert.Equal(t, []string{"app||3", "app||1", "app||4"}, keysOf(resp.Results))
+		assert.JSONEq(t, `{"city": "Seattle", "age": 41}`, string(resp.Results[0].Data))
+		assert.NotNil(t, resp.Results[0].ETag)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		resp := query(t, `{"sort": [{"key": "age"}], "page": {"limit": 2}}`)
+		assert.Equal(t, []string{"app||2", "app||1"}, keysOf(resp.Results))
+		require.NotEmpty(t, resp.Token)
+
+		// Keys saved and deleted before the position of the token do not
+		// shift the next page.
+		save(t, store, map[string]string{"app||0": `{"age": 20}`})
+		t.Cleanup(func() {
+			require.NoError(t, store.Delete(t.Context(), &state.DeleteRequest{Key: "app||0"}))
+		})
+		require.NoError(t, store.Delete(t.Context(), &state.DeleteRequest{Key: "app||2"}))
+		t.Cleanup(func() {
+			save(t, store, map[string]string{"app||2": `{"city": "Portland", "age": 25}`})
+		})
+
+		resp = query(t, `{"sort": [{"key": "age"}], "page": {"limit": 2, "token": "`+resp.Token+`"}}`)
+		assert.Equal(t, []string{"app||4", "app||3"}, keysOf(resp.Results))
+		assert.Empty(t, resp.Token)
+	})
+
+	t.Run("pagination without sort", func(t *testing.T) {
+		resp := query(t, `{"filter": {"EQ": {"city": "Seattle"}}, "page": {"limit": 2}}`)
+		require.Len(t, resp.Results, 2)
+		require.NotEmpty(t, resp.Token)
+		keys := keysOf(resp.Results)
+
+		resp = query(t, `{"filter": {"EQ": {"city": "Seattle"}}, "page": {"limit": 2, "token": "`+resp.Token+`"}}`)
+		keys = append(keys, keysOf(resp.Results)...)
+		assert.ElementsMatch(t, []string{"app||1", "app||3", "app||4"}, keys)
+		assert.Empty(t, resp.Token)
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		for _, q := range []string{
+			`{"page": {"token": "x"}}`,
+			`{"page": {"token": "e30"}}`,
+			`{"sort": [{"key": "age"}], "page": {"token": "eyJjIjoiMSJ9"}}`,
+		} {
+			parsed, err := Parse([]byte(q))
+			require.NoError(t, err)
+			_, err = querier.Query(t.Context(), &state.QueryRequest{Query: *parsed})
+			require.ErrorIs(t, err, ErrInvalidQuery)
+		}
+	})
+}
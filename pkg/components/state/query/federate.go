@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dapr/components-contrib/state"
+	contribquery "github.com/dapr/components-contrib/state/query"
+)
+
+// Target is a state store queried by a query.
+type Target struct {
+	Name    string
+	Querier state.Querier
+}
+
+// Result is a result of a query, along with the state store it came from.
+type Result struct {
+	state.QueryItem
+	Store string
+}
+
+// Response is the response of a query over one or more state stores.
+type Response struct {
+	Results []Result
+	// Token is the pagination token of the next page, or empty if there are no
+	// more results.
+	Token string
+	// Metadata is the metadata of the state store response, which is only
+	// returned when a single state store is queried.
+	Metadata map[string]string
+}
+
+// Run runs the query over the state stores of the targets. Without a sort, the
+// results of the state stores are returned one store after the other, in the
+// order of the targets. With a sort, the sorted results of each state store
+// are merged, so the sort holds across state stores.
+//
+// The page token of the query is a token returned by a previous run of the
+// same query over the same targets, which Run decodes into the position in
+// each state store, and the returned token encodes the positions after the
+// returned results.
+func Run(ctx context.Context, targets []Target, q *contribquery.Query, metadata map[string]string) (*Response, error) {
+	names := make([]string, len(targets))
+	for i, t := range targets {
+		names[i] = t.Name
+	}
+	digest := Digest(names, q)
+
+	cursors, err := DecodeToken(digest, q.Page.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]*stream, 0, len(targets))
+	for _, t := range targets {
+		s := &stream{target: t, query: q, metadata: metadata}
+		if cursors != nil {
+			c, ok := cursors[t.Name]
+			if !ok {
+				s.exhausted = true
+			}
+			s.cursor = c
+		}
+		streams = append(streams, s)
+	}
+
+	limit := q.Page.Limit
+	resp := &Response{Results: make([]Result, 0, limit)}
+	for limit == 0 || len(resp.Results) < limit {
+		var need int
+		if limit > 0 {
+			need = limit - len(resp.Results)
+		}
+
+		var next *stream
+		if len(q.Sort) == 0 {
+			next, err = firstStream(ctx, streams, need)
+		} else {
+			next, err = minStream(ctx, streams, q.Sort, need)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
+			break
+		}
+		resp.Results = append(resp.Results, Result{QueryItem: next.pop(), Store: next.target.Name})
+	}
+
+	next := make(map[string]Cursor, len(streams))
+	for _, s := range streams {
+		if c, ok := s.position(); ok {
+			next[s.target.Name] = c
+		}
+	}
+	resp.Token = EncodeToken(digest, next)
+
+	if len(streams) == 1 {
+		resp.Metadata = streams[0].respMetadata
+	}
+
+	return resp, nil
+}
+
+// firstStream returns the first stream with results left, querying the state
+// stores in order only as far as needed.
+func firstStream(ctx context.Context, streams []*stream, need int) (*stream, error) {
+	for _, s := range streams {
+		if err := s.fill(ctx, need); err != nil {
+			return nil, err
+		}
+		if s.hasNext() {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// minStream returns the stream whose next result sorts first, favouring
+// earlier state stores between equal results.
+func minStream(ctx context.Context, streams []*stream, sort []contribquery.Sorting, need int) (*stream, error) {
+	var best *stream
+	for _, s := range streams {
+		if err := s.fill(ctx, need); err != nil {
+			return nil, err
+		}
+		if !s.hasNext() {
+			continue
+		}
+		if best == nil || compareSort(sort, s.values[s.pos], best.values[best.pos]) < 0 {
+			best = s
+		}
+	}
+	return best, nil
+}
+
+// stream reads the results of a state store one page at a time, from the
+// position of the cursor.
+type stream struct {
+	target   Target
+	query    *contribquery.Query
+	metadata map[string]string
+
+	// cursor is the position of the loaded page.
+	cursor    Cursor
+	exhausted bool
+
+	loaded       bool
+	items        []state.QueryItem
+	values       []any
+	pos          int
+	nextToken    string
+	respMetadata map[string]string
+}
+
+// fill loads the next page of the state store if all loaded results have been
+// consumed, asking for the given number of results, or all results if zero.
+func (s *stream) fill(ctx context.Context, need int) error {
+	for !s.exhausted && !s.hasNext() {
+		if s.loaded {
+			if s.nextToken == "" {
+				s.exhausted = true
+				return nil
+			}
+			s.cursor = Cursor{Token: s.nextToken}
+			s.loaded = false
+		}
+
+		q := *s.query
+		q.Page = contribquery.Pagination{Token: s.cursor.Token}
+		if need > 0 {
+			q.Page.Limit = s.cursor.Skip + need
+		}
+		resp, err := s.target.Querier.Query(ctx, &state.QueryRequest{Query: q, Metadata: s.metadata})
+		if err != nil {
+			return err
+		}
+
+		s.items = resp.Results[min(s.cursor.Skip, len(resp.Results)):]
+		s.values = make([]any, len(s.items))
+		for i, item := range s.items {
+			var v any
+			if json.Unmarshal(item.Data, &v) == nil {
+				s.values[i] = v
+			}
+		}
+		s.pos = 0
+		s.nextToken = resp.Token
+		s.respMetadata = resp.Metadata
+		s.loaded = true
+	}
+	return nil
+}
+
+func (s *stream) hasNext() bool {
+	return s.loaded && s.pos < len(s.items)
+}
+
+func (s *stream) pop() state.QueryItem {
+	item := s.items[s.pos]
+	s.pos++
+	return item
+}
+
+// position returns the cursor of the results not yet returned, or false if
+// there are none.
+func (s *stream) position() (Cursor, bool) {
+	switch {
+	case s.exhausted:
+		return Cursor{}, false
+	case !s.loaded:
+		return s.cursor, true
+	case s.pos < len(s.items):
+		return Cursor{Token: s.cursor.Token, Skip: s.cursor.Skip + s.pos}, true
+	case s.nextToken != "":
+		return Cursor{Token: s.nextToken}, true
+	default:
+		return Cursor{}, false
+	}
+}
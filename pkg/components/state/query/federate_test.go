@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// countingQuerier counts the queries made to a querier.
+type countingQuerier struct {
+	state.Querier
+	queries int
+}
+
+func (c *countingQuerier) Query(ctx context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	c.queries++
+	return c.Querier.Query(ctx, req)
+}
+
+func TestRun(t *testing.T) {
+	newTarget := func(t *testing.T, name string, values map[string]string) (Target, *countingQuerier) {
+		t.Helper()
+		store := newStore(t)
+		save(t, store, values)
+		querier := &countingQuerier{Querier: NewEmulated(store, NewIndex(store, ""))}
+		return Target{Name: name, Querier: querier}, querier
+	}
+
+	east, eastQuerier := newTarget(t, "east", map[string]string{
+		"e1": `{"n": 1}`,
+		"e2": `{"n": 4}`,
+		"e3": `{"n": 5}`,
+	})
+	west, westQuerier := newTarget(t, "west", map[string]string{
+		"w1": `{"n": 2}`,
+		"w2": `{"n": 3}`,
+		"w3": `{"n": 6}`,
+	})
+	targets := []Target{east, west}
+
+	// page runs the query over all pages, returning the results of each page.
+	page := func(t *testing.T, q string) [][]string {
+		t.Helper()
+		parsed, err := Parse([]byte(q))
+		require.NoError(t, err)
+
+		var pages [][]string
+		for {
+			resp, err := Run(t.Context(), targets, parsed, nil)
+			require.NoError(t, err)
+			var keys []string
+			for _, r := range resp.Results {
+				keys = append(keys, r.Store+"/"+r.Key)
+			}
+			pages = append(pages, keys)
+			if resp.Token == "" {
+				return pages
+			}
+			parsed.Page.Token = resp.Token
+		}
+	}
+
+	t.Run("merges sorted results", func(t *testing.T) {
+		assert.Equal(t, [][]string{
+			{"east/e1", "west/w1", "west/w2", "east/e2"},
+			{"east/e3", "west/w3"},
+		}, page(t, `{"sort": [{"key": "n"}], "page": {"limit": 4}}`))
+
+		assert.Equal(t, [][]string{
+			{"west/w3", "east/e3"},
+			{"east/e2", "west/w2"},
+			{"west/w1", "east/e1"},
+		}, page(t, `{"sort": [{"key": "n", "order": "DESC"}], "page": {"limit": 2}}`))
+	})
+
+	t.Run("concatenates unsorted results", func(t *testing.T) {
+		eastQuerier.queries, westQuerier.queries = 0, 0
+		assert.Equal(t, [][]string{
+			{"east/e1", "east/e2"},
+			{"east/e3", "west/w1"},
+			{"west/w2", "west/w3"},
+		}, page(t, `{"page": {"limit": 2}}`))
+		// West is not queried for the first page, which east fills.
+		assert.Equal(t, 2, eastQuerier.queries)
+		assert.Equal(t, 2, westQuerier.queries)
+	})
+
+	t.Run("filters every store", func(t *testing.T) {
+		assert.Equal(t, [][]string{
+			{"west/w2", "east/e2", "east/e3"},
+		}, page(t, `{"filter": {"AND": [{"GTE": {"n": 3}}, {"LT": {"n": 6}}]}, "sort": [{"key": "n"}]}`))
+	})
+
+	t.Run("rejects the token of another query", func(t *testing.T) {
+		parsed, err := Parse([]byte(`{"page": {"limit": 1}}`))
+		require.NoError(t, err)
+		resp, err := Run(t.Context(), targets, parsed, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Token)
+
+		parsed.Page.Token = resp.Token
+		_, err = Run(t.Context(), targets[:1], parsed, nil)
+		require.ErrorIs(t, err, ErrInvalidQuery)
+	})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	contribquery "github.com/dapr/components-contrib/state/query"
+)
+
+// ErrInvalidQuery is returned when a query does not conform to the stable
+// query grammar.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Parse parses a query in the stable grammar of the state query API. Unlike
+// the alpha API, which passes the query as-is to the state store, the stable
+// grammar is validated by the runtime so that a query means the same on every
+// state store:
+//   - The query only has the "filter", "sort" and "page" fields.
+//   - Filters are EQ, NEQ, GT, GTE, LT, LTE, IN, AND and OR. The key of a
+//     filter is a dot-separated path in the state value. EQ, NEQ and IN
+//     compare against strings, numbers, booleans or null, and GT, GTE, LT and
+//     LTE against strings or numbers. IN needs at least one value, and AND and
+//     OR at least two filters.
+//   - Sort keys are paths in the state value, ordered "ASC" (default) or
+//     "DESC".
+//   - The page limit is zero (no limit) or positive.
+func Parse(data []byte) (*contribquery.Query, error) {
+	var fields contribquery.QueryFields
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+	}
+
+	q := &contribquery.Query{QueryFields: fields}
+	if len(fields.Filters) > 0 {
+		filter, err := contribquery.ParseFilter(fields.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+		}
+		if err = validateFilter(filter); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+		}
+		q.Filter = filter
+	}
+
+	for _, s := range fields.Sort {
+		if s.Key == "" {
+			return nil, fmt.Errorf("%w: sort key must not be empty", ErrInvalidQuery)
+		}
+		if s.Order != "" && s.Order != contribquery.ASC && s.Order != contribquery.DESC {
+			return nil, fmt.Errorf("%w: sort order of %q must be %q or %q", ErrInvalidQuery, s.Key, contribquery.ASC, contribquery.DESC)
+		}
+	}
+
+	if fields.Page.Limit < 0 {
+		return nil, fmt.Errorf("%w: page limit must not be negative", ErrInvalidQuery)
+	}
+
+	return q, nil
+}
+
+func validateFilter(filter contribquery.Filter) error {
+	switch f := filter.(type) {
+	case *contribquery.EQ:
+		return validateComparison("EQ", f.Key, f.Val, isScalar)
+	case *contribquery.NEQ:
+		return validateComparison("NEQ", f.Key, f.Val, isScalar)
+	case *contribquery.GT:
+		return validateComparison("GT", f.Key, f.Val, isOrdered)
+	case *contribquery.GTE:
+		return validateComparison("GTE", f.Key, f.Val, isOrdered)
+	case *contribquery.LT:
+		return validateComparison("LT", f.Key, f.Val, isOrdered)
+	case *contribquery.LTE:
+		return validateComparison("LTE", f.Key, f.Val, isOrdered)
+	case *contribquery.IN:
+		if len(f.Vals) == 0 {
+			return fmt.Errorf("IN filter of %q must have at least one value", f.Key)
+		}
+		for _, v := range f.Vals {
+			if err := validateComparison("IN", f.Key, v, isScalar); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *contribquery.AND:
+		return validateFilters(f.Filters)
+	case *contribquery.OR:
+		return validateFilters(f.Filters)
+	default:
+		return fmt.Errorf("unsupported filter %T", filter)
+	}
+}
+
+func validateFilters(filters []contribquery.Filter) error {
+	for _, f := range filters {
+		if err := validateFilter(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateComparison(op, key string, val any, valid func(any) bool) error {
+	if key == "" {
+		return fmt.Errorf("%s filter key must not be empty", op)
+	}
+	if !valid(val) {
+		return fmt.Errorf("%s filter of %q has an unsupported value %v", op, key, val)
+	}
+	return nil
+}
+
+func isScalar(v any) bool {
+	switch v.(type) {
+	case nil, bool, float64, string:
+		return true
+	default:
+		return false
+	}
+}
+
+func isOrdered(v any) bool {
+	switch v.(type) {
+	case float64, string:
+		return true
+	default:
+		return false
+	}
+}
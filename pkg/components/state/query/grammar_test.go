@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribquery "github.com/dapr/components-contrib/state/query"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("valid query", func(t *testing.T) {
+		q, err := Parse([]byte(`{
+			"filter": {"AND": [{"EQ": {"state": "CA"}}, {"GT": {"person.age": 30}}, {"IN": {"id": [1, "2", true, null]}}]},
+			"sort": [{"key": "person.age", "order": "DESC"}, {"key": "id"}],
+			"page": {"limit": 10, "token": "abc"}
+		}`))
+		require.NoError(t, err)
+		and, ok := q.Filter.(*contribquery.AND)
+		require.True(t, ok)
+		assert.Len(t, and.Filters, 3)
+		assert.Equal(t, []contribquery.Sorting{{Key: "person.age", Order: "DESC"}, {Key: "id"}}, q.Sort)
+		assert.Equal(t, contribquery.Pagination{Limit: 10, Token: "abc"}, q.Page)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		q, err := Parse([]byte(`{}`))
+		require.NoError(t, err)
+		assert.Nil(t, q.Filter)
+	})
+
+	tests := map[string]string{
+		"unknown field":         `{"filter": {"EQ": {"a": 1}}, "limit": 1}`,
+		"unknown filter":        `{"filter": {"LIKE": {"a": "b"}}}`,
+		"single AND":            `{"filter": {"AND": [{"EQ": {"a": 1}}]}}`,
+		"empty filter key":      `{"filter": {"EQ": {"": 1}}}`,
+		"object EQ value":       `{"filter": {"EQ": {"a": {"b": 1}}}}`,
+		"boolean GT value":      `{"filter": {"GT": {"a": true}}}`,
+		"null LTE value":        `{"filter": {"LTE": {"a": null}}}`,
+		"empty IN":              `{"filter": {"IN": {"a": []}}}`,
+		"array IN value":        `{"filter": {"IN": {"a": [[1]]}}}`,
+		"nested invalid filter": `{"filter": {"OR": [{"EQ": {"a": 1}}, {"GT": {"b": false}}]}}`,
+		"empty sort key":        `{"sort": [{"key": ""}]}`,
+		"invalid sort order":    `{"sort": [{"key": "a", "order": "desc"}]}`,
+		"negative limit":        `{"page": {"limit": -1}}`,
+		"not JSON":              `filter`,
+	}
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(query))
+			require.ErrorIs(t, err, ErrInvalidQuery)
+		})
+	}
+}
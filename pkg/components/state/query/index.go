@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	// indexPageSize is the number of keys listed from the state store at once.
+	indexPageSize = 1000
+
+	// keySeparator separates the key prefix of the app from the key. Keys
+	// written through the state API cannot contain it, so keys with more
+	// separators after the key prefix of the app, such as those of actors,
+	// are not part of the index.
+	keySeparator = "||"
+)
+
+// Index lists the keys saved by an app in a state store without native query
+// support, so that the store can be queried. State stores which can list
+// their keys are listed natively, so the index holds every key under the key
+// prefix of the app whichever way it was written. For the other state stores,
+// the runtime maintains the index in the state store itself, as state is saved
+// and deleted through the state store returned by NewIndexedStore.
+type Index struct {
+	lister lister
+}
+
+// lister lists the keys of an index a page at a time.
+type lister interface {
+	// list returns up to size keys of the index, as saved in the state store,
+	// from the cursor, along with the cursor of the keys after them, which is
+	// empty once every key has been listed.
+	list(ctx context.Context, cursor string, size int) ([]string, string, error)
+}
+
+// NewIndex returns the index of the keys under the given key prefix of the
+// state store.
+func NewIndex(store state.Store, prefix string) *Index {
+	if keysLiker, ok := store.(state.KeysLiker); ok {
+		return &Index{lister: &keysLikeLister{store: keysLiker, prefix: prefix}}
+	}
+	return &Index{lister: &trackedIndex{store: store, prefix: prefix}}
+}
+
+// Keys returns every key in the index, as saved in the state store, sorted.
+func (i *Index) Keys(ctx context.Context) ([]string, error) {
+	var keys []string
+	var cursor string
+	for {
+		page, next, err := i.lister.list(ctx, cursor, indexPageSize)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, page...)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	slices.Sort(keys)
+
+	return keys, nil
+}
+
+// list returns a page of the keys in the index. See lister.
+func (i *Index) list(ctx context.Context, cursor string, size int) ([]string, string, error) {
+	return i.lister.list(ctx, cursor, size)
+}
+
+// keysLikeLister lists the keys of a state store which lists its keys
+// natively. The cursor is the continuation token of the state store.
+type keysLikeLister struct {
+	store  state.KeysLiker
+	prefix string
+}
+
+func (l *keysLikeLister) list(ctx context.Context, cursor string, size int) ([]string, string, error) {
+	// The pattern is not escaped, as state stores differ in their escape
+	// character, so keys it matches outside the key prefix are dropped.
+	req := &state.KeysLikeRequest{
+		Pattern:  l.prefix + "%",
+		PageSize: ptr.Of(uint32(size)), //nolint:gosec
+	}
+	if cursor != "" {
+		req.ContinuationToken = &cursor
+	}
+
+	res, err := l.store.KeysLike(ctx, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list keys of the query index: %w", err)
+	}
+
+	keys := make([]string, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		if indexed(l.prefix, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	var next string
+	if res.ContinuationToken != nil && len(res.Keys) > 0 {
+		next = *res.ContinuationToken
+	}
+	return keys, next, nil
+}
+
+// indexed returns true if the key, as saved in the state store, belongs in the
+// index of the given key prefix.
+func indexed(prefix, key string) bool {
+	return strings.HasPrefix(key, prefix) && !strings.Contains(key[len(prefix):], keySeparator)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/kit/logger"
+)
+
+func newStore(t *testing.T) state.Store {
+	t.Helper()
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+	return store
+}
+
+func TestIndex(t *testing.T) {
+	t.Run("lists the keys of the app", func(t *testing.T) {
+		store := newStore(t)
+		for _, key := range []string{"app||c", "app||a", "app||b", "other||a", "app||actor||id||a", "appx||a"} {
+			require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: key, Value: []byte(`1`)}))
+		}
+
+		keys, err := NewIndex(store, "app||").Keys(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||a", "app||b", "app||c"}, keys)
+
+		require.NoError(t, store.Delete(t.Context(), &state.DeleteRequest{Key: "app||b"}))
+		keys, err = NewIndex(store, "app||").Keys(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||a", "app||c"}, keys)
+
+		keys, err = NewIndex(store, "none||").Keys(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("pages through the keys", func(t *testing.T) {
+		store := newStore(t)
+		for i := range indexPageSize + 5 {
+			require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: fmt.Sprintf("app||key-%04d", i), Value: []byte(`1`)}))
+		}
+
+		keys, err := NewIndex(store, "app||").Keys(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, keys, indexPageSize+5)
+		assert.True(t, slices.IsSorted(keys))
+	})
+
+	t.Run("state store without listing", func(t *testing.T) {
+		index := NewIndex(struct{ state.Store }{newStore(t)}, "app||")
+		assert.IsType(t, &trackedIndex{}, index.lister)
+	})
+}
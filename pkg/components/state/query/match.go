@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"cmp"
+	"strings"
+
+	contribquery "github.com/dapr/components-contrib/state/query"
+)
+
+// Match reports whether the decoded JSON state value matches the filter. A nil
+// filter matches every value.
+func Match(filter contribquery.Filter, value any) bool {
+	switch f := filter.(type) {
+	case nil:
+		return true
+	case *contribquery.EQ:
+		return Compare(Lookup(value, f.Key), f.Val) == 0
+	case *contribquery.NEQ:
+		return Compare(Lookup(value, f.Key), f.Val) != 0
+	case *contribquery.GT:
+		v := Lookup(value, f.Key)
+		return sameKind(v, f.Val) && Compare(v, f.Val) > 0
+	case *contribquery.GTE:
+		v := Lookup(value, f.Key)
+		return sameKind(v, f.Val) && Compare(v, f.Val) >= 0
+	case *contribquery.LT:
+		v := Lookup(value, f.Key)
+		return sameKind(v, f.Val) && Compare(v, f.Val) < 0
+	case *contribquery.LTE:
+		v := Lookup(value, f.Key)
+		return sameKind(v, f.Val) && Compare(v, f.Val) <= 0
+	case *contribquery.IN:
+		v := Lookup(value, f.Key)
+		for _, val := range f.Vals {
+			if Compare(v, val) == 0 {
+				return true
+			}
+		}
+		return false
+	case *contribquery.AND:
+		for _, sub := range f.Filters {
+			if !Match(sub, value) {
+				return false
+			}
+		}
+		return true
+	case *contribquery.OR:
+		for _, sub := range f.Filters {
+			if Match(sub, value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// Lookup returns the field at the dot-separated path in the decoded JSON
+// value, or nil if there is no such field.
+func Lookup(value any, path string) any {
+	for part := range strings.SplitSeq(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if value, ok = m[part]; !ok {
+			return nil
+		}
+	}
+	return value
+}
+
+// Compare orders two decoded JSON values for sorting. Values of different
+// types are ordered null (or missing), booleans, numbers, strings, and then
+// objects and arrays, which compare equal to each other.
+func Compare(a, b any) int {
+	if c := cmp.Compare(rank(a), rank(b)); c != 0 {
+		return c
+	}
+	switch av := a.(type) {
+	case bool:
+		bv := b.(bool)
+		switch {
+		case av == bv:
+			return 0
+		case !av:
+			return -1
+		default:
+			return 1
+		}
+	case float64:
+		return cmp.Compare(av, b.(float64))
+	case string:
+		return strings.Compare(av, b.(string))
+	default:
+		return 0
+	}
+}
+
+func sameKind(a, b any) bool {
+	return rank(a) == rank(b)
+}
+
+func rank(v any) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case float64:
+		return 2
+	case string:
+		return 3
+	default:
+		return 4
+	}
+}
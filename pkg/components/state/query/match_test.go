@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	var value any
+	require.NoError(t, json.Unmarshal([]byte(`{"state": "CA", "person": {"age": 42, "admin": true}, "tags": ["a"]}`), &value))
+
+	tests := map[string]struct {
+		filter string
+		match  bool
+	}{
+		"EQ string":               {`{"EQ": {"state": "CA"}}`, true},
+		"EQ nested":               {`{"EQ": {"person.age": 42}}`, true},
+		"EQ mismatched type":      {`{"EQ": {"person.age": "42"}}`, false},
+		"EQ null matches missing": {`{"EQ": {"person.name": null}}`, true},
+		"NEQ":                     {`{"NEQ": {"state": "WA"}}`, true},
+		"GT":                      {`{"GT": {"person.age": 40}}`, true},
+		"GT equal":                {`{"GT": {"person.age": 42}}`, false},
+		"GTE equal":               {`{"GTE": {"person.age": 42}}`, true},
+		"LT string":               {`{"LT": {"state": "DE"}}`, true},
+		"LT mismatched type":      {`{"LT": {"person.age": "zzz"}}`, false},
+		"LTE missing":             {`{"LTE": {"person.height": 100}}`, false},
+		"IN":                      {`{"IN": {"state": ["WA", "CA"]}}`, true},
+		"IN bool":                 {`{"IN": {"person.admin": [true]}}`, true},
+		"IN none":                 {`{"IN": {"state": ["WA", "OR"]}}`, false},
+		"AND":                     {`{"AND": [{"EQ": {"state": "CA"}}, {"GT": {"person.age": 50}}]}`, false},
+		"OR":                      {`{"OR": [{"EQ": {"state": "WA"}}, {"GT": {"person.age": 40}}]}`, true},
+		"path through non-object": {`{"EQ": {"state.code": "CA"}}`, false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q, err := Parse([]byte(`{"filter": ` + test.filter + `}`))
+			require.NoError(t, err)
+			assert.Equal(t, test.match, Match(q.Filter, value))
+		})
+	}
+
+	t.Run("nil filter", func(t *testing.T) {
+		assert.True(t, Match(nil, value))
+	})
+}
+
+func TestCompare(t *testing.T) {
+	ordered := []any{nil, false, true, -1.5, 0.0, 10.0, "", "a", "b", map[string]any{}}
+	for i := range ordered {
+		for j := range ordered {
+			switch {
+			case i < j:
+				assert.Negative(t, Compare(ordered[i], ordered[j]), "%v < %v", ordered[i], ordered[j])
+			case i > j:
+				assert.Positive(t, Compare(ordered[i], ordered[j]), "%v > %v", ordered[i], ordered[j])
+			default:
+				assert.Zero(t, Compare(ordered[i], ordered[j]))
+			}
+		}
+	}
+	assert.Zero(t, Compare(map[string]any{"a": 1.0}, []any{1.0}))
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.state.query")
+
+// NewIndexedStore returns the state store, maintaining the query index of the
+// keys under the given key prefix as state is saved and deleted through it. It
+// is meant for state stores which cannot list their keys, and is transactional
+// if the state store is.
+//
+// Keys are added to the index before they are saved, so that a failure to
+// index fails the write before it is made, and removed once deleted, so that a
+// failure to remove them only leaves keys without state in the index, which
+// queries skip.
+func NewIndexedStore(store state.Store, prefix string) state.Store {
+	s := &indexedStore{
+		Store: store,
+		index: &trackedIndex{store: store, prefix: prefix},
+	}
+	if tx, ok := store.(state.TransactionalStore); ok {
+		return &indexedTransactionalStore{indexedStore: s, tx: tx}
+	}
+	return s
+}
+
+type indexedStore struct {
+	state.Store
+	index *trackedIndex
+}
+
+func (s *indexedStore) Set(ctx context.Context, req *state.SetRequest) error {
+	if err := s.index.track(ctx, []string{req.Key}); err != nil {
+		return err
+	}
+	return s.Store.Set(ctx, req)
+}
+
+func (s *indexedStore) BulkSet(ctx context.Context, reqs []state.SetRequest, opts state.BulkStoreOpts) error {
+	keys := make([]string, len(reqs))
+	for i := range reqs {
+		keys[i] = reqs[i].Key
+	}
+	if err := s.index.track(ctx, keys); err != nil {
+		return err
+	}
+	return s.Store.BulkSet(ctx, reqs, opts)
+}
+
+func (s *indexedStore) Delete(ctx context.Context, req *state.DeleteRequest) error {
+	if err := s.Store.Delete(ctx, req); err != nil {
+		return err
+	}
+	s.untrack(ctx, []string{req.Key})
+	return nil
+}
+
+func (s *indexedStore) BulkDelete(ctx context.Context, reqs []state.DeleteRequest, opts state.BulkStoreOpts) error {
+	if err := s.Store.BulkDelete(ctx, reqs, opts); err != nil {
+		return err
+	}
+	keys := make([]string, len(reqs))
+	for i := range reqs {
+		keys[i] = reqs[i].Key
+	}
+	s.untrack(ctx, keys)
+	return nil
+}
+
+// untrack removes the deleted keys from the index. The state has already been
+// deleted, so failures are logged rather than returned.
+func (s *indexedStore) untrack(ctx context.Context, keys []string) {
+	if err := s.index.untrack(ctx, keys); err != nil {
+		log.Warnf("Failed to remove deleted keys from the query index: %s", err)
+	}
+}
+
+type indexedTransactionalStore struct {
+	*indexedStore
+	tx state.TransactionalStore
+}
+
+func (s *indexedTransactionalStore) Multi(ctx context.Context, req *state.TransactionalStateRequest) error {
+	// A key operated on more than once is indexed by its last operation.
+	last := make(map[string]state.OperationType, len(req.Operations))
+	for _, op := range req.Operations {
+		last[op.GetKey()] = op.Operation()
+	}
+	var upserts, deletes []string
+	for key, op := range last {
+		switch op {
+		case state.OperationUpsert:
+			upserts = append(upserts, key)
+		case state.OperationDelete:
+			deletes = append(deletes, key)
+		}
+	}
+
+	if err := s.index.track(ctx, upserts); err != nil {
+		return err
+	}
+	if err := s.tx.Multi(ctx, req); err != nil {
+		return err
+	}
+	s.untrack(ctx, deletes)
+	return nil
+}
+
+// MultiMaxSize implements state.TransactionalStoreMultiMaxSize, returning -1
+// if the state store does not limit the size of transactions.
+func (s *indexedTransactionalStore) MultiMaxSize() int {
+	if maxMulti, ok := s.tx.(state.TransactionalStoreMultiMaxSize); ok {
+		return maxMulti.MultiMaxSize()
+	}
+	return -1
+}
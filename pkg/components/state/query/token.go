@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	contribquery "github.com/dapr/components-contrib/state/query"
+)
+
+const tokenVersion = 1
+
+// Cursor is the position of a query in the results of a single state store.
+type Cursor struct {
+	// Token is the pagination token of the state store for the page the query
+	// continues from, or empty for the first page.
+	Token string `json:"t,omitempty"`
+	// Skip is the number of results of that page already returned.
+	Skip int `json:"s,omitempty"`
+}
+
+// token is the pagination token returned by the runtime. It is opaque to the
+// caller, and is bound to the query it was returned for: the token is only
+// accepted for a query with the same state stores, filter and sort, though
+// the page limit may change between pages.
+type token struct {
+	Version int    `json:"v"`
+	Digest  string `json:"d"`
+	// Cursors holds the position in each state store with results left. State
+	// stores without a cursor are exhausted.
+	Cursors map[string]Cursor `json:"c"`
+}
+
+// Digest returns the digest of the query over the given state stores, which
+// binds pagination tokens to the query.
+func Digest(stores []string, q *contribquery.Query) string {
+	b, _ := json.Marshal(struct {
+		Stores []string               `json:"stores"`
+		Filter map[string]any         `json:"filter,omitempty"`
+		Sort   []contribquery.Sorting `json:"sort,omitempty"`
+	}{Stores: stores, Filter: q.Filters, Sort: q.Sort})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// EncodeToken returns the pagination token for the given cursors, or an empty
+// token if there are no results left in any state store.
+func EncodeToken(digest string, cursors map[string]Cursor) string {
+	if len(cursors) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(token{Version: tokenVersion, Digest: digest, Cursors: cursors})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeToken returns the cursors of the pagination token, which must have
+// been returned for a query with the given digest. An empty token returns nil
+// cursors, meaning the query starts from the beginning of every state store.
+func DecodeToken(digest string, tok string) (map[string]Cursor, error) {
+	if tok == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(tok)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed pagination token", ErrInvalidQuery)
+	}
+	var t token
+	if err = json.Unmarshal(b, &t); err != nil || t.Cursors == nil {
+		return nil, fmt.Errorf("%w: malformed pagination token", ErrInvalidQuery)
+	}
+	if t.Version != tokenVersion {
+		return nil, fmt.Errorf("%w: unsupported pagination token version %d", ErrInvalidQuery, t.Version)
+	}
+	if t.Digest != digest {
+		return nil, fmt.Errorf("%w: pagination token does not belong to this query", ErrInvalidQuery)
+	}
+	for store, c := range t.Cursors {
+		if c.Skip < 0 {
+			return nil, fmt.Errorf("%w: malformed pagination token for state store %q", ErrInvalidQuery, store)
+		}
+	}
+
+	return t.Cursors, nil
+}
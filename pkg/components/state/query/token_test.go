@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribquery "github.com/dapr/components-contrib/state/query"
+)
+
+func TestToken(t *testing.T) {
+	q, err := Parse([]byte(`{"filter": {"EQ": {"a": 1}}, "sort": [{"key": "b"}], "page": {"limit": 2}}`))
+	require.NoError(t, err)
+	digest := Digest([]string{"s1", "s2"}, q)
+
+	t.Run("round trip", func(t *testing.T) {
+		cursors := map[string]Cursor{"s1": {Token: "7", Skip: 1}, "s2": {}}
+		tok := EncodeToken(digest, cursors)
+		got, err := DecodeToken(digest, tok)
+		require.NoError(t, err)
+		assert.Equal(t, cursors, got)
+	})
+
+	t.Run("no cursors", func(t *testing.T) {
+		assert.Empty(t, EncodeToken(digest, nil))
+		got, err := DecodeToken(digest, "")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("digest ignores the page", func(t *testing.T) {
+		other := *q
+		other.Page = contribquery.Pagination{Limit: 5, Token: "x"}
+		assert.Equal(t, digest, Digest([]string{"s1", "s2"}, &other))
+	})
+
+	t.Run("token of another query", func(t *testing.T) {
+		tok := EncodeToken(digest, map[string]Cursor{"s1": {}})
+		_, err := DecodeToken(Digest([]string{"s1"}, q), tok)
+		require.ErrorIs(t, err, ErrInvalidQuery)
+
+		other, err := Parse([]byte(`{"filter": {"EQ": {"a": 2}}, "sort": [{"key": "b"}]}`))
+		require.NoError(t, err)
+		_, err = DecodeToken(Digest([]string{"s1", "s2"}, other), tok)
+		require.ErrorIs(t, err, ErrInvalidQuery)
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		for _, tok := range []string{
+			"!!",
+			base64.RawURLEncoding.EncodeToString([]byte(`nope`)),
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":1,"d":"` + digest + `"}`)),
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"d":"` + digest + `","c":{}}`)),
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":1,"d":"` + digest + `","c":{"s1":{"s":-1}}}`)),
+		} {
+			_, err := DecodeToken(digest, tok)
+			require.ErrorIs(t, err, ErrInvalidQuery, tok)
+		}
+	})
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	// trackedIndexKey is the key of the documents of the tracked index, after
+	// the key prefix of the app. It contains the key separator, so it can
+	// never clash with a key written through the state API.
+	trackedIndexKey = "dapr.queryindex||"
+
+	// trackedIndexRetries is the number of times the counter of the tracked
+	// index is updated before giving up on concurrent writers.
+	trackedIndexRetries = 8
+)
+
+// trackedIndex is the index of a state store which cannot list its keys,
+// which the runtime maintains in the state store itself. Each key is given a
+// slot, numbered in the order in which keys are first saved, so that the index
+// is listed by ranges of slots, and keys saved while paging through it are
+// listed last. The index is made of:
+//   - the counter, holding the number of slots given out;
+//   - a document per slot, holding its key;
+//   - a document per key, holding its slot.
+//
+// Saving a key which is already indexed only reads its document, and saving
+// new keys updates the counter once for all of them. A slot is only listed if
+// the document of its key holds it, so that slots given out to concurrent
+// writers of the same key, or left behind by failed writes, are skipped.
+type trackedIndex struct {
+	store  state.Store
+	prefix string
+}
+
+// track adds the keys, as saved in the state store, to the index.
+func (t *trackedIndex) track(ctx context.Context, keys []string) error {
+	keys = t.indexed(keys)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	slots, err := t.slots(ctx, keys)
+	if err != nil {
+		return err
+	}
+	var untracked []string
+	for _, key := range keys {
+		if _, ok := slots[key]; !ok {
+			untracked = append(untracked, key)
+		}
+	}
+	if len(untracked) == 0 {
+		return nil
+	}
+
+	first, err := t.reserve(ctx, uint64(len(untracked)))
+	if err != nil {
+		return err
+	}
+
+	// The documents of the slots are saved first, as a slot is only listed
+	// once the document of its key holds it.
+	slotReqs := make([]state.SetRequest, len(untracked))
+	keyReqs := make([]state.SetRequest, len(untracked))
+	for i, key := range untracked {
+		slot := first + uint64(i)
+		slotReqs[i] = state.SetRequest{Key: t.slotKey(slot), Value: key}
+		keyReqs[i] = state.SetRequest{Key: t.keyKey(key), Value: slot}
+	}
+	if err = t.store.BulkSet(ctx, slotReqs, state.BulkStoreOpts{}); err != nil {
+		return fmt.Errorf("failed to save query index slots: %w", err)
+	}
+	if err = t.store.BulkSet(ctx, keyReqs, state.BulkStoreOpts{}); err != nil {
+		return fmt.Errorf("failed to save query index keys: %w", err)
+	}
+	return nil
+}
+
+// untrack removes the keys, as saved in the state store, from the index. A
+// key saved again while it is removed may be left out of the index.
+func (t *trackedIndex) untrack(ctx context.Context, keys []string) error {
+	keys = t.indexed(keys)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	slots, err := t.slots(ctx, keys)
+	if err != nil {
+		return err
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+
+	reqs := make([]state.DeleteRequest, 0, 2*len(slots))
+	for key, slot := range slots {
+		reqs = append(reqs,
+			state.DeleteRequest{Key: t.keyKey(key)},
+			state.DeleteRequest{Key: t.slotKey(slot)},
+		)
+	}
+	if err = t.store.BulkDelete(ctx, reqs, state.BulkStoreOpts{}); err != nil {
+		return fmt.Errorf("failed to delete query index keys: %w", err)
+	}
+	return nil
+}
+
+// list implements lister. The cursor is the first slot to list.
+func (t *trackedIndex) list(ctx context.Context, cursor string, size int) ([]string, string, error) {
+	var start uint64
+	if cursor != "" {
+		var err error
+		if start, err = strconv.ParseUint(cursor, 10, 64); err != nil {
+			return nil, "", fmt.Errorf("%w: malformed pagination token", ErrInvalidQuery)
+		}
+	}
+
+	count, _, err := t.count(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if start >= count {
+		return nil, "", nil
+	}
+	end := min(start+uint64(size), count) //nolint:gosec
+
+	reqs := make([]state.GetRequest, 0, end-start)
+	slotOf := make(map[string]uint64, end-start)
+	for slot := start; slot < end; slot++ {
+		reqs = append(reqs, state.GetRequest{Key: t.slotKey(slot)})
+		slotOf[t.slotKey(slot)] = slot
+	}
+	res, err := t.store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read query index slots: %w", err)
+	}
+
+	type entry struct {
+		key  string
+		slot uint64
+	}
+	entries := make([]entry, 0, len(res))
+	keys := make([]string, 0, len(res))
+	for _, r := range res {
+		if r.Error != "" {
+			return nil, "", fmt.Errorf("failed to read query index slot '%s': %s", r.Key, r.Error)
+		}
+		if len(r.Data) == 0 {
+			continue
+		}
+		var key string
+		if err = json.Unmarshal(r.Data, &key); err != nil {
+			return nil, "", fmt.Errorf("failed to decode query index slot '%s': %w", r.Key, err)
+		}
+		entries = append(entries, entry{key: key, slot: slotOf[r.Key]})
+		keys = append(keys, key)
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return cmp.Compare(a.slot, b.slot)
+	})
+
+	slots, err := t.slots(ctx, keys)
+	if err != nil {
+		return nil, "", err
+	}
+	keys = keys[:0]
+	for _, e := range entries {
+		if slot, ok := slots[e.key]; ok && slot == e.slot {
+			keys = append(keys, e.key)
+		}
+	}
+
+	var next string
+	if end < count {
+		next = strconv.FormatUint(end, 10)
+	}
+	return keys, next, nil
+}
+
+// slots returns the slots of the keys which are in the index.
+func (t *trackedIndex) slots(ctx context.Context, keys []string) (map[string]uint64, error) {
+	slots := make(map[string]uint64, len(keys))
+	if len(keys) == 0 {
+		return slots, nil
+	}
+
+	reqs := make([]state.GetRequest, len(keys))
+	for i, key := range keys {
+		reqs[i] = state.GetRequest{Key: t.keyKey(key)}
+	}
+	res, err := t.store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read query index keys: %w", err)
+	}
+
+	for _, r := range res {
+		if r.Error != "" {
+			return nil, fmt.Errorf("failed to read query index key '%s': %s", r.Key, r.Error)
+		}
+		if len(r.Data) == 0 {
+			continue
+		}
+		var slot uint64
+		if err = json.Unmarshal(r.Data, &slot); err != nil {
+			return nil, fmt.Errorf("failed to decode query index key '%s': %w", r.Key, err)
+		}
+		slots[strings.TrimPrefix(r.Key, t.keyKey(""))] = slot
+	}
+	return slots, nil
+}
+
+// reserve gives out n slots, returning the first of them.
+func (t *trackedIndex) reserve(ctx context.Context, n uint64) (uint64, error) {
+	key := t.counterKey()
+	for range trackedIndexRetries {
+		count, etag, err := t.count(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		// Without ETag support, concurrent writers can be given the same
+		// slots, so that only one of their keys is listed.
+		req := &state.SetRequest{Key: key, Value: count + n}
+		if state.FeatureETag.IsPresent(t.store.Features()) {
+			req.Options.Concurrency = state.FirstWrite
+			req.ETag = etag
+		}
+
+		err = t.store.Set(ctx, req)
+		if err == nil {
+			return count, nil
+		}
+
+		var etagErr *state.ETagError
+		if !errors.As(err, &etagErr) || etagErr.Kind() != state.ETagMismatch {
+			return 0, fmt.Errorf("failed to save query index counter '%s': %w", key, err)
+		}
+	}
+
+	return 0, fmt.Errorf("failed to save query index counter '%s': too many concurrent updates", key)
+}
+
+// count returns the number of slots given out, and the ETag of the counter.
+func (t *trackedIndex) count(ctx context.Context) (uint64, *string, error) {
+	key := t.counterKey()
+	res, err := t.store.Get(ctx, &state.GetRequest{Key: key})
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read query index counter '%s': %w", key, err)
+	}
+	if res == nil || len(res.Data) == 0 {
+		return 0, nil, nil
+	}
+
+	var count uint64
+	if err = json.Unmarshal(res.Data, &count); err != nil {
+		return 0, nil, fmt.Errorf("failed to decode query index counter '%s': %w", key, err)
+	}
+	return count, res.ETag, nil
+}
+
+// indexed returns the distinct keys which belong in the index.
+func (t *trackedIndex) indexed(keys []string) []string {
+	seen := make(map[string]struct{}, len(keys))
+	res := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; ok || !indexed(t.prefix, key) {
+			continue
+		}
+		seen[key] = struct{}{}
+		res = append(res, key)
+	}
+	return res
+}
+
+func (t *trackedIndex) counterKey() string {
+	return t.prefix + trackedIndexKey + "count"
+}
+
+func (t *trackedIndex) slotKey(slot uint64) string {
+	return t.prefix + trackedIndexKey + "slot||" + strconv.FormatUint(slot, 10)
+}
+
+func (t *trackedIndex) keyKey(key string) string {
+	return t.prefix + trackedIndexKey + "key||" + key
+}
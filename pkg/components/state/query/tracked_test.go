@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// transactionalStore is a transactional state store which cannot list its
+// keys.
+type transactionalStore struct {
+	state.Store
+	tx state.TransactionalStore
+}
+
+func (s *transactionalStore) Multi(ctx context.Context, req *state.TransactionalStateRequest) error {
+	return s.tx.Multi(ctx, req)
+}
+
+func newUnlistedStore(t *testing.T) state.Store {
+	t.Helper()
+	store := newStore(t)
+	return &transactionalStore{Store: store, tx: store.(state.TransactionalStore)}
+}
+
+func TestTrackedIndex(t *testing.T) {
+	t.Run("indexes the saved keys of the app", func(t *testing.T) {
+		store := NewIndexedStore(newUnlistedStore(t), "app||")
+		save(t, store, map[string]string{"app||a": `1`, "app||b": `1`, "other||a": `1`, "app||actor||id||a": `1`})
+		require.NoError(t, store.BulkSet(t.Context(), []state.SetRequest{
+			{Key: "app||c", Value: []byte(`1`)},
+			{Key: "app||a", Value: []byte(`2`)},
+		}, state.BulkStoreOpts{}))
+
+		index := NewIndex(store, "app||")
+		keys, err := index.Keys(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||a", "app||b", "app||c"}, keys)
+
+		// Saving indexed keys does not give them new slots.
+		count, _, err := index.lister.(*trackedIndex).count(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), count)
+	})
+
+	t.Run("removes deleted keys", func(t *testing.T) {
+		store := NewIndexedStore(newUnlistedStore(t), "app||")
+		save(t, store, map[string]string{"app||a": `1`, "app||b": `1`, "app||c": `1`})
+		require.NoError(t, store.Delete(t.Context(), &state.DeleteRequest{Key: "app||a"}))
+		require.NoError(t, store.BulkDelete(t.Context(), []state.DeleteRequest{{Key: "app||b"}}, state.BulkStoreOpts{}))
+
+		keys, err := NewIndex(store, "app||").Keys(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||c"}, keys)
+	})
+
+	t.Run("indexes transactions by the last operation of each key", func(t *testing.T) {
+		store := NewIndexedStore(newUnlistedStore(t), "app||")
+		save(t, store, map[string]string{"app||a": `1`})
+		tx, ok := store.(state.TransactionalStore)
+		require.True(t, ok)
+		require.NoError(t, tx.Multi(t.Context(), &state.TransactionalStateRequest{
+			Operations: []state.TransactionalStateOperation{
+				state.SetRequest{Key: "app||b", Value: []byte(`1`)},
+				state.DeleteRequest{Key: "app||a"},
+				state.SetRequest{Key: "app||c", Value: []byte(`1`)},
+				state.DeleteRequest{Key: "app||c"},
+			},
+		}))
+
+		keys, err := NewIndex(store, "app||").Keys(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||b"}, keys)
+	})
+
+	t.Run("lists the keys by slot", func(t *testing.T) {
+		store := NewIndexedStore(newUnlistedStore(t), "app||")
+		for _, key := range []string{"app||c", "app||a", "app||b"} {
+			save(t, store, map[string]string{key: `1`})
+		}
+		index := NewIndex(store, "app||")
+
+		keys, next, err := index.list(t.Context(), "", 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||c", "app||a"}, keys)
+		require.NotEmpty(t, next)
+
+		// Keys saved while paging are listed last.
+		save(t, store, map[string]string{"app||0": `1`})
+		keys, next, err = index.list(t.Context(), next, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||b", "app||0"}, keys)
+		assert.Empty(t, next)
+	})
+
+	t.Run("skips slots not held by their key", func(t *testing.T) {
+		unlisted := newUnlistedStore(t)
+		store := NewIndexedStore(unlisted, "app||")
+		save(t, store, map[string]string{"app||a": `1`})
+
+		// A concurrent writer of the same key was given another slot.
+		tracked := &trackedIndex{store: unlisted, prefix: "app||"}
+		slot, err := tracked.reserve(t.Context(), 1)
+		require.NoError(t, err)
+		require.NoError(t, unlisted.Set(t.Context(), &state.SetRequest{Key: tracked.slotKey(slot), Value: []byte(`"app||a"`)}))
+
+		keys, err := NewIndex(store, "app||").Keys(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||a"}, keys)
+	})
+
+	t.Run("non transactional state store", func(t *testing.T) {
+		store := NewIndexedStore(struct{ state.Store }{newStore(t)}, "app||")
+		_, ok := store.(state.TransactionalStore)
+		assert.False(t, ok)
+	})
+}
@@ -18,12 +18,26 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dapr/dapr/pkg/api/errors"
+	kitstrings "github.com/dapr/kit/strings"
 )
 
 const (
-	strategyKey = "keyprefix"
+	strategyKey           = "keyprefix"
+	queryIndexKey         = "queryindex"
+	ttlEmulationKey       = "ttlemulation"
+	allowedKeyPrefixesKey = "allowedkeyprefixes"
+	watchPollIntervalKey  = "watchpollinterval"
+
+	// KeyPrefixMetadataKey is the request metadata property which overrides
+	// the key prefix strategy of the state store for the request. The state
+	// store must allow the strategy with the "allowedKeyPrefixes" metadata
+	// property, a comma-separated list of strategies, or "*" for any.
+	KeyPrefixMetadataKey = "keyPrefix"
+
+	allowAnyKeyPrefix = "*"
 
 	strategyNamespace = "namespace"
 	strategyAppid     = "appid"
@@ -41,15 +55,38 @@ var (
 )
 
 type StoreConfiguration struct {
-	keyPrefixStrategy string
+	keyPrefixStrategy  string
+	queryIndex         bool
+	ttlEmulation       bool
+	allowedKeyPrefixes []string
+	watchPollInterval  time.Duration
 }
 
 func SaveStateConfiguration(storeName string, metadata map[string]string) error {
 	strategy := strategyDefault
+	var queryIndex, ttlEmulation bool
+	var allowedKeyPrefixes []string
+	var watchPollInterval time.Duration
 	for k, v := range metadata {
-		if strings.ToLower(k) == strategyKey { //nolint:gocritic
+		switch strings.ToLower(k) {
+		case strategyKey:
 			strategy = strings.ToLower(v)
-			break
+		case queryIndexKey:
+			queryIndex = kitstrings.IsTruthy(v)
+		case ttlEmulationKey:
+			ttlEmulation = kitstrings.IsTruthy(v)
+		case allowedKeyPrefixesKey:
+			for prefix := range strings.SplitSeq(v, ",") {
+				if prefix = strings.ToLower(strings.TrimSpace(prefix)); prefix != "" {
+					allowedKeyPrefixes = append(allowedKeyPrefixes, prefix)
+				}
+			}
+		case watchPollIntervalKey:
+			var err error
+			watchPollInterval, err = time.ParseDuration(v)
+			if err != nil || watchPollInterval <= 0 {
+				return fmt.Errorf("invalid watchPollInterval '%s' for state store %s: must be a positive duration", v, storeName)
+			}
 		}
 	}
 
@@ -59,38 +96,98 @@ func SaveStateConfiguration(storeName string, metadata map[string]string) error
 	}
 
 	statesConfigurationLock.Lock()
-	statesConfiguration[storeName] = &StoreConfiguration{keyPrefixStrategy: strategy}
+	statesConfiguration[storeName] = &StoreConfiguration{
+		keyPrefixStrategy:  strategy,
+		queryIndex:         queryIndex,
+		ttlEmulation:       ttlEmulation,
+		allowedKeyPrefixes: allowedKeyPrefixes,
+		watchPollInterval:  watchPollInterval,
+	}
 	statesConfigurationLock.Unlock()
 	return nil
 }
 
+// QueryIndexEnabled returns true if the state store is queried over its query
+// index, which is enabled with the "queryIndex" metadata property.
+func QueryIndexEnabled(storeName string) bool {
+	return getStateConfiguration(storeName).queryIndex
+}
+
+// TTLEmulationEnabled returns true if the runtime deletes the state of the
+// state store once its time to live expires, which is enabled with the
+// "ttlEmulation" metadata property for state stores without native TTLs.
+func TTLEmulationEnabled(storeName string) bool {
+	return getStateConfiguration(storeName).ttlEmulation
+}
+
+// WatchPollInterval returns the interval at which the runtime polls the state
+// store for changes to watched keys, which is configured with the
+// "watchPollInterval" metadata property, or zero if not configured.
+func WatchPollInterval(storeName string) time.Duration {
+	return getStateConfiguration(storeName).watchPollInterval
+}
+
 func GetModifiedStateKey(key, storeName, appID string) (string, error) {
 	if err := checkKeyIllegal(key); err != nil {
 		return "", errors.StateStore(storeName).InvalidKeyName(key, err.Error())
 	}
 
+	return modifiedStateKey(getStateConfiguration(storeName).keyPrefixStrategy, key, storeName, appID), nil
+}
+
+// GetModifiedStateKeyForRequest returns the key as saved in the state store,
+// with the key prefix strategy overridden by the KeyPrefixMetadataKey property
+// of the request metadata, if any. The override is read from the first of the
+// given metadata which has it, so the metadata of an operation can take
+// precedence over that of the request it is part of.
+func GetModifiedStateKeyForRequest(key, storeName, appID string, metadata ...map[string]string) (string, error) {
+	if err := checkKeyIllegal(key); err != nil {
+		return "", errors.StateStore(storeName).InvalidKeyName(key, err.Error())
+	}
+
 	stateConfiguration := getStateConfiguration(storeName)
-	switch stateConfiguration.keyPrefixStrategy {
+	strategy := stateConfiguration.keyPrefixStrategy
+	for _, md := range metadata {
+		override, ok := md[KeyPrefixMetadataKey]
+		if !ok {
+			continue
+		}
+		override = strings.ToLower(override)
+		if err := checkKeyIllegal(override); err != nil {
+			return "", errors.StateStore(storeName).InvalidKeyName(override, err.Error())
+		}
+		if override != strategy && !stateConfiguration.keyPrefixAllowed(override) {
+			return "", errors.StateStore(storeName).KeyPrefixNotAllowed(override)
+		}
+		strategy = override
+		break
+	}
+
+	return modifiedStateKey(strategy, key, storeName, appID), nil
+}
+
+func modifiedStateKey(strategy, key, storeName, appID string) string {
+	switch strategy {
 	case strategyNone:
-		return key, nil
+		return key
 	case strategyStoreName:
-		return storeName + daprSeparator + key, nil
+		return storeName + daprSeparator + key
 	case strategyAppid:
 		if appID == "" {
-			return key, nil
+			return key
 		}
-		return appID + daprSeparator + key, nil
+		return appID + daprSeparator + key
 	case strategyNamespace:
 		if appID == "" {
-			return key, nil
+			return key
 		}
 		if namespace == "" {
 			// if namespace is empty, fallback to app id strategy
-			return appID + daprSeparator + key, nil
+			return appID + daprSeparator + key
 		}
-		return namespace + "." + appID + daprSeparator + key, nil
+		return namespace + "." + appID + daprSeparator + key
 	default:
-		return stateConfiguration.keyPrefixStrategy + daprSeparator + key, nil
+		return strategy + daprSeparator + key
 	}
 }
 
@@ -127,6 +224,15 @@ func getStateConfiguration(storeName string) *StoreConfiguration {
 	return c
 }
 
+func (c *StoreConfiguration) keyPrefixAllowed(strategy string) bool {
+	for _, allowed := range c.allowedKeyPrefixes {
+		if allowed == allowAnyKeyPrefix || allowed == strategy {
+			return true
+		}
+	}
+	return false
+}
+
 func checkKeyIllegal(key string) error {
 	if strings.Contains(key, daprSeparator) {
 		return fmt.Errorf("input key/keyPrefix '%s' can't contain '%s'", key, daprSeparator)
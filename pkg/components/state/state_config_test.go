@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -165,6 +166,90 @@ func TestPrefix_StoreNotInitial(t *testing.T) {
 	require.Equal(t, key, originalStateKey)
 }
 
+func TestQueryIndexEnabled(t *testing.T) {
+	require.NoError(t, SaveStateConfiguration("store-index1", map[string]string{"queryIndex": "true", strategyKey: strategyNone}))
+	require.NoError(t, SaveStateConfiguration("store-index2", map[string]string{"queryindex": "false"}))
+
+	assert.True(t, QueryIndexEnabled("store-index1"))
+	assert.False(t, QueryIndexEnabled("store-index2"))
+	assert.False(t, QueryIndexEnabled("store1"))
+
+	modifiedStateKey, _ := GetModifiedStateKey(key, "store-index1", "appid1")
+	require.Equal(t, key, modifiedStateKey)
+}
+
+func TestTTLEmulationEnabled(t *testing.T) {
+	require.NoError(t, SaveStateConfiguration("store-ttl1", map[string]string{"ttlEmulation": "true"}))
+	require.NoError(t, SaveStateConfiguration("store-ttl2", map[string]string{"ttlemulation": "false"}))
+
+	assert.True(t, TTLEmulationEnabled("store-ttl1"))
+	assert.False(t, TTLEmulationEnabled("store-ttl2"))
+	assert.False(t, TTLEmulationEnabled("store1"))
+}
+
+func TestWatchPollInterval(t *testing.T) {
+	require.NoError(t, SaveStateConfiguration("store-watch1", map[string]string{"watchPollInterval": "250ms"}))
+	require.Error(t, SaveStateConfiguration("store-watch2", map[string]string{"watchPollInterval": "soon"}))
+	require.Error(t, SaveStateConfiguration("store-watch3", map[string]string{"watchpollinterval": "-1s"}))
+
+	assert.Equal(t, 250*time.Millisecond, WatchPollInterval("store-watch1"))
+	assert.Zero(t, WatchPollInterval("store1"))
+}
+
+func TestGetModifiedStateKeyForRequest(t *testing.T) {
+	require.NoError(t, SaveStateConfiguration("store-override1", map[string]string{"allowedKeyPrefixes": "None, shared"}))
+	require.NoError(t, SaveStateConfiguration("store-override2", map[string]string{"allowedkeyprefixes": "*", strategyKey: strategyNone}))
+
+	t.Run("without override", func(t *testing.T) {
+		modifiedStateKey, err := GetModifiedStateKeyForRequest(key, "store-override1", "appid1", nil, map[string]string{"other": "value"})
+		require.NoError(t, err)
+		require.Equal(t, "appid1||state-key-1234567", modifiedStateKey)
+	})
+
+	t.Run("allowed override", func(t *testing.T) {
+		modifiedStateKey, err := GetModifiedStateKeyForRequest(key, "store-override1", "appid1", map[string]string{KeyPrefixMetadataKey: "none"})
+		require.NoError(t, err)
+		require.Equal(t, key, modifiedStateKey)
+
+		modifiedStateKey, err = GetModifiedStateKeyForRequest(key, "store-override1", "appid1", map[string]string{KeyPrefixMetadataKey: "Shared"})
+		require.NoError(t, err)
+		require.Equal(t, "shared||state-key-1234567", modifiedStateKey)
+
+		modifiedStateKey, err = GetModifiedStateKeyForRequest(key, "store-override2", "appid1", map[string]string{KeyPrefixMetadataKey: strategyStoreName})
+		require.NoError(t, err)
+		require.Equal(t, "store-override2||state-key-1234567", modifiedStateKey)
+	})
+
+	t.Run("override of the store strategy is always allowed", func(t *testing.T) {
+		modifiedStateKey, err := GetModifiedStateKeyForRequest(key, "store2", "appid1", map[string]string{KeyPrefixMetadataKey: strategyAppid})
+		require.NoError(t, err)
+		require.Equal(t, "appid1||state-key-1234567", modifiedStateKey)
+	})
+
+	t.Run("first metadata with an override wins", func(t *testing.T) {
+		modifiedStateKey, err := GetModifiedStateKeyForRequest(key, "store-override1", "appid1",
+			map[string]string{"other": "value"},
+			map[string]string{KeyPrefixMetadataKey: "shared"},
+			map[string]string{KeyPrefixMetadataKey: "other"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "shared||state-key-1234567", modifiedStateKey)
+	})
+
+	t.Run("override not allowed", func(t *testing.T) {
+		_, err := GetModifiedStateKeyForRequest(key, "store-override1", "appid1", map[string]string{KeyPrefixMetadataKey: strategyStoreName})
+		require.ErrorContains(t, err, "key prefix 'name' is not allowed")
+
+		_, err = GetModifiedStateKeyForRequest(key, "store1", "appid1", map[string]string{KeyPrefixMetadataKey: strategyAppid})
+		require.ErrorContains(t, err, "key prefix 'appid' is not allowed")
+	})
+
+	t.Run("illegal override", func(t *testing.T) {
+		_, err := GetModifiedStateKeyForRequest(key, "store-override2", "appid1", map[string]string{KeyPrefixMetadataKey: "a||b"})
+		require.ErrorContains(t, err, "can't contain")
+	})
+}
+
 func TestStateConfigRace(t *testing.T) {
 	t.Run("data race between SaveStateConfiguration and GetModifiedStateKey", func(t *testing.T) {
 		var wg sync.WaitGroup
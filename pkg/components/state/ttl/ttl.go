@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttl
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	stateutils "github.com/dapr/components-contrib/state/utils"
+)
+
+const (
+	// MetadataKey is the request metadata property with the time to live of
+	// the state in seconds.
+	MetadataKey = stateutils.MetadataTTLKey
+
+	// ExpireTimeMetadataKey is the response metadata property with the expire
+	// time of state saved with a TTL, as reported by state stores which
+	// support TTLs natively.
+	ExpireTimeMetadataKey = "ttlExpireTime"
+
+	// NeverExpire is the time to live of state which never expires.
+	NeverExpire = -1
+
+	// jobNamePrefix is the prefix of the names of the jobs the runtime
+	// schedules to delete expired state. The jobs API does not allow jobs
+	// with names of this prefix.
+	jobNamePrefix = "dapr.internal.state.ttl."
+)
+
+// Expirer deletes the state of emulated TTLs once it expires.
+type Expirer interface {
+	ExpireState(ctx context.Context, storeName, key string) error
+}
+
+// ExpirerFunc is a function implementing Expirer.
+type ExpirerFunc func(ctx context.Context, storeName, key string) error
+
+// ExpireState calls f(ctx, storeName, key).
+func (f ExpirerFunc) ExpireState(ctx context.Context, storeName, key string) error {
+	return f(ctx, storeName, key)
+}
+
+// Validate returns an error if the time to live is not a positive number of
+// seconds, or NeverExpire.
+func Validate(ttlInSeconds int64) error {
+	if ttlInSeconds == 0 || ttlInSeconds < NeverExpire || ttlInSeconds > math.MaxInt32 {
+		return fmt.Errorf("time to live must be a positive number of seconds or %d, got %d", NeverExpire, ttlInSeconds)
+	}
+	return nil
+}
+
+// FromMetadata returns the time to live of the ttlInSeconds metadata
+// property, or nil if not set.
+func FromMetadata(md map[string]string) (*int64, error) {
+	return stateutils.ParseTTL64(md)
+}
+
+// WithMetadata returns a copy of the metadata with the ttlInSeconds property
+// set to the time to live.
+func WithMetadata(md map[string]string, ttlInSeconds int64) map[string]string {
+	res := make(map[string]string, len(md)+1)
+	maps.Copy(res, md)
+	res[MetadataKey] = strconv.FormatInt(ttlInSeconds, 10)
+	return res
+}
+
+// Remaining returns the remaining time to live of state which expires at the
+// given time, in seconds rounded up. State which expired has no time left to
+// live.
+func Remaining(expireTime, now time.Time) int64 {
+	d := expireTime.Sub(now)
+	if d <= 0 {
+		return 0
+	}
+	return int64((d + time.Second - 1) / time.Second)
+}
+
+// RemainingFromMetadata returns the remaining time to live of state from the
+// ttlExpireTime property of the response metadata of state stores with
+// native TTLs, or nil if not set.
+func RemainingFromMetadata(md map[string]string, now time.Time) *int64 {
+	v, ok := md[ExpireTimeMetadataKey]
+	if !ok {
+		return nil
+	}
+	expireTime, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil
+	}
+	return new(Remaining(expireTime, now))
+}
+
+// JobName returns the name of the job which deletes the key, as saved in the
+// state store, once it expires.
+func JobName(storeName, key string) string {
+	return jobNamePrefix + storeName + "." + base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// IsJobName returns true if the job name is reserved for the jobs which
+// delete expired state.
+func IsJobName(name string) bool {
+	return strings.HasPrefix(name, jobNamePrefix)
+}
+
+// ParseJobName returns the state store and key of the job which deletes
+// expired state.
+func ParseJobName(name string) (storeName string, key string, ok bool) {
+	rest, ok := strings.CutPrefix(name, jobNamePrefix)
+	if !ok {
+		return "", "", false
+	}
+
+	// Store names may contain dots, but the encoded key may not.
+	i := strings.LastIndexByte(rest, '.')
+	if i <= 0 {
+		return "", "", false
+	}
+	b, err := base64.RawURLEncoding.DecodeString(rest[i+1:])
+	if err != nil || len(b) == 0 {
+		return "", "", false
+	}
+	return rest[:i], string(b), true
+}
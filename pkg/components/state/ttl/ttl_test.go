@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	for _, ttl := range []int64{1, 3600, NeverExpire} {
+		require.NoError(t, Validate(ttl), ttl)
+	}
+	for _, ttl := range []int64{0, -2, 1 << 40} {
+		require.Error(t, Validate(ttl), ttl)
+	}
+}
+
+func TestWithMetadata(t *testing.T) {
+	md := map[string]string{"ttlInSeconds": "10", "contentType": "application/json"}
+	res := WithMetadata(md, 20)
+	assert.Equal(t, map[string]string{"ttlInSeconds": "20", "contentType": "application/json"}, res)
+	assert.Equal(t, "10", md["ttlInSeconds"])
+
+	ttl, err := FromMetadata(res)
+	require.NoError(t, err)
+	assert.Equal(t, new(int64(20)), ttl)
+}
+
+func TestRemaining(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, int64(10), Remaining(now.Add(10*time.Second), now))
+	assert.Equal(t, int64(10), Remaining(now.Add(9500*time.Millisecond), now))
+	assert.Equal(t, int64(0), Remaining(now.Add(-time.Second), now))
+
+	assert.Nil(t, RemainingFromMetadata(nil, now))
+	assert.Nil(t, RemainingFromMetadata(map[string]string{"ttlExpireTime": "soon"}, now))
+	assert.Equal(t, new(int64(60)), RemainingFromMetadata(map[string]string{
+		"ttlExpireTime": now.Add(time.Minute).Format(time.RFC3339),
+	}, now.Truncate(time.Second)))
+}
+
+func TestJobName(t *testing.T) {
+	name := JobName("my.store", "app||key.1")
+	assert.True(t, IsJobName(name))
+
+	storeName, key, ok := ParseJobName(name)
+	require.True(t, ok)
+	assert.Equal(t, "my.store", storeName)
+	assert.Equal(t, "app||key.1", key)
+
+	for _, name := range []string{"myjob", jobNamePrefix + "store", jobNamePrefix + ".a2V5", jobNamePrefix + "store.!"} {
+		_, _, ok = ParseJobName(name)
+		assert.False(t, ok, name)
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+	statequery "github.com/dapr/dapr/pkg/components/state/query"
+	"github.com/dapr/kit/logger"
+)
+
+// DefaultPollInterval is the interval at which the state store is polled for
+// changes, unless configured otherwise.
+const DefaultPollInterval = time.Second
+
+var log = logger.NewLogger("dapr.state.watch")
+
+// ErrPrefixUnsupported is returned when watching key prefixes of a state store
+// whose keys cannot be listed.
+var ErrPrefixUnsupported = errors.New("watching key prefixes requires the query index of the state store")
+
+// PollerOptions are the options for a Poller.
+type PollerOptions struct {
+	Store state.Store
+
+	// Index is the query index of the state store, which lists the keys when
+	// watching key prefixes. Prefixes cannot be watched without it.
+	Index *statequery.Index
+
+	// Interval is the interval at which the state store is polled. Defaults to
+	// DefaultPollInterval.
+	Interval time.Duration
+
+	Clock clock.WithTicker
+}
+
+// Poller emulates a change feed for state stores without one, by polling the
+// watched keys and comparing them with the previous poll.
+type Poller struct {
+	store    state.Store
+	index    *statequery.Index
+	interval time.Duration
+	clock    clock.WithTicker
+}
+
+// version is the version of the value of a key, to detect changes.
+type version struct {
+	tag  string
+	resp state.BulkGetResponse
+}
+
+// NewPoller returns a Poller over the state store.
+func NewPoller(opts PollerOptions) *Poller {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+	return &Poller{
+		store:    opts.Store,
+		index:    opts.Index,
+		interval: interval,
+		clock:    cl,
+	}
+}
+
+// Watch implements Watcher. Failed polls are retried at the next interval,
+// so changes which are reverted in between are not delivered.
+func (p *Poller) Watch(ctx context.Context, req *Request, handler Handler) error {
+	if len(req.Prefixes) > 0 && p.index == nil {
+		return ErrPrefixUnsupported
+	}
+
+	snapshot, err := p.poll(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err = handler(ctx, nil); err != nil {
+		return err
+	}
+
+	ticker := p.clock.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+
+		next, err := p.poll(ctx, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Warnf("Failed to poll state store for changes: %v", err)
+			continue
+		}
+
+		if changes := diff(snapshot, next); len(changes) > 0 {
+			if err = handler(ctx, changes); err != nil {
+				return err
+			}
+		}
+		snapshot = next
+	}
+}
+
+// poll returns the versions of the watched keys which exist in the state
+// store.
+func (p *Poller) poll(ctx context.Context, req *Request) (map[string]version, error) {
+	keys := slices.Clone(req.Keys)
+	if len(req.Prefixes) > 0 {
+		indexed, err := p.index.Keys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range indexed {
+			if slices.ContainsFunc(req.Prefixes, func(prefix string) bool {
+				return strings.HasPrefix(key, prefix)
+			}) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	versions := make(map[string]version, len(keys))
+	if len(keys) == 0 {
+		return versions, nil
+	}
+
+	reqs := make([]state.GetRequest, len(keys))
+	for i, key := range keys {
+		reqs[i] = state.GetRequest{Key: key, Metadata: req.Metadata}
+	}
+	res, err := p.store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watched keys: %w", err)
+	}
+
+	for _, r := range res {
+		if r.Error != "" {
+			return nil, fmt.Errorf("failed to get watched key '%s': %s", r.Key, r.Error)
+		}
+		if len(r.Data) == 0 && r.ETag == nil {
+			continue
+		}
+		versions[r.Key] = version{tag: versionTag(r), resp: r}
+	}
+	return versions, nil
+}
+
+// versionTag returns the ETag of the value, or a digest of the value for
+// state stores without ETags.
+func versionTag(r state.BulkGetResponse) string {
+	if r.ETag != nil {
+		return "etag:" + *r.ETag
+	}
+	sum := sha256.Sum256(r.Data)
+	return "data:" + hex.EncodeToString(sum[:])
+}
+
+// diff returns the changes from the previous to the next versions, sorted by
+// key.
+func diff(prev, next map[string]version) []Change {
+	var changes []Change
+	for key, v := range next {
+		if p, ok := prev[key]; ok && p.tag == v.tag {
+			continue
+		}
+		changes = append(changes, Change{
+			Key:       key,
+			Operation: state.OperationUpsert,
+			Data:      v.resp.Data,
+			ETag:      v.resp.ETag,
+			Metadata:  v.resp.Metadata,
+		})
+	}
+	for key := range prev {
+		if _, ok := next[key]; !ok {
+			changes = append(changes, Change{Key: key, Operation: state.OperationDelete})
+		}
+	}
+	slices.SortFunc(changes, func(a, b Change) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return changes
+}
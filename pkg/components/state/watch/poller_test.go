@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	statequery "github.com/dapr/dapr/pkg/components/state/query"
+	"github.com/dapr/kit/logger"
+)
+
+func newStore(t *testing.T) state.Store {
+	t.Helper()
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+	return store
+}
+
+// watch runs the watch in the background, returning the batches of changes
+// it delivers once it is established.
+func watch(t *testing.T, poller *Poller, req *Request) <-chan []Change {
+	t.Helper()
+	ctx, cancel := context.WithCancel(t.Context())
+	batches := make(chan []Change, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- poller.Watch(ctx, req, func(_ context.Context, changes []Change) error {
+			batches <- changes
+			return nil
+		})
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.ErrorIs(t, <-errCh, context.Canceled)
+	})
+
+	select {
+	case changes := <-batches:
+		require.Nil(t, changes)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "watch was not established")
+	}
+	return batches
+}
+
+func next(t *testing.T, clock *clocktesting.FakeClock, batches <-chan []Change) []Change {
+	t.Helper()
+	assert.Eventually(t, clock.HasWaiters, 5*time.Second, 10*time.Millisecond)
+	clock.Step(time.Second)
+	select {
+	case changes := <-batches:
+		return changes
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no changes delivered")
+		return nil
+	}
+}
+
+func keysAndOps(changes []Change) []string {
+	out := make([]string, len(changes))
+	for i, c := range changes {
+		out[i] = string(c.Operation) + ":" + c.Key
+	}
+	return out
+}
+
+func TestPoller(t *testing.T) {
+	t.Run("keys", func(t *testing.T) {
+		store := newStore(t)
+		clock := clocktesting.NewFakeClock(time.Now())
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "a", Value: "1"}))
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "b", Value: "1"}))
+
+		poller := NewPoller(PollerOptions{Store: store, Clock: clock})
+		batches := watch(t, poller, &Request{Keys: []string{"a", "b", "c"}})
+
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "a", Value: "2"}))
+		require.NoError(t, store.Delete(t.Context(), &state.DeleteRequest{Key: "b"}))
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "c", Value: "1"}))
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "d", Value: "1"}))
+
+		changes := next(t, clock, batches)
+		assert.Equal(t, []string{"upsert:a", "delete:b", "upsert:c"}, keysAndOps(changes))
+		assert.JSONEq(t, `"2"`, string(changes[0].Data))
+		assert.NotNil(t, changes[0].ETag)
+
+		// Unchanged keys are not delivered again.
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "c", Value: "2"}))
+		assert.Equal(t, []string{"upsert:c"}, keysAndOps(next(t, clock, batches)))
+	})
+
+	t.Run("prefixes", func(t *testing.T) {
+		store := newStore(t)
+		clock := clocktesting.NewFakeClock(time.Now())
+		index := statequery.NewIndex(store, "app||")
+
+		save := func(key string) {
+			require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: key, Value: "1"}))
+		}
+		save("app||a")
+
+		poller := NewPoller(PollerOptions{Store: store, Index: index, Clock: clock})
+		batches := watch(t, poller, &Request{Prefixes: []string{"app||"}})
+
+		save("app||b")
+		save("other||b")
+		assert.Equal(t, []string{"upsert:app||b"}, keysAndOps(next(t, clock, batches)))
+	})
+
+	t.Run("prefixes without index", func(t *testing.T) {
+		poller := NewPoller(PollerOptions{Store: newStore(t)})
+		err := poller.Watch(t.Context(), &Request{Prefixes: []string{"app||"}}, func(context.Context, []Change) error {
+			return nil
+		})
+		require.ErrorIs(t, err, ErrPrefixUnsupported)
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		poller := NewPoller(PollerOptions{Store: newStore(t)})
+		handlerErr := errors.New("handler failed")
+		err := poller.Watch(t.Context(), &Request{Keys: []string{"a"}}, func(context.Context, []Change) error {
+			return handlerErr
+		})
+		require.ErrorIs(t, err, handlerErr)
+	})
+}
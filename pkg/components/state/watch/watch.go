@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package watch implements watching keys of state stores for changes.
+package watch
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// Change is a change to the state of a key.
+type Change struct {
+	// Key is the key as saved in the state store.
+	Key string
+	// Operation is the operation which changed the key.
+	Operation state.OperationType
+	// Data is the value of the key after an upsert.
+	Data []byte
+	// ETag is the ETag of the value after an upsert, if any.
+	ETag *string
+	// Metadata is the metadata of the value after an upsert.
+	Metadata map[string]string
+}
+
+// Request is a request to watch keys of a state store.
+type Request struct {
+	// Keys are the keys to watch, as saved in the state store.
+	Keys []string
+	// Prefixes are the key prefixes to watch, as saved in the state store.
+	Prefixes []string
+	// Metadata is the metadata of the request.
+	Metadata map[string]string
+}
+
+// Handler handles a batch of changes to the watched keys.
+type Handler func(ctx context.Context, changes []Change) error
+
+// Watcher is implemented by state stores with a native change feed.
+type Watcher interface {
+	// Watch watches the keys of the request until the context is canceled or
+	// the handler returns an error, which is returned. The handler is first
+	// called with no changes once the watch is established.
+	Watch(ctx context.Context, req *Request, handler Handler) error
+}
@@ -30,6 +30,16 @@ type ApplicationConfig struct {
 	EntityConfigs []EntityConfig `json:"entitiesConfig,omitempty"`
 }
 
+// ActorStateEventsConfig configures the CloudEvent published to a pubsub topic
+// whenever an actor state transaction commits.
+type ActorStateEventsConfig struct {
+	PubsubName string `json:"pubsubName"`
+	Topic      string `json:"topic"`
+	// IncludeValues includes the upserted values in the event. By default
+	// only the changed keys and their operations are published.
+	IncludeValues bool `json:"includeValues,omitempty"`
+}
+
 type ReentrancyConfig struct {
 	Enabled       bool `json:"enabled"`
 	MaxStackDepth *int `json:"maxStackDepth,omitempty"`
@@ -44,6 +54,10 @@ type EntityConfig struct {
 	DrainRebalancedActors   *bool            `json:"drainRebalancedActors"`
 	Reentrancy              ReentrancyConfig `json:"reentrancy,omitzero"`
 
+	// StateEvents opts the entities into publishing an event each time an
+	// actor state transaction commits.
+	StateEvents *ActorStateEventsConfig `json:"stateEvents,omitempty"`
+
 	// DEPRECATED.
 	RemindersStoragePartitions int `json:"remindersStoragePartitions"`
 }
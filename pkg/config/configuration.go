@@ -14,12 +14,14 @@ limitations under the License.
 package config
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -68,6 +70,13 @@ const (
 	// history events are signed using the app's X.509 SVID identity,
 	// creating a verifiable chain of signatures. Disabled by default.
 	WorkflowHistorySigning Feature = "WorkflowHistorySigning"
+
+	// ServiceInvocationQUIC enables a QUIC transport for the gRPC connections
+	// between daprd sidecars. A daprd serves QUIC on the UDP port matching its
+	// internal gRPC port and advertises it to the sidecars calling it, which
+	// then connect over QUIC, falling back to TCP when QUIC is unreachable.
+	// Disabled by default.
+	ServiceInvocationQUIC Feature = "ServiceInvocationQUIC"
 )
 
 // end feature flags section
@@ -116,6 +125,19 @@ type AccessControlListPolicySpec struct {
 	TrustDomain         string
 	Namespace           string
 	AppOperationActions *Trie
+	Rules               []AccessControlListRule
+	DenyByDefault       bool
+}
+
+// AccessControlListRule is an in-memory access control rule of the calls of
+// an app.
+type AccessControlListRule struct {
+	// Segments are the segments of the path glob, which is empty for rules of
+	// gRPC methods.
+	Segments   []string
+	HTTPVerbs  []string
+	GRPCMethod string
+	Action     string
 }
 
 // AccessControlListOperationAction is an in-memory access control list config per operation for fast lookup.
@@ -126,21 +148,506 @@ type AccessControlListOperationAction struct {
 }
 
 type ConfigurationSpec struct {
-	HTTPPipelineSpec    *PipelineSpec       `json:"httpPipeline,omitempty"    yaml:"httpPipeline,omitempty"`
-	AppHTTPPipelineSpec *PipelineSpec       `json:"appHttpPipeline,omitempty" yaml:"appHttpPipeline,omitempty"`
-	TracingSpec         *TracingSpec        `json:"tracing,omitempty"         yaml:"tracing,omitempty"`
-	MTLSSpec            *MTLSSpec           `json:"mtls,omitempty"            yaml:"mtls,omitempty"`
-	MetricSpec          *MetricSpec         `json:"metric,omitempty"          yaml:"metric,omitempty"`
-	MetricsSpec         *MetricSpec         `json:"metrics,omitempty"         yaml:"metrics,omitempty"`
-	Secrets             *SecretsSpec        `json:"secrets,omitempty"         yaml:"secrets,omitempty"`
-	AccessControlSpec   *AccessControlSpec  `json:"accessControl,omitempty"   yaml:"accessControl,omitempty"`
-	NameResolutionSpec  *NameResolutionSpec `json:"nameResolution,omitempty"  yaml:"nameResolution,omitempty"`
-	Features            []FeatureSpec       `json:"features,omitempty"        yaml:"features,omitempty"`
-	APISpec             *APISpec            `json:"api,omitempty"             yaml:"api,omitempty"`
-	ComponentsSpec      *ComponentsSpec     `json:"components,omitempty"      yaml:"components,omitempty"`
-	LoggingSpec         *LoggingSpec        `json:"logging,omitempty"         yaml:"logging,omitempty"`
-	WasmSpec            *WasmSpec           `json:"wasm,omitempty"            yaml:"wasm,omitempty"`
-	WorkflowSpec        *WorkflowSpec       `json:"workflow,omitempty"        yaml:"workflow,omitempty"`
+	HTTPPipelineSpec         *PipelineSpec          `json:"httpPipeline,omitempty"    yaml:"httpPipeline,omitempty"`
+	AppHTTPPipelineSpec      *PipelineSpec          `json:"appHttpPipeline,omitempty" yaml:"appHttpPipeline,omitempty"`
+	GRPCPipelineSpec         *PipelineSpec          `json:"grpcPipeline,omitempty"    yaml:"grpcPipeline,omitempty"`
+	OutboundHTTPPipelineSpec *PipelineSpec          `json:"outboundHttpPipeline,omitempty" yaml:"outboundHttpPipeline,omitempty"`
+	TracingSpec              *TracingSpec           `json:"tracing,omitempty"         yaml:"tracing,omitempty"`
+	MTLSSpec                 *MTLSSpec              `json:"mtls,omitempty"            yaml:"mtls,omitempty"`
+	MetricSpec               *MetricSpec            `json:"metric,omitempty"          yaml:"metric,omitempty"`
+	MetricsSpec              *MetricSpec            `json:"metrics,omitempty"         yaml:"metrics,omitempty"`
+	Secrets                  *SecretsSpec           `json:"secrets,omitempty"         yaml:"secrets,omitempty"`
+	AccessControlSpec        *AccessControlSpec     `json:"accessControl,omitempty"   yaml:"accessControl,omitempty"`
+	NameResolutionSpec       *NameResolutionSpec    `json:"nameResolution,omitempty"  yaml:"nameResolution,omitempty"`
+	Features                 []FeatureSpec          `json:"features,omitempty"        yaml:"features,omitempty"`
+	APISpec                  *APISpec               `json:"api,omitempty"             yaml:"api,omitempty"`
+	ComponentsSpec           *ComponentsSpec        `json:"components,omitempty"      yaml:"components,omitempty"`
+	LoggingSpec              *LoggingSpec           `json:"logging,omitempty"         yaml:"logging,omitempty"`
+	WasmSpec                 *WasmSpec              `json:"wasm,omitempty"            yaml:"wasm,omitempty"`
+	WorkflowSpec             *WorkflowSpec          `json:"workflow,omitempty"        yaml:"workflow,omitempty"`
+	JobsSpec                 *JobsSpec              `json:"jobs,omitempty"            yaml:"jobs,omitempty"`
+	ServiceInvocation        *ServiceInvocationSpec `json:"serviceInvocation,omitempty" yaml:"serviceInvocation,omitempty"`
+	AppHealthSpec            *AppHealthSpec         `json:"appHealth,omitempty"         yaml:"appHealth,omitempty"`
+	RateLimitSpec            *RateLimitSpec         `json:"rateLimit,omitempty"         yaml:"rateLimit,omitempty"`
+	FaultInjectionSpec       *FaultInjectionSpec    `json:"faultInjection,omitempty"    yaml:"faultInjection,omitempty"`
+	BindingRoutingSpec       *BindingRoutingSpec    `json:"bindingRouting,omitempty"    yaml:"bindingRouting,omitempty"`
+	BindingsSpec             *BindingsSpec          `json:"bindings,omitempty"          yaml:"bindings,omitempty"`
+	AppChannelSpec           *AppChannelSpec        `json:"appChannel,omitempty"        yaml:"appChannel,omitempty"`
+}
+
+const (
+	// RateLimitTrafficInvocation is the traffic of the invocations of the app.
+	RateLimitTrafficInvocation = "invocation"
+	// RateLimitTrafficPubSub is the traffic of the messages delivered to the
+	// app by topic subscriptions, keyed by the source of the cloud events. The
+	// messages of bulk subscriptions are not limited.
+	RateLimitTrafficPubSub = "pubsub"
+
+	// RateLimitByCaller keeps a bucket of tokens per caller app ID.
+	RateLimitByCaller = "caller"
+	// RateLimitByRoute keeps a bucket of tokens per route.
+	RateLimitByRoute = "route"
+)
+
+// RateLimitSpec defines the rate limits of the inbound traffic of the app.
+type RateLimitSpec struct {
+	// StateStore is the name of the state store which keeps the buckets of
+	// tokens shared by the replicas of the app. If empty, each replica keeps
+	// its own buckets in memory.
+	StateStore string `json:"stateStore,omitempty" yaml:"stateStore,omitempty"`
+	// Policies are the rate limit policies. A call or a message is rejected if
+	// any of the policies which apply to it rejects it.
+	Policies []RateLimitPolicy `json:"policies,omitempty" yaml:"policies,omitempty"`
+}
+
+// RateLimitPolicy is a token bucket rate limit of the inbound traffic of the
+// app.
+type RateLimitPolicy struct {
+	// Name identifies the buckets of the policy. Defaults to the index of the
+	// policy.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Callers are the app IDs of the callers and publishers the policy applies
+	// to, where a trailing "*" matches any suffix. If empty, the policy applies
+	// to all of them.
+	Callers []string `json:"callers,omitempty" yaml:"callers,omitempty"`
+	// Routes are the invoked methods and the routes of the subscriptions the
+	// policy applies to, where a trailing "*" matches any suffix. If empty, the
+	// policy applies to all of them.
+	Routes []string `json:"routes,omitempty" yaml:"routes,omitempty"`
+	// Traffic is the inbound traffic the policy applies to: "invocation" and
+	// "pubsub". Defaults to both.
+	Traffic []string `json:"traffic,omitempty" yaml:"traffic,omitempty"`
+	// By is how the buckets of the policy are keyed: "caller", "route" or
+	// both. Defaults to "caller".
+	By []string `json:"by,omitempty" yaml:"by,omitempty"`
+	// RequestsPerSecond is the rate at which the buckets are refilled.
+	RequestsPerSecond int `json:"requestsPerSecond" yaml:"requestsPerSecond"`
+	// Burst is the capacity of the buckets. Defaults to RequestsPerSecond.
+	Burst int `json:"burst,omitempty" yaml:"burst,omitempty"`
+}
+
+// FaultInjectionSpec defines the faults injected by the sidecar in its calls
+// to the apps, actors and components, to test how the app handles failures,
+// such as during game days in pre-production environments. Setting it, even
+// without faults, also enables the APIs which inject faults at runtime.
+type FaultInjectionSpec struct {
+	Faults []FaultSpec `json:"faults,omitempty" yaml:"faults,omitempty"`
+}
+
+// FaultSpec is a fault injected in a percentage of the calls to the targets it
+// applies to. The faults are injected in each attempt of the calls, so they
+// are handled by the resiliency policies of the targets.
+type FaultSpec struct {
+	// Name identifies the fault.
+	Name string `json:"name" yaml:"name"`
+	// TargetType is the type of the targets of the fault: "app", "actor" or
+	// "component". If empty, the fault applies to all the targets.
+	TargetType string `json:"targetType,omitempty" yaml:"targetType,omitempty"`
+	// BuildingBlock is the type of the components the fault applies to, such
+	// as "statestore" or "pubsub". If empty, the fault applies to all the
+	// components.
+	BuildingBlock string `json:"buildingBlock,omitempty" yaml:"buildingBlock,omitempty"`
+	// Target is the ID of the app, the type of the actors, or the name of the
+	// component the fault applies to. If empty, the fault applies to all of
+	// them.
+	Target string `json:"target,omitempty" yaml:"target,omitempty"`
+	// Delay delays the calls to the targets.
+	Delay *FaultDelaySpec `json:"delay,omitempty" yaml:"delay,omitempty"`
+	// Error fails the calls to the targets.
+	Error *FaultErrorSpec `json:"error,omitempty" yaml:"error,omitempty"`
+	// TTL is the duration after which the fault is removed, from the time the
+	// sidecar starts. If empty, the fault doesn't expire.
+	TTL string `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+}
+
+// FaultDelaySpec delays a percentage of the calls.
+type FaultDelaySpec struct {
+	// Duration is the delay, such as "500ms".
+	Duration string `json:"duration" yaml:"duration"`
+	// Percentage is the percentage of the calls delayed, from 0 to 100.
+	Percentage int `json:"percentage" yaml:"percentage"`
+}
+
+// FaultErrorSpec fails a percentage of the calls.
+type FaultErrorSpec struct {
+	// Message is the message of the error.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	// Percentage is the percentage of the calls failed, from 0 to 100.
+	Percentage int `json:"percentage" yaml:"percentage"`
+}
+
+// AppChannelSpec defines how the sidecar sends the requests to the app.
+type AppChannelSpec struct {
+	// AdaptiveConcurrency adapts the number of requests in flight toward the
+	// app to its latency, so the sidecar backs off when the app degrades.
+	AdaptiveConcurrency *AdaptiveConcurrencySpec `json:"adaptiveConcurrency,omitempty" yaml:"adaptiveConcurrency,omitempty"`
+	// HTTP2 multiplexes the requests to the app over HTTP/2 connections.
+	HTTP2 *AppChannelHTTP2Spec `json:"http2,omitempty" yaml:"http2,omitempty"`
+}
+
+// AppChannelHTTP2Spec defines how the sidecar sends the requests to the app
+// over HTTP/2 cleartext (h2c), multiplexing them as streams over a few
+// connections rather than over a pool of HTTP/1.1 connections.
+type AppChannelHTTP2Spec struct {
+	// PriorKnowledge sends the requests to an app with the "http" protocol
+	// over h2c with prior knowledge, as with the "h2c" protocol.
+	PriorKnowledge bool `json:"priorKnowledge,omitempty" yaml:"priorKnowledge,omitempty"`
+	// Connections is the number of h2c connections the requests are
+	// multiplexed over. Defaults to 1.
+	Connections int `json:"connections,omitempty" yaml:"connections,omitempty"`
+}
+
+// AdaptiveConcurrencySpec limits the requests in flight toward the app with an
+// additive-increase/multiplicative-decrease (AIMD) algorithm: the limit grows
+// by one while the app responds within the latency threshold, and shrinks by
+// the backoff percentage when it responds slower or is overloaded. The limit
+// never exceeds the app max concurrency, if set.
+type AdaptiveConcurrencySpec struct {
+	// LatencyThreshold is the latency of the app above which the limit
+	// shrinks, as a Go duration.
+	LatencyThreshold string `json:"latencyThreshold" yaml:"latencyThreshold"`
+	// MinLimit is the lowest limit. Defaults to 1.
+	MinLimit int `json:"minLimit,omitempty" yaml:"minLimit,omitempty"`
+	// MaxLimit is the highest limit. Defaults to the app max concurrency if
+	// set, or to 1000.
+	MaxLimit int `json:"maxLimit,omitempty" yaml:"maxLimit,omitempty"`
+	// InitialLimit is the limit when the sidecar starts. Defaults to 20,
+	// within the lowest and highest limits.
+	InitialLimit int `json:"initialLimit,omitempty" yaml:"initialLimit,omitempty"`
+	// BackoffPercentage is the percentage of the limit kept when it shrinks,
+	// between 1 and 99. Defaults to 90.
+	BackoffPercentage int `json:"backoffPercentage,omitempty" yaml:"backoffPercentage,omitempty"`
+}
+
+// BindingsSpec defines how the sidecar reads from the input bindings.
+type BindingsSpec struct {
+	// PauseStateStore is the name of the state store which keeps the input
+	// bindings paused with persistence, so they stay paused when the sidecar
+	// restarts. If empty, the input bindings can only be paused until the
+	// sidecar restarts.
+	PauseStateStore string `json:"pauseStateStore,omitempty" yaml:"pauseStateStore,omitempty"`
+}
+
+// BindingRoutingSpec defines the routes of the events of input bindings which
+// are forwarded by the sidecar to topics or output bindings, without being
+// delivered to the app.
+type BindingRoutingSpec struct {
+	Routes []BindingRouteSpec `json:"routes,omitempty" yaml:"routes,omitempty"`
+}
+
+// BindingRouteSpec forwards the events of an input binding to either a topic
+// of a pub/sub or an output binding. The events of an input binding with
+// several routes are forwarded to all of them.
+type BindingRouteSpec struct {
+	// Name identifies the route.
+	Name string `json:"name" yaml:"name"`
+	// Binding is the name of the input binding the events are read from.
+	Binding string `json:"binding" yaml:"binding"`
+	// PubsubName and Topic are the topic the events are published to, as
+	// cloud events.
+	PubsubName string `json:"pubsubName,omitempty" yaml:"pubsubName,omitempty"`
+	Topic      string `json:"topic,omitempty"      yaml:"topic,omitempty"`
+	// OutputBinding is the name of the output binding the events are sent to.
+	OutputBinding string `json:"outputBinding,omitempty" yaml:"outputBinding,omitempty"`
+	// Operation is the operation the output binding is invoked with. Defaults
+	// to "create".
+	Operation string `json:"operation,omitempty" yaml:"operation,omitempty"`
+	// Transform is a Go template which maps the event to the forwarded data.
+	// It's executed with the Binding name, the Data of the event, decoded if
+	// it is JSON, and its Metadata. If empty, the data is forwarded as is.
+	Transform string `json:"transform,omitempty" yaml:"transform,omitempty"`
+}
+
+const (
+	// AppHealthPausePubSub pauses the delivery of the messages of topic
+	// subscriptions.
+	AppHealthPausePubSub = "pubsub"
+	// AppHealthPauseBindings pauses the reading from input bindings.
+	AppHealthPauseBindings = "bindings"
+	// AppHealthPauseActors unregisters the hosted actors, which pauses their
+	// reminders.
+	AppHealthPauseActors = "actors"
+	// AppHealthPauseJobs pauses the triggering of jobs.
+	AppHealthPauseJobs = "jobs"
+	// AppHealthPauseNone pauses none of the building blocks.
+	AppHealthPauseNone = "none"
+)
+
+// AppHealthSpec defines how the sidecar behaves while the app health checks
+// report the app as unhealthy.
+type AppHealthSpec struct {
+	// RejectInvocations rejects the invocations of the app with 503 Service
+	// Unavailable while it's unhealthy, instead of invoking it.
+	RejectInvocations bool `json:"rejectInvocations,omitempty" yaml:"rejectInvocations,omitempty"`
+	// Pause are the building blocks which stop delivering to the app while it's
+	// unhealthy: "pubsub", "bindings", "actors" and "jobs", or "none". Defaults
+	// to all of them.
+	Pause []string `json:"pause,omitempty" yaml:"pause,omitempty"`
+}
+
+// Pauses returns whether the building block stops delivering to the app while
+// it's unhealthy.
+func (s *AppHealthSpec) Pauses(block string) bool {
+	if s == nil || len(s.Pause) == 0 {
+		return true
+	}
+	return slices.Contains(s.Pause, block)
+}
+
+// ServiceInvocationSpec defines the configuration for service invocation.
+type ServiceInvocationSpec struct {
+	// LoadBalancing configures how the instance of the target app which
+	// receives a call is chosen.
+	LoadBalancing *LoadBalancingSpec `json:"loadBalancing,omitempty" yaml:"loadBalancing,omitempty"`
+	// ResponseCaching configures the caching of the responses to GET
+	// invocations of apps and HTTPEndpoints.
+	ResponseCaching *ResponseCachingSpec `json:"responseCaching,omitempty" yaml:"responseCaching,omitempty"`
+	// Limits configures the size limits of the calls to apps.
+	Limits *InvocationLimitsSpec `json:"limits,omitempty" yaml:"limits,omitempty"`
+	// Federation configures the invocation of the apps of remote clusters.
+	Federation *FederationSpec `json:"federation,omitempty" yaml:"federation,omitempty"`
+	// HeaderPolicies filter the headers and the gRPC metadata of the calls to
+	// apps and HTTPEndpoints, in order.
+	HeaderPolicies []InvocationHeaderPolicy `json:"headerPolicies,omitempty" yaml:"headerPolicies,omitempty"`
+	// ConnectionPool configures the pools of gRPC connections to the sidecars
+	// of the target apps.
+	ConnectionPool *ConnectionPoolSpec `json:"connectionPool,omitempty" yaml:"connectionPool,omitempty"`
+}
+
+// ConnectionPoolSpec defines the pool of gRPC connections to each address of
+// a target sidecar.
+type ConnectionPoolSpec struct {
+	// MaxConnections is the maximum number of connections to an address. Once
+	// reached, the calls share the least loaded connection. Defaults to no
+	// limit.
+	MaxConnections int `json:"maxConnections,omitempty" yaml:"maxConnections,omitempty"`
+	// MaxConcurrentStreams is the number of calls in flight on a connection
+	// above which another connection is opened. Defaults to 100.
+	MaxConcurrentStreams int `json:"maxConcurrentStreams,omitempty" yaml:"maxConcurrentStreams,omitempty"`
+	// IdleTimeout is the duration after which an unused connection is closed.
+	// Defaults to 3m.
+	IdleTimeout *time.Duration `json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty"`
+	// RebalanceOnDNSChange drains the connections to a host name when the
+	// addresses it resolves to change.
+	RebalanceOnDNSChange bool `json:"rebalanceOnDNSChange,omitempty" yaml:"rebalanceOnDNSChange,omitempty"`
+}
+
+// UnmarshalJSON handles the Kubernetes CRD JSON format sent by the operator,
+// where the idle timeout is encoded as a metav1.Duration string.
+func (c *ConnectionPoolSpec) UnmarshalJSON(data []byte) error {
+	var crd configapi.ConnectionPoolSpec
+	if err := json.Unmarshal(data, &crd); err != nil {
+		return err
+	}
+
+	c.MaxConnections = crd.MaxConnections
+	c.MaxConcurrentStreams = crd.MaxConcurrentStreams
+	c.IdleTimeout = fromMetaDuration(crd.IdleTimeout)
+	c.RebalanceOnDNSChange = crd.RebalanceOnDNSChange
+
+	return nil
+}
+
+// InvocationHeaderPolicy filters the headers and the gRPC metadata of the
+// calls to apps and HTTPEndpoints. Header names are case-insensitive, and
+// names ending with "*" match all the headers with the prefix.
+type InvocationHeaderPolicy struct {
+	// Targets are the IDs of the apps and the names of the HTTPEndpoints the
+	// policy applies to. If empty, the policy applies to all the calls.
+	Targets []string `json:"targets,omitempty" yaml:"targets,omitempty"`
+	// Allow are the only headers which are sent, if set.
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+	// Deny are the headers which are not sent.
+	Deny []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+	// Rename maps the names of headers to the names they are sent with.
+	Rename map[string]string `json:"rename,omitempty" yaml:"rename,omitempty"`
+	// Set are headers sent with static values, replacing the values of the
+	// caller.
+	Set map[string]string `json:"set,omitempty" yaml:"set,omitempty"`
+}
+
+// AppliesTo returns whether the policy applies to the calls to the target.
+func (p InvocationHeaderPolicy) AppliesTo(target string) bool {
+	return len(p.Targets) == 0 || slices.Contains(p.Targets, target)
+}
+
+// FederationSpec defines the gateways through which the apps of remote Dapr
+// clusters are invoked.
+type FederationSpec struct {
+	// Gateways are the gateways of the remote clusters.
+	Gateways []FederationGateway `json:"gateways" yaml:"gateways"`
+}
+
+// FederationGateway is the gateway of a remote Dapr cluster.
+type FederationGateway struct {
+	// HTTPEndpoint is the name of the HTTPEndpoint resource whose base URL is
+	// the Dapr HTTP API of the gateway. Its client TLS configuration holds the
+	// trust bundle of the remote cluster and its headers the API token.
+	HTTPEndpoint string `json:"httpEndpoint" yaml:"httpEndpoint"`
+	// AppIDs are the IDs of the apps of the remote cluster. If empty, the
+	// gateway receives the calls to the apps which can't be resolved in the
+	// cluster of the sidecar.
+	AppIDs []string `json:"appIds,omitempty" yaml:"appIds,omitempty"`
+}
+
+// GatewayFor returns the HTTPEndpoint of the gateway the calls to the app are
+// sent to. Unless resolved is false, only the gateways which list the app are
+// considered.
+func (s FederationSpec) GatewayFor(appID string, resolved bool) (string, bool) {
+	for _, gw := range s.Gateways {
+		if slices.Contains(gw.AppIDs, appID) {
+			return gw.HTTPEndpoint, true
+		}
+	}
+	if resolved {
+		return "", false
+	}
+	for _, gw := range s.Gateways {
+		if len(gw.AppIDs) == 0 {
+			return gw.HTTPEndpoint, true
+		}
+	}
+	return "", false
+}
+
+// InvocationLimitsSpec defines the size limits of service invocation calls.
+type InvocationLimitsSpec struct {
+	InvocationLimits `json:",inline" yaml:",inline"`
+	// Apps overrides the limits for the given target apps.
+	Apps []AppInvocationLimits `json:"apps,omitempty" yaml:"apps,omitempty"`
+}
+
+// InvocationLimits are the size limits of service invocation calls. Zero
+// values are not limits.
+type InvocationLimits struct {
+	// MaxRequestBodySize is the maximum size in bytes of the body of the
+	// requests to the app.
+	MaxRequestBodySize int `json:"maxRequestBodySize,omitempty" yaml:"maxRequestBodySize,omitempty"`
+	// MaxResponseBodySize is the maximum size in bytes of the body of the
+	// responses of the app.
+	MaxResponseBodySize int `json:"maxResponseBodySize,omitempty" yaml:"maxResponseBodySize,omitempty"`
+	// StreamingThreshold is the size in bytes above which the bodies of the
+	// requests to the app are streamed instead of buffered, which disables
+	// their retries.
+	StreamingThreshold int `json:"streamingThreshold,omitempty" yaml:"streamingThreshold,omitempty"`
+}
+
+// AppInvocationLimits are the size limits of the calls to an app.
+type AppInvocationLimits struct {
+	AppID            string `json:"appId" yaml:"appId"`
+	InvocationLimits `json:",inline" yaml:",inline"`
+}
+
+// ForApp returns the limits of the calls to the app. The limits set for the
+// app take precedence over the others.
+func (s InvocationLimitsSpec) ForApp(appID string) InvocationLimits {
+	limits := s.InvocationLimits
+	for _, app := range s.Apps {
+		if app.AppID != appID {
+			continue
+		}
+		limits.MaxRequestBodySize = cmp.Or(app.MaxRequestBodySize, limits.MaxRequestBodySize)
+		limits.MaxResponseBodySize = cmp.Or(app.MaxResponseBodySize, limits.MaxResponseBodySize)
+		limits.StreamingThreshold = cmp.Or(app.StreamingThreshold, limits.StreamingThreshold)
+	}
+	return limits
+}
+
+// ResponseCachingSpec defines the caching of the responses to GET
+// invocations, which are cached for as long as their Cache-Control header
+// allows.
+type ResponseCachingSpec struct {
+	// Targets are the IDs of the apps and the names of the HTTPEndpoints whose
+	// responses are cached.
+	Targets []string `json:"targets" yaml:"targets"`
+	// MaxEntries is the maximum number of cached responses. Defaults to 1000.
+	MaxEntries int `json:"maxEntries,omitempty" yaml:"maxEntries,omitempty"`
+	// MaxResponseSize is the maximum size in bytes of the body of a cached
+	// response. Defaults to 1MiB.
+	MaxResponseSize int `json:"maxResponseSize,omitempty" yaml:"maxResponseSize,omitempty"`
+	// MaxTTL is the maximum duration a response is cached for, whatever its
+	// Cache-Control header. Defaults to 5m.
+	MaxTTL *time.Duration `json:"maxTTL,omitempty" yaml:"maxTTL,omitempty"`
+}
+
+// UnmarshalJSON handles the Kubernetes CRD JSON format sent by the operator,
+// where the maximum TTL is encoded as a metav1.Duration string.
+func (r *ResponseCachingSpec) UnmarshalJSON(data []byte) error {
+	var crd configapi.ResponseCachingSpec
+	if err := json.Unmarshal(data, &crd); err != nil {
+		return err
+	}
+
+	r.Targets = crd.Targets
+	r.MaxEntries = crd.MaxEntries
+	r.MaxResponseSize = crd.MaxResponseSize
+	r.MaxTTL = fromMetaDuration(crd.MaxTTL)
+
+	return nil
+}
+
+const (
+	// LoadBalancingRandom sends each call to a random instance.
+	LoadBalancingRandom = "random"
+	// LoadBalancingRoundRobin sends the calls to the instances in turn.
+	LoadBalancingRoundRobin = "roundRobin"
+	// LoadBalancingLeastLoaded sends each call to the instance with the fewest
+	// calls in flight.
+	LoadBalancingLeastLoaded = "leastLoaded"
+)
+
+// LoadBalancingSpec defines the load balancing of service invocation calls
+// between the instances of the target apps.
+type LoadBalancingSpec struct {
+	LoadBalancingPolicy `json:",inline" yaml:",inline"`
+	// Apps overrides the load balancing policy for the given target apps.
+	Apps []AppLoadBalancingPolicy `json:"apps,omitempty" yaml:"apps,omitempty"`
+}
+
+// LoadBalancingPolicy is a load balancing policy of service invocation calls.
+type LoadBalancingPolicy struct {
+	// Policy is one of "random" (the default), "roundRobin" or "leastLoaded".
+	Policy string `json:"policy,omitempty" yaml:"policy,omitempty"`
+	// ZoneAware prefers the instances in the zone of the sidecar, set with the
+	// DAPR_ZONE environment variable.
+	ZoneAware bool `json:"zoneAware,omitempty" yaml:"zoneAware,omitempty"`
+	// SubsetSize limits the calls of the sidecar to a subset of the instances
+	// of the target app, of at most this size.
+	SubsetSize int `json:"subsetSize,omitempty" yaml:"subsetSize,omitempty"`
+}
+
+// AppLoadBalancingPolicy is the load balancing policy of the calls to an app.
+type AppLoadBalancingPolicy struct {
+	AppID               string `json:"appId" yaml:"appId"`
+	LoadBalancingPolicy `json:",inline" yaml:",inline"`
+}
+
+// ForApp returns the load balancing policy of the calls to the given app.
+func (s LoadBalancingSpec) ForApp(appID string) LoadBalancingPolicy {
+	for _, app := range s.Apps {
+		if app.AppID == appID {
+			return app.LoadBalancingPolicy
+		}
+	}
+	return s.LoadBalancingPolicy
+}
+
+// JobsSpec defines the configuration for the jobs API.
+type JobsSpec struct {
+	// Calendars are the exclusion calendars which jobs may reference by name.
+	Calendars []JobCalendar `json:"calendars,omitempty" yaml:"calendars,omitempty"`
+}
+
+// JobCalendar is a named set of dates on which jobs referencing the calendar
+// are not triggered.
+type JobCalendar struct {
+	Name string `json:"name" yaml:"name"`
+	// ExcludedDates are either "YYYY-MM-DD" for a single date, or "MM-DD" for
+	// a date every year.
+	ExcludedDates []string `json:"excludedDates,omitempty" yaml:"excludedDates,omitempty"`
+	// TimeZone is the IANA time zone the dates are evaluated in. Defaults to
+	// the time zone of the job.
+	TimeZone *string `json:"timeZone,omitempty" yaml:"timeZone,omitempty"`
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -172,6 +679,51 @@ type WorkflowSpec struct {
 	// state once a workflow reaches a terminal state. If not set, workflow
 	// instances will not be automatically purged.
 	StateRetentionPolicy *WorkflowStateRetentionPolicy `json:"stateRetentionPolicy,omitempty" yaml:"stateRetentionPolicy,omitempty"`
+
+	// Partitioning defines how workflow work items are partitioned and
+	// dispatched by this Dapr instance. If not set, all workflow work items
+	// share a single queue.
+	Partitioning *WorkflowPartitioning `json:"partitioning,omitempty" yaml:"partitioning,omitempty"`
+
+	// Per-workflow-name limits on the concurrent child workflows and
+	// activities of each workflow instance, enforced by the workflow engine.
+	FanOutLimits []WorkflowFanOutLimit `json:"fanOutLimits,omitempty" yaml:"fanOutLimits,omitempty"`
+}
+
+const (
+	// WorkflowRebalanceRoundRobin drains each workflow partition in turn.
+	WorkflowRebalanceRoundRobin = "roundRobin"
+	// WorkflowRebalanceBacklog drains the workflow partition with the largest
+	// backlog first.
+	WorkflowRebalanceBacklog = "backlog"
+)
+
+// WorkflowPartitioning defines the partitioning of workflow work items. Work
+// items are assigned to a partition by hashing their workflow instance ID.
+type WorkflowPartitioning struct {
+	// Partitions is the number of workflow work item partitions. Defaults to 1.
+	Partitions *int32 `json:"partitions,omitempty" yaml:"partitions,omitempty"`
+
+	// RebalanceStrategy is the order in which partitions are drained, either
+	// "roundRobin" (default) or "backlog".
+	RebalanceStrategy *string `json:"rebalanceStrategy,omitempty" yaml:"rebalanceStrategy,omitempty"`
+}
+
+// WorkflowFanOutLimit limits the number of concurrent child workflows and
+// activities of each instance of the named workflow. Child workflows and
+// activities scheduled beyond the limit are queued, and dispatched in the
+// order they were scheduled as earlier ones complete.
+type WorkflowFanOutLimit struct {
+	Name                        *string `json:"name"                                  yaml:"name"`
+	MaxConcurrentChildWorkflows *int32  `json:"maxConcurrentChildWorkflows,omitempty" yaml:"maxConcurrentChildWorkflows,omitempty"`
+	MaxConcurrentActivities     *int32  `json:"maxConcurrentActivities,omitempty"     yaml:"maxConcurrentActivities,omitempty"`
+}
+
+// FanOutLimit is the resolved fan-out limit of a workflow. A zero limit means
+// no limit.
+type FanOutLimit struct {
+	ChildWorkflows int
+	Activities     int
 }
 
 // NamedConcurrencyLimit defines a per-name concurrency limit.
@@ -202,6 +754,20 @@ type WorkflowStateRetentionPolicy struct {
 	// Terminated is the TTL for purging workflow instances that reach the
 	// Terminated terminal state.
 	Terminated *time.Duration `json:"terminated,omitempty" yaml:"terminated,omitempty"`
+
+	// Workflows overrides the retention policy for the named workflows.
+	Workflows []WorkflowNamedStateRetentionPolicy `json:"workflows,omitempty" yaml:"workflows,omitempty"`
+}
+
+// WorkflowNamedStateRetentionPolicy is the retention policy of the instances
+// of the named workflow. Its TTLs take precedence over those of the app. If
+// AnyTerminal is set, the TTLs of the app are not used for the workflow.
+type WorkflowNamedStateRetentionPolicy struct {
+	Name        string         `json:"name"                  yaml:"name"`
+	AnyTerminal *time.Duration `json:"anyTerminal,omitempty" yaml:"anyTerminal,omitempty"`
+	Completed   *time.Duration `json:"completed,omitempty"   yaml:"completed,omitempty"`
+	Failed      *time.Duration `json:"failed,omitempty"      yaml:"failed,omitempty"`
+	Terminated  *time.Duration `json:"terminated,omitempty"  yaml:"terminated,omitempty"`
 }
 
 // UnmarshalJSON handles the Kubernetes CRD JSON format sent by the operator,
@@ -231,9 +797,58 @@ func (p *WorkflowStateRetentionPolicy) UnmarshalJSON(data []byte) error {
 		p.Terminated = &d
 	}
 
+	for _, w := range crd.Workflows {
+		p.Workflows = append(p.Workflows, WorkflowNamedStateRetentionPolicy{
+			Name:        w.Name,
+			AnyTerminal: fromMetaDuration(w.AnyTerminal),
+			Completed:   fromMetaDuration(w.Completed),
+			Failed:      fromMetaDuration(w.Failed),
+			Terminated:  fromMetaDuration(w.Terminated),
+		})
+	}
+
 	return nil
 }
 
+func fromMetaDuration(d *metav1.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	return &d.Duration
+}
+
+// ForWorkflow returns the retention policy of the instances of the named
+// workflow. The TTLs of the workflow take precedence over those of the app,
+// and the TTLs of the app are not used at all if the workflow sets
+// AnyTerminal. Returns nil if the policy is nil.
+func (p *WorkflowStateRetentionPolicy) ForWorkflow(name string) *WorkflowStateRetentionPolicy {
+	if p == nil {
+		return nil
+	}
+
+	for _, w := range p.Workflows {
+		if w.Name != name {
+			continue
+		}
+
+		policy := &WorkflowStateRetentionPolicy{
+			AnyTerminal: w.AnyTerminal,
+			Completed:   w.Completed,
+			Failed:      w.Failed,
+			Terminated:  w.Terminated,
+		}
+		if policy.AnyTerminal == nil {
+			policy.AnyTerminal = p.AnyTerminal
+			policy.Completed = cmp.Or(policy.Completed, p.Completed)
+			policy.Failed = cmp.Or(policy.Failed, p.Failed)
+			policy.Terminated = cmp.Or(policy.Terminated, p.Terminated)
+		}
+		return policy
+	}
+
+	return p
+}
+
 func (w *WorkflowSpec) GetMaxConcurrentWorkflowInvocations() *int32 {
 	if w == nil || w.MaxConcurrentWorkflowInvocations <= 0 {
 		return nil
@@ -262,6 +877,54 @@ func (w *WorkflowSpec) GetGlobalMaxConcurrentActivityInvocations() *int32 {
 	return w.GlobalMaxConcurrentActivityInvocations
 }
 
+// GetPartitions returns the number of workflow work item partitions, which is
+// at least 1.
+func (w *WorkflowSpec) GetPartitions() int {
+	if w == nil || w.Partitioning == nil || w.Partitioning.Partitions == nil || *w.Partitioning.Partitions <= 0 {
+		return 1
+	}
+	return int(*w.Partitioning.Partitions)
+}
+
+// GetRebalanceStrategy returns the workflow partition rebalance strategy,
+// defaulting to round robin.
+func (w *WorkflowSpec) GetRebalanceStrategy() string {
+	if w == nil || w.Partitioning == nil || w.Partitioning.RebalanceStrategy == nil || *w.Partitioning.RebalanceStrategy == "" {
+		return WorkflowRebalanceRoundRobin
+	}
+	return *w.Partitioning.RebalanceStrategy
+}
+
+// GetFanOutLimits returns the fan-out limits keyed by workflow name. Entries
+// without a name or without a positive limit are ignored.
+func (w *WorkflowSpec) GetFanOutLimits() map[string]FanOutLimit {
+	if w == nil {
+		return nil
+	}
+
+	var limits map[string]FanOutLimit
+	for _, l := range w.FanOutLimits {
+		if l.Name == nil || *l.Name == "" {
+			continue
+		}
+		var limit FanOutLimit
+		if l.MaxConcurrentChildWorkflows != nil && *l.MaxConcurrentChildWorkflows > 0 {
+			limit.ChildWorkflows = int(*l.MaxConcurrentChildWorkflows)
+		}
+		if l.MaxConcurrentActivities != nil && *l.MaxConcurrentActivities > 0 {
+			limit.Activities = int(*l.MaxConcurrentActivities)
+		}
+		if limit == (FanOutLimit{}) {
+			continue
+		}
+		if limits == nil {
+			limits = make(map[string]FanOutLimit)
+		}
+		limits[*l.Name] = limit
+	}
+	return limits
+}
+
 type SecretsSpec struct {
 	Scopes []SecretsScope `json:"scopes,omitempty"`
 }
@@ -284,6 +947,52 @@ type APISpec struct {
 	Allowed APIAccessRules `json:"allowed,omitempty"`
 	// List of denied APIs. Can be used in conjunction with allowed.
 	Denied APIAccessRules `json:"denied,omitempty"`
+	// Services of the gRPC API server other than the Dapr API.
+	GRPC *APIGRPCSpec `json:"grpc,omitempty" yaml:"grpc,omitempty"`
+	// API tokens read from secret stores, in addition to the dapr-api-token.
+	Tokens []APITokenSpec `json:"tokens,omitempty" yaml:"tokens,omitempty"`
+}
+
+// APITokenSpec describes API tokens read from a secret of a secret store.
+// The secret holds one token per line, so a new token can be added before
+// the old one is removed, and is watched, so the tokens are rotated without a
+// restart.
+type APITokenSpec struct {
+	// Name of the tokens, used in logs.
+	Name string `json:"name" yaml:"name"`
+	// Name of the secret store component.
+	SecretStore string `json:"secretStore" yaml:"secretStore"`
+	// Name of the secret.
+	SecretName string `json:"secretName" yaml:"secretName"`
+	// Key of the secret holding the tokens. Defaults to the name of the secret.
+	SecretKey string `json:"secretKey,omitempty" yaml:"secretKey,omitempty"`
+	// APIs the tokens are allowed to call. All APIs are allowed if empty.
+	Allowed APIAccessRules `json:"allowed,omitempty" yaml:"allowed,omitempty"`
+}
+
+// APIGRPCSpec describes the services served on the gRPC API port, next to the
+// Dapr API, for tools which introspect or health-check the sidecar.
+type APIGRPCSpec struct {
+	// Serve the gRPC server reflection service. Enabled by default.
+	Reflection *bool `json:"reflection,omitempty" yaml:"reflection,omitempty"`
+	// Serve the grpc.health.v1 health service, reporting the health of the
+	// sidecar. Calls to the service don't require the API token.
+	HealthService bool `json:"healthService,omitempty" yaml:"healthService,omitempty"`
+}
+
+// ReflectionEnabled returns true if the gRPC API server serves the reflection
+// service.
+func (a APISpec) ReflectionEnabled() bool {
+	if a.GRPC == nil || a.GRPC.Reflection == nil {
+		return true
+	}
+	return *a.GRPC.Reflection
+}
+
+// HealthServiceEnabled returns true if the gRPC API server serves the
+// grpc.health.v1 health service.
+func (a APISpec) HealthServiceEnabled() bool {
+	return a.GRPC != nil && a.GRPC.HealthService
 }
 
 // APIAccessRule describes an access rule for allowing a Dapr API to be enabled and accessible by an app.
@@ -291,6 +1000,9 @@ type APIAccessRule struct {
 	Name     string                `json:"name"`
 	Version  string                `json:"version"`
 	Protocol APIAccessRuleProtocol `json:"protocol"`
+	// HTTP verbs the rule applies to, for the http protocol. The rule applies
+	// to all verbs if empty.
+	Verbs []string `json:"verbs,omitempty" yaml:"verbs,omitempty"`
 }
 
 // APIAccessRules is a list of API access rules (allowlist or denylist).
@@ -302,22 +1014,92 @@ type APIAccessRuleProtocol string
 const (
 	APIAccessRuleProtocolHTTP APIAccessRuleProtocol = "http"
 	APIAccessRuleProtocolGRPC APIAccessRuleProtocol = "grpc"
+	// APIAccessRuleProtocolApp is the protocol of the rules governing the
+	// callbacks of the app invoked by the sidecar, whatever the protocol of the
+	// app channel.
+	APIAccessRuleProtocolApp APIAccessRuleProtocol = "app"
+)
+
+// Names of the callbacks of the app in the rules of the app protocol. All
+// the callbacks are of version v1.
+const (
+	// AppCallbackInvoke is the service invocation of the app by other apps,
+	// or by itself.
+	AppCallbackInvoke = "invoke"
+	// AppCallbackSubscribe is the listing of the subscriptions of the app and
+	// the delivery of the messages of all its subscriptions.
+	AppCallbackSubscribe = "subscribe"
+	// AppCallbackBindings is the listing of the input bindings of the app and
+	// the delivery of their events.
+	AppCallbackBindings = "bindings"
+	// AppCallbackActors is the loading of the actor configuration of the app,
+	// without which the app hosts no actors.
+	AppCallbackActors = "actors"
+	// AppCallbackJobs is the delivery of the triggered jobs of the app.
+	AppCallbackJobs = "jobs"
 )
 
 // GetRulesByProtocol returns a list of APIAccessRule objects for a protocol
 // The result is a map where the key is in the format "<version>/<endpoint>"
+// The rules which apply only to some HTTP verbs are not included.
 func (r APIAccessRules) GetRulesByProtocol(protocol APIAccessRuleProtocol) map[string]struct{} {
+	return r.GetRulesByProtocolAndVerb(protocol, "")
+}
+
+// GetRulesByProtocolAndVerb returns the rules of the protocol which apply to
+// the HTTP verb: the rules without verbs, and the rules listing the verb.
+// The result is a map where the key is in the format "<version>/<endpoint>"
+func (r APIAccessRules) GetRulesByProtocolAndVerb(protocol APIAccessRuleProtocol, verb string) map[string]struct{} {
 	res := make(map[string]struct{}, len(r))
 	for _, v := range r {
 		//nolint:gocritic
-		if strings.ToLower(string(v.Protocol)) == string(protocol) {
-			key := v.Version + "/" + v.Name
-			res[key] = struct{}{}
+		if strings.ToLower(string(v.Protocol)) != string(protocol) {
+			continue
 		}
+		if len(v.Verbs) > 0 && !slices.ContainsFunc(v.Verbs, func(s string) bool {
+			return strings.EqualFold(s, verb)
+		}) {
+			continue
+		}
+		res[v.Version+"/"+v.Name] = struct{}{}
 	}
 	return res
 }
 
+// HasProtocol returns true if some rules are of the protocol.
+func (r APIAccessRules) HasProtocol(protocol APIAccessRuleProtocol) bool {
+	return slices.ContainsFunc(r, func(v APIAccessRule) bool {
+		return strings.ToLower(string(v.Protocol)) == string(protocol)
+	})
+}
+
+// HasVerbs returns true if some rules of the protocol apply only to some HTTP
+// verbs.
+func (r APIAccessRules) HasVerbs(protocol APIAccessRuleProtocol) bool {
+	return slices.ContainsFunc(r, func(v APIAccessRule) bool {
+		return strings.ToLower(string(v.Protocol)) == string(protocol) && len(v.Verbs) > 0
+	})
+}
+
+// AppCallbackAllowed returns true if the sidecar is allowed to invoke the
+// callback of the app, given the rules of the app protocol of the API
+// allowlist and denylist. The version of the rules is v1, or its alias v1.0.
+func (a APISpec) AppCallbackAllowed(name string) bool {
+	match := func(rules map[string]struct{}) bool {
+		_, ok := rules["v1/"+name]
+		if !ok {
+			_, ok = rules["v1.0/"+name]
+		}
+		return ok
+	}
+
+	if denied := a.Denied.GetRulesByProtocol(APIAccessRuleProtocolApp); match(denied) {
+		return false
+	}
+	allowed := a.Allowed.GetRulesByProtocol(APIAccessRuleProtocolApp)
+	return len(allowed) == 0 || match(allowed)
+}
+
 type HandlerSpec struct {
 	Name         string       `json:"name,omitempty"     yaml:"name,omitempty"`
 	Type         string       `json:"type,omitempty"     yaml:"type,omitempty"`
@@ -339,6 +1121,28 @@ type SelectorField struct {
 	Value string `json:"value" yaml:"value"`
 }
 
+// SelectorDestinationField is the field of the selectors of the handlers of
+// the outbound pipeline which selects the apps and HTTPEndpoints whose calls
+// the handler applies to.
+const SelectorDestinationField = "destination"
+
+// SelectsDestination returns whether the handler applies to the calls to the
+// destination: the selector has no destination fields, or one whose value is
+// the destination or "*".
+func (s SelectorSpec) SelectsDestination(destination string) bool {
+	selected := true
+	for _, f := range s.Fields {
+		if f.Field != SelectorDestinationField {
+			continue
+		}
+		if f.Value == destination || f.Value == "*" {
+			return true
+		}
+		selected = false
+	}
+	return selected
+}
+
 type TracingSpec struct {
 	SamplingRate string      `json:"samplingRate,omitempty" yaml:"samplingRate,omitempty"`
 	Stdout       bool        `json:"stdout,omitempty" yaml:"stdout,omitempty"`
@@ -505,6 +1309,30 @@ type AppPolicySpec struct {
 	TrustDomain         string         `json:"trustDomain,omitempty" yaml:"trustDomain,omitempty"`
 	Namespace           string         `json:"namespace,omitempty" yaml:"namespace,omitempty"`
 	AppOperationActions []AppOperation `json:"operations,omitempty" yaml:"operations,omitempty"`
+	// Rules are matched on the HTTP verbs and path globs, or the full gRPC
+	// method names, of the calls. They take precedence over the operations.
+	Rules []AppInvocationRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// DenyByDefault denies the calls which no rule nor operation allows,
+	// whatever the default actions.
+	DenyByDefault bool `json:"denyByDefault,omitempty" yaml:"denyByDefault,omitempty"`
+}
+
+// AppInvocationRule is an access control rule of the calls of an app. Among
+// the rules which match a call, the most specific one applies: a gRPC method
+// name over any path, then the path with the most literal segments, then the
+// one with HTTP verbs. Deny rules apply over allow rules of equal precedence.
+type AppInvocationRule struct {
+	// Path is a glob of the invoked method, where "*" matches any characters
+	// of a path segment and "**" any number of segments.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	// HTTPVerb are the HTTP verbs the rule matches. If empty, it matches all
+	// the verbs and the gRPC calls.
+	HTTPVerb []string `json:"httpVerb,omitempty" yaml:"httpVerb,omitempty"`
+	// GRPCMethod is the full name of the gRPC method the rule matches, as
+	// "/package.Service/Method", instead of a path.
+	GRPCMethod string `json:"grpcMethod,omitempty" yaml:"grpcMethod,omitempty"`
+	// Action is either "allow" or "deny".
+	Action string `json:"action" yaml:"action"`
 }
 
 // AppOperation defines the data structure for each app operation.
@@ -525,6 +1353,16 @@ type NameResolutionSpec struct {
 	Component     string `json:"component,omitempty"     yaml:"component,omitempty"`
 	Version       string `json:"version,omitempty"       yaml:"version,omitempty"`
 	Configuration any    `json:"configuration,omitempty" yaml:"configuration,omitempty"`
+	// Failover are the name resolvers tried in order for the apps which the
+	// resolvers before them fail to resolve.
+	Failover []NameResolverSpec `json:"failover,omitempty" yaml:"failover,omitempty"`
+}
+
+// NameResolverSpec is the spec of a failover name resolver.
+type NameResolverSpec struct {
+	Component     string `json:"component"               yaml:"component"`
+	Version       string `json:"version,omitempty"       yaml:"version,omitempty"`
+	Configuration any    `json:"configuration,omitempty" yaml:"configuration,omitempty"`
 }
 
 // MTLSSpec defines mTLS configuration.
@@ -538,6 +1376,40 @@ type MTLSSpec struct {
 	// When Dapr is running in Kubernetes mode, this is in addition to the built-in "kubernetes" validator.
 	// In self-hosted mode, enabling a custom validator will disable the built-in "insecure" validator.
 	TokenValidators []ValidatorSpec `json:"tokenValidators,omitempty" yaml:"tokenValidators,omitempty"`
+	// Provider of the CA which signs the workload certificates.
+	// Sentry signs them with its own issuer certificate when not set.
+	CAProvider *CAProviderSpec `json:"caProvider,omitempty" yaml:"caProvider,omitempty"`
+	// Upper bound of the TTL of the workload certificates, including the TTLs
+	// of namespaces and apps and the TTLs requested by pods.
+	MaxWorkloadCertTTL string `json:"maxWorkloadCertTTL,omitempty" yaml:"maxWorkloadCertTTL,omitempty"`
+	// TTLs of the workload certificates of namespaces or apps, overriding WorkloadCertTTL.
+	WorkloadCertTTLs []WorkloadCertTTLSpec `json:"workloadCertTTLs,omitempty" yaml:"workloadCertTTLs,omitempty"`
+}
+
+// WorkloadCertTTLSpec is the TTL of the workload certificates of a namespace,
+// or of an app if the app ID is set.
+type WorkloadCertTTLSpec struct {
+	Namespace string `json:"namespace"         yaml:"namespace"`
+	AppID     string `json:"appID,omitempty"   yaml:"appID,omitempty"`
+	TTL       string `json:"ttl"               yaml:"ttl"`
+}
+
+// CAProviderSpec is the provider of the CA which signs the workload certificates.
+type CAProviderSpec struct {
+	// Name of the provider
+	Name string `json:"name" yaml:"name"`
+	// Options for the provider, if any
+	Options any `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+// OptionsMap returns the provider options as a map[string]string.
+// If the options are empty, or if the conversion fails, returns nil.
+func (c CAProviderSpec) OptionsMap() map[string]string {
+	if c.Options == nil {
+		return nil
+	}
+
+	return cast.ToStringMapString(c.Options)
 }
 
 // ValidatorSpec contains additional token validators to use.
@@ -568,6 +1440,21 @@ type FeatureSpec struct {
 type ComponentsSpec struct {
 	// Denylist of component types that cannot be instantiated
 	Deny []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+	// Remote pluggable components, served over TCP by endpoints outside of the pod
+	Remote []RemoteComponentSpec `json:"remote,omitempty" yaml:"remote,omitempty"`
+}
+
+// RemoteComponentSpec describes a pluggable component served by a remote endpoint.
+// The endpoint is authenticated with mTLS as the Dapr app with the given ID.
+type RemoteComponentSpec struct {
+	// Name of the pluggable component, used in the type of the components
+	Name string `json:"name" yaml:"name"`
+	// Address of the endpoint, as host:port
+	Address string `json:"address" yaml:"address"`
+	// App ID of the endpoint identity
+	AppID string `json:"appId" yaml:"appId"`
+	// Namespace of the endpoint identity. Defaults to the namespace of the sidecar.
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
 }
 
 // WasmSpec describes the security profile for all Dapr Wasm components.
@@ -587,6 +1474,24 @@ func (w *WasmSpec) GetStrictSandbox() bool {
 type LoggingSpec struct {
 	// Configure API logging.
 	APILogging *APILoggingSpec `json:"apiLogging,omitempty" yaml:"apiLogging,omitempty"`
+	// Configure the audit log of security-relevant events.
+	AuditLog *AuditLogSpec `json:"auditLog,omitempty" yaml:"auditLog,omitempty"`
+	// Log level of the sidecar, which overrides the --log-level flag.
+	Level string `json:"level,omitempty" yaml:"level,omitempty"`
+}
+
+// AuditLogSpec defines the configuration for the audit log of
+// security-relevant events: ACL denials, API token failures, secret accesses
+// and crypto operations.
+type AuditLogSpec struct {
+	// Enables the audit log. The default value is false.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Output of the audit log: stdout, file or otel. Default: stdout.
+	Output string `json:"output,omitempty" yaml:"output,omitempty"`
+	// Path of the file the audit log is appended to, for the file output.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	// OTLP logs exporter, for the otel output.
+	Otel *OtelSpec `json:"otel,omitempty" yaml:"otel,omitempty"`
 }
 
 // APILoggingSpec defines the configuration for API logging.
@@ -914,6 +1819,125 @@ func (c Configuration) GetAPILoggingSpec() APILoggingSpec {
 	return *c.Spec.LoggingSpec.APILogging
 }
 
+// GetLogLevel returns the Logging.Level, or an empty string if it's not set.
+func (c Configuration) GetLogLevel() string {
+	if c.Spec.LoggingSpec == nil {
+		return ""
+	}
+	return c.Spec.LoggingSpec.Level
+}
+
+// GetAuditLogSpec returns the Logging.AuditLog spec.
+// It's a short-hand that includes nil-checks for safety.
+func (c Configuration) GetAuditLogSpec() AuditLogSpec {
+	if c.Spec.LoggingSpec == nil || c.Spec.LoggingSpec.AuditLog == nil {
+		return AuditLogSpec{}
+	}
+	return *c.Spec.LoggingSpec.AuditLog
+}
+
+// GetJobCalendar returns the job calendar with the given name.
+func (c Configuration) GetJobCalendar(name string) (JobCalendar, bool) {
+	if c.Spec.JobsSpec == nil {
+		return JobCalendar{}, false
+	}
+	for _, cal := range c.Spec.JobsSpec.Calendars {
+		if cal.Name == name {
+			return cal, true
+		}
+	}
+	return JobCalendar{}, false
+}
+
+// GetAdaptiveConcurrencySpec returns the AppChannel.AdaptiveConcurrency spec,
+// or nil if the concurrency toward the app doesn't adapt to its latency.
+func (c Configuration) GetAdaptiveConcurrencySpec() *AdaptiveConcurrencySpec {
+	if c.Spec.AppChannelSpec == nil {
+		return nil
+	}
+	return c.Spec.AppChannelSpec.AdaptiveConcurrency
+}
+
+// GetAppChannelHTTP2Spec returns the AppChannel.HTTP2 spec.
+// It's a short-hand that includes nil-checks for safety.
+func (c Configuration) GetAppChannelHTTP2Spec() AppChannelHTTP2Spec {
+	if c.Spec.AppChannelSpec == nil || c.Spec.AppChannelSpec.HTTP2 == nil {
+		return AppChannelHTTP2Spec{}
+	}
+	return *c.Spec.AppChannelSpec.HTTP2
+}
+
+// GetBindingsSpec returns the Bindings spec.
+// It's a short-hand that includes nil-checks for safety.
+func (c Configuration) GetBindingsSpec() BindingsSpec {
+	if c.Spec.BindingsSpec == nil {
+		return BindingsSpec{}
+	}
+	return *c.Spec.BindingsSpec
+}
+
+// GetBindingRoutes returns the routes forwarding the events of input bindings
+// to topics or output bindings.
+func (c Configuration) GetBindingRoutes() []BindingRouteSpec {
+	if c.Spec.BindingRoutingSpec == nil {
+		return nil
+	}
+	return c.Spec.BindingRoutingSpec.Routes
+}
+
+// GetLoadBalancingSpec returns the ServiceInvocation.LoadBalancing spec, or
+// nil if load balancing is not configured.
+func (c Configuration) GetLoadBalancingSpec() *LoadBalancingSpec {
+	if c.Spec.ServiceInvocation == nil {
+		return nil
+	}
+	return c.Spec.ServiceInvocation.LoadBalancing
+}
+
+// GetResponseCachingSpec returns the caching of the responses to service
+// invocations, or nil if it is not configured.
+func (c Configuration) GetResponseCachingSpec() *ResponseCachingSpec {
+	if c.Spec.ServiceInvocation == nil {
+		return nil
+	}
+	return c.Spec.ServiceInvocation.ResponseCaching
+}
+
+// GetFederationSpec returns the gateways of the remote clusters, or nil if
+// federation is not configured.
+func (c Configuration) GetFederationSpec() *FederationSpec {
+	if c.Spec.ServiceInvocation == nil {
+		return nil
+	}
+	return c.Spec.ServiceInvocation.Federation
+}
+
+// GetHeaderPolicies returns the policies filtering the headers of the calls to
+// apps and HTTPEndpoints.
+func (c Configuration) GetHeaderPolicies() []InvocationHeaderPolicy {
+	if c.Spec.ServiceInvocation == nil {
+		return nil
+	}
+	return c.Spec.ServiceInvocation.HeaderPolicies
+}
+
+// GetConnectionPoolSpec returns the configuration of the pools of gRPC
+// connections to the target sidecars, or nil if it is not configured.
+func (c Configuration) GetConnectionPoolSpec() *ConnectionPoolSpec {
+	if c.Spec.ServiceInvocation == nil {
+		return nil
+	}
+	return c.Spec.ServiceInvocation.ConnectionPool
+}
+
+// GetInvocationLimits returns the size limits of the calls to the app.
+func (c Configuration) GetInvocationLimits(appID string) InvocationLimits {
+	if c.Spec.ServiceInvocation == nil || c.Spec.ServiceInvocation.Limits == nil {
+		return InvocationLimits{}
+	}
+	return c.Spec.ServiceInvocation.Limits.ForApp(appID)
+}
+
 // ToYAML returns the Configuration represented as YAML.
 func (c *Configuration) ToYAML() (string, error) {
 	b, err := yaml.Marshal(c)
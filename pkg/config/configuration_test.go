@@ -591,6 +591,71 @@ func TestAPIAccessRules(t *testing.T) {
 	assert.Empty(t, slices.Collect(maps.Keys(apiSpec.Denied.GetRulesByProtocol(APIAccessRuleProtocolGRPC))))
 }
 
+func TestAPIAccessRulesVerbs(t *testing.T) {
+	rules := APIAccessRules{
+		{Name: "state", Version: "v1", Protocol: "http"},
+		{Name: "invoke", Version: "v1", Protocol: "http", Verbs: []string{"GET", "post"}},
+		{Name: "secrets", Version: "v1", Protocol: "grpc", Verbs: []string{"GET"}},
+	}
+
+	assert.Equal(t, []string{"v1/state"}, slices.Collect(maps.Keys(rules.GetRulesByProtocol(APIAccessRuleProtocolHTTP))))
+	assert.ElementsMatch(t, []string{"v1/state", "v1/invoke"}, slices.Collect(maps.Keys(rules.GetRulesByProtocolAndVerb(APIAccessRuleProtocolHTTP, "Post"))))
+	assert.Equal(t, []string{"v1/state"}, slices.Collect(maps.Keys(rules.GetRulesByProtocolAndVerb(APIAccessRuleProtocolHTTP, "DELETE"))))
+	assert.True(t, rules.HasVerbs(APIAccessRuleProtocolHTTP))
+	assert.True(t, rules.HasProtocol(APIAccessRuleProtocolGRPC))
+	assert.False(t, rules[:1].HasVerbs(APIAccessRuleProtocolHTTP))
+	assert.False(t, rules.HasProtocol(APIAccessRuleProtocolApp))
+}
+
+func TestAppCallbackAllowed(t *testing.T) {
+	t.Run("no rules", func(t *testing.T) {
+		assert.True(t, APISpec{}.AppCallbackAllowed(AppCallbackSubscribe))
+	})
+
+	t.Run("rules of other protocols", func(t *testing.T) {
+		apiSpec := APISpec{
+			Allowed: APIAccessRules{{Name: "state", Version: "v1", Protocol: "http"}},
+			Denied:  APIAccessRules{{Name: "subscribe", Version: "v1", Protocol: "grpc"}},
+		}
+		assert.True(t, apiSpec.AppCallbackAllowed(AppCallbackSubscribe))
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		apiSpec := APISpec{
+			Denied: APIAccessRules{{Name: "subscribe", Version: "v1.0", Protocol: "app"}},
+		}
+		assert.False(t, apiSpec.AppCallbackAllowed(AppCallbackSubscribe))
+		assert.True(t, apiSpec.AppCallbackAllowed(AppCallbackInvoke))
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		apiSpec := APISpec{
+			Allowed: APIAccessRules{
+				{Name: "invoke", Version: "v1", Protocol: "app"},
+				{Name: "jobs", Version: "v1", Protocol: "app"},
+			},
+			Denied: APIAccessRules{{Name: "jobs", Version: "v1", Protocol: "app"}},
+		}
+		assert.True(t, apiSpec.AppCallbackAllowed(AppCallbackInvoke))
+		assert.False(t, apiSpec.AppCallbackAllowed(AppCallbackJobs))
+		assert.False(t, apiSpec.AppCallbackAllowed(AppCallbackBindings))
+	})
+}
+
+func TestAPIGRPCSpec(t *testing.T) {
+	assert.True(t, APISpec{}.ReflectionEnabled())
+	assert.False(t, APISpec{}.HealthServiceEnabled())
+
+	apiSpec := APISpec{GRPC: &APIGRPCSpec{Reflection: new(false), HealthService: true}}
+	assert.False(t, apiSpec.ReflectionEnabled())
+	assert.True(t, apiSpec.HealthServiceEnabled())
+
+	conf, err := LoadStandaloneConfiguration("./testdata/grpc_api_config.yaml")
+	require.NoError(t, err)
+	assert.False(t, conf.GetAPISpec().ReflectionEnabled())
+	assert.True(t, conf.GetAPISpec().HealthServiceEnabled())
+}
+
 func TestSortMetrics(t *testing.T) {
 	t.Run("metrics overrides metric - enabled false", func(t *testing.T) {
 		config := &Configuration{
@@ -838,4 +903,225 @@ func TestWorkflowStateRetentionPolicyUnmarshalJSON(t *testing.T) {
 		var p WorkflowStateRetentionPolicy
 		assert.Error(t, json.Unmarshal([]byte(data), &p))
 	})
+
+	t.Run("per workflow policies", func(t *testing.T) {
+		data := `{"anyTerminal":"1h","workflows":[{"name":"batch","completed":"1s"},{"name":"audit","anyTerminal":"720h"}]}`
+		var p WorkflowStateRetentionPolicy
+		require.NoError(t, json.Unmarshal([]byte(data), &p))
+
+		require.Len(t, p.Workflows, 2)
+		assert.Equal(t, "batch", p.Workflows[0].Name)
+		require.NotNil(t, p.Workflows[0].Completed)
+		assert.Equal(t, time.Second, *p.Workflows[0].Completed)
+		assert.Nil(t, p.Workflows[0].AnyTerminal)
+		assert.Equal(t, "audit", p.Workflows[1].Name)
+		require.NotNil(t, p.Workflows[1].AnyTerminal)
+		assert.Equal(t, 720*time.Hour, *p.Workflows[1].AnyTerminal)
+	})
+}
+
+func TestWorkflowStateRetentionPolicyForWorkflow(t *testing.T) {
+	var nilPolicy *WorkflowStateRetentionPolicy
+	assert.Nil(t, nilPolicy.ForWorkflow("wf"))
+
+	p := &WorkflowStateRetentionPolicy{
+		AnyTerminal: new(time.Hour),
+		Failed:      new(48 * time.Hour),
+		Workflows: []WorkflowNamedStateRetentionPolicy{
+			{Name: "batch", Completed: new(time.Second)},
+			{Name: "audit", AnyTerminal: new(720 * time.Hour), Terminated: new(time.Minute)},
+		},
+	}
+
+	assert.Same(t, p, p.ForWorkflow("other"))
+
+	batch := p.ForWorkflow("batch")
+	assert.Equal(t, time.Second, *batch.Completed)
+	assert.Equal(t, 48*time.Hour, *batch.Failed)
+	assert.Nil(t, batch.Terminated)
+	assert.Equal(t, time.Hour, *batch.AnyTerminal)
+
+	audit := p.ForWorkflow("audit")
+	assert.Equal(t, 720*time.Hour, *audit.AnyTerminal)
+	assert.Equal(t, time.Minute, *audit.Terminated)
+	assert.Nil(t, audit.Completed)
+	assert.Nil(t, audit.Failed)
+}
+
+func TestGetJobCalendar(t *testing.T) {
+	t.Run("no jobs spec", func(t *testing.T) {
+		_, ok := Configuration{}.GetJobCalendar("holidays")
+		assert.False(t, ok)
+	})
+
+	t.Run("calendar by name", func(t *testing.T) {
+		data := `{"spec":{"jobs":{"calendars":[{"name":"holidays","excludedDates":["2026-12-25","01-01"],"timeZone":"Europe/London"},{"name":"weekends"}]}}}`
+		var c Configuration
+		require.NoError(t, json.Unmarshal([]byte(data), &c))
+
+		cal, ok := c.GetJobCalendar("holidays")
+		require.True(t, ok)
+		assert.Equal(t, []string{"2026-12-25", "01-01"}, cal.ExcludedDates)
+		require.NotNil(t, cal.TimeZone)
+		assert.Equal(t, "Europe/London", *cal.TimeZone)
+
+		_, ok = c.GetJobCalendar("other")
+		assert.False(t, ok)
+	})
+}
+
+func TestWorkflowSpecPartitioning(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		for _, w := range []*WorkflowSpec{
+			nil,
+			{},
+			{Partitioning: &WorkflowPartitioning{}},
+			{Partitioning: &WorkflowPartitioning{Partitions: new(int32(0)), RebalanceStrategy: new("")}},
+		} {
+			assert.Equal(t, 1, w.GetPartitions())
+			assert.Equal(t, WorkflowRebalanceRoundRobin, w.GetRebalanceStrategy())
+		}
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		var w WorkflowSpec
+		require.NoError(t, json.Unmarshal([]byte(`{"partitioning":{"partitions":8,"rebalanceStrategy":"backlog"}}`), &w))
+		assert.Equal(t, 8, w.GetPartitions())
+		assert.Equal(t, WorkflowRebalanceBacklog, w.GetRebalanceStrategy())
+	})
+}
+
+func TestWorkflowSpecFanOutLimits(t *testing.T) {
+	var nilSpec *WorkflowSpec
+	assert.Nil(t, nilSpec.GetFanOutLimits())
+
+	var w WorkflowSpec
+	require.NoError(t, json.Unmarshal([]byte(`{"fanOutLimits":[
+		{"name":"batch","maxConcurrentChildWorkflows":10,"maxConcurrentActivities":100},
+		{"name":"etl","maxConcurrentActivities":5},
+		{"name":"none","maxConcurrentActivities":0},
+		{"maxConcurrentActivities":3}
+	]}`), &w))
+	assert.Equal(t, map[string]FanOutLimit{
+		"batch": {ChildWorkflows: 10, Activities: 100},
+		"etl":   {Activities: 5},
+	}, w.GetFanOutLimits())
+}
+
+func TestLoadBalancingSpec(t *testing.T) {
+	assert.Nil(t, Configuration{}.GetLoadBalancingSpec())
+
+	var c Configuration
+	require.NoError(t, json.Unmarshal([]byte(`{"spec":{"serviceInvocation":{"loadBalancing":{
+		"policy":"leastLoaded","zoneAware":true,
+		"apps":[{"appId":"orders","policy":"roundRobin","subsetSize":3}]
+	}}}}`), &c))
+
+	spec := c.GetLoadBalancingSpec()
+	require.NotNil(t, spec)
+	assert.Equal(t, LoadBalancingPolicy{Policy: LoadBalancingLeastLoaded, ZoneAware: true}, spec.ForApp("other"))
+	assert.Equal(t, LoadBalancingPolicy{Policy: LoadBalancingRoundRobin, SubsetSize: 3}, spec.ForApp("orders"))
+}
+
+func TestResponseCachingSpec(t *testing.T) {
+	assert.Nil(t, Configuration{}.GetResponseCachingSpec())
+
+	var c Configuration
+	require.NoError(t, json.Unmarshal([]byte(`{"spec":{"serviceInvocation":{"responseCaching":{
+		"targets":["orders","catalog"],"maxEntries":100,"maxTTL":"30s"
+	}}}}`), &c))
+
+	spec := c.GetResponseCachingSpec()
+	require.NotNil(t, spec)
+	assert.Equal(t, []string{"orders", "catalog"}, spec.Targets)
+	assert.Equal(t, 100, spec.MaxEntries)
+	require.NotNil(t, spec.MaxTTL)
+	assert.Equal(t, 30*time.Second, *spec.MaxTTL)
+}
+
+func TestConnectionPoolSpec(t *testing.T) {
+	assert.Nil(t, Configuration{}.GetConnectionPoolSpec())
+
+	var c Configuration
+	require.NoError(t, json.Unmarshal([]byte(`{"spec":{"serviceInvocation":{"connectionPool":{
+		"maxConnections":4,"maxConcurrentStreams":50,"idleTimeout":"1m","rebalanceOnDNSChange":true
+	}}}}`), &c))
+
+	spec := c.GetConnectionPoolSpec()
+	require.NotNil(t, spec)
+	assert.Equal(t, 4, spec.MaxConnections)
+	assert.Equal(t, 50, spec.MaxConcurrentStreams)
+	require.NotNil(t, spec.IdleTimeout)
+	assert.Equal(t, time.Minute, *spec.IdleTimeout)
+	assert.True(t, spec.RebalanceOnDNSChange)
+}
+
+func TestInvocationLimits(t *testing.T) {
+	assert.Equal(t, InvocationLimits{}, Configuration{}.GetInvocationLimits("orders"))
+
+	var c Configuration
+	require.NoError(t, json.Unmarshal([]byte(`{"spec":{"serviceInvocation":{"limits":{
+		"maxRequestBodySize":1024,"streamingThreshold":512,
+		"apps":[{"appId":"orders","maxRequestBodySize":4096,"maxResponseBodySize":2048}]
+	}}}}`), &c))
+
+	assert.Equal(t, InvocationLimits{MaxRequestBodySize: 1024, StreamingThreshold: 512}, c.GetInvocationLimits("other"))
+	assert.Equal(t, InvocationLimits{MaxRequestBodySize: 4096, MaxResponseBodySize: 2048, StreamingThreshold: 512}, c.GetInvocationLimits("orders"))
+}
+
+func TestFederationSpec(t *testing.T) {
+	assert.Nil(t, Configuration{}.GetFederationSpec())
+
+	var c Configuration
+	require.NoError(t, json.Unmarshal([]byte(`{"spec":{"serviceInvocation":{"federation":{"gateways":[
+		{"httpEndpoint":"cluster-b","appIds":["orders"]},
+		{"httpEndpoint":"cluster-c"}
+	]}}}}`), &c))
+
+	federation := c.GetFederationSpec()
+	require.NotNil(t, federation)
+
+	gateway, ok := federation.GatewayFor("orders", true)
+	assert.True(t, ok)
+	assert.Equal(t, "cluster-b", gateway)
+
+	_, ok = federation.GatewayFor("shipping", true)
+	assert.False(t, ok)
+
+	gateway, ok = federation.GatewayFor("shipping", false)
+	assert.True(t, ok)
+	assert.Equal(t, "cluster-c", gateway)
+}
+
+func TestAppHealthSpecPauses(t *testing.T) {
+	var spec *AppHealthSpec
+	assert.True(t, spec.Pauses(AppHealthPausePubSub))
+
+	spec = &AppHealthSpec{RejectInvocations: true}
+	assert.True(t, spec.Pauses(AppHealthPauseActors))
+
+	spec.Pause = []string{AppHealthPausePubSub, AppHealthPauseActors}
+	assert.True(t, spec.Pauses(AppHealthPausePubSub))
+	assert.True(t, spec.Pauses(AppHealthPauseActors))
+	assert.False(t, spec.Pauses(AppHealthPauseBindings))
+	assert.False(t, spec.Pauses(AppHealthPauseJobs))
+
+	spec.Pause = []string{AppHealthPauseNone}
+	assert.False(t, spec.Pauses(AppHealthPausePubSub))
+}
+
+func TestHeaderPolicies(t *testing.T) {
+	assert.Nil(t, Configuration{}.GetHeaderPolicies())
+
+	var c Configuration
+	require.NoError(t, json.Unmarshal([]byte(`{"spec":{"serviceInvocation":{"headerPolicies":[
+		{"targets":["payments"],"deny":["x-internal-*"],"rename":{"x-tenant":"x-customer"},"set":{"x-region":"eu"}}
+	]}}}`), &c))
+
+	policies := c.GetHeaderPolicies()
+	require.Len(t, policies, 1)
+	assert.True(t, policies[0].AppliesTo("payments"))
+	assert.False(t, policies[0].AppliesTo("orders"))
+	assert.Equal(t, map[string]string{"x-tenant": "x-customer"}, policies[0].Rename)
+	assert.Equal(t, map[string]string{"x-region": "eu"}, policies[0].Set)
 }
@@ -17,6 +17,8 @@ const (
 	AppPort string = "APP_PORT"
 	// AppID is the ID of the application.
 	AppID string = "APP_ID"
+	// DaprZone is the zone, such as the availability zone, of the instance.
+	DaprZone string = "DAPR_ZONE"
 	// OpenTelemetry target URL for OTLP exporter
 	OtlpExporterEndpoint string = "OTEL_EXPORTER_OTLP_ENDPOINT"
 	// OpenTelemetry target URL for OTLP exporter for traces
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+)
+
+// StartActorReminderSpan starts a span for the execution of an actor reminder
+// or timer. Executions are triggered asynchronously, so rather than being a
+// child of the span which scheduled the reminder, the span is linked to it.
+// This allows a trace query to follow the causal chain from an actor
+// invocation to the reminders and timers it scheduled, and on to the calls
+// they make in turn.
+func StartActorReminderSpan(ctx context.Context, spanName string, scheduledBy trace.SpanContext, attributes map[string]string) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindInternal),
+	}
+	if scheduledBy.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{
+			SpanContext: scheduledBy,
+			Attributes: []attribute.KeyValue{
+				attribute.String(diagConsts.DaprActorLinkTypeAttributeKey, diagConsts.DaprActorLinkScheduledBy),
+			},
+		}))
+	}
+
+	//nolint:spancheck
+	ctx, span := tracer.Start(ctx, spanName, opts...)
+	AddAttributesToSpan(span, attributes)
+
+	//nolint:spancheck
+	return ctx, span
+}
@@ -29,6 +29,10 @@ const (
 	BulkGet                  = "bulk_get"
 	BulkDelete               = "bulk_delete"
 	CryptoOp                 = "crypto_op"
+	CryptoSign               = "sign"
+	CryptoVerify             = "verify"
+	CryptoWrapKey            = "wrap_key"
+	CryptoUnwrapKey          = "unwrap_key"
 	JobTriggerOp             = "job_trigger_op"
 )
 
@@ -53,12 +57,23 @@ type componentMetrics struct {
 	stateCount   *stats.Int64Measure
 	stateLatency *stats.Float64Measure
 
+	stateCacheHitCount  *stats.Int64Measure
+	stateCacheMissCount *stats.Int64Measure
+
+	stateEncryptionStaleKeyCount   *stats.Int64Measure
+	stateEncryptionReencryptCount  *stats.Int64Measure
+	stateEncryptionKeyRotatedCount *stats.Int64Measure
+	stateTTLExpiredCount           *stats.Int64Measure
+
 	configurationCount   *stats.Int64Measure
 	configurationLatency *stats.Float64Measure
 
 	secretCount   *stats.Int64Measure
 	secretLatency *stats.Float64Measure
 
+	secretCacheHitCount  *stats.Int64Measure
+	secretCacheMissCount *stats.Int64Measure
+
 	conversationCount   *stats.Int64Measure
 	conversationLatency *stats.Float64Measure
 
@@ -142,6 +157,30 @@ func newComponentMetrics() *componentMetrics {
 			"component/state/latencies",
 			"The latency of the response from the state component.",
 			stats.UnitMilliseconds),
+		stateCacheHitCount: stats.Int64(
+			"component/state/cache/hit_count",
+			"The number of state gets served from the read-through cache of the state component.",
+			stats.UnitDimensionless),
+		stateCacheMissCount: stats.Int64(
+			"component/state/cache/miss_count",
+			"The number of state gets missing the read-through cache of the state component.",
+			stats.UnitDimensionless),
+		stateEncryptionStaleKeyCount: stats.Int64(
+			"component/state/encryption/stale_key_count",
+			"The number of values read from the state component which are encrypted with a key other than the primary encryption key.",
+			stats.UnitDimensionless),
+		stateEncryptionReencryptCount: stats.Int64(
+			"component/state/encryption/reencrypt_count",
+			"The number of values of the state component re-encrypted with the primary encryption key on read.",
+			stats.UnitDimensionless),
+		stateEncryptionKeyRotatedCount: stats.Int64(
+			"component/state/encryption/key_rotated_count",
+			"The number of rotations of the primary encryption key of the state component.",
+			stats.UnitDimensionless),
+		stateTTLExpiredCount: stats.Int64(
+			"component/state/ttl/expired_count",
+			"The number of keys of the state component deleted by the runtime once their emulated TTL expired.",
+			stats.UnitDimensionless),
 		configurationCount: stats.Int64(
 			"component/configuration/count",
 			"The number of operations performed on the configuration component.",
@@ -158,6 +197,14 @@ func newComponentMetrics() *componentMetrics {
 			"component/secret/latencies",
 			"The latency of the response from the secret component.",
 			stats.UnitMilliseconds),
+		secretCacheHitCount: stats.Int64(
+			"component/secret/cache/hit_count",
+			"The number of secret gets served from the cache of the secret component.",
+			stats.UnitDimensionless),
+		secretCacheMissCount: stats.Int64(
+			"component/secret/cache/miss_count",
+			"The number of secret gets missing the cache of the secret component.",
+			stats.UnitDimensionless),
 		conversationCount: stats.Int64(
 			"component/conversation/count",
 			"The number of operations performed on the conversation component.",
@@ -215,10 +262,18 @@ func (c *componentMetrics) Init(meter view.Meter, appID, namespace string, laten
 		diagUtils.NewMeasureView(c.outputBindingCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.stateLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.stateCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateCacheHitCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateCacheMissCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateEncryptionStaleKeyCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateEncryptionReencryptCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateEncryptionKeyRotatedCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
+		diagUtils.NewMeasureView(c.stateTTLExpiredCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.configurationLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.configurationCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.secretLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.secretCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.secretCacheHitCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
+		diagUtils.NewMeasureView(c.secretCacheMissCount, []tag.Key{appIDKey, componentKey, namespaceKey}, view.Count()),
 		diagUtils.NewMeasureView(c.conversationLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.conversationCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.cryptoLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
@@ -384,6 +439,70 @@ func (c *componentMetrics) StateInvoked(ctx context.Context, component, operatio
 	}
 }
 
+// StateCacheAccessed records the metrics for a state get looked up in the
+// read-through cache of a state component.
+func (c *componentMetrics) StateCacheAccessed(ctx context.Context, component string, hit bool) {
+	if c.enabled {
+		measure := c.stateCacheMissCount
+		if hit {
+			measure = c.stateCacheHitCount
+		}
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(measure.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace)...),
+			stats.WithMeasurements(measure.M(1)))
+	}
+}
+
+// StateEncryptionStaleKeyRead records the metrics for a value read from a
+// state component which is encrypted with a key other than the primary key.
+func (c *componentMetrics) StateEncryptionStaleKeyRead(ctx context.Context, component string) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.stateEncryptionStaleKeyCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace)...),
+			stats.WithMeasurements(c.stateEncryptionStaleKeyCount.M(1)))
+	}
+}
+
+// StateEncryptionReencrypted records the metrics for a value of a state
+// component re-encrypted with the primary key on read.
+func (c *componentMetrics) StateEncryptionReencrypted(ctx context.Context, component string, success bool) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.stateEncryptionReencryptCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success))...),
+			stats.WithMeasurements(c.stateEncryptionReencryptCount.M(1)))
+	}
+}
+
+// StateEncryptionKeyRotated records the metrics for a rotation of the
+// primary encryption key of a state component.
+func (c *componentMetrics) StateEncryptionKeyRotated(ctx context.Context, component string) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.stateEncryptionKeyRotatedCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace)...),
+			stats.WithMeasurements(c.stateEncryptionKeyRotatedCount.M(1)))
+	}
+}
+
+// StateTTLExpired records the metrics for a key of a state component deleted
+// by the runtime once its emulated TTL expired.
+func (c *componentMetrics) StateTTLExpired(ctx context.Context, component string, success bool) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.stateTTLExpiredCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, successKey, strconv.FormatBool(success))...),
+			stats.WithMeasurements(c.stateTTLExpiredCount.M(1)))
+	}
+}
+
 // ConfigurationInvoked records the metrics for a configuration event.
 func (c *componentMetrics) ConfigurationInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
@@ -441,6 +560,22 @@ func (c *componentMetrics) SecretInvoked(ctx context.Context, component, operati
 	}
 }
 
+// SecretCacheAccessed records the metrics for a secret get looked up in the
+// cache of a secret component.
+func (c *componentMetrics) SecretCacheAccessed(ctx context.Context, component string, hit bool) {
+	if c.enabled {
+		measure := c.secretCacheMissCount
+		if hit {
+			measure = c.secretCacheHitCount
+		}
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(measure.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace)...),
+			stats.WithMeasurements(measure.M(1)))
+	}
+}
+
 // CryptoInvoked records the metrics for a crypto event.
 func (c *componentMetrics) CryptoInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
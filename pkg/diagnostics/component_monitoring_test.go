@@ -189,6 +189,63 @@ func TestState(t *testing.T) {
 		allTagsPresent(t, v, viewData[0].Tags)
 		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
 	})
+
+	t.Run("record state cache hits and misses", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.StateCacheAccessed(t.Context(), componentName, true)
+		c.StateCacheAccessed(t.Context(), componentName, true)
+		c.StateCacheAccessed(t.Context(), componentName, false)
+
+		viewData, _ := meter.RetrieveData("component/state/cache/hit_count")
+		v := meter.Find("component/state/cache/hit_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("component/state/cache/miss_count")
+		v = meter.Find("component/state/cache/miss_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+	})
+
+	t.Run("record state encryption metrics", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.StateEncryptionStaleKeyRead(t.Context(), componentName)
+		c.StateEncryptionReencrypted(t.Context(), componentName, true)
+		c.StateEncryptionKeyRotated(t.Context(), componentName)
+
+		for _, name := range []string{
+			"component/state/encryption/stale_key_count",
+			"component/state/encryption/reencrypt_count",
+			"component/state/encryption/key_rotated_count",
+		} {
+			viewData, _ := meter.RetrieveData(name)
+			v := meter.Find(name)
+			allTagsPresent(t, v, viewData[0].Tags)
+			assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+		}
+	})
+
+	t.Run("record state ttl expired count", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.StateTTLExpired(t.Context(), componentName, true)
+
+		viewData, _ := meter.RetrieveData("component/state/ttl/expired_count")
+		v := meter.Find("component/state/ttl/expired_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+	})
 }
 
 func TestConfiguration(t *testing.T) {
@@ -246,6 +303,27 @@ func TestSecrets(t *testing.T) {
 		allTagsPresent(t, v, viewData[0].Tags)
 		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
 	})
+
+	t.Run("record secret cache hits and misses", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+
+		c.SecretCacheAccessed(t.Context(), componentName, true)
+		c.SecretCacheAccessed(t.Context(), componentName, false)
+		c.SecretCacheAccessed(t.Context(), componentName, false)
+
+		viewData, _ := meter.RetrieveData("component/secret/cache/hit_count")
+		v := meter.Find("component/secret/cache/hit_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("component/secret/cache/miss_count")
+		v = meter.Find("component/secret/cache/miss_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+	})
 }
 
 func TestConversation(t *testing.T) {
@@ -41,6 +41,17 @@ const (
 	DaprAPIProtocolSpanAttributeKey   = "dapr.protocol"
 	DaprAPIInvokeMethod               = "dapr.invoke_method"
 	DaprAPIActorTypeID                = "dapr.actor"
+	DaprAPIJobNames                   = "dapr.job.names"
+	DaprAPIWorkflowComponent          = "dapr.workflow.component"
+	DaprAPIWorkflowName               = "dapr.workflow.name"
+	DaprAPIWorkflowRuntimeStatuses    = "dapr.workflow.runtime_statuses"
+
+	// DaprActorLinkTypeAttributeKey is the span link attribute describing the
+	// causal relationship between an actor span and the linked span.
+	DaprActorLinkTypeAttributeKey = "dapr.actor.link_type"
+	// DaprActorLinkScheduledBy links a reminder or timer execution to the span
+	// which scheduled it.
+	DaprActorLinkScheduledBy = "scheduled_by"
 
 	OtelSpanConvHTTPRequestMethodAttributeKey = "http.request.method"
 	OtelSpanConvServerAddressAttributeKey     = "server.address"
@@ -42,6 +42,7 @@ const (
 	daprInvokeServiceMethod   = "/dapr.proto.runtime.v1.Dapr/InvokeService"
 	daprCallLocalStreamMethod = "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream"
 	daprWorkflowPrefix        = "/TaskHubSidecarService"
+	grpcReflectionPrefix      = "/grpc.reflection."
 )
 
 // handleBaggage extracts baggage from the incoming metadata and forwards that along as metadata,
@@ -179,6 +180,10 @@ func GRPCTraceStreamServerInterceptor(appID string, spec config.TracingSpec) grp
 		case strings.HasPrefix(info.FullMethod, daprWorkflowPrefix):
 			spanKind = trace.WithSpanKind(trace.SpanKindServer)
 
+		// For the gRPC reflection service of the API server, this generates ServerSpan
+		case strings.HasPrefix(info.FullMethod, grpcReflectionPrefix):
+			spanKind = trace.WithSpanKind(trace.SpanKindServer)
+
 		// For proxied requests, this generates a span depending on whether this is the server (target) or client
 		default:
 			isProxied = true
@@ -15,6 +15,7 @@ var (
 	CircuitBreakerPolicy PolicyType = "circuitbreaker"
 	RetryPolicy          PolicyType = "retry"
 	TimeoutPolicy        PolicyType = "timeout"
+	HedgingPolicy        PolicyType = "hedging"
 
 	OutboundPolicyFlowDirection PolicyFlowDirection = "outbound"
 	InboundPolicyFlowDirection  PolicyFlowDirection = "inbound"
@@ -32,10 +33,12 @@ type PolicyType string
 type PolicyFlowDirection string
 
 type resiliencyMetrics struct {
-	policiesLoadCount   *stats.Int64Measure
-	executionCount      *stats.Int64Measure
-	activationsCount    *stats.Int64Measure
-	circuitbreakerState *stats.Int64Measure
+	policiesLoadCount        *stats.Int64Measure
+	executionCount           *stats.Int64Measure
+	activationsCount         *stats.Int64Measure
+	circuitbreakerState      *stats.Int64Measure
+	retryBudgetRejectedCount *stats.Int64Measure
+	dryRunActivationsCount   *stats.Int64Measure
 
 	appID   string
 	ctx     context.Context
@@ -61,6 +64,14 @@ func newResiliencyMetrics() *resiliencyMetrics {
 			"resiliency/cb_state",
 			"A resiliency policy's current CircuitBreakerState state. 0 is closed, 1 is half-open, 2 is open, and -1 is unknown.",
 			stats.UnitDimensionless),
+		retryBudgetRejectedCount: stats.Int64(
+			"resiliency/retry_budget_rejected_total",
+			"Number of retries which were not attempted because the retry budget of the target was exhausted.",
+			stats.UnitDimensionless),
+		dryRunActivationsCount: stats.Int64(
+			"resiliency/dry_run_activations_total",
+			"Number of times a resiliency policy in dry-run mode would have been activated, such as retrying an operation or rejecting it with an open circuit breaker.",
+			stats.UnitDimensionless),
 		// TODO: how to use correct context
 		ctx:     context.Background(),
 		enabled: false,
@@ -78,6 +89,8 @@ func (m *resiliencyMetrics) Init(meter view.Meter, id string) error {
 		diagUtils.NewMeasureView(m.executionCount, []tag.Key{appIDKey, resiliencyNameKey, policyKey, namespaceKey, flowDirectionKey, targetKey, statusKey}, view.Count()),
 		diagUtils.NewMeasureView(m.activationsCount, []tag.Key{appIDKey, resiliencyNameKey, policyKey, namespaceKey, flowDirectionKey, targetKey, statusKey}, view.Count()),
 		diagUtils.NewMeasureView(m.circuitbreakerState, []tag.Key{appIDKey, resiliencyNameKey, policyKey, namespaceKey, flowDirectionKey, targetKey, statusKey}, view.LastValue()),
+		diagUtils.NewMeasureView(m.retryBudgetRejectedCount, []tag.Key{appIDKey, resiliencyNameKey, namespaceKey, flowDirectionKey, targetKey}, view.Count()),
+		diagUtils.NewMeasureView(m.dryRunActivationsCount, []tag.Key{appIDKey, resiliencyNameKey, policyKey, namespaceKey, flowDirectionKey, targetKey}, view.Count()),
 	)
 }
 
@@ -185,6 +198,32 @@ func (m *resiliencyMetrics) PolicyWithStatusActivated(resiliencyName, namespace
 	}
 }
 
+// RetryBudgetRejected records metric when a retry is rejected because the retry budget of the target is exhausted.
+func (m *resiliencyMetrics) RetryBudgetRejected(resiliencyName, namespace string, flowDirection PolicyFlowDirection, target string) {
+	if m.enabled {
+		_ = stats.RecordWithOptions(
+			m.ctx,
+			stats.WithRecorder(m.meter),
+			stats.WithTags(diagUtils.WithTags(m.retryBudgetRejectedCount.Name(), appIDKey, m.appID, resiliencyNameKey, resiliencyName,
+				namespaceKey, namespace, flowDirectionKey, string(flowDirection), targetKey, target)...),
+			stats.WithMeasurements(m.retryBudgetRejectedCount.M(1)),
+		)
+	}
+}
+
+// DryRunPolicyActivated records metric when a policy in dry-run mode would have been activated.
+func (m *resiliencyMetrics) DryRunPolicyActivated(resiliencyName, namespace string, policy PolicyType, flowDirection PolicyFlowDirection, target string) {
+	if m.enabled {
+		_ = stats.RecordWithOptions(
+			m.ctx,
+			stats.WithRecorder(m.meter),
+			stats.WithTags(diagUtils.WithTags(m.dryRunActivationsCount.Name(), appIDKey, m.appID, resiliencyNameKey, resiliencyName, policyKey, string(policy),
+				namespaceKey, namespace, flowDirectionKey, string(flowDirection), targetKey, target)...),
+			stats.WithMeasurements(m.dryRunActivationsCount.M(1)),
+		)
+	}
+}
+
 func ResiliencyActorTarget(actorType string) string {
 	return "actor_" + actorType
 }
@@ -23,6 +23,8 @@ const (
 	resiliencyActivationViewName = "resiliency/activations_total"
 	resiliencyCBStateViewName    = "resiliency/cb_state"
 	resiliencyLoadedViewName     = "resiliency/loaded"
+	resiliencyBudgetViewName     = "resiliency/retry_budget_rejected_total"
+	resiliencyDryRunViewName     = "resiliency/dry_run_activations_total"
 	testAppID                    = "fakeID"
 	testResiliencyName           = "testResiliency"
 	testResiliencyNamespace      = "testNamespace"
@@ -576,6 +578,113 @@ func TestResiliencyLoadedMonitoring(t *testing.T) {
 	})
 }
 
+func TestResiliencyRetryBudgetRejectedMonitoring(t *testing.T) {
+	meter := view.NewMeter()
+	meter.Start()
+	t.Cleanup(func() {
+		meter.Stop()
+	})
+	require.NoError(t, diag.DefaultResiliencyMonitoring.Init(meter, testAppID))
+
+	r := resiliency.FromConfigurations(logger.NewLogger("fake-logger"), &resiliencyV1alpha.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testResiliencyName,
+			Namespace: testResiliencyNamespace,
+		},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Retries: map[string]resiliencyV1alpha.Retry{
+					"testRetry": {
+						Policy:     "constant",
+						Duration:   "10ms",
+						MaxRetries: new(3),
+						Budget: &resiliencyV1alpha.RetryBudget{
+							Ratio:      "0",
+							MinRetries: new(0),
+						},
+					},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					testStateStoreName: {
+						Outbound: resiliencyV1alpha.PolicyNames{Retry: "testRetry"},
+					},
+				},
+			},
+		},
+	})
+
+	var calls int
+	policyRunner := resiliency.NewRunner[any](t.Context(), r.ComponentOutboundPolicy(testStateStoreName, resiliency.Statestore))
+	_, err := policyRunner(func(ctx context.Context) (any, error) {
+		calls++
+		return nil, errors.New("fake error")
+	})
+	require.EqualError(t, err, "fake error")
+	require.Equal(t, 1, calls)
+
+	rows, err := meter.RetrieveData(resiliencyBudgetViewName)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, int64(1), rows[0].Data.(*view.CountData).Value)
+	diag.RequireTagExist(t, rows, diag.NewTag("app_id", testAppID))
+	diag.RequireTagExist(t, rows, diag.NewTag("flow_direction", string(diag.OutboundPolicyFlowDirection)))
+	diag.RequireTagExist(t, rows, diag.NewTag("target", diag.ResiliencyComponentTarget(testStateStoreName, string(resiliency.Statestore))))
+}
+
+func TestResiliencyDryRunMonitoring(t *testing.T) {
+	meter := view.NewMeter()
+	meter.Start()
+	t.Cleanup(func() {
+		meter.Stop()
+	})
+	require.NoError(t, diag.DefaultResiliencyMonitoring.Init(meter, testAppID))
+
+	r := resiliency.FromConfigurations(logger.NewLogger("fake-logger"), &resiliencyV1alpha.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testResiliencyName,
+			Namespace: testResiliencyNamespace,
+		},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			DryRun: true,
+			Policies: resiliencyV1alpha.Policies{
+				Retries: map[string]resiliencyV1alpha.Retry{
+					"testRetry": {
+						Policy:     "constant",
+						Duration:   "10ms",
+						MaxRetries: new(3),
+					},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					testStateStoreName: {
+						Outbound: resiliencyV1alpha.PolicyNames{Retry: "testRetry"},
+					},
+				},
+			},
+		},
+	})
+
+	var calls int
+	policyRunner := resiliency.NewRunner[any](t.Context(), r.ComponentOutboundPolicy(testStateStoreName, resiliency.Statestore))
+	_, err := policyRunner(func(ctx context.Context) (any, error) {
+		calls++
+		return nil, errors.New("fake error")
+	})
+	require.EqualError(t, err, "fake error")
+	require.Equal(t, 1, calls)
+
+	rows, err := meter.RetrieveData(resiliencyDryRunViewName)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, int64(1), rows[0].Data.(*view.CountData).Value)
+	diag.RequireTagExist(t, rows, diag.NewTag("app_id", testAppID))
+	diag.RequireTagExist(t, rows, diag.NewTag(diag.PolicyKey.Name(), string(diag.RetryPolicy)))
+	diag.RequireTagExist(t, rows, diag.NewTag("target", diag.ResiliencyComponentTarget(testStateStoreName, string(resiliency.Statestore))))
+}
+
 func newTestDefaultResiliencyConfig(resiliencyName, resiliencyNamespace string) *resiliencyV1alpha.Resiliency {
 	return &resiliencyV1alpha.Resiliency{
 		ObjectMeta: metav1.ObjectMeta{
@@ -33,6 +33,8 @@ var (
 	targetKey           = tag.MustNewKey("target")
 	typeKey             = tag.MustNewKey("type")
 	categoryKey         = tag.MustNewKey("category")
+	addressKey          = tag.MustNewKey("address")
+	trustAnchorKey      = tag.MustNewKey("trust_anchor")
 )
 
 const (
@@ -40,6 +42,16 @@ const (
 	typeStreaming = "streaming"
 )
 
+const (
+	// maxTrustAnchorLabels bounds the number of distinct values of the
+	// trust_anchor label, as the trust anchors change with every rotation.
+	maxTrustAnchorLabels = 8
+
+	// otherTrustAnchor is the trust_anchor label of the trust anchors seen
+	// once the bound of distinct values is reached.
+	otherTrustAnchor = "other"
+)
+
 // serviceMetrics holds dapr runtime metric monitoring methods.
 type serviceMetrics struct {
 	// component metrics
@@ -47,11 +59,15 @@ type serviceMetrics struct {
 	componentInitCompleted *stats.Int64Measure
 	componentInitFailed    *stats.Int64Measure
 
+	// Hot reload metrics
+	resourceStreamGap *stats.Int64Measure
+
 	// mTLS metrics
 	mtlsInitCompleted             *stats.Int64Measure
 	mtlsInitFailed                *stats.Int64Measure
 	mtlsWorkloadCertRotated       *stats.Int64Measure
 	mtlsWorkloadCertRotatedFailed *stats.Int64Measure
+	mtlsPeerTrustAnchor           *stats.Int64Measure
 
 	// Actor metrics
 	actorStatusReportTotal       *stats.Int64Measure
@@ -82,12 +98,21 @@ type serviceMetrics struct {
 	serviceInvocationResponseSentTotal       *stats.Int64Measure
 	serviceInvocationResponseReceivedTotal   *stats.Int64Measure
 	serviceInvocationResponseReceivedLatency *stats.Float64Measure
+	serviceInvocationResponseCacheHitCount   *stats.Int64Measure
+	serviceInvocationResponseCacheMissCount  *stats.Int64Measure
+	serviceInvocationConnPoolConnections     *stats.Int64Measure
+	serviceInvocationConnPoolStreams         *stats.Int64Measure
+
+	// App channel metrics
+	appChannelConcurrencyLimit *stats.Int64Measure
 
 	appID                 string
 	ctx                   context.Context
 	enabled               bool
 	pendingActorCalls     map[string]int32
 	pendingActorCallsLock sync.Mutex
+	trustAnchors          map[string]struct{}
+	trustAnchorsLock      sync.Mutex
 	meter                 stats.Recorder
 }
 
@@ -108,6 +133,12 @@ func newServiceMetrics() *serviceMetrics {
 			"The number of component initialization failures.",
 			stats.UnitDimensionless),
 
+		// Hot reload
+		resourceStreamGap: stats.Int64(
+			"runtime/hotreload/stream_gap_total",
+			"The number of gaps detected in the streams of resource updates from the operator.",
+			stats.UnitDimensionless),
+
 		// mTLS
 		mtlsInitCompleted: stats.Int64(
 			"runtime/mtls/init_total",
@@ -125,6 +156,10 @@ func newServiceMetrics() *serviceMetrics {
 			"runtime/mtls/workload_cert_rotated_fail_total",
 			"The number of the failed workload certificate rotations.",
 			stats.UnitDimensionless),
+		mtlsPeerTrustAnchor: stats.Int64(
+			"runtime/mtls/peer_trust_anchor_total",
+			"The number of the mTLS peers authorized per trust anchor their certificate chains to.",
+			stats.UnitDimensionless),
 
 		// Actor
 		actorStatusReportTotal: stats.Int64(
@@ -221,6 +256,27 @@ func newServiceMetrics() *serviceMetrics {
 			"runtime/service_invocation/res_recv_latency_ms",
 			"The latency of service invocation response.",
 			stats.UnitMilliseconds),
+		serviceInvocationResponseCacheHitCount: stats.Int64(
+			"runtime/service_invocation/res_cache/hit_count",
+			"The number of service invocations served from the response cache.",
+			stats.UnitDimensionless),
+		serviceInvocationResponseCacheMissCount: stats.Int64(
+			"runtime/service_invocation/res_cache/miss_count",
+			"The number of service invocations missing the response cache.",
+			stats.UnitDimensionless),
+		serviceInvocationConnPoolConnections: stats.Int64(
+			"runtime/service_invocation/conn_pool/connections",
+			"The number of pooled gRPC connections to the address of a target sidecar.",
+			stats.UnitDimensionless),
+		serviceInvocationConnPoolStreams: stats.Int64(
+			"runtime/service_invocation/conn_pool/active_streams",
+			"The number of calls in flight on the pooled gRPC connections to the address of a target sidecar.",
+			stats.UnitDimensionless),
+
+		appChannelConcurrencyLimit: stats.Int64(
+			"runtime/app_channel/concurrency_limit",
+			"The adaptive limit of the requests in flight toward the app.",
+			stats.UnitDimensionless),
 
 		// TODO: use the correct context for each request
 		ctx:               context.Background(),
@@ -240,10 +296,13 @@ func (s *serviceMetrics) Init(meter view.Meter, appID string, latencyDistributio
 		diagUtils.NewMeasureView(s.componentInitCompleted, []tag.Key{appIDKey, componentKey}, view.Count()),
 		diagUtils.NewMeasureView(s.componentInitFailed, []tag.Key{appIDKey, componentKey, failReasonKey, componentNameKey}, view.Count()),
 
+		diagUtils.NewMeasureView(s.resourceStreamGap, []tag.Key{appIDKey, typeKey}, view.Count()),
+
 		diagUtils.NewMeasureView(s.mtlsInitCompleted, []tag.Key{appIDKey}, view.Count()),
 		diagUtils.NewMeasureView(s.mtlsInitFailed, []tag.Key{appIDKey, failReasonKey}, view.Count()),
 		diagUtils.NewMeasureView(s.mtlsWorkloadCertRotated, []tag.Key{appIDKey}, view.Count()),
 		diagUtils.NewMeasureView(s.mtlsWorkloadCertRotatedFailed, []tag.Key{appIDKey, failReasonKey}, view.Count()),
+		diagUtils.NewMeasureView(s.mtlsPeerTrustAnchor, []tag.Key{appIDKey, trustAnchorKey}, view.Count()),
 
 		diagUtils.NewMeasureView(s.actorStatusReportTotal, []tag.Key{appIDKey, actorTypeKey, operationKey}, view.Count()),
 		diagUtils.NewMeasureView(s.actorStatusReportFailedTotal, []tag.Key{appIDKey, actorTypeKey, operationKey, failReasonKey}, view.Count()),
@@ -270,6 +329,12 @@ func (s *serviceMetrics) Init(meter view.Meter, appID string, latencyDistributio
 		diagUtils.NewMeasureView(s.serviceInvocationResponseSentTotal, []tag.Key{appIDKey, destinationAppIDKey, statusKey}, view.Count()),
 		diagUtils.NewMeasureView(s.serviceInvocationResponseReceivedTotal, []tag.Key{appIDKey, sourceAppIDKey, statusKey, typeKey}, view.Count()),
 		diagUtils.NewMeasureView(s.serviceInvocationResponseReceivedLatency, []tag.Key{appIDKey, sourceAppIDKey, statusKey}, latencyDistribution),
+		diagUtils.NewMeasureView(s.serviceInvocationResponseCacheHitCount, []tag.Key{appIDKey, destinationAppIDKey}, view.Count()),
+		diagUtils.NewMeasureView(s.serviceInvocationResponseCacheMissCount, []tag.Key{appIDKey, destinationAppIDKey}, view.Count()),
+		diagUtils.NewMeasureView(s.serviceInvocationConnPoolConnections, []tag.Key{appIDKey, addressKey}, view.LastValue()),
+		diagUtils.NewMeasureView(s.serviceInvocationConnPoolStreams, []tag.Key{appIDKey, addressKey}, view.LastValue()),
+
+		diagUtils.NewMeasureView(s.appChannelConcurrencyLimit, []tag.Key{appIDKey}, view.LastValue()),
 	)
 }
 
@@ -305,6 +370,18 @@ func (s *serviceMetrics) ComponentInitFailed(component string, reason string, na
 	}
 }
 
+// ResourceStreamGapDetected records metric when events of the kind of resource
+// were missed on the stream of updates from the operator.
+func (s *serviceMetrics) ResourceStreamGapDetected(kind string) {
+	if s.enabled {
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(s.resourceStreamGap.Name(), appIDKey, s.appID, typeKey, kind)...),
+			stats.WithMeasurements(s.resourceStreamGap.M(1)))
+	}
+}
+
 // MTLSInitCompleted records metric when component is initialized.
 func (s *serviceMetrics) MTLSInitCompleted() {
 	if s.enabled {
@@ -341,6 +418,37 @@ func (s *serviceMetrics) MTLSWorkLoadCertRotationFailed(reason string) {
 	}
 }
 
+// MTLSPeerTrustAnchor records metric when a mTLS peer presenting a
+// certificate chaining to the given trust anchor is authorized. Trust anchors
+// seen after the first maxTrustAnchorLabels are recorded as "other".
+func (s *serviceMetrics) MTLSPeerTrustAnchor(trustAnchor string) {
+	if s.enabled {
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(s.mtlsPeerTrustAnchor.Name(), appIDKey, s.appID, trustAnchorKey, s.trustAnchorLabel(trustAnchor))...),
+			stats.WithMeasurements(s.mtlsPeerTrustAnchor.M(1)))
+	}
+}
+
+// trustAnchorLabel returns the trust_anchor label of the trust anchor.
+func (s *serviceMetrics) trustAnchorLabel(trustAnchor string) string {
+	s.trustAnchorsLock.Lock()
+	defer s.trustAnchorsLock.Unlock()
+
+	if _, ok := s.trustAnchors[trustAnchor]; ok {
+		return trustAnchor
+	}
+	if len(s.trustAnchors) >= maxTrustAnchorLabels {
+		return otherTrustAnchor
+	}
+	if s.trustAnchors == nil {
+		s.trustAnchors = make(map[string]struct{})
+	}
+	s.trustAnchors[trustAnchor] = struct{}{}
+	return trustAnchor
+}
+
 // ActorStatusReported records metrics when status is reported to placement service.
 func (s *serviceMetrics) ActorStatusReported(operation string) {
 	if s.enabled {
@@ -588,6 +696,25 @@ func (s *serviceMetrics) ServiceInvocationStreamingRequestSent(destinationAppID
 	}
 }
 
+// ServiceInvocationResponseCacheAccessed records the metrics for a service
+// invocation looked up in the response cache.
+func (s *serviceMetrics) ServiceInvocationResponseCacheAccessed(destinationAppID string, hit bool) {
+	if s.enabled {
+		measure := s.serviceInvocationResponseCacheMissCount
+		if hit {
+			measure = s.serviceInvocationResponseCacheHitCount
+		}
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(
+				measure.Name(),
+				appIDKey, s.appID,
+				destinationAppIDKey, destinationAppID)...),
+			stats.WithMeasurements(measure.M(1)))
+	}
+}
+
 // ServiceInvocationRequestReceived records the number of service invocation requests received.
 func (s *serviceMetrics) ServiceInvocationRequestReceived(sourceAppID string) {
 	if s.enabled {
@@ -661,3 +788,32 @@ func (s *serviceMetrics) ServiceInvocationStreamingResponseReceived(sourceAppID
 			stats.WithMeasurements(s.serviceInvocationResponseReceivedTotal.M(1)))
 	}
 }
+
+// ServiceInvocationConnectionPool records the number of pooled connections to
+// the address of a target sidecar and the calls in flight on them.
+func (s *serviceMetrics) ServiceInvocationConnectionPool(address string, connections int, streams int) {
+	if s.enabled {
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(s.serviceInvocationConnPoolConnections.Name(), appIDKey, s.appID, addressKey, address)...),
+			stats.WithMeasurements(s.serviceInvocationConnPoolConnections.M(int64(connections))))
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(s.serviceInvocationConnPoolStreams.Name(), appIDKey, s.appID, addressKey, address)...),
+			stats.WithMeasurements(s.serviceInvocationConnPoolStreams.M(int64(streams))))
+	}
+}
+
+// AppChannelConcurrencyLimit records the adaptive limit of the requests in
+// flight toward the app.
+func (s *serviceMetrics) AppChannelConcurrencyLimit(limit int) {
+	if s.enabled {
+		stats.RecordWithOptions(
+			s.ctx,
+			stats.WithRecorder(s.meter),
+			stats.WithTags(diagUtils.WithTags(s.appChannelConcurrencyLimit.Name(), appIDKey, s.appID)...),
+			stats.WithMeasurements(s.appChannelConcurrencyLimit.M(int64(limit))))
+	}
+}
@@ -1,10 +1,12 @@
 package diagnostics
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
 
 	"github.com/dapr/dapr/pkg/config"
@@ -86,6 +88,92 @@ func TestServiceInvocation(t *testing.T) {
 
 		allTagsPresent(t, v2, viewData2[0].Tags)
 	})
+
+	t.Run("record service invocation response cache accessed", func(t *testing.T) {
+		s, meter := servicesMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		s.ServiceInvocationResponseCacheAccessed("testAppId2", true)
+		s.ServiceInvocationResponseCacheAccessed("testAppId2", true)
+		s.ServiceInvocationResponseCacheAccessed("testAppId2", false)
+
+		viewData, _ := meter.RetrieveData("runtime/service_invocation/res_cache/hit_count")
+		v := meter.Find("runtime/service_invocation/res_cache/hit_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+
+		viewData, _ = meter.RetrieveData("runtime/service_invocation/res_cache/miss_count")
+		v = meter.Find("runtime/service_invocation/res_cache/miss_count")
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+	})
+}
+
+func TestMTLSPeerTrustAnchor(t *testing.T) {
+	s, meter := servicesMetrics()
+	t.Cleanup(func() { meter.Stop() })
+
+	s.MTLSPeerTrustAnchor("old-root")
+	s.MTLSPeerTrustAnchor("new-root")
+	s.MTLSPeerTrustAnchor("new-root")
+
+	viewData, _ := meter.RetrieveData("runtime/mtls/peer_trust_anchor_total")
+	v := meter.Find("runtime/mtls/peer_trust_anchor_total")
+	require.Len(t, viewData, 2)
+	allTagsPresent(t, v, viewData[0].Tags)
+	RequireTagExist(t, viewData, NewTag(trustAnchorKey.Name(), "old-root"))
+	RequireTagExist(t, viewData, NewTag(trustAnchorKey.Name(), "new-root"))
+
+	var total int64
+	for _, row := range viewData {
+		total += row.Data.(*view.CountData).Value
+	}
+	assert.Equal(t, int64(3), total)
+}
+
+func TestResourceStreamGapDetected(t *testing.T) {
+	s, meter := servicesMetrics()
+	t.Cleanup(func() { meter.Stop() })
+
+	s.ResourceStreamGapDetected("Component")
+	s.ResourceStreamGapDetected("Component")
+
+	viewData, _ := meter.RetrieveData("runtime/hotreload/stream_gap_total")
+	v := meter.Find("runtime/hotreload/stream_gap_total")
+	require.Len(t, viewData, 1)
+	allTagsPresent(t, v, viewData[0].Tags)
+	RequireTagExist(t, viewData, NewTag(typeKey.Name(), "Component"))
+	assert.Equal(t, int64(2), viewData[0].Data.(*view.CountData).Value)
+}
+
+func TestAppChannelConcurrencyLimit(t *testing.T) {
+	s, meter := servicesMetrics()
+	t.Cleanup(func() { meter.Stop() })
+
+	s.AppChannelConcurrencyLimit(20)
+	s.AppChannelConcurrencyLimit(18)
+
+	viewData, _ := meter.RetrieveData("runtime/app_channel/concurrency_limit")
+	v := meter.Find("runtime/app_channel/concurrency_limit")
+	require.Len(t, viewData, 1)
+	allTagsPresent(t, v, viewData[0].Tags)
+	assert.InEpsilon(t, float64(18), viewData[0].Data.(*view.LastValueData).Value, 0)
+}
+
+func TestMTLSPeerTrustAnchorBound(t *testing.T) {
+	s, meter := servicesMetrics()
+	t.Cleanup(func() { meter.Stop() })
+
+	for i := range maxTrustAnchorLabels + 2 {
+		s.MTLSPeerTrustAnchor(fmt.Sprintf("root-%d", i))
+	}
+	s.MTLSPeerTrustAnchor("root-0")
+
+	viewData, _ := meter.RetrieveData("runtime/mtls/peer_trust_anchor_total")
+	require.Len(t, viewData, maxTrustAnchorLabels+1)
+	RequireTagExist(t, viewData, NewTag(trustAnchorKey.Name(), "root-0"))
+	RequireTagExist(t, viewData, NewTag(trustAnchorKey.Name(), otherTrustAnchor))
+	RequireTagNotExist(t, viewData, NewTag(trustAnchorKey.Name(), fmt.Sprintf("root-%d", maxTrustAnchorLabels)))
 }
 
 func TestSerivceMonitoringInit(t *testing.T) {
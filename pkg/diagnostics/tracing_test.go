@@ -262,6 +262,44 @@ func TestOtelConventionStrings(t *testing.T) {
 }
 
 // Otel Fake Exporter implements an open telemetry span exporter that does nothing.
+func TestStartActorReminderSpan(t *testing.T) {
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+	)
+	defer func() { _ = tp.Shutdown(t.Context()) }()
+	otel.SetTracerProvider(tp)
+
+	scheduledBy := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{75, 249, 47, 53, 119, 179, 77, 166, 163, 206, 146, 157, 14, 14, 71, 54},
+		SpanID:     trace.SpanID{0, 240, 103, 170, 11, 169, 2, 183},
+		TraceFlags: trace.TraceFlags(1),
+	})
+
+	t.Run("links to the scheduling span", func(t *testing.T) {
+		_, span := StartActorReminderSpan(t.Context(), "CallActorReminder/mytype", scheduledBy, map[string]string{
+			diagConsts.DaprAPIActorTypeID: "mytype.myid",
+		})
+		defer span.End()
+
+		ro, ok := span.(sdktrace.ReadOnlySpan)
+		require.True(t, ok)
+		assert.Equal(t, "CallActorReminder/mytype", ro.Name())
+		assert.NotEqual(t, scheduledBy.TraceID(), ro.SpanContext().TraceID())
+		require.Len(t, ro.Links(), 1)
+		assert.True(t, ro.Links()[0].SpanContext.Equal(scheduledBy))
+		assert.Equal(t, diagConsts.DaprActorLinkScheduledBy, ro.Links()[0].Attributes[0].Value.AsString())
+	})
+
+	t.Run("no link when scheduling span is unknown", func(t *testing.T) {
+		_, span := StartActorReminderSpan(t.Context(), "CallActorTimer/mytype", trace.SpanContext{}, nil)
+		defer span.End()
+
+		ro, ok := span.(sdktrace.ReadOnlySpan)
+		require.True(t, ok)
+		assert.Empty(t, ro.Links())
+	})
+}
+
 type otelFakeExporter struct{}
 
 // newOtelFakeExporter returns an Open Telemetry Fake Span Exporter
@@ -15,6 +15,7 @@ package diagnostics
 
 import (
 	"context"
+	"strconv"
 
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
@@ -29,6 +30,7 @@ var (
 	attestationKindKey   = tag.MustNewKey("attestation_kind")
 	attestationResultKey = tag.MustNewKey("attestation_result")
 	certCacheOutcomeKey  = tag.MustNewKey("cert_cache_outcome")
+	partitionKey         = tag.MustNewKey("partition")
 )
 
 const (
@@ -110,10 +112,17 @@ type workflowMetrics struct {
 	// the configured gRPC max body size. Same headroom intent as
 	// workflowPayloadSizeRatio.
 	activityPayloadSizeRatio *stats.Float64Measure
-	appID                    string
-	enabled                  bool
-	namespace                string
-	meter                    stats.Recorder
+	// workflowPartitionBacklog records the number of workflow work items
+	// waiting to be dispatched to the engine, per workflow partition.
+	workflowPartitionBacklog *stats.Int64Measure
+	// workflowRetentionPurgedCount records count of workflow instances purged
+	// by the state retention policy, tagged by workflow name and the terminal
+	// status of the instance.
+	workflowRetentionPurgedCount *stats.Int64Measure
+	appID                        string
+	enabled                      bool
+	namespace                    string
+	meter                        stats.Recorder
 }
 
 func newWorkflowMetrics() *workflowMetrics {
@@ -178,6 +187,14 @@ func newWorkflowMetrics() *workflowMetrics {
 			"runtime/workflow/activity/payload/size_ratio",
 			"Activity payload size as a fraction of the configured gRPC max body size; values >=0.95 trip the stall, values >1 exceed the limit.",
 			stats.UnitDimensionless),
+		workflowPartitionBacklog: stats.Int64(
+			"runtime/workflow/partition/backlog",
+			"The number of workflow work items waiting to be dispatched, per workflow partition.",
+			stats.UnitDimensionless),
+		workflowRetentionPurgedCount: stats.Int64(
+			"runtime/workflow/retention/purged/count",
+			"The number of workflow instances purged by the state retention policy.",
+			stats.UnitDimensionless),
 	}
 }
 
@@ -207,7 +224,9 @@ func (w *workflowMetrics) Init(meter view.Meter, appID, namespace string, latenc
 		diagUtils.NewMeasureView(w.attestationVerifyLatency, []tag.Key{appIDKey, namespaceKey, attestationKindKey, attestationResultKey}, latencyDistribution),
 		diagUtils.NewMeasureView(w.attestationCertCacheCount, []tag.Key{appIDKey, namespaceKey, certCacheOutcomeKey}, view.Count()),
 		diagUtils.NewMeasureView(w.workflowPayloadSizeRatio, []tag.Key{appIDKey, namespaceKey, workflowNameKey}, payloadRatioDistribution),
-		diagUtils.NewMeasureView(w.activityPayloadSizeRatio, []tag.Key{appIDKey, namespaceKey, workflowNameKey, activityNameKey}, payloadRatioDistribution))
+		diagUtils.NewMeasureView(w.activityPayloadSizeRatio, []tag.Key{appIDKey, namespaceKey, workflowNameKey, activityNameKey}, payloadRatioDistribution),
+		diagUtils.NewMeasureView(w.workflowPartitionBacklog, []tag.Key{appIDKey, namespaceKey, partitionKey}, view.LastValue()),
+		diagUtils.NewMeasureView(w.workflowRetentionPurgedCount, []tag.Key{appIDKey, namespaceKey, workflowNameKey, statusKey}, view.Count()))
 }
 
 // WorkflowOperationEvent records total number of Successful/Failed workflow Operations requests. It also records latency for those requests.
@@ -347,3 +366,27 @@ func (w *workflowMetrics) ActivityPayloadSizeRatio(ctx context.Context, workflow
 		stats.WithTags(diagUtils.WithTags(w.activityPayloadSizeRatio.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName, activityNameKey, activityName)...),
 		stats.WithMeasurements(w.activityPayloadSizeRatio.M(ratio)))
 }
+
+// WorkflowPartitionBacklog records the number of workflow work items waiting
+// to be dispatched from the given workflow partition.
+func (w *workflowMetrics) WorkflowPartitionBacklog(ctx context.Context, partition, backlog int) {
+	if !w.IsEnabled() {
+		return
+	}
+	stats.RecordWithOptions(ctx,
+		stats.WithRecorder(w.meter),
+		stats.WithTags(diagUtils.WithTags(w.workflowPartitionBacklog.Name(), appIDKey, w.appID, namespaceKey, w.namespace, partitionKey, strconv.Itoa(partition))...),
+		stats.WithMeasurements(w.workflowPartitionBacklog.M(int64(backlog))))
+}
+
+// WorkflowRetentionPurged records a workflow instance purged by the state
+// retention policy, with the status it terminated with.
+func (w *workflowMetrics) WorkflowRetentionPurged(ctx context.Context, workflowName, status string) {
+	if !w.IsEnabled() {
+		return
+	}
+	stats.RecordWithOptions(ctx,
+		stats.WithRecorder(w.meter),
+		stats.WithTags(diagUtils.WithTags(w.workflowRetentionPurgedCount.Name(), appIDKey, w.appID, namespaceKey, w.namespace, workflowNameKey, workflowName, statusKey, status)...),
+		stats.WithMeasurements(w.workflowRetentionPurgedCount.M(1)))
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
 
 	"github.com/dapr/dapr/pkg/config"
@@ -373,4 +374,34 @@ func TestExecution(t *testing.T) {
 			assert.InEpsilon(t, float64(10), viewData[0].Data.(*view.DistributionData).Min, 0)
 		})
 	})
+	t.Run("record workflow partition backlog", func(t *testing.T) {
+		metricName := "runtime/workflow/partition/backlog"
+		w, meter := initWorkflowMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		w.WorkflowPartitionBacklog(t.Context(), 3, 5)
+		w.WorkflowPartitionBacklog(t.Context(), 3, 2)
+
+		viewData, _ := meter.RetrieveData(metricName)
+		v := meter.Find(metricName)
+
+		require.Len(t, viewData, 1)
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.InEpsilon(t, float64(2), viewData[0].Data.(*view.LastValueData).Value, 0)
+	})
+	t.Run("record workflow retention purges", func(t *testing.T) {
+		metricName := "runtime/workflow/retention/purged/count"
+		w, meter := initWorkflowMetrics()
+		t.Cleanup(func() { meter.Stop() })
+
+		w.WorkflowRetentionPurged(t.Context(), "wf", StatusSuccess)
+		w.WorkflowRetentionPurged(t.Context(), "wf", StatusSuccess)
+		w.WorkflowRetentionPurged(t.Context(), "wf", StatusFailed)
+
+		viewData, _ := meter.RetrieveData(metricName)
+		v := meter.Find(metricName)
+
+		require.Len(t, viewData, 2)
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
 }
@@ -23,10 +23,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/dapr/components-contrib/secretstores"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	kitstrings "github.com/dapr/kit/strings"
 )
 
 type Algorithm string
@@ -34,6 +36,8 @@ type Algorithm string
 const (
 	primaryEncryptionKey   = "primaryEncryptionKey"
 	secondaryEncryptionKey = "secondaryEncryptionKey"
+	keyRotationInterval    = "encryptionKeyRotationInterval"
+	reencryptOnRead        = "encryptionReencryptOnRead"
 	errPrefix              = "failed to extract encryption key"
 	AESGCMAlgorithm        = "AES-GCM"
 )
@@ -42,6 +46,19 @@ const (
 type ComponentEncryptionKeys struct {
 	Primary   Key
 	Secondary Key
+
+	// RotationInterval is the interval at which the keys are read again from
+	// the secret store, to pick up rotated keys. Keys are not rotated if zero.
+	RotationInterval time.Duration
+
+	// ReencryptOnRead is true if values read which are encrypted with a key
+	// other than the primary key are written back encrypted with the primary
+	// key.
+	ReencryptOnRead bool
+
+	// retired are the keys which were rotated out, newest first. They are only
+	// used to decrypt values which were encrypted with them.
+	retired []Key
 }
 
 // Key holds the key to encrypt an arbitrary object.
@@ -53,6 +70,18 @@ type Key struct {
 
 // ComponentEncryptionKey checks if a component definition contains an encryption key and extracts it using the supplied secret store.
 func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstores.SecretStore) (ComponentEncryptionKeys, error) {
+	return componentEncryptionKey(component, secretStore, false)
+}
+
+// RefreshComponentEncryptionKey extracts the encryption keys of a component
+// like ComponentEncryptionKey, but always reads the keys referenced with a
+// secretKeyRef from the secret store, even if they were already extracted by
+// the Operator, to pick up rotated keys.
+func RefreshComponentEncryptionKey(component v1alpha1.Component, secretStore secretstores.SecretStore) (ComponentEncryptionKeys, error) {
+	return componentEncryptionKey(component, secretStore, true)
+}
+
+func componentEncryptionKey(component v1alpha1.Component, secretStore secretstores.SecretStore, refresh bool) (ComponentEncryptionKeys, error) {
 	if secretStore == nil {
 		return ComponentEncryptionKeys{}, nil
 	}
@@ -63,8 +92,25 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 		// search for primary encryption key
 		var valid bool
 
+		switch m.Name {
+		case keyRotationInterval:
+			interval, err := time.ParseDuration(m.Value.String())
+			if err != nil || interval <= 0 {
+				return ComponentEncryptionKeys{}, fmt.Errorf("%s: invalid %s '%s': must be a positive duration", errPrefix, keyRotationInterval, m.Value.String())
+			}
+			cek.RotationInterval = interval
+			continue
+		case reencryptOnRead:
+			cek.ReencryptOnRead = kitstrings.IsTruthy(m.Value.String())
+			continue
+		}
+
+		// Keys already extracted by the Operator are read again from the
+		// secret store when refreshing, if they reference a secret.
+		resolved := len(m.Value.Raw) > 0 && !(refresh && m.SecretKeyRef.Name != "")
+
 		if m.Name == primaryEncryptionKey {
-			if len(m.Value.Raw) > 0 {
+			if resolved {
 				// encryption key is already extracted by the Operator
 				cek.Primary = Key{
 					Key:  m.Value.String(),
@@ -76,7 +122,7 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 
 			valid = true
 		} else if m.Name == secondaryEncryptionKey {
-			if len(m.Value.Raw) > 0 {
+			if resolved {
 				cek.Secondary = Key{
 					Key:  m.Value.String(),
 					Name: m.SecretKeyRef.Name,
@@ -172,12 +218,19 @@ func encrypt(value []byte, key Key) ([]byte, error) {
 
 // Decrypt takes a byte array and decrypts it using a supplied encryption key.
 func decrypt(value []byte, key Key) ([]byte, error) {
+	if key.cipherObj == nil {
+		return value, errors.New("encryption key not found")
+	}
+
 	enc, err := b64.StdEncoding.DecodeString(string(value))
 	if err != nil {
 		return value, err
 	}
 
 	nsize := key.cipherObj.NonceSize()
+	if len(enc) < nsize {
+		return value, errors.New("encrypted value is too short")
+	}
 	nonce, ciphertext := enc[:nsize], enc[nsize:]
 
 	return key.cipherObj.Open(nil, nonce, ciphertext, nil)
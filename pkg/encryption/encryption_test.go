@@ -18,6 +18,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/dapr/components-contrib/metadata"
 	"github.com/dapr/components-contrib/secretstores"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -155,6 +157,60 @@ func TestComponentEncryptionKey(t *testing.T) {
 	})
 }
 
+func TestRefreshComponentEncryptionKey(t *testing.T) {
+	bytes := make([]byte, 32)
+	rand.Read(bytes)
+	primaryKey := hex.EncodeToString(bytes)
+
+	secretStore := &mockSecretStore{}
+	secretStore.Init(t.Context(), secretstores.Metadata{Base: metadata.Base{
+		Properties: map[string]string{
+			"primaryKey": primaryKey,
+		},
+	}})
+
+	component := v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "statestore",
+		},
+		Spec: v1alpha1.ComponentSpec{
+			Metadata: []commonapi.NameValuePair{
+				{
+					// Extracted by the Operator before the key was rotated.
+					Name:         primaryEncryptionKey,
+					Value:        commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(`"` + hex.EncodeToString(make([]byte, 32)) + `"`)}},
+					SecretKeyRef: commonapi.SecretKeyRef{Name: "primaryKey"},
+				},
+				{
+					Name:  keyRotationInterval,
+					Value: commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(`"1h"`)}},
+				},
+				{
+					Name:  reencryptOnRead,
+					Value: commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(`"true"`)}},
+				},
+			},
+		},
+	}
+
+	keys, err := ComponentEncryptionKey(component, secretStore)
+	require.NoError(t, err)
+	assert.NotEqual(t, primaryKey, keys.Primary.Key)
+	assert.Equal(t, time.Hour, keys.RotationInterval)
+	assert.True(t, keys.ReencryptOnRead)
+
+	keys, err = RefreshComponentEncryptionKey(component, secretStore)
+	require.NoError(t, err)
+	assert.Equal(t, primaryKey, keys.Primary.Key)
+	assert.Equal(t, "primaryKey", keys.Primary.Name)
+
+	t.Run("invalid rotation interval", func(t *testing.T) {
+		component.Spec.Metadata[1].Value = commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(`"soon"`)}}
+		_, err := ComponentEncryptionKey(component, secretStore)
+		require.ErrorContains(t, err, "invalid encryptionKeyRotationInterval")
+	})
+}
+
 func TestTryGetEncryptionKeyFromMetadataItem(t *testing.T) {
 	t.Run("no secretRef on valid item", func(t *testing.T) {
 		secretStore := &mockSecretStore{}
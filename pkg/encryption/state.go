@@ -17,16 +17,27 @@ import (
 	"bytes"
 	b64 "encoding/base64"
 	"fmt"
+	"sync"
 )
 
-var encryptedStateStores = map[string]ComponentEncryptionKeys{}
+var (
+	encryptedStateStoresLock sync.RWMutex
+	encryptedStateStores     = map[string]ComponentEncryptionKeys{}
+)
 
 const (
 	separator = "||"
+
+	// maxRetiredKeys is the maximum number of keys rotated out of a state
+	// store which are kept to decrypt values.
+	maxRetiredKeys = 10
 )
 
 // AddEncryptedStateStore adds an encrypted state store and an associated encryption key to a list.
 func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
 	if _, ok := encryptedStateStores[storeName]; ok {
 		return false
 	}
@@ -37,15 +48,66 @@ func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool
 
 // EncryptedStateStore returns a bool that indicates if a state stores supports encryption.
 func EncryptedStateStore(storeName string) bool {
+	encryptedStateStoresLock.RLock()
+	defer encryptedStateStoresLock.RUnlock()
+
 	_, ok := encryptedStateStores[storeName]
 	return ok
 }
 
+// RotateEncryptedStateStore replaces the encryption keys of an encrypted state
+// store with rotated keys. The keys which are rotated out are still used to
+// decrypt values, but values are only encrypted with the new primary key. It
+// returns true if the primary key changed.
+func RotateEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
+	prev, ok := encryptedStateStores[storeName]
+	if !ok || keys.Primary.Key == "" {
+		return false
+	}
+
+	for _, key := range append([]Key{prev.Primary, prev.Secondary}, prev.retired...) {
+		if key.Key == "" || key.Key == keys.Primary.Key || key.Key == keys.Secondary.Key {
+			continue
+		}
+		if len(keys.retired) < maxRetiredKeys && !containsKey(keys.retired, key) {
+			keys.retired = append(keys.retired, key)
+		}
+	}
+
+	encryptedStateStores[storeName] = keys
+	return prev.Primary.Key != keys.Primary.Key || prev.Primary.Name != keys.Primary.Name
+}
+
+// ReencryptOnRead returns true if values read from the encrypted state store
+// which are not encrypted with its primary key should be written back
+// encrypted with it.
+func ReencryptOnRead(storeName string) bool {
+	encryptedStateStoresLock.RLock()
+	defer encryptedStateStoresLock.RUnlock()
+
+	return encryptedStateStores[storeName].ReencryptOnRead
+}
+
+func containsKey(keys []Key, key Key) bool {
+	for _, k := range keys {
+		if k.Key == key.Key && k.Name == key.Name {
+			return true
+		}
+	}
+	return false
+}
+
 // TryEncryptValue will try to encrypt a byte array if the state store has associated encryption keys.
 // The function will append the name of the key to the value for later extraction.
 // If no encryption keys exist, the function will return the bytes unmodified.
 func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
+	encryptedStateStoresLock.RLock()
 	keys := encryptedStateStores[storeName]
+	encryptedStateStoresLock.RUnlock()
+
 	enc, err := encrypt(value, keys.Primary)
 	if err != nil {
 		return value, err
@@ -58,26 +120,49 @@ func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
 // TryDecryptValue will try to decrypt a byte array if the state store has associated encryption keys.
 // If no encryption keys exist, the function will return the bytes unmodified.
 func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
+	val, _, err := DecryptValue(storeName, value)
+	return val, err
+}
+
+// DecryptValue decrypts a byte array like TryDecryptValue, also returning
+// true if the value is not encrypted with the primary key of the state store,
+// so that it should be re-encrypted. The keys with the name recorded on the
+// value are tried in turn, as rotated keys can share the name of the secret
+// they are read from.
+func DecryptValue(storeName string, value []byte) ([]byte, bool, error) {
 	if len(value) == 0 {
-		return []byte(""), nil
+		return []byte(""), false, nil
 	}
 
+	encryptedStateStoresLock.RLock()
 	keys := encryptedStateStores[storeName]
+	encryptedStateStoresLock.RUnlock()
+
 	// extract the decryption key that should be appended to the value
 	ind := bytes.LastIndex(value, []byte(separator))
+	if ind < 0 {
+		return value, false, fmt.Errorf("could not decrypt data for state store %s: encryption key name not found on record", storeName)
+	}
 	keyName := string(value[ind+len(separator):])
 
 	if len(keyName) == 0 {
-		return value, fmt.Errorf("could not decrypt data for state store %s: encryption key name not found on record", storeName)
+		return value, false, fmt.Errorf("could not decrypt data for state store %s: encryption key name not found on record", storeName)
 	}
 
-	var key Key
-
-	if keys.Primary.Name == keyName {
-		key = keys.Primary
-	} else if keys.Secondary.Name == keyName {
-		key = keys.Secondary
+	candidates := append([]Key{keys.Primary, keys.Secondary}, keys.retired...)
+	var err error
+	for i, key := range candidates {
+		if key.Name != keyName || key.cipherObj == nil {
+			continue
+		}
+		var val []byte
+		val, err = decrypt(value[:ind], key)
+		if err == nil {
+			return val, i > 0, nil
+		}
 	}
-
-	return decrypt(value[:ind], key)
+	if err == nil {
+		err = fmt.Errorf("could not decrypt data for state store %s: encryption key %s not found", storeName, keyName)
+	}
+	return value[:ind], false, err
 }
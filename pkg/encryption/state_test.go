@@ -229,6 +229,75 @@ func TestTryDecryptValue(t *testing.T) {
 	})
 }
 
+func newTestKey(t *testing.T, name string) Key {
+	t.Helper()
+	bytes := make([]byte, 32)
+	rand.Read(bytes)
+	key := Key{Name: name, Key: hex.EncodeToString(bytes)}
+	cipherObj, err := createCipher(key, AESGCMAlgorithm)
+	require.NoError(t, err)
+	key.cipherObj = cipherObj
+	return key
+}
+
+func TestRotateEncryptedStateStore(t *testing.T) {
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	key1 := newTestKey(t, "key")
+	key2 := newTestKey(t, "key")
+	key3 := newTestKey(t, "other")
+
+	assert.False(t, RotateEncryptedStateStore("test", ComponentEncryptionKeys{Primary: key1}))
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: key1})
+
+	v1, err := TryEncryptValue("test", []byte("value1"))
+	require.NoError(t, err)
+
+	t.Run("unchanged keys", func(t *testing.T) {
+		assert.False(t, RotateEncryptedStateStore("test", ComponentEncryptionKeys{Primary: key1}))
+		dr, stale, err := DecryptValue("test", v1)
+		require.NoError(t, err)
+		assert.False(t, stale)
+		assert.Equal(t, "value1", string(dr))
+	})
+
+	// The rotated key is read from the same secret, so it has the same name.
+	require.True(t, RotateEncryptedStateStore("test", ComponentEncryptionKeys{Primary: key2, ReencryptOnRead: true}))
+	assert.True(t, ReencryptOnRead("test"))
+	v2, err := TryEncryptValue("test", []byte("value2"))
+	require.NoError(t, err)
+
+	t.Run("values encrypted with rotated keys are decrypted", func(t *testing.T) {
+		dr, stale, err := DecryptValue("test", v1)
+		require.NoError(t, err)
+		assert.True(t, stale)
+		assert.Equal(t, "value1", string(dr))
+
+		dr, stale, err = DecryptValue("test", v2)
+		require.NoError(t, err)
+		assert.False(t, stale)
+		assert.Equal(t, "value2", string(dr))
+	})
+
+	require.True(t, RotateEncryptedStateStore("test", ComponentEncryptionKeys{Primary: key3, Secondary: key2}))
+
+	t.Run("secondary and retired keys", func(t *testing.T) {
+		for value, encrypted := range map[string][]byte{"value1": v1, "value2": v2} {
+			dr, stale, err := DecryptValue("test", encrypted)
+			require.NoError(t, err)
+			assert.True(t, stale)
+			assert.Equal(t, value, string(dr))
+		}
+		assert.Len(t, encryptedStateStores["test"].retired, 1)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: key3})
+		_, _, err := DecryptValue("test", v1)
+		require.ErrorContains(t, err, "encryption key key not found")
+	})
+}
+
 func TestEncryptedStateStore(t *testing.T) {
 	t.Run("store supports encryption", func(t *testing.T) {
 		encryptedStateStores = map[string]ComponentEncryptionKeys{}
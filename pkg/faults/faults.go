@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.faults")
+
+// ErrFaultInjected is wrapped by the errors returned for the calls failed by a
+// fault.
+var ErrFaultInjected = errors.New("fault injected")
+
+// componentTypes are the building blocks the faults can apply to.
+var componentTypes = []resiliency.ComponentType{
+	resiliency.Binding,
+	resiliency.Configuration,
+	resiliency.Lock,
+	resiliency.Pubsub,
+	resiliency.Crypto,
+	resiliency.Conversation,
+	resiliency.Secretstore,
+	resiliency.Statestore,
+}
+
+type Options struct {
+	Spec *config.FaultInjectionSpec
+}
+
+// Injector injects faults in the calls of the sidecar to the apps, actors and
+// components. A nil Injector injects no faults.
+type Injector struct {
+	clock clock.Clock
+	rand  func(n int) int
+
+	lock   sync.RWMutex
+	faults map[string]*fault
+}
+
+// Fault is a fault being injected.
+type Fault struct {
+	Spec config.FaultSpec
+	// ExpiresAt is the time the fault is removed at, or the zero time if the
+	// fault doesn't expire.
+	ExpiresAt time.Time
+}
+
+type fault struct {
+	Fault
+
+	componentType   resiliency.ComponentType
+	delay           time.Duration
+	delayPercentage int
+	errorMessage    string
+	errorPercentage int
+	hasError        bool
+}
+
+// New returns the Injector of the fault injection spec, or nil if the spec is
+// nil, in which case fault injection is disabled.
+func New(opts Options) (*Injector, error) {
+	if opts.Spec == nil {
+		return nil, nil
+	}
+
+	i := &Injector{
+		clock:  clock.RealClock{},
+		rand:   rand.IntN,
+		faults: make(map[string]*fault, len(opts.Spec.Faults)),
+	}
+
+	for _, spec := range opts.Spec.Faults {
+		if _, ok := i.faults[spec.Name]; ok {
+			return nil, fmt.Errorf("duplicate fault name %q", spec.Name)
+		}
+		if err := i.Set(spec); err != nil {
+			return nil, err
+		}
+	}
+
+	return i, nil
+}
+
+// Set validates the fault and starts injecting it, replacing the fault with
+// the same name if any.
+func (i *Injector) Set(spec config.FaultSpec) error {
+	f, err := i.parse(spec)
+	if err != nil {
+		return err
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.faults[spec.Name] = f
+	log.Infof("Injecting fault %s", spec.Name)
+	return nil
+}
+
+func (i *Injector) parse(spec config.FaultSpec) (*fault, error) {
+	if spec.Name == "" {
+		return nil, errors.New("fault name is required")
+	}
+
+	f := &fault{Fault: Fault{Spec: spec}}
+	switch spec.TargetType {
+	case "", resiliency.TargetTypeApp, resiliency.TargetTypeActor, resiliency.TargetTypeComponent:
+	default:
+		return nil, fmt.Errorf("fault %q: unknown target type %q", spec.Name, spec.TargetType)
+	}
+	if spec.BuildingBlock != "" {
+		if spec.TargetType != "" && spec.TargetType != resiliency.TargetTypeComponent {
+			return nil, fmt.Errorf("fault %q: building block is only supported for components", spec.Name)
+		}
+		idx := slices.IndexFunc(componentTypes, func(t resiliency.ComponentType) bool {
+			return strings.EqualFold(string(t), spec.BuildingBlock)
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("fault %q: unknown building block %q", spec.Name, spec.BuildingBlock)
+		}
+		f.componentType = componentTypes[idx]
+	}
+
+	if spec.Delay == nil && spec.Error == nil {
+		return nil, fmt.Errorf("fault %q: either delay or error is required", spec.Name)
+	}
+	if spec.Delay != nil {
+		d, err := time.ParseDuration(spec.Delay.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("fault %q: invalid delay duration: %w", spec.Name, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("fault %q: delay duration must be positive", spec.Name)
+		}
+		if err := validatePercentage(spec.Delay.Percentage); err != nil {
+			return nil, fmt.Errorf("fault %q: delay %w", spec.Name, err)
+		}
+		f.delay = d
+		f.delayPercentage = spec.Delay.Percentage
+	}
+	if spec.Error != nil {
+		if err := validatePercentage(spec.Error.Percentage); err != nil {
+			return nil, fmt.Errorf("fault %q: error %w", spec.Name, err)
+		}
+		f.hasError = true
+		f.errorMessage = spec.Error.Message
+		f.errorPercentage = spec.Error.Percentage
+	}
+
+	if spec.TTL != "" {
+		ttl, err := time.ParseDuration(spec.TTL)
+		if err != nil {
+			return nil, fmt.Errorf("fault %q: invalid ttl: %w", spec.Name, err)
+		}
+		if ttl <= 0 {
+			return nil, fmt.Errorf("fault %q: ttl must be positive", spec.Name)
+		}
+		f.ExpiresAt = i.clock.Now().Add(ttl)
+	}
+
+	return f, nil
+}
+
+func validatePercentage(p int) error {
+	if p < 0 || p > 100 {
+		return fmt.Errorf("percentage %d must be between 0 and 100", p)
+	}
+	return nil
+}
+
+// Delete stops injecting the fault with the name, and returns whether it was
+// being injected.
+func (i *Injector) Delete(name string) bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	f, ok := i.faults[name]
+	delete(i.faults, name)
+	if !ok || i.expired(f) {
+		return false
+	}
+	log.Infof("Stopped injecting fault %s", name)
+	return true
+}
+
+// List returns the faults being injected, sorted by name.
+func (i *Injector) List() []Fault {
+	if i == nil {
+		return nil
+	}
+
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	res := make([]Fault, 0, len(i.faults))
+	for _, f := range i.faults {
+		if !i.expired(f) {
+			res = append(res, f.Fault)
+		}
+	}
+	slices.SortFunc(res, func(a, b Fault) int {
+		return strings.Compare(a.Spec.Name, b.Spec.Name)
+	})
+	return res
+}
+
+// Inject injects the faults which apply to an operation on the target: it
+// waits for the longest of their delays, and returns an error wrapping
+// ErrFaultInjected if one of them fails the operation. The target type is one
+// of resiliency.TargetTypeApp, resiliency.TargetTypeActor and
+// resiliency.TargetTypeComponent.
+func (i *Injector) Inject(ctx context.Context, targetType string, componentType resiliency.ComponentType, name string) error {
+	if i == nil {
+		return nil
+	}
+
+	var (
+		delay     time.Duration
+		injectErr error
+	)
+	i.lock.RLock()
+	for _, f := range i.faults {
+		if i.expired(f) || !f.appliesTo(targetType, componentType, name) {
+			continue
+		}
+		if f.delay > delay && i.roll(f.delayPercentage) {
+			delay = f.delay
+		}
+		if injectErr == nil && f.hasError && i.roll(f.errorPercentage) {
+			injectErr = fmt.Errorf("%w %s: %s", ErrFaultInjected, f.Spec.Name, f.errorMessage)
+		}
+	}
+	i.lock.RUnlock()
+
+	if delay > 0 {
+		log.Debugf("Delaying the call to %s %s by %v", targetType, name, delay)
+		select {
+		case <-i.clock.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if injectErr != nil {
+		log.Debugf("Failing the call to %s %s: %v", targetType, name, injectErr)
+	}
+	return injectErr
+}
+
+// roll returns true for the percentage of the calls.
+func (i *Injector) roll(percentage int) bool {
+	return percentage > 0 && i.rand(100) < percentage
+}
+
+func (i *Injector) expired(f *fault) bool {
+	return !f.ExpiresAt.IsZero() && !i.clock.Now().Before(f.ExpiresAt)
+}
+
+func (f *fault) appliesTo(targetType string, componentType resiliency.ComponentType, name string) bool {
+	if f.Spec.TargetType != "" && f.Spec.TargetType != targetType {
+		return false
+	}
+	if f.componentType != "" && f.componentType != componentType {
+		return false
+	}
+	return f.Spec.Target == "" || f.Spec.Target == name
+}
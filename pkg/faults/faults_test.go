@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+func TestNew(t *testing.T) {
+	i, err := New(Options{})
+	require.NoError(t, err)
+	assert.Nil(t, i)
+	require.NoError(t, i.Inject(t.Context(), resiliency.TargetTypeApp, "", "myapp"))
+	assert.Empty(t, i.List())
+
+	i, err = New(Options{Spec: &config.FaultInjectionSpec{}})
+	require.NoError(t, err)
+	assert.NotNil(t, i)
+
+	failure := &config.FaultErrorSpec{Percentage: 100}
+	for _, f := range []config.FaultSpec{
+		{Error: failure},
+		{Name: "a"},
+		{Name: "a", TargetType: "service", Error: failure},
+		{Name: "a", TargetType: resiliency.TargetTypeApp, BuildingBlock: "statestore", Error: failure},
+		{Name: "a", BuildingBlock: "workflow", Error: failure},
+		{Name: "a", Delay: &config.FaultDelaySpec{Duration: "0s", Percentage: 100}},
+		{Name: "a", Delay: &config.FaultDelaySpec{Duration: "1s", Percentage: 101}},
+		{Name: "a", Error: &config.FaultErrorSpec{Percentage: -1}},
+		{Name: "a", Error: failure, TTL: "-1m"},
+	} {
+		_, err = New(Options{Spec: &config.FaultInjectionSpec{Faults: []config.FaultSpec{f}}})
+		require.Error(t, err, f)
+	}
+
+	_, err = New(Options{Spec: &config.FaultInjectionSpec{Faults: []config.FaultSpec{
+		{Name: "a", Error: failure},
+		{Name: "a", Error: failure},
+	}}})
+	require.Error(t, err)
+}
+
+func TestInject(t *testing.T) {
+	newInjector := func(t *testing.T, faults ...config.FaultSpec) (*Injector, *clocktesting.FakeClock) {
+		t.Helper()
+		clock := clocktesting.NewFakeClock(time.Now())
+		i := &Injector{
+			clock:  clock,
+			rand:   func(n int) int { return 49 },
+			faults: make(map[string]*fault),
+		}
+		for _, f := range faults {
+			require.NoError(t, i.Set(f))
+		}
+		return i, clock
+	}
+
+	t.Run("error applies to the matching targets", func(t *testing.T) {
+		i, _ := newInjector(t, config.FaultSpec{
+			Name:          "statestores",
+			BuildingBlock: "statestore",
+			Error:         &config.FaultErrorSpec{Message: "unavailable", Percentage: 100},
+		})
+
+		err := i.Inject(t.Context(), resiliency.TargetTypeComponent, resiliency.Statestore, "mystore")
+		require.ErrorIs(t, err, ErrFaultInjected)
+		assert.Equal(t, "fault injected statestores: unavailable", err.Error())
+		require.NoError(t, i.Inject(t.Context(), resiliency.TargetTypeComponent, resiliency.Pubsub, "mypubsub"))
+		require.NoError(t, i.Inject(t.Context(), resiliency.TargetTypeApp, "", "myapp"))
+	})
+
+	t.Run("percentage", func(t *testing.T) {
+		i, _ := newInjector(t,
+			config.FaultSpec{Name: "half", TargetType: resiliency.TargetTypeApp, Error: &config.FaultErrorSpec{Percentage: 50}},
+			config.FaultSpec{Name: "less", TargetType: resiliency.TargetTypeActor, Error: &config.FaultErrorSpec{Percentage: 49}},
+		)
+
+		require.Error(t, i.Inject(t.Context(), resiliency.TargetTypeApp, "", "myapp"))
+		require.NoError(t, i.Inject(t.Context(), resiliency.TargetTypeActor, "", "myactor"))
+	})
+
+	t.Run("delay", func(t *testing.T) {
+		i, clock := newInjector(t,
+			config.FaultSpec{Name: "short", Target: "myapp", Delay: &config.FaultDelaySpec{Duration: "1s", Percentage: 100}},
+			config.FaultSpec{Name: "long", Target: "myapp", Delay: &config.FaultDelaySpec{Duration: "5s", Percentage: 100}},
+		)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- i.Inject(t.Context(), resiliency.TargetTypeApp, "", "myapp")
+		}()
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, 10*time.Millisecond)
+		clock.Step(time.Second)
+		select {
+		case <-errCh:
+			t.Fatal("the longest delay should be injected")
+		case <-time.After(50 * time.Millisecond):
+		}
+		clock.Step(4 * time.Second)
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("the delay should have ended")
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		go func() {
+			errCh <- i.Inject(ctx, resiliency.TargetTypeApp, "", "myapp")
+		}()
+		assert.Eventually(t, clock.HasWaiters, time.Second, 10*time.Millisecond)
+		cancel()
+		select {
+		case err := <-errCh:
+			require.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("the delay should have been canceled")
+		}
+	})
+
+	t.Run("ttl and delete", func(t *testing.T) {
+		i, clock := newInjector(t,
+			config.FaultSpec{Name: "b", Error: &config.FaultErrorSpec{Percentage: 100}, TTL: "1m"},
+			config.FaultSpec{Name: "a", Error: &config.FaultErrorSpec{Percentage: 100}},
+		)
+
+		faults := i.List()
+		require.Len(t, faults, 2)
+		assert.Equal(t, "a", faults[0].Spec.Name)
+		assert.True(t, faults[0].ExpiresAt.IsZero())
+		assert.Equal(t, "b", faults[1].Spec.Name)
+		assert.Equal(t, clock.Now().Add(time.Minute), faults[1].ExpiresAt)
+
+		clock.Step(time.Minute)
+		faults = i.List()
+		require.Len(t, faults, 1)
+		assert.Equal(t, "a", faults[0].Spec.Name)
+		assert.False(t, i.Delete("b"))
+
+		require.Error(t, i.Inject(t.Context(), resiliency.TargetTypeApp, "", "myapp"))
+		assert.True(t, i.Delete("a"))
+		assert.False(t, i.Delete("a"))
+		require.NoError(t, i.Inject(t.Context(), resiliency.TargetTypeApp, "", "myapp"))
+	})
+}
@@ -28,6 +28,9 @@ import (
 type Handler struct {
 	Path   string
 	Getter func(ctx context.Context) ([]byte, error)
+
+	// Handler, if set, serves the path in place of Getter.
+	Handler http.Handler
 }
 
 type Options struct {
@@ -59,6 +62,10 @@ func New(opts Options) *Server {
 
 	for _, handler := range opts.Handlers {
 		hdl := handler
+		if hdl.Handler != nil {
+			mux.Handle(hdl.Path, hdl.Handler)
+			continue
+		}
 		mux.Handle(handler.Path, http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 			data, err := hdl.Getter(request.Context())
 			if err != nil {
@@ -59,6 +59,7 @@ const (
 	KeyReadBufferSize                   = "dapr.io/read-buffer-size"
 	KeyGracefulShutdownSeconds          = "dapr.io/graceful-shutdown-seconds"
 	KeyBlockShutdownDuration            = "dapr.io/block-shutdown-duration"
+	KeyShutdownPhaseTimeouts            = "dapr.io/shutdown-phase-timeouts"
 	KeyEnableAPILogging                 = "dapr.io/enable-api-logging"
 	KeyUnixDomainSocketPath             = "dapr.io/unix-domain-socket-path"
 	KeyVolumeMountsReadOnly             = "dapr.io/volume-mounts"
@@ -81,4 +82,6 @@ const (
 	KeySidecarSvcAnnotations            = "dapr.io/sidecar-svc-annotations"
 	KeyDisableInitEndpoints             = "dapr.io/disable-init-endpoints"
 	KeyEnableNativeSidecar              = "dapr.io/enable-native-sidecar"
+	KeyWorkloadCertTTL                  = "dapr.io/workload-cert-ttl"
+	KeyInjectionProfile                 = "dapr.io/injection-profile"
 )
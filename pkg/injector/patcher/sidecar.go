@@ -101,6 +101,7 @@ type SidecarConfig struct {
 	ReadBufferSize                      string  `annotation:"dapr.io/read-buffer-size"`
 	GracefulShutdownSeconds             int     `annotation:"dapr.io/graceful-shutdown-seconds"               default:"-1"`
 	BlockShutdownDuration               *string `annotation:"dapr.io/block-shutdown-duration"`
+	ShutdownPhaseTimeouts               *string `annotation:"dapr.io/shutdown-phase-timeouts"`
 	EnableAPILogging                    *bool   `annotation:"dapr.io/enable-api-logging"`
 	UnixDomainSocketPath                string  `annotation:"dapr.io/unix-domain-socket-path"`
 	VolumeMounts                        string  `annotation:"dapr.io/volume-mounts"`
@@ -124,6 +125,8 @@ type SidecarConfig struct {
 	DaprServiceAnnotations              string  `annotation:"dapr.io/sidecar-svc-annotations"`
 	DisableInitEndpoint                 *string `annotation:"dapr.io/disable-init-endpoints"`
 	EnableNativeSidecar                 bool    `annotation:"dapr.io/enable-native-sidecar"`
+	WorkloadCertTTL                     string  `annotation:"dapr.io/workload-cert-ttl"` // Read by sentry from the pod.
+	InjectionProfile                    string  `annotation:"dapr.io/injection-profile"`
 
 	pod *corev1.Pod
 }
@@ -141,6 +144,13 @@ func (c *SidecarConfig) SetFromPodAnnotations() {
 	c.setFromAnnotations(c.pod.Annotations)
 }
 
+// SetFromProfileAnnotations updates the object with the annotations of an
+// injection profile. It must be called before SetFromPodAnnotations, so the
+// annotations of the pod override the annotations of the profile.
+func (c *SidecarConfig) SetFromProfileAnnotations(an map[string]string) {
+	c.setFromAnnotations(an)
+}
+
 func (c *SidecarConfig) setDefaultValues() {
 	// Iterate through the fields using reflection
 	val := reflect.ValueOf(c).Elem()
@@ -191,6 +191,10 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 		args = append(args, "--dapr-block-shutdown-duration", *c.BlockShutdownDuration)
 	}
 
+	if c.ShutdownPhaseTimeouts != nil {
+		args = append(args, "--dapr-shutdown-phase-timeouts", *c.ShutdownPhaseTimeouts)
+	}
+
 	if c.ActorsDisseminateTimeout != nil {
 		args = append(args, "--actors-disseminate-timeout", *c.ActorsDisseminateTimeout)
 	}
@@ -318,9 +322,18 @@ func (c *SidecarConfig) getSidecarContainer(opts getSidecarContainerOpts) (*core
 
 	// Native sidecar: set RestartPolicy to Always so Kubernetes treats
 	// this init container as a long-running sidecar (KEP-753).
+	// The app containers are started once the startup probe succeeds, so the
+	// probe waits for the outbound APIs of daprd to be ready: unlike the
+	// readiness of daprd, it doesn't depend on the app.
 	if c.EnableNativeSidecar {
 		policy := corev1.ContainerRestartPolicyAlways
 		container.RestartPolicy = &policy
+		container.StartupProbe = &corev1.Probe{
+			ProbeHandler:     getReadinessProbeHandler(c.SidecarPublicPort, injectorConsts.APIVersionV1, injectorConsts.SidecarHealthzPath, "outbound"),
+			TimeoutSeconds:   c.SidecarReadinessProbeTimeoutSeconds,
+			PeriodSeconds:    c.SidecarReadinessProbePeriodSeconds,
+			FailureThreshold: nativeSidecarStartupProbeThreshold,
+		}
 	}
 
 	// If the pod contains any of the tolerations specified by the configuration,
@@ -474,6 +487,11 @@ func (c *SidecarConfig) GetAppID() string {
 	return c.AppID
 }
 
+// nativeSidecarStartupProbeThreshold is the failure threshold of the startup
+// probe of native sidecars: with the default period of 1s, daprd has 5 minutes
+// to initialize its components before it is restarted.
+const nativeSidecarStartupProbeThreshold = 300
+
 var envRegexp = regexp.MustCompile(`(?m)(,)\s*[a-zA-Z\_][a-zA-Z0-9\_]*=`)
 
 // getEnv returns the EnvVar slice from the Env annotation.
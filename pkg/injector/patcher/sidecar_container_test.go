@@ -880,6 +880,27 @@ func TestGetSidecarContainer(t *testing.T) {
 		},
 	}))
 
+	t.Run("shutdown phase timeouts", testSuiteGenerator([]testCase{
+		{
+			name:        "default to empty",
+			annotations: map[string]string{},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.NotContains(t, args, "--dapr-shutdown-phase-timeouts")
+			},
+		},
+		{
+			name: "add shutdown phase timeouts",
+			annotations: map[string]string{
+				annotations.KeyShutdownPhaseTimeouts: "subscriptions=30s,actors=1m",
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				args := strings.Join(container.Args, " ")
+				assert.Contains(t, args, "--dapr-shutdown-phase-timeouts subscriptions=30s,actors=1m")
+			},
+		},
+	}))
+
 	t.Run("actors disseminate timeout", testSuiteGenerator([]testCase{
 		{
 			name:        "default to empty",
@@ -1624,6 +1645,25 @@ func TestGetSidecarContainer(t *testing.T) {
 				assert.Nil(t, container.RestartPolicy)
 			},
 		},
+		{
+			name: "startup probe waits for the outbound APIs when native sidecar enabled",
+			sidecarConfigModifierFn: func(c *SidecarConfig) {
+				c.EnableNativeSidecar = true
+			},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				require.NotNil(t, container.StartupProbe)
+				require.NotNil(t, container.StartupProbe.HTTPGet)
+				assert.Equal(t, "/v1.0/healthz/outbound", container.StartupProbe.HTTPGet.Path)
+				assert.Equal(t, int32(nativeSidecarStartupProbeThreshold), container.StartupProbe.FailureThreshold)
+			},
+		},
+		{
+			name:        "startup probe not set by default",
+			annotations: map[string]string{},
+			assertFn: func(t *testing.T, container *corev1.Container) {
+				assert.Nil(t, container.StartupProbe)
+			},
+		},
 		{
 			name: "native sidecar preserves graceful shutdown args",
 			annotations: map[string]string{
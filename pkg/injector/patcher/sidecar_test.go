@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/dapr/dapr/pkg/injector/annotations"
 	injectorConsts "github.com/dapr/dapr/pkg/injector/consts"
@@ -73,6 +74,27 @@ func TestSidecarConfigSetFromAnnotations(t *testing.T) {
 		assert.Equal(t, "info", c.LogLevel)
 	})
 
+	t.Run("pod annotations override profile annotations", func(t *testing.T) {
+		c := NewSidecarConfig(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					annotations.KeyLogLevel: "debug",
+				},
+			},
+		})
+
+		c.SetFromProfileAnnotations(map[string]string{
+			annotations.KeyLogLevel:    "warn",
+			annotations.KeyCPULimit:    "500m",
+			annotations.KeyMetricsPort: "9091",
+		})
+		c.SetFromPodAnnotations()
+
+		assert.Equal(t, "debug", c.LogLevel)
+		assert.Equal(t, "500m", c.SidecarCPULimit)
+		assert.Equal(t, int32(9091), c.SidecarMetricsPort)
+	})
+
 	t.Run("skip invalid properties", func(t *testing.T) {
 		c := NewSidecarConfig(&corev1.Pod{})
 
@@ -17,17 +17,24 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"slices"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 
+	configapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
 	scheme "github.com/dapr/dapr/pkg/client/clientset/versioned"
+	"github.com/dapr/dapr/pkg/injector/annotations"
 	injectorConsts "github.com/dapr/dapr/pkg/injector/consts"
 	"github.com/dapr/dapr/pkg/injector/patcher"
 	"github.com/dapr/dapr/pkg/security/token"
+	"github.com/dapr/kit/strings"
 )
 
 const (
@@ -56,6 +63,13 @@ func (i *injector) getPodPatchOperations(ctx context.Context, ar *admissionv1.Ad
 		return nil, err
 	}
 
+	nsAnnotations := namespaceAnnotations(ctx, i.kubeClient, ar.Request.Namespace)
+	injectorSpec := getInjectorSpec(i.controlPlaneNamespace, i.daprClient)
+	profile, err := injectionProfile(injectorSpec, pod, nsAnnotations)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create the sidecar configuration object from the pod
 	sidecar := patcher.NewSidecarConfig(pod)
 	sidecar.GetInjectedComponentContainers = i.getInjectedComponentContainers
@@ -80,7 +94,7 @@ func (i *injector) getPodPatchOperations(ctx context.Context, ar *admissionv1.Ad
 	sidecar.DisableTokenVolume = !token.HasKubernetesToken()
 	sidecar.KubeClusterDomain = i.config.KubeClusterDomain
 	sidecar.SchedulerEnabled = i.schedulerEnabled
-	sidecar.EnableNativeSidecar = i.config.GetNativeSidecarEnabled()
+	sidecar.EnableNativeSidecar = nativeSidecarEnabled(nsAnnotations, i.config.GetNativeSidecarEnabled())
 
 	// Set addresses for actor services only if it's not explicitly globally disabled
 	// Even if actors are disabled, however, the placement-host-address flag will still be included if explicitly set in the annotation dapr.io/placement-host-address
@@ -116,9 +130,11 @@ func (i *injector) getPodPatchOperations(ctx context.Context, ar *admissionv1.Ad
 	}
 
 	// Default value for the sidecar image, which can be overridden by annotations
-	sidecar.SidecarImage = i.config.SidecarImage
+	sidecar.SidecarImage = sidecarImage(i.config.SidecarImage, injectorSpec, ar.Request.Namespace, nsAnnotations, ar.Request.UID)
 
-	// Set the configuration from annotations
+	// Set the configuration from the injection profile and from annotations,
+	// which override the profile
+	sidecar.SetFromProfileAnnotations(profile)
 	sidecar.SetFromPodAnnotations()
 
 	// Get the patch to apply to the pod
@@ -146,3 +162,94 @@ func mTLSEnabled(controlPlaneNamespace string, daprClient scheme.Interface) bool
 
 	return resp.Spec.MTLSSpec.GetEnabled()
 }
+
+// namespaceAnnotations returns the annotations of the namespace, or nil if
+// the namespace can't be read.
+func namespaceAnnotations(ctx context.Context, kubeClient kubernetes.Interface, namespace string) map[string]string {
+	if kubeClient == nil {
+		return nil
+	}
+
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		log.Warnf("Failed to get namespace %s, its annotations are ignored: %s", namespace, err)
+		return nil
+	}
+	return ns.GetAnnotations()
+}
+
+// nativeSidecarEnabled returns whether daprd is injected as a native sidecar in
+// the namespace: the dapr.io/enable-native-sidecar annotation of the namespace
+// overrides the default of the injector, and is overridden by the annotation
+// of the pod.
+func nativeSidecarEnabled(nsAnnotations map[string]string, defaultEnabled bool) bool {
+	if v, ok := nsAnnotations[annotations.KeyEnableNativeSidecar]; ok {
+		return strings.IsTruthy(v)
+	}
+	return defaultEnabled
+}
+
+// getInjectorSpec returns the injector spec of the Dapr system configuration,
+// or nil if it can't be loaded.
+func getInjectorSpec(controlPlaneNamespace string, daprClient scheme.Interface) *configapi.InjectorSpec {
+	resp, err := daprClient.ConfigurationV1alpha1().
+		Configurations(controlPlaneNamespace).
+		Get(defaultConfig, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		log.Errorf("Failed to load dapr configuration from k8s, injection profiles and canary are ignored: %s", err)
+		return nil
+	}
+	return resp.Spec.InjectorSpec
+}
+
+// injectionProfile returns the annotations of the injection profile selected
+// by the dapr.io/injection-profile annotation of the pod, or else of its
+// namespace. It returns nil if no profile is selected; an empty annotation of
+// the pod opts out of the profile of the namespace.
+func injectionProfile(spec *configapi.InjectorSpec, pod *corev1.Pod, nsAnnotations map[string]string) (map[string]string, error) {
+	name, ok := pod.GetAnnotations()[annotations.KeyInjectionProfile]
+	if !ok {
+		name = nsAnnotations[annotations.KeyInjectionProfile]
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	if spec != nil {
+		for _, profile := range spec.Profiles {
+			if profile.Name == name {
+				return profile.Annotations, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("injection profile %q not found in Dapr system configuration '%s'", name, defaultConfig)
+}
+
+// sidecarImage returns the daprd image of a new pod of the namespace, before
+// the dapr.io/sidecar-image annotation of the pod is applied. The annotation
+// of the namespace pins the image of its pods; otherwise the canary image is
+// injected in the percentage of the pods chosen by the UID of their admission
+// request.
+func sidecarImage(defaultImage string, spec *configapi.InjectorSpec, namespace string, nsAnnotations map[string]string, uid types.UID) string {
+	if image := nsAnnotations[annotations.KeySidecarImage]; image != "" {
+		return image
+	}
+
+	if spec == nil || spec.Canary == nil || spec.Canary.Image == "" {
+		return defaultImage
+	}
+	canary := spec.Canary
+	if len(canary.Namespaces) > 0 && !slices.Contains(canary.Namespaces, namespace) {
+		return defaultImage
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(uid))
+	if int(h.Sum32()%100) < canary.Percentage {
+		return canary.Image
+	}
+	return defaultImage
+}
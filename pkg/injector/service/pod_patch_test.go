@@ -14,13 +14,19 @@ limitations under the License.
 package service
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
 
 	configapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
 	clientfake "github.com/dapr/dapr/pkg/client/clientset/versioned/fake"
+	"github.com/dapr/dapr/pkg/injector/annotations"
 )
 
 func Test_mtlsEnabled(t *testing.T) {
@@ -71,3 +77,160 @@ func Test_mtlsEnabled(t *testing.T) {
 		assert.True(t, mTLSEnabled("test-ns", cl))
 	})
 }
+
+func Test_nativeSidecarEnabled(t *testing.T) {
+	namespace := func(name string, annots map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annots}}
+	}
+	client := kubernetesfake.NewSimpleClientset(
+		namespace("enabled", map[string]string{annotations.KeyEnableNativeSidecar: "true"}),
+		namespace("disabled", map[string]string{annotations.KeyEnableNativeSidecar: "false"}),
+		namespace("default", nil),
+	)
+
+	t.Run("namespace annotation enables native sidecar", func(t *testing.T) {
+		assert.True(t, nativeSidecarEnabled(namespaceAnnotations(t.Context(), client, "enabled"), false))
+	})
+
+	t.Run("namespace annotation disables native sidecar", func(t *testing.T) {
+		assert.False(t, nativeSidecarEnabled(namespaceAnnotations(t.Context(), client, "disabled"), true))
+	})
+
+	t.Run("namespace without annotation uses default", func(t *testing.T) {
+		assert.True(t, nativeSidecarEnabled(namespaceAnnotations(t.Context(), client, "default"), true))
+		assert.False(t, nativeSidecarEnabled(namespaceAnnotations(t.Context(), client, "default"), false))
+	})
+
+	t.Run("missing namespace uses default", func(t *testing.T) {
+		assert.True(t, nativeSidecarEnabled(namespaceAnnotations(t.Context(), client, "missing"), true))
+	})
+
+	t.Run("nil client uses default", func(t *testing.T) {
+		assert.True(t, nativeSidecarEnabled(namespaceAnnotations(t.Context(), nil, "enabled"), true))
+		assert.False(t, nativeSidecarEnabled(namespaceAnnotations(t.Context(), nil, "enabled"), false))
+	})
+}
+
+func Test_getInjectorSpec(t *testing.T) {
+	t.Run("if configuration doesn't exist, return nil", func(t *testing.T) {
+		cl := clientfake.NewSimpleClientset()
+		assert.Nil(t, getInjectorSpec("test-ns", cl))
+	})
+
+	t.Run("if configuration exists, return its injector spec", func(t *testing.T) {
+		cl := clientfake.NewSimpleClientset()
+		spec := &configapi.InjectorSpec{
+			Profiles: []configapi.InjectionProfile{{Name: "small"}},
+		}
+		cl.ConfigurationV1alpha1().Configurations("test-ns").Create(
+			&configapi.Configuration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "daprsystem",
+					Namespace: "test-ns",
+				},
+				Spec: configapi.ConfigurationSpec{InjectorSpec: spec},
+			},
+		)
+		assert.Equal(t, spec, getInjectorSpec("test-ns", cl))
+	})
+}
+
+func Test_injectionProfile(t *testing.T) {
+	spec := &configapi.InjectorSpec{
+		Profiles: []configapi.InjectionProfile{
+			{Name: "small", Annotations: map[string]string{annotations.KeyCPULimit: "100m"}},
+			{Name: "large", Annotations: map[string]string{annotations.KeyCPULimit: "2"}},
+		},
+	}
+	pod := func(annots map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: annots}}
+	}
+	nsAnnotations := map[string]string{annotations.KeyInjectionProfile: "small"}
+
+	t.Run("no profile selected", func(t *testing.T) {
+		profile, err := injectionProfile(spec, pod(nil), nil)
+		require.NoError(t, err)
+		assert.Nil(t, profile)
+	})
+
+	t.Run("profile of the namespace", func(t *testing.T) {
+		profile, err := injectionProfile(spec, pod(nil), nsAnnotations)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{annotations.KeyCPULimit: "100m"}, profile)
+	})
+
+	t.Run("profile of the pod overrides the namespace", func(t *testing.T) {
+		profile, err := injectionProfile(spec, pod(map[string]string{annotations.KeyInjectionProfile: "large"}), nsAnnotations)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{annotations.KeyCPULimit: "2"}, profile)
+	})
+
+	t.Run("empty annotation of the pod opts out", func(t *testing.T) {
+		profile, err := injectionProfile(spec, pod(map[string]string{annotations.KeyInjectionProfile: ""}), nsAnnotations)
+		require.NoError(t, err)
+		assert.Nil(t, profile)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := injectionProfile(spec, pod(map[string]string{annotations.KeyInjectionProfile: "medium"}), nil)
+		require.ErrorContains(t, err, `injection profile "medium" not found`)
+	})
+
+	t.Run("missing configuration", func(t *testing.T) {
+		_, err := injectionProfile(nil, pod(nil), nsAnnotations)
+		require.Error(t, err)
+	})
+}
+
+func Test_sidecarImage(t *testing.T) {
+	const (
+		defaultImage = "daprio/daprd:1.16.0"
+		canaryImage  = "daprio/daprd:1.17.0"
+		pinnedImage  = "daprio/daprd:1.15.0"
+	)
+	canary := func(percentage int, namespaces ...string) *configapi.InjectorSpec {
+		return &configapi.InjectorSpec{Canary: &configapi.SidecarCanarySpec{
+			Image:      canaryImage,
+			Percentage: percentage,
+			Namespaces: namespaces,
+		}}
+	}
+	countCanary := func(spec *configapi.InjectorSpec, namespace string, nsAnnotations map[string]string) int {
+		n := 0
+		for i := range 1000 {
+			if sidecarImage(defaultImage, spec, namespace, nsAnnotations, types.UID(strconv.Itoa(i))) == canaryImage {
+				n++
+			}
+		}
+		return n
+	}
+
+	t.Run("default image without canary", func(t *testing.T) {
+		assert.Equal(t, defaultImage, sidecarImage(defaultImage, nil, "ns", nil, "uid"))
+		assert.Equal(t, 0, countCanary(&configapi.InjectorSpec{}, "ns", nil))
+	})
+
+	t.Run("namespace pins the image", func(t *testing.T) {
+		nsAnnotations := map[string]string{annotations.KeySidecarImage: pinnedImage}
+		assert.Equal(t, pinnedImage, sidecarImage(defaultImage, canary(100), "ns", nsAnnotations, "uid"))
+	})
+
+	t.Run("canary percentage", func(t *testing.T) {
+		assert.Equal(t, 0, countCanary(canary(0), "ns", nil))
+		assert.Equal(t, 1000, countCanary(canary(100), "ns", nil))
+		assert.InDelta(t, 250, countCanary(canary(25), "ns", nil), 60)
+	})
+
+	t.Run("canary is deterministic for a request", func(t *testing.T) {
+		spec := canary(50)
+		for i := range 100 {
+			uid := types.UID(strconv.Itoa(i))
+			assert.Equal(t, sidecarImage(defaultImage, spec, "ns", nil, uid), sidecarImage(defaultImage, spec, "ns", nil, uid))
+		}
+	})
+
+	t.Run("canary limited to namespaces", func(t *testing.T) {
+		assert.Equal(t, 1000, countCanary(canary(100, "ns"), "ns", nil))
+		assert.Equal(t, 0, countCanary(canary(100, "ns"), "other", nil))
+	})
+}
@@ -33,11 +33,16 @@ type disk[T meta.Resource] struct {
 	dirs       []string
 	namespace  string
 	appID      string
+	strict     bool
 }
 
 type Options struct {
 	AppID string
 	Paths []string
+
+	// Strict fails loading when a manifest file can't be read or parsed,
+	// rather than skipping the manifests of the file.
+	Strict bool
 }
 
 // new creates a new manifest loader for the given paths and kind.
@@ -49,6 +54,7 @@ func new[T meta.Resource](opts Options) *disk[T] {
 		apiVersion: zero.APIVersion(),
 		namespace:  security.CurrentNamespace(),
 		appID:      opts.AppID,
+		strict:     opts.Strict,
 	}
 }
 
@@ -71,6 +77,10 @@ func (d *disk[T]) LoadFromDirData(data *dirdata.DirData) ([]T, error) {
 }
 
 func (d *disk[T]) filterManifests(set *manifestSet[T]) ([]T, error) {
+	if d.strict && len(set.errs) > 0 {
+		return nil, errors.Join(set.errs...)
+	}
+
 	nsDefined := len(os.Getenv("NAMESPACE")) != 0
 
 	names := make(map[string]string)
@@ -75,6 +75,25 @@ name: statestore`
 		assert.Empty(t, components)
 	})
 
+	t.Run("invalid yaml fails strict loads", func(t *testing.T) {
+		tmp := t.TempDir()
+		request := NewComponents(Options{
+			Paths:  []string{tmp},
+			Strict: true,
+		})
+
+		yaml := `
+INVALID_YAML_HERE
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+name: statestore`
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "test-component-invalid.yaml"), []byte(yaml), fs.FileMode(0o600)))
+		components, err := request.Load(t.Context())
+		require.ErrorContains(t, err, "test-component-invalid.yaml")
+		assert.Empty(t, components)
+	})
+
 	t.Run("load components file not exist", func(t *testing.T) {
 		request := NewComponents(Options{
 			Paths: []string{"test-path-no-exists"},
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -45,6 +46,9 @@ type manifestSet[T meta.Resource] struct {
 
 	order []manifestOrder
 	ts    []T
+
+	// errs are the errors of the files which couldn't be read or parsed.
+	errs []error
 }
 
 type manifestOrder struct {
@@ -89,12 +93,17 @@ func (m *manifestSet[T]) loadManifestsFromFile(path string) {
 	f, err := os.Open(path)
 	if err != nil {
 		log.Warnf("daprd load %s error when opening file %s: %v", m.d.kind, path, err)
+		// Files deleted since the directory was listed are loaded as deleted.
+		if !errors.Is(err, fs.ErrNotExist) {
+			m.errs = append(m.errs, fmt.Errorf("failed to open %s: %w", path, err))
+		}
 		return
 	}
 	defer f.Close()
 
 	if err := m.decodeYaml(f); err != nil {
 		log.Warnf("daprd load %s error when parsing manifests yaml resource in %s: %v", m.d.kind, path, err)
+		m.errs = append(m.errs, fmt.Errorf("failed to parse %s: %w", path, err))
 	}
 }
 
@@ -105,6 +114,7 @@ func (m *manifestSet[T]) loadManifestsFromBytes(path string, data []byte) {
 
 	if err := m.decodeYaml(bytes.NewReader(data)); err != nil {
 		log.Warnf("daprd load %s error when parsing manifests yaml resource in %s: %v", m.d.kind, path, err)
+		m.errs = append(m.errs, fmt.Errorf("failed to parse %s: %w", path, err))
 	}
 }
 
@@ -19,16 +19,21 @@ import (
 	"fmt"
 	"net/http"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
+	kiterrors "github.com/dapr/kit/errors"
 )
 
 const (
 	defaultMessage  = "unknown error"
 	defaultTag      = "ERROR"
 	errStringFormat = "api error: code = %s desc = %s"
+
+	typeField     = "@type"
+	errorInfoType = "type.googleapis.com/google.rpc.ErrorInfo"
 )
 
 // APIError implements the Error interface and the interface that complies with "google.golang.org/grpc/status".FromError().
@@ -36,7 +41,8 @@ const (
 type APIError struct {
 	// Message is the human-readable error message.
 	message string
-	// Tag is an ErrorCode identifying the error, used with HTTP responses only.
+	// Tag is an ErrorCode identifying the error, used as the errorCode of HTTP
+	// responses and as the reason of the ErrorInfo details of the error.
 	tag errorcodes.ErrorCode
 	// Status code for HTTP responses.
 	httpCode int
@@ -83,7 +89,15 @@ func (e APIError) HTTPCode() int {
 // GRPCStatus returns the gRPC status.Status object.
 // This method allows APIError to comply with the interface expected by status.FromError().
 func (e APIError) GRPCStatus() *grpcStatus.Status {
-	return grpcStatus.New(e.grpcCode, e.Message())
+	s := grpcStatus.New(e.grpcCode, e.Message())
+	if e.tag.Code == "" {
+		return s
+	}
+	// Details can't be attached to an OK status.
+	if ds, err := s.WithDetails(e.errorInfo()); err == nil {
+		return ds
+	}
+	return s
 }
 
 // Error implements the error interface.
@@ -94,15 +108,41 @@ func (e APIError) Error() string {
 // JSONErrorValue implements the errorResponseValue interface.
 func (e APIError) JSONErrorValue() []byte {
 	b, _ := json.Marshal(struct {
-		ErrorCode string `json:"errorCode"`
-		Message   string `json:"message"`
+		ErrorCode string           `json:"errorCode"`
+		Message   string           `json:"message"`
+		Details   []map[string]any `json:"details,omitempty"`
 	}{
 		ErrorCode: e.Tag(),
 		Message:   e.Message(),
+		Details:   e.jsonDetails(),
 	})
 	return b
 }
 
+// errorInfo returns the ErrorInfo details of the error, identifying the error
+// code of the error in the Dapr domain.
+func (e APIError) errorInfo() *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason: e.tag.Reason(),
+		Domain: kiterrors.Domain,
+	}
+}
+
+// jsonDetails returns the details of the error in the format of the details of
+// the HTTP responses of the rich errors of the Dapr APIs.
+func (e APIError) jsonDetails() []map[string]any {
+	if e.tag.Code == "" {
+		return nil
+	}
+	info := e.errorInfo()
+	return []map[string]any{{
+		typeField:  errorInfoType,
+		"reason":   info.GetReason(),
+		"domain":   info.GetDomain(),
+		"metadata": info.GetMetadata(),
+	}}
+}
+
 // Is implements the interface that checks if the error matches the given one.
 func (e APIError) Is(targetI error) bool {
 	// Ignore the message in the comparison because the target could have been formatted
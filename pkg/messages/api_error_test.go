@@ -19,6 +19,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
@@ -264,3 +267,42 @@ func TestAPIError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIError_ErrorInfo(t *testing.T) {
+	t.Run("grpc status has error info details", func(t *testing.T) {
+		s := ErrBadRequest.WithFormat("error").GRPCStatus()
+		assert.Equal(t, grpcCodes.InvalidArgument, s.Code())
+		require.Len(t, s.Details(), 1)
+		info, ok := s.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, errorcodes.CommonBadRequest.Code, info.GetReason())
+		assert.Equal(t, "dapr.io", info.GetDomain())
+	})
+
+	t.Run("reason is the grpc code of the error code", func(t *testing.T) {
+		e := APIError{tag: errorcodes.SchedulerGetJob, grpcCode: grpcCodes.Internal}
+		info, ok := e.GRPCStatus().Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, errorcodes.SchedulerGetJob.GrpcCode, info.GetReason())
+	})
+
+	t.Run("no details without tag", func(t *testing.T) {
+		e := APIError{message: "Oy vey", grpcCode: grpcCodes.Internal}
+		assert.Empty(t, e.GRPCStatus().Details())
+		assert.JSONEq(t, `{"errorCode":"","message":"Oy vey"}`, string(e.JSONErrorValue()))
+	})
+
+	t.Run("json has error info details", func(t *testing.T) {
+		e := APIError{message: "Oy vey", tag: errorcodes.ActorInstanceMissing}
+		assert.JSONEq(t, `{
+			"errorCode": "ERR_ACTOR_INSTANCE_MISSING",
+			"message": "Oy vey",
+			"details": [{
+				"@type": "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason": "ERR_ACTOR_INSTANCE_MISSING",
+				"domain": "dapr.io",
+				"metadata": null
+			}]
+		}`, string(e.JSONErrorValue()))
+	})
+}
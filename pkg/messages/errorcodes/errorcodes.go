@@ -14,6 +14,8 @@ limitations under the License.
 //nolint:errname
 package errorcodes
 
+import "slices"
+
 type Category string
 
 const (
@@ -32,6 +34,7 @@ const (
 	CategoryHealth            Category = "health"
 	CategoryCommon            Category = "common"
 	CategoryPluggable         Category = "pluggable-component"
+	CategoryIdentity          Category = "identity"
 )
 
 type ErrorCode struct {
@@ -44,138 +47,202 @@ func (e *ErrorCode) Error() string {
 	return e.Code
 }
 
+// Reason returns the reason of the ErrorInfo details of errors with the code.
+func (e ErrorCode) Reason() string {
+	if e.GrpcCode != "" {
+		return e.GrpcCode
+	}
+	return e.Code
+}
+
+// registry contains all error codes, in the order they are declared.
+var registry []ErrorCode
+
+func register(e ErrorCode) ErrorCode {
+	registry = append(registry, e)
+	return e
+}
+
+// Registered returns all error codes of the Dapr APIs, in the order they are
+// declared.
+func Registered() []ErrorCode {
+	return slices.Clone(registry)
+}
+
 var (
 	// ### Actors API
-	ActorInstanceMissing          = ErrorCode{"ERR_ACTOR_INSTANCE_MISSING", "", CategoryActor}        // Missing actor instance
-	ActorInvokeMethod             = ErrorCode{"ERR_ACTOR_INVOKE_METHOD", "", CategoryActor}           // Error invoking actor method
-	ActorRuntimeNotFound          = ErrorCode{"ERR_ACTOR_RUNTIME_NOT_FOUND", "", CategoryActor}       // Actor runtime not found
-	ActorStateGet                 = ErrorCode{"ERR_ACTOR_STATE_GET", "", CategoryActor}               // Error getting actor state
-	ActorStateTransactionSave     = ErrorCode{"ERR_ACTOR_STATE_TRANSACTION_SAVE", "", CategoryActor}  // Error saving actor transaction
-	ActorReminderCreate           = ErrorCode{"ERR_ACTOR_REMINDER_CREATE", "", CategoryActor}         // Error creating actor reminder
-	ActorReminderDelete           = ErrorCode{"ERR_ACTOR_REMINDER_DELETE", "", CategoryActor}         // Error deleting actor reminder
-	ActorReminderGet              = ErrorCode{"ERR_ACTOR_REMINDER_GET", "", CategoryActor}            // Error getting actor reminder
-	ActorReminderNonHosted        = ErrorCode{"ERR_ACTOR_REMINDER_NON_HOSTED", "", CategoryActor}     // Reminder operation on non-hosted actor type
-	ActorTypeReserved             = ErrorCode{"ERR_ACTOR_TYPE_RESERVED", "", CategoryActor}           // User-facing actor API targeting a Dapr-reserved internal actor type
-	ActorReminderNotFound         = ErrorCode{"ERR_ACTOR_REMINDER_NOT_FOUND", "", CategoryActor}      // Actor reminder not found
-	ActorReminderAlreadyExists    = ErrorCode{"ERR_ACTOR_REMINDER_ALREADY_EXISTS", "", CategoryActor} // Actor reminder already exists
-	ActorTimerCreate              = ErrorCode{"ERR_ACTOR_TIMER_CREATE", "", CategoryActor}            // Error creating actor timer
-	ErrActorNoAppChannel          = ErrorCode{"ERR_ACTOR_NO_APP_CHANNEL", "", CategoryActor}          // App channel not initialized
-	ErrActorMaxStackDepthExceeded = ErrorCode{"ERR_ACTOR_STACK_DEPTH", "", CategoryActor}             // Maximum actor call stack depth exceeded
-	ErrActorNoPlacement           = ErrorCode{"ERR_ACTOR_NO_PLACEMENT", "", CategoryActor}            // Placement service not configured
-	ErrActorRuntimeClosed         = ErrorCode{"ERR_ACTOR_RUNTIME_CLOSED", "", CategoryActor}          // Actor runtime is closed
-	ErrActorNamespaceRequired     = ErrorCode{"ERR_ACTOR_NAMESPACE_REQUIRED", "", CategoryActor}      // Actors must have a namespace configured when running in Kubernetes mode
-	ErrActorNoAddress             = ErrorCode{"ERR_ACTOR_NO_ADDRESS", "", CategoryActor}              // No address found for actor
+	ActorInstanceMissing          = register(ErrorCode{"ERR_ACTOR_INSTANCE_MISSING", "", CategoryActor})        // Missing actor instance
+	ActorInvokeMethod             = register(ErrorCode{"ERR_ACTOR_INVOKE_METHOD", "", CategoryActor})           // Error invoking actor method
+	ActorRuntimeNotFound          = register(ErrorCode{"ERR_ACTOR_RUNTIME_NOT_FOUND", "", CategoryActor})       // Actor runtime not found
+	ActorStateGet                 = register(ErrorCode{"ERR_ACTOR_STATE_GET", "", CategoryActor})               // Error getting actor state
+	ActorStateTransactionSave     = register(ErrorCode{"ERR_ACTOR_STATE_TRANSACTION_SAVE", "", CategoryActor})  // Error saving actor transaction
+	ActorReminderCreate           = register(ErrorCode{"ERR_ACTOR_REMINDER_CREATE", "", CategoryActor})         // Error creating actor reminder
+	ActorReminderDelete           = register(ErrorCode{"ERR_ACTOR_REMINDER_DELETE", "", CategoryActor})         // Error deleting actor reminder
+	ActorReminderGet              = register(ErrorCode{"ERR_ACTOR_REMINDER_GET", "", CategoryActor})            // Error getting actor reminder
+	ActorReminderList             = register(ErrorCode{"ERR_ACTOR_REMINDER_LIST", "", CategoryActor})           // Error listing actor reminders
+	ActorReminderNonHosted        = register(ErrorCode{"ERR_ACTOR_REMINDER_NON_HOSTED", "", CategoryActor})     // Reminder operation on non-hosted actor type
+	ActorTypeReserved             = register(ErrorCode{"ERR_ACTOR_TYPE_RESERVED", "", CategoryActor})           // User-facing actor API targeting a Dapr-reserved internal actor type
+	ActorReminderNotFound         = register(ErrorCode{"ERR_ACTOR_REMINDER_NOT_FOUND", "", CategoryActor})      // Actor reminder not found
+	ActorReminderAlreadyExists    = register(ErrorCode{"ERR_ACTOR_REMINDER_ALREADY_EXISTS", "", CategoryActor}) // Actor reminder already exists
+	ActorTimerCreate              = register(ErrorCode{"ERR_ACTOR_TIMER_CREATE", "", CategoryActor})            // Error creating actor timer
+	ErrActorNoAppChannel          = register(ErrorCode{"ERR_ACTOR_NO_APP_CHANNEL", "", CategoryActor})          // App channel not initialized
+	ErrActorMaxStackDepthExceeded = register(ErrorCode{"ERR_ACTOR_STACK_DEPTH", "", CategoryActor})             // Maximum actor call stack depth exceeded
+	ErrActorNoPlacement           = register(ErrorCode{"ERR_ACTOR_NO_PLACEMENT", "", CategoryActor})            // Placement service not configured
+	ErrActorRuntimeClosed         = register(ErrorCode{"ERR_ACTOR_RUNTIME_CLOSED", "", CategoryActor})          // Actor runtime is closed
+	ErrActorNamespaceRequired     = register(ErrorCode{"ERR_ACTOR_NAMESPACE_REQUIRED", "", CategoryActor})      // Actors must have a namespace configured when running in Kubernetes mode
+	ErrActorNoAddress             = register(ErrorCode{"ERR_ACTOR_NO_ADDRESS", "", CategoryActor})              // No address found for actor
 
 	// ### Workflows API
-	WorkflowGet                       = ErrorCode{"ERR_GET_WORKFLOW", "", CategoryWorkflow}                 // Error getting workflow
-	WorkflowStart                     = ErrorCode{"ERR_START_WORKFLOW", "", CategoryWorkflow}               // Error starting workflow
-	WorkflowPause                     = ErrorCode{"ERR_PAUSE_WORKFLOW", "", CategoryWorkflow}               // Error pausing workflow
-	WorkflowResume                    = ErrorCode{"ERR_RESUME_WORKFLOW", "", CategoryWorkflow}              // Error resuming workflow
-	WorkflowTerminate                 = ErrorCode{"ERR_TERMINATE_WORKFLOW", "", CategoryWorkflow}           // Error terminating workflow
-	WorkflowPurge                     = ErrorCode{"ERR_PURGE_WORKFLOW", "", CategoryWorkflow}               // Error purging workflow
-	WorkflowRaiseEvent                = ErrorCode{"ERR_RAISE_EVENT_WORKFLOW", "", CategoryWorkflow}         // Error raising event in workflow
-	WorkflowComponentMissing          = ErrorCode{"ERR_WORKFLOW_COMPONENT_MISSING", "", CategoryWorkflow}   // Missing workflow component
-	WorkflowComponentNotFound         = ErrorCode{"ERR_WORKFLOW_COMPONENT_NOT_FOUND", "", CategoryWorkflow} // Workflow component not found
-	WorkflowEventNameMissing          = ErrorCode{"ERR_WORKFLOW_EVENT_NAME_MISSING", "", CategoryWorkflow}  // Missing workflow event name
-	WorkflowNameMissing               = ErrorCode{"ERR_WORKFLOW_NAME_MISSING", "", CategoryWorkflow}        // Workflow name not configured
-	WorkflowInstanceIDInvalid         = ErrorCode{"ERR_INSTANCE_ID_INVALID", "", CategoryWorkflow}          // Invalid workflow instance ID. (Only alphanumeric and underscore characters are allowed)
-	WorkflowInstanceIDNotFound        = ErrorCode{"ERR_INSTANCE_ID_NOT_FOUND", "", CategoryWorkflow}        // Workflow instance ID not found
-	WorkflowInstanceIDProvidedMissing = ErrorCode{"ERR_INSTANCE_ID_PROVIDED_MISSING", "", CategoryWorkflow} // Missing workflow instance ID
-	WorkflowInstanceIDTooLong         = ErrorCode{"ERR_INSTANCE_ID_TOO_LONG", "", CategoryWorkflow}         // Workflow instance ID too long
+	WorkflowGet                       = register(ErrorCode{"ERR_GET_WORKFLOW", "", CategoryWorkflow})                 // Error getting workflow
+	WorkflowList                      = register(ErrorCode{"ERR_LIST_WORKFLOWS", "", CategoryWorkflow})               // Error listing workflow instances
+	WorkflowHistory                   = register(ErrorCode{"ERR_GET_WORKFLOW_HISTORY", "", CategoryWorkflow})         // Error exporting workflow history
+	WorkflowReplay                    = register(ErrorCode{"ERR_REPLAY_WORKFLOW", "", CategoryWorkflow})              // Error replaying workflow
+	WorkflowStart                     = register(ErrorCode{"ERR_START_WORKFLOW", "", CategoryWorkflow})               // Error starting workflow
+	WorkflowPause                     = register(ErrorCode{"ERR_PAUSE_WORKFLOW", "", CategoryWorkflow})               // Error pausing workflow
+	WorkflowResume                    = register(ErrorCode{"ERR_RESUME_WORKFLOW", "", CategoryWorkflow})              // Error resuming workflow
+	WorkflowTerminate                 = register(ErrorCode{"ERR_TERMINATE_WORKFLOW", "", CategoryWorkflow})           // Error terminating workflow
+	WorkflowPurge                     = register(ErrorCode{"ERR_PURGE_WORKFLOW", "", CategoryWorkflow})               // Error purging workflow
+	WorkflowRaiseEvent                = register(ErrorCode{"ERR_RAISE_EVENT_WORKFLOW", "", CategoryWorkflow})         // Error raising event in workflow
+	WorkflowComponentMissing          = register(ErrorCode{"ERR_WORKFLOW_COMPONENT_MISSING", "", CategoryWorkflow})   // Missing workflow component
+	WorkflowComponentNotFound         = register(ErrorCode{"ERR_WORKFLOW_COMPONENT_NOT_FOUND", "", CategoryWorkflow}) // Workflow component not found
+	WorkflowEventNameMissing          = register(ErrorCode{"ERR_WORKFLOW_EVENT_NAME_MISSING", "", CategoryWorkflow})  // Missing workflow event name
+	WorkflowNameMissing               = register(ErrorCode{"ERR_WORKFLOW_NAME_MISSING", "", CategoryWorkflow})        // Workflow name not configured
+	WorkflowInstanceIDInvalid         = register(ErrorCode{"ERR_INSTANCE_ID_INVALID", "", CategoryWorkflow})          // Invalid workflow instance ID. (Only alphanumeric and underscore characters are allowed)
+	WorkflowInstanceIDNotFound        = register(ErrorCode{"ERR_INSTANCE_ID_NOT_FOUND", "", CategoryWorkflow})        // Workflow instance ID not found
+	WorkflowInstanceIDProvidedMissing = register(ErrorCode{"ERR_INSTANCE_ID_PROVIDED_MISSING", "", CategoryWorkflow}) // Missing workflow instance ID
+	WorkflowInstanceIDTooLong         = register(ErrorCode{"ERR_INSTANCE_ID_TOO_LONG", "", CategoryWorkflow})         // Workflow instance ID too long
 
 	// ### State management API
-	StateTransaction                   = ErrorCode{"ERR_STATE_TRANSACTION", "", CategoryState}                                                 // Error in state transaction
-	StateSave                          = ErrorCode{"ERR_STATE_SAVE", "", CategoryState}                                                        // Error saving state
-	StateGet                           = ErrorCode{"ERR_STATE_GET", "", CategoryState}                                                         // Error getting state
-	StateDelete                        = ErrorCode{"ERR_STATE_DELETE", "", CategoryState}                                                      // Error deleting state
-	StateBulkDelete                    = ErrorCode{"ERR_STATE_BULK_DELETE", "", CategoryState}                                                 // Error deleting state in bulk
-	StateBulkGet                       = ErrorCode{"ERR_STATE_BULK_GET", "", CategoryState}                                                    // Error getting state in bulk
-	StateNotSupportedOperation         = ErrorCode{"ERR_NOT_SUPPORTED_STATE_OPERATION", "", CategoryState}                                     // Operation not supported in transaction
-	StateQuery                         = ErrorCode{"ERR_STATE_QUERY", "DAPR_STATE_QUERY_FAILED", CategoryState}                                // Error querying state
-	StateStoreNotFound                 = ErrorCode{"ERR_STATE_STORE_NOT_FOUND", "DAPR_STATE_NOT_FOUND", CategoryState}                         // State store not found
-	StateStoreNotConfigured            = ErrorCode{"ERR_STATE_STORE_NOT_CONFIGURED", "DAPR_STATE_NOT_CONFIGURED", CategoryState}               // State store not configured
-	StateStoreTransactionsNotSupported = ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_TRANSACTIONS_NOT_SUPPORTED", CategoryState}    // State store does not support transactions
-	StateStoreQueryNotSupported        = ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_QUERYING_NOT_SUPPORTED", CategoryState}        // State store does not support querying
-	StateStoreTooManyTransactions      = ErrorCode{"ERR_STATE_STORE_TOO_MANY_TRANSACTIONS", "DAPR_STATE_TOO_MANY_TRANSACTIONS", CategoryState} // Too many operations per transaction
-	StateMalformedRequest              = ErrorCode{"ERR_MALFORMED_REQUEST", "DAPR_STATE_ILLEGAL_KEY", CategoryState}                           // Invalid key
+	StateTransaction                   = register(ErrorCode{"ERR_STATE_TRANSACTION", "", CategoryState})                                                 // Error in state transaction
+	StateTransactionETagMismatch       = register(ErrorCode{"ERR_STATE_TRANSACTION", "DAPR_STATE_TRANSACTION_ETAG_MISMATCH", CategoryState})             // ETag mismatch of operations in state transaction
+	StateSave                          = register(ErrorCode{"ERR_STATE_SAVE", "", CategoryState})                                                        // Error saving state
+	StateGet                           = register(ErrorCode{"ERR_STATE_GET", "", CategoryState})                                                         // Error getting state
+	StateDelete                        = register(ErrorCode{"ERR_STATE_DELETE", "", CategoryState})                                                      // Error deleting state
+	StateBulkDelete                    = register(ErrorCode{"ERR_STATE_BULK_DELETE", "", CategoryState})                                                 // Error deleting state in bulk
+	StateBulkGet                       = register(ErrorCode{"ERR_STATE_BULK_GET", "", CategoryState})                                                    // Error getting state in bulk
+	StateNotSupportedOperation         = register(ErrorCode{"ERR_NOT_SUPPORTED_STATE_OPERATION", "", CategoryState})                                     // Operation not supported in transaction
+	StateQuery                         = register(ErrorCode{"ERR_STATE_QUERY", "DAPR_STATE_QUERY_FAILED", CategoryState})                                // Error querying state
+	StateQueryInvalid                  = register(ErrorCode{"ERR_STATE_QUERY", "DAPR_STATE_QUERY_INVALID", CategoryState})                               // Invalid state query or pagination token
+	StateWatch                         = register(ErrorCode{"ERR_STATE_WATCH", "DAPR_STATE_WATCH_FAILED", CategoryState})                                // Error watching state
+	StateWatchInvalid                  = register(ErrorCode{"ERR_STATE_WATCH", "DAPR_STATE_WATCH_INVALID", CategoryState})                               // Invalid state watch request
+	StateStoreNotFound                 = register(ErrorCode{"ERR_STATE_STORE_NOT_FOUND", "DAPR_STATE_NOT_FOUND", CategoryState})                         // State store not found
+	StateStoreNotConfigured            = register(ErrorCode{"ERR_STATE_STORE_NOT_CONFIGURED", "DAPR_STATE_NOT_CONFIGURED", CategoryState})               // State store not configured
+	StateStoreTransactionsNotSupported = register(ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_TRANSACTIONS_NOT_SUPPORTED", CategoryState})    // State store does not support transactions
+	StateStoreQueryNotSupported        = register(ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_QUERYING_NOT_SUPPORTED", CategoryState})        // State store does not support querying
+	StateStoreWatchNotSupported        = register(ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_WATCH_NOT_SUPPORTED", CategoryState})           // State store does not support the watch request
+	StateStoreTTLNotSupported          = register(ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_TTL_NOT_SUPPORTED", CategoryState})             // State store does not support TTLs
+	StateTTLInvalid                    = register(ErrorCode{"ERR_STATE_SAVE", "DAPR_STATE_TTL_INVALID", CategoryState})                                  // Invalid state time to live
+	StateStoreListKeysNotSupported     = register(ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_LIST_KEYS_NOT_SUPPORTED", CategoryState})       // State store cannot list its keys
+	StateMigrationUnauthenticated      = register(ErrorCode{"ERR_STATE_MIGRATION", "DAPR_STATE_MIGRATION_UNAUTHENTICATED", CategoryState})               // State migration without API token authentication
+	StateMigrationInvalid              = register(ErrorCode{"ERR_STATE_MIGRATION", "DAPR_STATE_MIGRATION_INVALID", CategoryState})                       // Invalid state migration
+	StateMigrationInProgress           = register(ErrorCode{"ERR_STATE_MIGRATION", "DAPR_STATE_MIGRATION_IN_PROGRESS", CategoryState})                   // State migration of the state store already running
+	StateMigration                     = register(ErrorCode{"ERR_STATE_MIGRATION", "DAPR_STATE_MIGRATION_FAILED", CategoryState})                        // State migration failed
+	StateStoreTooManyTransactions      = register(ErrorCode{"ERR_STATE_STORE_TOO_MANY_TRANSACTIONS", "DAPR_STATE_TOO_MANY_TRANSACTIONS", CategoryState}) // Too many operations per transaction
+	StateMalformedRequest              = register(ErrorCode{"ERR_MALFORMED_REQUEST", "DAPR_STATE_ILLEGAL_KEY", CategoryState})                           // Invalid key
+	StateKeyPrefixNotAllowed           = register(ErrorCode{"ERR_STATE_KEY_PREFIX_NOT_ALLOWED", "DAPR_STATE_KEY_PREFIX_NOT_ALLOWED", CategoryState})     // Key prefix override not allowed by the state store
 
 	// ### Configuration API
-	ConfigurationGet                = ErrorCode{"ERR_CONFIGURATION_GET", "", CategoryConfiguration}                  // Error getting configuration
-	ConfigurationStoreNotConfigured = ErrorCode{"ERR_CONFIGURATION_STORE_NOT_CONFIGURED", "", CategoryConfiguration} // Configuration store not configured
-	ConfigurationStoreNotFound      = ErrorCode{"ERR_CONFIGURATION_STORE_NOT_FOUND", "", CategoryConfiguration}      // Configuration store not found
-	ConfigurationSubscribe          = ErrorCode{"ERR_CONFIGURATION_SUBSCRIBE", "", CategoryConfiguration}            // Error subscribing to configuration
-	ConfigurationUnsubscribe        = ErrorCode{"ERR_CONFIGURATION_UNSUBSCRIBE", "", CategoryConfiguration}          // Error unsubscribing from configuration
+	ConfigurationGet                = register(ErrorCode{"ERR_CONFIGURATION_GET", "", CategoryConfiguration})                  // Error getting configuration
+	ConfigurationStoreNotConfigured = register(ErrorCode{"ERR_CONFIGURATION_STORE_NOT_CONFIGURED", "", CategoryConfiguration}) // Configuration store not configured
+	ConfigurationStoreNotFound      = register(ErrorCode{"ERR_CONFIGURATION_STORE_NOT_FOUND", "", CategoryConfiguration})      // Configuration store not found
+	ConfigurationSubscribe          = register(ErrorCode{"ERR_CONFIGURATION_SUBSCRIBE", "", CategoryConfiguration})            // Error subscribing to configuration
+	ConfigurationSubscribeInvalid   = register(ErrorCode{"ERR_CONFIGURATION_SUBSCRIBE_INVALID", "", CategoryConfiguration})    // Invalid configuration subscription
+	ConfigurationUnsubscribe        = register(ErrorCode{"ERR_CONFIGURATION_UNSUBSCRIBE", "", CategoryConfiguration})          // Error unsubscribing from configuration
 
 	// ### Crypto API
-	Crypto                       = ErrorCode{"ERR_CRYPTO", "", CategoryCrypto}                          // Error in crypto operation
-	CryptoKey                    = ErrorCode{"ERR_CRYPTO_KEY", "", CategoryCrypto}                      // Error retrieving crypto key
-	CryptoProviderNotFound       = ErrorCode{"ERR_CRYPTO_PROVIDER_NOT_FOUND", "", CategoryCrypto}       // Crypto provider not found
-	CryptoProvidersNotConfigured = ErrorCode{"ERR_CRYPTO_PROVIDERS_NOT_CONFIGURED", "", CategoryCrypto} // Crypto providers not configured
+	Crypto                       = register(ErrorCode{"ERR_CRYPTO", "", CategoryCrypto})                          // Error in crypto operation
+	CryptoKey                    = register(ErrorCode{"ERR_CRYPTO_KEY", "", CategoryCrypto})                      // Error retrieving crypto key
+	CryptoProviderNotFound       = register(ErrorCode{"ERR_CRYPTO_PROVIDER_NOT_FOUND", "", CategoryCrypto})       // Crypto provider not found
+	CryptoProvidersNotConfigured = register(ErrorCode{"ERR_CRYPTO_PROVIDERS_NOT_CONFIGURED", "", CategoryCrypto}) // Crypto providers not configured
+	CryptoSign                   = register(ErrorCode{"ERR_CRYPTO_SIGN", "", CategoryCrypto})                     // Error signing with crypto key
+	CryptoVerify                 = register(ErrorCode{"ERR_CRYPTO_VERIFY", "", CategoryCrypto})                   // Error verifying signature with crypto key
+	CryptoWrapKey                = register(ErrorCode{"ERR_CRYPTO_WRAP_KEY", "", CategoryCrypto})                 // Error wrapping key with crypto key
+	CryptoUnwrapKey              = register(ErrorCode{"ERR_CRYPTO_UNWRAP_KEY", "", CategoryCrypto})               // Error unwrapping key with crypto key
 
 	// ### Secrets API
-	SecretGet                = ErrorCode{"ERR_SECRET_GET", "", CategorySecret}                   // Error getting secret
-	SecretStoreNotFound      = ErrorCode{"ERR_SECRET_STORE_NOT_FOUND", "", CategorySecret}       // Secret store not found
-	SecretStoreNotConfigured = ErrorCode{"ERR_SECRET_STORES_NOT_CONFIGURED", "", CategorySecret} // Secret store not configured
-	SecretPermissionDenied   = ErrorCode{"ERR_PERMISSION_DENIED", "", CategorySecret}            // Permission denied by policy
+	SecretGet                = register(ErrorCode{"ERR_SECRET_GET", "", CategorySecret})                   // Error getting secret
+	SecretStoreNotFound      = register(ErrorCode{"ERR_SECRET_STORE_NOT_FOUND", "", CategorySecret})       // Secret store not found
+	SecretStoreNotConfigured = register(ErrorCode{"ERR_SECRET_STORES_NOT_CONFIGURED", "", CategorySecret}) // Secret store not configured
+	SecretPermissionDenied   = register(ErrorCode{"ERR_PERMISSION_DENIED", "", CategorySecret})            // Permission denied by policy
+	SecretNameFilterInvalid  = register(ErrorCode{"ERR_SECRET_NAME_FILTER_INVALID", "", CategorySecret})   // Invalid secret name filter
+	SecretWatch              = register(ErrorCode{"ERR_SECRET_WATCH", "", CategorySecret})                 // Error watching secrets
+	SecretWatchInvalid       = register(ErrorCode{"ERR_SECRET_WATCH_INVALID", "", CategorySecret})         // Invalid secret watch request
+	SecretCacheNotEnabled    = register(ErrorCode{"ERR_SECRET_CACHE_NOT_ENABLED", "", CategorySecret})     // Secret store cache not enabled
 
 	// ### Pub/Sub and messaging errors
-	PubSubEmpty                 = ErrorCode{"ERR_PUBSUB_EMPTY", "DAPR_PUBSUB_NAME_EMPTY", CategoryPubsub}                          // Pubsub name is empty
-	PubSubNotFound              = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_NOT_FOUND", CategoryPubsub}                       // Pubsub not found
-	PubSubTestNotFound          = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_TEST_NOT_FOUND", CategoryPubsub}                  // Pubsub not found
-	PubSubNotConfigured         = ErrorCode{"ERR_PUBSUB_NOT_CONFIGURED", "DAPR_PUBSUB_NOT_CONFIGURED", CategoryPubsub}             // Pubsub not configured
-	PubSubTopicNameEmpty        = ErrorCode{"ERR_TOPIC_NAME_EMPTY", "DAPR_PUBSUB_TOPIC_NAME_EMPTY", CategoryPubsub}                // Topic name is empty
-	PubsubForbidden             = ErrorCode{"ERR_PUBSUB_FORBIDDEN", "DAPR_PUBSUB_FORBIDDEN", CategoryPubsub}                       // Access to topic forbidden for APP ID
-	PubsubPublishMessage        = ErrorCode{"ERR_PUBSUB_PUBLISH_MESSAGE", "DAPR_PUBSUB_PUBLISH_MESSAGE", CategoryPubsub}           // Error publishing message
-	PubSubRequestMetadata       = ErrorCode{"ERR_PUBSUB_REQUEST_METADATA", "DAPR_PUBSUB_METADATA_DESERIALIZATION", CategoryPubsub} // Error deserializing metadata
-	PubSubCloudEventsSer        = ErrorCode{"ERR_PUBSUB_CLOUD_EVENTS_SER", "DAPR_PUBSUB_CLOUD_EVENT_CREATION", CategoryPubsub}     // Error creating CloudEvent
-	PubSubEventsSerEnvelope     = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_ENVELOPE", CategoryPubsub}               // Error marshalling Cloud Event envelope
-	PubSubEventsMarshalEvents   = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_EVENTS", CategoryPubsub}                 // Error marshalling events to bytes
-	PubSubEventsUnmarshalEvents = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_UNMARSHAL_EVENTS", CategoryPubsub}               // Error unmarshalling events
-	PubsubPublishOutbox         = ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub}                                              // Error publishing message to outbox
+	PubSubEmpty                 = register(ErrorCode{"ERR_PUBSUB_EMPTY", "DAPR_PUBSUB_NAME_EMPTY", CategoryPubsub})                              // Pubsub name is empty
+	PubSubNotFound              = register(ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_NOT_FOUND", CategoryPubsub})                           // Pubsub not found
+	PubSubTestNotFound          = register(ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_TEST_NOT_FOUND", CategoryPubsub})                      // Pubsub not found
+	PubSubNotConfigured         = register(ErrorCode{"ERR_PUBSUB_NOT_CONFIGURED", "DAPR_PUBSUB_NOT_CONFIGURED", CategoryPubsub})                 // Pubsub not configured
+	PubSubTopicNameEmpty        = register(ErrorCode{"ERR_TOPIC_NAME_EMPTY", "DAPR_PUBSUB_TOPIC_NAME_EMPTY", CategoryPubsub})                    // Topic name is empty
+	PubsubForbidden             = register(ErrorCode{"ERR_PUBSUB_FORBIDDEN", "DAPR_PUBSUB_FORBIDDEN", CategoryPubsub})                           // Access to topic forbidden for APP ID
+	PubsubPublishMessage        = register(ErrorCode{"ERR_PUBSUB_PUBLISH_MESSAGE", "DAPR_PUBSUB_PUBLISH_MESSAGE", CategoryPubsub})               // Error publishing message
+	PubSubRequestMetadata       = register(ErrorCode{"ERR_PUBSUB_REQUEST_METADATA", "DAPR_PUBSUB_METADATA_DESERIALIZATION", CategoryPubsub})     // Error deserializing metadata
+	PubSubCloudEventsSer        = register(ErrorCode{"ERR_PUBSUB_CLOUD_EVENTS_SER", "DAPR_PUBSUB_CLOUD_EVENT_CREATION", CategoryPubsub})         // Error creating CloudEvent
+	PubSubEventsSerEnvelope     = register(ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_ENVELOPE", CategoryPubsub})                   // Error marshalling Cloud Event envelope
+	PubSubEventsMarshalEvents   = register(ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_EVENTS", CategoryPubsub})                     // Error marshalling events to bytes
+	PubSubEventsUnmarshalEvents = register(ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_UNMARSHAL_EVENTS", CategoryPubsub})                   // Error unmarshalling events
+	PubsubPublishOutbox         = register(ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub})                                                  // Error publishing message to outbox
+	PubSubSubscriptionNotFound  = register(ErrorCode{"ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", "DAPR_PUBSUB_SUBSCRIPTION_NOT_FOUND", CategoryPubsub}) // App not subscribed to topic
+	PubSubSubscriptionUpdate    = register(ErrorCode{"ERR_PUBSUB_SUBSCRIPTION_UPDATE", "DAPR_PUBSUB_SUBSCRIPTION_UPDATE", CategoryPubsub})       // Error pausing or resuming subscription
 
 	// ### Conversation API
-	ConversationInvalidParms  = ErrorCode{"ERR_CONVERSATION_INVALID_PARMS", "", CategoryConversation}  // Invalid parameters for conversation component
-	ConversationInvoke        = ErrorCode{"ERR_CONVERSATION_INVOKE", "", CategoryConversation}         // Error invoking conversation
-	ConversationMissingInputs = ErrorCode{"ERR_CONVERSATION_MISSING_INPUTS", "", CategoryConversation} // Missing inputs for conversation
-	ConversationNotFound      = ErrorCode{"ERR_CONVERSATION_NOT_FOUND", "", CategoryConversation}      // Conversation not found
+	ConversationInvalidParms  = register(ErrorCode{"ERR_CONVERSATION_INVALID_PARMS", "", CategoryConversation})  // Invalid parameters for conversation component
+	ConversationInvoke        = register(ErrorCode{"ERR_CONVERSATION_INVOKE", "", CategoryConversation})         // Error invoking conversation
+	ConversationMissingInputs = register(ErrorCode{"ERR_CONVERSATION_MISSING_INPUTS", "", CategoryConversation}) // Missing inputs for conversation
+	ConversationNotFound      = register(ErrorCode{"ERR_CONVERSATION_NOT_FOUND", "", CategoryConversation})      // Conversation not found
 
 	// ### Service Invocation / Direct Messaging API
-	ServiceInvocationDirectInvoke = ErrorCode{"ERR_DIRECT_INVOKE", "", CategoryServiceInvocation} // Error invoking service
+	ServiceInvocationDirectInvoke     = register(ErrorCode{"ERR_DIRECT_INVOKE", "", CategoryServiceInvocation})                    // Error invoking service
+	ServiceInvocationRequestTooLarge  = register(ErrorCode{"ERR_DIRECT_INVOKE_REQUEST_TOO_LARGE", "", CategoryServiceInvocation})  // Request body exceeds the limit of the target app
+	ServiceInvocationResponseTooLarge = register(ErrorCode{"ERR_DIRECT_INVOKE_RESPONSE_TOO_LARGE", "", CategoryServiceInvocation}) // Response body exceeds the limit of the target app
 
 	// ### Bindings API
-	BindingInvokeOutputBinding = ErrorCode{"ERR_INVOKE_OUTPUT_BINDING", "", CategoryBinding} // Error invoking output binding
+	BindingInvokeOutputBinding = register(ErrorCode{"ERR_INVOKE_OUTPUT_BINDING", "", CategoryBinding})   // Error invoking output binding
+	BindingInputNotFound       = register(ErrorCode{"ERR_INPUT_BINDING_NOT_FOUND", "", CategoryBinding}) // Input binding not found
+	BindingInputUpdate         = register(ErrorCode{"ERR_INPUT_BINDING_UPDATE", "", CategoryBinding})    // Error pausing or resuming input binding
 
 	// ### Distributed Lock API
-	LockStoreNotConfigured = ErrorCode{"ERR_LOCK_STORE_NOT_CONFIGURED", "", CategoryLock} // Lock store not configured
-	LockStoreNotFound      = ErrorCode{"ERR_LOCK_STORE_NOT_FOUND", "", CategoryLock}      // Lock store not found
-	LockTry                = ErrorCode{"ERR_TRY_LOCK", "", CategoryLock}                  // Error acquiring lock
-	LockUnlock             = ErrorCode{"ERR_UNLOCK", "", CategoryLock}                    // Error releasing lock
+	LockStoreNotConfigured = register(ErrorCode{"ERR_LOCK_STORE_NOT_CONFIGURED", "", CategoryLock}) // Lock store not configured
+	LockStoreNotFound      = register(ErrorCode{"ERR_LOCK_STORE_NOT_FOUND", "", CategoryLock})      // Lock store not found
+	LockTry                = register(ErrorCode{"ERR_TRY_LOCK", "", CategoryLock})                  // Error acquiring lock
+	LockUnlock             = register(ErrorCode{"ERR_UNLOCK", "", CategoryLock})                    // Error releasing lock
+	LockRenew              = register(ErrorCode{"ERR_RENEW_LOCK", "", CategoryLock})                // Error renewing lock
 
 	// ### Healthz
-	HealthNotReady         = ErrorCode{"ERR_HEALTH_NOT_READY", "", CategoryHealth}          // Dapr not ready
-	HealthAppidNotMatch    = ErrorCode{"ERR_HEALTH_APPID_NOT_MATCH", "", CategoryHealth}    // Dapr  App ID does not match
-	HealthOutboundNotReady = ErrorCode{"ERR_OUTBOUND_HEALTH_NOT_READY", "", CategoryHealth} // Dapr outbound not ready
+	HealthNotReady         = register(ErrorCode{"ERR_HEALTH_NOT_READY", "", CategoryHealth})          // Dapr not ready
+	HealthAppidNotMatch    = register(ErrorCode{"ERR_HEALTH_APPID_NOT_MATCH", "", CategoryHealth})    // Dapr  App ID does not match
+	HealthOutboundNotReady = register(ErrorCode{"ERR_OUTBOUND_HEALTH_NOT_READY", "", CategoryHealth}) // Dapr outbound not ready
+
+	// ### Identity API
+	IdentityJWTNotAvailable = register(ErrorCode{"ERR_IDENTITY_JWT_NOT_AVAILABLE", "", CategoryIdentity}) // JWT-SVIDs are not available
+	IdentityJWTAudience     = register(ErrorCode{"ERR_IDENTITY_JWT_AUDIENCE", "", CategoryIdentity})      // JWT-SVID audience not allowed
 
 	// ### Common
-	CommonAPIUnimplemented     = ErrorCode{"ERR_API_UNIMPLEMENTED", "", CategoryCommon}      // API not implemented
-	CommonAppChannelNil        = ErrorCode{"ERR_APP_CHANNEL_NIL", "", CategoryCommon}        // App channel is nil
-	CommonBadRequest           = ErrorCode{"ERR_BAD_REQUEST", "", CategoryCommon}            // Bad request
-	CommonBodyRead             = ErrorCode{"ERR_BODY_READ", "", CategoryCommon}              // Error reading request body
-	CommonInternal             = ErrorCode{"ERR_INTERNAL", "", CategoryCommon}               // Internal error
-	CommonMalformedRequest     = ErrorCode{"ERR_MALFORMED_REQUEST", "", CategoryCommon}      // Malformed request
-	CommonMalformedRequestData = ErrorCode{"ERR_MALFORMED_REQUEST_DATA", "", CategoryCommon} // Malformed request data
-	CommonMalformedResponse    = ErrorCode{"ERR_MALFORMED_RESPONSE", "", CategoryCommon}     // Malformed response
+	CommonAPIUnimplemented     = register(ErrorCode{"ERR_API_UNIMPLEMENTED", "", CategoryCommon})      // API not implemented
+	CommonAPIMethodDenied      = register(ErrorCode{"ERR_API_METHOD_DENIED", "", CategoryCommon})      // API method denied by the API configuration
+	CommonAppChannelNil        = register(ErrorCode{"ERR_APP_CHANNEL_NIL", "", CategoryCommon})        // App channel is nil
+	CommonBadRequest           = register(ErrorCode{"ERR_BAD_REQUEST", "", CategoryCommon})            // Bad request
+	CommonBodyRead             = register(ErrorCode{"ERR_BODY_READ", "", CategoryCommon})              // Error reading request body
+	CommonInternal             = register(ErrorCode{"ERR_INTERNAL", "", CategoryCommon})               // Internal error
+	CommonMalformedRequest     = register(ErrorCode{"ERR_MALFORMED_REQUEST", "", CategoryCommon})      // Malformed request
+	CommonMalformedRequestData = register(ErrorCode{"ERR_MALFORMED_REQUEST_DATA", "", CategoryCommon}) // Malformed request data
+	CommonMalformedResponse    = register(ErrorCode{"ERR_MALFORMED_RESPONSE", "", CategoryCommon})     // Malformed response
+	CommonReload               = register(ErrorCode{"ERR_RELOAD", "", CategoryCommon})                 // Error reloading the configuration
+	CommonFaultInjection       = register(ErrorCode{"ERR_FAULT_INJECTION", "", CategoryCommon})        // Error injecting or deleting a fault
 
 	// ### Scheduler/Jobs API
-	SchedulerScheduleJob   = ErrorCode{"DAPR_SCHEDULER_SCHEDULE_JOB", "DAPR_SCHEDULER_SCHEDULE_JOB", CategoryJob}     // Error scheduling job
-	SchedulerJobName       = ErrorCode{"DAPR_SCHEDULER_JOB_NAME", "DAPR_SCHEDULER_JOB_NAME", CategoryJob}             // Job name should only be set in the url
-	SchedulerJobNameEmpty  = ErrorCode{"DAPR_SCHEDULER_JOB_NAME_EMPTY", "DAPR_SCHEDULER_JOB_NAME_EMPTY", CategoryJob} // Job name is empty
-	SchedulerGetJob        = ErrorCode{"DAPR_SCHEDULER_GET_JOB", "DAPR_SCHEDULER_GET_JOB", CategoryJob}               // Error getting job
-	SchedulerListJobs      = ErrorCode{"DAPR_SCHEDULER_LIST_JOBS", "DAPR_SCHEDULER_LIST_JOBS", CategoryJob}           // Error listing jobs
-	SchedulerDeleteJob     = ErrorCode{"DAPR_SCHEDULER_DELETE_JOB", "DAPR_SCHEDULER_DELETE_JOB", CategoryJob}         // Error deleting job
-	SchedulerEmpty         = ErrorCode{"DAPR_SCHEDULER_EMPTY", "DAPR_SCHEDULER_EMPTY", CategoryJob}                   // Required argument is empty
-	SchedulerScheduleEmpty = ErrorCode{"DAPR_SCHEDULER_SCHEDULE_EMPTY", "DAPR_SCHEDULER_SCHEDULE_EMPTY", CategoryJob} // No schedule provided for job
+	SchedulerScheduleJob    = register(ErrorCode{"DAPR_SCHEDULER_SCHEDULE_JOB", "DAPR_SCHEDULER_SCHEDULE_JOB", CategoryJob})         // Error scheduling job
+	SchedulerJobName        = register(ErrorCode{"DAPR_SCHEDULER_JOB_NAME", "DAPR_SCHEDULER_JOB_NAME", CategoryJob})                 // Job name should only be set in the url
+	SchedulerJobNameEmpty   = register(ErrorCode{"DAPR_SCHEDULER_JOB_NAME_EMPTY", "DAPR_SCHEDULER_JOB_NAME_EMPTY", CategoryJob})     // Job name is empty
+	SchedulerGetJob         = register(ErrorCode{"DAPR_SCHEDULER_GET_JOB", "DAPR_SCHEDULER_GET_JOB", CategoryJob})                   // Error getting job
+	SchedulerListJobs       = register(ErrorCode{"DAPR_SCHEDULER_LIST_JOBS", "DAPR_SCHEDULER_LIST_JOBS", CategoryJob})               // Error listing jobs
+	SchedulerListJobsFilter = register(ErrorCode{"DAPR_SCHEDULER_LIST_JOBS_FILTER", "DAPR_SCHEDULER_LIST_JOBS_FILTER", CategoryJob}) // Invalid list jobs filter
+	SchedulerDeleteJob      = register(ErrorCode{"DAPR_SCHEDULER_DELETE_JOB", "DAPR_SCHEDULER_DELETE_JOB", CategoryJob})             // Error deleting job
+	SchedulerEmpty          = register(ErrorCode{"DAPR_SCHEDULER_EMPTY", "DAPR_SCHEDULER_EMPTY", CategoryJob})                       // Required argument is empty
+	SchedulerScheduleEmpty  = register(ErrorCode{"DAPR_SCHEDULER_SCHEDULE_EMPTY", "DAPR_SCHEDULER_SCHEDULE_EMPTY", CategoryJob})     // No schedule provided for job
+	SchedulerJobCalendar    = register(ErrorCode{"DAPR_SCHEDULER_JOB_CALENDAR", "DAPR_SCHEDULER_JOB_CALENDAR", CategoryJob})         // Job calendar not found in the Configuration
 
 	// ### Generic
-	CommonGeneric = ErrorCode{"ERROR", "ERROR", CategoryCommon} // Generic error
+	CommonGeneric = register(ErrorCode{"ERROR", "ERROR", CategoryCommon}) // Generic error
 )
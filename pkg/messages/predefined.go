@@ -46,15 +46,20 @@ const (
 	ErrChannelNotFound       = "app channel is not initialized"
 	ErrInternalInvokeRequest = "parsing InternalInvokeRequest error: %s"
 	ErrChannelInvoke         = "error invoking app channel: %s"
+	ErrAppCallbackDenied     = "the %s callbacks of the app are denied by the API configuration"
 
 	// AppHealth.
 	ErrAppUnhealthy = "app is not in a healthy state"
 
+	// RateLimit.
+	ErrRateLimited = "rate limit exceeded"
+
 	// Configuration.
 	ErrConfigurationStoresNotConfigured = "configuration stores not configured"
 	ErrConfigurationStoreNotFound       = "configuration store %s not found"
 	ErrConfigurationGet                 = "failed to get %s from Configuration store %s: %v"
 	ErrConfigurationSubscribe           = "failed to subscribe %s from Configuration store %s: %v"
+	ErrConfigurationSubscribeInvalid    = "invalid subscription to configuration store %s: %v"
 	ErrConfigurationUnsubscribe         = "failed to unsubscribe to configuration request %s: %v"
 )
 
@@ -62,15 +67,31 @@ var (
 	// Generic.
 	ErrBadRequest       = APIError{"invalid request: %v", errorcodes.CommonBadRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrAPIUnimplemented = APIError{"this API is currently not implemented", errorcodes.CommonAPIUnimplemented, http.StatusNotImplemented, grpcCodes.Unimplemented}
+	ErrAPIMethodDenied  = APIError{"method %s of this API is denied by the API configuration", errorcodes.CommonAPIMethodDenied, http.StatusMethodNotAllowed, grpcCodes.PermissionDenied}
+
+	// Reload.
+	ErrReloadNotSupported = APIError{"reloading the configuration is only supported in self-hosted mode", errorcodes.CommonReload, http.StatusBadRequest, grpcCodes.FailedPrecondition}
+	ErrReload             = APIError{"failed to reload the configuration: %v", errorcodes.CommonReload, http.StatusInternalServerError, grpcCodes.Internal}
+
+	// Fault injection.
+	ErrFaultInjectionDisabled = APIError{"fault injection is not enabled in the configuration", errorcodes.CommonFaultInjection, http.StatusBadRequest, grpcCodes.FailedPrecondition}
+	ErrFaultInvalid           = APIError{"invalid fault: %v", errorcodes.CommonFaultInjection, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrFaultNotFound          = APIError{"fault %s is not found", errorcodes.CommonFaultInjection, http.StatusNotFound, grpcCodes.NotFound}
+
+	// Bindings.
+	ErrInputBindingNotFound = APIError{"input binding %s is not found", errorcodes.BindingInputNotFound, http.StatusNotFound, grpcCodes.NotFound}
+	ErrInputBindingUpdate   = APIError{"failed to update input binding %s: %v", errorcodes.BindingInputUpdate, http.StatusInternalServerError, grpcCodes.Internal}
 
 	// HTTP.
 	ErrBodyRead         = APIError{"failed to read request body: %v", errorcodes.CommonBodyRead, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrMalformedRequest = APIError{"failed deserializing HTTP body: %v", errorcodes.CommonMalformedRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
 
 	// DirectMessaging.
-	ErrDirectInvoke         = APIError{"failed to invoke, id: %s, err: %v", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
-	ErrDirectInvokeNoAppID  = APIError{"failed getting app id either from the URL path or the header dapr-app-id", errorcodes.ServiceInvocationDirectInvoke, http.StatusNotFound, grpcCodes.NotFound}
-	ErrDirectInvokeNotReady = APIError{"invoke API is not ready", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDirectInvoke                 = APIError{"failed to invoke, id: %s, err: %v", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDirectInvokeNoAppID          = APIError{"failed getting app id either from the URL path or the header dapr-app-id", errorcodes.ServiceInvocationDirectInvoke, http.StatusNotFound, grpcCodes.NotFound}
+	ErrDirectInvokeNotReady         = APIError{"invoke API is not ready", errorcodes.ServiceInvocationDirectInvoke, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrDirectInvokeRequestTooLarge  = APIError{"request body to app %s exceeds the maximum size of %d bytes", errorcodes.ServiceInvocationRequestTooLarge, http.StatusRequestEntityTooLarge, grpcCodes.ResourceExhausted}
+	ErrDirectInvokeResponseTooLarge = APIError{"response body of app %s exceeds the maximum size of %d bytes", errorcodes.ServiceInvocationResponseTooLarge, http.StatusRequestEntityTooLarge, grpcCodes.ResourceExhausted}
 
 	// Healthz.
 	ErrHealthNotReady         = APIError{"dapr is not ready: %v", errorcodes.HealthNotReady, http.StatusInternalServerError, grpcCodes.Internal}
@@ -83,12 +104,20 @@ var (
 	ErrSecretPermissionDenied   = APIError{"access denied by policy to get %q from %q", errorcodes.SecretPermissionDenied, http.StatusForbidden, grpcCodes.PermissionDenied}
 	ErrSecretGet                = APIError{"failed getting secret with key %s from secret store %s: %s", errorcodes.SecretGet, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrBulkSecretGet            = APIError{"failed getting secrets from secret store %s: %v", errorcodes.SecretGet, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrSecretNameFilterInvalid  = APIError{"invalid secret name regular expression %q: %v", errorcodes.SecretNameFilterInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrSecretWatchInvalid       = APIError{"invalid watch of secret store %s: %s", errorcodes.SecretWatchInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrSecretWatch              = APIError{"failed watching secrets of secret store %s: %v", errorcodes.SecretWatch, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrSecretCacheNotEnabled    = APIError{"secret store %s has no cache", errorcodes.SecretCacheNotEnabled, http.StatusBadRequest, grpcCodes.FailedPrecondition}
 
 	// Crypto.
 	ErrCryptoProvidersNotConfigured = APIError{"crypto providers not configured", errorcodes.CryptoProvidersNotConfigured, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrCryptoProviderNotFound       = APIError{"crypto provider %s not found", errorcodes.CryptoProviderNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrCryptoGetKey                 = APIError{"failed to retrieve key %s: %v", errorcodes.CryptoKey, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrCryptoOperation              = APIError{"failed to perform operation: %v", errorcodes.Crypto, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrCryptoSign                   = APIError{"failed to sign with key %s", errorcodes.CryptoSign, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrCryptoVerify                 = APIError{"failed to verify signature with key %s", errorcodes.CryptoVerify, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrCryptoWrapKey                = APIError{"failed to wrap key with key %s", errorcodes.CryptoWrapKey, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrCryptoUnwrapKey              = APIError{"failed to unwrap key with key %s", errorcodes.CryptoUnwrapKey, http.StatusInternalServerError, grpcCodes.Internal}
 
 	// Actor.
 	ErrActorReminderOpActorNotHosted = APIError{"operations on actor reminders are only possible on hosted actor types", errorcodes.ActorReminderNonHosted, http.StatusForbidden, grpcCodes.PermissionDenied}
@@ -101,6 +130,7 @@ var (
 	ErrActorStateTransactionSave     = APIError{"error saving actor transaction state: %s", errorcodes.ActorStateTransactionSave, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorReminderCreate           = APIError{"error creating actor reminder: %s", errorcodes.ActorReminderCreate, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorReminderGet              = APIError{"error getting actor reminder: %s", errorcodes.ActorReminderGet, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrActorReminderList             = APIError{"error listing actor reminders: %s", errorcodes.ActorReminderList, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorReminderDelete           = APIError{"error deleting actor reminder: %s", errorcodes.ActorReminderDelete, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorReminderNotFound         = APIError{"actor reminder not found: %s", errorcodes.ActorReminderNotFound, http.StatusNotFound, grpcCodes.NotFound}
 	ErrActorReminderAlreadyExists    = APIError{"actor reminder already exists: %s", errorcodes.ActorReminderAlreadyExists, http.StatusConflict, grpcCodes.AlreadyExists}
@@ -116,13 +146,19 @@ var (
 	ErrResourceIDEmpty            = APIError{"ResourceId is empty in lock store %s", errorcodes.CommonMalformedRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrLockOwnerEmpty             = APIError{"LockOwner is empty in lock store %s", errorcodes.CommonMalformedRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrExpiryInSecondsNotPositive = APIError{"ExpiryInSeconds is not positive in lock store %s", errorcodes.CommonMalformedRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrWaitTimeoutNegative        = APIError{"WaitTimeoutInSeconds is negative in lock store %s", errorcodes.CommonMalformedRequest, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrLockStoreNotFound          = APIError{"lock store %s not found", errorcodes.LockStoreNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrTryLockFailed              = APIError{"failed to try acquiring lock: %s", errorcodes.LockTry, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrUnlockFailed               = APIError{"failed to release lock: %s", errorcodes.LockUnlock, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrRenewLockFailed            = APIError{"failed to renew lock: %s", errorcodes.LockRenew, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrRenewLockUnsupported       = APIError{"lock store %s does not support renewing locks", errorcodes.LockRenew, http.StatusNotImplemented, grpcCodes.Unimplemented}
 
 	// Workflow.
 	ErrStartWorkflow                 = APIError{"error starting workflow '%s': %s", errorcodes.WorkflowStart, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrWorkflowGetResponse           = APIError{"error while getting workflow info on instance '%s': %s", errorcodes.WorkflowGet, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrWorkflowList                  = APIError{"error while listing workflow instances: %s", errorcodes.WorkflowList, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrWorkflowHistory               = APIError{"error while getting the history of workflow instance '%s': %s", errorcodes.WorkflowHistory, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrWorkflowReplay                = APIError{"error while replaying workflow instance '%s': %s", errorcodes.WorkflowReplay, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrWorkflowNameMissing           = APIError{"workflow name is not configured", errorcodes.WorkflowNameMissing, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrInstanceIDTooLong             = APIError{"workflow instance ID exceeds the max length of %d characters", errorcodes.WorkflowInstanceIDTooLong, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrInvalidInstanceID             = APIError{"workflow instance ID '%s' is invalid: only alphanumeric and underscore characters are allowed", errorcodes.WorkflowInstanceIDInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
@@ -142,4 +178,8 @@ var (
 	ErrConversationInvalidParams = APIError{"failed conversing with component %s: invalid params: %s", errorcodes.ConversationInvalidParms, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrConversationInvoke        = APIError{"failed conversing with component %s: %s", errorcodes.ConversationInvoke, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrConversationMissingInputs = APIError{"failed conversing with component %s: missing inputs in request", errorcodes.ConversationMissingInputs, http.StatusBadRequest, grpcCodes.InvalidArgument}
+
+	// Identity
+	ErrIdentityJWTNotAvailable = APIError{"JWT-SVID is not available: %s", errorcodes.IdentityJWTNotAvailable, http.StatusInternalServerError, grpcCodes.FailedPrecondition}
+	ErrIdentityJWTAudience     = APIError{"audience %q is not one of the JWT audiences of the sidecar", errorcodes.IdentityJWTAudience, http.StatusForbidden, grpcCodes.PermissionDenied}
 )
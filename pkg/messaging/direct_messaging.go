@@ -28,11 +28,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/utils/clock"
 
 	nr "github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messaging/method"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/modes"
@@ -76,6 +79,11 @@ type directMessaging struct {
 	resiliency          resiliency.Provider
 	compStore           *compstore.ComponentStore
 	resolverCache       *ttlcache.Cache[nr.AddressList]
+	loadBalancer        *loadBalancer
+	responseCache       *responseCache
+	federation          *config.FederationSpec
+	headerPolicies      headerPolicies
+	outboundPipeline    OutboundPipelineFn
 	closed              atomic.Bool
 }
 
@@ -101,6 +109,23 @@ type NewDirectMessagingOpts struct {
 	Proxy              Proxy
 	ReadBufferSize     int
 	Resiliency         resiliency.Provider
+	// LoadBalancing configures how the instance of the target app is chosen
+	// when the name resolver returns several instances. Nil picks a random one.
+	LoadBalancing *config.LoadBalancingSpec
+	// Zone is the zone of this sidecar, used by zone-aware load balancing.
+	Zone string
+	// ResponseCaching configures the caching of the responses to GET
+	// invocations. Nil disables the cache.
+	ResponseCaching *config.ResponseCachingSpec
+	// Federation configures the gateways of the remote clusters whose apps are
+	// invoked. Nil disables federation.
+	Federation *config.FederationSpec
+	// HeaderPolicies filter the headers of the calls to apps and
+	// HTTPEndpoints.
+	HeaderPolicies []config.InvocationHeaderPolicy
+	// OutboundPipeline returns the middlewares the calls to an app or an
+	// HTTPEndpoint run through. Nil runs the calls through no middleware.
+	OutboundPipeline OutboundPipelineFn
 }
 
 // NewDirectMessaging returns a new direct messaging api.
@@ -130,6 +155,9 @@ func NewDirectMessaging(opts NewDirectMessagingOpts) invokev1.DirectMessaging {
 		hostFwdAddr:         hFwdAddr,
 		hostName:            hName,
 		compStore:           opts.CompStore,
+		federation:          opts.Federation,
+		headerPolicies:      opts.HeaderPolicies,
+		outboundPipeline:    opts.OutboundPipeline,
 	}
 
 	// Set resolverMulti if the resolver implements the ResolverMulti interface
@@ -140,6 +168,14 @@ func NewDirectMessaging(opts NewDirectMessagingOpts) invokev1.DirectMessaging {
 		})
 	}
 
+	if opts.LoadBalancing != nil {
+		dm.loadBalancer = newLoadBalancer(*opts.LoadBalancing, opts.Zone, hAddr+"/"+opts.AppID)
+	}
+
+	if opts.ResponseCaching != nil {
+		dm.responseCache = newResponseCache(*opts.ResponseCaching, clock.RealClock{})
+	}
+
 	if dm.proxy != nil {
 		dm.proxy.SetRemoteAppFn(dm.getRemoteApp)
 		dm.proxy.SetTelemetryFn(dm.setContextSpan)
@@ -172,11 +208,68 @@ func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *i
 		msg.Method = normalized
 	}
 
+	if len(d.headerPolicies) > 0 {
+		if appID, _, err := d.requestAppIDAndNamespace(targetAppID); err == nil {
+			d.headerPolicies.applyRequest(appID, req)
+		}
+	}
+
+	if d.outboundPipeline != nil {
+		if appID, _, err := d.requestAppIDAndNamespace(targetAppID); err == nil {
+			if pipeline := d.outboundPipeline(appID); pipeline != nil {
+				return d.invokeOutbound(ctx, pipeline, targetAppID, req)
+			}
+		}
+	}
+
+	return d.invoke(ctx, targetAppID, req)
+}
+
+// invoke sends the request to the target app, HTTPEndpoint or remote cluster.
+func (d *directMessaging) invoke(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	if gateway, ok := d.federatedGateway(targetAppID, true); ok {
+		return d.invokeFederated(ctx, gateway, targetAppID, req)
+	}
+
 	app, err := d.getRemoteApp(ctx, targetAppID)
 	if err != nil {
+		// Apps which can't be resolved may be apps of a remote cluster.
+		if gateway, ok := d.federatedGateway(targetAppID, false); ok {
+			return d.invokeFederated(ctx, gateway, targetAppID, req)
+		}
 		return nil, err
 	}
 
+	if d.responseCache != nil {
+		if key, ok := d.responseCache.key(app, req); ok {
+			return d.invokeCached(ctx, key, app, req)
+		}
+	}
+
+	return d.invokeApp(ctx, app, req)
+}
+
+// invokeCached serves the invocation from the response cache, or invokes the
+// app and caches its response.
+func (d *directMessaging) invokeCached(ctx context.Context, key string, app remoteApp, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	if resp, ok := d.responseCache.get(key, req); ok {
+		diag.DefaultMonitoring.ServiceInvocationResponseCacheAccessed(app.id, true)
+		return resp, nil
+	}
+	diag.DefaultMonitoring.ServiceInvocationResponseCacheAccessed(app.id, false)
+
+	resp, err := d.invokeApp(ctx, app, req)
+	if err != nil {
+		return resp, err
+	}
+	if err = d.responseCache.set(key, resp); err != nil {
+		_ = resp.Close()
+		return nil, fmt.Errorf("failed to read the response of app %s: %w", app.id, err)
+	}
+	return resp, nil
+}
+
+func (d *directMessaging) invokeApp(ctx context.Context, app remoteApp, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	// invoke external calls first if appID matches an httpEndpoint.Name or app.id == baseURL that is overwritten
 	if d.isHTTPEndpoint(app.id) || strings.HasPrefix(app.id, "http://") || strings.HasPrefix(app.id, "https://") {
 		return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeHTTPEndpoint, req)
@@ -250,8 +343,10 @@ func (d *directMessaging) invokeWithRetry(
 				return rResp, nil
 			}
 
+			// Invocations rejected by the sidecar of an unhealthy app are not
+			// retried, as the connection to the sidecar is fine.
 			code := status.Code(rErr)
-			if code == codes.Unavailable || code == codes.Unauthenticated {
+			if (code == codes.Unavailable && !IsAppUnhealthyError(rErr)) || code == codes.Unauthenticated {
 				// Destroy the connection and force a re-connection on the next attempt
 				// We also remove the resolved name from the cache
 				teardown(true)
@@ -271,11 +366,28 @@ func (d *directMessaging) invokeWithRetry(
 	return resp, err
 }
 
+// IsAppUnhealthyError returns whether the error is the rejection of an
+// invocation by the sidecar of an app which is unhealthy.
+func IsAppUnhealthyError(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.Unavailable && strings.HasSuffix(s.Message(), messages.ErrAppUnhealthy)
+}
+
+// IsRateLimitedError returns whether the error is the rejection of an
+// invocation by the sidecar of the target app because of its rate limits.
+func IsRateLimitedError(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.ResourceExhausted && strings.HasSuffix(s.Message(), messages.ErrRateLimited)
+}
+
 func (d *directMessaging) invokeLocal(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	appChannel := d.channels.AppChannel()
 	if appChannel == nil {
 		return nil, errors.New("cannot invoke local endpoint: app channel not initialized")
 	}
+	if !d.channels.AppCallbackAllowed(config.AppCallbackInvoke) {
+		return nil, status.Errorf(codes.PermissionDenied, messages.ErrAppCallbackDenied, config.AppCallbackInvoke)
+	}
 
 	// A self-invocation bypasses invokeRemote, so stamp the caller/callee
 	// identity headers here too. caller == callee == this app. Any
@@ -349,8 +461,12 @@ func (d *directMessaging) invokeRemote(ctx context.Context, appID, appNamespace,
 	start := time.Now()
 	diag.DefaultMonitoring.ServiceInvocationRequestSent(appID)
 
+	if d.loadBalancer != nil {
+		defer d.loadBalancer.track(appAddress)()
+	}
+
 	// Do invoke
-	imr, err := d.invokeRemoteStream(ctx, clientV1, req, appID, opts)
+	imr, err := d.invokeRemoteStream(ctx, clientV1, req, appID, appAddress, opts)
 
 	// Diagnostics
 	if imr != nil {
@@ -390,7 +506,7 @@ func (d *directMessaging) invokeRemoteUnary(ctx context.Context, clientV1 intern
 	return invokev1.InternalInvokeResponse(resp)
 }
 
-func (d *directMessaging) invokeRemoteStream(ctx context.Context, clientV1 internalv1pb.ServiceInvocationClient, req *invokev1.InvokeMethodRequest, appID string, opts []grpc.CallOption) (*invokev1.InvokeMethodResponse, error) {
+func (d *directMessaging) invokeRemoteStream(ctx context.Context, clientV1 internalv1pb.ServiceInvocationClient, req *invokev1.InvokeMethodRequest, appID, appAddress string, opts []grpc.CallOption) (*invokev1.InvokeMethodResponse, error) {
 	stream, err := clientV1.CallLocalStream(ctx, opts...)
 	if err != nil {
 		return nil, err
@@ -506,6 +622,11 @@ func (d *directMessaging) invokeRemoteStream(ctx context.Context, clientV1 inter
 	if chunk.GetResponse().GetStatus() == nil {
 		return nil, errors.New("response does not contain the required fields in the leading chunk")
 	}
+	if d.loadBalancer != nil {
+		if header, herr := stream.Header(); herr == nil {
+			d.loadBalancer.observeZone(appAddress, header)
+		}
+	}
 	pr, pw := io.Pipe()
 	res, err := invokev1.InternalInvokeResponse(chunk.GetResponse())
 	if err != nil {
@@ -653,7 +774,7 @@ func (d *directMessaging) getRemoteApp(ctx context.Context, appID string) (res r
 				addresses, _ = d.resolverCache.Get(res.cacheKey)
 				if len(addresses) > 0 {
 					// Pick a random one
-					res.address = addresses.Pick()
+					res.address = d.pickAddress(ctx, res.id, addresses)
 				}
 			}
 
@@ -664,7 +785,7 @@ func (d *directMessaging) getRemoteApp(ctx context.Context, appID string) (res r
 				if err != nil {
 					return res, err
 				}
-				res.address = addresses.Pick()
+				res.address = d.pickAddress(ctx, res.id, addresses)
 
 				if len(addresses) > 0 && res.cacheKey != "" {
 					// Store the result in cache
@@ -715,3 +836,16 @@ func ReadChunk(payload *commonv1pb.StreamPayload, out io.Writer) (seq uint64, er
 
 	return payload.GetSeq(), nil
 }
+
+// pickAddress picks one of the resolved addresses of an app, using the
+// configured load balancing policy.
+// Attempts of a hedged invocation are sent to different instances when possible.
+func (d *directMessaging) pickAddress(ctx context.Context, appID string, addresses nr.AddressList) string {
+	if d.loadBalancer != nil {
+		return d.loadBalancer.pick(ctx, appID, addresses)
+	}
+	if address, ok := resiliency.PickHedgingTarget(ctx, addresses); ok {
+		return address
+	}
+	return addresses.Pick()
+}
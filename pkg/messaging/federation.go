@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	"github.com/dapr/dapr/pkg/retry"
+)
+
+// federatedGateway returns the HTTPEndpoint of the gateway of the remote
+// cluster the calls to the app are sent to. Unless resolved is false, only
+// the gateways which list the app are considered.
+func (d *directMessaging) federatedGateway(targetAppID string, resolved bool) (string, bool) {
+	if d.federation == nil {
+		return "", false
+	}
+	return d.federation.GatewayFor(targetAppID, resolved)
+}
+
+// invokeFederated invokes the app of a remote cluster through the Dapr HTTP
+// API of the gateway of the cluster, with the invocation API of the caller.
+func (d *directMessaging) invokeFederated(ctx context.Context, gateway, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	if !d.isHTTPEndpoint(gateway) {
+		return nil, fmt.Errorf("failed to invoke app %s: federation gateway %s is not an HTTPEndpoint", targetAppID, gateway)
+	}
+
+	msg := req.Message()
+	msg.Method = "v1.0/invoke/" + url.PathEscape(targetAppID) + "/method/" + msg.GetMethod()
+	// Calls of gRPC apps have no HTTP verb, which the Dapr HTTP API requires.
+	if msg.GetHttpExtension().GetVerb() == commonv1pb.HTTPExtension_NONE {
+		msg.HttpExtension = &commonv1pb.HTTPExtension{
+			Verb: commonv1pb.HTTPExtension_POST,
+		}
+	}
+
+	log.Debugf("Invoking app %s of a remote cluster through federation gateway %s", targetAppID, gateway)
+	return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, remoteApp{id: gateway}, d.invokeHTTPEndpoint, req)
+}
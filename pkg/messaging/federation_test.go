@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	httpendpointapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+type recordingChannel struct {
+	appID  string
+	method string
+	verb   commonv1pb.HTTPExtension_Verb
+}
+
+func (r *recordingChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*invokev1.InvokeMethodResponse, error) {
+	r.appID = appID
+	r.method = req.Message().GetMethod()
+	r.verb = req.Message().GetHttpExtension().GetVerb()
+	return invokev1.NewInvokeMethodResponse(200, "OK", nil), nil
+}
+
+func TestInvokeFederated(t *testing.T) {
+	compStore := compstore.New()
+	for _, name := range []string{"cluster-b", "cluster-c"} {
+		compStore.AddHTTPEndpoint(httpendpointapi.HTTPEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       httpendpointapi.HTTPEndpointSpec{BaseURL: "https://" + name + ".example.com"},
+		})
+	}
+
+	resolver := new(daprt.MockResolver)
+	resolver.On("ResolveID", mock.Anything).Return("", errors.New("app not found"))
+
+	newDirectMessaging := func(federation *config.FederationSpec) (*directMessaging, *recordingChannel) {
+		ch := new(recordingChannel)
+		return &directMessaging{
+			appID:      "caller",
+			namespace:  "default",
+			resolver:   resolver,
+			resiliency: resiliency.New(nil),
+			compStore:  compStore,
+			federation: federation,
+			channels: (new(channels.Channels)).WithEndpointChannels(map[string]channel.HTTPEndpointAppChannel{
+				"cluster-b": ch,
+				"cluster-c": ch,
+			}),
+		}, ch
+	}
+
+	federation := &config.FederationSpec{
+		Gateways: []config.FederationGateway{
+			{HTTPEndpoint: "cluster-b", AppIDs: []string{"orders"}},
+			{HTTPEndpoint: "cluster-c"},
+		},
+	}
+
+	t.Run("listed apps are invoked through their gateway", func(t *testing.T) {
+		d, ch := newDirectMessaging(federation)
+		req := invokev1.NewInvokeMethodRequest("orders/1").WithHTTPExtension("GET", "")
+		defer req.Close()
+
+		resp, err := d.Invoke(t.Context(), "orders", req)
+		require.NoError(t, err)
+		defer resp.Close()
+		assert.Equal(t, "cluster-b", ch.appID)
+		assert.Equal(t, "v1.0/invoke/orders/method/orders/1", ch.method)
+		assert.Equal(t, commonv1pb.HTTPExtension_GET, ch.verb)
+	})
+
+	t.Run("apps which can't be resolved are invoked through the default gateway", func(t *testing.T) {
+		d, ch := newDirectMessaging(federation)
+		req := invokev1.NewInvokeMethodRequest("ship")
+		defer req.Close()
+
+		resp, err := d.Invoke(t.Context(), "shipping.logistics", req)
+		require.NoError(t, err)
+		defer resp.Close()
+		assert.Equal(t, "cluster-c", ch.appID)
+		assert.Equal(t, "v1.0/invoke/shipping.logistics/method/ship", ch.method)
+		assert.Equal(t, commonv1pb.HTTPExtension_POST, ch.verb)
+	})
+
+	t.Run("resolution errors are returned without a default gateway", func(t *testing.T) {
+		d, _ := newDirectMessaging(&config.FederationSpec{
+			Gateways: []config.FederationGateway{{HTTPEndpoint: "cluster-b", AppIDs: []string{"orders"}}},
+		})
+		req := invokev1.NewInvokeMethodRequest("ship")
+		defer req.Close()
+
+		_, err := d.Invoke(t.Context(), "shipping", req)
+		require.ErrorContains(t, err, "app not found")
+	})
+
+	t.Run("gateway is not an HTTPEndpoint", func(t *testing.T) {
+		d, _ := newDirectMessaging(&config.FederationSpec{
+			Gateways: []config.FederationGateway{{HTTPEndpoint: "cluster-d"}},
+		})
+		req := invokev1.NewInvokeMethodRequest("ship")
+		defer req.Close()
+
+		_, err := d.Invoke(t.Context(), "shipping", req)
+		require.ErrorContains(t, err, "federation gateway cluster-d is not an HTTPEndpoint")
+	})
+}
@@ -52,6 +52,7 @@ type proxy struct {
 	acl                *config.AccessControlList
 	resiliency         resiliency.Provider
 	maxRequestBodySize int
+	headerPolicies     headerPolicies
 }
 
 // ProxyOpts is the struct with options for NewProxy.
@@ -63,6 +64,8 @@ type ProxyOpts struct {
 	Resiliency         resiliency.Provider
 	MaxRequestBodySize int
 	AppendAppTokenFn   func(context.Context) context.Context
+	// HeaderPolicies filter the metadata of the calls to remote apps.
+	HeaderPolicies []config.InvocationHeaderPolicy
 }
 
 // NewProxy returns a new proxy.
@@ -75,6 +78,7 @@ func NewProxy(opts ProxyOpts) Proxy {
 		acl:                opts.ACL,
 		resiliency:         opts.Resiliency,
 		maxRequestBodySize: opts.MaxRequestBodySize,
+		headerPolicies:     opts.HeaderPolicies,
 	}
 }
 
@@ -153,7 +157,9 @@ func (p *proxy) intercept(ctx context.Context, fullName string) (context.Context
 		return outCtx, appClient.(*grpc.ClientConn), nil, teardown, nil
 	}
 
-	outCtx := metadata.NewOutgoingContext(ctx, md.Copy())
+	mdCopy := md.Copy()
+	p.headerPolicies.applyMD(target.id, mdCopy)
+	outCtx := metadata.NewOutgoingContext(ctx, mdCopy)
 
 	// proxy to a remote daprd
 	conn, teardown, cErr := p.connectionFactory(outCtx, target.address, target.id, target.namespace,
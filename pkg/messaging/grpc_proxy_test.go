@@ -242,6 +242,38 @@ func TestIntercept(t *testing.T) {
 		assert.NotContains(t, md, securityConsts.APITokenHeader)
 	})
 
+	t.Run("header policies applied to a remote app", func(t *testing.T) {
+		p := NewProxy(ProxyOpts{
+			ConnectionFactory: connectionFn,
+			AppClientFn:       appClientFn,
+			AppID:             "a",
+			Resiliency:        resiliency.New(nil),
+			HeaderPolicies: []config.InvocationHeaderPolicy{{
+				Targets: []string{"b"},
+				Deny:    []string{"x-internal-*"},
+				Set:     map[string]string{"x-source": "a"},
+			}},
+		})
+		p.SetTelemetryFn(func(ctx context.Context) context.Context { return ctx })
+		p.SetRemoteAppFn(func(_ context.Context, s string) (remoteApp, error) {
+			return remoteApp{id: "b"}, nil
+		})
+
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.MD{
+			diagConsts.GRPCProxyAppIDKey: []string{"b"},
+			"x-internal-user":            []string{"admin"},
+			"x-source":                   []string{"spoofed"},
+		})
+		ctx, _, _, teardown, err := p.(*proxy).intercept(ctx, "/test")
+		defer teardown(true)
+		require.NoError(t, err)
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		assert.NotContains(t, md, "x-internal-user")
+		assert.Equal(t, []string{"a"}, md["x-source"])
+		assert.Equal(t, []string{"b"}, md[diagConsts.GRPCProxyAppIDKey])
+	})
+
 	t.Run("access policies applied", func(t *testing.T) {
 		acl := &config.AccessControlList{
 			DefaultAction: "deny",
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/config"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// headerPolicies filter the headers and the gRPC metadata of the calls to
+// apps and HTTPEndpoints.
+type headerPolicies []config.InvocationHeaderPolicy
+
+// applyRequest applies the policies of the target to the headers of the
+// request.
+func (p headerPolicies) applyRequest(target string, req *invokev1.InvokeMethodRequest) {
+	md := req.Metadata()
+	if md == nil {
+		return
+	}
+	for _, policy := range p {
+		if policy.AppliesTo(target) {
+			applyHeaderPolicy(policy, md, func(val string) *internalv1pb.ListStringValue {
+				return &internalv1pb.ListStringValue{Values: []string{val}}
+			})
+		}
+	}
+}
+
+// applyMD applies the policies of the target to the metadata of a proxied
+// gRPC call.
+func (p headerPolicies) applyMD(target string, md metadata.MD) {
+	for _, policy := range p {
+		if policy.AppliesTo(target) {
+			applyHeaderPolicy(policy, md, func(val string) []string {
+				return []string{val}
+			})
+		}
+	}
+}
+
+func applyHeaderPolicy[V any](policy config.InvocationHeaderPolicy, md map[string]V, value func(string) V) {
+	for key := range md {
+		if protectedHeader(key) {
+			continue
+		}
+		name := strings.ToLower(key)
+		if (len(policy.Allow) > 0 && !matchHeader(policy.Allow, name)) || matchHeader(policy.Deny, name) {
+			delete(md, key)
+		}
+	}
+
+	for from, to := range policy.Rename {
+		for _, key := range headerKeys(md, from) {
+			val := md[key]
+			delete(md, key)
+			md[strings.ToLower(to)] = val
+		}
+	}
+
+	for name, val := range policy.Set {
+		for _, key := range headerKeys(md, name) {
+			delete(md, key)
+		}
+		md[strings.ToLower(name)] = value(val)
+	}
+}
+
+// protectedHeader returns whether the header is needed to deliver the call,
+// and can't be filtered.
+func protectedHeader(key string) bool {
+	key = strings.ToLower(key)
+	return strings.HasPrefix(key, ":") ||
+		strings.HasPrefix(key, "grpc-") ||
+		key == "content-type" ||
+		key == diagConsts.GRPCProxyAppIDKey
+}
+
+// matchHeader returns whether the lowercase header name matches any of the
+// names, which match the headers with their prefix if they end with "*".
+func matchHeader(names []string, name string) bool {
+	for _, n := range names {
+		n = strings.ToLower(n)
+		if prefix, ok := strings.CutSuffix(n, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// headerKeys returns the keys of the metadata with the header name, whatever
+// their case.
+func headerKeys[V any](md map[string]V, name string) []string {
+	var keys []string
+	for key := range md {
+		if strings.EqualFold(key, name) && !protectedHeader(key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+)
+
+func TestHeaderPolicies(t *testing.T) {
+	policies := headerPolicies{
+		{
+			Deny:   []string{"X-Internal-*", "dapr-api-token"},
+			Rename: map[string]string{"X-Tenant": "x-customer"},
+		},
+		{
+			Targets: []string{"payments"},
+			Allow:   []string{"x-customer", "traceparent", "x-region"},
+			Set:     map[string]string{"X-Region": "eu"},
+		},
+	}
+
+	t.Run("gRPC metadata", func(t *testing.T) {
+		newMD := func() metadata.MD {
+			return metadata.Pairs(
+				"x-internal-user", "admin",
+				"dapr-api-token", "secret",
+				"x-tenant", "acme",
+				"traceparent", "00-abc",
+				"x-region", "us",
+				"x-other", "value",
+				"dapr-app-id", "payments",
+				"content-type", "application/grpc",
+			)
+		}
+
+		md := newMD()
+		policies.applyMD("orders", md)
+		assert.Equal(t, metadata.Pairs(
+			"x-customer", "acme",
+			"traceparent", "00-abc",
+			"x-region", "us",
+			"x-other", "value",
+			"dapr-app-id", "payments",
+			"content-type", "application/grpc",
+		), md)
+
+		md = newMD()
+		policies.applyMD("payments", md)
+		assert.Equal(t, metadata.Pairs(
+			"x-customer", "acme",
+			"traceparent", "00-abc",
+			"x-region", "eu",
+			"dapr-app-id", "payments",
+			"content-type", "application/grpc",
+		), md)
+	})
+
+	t.Run("HTTP headers", func(t *testing.T) {
+		req := invokev1.NewInvokeMethodRequest("method").WithHTTPHeaders(map[string][]string{
+			"X-Internal-User": {"admin"},
+			"X-Tenant":        {"acme"},
+			"X-Region":        {"us"},
+			"X-Other":         {"value"},
+		})
+		defer req.Close()
+
+		policies.applyRequest("payments", req)
+		md := req.Metadata()
+		assert.Len(t, md, 2)
+		assert.Equal(t, []string{"acme"}, md["x-customer"].GetValues())
+		assert.Equal(t, []string{"eu"}, md["x-region"].GetValues())
+	})
+
+	t.Run("no policies", func(t *testing.T) {
+		md := metadata.Pairs("x-internal-user", "admin")
+		headerPolicies(nil).applyMD("orders", md)
+		assert.Equal(t, metadata.Pairs("x-internal-user", "admin"), md)
+
+		assert.True(t, config.InvocationHeaderPolicy{}.AppliesTo("orders"))
+	})
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/metadata"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// ZoneHeader is the response header in which the internal gRPC server of a
+// sidecar advertises the zone of the sidecar.
+const ZoneHeader = "dapr-zone"
+
+// loadBalancer chooses the instance of the target app which receives each
+// call, when the name resolver returns several instances.
+type loadBalancer struct {
+	spec config.LoadBalancingSpec
+	// zone is the zone of this sidecar.
+	zone string
+	// subsetKey identifies this sidecar when choosing its subset of the
+	// instances of an app, so that each sidecar gets a stable subset.
+	subsetKey string
+
+	lock sync.RWMutex
+	// zones are the zones advertised by the instances, by address.
+	zones map[string]string
+	// inFlight are the calls awaiting a response, by address.
+	inFlight map[string]int
+	// next are the round-robin counters, by app.
+	next map[string]*atomic.Uint64
+}
+
+func newLoadBalancer(spec config.LoadBalancingSpec, zone string, subsetKey string) *loadBalancer {
+	for _, policy := range append([]config.LoadBalancingPolicy{spec.LoadBalancingPolicy}, appPolicies(spec)...) {
+		switch policy.Policy {
+		case "", config.LoadBalancingRandom, config.LoadBalancingRoundRobin, config.LoadBalancingLeastLoaded:
+		default:
+			log.Warnf("Unknown load balancing policy %q, using %q instead", policy.Policy, config.LoadBalancingRandom)
+		}
+		if policy.ZoneAware && zone == "" {
+			log.Warn("Zone-aware load balancing requires the zone of the sidecar to be set with the DAPR_ZONE environment variable")
+		}
+	}
+
+	return &loadBalancer{
+		spec:      spec,
+		zone:      zone,
+		subsetKey: subsetKey,
+		zones:     make(map[string]string),
+		inFlight:  make(map[string]int),
+		next:      make(map[string]*atomic.Uint64),
+	}
+}
+
+func appPolicies(spec config.LoadBalancingSpec) []config.LoadBalancingPolicy {
+	policies := make([]config.LoadBalancingPolicy, len(spec.Apps))
+	for i, app := range spec.Apps {
+		policies[i] = app.LoadBalancingPolicy
+	}
+	return policies
+}
+
+// pick picks the address of the instance of the app which receives a call.
+func (lb *loadBalancer) pick(ctx context.Context, appID string, addresses nr.AddressList) string {
+	if len(addresses) == 0 {
+		return ""
+	}
+
+	policy := lb.spec.ForApp(appID)
+	candidates := lb.candidates(policy, addresses)
+
+	// Attempts of a hedged invocation are sent to different instances.
+	if address, ok := resiliency.PickHedgingTarget(ctx, candidates); ok {
+		return address
+	}
+
+	switch policy.Policy {
+	case config.LoadBalancingRoundRobin:
+		return candidates[int(lb.counter(appID).Add(1)-1)%len(candidates)] //nolint:gosec
+
+	case config.LoadBalancingLeastLoaded:
+		if len(candidates) == 1 {
+			return candidates[0]
+		}
+		// Compare two random instances, which avoids sending all the calls
+		// to the same instance before their load is known.
+		//nolint:gosec
+		i, j := rand.IntN(len(candidates)), rand.IntN(len(candidates)-1)
+		if j >= i {
+			j++
+		}
+		lb.lock.RLock()
+		defer lb.lock.RUnlock()
+		if lb.inFlight[candidates[j]] < lb.inFlight[candidates[i]] {
+			return candidates[j]
+		}
+		return candidates[i]
+
+	default:
+		return nr.AddressList(candidates).Pick()
+	}
+}
+
+// candidates returns the addresses the policy can send a call to, in a
+// stable order.
+func (lb *loadBalancer) candidates(policy config.LoadBalancingPolicy, addresses nr.AddressList) []string {
+	candidates := slices.Clone(addresses)
+	slices.Sort(candidates)
+
+	if policy.ZoneAware && lb.zone != "" {
+		// Instances which did not advertise their zone yet remain candidates,
+		// so that their zone is learned.
+		lb.lock.RLock()
+		local := slices.DeleteFunc(slices.Clone(candidates), func(address string) bool {
+			zone, ok := lb.zones[address]
+			return ok && zone != lb.zone
+		})
+		lb.lock.RUnlock()
+		if len(local) > 0 {
+			candidates = local
+		}
+	}
+
+	if policy.SubsetSize > 0 && len(candidates) > policy.SubsetSize {
+		// Rendezvous hashing keeps the subset stable as instances come and go.
+		scores := make(map[string]uint64, len(candidates))
+		for _, address := range candidates {
+			h := fnv.New64a()
+			h.Write([]byte(lb.subsetKey + "/" + address))
+			scores[address] = h.Sum64()
+		}
+		slices.SortFunc(candidates, func(a, b string) int {
+			switch {
+			case scores[a] > scores[b]:
+				return -1
+			case scores[a] < scores[b]:
+				return 1
+			default:
+				return 0
+			}
+		})
+		candidates = candidates[:policy.SubsetSize]
+		slices.Sort(candidates)
+	}
+
+	return candidates
+}
+
+func (lb *loadBalancer) counter(appID string) *atomic.Uint64 {
+	lb.lock.RLock()
+	c, ok := lb.next[appID]
+	lb.lock.RUnlock()
+	if ok {
+		return c
+	}
+
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+	if c, ok = lb.next[appID]; !ok {
+		c = &atomic.Uint64{}
+		lb.next[appID] = c
+	}
+	return c
+}
+
+// track records a call to the instance at address until the returned
+// function is invoked.
+func (lb *loadBalancer) track(address string) func() {
+	lb.lock.Lock()
+	lb.inFlight[address]++
+	lb.lock.Unlock()
+
+	return func() {
+		lb.lock.Lock()
+		defer lb.lock.Unlock()
+		lb.inFlight[address]--
+		if lb.inFlight[address] <= 0 {
+			delete(lb.inFlight, address)
+		}
+	}
+}
+
+// observeZone records the zone advertised by the instance at address in the
+// response header.
+func (lb *loadBalancer) observeZone(address string, header metadata.MD) {
+	zone := header.Get(ZoneHeader)
+	if len(zone) == 0 || zone[0] == "" {
+		return
+	}
+
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+	lb.zones[address] = zone[0]
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	nr "github.com/dapr/components-contrib/nameresolution"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestLoadBalancerPick(t *testing.T) {
+	addresses := nr.AddressList{"10.0.0.3:50002", "10.0.0.1:50002", "10.0.0.2:50002"}
+
+	t.Run("random by default", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{}, "", "caller")
+		for range 20 {
+			assert.Contains(t, addresses, lb.pick(t.Context(), "app", addresses))
+		}
+		assert.Empty(t, lb.pick(t.Context(), "app", nil))
+	})
+
+	t.Run("round robin per app", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{
+			Apps: []config.AppLoadBalancingPolicy{
+				{AppID: "app", LoadBalancingPolicy: config.LoadBalancingPolicy{Policy: config.LoadBalancingRoundRobin}},
+			},
+		}, "", "caller")
+
+		picked := make([]string, 6)
+		for i := range picked {
+			picked[i] = lb.pick(t.Context(), "app", addresses)
+		}
+		assert.Equal(t, []string{
+			"10.0.0.1:50002", "10.0.0.2:50002", "10.0.0.3:50002",
+			"10.0.0.1:50002", "10.0.0.2:50002", "10.0.0.3:50002",
+		}, picked)
+		assert.Equal(t, "10.0.0.1:50002", lb.pick(t.Context(), "app", nr.AddressList{"10.0.0.1:50002"}))
+	})
+
+	t.Run("least loaded", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{
+			LoadBalancingPolicy: config.LoadBalancingPolicy{Policy: config.LoadBalancingLeastLoaded},
+		}, "", "caller")
+
+		two := nr.AddressList{"10.0.0.1:50002", "10.0.0.2:50002"}
+		done := lb.track("10.0.0.1:50002")
+		for range 20 {
+			assert.Equal(t, "10.0.0.2:50002", lb.pick(t.Context(), "app", two))
+		}
+		done()
+		assert.Empty(t, lb.inFlight)
+	})
+
+	t.Run("zone aware", func(t *testing.T) {
+		lb := newLoadBalancer(config.LoadBalancingSpec{
+			LoadBalancingPolicy: config.LoadBalancingPolicy{ZoneAware: true},
+		}, "zone-a", "caller")
+
+		lb.observeZone("10.0.0.1:50002", metadata.Pairs(ZoneHeader, "zone-b"))
+		lb.observeZone("10.0.0.2:50002", metadata.Pairs(ZoneHeader, "zone-a"))
+		lb.observeZone("10.0.0.3:50002", metadata.MD{})
+
+		// Instances which did not advertise a zone remain candidates.
+		assert.Equal(t, []string{"10.0.0.2:50002", "10.0.0.3:50002"}, lb.candidates(lb.spec.ForApp("app"), addresses))
+
+		// All instances are candidates if none is in the zone.
+		remote := nr.AddressList{"10.0.0.1:50002"}
+		assert.Equal(t, "10.0.0.1:50002", lb.pick(t.Context(), "app", remote))
+	})
+
+	t.Run("subset", func(t *testing.T) {
+		spec := config.LoadBalancingSpec{
+			LoadBalancingPolicy: config.LoadBalancingPolicy{SubsetSize: 2},
+		}
+		lb := newLoadBalancer(spec, "", "caller")
+		subset := lb.candidates(spec.LoadBalancingPolicy, addresses)
+		require.Len(t, subset, 2)
+
+		// The subset is stable when instances are added.
+		more := append(nr.AddressList{"10.0.0.4:50002"}, addresses...)
+		for _, address := range lb.candidates(spec.LoadBalancingPolicy, more) {
+			if address != "10.0.0.4:50002" {
+				assert.Contains(t, subset, address)
+			}
+		}
+		for range 20 {
+			assert.Contains(t, subset, lb.pick(t.Context(), "app", addresses))
+		}
+	})
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/middleware"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/kit/grpccodes"
+)
+
+// OutboundPipelineFn returns the pipeline of middlewares the calls to an app
+// or an HTTPEndpoint run through, or nil if there is none.
+type OutboundPipelineFn func(destination string) middleware.HTTP
+
+// invokeOutbound runs the call through the outbound pipeline of its
+// destination, presenting it to the middlewares as an HTTP request to the
+// method of the destination. The headers and the body of the request left by
+// the middlewares are sent to the destination. The middlewares see the status
+// code and the headers of the response, but not its body, which is returned
+// to the caller as is. Calls the middlewares respond to without invoking the
+// next handler fail with the code matching the HTTP status of the response.
+func (d *directMessaging) invokeOutbound(ctx context.Context, pipeline middleware.HTTP, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	r, err := outboundRequest(ctx, targetAppID, req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create the request for the outbound middlewares: %v", err)
+	}
+	body := r.Body
+	header := r.Header.Clone()
+
+	var (
+		called bool
+		resp   *invokev1.InvokeMethodResponse
+	)
+	rec := &outboundRecorder{header: make(http.Header)}
+	pipeline(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		if r.Body != body {
+			req.WithRawData(r.Body)
+		}
+		applyOutboundHeader(req, header, r.Header)
+
+		resp, err = d.invoke(r.Context(), targetAppID, req)
+		if err != nil {
+			w.WriteHeader(invokev1.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+		for k, v := range resp.Headers() {
+			w.Header()[http.CanonicalHeaderKey(k)] = v.GetValues()
+		}
+		code := int(resp.Status().GetCode())
+		if !resp.IsHTTPResponse() {
+			code = invokev1.HTTPStatusFromCode(codes.Code(code)) //nolint:gosec
+		}
+		w.WriteHeader(code)
+	})).ServeHTTP(rec, r)
+
+	if !called {
+		if rec.code == 0 {
+			rec.code = http.StatusOK
+		}
+		msg := strings.TrimSpace(rec.body.String())
+		if msg == "" {
+			msg = http.StatusText(rec.code)
+		}
+		code := grpccodes.CodeFromHTTPStatus(rec.code)
+		if code == codes.OK {
+			// The call was not sent, so it can't succeed
+			code = codes.Unknown
+		}
+		return nil, status.Errorf(code, "call to %s rejected by outbound middleware: %s", targetAppID, msg)
+	}
+	return resp, err
+}
+
+// outboundRequest returns the request presented to the outbound middlewares
+// for the call. The body is read from the call only if a middleware reads it.
+func outboundRequest(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*http.Request, error) {
+	msg := req.Message()
+	verb := http.MethodPost
+	if v := msg.GetHttpExtension().GetVerb().String(); msg.GetHttpExtension() != nil && v != "NONE" {
+		verb = v
+	}
+
+	base := targetAppID
+	if !strings.HasPrefix(base, "http://") && !strings.HasPrefix(base, "https://") {
+		base = "http://" + base
+	}
+	target := strings.TrimSuffix(base, "/") + "/" + strings.TrimPrefix(msg.GetMethod(), "/")
+	if qs := req.EncodeHTTPQueryString(); qs != "" {
+		target += "?" + qs
+	}
+
+	r, err := http.NewRequestWithContext(ctx, verb, target, &lazyBody{req: req})
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range req.Metadata() {
+		if protectedHeader(k) {
+			continue
+		}
+		r.Header[http.CanonicalHeaderKey(k)] = slices.Clone(v.GetValues())
+	}
+	if ct := req.ContentType(); ct != "" {
+		r.Header.Set("Content-Type", ct)
+	}
+	return r, nil
+}
+
+// applyOutboundHeader applies the changes the middlewares made to the headers
+// of the request to the metadata of the call.
+func applyOutboundHeader(req *invokev1.InvokeMethodRequest, before http.Header, after http.Header) {
+	md := req.Metadata()
+	for k, v := range after {
+		if protectedHeader(k) || slices.Equal(before[k], v) {
+			continue
+		}
+		if md == nil {
+			req.WithMetadata(map[string][]string{})
+			md = req.Metadata()
+		}
+		for _, key := range headerKeys(md, k) {
+			delete(md, key)
+		}
+		md[strings.ToLower(k)] = &internalv1pb.ListStringValue{Values: slices.Clone(v)}
+	}
+	for k := range before {
+		if _, ok := after[k]; ok {
+			continue
+		}
+		for _, key := range headerKeys(md, k) {
+			delete(md, key)
+		}
+	}
+}
+
+// lazyBody reads the data of the call when it is first read. The data read is
+// kept in the call, so it is still sent if the middlewares don't replace the
+// body.
+type lazyBody struct {
+	req *invokev1.InvokeMethodRequest
+	r   io.Reader
+}
+
+func (b *lazyBody) Read(p []byte) (int, error) {
+	if b.r == nil {
+		data, err := b.req.RawDataFull()
+		if err != nil {
+			return 0, err
+		}
+		if !b.req.HasMessageData() {
+			b.req.WithRawDataBytes(data)
+		}
+		b.r = bytes.NewReader(data)
+	}
+	return b.r.Read(p)
+}
+
+// outboundRecorder records the response the outbound middlewares write.
+type outboundRecorder struct {
+	header http.Header
+	code   int
+	body   strings.Builder
+}
+
+func (r *outboundRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *outboundRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+}
+
+func (r *outboundRecorder) Write(p []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(p)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	httpendpointapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	"github.com/dapr/dapr/pkg/channel"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+type outboundChannel struct {
+	md   invokev1.DaprInternalMetadata
+	data []byte
+}
+
+func (c *outboundChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*invokev1.InvokeMethodResponse, error) {
+	c.md = req.Metadata()
+	c.data, _ = req.RawDataFull()
+	return invokev1.NewInvokeMethodResponse(http.StatusAccepted, "Accepted", nil).
+		WithHTTPHeaders(map[string][]string{"X-Target": {"payments"}}), nil
+}
+
+func TestInvokeOutbound(t *testing.T) {
+	compStore := compstore.New()
+	compStore.AddHTTPEndpoint(httpendpointapi.HTTPEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments"},
+		Spec:       httpendpointapi.HTTPEndpointSpec{BaseURL: "https://payments.example.com"},
+	})
+
+	var (
+		gotURL        string
+		gotStatus     int
+		gotRespHeader string
+	)
+	pipeline := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotURL = r.Method + " " + r.URL.String()
+			if r.Header.Get("X-Deny") != "" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("denied"))
+				return
+			}
+			r.Header.Set("X-Signature", "signed")
+			r.Header.Del("X-Secret")
+			if r.Header.Get("X-Upper") != "" {
+				b, _ := io.ReadAll(r.Body)
+				r.Body = io.NopCloser(bytes.NewReader(bytes.ToUpper(b)))
+			}
+			rec := &outboundRecorder{header: make(http.Header)}
+			next.ServeHTTP(rec, r)
+			gotStatus = rec.code
+			gotRespHeader = rec.header.Get("X-Target")
+		})
+	}
+
+	newDirectMessaging := func() (*directMessaging, *outboundChannel) {
+		ch := new(outboundChannel)
+		return &directMessaging{
+			appID:      "caller",
+			namespace:  "default",
+			resolver:   new(daprt.MockResolver),
+			resiliency: resiliency.New(nil),
+			compStore:  compStore,
+			channels: (new(channels.Channels)).WithEndpointChannels(map[string]channel.HTTPEndpointAppChannel{
+				"payments": ch,
+			}),
+			outboundPipeline: func(destination string) middleware.HTTP {
+				if destination != "payments" {
+					return nil
+				}
+				return pipeline
+			},
+		}, ch
+	}
+
+	t.Run("call runs through the pipeline of the destination", func(t *testing.T) {
+		d, ch := newDirectMessaging()
+		req := invokev1.NewInvokeMethodRequest("charge").
+			WithHTTPExtension(http.MethodPut, "id=1").
+			WithMetadata(map[string][]string{"x-secret": {"s"}, "x-keep": {"k"}}).
+			WithRawDataString("body")
+		defer req.Close()
+
+		resp, err := d.Invoke(t.Context(), "payments", req)
+		require.NoError(t, err)
+		defer resp.Close()
+		assert.Equal(t, "PUT http://payments/charge?id=1", gotURL)
+		assert.Equal(t, []string{"signed"}, ch.md["x-signature"].GetValues())
+		assert.Equal(t, []string{"k"}, ch.md["x-keep"].GetValues())
+		assert.NotContains(t, ch.md, "x-secret")
+		assert.Equal(t, "body", string(ch.data))
+		assert.Equal(t, http.StatusAccepted, gotStatus)
+		assert.Equal(t, "payments", gotRespHeader)
+		assert.Equal(t, int32(http.StatusAccepted), resp.Status().GetCode())
+	})
+
+	t.Run("body is transformed", func(t *testing.T) {
+		d, ch := newDirectMessaging()
+		req := invokev1.NewInvokeMethodRequest("charge").
+			WithMetadata(map[string][]string{"x-upper": {"1"}}).
+			WithRawDataString("body")
+		defer req.Close()
+
+		resp, err := d.Invoke(t.Context(), "payments", req)
+		require.NoError(t, err)
+		defer resp.Close()
+		assert.Equal(t, "BODY", string(ch.data))
+	})
+
+	t.Run("call rejected by a middleware", func(t *testing.T) {
+		d, ch := newDirectMessaging()
+		req := invokev1.NewInvokeMethodRequest("charge").
+			WithMetadata(map[string][]string{"x-deny": {"1"}})
+		defer req.Close()
+
+		_, err := d.Invoke(t.Context(), "payments", req)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.True(t, strings.HasSuffix(err.Error(), "call to payments rejected by outbound middleware: denied"))
+		assert.Nil(t, ch.md)
+	})
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	streamutils "github.com/dapr/kit/streams"
+)
+
+const (
+	defaultResponseCacheMaxEntries      = 1000
+	defaultResponseCacheMaxResponseSize = 1 << 20
+	defaultResponseCacheMaxTTL          = 5 * time.Minute
+)
+
+// responseCache is a least recently used cache of the responses to the GET
+// invocations of the configured targets, which are cached for as long as
+// their Cache-Control header allows.
+type responseCache struct {
+	targets         map[string]struct{}
+	maxResponseSize int
+	maxTTL          time.Duration
+	clock           clock.Clock
+	cache           *lru.Cache[string, *cachedResponse]
+}
+
+type cachedResponse struct {
+	resp     *internalv1pb.InternalInvokeResponse
+	storedAt time.Time
+	expireAt time.Time
+}
+
+func newResponseCache(spec config.ResponseCachingSpec, clock clock.Clock) *responseCache {
+	c := &responseCache{
+		targets:         make(map[string]struct{}, len(spec.Targets)),
+		maxResponseSize: spec.MaxResponseSize,
+		maxTTL:          defaultResponseCacheMaxTTL,
+		clock:           clock,
+	}
+	for _, target := range spec.Targets {
+		c.targets[target] = struct{}{}
+	}
+	if c.maxResponseSize <= 0 {
+		c.maxResponseSize = defaultResponseCacheMaxResponseSize
+	}
+	if spec.MaxTTL != nil {
+		c.maxTTL = *spec.MaxTTL
+	}
+
+	maxEntries := spec.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultResponseCacheMaxEntries
+	}
+	// lru.New only fails if the size is not positive.
+	c.cache, _ = lru.New[string, *cachedResponse](maxEntries)
+	return c
+}
+
+// key returns the key of the response to the request to the app in the
+// cache, or false if the response cannot be cached.
+// Only the GET invocations of the targets without credentials are cached, as
+// the cache is shared by all the callers in the app.
+func (c *responseCache) key(app remoteApp, req *invokev1.InvokeMethodRequest) (string, bool) {
+	if _, ok := c.targets[app.id]; !ok {
+		return "", false
+	}
+	msg := req.Message()
+	if req.Actor() != nil || msg.GetHttpExtension().GetVerb() != commonv1pb.HTTPExtension_GET {
+		return "", false
+	}
+	if len(headerValues(req.Metadata(), "Authorization")) > 0 || hasCacheDirective(req.Metadata(), "no-store") {
+		return "", false
+	}
+
+	return app.namespace + "||" + app.id + "||" + msg.GetMethod() + "?" + msg.GetHttpExtension().GetQuerystring(), true
+}
+
+// get returns a copy of the cached response for the key, unless it expired or
+// the request asks for a fresh response.
+func (c *responseCache) get(key string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, bool) {
+	if hasCacheDirective(req.Metadata(), "no-cache") {
+		return nil, false
+	}
+
+	cached, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	now := c.clock.Now()
+	if !now.Before(cached.expireAt) {
+		c.cache.Remove(key)
+		return nil, false
+	}
+
+	pb := proto.Clone(cached.resp).(*internalv1pb.InternalInvokeResponse)
+	if pb.GetHeaders() == nil {
+		pb.Headers = make(map[string]*internalv1pb.ListStringValue)
+	}
+	pb.Headers["Age"] = &internalv1pb.ListStringValue{
+		Values: []string{strconv.Itoa(int(now.Sub(cached.storedAt).Seconds()))},
+	}
+	resp, err := invokev1.InternalInvokeResponse(pb)
+	if err != nil {
+		return nil, false
+	}
+	return resp, true
+}
+
+// set caches the response for the key if its Cache-Control header allows it.
+// The body of the response is read to be cached, and remains readable by the
+// caller.
+func (c *responseCache) set(key string, resp *invokev1.InvokeMethodResponse) error {
+	if !resp.IsHTTPResponse() || resp.Status().GetCode() != http.StatusOK ||
+		strings.HasPrefix(resp.ContentType(), "text/event-stream") {
+		return nil
+	}
+	ttl := min(responseTTL(resp.Headers()), c.maxTTL)
+	if ttl <= 0 {
+		return nil
+	}
+
+	if !resp.HasMessageData() {
+		// The body is read by the cache and by the caller, so it cannot be
+		// replayable.
+		if resp.CanReplay() {
+			return nil
+		}
+		r := resp.RawData()
+		data, err := io.ReadAll(io.LimitReader(r, int64(c.maxResponseSize)+1))
+		if err != nil {
+			return err
+		}
+		if len(data) > c.maxResponseSize {
+			resp.WithRawData(streamutils.NewMultiReaderCloser(bytes.NewReader(data), r))
+			return nil
+		}
+		if rc, ok := r.(io.Closer); ok {
+			_ = rc.Close()
+		}
+		resp.WithRawDataBytes(data)
+		// ProtoWithData reads the body, which the caller reads again.
+		defer resp.WithRawDataBytes(data)
+	}
+
+	pb, err := resp.ProtoWithData()
+	if err != nil {
+		return err
+	}
+	if len(pb.GetMessage().GetData().GetValue()) > c.maxResponseSize {
+		return nil
+	}
+
+	now := c.clock.Now()
+	c.cache.Add(key, &cachedResponse{
+		resp:     proto.Clone(pb).(*internalv1pb.InternalInvokeResponse),
+		storedAt: now,
+		expireAt: now.Add(ttl),
+	})
+	return nil
+}
+
+// responseTTL returns how long the response may be cached by a shared cache,
+// according to its Cache-Control header. Responses varying by request
+// headers are not cached.
+func responseTTL(headers invokev1.DaprInternalMetadata) time.Duration {
+	if len(headerValues(headers, "Vary")) > 0 {
+		return 0
+	}
+
+	var maxAge, sMaxAge time.Duration = -1, -1
+	for _, value := range headerValues(headers, "Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(name) {
+			case "no-store", "no-cache", "private":
+				return 0
+			case "max-age":
+				maxAge = parseSeconds(arg)
+			case "s-maxage":
+				sMaxAge = parseSeconds(arg)
+			}
+		}
+	}
+
+	if sMaxAge >= 0 {
+		return sMaxAge
+	}
+	return max(maxAge, 0)
+}
+
+func parseSeconds(arg string) time.Duration {
+	seconds, err := strconv.Atoi(strings.Trim(arg, `"`))
+	if err != nil || seconds < 0 {
+		return -1
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// hasCacheDirective returns true if the Cache-Control header of the request
+// contains the directive.
+func hasCacheDirective(md invokev1.DaprInternalMetadata, directive string) bool {
+	for _, value := range headerValues(md, "Cache-Control") {
+		for _, d := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(d), directive) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// headerValues returns the values of the header, whose name is matched case
+// insensitively as the metadata of HTTP requests keeps the original casing.
+func headerValues(md invokev1.DaprInternalMetadata, name string) []string {
+	var values []string
+	for k, v := range md {
+		if strings.EqualFold(k, name) {
+			values = append(values, v.GetValues()...)
+		}
+	}
+	return values
+}
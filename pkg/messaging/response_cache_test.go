@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+)
+
+func TestResponseCache(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	c := newResponseCache(config.ResponseCachingSpec{
+		Targets:         []string{"slow"},
+		MaxEntries:      2,
+		MaxResponseSize: 8,
+		MaxTTL:          new(time.Minute),
+	}, clock)
+	app := remoteApp{id: "slow", namespace: "default"}
+
+	get := func(method string, headers map[string][]string) *invokev1.InvokeMethodRequest {
+		req := invokev1.NewInvokeMethodRequest(method).WithHTTPExtension(http.MethodGet, "a=1")
+		if headers != nil {
+			req.WithMetadata(headers)
+		}
+		return req
+	}
+	ok := func(cacheControl string, body string) *invokev1.InvokeMethodResponse {
+		return invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil).
+			WithHTTPHeaders(map[string][]string{"Cache-Control": {cacheControl}}).
+			WithRawData(io.NopCloser(strings.NewReader(body)))
+	}
+	body := func(t *testing.T, resp *invokev1.InvokeMethodResponse) string {
+		t.Helper()
+		data, err := resp.RawDataFull()
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("cacheable requests", func(t *testing.T) {
+		_, cacheable := c.key(app, get("method", nil))
+		assert.True(t, cacheable)
+		_, cacheable = c.key(remoteApp{id: "other"}, get("method", nil))
+		assert.False(t, cacheable)
+		_, cacheable = c.key(app, invokev1.NewInvokeMethodRequest("method").WithHTTPExtension(http.MethodPost, ""))
+		assert.False(t, cacheable)
+		_, cacheable = c.key(app, get("method", map[string][]string{"authorization": {"Bearer token"}}))
+		assert.False(t, cacheable)
+		_, cacheable = c.key(app, get("method", map[string][]string{"Cache-Control": {"no-store"}}))
+		assert.False(t, cacheable)
+
+		k1, _ := c.key(app, get("method", nil))
+		k2, _ := c.key(app, invokev1.NewInvokeMethodRequest("method").WithHTTPExtension(http.MethodGet, "a=2"))
+		assert.NotEqual(t, k1, k2)
+	})
+
+	t.Run("response is cached for its max age", func(t *testing.T) {
+		req := get("maxage", nil)
+		key, _ := c.key(app, req)
+		resp := ok("public, max-age=10", "hello")
+		require.NoError(t, c.set(key, resp))
+		assert.Equal(t, "hello", body(t, resp))
+
+		clock.Step(5 * time.Second)
+		cached, hit := c.get(key, req)
+		require.True(t, hit)
+		assert.Equal(t, "hello", body(t, cached))
+		assert.Equal(t, []string{"5"}, cached.Headers()["Age"].GetValues())
+
+		_, hit = c.get(key, get("maxage", map[string][]string{"cache-control": {"no-cache"}}))
+		assert.False(t, hit)
+
+		clock.Step(5 * time.Second)
+		_, hit = c.get(key, req)
+		assert.False(t, hit)
+	})
+
+	t.Run("ttl is capped", func(t *testing.T) {
+		key, _ := c.key(app, get("capped", nil))
+		require.NoError(t, c.set(key, ok("max-age=600, s-maxage=3600", "hello")))
+		clock.Step(time.Minute)
+		_, hit := c.get(key, get("capped", nil))
+		assert.False(t, hit)
+	})
+
+	t.Run("responses which are not cached", func(t *testing.T) {
+		for name, resp := range map[string]*invokev1.InvokeMethodResponse{
+			"no-store":   ok("no-store", "hello"),
+			"private":    ok("private, max-age=10", "hello"),
+			"no max age": ok("public", "hello"),
+			"too large":  ok("max-age=10", "hello world"),
+			"error":      invokev1.NewInvokeMethodResponse(http.StatusNotFound, "", nil).WithHTTPHeaders(map[string][]string{"Cache-Control": {"max-age=10"}}),
+			"vary":       ok("max-age=10", "hello").WithHTTPHeaders(map[string][]string{"Cache-Control": {"max-age=10"}, "Vary": {"Accept"}}),
+		} {
+			t.Run(name, func(t *testing.T) {
+				key, _ := c.key(app, get(name, nil))
+				require.NoError(t, c.set(key, resp))
+				_, hit := c.get(key, get(name, nil))
+				assert.False(t, hit)
+			})
+		}
+
+		// The body of responses too large to be cached remains readable.
+		resp := ok("max-age=10", "hello world")
+		require.NoError(t, c.set("large", resp))
+		assert.Equal(t, "hello world", body(t, resp))
+	})
+
+	t.Run("least recently used responses are evicted", func(t *testing.T) {
+		keys := make([]string, 3)
+		for i, method := range []string{"a", "b", "c"} {
+			keys[i], _ = c.key(app, get(method, nil))
+			require.NoError(t, c.set(keys[i], ok("max-age=10", method)))
+		}
+		_, hit := c.get(keys[0], get("a", nil))
+		assert.False(t, hit)
+		_, hit = c.get(keys[2], get("c", nil))
+		assert.True(t, hit)
+	})
+}
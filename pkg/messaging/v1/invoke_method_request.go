@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"strings"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
@@ -286,6 +287,22 @@ func (imr *InvokeMethodRequest) ProtoWithData() (*internalv1pb.InternalInvokeReq
 	return m, nil
 }
 
+// CloneWithData returns a deep copy of the request with the entire data stream read into memory.
+// Unlike the request itself, the copy can be cloned again concurrently, for example to send parallel attempts of the request.
+// The HTTP response writer is not copied.
+func (imr *InvokeMethodRequest) CloneWithData() (*InvokeMethodRequest, error) {
+	pd, err := imr.ProtoWithData()
+	if err != nil {
+		return nil, err
+	}
+
+	return &InvokeMethodRequest{
+		r:           proto.Clone(pd).(*internalv1pb.InternalInvokeRequest),
+		dataObject:  imr.dataObject,
+		dataTypeURL: imr.dataTypeURL,
+	}, nil
+}
+
 // Actor returns actor type and id.
 func (imr *InvokeMethodRequest) Actor() *internalv1pb.Actor {
 	return imr.r.GetActor()
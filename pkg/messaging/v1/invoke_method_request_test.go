@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -401,6 +402,41 @@ func TestRequestProtoWithData(t *testing.T) {
 	})
 }
 
+func TestRequestCloneWithData(t *testing.T) {
+	req := NewInvokeMethodRequest("invoketest").
+		WithContentType("application/json").
+		WithRawDataString("test").
+		WithMetadata(map[string][]string{"header1": {"value1"}})
+	defer req.Close()
+
+	clone, err := req.CloneWithData()
+	require.NoError(t, err)
+	defer clone.Close()
+
+	assert.True(t, clone.HasMessageData())
+	assert.Equal(t, "application/json", clone.ContentType())
+	assert.Equal(t, "value1", clone.Metadata()["header1"].GetValues()[0])
+
+	// Modifying the clone does not modify the original request.
+	clone.Message().Method = "other"
+	assert.Equal(t, "invoketest", req.Message().GetMethod())
+
+	// Copies of the clone can be taken concurrently.
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, err := clone.CloneWithData()
+			assert.NoError(t, err)
+			data, err := c.RawDataFull()
+			assert.NoError(t, err)
+			assert.Equal(t, "test", string(data))
+		}()
+	}
+	wg.Wait()
+}
+
 func TestAddHeaders(t *testing.T) {
 	t.Run("single value", func(t *testing.T) {
 		req := NewInvokeMethodRequest("test_method")
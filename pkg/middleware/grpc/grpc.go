@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpc applies the HTTP middleware components to gRPC calls.
+//
+// Each call is presented to the middleware pipeline as a POST request to the
+// full name of the gRPC method, with the incoming metadata as headers. The
+// body of unary calls is the request message in the protobuf wire format, and
+// the body of the response is the response message, so middlewares can
+// inspect and transform them. Streaming calls, including the proxied gRPC
+// invocations, are presented with their metadata only.
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/kit/grpccodes"
+)
+
+// contentType is the content type of the requests presented to the
+// middlewares.
+const contentType = "application/grpc+proto"
+
+type unaryCallKey struct{}
+
+type streamCallKey struct{}
+
+// unaryCall holds the state of a unary call while it runs through the
+// pipeline.
+type unaryCall struct {
+	req      any
+	reqBody  []byte
+	handler  grpc.UnaryHandler
+	called   bool
+	resp     any
+	respBody []byte
+	err      error
+}
+
+// streamCall holds the state of a streaming call while it runs through the
+// pipeline.
+type streamCall struct {
+	srv     any
+	stream  grpc.ServerStream
+	handler grpc.StreamHandler
+	called  bool
+	err     error
+}
+
+// Interceptors returns the interceptors running the unary and streaming
+// calls through the pipeline. Calls the middlewares respond to without
+// invoking the next handler fail with the code matching the HTTP status of the
+// response.
+func Interceptors(pipeline middleware.HTTP) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unaryChain := pipeline(http.HandlerFunc(serveUnary))
+	streamChain := pipeline(http.HandlerFunc(serveStream))
+
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		call := &unaryCall{req: req, handler: handler}
+		if msg, ok := req.(proto.Message); ok {
+			var err error
+			call.reqBody, err = proto.Marshal(msg)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to encode the request for the middlewares: %v", err)
+			}
+		}
+
+		rec := newRecorder()
+		r := newRequest(context.WithValue(ctx, unaryCallKey{}, call), info.FullMethod, call.reqBody)
+		unaryChain.ServeHTTP(rec, r)
+		if !call.called {
+			return nil, rec.statusError()
+		}
+		if call.err != nil {
+			return call.resp, call.err
+		}
+
+		// Decode the response again if the middlewares transformed it
+		if msg, ok := call.resp.(proto.Message); ok && !bytes.Equal(rec.body.Bytes(), call.respBody) {
+			resp := msg.ProtoReflect().New().Interface()
+			if err := proto.Unmarshal(rec.body.Bytes(), resp); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to decode the response transformed by the middlewares: %v", err)
+			}
+			return resp, nil
+		}
+		return call.resp, nil
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		call := &streamCall{srv: srv, stream: ss, handler: handler}
+		rec := newRecorder()
+		r := newRequest(context.WithValue(ss.Context(), streamCallKey{}, call), info.FullMethod, nil)
+		streamChain.ServeHTTP(rec, r)
+		if !call.called {
+			return rec.statusError()
+		}
+		return call.err
+	}
+
+	return unary, stream
+}
+
+// serveUnary is the root handler of the pipeline of the unary calls. It
+// invokes the gRPC handler with the metadata and the message of the request
+// as left by the middlewares, and writes the response message.
+func serveUnary(w http.ResponseWriter, r *http.Request) {
+	call := r.Context().Value(unaryCallKey{}).(*unaryCall)
+	call.called = true
+
+	req := call.req
+	if msg, ok := req.(proto.Message); ok {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			call.err = status.Errorf(codes.Internal, "failed to read the request transformed by the middlewares: %v", err)
+			return
+		}
+		if !bytes.Equal(body, call.reqBody) {
+			transformed := msg.ProtoReflect().New().Interface()
+			if err = proto.Unmarshal(body, transformed); err != nil {
+				call.err = status.Errorf(codes.InvalidArgument, "failed to decode the request transformed by the middlewares: %v", err)
+				return
+			}
+			req = transformed
+		}
+	}
+
+	call.resp, call.err = call.handler(contextWithHeader(r.Context(), r.Header), req)
+	if call.err != nil {
+		return
+	}
+	if msg, ok := call.resp.(proto.Message); ok {
+		var err error
+		call.respBody, err = proto.Marshal(msg)
+		if err != nil {
+			call.err = status.Errorf(codes.Internal, "failed to encode the response for the middlewares: %v", err)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(call.respBody)
+	}
+}
+
+// serveStream is the root handler of the pipeline of the streaming calls. It
+// invokes the gRPC handler with the metadata left by the middlewares.
+func serveStream(_ http.ResponseWriter, r *http.Request) {
+	call := r.Context().Value(streamCallKey{}).(*streamCall)
+	call.called = true
+	call.err = call.handler(call.srv, &serverStream{
+		ServerStream: call.stream,
+		ctx:          contextWithHeader(call.stream.Context(), r.Header),
+	})
+}
+
+// newRequest returns the request presented to the middlewares for a call to
+// the method.
+func newRequest(ctx context.Context, method string, body []byte) *http.Request {
+	r, _ := http.NewRequestWithContext(ctx, http.MethodPost, method, bytes.NewReader(body))
+	r.RequestURI = method
+	r.Proto, r.ProtoMajor, r.ProtoMinor = "HTTP/2.0", 2, 0
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for k, vals := range md {
+		if strings.HasPrefix(k, ":") {
+			continue
+		}
+		for _, v := range vals {
+			r.Header.Add(k, v)
+		}
+	}
+	r.Header.Set("Content-Type", contentType)
+	if authority := md.Get(":authority"); len(authority) > 0 {
+		r.Host = authority[0]
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.RemoteAddr = p.Addr.String()
+	}
+	return r
+}
+
+// contextWithHeader returns the context with the incoming metadata replaced
+// by the headers of the request, which the middlewares may have changed.
+func contextWithHeader(ctx context.Context, header http.Header) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	out := make(metadata.MD, len(header)+1)
+	for k, vals := range header {
+		if strings.EqualFold(k, "Content-Type") {
+			continue
+		}
+		out[strings.ToLower(k)] = vals
+	}
+	// Keep the pseudo-headers and the content type of the call
+	for k, vals := range md {
+		if strings.HasPrefix(k, ":") || k == "content-type" {
+			out[k] = vals
+		}
+	}
+	return metadata.NewIncomingContext(ctx, out)
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// recorder records the response written by the pipeline.
+type recorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func newRecorder() *recorder {
+	return &recorder{header: make(http.Header)}
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(p)
+}
+
+// statusError returns the error of a call the middlewares responded to.
+func (r *recorder) statusError() error {
+	code := r.code
+	if code == 0 {
+		code = http.StatusOK
+	}
+	msg := strings.TrimSpace(r.body.String())
+	if msg == "" {
+		msg = http.StatusText(code)
+	}
+	grpcCode := grpccodes.CodeFromHTTPStatus(code)
+	if grpcCode == codes.OK {
+		// The call was not handled, so it can't succeed
+		grpcCode = codes.Unknown
+	}
+	return status.Error(grpcCode, "rejected by middleware: "+msg)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testMiddleware denies the requests with the x-deny header, sets the
+// x-middleware header, and upper-cases the string values of the requests and
+// responses with the x-transform header.
+func testMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-deny") != "" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("denied"))
+			return
+		}
+		r.Header.Set("x-middleware", r.URL.Path)
+
+		if r.Header.Get("x-transform") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(upper(r.Body)))
+		rec := newRecorder()
+		next.ServeHTTP(rec, r)
+		_, _ = w.Write(upper(&rec.body))
+	})
+}
+
+func upper(r io.Reader) []byte {
+	b, _ := io.ReadAll(r)
+	var msg wrapperspb.StringValue
+	_ = proto.Unmarshal(b, &msg)
+	b, _ = proto.Marshal(wrapperspb.String(string(bytes.ToUpper([]byte(msg.GetValue())))))
+	return b
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	unary, _ := Interceptors(testMiddleware)
+	info := &grpc.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/GetState"}
+
+	var gotMD metadata.MD
+	var gotReq any
+	handler := func(ctx context.Context, req any) (any, error) {
+		gotMD, _ = metadata.FromIncomingContext(ctx)
+		gotReq = req
+		return wrapperspb.String("response"), nil
+	}
+
+	t.Run("call runs through the middlewares", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("dapr-app-id", "myapp"))
+		resp, err := unary(ctx, wrapperspb.String("request"), info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "response", resp.(*wrapperspb.StringValue).GetValue())
+		assert.Equal(t, "request", gotReq.(*wrapperspb.StringValue).GetValue())
+		assert.Equal(t, []string{"myapp"}, gotMD.Get("dapr-app-id"))
+		assert.Equal(t, []string{info.FullMethod}, gotMD.Get("x-middleware"))
+	})
+
+	t.Run("messages are transformed", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("x-transform", "1"))
+		resp, err := unary(ctx, wrapperspb.String("request"), info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "RESPONSE", resp.(*wrapperspb.StringValue).GetValue())
+		assert.Equal(t, "REQUEST", gotReq.(*wrapperspb.StringValue).GetValue())
+	})
+
+	t.Run("call rejected by a middleware", func(t *testing.T) {
+		gotReq = nil
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("x-deny", "1"))
+		_, err := unary(ctx, wrapperspb.String("request"), info, handler)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "denied")
+		assert.Nil(t, gotReq)
+	})
+
+	t.Run("handler error is returned", func(t *testing.T) {
+		_, err := unary(t.Context(), wrapperspb.String("request"), info, func(ctx context.Context, req any) (any, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	_, stream := Interceptors(testMiddleware)
+	info := &grpc.StreamServerInfo{FullMethod: "/myapp.Service/Stream"}
+
+	var gotMD metadata.MD
+	handler := func(srv any, ss grpc.ServerStream) error {
+		gotMD, _ = metadata.FromIncomingContext(ss.Context())
+		return nil
+	}
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("dapr-app-id", "myapp"))
+	require.NoError(t, stream(nil, &fakeServerStream{ctx: ctx}, info, handler))
+	assert.Equal(t, []string{info.FullMethod}, gotMD.Get("x-middleware"))
+	assert.Equal(t, []string{"myapp"}, gotMD.Get("dapr-app-id"))
+
+	gotMD = nil
+	ctx = metadata.NewIncomingContext(t.Context(), metadata.Pairs("x-deny", "1"))
+	err := stream(nil, &fakeServerStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, gotMD)
+}
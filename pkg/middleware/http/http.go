@@ -34,6 +34,16 @@ type HTTP struct {
 	pipelines []*pipeline
 }
 
+// Outbound returns the pipelines of the calls to each destination, built from
+// the handlers of a spec which select the destination.
+type Outbound struct {
+	lock      sync.Mutex
+	http      *HTTP
+	name      string
+	spec      *config.PipelineSpec
+	pipelines map[string]middleware.HTTP
+}
+
 // Spec is a specification for a creating a middleware.
 type Spec struct {
 	Component      compapi.Component
@@ -81,3 +91,44 @@ func (h *HTTP) BuildPipelineFromSpec(name string, spec *config.PipelineSpec) mid
 	h.pipelines = append(h.pipelines, p)
 	return p.http()
 }
+
+// BuildOutboundPipelineFromSpec returns the outbound pipelines of a spec,
+// whose handlers apply to the calls to the destinations their selector
+// selects.
+func (h *HTTP) BuildOutboundPipelineFromSpec(name string, spec *config.PipelineSpec) *Outbound {
+	return &Outbound{
+		http:      h,
+		name:      name,
+		spec:      spec,
+		pipelines: make(map[string]middleware.HTTP),
+	}
+}
+
+// For returns the pipeline of the calls to the destination, or nil if no
+// handler applies to them.
+func (o *Outbound) For(destination string) middleware.HTTP {
+	if o == nil || o.spec == nil || len(o.spec.Handlers) == 0 {
+		return nil
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if p, ok := o.pipelines[destination]; ok {
+		return p
+	}
+
+	var spec config.PipelineSpec
+	for _, handler := range o.spec.Handlers {
+		if handler.SelectorSpec.SelectsDestination(destination) {
+			spec.Handlers = append(spec.Handlers, handler)
+		}
+	}
+
+	var p middleware.HTTP
+	if len(spec.Handlers) > 0 {
+		p = o.http.BuildPipelineFromSpec(o.name+"/"+destination, &spec)
+	}
+	o.pipelines[destination] = p
+	return p
+}
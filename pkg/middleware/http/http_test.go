@@ -151,3 +151,44 @@ func TestHTTP(t *testing.T) {
 		assert.Equal(t, int32(4), middle2.invoked.Load())
 	})
 }
+
+func TestOutbound(t *testing.T) {
+	middle1 := newTestMiddle("test")
+	middle2 := newTestMiddle("test2")
+	h := New()
+	h.Add(Spec{
+		Component:      middle1.comp,
+		Implementation: middle1.item.Middleware,
+	})
+	h.Add(Spec{
+		Component:      middle2.comp,
+		Implementation: middle2.item.Middleware,
+	})
+
+	outbound := h.BuildOutboundPipelineFromSpec("outbound", &config.PipelineSpec{Handlers: []config.HandlerSpec{
+		{Name: "test", Type: "middleware.http.fakemw", Version: "v1"},
+		{Name: "test2", Type: "middleware.http.fakemw", SelectorSpec: config.SelectorSpec{Fields: []config.SelectorField{
+			{Field: config.SelectorDestinationField, Value: "orders"},
+		}}},
+	}})
+
+	var invoked int
+	root := nethttp.HandlerFunc(func(nethttp.ResponseWriter, *nethttp.Request) { invoked++ })
+
+	outbound.For("catalog")(root).ServeHTTP(nil, nil)
+	assert.Equal(t, 1, invoked)
+	assert.Equal(t, int32(1), middle1.invoked.Load())
+	assert.Equal(t, int32(0), middle2.invoked.Load())
+
+	outbound.For("orders")(root).ServeHTTP(nil, nil)
+	assert.Equal(t, 2, invoked)
+	assert.Equal(t, int32(2), middle1.invoked.Load())
+	assert.Equal(t, int32(1), middle2.invoked.Load())
+
+	assert.Nil(t, h.BuildOutboundPipelineFromSpec("outbound", nil).For("orders"))
+	assert.Nil(t, h.BuildOutboundPipelineFromSpec("outbound", &config.PipelineSpec{Handlers: []config.HandlerSpec{
+		{Name: "test", Type: "middleware.http.fakemw", SelectorSpec: config.SelectorSpec{Fields: []config.SelectorField{
+			{Field: config.SelectorDestinationField, Value: "orders"},
+		}}},
+	}}).For("catalog"))
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wasm implements the HTTP middleware running the requests and the
+// responses through an http-wasm guest module.
+//
+// The instances of the module are pooled: they are instantiated once and
+// reused across requests, each serving one request at a time, so heavy
+// transforms don't pay for the instantiation of the module per request. The
+// memory of each instance can be limited, and the guest can read the secrets
+// of the component with the get_secret function of the dapr host module.
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/http-wasm/http-wasm-host-go/api"
+	"github.com/http-wasm/http-wasm-host-go/handler"
+	wasmnethttp "github.com/http-wasm/http-wasm-host-go/handler/nethttp"
+	"github.com/tetratelabs/wazero"
+	wazeroapi "github.com/tetratelabs/wazero/api"
+
+	"github.com/dapr/components-contrib/common/wasm"
+	contribmiddleware "github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/dapr/pkg/middleware"
+	"github.com/dapr/kit/logger"
+	kitmd "github.com/dapr/kit/metadata"
+)
+
+const (
+	// HostModule is the name of the host module of the functions dapr
+	// exports to the guests.
+	HostModule = "dapr"
+	// FuncGetSecret is the name of the function returning a secret of the
+	// component.
+	//
+	// It takes the name of the secret and a buffer, as (name, name_len, buf,
+	// buf_limit), and returns the length of the secret, which is written to
+	// the buffer if not larger than buf_limit, or -1 if the component has no
+	// such secret.
+	FuncGetSecret = "get_secret"
+
+	// pageSize is the size of a page of the memory of a module.
+	pageSize = 64 * 1024
+	// maxPages is the maximum number of pages of the memory of a module.
+	maxPages = 65536
+)
+
+// Metadata is the metadata of the middleware, in addition to the metadata
+// common to the wasm components.
+type Metadata struct {
+	// GuestConfig is an optional configuration passed to the guest.
+	GuestConfig string `mapstructure:"guestConfig"`
+	// MaxInstances is the maximum number of instances of the module, hence of
+	// requests handled concurrently. Requests wait for an instance to be free
+	// beyond it. 0 doesn't limit the instances.
+	MaxInstances int `mapstructure:"maxInstances"`
+	// MinInstances is the number of instances created with the middleware,
+	// which are kept for its lifetime.
+	MinInstances int `mapstructure:"minInstances"`
+	// MaxMemory limits the memory of each instance, e.g. "16Mi". 0 applies
+	// the limit of the module, or 4Gi.
+	MaxMemory kitmd.ByteSize `mapstructure:"maxMemory"`
+	// Secrets is the comma-separated list of the metadata properties the
+	// guest can read with get_secret, usually set from secret references.
+	Secrets string `mapstructure:"secrets"`
+}
+
+// maxIdle returns the maximum number of idle instances kept in the pool.
+func (m Metadata) maxIdle() int {
+	if m.MaxInstances > 0 {
+		return m.MaxInstances
+	}
+	return max(m.MinInstances, runtime.GOMAXPROCS(0))
+}
+
+// NewMiddleware returns the middleware for the metadata of the component.
+func NewMiddleware(ctx context.Context, log logger.Logger, metadata contribmiddleware.Metadata) (middleware.HTTP, error) {
+	p, err := newPool(ctx, log, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return p.handler, nil
+}
+
+// newPool returns the pool of the instances of the module of the component.
+func newPool(ctx context.Context, log logger.Logger, metadata contribmiddleware.Metadata) (*pool, error) {
+	initMeta, err := wasm.GetInitMetadata(ctx, metadata.Base)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: failed to parse metadata: %w", err)
+	}
+
+	var meta Metadata
+	if err = kitmd.DecodeMetadata(metadata.Properties, &meta); err != nil {
+		return nil, fmt.Errorf("wasm: failed to parse wasm middleware metadata: %w", err)
+	}
+	if meta.MaxInstances < 0 || meta.MinInstances < 0 {
+		return nil, errors.New("wasm: the number of instances can't be negative")
+	}
+	if meta.MaxInstances > 0 && meta.MinInstances > meta.MaxInstances {
+		return nil, fmt.Errorf("wasm: minInstances (%d) is greater than maxInstances (%d)", meta.MinInstances, meta.MaxInstances)
+	}
+	maxMemory, err := meta.MaxMemory.GetBytes()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid maxMemory: %w", err)
+	}
+
+	secrets := make(map[string]string)
+	for _, name := range strings.Split(meta.Secrets, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		v, ok := metadata.Properties[name]
+		if !ok {
+			return nil, fmt.Errorf("wasm: secret %s is not a metadata property of the component", name)
+		}
+		secrets[name] = v
+	}
+
+	p := &pool{
+		log:     log,
+		maxIdle: meta.maxIdle(),
+		cache:   wazero.NewCompilationCache(),
+	}
+	if meta.MaxInstances > 0 {
+		p.sem = make(chan struct{}, meta.MaxInstances)
+	}
+	p.newInstance = func(ctx context.Context) (*instance, error) {
+		return newInstance(ctx, log, p.cache, initMeta, meta.GuestConfig, memoryLimitPages(maxMemory), secrets)
+	}
+
+	// Create the first instance even without minInstances, to fail fast if
+	// the guest is invalid
+	for range max(meta.MinInstances, 1) {
+		i, err := p.newInstance(ctx)
+		if err != nil {
+			p.close()
+			return nil, err
+		}
+		p.idle = append(p.idle, i)
+	}
+
+	return p, nil
+}
+
+// memoryLimitPages returns the number of pages of the memory limit, or 0 if
+// there is no limit.
+func memoryLimitPages(maxMemory int64) uint32 {
+	if maxMemory <= 0 {
+		return 0
+	}
+	pages := (maxMemory + pageSize - 1) / pageSize
+	return uint32(min(pages, maxPages)) //nolint:gosec
+}
+
+// pool is the pool of the instances of the module.
+type pool struct {
+	log         logger.Logger
+	newInstance func(context.Context) (*instance, error)
+	cache       wazero.CompilationCache
+	maxIdle     int
+
+	// sem limits the instances in use, if not nil.
+	sem chan struct{}
+
+	lock   sync.Mutex
+	idle   []*instance
+	closed bool
+}
+
+// get returns an idle instance, or a new one if there is none. It waits for
+// an instance to be released if the instances are limited.
+func (p *pool) get(ctx context.Context) (*instance, error) {
+	if p.sem != nil {
+		select {
+		case p.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	p.lock.Lock()
+	if n := len(p.idle); n > 0 {
+		i := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.lock.Unlock()
+		return i, nil
+	}
+	p.lock.Unlock()
+
+	i, err := p.newInstance(ctx)
+	if err != nil {
+		p.releaseSem()
+		return nil, err
+	}
+	return i, nil
+}
+
+// put returns the instance to the pool, or closes it if the pool has enough
+// idle instances.
+func (p *pool) put(i *instance) {
+	defer p.releaseSem()
+
+	p.lock.Lock()
+	if !p.closed && len(p.idle) < p.maxIdle {
+		p.idle = append(p.idle, i)
+		p.lock.Unlock()
+		return
+	}
+	p.lock.Unlock()
+	i.close()
+}
+
+func (p *pool) releaseSem() {
+	if p.sem != nil {
+		<-p.sem
+	}
+}
+
+func (p *pool) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i, err := p.get(r.Context())
+		if err != nil {
+			p.log.Errorf("wasm: failed to get an instance of the module: %v", err)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		defer p.put(i)
+
+		i.mw.NewHandler(r.Context(), next).ServeHTTP(w, r)
+		i.logOutput()
+	})
+}
+
+// close closes the idle instances. The instances in use are closed when
+// they are released.
+func (p *pool) close() {
+	p.lock.Lock()
+	p.closed = true
+	idle := p.idle
+	p.idle = nil
+	p.lock.Unlock()
+
+	for _, i := range idle {
+		i.close()
+	}
+}
+
+// instance is an instance of the module, which has its own runtime and
+// memory, and handles one request at a time.
+type instance struct {
+	log            logger.Logger
+	mw             wasmnethttp.Middleware
+	stdout, stderr bytes.Buffer
+}
+
+func newInstance(ctx context.Context, log logger.Logger, cache wazero.CompilationCache, meta *wasm.InitMetadata, guestConfig string, pages uint32, secrets map[string]string) (*instance, error) {
+	i := &instance{log: log}
+	newRuntime := func(ctx context.Context) (wazero.Runtime, error) {
+		cfg := wazero.NewRuntimeConfig().WithCompilationCache(cache)
+		if pages > 0 {
+			cfg = cfg.WithMemoryLimitPages(pages)
+		}
+		r := wazero.NewRuntimeWithConfig(ctx, cfg)
+		if err := instantiateHost(ctx, r, secrets); err != nil {
+			_ = r.Close(ctx)
+			return nil, fmt.Errorf("wasm: error instantiating the dapr host module: %w", err)
+		}
+		return r, nil
+	}
+
+	var err error
+	i.mw, err = wasmnethttp.NewMiddleware(ctx, meta.Guest,
+		handler.Runtime(newRuntime),
+		handler.Logger(&apiLogger{log: log}),
+		handler.ModuleConfig(wasm.NewModuleConfig(meta).
+			WithName(meta.GuestName).
+			WithStdout(&i.stdout).
+			WithStderr(&i.stderr)),
+		handler.GuestConfig([]byte(guestConfig)))
+	if err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// logOutput logs and resets the output the guest wrote while handling the
+// request.
+func (i *instance) logOutput() {
+	if i.stdout.Len() > 0 {
+		i.log.Debugf("wasm stdout: %s", i.stdout.String())
+		i.stdout.Reset()
+	}
+	if i.stderr.Len() > 0 {
+		i.log.Debugf("wasm stderr: %s", i.stderr.String())
+		i.stderr.Reset()
+	}
+}
+
+func (i *instance) close() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := i.mw.Close(ctx); err != nil {
+		i.log.Warnf("wasm: failed to close an instance of the module: %v", err)
+	}
+}
+
+// instantiateHost instantiates the dapr host module in the runtime.
+func instantiateHost(ctx context.Context, r wazero.Runtime, secrets map[string]string) error {
+	i32 := wazeroapi.ValueTypeI32
+	getSecret := func(_ context.Context, mod wazeroapi.Module, stack []uint64) {
+		name, nameLen := uint32(stack[0]), uint32(stack[1]) //nolint:gosec
+		buf, bufLimit := uint32(stack[2]), uint32(stack[3]) //nolint:gosec
+
+		b, ok := mod.Memory().Read(name, nameLen)
+		if !ok {
+			panic(fmt.Errorf("out of memory reading the name of the secret"))
+		}
+		v, ok := secrets[string(b)]
+		if !ok {
+			stack[0] = wazeroapi.EncodeI32(-1)
+			return
+		}
+		if uint32(len(v)) <= bufLimit { //nolint:gosec
+			mod.Memory().Write(buf, []byte(v))
+		}
+		stack[0] = uint64(len(v))
+	}
+
+	_, err := r.NewHostModuleBuilder(HostModule).
+		NewFunctionBuilder().
+		WithGoModuleFunction(wazeroapi.GoModuleFunc(getSecret), []wazeroapi.ValueType{i32, i32, i32, i32}, []wazeroapi.ValueType{i32}).
+		WithParameterNames("name", "name_len", "buf", "buf_limit").
+		Export(FuncGetSecret).
+		Instantiate(ctx)
+	return err
+}
+
+// apiLogger logs the messages of the guest.
+type apiLogger struct {
+	log logger.Logger
+}
+
+func (l *apiLogger) IsEnabled(level api.LogLevel) bool {
+	switch level {
+	case api.LogLevelError:
+		return l.log.IsOutputLevelEnabled(logger.ErrorLevel)
+	case api.LogLevelWarn:
+		return l.log.IsOutputLevelEnabled(logger.WarnLevel)
+	case api.LogLevelInfo:
+		return l.log.IsOutputLevelEnabled(logger.InfoLevel)
+	case api.LogLevelDebug:
+		return l.log.IsOutputLevelEnabled(logger.DebugLevel)
+	default:
+		return false
+	}
+}
+
+func (l *apiLogger) Log(_ context.Context, level api.LogLevel, message string) {
+	switch level {
+	case api.LogLevelError:
+		l.log.Error(message)
+	case api.LogLevelWarn:
+		l.log.Warn(message)
+	case api.LogLevelInfo:
+		l.log.Info(message)
+	case api.LogLevelDebug:
+		l.log.Debug(message)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/metadata"
+	contribmiddleware "github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/kit/logger"
+)
+
+// testdata/rewrite.wasm rewrites the request URI /v1.0/hi?name=panda to
+// /v1.0/hello?name=teddy.
+func testMetadata(t *testing.T, props map[string]string) contribmiddleware.Metadata {
+	path, err := filepath.Abs("testdata/rewrite.wasm")
+	require.NoError(t, err)
+
+	md := contribmiddleware.Metadata{Base: metadata.Base{Properties: map[string]string{
+		"url": "file://" + path,
+	}}}
+	for k, v := range props {
+		md.Properties[k] = v
+	}
+	return md
+}
+
+func TestMiddleware(t *testing.T) {
+	log := logger.NewLogger("wasm.test")
+
+	t.Run("request is transformed by a pooled instance", func(t *testing.T) {
+		p, err := newPool(t.Context(), log, testMetadata(t, nil))
+		require.NoError(t, err)
+		defer p.close()
+		require.Len(t, p.idle, 1)
+		first := p.idle[0]
+
+		var uri string
+		h := p.handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			uri = r.URL.RequestURI()
+		}))
+		for range 3 {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1.0/hi?name=panda", nil))
+			assert.Equal(t, "/v1.0/hello?name=teddy", uri)
+		}
+		require.Len(t, p.idle, 1)
+		assert.Same(t, first, p.idle[0])
+	})
+
+	t.Run("min instances are created upfront", func(t *testing.T) {
+		p, err := newPool(t.Context(), log, testMetadata(t, map[string]string{"minInstances": "3"}))
+		require.NoError(t, err)
+		defer p.close()
+		assert.Len(t, p.idle, 3)
+	})
+
+	t.Run("requests wait for an instance beyond max instances", func(t *testing.T) {
+		p, err := newPool(t.Context(), log, testMetadata(t, map[string]string{"maxInstances": "1"}))
+		require.NoError(t, err)
+		defer p.close()
+
+		i, err := p.get(t.Context())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		_, err = p.get(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+
+		p.put(i)
+		i, err = p.get(t.Context())
+		require.NoError(t, err)
+		p.put(i)
+	})
+
+	t.Run("memory limit is rounded up to pages", func(t *testing.T) {
+		assert.Equal(t, uint32(0), memoryLimitPages(0))
+		assert.Equal(t, uint32(1), memoryLimitPages(1))
+		assert.Equal(t, uint32(256), memoryLimitPages(16<<20))
+		assert.Equal(t, uint32(maxPages), memoryLimitPages(1<<40))
+
+		p, err := newPool(t.Context(), log, testMetadata(t, map[string]string{"maxMemory": "64Ki"}))
+		require.NoError(t, err)
+		p.close()
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		_, err := newPool(t.Context(), log, testMetadata(t, map[string]string{"minInstances": "2", "maxInstances": "1"}))
+		require.ErrorContains(t, err, "minInstances (2) is greater than maxInstances (1)")
+
+		_, err = newPool(t.Context(), log, testMetadata(t, map[string]string{"secrets": "token"}))
+		require.ErrorContains(t, err, "secret token is not a metadata property of the component")
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -34,6 +35,7 @@ import (
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	wfaclapi "github.com/dapr/dapr/pkg/apis/workflowaccesspolicy/v1alpha1"
 	"github.com/dapr/dapr/pkg/operator/api/informer"
+	"github.com/dapr/dapr/pkg/operator/api/rollout"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/kit/concurrency"
@@ -44,6 +46,10 @@ const (
 	APIVersionV1alpha1    = "dapr.io/v1alpha1"
 	APIVersionV2alpha1    = "dapr.io/v2alpha1"
 	kubernetesSecretStore = "kubernetes"
+
+	// resyncInterval is the interval of sending the sequence of the last event
+	// on the streams of the sidecars which request it.
+	resyncInterval = 30 * time.Second
 )
 
 var log = logger.NewLogger("dapr.operator.api")
@@ -79,6 +85,8 @@ type apiServer struct {
 	mcpServerInformer  informer.Interface[mcpserverapi.MCPServer]
 	policyInformer     informer.Interface[wfaclapi.WorkflowAccessPolicy]
 
+	rollout *rollout.Tracker
+
 	readyCh chan struct{}
 	running atomic.Bool
 	closed  atomic.Bool
@@ -117,6 +125,11 @@ func NewAPIServer(opts Options) Server {
 		policyInformer: informer.New[wfaclapi.WorkflowAccessPolicy](informer.Options{
 			Cache: opts.Cache,
 		}),
+		rollout: rollout.New(rollout.Options{
+			Client:    opts.Client,
+			PodReader: podReader,
+			Operator:  operatorName(),
+		}),
 		sec:           opts.Security,
 		port:          strconv.Itoa(opts.Port),
 		listenAddress: opts.ListenAddress,
@@ -154,6 +167,7 @@ func (a *apiServer) Run(ctx context.Context) error {
 		a.configInformer.Run,
 		a.resiliencyInformer.Run,
 		a.policyInformer.Run,
+		a.rollout.Run,
 		func(ctx context.Context) error {
 			if err := s.Serve(lis); err != nil {
 				return fmt.Errorf("gRPC server error: %w", err)
@@ -190,3 +204,22 @@ func (a *apiServer) Ready(ctx context.Context) error {
 		return errors.New("timeout waiting for api server to be ready")
 	}
 }
+
+// resyncIntervalFor returns the resync interval of a stream, which is only
+// resynced when the sidecar requests it.
+func resyncIntervalFor(requested bool) time.Duration {
+	if !requested {
+		return 0
+	}
+	return resyncInterval
+}
+
+// operatorName returns the name of the operator replica, which is the name of
+// its pod in Kubernetes.
+func operatorName() string {
+	if name := os.Getenv("POD_NAME"); name != "" {
+		return name
+	}
+	name, _ := os.Hostname()
+	return name
+}
@@ -71,6 +71,8 @@ func (a *apiServer) ComponentUpdate(in *operatorv1pb.ComponentUpdateRequest, srv
 		Namespace:      in.GetNamespace(),
 		KubeClient:     a.Client,
 		ProcessSecrets: processComponentSecrets,
+		Sequenced:      true,
+		ResyncInterval: resyncIntervalFor(in.GetResync()),
 	})
 	defer client.CacheLoop()
 
@@ -68,6 +68,15 @@ func processHTTPEndpointSecrets(ctx context.Context, endpoint *httpendpointsapi.
 		endpoint.Spec.ClientTLS.RootCA.Value = &v
 	}
 
+	if endpoint.HasOAuth2ClientSecretRef() && pairNeedsSecretExtraction(*endpoint.Spec.OAuth2.ClientSecret.SecretKeyRef, endpoint.Auth) {
+		v, err := getSecret(ctx, endpoint.Spec.OAuth2.ClientSecret.SecretKeyRef.Name, namespace, *endpoint.Spec.OAuth2.ClientSecret.SecretKeyRef, kubeClient)
+		if err != nil {
+			return err
+		}
+
+		endpoint.Spec.OAuth2.ClientSecret.Value = &v
+	}
+
 	return nil
 }
 
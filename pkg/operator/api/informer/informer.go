@@ -16,6 +16,7 @@ package informer
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 
@@ -201,6 +202,17 @@ func (i *informer[T]) handleEvent(ctx context.Context, oldObj, newObj any, event
 				newMeta.GetNamespace(), newMeta.GetName(), newMeta.GetResourceVersion())
 			return
 		}
+		// The generation of resources with a status subresource doesn't change
+		// on updates to their status, which sidecars don't need.
+		if oerr == nil && nerr == nil &&
+			oldMeta.GetGeneration() != 0 &&
+			oldMeta.GetGeneration() == newMeta.GetGeneration() &&
+			maps.Equal(oldMeta.GetLabels(), newMeta.GetLabels()) &&
+			maps.Equal(oldMeta.GetAnnotations(), newMeta.GetAnnotations()) {
+			i.log.Debugf("Ignoring update event for %s/%s: generation %d unchanged",
+				newMeta.GetNamespace(), newMeta.GetName(), newMeta.GetGeneration())
+			return
+		}
 	}
 
 	newT, ok := i.anyToT(newObj)
@@ -209,6 +209,12 @@ func Test_handleEvent_resyncDedup(t *testing.T) {
 		}
 	}
 
+	withGeneration := func(c *compapi.Component, generation int64, labels map[string]string) *compapi.Component {
+		c.Generation = generation
+		c.Labels = labels
+		return c
+	}
+
 	tests := map[string]struct {
 		old       *compapi.Component
 		new       *compapi.Component
@@ -233,6 +239,24 @@ func Test_handleEvent_resyncDedup(t *testing.T) {
 			eventType: operator.ResourceEventType_UPDATED,
 			expFwd:    true,
 		},
+		"UPDATED with equal generation is dropped (status update)": {
+			old:       withGeneration(comp("100", "bindings.redis"), 2, nil),
+			new:       withGeneration(comp("101", "bindings.redis"), 2, nil),
+			eventType: operator.ResourceEventType_UPDATED,
+			expFwd:    false,
+		},
+		"UPDATED with changed generation is forwarded": {
+			old:       withGeneration(comp("100", "bindings.redis"), 2, nil),
+			new:       withGeneration(comp("101", "bindings.kafka"), 3, nil),
+			eventType: operator.ResourceEventType_UPDATED,
+			expFwd:    true,
+		},
+		"UPDATED with equal generation and changed labels is forwarded": {
+			old:       withGeneration(comp("100", "bindings.redis"), 2, nil),
+			new:       withGeneration(comp("101", "bindings.redis"), 2, map[string]string{"a": "b"}),
+			eventType: operator.ResourceEventType_UPDATED,
+			expFwd:    true,
+		},
 		"CREATED with equal resourceVersion is forwarded (only UPDATED is deduped)": {
 			old:       nil,
 			new:       comp("100", "bindings.redis"),
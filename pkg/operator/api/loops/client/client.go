@@ -17,13 +17,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/dapr/dapr/pkg/operator/api/informer"
 	"github.com/dapr/dapr/pkg/operator/api/loops"
 	"github.com/dapr/dapr/pkg/operator/api/loops/sender"
 	"github.com/dapr/dapr/pkg/operator/api/loops/stream"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/events/loop"
@@ -46,6 +50,13 @@ type Options[T meta.Resource] struct {
 	// enqueued or streamed. Used to forward only the resources that belong to the
 	// connecting app.
 	Filter func(T) bool
+	// Sequenced numbers the events sent on the stream, and sends the resource
+	// versions of the resources, so the sidecar detects missed events.
+	Sequenced bool
+	// ResyncInterval, when set on sequenced streams, is the interval of
+	// sending the sequence of the last event to the sidecar.
+	ResyncInterval time.Duration
+	Clock          clock.WithTicker
 }
 
 type Client[T meta.Resource] struct {
@@ -55,6 +66,12 @@ type Client[T meta.Resource] struct {
 	kubeClient     client.Client
 	processSecrets func(context.Context, *T, string, client.Client) error
 	filter         func(T) bool
+	sequenced      bool
+	resyncInterval time.Duration
+	clock          clock.WithTicker
+
+	// sequence is the sequence of the last event sent on the stream.
+	sequence uint64
 
 	loop       loop.Interface[loops.EventClient]
 	streamLoop loop.Interface[loops.EventStream]
@@ -68,10 +85,16 @@ func New[T meta.Resource](opts Options[T]) *Client[T] {
 		kubeClient:     opts.KubeClient,
 		processSecrets: opts.ProcessSecrets,
 		filter:         opts.Filter,
+		sequenced:      opts.Sequenced,
+		resyncInterval: opts.ResyncInterval,
+		clock:          opts.Clock,
 		streamLoop: stream.New(stream.Options{
 			Stream: opts.Stream,
 		}),
 	}
+	if c.clock == nil {
+		c.clock = clock.RealClock{}
+	}
 	c.loop = LoopFactoryCache.NewLoop(c)
 	return c
 }
@@ -79,6 +102,7 @@ func New[T meta.Resource](opts Options[T]) *Client[T] {
 func (c *Client[T]) Run(ctx context.Context) error {
 	return concurrency.NewRunnerManager(
 		c.watchEvents,
+		c.watchResync,
 		c.streamLoop.Run,
 		c.loop.Run,
 	).Run(ctx)
@@ -112,10 +136,31 @@ func (c *Client[T]) watchEvents(ctx context.Context) error {
 	}
 }
 
+func (c *Client[T]) watchResync(ctx context.Context) error {
+	if !c.sequenced || c.resyncInterval <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := c.clock.NewTicker(c.resyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			c.loop.Enqueue(new(loops.Resync))
+		}
+	}
+}
+
 func (c *Client[T]) Handle(ctx context.Context, event loops.EventClient) error {
 	switch e := event.(type) {
 	case *loops.ResourceUpdate[T]:
 		return c.handleResourceUpdate(ctx, e)
+	case *loops.Resync:
+		c.handleResync()
 	case *loops.Shutdown:
 		c.handleShutdown(e)
 	default:
@@ -144,15 +189,30 @@ func (c *Client[T]) handleResourceUpdate(ctx context.Context, e *loops.ResourceU
 			r.Kind(), r.GetName(), c.namespace, err)
 	}
 
-	c.streamLoop.Enqueue(&loops.StreamSend{
+	send := &loops.StreamSend{
 		Resource:  b,
 		EventType: e.EventType,
-	})
+	}
+	if c.sequenced {
+		c.sequence++
+		send.Sequence = c.sequence
+		if obj, ok := any(&r).(metav1.Object); ok {
+			send.ResourceVersion = obj.GetResourceVersion()
+		}
+	}
+	c.streamLoop.Enqueue(send)
 
 	log.Debugf("updated sidecar with %s %s %s in namespace %s", r.Kind(), e.EventType.String(), r.GetName(), c.namespace)
 	return nil
 }
 
+func (c *Client[T]) handleResync() {
+	c.streamLoop.Enqueue(&loops.StreamSend{
+		EventType: operatorv1pb.ResourceEventType_RESYNC,
+		Sequence:  c.sequence,
+	})
+}
+
 func (c *Client[T]) handleShutdown(e *loops.Shutdown) {
 	c.streamLoop.Close(&loops.Shutdown{Error: e.Error})
 	stream.LoopFactory.CacheLoop(c.streamLoop)
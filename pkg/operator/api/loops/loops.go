@@ -39,11 +39,20 @@ type ResourceUpdate[T meta.Resource] struct {
 	EventType operatorv1pb.ResourceEventType
 }
 
+// Resync is an event to send the sequence of the last event to the sidecar.
+type Resync struct {
+	*clientbase
+}
+
 // StreamSend is an event to send a message over the gRPC stream.
 type StreamSend struct {
 	*streambase
 	Resource  []byte
 	EventType operatorv1pb.ResourceEventType
+	// Sequence and ResourceVersion are only sent on the streams whose events
+	// are sequenced.
+	Sequence        uint64
+	ResourceVersion string
 }
 
 // Shutdown signals graceful shutdown.
@@ -16,11 +16,12 @@ package sender
 import (
 	"errors"
 
+	"github.com/dapr/dapr/pkg/operator/api/loops"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 )
 
 type Interface interface {
-	Send([]byte, operatorv1pb.ResourceEventType) error
+	Send(*loops.StreamSend) error
 }
 
 func New(stream any) (Interface, error) {
@@ -67,10 +68,12 @@ type component struct {
 	stream operatorv1pb.Operator_ComponentUpdateServer
 }
 
-func (c *component) Send(data []byte, eventType operatorv1pb.ResourceEventType) error {
+func (c *component) Send(e *loops.StreamSend) error {
 	return c.stream.Send(&operatorv1pb.ComponentUpdateEvent{
-		Component: data,
-		Type:      eventType,
+		Component:       e.Resource,
+		Type:            e.EventType,
+		Sequence:        e.Sequence,
+		ResourceVersion: e.ResourceVersion,
 	})
 }
 
@@ -78,10 +81,12 @@ type subscription struct {
 	stream operatorv1pb.Operator_SubscriptionUpdateServer
 }
 
-func (s *subscription) Send(data []byte, eventType operatorv1pb.ResourceEventType) error {
+func (s *subscription) Send(e *loops.StreamSend) error {
 	return s.stream.Send(&operatorv1pb.SubscriptionUpdateEvent{
-		Subscription: data,
-		Type:         eventType,
+		Subscription:    e.Resource,
+		Type:            e.EventType,
+		Sequence:        e.Sequence,
+		ResourceVersion: e.ResourceVersion,
 	})
 }
 
@@ -89,10 +94,10 @@ type httpendpoint struct {
 	stream operatorv1pb.Operator_HTTPEndpointUpdateServer
 }
 
-func (h *httpendpoint) Send(data []byte, eventType operatorv1pb.ResourceEventType) error {
+func (h *httpendpoint) Send(e *loops.StreamSend) error {
 	return h.stream.Send(&operatorv1pb.HTTPEndpointUpdateEvent{
-		HttpEndpoints: data,
-		Type:          eventType,
+		HttpEndpoints: e.Resource,
+		Type:          e.EventType,
 	})
 }
 
@@ -100,10 +105,10 @@ type mcpserver struct {
 	stream operatorv1pb.Operator_MCPServerUpdateServer
 }
 
-func (m *mcpserver) Send(data []byte, eventType operatorv1pb.ResourceEventType) error {
+func (m *mcpserver) Send(e *loops.StreamSend) error {
 	return m.stream.Send(&operatorv1pb.MCPServerUpdateEvent{
-		McpServer: data,
-		Type:      eventType,
+		McpServer: e.Resource,
+		Type:      e.EventType,
 	})
 }
 
@@ -111,10 +116,10 @@ type configuration struct {
 	stream operatorv1pb.Operator_ConfigurationUpdateServer
 }
 
-func (c *configuration) Send(data []byte, eventType operatorv1pb.ResourceEventType) error {
+func (c *configuration) Send(e *loops.StreamSend) error {
 	return c.stream.Send(&operatorv1pb.ConfigurationUpdateEvent{
-		Configuration: data,
-		Type:          eventType,
+		Configuration: e.Resource,
+		Type:          e.EventType,
 	})
 }
 
@@ -122,10 +127,10 @@ type resiliency struct {
 	stream operatorv1pb.Operator_ResiliencyUpdateServer
 }
 
-func (r *resiliency) Send(data []byte, eventType operatorv1pb.ResourceEventType) error {
+func (r *resiliency) Send(e *loops.StreamSend) error {
 	return r.stream.Send(&operatorv1pb.ResiliencyUpdateEvent{
-		Resiliency: data,
-		Type:       eventType,
+		Resiliency: e.Resource,
+		Type:       e.EventType,
 	})
 }
 
@@ -133,9 +138,9 @@ type workflowAccessPolicy struct {
 	stream operatorv1pb.Operator_WorkflowAccessPolicyUpdateServer
 }
 
-func (w *workflowAccessPolicy) Send(data []byte, eventType operatorv1pb.ResourceEventType) error {
+func (w *workflowAccessPolicy) Send(e *loops.StreamSend) error {
 	return w.stream.Send(&operatorv1pb.WorkflowAccessPolicyUpdateEvent{
-		Policy: data,
-		Type:   eventType,
+		Policy: e.Resource,
+		Type:   e.EventType,
 	})
 }
@@ -60,7 +60,7 @@ func (s *stream) Handle(ctx context.Context, event loops.EventStream) error {
 }
 
 func (s *stream) handleSend(e *loops.StreamSend) error {
-	if err := s.sender.Send(e.Resource, e.EventType); err != nil {
+	if err := s.sender.Send(e); err != nil {
 		log.Warnf("error sending message: %s", err)
 		return err
 	}
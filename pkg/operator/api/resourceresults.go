@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/dapr/dapr/pkg/operator/api/authz"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+// ReportResourceResults receives the results of a sidecar loading Components
+// and Subscriptions, which are reflected in the status of the resources until
+// the sidecar disconnects.
+func (a *apiServer) ReportResourceResults(srv operatorv1pb.Operator_ReportResourceResultsServer) error { //nolint:nosnakecase
+	if a.closed.Load() {
+		return errors.New("server is closed")
+	}
+
+	ctx := srv.Context()
+
+	var namespace, podName, appID string
+	defer func() {
+		if podName != "" {
+			a.rollout.Disconnect(namespace, podName)
+		}
+	}()
+
+	for {
+		req, err := srv.Recv()
+		if errors.Is(err, io.EOF) {
+			return srv.SendAndClose(&emptypb.Empty{})
+		}
+		if err != nil {
+			return err
+		}
+
+		if podName == "" {
+			id, err := authz.Request(ctx, req.GetNamespace())
+			if err != nil {
+				return err
+			}
+
+			// The sidecar may only report the results of its own pod.
+			var pod metav1.PartialObjectMetadata
+			pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+			if err := a.podReader.Get(ctx, types.NamespacedName{Namespace: req.GetNamespace(), Name: req.GetPodName()}, &pod); err != nil {
+				return status.Errorf(codes.PermissionDenied, "failed to get pod %s/%s: %s", req.GetNamespace(), req.GetPodName(), err)
+			}
+			if podAppID(&pod) != id.AppID() {
+				return status.Errorf(codes.PermissionDenied, "pod %s/%s is not of app %s", req.GetNamespace(), req.GetPodName(), id.AppID())
+			}
+
+			namespace, podName, appID = req.GetNamespace(), req.GetPodName(), id.AppID()
+			log.Debugf("Sidecar of pod %s/%s connected for resource results", namespace, podName)
+		} else if req.GetNamespace() != namespace || req.GetPodName() != podName {
+			return status.Error(codes.InvalidArgument, "namespace and pod name can't change within the stream")
+		}
+
+		a.rollout.Report(namespace, podName, appID, req.GetResult())
+	}
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rollout reflects the results of the sidecars loading Components and
+// Subscriptions in the status of the resources.
+package rollout
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.operator.api.rollout")
+
+const (
+	defaultInterval = 2 * time.Second
+
+	// maxErrorLength bounds the errors of the sidecars in the status, so the
+	// status of resources loaded by many failing sidecars stays small.
+	maxErrorLength = 512
+)
+
+type Options struct {
+	// Client reads the resources and writes their status.
+	Client client.Client
+
+	// PodReader reads the metadata of the pods, to drop the results of the
+	// pods of other operator replicas which no longer exist.
+	PodReader client.Reader
+
+	// Operator is the name of the operator replica the sidecars report to.
+	Operator string
+
+	// Interval is the interval of writing the status of the resources whose
+	// results changed. Defaults to 2s.
+	Interval time.Duration
+
+	Clock clock.WithTicker
+}
+
+type resourceKey struct {
+	resourceType operatorv1pb.ResourceType
+	namespace    string
+	name         string
+}
+
+// Tracker holds the results of the sidecars connected to the operator
+// replica, and merges them in the status of the resources with the results
+// of the sidecars connected to the other replicas.
+type Tracker struct {
+	client    client.Client
+	podReader client.Reader
+	operator  string
+	interval  time.Duration
+	clock     clock.WithTicker
+
+	lock    sync.Mutex
+	results map[resourceKey]map[string]commonapi.PodResourceStatus
+	dirty   map[resourceKey]struct{}
+}
+
+func New(opts Options) *Tracker {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+
+	return &Tracker{
+		client:    opts.Client,
+		podReader: opts.PodReader,
+		operator:  opts.Operator,
+		interval:  interval,
+		clock:     cl,
+		results:   make(map[resourceKey]map[string]commonapi.PodResourceStatus),
+		dirty:     make(map[resourceKey]struct{}),
+	}
+}
+
+// Report records the result of the sidecar of the pod loading a resource.
+func (t *Tracker) Report(namespace, pod, appID string, res *operatorv1pb.ResourceResult) {
+	switch res.GetResourceType() {
+	case operatorv1pb.ResourceType_RESOURCE_COMPONENT, operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION:
+	default:
+		return
+	}
+
+	key := resourceKey{
+		resourceType: res.GetResourceType(),
+		namespace:    namespace,
+		name:         res.GetName(),
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if res.GetEventType() == operatorv1pb.EventType_EVENT_CLOSE {
+		if _, ok := t.results[key][pod]; !ok {
+			return
+		}
+		delete(t.results[key], pod)
+		if len(t.results[key]) == 0 {
+			delete(t.results, key)
+		}
+		t.dirty[key] = struct{}{}
+		return
+	}
+
+	status := commonapi.PodResourceStatus{
+		Pod:                pod,
+		AppID:              appID,
+		ObservedGeneration: res.GetObservedGeneration(),
+		Loaded:             res.GetCondition() == operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+		Operator:           t.operator,
+	}
+	if !status.Loaded {
+		status.Error = res.GetMessage()
+		if len(status.Error) > maxErrorLength {
+			status.Error = status.Error[:maxErrorLength]
+		}
+	}
+
+	if t.results[key] == nil {
+		t.results[key] = make(map[string]commonapi.PodResourceStatus)
+	}
+	t.results[key][pod] = status
+	t.dirty[key] = struct{}{}
+}
+
+// Disconnect drops the results of the sidecar of the pod, once it is no
+// longer connected to the operator replica.
+func (t *Tracker) Disconnect(namespace, pod string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for key, pods := range t.results {
+		if key.namespace != namespace {
+			continue
+		}
+		if _, ok := pods[pod]; !ok {
+			continue
+		}
+		delete(pods, pod)
+		if len(pods) == 0 {
+			delete(t.results, key)
+		}
+		t.dirty[key] = struct{}{}
+	}
+}
+
+// Run writes the status of the resources whose results changed, until the
+// context is cancelled.
+func (t *Tracker) Run(ctx context.Context) error {
+	ticker := t.clock.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			t.flush(ctx)
+		}
+	}
+}
+
+func (t *Tracker) flush(ctx context.Context) {
+	t.lock.Lock()
+	pending := make(map[resourceKey][]commonapi.PodResourceStatus, len(t.dirty))
+	for key := range t.dirty {
+		pods := make([]commonapi.PodResourceStatus, 0, len(t.results[key]))
+		for _, p := range t.results[key] {
+			pods = append(pods, p)
+		}
+		pending[key] = pods
+	}
+	clear(t.dirty)
+	t.lock.Unlock()
+
+	for key, pods := range pending {
+		if err := t.write(ctx, key, pods); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warnf("Failed to update the status of %s %s/%s, retrying: %s", key.resourceType, key.namespace, key.name, err)
+			t.lock.Lock()
+			t.dirty[key] = struct{}{}
+			t.lock.Unlock()
+		}
+	}
+}
+
+// write merges the results of the sidecars connected to the replica in the
+// status of the resource.
+func (t *Tracker) write(ctx context.Context, key resourceKey, own []commonapi.PodResourceStatus) error {
+	var (
+		obj    client.Object
+		status *commonapi.ResourceStatus
+	)
+	switch key.resourceType {
+	case operatorv1pb.ResourceType_RESOURCE_COMPONENT:
+		comp := new(componentsapi.Component)
+		obj, status = comp, &comp.Status
+	case operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION:
+		sub := new(subapi.Subscription)
+		obj, status = sub, &sub.Status
+	}
+
+	err := t.client.Get(ctx, types.NamespacedName{Namespace: key.namespace, Name: key.name}, obj)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	pods := own
+	for _, p := range status.Pods {
+		if p.Operator == t.operator || slices.ContainsFunc(own, func(o commonapi.PodResourceStatus) bool {
+			return o.Pod == p.Pod
+		}) || !t.podExists(ctx, key.namespace, p.Pod) {
+			continue
+		}
+		pods = append(pods, p)
+	}
+
+	updated := Summarize(obj.GetGeneration(), pods)
+	if equality.Semantic.DeepEqual(*status, updated) {
+		return nil
+	}
+	*status = updated
+	return t.client.Status().Update(ctx, obj)
+}
+
+func (t *Tracker) podExists(ctx context.Context, namespace, name string) bool {
+	var pod metav1.PartialObjectMetadata
+	pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	err := t.podReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &pod)
+	// Keep the results of the pods which can't be read, until they can.
+	return !apierrors.IsNotFound(err)
+}
+
+// Summarize returns the status of the resource of the generation from the
+// results of the sidecars. The sidecars which haven't loaded the generation
+// yet are neither loaded nor failed.
+func Summarize(generation int64, pods []commonapi.PodResourceStatus) commonapi.ResourceStatus {
+	status := commonapi.ResourceStatus{
+		ObservedGeneration: generation,
+		Sidecars:           int32(len(pods)), //nolint:gosec
+	}
+	for _, p := range pods {
+		if p.ObservedGeneration != generation {
+			continue
+		}
+		if p.Loaded {
+			status.Loaded++
+		} else {
+			status.Failed++
+		}
+	}
+	if len(pods) > 0 {
+		status.Pods = slices.SortedFunc(slices.Values(pods), func(a, b commonapi.PodResourceStatus) int {
+			return cmp.Compare(a.Pod, b.Pod)
+		})
+	}
+	return status
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+func result(typ operatorv1pb.ResourceType, name string, generation int64, err string) *operatorv1pb.ResourceResult {
+	res := &operatorv1pb.ResourceResult{
+		ResourceType:       typ,
+		EventType:          operatorv1pb.EventType_EVENT_INIT,
+		Name:               name,
+		Condition:          operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+		ObservedGeneration: generation,
+	}
+	if err != "" {
+		res.Condition = operatorv1pb.ResourceConditionStatus_STATUS_FAILURE
+		res.Message = ptr.To(err)
+	}
+	return res
+}
+
+func newTracker(t *testing.T, objs ...client.Object) (*Tracker, client.Client) {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, componentsapi.AddToScheme(s))
+	require.NoError(t, subapi.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&componentsapi.Component{}, &subapi.Subscription{}).
+		Build()
+
+	return New(Options{Client: cl, PodReader: cl, Operator: "operator-0"}), cl
+}
+
+func TestTracker(t *testing.T) {
+	comp := &componentsapi.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "statestore", Namespace: "default", Generation: 2},
+	}
+	sub := &subapi.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "default", Generation: 1},
+	}
+	pod := func(name string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	}
+
+	t.Run("results are written in the status", func(t *testing.T) {
+		tr, cl := newTracker(t, comp.DeepCopy(), sub.DeepCopy())
+
+		tr.Report("default", "app-a", "a", result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 2, ""))
+		tr.Report("default", "app-b", "b", result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 2, "connection refused"))
+		tr.Report("default", "app-c", "c", result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 1, ""))
+		tr.Report("default", "app-a", "a", result(operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION, "orders", 1, ""))
+		tr.flush(t.Context())
+
+		var gotComp componentsapi.Component
+		require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "statestore"}, &gotComp))
+		assert.Equal(t, int64(2), gotComp.Status.ObservedGeneration)
+		assert.Equal(t, int32(3), gotComp.Status.Sidecars)
+		assert.Equal(t, int32(1), gotComp.Status.Loaded)
+		assert.Equal(t, int32(1), gotComp.Status.Failed)
+		require.Len(t, gotComp.Status.Pods, 3)
+		assert.Equal(t, commonapi.PodResourceStatus{
+			Pod:                "app-b",
+			AppID:              "b",
+			ObservedGeneration: 2,
+			Error:              "connection refused",
+			Operator:           "operator-0",
+		}, gotComp.Status.Pods[1])
+
+		var gotSub subapi.Subscription
+		require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "orders"}, &gotSub))
+		assert.Equal(t, int32(1), gotSub.Status.Loaded)
+	})
+
+	t.Run("closed and disconnected sidecars are removed", func(t *testing.T) {
+		tr, cl := newTracker(t, comp.DeepCopy())
+
+		tr.Report("default", "app-a", "a", result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 2, ""))
+		tr.Report("default", "app-b", "b", result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 2, ""))
+		tr.flush(t.Context())
+
+		closed := result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 2, "")
+		closed.EventType = operatorv1pb.EventType_EVENT_CLOSE
+		tr.Report("default", "app-a", "a", closed)
+		tr.flush(t.Context())
+
+		var got componentsapi.Component
+		require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "statestore"}, &got))
+		require.Len(t, got.Status.Pods, 1)
+		assert.Equal(t, "app-b", got.Status.Pods[0].Pod)
+
+		tr.Disconnect("default", "app-b")
+		tr.flush(t.Context())
+		require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "statestore"}, &got))
+		assert.Equal(t, commonapi.ResourceStatus{ObservedGeneration: 2}, got.Status)
+	})
+
+	t.Run("results of the other replicas are kept while their pods exist", func(t *testing.T) {
+		existing := comp.DeepCopy()
+		existing.Status.Pods = []commonapi.PodResourceStatus{
+			{Pod: "app-b", AppID: "b", ObservedGeneration: 2, Loaded: true, Operator: "operator-1"},
+			{Pod: "app-c", AppID: "c", ObservedGeneration: 2, Loaded: true, Operator: "operator-1"},
+			{Pod: "app-d", AppID: "d", ObservedGeneration: 2, Loaded: true, Operator: "operator-0"},
+		}
+		tr, cl := newTracker(t, existing, pod("app-b"), pod("app-d"))
+
+		tr.Report("default", "app-a", "a", result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 2, ""))
+		tr.flush(t.Context())
+
+		var got componentsapi.Component
+		require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "statestore"}, &got))
+		require.Len(t, got.Status.Pods, 2)
+		assert.Equal(t, "app-a", got.Status.Pods[0].Pod)
+		assert.Equal(t, "app-b", got.Status.Pods[1].Pod)
+		assert.Equal(t, int32(2), got.Status.Loaded)
+	})
+
+	t.Run("deleted resources are skipped", func(t *testing.T) {
+		tr, _ := newTracker(t)
+		tr.Report("default", "app-a", "a", result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, "statestore", 2, ""))
+		tr.flush(t.Context())
+		assert.Empty(t, tr.dirty)
+	})
+
+	t.Run("other resource types are ignored", func(t *testing.T) {
+		tr, _ := newTracker(t)
+		tr.Report("default", "app-a", "a", result(operatorv1pb.ResourceType_RESOURCE_UNKNOWN, "statestore", 2, ""))
+		assert.Empty(t, tr.results)
+		assert.Empty(t, tr.dirty)
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	assert.Equal(t, commonapi.ResourceStatus{ObservedGeneration: 3}, Summarize(3, nil))
+
+	status := Summarize(3, []commonapi.PodResourceStatus{
+		{Pod: "c", ObservedGeneration: 3, Loaded: true},
+		{Pod: "a", ObservedGeneration: 3},
+		{Pod: "b", ObservedGeneration: 2, Loaded: true},
+	})
+	assert.Equal(t, int32(3), status.Sidecars)
+	assert.Equal(t, int32(1), status.Loaded)
+	assert.Equal(t, int32(1), status.Failed)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{status.Pods[0].Pod, status.Pods[1].Pod, status.Pods[2].Pod})
+}
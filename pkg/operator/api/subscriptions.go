@@ -92,10 +92,12 @@ func (a *apiServer) SubscriptionUpdate(in *operatorv1pb.SubscriptionUpdateReques
 
 	// Create a client for this connection
 	client := loopsclient.New(loopsclient.Options[subapi.Subscription]{
-		EventCh:     ch,
-		CancelWatch: cancel,
-		Stream:      stream,
-		Namespace:   in.GetNamespace(),
+		EventCh:        ch,
+		CancelWatch:    cancel,
+		Stream:         stream,
+		Namespace:      in.GetNamespace(),
+		Sequenced:      true,
+		ResyncInterval: resyncIntervalFor(in.GetResync()),
 	})
 	defer client.CacheLoop()
 
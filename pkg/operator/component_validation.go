@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/components/schema"
+	"github.com/dapr/dapr/pkg/security"
+)
+
+// componentValidationWebhookName is the name of the
+// ValidatingWebhookConfiguration of the components, installed by the chart.
+const componentValidationWebhookName = "dapr-component-validation"
+
+// componentValidator rejects the components whose spec doesn't match the
+// metadata schema of their type when they are applied, rather than when the
+// sidecars initialize them.
+type componentValidator struct {
+	schemas *schema.Registry
+	// reader reads the secrets from the API server, so they aren't cached by
+	// the operator.
+	reader client.Reader
+}
+
+var _ admission.CustomValidator = (*componentValidator)(nil)
+
+func (v *componentValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(ctx, obj)
+}
+
+func (v *componentValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(ctx, newObj)
+}
+
+func (v *componentValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *componentValidator) validate(ctx context.Context, obj runtime.Object) error {
+	comp, ok := obj.(*componentsapi.Component)
+	if !ok {
+		return fmt.Errorf("expected a Component, got %T", obj)
+	}
+	return v.schemas.Validate(ctx, comp, v.secretExists)
+}
+
+func (v *componentValidator) secretExists(ctx context.Context, namespace, name, key string) (bool, error) {
+	var secret corev1.Secret
+	err := v.reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	_, ok := secret.Data[key]
+	return ok, nil
+}
+
+// patchValidatingWebhook sets the namespace and CA bundle of the webhooks of
+// the ValidatingWebhookConfiguration.
+func (o *operator) patchValidatingWebhook(ctx context.Context, caBundle []byte, conf *rest.Config, name string) error {
+	clientSet, err := kubernetes.NewForConfig(conf)
+	if err != nil {
+		return fmt.Errorf("could not get Kubernetes client: %w", err)
+	}
+
+	webhookClient := clientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	cfg, err := webhookClient.Get(ctx, name, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.Warnf("ValidatingWebhookConfiguration %q not found, components won't be validated when applied", name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not get ValidatingWebhookConfiguration %q: %w", name, err)
+	}
+
+	type patchValue struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		Value any    `json:"value"`
+	}
+	var payload []patchValue
+	for i, wh := range cfg.Webhooks {
+		if wh.ClientConfig.Service != nil && wh.ClientConfig.Service.Namespace != security.CurrentNamespace() {
+			payload = append(payload, patchValue{
+				Op:    "replace",
+				Path:  fmt.Sprintf("/webhooks/%d/clientConfig/service/namespace", i),
+				Value: security.CurrentNamespace(),
+			})
+		}
+		if !bytes.Equal(wh.ClientConfig.CABundle, caBundle) {
+			payload = append(payload, patchValue{
+				Op:    "add",
+				Path:  fmt.Sprintf("/webhooks/%d/clientConfig/caBundle", i),
+				Value: caBundle,
+			})
+		}
+	}
+	if len(payload) == 0 {
+		log.Infof("ValidatingWebhookConfiguration %q is up to date", name)
+		return nil
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal webhook spec: %w", err)
+	}
+	if _, err := webhookClient.Patch(ctx, name, types.JSONPatchType, payloadJSON, v1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch ValidatingWebhookConfiguration %q: %w", name, err)
+	}
+
+	log.Infof("Successfully patched ValidatingWebhookConfiguration %q", name)
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/components/schema"
+)
+
+func TestComponentValidator(t *testing.T) {
+	v := &componentValidator{
+		schemas: schema.NewRegistry(&schema.Schema{
+			Type:     "state",
+			Name:     "redis",
+			Version:  "v1",
+			Metadata: []schema.Field{{Name: "redisHost", Required: true}},
+		}),
+		reader: fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "default"},
+			Data:       map[string][]byte{"host": []byte("localhost:6379")},
+		}).Build(),
+	}
+
+	comp := func(secret, key string) *componentsapi.Component {
+		return &componentsapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: "statestore", Namespace: "default"},
+			Spec: componentsapi.ComponentSpec{
+				Type:    "state.redis",
+				Version: "v1",
+				Metadata: []commonapi.NameValuePair{{
+					Name:         "redisHost",
+					SecretKeyRef: commonapi.SecretKeyRef{Name: secret, Key: key},
+				}},
+			},
+		}
+	}
+
+	t.Run("valid component", func(t *testing.T) {
+		_, err := v.ValidateCreate(t.Context(), comp("redis", "host"))
+		require.NoError(t, err)
+	})
+
+	t.Run("missing secret key", func(t *testing.T) {
+		_, err := v.ValidateUpdate(t.Context(), comp("redis", "host"), comp("redis", "port"))
+		require.ErrorContains(t, err, `references key "port" of secret "redis"`)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		_, err := v.ValidateCreate(t.Context(), comp("other", "host"))
+		require.ErrorContains(t, err, `of secret "other", which doesn't exist`)
+	})
+
+	t.Run("delete isn't validated", func(t *testing.T) {
+		_, err := v.ValidateDelete(t.Context(), comp("other", "host"))
+		require.NoError(t, err)
+	})
+
+	t.Run("not a component", func(t *testing.T) {
+		_, err := v.ValidateCreate(t.Context(), &corev1.Secret{})
+		assert.Error(t, err)
+	})
+}
@@ -45,6 +45,7 @@ import (
 	subscriptionsapiV1alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	wfaclapi "github.com/dapr/dapr/pkg/apis/workflowaccesspolicy/v1alpha1"
+	"github.com/dapr/dapr/pkg/components/schema"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/api"
@@ -88,6 +89,11 @@ type Options struct {
 	// resync period (default 10h). Zero leaves the default in place. Primarily
 	// used by integration tests to exercise resync behaviour deterministically.
 	CacheSyncPeriod time.Duration
+
+	// ComponentSchemasDir is the directory of the metadata schemas of the
+	// components. If set, the components are validated against them when
+	// applied.
+	ComponentSchemasDir string
 }
 
 type operator struct {
@@ -97,6 +103,8 @@ type operator struct {
 	mgr          ctrl.Manager
 	podMetaCache ctrlcache.Cache
 	secProvider  security.Provider
+	// componentSchemas is nil if the components aren't validated.
+	componentSchemas *schema.Registry
 
 	secHealthz       healthz.Target
 	apiServerHealthz healthz.Target
@@ -220,8 +228,17 @@ func NewOperator(ctx context.Context, opts Options) (Operator, error) {
 		}
 	}
 
+	var componentSchemas *schema.Registry
+	if opts.ComponentSchemasDir != "" {
+		componentSchemas, err = schema.LoadDir(opts.ComponentSchemasDir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load component schemas: %w", err)
+		}
+	}
+
 	return &operator{
 		mgr:              mgr,
+		componentSchemas: componentSchemas,
 		podMetaCache:     podMetaCache,
 		secProvider:      secProvider,
 		config:           config,
@@ -260,6 +277,18 @@ func (o *operator) Start(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("unable to create webhook Subscriptions v2alpha1: %w", err)
 		}
+		if o.componentSchemas != nil {
+			err = ctrl.NewWebhookManagedBy(o.mgr).
+				For(&componentsapi.Component{}).
+				WithValidator(&componentValidator{
+					schemas: o.componentSchemas,
+					reader:  o.mgr.GetAPIReader(),
+				}).
+				Complete()
+			if err != nil {
+				return fmt.Errorf("unable to create webhook Components v1alpha1: %w", err)
+			}
+		}
 	}
 
 	caBundleCh := make(chan []byte)
@@ -318,6 +347,12 @@ func (o *operator) Start(ctx context.Context) error {
 				if rErr != nil {
 					return rErr
 				}
+				if o.componentSchemas != nil {
+					rErr = o.patchValidatingWebhook(ctx, caBundle, o.mgr.GetConfig(), componentValidationWebhookName)
+					if rErr != nil {
+						return rErr
+					}
+				}
 
 				o.webhookHealthz.Ready()
 
@@ -23,7 +23,7 @@ import (
 type Fake struct {
 	addOrUpdateOutboxFn         func(stateStore v1alpha1.Component)
 	enabledFn                   func(stateStore string) bool
-	publishInternalFn           func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
+	publishInternalFn           func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, metadata map[string]string, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
 	subscribeToInternalTopicsFn func(ctx context.Context, appID string) error
 }
 
@@ -31,7 +31,7 @@ func New() *Fake {
 	return &Fake{
 		addOrUpdateOutboxFn: func(stateStore v1alpha1.Component) {},
 		enabledFn:           func(stateStore string) bool { return false },
-		publishInternalFn: func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
+		publishInternalFn: func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, metadata map[string]string, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
 			return nil, nil
 		},
 		subscribeToInternalTopicsFn: func(ctx context.Context, appID string) error { return nil },
@@ -48,7 +48,7 @@ func (f *Fake) WithEnabled(fn func(stateStore string) bool) *Fake {
 	return f
 }
 
-func (f *Fake) WithPublishInternal(fn func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)) *Fake {
+func (f *Fake) WithPublishInternal(fn func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, metadata map[string]string, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)) *Fake {
 	f.publishInternalFn = fn
 	return f
 }
@@ -66,8 +66,8 @@ func (f *Fake) Enabled(stateStore string) bool {
 	return f.enabledFn(stateStore)
 }
 
-func (f *Fake) PublishInternal(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
-	return f.publishInternalFn(ctx, stateStore, states, source, traceID, traceState)
+func (f *Fake) PublishInternal(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, metadata map[string]string, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
+	return f.publishInternalFn(ctx, stateStore, states, metadata, source, traceID, traceState)
 }
 
 func (f *Fake) SubscribeToInternalTopics(ctx context.Context, appID string) error {
@@ -24,6 +24,6 @@ import (
 type Outbox interface {
 	AddOrUpdateOutbox(stateStore v1alpha1.Component)
 	Enabled(stateStore string) bool
-	PublishInternal(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
+	PublishInternal(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, metadata map[string]string, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
 	SubscribeToInternalTopics(ctx context.Context, appID string) error
 }
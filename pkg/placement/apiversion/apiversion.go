@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apiversion defines the placement stream API versions negotiated
+// between daprd and the placement service.
+//
+// daprd reports the highest API version it supports in the stream metadata
+// when opening the placement stream. Sidecars which report no version use v1,
+// and always receive full placement tables.
+//
+// In v2, once a full placement table has been sent on a stream, the placement
+// service may send the following tables as diffs using the `update-diff`
+// operation. A diff only contains the actor types whose hosts have changed.
+// An actor type with an empty load map has been removed from the table.
+package apiversion
+
+import "slices"
+
+// MetadataKey is the gRPC metadata key daprd uses to report the placement
+// stream API version it supports.
+const MetadataKey = "dapr-placement-api-version"
+
+const (
+	V1 = "v1"
+	V2 = "v2"
+)
+
+// SupportsTableDiff returns true if the given reported API versions support
+// placement table diffs.
+func SupportsTableDiff(versions []string) bool {
+	return slices.Contains(versions, V2)
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package federation implements ownership of federated actor types between a
+// primary and a replica placement service running in different clusters.
+//
+// At most one of the two placement services includes the federated actor
+// types in its placement tables at any time:
+//   - The primary owns the actor types while it can reach the replica and the
+//     replica is in standby. If it cannot reach the replica for the lease
+//     duration, it fences itself and stops disseminating the actor types.
+//   - The replica takes ownership if it cannot reach the primary for the
+//     takeover delay, which must be greater than the lease duration plus the
+//     time taken for a dissemination round. Once the primary is reachable and
+//     fenced again, the replica releases ownership and reports standby after
+//     the release delay, allowing its own dissemination round to complete.
+//
+// Without a third party to arbitrate, the primary cannot distinguish a failed
+// replica from a network partition, so it fences itself whenever the replica
+// is unreachable.
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	securityConsts "github.com/dapr/dapr/pkg/security/consts"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.placement.federation")
+
+// StatusPath is the path of the admin endpoint serving the federation status.
+const StatusPath = "/placement/admin/federation"
+
+// Role is the role of a placement service in the federation.
+type Role string
+
+const (
+	RolePrimary Role = "primary"
+	RoleReplica Role = "replica"
+)
+
+// State is the ownership state of a placement service in the federation.
+type State string
+
+const (
+	// StateActive owns the federated actor types.
+	StateActive State = "active"
+	// StateFenced is a primary which does not own the federated actor types.
+	StateFenced State = "fenced"
+	// StateReleasing is a replica which is releasing ownership of the
+	// federated actor types.
+	StateReleasing State = "releasing"
+	// StateStandby is a replica which does not own the federated actor types.
+	StateStandby State = "standby"
+)
+
+// Status is the federation status reported to the peer.
+type Status struct {
+	Role  Role  `json:"role"`
+	State State `json:"state"`
+	// Epoch is incremented every time ownership moves between the primary and
+	// the replica.
+	Epoch uint64 `json:"epoch"`
+	// Versions are the current placement table versions, indexed by
+	// namespace.
+	Versions map[string]uint64 `json:"versions,omitempty"`
+}
+
+type Options struct {
+	Role Role
+	// PeerAddresses are the addresses of the peer placement healthz servers.
+	// Only the peer leader serves the federation status, so each address is
+	// tried in turn.
+	PeerAddresses []string
+	Token         string
+
+	// ActorTypes are the federated actor types. If empty, all actor types are
+	// federated.
+	ActorTypes []string
+
+	Lease         time.Duration
+	TakeoverDelay time.Duration
+	ReleaseDelay  time.Duration
+
+	// Versions returns the current placement table versions.
+	Versions func(context.Context) (map[string]uint64, error)
+
+	// OnChange is called when this placement service gains or loses ownership
+	// of the federated actor types, with the last table versions reported by
+	// the peer.
+	OnChange func(owner bool, peerVersions map[string]uint64)
+
+	Client *http.Client
+	Clock  clock.WithTicker
+}
+
+type Federation struct {
+	role       Role
+	peerURLs   []string
+	token      string
+	actorTypes []string
+
+	lease         time.Duration
+	takeoverDelay time.Duration
+	releaseDelay  time.Duration
+
+	versions func(context.Context) (map[string]uint64, error)
+	onChange func(bool, map[string]uint64)
+	client   *http.Client
+	clock    clock.WithTicker
+
+	lock         sync.RWMutex
+	state        State
+	epoch        uint64
+	lastContact  time.Time
+	releaseStart time.Time
+	peerVersions map[string]uint64
+}
+
+func New(opts Options) (*Federation, error) {
+	if opts.Role != RolePrimary && opts.Role != RoleReplica {
+		return nil, fmt.Errorf("invalid federation role %q: must be %q or %q", opts.Role, RolePrimary, RoleReplica)
+	}
+	if len(opts.PeerAddresses) == 0 {
+		return nil, errors.New("federation peer address is required")
+	}
+	if opts.Token == "" {
+		return nil, errors.New("federation requires the admin token to be set")
+	}
+	if opts.TakeoverDelay <= opts.Lease {
+		return nil, fmt.Errorf("federation takeover delay (%s) must be greater than the lease duration (%s)", opts.TakeoverDelay, opts.Lease)
+	}
+
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: opts.Lease / 2}
+	}
+
+	f := &Federation{
+		role:          opts.Role,
+		token:         opts.Token,
+		actorTypes:    opts.ActorTypes,
+		lease:         opts.Lease,
+		takeoverDelay: opts.TakeoverDelay,
+		releaseDelay:  opts.ReleaseDelay,
+		versions:      opts.Versions,
+		onChange:      opts.OnChange,
+		client:        client,
+		clock:         cl,
+		// Both sides start without ownership. The primary takes ownership once
+		// it has seen the replica in standby, and the replica once the primary
+		// has been unreachable for the takeover delay.
+		lastContact: cl.Now(),
+	}
+
+	for _, addr := range opts.PeerAddresses {
+		f.peerURLs = append(f.peerURLs, strings.TrimSuffix(addr, "/")+StatusPath)
+	}
+
+	if f.role == RolePrimary {
+		f.state = StateFenced
+	} else {
+		f.state = StateStandby
+	}
+
+	return f, nil
+}
+
+// ActorTypes returns the federated actor types. An empty list means all actor
+// types.
+func (f *Federation) ActorTypes() []string {
+	return f.actorTypes
+}
+
+// Owner returns true if this placement service currently owns the federated
+// actor types.
+func (f *Federation) Owner() bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.state == StateActive
+}
+
+// Status returns the current federation status.
+func (f *Federation) Status(ctx context.Context) (*Status, error) {
+	f.lock.RLock()
+	status := &Status{
+		Role:  f.role,
+		State: f.state,
+		Epoch: f.epoch,
+	}
+	f.lock.RUnlock()
+
+	if f.versions != nil {
+		versions, err := f.versions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		status.Versions = versions
+	}
+
+	return status, nil
+}
+
+// Run polls the peer until the context is cancelled.
+func (f *Federation) Run(ctx context.Context) error {
+	log.Infof("Placement federation running as %s with peer %s", f.role, strings.Join(f.peerURLs, ","))
+
+	ticker := f.clock.NewTicker(f.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		f.step(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		}
+	}
+}
+
+// step polls the peer once and moves to the next state.
+func (f *Federation) step(ctx context.Context) {
+	var peer *Status
+	for _, url := range f.peerURLs {
+		var err error
+		peer, err = f.peerStatus(ctx, url)
+		if err == nil {
+			break
+		}
+		log.Debugf("Failed to reach federation peer %s: %s", url, err)
+	}
+
+	f.lock.Lock()
+	now := f.clock.Now()
+	if peer != nil {
+		f.lastContact = now
+		f.peerVersions = peer.Versions
+		f.epoch = max(f.epoch, peer.Epoch)
+	}
+
+	prev := f.state
+	f.state = f.next(peer, now)
+	changed := prev != f.state
+	wasOwner, isOwner := prev == StateActive, f.state == StateActive
+	if isOwner && !wasOwner {
+		f.epoch++
+	}
+	epoch := f.epoch
+	peerVersions := maps.Clone(f.peerVersions)
+	f.lock.Unlock()
+
+	if changed {
+		log.Infof("Placement federation %s moved from %s to %s (epoch %d)", f.role, prev, f.state, epoch)
+	}
+
+	if wasOwner != isOwner && f.onChange != nil {
+		f.onChange(isOwner, peerVersions)
+	}
+}
+
+// next returns the next state given the peer status, which is nil if the peer
+// could not be reached. Must be called with the lock held.
+func (f *Federation) next(peer *Status, now time.Time) State {
+	sinceContact := now.Sub(f.lastContact)
+
+	if f.role == RolePrimary {
+		switch {
+		case peer == nil && sinceContact >= f.lease:
+			return StateFenced
+		case peer == nil:
+			return f.state
+		case peer.State == StateStandby:
+			return StateActive
+		default:
+			// The replica owns, or is still releasing, the actor types.
+			return StateFenced
+		}
+	}
+
+	switch f.state {
+	case StateStandby:
+		if peer == nil && sinceContact >= f.takeoverDelay {
+			return StateActive
+		}
+		return StateStandby
+
+	case StateActive:
+		if peer != nil && peer.State == StateFenced {
+			f.releaseStart = now
+			return StateReleasing
+		}
+		return StateActive
+
+	case StateReleasing:
+		if peer == nil && sinceContact >= f.takeoverDelay {
+			return StateActive
+		}
+		if now.Sub(f.releaseStart) >= f.releaseDelay {
+			return StateStandby
+		}
+		return StateReleasing
+	}
+
+	return f.state
+}
+
+func (f *Federation) peerStatus(ctx context.Context, url string) (*Status, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.lease/2)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(securityConsts.APITokenHeader, f.token)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var status Status
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+
+	expected := RolePrimary
+	if f.role == RolePrimary {
+		expected = RoleReplica
+	}
+	if status.Role != expected {
+		return nil, fmt.Errorf("peer reported role %q, expected %q", status.Role, expected)
+	}
+
+	return &status, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	securityConsts "github.com/dapr/dapr/pkg/security/consts"
+)
+
+type fakePeer struct {
+	lock   sync.Mutex
+	status *Status
+	srv    *httptest.Server
+}
+
+func newFakePeer(t *testing.T, status *Status) *fakePeer {
+	t.Helper()
+	p := &fakePeer{status: status}
+	p.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(securityConsts.APITokenHeader) != "token" || r.URL.Path != StatusPath {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		if p.status == nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(p.status)
+	}))
+	t.Cleanup(p.srv.Close)
+	return p
+}
+
+func (p *fakePeer) set(status *Status) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.status = status
+}
+
+type ownerChange struct {
+	owner        bool
+	peerVersions map[string]uint64
+}
+
+func newFederation(t *testing.T, role Role, peer *fakePeer) (*Federation, *clocktesting.FakeClock, *[]ownerChange) {
+	t.Helper()
+	clock := clocktesting.NewFakeClock(time.Now())
+	var changes []ownerChange
+	f, err := New(Options{
+		Role:          role,
+		PeerAddresses: []string{"http://127.0.0.1:0", peer.srv.URL},
+		Token:         "token",
+		Lease:         10 * time.Second,
+		TakeoverDelay: 30 * time.Second,
+		ReleaseDelay:  10 * time.Second,
+		OnChange: func(owner bool, peerVersions map[string]uint64) {
+			changes = append(changes, ownerChange{owner, peerVersions})
+		},
+		Clock: clock,
+	})
+	require.NoError(t, err)
+	return f, clock, &changes
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Options{Role: "other", PeerAddresses: []string{"x"}, Token: "t", Lease: time.Second, TakeoverDelay: 2 * time.Second})
+	require.Error(t, err)
+	_, err = New(Options{Role: RolePrimary, Token: "t", Lease: time.Second, TakeoverDelay: 2 * time.Second})
+	require.Error(t, err)
+	_, err = New(Options{Role: RolePrimary, PeerAddresses: []string{"x"}, Lease: time.Second, TakeoverDelay: 2 * time.Second})
+	require.Error(t, err)
+	_, err = New(Options{Role: RolePrimary, PeerAddresses: []string{"x"}, Token: "t", Lease: time.Second, TakeoverDelay: time.Second})
+	require.Error(t, err)
+}
+
+func TestPrimary(t *testing.T) {
+	peer := newFakePeer(t, &Status{Role: RoleReplica, State: StateStandby, Versions: map[string]uint64{"ns": 7}})
+	f, clock, changes := newFederation(t, RolePrimary, peer)
+
+	require.False(t, f.Owner(), "primary starts fenced")
+
+	f.step(t.Context())
+	assert.True(t, f.Owner())
+	assert.Equal(t, []ownerChange{{true, map[string]uint64{"ns": 7}}}, *changes)
+
+	status, err := f.Status(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, &Status{Role: RolePrimary, State: StateActive, Epoch: 1}, status)
+
+	t.Run("keeps ownership while the lease has not expired", func(t *testing.T) {
+		peer.set(nil)
+		clock.Step(5 * time.Second)
+		f.step(t.Context())
+		assert.True(t, f.Owner())
+	})
+
+	t.Run("fences when the lease expires", func(t *testing.T) {
+		clock.Step(5 * time.Second)
+		f.step(t.Context())
+		assert.False(t, f.Owner())
+		assert.Len(t, *changes, 2)
+	})
+
+	t.Run("stays fenced while the replica is active", func(t *testing.T) {
+		peer.set(&Status{Role: RoleReplica, State: StateActive, Epoch: 2})
+		f.step(t.Context())
+		assert.False(t, f.Owner())
+		peer.set(&Status{Role: RoleReplica, State: StateReleasing, Epoch: 2})
+		f.step(t.Context())
+		assert.False(t, f.Owner())
+	})
+
+	t.Run("resumes after the replica stands by, past the replica epoch", func(t *testing.T) {
+		peer.set(&Status{Role: RoleReplica, State: StateStandby, Epoch: 2})
+		f.step(t.Context())
+		assert.True(t, f.Owner())
+		status, err := f.Status(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), status.Epoch)
+	})
+
+	t.Run("peer with the wrong role is unreachable", func(t *testing.T) {
+		peer.set(&Status{Role: RolePrimary, State: StateStandby})
+		clock.Step(10 * time.Second)
+		f.step(t.Context())
+		assert.False(t, f.Owner())
+	})
+}
+
+func TestReplica(t *testing.T) {
+	peer := newFakePeer(t, &Status{Role: RolePrimary, State: StateActive, Epoch: 1})
+	f, clock, changes := newFederation(t, RoleReplica, peer)
+
+	f.step(t.Context())
+	require.False(t, f.Owner())
+
+	t.Run("does not take over before the takeover delay", func(t *testing.T) {
+		peer.set(nil)
+		clock.Step(29 * time.Second)
+		f.step(t.Context())
+		assert.False(t, f.Owner())
+		assert.Empty(t, *changes)
+	})
+
+	t.Run("takes over after the takeover delay", func(t *testing.T) {
+		clock.Step(time.Second)
+		f.step(t.Context())
+		assert.True(t, f.Owner())
+		status, err := f.Status(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, StateActive, status.State)
+		assert.Equal(t, uint64(2), status.Epoch)
+	})
+
+	t.Run("releases once the primary is fenced", func(t *testing.T) {
+		peer.set(&Status{Role: RolePrimary, State: StateFenced, Epoch: 1})
+		f.step(t.Context())
+		assert.False(t, f.Owner())
+		status, err := f.Status(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, StateReleasing, status.State)
+
+		clock.Step(5 * time.Second)
+		f.step(t.Context())
+		status, err = f.Status(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, StateReleasing, status.State)
+
+		clock.Step(5 * time.Second)
+		f.step(t.Context())
+		status, err = f.Status(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, StateStandby, status.State)
+		assert.Equal(t, []ownerChange{{true, nil}, {false, nil}}, *changes)
+	})
+}
@@ -106,11 +106,9 @@ func (d *disseminator) advancePhase(ctx context.Context) {
 	case v1pb.HostOperation_LOCK:
 		d.currentOperation = v1pb.HostOperation_UPDATE
 		d.streamsInTargetState = 0
+		tables, diff, base := d.nextTables()
 		for _, s := range d.streams {
-			s.loop.Enqueue(&loops.DisseminateUpdate{
-				Version: d.currentVersion,
-				Tables:  d.store.PlacementTables(d.currentVersion),
-			})
+			s.loop.Enqueue(d.updateEvent(tables, diff, base))
 		}
 
 	case v1pb.HostOperation_UPDATE:
@@ -128,6 +126,11 @@ func (d *disseminator) advancePhase(ctx context.Context) {
 		d.timeoutQ.Dequeue(d.currentVersion)
 		log.Debugf("Dissemination of version %d in %s complete (via stream close)", d.currentVersion, d.namespace)
 
+		if d.ownershipChanged {
+			d.disseminateOwnership(ctx)
+			return
+		}
+
 		// Always arm the coalesce timer after a round completes when coalesceWindow > 0,
 		// regardless of whether anything is currently queued.
 		// An UNLOCK ack and a new ConnAdd that race through the disseminator's
@@ -216,6 +219,11 @@ func (d *disseminator) processWaitingDisseminate(ctx context.Context, forceRound
 func (d *disseminator) handleCoalesceFire(ctx context.Context) {
 	d.coalesceTimer = nil
 
+	if d.ownershipChanged {
+		d.disseminateOwnership(ctx)
+		return
+	}
+
 	// Mirror handleAdd's flow: apply queued deletions to the store BEFORE
 	// processing waiting-to-disseminate, so a single round emitted by
 	// processWaitingDisseminate captures both. If only deletes are queued
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disseminator
+
+import (
+	"github.com/dapr/dapr/pkg/placement/internal/loops"
+	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/store"
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+// nextTables builds the placement tables for the current version, along with
+// the diff from the tables sent in the previous UPDATE phase. The tables are
+// built once and shared by every stream.
+func (d *disseminator) nextTables() (tables, diff, base *v1pb.PlacementTables) {
+	tables = d.store.PlacementTables(d.currentVersion)
+	base = d.lastTables
+	diff = store.Diff(base, tables)
+	d.lastTables = tables
+	return tables, diff, base
+}
+
+func (d *disseminator) updateEvent(tables, diff, base *v1pb.PlacementTables) *loops.DisseminateUpdate {
+	return &loops.DisseminateUpdate{
+		Version: d.currentVersion,
+		Tables:  tables,
+		Diff:    diff,
+		Base:    base,
+	}
+}
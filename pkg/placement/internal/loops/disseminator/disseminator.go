@@ -28,6 +28,7 @@ import (
 	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/store"
 	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/timeout"
 	"github.com/dapr/dapr/pkg/placement/internal/loops/stream"
+	"github.com/dapr/dapr/pkg/placement/labels"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 	"github.com/dapr/kit/events/loop"
@@ -49,6 +50,8 @@ type Options struct {
 	NamespaceLoop               loop.Interface[loops.EventNamespace]
 	Namespace                   string
 	ReplicationFactor           int64
+	Selectors                   labels.Selectors
+	Ownership                   *loops.FederationOwnership
 	Authorizer                  *authorizer.Authorizer
 	DisseminationTimeout        time.Duration
 	DisseminationCoalesceWindow time.Duration
@@ -102,6 +105,14 @@ type disseminator struct {
 	actorConnCount       atomic.Int64
 	waitingToDisseminate []*loops.ConnAdd
 	waitingToDelete      []uint64
+
+	// ownershipChanged is set when federation ownership changed while a
+	// round was in progress, so a follow-up round disseminates it.
+	ownershipChanged bool
+
+	// lastTables are the tables sent in the last UPDATE phase, which the
+	// next UPDATE phase is diffed against.
+	lastTables *v1pb.PlacementTables
 }
 
 func New(opts Options) loop.Interface[loops.EventDisseminator] {
@@ -128,6 +139,13 @@ func New(opts Options) loop.Interface[loops.EventDisseminator] {
 			ReplicationFactor: opts.ReplicationFactor,
 		})
 	}
+	diss.store.SetSelectors(opts.Selectors)
+	diss.store.SetFenced(false, nil)
+	if opts.Ownership != nil {
+		diss.store.SetFenced(!opts.Ownership.Owner, opts.Ownership.ActorTypes)
+	}
+	diss.ownershipChanged = false
+	diss.lastTables = nil
 
 	diss.loop = LoopFactory.NewLoop(diss)
 
@@ -155,6 +173,10 @@ func (d *disseminator) Handle(ctx context.Context, event loops.EventDisseminator
 		d.handleTimeout(ctx, e)
 	case *loops.NamespaceTableRequest:
 		d.handleTableRequest(e)
+	case *loops.SimulateRequest:
+		d.handleSimulate(e)
+	case *loops.FederationOwnership:
+		d.handleOwnership(ctx, e)
 	case *loops.CoalesceFire:
 		d.handleCoalesceFire(ctx)
 	default:
@@ -196,6 +218,7 @@ func (d *disseminator) addStream(ctx context.Context, add *loops.ConnAdd) uint64
 	}
 
 	d.streams[streamIDx] = stream
+	d.store.SetLabels(streamIDx, add.Labels)
 
 	return streamIDx
 }
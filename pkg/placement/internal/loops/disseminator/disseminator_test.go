@@ -14,11 +14,14 @@ limitations under the License.
 package disseminator
 
 import (
+	"maps"
+	"slices"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/dapr/dapr/pkg/placement/internal/loops"
 	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/store"
@@ -585,3 +588,74 @@ func TestStoreCollectOrphans_NoOrphans(t *testing.T) {
 
 	assert.Empty(t, orphans)
 }
+
+func TestHandleOwnership_FencesAndStartsRound(t *testing.T) {
+	d := newTestDisseminator(t)
+	fs := addFakeStream(d, 0, []string{"actorA", "actorB"})
+	d.currentVersion = 3
+
+	d.handleOwnership(t.Context(), &loops.FederationOwnership{
+		Owner:        false,
+		ActorTypes:   []string{"actorA"},
+		PeerVersions: map[string]uint64{"default": 10},
+	})
+
+	assert.Equal(t, v1pb.HostOperation_LOCK, d.currentOperation)
+	assert.Equal(t, uint64(11), d.currentVersion, "version carried past the peer version")
+	assert.Equal(t, int32(1), fs.enqueued.Load())
+
+	tables := d.store.PlacementTables(d.currentVersion)
+	assert.NotContains(t, tables.GetEntries(), "actorA")
+	assert.Contains(t, tables.GetEntries(), "actorB")
+}
+
+func TestHandleOwnership_DeferredDuringRound(t *testing.T) {
+	d := newTestDisseminator(t)
+	fs := addFakeStream(d, 0, []string{"actorA"})
+	d.currentOperation = v1pb.HostOperation_UPDATE
+
+	d.handleOwnership(t.Context(), &loops.FederationOwnership{Owner: false})
+	assert.True(t, d.ownershipChanged)
+	assert.Equal(t, int32(0), fs.enqueued.Load())
+
+	// Unchanged ownership is a no-op.
+	d.ownershipChanged = false
+	d.handleOwnership(t.Context(), &loops.FederationOwnership{Owner: false})
+	assert.False(t, d.ownershipChanged)
+}
+
+func TestAdvancePhase_LockToUpdate_SendsDiffAgainstLastTables(t *testing.T) {
+	d := newTestDisseminator(t)
+	var updates []*loops.DisseminateUpdate
+	d.streams[0] = &streamConn{
+		loop: fake.New[loops.EventStream]().WithEnqueue(func(e loops.EventStream) {
+			if u, ok := e.(*loops.DisseminateUpdate); ok {
+				updates = append(updates, u)
+			}
+		}),
+		currentState: v1pb.HostOperation_REPORT,
+		hasActors:    true,
+	}
+	d.store.Set(0, host("app-0", "actorA", "actorB"))
+
+	d.currentVersion = 1
+	d.currentOperation = v1pb.HostOperation_LOCK
+	d.streamsInTargetState = 1
+	d.advancePhase(t.Context())
+
+	require.Len(t, updates, 1)
+	assert.Nil(t, updates[0].Diff, "no previous tables to diff against")
+	assert.Nil(t, updates[0].Base)
+
+	d.store.Set(0, host("app-0", "actorA", "actorC"))
+	d.currentVersion = 2
+	d.currentOperation = v1pb.HostOperation_LOCK
+	d.streamsInTargetState = 1
+	d.advancePhase(t.Context())
+
+	require.Len(t, updates, 2)
+	assert.Same(t, updates[0].Tables, updates[1].Base)
+	require.NotNil(t, updates[1].Diff)
+	assert.ElementsMatch(t, []string{"actorB", "actorC"}, slices.Collect(maps.Keys(updates[1].Diff.GetEntries())))
+	assert.Len(t, updates[1].Tables.GetEntries(), 2)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disseminator
+
+import (
+	"context"
+
+	"github.com/dapr/dapr/pkg/placement/internal/loops"
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+// handleOwnership updates the fenced actor types when federation ownership
+// changes, and disseminates the resulting table.
+func (d *disseminator) handleOwnership(ctx context.Context, ownership *loops.FederationOwnership) {
+	if !d.store.SetFenced(!ownership.Owner, ownership.ActorTypes) {
+		return
+	}
+
+	// Carry the table version past the version last disseminated by the peer,
+	// so versions keep increasing as ownership moves between clusters.
+	d.currentVersion = max(d.currentVersion, ownership.PeerVersions[d.namespace])
+
+	d.ownershipChanged = true
+	if d.currentOperation != v1pb.HostOperation_REPORT || d.coalesceTimer != nil {
+		// Disseminated once the current round or coalesce window completes.
+		return
+	}
+
+	d.disseminateOwnership(ctx)
+}
+
+// disseminateOwnership starts a round to disseminate a change of federation
+// ownership, along with any queued deletions and connections. Must only be
+// called when currentOperation is REPORT.
+func (d *disseminator) disseminateOwnership(ctx context.Context) {
+	d.ownershipChanged = false
+
+	for _, toDelete := range d.waitingToDelete {
+		d.store.Delete(toDelete)
+	}
+	d.waitingToDelete = nil
+
+	if len(d.waitingToDisseminate) > 0 {
+		d.processWaitingDisseminate(ctx, true)
+		return
+	}
+
+	if len(d.streams) == 0 {
+		return
+	}
+
+	d.currentVersion++
+	d.timeoutQ.Enqueue(d.currentVersion)
+	d.currentOperation = v1pb.HostOperation_LOCK
+	d.streamsInTargetState = 0
+
+	for _, s := range d.streams {
+		s.currentState = v1pb.HostOperation_REPORT
+		s.receivingTable = nil
+		s.loop.Enqueue(&loops.DisseminateLock{
+			Version: d.currentVersion,
+		})
+	}
+}
@@ -119,12 +119,10 @@ func (d *disseminator) handleReportedLock(streamIDx uint64) {
 		// All streams have locked, move to update phase.
 		d.currentOperation = v1pb.HostOperation_UPDATE
 		d.streamsInTargetState = 0
+		tables, diff, base := d.nextTables()
 
 		for _, s := range d.streams {
-			s.loop.Enqueue(&loops.DisseminateUpdate{
-				Version: d.currentVersion,
-				Tables:  d.store.PlacementTables(d.currentVersion),
-			})
+			s.loop.Enqueue(d.updateEvent(tables, diff, base))
 		}
 	}
 }
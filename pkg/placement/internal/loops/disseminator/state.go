@@ -20,3 +20,7 @@ import (
 func (d *disseminator) handleTableRequest(request *loops.NamespaceTableRequest) {
 	request.Table(d.store.StatePlacementTable(d.currentVersion))
 }
+
+func (d *disseminator) handleSimulate(request *loops.SimulateRequest) {
+	request.Simulation(d.store.Simulate(d.currentVersion, request.Request))
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+// Diff returns the placement tables containing only the actor types whose
+// hosts changed from prev to next. Actor types which were removed are
+// included with an empty load map. Returns nil if a diff cannot be applied to
+// prev, in which case the full tables must be sent.
+func Diff(prev, next *v1pb.PlacementTables) *v1pb.PlacementTables {
+	if prev == nil || prev.GetReplicationFactor() != next.GetReplicationFactor() {
+		return nil
+	}
+
+	diff := &v1pb.PlacementTables{
+		ReplicationFactor: next.GetReplicationFactor(),
+		Entries:           make(map[string]*v1pb.PlacementTable),
+		//nolint:staticcheck
+		Version: next.GetVersion(),
+	}
+
+	for entity, table := range next.GetEntries() {
+		if !tableEqual(prev.GetEntries()[entity], table) {
+			diff.Entries[entity] = table
+		}
+	}
+
+	for entity := range prev.GetEntries() {
+		if _, ok := next.GetEntries()[entity]; !ok {
+			diff.Entries[entity] = &v1pb.PlacementTable{
+				LoadMap: make(map[string]*v1pb.Host),
+			}
+		}
+	}
+
+	return diff
+}
+
+// tableEqual returns true if both tables contain the same hosts, comparing
+// only the fields daprd uses to build its hash ring.
+func tableEqual(a, b *v1pb.PlacementTable) bool {
+	if a == nil || b == nil || len(a.GetLoadMap()) != len(b.GetLoadMap()) {
+		return a == b
+	}
+
+	for name, ah := range a.GetLoadMap() {
+		bh, ok := b.GetLoadMap()[name]
+		if !ok {
+			return false
+		}
+		if ah == bh {
+			continue
+		}
+		//nolint:staticcheck
+		if ah.GetName() != bh.GetName() || ah.GetId() != bh.GetId() ||
+			ah.GetPort() != bh.GetPort() || ah.GetLoad() != bh.GetLoad() {
+			return false
+		}
+	}
+
+	return true
+}
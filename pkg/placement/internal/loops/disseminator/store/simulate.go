@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/dapr/dapr/pkg/placement/hashing"
+)
+
+// SimulateRequest describes host changes to simulate against the current
+// placement table of a namespace.
+type SimulateRequest struct {
+	Namespace   string          `json:"namespace"`
+	RemoveHosts []string        `json:"removeHosts,omitempty"`
+	AddHosts    []SimulatedHost `json:"addHosts,omitempty"`
+}
+
+// SimulatedHost is a host to add in a simulation.
+type SimulatedHost struct {
+	Name       string            `json:"name"`
+	ActorTypes []string          `json:"actorTypes"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// Simulation is the current placement table of a namespace, along with the
+// effect of the simulated host changes.
+type Simulation struct {
+	Namespace         string                         `json:"namespace"`
+	Version           uint64                         `json:"version"`
+	ReplicationFactor int64                          `json:"replicationFactor"`
+	Hosts             []SimulationHost               `json:"hosts"`
+	ActorTypes        map[string]SimulationActorType `json:"actorTypes"`
+}
+
+// SimulationHost is a host in the current placement table, along with the
+// actor types it has been assigned.
+type SimulationHost struct {
+	Name       string            `json:"name"`
+	ID         string            `json:"id"`
+	ActorTypes []string          `json:"actorTypes"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+// SimulationActorType is the distribution of an actor type before and after
+// the simulated host changes.
+type SimulationActorType struct {
+	Hosts          []string `json:"hosts"`
+	SimulatedHosts []string `json:"simulatedHosts"`
+	// MovedFraction is the fraction of the actor ID hash space which would be
+	// owned by a different host after the simulated changes, and therefore the
+	// fraction of active actors of this type which would be rebalanced.
+	MovedFraction float64 `json:"movedFraction"`
+}
+
+// Simulate returns the current placement table with the effect of the given
+// host changes. The store is not modified.
+func (s *Store) Simulate(version uint64, req SimulateRequest) *Simulation {
+	sim := &Simulation{
+		Namespace:         req.Namespace,
+		Version:           version,
+		ReplicationFactor: s.replicationFactor,
+		Hosts:             make([]SimulationHost, 0, len(s.hosts)),
+		ActorTypes:        make(map[string]SimulationActorType),
+	}
+
+	before := make(map[string][]string)
+	after := make(map[string][]string)
+
+	for idx, host := range s.hosts {
+		assigned := s.assigned(host.GetEntities(), s.labels[idx])
+		sim.Hosts = append(sim.Hosts, SimulationHost{
+			Name:       host.GetName(),
+			ID:         host.GetId(),
+			ActorTypes: assigned,
+			Labels:     s.labels[idx],
+		})
+
+		removed := slices.Contains(req.RemoveHosts, host.GetName())
+		for _, entity := range assigned {
+			before[entity] = append(before[entity], host.GetName())
+			if !removed {
+				after[entity] = append(after[entity], host.GetName())
+			}
+		}
+	}
+
+	for _, host := range req.AddHosts {
+		for _, entity := range s.assigned(host.ActorTypes, host.Labels) {
+			if !slices.Contains(after[entity], host.Name) {
+				after[entity] = append(after[entity], host.Name)
+			}
+		}
+	}
+
+	sort.Slice(sim.Hosts, func(i, j int) bool {
+		return sim.Hosts[i].Name < sim.Hosts[j].Name
+	})
+
+	cache := hashing.NewVirtualNodesCache()
+	for _, entities := range []map[string][]string{before, after} {
+		for entity := range entities {
+			if _, ok := sim.ActorTypes[entity]; ok {
+				continue
+			}
+			b, a := before[entity], after[entity]
+			slices.Sort(b)
+			slices.Sort(a)
+			sim.ActorTypes[entity] = SimulationActorType{
+				Hosts:          nonNil(b),
+				SimulatedHosts: nonNil(a),
+				MovedFraction:  s.movedFraction(cache, b, a),
+			}
+		}
+	}
+
+	return sim
+}
+
+// assigned returns the given entities which a host with the given labels may
+// be assigned, sorted.
+func (s *Store) assigned(entities []string, labels map[string]string) []string {
+	assigned := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		if s.allowed(entity, labels) {
+			assigned = append(assigned, entity)
+		}
+	}
+	slices.Sort(assigned)
+	return assigned
+}
+
+// movedFraction returns the fraction of the hash ring which is owned by a
+// different host in the ring built from hosts b compared to hosts a.
+func (s *Store) movedFraction(cache *hashing.VirtualNodesCache, b, a []string) float64 {
+	if len(b) == 0 || len(a) == 0 {
+		if len(b) == 0 && len(a) == 0 {
+			return 0
+		}
+		return 1
+	}
+
+	ringB := newRing(cache, s.replicationFactor, b)
+	ringA := newRing(cache, s.replicationFactor, a)
+
+	// Every key between two consecutive points of the combined ring is owned
+	// by the same host in each ring, so comparing the owner of each point
+	// covers the whole hash space.
+	points := make([]uint64, 0, len(ringB.sorted)+len(ringA.sorted))
+	points = append(points, ringB.sorted...)
+	points = append(points, ringA.sorted...)
+	slices.Sort(points)
+	points = slices.Compact(points)
+
+	if len(points) == 1 {
+		if ringB.owner(points[0]) == ringA.owner(points[0]) {
+			return 0
+		}
+		return 1
+	}
+
+	var moved float64
+	prev := points[len(points)-1]
+	for _, p := range points {
+		if ringB.owner(p) != ringA.owner(p) {
+			// Width of the interval (prev, p], wrapping around the ring.
+			moved += float64(p - prev)
+		}
+		prev = p
+	}
+
+	return moved / math.MaxUint64
+}
+
+type ring struct {
+	hosts  map[uint64]string
+	sorted []uint64
+}
+
+func newRing(cache *hashing.VirtualNodesCache, replicationFactor int64, hosts []string) *ring {
+	loadMap := make(map[string]*hashing.Host, len(hosts))
+	for _, host := range hosts {
+		loadMap[host] = hashing.NewHost(host, "", 0, 0)
+	}
+
+	r := new(ring)
+	hashing.NewFromExisting(loadMap, replicationFactor, cache).
+		ReadInternals(func(hosts map[uint64]string, sorted []uint64, _ map[string]*hashing.Host, _ int64) {
+			r.hosts = hosts
+			r.sorted = sorted
+		})
+	return r
+}
+
+// owner returns the host owning the given point of the hash ring.
+func (r *ring) owner(key uint64) string {
+	idx := sort.Search(len(r.sorted), func(i int) bool {
+		return r.sorted[i] >= key
+	})
+	if idx >= len(r.sorted) {
+		idx = 0
+	}
+	return r.hosts[r.sorted[idx]]
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -20,25 +20,66 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"github.com/dapr/dapr/pkg/placement/labels"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 )
 
 type Options struct {
 	ReplicationFactor int64
+	Selectors         labels.Selectors
 }
 
 type Store struct {
 	replicationFactor int64
+	selectors         labels.Selectors
 
 	// hosts are indexed on streamIDx.
 	hosts map[uint64]*v1pb.Host
+
+	// labels are the labels reported by each stream when connecting, indexed
+	// on streamIDx.
+	labels map[uint64]map[string]string
+
+	// fenced excludes the fenced actor types from the placement tables, or
+	// all actor types if fencedTypes is empty.
+	fenced      bool
+	fencedTypes []string
 }
 
 func New(opts Options) *Store {
 	return &Store{
 		replicationFactor: opts.ReplicationFactor,
+		selectors:         opts.Selectors,
 		hosts:             make(map[uint64]*v1pb.Host),
+		labels:            make(map[uint64]map[string]string),
+	}
+}
+
+// SetSelectors sets the actor type selectors used when building the
+// placement tables.
+func (s *Store) SetSelectors(selectors labels.Selectors) {
+	s.selectors = selectors
+}
+
+// SetFenced sets whether the given actor types, or all actor types if empty,
+// are excluded from the placement tables. Returns true if the fencing
+// changed.
+func (s *Store) SetFenced(fenced bool, actorTypes []string) bool {
+	if s.fenced == fenced && slices.Equal(s.fencedTypes, actorTypes) {
+		return false
 	}
+	s.fenced = fenced
+	s.fencedTypes = actorTypes
+	return true
+}
+
+// SetLabels sets the labels reported by the given stream.
+func (s *Store) SetLabels(streamIDx uint64, labels map[string]string) {
+	if len(labels) == 0 {
+		delete(s.labels, streamIDx)
+		return
+	}
+	s.labels[streamIDx] = labels
 }
 
 func (s *Store) PlacementTables(version uint64) *v1pb.PlacementTables {
@@ -48,8 +89,14 @@ func (s *Store) PlacementTables(version uint64) *v1pb.PlacementTables {
 		Version:           strconv.FormatUint(version, 10),
 	}
 
-	for _, host := range s.hosts {
+	for idx, host := range s.hosts {
 		for _, entity := range host.GetEntities() {
+			// Hosts which do not match the actor type selector are not assigned
+			// the actor type, even though they have registered it.
+			if !s.allowed(entity, s.labels[idx]) {
+				continue
+			}
+
 			if e := t.GetEntries(); e[entity] == nil {
 				e[entity] = &v1pb.PlacementTable{
 					LoadMap: make(map[string]*v1pb.Host),
@@ -121,6 +168,7 @@ func (s *Store) Has(streamIDx uint64) bool {
 
 func (s *Store) Delete(streamIDx uint64) {
 	delete(s.hosts, streamIDx)
+	delete(s.labels, streamIDx)
 }
 
 // CollectOrphans appends orphaned store entry indices to the given slice. An
@@ -133,6 +181,16 @@ func (s *Store) CollectOrphans(isActive func(uint64) bool, orphans *[]uint64) {
 	}
 }
 
+// allowed returns true if a host with the given labels may be assigned the
+// actor type.
+func (s *Store) allowed(entity string, labels map[string]string) bool {
+	if s.fenced && (len(s.fencedTypes) == 0 || slices.Contains(s.fencedTypes, entity)) {
+		return false
+	}
+	return s.selectors.Matches(entity, labels)
+}
+
 func (s *Store) DeleteAll() {
 	clear(s.hosts)
+	clear(s.labels)
 }
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/placement/labels"
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
+)
+
+func TestPlacementTablesSelectors(t *testing.T) {
+	s := New(Options{
+		ReplicationFactor: 1,
+		Selectors:         labels.Selectors{"heavy": {"size": "large"}},
+	})
+
+	s.SetLabels(0, map[string]string{"size": "large"})
+	s.Set(0, &v1pb.Host{Name: "large:1", Entities: []string{"heavy", "light"}})
+	s.SetLabels(1, map[string]string{"size": "small"})
+	s.Set(1, &v1pb.Host{Name: "small:1", Entities: []string{"heavy", "light"}})
+	s.Set(2, &v1pb.Host{Name: "nolabels:1", Entities: []string{"heavy", "light"}})
+
+	tables := s.PlacementTables(1)
+	assert.ElementsMatch(t, []string{"large:1"}, keys(tables.GetEntries()["heavy"].GetLoadMap()))
+	assert.ElementsMatch(t, []string{"large:1", "small:1", "nolabels:1"}, keys(tables.GetEntries()["light"].GetLoadMap()))
+
+	t.Run("no matching hosts omits the actor type", func(t *testing.T) {
+		s.Delete(0)
+		tables := s.PlacementTables(2)
+		assert.NotContains(t, tables.GetEntries(), "heavy")
+		assert.Len(t, tables.GetEntries()["light"].GetLoadMap(), 2)
+	})
+}
+
+func keys(m map[string]*v1pb.Host) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+func TestSimulate(t *testing.T) {
+	s := New(Options{
+		ReplicationFactor: 100,
+		Selectors:         labels.Selectors{"heavy": {"size": "large"}},
+	})
+	s.SetLabels(0, map[string]string{"size": "large"})
+	s.Set(0, &v1pb.Host{Name: "a:1", Id: "app", Entities: []string{"heavy", "light"}})
+	s.Set(1, &v1pb.Host{Name: "b:1", Id: "app", Entities: []string{"heavy", "light"}})
+
+	t.Run("no changes", func(t *testing.T) {
+		sim := s.Simulate(3, SimulateRequest{Namespace: "ns"})
+		assert.Equal(t, "ns", sim.Namespace)
+		assert.Equal(t, uint64(3), sim.Version)
+		require.Len(t, sim.Hosts, 2)
+		assert.Equal(t, []string{"heavy", "light"}, sim.Hosts[0].ActorTypes)
+		assert.Equal(t, []string{"light"}, sim.Hosts[1].ActorTypes)
+		assert.Equal(t, SimulationActorType{
+			Hosts:          []string{"a:1"},
+			SimulatedHosts: []string{"a:1"},
+		}, sim.ActorTypes["heavy"])
+		assert.Zero(t, sim.ActorTypes["light"].MovedFraction)
+	})
+
+	t.Run("remove host", func(t *testing.T) {
+		sim := s.Simulate(3, SimulateRequest{Namespace: "ns", RemoveHosts: []string{"b:1"}})
+		assert.Equal(t, []string{"a:1"}, sim.ActorTypes["light"].SimulatedHosts)
+		assert.InDelta(t, 0.5, sim.ActorTypes["light"].MovedFraction, 0.15)
+		assert.Zero(t, sim.ActorTypes["heavy"].MovedFraction)
+
+		sim = s.Simulate(3, SimulateRequest{Namespace: "ns", RemoveHosts: []string{"a:1"}})
+		assert.Empty(t, sim.ActorTypes["heavy"].SimulatedHosts)
+		assert.InDelta(t, 1.0, sim.ActorTypes["heavy"].MovedFraction, 0)
+	})
+
+	t.Run("add host respects selectors", func(t *testing.T) {
+		sim := s.Simulate(3, SimulateRequest{
+			Namespace: "ns",
+			AddHosts: []SimulatedHost{
+				{Name: "c:1", ActorTypes: []string{"heavy", "light", "new"}},
+			},
+		})
+		assert.Equal(t, []string{"a:1"}, sim.ActorTypes["heavy"].SimulatedHosts)
+		assert.Equal(t, []string{"a:1", "b:1", "c:1"}, sim.ActorTypes["light"].SimulatedHosts)
+		assert.InDelta(t, 1.0/3, sim.ActorTypes["light"].MovedFraction, 0.15)
+		assert.Equal(t, SimulationActorType{
+			Hosts:          []string{},
+			SimulatedHosts: []string{"c:1"},
+			MovedFraction:  1,
+		}, sim.ActorTypes["new"])
+	})
+}
+
+func TestDiff(t *testing.T) {
+	s := New(Options{ReplicationFactor: 100})
+	s.Set(0, &v1pb.Host{Name: "a:1", Id: "a", Entities: []string{"t1", "t2"}})
+	s.Set(1, &v1pb.Host{Name: "b:1", Id: "b", Entities: []string{"t2", "t3"}})
+	prev := s.PlacementTables(1)
+
+	assert.Nil(t, Diff(nil, prev))
+
+	t.Run("unchanged tables give an empty diff", func(t *testing.T) {
+		diff := Diff(prev, s.PlacementTables(2))
+		require.NotNil(t, diff)
+		assert.Empty(t, diff.GetEntries())
+		assert.Equal(t, int64(100), diff.GetReplicationFactor())
+	})
+
+	t.Run("only changed and removed actor types are included", func(t *testing.T) {
+		s.Set(1, &v1pb.Host{Name: "b:1", Id: "b", Entities: []string{"t2", "t4"}})
+		next := s.PlacementTables(2)
+
+		diff := Diff(prev, next)
+		require.NotNil(t, diff)
+		assert.ElementsMatch(t, []string{"t3", "t4"}, slices.Collect(maps.Keys(diff.GetEntries())))
+		assert.Empty(t, diff.GetEntries()["t3"].GetLoadMap())
+		assert.ElementsMatch(t, []string{"b:1"}, keys(diff.GetEntries()["t4"].GetLoadMap()))
+	})
+
+	t.Run("host ID change is included", func(t *testing.T) {
+		s.Set(0, &v1pb.Host{Name: "a:1", Id: "a2", Entities: []string{"t1", "t2"}})
+		diff := Diff(prev, s.PlacementTables(3))
+		require.NotNil(t, diff)
+		assert.Contains(t, diff.GetEntries(), "t1")
+		assert.Contains(t, diff.GetEntries(), "t2")
+	})
+
+	t.Run("replication factor change requires full tables", func(t *testing.T) {
+		next := s.PlacementTables(4)
+		next.ReplicationFactor = 10
+		assert.Nil(t, Diff(prev, next))
+	})
+}
@@ -16,6 +16,7 @@ package loops
 import (
 	"context"
 
+	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/store"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 )
 
@@ -44,6 +45,10 @@ type ConnAdd struct {
 	Channel     v1pb.Placement_ReportDaprStatusServer
 	Cancel      context.CancelCauseFunc
 	InitialHost *v1pb.Host
+	// Labels are the host labels reported by daprd when opening the stream.
+	Labels map[string]string
+	// TableDiff is true if daprd supports receiving placement table diffs.
+	TableDiff bool
 }
 
 type ReportedHost struct {
@@ -62,6 +67,12 @@ type DisseminateUpdate struct {
 	*streambase
 	Tables  *v1pb.PlacementTables
 	Version uint64
+
+	// Diff contains the actor types which changed between Base and Tables. It
+	// is sent instead of Tables to streams which support table diffs, and
+	// whose last sent tables are Base.
+	Diff *v1pb.PlacementTables
+	Base *v1pb.PlacementTables
 }
 
 type DisseminateUnlock struct {
@@ -125,3 +136,25 @@ type StateTableRequest struct {
 	*nsbase
 	State func(*v1pb.StatePlacementTables)
 }
+
+// SimulateRequest is the event for simulating host changes against the
+// placement table of a namespace.
+type SimulateRequest struct {
+	*nsbase
+	*dissbase
+	Request    store.SimulateRequest
+	Simulation func(*store.Simulation)
+}
+
+// FederationOwnership is the event for changing whether this placement owns
+// the federated actor types.
+type FederationOwnership struct {
+	*nsbase
+	*dissbase
+	Owner bool
+	// ActorTypes are the federated actor types, or all actor types if empty.
+	ActorTypes []string
+	// PeerVersions are the last table versions reported by the federation
+	// peer, indexed by namespace.
+	PeerVersions map[string]uint64
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/dapr/dapr/pkg/placement/internal/authorizer"
 	"github.com/dapr/dapr/pkg/placement/internal/loops"
 	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator"
+	"github.com/dapr/dapr/pkg/placement/labels"
 	"github.com/dapr/kit/events/loop"
 	"github.com/dapr/kit/logger"
 )
@@ -30,8 +31,12 @@ import (
 var log = logger.NewLogger("dapr.placement.server.loops.namespaces")
 
 type Options struct {
-	CancelPool                  context.CancelCauseFunc
-	ReplicationFactor           int64
+	CancelPool        context.CancelCauseFunc
+	ReplicationFactor int64
+	Selectors         labels.Selectors
+	// Ownership is the initial federation ownership, if federation is
+	// enabled.
+	Ownership                   *loops.FederationOwnership
 	Authorizer                  *authorizer.Authorizer
 	DisseminationTimeout        time.Duration
 	DisseminationCoalesceWindow time.Duration
@@ -47,6 +52,8 @@ type disseminatorLoop struct {
 type namespaces struct {
 	cancelPool                  context.CancelCauseFunc
 	replicationFactor           int64
+	selectors                   labels.Selectors
+	ownership                   *loops.FederationOwnership
 	disseminationTimeout        time.Duration
 	disseminationCoalesceWindow time.Duration
 
@@ -62,6 +69,8 @@ func New(opts Options) loop.Interface[loops.EventNamespace] {
 	ns := &namespaces{
 		cancelPool:                  opts.CancelPool,
 		replicationFactor:           opts.ReplicationFactor,
+		selectors:                   opts.Selectors,
+		ownership:                   opts.Ownership,
 		disseminators:               make(map[string]*disseminatorLoop),
 		authorizer:                  opts.Authorizer,
 		disseminationTimeout:        opts.DisseminationTimeout,
@@ -84,6 +93,10 @@ func (n *namespaces) Handle(ctx context.Context, event loops.EventNamespace) err
 		return n.handleShutdown(e)
 	case *loops.StateTableRequest:
 		n.handleStatePlacement(e)
+	case *loops.SimulateRequest:
+		n.handleSimulate(e)
+	case *loops.FederationOwnership:
+		n.handleOwnership(e)
 	default:
 		panic(fmt.Sprintf("unknown namespaces event type: %T", e))
 	}
@@ -98,6 +111,8 @@ func (n *namespaces) handleAdd(ctx context.Context, add *loops.ConnAdd) error {
 	if !ok {
 		loop := disseminator.New(disseminator.Options{
 			ReplicationFactor:           n.replicationFactor,
+			Selectors:                   n.selectors,
+			Ownership:                   n.ownership,
 			NamespaceLoop:               n.loop,
 			Authorizer:                  n.authorizer,
 			DisseminationTimeout:        n.disseminationTimeout,
@@ -17,6 +17,7 @@ import (
 	"sync"
 
 	"github.com/dapr/dapr/pkg/placement/internal/loops"
+	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/store"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 )
 
@@ -44,3 +45,29 @@ func (n *namespaces) handleStatePlacement(request *loops.StateTableRequest) {
 
 	request.State(resp)
 }
+
+func (n *namespaces) handleSimulate(request *loops.SimulateRequest) {
+	diss, ok := n.disseminators[request.Request.Namespace]
+	if !ok {
+		// No hosts are connected in this namespace, so simulate against an
+		// empty table.
+		empty := store.New(store.Options{
+			ReplicationFactor: n.replicationFactor,
+			Selectors:         n.selectors,
+		})
+		if n.ownership != nil {
+			empty.SetFenced(!n.ownership.Owner, n.ownership.ActorTypes)
+		}
+		request.Simulation(empty.Simulate(0, request.Request))
+		return
+	}
+
+	diss.loop.Enqueue(request)
+}
+
+func (n *namespaces) handleOwnership(ownership *loops.FederationOwnership) {
+	n.ownership = ownership
+	for _, diss := range n.disseminators {
+		diss.loop.Enqueue(ownership)
+	}
+}
@@ -14,13 +14,15 @@ limitations under the License.
 package stream
 
 import (
+	"github.com/dapr/dapr/pkg/placement/internal/loops"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 )
 
 const (
-	operationLock   = "lock"
-	operationUnlock = "unlock"
-	operationUpdate = "update"
+	operationLock       = "lock"
+	operationUnlock     = "unlock"
+	operationUpdate     = "update"
+	operationUpdateDiff = "update-diff"
 )
 
 // handleLock sends the lock operation to the stream.
@@ -42,19 +44,36 @@ func (s *stream) handleLock(version uint64) error {
 }
 
 // handleUpdate sends the update operation to the stream with the new tables.
-func (s *stream) handleUpdate(version uint64, tables *v1pb.PlacementTables) error {
+// If daprd supports table diffs and holds the tables the diff is based on, only
+// the diff is sent.
+func (s *stream) handleUpdate(e *loops.DisseminateUpdate) error {
 	// Ignore outdated updates.
-	if s.currentVersion == nil || *s.currentVersion != version {
+	if s.currentVersion == nil || *s.currentVersion != e.Version {
 		return nil
 	}
 
-	log.Debugf("Sending UPDATE for version %d to stream %s:%d", version, s.ns, s.idx)
-
-	return s.channel.Send(&v1pb.PlacementOrder{
+	order := &v1pb.PlacementOrder{
 		Operation: operationUpdate,
-		Version:   version,
-		Tables:    tables,
-	})
+		Version:   e.Version,
+		Tables:    e.Tables,
+	}
+	if s.tableDiff && e.Diff != nil && s.lastTables != nil && s.lastTables == e.Base {
+		order.Operation = operationUpdateDiff
+		order.Tables = e.Diff
+	}
+
+	log.Debugf("Sending %s for version %d to stream %s:%d (%d actor types)",
+		order.Operation, e.Version, s.ns, s.idx, len(order.GetTables().GetEntries()))
+
+	// Forget the last sent tables on error so that a diff is never based on
+	// tables daprd may not have received.
+	s.lastTables = nil
+	if err := s.channel.Send(order); err != nil {
+		return err
+	}
+	s.lastTables = e.Tables
+
+	return nil
 }
 
 // handleTable sends a one-shot LOCK+UPDATE+UNLOCK sequence to the stream.
@@ -63,6 +82,8 @@ func (s *stream) handleUpdate(version uint64, tables *v1pb.PlacementTables) erro
 func (s *stream) handleTable(version uint64, tables *v1pb.PlacementTables) error {
 	log.Debugf("Sending initial table for version %d to stream %s:%d", version, s.ns, s.idx)
 
+	s.lastTables = nil
+
 	if err := s.channel.Send(&v1pb.PlacementOrder{
 		Operation: operationLock,
 		Version:   version,
@@ -77,6 +98,7 @@ func (s *stream) handleTable(version uint64, tables *v1pb.PlacementTables) error
 	}); err != nil {
 		return err
 	}
+	s.lastTables = tables
 
 	return s.channel.Send(&v1pb.PlacementOrder{
 		Operation: operationUnlock,
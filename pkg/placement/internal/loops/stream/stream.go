@@ -58,6 +58,11 @@ type stream struct {
 
 	currentVersion *uint64
 
+	// tableDiff is true if daprd supports placement table diffs. lastTables
+	// are the tables last sent to daprd, which a diff must be based on.
+	tableDiff  bool
+	lastTables *v1pb.PlacementTables
+
 	addr string
 	wg   sync.WaitGroup
 }
@@ -80,6 +85,8 @@ func New(ctx context.Context, opts Options) loop.Interface[loops.EventStream] {
 	stream.idx = opts.IDx
 
 	stream.currentVersion = nil
+	stream.tableDiff = opts.Add.TableDiff
+	stream.lastTables = nil
 
 	stream.addr = addr
 
@@ -101,7 +108,7 @@ func (s *stream) Handle(ctx context.Context, event loops.EventStream) error {
 	case *loops.DisseminateLock:
 		err = s.handleLock(e.Version)
 	case *loops.DisseminateUpdate:
-		err = s.handleUpdate(e.Version, e.Tables)
+		err = s.handleUpdate(e)
 	case *loops.DisseminateUnlock:
 		err = s.handleUnlock(e.Version)
 	case *loops.DisseminateTable:
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -25,14 +26,19 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/placement/apiversion"
 	"github.com/dapr/dapr/pkg/placement/internal/authorizer"
+	"github.com/dapr/dapr/pkg/placement/internal/federation"
 	"github.com/dapr/dapr/pkg/placement/internal/leadership"
 	"github.com/dapr/dapr/pkg/placement/internal/loops"
+	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/store"
 	"github.com/dapr/dapr/pkg/placement/internal/loops/namespaces"
+	"github.com/dapr/dapr/pkg/placement/labels"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 	"github.com/dapr/dapr/pkg/security"
@@ -53,6 +59,9 @@ type Options struct {
 	KeepAliveTime     time.Duration
 	KeepAliveTimeout  time.Duration
 	ReplicationFactor int64
+	Selectors         labels.Selectors
+	// Federation enables federation with a peer placement service, if set.
+	Federation *federation.Options
 
 	DisseminateTimeout        time.Duration
 	DisseminateCoalesceWindow time.Duration
@@ -68,18 +77,20 @@ type Server struct {
 	keepAliveTime             time.Duration
 	keepAliveTimeout          time.Duration
 	replicationFactor         int64
+	selectors                 labels.Selectors
 	disseminateTimeout        time.Duration
 	disseminateCoalesceWindow time.Duration
 
-	authz *authorizer.Authorizer
-	loop  loop.Interface[loops.EventNamespace]
+	authz      *authorizer.Authorizer
+	loop       loop.Interface[loops.EventNamespace]
+	federation *federation.Federation
 
 	isLeader atomic.Bool
 	shutdown atomic.Bool
 }
 
-func New(opts Options) *Server {
-	return &Server{
+func New(opts Options) (*Server, error) {
+	s := &Server{
 		nodeID:           opts.NodeID,
 		port:             opts.Port,
 		listenAddress:    opts.ListenAddress,
@@ -92,9 +103,29 @@ func New(opts Options) *Server {
 			Security: opts.Security,
 		}),
 		replicationFactor:         opts.ReplicationFactor,
+		selectors:                 opts.Selectors,
 		disseminateTimeout:        opts.DisseminateTimeout,
 		disseminateCoalesceWindow: opts.DisseminateCoalesceWindow,
 	}
+
+	if opts.Federation != nil {
+		fopts := *opts.Federation
+		fopts.Versions = s.tableVersions
+		fopts.OnChange = func(owner bool, peerVersions map[string]uint64) {
+			s.loop.Enqueue(&loops.FederationOwnership{
+				Owner:        owner,
+				ActorTypes:   fopts.ActorTypes,
+				PeerVersions: peerVersions,
+			})
+		}
+		var err error
+		s.federation, err = federation.New(fopts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
 }
 
 func (s *Server) Run(ctx context.Context) error {
@@ -126,10 +157,22 @@ func (s *Server) Run(ctx context.Context) error {
 
 	log.Infof("Placement service started on port %d", listener.Addr().(*net.TCPAddr).Port)
 
+	var ownership *loops.FederationOwnership
+	if s.federation != nil {
+		// Federated actor types are fenced until the federation grants
+		// ownership.
+		ownership = &loops.FederationOwnership{
+			Owner:      s.federation.Owner(),
+			ActorTypes: s.federation.ActorTypes(),
+		}
+	}
+
 	ctx, cancel := context.WithCancelCause(ctx)
 	s.loop = namespaces.New(namespaces.Options{
+		Ownership:                   ownership,
 		CancelPool:                  cancel,
 		ReplicationFactor:           s.replicationFactor,
+		Selectors:                   s.selectors,
 		Authorizer:                  s.authz,
 		DisseminationTimeout:        s.disseminateTimeout,
 		DisseminationCoalesceWindow: s.disseminateCoalesceWindow,
@@ -148,6 +191,11 @@ func (s *Server) Run(ctx context.Context) error {
 			s.isLeader.Store(true)
 			monitoring.RecordPlacementLeaderStatus(true)
 			monitoring.RecordRaftPlacementLeaderStatus(true)
+			if s.federation != nil {
+				if ferr := s.federation.Run(ctx); ferr != nil {
+					return ferr
+				}
+			}
 			<-ctx.Done()
 			return ctx.Err()
 		},
@@ -202,6 +250,68 @@ func (s *Server) StatePlacementTables(ctx context.Context) (*v1pb.StatePlacement
 	return proto.Clone(got).(*v1pb.StatePlacementTables), nil
 }
 
+// FederationStatus returns the federation status of this placement service.
+func (s *Server) FederationStatus(ctx context.Context) (*federation.Status, error) {
+	if s.federation == nil {
+		return nil, status.Error(codes.Unimplemented, "placement federation is not enabled")
+	}
+	if !s.isLeader.Load() {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"node id=%s is not a leader. Only the leader can serve requests",
+			s.nodeID,
+		)
+	}
+	return s.federation.Status(ctx)
+}
+
+func (s *Server) tableVersions(ctx context.Context) (map[string]uint64, error) {
+	tables, err := s.StatePlacementTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[string]uint64, len(tables.GetTables()))
+	for ns, table := range tables.GetTables() {
+		versions[ns] = table.GetVersion()
+	}
+	return versions, nil
+}
+
+func (s *Server) Simulate(ctx context.Context, req store.SimulateRequest) (*store.Simulation, error) {
+	if !s.isLeader.Load() {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"node id=%s is not a leader. Only the leader can serve requests",
+			s.nodeID,
+		)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var got *store.Simulation
+	var lock sync.Mutex
+
+	s.loop.Enqueue(&loops.SimulateRequest{
+		Request: req,
+		Simulation: func(result *store.Simulation) {
+			lock.Lock()
+			got = result
+			lock.Unlock()
+			cancel()
+		},
+	})
+
+	<-ctx.Done()
+
+	lock.Lock()
+	defer lock.Unlock()
+	if got == nil {
+		return nil, ctx.Err()
+	}
+	return got, nil
+}
+
 func (s *Server) ReportDaprStatus(stream v1pb.Placement_ReportDaprStatusServer) error {
 	if !s.isLeader.Load() {
 		return status.Errorf(
@@ -227,6 +337,11 @@ func (s *Server) ReportDaprStatus(stream v1pb.Placement_ReportDaprStatusServer)
 		return err
 	}
 
+	hostLabels, err := streamLabels(stream)
+	if err != nil {
+		return err
+	}
+
 	log.Infof("Received status report connection from new namespace=%s id=%s host=%s",
 		host.GetNamespace(), host.GetId(), host.GetName())
 
@@ -235,6 +350,8 @@ func (s *Server) ReportDaprStatus(stream v1pb.Placement_ReportDaprStatusServer)
 
 	s.loop.Enqueue(&loops.ConnAdd{
 		InitialHost: host,
+		Labels:      hostLabels,
+		TableDiff:   streamTableDiff(stream),
 		Channel:     stream,
 		Cancel:      cancel,
 	})
@@ -243,3 +360,34 @@ func (s *Server) ReportDaprStatus(stream v1pb.Placement_ReportDaprStatusServer)
 
 	return context.Cause(ctx)
 }
+
+// streamLabels returns the host labels reported by daprd in the stream
+// metadata, if any.
+func streamLabels(stream v1pb.Placement_ReportDaprStatusServer) (map[string]string, error) {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return nil, nil
+	}
+
+	vals := md.Get(labels.MetadataKey)
+	if len(vals) == 0 {
+		return nil, nil
+	}
+
+	hostLabels, err := labels.Parse(strings.Join(vals, ","))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid host labels: %s", err)
+	}
+
+	return hostLabels, nil
+}
+
+// streamTableDiff returns true if daprd reported support for placement table
+// diffs in the stream metadata.
+func streamTableDiff(stream v1pb.Placement_ReportDaprStatusServer) bool {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return false
+	}
+	return apiversion.SupportsTableDiff(md.Get(apiversion.MetadataKey))
+}
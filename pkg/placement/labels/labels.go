@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package labels implements the host labels reported by daprd to the
+// placement service, and the actor type selectors placement uses to restrict
+// which hosts may own an actor type.
+package labels
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// MetadataKey is the gRPC metadata key daprd uses to report its host labels
+// when opening the placement stream.
+const MetadataKey = "dapr-placement-host-labels"
+
+// Parse parses a comma separated list of `key=value` pairs.
+func Parse(s string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for pair := range strings.SplitSeq(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid label %q: expected key=value", pair)
+		}
+		labels[k] = strings.TrimSpace(v)
+	}
+	return labels, nil
+}
+
+// Encode encodes labels in the format accepted by Parse, with keys sorted.
+func Encode(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		pairs = append(pairs, k+"="+labels[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// Selectors maps an actor type to the labels a host must have to be assigned
+// that actor type. Actor types without a selector may be placed on any host.
+type Selectors map[string]map[string]string
+
+// ParseSelectors parses a list of selectors, each in the format
+// `actorType:key=value[,key=value]`.
+func ParseSelectors(vals []string) (Selectors, error) {
+	selectors := make(Selectors, len(vals))
+	for _, val := range vals {
+		actorType, sel, ok := strings.Cut(val, ":")
+		actorType = strings.TrimSpace(actorType)
+		if !ok || actorType == "" {
+			return nil, fmt.Errorf("invalid actor type selector %q: expected actorType:key=value[,key=value]", val)
+		}
+		labels, err := Parse(sel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid actor type selector %q: %w", val, err)
+		}
+		if len(labels) == 0 {
+			return nil, fmt.Errorf("invalid actor type selector %q: no labels given", val)
+		}
+		if _, ok := selectors[actorType]; ok {
+			return nil, fmt.Errorf("duplicate selector for actor type %q", actorType)
+		}
+		selectors[actorType] = labels
+	}
+	return selectors, nil
+}
+
+// Matches returns true if a host with the given labels may be assigned the
+// actor type.
+func (s Selectors) Matches(actorType string, labels map[string]string) bool {
+	for k, v := range s[actorType] {
+		if hv, ok := labels[k]; !ok || hv != v {
+			return false
+		}
+	}
+	return true
+}
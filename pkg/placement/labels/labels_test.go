@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	got, err := Parse(" size=large, zone=a ,,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"size": "large", "zone": "a"}, got)
+	assert.Equal(t, "size=large,zone=a", Encode(got))
+
+	_, err = Parse("size")
+	require.Error(t, err)
+	_, err = Parse("=large")
+	require.Error(t, err)
+}
+
+func TestParseSelectors(t *testing.T) {
+	got, err := ParseSelectors([]string{"heavy:size=large,zone=a", "other:zone=b"})
+	require.NoError(t, err)
+	assert.Equal(t, Selectors{
+		"heavy": {"size": "large", "zone": "a"},
+		"other": {"zone": "b"},
+	}, got)
+
+	for _, val := range []string{"heavy", ":size=large", "heavy:", "heavy:size"} {
+		_, err = ParseSelectors([]string{val})
+		require.Error(t, err, val)
+	}
+
+	_, err = ParseSelectors([]string{"heavy:size=large", "heavy:zone=a"})
+	require.Error(t, err)
+}
+
+func TestMatches(t *testing.T) {
+	s := Selectors{"heavy": {"size": "large", "zone": "a"}}
+
+	assert.True(t, s.Matches("heavy", map[string]string{"size": "large", "zone": "a", "extra": "x"}))
+	assert.False(t, s.Matches("heavy", map[string]string{"size": "large"}))
+	assert.False(t, s.Matches("heavy", map[string]string{"size": "small", "zone": "a"}))
+	assert.False(t, s.Matches("heavy", nil))
+	assert.True(t, s.Matches("light", nil))
+	assert.True(t, Selectors(nil).Matches("heavy", nil))
+}
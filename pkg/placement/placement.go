@@ -18,8 +18,11 @@ import (
 	"time"
 
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/placement/internal/federation"
 	"github.com/dapr/dapr/pkg/placement/internal/leadership"
+	"github.com/dapr/dapr/pkg/placement/internal/loops/disseminator/store"
 	"github.com/dapr/dapr/pkg/placement/internal/server"
+	"github.com/dapr/dapr/pkg/placement/labels"
 	"github.com/dapr/dapr/pkg/placement/peers"
 	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 	"github.com/dapr/dapr/pkg/security"
@@ -36,12 +39,45 @@ type Options struct {
 	KeepAliveTimeout  time.Duration
 	ReplicationFactor int64
 
+	// Selectors restrict actor types to hosts which report matching labels.
+	Selectors labels.Selectors
+
+	// Federation enables federation of actor types with a placement service
+	// in another cluster, if set.
+	Federation *FederationOptions
+
 	DisseminateTimeout        time.Duration
 	DisseminateCoalesceWindow time.Duration
 
 	Peers []peers.PeerInfo
 }
 
+type (
+	// SimulateRequest describes host changes to simulate against the
+	// placement table of a namespace.
+	SimulateRequest = store.SimulateRequest
+	// SimulatedHost is a host to add in a simulation.
+	SimulatedHost = store.SimulatedHost
+	// Simulation is the placement table of a namespace along with the effect
+	// of the simulated host changes.
+	Simulation = store.Simulation
+
+	// FederationOptions configures federation with a peer placement service.
+	FederationOptions = federation.Options
+	// FederationStatus is the federation status of a placement service.
+	FederationStatus = federation.Status
+	// FederationRole is the role of a placement service in the federation.
+	FederationRole = federation.Role
+)
+
+const (
+	FederationRolePrimary = federation.RolePrimary
+	FederationRoleReplica = federation.RoleReplica
+
+	// FederationStatusPath is the admin path serving the federation status.
+	FederationStatusPath = federation.StatusPath
+)
+
 type Placement struct {
 	server     *server.Server
 	leadership *leadership.Leadership
@@ -58,7 +94,7 @@ func New(opts Options) (*Placement, error) {
 		return nil, err
 	}
 
-	server := server.New(server.Options{
+	server, err := server.New(server.Options{
 		NodeID:                    opts.NodeID,
 		Port:                      opts.Port,
 		ListenAddress:             opts.ListenAddress,
@@ -68,9 +104,14 @@ func New(opts Options) (*Placement, error) {
 		KeepAliveTime:             opts.KeepAliveTime,
 		KeepAliveTimeout:          opts.KeepAliveTimeout,
 		ReplicationFactor:         opts.ReplicationFactor,
+		Selectors:                 opts.Selectors,
+		Federation:                opts.Federation,
 		DisseminateTimeout:        opts.DisseminateTimeout,
 		DisseminateCoalesceWindow: opts.DisseminateCoalesceWindow,
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &Placement{
 		server:     server,
@@ -88,3 +129,14 @@ func (p *Placement) Run(ctx context.Context) error {
 func (p *Placement) StatePlacementTables(ctx context.Context) (*v1pb.StatePlacementTables, error) {
 	return p.server.StatePlacementTables(ctx)
 }
+
+// Simulate returns the current placement table of a namespace, and the
+// effect of the requested host changes on the distribution of actor types.
+func (p *Placement) Simulate(ctx context.Context, req SimulateRequest) (*Simulation, error) {
+	return p.server.Simulate(ctx, req)
+}
+
+// FederationStatus returns the federation status of this placement service.
+func (p *Placement) FederationStatus(ctx context.Context) (*FederationStatus, error) {
+	return p.server.FederationStatus(ctx)
+}
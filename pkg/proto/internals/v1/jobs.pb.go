@@ -53,6 +53,10 @@ type JobHTTPRequest struct {
 	Data          *structpb.Value      `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 	Overwrite     *bool                `protobuf:"varint,7,opt,name=overwrite,proto3,oneof" json:"overwrite,omitempty"`
 	FailurePolicy *v1.JobFailurePolicy `protobuf:"bytes,8,opt,name=failure_policy,json=failurePolicy,proto3,oneof" json:"failure_policy,omitempty"`
+	Labels        map[string]string    `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExactlyOnce   *bool                `protobuf:"varint,10,opt,name=exactly_once,json=exactlyOnce,proto3,oneof" json:"exactly_once,omitempty"`
+	TimeZone      *string              `protobuf:"bytes,11,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	Calendar      *string              `protobuf:"bytes,12,opt,name=calendar,proto3,oneof" json:"calendar,omitempty"`
 }
 
 func (x *JobHTTPRequest) Reset() {
@@ -143,6 +147,83 @@ func (x *JobHTTPRequest) GetFailurePolicy() *v1.JobFailurePolicy {
 	return nil
 }
 
+func (x *JobHTTPRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *JobHTTPRequest) GetExactlyOnce() bool {
+	if x != nil && x.ExactlyOnce != nil {
+		return *x.ExactlyOnce
+	}
+	return false
+}
+
+func (x *JobHTTPRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *JobHTTPRequest) GetCalendar() string {
+	if x != nil && x.Calendar != nil {
+		return *x.Calendar
+	}
+	return ""
+}
+
+// BulkJobsHTTPRequest is the HTTP request body of a batch of jobs to
+// schedule.
+type BulkJobsHTTPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*JobHTTPRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *BulkJobsHTTPRequest) Reset() {
+	*x = BulkJobsHTTPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkJobsHTTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkJobsHTTPRequest) ProtoMessage() {}
+
+func (x *BulkJobsHTTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkJobsHTTPRequest.ProtoReflect.Descriptor instead.
+func (*BulkJobsHTTPRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_internals_v1_jobs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BulkJobsHTTPRequest) GetJobs() []*JobHTTPRequest {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
 // JobEvent is an event of a job to be processed by Scheduler.
 type JobEvent struct {
 	state         protoimpl.MessageState
@@ -162,7 +243,7 @@ type JobEvent struct {
 func (x *JobEvent) Reset() {
 	*x = JobEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[1]
+		mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -175,7 +256,7 @@ func (x *JobEvent) String() string {
 func (*JobEvent) ProtoMessage() {}
 
 func (x *JobEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[1]
+	mi := &file_dapr_proto_internals_v1_jobs_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +269,7 @@ func (x *JobEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
 func (*JobEvent) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_internals_v1_jobs_proto_rawDescGZIP(), []int{1}
+	return file_dapr_proto_internals_v1_jobs_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *JobEvent) GetKey() string {
@@ -234,7 +315,7 @@ var file_dapr_proto_internals_v1_jobs_proto_rawDesc = []byte{
 	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x21, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x8d, 0x03, 0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65,
+	0x74, 0x6f, 0x22, 0xac, 0x05, 0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x63, 0x68,
 	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73,
@@ -254,26 +335,49 @@ var file_dapr_proto_internals_v1_jobs_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
 	0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48,
 	0x05, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74,
-	0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2f, 0x76, 0x31,
-	0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x26, 0x0a, 0x0c, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x5f, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c,
+	0x79, 0x4f, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x74, 0x74, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c,
+	0x79, 0x5f, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x22, 0x52, 0x0a, 0x13, 0x42, 0x75, 0x6c, 0x6b, 0x4a, 0x6f, 0x62, 0x73, 0x48, 0x54, 0x54,
+	0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x54, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -288,25 +392,29 @@ func file_dapr_proto_internals_v1_jobs_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_internals_v1_jobs_proto_rawDescData
 }
 
-var file_dapr_proto_internals_v1_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_dapr_proto_internals_v1_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_dapr_proto_internals_v1_jobs_proto_goTypes = []interface{}{
 	(*JobHTTPRequest)(nil),      // 0: dapr.proto.internals.v1.JobHTTPRequest
-	(*JobEvent)(nil),            // 1: dapr.proto.internals.v1.JobEvent
-	(*structpb.Value)(nil),      // 2: google.protobuf.Value
-	(*v1.JobFailurePolicy)(nil), // 3: dapr.proto.common.v1.JobFailurePolicy
-	(*v11.JobMetadata)(nil),     // 4: dapr.proto.scheduler.v1.JobMetadata
-	(*anypb.Any)(nil),           // 5: google.protobuf.Any
+	(*BulkJobsHTTPRequest)(nil), // 1: dapr.proto.internals.v1.BulkJobsHTTPRequest
+	(*JobEvent)(nil),            // 2: dapr.proto.internals.v1.JobEvent
+	nil,                         // 3: dapr.proto.internals.v1.JobHTTPRequest.LabelsEntry
+	(*structpb.Value)(nil),      // 4: google.protobuf.Value
+	(*v1.JobFailurePolicy)(nil), // 5: dapr.proto.common.v1.JobFailurePolicy
+	(*v11.JobMetadata)(nil),     // 6: dapr.proto.scheduler.v1.JobMetadata
+	(*anypb.Any)(nil),           // 7: google.protobuf.Any
 }
 var file_dapr_proto_internals_v1_jobs_proto_depIdxs = []int32{
-	2, // 0: dapr.proto.internals.v1.JobHTTPRequest.data:type_name -> google.protobuf.Value
-	3, // 1: dapr.proto.internals.v1.JobHTTPRequest.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
-	4, // 2: dapr.proto.internals.v1.JobEvent.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	5, // 3: dapr.proto.internals.v1.JobEvent.data:type_name -> google.protobuf.Any
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 0: dapr.proto.internals.v1.JobHTTPRequest.data:type_name -> google.protobuf.Value
+	5, // 1: dapr.proto.internals.v1.JobHTTPRequest.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
+	3, // 2: dapr.proto.internals.v1.JobHTTPRequest.labels:type_name -> dapr.proto.internals.v1.JobHTTPRequest.LabelsEntry
+	0, // 3: dapr.proto.internals.v1.BulkJobsHTTPRequest.jobs:type_name -> dapr.proto.internals.v1.JobHTTPRequest
+	6, // 4: dapr.proto.internals.v1.JobEvent.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	7, // 5: dapr.proto.internals.v1.JobEvent.data:type_name -> google.protobuf.Any
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_internals_v1_jobs_proto_init() }
@@ -328,6 +436,18 @@ func file_dapr_proto_internals_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_internals_v1_jobs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkJobsHTTPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_internals_v1_jobs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobEvent); i {
 			case 0:
 				return &v.state
@@ -347,7 +467,7 @@ func file_dapr_proto_internals_v1_jobs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_internals_v1_jobs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -48,6 +48,7 @@ const (
 	Operator_ResiliencyUpdate_FullMethodName           = "/dapr.proto.operator.v1.Operator/ResiliencyUpdate"
 	Operator_ListWorkflowAccessPolicy_FullMethodName   = "/dapr.proto.operator.v1.Operator/ListWorkflowAccessPolicy"
 	Operator_WorkflowAccessPolicyUpdate_FullMethodName = "/dapr.proto.operator.v1.Operator/WorkflowAccessPolicyUpdate"
+	Operator_ReportResourceResults_FullMethodName      = "/dapr.proto.operator.v1.Operator/ReportResourceResults"
 )
 
 // OperatorClient is the client API for Operator service.
@@ -86,6 +87,10 @@ type OperatorClient interface {
 	ListWorkflowAccessPolicy(ctx context.Context, in *ListWorkflowAccessPolicyRequest, opts ...grpc.CallOption) (*ListWorkflowAccessPolicyResponse, error)
 	// Sends events to Dapr sidecars upon workflow access policy changes.
 	WorkflowAccessPolicyUpdate(ctx context.Context, in *WorkflowAccessPolicyUpdateRequest, opts ...grpc.CallOption) (Operator_WorkflowAccessPolicyUpdateClient, error)
+	// Receives the results of Dapr sidecars loading resources, reflected in the
+	// status of the resources. The results of a sidecar are dropped when its
+	// stream ends.
+	ReportResourceResults(ctx context.Context, opts ...grpc.CallOption) (Operator_ReportResourceResultsClient, error)
 }
 
 type operatorClient struct {
@@ -401,6 +406,40 @@ func (x *operatorWorkflowAccessPolicyUpdateClient) Recv() (*WorkflowAccessPolicy
 	return m, nil
 }
 
+func (c *operatorClient) ReportResourceResults(ctx context.Context, opts ...grpc.CallOption) (Operator_ReportResourceResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Operator_ServiceDesc.Streams[7], Operator_ReportResourceResults_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorReportResourceResultsClient{stream}
+	return x, nil
+}
+
+type Operator_ReportResourceResultsClient interface {
+	Send(*ReportResourceResultsRequest) error
+	CloseAndRecv() (*emptypb.Empty, error)
+	grpc.ClientStream
+}
+
+type operatorReportResourceResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorReportResourceResultsClient) Send(m *ReportResourceResultsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *operatorReportResourceResultsClient) CloseAndRecv() (*emptypb.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(emptypb.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OperatorServer is the server API for Operator service.
 // All implementations should embed UnimplementedOperatorServer
 // for forward compatibility
@@ -437,6 +476,10 @@ type OperatorServer interface {
 	ListWorkflowAccessPolicy(context.Context, *ListWorkflowAccessPolicyRequest) (*ListWorkflowAccessPolicyResponse, error)
 	// Sends events to Dapr sidecars upon workflow access policy changes.
 	WorkflowAccessPolicyUpdate(*WorkflowAccessPolicyUpdateRequest, Operator_WorkflowAccessPolicyUpdateServer) error
+	// Receives the results of Dapr sidecars loading resources, reflected in the
+	// status of the resources. The results of a sidecar are dropped when its
+	// stream ends.
+	ReportResourceResults(Operator_ReportResourceResultsServer) error
 }
 
 // UnimplementedOperatorServer should be embedded to have forward compatible implementations.
@@ -491,6 +534,9 @@ func (UnimplementedOperatorServer) ListWorkflowAccessPolicy(context.Context, *Li
 func (UnimplementedOperatorServer) WorkflowAccessPolicyUpdate(*WorkflowAccessPolicyUpdateRequest, Operator_WorkflowAccessPolicyUpdateServer) error {
 	return status.Errorf(codes.Unimplemented, "method WorkflowAccessPolicyUpdate not implemented")
 }
+func (UnimplementedOperatorServer) ReportResourceResults(Operator_ReportResourceResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportResourceResults not implemented")
+}
 
 // UnsafeOperatorServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OperatorServer will
@@ -812,6 +858,32 @@ func (x *operatorWorkflowAccessPolicyUpdateServer) Send(m *WorkflowAccessPolicyU
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Operator_ReportResourceResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OperatorServer).ReportResourceResults(&operatorReportResourceResultsServer{stream})
+}
+
+type Operator_ReportResourceResultsServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*ReportResourceResultsRequest, error)
+	grpc.ServerStream
+}
+
+type operatorReportResourceResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorReportResourceResultsServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *operatorReportResourceResultsServer) Recv() (*ReportResourceResultsRequest, error) {
+	m := new(ReportResourceResultsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Operator_ServiceDesc is the grpc.ServiceDesc for Operator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -892,6 +964,11 @@ var Operator_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Operator_WorkflowAccessPolicyUpdate_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ReportResourceResults",
+			Handler:       _Operator_ReportResourceResults_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "dapr/proto/operator/v1/operator.proto",
 }
@@ -173,6 +173,116 @@ func (x *InvokeBindingResponse) GetMetadata() map[string]string {
 	return nil
 }
 
+// PauseInputBindingRequest is the message to pause reading the events of an
+// input binding, until it is resumed.
+type PauseInputBindingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the input binding.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the input binding stays paused when the sidecar restarts. It
+	// requires the state store of the paused input bindings to be configured.
+	Persist bool `protobuf:"varint,2,opt,name=persist,proto3" json:"persist,omitempty"`
+}
+
+func (x *PauseInputBindingRequest) Reset() {
+	*x = PauseInputBindingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_binding_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseInputBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseInputBindingRequest) ProtoMessage() {}
+
+func (x *PauseInputBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_binding_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseInputBindingRequest.ProtoReflect.Descriptor instead.
+func (*PauseInputBindingRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_binding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PauseInputBindingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PauseInputBindingRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
+// ResumeInputBindingRequest is the message to resume reading the events of a
+// paused input binding.
+type ResumeInputBindingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the input binding.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ResumeInputBindingRequest) Reset() {
+	*x = ResumeInputBindingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_binding_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeInputBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeInputBindingRequest) ProtoMessage() {}
+
+func (x *ResumeInputBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_binding_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeInputBindingRequest.ProtoReflect.Descriptor instead.
+func (*ResumeInputBindingRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_binding_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResumeInputBindingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 var File_dapr_proto_runtime_v1_binding_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_runtime_v1_binding_proto_rawDesc = []byte{
@@ -207,14 +317,22 @@ var file_dapr_proto_runtime_v1_binding_proto_rawDesc = []byte{
 	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x42, 0x71, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31,
-	0x42, 0x12, 0x44, 0x61, 0x70, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x38, 0x01, 0x22, 0x48, 0x0a, 0x18, 0x50, 0x61, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x22, 0x2f, 0x0a, 0x19,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x71, 0x0a,
+	0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x44, 0x61, 0x70,
+	0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a,
+	0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72,
+	0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -229,16 +347,18 @@ func file_dapr_proto_runtime_v1_binding_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_binding_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_dapr_proto_runtime_v1_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_dapr_proto_runtime_v1_binding_proto_goTypes = []interface{}{
-	(*InvokeBindingRequest)(nil),  // 0: dapr.proto.runtime.v1.InvokeBindingRequest
-	(*InvokeBindingResponse)(nil), // 1: dapr.proto.runtime.v1.InvokeBindingResponse
-	nil,                           // 2: dapr.proto.runtime.v1.InvokeBindingRequest.MetadataEntry
-	nil,                           // 3: dapr.proto.runtime.v1.InvokeBindingResponse.MetadataEntry
+	(*InvokeBindingRequest)(nil),      // 0: dapr.proto.runtime.v1.InvokeBindingRequest
+	(*InvokeBindingResponse)(nil),     // 1: dapr.proto.runtime.v1.InvokeBindingResponse
+	(*PauseInputBindingRequest)(nil),  // 2: dapr.proto.runtime.v1.PauseInputBindingRequest
+	(*ResumeInputBindingRequest)(nil), // 3: dapr.proto.runtime.v1.ResumeInputBindingRequest
+	nil,                               // 4: dapr.proto.runtime.v1.InvokeBindingRequest.MetadataEntry
+	nil,                               // 5: dapr.proto.runtime.v1.InvokeBindingResponse.MetadataEntry
 }
 var file_dapr_proto_runtime_v1_binding_proto_depIdxs = []int32{
-	2, // 0: dapr.proto.runtime.v1.InvokeBindingRequest.metadata:type_name -> dapr.proto.runtime.v1.InvokeBindingRequest.MetadataEntry
-	3, // 1: dapr.proto.runtime.v1.InvokeBindingResponse.metadata:type_name -> dapr.proto.runtime.v1.InvokeBindingResponse.MetadataEntry
+	4, // 0: dapr.proto.runtime.v1.InvokeBindingRequest.metadata:type_name -> dapr.proto.runtime.v1.InvokeBindingRequest.MetadataEntry
+	5, // 1: dapr.proto.runtime.v1.InvokeBindingResponse.metadata:type_name -> dapr.proto.runtime.v1.InvokeBindingResponse.MetadataEntry
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -276,6 +396,30 @@ func file_dapr_proto_runtime_v1_binding_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_runtime_v1_binding_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseInputBindingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_binding_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeInputBindingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -283,7 +427,7 @@ func file_dapr_proto_runtime_v1_binding_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_binding_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -36,18 +36,29 @@ const (
 	Dapr_InvokeService_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeService"
 	Dapr_GetState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetState"
 	Dapr_GetBulkState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
+	Dapr_GetBulkStateStreamAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/GetBulkStateStreamAlpha1"
 	Dapr_SaveState_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/SaveState"
 	Dapr_QueryStateAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
+	Dapr_QueryStateBeta1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/QueryStateBeta1"
+	Dapr_WatchStateAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/WatchStateAlpha1"
 	Dapr_DeleteState_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/DeleteState"
 	Dapr_DeleteBulkState_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
 	Dapr_ExecuteStateTransaction_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
+	Dapr_MigrateStateAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/MigrateStateAlpha1"
 	Dapr_PublishEvent_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
 	Dapr_BulkPublishEventAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
 	Dapr_BulkPublishEvent_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/BulkPublishEvent"
 	Dapr_SubscribeTopicEventsAlpha1_FullMethodName     = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
+	Dapr_PauseSubscriptionAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/PauseSubscriptionAlpha1"
+	Dapr_ResumeSubscriptionAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/ResumeSubscriptionAlpha1"
 	Dapr_InvokeBinding_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
+	Dapr_PauseInputBindingAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/PauseInputBindingAlpha1"
+	Dapr_ResumeInputBindingAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/ResumeInputBindingAlpha1"
 	Dapr_GetSecret_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/GetSecret"
 	Dapr_GetBulkSecret_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
+	Dapr_GetBulkSecretStreamAlpha1_FullMethodName      = "/dapr.proto.runtime.v1.Dapr/GetBulkSecretStreamAlpha1"
+	Dapr_WatchSecretsAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/WatchSecretsAlpha1"
+	Dapr_PurgeSecretsCacheAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/PurgeSecretsCacheAlpha1"
 	Dapr_RegisterActorTimer_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
 	Dapr_UnregisterActorTimer_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimer"
 	Dapr_RegisterActorReminder_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/RegisterActorReminder"
@@ -67,10 +78,14 @@ const (
 	Dapr_UnsubscribeConfiguration_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
 	Dapr_TryLockAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
 	Dapr_UnlockAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
+	Dapr_RenewLockAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/RenewLockAlpha1"
+	Dapr_AcquireLockAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/AcquireLockAlpha1"
 	Dapr_EncryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
 	Dapr_DecryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
 	Dapr_GetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
 	Dapr_SetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
+	Dapr_GetActiveActorsAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/GetActiveActorsAlpha1"
+	Dapr_GetResiliencyAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/GetResiliencyAlpha1"
 	Dapr_SubtleGetKeyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
 	Dapr_SubtleEncryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleEncryptAlpha1"
 	Dapr_SubtleDecryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleDecryptAlpha1"
@@ -92,7 +107,13 @@ const (
 	Dapr_PauseWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
 	Dapr_ResumeWorkflowBeta1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
 	Dapr_RaiseEventWorkflowBeta1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
+	Dapr_ListWorkflowInstancesBeta1_FullMethodName     = "/dapr.proto.runtime.v1.Dapr/ListWorkflowInstancesBeta1"
+	Dapr_GetWorkflowHistoryBeta1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/GetWorkflowHistoryBeta1"
+	Dapr_ReplayWorkflowBeta1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ReplayWorkflowBeta1"
 	Dapr_Shutdown_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/Shutdown"
+	Dapr_ReloadAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/ReloadAlpha1"
+	Dapr_SetFaultAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/SetFaultAlpha1"
+	Dapr_DeleteFaultAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/DeleteFaultAlpha1"
 	Dapr_ScheduleJobAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
 	Dapr_ScheduleJob_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/ScheduleJob"
 	Dapr_GetJobAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
@@ -103,6 +124,8 @@ const (
 	Dapr_DeleteJobsByPrefix_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/DeleteJobsByPrefix"
 	Dapr_ListJobsAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ListJobsAlpha1"
 	Dapr_ListJobs_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/ListJobs"
+	Dapr_BulkScheduleJobs_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/BulkScheduleJobs"
+	Dapr_BulkDeleteJobs_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/BulkDeleteJobs"
 	Dapr_ConverseAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
 	Dapr_ConverseAlpha2_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha2"
 )
@@ -118,16 +141,27 @@ type DaprClient interface {
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
 	// Gets a bulk of state items for a list of keys
 	GetBulkState(ctx context.Context, in *GetBulkStateRequest, opts ...grpc.CallOption) (*GetBulkStateResponse, error)
+	// Gets a bulk of state items for a list of keys, streaming the items in
+	// chunks as they are read from the state store.
+	GetBulkStateStreamAlpha1(ctx context.Context, in *GetBulkStateStreamRequest, opts ...grpc.CallOption) (Dapr_GetBulkStateStreamAlpha1Client, error)
 	// Saves the state for a specific key.
 	SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Queries the state.
 	QueryStateAlpha1(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// Queries the state with the stable query grammar, optionally across
+	// multiple state stores.
+	QueryStateBeta1(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// Watches keys of a state store for changes.
+	WatchStateAlpha1(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (Dapr_WatchStateAlpha1Client, error)
 	// Deletes the state for a specific key.
 	DeleteState(ctx context.Context, in *DeleteStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deletes a bulk of state items for a list of keys
 	DeleteBulkState(ctx context.Context, in *DeleteBulkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(ctx context.Context, in *ExecuteStateTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Copies the state of this app from a state store to another, reporting
+	// the progress on the metadata API.
+	MigrateStateAlpha1(ctx context.Context, in *MigrateStateRequest, opts ...grpc.CallOption) (*MigrateStateResponse, error)
 	// Publishes events to the specific topic.
 	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deprecated: Do not use.
@@ -138,12 +172,26 @@ type DaprClient interface {
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error)
+	// Pauses the delivery of the messages of a topic to the app.
+	PauseSubscriptionAlpha1(ctx context.Context, in *PauseSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Resumes the delivery of the messages of a paused topic to the app.
+	ResumeSubscriptionAlpha1(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
+	// Pauses reading the events of an input binding.
+	PauseInputBindingAlpha1(ctx context.Context, in *PauseInputBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Resumes reading the events of a paused input binding.
+	ResumeInputBindingAlpha1(ctx context.Context, in *ResumeInputBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets secrets from secret stores.
 	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
 	// Gets a bulk of secrets
 	GetBulkSecret(ctx context.Context, in *GetBulkSecretRequest, opts ...grpc.CallOption) (*GetBulkSecretResponse, error)
+	// Gets a bulk of secrets, streamed in chunks.
+	GetBulkSecretStreamAlpha1(ctx context.Context, in *GetBulkSecretStreamRequest, opts ...grpc.CallOption) (Dapr_GetBulkSecretStreamAlpha1Client, error)
+	// Watches secrets of a secret store for changes.
+	WatchSecretsAlpha1(ctx context.Context, in *WatchSecretsRequest, opts ...grpc.CallOption) (Dapr_WatchSecretsAlpha1Client, error)
+	// Purges secrets from the cache of a secret store.
+	PurgeSecretsCacheAlpha1(ctx context.Context, in *PurgeSecretsCacheRequest, opts ...grpc.CallOption) (*PurgeSecretsCacheResponse, error)
 	// Register an actor timer.
 	RegisterActorTimer(ctx context.Context, in *RegisterActorTimerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Unregister an actor timer.
@@ -185,6 +233,12 @@ type DaprClient interface {
 	TryLockAlpha1(ctx context.Context, in *TryLockRequest, opts ...grpc.CallOption) (*TryLockResponse, error)
 	// UnlockAlpha1 unlocks a lock.
 	UnlockAlpha1(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// RenewLockAlpha1 extends the expiry of a lock held by the lock owner. Only
+	// lock stores which renew locks natively support it.
+	RenewLockAlpha1(ctx context.Context, in *RenewLockRequest, opts ...grpc.CallOption) (*RenewLockResponse, error)
+	// AcquireLockAlpha1 waits for a lock to be released to acquire it, in the
+	// order in which the lock was requested on the sidecar.
+	AcquireLockAlpha1(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (*AcquireLockResponse, error)
 	// EncryptAlpha1 encrypts a message using the Dapr encryption scheme and a key stored in the vault.
 	EncryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_EncryptAlpha1Client, error)
 	// DecryptAlpha1 decrypts a message using the Dapr encryption scheme and a key stored in the vault.
@@ -193,6 +247,12 @@ type DaprClient interface {
 	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
 	// Sets value in extended metadata of the sidecar
 	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetActiveActorsAlpha1 lists the counts per type of the actors active on
+	// this host, and optionally a page of their IDs.
+	GetActiveActorsAlpha1(ctx context.Context, in *GetActiveActorsRequest, opts ...grpc.CallOption) (*GetActiveActorsResponse, error)
+	// GetResiliencyAlpha1 lists the resiliency policies loaded by the sidecar,
+	// the targets they apply to, and the state of their circuit breakers.
+	GetResiliencyAlpha1(ctx context.Context, in *GetResiliencyRequest, opts ...grpc.CallOption) (*GetResiliencyResponse, error)
 	// SubtleGetKeyAlpha1 returns the public part of an asymmetric key stored in the vault.
 	SubtleGetKeyAlpha1(ctx context.Context, in *SubtleGetKeyRequest, opts ...grpc.CallOption) (*SubtleGetKeyResponse, error)
 	// SubtleEncryptAlpha1 encrypts a small message using a key stored in the vault.
@@ -242,8 +302,24 @@ type DaprClient interface {
 	ResumeWorkflowBeta1(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEventWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List workflow instances, filtered by status, name and creation time
+	ListWorkflowInstancesBeta1(ctx context.Context, in *ListWorkflowInstancesRequest, opts ...grpc.CallOption) (*ListWorkflowInstancesResponse, error)
+	// Export the full history of a workflow instance
+	GetWorkflowHistoryBeta1(ctx context.Context, in *GetWorkflowHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryResponse, error)
+	// Replay a completed workflow instance against the current workflow code to
+	// detect non-determinism
+	ReplayWorkflowBeta1(ctx context.Context, in *ReplayWorkflowRequest, opts ...grpc.CallOption) (*ReplayWorkflowResponse, error)
 	// Shutdown the sidecar
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reload the configuration and the resiliency policies of the sidecar
+	// without restarting it, in self-hosted mode
+	ReloadAlpha1(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error)
+	// SetFaultAlpha1 injects a fault in the calls of the sidecar to the apps,
+	// actors and components, or replaces the fault with the same name. Fault
+	// injection must be enabled in the configuration.
+	SetFaultAlpha1(ctx context.Context, in *SetFaultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteFaultAlpha1 stops injecting a fault.
+	DeleteFaultAlpha1(ctx context.Context, in *DeleteFaultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deprecated: Do not use.
 	// Deprecated: Create and schedule a job
 	ScheduleJobAlpha1(ctx context.Context, in *ScheduleJobRequest, opts ...grpc.CallOption) (*ScheduleJobResponse, error)
@@ -269,6 +345,10 @@ type DaprClient interface {
 	ListJobsAlpha1(ctx context.Context, in *ListJobsRequestAlpha1, opts ...grpc.CallOption) (*ListJobsResponseAlpha1, error)
 	// List all jobs
 	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// Create and schedule a batch of jobs, reporting the jobs which failed
+	BulkScheduleJobs(ctx context.Context, in *BulkScheduleJobsRequest, opts ...grpc.CallOption) (*BulkScheduleJobsResponse, error)
+	// Delete a batch of jobs, reporting the jobs which failed
+	BulkDeleteJobs(ctx context.Context, in *BulkDeleteJobsRequest, opts ...grpc.CallOption) (*BulkDeleteJobsResponse, error)
 	// Converse with a LLM service
 	ConverseAlpha1(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
 	// Converse with a LLM service via alpha2 api
@@ -310,6 +390,38 @@ func (c *daprClient) GetBulkState(ctx context.Context, in *GetBulkStateRequest,
 	return out, nil
 }
 
+func (c *daprClient) GetBulkStateStreamAlpha1(ctx context.Context, in *GetBulkStateStreamRequest, opts ...grpc.CallOption) (Dapr_GetBulkStateStreamAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], Dapr_GetBulkStateStreamAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprGetBulkStateStreamAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_GetBulkStateStreamAlpha1Client interface {
+	Recv() (*GetBulkStateStreamResponse, error)
+	grpc.ClientStream
+}
+
+type daprGetBulkStateStreamAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprGetBulkStateStreamAlpha1Client) Recv() (*GetBulkStateStreamResponse, error) {
+	m := new(GetBulkStateStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daprClient) SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_SaveState_FullMethodName, in, out, opts...)
@@ -328,6 +440,47 @@ func (c *daprClient) QueryStateAlpha1(ctx context.Context, in *QueryStateRequest
 	return out, nil
 }
 
+func (c *daprClient) QueryStateBeta1(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error) {
+	out := new(QueryStateResponse)
+	err := c.cc.Invoke(ctx, Dapr_QueryStateBeta1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) WatchStateAlpha1(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (Dapr_WatchStateAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], Dapr_WatchStateAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprWatchStateAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_WatchStateAlpha1Client interface {
+	Recv() (*WatchStateResponse, error)
+	grpc.ClientStream
+}
+
+type daprWatchStateAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprWatchStateAlpha1Client) Recv() (*WatchStateResponse, error) {
+	m := new(WatchStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daprClient) DeleteState(ctx context.Context, in *DeleteStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_DeleteState_FullMethodName, in, out, opts...)
@@ -355,6 +508,15 @@ func (c *daprClient) ExecuteStateTransaction(ctx context.Context, in *ExecuteSta
 	return out, nil
 }
 
+func (c *daprClient) MigrateStateAlpha1(ctx context.Context, in *MigrateStateRequest, opts ...grpc.CallOption) (*MigrateStateResponse, error) {
+	out := new(MigrateStateResponse)
+	err := c.cc.Invoke(ctx, Dapr_MigrateStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_PublishEvent_FullMethodName, in, out, opts...)
@@ -384,7 +546,7 @@ func (c *daprClient) BulkPublishEvent(ctx context.Context, in *BulkPublishReques
 }
 
 func (c *daprClient) SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[0], Dapr_SubscribeTopicEventsAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], Dapr_SubscribeTopicEventsAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -414,6 +576,24 @@ func (x *daprSubscribeTopicEventsAlpha1Client) Recv() (*SubscribeTopicEventsResp
 	return m, nil
 }
 
+func (c *daprClient) PauseSubscriptionAlpha1(ctx context.Context, in *PauseSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_PauseSubscriptionAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ResumeSubscriptionAlpha1(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_ResumeSubscriptionAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error) {
 	out := new(InvokeBindingResponse)
 	err := c.cc.Invoke(ctx, Dapr_InvokeBinding_FullMethodName, in, out, opts...)
@@ -423,6 +603,24 @@ func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest
 	return out, nil
 }
 
+func (c *daprClient) PauseInputBindingAlpha1(ctx context.Context, in *PauseInputBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_PauseInputBindingAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ResumeInputBindingAlpha1(ctx context.Context, in *ResumeInputBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_ResumeInputBindingAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
 	out := new(GetSecretResponse)
 	err := c.cc.Invoke(ctx, Dapr_GetSecret_FullMethodName, in, out, opts...)
@@ -441,6 +639,79 @@ func (c *daprClient) GetBulkSecret(ctx context.Context, in *GetBulkSecretRequest
 	return out, nil
 }
 
+func (c *daprClient) GetBulkSecretStreamAlpha1(ctx context.Context, in *GetBulkSecretStreamRequest, opts ...grpc.CallOption) (Dapr_GetBulkSecretStreamAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], Dapr_GetBulkSecretStreamAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprGetBulkSecretStreamAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_GetBulkSecretStreamAlpha1Client interface {
+	Recv() (*GetBulkSecretStreamResponse, error)
+	grpc.ClientStream
+}
+
+type daprGetBulkSecretStreamAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprGetBulkSecretStreamAlpha1Client) Recv() (*GetBulkSecretStreamResponse, error) {
+	m := new(GetBulkSecretStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daprClient) WatchSecretsAlpha1(ctx context.Context, in *WatchSecretsRequest, opts ...grpc.CallOption) (Dapr_WatchSecretsAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[4], Dapr_WatchSecretsAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprWatchSecretsAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_WatchSecretsAlpha1Client interface {
+	Recv() (*WatchSecretsResponse, error)
+	grpc.ClientStream
+}
+
+type daprWatchSecretsAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprWatchSecretsAlpha1Client) Recv() (*WatchSecretsResponse, error) {
+	m := new(WatchSecretsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daprClient) PurgeSecretsCacheAlpha1(ctx context.Context, in *PurgeSecretsCacheRequest, opts ...grpc.CallOption) (*PurgeSecretsCacheResponse, error) {
+	out := new(PurgeSecretsCacheResponse)
+	err := c.cc.Invoke(ctx, Dapr_PurgeSecretsCacheAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) RegisterActorTimer(ctx context.Context, in *RegisterActorTimerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_RegisterActorTimer_FullMethodName, in, out, opts...)
@@ -532,7 +803,7 @@ func (c *daprClient) InvokeActor(ctx context.Context, in *InvokeActorRequest, op
 }
 
 func (c *daprClient) SubscribeActorEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeActorEventsAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[1], Dapr_SubscribeActorEventsAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[5], Dapr_SubscribeActorEventsAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -581,7 +852,7 @@ func (c *daprClient) GetConfiguration(ctx context.Context, in *GetConfigurationR
 }
 
 func (c *daprClient) SubscribeConfigurationAlpha1(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[2], Dapr_SubscribeConfigurationAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[6], Dapr_SubscribeConfigurationAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -613,7 +884,7 @@ func (x *daprSubscribeConfigurationAlpha1Client) Recv() (*SubscribeConfiguration
 }
 
 func (c *daprClient) SubscribeConfiguration(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (Dapr_SubscribeConfigurationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[3], Dapr_SubscribeConfiguration_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[7], Dapr_SubscribeConfiguration_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -680,8 +951,26 @@ func (c *daprClient) UnlockAlpha1(ctx context.Context, in *UnlockRequest, opts .
 	return out, nil
 }
 
+func (c *daprClient) RenewLockAlpha1(ctx context.Context, in *RenewLockRequest, opts ...grpc.CallOption) (*RenewLockResponse, error) {
+	out := new(RenewLockResponse)
+	err := c.cc.Invoke(ctx, Dapr_RenewLockAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) AcquireLockAlpha1(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (*AcquireLockResponse, error) {
+	out := new(AcquireLockResponse)
+	err := c.cc.Invoke(ctx, Dapr_AcquireLockAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) EncryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_EncryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[4], Dapr_EncryptAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[8], Dapr_EncryptAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -712,7 +1001,7 @@ func (x *daprEncryptAlpha1Client) Recv() (*EncryptResponse, error) {
 }
 
 func (c *daprClient) DecryptAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_DecryptAlpha1Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[5], Dapr_DecryptAlpha1_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[9], Dapr_DecryptAlpha1_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -760,6 +1049,24 @@ func (c *daprClient) SetMetadata(ctx context.Context, in *SetMetadataRequest, op
 	return out, nil
 }
 
+func (c *daprClient) GetActiveActorsAlpha1(ctx context.Context, in *GetActiveActorsRequest, opts ...grpc.CallOption) (*GetActiveActorsResponse, error) {
+	out := new(GetActiveActorsResponse)
+	err := c.cc.Invoke(ctx, Dapr_GetActiveActorsAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetResiliencyAlpha1(ctx context.Context, in *GetResiliencyRequest, opts ...grpc.CallOption) (*GetResiliencyResponse, error) {
+	out := new(GetResiliencyResponse)
+	err := c.cc.Invoke(ctx, Dapr_GetResiliencyAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) SubtleGetKeyAlpha1(ctx context.Context, in *SubtleGetKeyRequest, opts ...grpc.CallOption) (*SubtleGetKeyResponse, error) {
 	out := new(SubtleGetKeyResponse)
 	err := c.cc.Invoke(ctx, Dapr_SubtleGetKeyAlpha1_FullMethodName, in, out, opts...)
@@ -956,6 +1263,33 @@ func (c *daprClient) RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEvent
 	return out, nil
 }
 
+func (c *daprClient) ListWorkflowInstancesBeta1(ctx context.Context, in *ListWorkflowInstancesRequest, opts ...grpc.CallOption) (*ListWorkflowInstancesResponse, error) {
+	out := new(ListWorkflowInstancesResponse)
+	err := c.cc.Invoke(ctx, Dapr_ListWorkflowInstancesBeta1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetWorkflowHistoryBeta1(ctx context.Context, in *GetWorkflowHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryResponse, error) {
+	out := new(GetWorkflowHistoryResponse)
+	err := c.cc.Invoke(ctx, Dapr_GetWorkflowHistoryBeta1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ReplayWorkflowBeta1(ctx context.Context, in *ReplayWorkflowRequest, opts ...grpc.CallOption) (*ReplayWorkflowResponse, error) {
+	out := new(ReplayWorkflowResponse)
+	err := c.cc.Invoke(ctx, Dapr_ReplayWorkflowBeta1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_Shutdown_FullMethodName, in, out, opts...)
@@ -965,6 +1299,33 @@ func (c *daprClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...
 	return out, nil
 }
 
+func (c *daprClient) ReloadAlpha1(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error) {
+	out := new(ReloadResponse)
+	err := c.cc.Invoke(ctx, Dapr_ReloadAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) SetFaultAlpha1(ctx context.Context, in *SetFaultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_SetFaultAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) DeleteFaultAlpha1(ctx context.Context, in *DeleteFaultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_DeleteFaultAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *daprClient) ScheduleJobAlpha1(ctx context.Context, in *ScheduleJobRequest, opts ...grpc.CallOption) (*ScheduleJobResponse, error) {
 	out := new(ScheduleJobResponse)
@@ -1060,6 +1421,24 @@ func (c *daprClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...
 	return out, nil
 }
 
+func (c *daprClient) BulkScheduleJobs(ctx context.Context, in *BulkScheduleJobsRequest, opts ...grpc.CallOption) (*BulkScheduleJobsResponse, error) {
+	out := new(BulkScheduleJobsResponse)
+	err := c.cc.Invoke(ctx, Dapr_BulkScheduleJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) BulkDeleteJobs(ctx context.Context, in *BulkDeleteJobsRequest, opts ...grpc.CallOption) (*BulkDeleteJobsResponse, error) {
+	out := new(BulkDeleteJobsResponse)
+	err := c.cc.Invoke(ctx, Dapr_BulkDeleteJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) ConverseAlpha1(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
 	out := new(ConversationResponse)
 	err := c.cc.Invoke(ctx, Dapr_ConverseAlpha1_FullMethodName, in, out, opts...)
@@ -1089,16 +1468,27 @@ type DaprServer interface {
 	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
 	// Gets a bulk of state items for a list of keys
 	GetBulkState(context.Context, *GetBulkStateRequest) (*GetBulkStateResponse, error)
+	// Gets a bulk of state items for a list of keys, streaming the items in
+	// chunks as they are read from the state store.
+	GetBulkStateStreamAlpha1(*GetBulkStateStreamRequest, Dapr_GetBulkStateStreamAlpha1Server) error
 	// Saves the state for a specific key.
 	SaveState(context.Context, *SaveStateRequest) (*emptypb.Empty, error)
 	// Queries the state.
 	QueryStateAlpha1(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// Queries the state with the stable query grammar, optionally across
+	// multiple state stores.
+	QueryStateBeta1(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// Watches keys of a state store for changes.
+	WatchStateAlpha1(*WatchStateRequest, Dapr_WatchStateAlpha1Server) error
 	// Deletes the state for a specific key.
 	DeleteState(context.Context, *DeleteStateRequest) (*emptypb.Empty, error)
 	// Deletes a bulk of state items for a list of keys
 	DeleteBulkState(context.Context, *DeleteBulkStateRequest) (*emptypb.Empty, error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error)
+	// Copies the state of this app from a state store to another, reporting
+	// the progress on the metadata API.
+	MigrateStateAlpha1(context.Context, *MigrateStateRequest) (*MigrateStateResponse, error)
 	// Publishes events to the specific topic.
 	PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error)
 	// Deprecated: Do not use.
@@ -1109,12 +1499,26 @@ type DaprServer interface {
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error
+	// Pauses the delivery of the messages of a topic to the app.
+	PauseSubscriptionAlpha1(context.Context, *PauseSubscriptionRequest) (*emptypb.Empty, error)
+	// Resumes the delivery of the messages of a paused topic to the app.
+	ResumeSubscriptionAlpha1(context.Context, *ResumeSubscriptionRequest) (*emptypb.Empty, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
+	// Pauses reading the events of an input binding.
+	PauseInputBindingAlpha1(context.Context, *PauseInputBindingRequest) (*emptypb.Empty, error)
+	// Resumes reading the events of a paused input binding.
+	ResumeInputBindingAlpha1(context.Context, *ResumeInputBindingRequest) (*emptypb.Empty, error)
 	// Gets secrets from secret stores.
 	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
 	// Gets a bulk of secrets
 	GetBulkSecret(context.Context, *GetBulkSecretRequest) (*GetBulkSecretResponse, error)
+	// Gets a bulk of secrets, streamed in chunks.
+	GetBulkSecretStreamAlpha1(*GetBulkSecretStreamRequest, Dapr_GetBulkSecretStreamAlpha1Server) error
+	// Watches secrets of a secret store for changes.
+	WatchSecretsAlpha1(*WatchSecretsRequest, Dapr_WatchSecretsAlpha1Server) error
+	// Purges secrets from the cache of a secret store.
+	PurgeSecretsCacheAlpha1(context.Context, *PurgeSecretsCacheRequest) (*PurgeSecretsCacheResponse, error)
 	// Register an actor timer.
 	RegisterActorTimer(context.Context, *RegisterActorTimerRequest) (*emptypb.Empty, error)
 	// Unregister an actor timer.
@@ -1156,6 +1560,12 @@ type DaprServer interface {
 	TryLockAlpha1(context.Context, *TryLockRequest) (*TryLockResponse, error)
 	// UnlockAlpha1 unlocks a lock.
 	UnlockAlpha1(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// RenewLockAlpha1 extends the expiry of a lock held by the lock owner. Only
+	// lock stores which renew locks natively support it.
+	RenewLockAlpha1(context.Context, *RenewLockRequest) (*RenewLockResponse, error)
+	// AcquireLockAlpha1 waits for a lock to be released to acquire it, in the
+	// order in which the lock was requested on the sidecar.
+	AcquireLockAlpha1(context.Context, *AcquireLockRequest) (*AcquireLockResponse, error)
 	// EncryptAlpha1 encrypts a message using the Dapr encryption scheme and a key stored in the vault.
 	EncryptAlpha1(Dapr_EncryptAlpha1Server) error
 	// DecryptAlpha1 decrypts a message using the Dapr encryption scheme and a key stored in the vault.
@@ -1164,6 +1574,12 @@ type DaprServer interface {
 	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
 	// Sets value in extended metadata of the sidecar
 	SetMetadata(context.Context, *SetMetadataRequest) (*emptypb.Empty, error)
+	// GetActiveActorsAlpha1 lists the counts per type of the actors active on
+	// this host, and optionally a page of their IDs.
+	GetActiveActorsAlpha1(context.Context, *GetActiveActorsRequest) (*GetActiveActorsResponse, error)
+	// GetResiliencyAlpha1 lists the resiliency policies loaded by the sidecar,
+	// the targets they apply to, and the state of their circuit breakers.
+	GetResiliencyAlpha1(context.Context, *GetResiliencyRequest) (*GetResiliencyResponse, error)
 	// SubtleGetKeyAlpha1 returns the public part of an asymmetric key stored in the vault.
 	SubtleGetKeyAlpha1(context.Context, *SubtleGetKeyRequest) (*SubtleGetKeyResponse, error)
 	// SubtleEncryptAlpha1 encrypts a small message using a key stored in the vault.
@@ -1213,8 +1629,24 @@ type DaprServer interface {
 	ResumeWorkflowBeta1(context.Context, *ResumeWorkflowRequest) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error)
+	// List workflow instances, filtered by status, name and creation time
+	ListWorkflowInstancesBeta1(context.Context, *ListWorkflowInstancesRequest) (*ListWorkflowInstancesResponse, error)
+	// Export the full history of a workflow instance
+	GetWorkflowHistoryBeta1(context.Context, *GetWorkflowHistoryRequest) (*GetWorkflowHistoryResponse, error)
+	// Replay a completed workflow instance against the current workflow code to
+	// detect non-determinism
+	ReplayWorkflowBeta1(context.Context, *ReplayWorkflowRequest) (*ReplayWorkflowResponse, error)
 	// Shutdown the sidecar
 	Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error)
+	// Reload the configuration and the resiliency policies of the sidecar
+	// without restarting it, in self-hosted mode
+	ReloadAlpha1(context.Context, *ReloadRequest) (*ReloadResponse, error)
+	// SetFaultAlpha1 injects a fault in the calls of the sidecar to the apps,
+	// actors and components, or replaces the fault with the same name. Fault
+	// injection must be enabled in the configuration.
+	SetFaultAlpha1(context.Context, *SetFaultRequest) (*emptypb.Empty, error)
+	// DeleteFaultAlpha1 stops injecting a fault.
+	DeleteFaultAlpha1(context.Context, *DeleteFaultRequest) (*emptypb.Empty, error)
 	// Deprecated: Do not use.
 	// Deprecated: Create and schedule a job
 	ScheduleJobAlpha1(context.Context, *ScheduleJobRequest) (*ScheduleJobResponse, error)
@@ -1240,6 +1672,10 @@ type DaprServer interface {
 	ListJobsAlpha1(context.Context, *ListJobsRequestAlpha1) (*ListJobsResponseAlpha1, error)
 	// List all jobs
 	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// Create and schedule a batch of jobs, reporting the jobs which failed
+	BulkScheduleJobs(context.Context, *BulkScheduleJobsRequest) (*BulkScheduleJobsResponse, error)
+	// Delete a batch of jobs, reporting the jobs which failed
+	BulkDeleteJobs(context.Context, *BulkDeleteJobsRequest) (*BulkDeleteJobsResponse, error)
 	// Converse with a LLM service
 	ConverseAlpha1(context.Context, *ConversationRequest) (*ConversationResponse, error)
 	// Converse with a LLM service via alpha2 api
@@ -1259,12 +1695,21 @@ func (UnimplementedDaprServer) GetState(context.Context, *GetStateRequest) (*Get
 func (UnimplementedDaprServer) GetBulkState(context.Context, *GetBulkStateRequest) (*GetBulkStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBulkState not implemented")
 }
+func (UnimplementedDaprServer) GetBulkStateStreamAlpha1(*GetBulkStateStreamRequest, Dapr_GetBulkStateStreamAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method GetBulkStateStreamAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) SaveState(context.Context, *SaveStateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveState not implemented")
 }
 func (UnimplementedDaprServer) QueryStateAlpha1(context.Context, *QueryStateRequest) (*QueryStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryStateAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) QueryStateBeta1(context.Context, *QueryStateRequest) (*QueryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStateBeta1 not implemented")
+}
+func (UnimplementedDaprServer) WatchStateAlpha1(*WatchStateRequest, Dapr_WatchStateAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStateAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) DeleteState(context.Context, *DeleteStateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteState not implemented")
 }
@@ -1274,6 +1719,9 @@ func (UnimplementedDaprServer) DeleteBulkState(context.Context, *DeleteBulkState
 func (UnimplementedDaprServer) ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteStateTransaction not implemented")
 }
+func (UnimplementedDaprServer) MigrateStateAlpha1(context.Context, *MigrateStateRequest) (*MigrateStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateStateAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
 }
@@ -1286,15 +1734,36 @@ func (UnimplementedDaprServer) BulkPublishEvent(context.Context, *BulkPublishReq
 func (UnimplementedDaprServer) SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeTopicEventsAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) PauseSubscriptionAlpha1(context.Context, *PauseSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSubscriptionAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ResumeSubscriptionAlpha1(context.Context, *ResumeSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscriptionAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
+func (UnimplementedDaprServer) PauseInputBindingAlpha1(context.Context, *PauseInputBindingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseInputBindingAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ResumeInputBindingAlpha1(context.Context, *ResumeInputBindingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInputBindingAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
 }
 func (UnimplementedDaprServer) GetBulkSecret(context.Context, *GetBulkSecretRequest) (*GetBulkSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBulkSecret not implemented")
 }
+func (UnimplementedDaprServer) GetBulkSecretStreamAlpha1(*GetBulkSecretStreamRequest, Dapr_GetBulkSecretStreamAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method GetBulkSecretStreamAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) WatchSecretsAlpha1(*WatchSecretsRequest, Dapr_WatchSecretsAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSecretsAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) PurgeSecretsCacheAlpha1(context.Context, *PurgeSecretsCacheRequest) (*PurgeSecretsCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeSecretsCacheAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) RegisterActorTimer(context.Context, *RegisterActorTimerRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterActorTimer not implemented")
 }
@@ -1352,6 +1821,12 @@ func (UnimplementedDaprServer) TryLockAlpha1(context.Context, *TryLockRequest) (
 func (UnimplementedDaprServer) UnlockAlpha1(context.Context, *UnlockRequest) (*UnlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) RenewLockAlpha1(context.Context, *RenewLockRequest) (*RenewLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLockAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) AcquireLockAlpha1(context.Context, *AcquireLockRequest) (*AcquireLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLockAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) EncryptAlpha1(Dapr_EncryptAlpha1Server) error {
 	return status.Errorf(codes.Unimplemented, "method EncryptAlpha1 not implemented")
 }
@@ -1364,6 +1839,12 @@ func (UnimplementedDaprServer) GetMetadata(context.Context, *GetMetadataRequest)
 func (UnimplementedDaprServer) SetMetadata(context.Context, *SetMetadataRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
 }
+func (UnimplementedDaprServer) GetActiveActorsAlpha1(context.Context, *GetActiveActorsRequest) (*GetActiveActorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveActorsAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) GetResiliencyAlpha1(context.Context, *GetResiliencyRequest) (*GetResiliencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResiliencyAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) SubtleGetKeyAlpha1(context.Context, *SubtleGetKeyRequest) (*SubtleGetKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubtleGetKeyAlpha1 not implemented")
 }
@@ -1427,9 +1908,27 @@ func (UnimplementedDaprServer) ResumeWorkflowBeta1(context.Context, *ResumeWorkf
 func (UnimplementedDaprServer) RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RaiseEventWorkflowBeta1 not implemented")
 }
+func (UnimplementedDaprServer) ListWorkflowInstancesBeta1(context.Context, *ListWorkflowInstancesRequest) (*ListWorkflowInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowInstancesBeta1 not implemented")
+}
+func (UnimplementedDaprServer) GetWorkflowHistoryBeta1(context.Context, *GetWorkflowHistoryRequest) (*GetWorkflowHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowHistoryBeta1 not implemented")
+}
+func (UnimplementedDaprServer) ReplayWorkflowBeta1(context.Context, *ReplayWorkflowRequest) (*ReplayWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWorkflowBeta1 not implemented")
+}
 func (UnimplementedDaprServer) Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
 }
+func (UnimplementedDaprServer) ReloadAlpha1(context.Context, *ReloadRequest) (*ReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) SetFaultAlpha1(context.Context, *SetFaultRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFaultAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) DeleteFaultAlpha1(context.Context, *DeleteFaultRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFaultAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) ScheduleJobAlpha1(context.Context, *ScheduleJobRequest) (*ScheduleJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleJobAlpha1 not implemented")
 }
@@ -1460,6 +1959,12 @@ func (UnimplementedDaprServer) ListJobsAlpha1(context.Context, *ListJobsRequestA
 func (UnimplementedDaprServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
 }
+func (UnimplementedDaprServer) BulkScheduleJobs(context.Context, *BulkScheduleJobsRequest) (*BulkScheduleJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkScheduleJobs not implemented")
+}
+func (UnimplementedDaprServer) BulkDeleteJobs(context.Context, *BulkDeleteJobsRequest) (*BulkDeleteJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteJobs not implemented")
+}
 func (UnimplementedDaprServer) ConverseAlpha1(context.Context, *ConversationRequest) (*ConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConverseAlpha1 not implemented")
 }
@@ -1532,6 +2037,27 @@ func _Dapr_GetBulkState_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_GetBulkStateStreamAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBulkStateStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).GetBulkStateStreamAlpha1(m, &daprGetBulkStateStreamAlpha1Server{stream})
+}
+
+type Dapr_GetBulkStateStreamAlpha1Server interface {
+	Send(*GetBulkStateStreamResponse) error
+	grpc.ServerStream
+}
+
+type daprGetBulkStateStreamAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprGetBulkStateStreamAlpha1Server) Send(m *GetBulkStateStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Dapr_SaveState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SaveStateRequest)
 	if err := dec(in); err != nil {
@@ -1568,6 +2094,45 @@ func _Dapr_QueryStateAlpha1_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_QueryStateBeta1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).QueryStateBeta1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_QueryStateBeta1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).QueryStateBeta1(ctx, req.(*QueryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_WatchStateAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).WatchStateAlpha1(m, &daprWatchStateAlpha1Server{stream})
+}
+
+type Dapr_WatchStateAlpha1Server interface {
+	Send(*WatchStateResponse) error
+	grpc.ServerStream
+}
+
+type daprWatchStateAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprWatchStateAlpha1Server) Send(m *WatchStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Dapr_DeleteState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteStateRequest)
 	if err := dec(in); err != nil {
@@ -1622,6 +2187,24 @@ func _Dapr_ExecuteStateTransaction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_MigrateStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).MigrateStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_MigrateStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).MigrateStateAlpha1(ctx, req.(*MigrateStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PublishEventRequest)
 	if err := dec(in); err != nil {
@@ -1699,23 +2282,95 @@ func (x *daprSubscribeTopicEventsAlpha1Server) Recv() (*SubscribeTopicEventsRequ
 	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return m, nil
+	return m, nil
+}
+
+func _Dapr_PauseSubscriptionAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).PauseSubscriptionAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_PauseSubscriptionAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).PauseSubscriptionAlpha1(ctx, req.(*PauseSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ResumeSubscriptionAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ResumeSubscriptionAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ResumeSubscriptionAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ResumeSubscriptionAlpha1(ctx, req.(*ResumeSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).InvokeBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_InvokeBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).InvokeBinding(ctx, req.(*InvokeBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_PauseInputBindingAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseInputBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).PauseInputBindingAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_PauseInputBindingAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).PauseInputBindingAlpha1(ctx, req.(*PauseInputBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Dapr_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvokeBindingRequest)
+func _Dapr_ResumeInputBindingAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeInputBindingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaprServer).InvokeBinding(ctx, in)
+		return srv.(DaprServer).ResumeInputBindingAlpha1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Dapr_InvokeBinding_FullMethodName,
+		FullMethod: Dapr_ResumeInputBindingAlpha1_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaprServer).InvokeBinding(ctx, req.(*InvokeBindingRequest))
+		return srv.(DaprServer).ResumeInputBindingAlpha1(ctx, req.(*ResumeInputBindingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1756,6 +2411,66 @@ func _Dapr_GetBulkSecret_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_GetBulkSecretStreamAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBulkSecretStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).GetBulkSecretStreamAlpha1(m, &daprGetBulkSecretStreamAlpha1Server{stream})
+}
+
+type Dapr_GetBulkSecretStreamAlpha1Server interface {
+	Send(*GetBulkSecretStreamResponse) error
+	grpc.ServerStream
+}
+
+type daprGetBulkSecretStreamAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprGetBulkSecretStreamAlpha1Server) Send(m *GetBulkSecretStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_WatchSecretsAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSecretsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).WatchSecretsAlpha1(m, &daprWatchSecretsAlpha1Server{stream})
+}
+
+type Dapr_WatchSecretsAlpha1Server interface {
+	Send(*WatchSecretsResponse) error
+	grpc.ServerStream
+}
+
+type daprWatchSecretsAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprWatchSecretsAlpha1Server) Send(m *WatchSecretsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_PurgeSecretsCacheAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeSecretsCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).PurgeSecretsCacheAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_PurgeSecretsCacheAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).PurgeSecretsCacheAlpha1(ctx, req.(*PurgeSecretsCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_RegisterActorTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterActorTimerRequest)
 	if err := dec(in); err != nil {
@@ -2112,6 +2827,42 @@ func _Dapr_UnlockAlpha1_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_RenewLockAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).RenewLockAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_RenewLockAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).RenewLockAlpha1(ctx, req.(*RenewLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_AcquireLockAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).AcquireLockAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_AcquireLockAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).AcquireLockAlpha1(ctx, req.(*AcquireLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_EncryptAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(DaprServer).EncryptAlpha1(&daprEncryptAlpha1Server{stream})
 }
@@ -2200,6 +2951,42 @@ func _Dapr_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_GetActiveActorsAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveActorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetActiveActorsAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GetActiveActorsAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetActiveActorsAlpha1(ctx, req.(*GetActiveActorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetResiliencyAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResiliencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetResiliencyAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GetResiliencyAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetResiliencyAlpha1(ctx, req.(*GetResiliencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_SubtleGetKeyAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubtleGetKeyRequest)
 	if err := dec(in); err != nil {
@@ -2578,6 +3365,60 @@ func _Dapr_RaiseEventWorkflowBeta1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ListWorkflowInstancesBeta1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListWorkflowInstancesBeta1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ListWorkflowInstancesBeta1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListWorkflowInstancesBeta1(ctx, req.(*ListWorkflowInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetWorkflowHistoryBeta1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetWorkflowHistoryBeta1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GetWorkflowHistoryBeta1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetWorkflowHistoryBeta1(ctx, req.(*GetWorkflowHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ReplayWorkflowBeta1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ReplayWorkflowBeta1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ReplayWorkflowBeta1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ReplayWorkflowBeta1(ctx, req.(*ReplayWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
@@ -2596,6 +3437,60 @@ func _Dapr_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ReloadAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ReloadAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ReloadAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ReloadAlpha1(ctx, req.(*ReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_SetFaultAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).SetFaultAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_SetFaultAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).SetFaultAlpha1(ctx, req.(*SetFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_DeleteFaultAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).DeleteFaultAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_DeleteFaultAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).DeleteFaultAlpha1(ctx, req.(*DeleteFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_ScheduleJobAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ScheduleJobRequest)
 	if err := dec(in); err != nil {
@@ -2776,6 +3671,42 @@ func _Dapr_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_BulkScheduleJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkScheduleJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).BulkScheduleJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_BulkScheduleJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).BulkScheduleJobs(ctx, req.(*BulkScheduleJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_BulkDeleteJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).BulkDeleteJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_BulkDeleteJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).BulkDeleteJobs(ctx, req.(*BulkDeleteJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_ConverseAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConversationRequest)
 	if err := dec(in); err != nil {
@@ -2839,6 +3770,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryStateAlpha1",
 			Handler:    _Dapr_QueryStateAlpha1_Handler,
 		},
+		{
+			MethodName: "QueryStateBeta1",
+			Handler:    _Dapr_QueryStateBeta1_Handler,
+		},
 		{
 			MethodName: "DeleteState",
 			Handler:    _Dapr_DeleteState_Handler,
@@ -2851,6 +3786,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteStateTransaction",
 			Handler:    _Dapr_ExecuteStateTransaction_Handler,
 		},
+		{
+			MethodName: "MigrateStateAlpha1",
+			Handler:    _Dapr_MigrateStateAlpha1_Handler,
+		},
 		{
 			MethodName: "PublishEvent",
 			Handler:    _Dapr_PublishEvent_Handler,
@@ -2863,10 +3802,26 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkPublishEvent",
 			Handler:    _Dapr_BulkPublishEvent_Handler,
 		},
+		{
+			MethodName: "PauseSubscriptionAlpha1",
+			Handler:    _Dapr_PauseSubscriptionAlpha1_Handler,
+		},
+		{
+			MethodName: "ResumeSubscriptionAlpha1",
+			Handler:    _Dapr_ResumeSubscriptionAlpha1_Handler,
+		},
 		{
 			MethodName: "InvokeBinding",
 			Handler:    _Dapr_InvokeBinding_Handler,
 		},
+		{
+			MethodName: "PauseInputBindingAlpha1",
+			Handler:    _Dapr_PauseInputBindingAlpha1_Handler,
+		},
+		{
+			MethodName: "ResumeInputBindingAlpha1",
+			Handler:    _Dapr_ResumeInputBindingAlpha1_Handler,
+		},
 		{
 			MethodName: "GetSecret",
 			Handler:    _Dapr_GetSecret_Handler,
@@ -2875,6 +3830,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBulkSecret",
 			Handler:    _Dapr_GetBulkSecret_Handler,
 		},
+		{
+			MethodName: "PurgeSecretsCacheAlpha1",
+			Handler:    _Dapr_PurgeSecretsCacheAlpha1_Handler,
+		},
 		{
 			MethodName: "RegisterActorTimer",
 			Handler:    _Dapr_RegisterActorTimer_Handler,
@@ -2939,6 +3898,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlockAlpha1",
 			Handler:    _Dapr_UnlockAlpha1_Handler,
 		},
+		{
+			MethodName: "RenewLockAlpha1",
+			Handler:    _Dapr_RenewLockAlpha1_Handler,
+		},
+		{
+			MethodName: "AcquireLockAlpha1",
+			Handler:    _Dapr_AcquireLockAlpha1_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _Dapr_GetMetadata_Handler,
@@ -2947,6 +3914,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMetadata",
 			Handler:    _Dapr_SetMetadata_Handler,
 		},
+		{
+			MethodName: "GetActiveActorsAlpha1",
+			Handler:    _Dapr_GetActiveActorsAlpha1_Handler,
+		},
+		{
+			MethodName: "GetResiliencyAlpha1",
+			Handler:    _Dapr_GetResiliencyAlpha1_Handler,
+		},
 		{
 			MethodName: "SubtleGetKeyAlpha1",
 			Handler:    _Dapr_SubtleGetKeyAlpha1_Handler,
@@ -3031,10 +4006,34 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RaiseEventWorkflowBeta1",
 			Handler:    _Dapr_RaiseEventWorkflowBeta1_Handler,
 		},
+		{
+			MethodName: "ListWorkflowInstancesBeta1",
+			Handler:    _Dapr_ListWorkflowInstancesBeta1_Handler,
+		},
+		{
+			MethodName: "GetWorkflowHistoryBeta1",
+			Handler:    _Dapr_GetWorkflowHistoryBeta1_Handler,
+		},
+		{
+			MethodName: "ReplayWorkflowBeta1",
+			Handler:    _Dapr_ReplayWorkflowBeta1_Handler,
+		},
 		{
 			MethodName: "Shutdown",
 			Handler:    _Dapr_Shutdown_Handler,
 		},
+		{
+			MethodName: "ReloadAlpha1",
+			Handler:    _Dapr_ReloadAlpha1_Handler,
+		},
+		{
+			MethodName: "SetFaultAlpha1",
+			Handler:    _Dapr_SetFaultAlpha1_Handler,
+		},
+		{
+			MethodName: "DeleteFaultAlpha1",
+			Handler:    _Dapr_DeleteFaultAlpha1_Handler,
+		},
 		{
 			MethodName: "ScheduleJobAlpha1",
 			Handler:    _Dapr_ScheduleJobAlpha1_Handler,
@@ -3075,6 +4074,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListJobs",
 			Handler:    _Dapr_ListJobs_Handler,
 		},
+		{
+			MethodName: "BulkScheduleJobs",
+			Handler:    _Dapr_BulkScheduleJobs_Handler,
+		},
+		{
+			MethodName: "BulkDeleteJobs",
+			Handler:    _Dapr_BulkDeleteJobs_Handler,
+		},
 		{
 			MethodName: "ConverseAlpha1",
 			Handler:    _Dapr_ConverseAlpha1_Handler,
@@ -3085,12 +4092,32 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBulkStateStreamAlpha1",
+			Handler:       _Dapr_GetBulkStateStreamAlpha1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchStateAlpha1",
+			Handler:       _Dapr_WatchStateAlpha1_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeTopicEventsAlpha1",
 			Handler:       _Dapr_SubscribeTopicEventsAlpha1_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "GetBulkSecretStreamAlpha1",
+			Handler:       _Dapr_GetBulkSecretStreamAlpha1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSecretsAlpha1",
+			Handler:       _Dapr_WatchSecretsAlpha1_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeActorEventsAlpha1",
 			Handler:       _Dapr_SubscribeActorEventsAlpha1_Handler,
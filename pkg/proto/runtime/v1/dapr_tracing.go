@@ -14,6 +14,8 @@ limitations under the License.
 package runtime
 
 import (
+	"strings"
+
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 )
 
@@ -37,6 +39,18 @@ func (x *BulkPublishRequest) AppendSpanAttributes(rpcMethod string, m map[string
 	m[diagConsts.MessagingDestinationSpanAttributeKey] = x.GetTopic()
 }
 
+func (x *PauseSubscriptionRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.MessagingSystemSpanAttributeKey] = diagConsts.PubsubBuildingBlockType
+	m[diagConsts.MessagingDestinationSpanAttributeKey] = x.GetTopic()
+}
+
+func (x *ResumeSubscriptionRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.MessagingSystemSpanAttributeKey] = diagConsts.PubsubBuildingBlockType
+	m[diagConsts.MessagingDestinationSpanAttributeKey] = x.GetTopic()
+}
+
 func (x *InvokeBindingRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	m[diagConsts.DBNameSpanAttributeKey] = x.GetName()
 	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
@@ -45,6 +59,22 @@ func (x *InvokeBindingRequest) AppendSpanAttributes(rpcMethod string, m map[stri
 	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.BindingBuildingBlockType
 }
 
+func (x *PauseInputBindingRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.BindingBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.BindingBuildingBlockType
+}
+
+func (x *ResumeInputBindingRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.BindingBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.BindingBuildingBlockType
+}
+
 func (x *GetStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
 	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
@@ -69,6 +99,30 @@ func (x *DeleteStateRequest) AppendSpanAttributes(rpcMethod string, m map[string
 	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
 }
 
+func (x *GetBulkStateStreamRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *MigrateStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
+func (x *WatchStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.StateBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.StateBuildingBlockType
+}
+
 func (x *GetSecretRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
 	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
@@ -85,6 +139,10 @@ func (x *ConversationRequestAlpha2) AppendSpanAttributes(rpcMethod string, m map
 	m[diagConsts.RPCSystemSpanAttributeKey] = x.GetName()
 }
 
+func (*AcquireLockRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*DeleteJobRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -93,6 +151,20 @@ func (*DeleteJobsByPrefixRequest) AppendSpanAttributes(rpcMethod string, m map[s
 	// TODO
 }
 
+func (x *BulkDeleteJobsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DaprAPIJobNames] = strings.Join(x.GetNames(), ",")
+}
+
+func (x *BulkScheduleJobsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	names := make([]string, len(x.GetJobs()))
+	for i, job := range x.GetJobs() {
+		names[i] = job.GetJob().GetName()
+	}
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DaprAPIJobNames] = strings.Join(names, ",")
+}
+
 func (*DecryptRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -113,6 +185,14 @@ func (*ExecuteStateTransactionRequest) AppendSpanAttributes(rpcMethod string, m
 	// TODO
 }
 
+func (*GetActiveActorsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
+func (*GetResiliencyRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*GetActorStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -121,6 +201,26 @@ func (*GetBulkSecretRequest) AppendSpanAttributes(rpcMethod string, m map[string
 	// TODO
 }
 
+func (*GetBulkSecretStreamRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
+func (x *WatchSecretsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.SecretBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.SecretBuildingBlockType
+}
+
+func (x *PurgeSecretsCacheRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.DBNameSpanAttributeKey] = x.GetStoreName()
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.SecretBuildingBlockType
+	m[diagConsts.DBStatementSpanAttributeKey] = rpcMethod
+	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.SecretBuildingBlockType
+}
+
 func (*GetBulkStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -141,6 +241,10 @@ func (*GetWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]s
 	// TODO
 }
 
+func (*GetWorkflowHistoryRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*ListActorRemindersRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -149,6 +253,17 @@ func (*ListJobsRequest) AppendSpanAttributes(rpcMethod string, m map[string]stri
 	// TODO
 }
 
+func (x *ListWorkflowInstancesRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.DaprAPIWorkflowComponent] = x.GetWorkflowComponent()
+	if x.WorkflowName != nil {
+		m[diagConsts.DaprAPIWorkflowName] = x.GetWorkflowName()
+	}
+	if len(x.GetRuntimeStatuses()) > 0 {
+		m[diagConsts.DaprAPIWorkflowRuntimeStatuses] = strings.Join(x.GetRuntimeStatuses(), ",")
+	}
+}
+
 func (*InvokeActorRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -169,6 +284,26 @@ func (*RaiseEventWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[s
 	// TODO
 }
 
+func (*ReloadRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
+func (*SetFaultRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
+func (*DeleteFaultRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
+func (*RenewLockRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
+func (*ReplayWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+
 func (*RegisterActorReminderRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
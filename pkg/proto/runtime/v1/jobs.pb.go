@@ -81,6 +81,30 @@ type Job struct {
 	Data *anypb.Any `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 	// failure_policy is the optional policy for handling job failures.
 	FailurePolicy *v1.JobFailurePolicy `protobuf:"bytes,7,opt,name=failure_policy,json=failurePolicy,proto3,oneof" json:"failure_policy,omitempty"`
+	// labels are optional user-defined key/value pairs attached to the job,
+	// which can be used to select jobs when listing.
+	Labels map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// trigger_history is the most recent trigger attempts of the job, oldest
+	// first. Output only, populated when getting a job if trigger history is
+	// enabled on the scheduler. Ignored when scheduling a job.
+	TriggerHistory []*JobTriggerAttempt `protobuf:"bytes,9,rep,name=trigger_history,json=triggerHistory,proto3" json:"trigger_history,omitempty"`
+	// exactly_once is the optional flag to trigger a one-time job with
+	// exactly-once semantics. Only valid for jobs without a schedule which
+	// repeat at most once. The trigger is sent to the app with an idempotency
+	// token, and is not redelivered once it has been received by the sidecar,
+	// even if the sidecar crashes before the app acknowledges it. Redeliveries
+	// caused by a scheduler failover carry the same token, so the app can
+	// deduplicate them.
+	ExactlyOnce *bool `protobuf:"varint,10,opt,name=exactly_once,json=exactlyOnce,proto3,oneof" json:"exactly_once,omitempty"`
+	// time_zone is the optional IANA time zone the schedule is evaluated in,
+	// for example "America/New_York". Defaults to UTC. Requires a schedule.
+	TimeZone *string `protobuf:"bytes,11,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	// calendar is the optional name of an exclusion calendar defined in the
+	// jobs section of the Configuration of this sidecar. Triggers falling on a
+	// date excluded by the calendar are skipped. The calendar is resolved when
+	// the job is scheduled, so later changes to the Configuration take effect
+	// once the job is scheduled again.
+	Calendar *string `protobuf:"bytes,12,opt,name=calendar,proto3,oneof" json:"calendar,omitempty"`
 }
 
 func (x *Job) Reset() {
@@ -164,6 +188,109 @@ func (x *Job) GetFailurePolicy() *v1.JobFailurePolicy {
 	return nil
 }
 
+func (x *Job) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Job) GetTriggerHistory() []*JobTriggerAttempt {
+	if x != nil {
+		return x.TriggerHistory
+	}
+	return nil
+}
+
+func (x *Job) GetExactlyOnce() bool {
+	if x != nil && x.ExactlyOnce != nil {
+		return *x.ExactlyOnce
+	}
+	return false
+}
+
+func (x *Job) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *Job) GetCalendar() string {
+	if x != nil && x.Calendar != nil {
+		return *x.Calendar
+	}
+	return ""
+}
+
+// JobTriggerAttempt is a single trigger attempt of a job.
+type JobTriggerAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time is the time the trigger attempt was made, in RFC3339 format.
+	Time string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// result is the outcome of the trigger attempt, one of "SUCCESS", "FAILED"
+	// or "UNDELIVERABLE".
+	Result string `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// error is the reason the trigger attempt failed, if reported.
+	Error *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *JobTriggerAttempt) Reset() {
+	*x = JobTriggerAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobTriggerAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTriggerAttempt) ProtoMessage() {}
+
+func (x *JobTriggerAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTriggerAttempt.ProtoReflect.Descriptor instead.
+func (*JobTriggerAttempt) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobTriggerAttempt) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *JobTriggerAttempt) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *JobTriggerAttempt) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
 // ScheduleJobRequest is the message to create/schedule the job.
 type ScheduleJobRequest struct {
 	state         protoimpl.MessageState
@@ -179,7 +306,7 @@ type ScheduleJobRequest struct {
 func (x *ScheduleJobRequest) Reset() {
 	*x = ScheduleJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[1]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -192,7 +319,7 @@ func (x *ScheduleJobRequest) String() string {
 func (*ScheduleJobRequest) ProtoMessage() {}
 
 func (x *ScheduleJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[1]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +332,7 @@ func (x *ScheduleJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleJobRequest.ProtoReflect.Descriptor instead.
 func (*ScheduleJobRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{1}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ScheduleJobRequest) GetJob() *Job {
@@ -232,7 +359,7 @@ type ScheduleJobResponse struct {
 func (x *ScheduleJobResponse) Reset() {
 	*x = ScheduleJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[2]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -245,7 +372,7 @@ func (x *ScheduleJobResponse) String() string {
 func (*ScheduleJobResponse) ProtoMessage() {}
 
 func (x *ScheduleJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[2]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +385,7 @@ func (x *ScheduleJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleJobResponse.ProtoReflect.Descriptor instead.
 func (*ScheduleJobResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{2}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{3}
 }
 
 // GetJobRequest is the message to retrieve a job.
@@ -274,7 +401,7 @@ type GetJobRequest struct {
 func (x *GetJobRequest) Reset() {
 	*x = GetJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[3]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +414,7 @@ func (x *GetJobRequest) String() string {
 func (*GetJobRequest) ProtoMessage() {}
 
 func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[3]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +427,7 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
 func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{3}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetJobRequest) GetName() string {
@@ -323,7 +450,7 @@ type GetJobResponse struct {
 func (x *GetJobResponse) Reset() {
 	*x = GetJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[4]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -336,7 +463,7 @@ func (x *GetJobResponse) String() string {
 func (*GetJobResponse) ProtoMessage() {}
 
 func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[4]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +476,7 @@ func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
 func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{4}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetJobResponse) GetJob() *Job {
@@ -372,7 +499,7 @@ type DeleteJobRequest struct {
 func (x *DeleteJobRequest) Reset() {
 	*x = DeleteJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[5]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +512,7 @@ func (x *DeleteJobRequest) String() string {
 func (*DeleteJobRequest) ProtoMessage() {}
 
 func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[5]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +525,7 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{5}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteJobRequest) GetName() string {
@@ -418,7 +545,7 @@ type DeleteJobResponse struct {
 func (x *DeleteJobResponse) Reset() {
 	*x = DeleteJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[6]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -431,7 +558,7 @@ func (x *DeleteJobResponse) String() string {
 func (*DeleteJobResponse) ProtoMessage() {}
 
 func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[6]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +571,7 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
 func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{6}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{7}
 }
 
 // DeleteJobsByPrefixRequest is the message to delete jobs by name prefix.
@@ -461,7 +588,7 @@ type DeleteJobsByPrefixRequestAlpha1 struct {
 func (x *DeleteJobsByPrefixRequestAlpha1) Reset() {
 	*x = DeleteJobsByPrefixRequestAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[7]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -474,7 +601,7 @@ func (x *DeleteJobsByPrefixRequestAlpha1) String() string {
 func (*DeleteJobsByPrefixRequestAlpha1) ProtoMessage() {}
 
 func (x *DeleteJobsByPrefixRequestAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[7]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +614,7 @@ func (x *DeleteJobsByPrefixRequestAlpha1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobsByPrefixRequestAlpha1.ProtoReflect.Descriptor instead.
 func (*DeleteJobsByPrefixRequestAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{7}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteJobsByPrefixRequestAlpha1) GetNamePrefix() string {
@@ -506,7 +633,7 @@ type DeleteJobsByPrefixResponseAlpha1 struct {
 func (x *DeleteJobsByPrefixResponseAlpha1) Reset() {
 	*x = DeleteJobsByPrefixResponseAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[8]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +646,7 @@ func (x *DeleteJobsByPrefixResponseAlpha1) String() string {
 func (*DeleteJobsByPrefixResponseAlpha1) ProtoMessage() {}
 
 func (x *DeleteJobsByPrefixResponseAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[8]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +659,7 @@ func (x *DeleteJobsByPrefixResponseAlpha1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobsByPrefixResponseAlpha1.ProtoReflect.Descriptor instead.
 func (*DeleteJobsByPrefixResponseAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{8}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{9}
 }
 
 // DeleteJobsByPrefixRequest is the stable message to delete jobs by name prefix.
@@ -549,7 +676,7 @@ type DeleteJobsByPrefixRequest struct {
 func (x *DeleteJobsByPrefixRequest) Reset() {
 	*x = DeleteJobsByPrefixRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[9]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -562,7 +689,7 @@ func (x *DeleteJobsByPrefixRequest) String() string {
 func (*DeleteJobsByPrefixRequest) ProtoMessage() {}
 
 func (x *DeleteJobsByPrefixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[9]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +702,7 @@ func (x *DeleteJobsByPrefixRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobsByPrefixRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobsByPrefixRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{9}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteJobsByPrefixRequest) GetNamePrefix() string {
@@ -594,7 +721,7 @@ type DeleteJobsByPrefixResponse struct {
 func (x *DeleteJobsByPrefixResponse) Reset() {
 	*x = DeleteJobsByPrefixResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[10]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -607,7 +734,7 @@ func (x *DeleteJobsByPrefixResponse) String() string {
 func (*DeleteJobsByPrefixResponse) ProtoMessage() {}
 
 func (x *DeleteJobsByPrefixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[10]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +747,7 @@ func (x *DeleteJobsByPrefixResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobsByPrefixResponse.ProtoReflect.Descriptor instead.
 func (*DeleteJobsByPrefixResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{10}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{11}
 }
 
 type ListJobsRequestAlpha1 struct {
@@ -632,7 +759,7 @@ type ListJobsRequestAlpha1 struct {
 func (x *ListJobsRequestAlpha1) Reset() {
 	*x = ListJobsRequestAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[11]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +772,7 @@ func (x *ListJobsRequestAlpha1) String() string {
 func (*ListJobsRequestAlpha1) ProtoMessage() {}
 
 func (x *ListJobsRequestAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[11]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +785,7 @@ func (x *ListJobsRequestAlpha1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsRequestAlpha1.ProtoReflect.Descriptor instead.
 func (*ListJobsRequestAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{11}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{12}
 }
 
 // ListJobsResponse is the message response containing the list of jobs.
@@ -674,7 +801,7 @@ type ListJobsResponseAlpha1 struct {
 func (x *ListJobsResponseAlpha1) Reset() {
 	*x = ListJobsResponseAlpha1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[12]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -687,7 +814,7 @@ func (x *ListJobsResponseAlpha1) String() string {
 func (*ListJobsResponseAlpha1) ProtoMessage() {}
 
 func (x *ListJobsResponseAlpha1) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[12]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +827,7 @@ func (x *ListJobsResponseAlpha1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsResponseAlpha1.ProtoReflect.Descriptor instead.
 func (*ListJobsResponseAlpha1) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{12}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListJobsResponseAlpha1) GetJobs() []*Job {
@@ -714,12 +841,27 @@ type ListJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// label_selector filters the jobs to those which have all of the given
+	// labels. If empty, jobs are not filtered by label.
+	LabelSelector map[string]string `protobuf:"bytes,1,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// due_after and due_before are optional RFC3339 times which filter the jobs
+	// to those with a due time within the bounds, inclusive. Jobs whose due time
+	// is relative to their creation time are not returned when either is set.
+	DueAfter  *string `protobuf:"bytes,2,opt,name=due_after,json=dueAfter,proto3,oneof" json:"due_after,omitempty"`
+	DueBefore *string `protobuf:"bytes,3,opt,name=due_before,json=dueBefore,proto3,oneof" json:"due_before,omitempty"`
+	// page_size is the optional maximum number of jobs to return. If not set,
+	// all matching jobs are returned.
+	PageSize *uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// continuation_token is the optional token returned by a previous ListJobs
+	// call, to list the next page of jobs.
+	ContinuationToken *string `protobuf:"bytes,5,opt,name=continuation_token,json=continuationToken,proto3,oneof" json:"continuation_token,omitempty"`
 }
 
 func (x *ListJobsRequest) Reset() {
 	*x = ListJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[13]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +874,7 @@ func (x *ListJobsRequest) String() string {
 func (*ListJobsRequest) ProtoMessage() {}
 
 func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[13]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +887,42 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
 func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{13}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListJobsRequest) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+func (x *ListJobsRequest) GetDueAfter() string {
+	if x != nil && x.DueAfter != nil {
+		return *x.DueAfter
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetDueBefore() string {
+	if x != nil && x.DueBefore != nil {
+		return *x.DueBefore
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetContinuationToken() string {
+	if x != nil && x.ContinuationToken != nil {
+		return *x.ContinuationToken
+	}
+	return ""
 }
 
 // ListJobsResponse is the stable message response containing the list of jobs.
@@ -756,12 +933,15 @@ type ListJobsResponse struct {
 
 	// The list of jobs.
 	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// continuation_token is set if there are more jobs to list, and can be
+	// passed in the next ListJobs call to list the next page.
+	ContinuationToken string `protobuf:"bytes,2,opt,name=continuation_token,json=continuationToken,proto3" json:"continuation_token,omitempty"`
 }
 
 func (x *ListJobsResponse) Reset() {
 	*x = ListJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[14]
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -774,7 +954,7 @@ func (x *ListJobsResponse) String() string {
 func (*ListJobsResponse) ProtoMessage() {}
 
 func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[14]
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +967,7 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
 func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{14}
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListJobsResponse) GetJobs() []*Job {
@@ -797,6 +977,269 @@ func (x *ListJobsResponse) GetJobs() []*Job {
 	return nil
 }
 
+func (x *ListJobsResponse) GetContinuationToken() string {
+	if x != nil {
+		return x.ContinuationToken
+	}
+	return ""
+}
+
+// BulkScheduleJobsRequest is the message to create or update a batch of jobs.
+type BulkScheduleJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The jobs to schedule. Job names must be unique within the batch.
+	Jobs []*ScheduleJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *BulkScheduleJobsRequest) Reset() {
+	*x = BulkScheduleJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkScheduleJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkScheduleJobsRequest) ProtoMessage() {}
+
+func (x *BulkScheduleJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkScheduleJobsRequest.ProtoReflect.Descriptor instead.
+func (*BulkScheduleJobsRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BulkScheduleJobsRequest) GetJobs() []*ScheduleJobRequest {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// BulkScheduleJobsResponse is the message response of a batch of jobs to
+// schedule.
+type BulkScheduleJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The jobs which failed to be scheduled. Empty if all jobs were scheduled.
+	FailedEntries []*BulkJobsFailedEntry `protobuf:"bytes,1,rep,name=failed_entries,json=failedEntries,proto3" json:"failed_entries,omitempty"`
+}
+
+func (x *BulkScheduleJobsResponse) Reset() {
+	*x = BulkScheduleJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkScheduleJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkScheduleJobsResponse) ProtoMessage() {}
+
+func (x *BulkScheduleJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkScheduleJobsResponse.ProtoReflect.Descriptor instead.
+func (*BulkScheduleJobsResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BulkScheduleJobsResponse) GetFailedEntries() []*BulkJobsFailedEntry {
+	if x != nil {
+		return x.FailedEntries
+	}
+	return nil
+}
+
+// BulkDeleteJobsRequest is the message to delete a batch of jobs by name.
+type BulkDeleteJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The names of the jobs to delete.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *BulkDeleteJobsRequest) Reset() {
+	*x = BulkDeleteJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkDeleteJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteJobsRequest) ProtoMessage() {}
+
+func (x *BulkDeleteJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteJobsRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteJobsRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BulkDeleteJobsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// BulkDeleteJobsResponse is the message response of a batch of jobs to delete.
+type BulkDeleteJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The jobs which failed to be deleted. Empty if all jobs were deleted.
+	FailedEntries []*BulkJobsFailedEntry `protobuf:"bytes,1,rep,name=failed_entries,json=failedEntries,proto3" json:"failed_entries,omitempty"`
+}
+
+func (x *BulkDeleteJobsResponse) Reset() {
+	*x = BulkDeleteJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkDeleteJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteJobsResponse) ProtoMessage() {}
+
+func (x *BulkDeleteJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteJobsResponse.ProtoReflect.Descriptor instead.
+func (*BulkDeleteJobsResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BulkDeleteJobsResponse) GetFailedEntries() []*BulkJobsFailedEntry {
+	if x != nil {
+		return x.FailedEntries
+	}
+	return nil
+}
+
+// BulkJobsFailedEntry is the message containing the name and error of a job
+// which failed in a bulk jobs call.
+type BulkJobsFailedEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the job.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The error message of the failure.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BulkJobsFailedEntry) Reset() {
+	*x = BulkJobsFailedEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkJobsFailedEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkJobsFailedEntry) ProtoMessage() {}
+
+func (x *BulkJobsFailedEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_jobs_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkJobsFailedEntry.ProtoReflect.Descriptor instead.
+func (*BulkJobsFailedEntry) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BulkJobsFailedEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BulkJobsFailedEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_dapr_proto_runtime_v1_jobs_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_runtime_v1_jobs_proto_rawDesc = []byte{
@@ -807,7 +1250,7 @@ var file_dapr_proto_runtime_v1_jobs_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x05, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
@@ -824,63 +1267,145 @@ var file_dapr_proto_runtime_v1_jobs_proto_rawDesc = []byte{
 	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f,
 	0x6c, 0x69, 0x63, 0x79, 0x48, 0x04, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06,
-	0x0a, 0x04, 0x5f, 0x74, 0x74, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x60, 0x0a, 0x12, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x51, 0x0a, 0x0f, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x0e, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0c, 0x65,
+	0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x5f, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x4f, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64,
+	0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74, 0x6c, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x5f, 0x6f,
+	0x6e, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x64,
+	0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x6a, 0x6f,
+	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65,
+	0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a,
+	0x6f, 0x62, 0x22, 0x26, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x57, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x42, 0x79, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x51, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22,
+	0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x42, 0x79, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x48, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x12, 0x2e, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x22, 0x93, 0x03, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x64,
 	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x1c, 0x0a,
-	0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x6a, 0x6f, 0x62,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
-	0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x26, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
-	0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x22, 0x0a,
-	0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x22, 0x51, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x42,
-	0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x48, 0x0a, 0x16, 0x4c,
-	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x12, 0x2e, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
-	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04,
-	0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x42, 0x6d, 0x0a, 0x0a,
-	0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x44, 0x61, 0x70, 0x72,
-	0x4a, 0x6f, 0x62, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b,
-	0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f,
-	0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x09, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x75, 0x65, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09,
+	0x64, 0x75, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32,
+	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x11, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x0a, 0x17, 0x42, 0x75, 0x6c,
+	0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x22, 0x6d, 0x0a, 0x18, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x51, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x6c, 0x6b, 0x4a, 0x6f, 0x62, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x6b, 0x0a, 0x16, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
+	0x4a, 0x6f, 0x62, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3f,
+	0x0a, 0x13, 0x42, 0x75, 0x6c, 0x6b, 0x4a, 0x6f, 0x62, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x6d, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x44,
+	0x61, 0x70, 0x72, 0x4a, 0x6f, 0x62, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64,
+	0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -895,38 +1420,52 @@ func file_dapr_proto_runtime_v1_jobs_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_jobs_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_dapr_proto_runtime_v1_jobs_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_dapr_proto_runtime_v1_jobs_proto_goTypes = []interface{}{
 	(*Job)(nil),                              // 0: dapr.proto.runtime.v1.Job
-	(*ScheduleJobRequest)(nil),               // 1: dapr.proto.runtime.v1.ScheduleJobRequest
-	(*ScheduleJobResponse)(nil),              // 2: dapr.proto.runtime.v1.ScheduleJobResponse
-	(*GetJobRequest)(nil),                    // 3: dapr.proto.runtime.v1.GetJobRequest
-	(*GetJobResponse)(nil),                   // 4: dapr.proto.runtime.v1.GetJobResponse
-	(*DeleteJobRequest)(nil),                 // 5: dapr.proto.runtime.v1.DeleteJobRequest
-	(*DeleteJobResponse)(nil),                // 6: dapr.proto.runtime.v1.DeleteJobResponse
-	(*DeleteJobsByPrefixRequestAlpha1)(nil),  // 7: dapr.proto.runtime.v1.DeleteJobsByPrefixRequestAlpha1
-	(*DeleteJobsByPrefixResponseAlpha1)(nil), // 8: dapr.proto.runtime.v1.DeleteJobsByPrefixResponseAlpha1
-	(*DeleteJobsByPrefixRequest)(nil),        // 9: dapr.proto.runtime.v1.DeleteJobsByPrefixRequest
-	(*DeleteJobsByPrefixResponse)(nil),       // 10: dapr.proto.runtime.v1.DeleteJobsByPrefixResponse
-	(*ListJobsRequestAlpha1)(nil),            // 11: dapr.proto.runtime.v1.ListJobsRequestAlpha1
-	(*ListJobsResponseAlpha1)(nil),           // 12: dapr.proto.runtime.v1.ListJobsResponseAlpha1
-	(*ListJobsRequest)(nil),                  // 13: dapr.proto.runtime.v1.ListJobsRequest
-	(*ListJobsResponse)(nil),                 // 14: dapr.proto.runtime.v1.ListJobsResponse
-	(*anypb.Any)(nil),                        // 15: google.protobuf.Any
-	(*v1.JobFailurePolicy)(nil),              // 16: dapr.proto.common.v1.JobFailurePolicy
+	(*JobTriggerAttempt)(nil),                // 1: dapr.proto.runtime.v1.JobTriggerAttempt
+	(*ScheduleJobRequest)(nil),               // 2: dapr.proto.runtime.v1.ScheduleJobRequest
+	(*ScheduleJobResponse)(nil),              // 3: dapr.proto.runtime.v1.ScheduleJobResponse
+	(*GetJobRequest)(nil),                    // 4: dapr.proto.runtime.v1.GetJobRequest
+	(*GetJobResponse)(nil),                   // 5: dapr.proto.runtime.v1.GetJobResponse
+	(*DeleteJobRequest)(nil),                 // 6: dapr.proto.runtime.v1.DeleteJobRequest
+	(*DeleteJobResponse)(nil),                // 7: dapr.proto.runtime.v1.DeleteJobResponse
+	(*DeleteJobsByPrefixRequestAlpha1)(nil),  // 8: dapr.proto.runtime.v1.DeleteJobsByPrefixRequestAlpha1
+	(*DeleteJobsByPrefixResponseAlpha1)(nil), // 9: dapr.proto.runtime.v1.DeleteJobsByPrefixResponseAlpha1
+	(*DeleteJobsByPrefixRequest)(nil),        // 10: dapr.proto.runtime.v1.DeleteJobsByPrefixRequest
+	(*DeleteJobsByPrefixResponse)(nil),       // 11: dapr.proto.runtime.v1.DeleteJobsByPrefixResponse
+	(*ListJobsRequestAlpha1)(nil),            // 12: dapr.proto.runtime.v1.ListJobsRequestAlpha1
+	(*ListJobsResponseAlpha1)(nil),           // 13: dapr.proto.runtime.v1.ListJobsResponseAlpha1
+	(*ListJobsRequest)(nil),                  // 14: dapr.proto.runtime.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),                 // 15: dapr.proto.runtime.v1.ListJobsResponse
+	(*BulkScheduleJobsRequest)(nil),          // 16: dapr.proto.runtime.v1.BulkScheduleJobsRequest
+	(*BulkScheduleJobsResponse)(nil),         // 17: dapr.proto.runtime.v1.BulkScheduleJobsResponse
+	(*BulkDeleteJobsRequest)(nil),            // 18: dapr.proto.runtime.v1.BulkDeleteJobsRequest
+	(*BulkDeleteJobsResponse)(nil),           // 19: dapr.proto.runtime.v1.BulkDeleteJobsResponse
+	(*BulkJobsFailedEntry)(nil),              // 20: dapr.proto.runtime.v1.BulkJobsFailedEntry
+	nil,                                      // 21: dapr.proto.runtime.v1.Job.LabelsEntry
+	nil,                                      // 22: dapr.proto.runtime.v1.ListJobsRequest.LabelSelectorEntry
+	(*anypb.Any)(nil),                        // 23: google.protobuf.Any
+	(*v1.JobFailurePolicy)(nil),              // 24: dapr.proto.common.v1.JobFailurePolicy
 }
 var file_dapr_proto_runtime_v1_jobs_proto_depIdxs = []int32{
-	15, // 0: dapr.proto.runtime.v1.Job.data:type_name -> google.protobuf.Any
-	16, // 1: dapr.proto.runtime.v1.Job.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
-	0,  // 2: dapr.proto.runtime.v1.ScheduleJobRequest.job:type_name -> dapr.proto.runtime.v1.Job
-	0,  // 3: dapr.proto.runtime.v1.GetJobResponse.job:type_name -> dapr.proto.runtime.v1.Job
-	0,  // 4: dapr.proto.runtime.v1.ListJobsResponseAlpha1.jobs:type_name -> dapr.proto.runtime.v1.Job
-	0,  // 5: dapr.proto.runtime.v1.ListJobsResponse.jobs:type_name -> dapr.proto.runtime.v1.Job
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	23, // 0: dapr.proto.runtime.v1.Job.data:type_name -> google.protobuf.Any
+	24, // 1: dapr.proto.runtime.v1.Job.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
+	21, // 2: dapr.proto.runtime.v1.Job.labels:type_name -> dapr.proto.runtime.v1.Job.LabelsEntry
+	1,  // 3: dapr.proto.runtime.v1.Job.trigger_history:type_name -> dapr.proto.runtime.v1.JobTriggerAttempt
+	0,  // 4: dapr.proto.runtime.v1.ScheduleJobRequest.job:type_name -> dapr.proto.runtime.v1.Job
+	0,  // 5: dapr.proto.runtime.v1.GetJobResponse.job:type_name -> dapr.proto.runtime.v1.Job
+	0,  // 6: dapr.proto.runtime.v1.ListJobsResponseAlpha1.jobs:type_name -> dapr.proto.runtime.v1.Job
+	22, // 7: dapr.proto.runtime.v1.ListJobsRequest.label_selector:type_name -> dapr.proto.runtime.v1.ListJobsRequest.LabelSelectorEntry
+	0,  // 8: dapr.proto.runtime.v1.ListJobsResponse.jobs:type_name -> dapr.proto.runtime.v1.Job
+	2,  // 9: dapr.proto.runtime.v1.BulkScheduleJobsRequest.jobs:type_name -> dapr.proto.runtime.v1.ScheduleJobRequest
+	20, // 10: dapr.proto.runtime.v1.BulkScheduleJobsResponse.failed_entries:type_name -> dapr.proto.runtime.v1.BulkJobsFailedEntry
+	20, // 11: dapr.proto.runtime.v1.BulkDeleteJobsResponse.failed_entries:type_name -> dapr.proto.runtime.v1.BulkJobsFailedEntry
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_runtime_v1_jobs_proto_init() }
@@ -948,7 +1487,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleJobRequest); i {
+			switch v := v.(*JobTriggerAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -960,7 +1499,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleJobResponse); i {
+			switch v := v.(*ScheduleJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -972,7 +1511,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobRequest); i {
+			switch v := v.(*ScheduleJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -984,7 +1523,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobResponse); i {
+			switch v := v.(*GetJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -996,7 +1535,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobRequest); i {
+			switch v := v.(*GetJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1008,7 +1547,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobResponse); i {
+			switch v := v.(*DeleteJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1020,7 +1559,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobsByPrefixRequestAlpha1); i {
+			switch v := v.(*DeleteJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1032,7 +1571,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobsByPrefixResponseAlpha1); i {
+			switch v := v.(*DeleteJobsByPrefixRequestAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1044,7 +1583,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobsByPrefixRequest); i {
+			switch v := v.(*DeleteJobsByPrefixResponseAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1056,7 +1595,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobsByPrefixResponse); i {
+			switch v := v.(*DeleteJobsByPrefixRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1068,7 +1607,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsRequestAlpha1); i {
+			switch v := v.(*DeleteJobsByPrefixResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1080,7 +1619,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsResponseAlpha1); i {
+			switch v := v.(*ListJobsRequestAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1092,7 +1631,7 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsRequest); i {
+			switch v := v.(*ListJobsResponseAlpha1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1104,6 +1643,18 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListJobsResponse); i {
 			case 0:
 				return &v.state
@@ -1115,17 +1666,79 @@ func file_dapr_proto_runtime_v1_jobs_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkScheduleJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkScheduleJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkDeleteJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkDeleteJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_jobs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkJobsFailedEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_dapr_proto_runtime_v1_jobs_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_dapr_proto_runtime_v1_jobs_proto_msgTypes[7].OneofWrappers = []interface{}{}
-	file_dapr_proto_runtime_v1_jobs_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_jobs_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_jobs_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_jobs_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_jobs_proto_msgTypes[14].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_jobs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -89,6 +89,54 @@ func (PubsubSubscriptionType) EnumDescriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{0}
 }
 
+type MetadataComponentReload_Status int32
+
+const (
+	// Indicates that the new instance of the component was initialized.
+	MetadataComponentReload_SUCCEEDED MetadataComponentReload_Status = 0
+	// Indicates that the new instance of the component failed to initialize.
+	MetadataComponentReload_FAILED MetadataComponentReload_Status = 1
+)
+
+// Enum value maps for MetadataComponentReload_Status.
+var (
+	MetadataComponentReload_Status_name = map[int32]string{
+		0: "SUCCEEDED",
+		1: "FAILED",
+	}
+	MetadataComponentReload_Status_value = map[string]int32{
+		"SUCCEEDED": 0,
+		"FAILED":    1,
+	}
+)
+
+func (x MetadataComponentReload_Status) Enum() *MetadataComponentReload_Status {
+	p := new(MetadataComponentReload_Status)
+	*p = x
+	return p
+}
+
+func (x MetadataComponentReload_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataComponentReload_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_runtime_v1_metadata_proto_enumTypes[1].Descriptor()
+}
+
+func (MetadataComponentReload_Status) Type() protoreflect.EnumType {
+	return &file_dapr_proto_runtime_v1_metadata_proto_enumTypes[1]
+}
+
+func (x MetadataComponentReload_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataComponentReload_Status.Descriptor instead.
+func (MetadataComponentReload_Status) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{8, 0}
+}
+
 type ActorRuntime_ActorRuntimeStatus int32
 
 const (
@@ -126,11 +174,11 @@ func (x ActorRuntime_ActorRuntimeStatus) String() string {
 }
 
 func (ActorRuntime_ActorRuntimeStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_dapr_proto_runtime_v1_metadata_proto_enumTypes[1].Descriptor()
+	return file_dapr_proto_runtime_v1_metadata_proto_enumTypes[2].Descriptor()
 }
 
 func (ActorRuntime_ActorRuntimeStatus) Type() protoreflect.EnumType {
-	return &file_dapr_proto_runtime_v1_metadata_proto_enumTypes[1]
+	return &file_dapr_proto_runtime_v1_metadata_proto_enumTypes[2]
 }
 
 func (x ActorRuntime_ActorRuntimeStatus) Number() protoreflect.EnumNumber {
@@ -139,7 +187,59 @@ func (x ActorRuntime_ActorRuntimeStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ActorRuntime_ActorRuntimeStatus.Descriptor instead.
 func (ActorRuntime_ActorRuntimeStatus) EnumDescriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{4, 0}
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type MetadataStateMigration_Status int32
+
+const (
+	// Indicates that the migration is copying keys.
+	MetadataStateMigration_RUNNING MetadataStateMigration_Status = 0
+	// Indicates that all keys were copied, or counted in a dry run.
+	MetadataStateMigration_COMPLETED MetadataStateMigration_Status = 1
+	// Indicates that the migration stopped on an error.
+	MetadataStateMigration_FAILED MetadataStateMigration_Status = 2
+)
+
+// Enum value maps for MetadataStateMigration_Status.
+var (
+	MetadataStateMigration_Status_name = map[int32]string{
+		0: "RUNNING",
+		1: "COMPLETED",
+		2: "FAILED",
+	}
+	MetadataStateMigration_Status_value = map[string]int32{
+		"RUNNING":   0,
+		"COMPLETED": 1,
+		"FAILED":    2,
+	}
+)
+
+func (x MetadataStateMigration_Status) Enum() *MetadataStateMigration_Status {
+	p := new(MetadataStateMigration_Status)
+	*p = x
+	return p
+}
+
+func (x MetadataStateMigration_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataStateMigration_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_runtime_v1_metadata_proto_enumTypes[3].Descriptor()
+}
+
+func (MetadataStateMigration_Status) Type() protoreflect.EnumType {
+	return &file_dapr_proto_runtime_v1_metadata_proto_enumTypes[3]
+}
+
+func (x MetadataStateMigration_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataStateMigration_Status.Descriptor instead.
+func (MetadataStateMigration_Status) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{25, 0}
 }
 
 // GetMetadataRequest is the message for the GetMetadata request.
@@ -205,6 +305,21 @@ type GetMetadataResponse struct {
 	McpServers              []*MetadataMCPServer            `protobuf:"bytes,13,rep,name=mcp_servers,json=mcpServers,proto3" json:"mcp_servers,omitempty"`
 	WorkflowAccessPolicies  []*MetadataWorkflowAccessPolicy `protobuf:"bytes,14,rep,name=workflow_access_policies,json=workflowAccessPolicies,proto3" json:"workflow_access_policies,omitempty"`
 	Resiliencies            []*MetadataResiliency           `protobuf:"bytes,15,rep,name=resiliencies,proto3" json:"resiliencies,omitempty"`
+	StateMigrations         []*MetadataStateMigration       `protobuf:"bytes,16,rep,name=state_migrations,json=stateMigrations,proto3" json:"state_migrations,omitempty"`
+	ErrorCodes              []*MetadataErrorCodes           `protobuf:"bytes,17,rep,name=error_codes,json=errorCodes,proto3" json:"error_codes,omitempty"`
+	ComponentReloads        []*MetadataComponentReload      `protobuf:"bytes,18,rep,name=component_reloads,json=componentReloads,proto3" json:"component_reloads,omitempty"`
+	// configuration is the configuration the sidecar runs with, once the
+	// configurations it was started with are merged and the defaults applied.
+	Configuration        *MetadataConfiguration         `protobuf:"bytes,19,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	ConfigurationReloads []*MetadataConfigurationReload `protobuf:"bytes,20,rep,name=configuration_reloads,json=configurationReloads,proto3" json:"configuration_reloads,omitempty"`
+	// circuit_breakers are the circuit breakers created for the targets of the
+	// resiliency policies, with their current state.
+	CircuitBreakers []*MetadataCircuitBreaker `protobuf:"bytes,21,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+	// faults are the faults being injected by the sidecar.
+	Faults []*MetadataFault `protobuf:"bytes,22,rep,name=faults,proto3" json:"faults,omitempty"`
+	// paused_input_bindings are the names of the input bindings whose reading
+	// is paused.
+	PausedInputBindings []string `protobuf:"bytes,23,rep,name=paused_input_bindings,json=pausedInputBindings,proto3" json:"paused_input_bindings,omitempty"`
 }
 
 func (x *GetMetadataResponse) Reset() {
@@ -345,16 +460,87 @@ func (x *GetMetadataResponse) GetResiliencies() []*MetadataResiliency {
 	return nil
 }
 
-type MetadataWorkflows struct {
+func (x *GetMetadataResponse) GetStateMigrations() []*MetadataStateMigration {
+	if x != nil {
+		return x.StateMigrations
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetErrorCodes() []*MetadataErrorCodes {
+	if x != nil {
+		return x.ErrorCodes
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetComponentReloads() []*MetadataComponentReload {
+	if x != nil {
+		return x.ComponentReloads
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetConfiguration() *MetadataConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetConfigurationReloads() []*MetadataConfigurationReload {
+	if x != nil {
+		return x.ConfigurationReloads
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetCircuitBreakers() []*MetadataCircuitBreaker {
+	if x != nil {
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetFaults() []*MetadataFault {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetPausedInputBindings() []string {
+	if x != nil {
+		return x.PausedInputBindings
+	}
+	return nil
+}
+
+// MetadataConfiguration is the effective configuration of the sidecar.
+type MetadataConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectedWorkers int32 `protobuf:"varint,1,opt,name=connected_workers,json=connectedWorkers,proto3" json:"connected_workers,omitempty"`
-}
-
-func (x *MetadataWorkflows) Reset() {
-	*x = MetadataWorkflows{}
+	// names are the names of the Configuration resources, or the paths of the
+	// configuration files in self-hosted mode, in the order they are merged.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// tracing is the tracing configuration.
+	Tracing *MetadataTracing `protobuf:"bytes,2,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	// metrics is the metrics configuration.
+	Metrics *MetadataMetrics `protobuf:"bytes,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// features are the features the configuration declares, and whether they
+	// are enabled. Features can be enabled regardless of the configuration,
+	// so enabled_features of the response lists all the enabled features.
+	Features []*MetadataFeature `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"`
+	// api is the access control of the Dapr APIs.
+	Api *MetadataAPIAccess `protobuf:"bytes,5,opt,name=api,proto3" json:"api,omitempty"`
+	// resiliencies are the names of the Resiliency resources in effect.
+	Resiliencies []string `protobuf:"bytes,6,rep,name=resiliencies,proto3" json:"resiliencies,omitempty"`
+}
+
+func (x *MetadataConfiguration) Reset() {
+	*x = MetadataConfiguration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -362,13 +548,13 @@ func (x *MetadataWorkflows) Reset() {
 	}
 }
 
-func (x *MetadataWorkflows) String() string {
+func (x *MetadataConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetadataWorkflows) ProtoMessage() {}
+func (*MetadataConfiguration) ProtoMessage() {}
 
-func (x *MetadataWorkflows) ProtoReflect() protoreflect.Message {
+func (x *MetadataConfiguration) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -380,31 +566,78 @@ func (x *MetadataWorkflows) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetadataWorkflows.ProtoReflect.Descriptor instead.
-func (*MetadataWorkflows) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataConfiguration.ProtoReflect.Descriptor instead.
+func (*MetadataConfiguration) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *MetadataWorkflows) GetConnectedWorkers() int32 {
+func (x *MetadataConfiguration) GetNames() []string {
 	if x != nil {
-		return x.ConnectedWorkers
+		return x.Names
 	}
-	return 0
+	return nil
 }
 
-// MetadataScheduler is a message that contains the list of addresses of the
-// scheduler connections.
-type MetadataScheduler struct {
+func (x *MetadataConfiguration) GetTracing() *MetadataTracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+func (x *MetadataConfiguration) GetMetrics() *MetadataMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *MetadataConfiguration) GetFeatures() []*MetadataFeature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *MetadataConfiguration) GetApi() *MetadataAPIAccess {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+func (x *MetadataConfiguration) GetResiliencies() []string {
+	if x != nil {
+		return x.Resiliencies
+	}
+	return nil
+}
+
+// MetadataTracing is the tracing configuration of the sidecar. The headers of
+// the exporters are not included, as they usually hold credentials.
+type MetadataTracing struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// connected_addresses the list of addresses of the scheduler connections.
-	ConnectedAddresses []string `protobuf:"bytes,1,rep,name=connected_addresses,json=connectedAddresses,proto3" json:"connected_addresses,omitempty"`
-}
-
-func (x *MetadataScheduler) Reset() {
-	*x = MetadataScheduler{}
+	// sampling_rate is the probability of sampling traces, from 0 to 1.
+	SamplingRate string `protobuf:"bytes,1,opt,name=sampling_rate,json=samplingRate,proto3" json:"sampling_rate,omitempty"`
+	// stdout is true if the spans are written to the standard output.
+	Stdout bool `protobuf:"varint,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// zipkin_endpoint_address is the address of the Zipkin exporter, if any.
+	ZipkinEndpointAddress string `protobuf:"bytes,3,opt,name=zipkin_endpoint_address,json=zipkinEndpointAddress,proto3" json:"zipkin_endpoint_address,omitempty"`
+	// otel_endpoint_address is the address of the OpenTelemetry exporter, if
+	// any.
+	OtelEndpointAddress string `protobuf:"bytes,4,opt,name=otel_endpoint_address,json=otelEndpointAddress,proto3" json:"otel_endpoint_address,omitempty"`
+	// otel_protocol is the protocol of the OpenTelemetry exporter.
+	OtelProtocol string `protobuf:"bytes,5,opt,name=otel_protocol,json=otelProtocol,proto3" json:"otel_protocol,omitempty"`
+	// otel_is_secure is true if the connection to the OpenTelemetry exporter
+	// is secured.
+	OtelIsSecure bool `protobuf:"varint,6,opt,name=otel_is_secure,json=otelIsSecure,proto3" json:"otel_is_secure,omitempty"`
+}
+
+func (x *MetadataTracing) Reset() {
+	*x = MetadataTracing{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -412,13 +645,13 @@ func (x *MetadataScheduler) Reset() {
 	}
 }
 
-func (x *MetadataScheduler) String() string {
+func (x *MetadataTracing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetadataScheduler) ProtoMessage() {}
+func (*MetadataTracing) ProtoMessage() {}
 
-func (x *MetadataScheduler) ProtoReflect() protoreflect.Message {
+func (x *MetadataTracing) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -430,35 +663,76 @@ func (x *MetadataScheduler) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetadataScheduler.ProtoReflect.Descriptor instead.
-func (*MetadataScheduler) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataTracing.ProtoReflect.Descriptor instead.
+func (*MetadataTracing) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *MetadataScheduler) GetConnectedAddresses() []string {
+func (x *MetadataTracing) GetSamplingRate() string {
 	if x != nil {
-		return x.ConnectedAddresses
+		return x.SamplingRate
 	}
-	return nil
+	return ""
 }
 
-type ActorRuntime struct {
+func (x *MetadataTracing) GetStdout() bool {
+	if x != nil {
+		return x.Stdout
+	}
+	return false
+}
+
+func (x *MetadataTracing) GetZipkinEndpointAddress() string {
+	if x != nil {
+		return x.ZipkinEndpointAddress
+	}
+	return ""
+}
+
+func (x *MetadataTracing) GetOtelEndpointAddress() string {
+	if x != nil {
+		return x.OtelEndpointAddress
+	}
+	return ""
+}
+
+func (x *MetadataTracing) GetOtelProtocol() string {
+	if x != nil {
+		return x.OtelProtocol
+	}
+	return ""
+}
+
+func (x *MetadataTracing) GetOtelIsSecure() bool {
+	if x != nil {
+		return x.OtelIsSecure
+	}
+	return false
+}
+
+// MetadataMetrics is the metrics configuration of the sidecar.
+type MetadataMetrics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Contains an enum indicating whether the actor runtime has been initialized.
-	RuntimeStatus ActorRuntime_ActorRuntimeStatus `protobuf:"varint,1,opt,name=runtime_status,json=runtimeStatus,proto3,enum=dapr.proto.runtime.v1.ActorRuntime_ActorRuntimeStatus" json:"runtime_status,omitempty"`
-	// Count of active actors per type.
-	ActiveActors []*ActiveActorsCount `protobuf:"bytes,2,rep,name=active_actors,json=activeActors,proto3" json:"active_actors,omitempty"`
-	// Indicates whether the actor runtime is ready to host actors.
-	HostReady bool `protobuf:"varint,3,opt,name=host_ready,json=hostReady,proto3" json:"host_ready,omitempty"`
-	// Custom message from the placement provider.
-	Placement string `protobuf:"bytes,4,opt,name=placement,proto3" json:"placement,omitempty"`
-}
-
-func (x *ActorRuntime) Reset() {
-	*x = ActorRuntime{}
+	// enabled is true if metrics are collected.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// record_error_codes is true if the error codes of the APIs are recorded.
+	RecordErrorCodes bool `protobuf:"varint,2,opt,name=record_error_codes,json=recordErrorCodes,proto3" json:"record_error_codes,omitempty"`
+	// http_increased_cardinality is true if the metrics of the HTTP server are
+	// collected per path.
+	HttpIncreasedCardinality bool `protobuf:"varint,3,opt,name=http_increased_cardinality,json=httpIncreasedCardinality,proto3" json:"http_increased_cardinality,omitempty"`
+	// http_exclude_verbs is true if the HTTP verbs are excluded from the
+	// metrics of the HTTP server.
+	HttpExcludeVerbs bool `protobuf:"varint,4,opt,name=http_exclude_verbs,json=httpExcludeVerbs,proto3" json:"http_exclude_verbs,omitempty"`
+	// latency_distribution_buckets are the buckets of the latency
+	// distributions, in milliseconds. Empty if the default buckets are used.
+	LatencyDistributionBuckets []int32 `protobuf:"varint,5,rep,packed,name=latency_distribution_buckets,json=latencyDistributionBuckets,proto3" json:"latency_distribution_buckets,omitempty"`
+}
+
+func (x *MetadataMetrics) Reset() {
+	*x = MetadataMetrics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -466,13 +740,13 @@ func (x *ActorRuntime) Reset() {
 	}
 }
 
-func (x *ActorRuntime) String() string {
+func (x *MetadataMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActorRuntime) ProtoMessage() {}
+func (*MetadataMetrics) ProtoMessage() {}
 
-func (x *ActorRuntime) ProtoReflect() protoreflect.Message {
+func (x *MetadataMetrics) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,50 +758,58 @@ func (x *ActorRuntime) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActorRuntime.ProtoReflect.Descriptor instead.
-func (*ActorRuntime) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataMetrics.ProtoReflect.Descriptor instead.
+func (*MetadataMetrics) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ActorRuntime) GetRuntimeStatus() ActorRuntime_ActorRuntimeStatus {
+func (x *MetadataMetrics) GetEnabled() bool {
 	if x != nil {
-		return x.RuntimeStatus
+		return x.Enabled
 	}
-	return ActorRuntime_INITIALIZING
+	return false
 }
 
-func (x *ActorRuntime) GetActiveActors() []*ActiveActorsCount {
+func (x *MetadataMetrics) GetRecordErrorCodes() bool {
 	if x != nil {
-		return x.ActiveActors
+		return x.RecordErrorCodes
 	}
-	return nil
+	return false
 }
 
-func (x *ActorRuntime) GetHostReady() bool {
+func (x *MetadataMetrics) GetHttpIncreasedCardinality() bool {
 	if x != nil {
-		return x.HostReady
+		return x.HttpIncreasedCardinality
 	}
 	return false
 }
 
-func (x *ActorRuntime) GetPlacement() string {
+func (x *MetadataMetrics) GetHttpExcludeVerbs() bool {
 	if x != nil {
-		return x.Placement
+		return x.HttpExcludeVerbs
 	}
-	return ""
+	return false
 }
 
-type ActiveActorsCount struct {
+func (x *MetadataMetrics) GetLatencyDistributionBuckets() []int32 {
+	if x != nil {
+		return x.LatencyDistributionBuckets
+	}
+	return nil
+}
+
+// MetadataFeature is a feature, such as a preview, and whether it's enabled.
+type MetadataFeature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *ActiveActorsCount) Reset() {
-	*x = ActiveActorsCount{}
+func (x *MetadataFeature) Reset() {
+	*x = MetadataFeature{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,13 +817,13 @@ func (x *ActiveActorsCount) Reset() {
 	}
 }
 
-func (x *ActiveActorsCount) String() string {
+func (x *MetadataFeature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveActorsCount) ProtoMessage() {}
+func (*MetadataFeature) ProtoMessage() {}
 
-func (x *ActiveActorsCount) ProtoReflect() protoreflect.Message {
+func (x *MetadataFeature) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,38 +835,40 @@ func (x *ActiveActorsCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveActorsCount.ProtoReflect.Descriptor instead.
-func (*ActiveActorsCount) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataFeature.ProtoReflect.Descriptor instead.
+func (*MetadataFeature) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ActiveActorsCount) GetType() string {
+func (x *MetadataFeature) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ActiveActorsCount) GetCount() int32 {
+func (x *MetadataFeature) GetEnabled() bool {
 	if x != nil {
-		return x.Count
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-type RegisteredComponents struct {
+// MetadataAPIAccess is the access control of the Dapr APIs. All the APIs are
+// allowed if both lists are empty.
+type MetadataAPIAccess struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type         string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Version      string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Capabilities []string `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// allowed are the allowed APIs. If not empty, the other APIs are denied.
+	Allowed []*MetadataAPIAccessRule `protobuf:"bytes,1,rep,name=allowed,proto3" json:"allowed,omitempty"`
+	// denied are the denied APIs.
+	Denied []*MetadataAPIAccessRule `protobuf:"bytes,2,rep,name=denied,proto3" json:"denied,omitempty"`
 }
 
-func (x *RegisteredComponents) Reset() {
-	*x = RegisteredComponents{}
+func (x *MetadataAPIAccess) Reset() {
+	*x = MetadataAPIAccess{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,13 +876,13 @@ func (x *RegisteredComponents) Reset() {
 	}
 }
 
-func (x *RegisteredComponents) String() string {
+func (x *MetadataAPIAccess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisteredComponents) ProtoMessage() {}
+func (*MetadataAPIAccess) ProtoMessage() {}
 
-func (x *RegisteredComponents) ProtoReflect() protoreflect.Message {
+func (x *MetadataAPIAccess) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -610,49 +894,40 @@ func (x *RegisteredComponents) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisteredComponents.ProtoReflect.Descriptor instead.
-func (*RegisteredComponents) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataAPIAccess.ProtoReflect.Descriptor instead.
+func (*MetadataAPIAccess) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RegisteredComponents) GetName() string {
+func (x *MetadataAPIAccess) GetAllowed() []*MetadataAPIAccessRule {
 	if x != nil {
-		return x.Name
+		return x.Allowed
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisteredComponents) GetType() string {
+func (x *MetadataAPIAccess) GetDenied() []*MetadataAPIAccessRule {
 	if x != nil {
-		return x.Type
+		return x.Denied
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisteredComponents) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *RegisteredComponents) GetCapabilities() []string {
-	if x != nil {
-		return x.Capabilities
-	}
-	return nil
-}
-
-type MetadataHTTPEndpoint struct {
+// MetadataAPIAccessRule is a rule of the access control of the Dapr APIs.
+type MetadataAPIAccessRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// verbs are the HTTP verbs the rule applies to, or all verbs if empty.
+	Verbs []string `protobuf:"bytes,4,rep,name=verbs,proto3" json:"verbs,omitempty"`
 }
 
-func (x *MetadataHTTPEndpoint) Reset() {
-	*x = MetadataHTTPEndpoint{}
+func (x *MetadataAPIAccessRule) Reset() {
+	*x = MetadataAPIAccessRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -660,13 +935,13 @@ func (x *MetadataHTTPEndpoint) Reset() {
 	}
 }
 
-func (x *MetadataHTTPEndpoint) String() string {
+func (x *MetadataAPIAccessRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetadataHTTPEndpoint) ProtoMessage() {}
+func (*MetadataAPIAccessRule) ProtoMessage() {}
 
-func (x *MetadataHTTPEndpoint) ProtoReflect() protoreflect.Message {
+func (x *MetadataAPIAccessRule) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,28 +953,66 @@ func (x *MetadataHTTPEndpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetadataHTTPEndpoint.ProtoReflect.Descriptor instead.
-func (*MetadataHTTPEndpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataAPIAccessRule.ProtoReflect.Descriptor instead.
+func (*MetadataAPIAccessRule) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MetadataHTTPEndpoint) GetName() string {
+func (x *MetadataAPIAccessRule) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type MetadataMCPServer struct {
+func (x *MetadataAPIAccessRule) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *MetadataAPIAccessRule) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *MetadataAPIAccessRule) GetVerbs() []string {
+	if x != nil {
+		return x.Verbs
+	}
+	return nil
+}
+
+// MetadataComponentReload is the result of hot reloading a component whose
+// spec changed.
+type MetadataComponentReload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// name is the name of the component.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *MetadataMCPServer) Reset() {
-	*x = MetadataMCPServer{}
+	// type is the type of the reloaded component.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// version is the version of the reloaded component.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// swapped is true if the new instance of the component was initialized
+	// while the existing instance kept serving, which was then drained and
+	// closed. False if the existing instance was closed first.
+	Swapped bool `protobuf:"varint,4,opt,name=swapped,proto3" json:"swapped,omitempty"`
+	// status is the status of the reload.
+	Status MetadataComponentReload_Status `protobuf:"varint,5,opt,name=status,proto3,enum=dapr.proto.runtime.v1.MetadataComponentReload_Status" json:"status,omitempty"`
+	// time is the time of the reload, in RFC3339 format.
+	Time string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	// error is the error of the reload, if it failed.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MetadataComponentReload) Reset() {
+	*x = MetadataComponentReload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,13 +1020,13 @@ func (x *MetadataMCPServer) Reset() {
 	}
 }
 
-func (x *MetadataMCPServer) String() string {
+func (x *MetadataComponentReload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetadataMCPServer) ProtoMessage() {}
+func (*MetadataComponentReload) ProtoMessage() {}
 
-func (x *MetadataMCPServer) ProtoReflect() protoreflect.Message {
+func (x *MetadataComponentReload) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,31 +1038,81 @@ func (x *MetadataMCPServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetadataMCPServer.ProtoReflect.Descriptor instead.
-func (*MetadataMCPServer) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataComponentReload.ProtoReflect.Descriptor instead.
+func (*MetadataComponentReload) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MetadataMCPServer) GetName() string {
+func (x *MetadataComponentReload) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// MetadataWorkflowAccessPolicy describes a loaded WorkflowAccessPolicy
-// resource. Lets clients verify which policies are currently in effect on
-// the sidecar, including after a hot reload.
-type MetadataWorkflowAccessPolicy struct {
+func (x *MetadataComponentReload) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MetadataComponentReload) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *MetadataComponentReload) GetSwapped() bool {
+	if x != nil {
+		return x.Swapped
+	}
+	return false
+}
+
+func (x *MetadataComponentReload) GetStatus() MetadataComponentReload_Status {
+	if x != nil {
+		return x.Status
+	}
+	return MetadataComponentReload_SUCCEEDED
+}
+
+func (x *MetadataComponentReload) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *MetadataComponentReload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// MetadataConfigurationReload is the result of reloading the configuration and
+// the resiliency policies of the sidecar.
+type MetadataConfigurationReload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// time is the time of the reload, in RFC3339 format.
+	Time string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// applied are the changes which were applied without restarting the
+	// sidecar.
+	Applied []string `protobuf:"bytes,2,rep,name=applied,proto3" json:"applied,omitempty"`
+	// restart_required are the changes which only take effect once the sidecar
+	// is restarted.
+	RestartRequired []string `protobuf:"bytes,3,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	// error is the error of the reload, if it failed.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *MetadataWorkflowAccessPolicy) Reset() {
-	*x = MetadataWorkflowAccessPolicy{}
+func (x *MetadataConfigurationReload) Reset() {
+	*x = MetadataConfigurationReload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -757,13 +1120,13 @@ func (x *MetadataWorkflowAccessPolicy) Reset() {
 	}
 }
 
-func (x *MetadataWorkflowAccessPolicy) String() string {
+func (x *MetadataConfigurationReload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetadataWorkflowAccessPolicy) ProtoMessage() {}
+func (*MetadataConfigurationReload) ProtoMessage() {}
 
-func (x *MetadataWorkflowAccessPolicy) ProtoReflect() protoreflect.Message {
+func (x *MetadataConfigurationReload) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -775,31 +1138,54 @@ func (x *MetadataWorkflowAccessPolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetadataWorkflowAccessPolicy.ProtoReflect.Descriptor instead.
-func (*MetadataWorkflowAccessPolicy) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataConfigurationReload.ProtoReflect.Descriptor instead.
+func (*MetadataConfigurationReload) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MetadataWorkflowAccessPolicy) GetName() string {
+func (x *MetadataConfigurationReload) GetTime() string {
 	if x != nil {
-		return x.Name
+		return x.Time
 	}
 	return ""
 }
 
-// MetadataResiliency describes a loaded Resiliency resource. Lets clients
-// verify which resiliency resources are currently in effect on the sidecar,
-// including after a hot reload.
-type MetadataResiliency struct {
+func (x *MetadataConfigurationReload) GetApplied() []string {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *MetadataConfigurationReload) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
+func (x *MetadataConfigurationReload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// MetadataErrorCodes are the error codes which the APIs of a building block
+// return, so that clients can handle errors without parsing their messages.
+type MetadataErrorCodes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// api is the building block of the APIs, such as "state" or "pubsub".
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// codes are the error codes of the APIs of the building block.
+	Codes []*MetadataErrorCode `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
 }
 
-func (x *MetadataResiliency) Reset() {
-	*x = MetadataResiliency{}
+func (x *MetadataErrorCodes) Reset() {
+	*x = MetadataErrorCodes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,13 +1193,13 @@ func (x *MetadataResiliency) Reset() {
 	}
 }
 
-func (x *MetadataResiliency) String() string {
+func (x *MetadataErrorCodes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetadataResiliency) ProtoMessage() {}
+func (*MetadataErrorCodes) ProtoMessage() {}
 
-func (x *MetadataResiliency) ProtoReflect() protoreflect.Message {
+func (x *MetadataErrorCodes) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -825,32 +1211,40 @@ func (x *MetadataResiliency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetadataResiliency.ProtoReflect.Descriptor instead.
-func (*MetadataResiliency) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataErrorCodes.ProtoReflect.Descriptor instead.
+func (*MetadataErrorCodes) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MetadataResiliency) GetName() string {
+func (x *MetadataErrorCodes) GetApi() string {
 	if x != nil {
-		return x.Name
+		return x.Api
 	}
 	return ""
 }
 
-type AppConnectionProperties struct {
+func (x *MetadataErrorCodes) GetCodes() []*MetadataErrorCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+// MetadataErrorCode is an error code of the Dapr APIs.
+type MetadataErrorCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port           int32                          `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
-	Protocol       string                         `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	ChannelAddress string                         `protobuf:"bytes,3,opt,name=channel_address,json=channelAddress,proto3" json:"channel_address,omitempty"`
-	MaxConcurrency int32                          `protobuf:"varint,4,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
-	Health         *AppConnectionHealthProperties `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
+	// code is the error code of HTTP responses, returned in the errorCode field.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// reason is the reason of the google.rpc.ErrorInfo details of errors with
+	// the code, in the dapr.io domain.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AppConnectionProperties) Reset() {
-	*x = AppConnectionProperties{}
+func (x *MetadataErrorCode) Reset() {
+	*x = MetadataErrorCode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -858,13 +1252,13 @@ func (x *AppConnectionProperties) Reset() {
 	}
 }
 
-func (x *AppConnectionProperties) String() string {
+func (x *MetadataErrorCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppConnectionProperties) ProtoMessage() {}
+func (*MetadataErrorCode) ProtoMessage() {}
 
-func (x *AppConnectionProperties) ProtoReflect() protoreflect.Message {
+func (x *MetadataErrorCode) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -876,59 +1270,35 @@ func (x *AppConnectionProperties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppConnectionProperties.ProtoReflect.Descriptor instead.
-func (*AppConnectionProperties) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataErrorCode.ProtoReflect.Descriptor instead.
+func (*MetadataErrorCode) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AppConnectionProperties) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *AppConnectionProperties) GetProtocol() string {
+func (x *MetadataErrorCode) GetCode() string {
 	if x != nil {
-		return x.Protocol
+		return x.Code
 	}
 	return ""
 }
 
-func (x *AppConnectionProperties) GetChannelAddress() string {
+func (x *MetadataErrorCode) GetReason() string {
 	if x != nil {
-		return x.ChannelAddress
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *AppConnectionProperties) GetMaxConcurrency() int32 {
-	if x != nil {
-		return x.MaxConcurrency
-	}
-	return 0
-}
-
-func (x *AppConnectionProperties) GetHealth() *AppConnectionHealthProperties {
-	if x != nil {
-		return x.Health
-	}
-	return nil
-}
-
-type AppConnectionHealthProperties struct {
+type MetadataWorkflows struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HealthCheckPath     string `protobuf:"bytes,1,opt,name=health_check_path,json=healthCheckPath,proto3" json:"health_check_path,omitempty"`
-	HealthProbeInterval string `protobuf:"bytes,2,opt,name=health_probe_interval,json=healthProbeInterval,proto3" json:"health_probe_interval,omitempty"`
-	HealthProbeTimeout  string `protobuf:"bytes,3,opt,name=health_probe_timeout,json=healthProbeTimeout,proto3" json:"health_probe_timeout,omitempty"`
-	HealthThreshold     int32  `protobuf:"varint,4,opt,name=health_threshold,json=healthThreshold,proto3" json:"health_threshold,omitempty"`
+	ConnectedWorkers int32 `protobuf:"varint,1,opt,name=connected_workers,json=connectedWorkers,proto3" json:"connected_workers,omitempty"`
 }
 
-func (x *AppConnectionHealthProperties) Reset() {
-	*x = AppConnectionHealthProperties{}
+func (x *MetadataWorkflows) Reset() {
+	*x = MetadataWorkflows{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,13 +1306,13 @@ func (x *AppConnectionHealthProperties) Reset() {
 	}
 }
 
-func (x *AppConnectionHealthProperties) String() string {
+func (x *MetadataWorkflows) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppConnectionHealthProperties) ProtoMessage() {}
+func (*MetadataWorkflows) ProtoMessage() {}
 
-func (x *AppConnectionHealthProperties) ProtoReflect() protoreflect.Message {
+func (x *MetadataWorkflows) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -954,54 +1324,34 @@ func (x *AppConnectionHealthProperties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppConnectionHealthProperties.ProtoReflect.Descriptor instead.
-func (*AppConnectionHealthProperties) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataWorkflows.ProtoReflect.Descriptor instead.
+func (*MetadataWorkflows) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AppConnectionHealthProperties) GetHealthCheckPath() string {
-	if x != nil {
-		return x.HealthCheckPath
-	}
-	return ""
-}
-
-func (x *AppConnectionHealthProperties) GetHealthProbeInterval() string {
-	if x != nil {
-		return x.HealthProbeInterval
-	}
-	return ""
-}
-
-func (x *AppConnectionHealthProperties) GetHealthProbeTimeout() string {
-	if x != nil {
-		return x.HealthProbeTimeout
-	}
-	return ""
-}
-
-func (x *AppConnectionHealthProperties) GetHealthThreshold() int32 {
+func (x *MetadataWorkflows) GetConnectedWorkers() int32 {
 	if x != nil {
-		return x.HealthThreshold
+		return x.ConnectedWorkers
 	}
 	return 0
 }
 
-type PubsubSubscription struct {
+// MetadataScheduler is a message that contains the list of addresses of the
+// scheduler connections.
+type MetadataScheduler struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PubsubName      string                   `protobuf:"bytes,1,opt,name=pubsub_name,json=pubsubname,proto3" json:"pubsub_name,omitempty"`
-	Topic           string                   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	Metadata        map[string]string        `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Rules           *PubsubSubscriptionRules `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
-	DeadLetterTopic string                   `protobuf:"bytes,5,opt,name=dead_letter_topic,json=deadLetterTopic,proto3" json:"dead_letter_topic,omitempty"`
-	Type            PubsubSubscriptionType   `protobuf:"varint,6,opt,name=type,proto3,enum=dapr.proto.runtime.v1.PubsubSubscriptionType" json:"type,omitempty"`
+	// connected_addresses the list of addresses of the scheduler connections.
+	ConnectedAddresses []string `protobuf:"bytes,1,rep,name=connected_addresses,json=connectedAddresses,proto3" json:"connected_addresses,omitempty"`
+	// job_failures are the jobs whose most recent trigger delivered to this
+	// sidecar failed.
+	JobFailures []*MetadataJobFailure `protobuf:"bytes,2,rep,name=job_failures,json=jobFailures,proto3" json:"job_failures,omitempty"`
 }
 
-func (x *PubsubSubscription) Reset() {
-	*x = PubsubSubscription{}
+func (x *MetadataScheduler) Reset() {
+	*x = MetadataScheduler{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1009,13 +1359,13 @@ func (x *PubsubSubscription) Reset() {
 	}
 }
 
-func (x *PubsubSubscription) String() string {
+func (x *MetadataScheduler) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PubsubSubscription) ProtoMessage() {}
+func (*MetadataScheduler) ProtoMessage() {}
 
-func (x *PubsubSubscription) ProtoReflect() protoreflect.Message {
+func (x *MetadataScheduler) ProtoReflect() protoreflect.Message {
 	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1027,36 +1377,1150 @@ func (x *PubsubSubscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PubsubSubscription.ProtoReflect.Descriptor instead.
-func (*PubsubSubscription) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetadataScheduler.ProtoReflect.Descriptor instead.
+func (*MetadataScheduler) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PubsubSubscription) GetPubsubName() string {
-	if x != nil {
-		return x.PubsubName
-	}
-	return ""
-}
-
-func (x *PubsubSubscription) GetTopic() string {
+func (x *MetadataScheduler) GetConnectedAddresses() []string {
 	if x != nil {
-		return x.Topic
+		return x.ConnectedAddresses
 	}
-	return ""
+	return nil
 }
 
-func (x *PubsubSubscription) GetMetadata() map[string]string {
+func (x *MetadataScheduler) GetJobFailures() []*MetadataJobFailure {
 	if x != nil {
-		return x.Metadata
+		return x.JobFailures
 	}
 	return nil
 }
 
-func (x *PubsubSubscription) GetRules() *PubsubSubscriptionRules {
-	if x != nil {
-		return x.Rules
-	}
+// MetadataJobFailure is the most recent failed trigger of a job.
+type MetadataJobFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the job.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// time is the time the failed trigger was received, in RFC3339 format.
+	Time string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// error is the reason the trigger failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MetadataJobFailure) Reset() {
+	*x = MetadataJobFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataJobFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataJobFailure) ProtoMessage() {}
+
+func (x *MetadataJobFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataJobFailure.ProtoReflect.Descriptor instead.
+func (*MetadataJobFailure) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MetadataJobFailure) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetadataJobFailure) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *MetadataJobFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ActorRuntime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Contains an enum indicating whether the actor runtime has been initialized.
+	RuntimeStatus ActorRuntime_ActorRuntimeStatus `protobuf:"varint,1,opt,name=runtime_status,json=runtimeStatus,proto3,enum=dapr.proto.runtime.v1.ActorRuntime_ActorRuntimeStatus" json:"runtime_status,omitempty"`
+	// Count of active actors per type.
+	ActiveActors []*ActiveActorsCount `protobuf:"bytes,2,rep,name=active_actors,json=activeActors,proto3" json:"active_actors,omitempty"`
+	// Indicates whether the actor runtime is ready to host actors.
+	HostReady bool `protobuf:"varint,3,opt,name=host_ready,json=hostReady,proto3" json:"host_ready,omitempty"`
+	// Custom message from the placement provider.
+	Placement string `protobuf:"bytes,4,opt,name=placement,proto3" json:"placement,omitempty"`
+}
+
+func (x *ActorRuntime) Reset() {
+	*x = ActorRuntime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActorRuntime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActorRuntime) ProtoMessage() {}
+
+func (x *ActorRuntime) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActorRuntime.ProtoReflect.Descriptor instead.
+func (*ActorRuntime) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ActorRuntime) GetRuntimeStatus() ActorRuntime_ActorRuntimeStatus {
+	if x != nil {
+		return x.RuntimeStatus
+	}
+	return ActorRuntime_INITIALIZING
+}
+
+func (x *ActorRuntime) GetActiveActors() []*ActiveActorsCount {
+	if x != nil {
+		return x.ActiveActors
+	}
+	return nil
+}
+
+func (x *ActorRuntime) GetHostReady() bool {
+	if x != nil {
+		return x.HostReady
+	}
+	return false
+}
+
+func (x *ActorRuntime) GetPlacement() string {
+	if x != nil {
+		return x.Placement
+	}
+	return ""
+}
+
+type ActiveActorsCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ActiveActorsCount) Reset() {
+	*x = ActiveActorsCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveActorsCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveActorsCount) ProtoMessage() {}
+
+func (x *ActiveActorsCount) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveActorsCount.ProtoReflect.Descriptor instead.
+func (*ActiveActorsCount) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ActiveActorsCount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ActiveActorsCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RegisteredComponents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type         string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Version      string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Capabilities []string `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// init_duration_ms is the time initializing the component took, in
+	// milliseconds.
+	InitDurationMs int64 `protobuf:"varint,5,opt,name=init_duration_ms,json=initDurationMs,proto3" json:"init_duration_ms,omitempty"`
+}
+
+func (x *RegisteredComponents) Reset() {
+	*x = RegisteredComponents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredComponents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredComponents) ProtoMessage() {}
+
+func (x *RegisteredComponents) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredComponents.ProtoReflect.Descriptor instead.
+func (*RegisteredComponents) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegisteredComponents) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisteredComponents) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RegisteredComponents) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RegisteredComponents) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *RegisteredComponents) GetInitDurationMs() int64 {
+	if x != nil {
+		return x.InitDurationMs
+	}
+	return 0
+}
+
+type MetadataHTTPEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MetadataHTTPEndpoint) Reset() {
+	*x = MetadataHTTPEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataHTTPEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataHTTPEndpoint) ProtoMessage() {}
+
+func (x *MetadataHTTPEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataHTTPEndpoint.ProtoReflect.Descriptor instead.
+func (*MetadataHTTPEndpoint) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MetadataHTTPEndpoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MetadataMCPServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MetadataMCPServer) Reset() {
+	*x = MetadataMCPServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataMCPServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataMCPServer) ProtoMessage() {}
+
+func (x *MetadataMCPServer) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataMCPServer.ProtoReflect.Descriptor instead.
+func (*MetadataMCPServer) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MetadataMCPServer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// MetadataWorkflowAccessPolicy describes a loaded WorkflowAccessPolicy
+// resource. Lets clients verify which policies are currently in effect on
+// the sidecar, including after a hot reload.
+type MetadataWorkflowAccessPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MetadataWorkflowAccessPolicy) Reset() {
+	*x = MetadataWorkflowAccessPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataWorkflowAccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataWorkflowAccessPolicy) ProtoMessage() {}
+
+func (x *MetadataWorkflowAccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataWorkflowAccessPolicy.ProtoReflect.Descriptor instead.
+func (*MetadataWorkflowAccessPolicy) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MetadataWorkflowAccessPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// MetadataResiliency describes a loaded Resiliency resource. Lets clients
+// verify which resiliency resources are currently in effect on the sidecar,
+// including after a hot reload.
+type MetadataResiliency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// dry_run is true if the policies of the resource are evaluated but not
+	// enforced.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// policies are the policies defined by the resource.
+	Policies []*MetadataResiliencyPolicy `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"`
+	// targets are the targets the policies of the resource apply to.
+	Targets []*MetadataResiliencyTarget `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
+}
+
+func (x *MetadataResiliency) Reset() {
+	*x = MetadataResiliency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataResiliency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataResiliency) ProtoMessage() {}
+
+func (x *MetadataResiliency) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataResiliency.ProtoReflect.Descriptor instead.
+func (*MetadataResiliency) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MetadataResiliency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetadataResiliency) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *MetadataResiliency) GetPolicies() []*MetadataResiliencyPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *MetadataResiliency) GetTargets() []*MetadataResiliencyTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+// MetadataResiliencyPolicy is a policy defined by a Resiliency resource.
+type MetadataResiliencyPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the type of the policy: "timeout", "retry", "circuitBreaker" or
+	// "hedging".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MetadataResiliencyPolicy) Reset() {
+	*x = MetadataResiliencyPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataResiliencyPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataResiliencyPolicy) ProtoMessage() {}
+
+func (x *MetadataResiliencyPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataResiliencyPolicy.ProtoReflect.Descriptor instead.
+func (*MetadataResiliencyPolicy) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MetadataResiliencyPolicy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// MetadataResiliencyTarget is a target the policies of a Resiliency resource
+// apply to.
+type MetadataResiliencyTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the type of the target: "app", "actor" or "component".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// name is the ID of the app, the type of the actors, or the name of the
+	// component.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// direction is "inbound" or "outbound" for components, and empty for the
+	// other targets.
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	// operation is the operation of the component, or the method of the actors,
+	// the policies are overridden for. Empty for the policies of the target.
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Names of the policies applied to the target, empty if none.
+	Timeout        string `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Retry          string `protobuf:"bytes,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	CircuitBreaker string `protobuf:"bytes,7,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	Hedging        string `protobuf:"bytes,8,opt,name=hedging,proto3" json:"hedging,omitempty"`
+}
+
+func (x *MetadataResiliencyTarget) Reset() {
+	*x = MetadataResiliencyTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataResiliencyTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataResiliencyTarget) ProtoMessage() {}
+
+func (x *MetadataResiliencyTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataResiliencyTarget.ProtoReflect.Descriptor instead.
+func (*MetadataResiliencyTarget) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MetadataResiliencyTarget) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyTarget) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyTarget) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyTarget) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyTarget) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyTarget) GetRetry() string {
+	if x != nil {
+		return x.Retry
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyTarget) GetCircuitBreaker() string {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return ""
+}
+
+func (x *MetadataResiliencyTarget) GetHedging() string {
+	if x != nil {
+		return x.Hedging
+	}
+	return ""
+}
+
+// MetadataCircuitBreaker is the current state of a circuit breaker created
+// for a target of a resiliency policy.
+type MetadataCircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the circuit breaker instance.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// policy is the name of the circuit breaker policy.
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// target_type is the type of the target: "app", "actor" or "component".
+	TargetType string `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	// target_name is the ID of the app, the type of the actors, or the name of
+	// the component.
+	TargetName string `protobuf:"bytes,4,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	// state is the state of the circuit breaker: "closed", "half-open" or
+	// "open". While open, calls to the target fail fast.
+	State string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *MetadataCircuitBreaker) Reset() {
+	*x = MetadataCircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataCircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataCircuitBreaker) ProtoMessage() {}
+
+func (x *MetadataCircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataCircuitBreaker.ProtoReflect.Descriptor instead.
+func (*MetadataCircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MetadataCircuitBreaker) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetadataCircuitBreaker) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *MetadataCircuitBreaker) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *MetadataCircuitBreaker) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *MetadataCircuitBreaker) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// MetadataStateMigration is a running or recently finished migration of the
+// state of this app from a state store to another.
+type MetadataStateMigration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the identifier of the migration.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// store_name is the name of the state store the state is copied from.
+	StoreName string `protobuf:"bytes,2,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// target_store_name is the name of the state store the state is copied to.
+	TargetStoreName string `protobuf:"bytes,3,opt,name=target_store_name,json=targetStoreName,proto3" json:"target_store_name,omitempty"`
+	// dry_run is true if the keys are counted but not copied.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// status is the status of the migration.
+	Status MetadataStateMigration_Status `protobuf:"varint,5,opt,name=status,proto3,enum=dapr.proto.runtime.v1.MetadataStateMigration_Status" json:"status,omitempty"`
+	// keys_total is the number of keys to copy.
+	KeysTotal int64 `protobuf:"varint,6,opt,name=keys_total,json=keysTotal,proto3" json:"keys_total,omitempty"`
+	// keys_copied is the number of keys copied so far, or which would be
+	// copied in a dry run.
+	KeysCopied int64 `protobuf:"varint,7,opt,name=keys_copied,json=keysCopied,proto3" json:"keys_copied,omitempty"`
+	// keys_skipped is the number of keys skipped so far, as they exist in the
+	// target state store or no longer exist in the source state store.
+	KeysSkipped int64 `protobuf:"varint,8,opt,name=keys_skipped,json=keysSkipped,proto3" json:"keys_skipped,omitempty"`
+	// start_time is the time the migration started, in RFC3339 format.
+	StartTime string `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time the migration finished, in RFC3339 format.
+	EndTime string `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// error is the reason the migration failed.
+	Error string `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MetadataStateMigration) Reset() {
+	*x = MetadataStateMigration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataStateMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataStateMigration) ProtoMessage() {}
+
+func (x *MetadataStateMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataStateMigration.ProtoReflect.Descriptor instead.
+func (*MetadataStateMigration) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MetadataStateMigration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MetadataStateMigration) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *MetadataStateMigration) GetTargetStoreName() string {
+	if x != nil {
+		return x.TargetStoreName
+	}
+	return ""
+}
+
+func (x *MetadataStateMigration) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *MetadataStateMigration) GetStatus() MetadataStateMigration_Status {
+	if x != nil {
+		return x.Status
+	}
+	return MetadataStateMigration_RUNNING
+}
+
+func (x *MetadataStateMigration) GetKeysTotal() int64 {
+	if x != nil {
+		return x.KeysTotal
+	}
+	return 0
+}
+
+func (x *MetadataStateMigration) GetKeysCopied() int64 {
+	if x != nil {
+		return x.KeysCopied
+	}
+	return 0
+}
+
+func (x *MetadataStateMigration) GetKeysSkipped() int64 {
+	if x != nil {
+		return x.KeysSkipped
+	}
+	return 0
+}
+
+func (x *MetadataStateMigration) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MetadataStateMigration) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *MetadataStateMigration) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AppConnectionProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port           int32                          `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol       string                         `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ChannelAddress string                         `protobuf:"bytes,3,opt,name=channel_address,json=channelAddress,proto3" json:"channel_address,omitempty"`
+	MaxConcurrency int32                          `protobuf:"varint,4,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	Health         *AppConnectionHealthProperties `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *AppConnectionProperties) Reset() {
+	*x = AppConnectionProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppConnectionProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppConnectionProperties) ProtoMessage() {}
+
+func (x *AppConnectionProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppConnectionProperties.ProtoReflect.Descriptor instead.
+func (*AppConnectionProperties) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AppConnectionProperties) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AppConnectionProperties) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *AppConnectionProperties) GetChannelAddress() string {
+	if x != nil {
+		return x.ChannelAddress
+	}
+	return ""
+}
+
+func (x *AppConnectionProperties) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *AppConnectionProperties) GetHealth() *AppConnectionHealthProperties {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type AppConnectionHealthProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HealthCheckPath     string `protobuf:"bytes,1,opt,name=health_check_path,json=healthCheckPath,proto3" json:"health_check_path,omitempty"`
+	HealthProbeInterval string `protobuf:"bytes,2,opt,name=health_probe_interval,json=healthProbeInterval,proto3" json:"health_probe_interval,omitempty"`
+	HealthProbeTimeout  string `protobuf:"bytes,3,opt,name=health_probe_timeout,json=healthProbeTimeout,proto3" json:"health_probe_timeout,omitempty"`
+	HealthThreshold     int32  `protobuf:"varint,4,opt,name=health_threshold,json=healthThreshold,proto3" json:"health_threshold,omitempty"`
+}
+
+func (x *AppConnectionHealthProperties) Reset() {
+	*x = AppConnectionHealthProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppConnectionHealthProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppConnectionHealthProperties) ProtoMessage() {}
+
+func (x *AppConnectionHealthProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppConnectionHealthProperties.ProtoReflect.Descriptor instead.
+func (*AppConnectionHealthProperties) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AppConnectionHealthProperties) GetHealthCheckPath() string {
+	if x != nil {
+		return x.HealthCheckPath
+	}
+	return ""
+}
+
+func (x *AppConnectionHealthProperties) GetHealthProbeInterval() string {
+	if x != nil {
+		return x.HealthProbeInterval
+	}
+	return ""
+}
+
+func (x *AppConnectionHealthProperties) GetHealthProbeTimeout() string {
+	if x != nil {
+		return x.HealthProbeTimeout
+	}
+	return ""
+}
+
+func (x *AppConnectionHealthProperties) GetHealthThreshold() int32 {
+	if x != nil {
+		return x.HealthThreshold
+	}
+	return 0
+}
+
+type PubsubSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubsubName      string                   `protobuf:"bytes,1,opt,name=pubsub_name,json=pubsubname,proto3" json:"pubsub_name,omitempty"`
+	Topic           string                   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Metadata        map[string]string        `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Rules           *PubsubSubscriptionRules `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	DeadLetterTopic string                   `protobuf:"bytes,5,opt,name=dead_letter_topic,json=deadLetterTopic,proto3" json:"dead_letter_topic,omitempty"`
+	Type            PubsubSubscriptionType   `protobuf:"varint,6,opt,name=type,proto3,enum=dapr.proto.runtime.v1.PubsubSubscriptionType" json:"type,omitempty"`
+	// The bulk subscribe settings of the subscription, if set.
+	BulkSubscribe *BulkSubscribeConfig `protobuf:"bytes,7,opt,name=bulk_subscribe,json=bulkSubscribe,proto3" json:"bulk_subscribe,omitempty"`
+	// Whether the delivery of the messages of the topic to the app is paused.
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *PubsubSubscription) Reset() {
+	*x = PubsubSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubsubSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubsubSubscription) ProtoMessage() {}
+
+func (x *PubsubSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubsubSubscription.ProtoReflect.Descriptor instead.
+func (*PubsubSubscription) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PubsubSubscription) GetPubsubName() string {
+	if x != nil {
+		return x.PubsubName
+	}
+	return ""
+}
+
+func (x *PubsubSubscription) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PubsubSubscription) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PubsubSubscription) GetRules() *PubsubSubscriptionRules {
+	if x != nil {
+		return x.Rules
+	}
 	return nil
 }
 
@@ -1074,6 +2538,20 @@ func (x *PubsubSubscription) GetType() PubsubSubscriptionType {
 	return PubsubSubscriptionType_UNKNOWN
 }
 
+func (x *PubsubSubscription) GetBulkSubscribe() *BulkSubscribeConfig {
+	if x != nil {
+		return x.BulkSubscribe
+	}
+	return nil
+}
+
+func (x *PubsubSubscription) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
 type PubsubSubscriptionRules struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1085,7 +2563,7 @@ type PubsubSubscriptionRules struct {
 func (x *PubsubSubscriptionRules) Reset() {
 	*x = PubsubSubscriptionRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[14]
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1098,7 +2576,7 @@ func (x *PubsubSubscriptionRules) String() string {
 func (*PubsubSubscriptionRules) ProtoMessage() {}
 
 func (x *PubsubSubscriptionRules) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[14]
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +2589,7 @@ func (x *PubsubSubscriptionRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubsubSubscriptionRules.ProtoReflect.Descriptor instead.
 func (*PubsubSubscriptionRules) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{14}
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *PubsubSubscriptionRules) GetRules() []*PubsubSubscriptionRule {
@@ -1133,7 +2611,7 @@ type PubsubSubscriptionRule struct {
 func (x *PubsubSubscriptionRule) Reset() {
 	*x = PubsubSubscriptionRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[15]
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1146,7 +2624,7 @@ func (x *PubsubSubscriptionRule) String() string {
 func (*PubsubSubscriptionRule) ProtoMessage() {}
 
 func (x *PubsubSubscriptionRule) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[15]
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +2637,7 @@ func (x *PubsubSubscriptionRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubsubSubscriptionRule.ProtoReflect.Descriptor instead.
 func (*PubsubSubscriptionRule) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{15}
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PubsubSubscriptionRule) GetMatch() string {
@@ -1188,7 +2666,7 @@ type SetMetadataRequest struct {
 func (x *SetMetadataRequest) Reset() {
 	*x = SetMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[16]
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1201,7 +2679,7 @@ func (x *SetMetadataRequest) String() string {
 func (*SetMetadataRequest) ProtoMessage() {}
 
 func (x *SetMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[16]
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +2692,7 @@ func (x *SetMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMetadataRequest.ProtoReflect.Descriptor instead.
 func (*SetMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{16}
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SetMetadataRequest) GetKey() string {
@@ -1231,226 +2709,804 @@ func (x *SetMetadataRequest) GetValue() string {
 	return ""
 }
 
+// GetActiveActorsRequest is the message to list the actors active on this host.
+type GetActiveActorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// actor_type is the optional type to list the actors of. If empty, the
+	// actors of all the hosted types are listed.
+	ActorType string `protobuf:"bytes,1,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	// page_size is the optional maximum number of actors to return. If not set
+	// or 0, only the counts are returned.
+	PageSize *uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// continuation_token is the optional token returned by a previous
+	// GetActiveActorsAlpha1 call, to list the next page of actors.
+	ContinuationToken *string `protobuf:"bytes,3,opt,name=continuation_token,json=continuationToken,proto3,oneof" json:"continuation_token,omitempty"`
+}
+
+func (x *GetActiveActorsRequest) Reset() {
+	*x = GetActiveActorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveActorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveActorsRequest) ProtoMessage() {}
+
+func (x *GetActiveActorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveActorsRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveActorsRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetActiveActorsRequest) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *GetActiveActorsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *GetActiveActorsRequest) GetContinuationToken() string {
+	if x != nil && x.ContinuationToken != nil {
+		return *x.ContinuationToken
+	}
+	return ""
+}
+
+// GetActiveActorsResponse is the response of the actors active on this host.
+type GetActiveActorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Count of active actors per type.
+	Counts []*ActiveActorsCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	// The page of active actors, ordered by type then ID.
+	Actors []*ActiveActor `protobuf:"bytes,2,rep,name=actors,proto3" json:"actors,omitempty"`
+	// continuation_token is set if there are more actors to list, and can be
+	// passed in the next GetActiveActorsAlpha1 call to list the next page.
+	ContinuationToken string `protobuf:"bytes,3,opt,name=continuation_token,json=continuationToken,proto3" json:"continuation_token,omitempty"`
+}
+
+func (x *GetActiveActorsResponse) Reset() {
+	*x = GetActiveActorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveActorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveActorsResponse) ProtoMessage() {}
+
+func (x *GetActiveActorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveActorsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveActorsResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetActiveActorsResponse) GetCounts() []*ActiveActorsCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GetActiveActorsResponse) GetActors() []*ActiveActor {
+	if x != nil {
+		return x.Actors
+	}
+	return nil
+}
+
+func (x *GetActiveActorsResponse) GetContinuationToken() string {
+	if x != nil {
+		return x.ContinuationToken
+	}
+	return ""
+}
+
+type ActiveActor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id   string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ActiveActor) Reset() {
+	*x = ActiveActor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveActor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveActor) ProtoMessage() {}
+
+func (x *ActiveActor) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveActor.ProtoReflect.Descriptor instead.
+func (*ActiveActor) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ActiveActor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ActiveActor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetResiliencyRequest is the message to get the resiliency policies loaded
+// by the sidecar.
+type GetResiliencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetResiliencyRequest) Reset() {
+	*x = GetResiliencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResiliencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResiliencyRequest) ProtoMessage() {}
+
+func (x *GetResiliencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResiliencyRequest.ProtoReflect.Descriptor instead.
+func (*GetResiliencyRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{35}
+}
+
+// GetResiliencyResponse is the response with the resiliency policies loaded
+// by the sidecar, and the state of their circuit breakers.
+type GetResiliencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resiliencies    []*MetadataResiliency     `protobuf:"bytes,1,rep,name=resiliencies,proto3" json:"resiliencies,omitempty"`
+	CircuitBreakers []*MetadataCircuitBreaker `protobuf:"bytes,2,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+}
+
+func (x *GetResiliencyResponse) Reset() {
+	*x = GetResiliencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResiliencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResiliencyResponse) ProtoMessage() {}
+
+func (x *GetResiliencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_metadata_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResiliencyResponse.ProtoReflect.Descriptor instead.
+func (*GetResiliencyResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetResiliencyResponse) GetResiliencies() []*MetadataResiliency {
+	if x != nil {
+		return x.Resiliencies
+	}
+	return nil
+}
+
+func (x *GetResiliencyResponse) GetCircuitBreakers() []*MetadataCircuitBreaker {
+	if x != nil {
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
 var File_dapr_proto_runtime_v1_metadata_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_runtime_v1_metadata_proto_rawDesc = []byte{
 	0x0a, 0x24, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e,
 	0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x14, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xea, 0x09, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x27, 0x64,
+	0x61, 0x70, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xf6, 0x0e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x15, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x65, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x0e, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x54, 0x54, 0x50, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x6a, 0x0a, 0x19, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x17, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x48, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64,
+	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x48, 0x01, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x0b, 0x6d, 0x63, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x56,
-	0x0a, 0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x0a, 0x6d, 0x63, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x6d,
+	0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x4d, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x10,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x5b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
 	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x65, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
-	0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x40, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x4f, 0x0a,
-	0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62,
-	0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52,
-	0x0a, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12,
+	0x52, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x54, 0x54, 0x50, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x6a, 0x0a, 0x19, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x17, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x27,
-	0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x0c,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x09,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x10,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x17, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x43, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x15, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64,
 	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x57, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x48, 0x01, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x73, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x0b, 0x6d, 0x63, 0x70, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x42, 0x0a,
+	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x3a, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41,
+	0x50, 0x49, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0x85, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x54, 0x72,
+	0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x7a, 0x69, 0x70, 0x6b, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x7a, 0x69, 0x70, 0x6b, 0x69, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x74,
+	0x65, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6f, 0x74, 0x65, 0x6c, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6f, 0x74, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x74, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x74, 0x65, 0x6c, 0x5f, 0x69, 0x73, 0x5f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x74, 0x65,
+	0x6c, 0x49, 0x73, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x22, 0x87, 0x02, 0x0a, 0x0f, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x69, 0x6e,
+	0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x68, 0x74, 0x74, 0x70, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x68, 0x74, 0x74, 0x70, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x65, 0x72, 0x62,
+	0x73, 0x12, 0x40, 0x0a, 0x1c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x1a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x41, 0x50, 0x49, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x61,
 	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x43, 0x50, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x6d, 0x63, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x73, 0x12, 0x6d, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63,
-	0x79, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a,
-	0x43, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
-	0x22, 0x40, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x73, 0x22, 0x44, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x50, 0x49, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x41, 0x50, 0x49, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x06, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x22, 0x77, 0x0a, 0x15, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x41, 0x50, 0x49, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x65, 0x72, 0x62, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x65, 0x72, 0x62,
+	0x73, 0x22, 0x93, 0x02, 0x0a, 0x17, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x23, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x1b, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x3e,
+	0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x3f,
+	0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x40, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x73, 0x22, 0x92, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63,
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
 	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x0c, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x36, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e,
-	0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55,
-	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x22, 0x3d, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7c, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x48, 0x54, 0x54, 0x50, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0c, 0x6a, 0x6f, 0x62, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a,
+	0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x0b, 0x6a, 0x6f, 0x62, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x27, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x43, 0x50, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x1c, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a,
-	0x12, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65,
-	0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f,
-	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x4c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x22, 0xdc, 0x01, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x22, 0x92, 0x03, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62,
-	0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x12, 0x53, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x73, 0x75,
-	0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbc, 0x02, 0x0a, 0x0c, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c,
+	0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x22, 0x3d, 0x0a, 0x11, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x22, 0x2a, 0x0a, 0x14, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x54, 0x54,
+	0x50, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x27, 0x0a,
+	0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x1c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x12, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x4b,
+	0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64,
+	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x18, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x18, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63,
+	0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x22, 0x9c,
+	0x01, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xbf, 0x03,
+	0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x4c, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x64,
+	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65,
+	0x79, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79,
+	0x73, 0x5f, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6b, 0x65, 0x79, 0x73, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65,
+	0x79, 0x73, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6b, 0x65, 0x79, 0x73, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x30, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22,
+	0xe9, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x4c, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xdc, 0x01, 0x0a, 0x1d,
+	0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x30, 0x0a,
+	0x14, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xfd, 0x03, 0x0a, 0x12, 0x50,
+	0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64,
+	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x41, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x62, 0x75, 0x6c, 0x6b, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x17, 0x50, 0x75,
+	0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62,
 	0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x64,
-	0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x41, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x75,
 	0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x17, 0x50, 0x75, 0x62, 0x73, 0x75,
-	0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x75, 0x62, 0x73, 0x75,
-	0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3c, 0x0a, 0x12, 0x53,
-	0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x57, 0x0a, 0x16, 0x50, 0x75, 0x62,
-	0x73, 0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x43, 0x4c, 0x41, 0x52, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x4d, 0x41, 0x54, 0x49,
-	0x43, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47,
-	0x10, 0x03, 0x42, 0x71, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31,
-	0x42, 0x12, 0x44, 0x61, 0x70, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3c,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb2, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74,
+	0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x3a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x0b, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x58,
+	0x0a, 0x10, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x2a, 0x57, 0x0a, 0x16, 0x50, 0x75, 0x62, 0x73,
+	0x75, 0x62, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x43, 0x4c, 0x41, 0x52, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x4d, 0x41, 0x54, 0x49, 0x43,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x10,
+	0x03, 0x42, 0x71, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42,
+	0x12, 0x44, 0x61, 0x70, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1465,56 +3521,104 @@ func file_dapr_proto_runtime_v1_metadata_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_metadata_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_dapr_proto_runtime_v1_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_dapr_proto_runtime_v1_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_dapr_proto_runtime_v1_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_dapr_proto_runtime_v1_metadata_proto_goTypes = []interface{}{
 	(PubsubSubscriptionType)(0),           // 0: dapr.proto.runtime.v1.PubsubSubscriptionType
-	(ActorRuntime_ActorRuntimeStatus)(0),  // 1: dapr.proto.runtime.v1.ActorRuntime.ActorRuntimeStatus
-	(*GetMetadataRequest)(nil),            // 2: dapr.proto.runtime.v1.GetMetadataRequest
-	(*GetMetadataResponse)(nil),           // 3: dapr.proto.runtime.v1.GetMetadataResponse
-	(*MetadataWorkflows)(nil),             // 4: dapr.proto.runtime.v1.MetadataWorkflows
-	(*MetadataScheduler)(nil),             // 5: dapr.proto.runtime.v1.MetadataScheduler
-	(*ActorRuntime)(nil),                  // 6: dapr.proto.runtime.v1.ActorRuntime
-	(*ActiveActorsCount)(nil),             // 7: dapr.proto.runtime.v1.ActiveActorsCount
-	(*RegisteredComponents)(nil),          // 8: dapr.proto.runtime.v1.RegisteredComponents
-	(*MetadataHTTPEndpoint)(nil),          // 9: dapr.proto.runtime.v1.MetadataHTTPEndpoint
-	(*MetadataMCPServer)(nil),             // 10: dapr.proto.runtime.v1.MetadataMCPServer
-	(*MetadataWorkflowAccessPolicy)(nil),  // 11: dapr.proto.runtime.v1.MetadataWorkflowAccessPolicy
-	(*MetadataResiliency)(nil),            // 12: dapr.proto.runtime.v1.MetadataResiliency
-	(*AppConnectionProperties)(nil),       // 13: dapr.proto.runtime.v1.AppConnectionProperties
-	(*AppConnectionHealthProperties)(nil), // 14: dapr.proto.runtime.v1.AppConnectionHealthProperties
-	(*PubsubSubscription)(nil),            // 15: dapr.proto.runtime.v1.PubsubSubscription
-	(*PubsubSubscriptionRules)(nil),       // 16: dapr.proto.runtime.v1.PubsubSubscriptionRules
-	(*PubsubSubscriptionRule)(nil),        // 17: dapr.proto.runtime.v1.PubsubSubscriptionRule
-	(*SetMetadataRequest)(nil),            // 18: dapr.proto.runtime.v1.SetMetadataRequest
-	nil,                                   // 19: dapr.proto.runtime.v1.GetMetadataResponse.ExtendedMetadataEntry
-	nil,                                   // 20: dapr.proto.runtime.v1.PubsubSubscription.MetadataEntry
+	(MetadataComponentReload_Status)(0),   // 1: dapr.proto.runtime.v1.MetadataComponentReload.Status
+	(ActorRuntime_ActorRuntimeStatus)(0),  // 2: dapr.proto.runtime.v1.ActorRuntime.ActorRuntimeStatus
+	(MetadataStateMigration_Status)(0),    // 3: dapr.proto.runtime.v1.MetadataStateMigration.Status
+	(*GetMetadataRequest)(nil),            // 4: dapr.proto.runtime.v1.GetMetadataRequest
+	(*GetMetadataResponse)(nil),           // 5: dapr.proto.runtime.v1.GetMetadataResponse
+	(*MetadataConfiguration)(nil),         // 6: dapr.proto.runtime.v1.MetadataConfiguration
+	(*MetadataTracing)(nil),               // 7: dapr.proto.runtime.v1.MetadataTracing
+	(*MetadataMetrics)(nil),               // 8: dapr.proto.runtime.v1.MetadataMetrics
+	(*MetadataFeature)(nil),               // 9: dapr.proto.runtime.v1.MetadataFeature
+	(*MetadataAPIAccess)(nil),             // 10: dapr.proto.runtime.v1.MetadataAPIAccess
+	(*MetadataAPIAccessRule)(nil),         // 11: dapr.proto.runtime.v1.MetadataAPIAccessRule
+	(*MetadataComponentReload)(nil),       // 12: dapr.proto.runtime.v1.MetadataComponentReload
+	(*MetadataConfigurationReload)(nil),   // 13: dapr.proto.runtime.v1.MetadataConfigurationReload
+	(*MetadataErrorCodes)(nil),            // 14: dapr.proto.runtime.v1.MetadataErrorCodes
+	(*MetadataErrorCode)(nil),             // 15: dapr.proto.runtime.v1.MetadataErrorCode
+	(*MetadataWorkflows)(nil),             // 16: dapr.proto.runtime.v1.MetadataWorkflows
+	(*MetadataScheduler)(nil),             // 17: dapr.proto.runtime.v1.MetadataScheduler
+	(*MetadataJobFailure)(nil),            // 18: dapr.proto.runtime.v1.MetadataJobFailure
+	(*ActorRuntime)(nil),                  // 19: dapr.proto.runtime.v1.ActorRuntime
+	(*ActiveActorsCount)(nil),             // 20: dapr.proto.runtime.v1.ActiveActorsCount
+	(*RegisteredComponents)(nil),          // 21: dapr.proto.runtime.v1.RegisteredComponents
+	(*MetadataHTTPEndpoint)(nil),          // 22: dapr.proto.runtime.v1.MetadataHTTPEndpoint
+	(*MetadataMCPServer)(nil),             // 23: dapr.proto.runtime.v1.MetadataMCPServer
+	(*MetadataWorkflowAccessPolicy)(nil),  // 24: dapr.proto.runtime.v1.MetadataWorkflowAccessPolicy
+	(*MetadataResiliency)(nil),            // 25: dapr.proto.runtime.v1.MetadataResiliency
+	(*MetadataResiliencyPolicy)(nil),      // 26: dapr.proto.runtime.v1.MetadataResiliencyPolicy
+	(*MetadataResiliencyTarget)(nil),      // 27: dapr.proto.runtime.v1.MetadataResiliencyTarget
+	(*MetadataCircuitBreaker)(nil),        // 28: dapr.proto.runtime.v1.MetadataCircuitBreaker
+	(*MetadataStateMigration)(nil),        // 29: dapr.proto.runtime.v1.MetadataStateMigration
+	(*AppConnectionProperties)(nil),       // 30: dapr.proto.runtime.v1.AppConnectionProperties
+	(*AppConnectionHealthProperties)(nil), // 31: dapr.proto.runtime.v1.AppConnectionHealthProperties
+	(*PubsubSubscription)(nil),            // 32: dapr.proto.runtime.v1.PubsubSubscription
+	(*PubsubSubscriptionRules)(nil),       // 33: dapr.proto.runtime.v1.PubsubSubscriptionRules
+	(*PubsubSubscriptionRule)(nil),        // 34: dapr.proto.runtime.v1.PubsubSubscriptionRule
+	(*SetMetadataRequest)(nil),            // 35: dapr.proto.runtime.v1.SetMetadataRequest
+	(*GetActiveActorsRequest)(nil),        // 36: dapr.proto.runtime.v1.GetActiveActorsRequest
+	(*GetActiveActorsResponse)(nil),       // 37: dapr.proto.runtime.v1.GetActiveActorsResponse
+	(*ActiveActor)(nil),                   // 38: dapr.proto.runtime.v1.ActiveActor
+	(*GetResiliencyRequest)(nil),          // 39: dapr.proto.runtime.v1.GetResiliencyRequest
+	(*GetResiliencyResponse)(nil),         // 40: dapr.proto.runtime.v1.GetResiliencyResponse
+	nil,                                   // 41: dapr.proto.runtime.v1.GetMetadataResponse.ExtendedMetadataEntry
+	nil,                                   // 42: dapr.proto.runtime.v1.PubsubSubscription.MetadataEntry
+	(*MetadataFault)(nil),                 // 43: dapr.proto.runtime.v1.MetadataFault
+	(*BulkSubscribeConfig)(nil),           // 44: dapr.proto.runtime.v1.BulkSubscribeConfig
 }
 var file_dapr_proto_runtime_v1_metadata_proto_depIdxs = []int32{
-	7,  // 0: dapr.proto.runtime.v1.GetMetadataResponse.active_actors_count:type_name -> dapr.proto.runtime.v1.ActiveActorsCount
-	8,  // 1: dapr.proto.runtime.v1.GetMetadataResponse.registered_components:type_name -> dapr.proto.runtime.v1.RegisteredComponents
-	19, // 2: dapr.proto.runtime.v1.GetMetadataResponse.extended_metadata:type_name -> dapr.proto.runtime.v1.GetMetadataResponse.ExtendedMetadataEntry
-	15, // 3: dapr.proto.runtime.v1.GetMetadataResponse.subscriptions:type_name -> dapr.proto.runtime.v1.PubsubSubscription
-	9,  // 4: dapr.proto.runtime.v1.GetMetadataResponse.http_endpoints:type_name -> dapr.proto.runtime.v1.MetadataHTTPEndpoint
-	13, // 5: dapr.proto.runtime.v1.GetMetadataResponse.app_connection_properties:type_name -> dapr.proto.runtime.v1.AppConnectionProperties
-	6,  // 6: dapr.proto.runtime.v1.GetMetadataResponse.actor_runtime:type_name -> dapr.proto.runtime.v1.ActorRuntime
-	5,  // 7: dapr.proto.runtime.v1.GetMetadataResponse.scheduler:type_name -> dapr.proto.runtime.v1.MetadataScheduler
-	4,  // 8: dapr.proto.runtime.v1.GetMetadataResponse.workflows:type_name -> dapr.proto.runtime.v1.MetadataWorkflows
-	10, // 9: dapr.proto.runtime.v1.GetMetadataResponse.mcp_servers:type_name -> dapr.proto.runtime.v1.MetadataMCPServer
-	11, // 10: dapr.proto.runtime.v1.GetMetadataResponse.workflow_access_policies:type_name -> dapr.proto.runtime.v1.MetadataWorkflowAccessPolicy
-	12, // 11: dapr.proto.runtime.v1.GetMetadataResponse.resiliencies:type_name -> dapr.proto.runtime.v1.MetadataResiliency
-	1,  // 12: dapr.proto.runtime.v1.ActorRuntime.runtime_status:type_name -> dapr.proto.runtime.v1.ActorRuntime.ActorRuntimeStatus
-	7,  // 13: dapr.proto.runtime.v1.ActorRuntime.active_actors:type_name -> dapr.proto.runtime.v1.ActiveActorsCount
-	14, // 14: dapr.proto.runtime.v1.AppConnectionProperties.health:type_name -> dapr.proto.runtime.v1.AppConnectionHealthProperties
-	20, // 15: dapr.proto.runtime.v1.PubsubSubscription.metadata:type_name -> dapr.proto.runtime.v1.PubsubSubscription.MetadataEntry
-	16, // 16: dapr.proto.runtime.v1.PubsubSubscription.rules:type_name -> dapr.proto.runtime.v1.PubsubSubscriptionRules
-	0,  // 17: dapr.proto.runtime.v1.PubsubSubscription.type:type_name -> dapr.proto.runtime.v1.PubsubSubscriptionType
-	17, // 18: dapr.proto.runtime.v1.PubsubSubscriptionRules.rules:type_name -> dapr.proto.runtime.v1.PubsubSubscriptionRule
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	20, // 0: dapr.proto.runtime.v1.GetMetadataResponse.active_actors_count:type_name -> dapr.proto.runtime.v1.ActiveActorsCount
+	21, // 1: dapr.proto.runtime.v1.GetMetadataResponse.registered_components:type_name -> dapr.proto.runtime.v1.RegisteredComponents
+	41, // 2: dapr.proto.runtime.v1.GetMetadataResponse.extended_metadata:type_name -> dapr.proto.runtime.v1.GetMetadataResponse.ExtendedMetadataEntry
+	32, // 3: dapr.proto.runtime.v1.GetMetadataResponse.subscriptions:type_name -> dapr.proto.runtime.v1.PubsubSubscription
+	22, // 4: dapr.proto.runtime.v1.GetMetadataResponse.http_endpoints:type_name -> dapr.proto.runtime.v1.MetadataHTTPEndpoint
+	30, // 5: dapr.proto.runtime.v1.GetMetadataResponse.app_connection_properties:type_name -> dapr.proto.runtime.v1.AppConnectionProperties
+	19, // 6: dapr.proto.runtime.v1.GetMetadataResponse.actor_runtime:type_name -> dapr.proto.runtime.v1.ActorRuntime
+	17, // 7: dapr.proto.runtime.v1.GetMetadataResponse.scheduler:type_name -> dapr.proto.runtime.v1.MetadataScheduler
+	16, // 8: dapr.proto.runtime.v1.GetMetadataResponse.workflows:type_name -> dapr.proto.runtime.v1.MetadataWorkflows
+	23, // 9: dapr.proto.runtime.v1.GetMetadataResponse.mcp_servers:type_name -> dapr.proto.runtime.v1.MetadataMCPServer
+	24, // 10: dapr.proto.runtime.v1.GetMetadataResponse.workflow_access_policies:type_name -> dapr.proto.runtime.v1.MetadataWorkflowAccessPolicy
+	25, // 11: dapr.proto.runtime.v1.GetMetadataResponse.resiliencies:type_name -> dapr.proto.runtime.v1.MetadataResiliency
+	29, // 12: dapr.proto.runtime.v1.GetMetadataResponse.state_migrations:type_name -> dapr.proto.runtime.v1.MetadataStateMigration
+	14, // 13: dapr.proto.runtime.v1.GetMetadataResponse.error_codes:type_name -> dapr.proto.runtime.v1.MetadataErrorCodes
+	12, // 14: dapr.proto.runtime.v1.GetMetadataResponse.component_reloads:type_name -> dapr.proto.runtime.v1.MetadataComponentReload
+	6,  // 15: dapr.proto.runtime.v1.GetMetadataResponse.configuration:type_name -> dapr.proto.runtime.v1.MetadataConfiguration
+	13, // 16: dapr.proto.runtime.v1.GetMetadataResponse.configuration_reloads:type_name -> dapr.proto.runtime.v1.MetadataConfigurationReload
+	28, // 17: dapr.proto.runtime.v1.GetMetadataResponse.circuit_breakers:type_name -> dapr.proto.runtime.v1.MetadataCircuitBreaker
+	43, // 18: dapr.proto.runtime.v1.GetMetadataResponse.faults:type_name -> dapr.proto.runtime.v1.MetadataFault
+	7,  // 19: dapr.proto.runtime.v1.MetadataConfiguration.tracing:type_name -> dapr.proto.runtime.v1.MetadataTracing
+	8,  // 20: dapr.proto.runtime.v1.MetadataConfiguration.metrics:type_name -> dapr.proto.runtime.v1.MetadataMetrics
+	9,  // 21: dapr.proto.runtime.v1.MetadataConfiguration.features:type_name -> dapr.proto.runtime.v1.MetadataFeature
+	10, // 22: dapr.proto.runtime.v1.MetadataConfiguration.api:type_name -> dapr.proto.runtime.v1.MetadataAPIAccess
+	11, // 23: dapr.proto.runtime.v1.MetadataAPIAccess.allowed:type_name -> dapr.proto.runtime.v1.MetadataAPIAccessRule
+	11, // 24: dapr.proto.runtime.v1.MetadataAPIAccess.denied:type_name -> dapr.proto.runtime.v1.MetadataAPIAccessRule
+	1,  // 25: dapr.proto.runtime.v1.MetadataComponentReload.status:type_name -> dapr.proto.runtime.v1.MetadataComponentReload.Status
+	15, // 26: dapr.proto.runtime.v1.MetadataErrorCodes.codes:type_name -> dapr.proto.runtime.v1.MetadataErrorCode
+	18, // 27: dapr.proto.runtime.v1.MetadataScheduler.job_failures:type_name -> dapr.proto.runtime.v1.MetadataJobFailure
+	2,  // 28: dapr.proto.runtime.v1.ActorRuntime.runtime_status:type_name -> dapr.proto.runtime.v1.ActorRuntime.ActorRuntimeStatus
+	20, // 29: dapr.proto.runtime.v1.ActorRuntime.active_actors:type_name -> dapr.proto.runtime.v1.ActiveActorsCount
+	26, // 30: dapr.proto.runtime.v1.MetadataResiliency.policies:type_name -> dapr.proto.runtime.v1.MetadataResiliencyPolicy
+	27, // 31: dapr.proto.runtime.v1.MetadataResiliency.targets:type_name -> dapr.proto.runtime.v1.MetadataResiliencyTarget
+	3,  // 32: dapr.proto.runtime.v1.MetadataStateMigration.status:type_name -> dapr.proto.runtime.v1.MetadataStateMigration.Status
+	31, // 33: dapr.proto.runtime.v1.AppConnectionProperties.health:type_name -> dapr.proto.runtime.v1.AppConnectionHealthProperties
+	42, // 34: dapr.proto.runtime.v1.PubsubSubscription.metadata:type_name -> dapr.proto.runtime.v1.PubsubSubscription.MetadataEntry
+	33, // 35: dapr.proto.runtime.v1.PubsubSubscription.rules:type_name -> dapr.proto.runtime.v1.PubsubSubscriptionRules
+	0,  // 36: dapr.proto.runtime.v1.PubsubSubscription.type:type_name -> dapr.proto.runtime.v1.PubsubSubscriptionType
+	44, // 37: dapr.proto.runtime.v1.PubsubSubscription.bulk_subscribe:type_name -> dapr.proto.runtime.v1.BulkSubscribeConfig
+	34, // 38: dapr.proto.runtime.v1.PubsubSubscriptionRules.rules:type_name -> dapr.proto.runtime.v1.PubsubSubscriptionRule
+	20, // 39: dapr.proto.runtime.v1.GetActiveActorsResponse.counts:type_name -> dapr.proto.runtime.v1.ActiveActorsCount
+	38, // 40: dapr.proto.runtime.v1.GetActiveActorsResponse.actors:type_name -> dapr.proto.runtime.v1.ActiveActor
+	25, // 41: dapr.proto.runtime.v1.GetResiliencyResponse.resiliencies:type_name -> dapr.proto.runtime.v1.MetadataResiliency
+	28, // 42: dapr.proto.runtime.v1.GetResiliencyResponse.circuit_breakers:type_name -> dapr.proto.runtime.v1.MetadataCircuitBreaker
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_runtime_v1_metadata_proto_init() }
@@ -1522,6 +3626,8 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 	if File_dapr_proto_runtime_v1_metadata_proto != nil {
 		return
 	}
+	file_dapr_proto_runtime_v1_appcallback_proto_init()
+	file_dapr_proto_runtime_v1_faults_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMetadataRequest); i {
@@ -1548,7 +3654,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataWorkflows); i {
+			switch v := v.(*MetadataConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1560,7 +3666,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataScheduler); i {
+			switch v := v.(*MetadataTracing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1572,7 +3678,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActorRuntime); i {
+			switch v := v.(*MetadataMetrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1584,7 +3690,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveActorsCount); i {
+			switch v := v.(*MetadataFeature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1596,7 +3702,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisteredComponents); i {
+			switch v := v.(*MetadataAPIAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1608,7 +3714,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataHTTPEndpoint); i {
+			switch v := v.(*MetadataAPIAccessRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1620,7 +3726,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataMCPServer); i {
+			switch v := v.(*MetadataComponentReload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1632,7 +3738,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataWorkflowAccessPolicy); i {
+			switch v := v.(*MetadataConfigurationReload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1644,7 +3750,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataResiliency); i {
+			switch v := v.(*MetadataErrorCodes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1656,7 +3762,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppConnectionProperties); i {
+			switch v := v.(*MetadataErrorCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1668,7 +3774,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppConnectionHealthProperties); i {
+			switch v := v.(*MetadataWorkflows); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1680,7 +3786,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubsubSubscription); i {
+			switch v := v.(*MetadataScheduler); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1692,7 +3798,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubsubSubscriptionRules); i {
+			switch v := v.(*MetadataJobFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1704,7 +3810,7 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubsubSubscriptionRule); i {
+			switch v := v.(*ActorRuntime); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1716,6 +3822,186 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 			}
 		}
 		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveActorsCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredComponents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataHTTPEndpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataMCPServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataWorkflowAccessPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataResiliency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataResiliencyPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataResiliencyTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataCircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataStateMigration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppConnectionProperties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppConnectionHealthProperties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubsubSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubsubSubscriptionRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubsubSubscriptionRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetMetadataRequest); i {
 			case 0:
 				return &v.state
@@ -1727,15 +4013,76 @@ func file_dapr_proto_runtime_v1_metadata_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActiveActorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActiveActorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveActor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResiliencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_metadata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResiliencyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_dapr_proto_runtime_v1_metadata_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_dapr_proto_runtime_v1_metadata_proto_msgTypes[32].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_metadata_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      4,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
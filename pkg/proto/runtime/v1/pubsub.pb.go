@@ -389,7 +389,6 @@ type SubscribeTopicEventsRequestAlpha1 struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to SubscribeTopicEventsRequestType:
-	//
 	//	*SubscribeTopicEventsRequestAlpha1_InitialRequest
 	//	*SubscribeTopicEventsRequestAlpha1_EventProcessed
 	SubscribeTopicEventsRequestType isSubscribeTopicEventsRequestAlpha1_SubscribeTopicEventsRequestType `protobuf_oneof:"subscribe_topic_events_request_type"`
@@ -614,7 +613,6 @@ type SubscribeTopicEventsResponseAlpha1 struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to SubscribeTopicEventsResponseType:
-	//
 	//	*SubscribeTopicEventsResponseAlpha1_InitialResponse
 	//	*SubscribeTopicEventsResponseAlpha1_EventMessage
 	SubscribeTopicEventsResponseType isSubscribeTopicEventsResponseAlpha1_SubscribeTopicEventsResponseType `protobuf_oneof:"subscribe_topic_events_response_type"`
@@ -731,6 +729,124 @@ func (*SubscribeTopicEventsResponseInitialAlpha1) Descriptor() ([]byte, []int) {
 	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{9}
 }
 
+// PauseSubscriptionRequest is the message to pause the subscriptions of the
+// app to a topic, until they are resumed.
+type PauseSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the pubsub component
+	PubsubName string `protobuf:"bytes,1,opt,name=pubsub_name,json=pubsubName,proto3" json:"pubsub_name,omitempty"`
+	// The pubsub topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PauseSubscriptionRequest) GetPubsubName() string {
+	if x != nil {
+		return x.PubsubName
+	}
+	return ""
+}
+
+func (x *PauseSubscriptionRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+// ResumeSubscriptionRequest is the message to resume the paused subscriptions
+// of the app to a topic.
+type ResumeSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the pubsub component
+	PubsubName string `protobuf:"bytes,1,opt,name=pubsub_name,json=pubsubName,proto3" json:"pubsub_name,omitempty"`
+	// The pubsub topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
+
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResumeSubscriptionRequest) GetPubsubName() string {
+	if x != nil {
+		return x.PubsubName
+	}
+	return ""
+}
+
+func (x *ResumeSubscriptionRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
 var File_dapr_proto_runtime_v1_pubsub_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_runtime_v1_pubsub_proto_rawDesc = []byte{
@@ -873,14 +989,25 @@ var file_dapr_proto_runtime_v1_pubsub_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x29, 0x53, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x6f, 0x0a, 0x0a, 0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x76, 0x31, 0x42, 0x10, 0x44, 0x61, 0x70, 0x72, 0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa, 0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x51, 0x0a, 0x18, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x52, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x73,
+	0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x6f, 0x0a, 0x0a,
+	0x69, 0x6f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x44, 0x61, 0x70, 0x72,
+	0x50, 0x75, 0x62, 0x73, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x31, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61,
+	0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0xaa,
+	0x02, 0x1b, 0x44, 0x61, 0x70, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75,
+	0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -895,7 +1022,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_runtime_v1_pubsub_proto_rawDescData
 }
 
-var file_dapr_proto_runtime_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_dapr_proto_runtime_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_dapr_proto_runtime_v1_pubsub_proto_goTypes = []interface{}{
 	(*PublishEventRequest)(nil),                        // 0: dapr.proto.runtime.v1.PublishEventRequest
 	(*BulkPublishRequest)(nil),                         // 1: dapr.proto.runtime.v1.BulkPublishRequest
@@ -907,25 +1034,27 @@ var file_dapr_proto_runtime_v1_pubsub_proto_goTypes = []interface{}{
 	(*SubscribeTopicEventsRequestProcessedAlpha1)(nil), // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
 	(*SubscribeTopicEventsResponseAlpha1)(nil),         // 8: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1
 	(*SubscribeTopicEventsResponseInitialAlpha1)(nil),  // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
-	nil,                        // 10: dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
-	nil,                        // 11: dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
-	nil,                        // 12: dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
-	nil,                        // 13: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
-	(*TopicEventResponse)(nil), // 14: dapr.proto.runtime.v1.TopicEventResponse
-	(*TopicEventRequest)(nil),  // 15: dapr.proto.runtime.v1.TopicEventRequest
+	(*PauseSubscriptionRequest)(nil),                   // 10: dapr.proto.runtime.v1.PauseSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil),                  // 11: dapr.proto.runtime.v1.ResumeSubscriptionRequest
+	nil,                                                // 12: dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
+	nil,                                                // 13: dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
+	nil,                                                // 14: dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
+	nil,                                                // 15: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
+	(*TopicEventResponse)(nil),                         // 16: dapr.proto.runtime.v1.TopicEventResponse
+	(*TopicEventRequest)(nil),                          // 17: dapr.proto.runtime.v1.TopicEventRequest
 }
 var file_dapr_proto_runtime_v1_pubsub_proto_depIdxs = []int32{
-	10, // 0: dapr.proto.runtime.v1.PublishEventRequest.metadata:type_name -> dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
+	12, // 0: dapr.proto.runtime.v1.PublishEventRequest.metadata:type_name -> dapr.proto.runtime.v1.PublishEventRequest.MetadataEntry
 	2,  // 1: dapr.proto.runtime.v1.BulkPublishRequest.entries:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry
-	11, // 2: dapr.proto.runtime.v1.BulkPublishRequest.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
-	12, // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
+	13, // 2: dapr.proto.runtime.v1.BulkPublishRequest.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequest.MetadataEntry
+	14, // 3: dapr.proto.runtime.v1.BulkPublishRequestEntry.metadata:type_name -> dapr.proto.runtime.v1.BulkPublishRequestEntry.MetadataEntry
 	4,  // 4: dapr.proto.runtime.v1.BulkPublishResponse.failedEntries:type_name -> dapr.proto.runtime.v1.BulkPublishResponseFailedEntry
 	6,  // 5: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.initial_request:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1
 	7,  // 6: dapr.proto.runtime.v1.SubscribeTopicEventsRequestAlpha1.event_processed:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1
-	13, // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.metadata:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
-	14, // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1.status:type_name -> dapr.proto.runtime.v1.TopicEventResponse
+	15, // 7: dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.metadata:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsRequestInitialAlpha1.MetadataEntry
+	16, // 8: dapr.proto.runtime.v1.SubscribeTopicEventsRequestProcessedAlpha1.status:type_name -> dapr.proto.runtime.v1.TopicEventResponse
 	9,  // 9: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.initial_response:type_name -> dapr.proto.runtime.v1.SubscribeTopicEventsResponseInitialAlpha1
-	15, // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.event_message:type_name -> dapr.proto.runtime.v1.TopicEventRequest
+	17, // 10: dapr.proto.runtime.v1.SubscribeTopicEventsResponseAlpha1.event_message:type_name -> dapr.proto.runtime.v1.TopicEventRequest
 	11, // [11:11] is the sub-list for method output_type
 	11, // [11:11] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
@@ -1060,6 +1189,30 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_dapr_proto_runtime_v1_pubsub_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*SubscribeTopicEventsRequestAlpha1_InitialRequest)(nil),
@@ -1076,7 +1229,7 @@ func file_dapr_proto_runtime_v1_pubsub_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_runtime_v1_pubsub_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
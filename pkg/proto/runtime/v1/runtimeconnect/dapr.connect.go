@@ -53,10 +53,17 @@ const (
 	DaprGetStateProcedure = "/dapr.proto.runtime.v1.Dapr/GetState"
 	// DaprGetBulkStateProcedure is the fully-qualified name of the Dapr's GetBulkState RPC.
 	DaprGetBulkStateProcedure = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
+	// DaprGetBulkStateStreamAlpha1Procedure is the fully-qualified name of the Dapr's
+	// GetBulkStateStreamAlpha1 RPC.
+	DaprGetBulkStateStreamAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/GetBulkStateStreamAlpha1"
 	// DaprSaveStateProcedure is the fully-qualified name of the Dapr's SaveState RPC.
 	DaprSaveStateProcedure = "/dapr.proto.runtime.v1.Dapr/SaveState"
 	// DaprQueryStateAlpha1Procedure is the fully-qualified name of the Dapr's QueryStateAlpha1 RPC.
 	DaprQueryStateAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
+	// DaprQueryStateBeta1Procedure is the fully-qualified name of the Dapr's QueryStateBeta1 RPC.
+	DaprQueryStateBeta1Procedure = "/dapr.proto.runtime.v1.Dapr/QueryStateBeta1"
+	// DaprWatchStateAlpha1Procedure is the fully-qualified name of the Dapr's WatchStateAlpha1 RPC.
+	DaprWatchStateAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/WatchStateAlpha1"
 	// DaprDeleteStateProcedure is the fully-qualified name of the Dapr's DeleteState RPC.
 	DaprDeleteStateProcedure = "/dapr.proto.runtime.v1.Dapr/DeleteState"
 	// DaprDeleteBulkStateProcedure is the fully-qualified name of the Dapr's DeleteBulkState RPC.
@@ -64,6 +71,8 @@ const (
 	// DaprExecuteStateTransactionProcedure is the fully-qualified name of the Dapr's
 	// ExecuteStateTransaction RPC.
 	DaprExecuteStateTransactionProcedure = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
+	// DaprMigrateStateAlpha1Procedure is the fully-qualified name of the Dapr's MigrateStateAlpha1 RPC.
+	DaprMigrateStateAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/MigrateStateAlpha1"
 	// DaprPublishEventProcedure is the fully-qualified name of the Dapr's PublishEvent RPC.
 	DaprPublishEventProcedure = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
 	// DaprBulkPublishEventAlpha1Procedure is the fully-qualified name of the Dapr's
@@ -74,12 +83,30 @@ const (
 	// DaprSubscribeTopicEventsAlpha1Procedure is the fully-qualified name of the Dapr's
 	// SubscribeTopicEventsAlpha1 RPC.
 	DaprSubscribeTopicEventsAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
+	// DaprPauseSubscriptionAlpha1Procedure is the fully-qualified name of the Dapr's
+	// PauseSubscriptionAlpha1 RPC.
+	DaprPauseSubscriptionAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/PauseSubscriptionAlpha1"
+	// DaprResumeSubscriptionAlpha1Procedure is the fully-qualified name of the Dapr's
+	// ResumeSubscriptionAlpha1 RPC.
+	DaprResumeSubscriptionAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/ResumeSubscriptionAlpha1"
 	// DaprInvokeBindingProcedure is the fully-qualified name of the Dapr's InvokeBinding RPC.
 	DaprInvokeBindingProcedure = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
+	// DaprPauseInputBindingAlpha1Procedure is the fully-qualified name of the Dapr's PauseInputBindingAlpha1 RPC.
+	DaprPauseInputBindingAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/PauseInputBindingAlpha1"
+	// DaprResumeInputBindingAlpha1Procedure is the fully-qualified name of the Dapr's ResumeInputBindingAlpha1 RPC.
+	DaprResumeInputBindingAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/ResumeInputBindingAlpha1"
 	// DaprGetSecretProcedure is the fully-qualified name of the Dapr's GetSecret RPC.
 	DaprGetSecretProcedure = "/dapr.proto.runtime.v1.Dapr/GetSecret"
 	// DaprGetBulkSecretProcedure is the fully-qualified name of the Dapr's GetBulkSecret RPC.
 	DaprGetBulkSecretProcedure = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
+	// DaprGetBulkSecretStreamAlpha1Procedure is the fully-qualified name of the Dapr's
+	// GetBulkSecretStreamAlpha1 RPC.
+	DaprGetBulkSecretStreamAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/GetBulkSecretStreamAlpha1"
+	// DaprWatchSecretsAlpha1Procedure is the fully-qualified name of the Dapr's WatchSecretsAlpha1 RPC.
+	DaprWatchSecretsAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/WatchSecretsAlpha1"
+	// DaprPurgeSecretsCacheAlpha1Procedure is the fully-qualified name of the Dapr's
+	// PurgeSecretsCacheAlpha1 RPC.
+	DaprPurgeSecretsCacheAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/PurgeSecretsCacheAlpha1"
 	// DaprRegisterActorTimerProcedure is the fully-qualified name of the Dapr's RegisterActorTimer RPC.
 	DaprRegisterActorTimerProcedure = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
 	// DaprUnregisterActorTimerProcedure is the fully-qualified name of the Dapr's UnregisterActorTimer
@@ -129,6 +156,10 @@ const (
 	DaprTryLockAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
 	// DaprUnlockAlpha1Procedure is the fully-qualified name of the Dapr's UnlockAlpha1 RPC.
 	DaprUnlockAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
+	// DaprRenewLockAlpha1Procedure is the fully-qualified name of the Dapr's RenewLockAlpha1 RPC.
+	DaprRenewLockAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/RenewLockAlpha1"
+	// DaprAcquireLockAlpha1Procedure is the fully-qualified name of the Dapr's AcquireLockAlpha1 RPC.
+	DaprAcquireLockAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/AcquireLockAlpha1"
 	// DaprEncryptAlpha1Procedure is the fully-qualified name of the Dapr's EncryptAlpha1 RPC.
 	DaprEncryptAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
 	// DaprDecryptAlpha1Procedure is the fully-qualified name of the Dapr's DecryptAlpha1 RPC.
@@ -137,6 +168,10 @@ const (
 	DaprGetMetadataProcedure = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
 	// DaprSetMetadataProcedure is the fully-qualified name of the Dapr's SetMetadata RPC.
 	DaprSetMetadataProcedure = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
+	// DaprGetActiveActorsAlpha1Procedure is the fully-qualified name of the Dapr's GetActiveActorsAlpha1 RPC.
+	DaprGetActiveActorsAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/GetActiveActorsAlpha1"
+	// DaprGetResiliencyAlpha1Procedure is the fully-qualified name of the Dapr's GetResiliencyAlpha1 RPC.
+	DaprGetResiliencyAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/GetResiliencyAlpha1"
 	// DaprSubtleGetKeyAlpha1Procedure is the fully-qualified name of the Dapr's SubtleGetKeyAlpha1 RPC.
 	DaprSubtleGetKeyAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
 	// DaprSubtleEncryptAlpha1Procedure is the fully-qualified name of the Dapr's SubtleEncryptAlpha1
@@ -192,8 +227,23 @@ const (
 	// DaprRaiseEventWorkflowBeta1Procedure is the fully-qualified name of the Dapr's
 	// RaiseEventWorkflowBeta1 RPC.
 	DaprRaiseEventWorkflowBeta1Procedure = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
+	// DaprListWorkflowInstancesBeta1Procedure is the fully-qualified name of the Dapr's
+	// ListWorkflowInstancesBeta1 RPC.
+	DaprListWorkflowInstancesBeta1Procedure = "/dapr.proto.runtime.v1.Dapr/ListWorkflowInstancesBeta1"
+	// DaprGetWorkflowHistoryBeta1Procedure is the fully-qualified name of the Dapr's
+	// GetWorkflowHistoryBeta1 RPC.
+	DaprGetWorkflowHistoryBeta1Procedure = "/dapr.proto.runtime.v1.Dapr/GetWorkflowHistoryBeta1"
+	// DaprReplayWorkflowBeta1Procedure is the fully-qualified name of the Dapr's ReplayWorkflowBeta1
+	// RPC.
+	DaprReplayWorkflowBeta1Procedure = "/dapr.proto.runtime.v1.Dapr/ReplayWorkflowBeta1"
 	// DaprShutdownProcedure is the fully-qualified name of the Dapr's Shutdown RPC.
 	DaprShutdownProcedure = "/dapr.proto.runtime.v1.Dapr/Shutdown"
+	// DaprReloadAlpha1Procedure is the fully-qualified name of the Dapr's ReloadAlpha1 RPC.
+	DaprReloadAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/ReloadAlpha1"
+	// DaprSetFaultAlpha1Procedure is the fully-qualified name of the Dapr's SetFaultAlpha1 RPC.
+	DaprSetFaultAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/SetFaultAlpha1"
+	// DaprDeleteFaultAlpha1Procedure is the fully-qualified name of the Dapr's DeleteFaultAlpha1 RPC.
+	DaprDeleteFaultAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/DeleteFaultAlpha1"
 	// DaprScheduleJobAlpha1Procedure is the fully-qualified name of the Dapr's ScheduleJobAlpha1 RPC.
 	DaprScheduleJobAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
 	// DaprScheduleJobProcedure is the fully-qualified name of the Dapr's ScheduleJob RPC.
@@ -215,6 +265,10 @@ const (
 	DaprListJobsAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/ListJobsAlpha1"
 	// DaprListJobsProcedure is the fully-qualified name of the Dapr's ListJobs RPC.
 	DaprListJobsProcedure = "/dapr.proto.runtime.v1.Dapr/ListJobs"
+	// DaprBulkScheduleJobsProcedure is the fully-qualified name of the Dapr's BulkScheduleJobs RPC.
+	DaprBulkScheduleJobsProcedure = "/dapr.proto.runtime.v1.Dapr/BulkScheduleJobs"
+	// DaprBulkDeleteJobsProcedure is the fully-qualified name of the Dapr's BulkDeleteJobs RPC.
+	DaprBulkDeleteJobsProcedure = "/dapr.proto.runtime.v1.Dapr/BulkDeleteJobs"
 	// DaprConverseAlpha1Procedure is the fully-qualified name of the Dapr's ConverseAlpha1 RPC.
 	DaprConverseAlpha1Procedure = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
 	// DaprConverseAlpha2Procedure is the fully-qualified name of the Dapr's ConverseAlpha2 RPC.
@@ -230,16 +284,27 @@ type DaprClient interface {
 	GetState(context.Context, *connect.Request[v1.GetStateRequest]) (*connect.Response[v1.GetStateResponse], error)
 	// Gets a bulk of state items for a list of keys
 	GetBulkState(context.Context, *connect.Request[v1.GetBulkStateRequest]) (*connect.Response[v1.GetBulkStateResponse], error)
+	// Gets a bulk of state items for a list of keys, streaming the items in
+	// chunks as they are read from the state store.
+	GetBulkStateStreamAlpha1(context.Context, *connect.Request[v1.GetBulkStateStreamRequest]) (*connect.ServerStreamForClient[v1.GetBulkStateStreamResponse], error)
 	// Saves the state for a specific key.
 	SaveState(context.Context, *connect.Request[v1.SaveStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Queries the state.
 	QueryStateAlpha1(context.Context, *connect.Request[v1.QueryStateRequest]) (*connect.Response[v1.QueryStateResponse], error)
+	// Queries the state with the stable query grammar, optionally across
+	// multiple state stores.
+	QueryStateBeta1(context.Context, *connect.Request[v1.QueryStateRequest]) (*connect.Response[v1.QueryStateResponse], error)
+	// Watches keys of a state store for changes.
+	WatchStateAlpha1(context.Context, *connect.Request[v1.WatchStateRequest]) (*connect.ServerStreamForClient[v1.WatchStateResponse], error)
 	// Deletes the state for a specific key.
 	DeleteState(context.Context, *connect.Request[v1.DeleteStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Deletes a bulk of state items for a list of keys
 	DeleteBulkState(context.Context, *connect.Request[v1.DeleteBulkStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(context.Context, *connect.Request[v1.ExecuteStateTransactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// Copies the state of this app from a state store to another, reporting
+	// the progress on the metadata API.
+	MigrateStateAlpha1(context.Context, *connect.Request[v1.MigrateStateRequest]) (*connect.Response[v1.MigrateStateResponse], error)
 	// Publishes events to the specific topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error)
 	// Deprecated: Bulk Publishes multiple events to the specified topic.
@@ -251,12 +316,26 @@ type DaprClient interface {
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(context.Context) *connect.BidiStreamForClient[v1.SubscribeTopicEventsRequestAlpha1, v1.SubscribeTopicEventsResponseAlpha1]
+	// Pauses the delivery of the messages of a topic to the app.
+	PauseSubscriptionAlpha1(context.Context, *connect.Request[v1.PauseSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// Resumes the delivery of the messages of a paused topic to the app.
+	ResumeSubscriptionAlpha1(context.Context, *connect.Request[v1.ResumeSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *connect.Request[v1.InvokeBindingRequest]) (*connect.Response[v1.InvokeBindingResponse], error)
+	// Pauses reading the events of an input binding.
+	PauseInputBindingAlpha1(context.Context, *connect.Request[v1.PauseInputBindingRequest]) (*connect.Response[emptypb.Empty], error)
+	// Resumes reading the events of a paused input binding.
+	ResumeInputBindingAlpha1(context.Context, *connect.Request[v1.ResumeInputBindingRequest]) (*connect.Response[emptypb.Empty], error)
 	// Gets secrets from secret stores.
 	GetSecret(context.Context, *connect.Request[v1.GetSecretRequest]) (*connect.Response[v1.GetSecretResponse], error)
 	// Gets a bulk of secrets
 	GetBulkSecret(context.Context, *connect.Request[v1.GetBulkSecretRequest]) (*connect.Response[v1.GetBulkSecretResponse], error)
+	// Gets a bulk of secrets, streamed in chunks.
+	GetBulkSecretStreamAlpha1(context.Context, *connect.Request[v1.GetBulkSecretStreamRequest]) (*connect.ServerStreamForClient[v1.GetBulkSecretStreamResponse], error)
+	// Watches secrets of a secret store for changes.
+	WatchSecretsAlpha1(context.Context, *connect.Request[v1.WatchSecretsRequest]) (*connect.ServerStreamForClient[v1.WatchSecretsResponse], error)
+	// Purges secrets from the cache of a secret store.
+	PurgeSecretsCacheAlpha1(context.Context, *connect.Request[v1.PurgeSecretsCacheRequest]) (*connect.Response[v1.PurgeSecretsCacheResponse], error)
 	// Register an actor timer.
 	RegisterActorTimer(context.Context, *connect.Request[v1.RegisterActorTimerRequest]) (*connect.Response[emptypb.Empty], error)
 	// Unregister an actor timer.
@@ -298,6 +377,11 @@ type DaprClient interface {
 	TryLockAlpha1(context.Context, *connect.Request[v1.TryLockRequest]) (*connect.Response[v1.TryLockResponse], error)
 	// UnlockAlpha1 unlocks a lock.
 	UnlockAlpha1(context.Context, *connect.Request[v1.UnlockRequest]) (*connect.Response[v1.UnlockResponse], error)
+	// RenewLockAlpha1 extends the expiry of a lock held by the lock owner.
+	RenewLockAlpha1(context.Context, *connect.Request[v1.RenewLockRequest]) (*connect.Response[v1.RenewLockResponse], error)
+	// AcquireLockAlpha1 waits for a lock to be released to acquire it, in the
+	// order in which the lock was requested on the sidecar.
+	AcquireLockAlpha1(context.Context, *connect.Request[v1.AcquireLockRequest]) (*connect.Response[v1.AcquireLockResponse], error)
 	// EncryptAlpha1 encrypts a message using the Dapr encryption scheme and a key stored in the vault.
 	EncryptAlpha1(context.Context) *connect.BidiStreamForClient[v1.EncryptRequest, v1.EncryptResponse]
 	// DecryptAlpha1 decrypts a message using the Dapr encryption scheme and a key stored in the vault.
@@ -306,6 +390,12 @@ type DaprClient interface {
 	GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error)
 	// Sets value in extended metadata of the sidecar
 	SetMetadata(context.Context, *connect.Request[v1.SetMetadataRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetActiveActorsAlpha1 lists the counts per type of the actors active on
+	// this host, and optionally a page of their IDs.
+	GetActiveActorsAlpha1(context.Context, *connect.Request[v1.GetActiveActorsRequest]) (*connect.Response[v1.GetActiveActorsResponse], error)
+	// GetResiliencyAlpha1 lists the resiliency policies loaded by the sidecar,
+	// the targets they apply to, and the state of their circuit breakers.
+	GetResiliencyAlpha1(context.Context, *connect.Request[v1.GetResiliencyRequest]) (*connect.Response[v1.GetResiliencyResponse], error)
 	// SubtleGetKeyAlpha1 returns the public part of an asymmetric key stored in the vault.
 	SubtleGetKeyAlpha1(context.Context, *connect.Request[v1.SubtleGetKeyRequest]) (*connect.Response[v1.SubtleGetKeyResponse], error)
 	// SubtleEncryptAlpha1 encrypts a small message using a key stored in the vault.
@@ -362,8 +452,24 @@ type DaprClient interface {
 	ResumeWorkflowBeta1(context.Context, *connect.Request[v1.ResumeWorkflowRequest]) (*connect.Response[emptypb.Empty], error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(context.Context, *connect.Request[v1.RaiseEventWorkflowRequest]) (*connect.Response[emptypb.Empty], error)
+	// List workflow instances, filtered by status, name and creation time
+	ListWorkflowInstancesBeta1(context.Context, *connect.Request[v1.ListWorkflowInstancesRequest]) (*connect.Response[v1.ListWorkflowInstancesResponse], error)
+	// Export the full history of a workflow instance
+	GetWorkflowHistoryBeta1(context.Context, *connect.Request[v1.GetWorkflowHistoryRequest]) (*connect.Response[v1.GetWorkflowHistoryResponse], error)
+	// Replay a completed workflow instance against the current workflow code to
+	// detect non-determinism
+	ReplayWorkflowBeta1(context.Context, *connect.Request[v1.ReplayWorkflowRequest]) (*connect.Response[v1.ReplayWorkflowResponse], error)
 	// Shutdown the sidecar
 	Shutdown(context.Context, *connect.Request[v1.ShutdownRequest]) (*connect.Response[emptypb.Empty], error)
+	// Reload the configuration and the resiliency policies of the sidecar
+	// without restarting it, in self-hosted mode
+	ReloadAlpha1(context.Context, *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error)
+	// SetFaultAlpha1 injects a fault in the calls of the sidecar to the apps,
+	// actors and components, or replaces the fault with the same name. Fault
+	// injection must be enabled in the configuration.
+	SetFaultAlpha1(context.Context, *connect.Request[v1.SetFaultRequest]) (*connect.Response[emptypb.Empty], error)
+	// DeleteFaultAlpha1 stops injecting a fault.
+	DeleteFaultAlpha1(context.Context, *connect.Request[v1.DeleteFaultRequest]) (*connect.Response[emptypb.Empty], error)
 	// Deprecated: Create and schedule a job
 	//
 	// Deprecated: do not use.
@@ -394,6 +500,10 @@ type DaprClient interface {
 	ListJobsAlpha1(context.Context, *connect.Request[v1.ListJobsRequestAlpha1]) (*connect.Response[v1.ListJobsResponseAlpha1], error)
 	// List all jobs
 	ListJobs(context.Context, *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error)
+	// Create and schedule a batch of jobs, reporting the jobs which failed
+	BulkScheduleJobs(context.Context, *connect.Request[v1.BulkScheduleJobsRequest]) (*connect.Response[v1.BulkScheduleJobsResponse], error)
+	// Delete a batch of jobs, reporting the jobs which failed
+	BulkDeleteJobs(context.Context, *connect.Request[v1.BulkDeleteJobsRequest]) (*connect.Response[v1.BulkDeleteJobsResponse], error)
 	// Converse with a LLM service
 	ConverseAlpha1(context.Context, *connect.Request[v1.ConversationRequest]) (*connect.Response[v1.ConversationResponse], error)
 	// Converse with a LLM service via alpha2 api
@@ -429,6 +539,12 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("GetBulkState")),
 			connect.WithClientOptions(opts...),
 		),
+		getBulkStateStreamAlpha1: connect.NewClient[v1.GetBulkStateStreamRequest, v1.GetBulkStateStreamResponse](
+			httpClient,
+			baseURL+DaprGetBulkStateStreamAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("GetBulkStateStreamAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		saveState: connect.NewClient[v1.SaveStateRequest, emptypb.Empty](
 			httpClient,
 			baseURL+DaprSaveStateProcedure,
@@ -441,6 +557,18 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("QueryStateAlpha1")),
 			connect.WithClientOptions(opts...),
 		),
+		queryStateBeta1: connect.NewClient[v1.QueryStateRequest, v1.QueryStateResponse](
+			httpClient,
+			baseURL+DaprQueryStateBeta1Procedure,
+			connect.WithSchema(daprMethods.ByName("QueryStateBeta1")),
+			connect.WithClientOptions(opts...),
+		),
+		watchStateAlpha1: connect.NewClient[v1.WatchStateRequest, v1.WatchStateResponse](
+			httpClient,
+			baseURL+DaprWatchStateAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("WatchStateAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteState: connect.NewClient[v1.DeleteStateRequest, emptypb.Empty](
 			httpClient,
 			baseURL+DaprDeleteStateProcedure,
@@ -459,6 +587,12 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("ExecuteStateTransaction")),
 			connect.WithClientOptions(opts...),
 		),
+		migrateStateAlpha1: connect.NewClient[v1.MigrateStateRequest, v1.MigrateStateResponse](
+			httpClient,
+			baseURL+DaprMigrateStateAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("MigrateStateAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		publishEvent: connect.NewClient[v1.PublishEventRequest, emptypb.Empty](
 			httpClient,
 			baseURL+DaprPublishEventProcedure,
@@ -483,12 +617,36 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("SubscribeTopicEventsAlpha1")),
 			connect.WithClientOptions(opts...),
 		),
+		pauseSubscriptionAlpha1: connect.NewClient[v1.PauseSubscriptionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+DaprPauseSubscriptionAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("PauseSubscriptionAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeSubscriptionAlpha1: connect.NewClient[v1.ResumeSubscriptionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+DaprResumeSubscriptionAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("ResumeSubscriptionAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		invokeBinding: connect.NewClient[v1.InvokeBindingRequest, v1.InvokeBindingResponse](
 			httpClient,
 			baseURL+DaprInvokeBindingProcedure,
 			connect.WithSchema(daprMethods.ByName("InvokeBinding")),
 			connect.WithClientOptions(opts...),
 		),
+		pauseInputBindingAlpha1: connect.NewClient[v1.PauseInputBindingRequest, emptypb.Empty](
+			httpClient,
+			baseURL+DaprPauseInputBindingAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("PauseInputBindingAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeInputBindingAlpha1: connect.NewClient[v1.ResumeInputBindingRequest, emptypb.Empty](
+			httpClient,
+			baseURL+DaprResumeInputBindingAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("ResumeInputBindingAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		getSecret: connect.NewClient[v1.GetSecretRequest, v1.GetSecretResponse](
 			httpClient,
 			baseURL+DaprGetSecretProcedure,
@@ -501,6 +659,24 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("GetBulkSecret")),
 			connect.WithClientOptions(opts...),
 		),
+		getBulkSecretStreamAlpha1: connect.NewClient[v1.GetBulkSecretStreamRequest, v1.GetBulkSecretStreamResponse](
+			httpClient,
+			baseURL+DaprGetBulkSecretStreamAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("GetBulkSecretStreamAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		watchSecretsAlpha1: connect.NewClient[v1.WatchSecretsRequest, v1.WatchSecretsResponse](
+			httpClient,
+			baseURL+DaprWatchSecretsAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("WatchSecretsAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeSecretsCacheAlpha1: connect.NewClient[v1.PurgeSecretsCacheRequest, v1.PurgeSecretsCacheResponse](
+			httpClient,
+			baseURL+DaprPurgeSecretsCacheAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("PurgeSecretsCacheAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		registerActorTimer: connect.NewClient[v1.RegisterActorTimerRequest, emptypb.Empty](
 			httpClient,
 			baseURL+DaprRegisterActorTimerProcedure,
@@ -615,6 +791,18 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("UnlockAlpha1")),
 			connect.WithClientOptions(opts...),
 		),
+		renewLockAlpha1: connect.NewClient[v1.RenewLockRequest, v1.RenewLockResponse](
+			httpClient,
+			baseURL+DaprRenewLockAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("RenewLockAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		acquireLockAlpha1: connect.NewClient[v1.AcquireLockRequest, v1.AcquireLockResponse](
+			httpClient,
+			baseURL+DaprAcquireLockAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("AcquireLockAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		encryptAlpha1: connect.NewClient[v1.EncryptRequest, v1.EncryptResponse](
 			httpClient,
 			baseURL+DaprEncryptAlpha1Procedure,
@@ -639,6 +827,18 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("SetMetadata")),
 			connect.WithClientOptions(opts...),
 		),
+		getActiveActorsAlpha1: connect.NewClient[v1.GetActiveActorsRequest, v1.GetActiveActorsResponse](
+			httpClient,
+			baseURL+DaprGetActiveActorsAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("GetActiveActorsAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		getResiliencyAlpha1: connect.NewClient[v1.GetResiliencyRequest, v1.GetResiliencyResponse](
+			httpClient,
+			baseURL+DaprGetResiliencyAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("GetResiliencyAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		subtleGetKeyAlpha1: connect.NewClient[v1.SubtleGetKeyRequest, v1.SubtleGetKeyResponse](
 			httpClient,
 			baseURL+DaprSubtleGetKeyAlpha1Procedure,
@@ -765,12 +965,48 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("RaiseEventWorkflowBeta1")),
 			connect.WithClientOptions(opts...),
 		),
+		listWorkflowInstancesBeta1: connect.NewClient[v1.ListWorkflowInstancesRequest, v1.ListWorkflowInstancesResponse](
+			httpClient,
+			baseURL+DaprListWorkflowInstancesBeta1Procedure,
+			connect.WithSchema(daprMethods.ByName("ListWorkflowInstancesBeta1")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkflowHistoryBeta1: connect.NewClient[v1.GetWorkflowHistoryRequest, v1.GetWorkflowHistoryResponse](
+			httpClient,
+			baseURL+DaprGetWorkflowHistoryBeta1Procedure,
+			connect.WithSchema(daprMethods.ByName("GetWorkflowHistoryBeta1")),
+			connect.WithClientOptions(opts...),
+		),
+		replayWorkflowBeta1: connect.NewClient[v1.ReplayWorkflowRequest, v1.ReplayWorkflowResponse](
+			httpClient,
+			baseURL+DaprReplayWorkflowBeta1Procedure,
+			connect.WithSchema(daprMethods.ByName("ReplayWorkflowBeta1")),
+			connect.WithClientOptions(opts...),
+		),
 		shutdown: connect.NewClient[v1.ShutdownRequest, emptypb.Empty](
 			httpClient,
 			baseURL+DaprShutdownProcedure,
 			connect.WithSchema(daprMethods.ByName("Shutdown")),
 			connect.WithClientOptions(opts...),
 		),
+		reloadAlpha1: connect.NewClient[v1.ReloadRequest, v1.ReloadResponse](
+			httpClient,
+			baseURL+DaprReloadAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("ReloadAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		setFaultAlpha1: connect.NewClient[v1.SetFaultRequest, emptypb.Empty](
+			httpClient,
+			baseURL+DaprSetFaultAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("SetFaultAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFaultAlpha1: connect.NewClient[v1.DeleteFaultRequest, emptypb.Empty](
+			httpClient,
+			baseURL+DaprDeleteFaultAlpha1Procedure,
+			connect.WithSchema(daprMethods.ByName("DeleteFaultAlpha1")),
+			connect.WithClientOptions(opts...),
+		),
 		scheduleJobAlpha1: connect.NewClient[v1.ScheduleJobRequest, v1.ScheduleJobResponse](
 			httpClient,
 			baseURL+DaprScheduleJobAlpha1Procedure,
@@ -831,6 +1067,18 @@ func NewDaprClient(httpClient connect.HTTPClient, baseURL string, opts ...connec
 			connect.WithSchema(daprMethods.ByName("ListJobs")),
 			connect.WithClientOptions(opts...),
 		),
+		bulkScheduleJobs: connect.NewClient[v1.BulkScheduleJobsRequest, v1.BulkScheduleJobsResponse](
+			httpClient,
+			baseURL+DaprBulkScheduleJobsProcedure,
+			connect.WithSchema(daprMethods.ByName("BulkScheduleJobs")),
+			connect.WithClientOptions(opts...),
+		),
+		bulkDeleteJobs: connect.NewClient[v1.BulkDeleteJobsRequest, v1.BulkDeleteJobsResponse](
+			httpClient,
+			baseURL+DaprBulkDeleteJobsProcedure,
+			connect.WithSchema(daprMethods.ByName("BulkDeleteJobs")),
+			connect.WithClientOptions(opts...),
+		),
 		converseAlpha1: connect.NewClient[v1.ConversationRequest, v1.ConversationResponse](
 			httpClient,
 			baseURL+DaprConverseAlpha1Procedure,
@@ -851,18 +1099,29 @@ type daprClient struct {
 	invokeService                  *connect.Client[v1.InvokeServiceRequest, v11.InvokeResponse]
 	getState                       *connect.Client[v1.GetStateRequest, v1.GetStateResponse]
 	getBulkState                   *connect.Client[v1.GetBulkStateRequest, v1.GetBulkStateResponse]
+	getBulkStateStreamAlpha1       *connect.Client[v1.GetBulkStateStreamRequest, v1.GetBulkStateStreamResponse]
 	saveState                      *connect.Client[v1.SaveStateRequest, emptypb.Empty]
 	queryStateAlpha1               *connect.Client[v1.QueryStateRequest, v1.QueryStateResponse]
+	queryStateBeta1                *connect.Client[v1.QueryStateRequest, v1.QueryStateResponse]
+	watchStateAlpha1               *connect.Client[v1.WatchStateRequest, v1.WatchStateResponse]
 	deleteState                    *connect.Client[v1.DeleteStateRequest, emptypb.Empty]
 	deleteBulkState                *connect.Client[v1.DeleteBulkStateRequest, emptypb.Empty]
 	executeStateTransaction        *connect.Client[v1.ExecuteStateTransactionRequest, emptypb.Empty]
+	migrateStateAlpha1             *connect.Client[v1.MigrateStateRequest, v1.MigrateStateResponse]
 	publishEvent                   *connect.Client[v1.PublishEventRequest, emptypb.Empty]
 	bulkPublishEventAlpha1         *connect.Client[v1.BulkPublishRequest, v1.BulkPublishResponse]
 	bulkPublishEvent               *connect.Client[v1.BulkPublishRequest, v1.BulkPublishResponse]
 	subscribeTopicEventsAlpha1     *connect.Client[v1.SubscribeTopicEventsRequestAlpha1, v1.SubscribeTopicEventsResponseAlpha1]
+	pauseSubscriptionAlpha1        *connect.Client[v1.PauseSubscriptionRequest, emptypb.Empty]
+	resumeSubscriptionAlpha1       *connect.Client[v1.ResumeSubscriptionRequest, emptypb.Empty]
 	invokeBinding                  *connect.Client[v1.InvokeBindingRequest, v1.InvokeBindingResponse]
+	pauseInputBindingAlpha1        *connect.Client[v1.PauseInputBindingRequest, emptypb.Empty]
+	resumeInputBindingAlpha1       *connect.Client[v1.ResumeInputBindingRequest, emptypb.Empty]
 	getSecret                      *connect.Client[v1.GetSecretRequest, v1.GetSecretResponse]
 	getBulkSecret                  *connect.Client[v1.GetBulkSecretRequest, v1.GetBulkSecretResponse]
+	getBulkSecretStreamAlpha1      *connect.Client[v1.GetBulkSecretStreamRequest, v1.GetBulkSecretStreamResponse]
+	watchSecretsAlpha1             *connect.Client[v1.WatchSecretsRequest, v1.WatchSecretsResponse]
+	purgeSecretsCacheAlpha1        *connect.Client[v1.PurgeSecretsCacheRequest, v1.PurgeSecretsCacheResponse]
 	registerActorTimer             *connect.Client[v1.RegisterActorTimerRequest, emptypb.Empty]
 	unregisterActorTimer           *connect.Client[v1.UnregisterActorTimerRequest, emptypb.Empty]
 	registerActorReminder          *connect.Client[v1.RegisterActorReminderRequest, emptypb.Empty]
@@ -882,10 +1141,14 @@ type daprClient struct {
 	unsubscribeConfiguration       *connect.Client[v1.UnsubscribeConfigurationRequest, v1.UnsubscribeConfigurationResponse]
 	tryLockAlpha1                  *connect.Client[v1.TryLockRequest, v1.TryLockResponse]
 	unlockAlpha1                   *connect.Client[v1.UnlockRequest, v1.UnlockResponse]
+	renewLockAlpha1                *connect.Client[v1.RenewLockRequest, v1.RenewLockResponse]
+	acquireLockAlpha1              *connect.Client[v1.AcquireLockRequest, v1.AcquireLockResponse]
 	encryptAlpha1                  *connect.Client[v1.EncryptRequest, v1.EncryptResponse]
 	decryptAlpha1                  *connect.Client[v1.DecryptRequest, v1.DecryptResponse]
 	getMetadata                    *connect.Client[v1.GetMetadataRequest, v1.GetMetadataResponse]
 	setMetadata                    *connect.Client[v1.SetMetadataRequest, emptypb.Empty]
+	getActiveActorsAlpha1          *connect.Client[v1.GetActiveActorsRequest, v1.GetActiveActorsResponse]
+	getResiliencyAlpha1            *connect.Client[v1.GetResiliencyRequest, v1.GetResiliencyResponse]
 	subtleGetKeyAlpha1             *connect.Client[v1.SubtleGetKeyRequest, v1.SubtleGetKeyResponse]
 	subtleEncryptAlpha1            *connect.Client[v1.SubtleEncryptRequest, v1.SubtleEncryptResponse]
 	subtleDecryptAlpha1            *connect.Client[v1.SubtleDecryptRequest, v1.SubtleDecryptResponse]
@@ -907,7 +1170,13 @@ type daprClient struct {
 	pauseWorkflowBeta1             *connect.Client[v1.PauseWorkflowRequest, emptypb.Empty]
 	resumeWorkflowBeta1            *connect.Client[v1.ResumeWorkflowRequest, emptypb.Empty]
 	raiseEventWorkflowBeta1        *connect.Client[v1.RaiseEventWorkflowRequest, emptypb.Empty]
+	listWorkflowInstancesBeta1     *connect.Client[v1.ListWorkflowInstancesRequest, v1.ListWorkflowInstancesResponse]
+	getWorkflowHistoryBeta1        *connect.Client[v1.GetWorkflowHistoryRequest, v1.GetWorkflowHistoryResponse]
+	replayWorkflowBeta1            *connect.Client[v1.ReplayWorkflowRequest, v1.ReplayWorkflowResponse]
 	shutdown                       *connect.Client[v1.ShutdownRequest, emptypb.Empty]
+	reloadAlpha1                   *connect.Client[v1.ReloadRequest, v1.ReloadResponse]
+	setFaultAlpha1                 *connect.Client[v1.SetFaultRequest, emptypb.Empty]
+	deleteFaultAlpha1              *connect.Client[v1.DeleteFaultRequest, emptypb.Empty]
 	scheduleJobAlpha1              *connect.Client[v1.ScheduleJobRequest, v1.ScheduleJobResponse]
 	scheduleJob                    *connect.Client[v1.ScheduleJobRequest, v1.ScheduleJobResponse]
 	getJobAlpha1                   *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
@@ -918,6 +1187,8 @@ type daprClient struct {
 	deleteJobsByPrefix             *connect.Client[v1.DeleteJobsByPrefixRequest, v1.DeleteJobsByPrefixResponse]
 	listJobsAlpha1                 *connect.Client[v1.ListJobsRequestAlpha1, v1.ListJobsResponseAlpha1]
 	listJobs                       *connect.Client[v1.ListJobsRequest, v1.ListJobsResponse]
+	bulkScheduleJobs               *connect.Client[v1.BulkScheduleJobsRequest, v1.BulkScheduleJobsResponse]
+	bulkDeleteJobs                 *connect.Client[v1.BulkDeleteJobsRequest, v1.BulkDeleteJobsResponse]
 	converseAlpha1                 *connect.Client[v1.ConversationRequest, v1.ConversationResponse]
 	converseAlpha2                 *connect.Client[v1.ConversationRequestAlpha2, v1.ConversationResponseAlpha2]
 }
@@ -937,6 +1208,11 @@ func (c *daprClient) GetBulkState(ctx context.Context, req *connect.Request[v1.G
 	return c.getBulkState.CallUnary(ctx, req)
 }
 
+// GetBulkStateStreamAlpha1 calls dapr.proto.runtime.v1.Dapr.GetBulkStateStreamAlpha1.
+func (c *daprClient) GetBulkStateStreamAlpha1(ctx context.Context, req *connect.Request[v1.GetBulkStateStreamRequest]) (*connect.ServerStreamForClient[v1.GetBulkStateStreamResponse], error) {
+	return c.getBulkStateStreamAlpha1.CallServerStream(ctx, req)
+}
+
 // SaveState calls dapr.proto.runtime.v1.Dapr.SaveState.
 func (c *daprClient) SaveState(ctx context.Context, req *connect.Request[v1.SaveStateRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.saveState.CallUnary(ctx, req)
@@ -947,6 +1223,16 @@ func (c *daprClient) QueryStateAlpha1(ctx context.Context, req *connect.Request[
 	return c.queryStateAlpha1.CallUnary(ctx, req)
 }
 
+// QueryStateBeta1 calls dapr.proto.runtime.v1.Dapr.QueryStateBeta1.
+func (c *daprClient) QueryStateBeta1(ctx context.Context, req *connect.Request[v1.QueryStateRequest]) (*connect.Response[v1.QueryStateResponse], error) {
+	return c.queryStateBeta1.CallUnary(ctx, req)
+}
+
+// WatchStateAlpha1 calls dapr.proto.runtime.v1.Dapr.WatchStateAlpha1.
+func (c *daprClient) WatchStateAlpha1(ctx context.Context, req *connect.Request[v1.WatchStateRequest]) (*connect.ServerStreamForClient[v1.WatchStateResponse], error) {
+	return c.watchStateAlpha1.CallServerStream(ctx, req)
+}
+
 // DeleteState calls dapr.proto.runtime.v1.Dapr.DeleteState.
 func (c *daprClient) DeleteState(ctx context.Context, req *connect.Request[v1.DeleteStateRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.deleteState.CallUnary(ctx, req)
@@ -962,6 +1248,11 @@ func (c *daprClient) ExecuteStateTransaction(ctx context.Context, req *connect.R
 	return c.executeStateTransaction.CallUnary(ctx, req)
 }
 
+// MigrateStateAlpha1 calls dapr.proto.runtime.v1.Dapr.MigrateStateAlpha1.
+func (c *daprClient) MigrateStateAlpha1(ctx context.Context, req *connect.Request[v1.MigrateStateRequest]) (*connect.Response[v1.MigrateStateResponse], error) {
+	return c.migrateStateAlpha1.CallUnary(ctx, req)
+}
+
 // PublishEvent calls dapr.proto.runtime.v1.Dapr.PublishEvent.
 func (c *daprClient) PublishEvent(ctx context.Context, req *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.publishEvent.CallUnary(ctx, req)
@@ -984,11 +1275,31 @@ func (c *daprClient) SubscribeTopicEventsAlpha1(ctx context.Context) *connect.Bi
 	return c.subscribeTopicEventsAlpha1.CallBidiStream(ctx)
 }
 
+// PauseSubscriptionAlpha1 calls dapr.proto.runtime.v1.Dapr.PauseSubscriptionAlpha1.
+func (c *daprClient) PauseSubscriptionAlpha1(ctx context.Context, req *connect.Request[v1.PauseSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.pauseSubscriptionAlpha1.CallUnary(ctx, req)
+}
+
+// ResumeSubscriptionAlpha1 calls dapr.proto.runtime.v1.Dapr.ResumeSubscriptionAlpha1.
+func (c *daprClient) ResumeSubscriptionAlpha1(ctx context.Context, req *connect.Request[v1.ResumeSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.resumeSubscriptionAlpha1.CallUnary(ctx, req)
+}
+
 // InvokeBinding calls dapr.proto.runtime.v1.Dapr.InvokeBinding.
 func (c *daprClient) InvokeBinding(ctx context.Context, req *connect.Request[v1.InvokeBindingRequest]) (*connect.Response[v1.InvokeBindingResponse], error) {
 	return c.invokeBinding.CallUnary(ctx, req)
 }
 
+// PauseInputBindingAlpha1 calls dapr.proto.runtime.v1.Dapr.PauseInputBindingAlpha1.
+func (c *daprClient) PauseInputBindingAlpha1(ctx context.Context, req *connect.Request[v1.PauseInputBindingRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.pauseInputBindingAlpha1.CallUnary(ctx, req)
+}
+
+// ResumeInputBindingAlpha1 calls dapr.proto.runtime.v1.Dapr.ResumeInputBindingAlpha1.
+func (c *daprClient) ResumeInputBindingAlpha1(ctx context.Context, req *connect.Request[v1.ResumeInputBindingRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.resumeInputBindingAlpha1.CallUnary(ctx, req)
+}
+
 // GetSecret calls dapr.proto.runtime.v1.Dapr.GetSecret.
 func (c *daprClient) GetSecret(ctx context.Context, req *connect.Request[v1.GetSecretRequest]) (*connect.Response[v1.GetSecretResponse], error) {
 	return c.getSecret.CallUnary(ctx, req)
@@ -999,6 +1310,21 @@ func (c *daprClient) GetBulkSecret(ctx context.Context, req *connect.Request[v1.
 	return c.getBulkSecret.CallUnary(ctx, req)
 }
 
+// GetBulkSecretStreamAlpha1 calls dapr.proto.runtime.v1.Dapr.GetBulkSecretStreamAlpha1.
+func (c *daprClient) GetBulkSecretStreamAlpha1(ctx context.Context, req *connect.Request[v1.GetBulkSecretStreamRequest]) (*connect.ServerStreamForClient[v1.GetBulkSecretStreamResponse], error) {
+	return c.getBulkSecretStreamAlpha1.CallServerStream(ctx, req)
+}
+
+// WatchSecretsAlpha1 calls dapr.proto.runtime.v1.Dapr.WatchSecretsAlpha1.
+func (c *daprClient) WatchSecretsAlpha1(ctx context.Context, req *connect.Request[v1.WatchSecretsRequest]) (*connect.ServerStreamForClient[v1.WatchSecretsResponse], error) {
+	return c.watchSecretsAlpha1.CallServerStream(ctx, req)
+}
+
+// PurgeSecretsCacheAlpha1 calls dapr.proto.runtime.v1.Dapr.PurgeSecretsCacheAlpha1.
+func (c *daprClient) PurgeSecretsCacheAlpha1(ctx context.Context, req *connect.Request[v1.PurgeSecretsCacheRequest]) (*connect.Response[v1.PurgeSecretsCacheResponse], error) {
+	return c.purgeSecretsCacheAlpha1.CallUnary(ctx, req)
+}
+
 // RegisterActorTimer calls dapr.proto.runtime.v1.Dapr.RegisterActorTimer.
 func (c *daprClient) RegisterActorTimer(ctx context.Context, req *connect.Request[v1.RegisterActorTimerRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.registerActorTimer.CallUnary(ctx, req)
@@ -1094,6 +1420,16 @@ func (c *daprClient) UnlockAlpha1(ctx context.Context, req *connect.Request[v1.U
 	return c.unlockAlpha1.CallUnary(ctx, req)
 }
 
+// RenewLockAlpha1 calls dapr.proto.runtime.v1.Dapr.RenewLockAlpha1.
+func (c *daprClient) RenewLockAlpha1(ctx context.Context, req *connect.Request[v1.RenewLockRequest]) (*connect.Response[v1.RenewLockResponse], error) {
+	return c.renewLockAlpha1.CallUnary(ctx, req)
+}
+
+// AcquireLockAlpha1 calls dapr.proto.runtime.v1.Dapr.AcquireLockAlpha1.
+func (c *daprClient) AcquireLockAlpha1(ctx context.Context, req *connect.Request[v1.AcquireLockRequest]) (*connect.Response[v1.AcquireLockResponse], error) {
+	return c.acquireLockAlpha1.CallUnary(ctx, req)
+}
+
 // EncryptAlpha1 calls dapr.proto.runtime.v1.Dapr.EncryptAlpha1.
 func (c *daprClient) EncryptAlpha1(ctx context.Context) *connect.BidiStreamForClient[v1.EncryptRequest, v1.EncryptResponse] {
 	return c.encryptAlpha1.CallBidiStream(ctx)
@@ -1114,6 +1450,16 @@ func (c *daprClient) SetMetadata(ctx context.Context, req *connect.Request[v1.Se
 	return c.setMetadata.CallUnary(ctx, req)
 }
 
+// GetActiveActorsAlpha1 calls dapr.proto.runtime.v1.Dapr.GetActiveActorsAlpha1.
+func (c *daprClient) GetActiveActorsAlpha1(ctx context.Context, req *connect.Request[v1.GetActiveActorsRequest]) (*connect.Response[v1.GetActiveActorsResponse], error) {
+	return c.getActiveActorsAlpha1.CallUnary(ctx, req)
+}
+
+// GetResiliencyAlpha1 calls dapr.proto.runtime.v1.Dapr.GetResiliencyAlpha1.
+func (c *daprClient) GetResiliencyAlpha1(ctx context.Context, req *connect.Request[v1.GetResiliencyRequest]) (*connect.Response[v1.GetResiliencyResponse], error) {
+	return c.getResiliencyAlpha1.CallUnary(ctx, req)
+}
+
 // SubtleGetKeyAlpha1 calls dapr.proto.runtime.v1.Dapr.SubtleGetKeyAlpha1.
 func (c *daprClient) SubtleGetKeyAlpha1(ctx context.Context, req *connect.Request[v1.SubtleGetKeyRequest]) (*connect.Response[v1.SubtleGetKeyResponse], error) {
 	return c.subtleGetKeyAlpha1.CallUnary(ctx, req)
@@ -1233,11 +1579,41 @@ func (c *daprClient) RaiseEventWorkflowBeta1(ctx context.Context, req *connect.R
 	return c.raiseEventWorkflowBeta1.CallUnary(ctx, req)
 }
 
+// ListWorkflowInstancesBeta1 calls dapr.proto.runtime.v1.Dapr.ListWorkflowInstancesBeta1.
+func (c *daprClient) ListWorkflowInstancesBeta1(ctx context.Context, req *connect.Request[v1.ListWorkflowInstancesRequest]) (*connect.Response[v1.ListWorkflowInstancesResponse], error) {
+	return c.listWorkflowInstancesBeta1.CallUnary(ctx, req)
+}
+
+// GetWorkflowHistoryBeta1 calls dapr.proto.runtime.v1.Dapr.GetWorkflowHistoryBeta1.
+func (c *daprClient) GetWorkflowHistoryBeta1(ctx context.Context, req *connect.Request[v1.GetWorkflowHistoryRequest]) (*connect.Response[v1.GetWorkflowHistoryResponse], error) {
+	return c.getWorkflowHistoryBeta1.CallUnary(ctx, req)
+}
+
+// ReplayWorkflowBeta1 calls dapr.proto.runtime.v1.Dapr.ReplayWorkflowBeta1.
+func (c *daprClient) ReplayWorkflowBeta1(ctx context.Context, req *connect.Request[v1.ReplayWorkflowRequest]) (*connect.Response[v1.ReplayWorkflowResponse], error) {
+	return c.replayWorkflowBeta1.CallUnary(ctx, req)
+}
+
 // Shutdown calls dapr.proto.runtime.v1.Dapr.Shutdown.
 func (c *daprClient) Shutdown(ctx context.Context, req *connect.Request[v1.ShutdownRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.shutdown.CallUnary(ctx, req)
 }
 
+// ReloadAlpha1 calls dapr.proto.runtime.v1.Dapr.ReloadAlpha1.
+func (c *daprClient) ReloadAlpha1(ctx context.Context, req *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error) {
+	return c.reloadAlpha1.CallUnary(ctx, req)
+}
+
+// SetFaultAlpha1 calls dapr.proto.runtime.v1.Dapr.SetFaultAlpha1.
+func (c *daprClient) SetFaultAlpha1(ctx context.Context, req *connect.Request[v1.SetFaultRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.setFaultAlpha1.CallUnary(ctx, req)
+}
+
+// DeleteFaultAlpha1 calls dapr.proto.runtime.v1.Dapr.DeleteFaultAlpha1.
+func (c *daprClient) DeleteFaultAlpha1(ctx context.Context, req *connect.Request[v1.DeleteFaultRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteFaultAlpha1.CallUnary(ctx, req)
+}
+
 // ScheduleJobAlpha1 calls dapr.proto.runtime.v1.Dapr.ScheduleJobAlpha1.
 //
 // Deprecated: do not use.
@@ -1298,6 +1674,16 @@ func (c *daprClient) ListJobs(ctx context.Context, req *connect.Request[v1.ListJ
 	return c.listJobs.CallUnary(ctx, req)
 }
 
+// BulkScheduleJobs calls dapr.proto.runtime.v1.Dapr.BulkScheduleJobs.
+func (c *daprClient) BulkScheduleJobs(ctx context.Context, req *connect.Request[v1.BulkScheduleJobsRequest]) (*connect.Response[v1.BulkScheduleJobsResponse], error) {
+	return c.bulkScheduleJobs.CallUnary(ctx, req)
+}
+
+// BulkDeleteJobs calls dapr.proto.runtime.v1.Dapr.BulkDeleteJobs.
+func (c *daprClient) BulkDeleteJobs(ctx context.Context, req *connect.Request[v1.BulkDeleteJobsRequest]) (*connect.Response[v1.BulkDeleteJobsResponse], error) {
+	return c.bulkDeleteJobs.CallUnary(ctx, req)
+}
+
 // ConverseAlpha1 calls dapr.proto.runtime.v1.Dapr.ConverseAlpha1.
 func (c *daprClient) ConverseAlpha1(ctx context.Context, req *connect.Request[v1.ConversationRequest]) (*connect.Response[v1.ConversationResponse], error) {
 	return c.converseAlpha1.CallUnary(ctx, req)
@@ -1317,16 +1703,27 @@ type DaprHandler interface {
 	GetState(context.Context, *connect.Request[v1.GetStateRequest]) (*connect.Response[v1.GetStateResponse], error)
 	// Gets a bulk of state items for a list of keys
 	GetBulkState(context.Context, *connect.Request[v1.GetBulkStateRequest]) (*connect.Response[v1.GetBulkStateResponse], error)
+	// Gets a bulk of state items for a list of keys, streaming the items in
+	// chunks as they are read from the state store.
+	GetBulkStateStreamAlpha1(context.Context, *connect.Request[v1.GetBulkStateStreamRequest], *connect.ServerStream[v1.GetBulkStateStreamResponse]) error
 	// Saves the state for a specific key.
 	SaveState(context.Context, *connect.Request[v1.SaveStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Queries the state.
 	QueryStateAlpha1(context.Context, *connect.Request[v1.QueryStateRequest]) (*connect.Response[v1.QueryStateResponse], error)
+	// Queries the state with the stable query grammar, optionally across
+	// multiple state stores.
+	QueryStateBeta1(context.Context, *connect.Request[v1.QueryStateRequest]) (*connect.Response[v1.QueryStateResponse], error)
+	// Watches keys of a state store for changes.
+	WatchStateAlpha1(context.Context, *connect.Request[v1.WatchStateRequest], *connect.ServerStream[v1.WatchStateResponse]) error
 	// Deletes the state for a specific key.
 	DeleteState(context.Context, *connect.Request[v1.DeleteStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Deletes a bulk of state items for a list of keys
 	DeleteBulkState(context.Context, *connect.Request[v1.DeleteBulkStateRequest]) (*connect.Response[emptypb.Empty], error)
 	// Executes transactions for a specified store
 	ExecuteStateTransaction(context.Context, *connect.Request[v1.ExecuteStateTransactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// Copies the state of this app from a state store to another, reporting
+	// the progress on the metadata API.
+	MigrateStateAlpha1(context.Context, *connect.Request[v1.MigrateStateRequest]) (*connect.Response[v1.MigrateStateResponse], error)
 	// Publishes events to the specific topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error)
 	// Deprecated: Bulk Publishes multiple events to the specified topic.
@@ -1338,12 +1735,26 @@ type DaprHandler interface {
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(context.Context, *connect.BidiStream[v1.SubscribeTopicEventsRequestAlpha1, v1.SubscribeTopicEventsResponseAlpha1]) error
+	// Pauses the delivery of the messages of a topic to the app.
+	PauseSubscriptionAlpha1(context.Context, *connect.Request[v1.PauseSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// Resumes the delivery of the messages of a paused topic to the app.
+	ResumeSubscriptionAlpha1(context.Context, *connect.Request[v1.ResumeSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *connect.Request[v1.InvokeBindingRequest]) (*connect.Response[v1.InvokeBindingResponse], error)
+	// Pauses reading the events of an input binding.
+	PauseInputBindingAlpha1(context.Context, *connect.Request[v1.PauseInputBindingRequest]) (*connect.Response[emptypb.Empty], error)
+	// Resumes reading the events of a paused input binding.
+	ResumeInputBindingAlpha1(context.Context, *connect.Request[v1.ResumeInputBindingRequest]) (*connect.Response[emptypb.Empty], error)
 	// Gets secrets from secret stores.
 	GetSecret(context.Context, *connect.Request[v1.GetSecretRequest]) (*connect.Response[v1.GetSecretResponse], error)
 	// Gets a bulk of secrets
 	GetBulkSecret(context.Context, *connect.Request[v1.GetBulkSecretRequest]) (*connect.Response[v1.GetBulkSecretResponse], error)
+	// Gets a bulk of secrets, streamed in chunks.
+	GetBulkSecretStreamAlpha1(context.Context, *connect.Request[v1.GetBulkSecretStreamRequest], *connect.ServerStream[v1.GetBulkSecretStreamResponse]) error
+	// Watches secrets of a secret store for changes.
+	WatchSecretsAlpha1(context.Context, *connect.Request[v1.WatchSecretsRequest], *connect.ServerStream[v1.WatchSecretsResponse]) error
+	// Purges secrets from the cache of a secret store.
+	PurgeSecretsCacheAlpha1(context.Context, *connect.Request[v1.PurgeSecretsCacheRequest]) (*connect.Response[v1.PurgeSecretsCacheResponse], error)
 	// Register an actor timer.
 	RegisterActorTimer(context.Context, *connect.Request[v1.RegisterActorTimerRequest]) (*connect.Response[emptypb.Empty], error)
 	// Unregister an actor timer.
@@ -1385,6 +1796,11 @@ type DaprHandler interface {
 	TryLockAlpha1(context.Context, *connect.Request[v1.TryLockRequest]) (*connect.Response[v1.TryLockResponse], error)
 	// UnlockAlpha1 unlocks a lock.
 	UnlockAlpha1(context.Context, *connect.Request[v1.UnlockRequest]) (*connect.Response[v1.UnlockResponse], error)
+	// RenewLockAlpha1 extends the expiry of a lock held by the lock owner.
+	RenewLockAlpha1(context.Context, *connect.Request[v1.RenewLockRequest]) (*connect.Response[v1.RenewLockResponse], error)
+	// AcquireLockAlpha1 waits for a lock to be released to acquire it, in the
+	// order in which the lock was requested on the sidecar.
+	AcquireLockAlpha1(context.Context, *connect.Request[v1.AcquireLockRequest]) (*connect.Response[v1.AcquireLockResponse], error)
 	// EncryptAlpha1 encrypts a message using the Dapr encryption scheme and a key stored in the vault.
 	EncryptAlpha1(context.Context, *connect.BidiStream[v1.EncryptRequest, v1.EncryptResponse]) error
 	// DecryptAlpha1 decrypts a message using the Dapr encryption scheme and a key stored in the vault.
@@ -1393,6 +1809,12 @@ type DaprHandler interface {
 	GetMetadata(context.Context, *connect.Request[v1.GetMetadataRequest]) (*connect.Response[v1.GetMetadataResponse], error)
 	// Sets value in extended metadata of the sidecar
 	SetMetadata(context.Context, *connect.Request[v1.SetMetadataRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetActiveActorsAlpha1 lists the counts per type of the actors active on
+	// this host, and optionally a page of their IDs.
+	GetActiveActorsAlpha1(context.Context, *connect.Request[v1.GetActiveActorsRequest]) (*connect.Response[v1.GetActiveActorsResponse], error)
+	// GetResiliencyAlpha1 lists the resiliency policies loaded by the sidecar,
+	// the targets they apply to, and the state of their circuit breakers.
+	GetResiliencyAlpha1(context.Context, *connect.Request[v1.GetResiliencyRequest]) (*connect.Response[v1.GetResiliencyResponse], error)
 	// SubtleGetKeyAlpha1 returns the public part of an asymmetric key stored in the vault.
 	SubtleGetKeyAlpha1(context.Context, *connect.Request[v1.SubtleGetKeyRequest]) (*connect.Response[v1.SubtleGetKeyResponse], error)
 	// SubtleEncryptAlpha1 encrypts a small message using a key stored in the vault.
@@ -1449,8 +1871,24 @@ type DaprHandler interface {
 	ResumeWorkflowBeta1(context.Context, *connect.Request[v1.ResumeWorkflowRequest]) (*connect.Response[emptypb.Empty], error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(context.Context, *connect.Request[v1.RaiseEventWorkflowRequest]) (*connect.Response[emptypb.Empty], error)
+	// List workflow instances, filtered by status, name and creation time
+	ListWorkflowInstancesBeta1(context.Context, *connect.Request[v1.ListWorkflowInstancesRequest]) (*connect.Response[v1.ListWorkflowInstancesResponse], error)
+	// Export the full history of a workflow instance
+	GetWorkflowHistoryBeta1(context.Context, *connect.Request[v1.GetWorkflowHistoryRequest]) (*connect.Response[v1.GetWorkflowHistoryResponse], error)
+	// Replay a completed workflow instance against the current workflow code to
+	// detect non-determinism
+	ReplayWorkflowBeta1(context.Context, *connect.Request[v1.ReplayWorkflowRequest]) (*connect.Response[v1.ReplayWorkflowResponse], error)
 	// Shutdown the sidecar
 	Shutdown(context.Context, *connect.Request[v1.ShutdownRequest]) (*connect.Response[emptypb.Empty], error)
+	// Reload the configuration and the resiliency policies of the sidecar
+	// without restarting it, in self-hosted mode
+	ReloadAlpha1(context.Context, *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error)
+	// SetFaultAlpha1 injects a fault in the calls of the sidecar to the apps,
+	// actors and components, or replaces the fault with the same name. Fault
+	// injection must be enabled in the configuration.
+	SetFaultAlpha1(context.Context, *connect.Request[v1.SetFaultRequest]) (*connect.Response[emptypb.Empty], error)
+	// DeleteFaultAlpha1 stops injecting a fault.
+	DeleteFaultAlpha1(context.Context, *connect.Request[v1.DeleteFaultRequest]) (*connect.Response[emptypb.Empty], error)
 	// Deprecated: Create and schedule a job
 	//
 	// Deprecated: do not use.
@@ -1481,6 +1919,10 @@ type DaprHandler interface {
 	ListJobsAlpha1(context.Context, *connect.Request[v1.ListJobsRequestAlpha1]) (*connect.Response[v1.ListJobsResponseAlpha1], error)
 	// List all jobs
 	ListJobs(context.Context, *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error)
+	// Create and schedule a batch of jobs, reporting the jobs which failed
+	BulkScheduleJobs(context.Context, *connect.Request[v1.BulkScheduleJobsRequest]) (*connect.Response[v1.BulkScheduleJobsResponse], error)
+	// Delete a batch of jobs, reporting the jobs which failed
+	BulkDeleteJobs(context.Context, *connect.Request[v1.BulkDeleteJobsRequest]) (*connect.Response[v1.BulkDeleteJobsResponse], error)
 	// Converse with a LLM service
 	ConverseAlpha1(context.Context, *connect.Request[v1.ConversationRequest]) (*connect.Response[v1.ConversationResponse], error)
 	// Converse with a LLM service via alpha2 api
@@ -1512,6 +1954,12 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("GetBulkState")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprGetBulkStateStreamAlpha1Handler := connect.NewServerStreamHandler(
+		DaprGetBulkStateStreamAlpha1Procedure,
+		svc.GetBulkStateStreamAlpha1,
+		connect.WithSchema(daprMethods.ByName("GetBulkStateStreamAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprSaveStateHandler := connect.NewUnaryHandler(
 		DaprSaveStateProcedure,
 		svc.SaveState,
@@ -1524,6 +1972,18 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("QueryStateAlpha1")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprQueryStateBeta1Handler := connect.NewUnaryHandler(
+		DaprQueryStateBeta1Procedure,
+		svc.QueryStateBeta1,
+		connect.WithSchema(daprMethods.ByName("QueryStateBeta1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprWatchStateAlpha1Handler := connect.NewServerStreamHandler(
+		DaprWatchStateAlpha1Procedure,
+		svc.WatchStateAlpha1,
+		connect.WithSchema(daprMethods.ByName("WatchStateAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprDeleteStateHandler := connect.NewUnaryHandler(
 		DaprDeleteStateProcedure,
 		svc.DeleteState,
@@ -1542,6 +2002,12 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("ExecuteStateTransaction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprMigrateStateAlpha1Handler := connect.NewUnaryHandler(
+		DaprMigrateStateAlpha1Procedure,
+		svc.MigrateStateAlpha1,
+		connect.WithSchema(daprMethods.ByName("MigrateStateAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprPublishEventHandler := connect.NewUnaryHandler(
 		DaprPublishEventProcedure,
 		svc.PublishEvent,
@@ -1566,12 +2032,36 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("SubscribeTopicEventsAlpha1")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprPauseSubscriptionAlpha1Handler := connect.NewUnaryHandler(
+		DaprPauseSubscriptionAlpha1Procedure,
+		svc.PauseSubscriptionAlpha1,
+		connect.WithSchema(daprMethods.ByName("PauseSubscriptionAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprResumeSubscriptionAlpha1Handler := connect.NewUnaryHandler(
+		DaprResumeSubscriptionAlpha1Procedure,
+		svc.ResumeSubscriptionAlpha1,
+		connect.WithSchema(daprMethods.ByName("ResumeSubscriptionAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprInvokeBindingHandler := connect.NewUnaryHandler(
 		DaprInvokeBindingProcedure,
 		svc.InvokeBinding,
 		connect.WithSchema(daprMethods.ByName("InvokeBinding")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprPauseInputBindingAlpha1Handler := connect.NewUnaryHandler(
+		DaprPauseInputBindingAlpha1Procedure,
+		svc.PauseInputBindingAlpha1,
+		connect.WithSchema(daprMethods.ByName("PauseInputBindingAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprResumeInputBindingAlpha1Handler := connect.NewUnaryHandler(
+		DaprResumeInputBindingAlpha1Procedure,
+		svc.ResumeInputBindingAlpha1,
+		connect.WithSchema(daprMethods.ByName("ResumeInputBindingAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprGetSecretHandler := connect.NewUnaryHandler(
 		DaprGetSecretProcedure,
 		svc.GetSecret,
@@ -1584,6 +2074,24 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("GetBulkSecret")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprGetBulkSecretStreamAlpha1Handler := connect.NewServerStreamHandler(
+		DaprGetBulkSecretStreamAlpha1Procedure,
+		svc.GetBulkSecretStreamAlpha1,
+		connect.WithSchema(daprMethods.ByName("GetBulkSecretStreamAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprWatchSecretsAlpha1Handler := connect.NewServerStreamHandler(
+		DaprWatchSecretsAlpha1Procedure,
+		svc.WatchSecretsAlpha1,
+		connect.WithSchema(daprMethods.ByName("WatchSecretsAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprPurgeSecretsCacheAlpha1Handler := connect.NewUnaryHandler(
+		DaprPurgeSecretsCacheAlpha1Procedure,
+		svc.PurgeSecretsCacheAlpha1,
+		connect.WithSchema(daprMethods.ByName("PurgeSecretsCacheAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprRegisterActorTimerHandler := connect.NewUnaryHandler(
 		DaprRegisterActorTimerProcedure,
 		svc.RegisterActorTimer,
@@ -1698,6 +2206,18 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("UnlockAlpha1")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprRenewLockAlpha1Handler := connect.NewUnaryHandler(
+		DaprRenewLockAlpha1Procedure,
+		svc.RenewLockAlpha1,
+		connect.WithSchema(daprMethods.ByName("RenewLockAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprAcquireLockAlpha1Handler := connect.NewUnaryHandler(
+		DaprAcquireLockAlpha1Procedure,
+		svc.AcquireLockAlpha1,
+		connect.WithSchema(daprMethods.ByName("AcquireLockAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprEncryptAlpha1Handler := connect.NewBidiStreamHandler(
 		DaprEncryptAlpha1Procedure,
 		svc.EncryptAlpha1,
@@ -1722,6 +2242,18 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("SetMetadata")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprGetActiveActorsAlpha1Handler := connect.NewUnaryHandler(
+		DaprGetActiveActorsAlpha1Procedure,
+		svc.GetActiveActorsAlpha1,
+		connect.WithSchema(daprMethods.ByName("GetActiveActorsAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprGetResiliencyAlpha1Handler := connect.NewUnaryHandler(
+		DaprGetResiliencyAlpha1Procedure,
+		svc.GetResiliencyAlpha1,
+		connect.WithSchema(daprMethods.ByName("GetResiliencyAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprSubtleGetKeyAlpha1Handler := connect.NewUnaryHandler(
 		DaprSubtleGetKeyAlpha1Procedure,
 		svc.SubtleGetKeyAlpha1,
@@ -1848,12 +2380,48 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("RaiseEventWorkflowBeta1")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprListWorkflowInstancesBeta1Handler := connect.NewUnaryHandler(
+		DaprListWorkflowInstancesBeta1Procedure,
+		svc.ListWorkflowInstancesBeta1,
+		connect.WithSchema(daprMethods.ByName("ListWorkflowInstancesBeta1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprGetWorkflowHistoryBeta1Handler := connect.NewUnaryHandler(
+		DaprGetWorkflowHistoryBeta1Procedure,
+		svc.GetWorkflowHistoryBeta1,
+		connect.WithSchema(daprMethods.ByName("GetWorkflowHistoryBeta1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprReplayWorkflowBeta1Handler := connect.NewUnaryHandler(
+		DaprReplayWorkflowBeta1Procedure,
+		svc.ReplayWorkflowBeta1,
+		connect.WithSchema(daprMethods.ByName("ReplayWorkflowBeta1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprShutdownHandler := connect.NewUnaryHandler(
 		DaprShutdownProcedure,
 		svc.Shutdown,
 		connect.WithSchema(daprMethods.ByName("Shutdown")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprReloadAlpha1Handler := connect.NewUnaryHandler(
+		DaprReloadAlpha1Procedure,
+		svc.ReloadAlpha1,
+		connect.WithSchema(daprMethods.ByName("ReloadAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprSetFaultAlpha1Handler := connect.NewUnaryHandler(
+		DaprSetFaultAlpha1Procedure,
+		svc.SetFaultAlpha1,
+		connect.WithSchema(daprMethods.ByName("SetFaultAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprDeleteFaultAlpha1Handler := connect.NewUnaryHandler(
+		DaprDeleteFaultAlpha1Procedure,
+		svc.DeleteFaultAlpha1,
+		connect.WithSchema(daprMethods.ByName("DeleteFaultAlpha1")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprScheduleJobAlpha1Handler := connect.NewUnaryHandler(
 		DaprScheduleJobAlpha1Procedure,
 		svc.ScheduleJobAlpha1,
@@ -1914,6 +2482,18 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 		connect.WithSchema(daprMethods.ByName("ListJobs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	daprBulkScheduleJobsHandler := connect.NewUnaryHandler(
+		DaprBulkScheduleJobsProcedure,
+		svc.BulkScheduleJobs,
+		connect.WithSchema(daprMethods.ByName("BulkScheduleJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	daprBulkDeleteJobsHandler := connect.NewUnaryHandler(
+		DaprBulkDeleteJobsProcedure,
+		svc.BulkDeleteJobs,
+		connect.WithSchema(daprMethods.ByName("BulkDeleteJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	daprConverseAlpha1Handler := connect.NewUnaryHandler(
 		DaprConverseAlpha1Procedure,
 		svc.ConverseAlpha1,
@@ -1934,16 +2514,24 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 			daprGetStateHandler.ServeHTTP(w, r)
 		case DaprGetBulkStateProcedure:
 			daprGetBulkStateHandler.ServeHTTP(w, r)
+		case DaprGetBulkStateStreamAlpha1Procedure:
+			daprGetBulkStateStreamAlpha1Handler.ServeHTTP(w, r)
 		case DaprSaveStateProcedure:
 			daprSaveStateHandler.ServeHTTP(w, r)
 		case DaprQueryStateAlpha1Procedure:
 			daprQueryStateAlpha1Handler.ServeHTTP(w, r)
+		case DaprQueryStateBeta1Procedure:
+			daprQueryStateBeta1Handler.ServeHTTP(w, r)
+		case DaprWatchStateAlpha1Procedure:
+			daprWatchStateAlpha1Handler.ServeHTTP(w, r)
 		case DaprDeleteStateProcedure:
 			daprDeleteStateHandler.ServeHTTP(w, r)
 		case DaprDeleteBulkStateProcedure:
 			daprDeleteBulkStateHandler.ServeHTTP(w, r)
 		case DaprExecuteStateTransactionProcedure:
 			daprExecuteStateTransactionHandler.ServeHTTP(w, r)
+		case DaprMigrateStateAlpha1Procedure:
+			daprMigrateStateAlpha1Handler.ServeHTTP(w, r)
 		case DaprPublishEventProcedure:
 			daprPublishEventHandler.ServeHTTP(w, r)
 		case DaprBulkPublishEventAlpha1Procedure:
@@ -1952,12 +2540,26 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 			daprBulkPublishEventHandler.ServeHTTP(w, r)
 		case DaprSubscribeTopicEventsAlpha1Procedure:
 			daprSubscribeTopicEventsAlpha1Handler.ServeHTTP(w, r)
+		case DaprPauseSubscriptionAlpha1Procedure:
+			daprPauseSubscriptionAlpha1Handler.ServeHTTP(w, r)
+		case DaprResumeSubscriptionAlpha1Procedure:
+			daprResumeSubscriptionAlpha1Handler.ServeHTTP(w, r)
 		case DaprInvokeBindingProcedure:
 			daprInvokeBindingHandler.ServeHTTP(w, r)
+		case DaprPauseInputBindingAlpha1Procedure:
+			daprPauseInputBindingAlpha1Handler.ServeHTTP(w, r)
+		case DaprResumeInputBindingAlpha1Procedure:
+			daprResumeInputBindingAlpha1Handler.ServeHTTP(w, r)
 		case DaprGetSecretProcedure:
 			daprGetSecretHandler.ServeHTTP(w, r)
 		case DaprGetBulkSecretProcedure:
 			daprGetBulkSecretHandler.ServeHTTP(w, r)
+		case DaprGetBulkSecretStreamAlpha1Procedure:
+			daprGetBulkSecretStreamAlpha1Handler.ServeHTTP(w, r)
+		case DaprWatchSecretsAlpha1Procedure:
+			daprWatchSecretsAlpha1Handler.ServeHTTP(w, r)
+		case DaprPurgeSecretsCacheAlpha1Procedure:
+			daprPurgeSecretsCacheAlpha1Handler.ServeHTTP(w, r)
 		case DaprRegisterActorTimerProcedure:
 			daprRegisterActorTimerHandler.ServeHTTP(w, r)
 		case DaprUnregisterActorTimerProcedure:
@@ -1996,6 +2598,10 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 			daprTryLockAlpha1Handler.ServeHTTP(w, r)
 		case DaprUnlockAlpha1Procedure:
 			daprUnlockAlpha1Handler.ServeHTTP(w, r)
+		case DaprRenewLockAlpha1Procedure:
+			daprRenewLockAlpha1Handler.ServeHTTP(w, r)
+		case DaprAcquireLockAlpha1Procedure:
+			daprAcquireLockAlpha1Handler.ServeHTTP(w, r)
 		case DaprEncryptAlpha1Procedure:
 			daprEncryptAlpha1Handler.ServeHTTP(w, r)
 		case DaprDecryptAlpha1Procedure:
@@ -2004,6 +2610,10 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 			daprGetMetadataHandler.ServeHTTP(w, r)
 		case DaprSetMetadataProcedure:
 			daprSetMetadataHandler.ServeHTTP(w, r)
+		case DaprGetActiveActorsAlpha1Procedure:
+			daprGetActiveActorsAlpha1Handler.ServeHTTP(w, r)
+		case DaprGetResiliencyAlpha1Procedure:
+			daprGetResiliencyAlpha1Handler.ServeHTTP(w, r)
 		case DaprSubtleGetKeyAlpha1Procedure:
 			daprSubtleGetKeyAlpha1Handler.ServeHTTP(w, r)
 		case DaprSubtleEncryptAlpha1Procedure:
@@ -2046,8 +2656,20 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 			daprResumeWorkflowBeta1Handler.ServeHTTP(w, r)
 		case DaprRaiseEventWorkflowBeta1Procedure:
 			daprRaiseEventWorkflowBeta1Handler.ServeHTTP(w, r)
+		case DaprListWorkflowInstancesBeta1Procedure:
+			daprListWorkflowInstancesBeta1Handler.ServeHTTP(w, r)
+		case DaprGetWorkflowHistoryBeta1Procedure:
+			daprGetWorkflowHistoryBeta1Handler.ServeHTTP(w, r)
+		case DaprReplayWorkflowBeta1Procedure:
+			daprReplayWorkflowBeta1Handler.ServeHTTP(w, r)
 		case DaprShutdownProcedure:
 			daprShutdownHandler.ServeHTTP(w, r)
+		case DaprReloadAlpha1Procedure:
+			daprReloadAlpha1Handler.ServeHTTP(w, r)
+		case DaprSetFaultAlpha1Procedure:
+			daprSetFaultAlpha1Handler.ServeHTTP(w, r)
+		case DaprDeleteFaultAlpha1Procedure:
+			daprDeleteFaultAlpha1Handler.ServeHTTP(w, r)
 		case DaprScheduleJobAlpha1Procedure:
 			daprScheduleJobAlpha1Handler.ServeHTTP(w, r)
 		case DaprScheduleJobProcedure:
@@ -2068,6 +2690,10 @@ func NewDaprHandler(svc DaprHandler, opts ...connect.HandlerOption) (string, htt
 			daprListJobsAlpha1Handler.ServeHTTP(w, r)
 		case DaprListJobsProcedure:
 			daprListJobsHandler.ServeHTTP(w, r)
+		case DaprBulkScheduleJobsProcedure:
+			daprBulkScheduleJobsHandler.ServeHTTP(w, r)
+		case DaprBulkDeleteJobsProcedure:
+			daprBulkDeleteJobsHandler.ServeHTTP(w, r)
 		case DaprConverseAlpha1Procedure:
 			daprConverseAlpha1Handler.ServeHTTP(w, r)
 		case DaprConverseAlpha2Procedure:
@@ -2093,6 +2719,10 @@ func (UnimplementedDaprHandler) GetBulkState(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetBulkState is not implemented"))
 }
 
+func (UnimplementedDaprHandler) GetBulkStateStreamAlpha1(context.Context, *connect.Request[v1.GetBulkStateStreamRequest], *connect.ServerStream[v1.GetBulkStateStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetBulkStateStreamAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) SaveState(context.Context, *connect.Request[v1.SaveStateRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.SaveState is not implemented"))
 }
@@ -2101,6 +2731,14 @@ func (UnimplementedDaprHandler) QueryStateAlpha1(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.QueryStateAlpha1 is not implemented"))
 }
 
+func (UnimplementedDaprHandler) QueryStateBeta1(context.Context, *connect.Request[v1.QueryStateRequest]) (*connect.Response[v1.QueryStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.QueryStateBeta1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) WatchStateAlpha1(context.Context, *connect.Request[v1.WatchStateRequest], *connect.ServerStream[v1.WatchStateResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.WatchStateAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) DeleteState(context.Context, *connect.Request[v1.DeleteStateRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.DeleteState is not implemented"))
 }
@@ -2113,6 +2751,10 @@ func (UnimplementedDaprHandler) ExecuteStateTransaction(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ExecuteStateTransaction is not implemented"))
 }
 
+func (UnimplementedDaprHandler) MigrateStateAlpha1(context.Context, *connect.Request[v1.MigrateStateRequest]) (*connect.Response[v1.MigrateStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.MigrateStateAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.PublishEvent is not implemented"))
 }
@@ -2129,10 +2771,26 @@ func (UnimplementedDaprHandler) SubscribeTopicEventsAlpha1(context.Context, *con
 	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.SubscribeTopicEventsAlpha1 is not implemented"))
 }
 
+func (UnimplementedDaprHandler) PauseSubscriptionAlpha1(context.Context, *connect.Request[v1.PauseSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.PauseSubscriptionAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) ResumeSubscriptionAlpha1(context.Context, *connect.Request[v1.ResumeSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ResumeSubscriptionAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) InvokeBinding(context.Context, *connect.Request[v1.InvokeBindingRequest]) (*connect.Response[v1.InvokeBindingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.InvokeBinding is not implemented"))
 }
 
+func (UnimplementedDaprHandler) PauseInputBindingAlpha1(context.Context, *connect.Request[v1.PauseInputBindingRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.PauseInputBindingAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) ResumeInputBindingAlpha1(context.Context, *connect.Request[v1.ResumeInputBindingRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ResumeInputBindingAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) GetSecret(context.Context, *connect.Request[v1.GetSecretRequest]) (*connect.Response[v1.GetSecretResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetSecret is not implemented"))
 }
@@ -2141,6 +2799,18 @@ func (UnimplementedDaprHandler) GetBulkSecret(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetBulkSecret is not implemented"))
 }
 
+func (UnimplementedDaprHandler) GetBulkSecretStreamAlpha1(context.Context, *connect.Request[v1.GetBulkSecretStreamRequest], *connect.ServerStream[v1.GetBulkSecretStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetBulkSecretStreamAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) WatchSecretsAlpha1(context.Context, *connect.Request[v1.WatchSecretsRequest], *connect.ServerStream[v1.WatchSecretsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.WatchSecretsAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) PurgeSecretsCacheAlpha1(context.Context, *connect.Request[v1.PurgeSecretsCacheRequest]) (*connect.Response[v1.PurgeSecretsCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.PurgeSecretsCacheAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) RegisterActorTimer(context.Context, *connect.Request[v1.RegisterActorTimerRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.RegisterActorTimer is not implemented"))
 }
@@ -2217,6 +2887,14 @@ func (UnimplementedDaprHandler) UnlockAlpha1(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.UnlockAlpha1 is not implemented"))
 }
 
+func (UnimplementedDaprHandler) RenewLockAlpha1(context.Context, *connect.Request[v1.RenewLockRequest]) (*connect.Response[v1.RenewLockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.RenewLockAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) AcquireLockAlpha1(context.Context, *connect.Request[v1.AcquireLockRequest]) (*connect.Response[v1.AcquireLockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.AcquireLockAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) EncryptAlpha1(context.Context, *connect.BidiStream[v1.EncryptRequest, v1.EncryptResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.EncryptAlpha1 is not implemented"))
 }
@@ -2233,6 +2911,14 @@ func (UnimplementedDaprHandler) SetMetadata(context.Context, *connect.Request[v1
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.SetMetadata is not implemented"))
 }
 
+func (UnimplementedDaprHandler) GetActiveActorsAlpha1(context.Context, *connect.Request[v1.GetActiveActorsRequest]) (*connect.Response[v1.GetActiveActorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetActiveActorsAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) GetResiliencyAlpha1(context.Context, *connect.Request[v1.GetResiliencyRequest]) (*connect.Response[v1.GetResiliencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetResiliencyAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) SubtleGetKeyAlpha1(context.Context, *connect.Request[v1.SubtleGetKeyRequest]) (*connect.Response[v1.SubtleGetKeyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.SubtleGetKeyAlpha1 is not implemented"))
 }
@@ -2317,10 +3003,34 @@ func (UnimplementedDaprHandler) RaiseEventWorkflowBeta1(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.RaiseEventWorkflowBeta1 is not implemented"))
 }
 
+func (UnimplementedDaprHandler) ListWorkflowInstancesBeta1(context.Context, *connect.Request[v1.ListWorkflowInstancesRequest]) (*connect.Response[v1.ListWorkflowInstancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ListWorkflowInstancesBeta1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) GetWorkflowHistoryBeta1(context.Context, *connect.Request[v1.GetWorkflowHistoryRequest]) (*connect.Response[v1.GetWorkflowHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.GetWorkflowHistoryBeta1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) ReplayWorkflowBeta1(context.Context, *connect.Request[v1.ReplayWorkflowRequest]) (*connect.Response[v1.ReplayWorkflowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ReplayWorkflowBeta1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) Shutdown(context.Context, *connect.Request[v1.ShutdownRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.Shutdown is not implemented"))
 }
 
+func (UnimplementedDaprHandler) ReloadAlpha1(context.Context, *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ReloadAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) SetFaultAlpha1(context.Context, *connect.Request[v1.SetFaultRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.SetFaultAlpha1 is not implemented"))
+}
+
+func (UnimplementedDaprHandler) DeleteFaultAlpha1(context.Context, *connect.Request[v1.DeleteFaultRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.DeleteFaultAlpha1 is not implemented"))
+}
+
 func (UnimplementedDaprHandler) ScheduleJobAlpha1(context.Context, *connect.Request[v1.ScheduleJobRequest]) (*connect.Response[v1.ScheduleJobResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ScheduleJobAlpha1 is not implemented"))
 }
@@ -2361,6 +3071,14 @@ func (UnimplementedDaprHandler) ListJobs(context.Context, *connect.Request[v1.Li
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ListJobs is not implemented"))
 }
 
+func (UnimplementedDaprHandler) BulkScheduleJobs(context.Context, *connect.Request[v1.BulkScheduleJobsRequest]) (*connect.Response[v1.BulkScheduleJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.BulkScheduleJobs is not implemented"))
+}
+
+func (UnimplementedDaprHandler) BulkDeleteJobs(context.Context, *connect.Request[v1.BulkDeleteJobsRequest]) (*connect.Response[v1.BulkDeleteJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.BulkDeleteJobs is not implemented"))
+}
+
 func (UnimplementedDaprHandler) ConverseAlpha1(context.Context, *connect.Request[v1.ConversationRequest]) (*connect.Response[v1.ConversationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dapr.proto.runtime.v1.Dapr.ConverseAlpha1 is not implemented"))
 }
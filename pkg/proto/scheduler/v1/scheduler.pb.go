@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -81,6 +82,9 @@ const (
 	WatchJobsRequestResultStatus_SUCCESS WatchJobsRequestResultStatus = 0
 	// The job was processed with an error.
 	WatchJobsRequestResultStatus_FAILED WatchJobsRequestResultStatus = 1
+	// The job trigger was received by the daprd sidecar, which will report the
+	// processing result later. Only sent for triggers with an idempotency token.
+	WatchJobsRequestResultStatus_RECEIVED WatchJobsRequestResultStatus = 2
 )
 
 // Enum value maps for WatchJobsRequestResultStatus.
@@ -88,10 +92,12 @@ var (
 	WatchJobsRequestResultStatus_name = map[int32]string{
 		0: "SUCCESS",
 		1: "FAILED",
+		2: "RECEIVED",
 	}
 	WatchJobsRequestResultStatus_value = map[string]int32{
-		"SUCCESS": 0,
-		"FAILED":  1,
+		"SUCCESS":  0,
+		"FAILED":   1,
+		"RECEIVED": 2,
 	}
 )
 
@@ -122,6 +128,61 @@ func (WatchJobsRequestResultStatus) EnumDescriptor() ([]byte, []int) {
 	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{1}
 }
 
+// JobTriggerResult is the outcome of a job trigger attempt.
+type JobTriggerResult int32
+
+const (
+	// JOB_TRIGGER_RESULT_SUCCESS is a trigger which was processed successfully.
+	JobTriggerResult_JOB_TRIGGER_RESULT_SUCCESS JobTriggerResult = 0
+	// JOB_TRIGGER_RESULT_FAILED is a trigger which was delivered but failed to
+	// be processed.
+	JobTriggerResult_JOB_TRIGGER_RESULT_FAILED JobTriggerResult = 1
+	// JOB_TRIGGER_RESULT_UNDELIVERABLE is a trigger which could not be
+	// delivered as no daprd sidecar was connected to receive it.
+	JobTriggerResult_JOB_TRIGGER_RESULT_UNDELIVERABLE JobTriggerResult = 2
+)
+
+// Enum value maps for JobTriggerResult.
+var (
+	JobTriggerResult_name = map[int32]string{
+		0: "JOB_TRIGGER_RESULT_SUCCESS",
+		1: "JOB_TRIGGER_RESULT_FAILED",
+		2: "JOB_TRIGGER_RESULT_UNDELIVERABLE",
+	}
+	JobTriggerResult_value = map[string]int32{
+		"JOB_TRIGGER_RESULT_SUCCESS":       0,
+		"JOB_TRIGGER_RESULT_FAILED":        1,
+		"JOB_TRIGGER_RESULT_UNDELIVERABLE": 2,
+	}
+)
+
+func (x JobTriggerResult) Enum() *JobTriggerResult {
+	p := new(JobTriggerResult)
+	*p = x
+	return p
+}
+
+func (x JobTriggerResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobTriggerResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_enumTypes[2].Descriptor()
+}
+
+func (JobTriggerResult) Type() protoreflect.EnumType {
+	return &file_dapr_proto_scheduler_v1_scheduler_proto_enumTypes[2]
+}
+
+func (x JobTriggerResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobTriggerResult.Descriptor instead.
+func (JobTriggerResult) EnumDescriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{2}
+}
+
 type Job struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -142,6 +203,9 @@ type Job struct {
 	// By default, the failure policy is FailurePolicyConstant with a 1s interval
 	// and 3 maximum retries.
 	FailurePolicy *v1.JobFailurePolicy `protobuf:"bytes,6,opt,name=failure_policy,json=failurePolicy,proto3,oneof" json:"failure_policy,omitempty"`
+	// Optional: IANA time zone the schedule is evaluated in, for example
+	// "America/New_York". Defaults to UTC.
+	TimeZone *string `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
 }
 
 func (x *Job) Reset() {
@@ -218,6 +282,13 @@ func (x *Job) GetFailurePolicy() *v1.JobFailurePolicy {
 	return nil
 }
 
+func (x *Job) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
 // TargetJob is the message used by the daprd sidecar to schedule a job
 // from an App.
 type TargetJob struct {
@@ -325,7 +396,6 @@ type JobTargetMetadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Type:
-	//
 	//	*JobTargetMetadata_Job
 	//	*JobTargetMetadata_Actor
 	Type isJobTargetMetadata_Type `protobuf_oneof:"type"`
@@ -418,6 +488,22 @@ type JobMetadata struct {
 	// value in addition to any type-level gate. For workflow activities this is
 	// the activity name, for workflows this is the workflow name.
 	ConcurrencyKey *string `protobuf:"bytes,4,opt,name=concurrency_key,json=concurrencyKey,proto3,oneof" json:"concurrency_key,omitempty"`
+	// labels are the user-defined labels of the job. They are stored with the
+	// job but are not part of its identity.
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// idempotency_token is set on one-time jobs scheduled with exactly-once
+	// trigger semantics. It is sent to the app with the trigger, so the app can
+	// deduplicate redelivered triggers. Triggers carrying a token are
+	// acknowledged as received by the daprd sidecar, and are not redelivered
+	// once received.
+	IdempotencyToken *string `protobuf:"bytes,6,opt,name=idempotency_token,json=idempotencyToken,proto3,oneof" json:"idempotency_token,omitempty"`
+	// calendar is the optional exclusion calendar of the job. Triggers falling
+	// on an excluded date are skipped.
+	Calendar *JobCalendar `protobuf:"bytes,7,opt,name=calendar,proto3,oneof" json:"calendar,omitempty"`
+	// traceparent is the W3C trace context of the request which scheduled the
+	// job. It is sent back with the triggers, so that the span of an actor
+	// reminder execution is linked to the span which created the reminder.
+	Traceparent *string `protobuf:"bytes,8,opt,name=traceparent,proto3,oneof" json:"traceparent,omitempty"`
 }
 
 func (x *JobMetadata) Reset() {
@@ -480,6 +566,104 @@ func (x *JobMetadata) GetConcurrencyKey() string {
 	return ""
 }
 
+func (x *JobMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *JobMetadata) GetIdempotencyToken() string {
+	if x != nil && x.IdempotencyToken != nil {
+		return *x.IdempotencyToken
+	}
+	return ""
+}
+
+func (x *JobMetadata) GetCalendar() *JobCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+func (x *JobMetadata) GetTraceparent() string {
+	if x != nil && x.Traceparent != nil {
+		return *x.Traceparent
+	}
+	return ""
+}
+
+// JobCalendar is an exclusion calendar of a job, resolved from the
+// Configuration of the daprd sidecar when the job is scheduled.
+type JobCalendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the calendar in the Configuration.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// excluded_dates are the dates on which the job is not triggered, either
+	// "YYYY-MM-DD" for a single date or "MM-DD" for a date every year.
+	ExcludedDates []string `protobuf:"bytes,2,rep,name=excluded_dates,json=excludedDates,proto3" json:"excluded_dates,omitempty"`
+	// time_zone is the IANA time zone the dates are evaluated in. Defaults to
+	// UTC.
+	TimeZone *string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+}
+
+func (x *JobCalendar) Reset() {
+	*x = JobCalendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobCalendar) ProtoMessage() {}
+
+func (x *JobCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobCalendar.ProtoReflect.Descriptor instead.
+func (*JobCalendar) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *JobCalendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobCalendar) GetExcludedDates() []string {
+	if x != nil {
+		return x.ExcludedDates
+	}
+	return nil
+}
+
+func (x *JobCalendar) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
 // WatchJobsRequest is the message used by the daprd sidecar to connect to the
 // Scheduler and send Job process results.
 type WatchJobsRequest struct {
@@ -488,7 +672,6 @@ type WatchJobsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to WatchJobRequestType:
-	//
 	//	*WatchJobsRequest_Initial
 	//	*WatchJobsRequest_Result
 	WatchJobRequestType isWatchJobsRequest_WatchJobRequestType `protobuf_oneof:"watch_job_request_type"`
@@ -497,7 +680,7 @@ type WatchJobsRequest struct {
 func (x *WatchJobsRequest) Reset() {
 	*x = WatchJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[5]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -510,7 +693,7 @@ func (x *WatchJobsRequest) String() string {
 func (*WatchJobsRequest) ProtoMessage() {}
 
 func (x *WatchJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[5]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +706,7 @@ func (x *WatchJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchJobsRequest.ProtoReflect.Descriptor instead.
 func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{5}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *WatchJobsRequest) GetWatchJobRequestType() isWatchJobsRequest_WatchJobRequestType {
@@ -589,7 +772,7 @@ type WatchJobsRequestInitial struct {
 func (x *WatchJobsRequestInitial) Reset() {
 	*x = WatchJobsRequestInitial{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[6]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -602,7 +785,7 @@ func (x *WatchJobsRequestInitial) String() string {
 func (*WatchJobsRequestInitial) ProtoMessage() {}
 
 func (x *WatchJobsRequestInitial) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[6]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +798,7 @@ func (x *WatchJobsRequestInitial) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchJobsRequestInitial.ProtoReflect.Descriptor instead.
 func (*WatchJobsRequestInitial) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{6}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WatchJobsRequestInitial) GetAppId() string {
@@ -673,7 +856,6 @@ type ConcurrencyLimit struct {
 	// Jobs (non-actor triggers) when needed.
 	//
 	// Types that are assignable to Target:
-	//
 	//	*ConcurrencyLimit_Actor
 	Target isConcurrencyLimit_Target `protobuf_oneof:"target"`
 }
@@ -681,7 +863,7 @@ type ConcurrencyLimit struct {
 func (x *ConcurrencyLimit) Reset() {
 	*x = ConcurrencyLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[7]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -694,7 +876,7 @@ func (x *ConcurrencyLimit) String() string {
 func (*ConcurrencyLimit) ProtoMessage() {}
 
 func (x *ConcurrencyLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[7]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +889,7 @@ func (x *ConcurrencyLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConcurrencyLimit.ProtoReflect.Descriptor instead.
 func (*ConcurrencyLimit) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{7}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ConcurrencyLimit) GetMaxConcurrent() int32 {
@@ -765,7 +947,7 @@ type ConcurrencyLimitActor struct {
 func (x *ConcurrencyLimitActor) Reset() {
 	*x = ConcurrencyLimitActor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[8]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -778,7 +960,7 @@ func (x *ConcurrencyLimitActor) String() string {
 func (*ConcurrencyLimitActor) ProtoMessage() {}
 
 func (x *ConcurrencyLimitActor) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[8]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +973,7 @@ func (x *ConcurrencyLimitActor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConcurrencyLimitActor.ProtoReflect.Descriptor instead.
 func (*ConcurrencyLimitActor) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{8}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ConcurrencyLimitActor) GetType() string {
@@ -812,12 +994,15 @@ type WatchJobsRequestResult struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// status is the status of the job processing.
 	Status WatchJobsRequestResultStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dapr.proto.scheduler.v1.WatchJobsRequestResultStatus" json:"status,omitempty"`
+	// error is the optional reason the job processing failed, recorded in the
+	// job's trigger history.
+	Error *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
 }
 
 func (x *WatchJobsRequestResult) Reset() {
 	*x = WatchJobsRequestResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[9]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -830,7 +1015,7 @@ func (x *WatchJobsRequestResult) String() string {
 func (*WatchJobsRequestResult) ProtoMessage() {}
 
 func (x *WatchJobsRequestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[9]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1028,7 @@ func (x *WatchJobsRequestResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchJobsRequestResult.ProtoReflect.Descriptor instead.
 func (*WatchJobsRequestResult) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{9}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WatchJobsRequestResult) GetId() uint64 {
@@ -860,6 +1045,13 @@ func (x *WatchJobsRequestResult) GetStatus() WatchJobsRequestResultStatus {
 	return WatchJobsRequestResultStatus_SUCCESS
 }
 
+func (x *WatchJobsRequestResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
 // WatchJobsResponse is the response message to convey the details of a job.
 type WatchJobsResponse struct {
 	state         protoimpl.MessageState
@@ -880,7 +1072,7 @@ type WatchJobsResponse struct {
 func (x *WatchJobsResponse) Reset() {
 	*x = WatchJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[10]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -893,7 +1085,7 @@ func (x *WatchJobsResponse) String() string {
 func (*WatchJobsResponse) ProtoMessage() {}
 
 func (x *WatchJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[10]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +1098,7 @@ func (x *WatchJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchJobsResponse.ProtoReflect.Descriptor instead.
 func (*WatchJobsResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{10}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WatchJobsResponse) GetName() string {
@@ -955,7 +1147,7 @@ type ScheduleJobRequest struct {
 func (x *ScheduleJobRequest) Reset() {
 	*x = ScheduleJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[11]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -968,7 +1160,7 @@ func (x *ScheduleJobRequest) String() string {
 func (*ScheduleJobRequest) ProtoMessage() {}
 
 func (x *ScheduleJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[11]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1173,7 @@ func (x *ScheduleJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleJobRequest.ProtoReflect.Descriptor instead.
 func (*ScheduleJobRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{11}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ScheduleJobRequest) GetName() string {
@@ -1021,7 +1213,7 @@ type ScheduleJobResponse struct {
 func (x *ScheduleJobResponse) Reset() {
 	*x = ScheduleJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[12]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1034,7 +1226,7 @@ func (x *ScheduleJobResponse) String() string {
 func (*ScheduleJobResponse) ProtoMessage() {}
 
 func (x *ScheduleJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[12]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1239,7 @@ func (x *ScheduleJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleJobResponse.ProtoReflect.Descriptor instead.
 func (*ScheduleJobResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{12}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{13}
 }
 
 // GetJobRequest is the message used by the daprd sidecar to delete or get a job.
@@ -1065,7 +1257,7 @@ type GetJobRequest struct {
 func (x *GetJobRequest) Reset() {
 	*x = GetJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[13]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1078,7 +1270,7 @@ func (x *GetJobRequest) String() string {
 func (*GetJobRequest) ProtoMessage() {}
 
 func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[13]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +1283,7 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
 func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{13}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetJobRequest) GetName() string {
@@ -1116,12 +1308,22 @@ type GetJobResponse struct {
 
 	// The job to be scheduled.
 	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// labels are the user-defined labels of the job.
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// history is the most recent trigger attempts of the job, oldest first.
+	// Empty if trigger history is disabled on the scheduler.
+	History []*JobTriggerAttempt `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	// exactly_once is true if the job was scheduled with exactly-once trigger
+	// semantics.
+	ExactlyOnce bool `protobuf:"varint,4,opt,name=exactly_once,json=exactlyOnce,proto3" json:"exactly_once,omitempty"`
+	// calendar is the name of the exclusion calendar of the job, if any.
+	Calendar *string `protobuf:"bytes,5,opt,name=calendar,proto3,oneof" json:"calendar,omitempty"`
 }
 
 func (x *GetJobResponse) Reset() {
 	*x = GetJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[14]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1134,7 +1336,7 @@ func (x *GetJobResponse) String() string {
 func (*GetJobResponse) ProtoMessage() {}
 
 func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[14]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1349,7 @@ func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
 func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{14}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetJobResponse) GetJob() *Job {
@@ -1157,34 +1359,65 @@ func (x *GetJobResponse) GetJob() *Job {
 	return nil
 }
 
-// DeleteJobRequest is the message used by the daprd sidecar to delete or get a job.
-type DeleteJobRequest struct {
+func (x *GetJobResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *GetJobResponse) GetHistory() []*JobTriggerAttempt {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetJobResponse) GetExactlyOnce() bool {
+	if x != nil {
+		return x.ExactlyOnce
+	}
+	return false
+}
+
+func (x *GetJobResponse) GetCalendar() string {
+	if x != nil && x.Calendar != nil {
+		return *x.Calendar
+	}
+	return ""
+}
+
+// JobTriggerAttempt is a single trigger attempt of a job.
+type JobTriggerAttempt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The metadata associated with the job.
-	Metadata *JobMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// time is the time the trigger attempt was made.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// result is the outcome of the trigger attempt.
+	Result JobTriggerResult `protobuf:"varint,2,opt,name=result,proto3,enum=dapr.proto.scheduler.v1.JobTriggerResult" json:"result,omitempty"`
+	// error is the reason the trigger attempt failed, if reported.
+	Error *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
 }
 
-func (x *DeleteJobRequest) Reset() {
-	*x = DeleteJobRequest{}
+func (x *JobTriggerAttempt) Reset() {
+	*x = JobTriggerAttempt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[15]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteJobRequest) String() string {
+func (x *JobTriggerAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobRequest) ProtoMessage() {}
+func (*JobTriggerAttempt) ProtoMessage() {}
 
-func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[15]
+func (x *JobTriggerAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,49 +1428,59 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use JobTriggerAttempt.ProtoReflect.Descriptor instead.
+func (*JobTriggerAttempt) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteJobRequest) GetName() string {
+func (x *JobTriggerAttempt) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteJobRequest) GetMetadata() *JobMetadata {
+func (x *JobTriggerAttempt) GetResult() JobTriggerResult {
 	if x != nil {
-		return x.Metadata
+		return x.Result
 	}
-	return nil
+	return JobTriggerResult_JOB_TRIGGER_RESULT_SUCCESS
 }
 
-// DeleteJobRequest is the response message used by the daprd sidecar to delete or get a job.
-type DeleteJobResponse struct {
+func (x *JobTriggerAttempt) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// JobTriggerHistory is the stored trigger history of a job.
+type JobTriggerHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// attempts are the most recent trigger attempts, oldest first.
+	Attempts []*JobTriggerAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
-func (x *DeleteJobResponse) Reset() {
-	*x = DeleteJobResponse{}
+func (x *JobTriggerHistory) Reset() {
+	*x = JobTriggerHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[16]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteJobResponse) String() string {
+func (x *JobTriggerHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobResponse) ProtoMessage() {}
+func (*JobTriggerHistory) ProtoMessage() {}
 
-func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[16]
+func (x *JobTriggerHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,42 +1491,46 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use JobTriggerHistory.ProtoReflect.Descriptor instead.
+func (*JobTriggerHistory) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{17}
 }
 
-// NamedJob is a job with a name.
-type NamedJob struct {
+func (x *JobTriggerHistory) GetAttempts() []*JobTriggerAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+// DeleteJobRequest is the message used by the daprd sidecar to delete or get a job.
+type DeleteJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the name of the job.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The metadata associated with the job.
 	Metadata *JobMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// The job scheduled.
-	Job *Job `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *NamedJob) Reset() {
-	*x = NamedJob{}
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[17]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NamedJob) String() string {
+func (x *DeleteJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NamedJob) ProtoMessage() {}
+func (*DeleteJobRequest) ProtoMessage() {}
 
-func (x *NamedJob) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[17]
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,9 +1541,108 @@ func (x *NamedJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NamedJob.ProtoReflect.Descriptor instead.
-func (*NamedJob) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteJobRequest) GetMetadata() *JobMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// DeleteJobRequest is the response message used by the daprd sidecar to delete or get a job.
+type DeleteJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobResponse) ProtoMessage() {}
+
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{19}
+}
+
+// NamedJob is a job with a name.
+type NamedJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the job.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The metadata associated with the job.
+	Metadata *JobMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The job scheduled.
+	Job *Job `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *NamedJob) Reset() {
+	*x = NamedJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamedJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamedJob) ProtoMessage() {}
+
+func (x *NamedJob) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamedJob.ProtoReflect.Descriptor instead.
+func (*NamedJob) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *NamedJob) GetName() string {
@@ -1328,12 +1674,26 @@ type ListJobsRequest struct {
 
 	// The metadata associated with the job.
 	Metadata *JobMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// label_selector filters the jobs to those which have all of the given
+	// labels.
+	LabelSelector map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// due_after and due_before filter the jobs to those with an RFC3339 due
+	// time within the bounds, inclusive.
+	DueAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_after,json=dueAfter,proto3,oneof" json:"due_after,omitempty"`
+	DueBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_before,json=dueBefore,proto3,oneof" json:"due_before,omitempty"`
+	// page_size is the maximum number of jobs to return, ordered by name, or by
+	// actor ID and name joined by "||" for actor reminders. If 0, all matching
+	// jobs are returned.
+	PageSize uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// start_after filters the jobs to those ordered after the job at this
+	// position.
+	StartAfter *ListJobsPosition `protobuf:"bytes,6,opt,name=start_after,json=startAfter,proto3,oneof" json:"start_after,omitempty"`
 }
 
 func (x *ListJobsRequest) Reset() {
 	*x = ListJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[18]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1346,7 +1706,7 @@ func (x *ListJobsRequest) String() string {
 func (*ListJobsRequest) ProtoMessage() {}
 
 func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[18]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,7 +1719,7 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
 func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{18}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListJobsRequest) GetMetadata() *JobMetadata {
@@ -1369,6 +1729,101 @@ func (x *ListJobsRequest) GetMetadata() *JobMetadata {
 	return nil
 }
 
+func (x *ListJobsRequest) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+func (x *ListJobsRequest) GetDueAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAfter
+	}
+	return nil
+}
+
+func (x *ListJobsRequest) GetDueBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBefore
+	}
+	return nil
+}
+
+func (x *ListJobsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetStartAfter() *ListJobsPosition {
+	if x != nil {
+		return x.StartAfter
+	}
+	return nil
+}
+
+// ListJobsPosition is the position of a job in the order jobs are listed in.
+type ListJobsPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the job.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// actor_id is the ID of the actor of an actor reminder. It is only used
+	// when the jobs of an actor type are listed, as the actor ID is otherwise
+	// that of the list request metadata.
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+}
+
+func (x *ListJobsPosition) Reset() {
+	*x = ListJobsPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsPosition) ProtoMessage() {}
+
+func (x *ListJobsPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsPosition.ProtoReflect.Descriptor instead.
+func (*ListJobsPosition) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListJobsPosition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListJobsPosition) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
 // ListJobsResponse is the response message to convey the details of a job.
 type ListJobsResponse struct {
 	state         protoimpl.MessageState
@@ -1377,12 +1832,15 @@ type ListJobsResponse struct {
 
 	// The list of jobs.
 	Jobs []*NamedJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// has_more is true if the page size was reached before all matching jobs
+	// were returned.
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
 func (x *ListJobsResponse) Reset() {
 	*x = ListJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[19]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1395,7 +1853,7 @@ func (x *ListJobsResponse) String() string {
 func (*ListJobsResponse) ProtoMessage() {}
 
 func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[19]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,7 +1866,7 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
 func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{19}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListJobsResponse) GetJobs() []*NamedJob {
@@ -1418,6 +1876,13 @@ func (x *ListJobsResponse) GetJobs() []*NamedJob {
 	return nil
 }
 
+func (x *ListJobsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 // WatchHostsRequest is the message used by the daprd sidecar to connect to the
 // Scheduler and watch for hosts.
 type WatchHostsRequest struct {
@@ -1429,7 +1894,7 @@ type WatchHostsRequest struct {
 func (x *WatchHostsRequest) Reset() {
 	*x = WatchHostsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[20]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1442,7 +1907,7 @@ func (x *WatchHostsRequest) String() string {
 func (*WatchHostsRequest) ProtoMessage() {}
 
 func (x *WatchHostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[20]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +1920,7 @@ func (x *WatchHostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchHostsRequest.ProtoReflect.Descriptor instead.
 func (*WatchHostsRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{20}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{24}
 }
 
 // WatchHostsResponse is the response message to convey the details of a host.
@@ -1471,7 +1936,7 @@ type WatchHostsResponse struct {
 func (x *WatchHostsResponse) Reset() {
 	*x = WatchHostsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[21]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1484,7 +1949,7 @@ func (x *WatchHostsResponse) String() string {
 func (*WatchHostsResponse) ProtoMessage() {}
 
 func (x *WatchHostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[21]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,7 +1962,7 @@ func (x *WatchHostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchHostsResponse.ProtoReflect.Descriptor instead.
 func (*WatchHostsResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{21}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *WatchHostsResponse) GetHosts() []*Host {
@@ -1520,7 +1985,7 @@ type Host struct {
 func (x *Host) Reset() {
 	*x = Host{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[22]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1533,7 +1998,7 @@ func (x *Host) String() string {
 func (*Host) ProtoMessage() {}
 
 func (x *Host) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[22]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +2011,7 @@ func (x *Host) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Host.ProtoReflect.Descriptor instead.
 func (*Host) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{22}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Host) GetAddress() string {
@@ -1575,7 +2040,7 @@ type DeleteByMetadataRequest struct {
 func (x *DeleteByMetadataRequest) Reset() {
 	*x = DeleteByMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[23]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1588,7 +2053,7 @@ func (x *DeleteByMetadataRequest) String() string {
 func (*DeleteByMetadataRequest) ProtoMessage() {}
 
 func (x *DeleteByMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[23]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1601,7 +2066,7 @@ func (x *DeleteByMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteByMetadataRequest.ProtoReflect.Descriptor instead.
 func (*DeleteByMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{23}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeleteByMetadataRequest) GetMetadata() *JobMetadata {
@@ -1628,7 +2093,7 @@ type DeleteByMetadataResponse struct {
 func (x *DeleteByMetadataResponse) Reset() {
 	*x = DeleteByMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[24]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1641,7 +2106,7 @@ func (x *DeleteByMetadataResponse) String() string {
 func (*DeleteByMetadataResponse) ProtoMessage() {}
 
 func (x *DeleteByMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[24]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1654,7 +2119,7 @@ func (x *DeleteByMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteByMetadataResponse.ProtoReflect.Descriptor instead.
 func (*DeleteByMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{24}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{28}
 }
 
 // DeleteByNamePrefixRequest is the message used by the daprd sidecar to delete jobs by name prefix.
@@ -1674,7 +2139,7 @@ type DeleteByNamePrefixRequest struct {
 func (x *DeleteByNamePrefixRequest) Reset() {
 	*x = DeleteByNamePrefixRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[25]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1687,7 +2152,7 @@ func (x *DeleteByNamePrefixRequest) String() string {
 func (*DeleteByNamePrefixRequest) ProtoMessage() {}
 
 func (x *DeleteByNamePrefixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[25]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,7 +2165,7 @@ func (x *DeleteByNamePrefixRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteByNamePrefixRequest.ProtoReflect.Descriptor instead.
 func (*DeleteByNamePrefixRequest) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{25}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteByNamePrefixRequest) GetNamePrefix() string {
@@ -1726,7 +2191,7 @@ type DeleteByNamePrefixResponse struct {
 func (x *DeleteByNamePrefixResponse) Reset() {
 	*x = DeleteByNamePrefixResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[26]
+		mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1739,7 +2204,7 @@ func (x *DeleteByNamePrefixResponse) String() string {
 func (*DeleteByNamePrefixResponse) ProtoMessage() {}
 
 func (x *DeleteByNamePrefixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[26]
+	mi := &file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,7 +2217,7 @@ func (x *DeleteByNamePrefixResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteByNamePrefixResponse.ProtoReflect.Descriptor instead.
 func (*DeleteByNamePrefixResponse) Descriptor() ([]byte, []int) {
-	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{26}
+	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{30}
 }
 
 var File_dapr_proto_scheduler_v1_scheduler_proto protoreflect.FileDescriptor
@@ -1766,263 +2231,377 @@ var file_dapr_proto_scheduler_v1_scheduler_proto_rawDesc = []byte{
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xbb, 0x02, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x07, 0x72, 0x65,
-	0x70, 0x65, 0x61, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x64, 0x75,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x88, 0x01, 0x01, 0x12,
-	0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x0e, 0x66, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x04, 0x52, 0x0d, 0x66, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x0b,
-	0x0a, 0x09, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x22, 0x39, 0x0a, 0x13, 0x54,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xeb, 0x02, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x07, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x75, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x64,
+	0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x0e, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x04, 0x52, 0x0d, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x05, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74, 0x6c, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x22,
+	0x0b, 0x0a, 0x09, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x22, 0x39, 0x0a, 0x13,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6d, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a,
+	0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x48, 0x00,
+	0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x44, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
 	0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x03,
-	0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52,
-	0x03, 0x6a, 0x6f, 0x62, 0x12, 0x44, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
-	0x72, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f,
-	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xc5, 0x01,
-	0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x4c, 0x0a, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x11, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x10, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x48, 0x02,
+	0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65,
+	0x22, 0xc5, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x77,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x9b, 0x02, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a,
-	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
-	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x77, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x64, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x18,
+	0x0a, 0x16, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x9b, 0x02, 0x0a, 0x17, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x6f, 0x62, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x61, 0x70,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xb8, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6a, 0x6f,
-	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64,
-	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
-	0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x65, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
 	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6a, 0x6f,
-	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x68, 0x0a, 0x10, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
-	0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x08, 0x4e, 0x61,
-	0x6d, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64,
-	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x03,
-	0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x53, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03,
+	0x6a, 0x6f, 0x62, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
 	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d,
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x49, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61,
-	0x6d, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x13, 0x0a, 0x11,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x49, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x04,
-	0x48, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9c,
-	0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64,
-	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x0f,
-	0x69, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x64,
-	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x1a, 0x0a,
-	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x19, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
-	0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x61, 0x22, 0xdf, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
+	0x03, 0x6a, 0x6f, 0x62, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x44, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x61, 0x63, 0x74,
+	0x6c, 0x79, 0x5f, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65,
+	0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x4f, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4c, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4a, 0x4f, 0x42, 0x5f,
-	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x10,
-	0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e,
-	0x44, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x37, 0x0a, 0x1c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
-	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x32, 0xee,
-	0x06, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x0b,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x2b, 0x2e, 0x64, 0x61,
-	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x5b, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x46, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x70,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x68,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01,
+	0x0a, 0x08, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x2e, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x09, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62,
+	0x22, 0x92, 0x04, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x62, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x09, 0x64, 0x75,
+	0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x64, 0x75, 0x65, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x09, 0x64, 0x75, 0x65, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x75, 0x65,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04,
+	0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x13,
+	0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
-	0x73, 0x12, 0x28, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x61,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x20,
+	0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x9c, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b,
+	0x0a, 0x0f, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x64, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x69, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61,
 	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x30, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x61, 0x70, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x12, 0x32, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1c, 0x0a, 0x1a, 0x44,
 	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
-	0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4c, 0x0a, 0x0d, 0x4a, 0x6f, 0x62,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4a, 0x4f,
+	0x42, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f,
+	0x42, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x4d,
+	0x49, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x45, 0x0a, 0x1c, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x53, 0x53, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x77,
+	0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x24, 0x0a, 0x20, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
+	0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45,
+	0x52, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x32, 0xee, 0x06, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4a, 0x6f, 0x62, 0x12, 0x2b, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x26, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x29, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62,
+	0x73, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64,
+	0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x61,
+	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x61, 0x70,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x69, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x2a, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x48,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x61,
+	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x30, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x32, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2037,88 +2616,108 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_rawDescGZIP() []byte {
 	return file_dapr_proto_scheduler_v1_scheduler_proto_rawDescData
 }
 
-var file_dapr_proto_scheduler_v1_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_dapr_proto_scheduler_v1_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_dapr_proto_scheduler_v1_scheduler_proto_goTypes = []interface{}{
 	(JobTargetType)(0),                 // 0: dapr.proto.scheduler.v1.JobTargetType
 	(WatchJobsRequestResultStatus)(0),  // 1: dapr.proto.scheduler.v1.WatchJobsRequestResultStatus
-	(*Job)(nil),                        // 2: dapr.proto.scheduler.v1.Job
-	(*TargetJob)(nil),                  // 3: dapr.proto.scheduler.v1.TargetJob
-	(*TargetActorReminder)(nil),        // 4: dapr.proto.scheduler.v1.TargetActorReminder
-	(*JobTargetMetadata)(nil),          // 5: dapr.proto.scheduler.v1.JobTargetMetadata
-	(*JobMetadata)(nil),                // 6: dapr.proto.scheduler.v1.JobMetadata
-	(*WatchJobsRequest)(nil),           // 7: dapr.proto.scheduler.v1.WatchJobsRequest
-	(*WatchJobsRequestInitial)(nil),    // 8: dapr.proto.scheduler.v1.WatchJobsRequestInitial
-	(*ConcurrencyLimit)(nil),           // 9: dapr.proto.scheduler.v1.ConcurrencyLimit
-	(*ConcurrencyLimitActor)(nil),      // 10: dapr.proto.scheduler.v1.ConcurrencyLimitActor
-	(*WatchJobsRequestResult)(nil),     // 11: dapr.proto.scheduler.v1.WatchJobsRequestResult
-	(*WatchJobsResponse)(nil),          // 12: dapr.proto.scheduler.v1.WatchJobsResponse
-	(*ScheduleJobRequest)(nil),         // 13: dapr.proto.scheduler.v1.ScheduleJobRequest
-	(*ScheduleJobResponse)(nil),        // 14: dapr.proto.scheduler.v1.ScheduleJobResponse
-	(*GetJobRequest)(nil),              // 15: dapr.proto.scheduler.v1.GetJobRequest
-	(*GetJobResponse)(nil),             // 16: dapr.proto.scheduler.v1.GetJobResponse
-	(*DeleteJobRequest)(nil),           // 17: dapr.proto.scheduler.v1.DeleteJobRequest
-	(*DeleteJobResponse)(nil),          // 18: dapr.proto.scheduler.v1.DeleteJobResponse
-	(*NamedJob)(nil),                   // 19: dapr.proto.scheduler.v1.NamedJob
-	(*ListJobsRequest)(nil),            // 20: dapr.proto.scheduler.v1.ListJobsRequest
-	(*ListJobsResponse)(nil),           // 21: dapr.proto.scheduler.v1.ListJobsResponse
-	(*WatchHostsRequest)(nil),          // 22: dapr.proto.scheduler.v1.WatchHostsRequest
-	(*WatchHostsResponse)(nil),         // 23: dapr.proto.scheduler.v1.WatchHostsResponse
-	(*Host)(nil),                       // 24: dapr.proto.scheduler.v1.Host
-	(*DeleteByMetadataRequest)(nil),    // 25: dapr.proto.scheduler.v1.DeleteByMetadataRequest
-	(*DeleteByMetadataResponse)(nil),   // 26: dapr.proto.scheduler.v1.DeleteByMetadataResponse
-	(*DeleteByNamePrefixRequest)(nil),  // 27: dapr.proto.scheduler.v1.DeleteByNamePrefixRequest
-	(*DeleteByNamePrefixResponse)(nil), // 28: dapr.proto.scheduler.v1.DeleteByNamePrefixResponse
-	(*anypb.Any)(nil),                  // 29: google.protobuf.Any
-	(*v1.JobFailurePolicy)(nil),        // 30: dapr.proto.common.v1.JobFailurePolicy
+	(JobTriggerResult)(0),              // 2: dapr.proto.scheduler.v1.JobTriggerResult
+	(*Job)(nil),                        // 3: dapr.proto.scheduler.v1.Job
+	(*TargetJob)(nil),                  // 4: dapr.proto.scheduler.v1.TargetJob
+	(*TargetActorReminder)(nil),        // 5: dapr.proto.scheduler.v1.TargetActorReminder
+	(*JobTargetMetadata)(nil),          // 6: dapr.proto.scheduler.v1.JobTargetMetadata
+	(*JobMetadata)(nil),                // 7: dapr.proto.scheduler.v1.JobMetadata
+	(*JobCalendar)(nil),                // 8: dapr.proto.scheduler.v1.JobCalendar
+	(*WatchJobsRequest)(nil),           // 9: dapr.proto.scheduler.v1.WatchJobsRequest
+	(*WatchJobsRequestInitial)(nil),    // 10: dapr.proto.scheduler.v1.WatchJobsRequestInitial
+	(*ConcurrencyLimit)(nil),           // 11: dapr.proto.scheduler.v1.ConcurrencyLimit
+	(*ConcurrencyLimitActor)(nil),      // 12: dapr.proto.scheduler.v1.ConcurrencyLimitActor
+	(*WatchJobsRequestResult)(nil),     // 13: dapr.proto.scheduler.v1.WatchJobsRequestResult
+	(*WatchJobsResponse)(nil),          // 14: dapr.proto.scheduler.v1.WatchJobsResponse
+	(*ScheduleJobRequest)(nil),         // 15: dapr.proto.scheduler.v1.ScheduleJobRequest
+	(*ScheduleJobResponse)(nil),        // 16: dapr.proto.scheduler.v1.ScheduleJobResponse
+	(*GetJobRequest)(nil),              // 17: dapr.proto.scheduler.v1.GetJobRequest
+	(*GetJobResponse)(nil),             // 18: dapr.proto.scheduler.v1.GetJobResponse
+	(*JobTriggerAttempt)(nil),          // 19: dapr.proto.scheduler.v1.JobTriggerAttempt
+	(*JobTriggerHistory)(nil),          // 20: dapr.proto.scheduler.v1.JobTriggerHistory
+	(*DeleteJobRequest)(nil),           // 21: dapr.proto.scheduler.v1.DeleteJobRequest
+	(*DeleteJobResponse)(nil),          // 22: dapr.proto.scheduler.v1.DeleteJobResponse
+	(*NamedJob)(nil),                   // 23: dapr.proto.scheduler.v1.NamedJob
+	(*ListJobsRequest)(nil),            // 24: dapr.proto.scheduler.v1.ListJobsRequest
+	(*ListJobsPosition)(nil),           // 25: dapr.proto.scheduler.v1.ListJobsPosition
+	(*ListJobsResponse)(nil),           // 26: dapr.proto.scheduler.v1.ListJobsResponse
+	(*WatchHostsRequest)(nil),          // 27: dapr.proto.scheduler.v1.WatchHostsRequest
+	(*WatchHostsResponse)(nil),         // 28: dapr.proto.scheduler.v1.WatchHostsResponse
+	(*Host)(nil),                       // 29: dapr.proto.scheduler.v1.Host
+	(*DeleteByMetadataRequest)(nil),    // 30: dapr.proto.scheduler.v1.DeleteByMetadataRequest
+	(*DeleteByMetadataResponse)(nil),   // 31: dapr.proto.scheduler.v1.DeleteByMetadataResponse
+	(*DeleteByNamePrefixRequest)(nil),  // 32: dapr.proto.scheduler.v1.DeleteByNamePrefixRequest
+	(*DeleteByNamePrefixResponse)(nil), // 33: dapr.proto.scheduler.v1.DeleteByNamePrefixResponse
+	nil,                                // 34: dapr.proto.scheduler.v1.JobMetadata.LabelsEntry
+	nil,                                // 35: dapr.proto.scheduler.v1.GetJobResponse.LabelsEntry
+	nil,                                // 36: dapr.proto.scheduler.v1.ListJobsRequest.LabelSelectorEntry
+	(*anypb.Any)(nil),                  // 37: google.protobuf.Any
+	(*v1.JobFailurePolicy)(nil),        // 38: dapr.proto.common.v1.JobFailurePolicy
+	(*timestamppb.Timestamp)(nil),      // 39: google.protobuf.Timestamp
 }
 var file_dapr_proto_scheduler_v1_scheduler_proto_depIdxs = []int32{
-	29, // 0: dapr.proto.scheduler.v1.Job.data:type_name -> google.protobuf.Any
-	30, // 1: dapr.proto.scheduler.v1.Job.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
-	3,  // 2: dapr.proto.scheduler.v1.JobTargetMetadata.job:type_name -> dapr.proto.scheduler.v1.TargetJob
-	4,  // 3: dapr.proto.scheduler.v1.JobTargetMetadata.actor:type_name -> dapr.proto.scheduler.v1.TargetActorReminder
-	5,  // 4: dapr.proto.scheduler.v1.JobMetadata.target:type_name -> dapr.proto.scheduler.v1.JobTargetMetadata
-	8,  // 5: dapr.proto.scheduler.v1.WatchJobsRequest.initial:type_name -> dapr.proto.scheduler.v1.WatchJobsRequestInitial
-	11, // 6: dapr.proto.scheduler.v1.WatchJobsRequest.result:type_name -> dapr.proto.scheduler.v1.WatchJobsRequestResult
-	0,  // 7: dapr.proto.scheduler.v1.WatchJobsRequestInitial.accept_job_types:type_name -> dapr.proto.scheduler.v1.JobTargetType
-	9,  // 8: dapr.proto.scheduler.v1.WatchJobsRequestInitial.concurrency_limits:type_name -> dapr.proto.scheduler.v1.ConcurrencyLimit
-	10, // 9: dapr.proto.scheduler.v1.ConcurrencyLimit.actor:type_name -> dapr.proto.scheduler.v1.ConcurrencyLimitActor
-	1,  // 10: dapr.proto.scheduler.v1.WatchJobsRequestResult.status:type_name -> dapr.proto.scheduler.v1.WatchJobsRequestResultStatus
-	29, // 11: dapr.proto.scheduler.v1.WatchJobsResponse.data:type_name -> google.protobuf.Any
-	6,  // 12: dapr.proto.scheduler.v1.WatchJobsResponse.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	2,  // 13: dapr.proto.scheduler.v1.ScheduleJobRequest.job:type_name -> dapr.proto.scheduler.v1.Job
-	6,  // 14: dapr.proto.scheduler.v1.ScheduleJobRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	6,  // 15: dapr.proto.scheduler.v1.GetJobRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	2,  // 16: dapr.proto.scheduler.v1.GetJobResponse.job:type_name -> dapr.proto.scheduler.v1.Job
-	6,  // 17: dapr.proto.scheduler.v1.DeleteJobRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	6,  // 18: dapr.proto.scheduler.v1.NamedJob.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	2,  // 19: dapr.proto.scheduler.v1.NamedJob.job:type_name -> dapr.proto.scheduler.v1.Job
-	6,  // 20: dapr.proto.scheduler.v1.ListJobsRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	19, // 21: dapr.proto.scheduler.v1.ListJobsResponse.jobs:type_name -> dapr.proto.scheduler.v1.NamedJob
-	24, // 22: dapr.proto.scheduler.v1.WatchHostsResponse.hosts:type_name -> dapr.proto.scheduler.v1.Host
-	6,  // 23: dapr.proto.scheduler.v1.DeleteByMetadataRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	6,  // 24: dapr.proto.scheduler.v1.DeleteByNamePrefixRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
-	13, // 25: dapr.proto.scheduler.v1.Scheduler.ScheduleJob:input_type -> dapr.proto.scheduler.v1.ScheduleJobRequest
-	15, // 26: dapr.proto.scheduler.v1.Scheduler.GetJob:input_type -> dapr.proto.scheduler.v1.GetJobRequest
-	17, // 27: dapr.proto.scheduler.v1.Scheduler.DeleteJob:input_type -> dapr.proto.scheduler.v1.DeleteJobRequest
-	7,  // 28: dapr.proto.scheduler.v1.Scheduler.WatchJobs:input_type -> dapr.proto.scheduler.v1.WatchJobsRequest
-	20, // 29: dapr.proto.scheduler.v1.Scheduler.ListJobs:input_type -> dapr.proto.scheduler.v1.ListJobsRequest
-	22, // 30: dapr.proto.scheduler.v1.Scheduler.WatchHosts:input_type -> dapr.proto.scheduler.v1.WatchHostsRequest
-	25, // 31: dapr.proto.scheduler.v1.Scheduler.DeleteByMetadata:input_type -> dapr.proto.scheduler.v1.DeleteByMetadataRequest
-	27, // 32: dapr.proto.scheduler.v1.Scheduler.DeleteByNamePrefix:input_type -> dapr.proto.scheduler.v1.DeleteByNamePrefixRequest
-	14, // 33: dapr.proto.scheduler.v1.Scheduler.ScheduleJob:output_type -> dapr.proto.scheduler.v1.ScheduleJobResponse
-	16, // 34: dapr.proto.scheduler.v1.Scheduler.GetJob:output_type -> dapr.proto.scheduler.v1.GetJobResponse
-	18, // 35: dapr.proto.scheduler.v1.Scheduler.DeleteJob:output_type -> dapr.proto.scheduler.v1.DeleteJobResponse
-	12, // 36: dapr.proto.scheduler.v1.Scheduler.WatchJobs:output_type -> dapr.proto.scheduler.v1.WatchJobsResponse
-	21, // 37: dapr.proto.scheduler.v1.Scheduler.ListJobs:output_type -> dapr.proto.scheduler.v1.ListJobsResponse
-	23, // 38: dapr.proto.scheduler.v1.Scheduler.WatchHosts:output_type -> dapr.proto.scheduler.v1.WatchHostsResponse
-	26, // 39: dapr.proto.scheduler.v1.Scheduler.DeleteByMetadata:output_type -> dapr.proto.scheduler.v1.DeleteByMetadataResponse
-	28, // 40: dapr.proto.scheduler.v1.Scheduler.DeleteByNamePrefix:output_type -> dapr.proto.scheduler.v1.DeleteByNamePrefixResponse
-	33, // [33:41] is the sub-list for method output_type
-	25, // [25:33] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	37, // 0: dapr.proto.scheduler.v1.Job.data:type_name -> google.protobuf.Any
+	38, // 1: dapr.proto.scheduler.v1.Job.failure_policy:type_name -> dapr.proto.common.v1.JobFailurePolicy
+	4,  // 2: dapr.proto.scheduler.v1.JobTargetMetadata.job:type_name -> dapr.proto.scheduler.v1.TargetJob
+	5,  // 3: dapr.proto.scheduler.v1.JobTargetMetadata.actor:type_name -> dapr.proto.scheduler.v1.TargetActorReminder
+	6,  // 4: dapr.proto.scheduler.v1.JobMetadata.target:type_name -> dapr.proto.scheduler.v1.JobTargetMetadata
+	34, // 5: dapr.proto.scheduler.v1.JobMetadata.labels:type_name -> dapr.proto.scheduler.v1.JobMetadata.LabelsEntry
+	8,  // 6: dapr.proto.scheduler.v1.JobMetadata.calendar:type_name -> dapr.proto.scheduler.v1.JobCalendar
+	10, // 7: dapr.proto.scheduler.v1.WatchJobsRequest.initial:type_name -> dapr.proto.scheduler.v1.WatchJobsRequestInitial
+	13, // 8: dapr.proto.scheduler.v1.WatchJobsRequest.result:type_name -> dapr.proto.scheduler.v1.WatchJobsRequestResult
+	0,  // 9: dapr.proto.scheduler.v1.WatchJobsRequestInitial.accept_job_types:type_name -> dapr.proto.scheduler.v1.JobTargetType
+	11, // 10: dapr.proto.scheduler.v1.WatchJobsRequestInitial.concurrency_limits:type_name -> dapr.proto.scheduler.v1.ConcurrencyLimit
+	12, // 11: dapr.proto.scheduler.v1.ConcurrencyLimit.actor:type_name -> dapr.proto.scheduler.v1.ConcurrencyLimitActor
+	1,  // 12: dapr.proto.scheduler.v1.WatchJobsRequestResult.status:type_name -> dapr.proto.scheduler.v1.WatchJobsRequestResultStatus
+	37, // 13: dapr.proto.scheduler.v1.WatchJobsResponse.data:type_name -> google.protobuf.Any
+	7,  // 14: dapr.proto.scheduler.v1.WatchJobsResponse.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	3,  // 15: dapr.proto.scheduler.v1.ScheduleJobRequest.job:type_name -> dapr.proto.scheduler.v1.Job
+	7,  // 16: dapr.proto.scheduler.v1.ScheduleJobRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	7,  // 17: dapr.proto.scheduler.v1.GetJobRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	3,  // 18: dapr.proto.scheduler.v1.GetJobResponse.job:type_name -> dapr.proto.scheduler.v1.Job
+	35, // 19: dapr.proto.scheduler.v1.GetJobResponse.labels:type_name -> dapr.proto.scheduler.v1.GetJobResponse.LabelsEntry
+	19, // 20: dapr.proto.scheduler.v1.GetJobResponse.history:type_name -> dapr.proto.scheduler.v1.JobTriggerAttempt
+	39, // 21: dapr.proto.scheduler.v1.JobTriggerAttempt.time:type_name -> google.protobuf.Timestamp
+	2,  // 22: dapr.proto.scheduler.v1.JobTriggerAttempt.result:type_name -> dapr.proto.scheduler.v1.JobTriggerResult
+	19, // 23: dapr.proto.scheduler.v1.JobTriggerHistory.attempts:type_name -> dapr.proto.scheduler.v1.JobTriggerAttempt
+	7,  // 24: dapr.proto.scheduler.v1.DeleteJobRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	7,  // 25: dapr.proto.scheduler.v1.NamedJob.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	3,  // 26: dapr.proto.scheduler.v1.NamedJob.job:type_name -> dapr.proto.scheduler.v1.Job
+	7,  // 27: dapr.proto.scheduler.v1.ListJobsRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	36, // 28: dapr.proto.scheduler.v1.ListJobsRequest.label_selector:type_name -> dapr.proto.scheduler.v1.ListJobsRequest.LabelSelectorEntry
+	39, // 29: dapr.proto.scheduler.v1.ListJobsRequest.due_after:type_name -> google.protobuf.Timestamp
+	39, // 30: dapr.proto.scheduler.v1.ListJobsRequest.due_before:type_name -> google.protobuf.Timestamp
+	25, // 31: dapr.proto.scheduler.v1.ListJobsRequest.start_after:type_name -> dapr.proto.scheduler.v1.ListJobsPosition
+	23, // 32: dapr.proto.scheduler.v1.ListJobsResponse.jobs:type_name -> dapr.proto.scheduler.v1.NamedJob
+	29, // 33: dapr.proto.scheduler.v1.WatchHostsResponse.hosts:type_name -> dapr.proto.scheduler.v1.Host
+	7,  // 34: dapr.proto.scheduler.v1.DeleteByMetadataRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	7,  // 35: dapr.proto.scheduler.v1.DeleteByNamePrefixRequest.metadata:type_name -> dapr.proto.scheduler.v1.JobMetadata
+	15, // 36: dapr.proto.scheduler.v1.Scheduler.ScheduleJob:input_type -> dapr.proto.scheduler.v1.ScheduleJobRequest
+	17, // 37: dapr.proto.scheduler.v1.Scheduler.GetJob:input_type -> dapr.proto.scheduler.v1.GetJobRequest
+	21, // 38: dapr.proto.scheduler.v1.Scheduler.DeleteJob:input_type -> dapr.proto.scheduler.v1.DeleteJobRequest
+	9,  // 39: dapr.proto.scheduler.v1.Scheduler.WatchJobs:input_type -> dapr.proto.scheduler.v1.WatchJobsRequest
+	24, // 40: dapr.proto.scheduler.v1.Scheduler.ListJobs:input_type -> dapr.proto.scheduler.v1.ListJobsRequest
+	27, // 41: dapr.proto.scheduler.v1.Scheduler.WatchHosts:input_type -> dapr.proto.scheduler.v1.WatchHostsRequest
+	30, // 42: dapr.proto.scheduler.v1.Scheduler.DeleteByMetadata:input_type -> dapr.proto.scheduler.v1.DeleteByMetadataRequest
+	32, // 43: dapr.proto.scheduler.v1.Scheduler.DeleteByNamePrefix:input_type -> dapr.proto.scheduler.v1.DeleteByNamePrefixRequest
+	16, // 44: dapr.proto.scheduler.v1.Scheduler.ScheduleJob:output_type -> dapr.proto.scheduler.v1.ScheduleJobResponse
+	18, // 45: dapr.proto.scheduler.v1.Scheduler.GetJob:output_type -> dapr.proto.scheduler.v1.GetJobResponse
+	22, // 46: dapr.proto.scheduler.v1.Scheduler.DeleteJob:output_type -> dapr.proto.scheduler.v1.DeleteJobResponse
+	14, // 47: dapr.proto.scheduler.v1.Scheduler.WatchJobs:output_type -> dapr.proto.scheduler.v1.WatchJobsResponse
+	26, // 48: dapr.proto.scheduler.v1.Scheduler.ListJobs:output_type -> dapr.proto.scheduler.v1.ListJobsResponse
+	28, // 49: dapr.proto.scheduler.v1.Scheduler.WatchHosts:output_type -> dapr.proto.scheduler.v1.WatchHostsResponse
+	31, // 50: dapr.proto.scheduler.v1.Scheduler.DeleteByMetadata:output_type -> dapr.proto.scheduler.v1.DeleteByMetadataResponse
+	33, // 51: dapr.proto.scheduler.v1.Scheduler.DeleteByNamePrefix:output_type -> dapr.proto.scheduler.v1.DeleteByNamePrefixResponse
+	44, // [44:52] is the sub-list for method output_type
+	36, // [36:44] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_scheduler_v1_scheduler_proto_init() }
@@ -2188,7 +2787,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchJobsRequest); i {
+			switch v := v.(*JobCalendar); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2200,7 +2799,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchJobsRequestInitial); i {
+			switch v := v.(*WatchJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2212,7 +2811,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConcurrencyLimit); i {
+			switch v := v.(*WatchJobsRequestInitial); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2224,7 +2823,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConcurrencyLimitActor); i {
+			switch v := v.(*ConcurrencyLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2236,7 +2835,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchJobsRequestResult); i {
+			switch v := v.(*ConcurrencyLimitActor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2248,7 +2847,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchJobsResponse); i {
+			switch v := v.(*WatchJobsRequestResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2260,7 +2859,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleJobRequest); i {
+			switch v := v.(*WatchJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2272,7 +2871,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleJobResponse); i {
+			switch v := v.(*ScheduleJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2284,7 +2883,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobRequest); i {
+			switch v := v.(*ScheduleJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2296,7 +2895,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobResponse); i {
+			switch v := v.(*GetJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2308,7 +2907,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobRequest); i {
+			switch v := v.(*GetJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2320,7 +2919,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobResponse); i {
+			switch v := v.(*JobTriggerAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2332,7 +2931,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NamedJob); i {
+			switch v := v.(*JobTriggerHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2344,7 +2943,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsRequest); i {
+			switch v := v.(*DeleteJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2356,7 +2955,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsResponse); i {
+			switch v := v.(*DeleteJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2368,7 +2967,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchHostsRequest); i {
+			switch v := v.(*NamedJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2380,7 +2979,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchHostsResponse); i {
+			switch v := v.(*ListJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2392,7 +2991,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Host); i {
+			switch v := v.(*ListJobsPosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2404,7 +3003,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteByMetadataRequest); i {
+			switch v := v.(*ListJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2416,7 +3015,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteByMetadataResponse); i {
+			switch v := v.(*WatchHostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2428,7 +3027,7 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteByNamePrefixRequest); i {
+			switch v := v.(*WatchHostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2440,6 +3039,54 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 			}
 		}
 		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Host); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteByMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteByMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteByNamePrefixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteByNamePrefixResponse); i {
 			case 0:
 				return &v.state
@@ -2458,21 +3105,26 @@ func file_dapr_proto_scheduler_v1_scheduler_proto_init() {
 		(*JobTargetMetadata_Actor)(nil),
 	}
 	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*WatchJobsRequest_Initial)(nil),
 		(*WatchJobsRequest_Result)(nil),
 	}
-	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*ConcurrencyLimit_Actor)(nil),
 	}
-	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_dapr_proto_scheduler_v1_scheduler_proto_msgTypes[27].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_scheduler_v1_scheduler_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   27,
+			NumEnums:      3,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,340 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.ratelimit")
+
+const (
+	// storeAttempts is the number of times a bucket in the state store is
+	// read and written before its update is abandoned because of concurrent
+	// updates by other replicas.
+	storeAttempts = 3
+	// maxLocalBuckets is the number of buckets kept in memory above which the
+	// full buckets are evicted.
+	maxLocalBuckets = 10_000
+)
+
+type Options struct {
+	AppID     string
+	Spec      *config.RateLimitSpec
+	CompStore *compstore.ComponentStore
+}
+
+// Limiter enforces the rate limits of the inbound traffic of the app. A nil
+// Limiter allows everything.
+type Limiter struct {
+	appID      string
+	stateStore string
+	policies   []policy
+	compStore  *compstore.ComponentStore
+	clock      clock.Clock
+
+	lock    sync.Mutex
+	buckets map[string]*bucket
+}
+
+type policy struct {
+	name    string
+	callers []string
+	routes  []string
+	traffic []string
+	byRoute bool
+	byCall  bool
+	rate    float64
+	burst   float64
+}
+
+// New returns the Limiter of the rate limit spec, or nil if the spec has no
+// policies.
+func New(opts Options) (*Limiter, error) {
+	if opts.Spec == nil || len(opts.Spec.Policies) == 0 {
+		return nil, nil
+	}
+
+	l := &Limiter{
+		appID:      opts.AppID,
+		stateStore: opts.Spec.StateStore,
+		compStore:  opts.CompStore,
+		clock:      clock.RealClock{},
+		buckets:    make(map[string]*bucket),
+	}
+
+	names := make(map[string]struct{}, len(opts.Spec.Policies))
+	for i, p := range opts.Spec.Policies {
+		name := p.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("duplicate rate limit policy name %q", name)
+		}
+		names[name] = struct{}{}
+
+		if p.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("rate limit policy %q: requestsPerSecond must be positive", name)
+		}
+		burst := p.Burst
+		if burst == 0 {
+			burst = p.RequestsPerSecond
+		}
+		if burst < 0 {
+			return nil, fmt.Errorf("rate limit policy %q: burst cannot be negative", name)
+		}
+
+		for _, t := range p.Traffic {
+			if t != config.RateLimitTrafficInvocation && t != config.RateLimitTrafficPubSub {
+				return nil, fmt.Errorf("rate limit policy %q: unknown traffic %q", name, t)
+			}
+		}
+
+		compiled := policy{
+			name:    name,
+			callers: p.Callers,
+			routes:  make([]string, len(p.Routes)),
+			traffic: p.Traffic,
+			byCall:  len(p.By) == 0,
+			rate:    float64(p.RequestsPerSecond),
+			burst:   float64(burst),
+		}
+		for j, route := range p.Routes {
+			compiled.routes[j] = normalizeRoute(route)
+		}
+		for _, by := range p.By {
+			switch by {
+			case config.RateLimitByCaller:
+				compiled.byCall = true
+			case config.RateLimitByRoute:
+				compiled.byRoute = true
+			default:
+				return nil, fmt.Errorf("rate limit policy %q: unknown bucket key %q", name, by)
+			}
+		}
+
+		l.policies = append(l.policies, compiled)
+	}
+
+	return l, nil
+}
+
+// Allow takes a token of each of the buckets of the policies which apply to
+// the call or the message, and returns whether all of them had one. The
+// traffic is either config.RateLimitTrafficInvocation or
+// config.RateLimitTrafficPubSub, and the route is the invoked method or the
+// route of the subscription.
+func (l *Limiter) Allow(ctx context.Context, traffic, caller, route string) bool {
+	if l == nil {
+		return true
+	}
+
+	route = normalizeRoute(route)
+	for i := range l.policies {
+		p := &l.policies[i]
+		if !p.appliesTo(traffic, caller, route) {
+			continue
+		}
+		if !l.take(ctx, p.bucketKey(caller, route), p) {
+			log.Debugf("Rate limit policy %s rejected the %s of caller %s on route %s", p.name, traffic, caller, route)
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l *Limiter) take(ctx context.Context, key string, p *policy) bool {
+	if l.stateStore != "" {
+		allowed, err := l.takeShared(ctx, key, p)
+		if err == nil {
+			return allowed
+		}
+		// The limits are enforced per replica while the state store is
+		// unavailable, rather than rejecting or allowing everything.
+		log.Warnf("Failed to take a token of rate limit bucket %s from state store %s, using the bucket of the replica: %v", key, l.stateStore, err)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxLocalBuckets {
+			l.evictFull(now)
+		}
+		b = new(bucket)
+		l.buckets[key] = b
+	}
+	return b.take(now, p.rate, p.burst)
+}
+
+// evictFull removes the buckets which have been refilled, as they are
+// the same as new buckets.
+func (l *Limiter) evictFull(now time.Time) {
+	for key, b := range l.buckets {
+		if b.full(now, l.policyOf(key)) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (l *Limiter) policyOf(key string) *policy {
+	name, _, _ := strings.Cut(key, "||")
+	for i := range l.policies {
+		if l.policies[i].name == name {
+			return &l.policies[i]
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) takeShared(ctx context.Context, key string, p *policy) (bool, error) {
+	store, ok := l.compStore.GetStateStore(l.stateStore)
+	if !ok {
+		return false, errors.New("state store not found")
+	}
+
+	key = l.appID + "||ratelimit||" + key
+	var md map[string]string
+	if state.FeatureTTL.IsPresent(store.Features()) {
+		// Buckets left alone are full once refilled, so they expire by then.
+		ttl := int(math.Ceil(p.burst/p.rate)) + 1
+		md = map[string]string{"ttlInSeconds": strconv.Itoa(ttl)}
+	}
+
+	for range storeAttempts {
+		res, err := store.Get(ctx, &state.GetRequest{Key: key})
+		if err != nil {
+			return false, err
+		}
+
+		var b bucket
+		if res != nil && len(res.Data) > 0 {
+			if err = json.Unmarshal(res.Data, &b); err != nil {
+				return false, fmt.Errorf("invalid bucket: %w", err)
+			}
+		}
+		if !b.take(l.clock.Now(), p.rate, p.burst) {
+			return false, nil
+		}
+
+		data, err := json.Marshal(b)
+		if err != nil {
+			return false, err
+		}
+		req := &state.SetRequest{Key: key, Value: data, Metadata: md}
+		if res != nil && res.ETag != nil {
+			req.ETag = res.ETag
+			req.Options.Concurrency = state.FirstWrite
+		}
+		err = store.Set(ctx, req)
+		if err == nil {
+			return true, nil
+		}
+		var etagErr *state.ETagError
+		if !errors.As(err, &etagErr) || etagErr.Kind() != state.ETagMismatch {
+			return false, err
+		}
+	}
+
+	// The bucket is contended by the other replicas, which are taking its
+	// tokens.
+	return false, nil
+}
+
+func (p *policy) appliesTo(traffic, caller, route string) bool {
+	if len(p.traffic) > 0 && !slices.Contains(p.traffic, traffic) {
+		return false
+	}
+	if len(p.callers) > 0 && !slices.ContainsFunc(p.callers, func(pattern string) bool { return match(pattern, caller) }) {
+		return false
+	}
+	if len(p.routes) > 0 && !slices.ContainsFunc(p.routes, func(pattern string) bool { return match(pattern, route) }) {
+		return false
+	}
+	return true
+}
+
+func (p *policy) bucketKey(caller, route string) string {
+	key := p.name
+	if p.byCall {
+		key += "||" + caller
+	}
+	if p.byRoute {
+		key += "||" + route
+	}
+	return key
+}
+
+// match reports whether the value matches the pattern, where a trailing "*"
+// matches any suffix.
+func match(pattern, value string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(value, prefix)
+	}
+	return pattern == value
+}
+
+func normalizeRoute(route string) string {
+	return strings.TrimPrefix(route, "/")
+}
+
+// bucket is a bucket of tokens, which is stored as JSON in the state store
+// when shared by the replicas.
+type bucket struct {
+	Tokens  float64   `json:"tokens"`
+	Updated time.Time `json:"updated"`
+}
+
+// take refills the bucket for the time elapsed since its last update, and
+// takes a token from it if it has one.
+func (b *bucket) take(now time.Time, rate, burst float64) bool {
+	if b.Updated.IsZero() {
+		b.Tokens = burst
+	} else if elapsed := now.Sub(b.Updated); elapsed > 0 {
+		b.Tokens = min(burst, b.Tokens+elapsed.Seconds()*rate)
+	}
+	if now.After(b.Updated) {
+		b.Updated = now
+	}
+
+	if b.Tokens < 1 {
+		return false
+	}
+	b.Tokens--
+	return true
+}
+
+func (b *bucket) full(now time.Time, p *policy) bool {
+	return p == nil || b.Tokens+now.Sub(b.Updated).Seconds()*p.rate >= p.burst
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+func TestNew(t *testing.T) {
+	l, err := New(Options{Spec: &config.RateLimitSpec{}})
+	require.NoError(t, err)
+	assert.Nil(t, l)
+	assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+
+	for _, p := range []config.RateLimitPolicy{
+		{RequestsPerSecond: 0},
+		{RequestsPerSecond: 1, Burst: -1},
+		{RequestsPerSecond: 1, Traffic: []string{"bindings"}},
+		{RequestsPerSecond: 1, By: []string{"namespace"}},
+	} {
+		_, err = New(Options{Spec: &config.RateLimitSpec{Policies: []config.RateLimitPolicy{p}}})
+		require.Error(t, err, p)
+	}
+
+	_, err = New(Options{Spec: &config.RateLimitSpec{Policies: []config.RateLimitPolicy{
+		{Name: "a", RequestsPerSecond: 1},
+		{Name: "a", RequestsPerSecond: 2},
+	}}})
+	require.Error(t, err)
+}
+
+func TestAllow(t *testing.T) {
+	newLimiter := func(t *testing.T, spec *config.RateLimitSpec) (*Limiter, *clocktesting.FakeClock) {
+		t.Helper()
+		l, err := New(Options{AppID: "myapp", Spec: spec, CompStore: compstore.New()})
+		require.NoError(t, err)
+		clock := clocktesting.NewFakeClock(time.Now())
+		l.clock = clock
+		return l, clock
+	}
+
+	t.Run("buckets per caller", func(t *testing.T) {
+		l, clock := newLimiter(t, &config.RateLimitSpec{Policies: []config.RateLimitPolicy{
+			{RequestsPerSecond: 2, Burst: 3},
+		}})
+		for range 3 {
+			assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+		}
+		assert.False(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "other"))
+		assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficPubSub, "app2", "/orders"))
+
+		clock.Step(500 * time.Millisecond)
+		assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+		assert.False(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+
+		// Buckets are not refilled above their capacity.
+		clock.Step(time.Hour)
+		for range 3 {
+			assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+		}
+		assert.False(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+	})
+
+	t.Run("buckets per route of matching traffic", func(t *testing.T) {
+		l, _ := newLimiter(t, &config.RateLimitSpec{Policies: []config.RateLimitPolicy{
+			{
+				Callers:           []string{"front*"},
+				Routes:            []string{"/orders/*"},
+				Traffic:           []string{config.RateLimitTrafficPubSub},
+				By:                []string{config.RateLimitByRoute},
+				RequestsPerSecond: 1,
+			},
+		}})
+		assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficPubSub, "frontend", "/orders/new"))
+		assert.False(t, l.Allow(t.Context(), config.RateLimitTrafficPubSub, "frontdoor", "orders/new"))
+		assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficPubSub, "frontend", "/orders/paid"))
+
+		// The policy does not apply to other callers, routes or traffic.
+		for range 3 {
+			assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficPubSub, "backend", "/orders/new"))
+			assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficPubSub, "frontend", "/customers"))
+			assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "frontend", "orders/new"))
+		}
+	})
+
+	t.Run("all the policies apply", func(t *testing.T) {
+		l, _ := newLimiter(t, &config.RateLimitSpec{Policies: []config.RateLimitPolicy{
+			{RequestsPerSecond: 10},
+			{Routes: []string{"expensive"}, By: []string{config.RateLimitByRoute}, RequestsPerSecond: 1},
+		}})
+		assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "expensive"))
+		assert.False(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app2", "expensive"))
+		assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app2", "cheap"))
+	})
+
+	t.Run("buckets shared in a state store", func(t *testing.T) {
+		store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+		require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+		t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+
+		spec := &config.RateLimitSpec{
+			StateStore: "mystore",
+			Policies:   []config.RateLimitPolicy{{Name: "shared", RequestsPerSecond: 1, Burst: 2}},
+		}
+		replica1, clock := newLimiter(t, spec)
+		replica2, _ := newLimiter(t, spec)
+		replica2.clock = clock
+		replica1.compStore.AddStateStore("mystore", store)
+		replica2.compStore.AddStateStore("mystore", store)
+
+		assert.True(t, replica1.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+		assert.True(t, replica2.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+		assert.False(t, replica1.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+
+		res, err := store.Get(t.Context(), &state.GetRequest{Key: "myapp||ratelimit||shared||app1"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Data)
+		assert.Empty(t, replica1.buckets)
+	})
+
+	t.Run("missing state store falls back to local buckets", func(t *testing.T) {
+		l, _ := newLimiter(t, &config.RateLimitSpec{
+			StateStore: "nostore",
+			Policies:   []config.RateLimitPolicy{{RequestsPerSecond: 1}},
+		})
+		assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+		assert.False(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+	})
+}
+
+func TestEvictFull(t *testing.T) {
+	l, err := New(Options{Spec: &config.RateLimitSpec{Policies: []config.RateLimitPolicy{{Name: "p", RequestsPerSecond: 1}}}})
+	require.NoError(t, err)
+	clock := clocktesting.NewFakeClock(time.Now())
+	l.clock = clock
+
+	assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app1", "method"))
+	clock.Step(time.Second)
+	assert.True(t, l.Allow(t.Context(), config.RateLimitTrafficInvocation, "app2", "method"))
+
+	l.evictFull(clock.Now())
+	assert.Len(t, l.buckets, 1)
+	assert.Contains(t, l.buckets, "p||app2")
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/sony/gobreaker"
+	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/kit/logger"
@@ -49,8 +50,25 @@ type CircuitBreaker struct {
 	// Default is consecutiveFailures > 5.
 	// Other possible values: `requests`, `totalFailures`
 	Trip *expr.Expr `mapstructure:"trip"`
+	// Policy is the name of the policy the circuit breaker was created
+	// from, for the circuit breakers created for a target.
+	Policy string `mapstructure:"-"`
+	// Store shares the state of the circuit breaker with the other
+	// replicas of the app. If nil, the state is local.
+	Store SharedStore `mapstructure:"-"`
+	// StoreKey is the key of the shared state in the store.
+	StoreKey string `mapstructure:"-"`
+	// SyncInterval is the interval at which the shared state is read
+	// from the store.
+	// Default is 1s.
+	SyncInterval time.Duration `mapstructure:"-"`
+	// DryRun evaluates the circuit breaker without enforcing it: the
+	// operations it rejects are still invoked by the resiliency runner.
+	DryRun bool `mapstructure:"-"`
 
 	breaker *gobreaker.CircuitBreaker
+	shared  *shared
+	clock   clock.Clock
 }
 
 var (
@@ -100,6 +118,28 @@ func (c *CircuitBreaker) Initialize(log logger.Logger) {
 		}
 	}
 
+	if c.Store != nil {
+		if c.clock == nil {
+			c.clock = clock.RealClock{}
+		}
+		if c.SyncInterval <= 0 {
+			c.SyncInterval = DefaultSyncInterval
+		}
+		timeout := c.Timeout
+		if timeout <= 0 {
+			// Same default as gobreaker.
+			timeout = 60 * time.Second
+		}
+		c.shared = &shared{
+			store:        c.Store,
+			key:          c.StoreKey,
+			timeout:      timeout,
+			syncInterval: c.SyncInterval,
+			clock:        c.clock,
+			log:          log,
+		}
+	}
+
 	c.breaker = gobreaker.NewCircuitBreaker(gobreaker.Settings{ //nolint:exhaustivestruct
 		Name:        c.Name,
 		MaxRequests: c.MaxRequests,
@@ -108,6 +148,15 @@ func (c *CircuitBreaker) Initialize(log logger.Logger) {
 		ReadyToTrip: tripFn,
 		OnStateChange: func(name string, from, to gobreaker.State) {
 			log.Infof("Circuit breaker %q changed state from %s to %s", name, from, to)
+			if c.shared == nil {
+				return
+			}
+			switch to {
+			case gobreaker.StateOpen:
+				c.shared.update(StateOpen)
+			case gobreaker.StateClosed:
+				c.shared.update(StateClosed)
+			}
 		},
 	})
 }
@@ -121,7 +170,32 @@ func (c *CircuitBreaker) Initialize(log logger.Logger) {
 // in those scenarios will by nil/zero.
 // In all other scenarios the result, error returned is the result, error returned by the
 // operation.
+// If the state is shared with the other replicas of the app, the operation is also shorted
+// while the shared state is open, or half-open and probed by another replica.
 func (c *CircuitBreaker) Execute(oper func() (any, error)) (any, error) {
+	if c.shared == nil {
+		return c.execute(oper)
+	}
+
+	probe, err := c.shared.acquire()
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.execute(oper)
+	if probe {
+		switch {
+		case err == nil:
+			c.shared.update(StateClosed)
+		case !IsErrorPermanent(err):
+			c.shared.update(StateOpen)
+		}
+		// If the local circuit breaker rejected the probe, another replica
+		// claims it once it's given up.
+	}
+	return res, err
+}
+
+func (c *CircuitBreaker) execute(oper func() (any, error)) (any, error) {
 	res, err := c.breaker.Execute(oper)
 
 	// Wrap the error so we don't have to reference the external package in other places.
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// DefaultSyncInterval is the default interval at which the shared state of
+	// a circuit breaker is read from the store.
+	DefaultSyncInterval = time.Second
+
+	sharedStoreTimeout = 5 * time.Second
+)
+
+// ErrSharedStateConflict is returned by SharedStore.Set when the state was
+// changed since it was read.
+var ErrSharedStateConflict = errors.New("the shared state of the circuit breaker was changed concurrently")
+
+// SharedStore persists the state of circuit breakers, so that it's shared by
+// the replicas of an app.
+type SharedStore interface {
+	// Get returns the state saved for the key and its ETag, or a nil state if
+	// none is saved.
+	Get(ctx context.Context, key string) (*SharedState, string, error)
+	// Set saves the state for the key. If etag is not empty, the state is only
+	// saved if it wasn't changed since, otherwise ErrSharedStateConflict is
+	// returned.
+	Set(ctx context.Context, key string, state SharedState, etag string) error
+}
+
+// SharedState is the state of a circuit breaker shared by the replicas of an
+// app.
+type SharedState struct {
+	State CircuitBreakerState `json:"state"`
+	// Until is when the open state ends, or when the probe of the half-open
+	// state is given up.
+	Until time.Time `json:"until,omitzero"`
+}
+
+// shared keeps a circuit breaker in sync with the state shared by the
+// replicas of the app. A replica which trips its circuit breaker opens it for
+// all the replicas. When the open state ends, a single replica claims the
+// probe of the half-open state, and the others reject requests until the
+// result of the probe closes or opens the circuit breaker again.
+// The shared state is read at most once per sync interval. Failures of the
+// store are logged and the circuit breaker falls back to its local state.
+type shared struct {
+	store        SharedStore
+	key          string
+	timeout      time.Duration
+	syncInterval time.Duration
+	clock        clock.Clock
+	log          logger.Logger
+
+	lock   sync.Mutex
+	state  SharedState
+	etag   string
+	synced time.Time
+}
+
+// acquire returns an error if the shared state rejects the request, or true
+// if the request is the probe of the half-open state.
+func (s *shared) acquire() (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.clock.Now()
+	if now.Sub(s.synced) >= s.syncInterval {
+		s.sync(now)
+	}
+
+	if s.state.State != StateOpen && s.state.State != StateHalfOpen {
+		return false, nil
+	}
+	if now.Before(s.state.Until) {
+		if s.state.State == StateOpen {
+			return false, ErrOpenState
+		}
+		return false, ErrTooManyRequests
+	}
+
+	err := s.set(SharedState{State: StateHalfOpen, Until: now.Add(s.timeout)}, s.etag)
+	switch {
+	case errors.Is(err, ErrSharedStateConflict):
+		// Another replica claimed the probe.
+		return false, ErrTooManyRequests
+	case err != nil:
+		s.log.Warnf("Failed to claim the probe of circuit breaker %q in the shared state: %v", s.key, err)
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// update saves the state of the circuit breaker, after it changed locally or
+// after the result of a probe.
+func (s *shared) update(state CircuitBreakerState) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	next := SharedState{State: state}
+	if state == StateOpen {
+		next.Until = s.clock.Now().Add(s.timeout)
+	}
+	if err := s.set(next, ""); err != nil {
+		s.log.Warnf("Failed to save the shared state of circuit breaker %q: %v", s.key, err)
+	}
+}
+
+func (s *shared) sync(now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), sharedStoreTimeout)
+	defer cancel()
+
+	// The state is read again after the sync interval even if it fails, so
+	// that an unavailable store doesn't slow down every request.
+	s.synced = now
+	state, etag, err := s.store.Get(ctx, s.key)
+	if err != nil {
+		s.log.Warnf("Failed to read the shared state of circuit breaker %q: %v", s.key, err)
+		return
+	}
+	s.state = SharedState{}
+	if state != nil {
+		s.state = *state
+	}
+	s.etag = etag
+}
+
+// set saves the state. The state is read back on the next request to get its
+// ETag, or the one saved by another replica on conflict.
+func (s *shared) set(state SharedState, etag string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sharedStoreTimeout)
+	defer cancel()
+
+	err := s.store.Set(ctx, s.key, state, etag)
+	if err != nil && !errors.Is(err, ErrSharedStateConflict) {
+		return err
+	}
+	s.synced = time.Time{}
+	if err != nil {
+		return err
+	}
+	s.state = state
+	s.etag = ""
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breaker
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/expr"
+	"github.com/dapr/kit/logger"
+)
+
+type fakeSharedStore struct {
+	lock   sync.Mutex
+	states map[string]SharedState
+	etags  map[string]int
+}
+
+func newFakeSharedStore() *fakeSharedStore {
+	return &fakeSharedStore{
+		states: make(map[string]SharedState),
+		etags:  make(map[string]int),
+	}
+}
+
+func (f *fakeSharedStore) Get(_ context.Context, key string) (*SharedState, string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.states[key]
+	if !ok {
+		return nil, "", nil
+	}
+	return &state, strconv.Itoa(f.etags[key]), nil
+}
+
+func (f *fakeSharedStore) Set(_ context.Context, key string, state SharedState, etag string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if etag != "" && etag != strconv.Itoa(f.etags[key]) {
+		return ErrSharedStateConflict
+	}
+	f.states[key] = state
+	f.etags[key]++
+	return nil
+}
+
+func (f *fakeSharedStore) get(key string) SharedState {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.states[key]
+}
+
+func TestSharedCircuitBreaker(t *testing.T) {
+	log := logger.NewLogger("test")
+	clock := clocktesting.NewFakeClock(time.Now())
+	store := newFakeSharedStore()
+
+	var trip expr.Expr
+	require.NoError(t, trip.DecodeString("consecutiveFailures > 1"))
+
+	newReplica := func() *CircuitBreaker {
+		cb := &CircuitBreaker{
+			Name:         "test",
+			Trip:         &trip,
+			Timeout:      10 * time.Second,
+			Store:        store,
+			StoreKey:     "key",
+			SyncInterval: time.Minute,
+			clock:        clock,
+		}
+		cb.Initialize(log)
+		return cb
+	}
+	a, b, c := newReplica(), newReplica(), newReplica()
+
+	succeed := func() (any, error) { return 42, nil }
+	fail := func() (any, error) { return nil, errors.New("test request") }
+
+	// Tripping the circuit breaker of a replica opens it for the others.
+	for range 2 {
+		a.Execute(fail)
+	}
+	assert.Equal(t, StateOpen, a.State())
+	assert.Equal(t, StateOpen, store.get("key").State)
+
+	_, err := b.Execute(succeed)
+	require.ErrorIs(t, err, ErrOpenState)
+	_, err = c.Execute(succeed)
+	require.ErrorIs(t, err, ErrOpenState)
+	assert.Equal(t, StateClosed, b.State())
+
+	// When the open state ends, a single replica probes the dependency.
+	clock.Step(11 * time.Second)
+	res, err := b.Execute(func() (any, error) {
+		assert.Equal(t, StateHalfOpen, store.get("key").State)
+		_, cErr := c.Execute(succeed)
+		require.ErrorIs(t, cErr, ErrTooManyRequests)
+		return succeed()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 42, res)
+	assert.Equal(t, StateClosed, store.get("key").State)
+
+	// The other replicas read the closed state back.
+	res, err = c.Execute(succeed)
+	require.NoError(t, err)
+	assert.Equal(t, 42, res)
+
+	// A failed probe opens the circuit breaker again.
+	for range 2 {
+		b.Execute(fail)
+	}
+	clock.Step(11 * time.Second)
+	d := newReplica()
+	_, err = d.Execute(fail)
+	require.EqualError(t, err, "test request")
+	assert.Equal(t, StateOpen, store.get("key").State)
+	assert.True(t, store.get("key").Until.Equal(clock.Now().Add(10*time.Second)))
+	_, err = d.Execute(succeed)
+	require.ErrorIs(t, err, ErrOpenState)
+}
+
+func TestSharedCircuitBreakerStoreFailure(t *testing.T) {
+	cb := &CircuitBreaker{
+		Name:     "test",
+		Store:    failingSharedStore{},
+		StoreKey: "key",
+		clock:    clocktesting.NewFakeClock(time.Now()),
+	}
+	cb.Initialize(logger.NewLogger("test"))
+
+	// The local state is used when the store fails.
+	res, err := cb.Execute(func() (any, error) { return 42, nil })
+	require.NoError(t, err)
+	assert.Equal(t, 42, res)
+}
+
+type failingSharedStore struct{}
+
+func (failingSharedStore) Get(context.Context, string) (*SharedState, string, error) {
+	return nil, "", errors.New("store unavailable")
+}
+
+func (failingSharedStore) Set(context.Context, string, SharedState, string) error {
+	return errors.New("store unavailable")
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+// CircuitBreakerStoreFn returns the store in which circuit breakers share
+// their state, backed by the state store with the given name.
+type CircuitBreakerStoreFn func(storeName string) (breaker.SharedStore, error)
+
+var errCircuitBreakerStoreNotSet = errors.New("circuit breaker persistence is not supported")
+
+// circuitBreakerStore is the store of the circuit breakers of a policy with
+// persistence. It looks up the state store when the state is read or saved,
+// and prefixes the keys with the name of the resiliency resource.
+type circuitBreakerStore struct {
+	r              *Resiliency
+	resiliencyName string
+	storeName      string
+}
+
+func (s *circuitBreakerStore) Get(ctx context.Context, key string) (*breaker.SharedState, string, error) {
+	store, err := s.store()
+	if err != nil {
+		return nil, "", err
+	}
+	return store.Get(ctx, s.resiliencyName+"||"+key)
+}
+
+func (s *circuitBreakerStore) Set(ctx context.Context, key string, state breaker.SharedState, etag string) error {
+	store, err := s.store()
+	if err != nil {
+		return err
+	}
+	return store.Set(ctx, s.resiliencyName+"||"+key, state, etag)
+}
+
+func (s *circuitBreakerStore) store() (breaker.SharedStore, error) {
+	if s.r.circuitBreakerStoreFn == nil {
+		return nil, errCircuitBreakerStoreNotSet
+	}
+	return s.r.circuitBreakerStoreFn(s.storeName)
+}
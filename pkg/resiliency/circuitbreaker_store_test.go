@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+type recordingSharedStore struct {
+	lock   sync.Mutex
+	states map[string]breaker.SharedState
+}
+
+func (s *recordingSharedStore) Get(_ context.Context, key string) (*breaker.SharedState, string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state, ok := s.states[key]
+	if !ok {
+		return nil, "", nil
+	}
+	return &state, "", nil
+}
+
+func (s *recordingSharedStore) Set(_ context.Context, key string, state breaker.SharedState, _ string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.states[key] = state
+	return nil
+}
+
+func TestCircuitBreakerPersistence(t *testing.T) {
+	newResiliency := func(persistence *resiliencyV1alpha.CircuitBreakerPersistence) (*Resiliency, error) {
+		r := New(log)
+		err := r.DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+			ObjectMeta: metav1.ObjectMeta{Name: "myresiliency"},
+			Spec: resiliencyV1alpha.ResiliencySpec{
+				Policies: resiliencyV1alpha.Policies{
+					CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+						"shared": {
+							MaxRequests: 1,
+							Timeout:     "30s",
+							Trip:        "consecutiveFailures > 0",
+							Persistence: persistence,
+						},
+					},
+				},
+				Targets: resiliencyV1alpha.Targets{
+					Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+						"statestore": {Outbound: resiliencyV1alpha.PolicyNames{CircuitBreaker: "shared"}},
+					},
+				},
+			},
+		})
+		return r, err
+	}
+
+	t.Run("state is shared through the store", func(t *testing.T) {
+		r, err := newResiliency(&resiliencyV1alpha.CircuitBreakerPersistence{StateStore: "mystore", SyncInterval: "5s"})
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Second, r.circuitBreakers["shared"].SyncInterval)
+
+		store := &recordingSharedStore{states: make(map[string]breaker.SharedState)}
+		var storeName string
+		r.SetCircuitBreakerStore(func(name string) (breaker.SharedStore, error) {
+			storeName = name
+			return store, nil
+		})
+
+		_, err = NewRunner[any](t.Context(), r.ComponentOutboundPolicy("statestore", Statestore))(func(context.Context) (any, error) {
+			return nil, errors.New("fake error")
+		})
+		require.EqualError(t, err, "fake error")
+		assert.Equal(t, "mystore", storeName)
+		require.Contains(t, store.states, "myresiliency||shared||shared-statestore")
+		assert.Equal(t, breaker.StateOpen, store.states["myresiliency||shared||shared-statestore"].State)
+	})
+
+	t.Run("store is not set", func(t *testing.T) {
+		r, err := newResiliency(&resiliencyV1alpha.CircuitBreakerPersistence{StateStore: "mystore"})
+		require.NoError(t, err)
+
+		// The circuit breaker falls back to its local state.
+		res, err := NewRunner[any](t.Context(), r.ComponentOutboundPolicy("statestore", Statestore))(func(context.Context) (any, error) {
+			return 42, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 42, res)
+	})
+
+	t.Run("state store is required", func(t *testing.T) {
+		_, err := newResiliency(&resiliencyV1alpha.CircuitBreakerPersistence{})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid sync interval", func(t *testing.T) {
+		_, err := newResiliency(&resiliencyV1alpha.CircuitBreakerPersistence{StateStore: "mystore", SyncInterval: "a"})
+		require.Error(t, err)
+	})
+}
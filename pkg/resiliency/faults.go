@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+)
+
+// FaultInjectorFn injects the faults which apply to an operation on a target,
+// such as a delay, and returns the error of the operation if a fault fails it.
+// The target type is one of TargetTypeApp, TargetTypeActor and
+// TargetTypeComponent, and the component type is only set for components.
+type FaultInjectorFn func(ctx context.Context, targetType string, componentType ComponentType, name string) error
+
+// SetFaultInjector sets the function injecting faults in the operations on
+// the apps, actors and components, run with the outbound policies. It should
+// be called once during startup, before the provider is used to create
+// runners.
+func (r *Resiliency) SetFaultInjector(fn FaultInjectorFn) {
+	r.faultInjectorFn = fn
+}
+
+// FaultInjector returns the function set with SetFaultInjector, or nil if none
+// is set.
+func (r *Resiliency) FaultInjector() FaultInjectorFn {
+	if r == nil {
+		return nil
+	}
+	return r.faultInjectorFn
+}
+
+// addFaultsToPolicy makes the runners of the policy inject the faults of the
+// target in each attempt of the operation, so the faults are handled by the
+// policy like actual failures.
+func (r *Resiliency) addFaultsToPolicy(policyDef *PolicyDefinition, targetType string, componentType ComponentType, name string) {
+	fn := r.faultInjectorFn
+	if fn == nil {
+		return
+	}
+	policyDef.faultFn = func(ctx context.Context) error {
+		return fn(ctx, targetType, componentType, name)
+	}
+}
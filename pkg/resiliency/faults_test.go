@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestFaultInjector(t *testing.T) {
+	r := New(log)
+	require.NoError(t, r.DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{Name: "myresiliency"},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Retries: map[string]resiliencyV1alpha.Retry{
+					"twice": {Policy: "constant", Duration: "1ms", MaxRetries: new(2)},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore": {Outbound: resiliencyV1alpha.PolicyNames{Retry: "twice"}},
+				},
+			},
+		},
+	}))
+
+	type call struct {
+		targetType    string
+		componentType ComponentType
+		name          string
+	}
+	var calls []call
+	errFault := errors.New("fault")
+	rl := NewReloadable(r)
+	rl.SetFaultInjector(func(ctx context.Context, targetType string, componentType ComponentType, name string) error {
+		calls = append(calls, call{targetType, componentType, name})
+		if len(calls) == 1 {
+			return errFault
+		}
+		return nil
+	})
+
+	attempts := 0
+	_, err := NewRunner[any](t.Context(), rl.ComponentOutboundPolicy("statestore", Statestore))(
+		func(context.Context) (any, error) {
+			attempts++
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts, "the fault should be retried like a failure of the operation")
+	assert.Equal(t, []call{
+		{TargetTypeComponent, Statestore, "statestore"},
+		{TargetTypeComponent, Statestore, "statestore"},
+	}, calls)
+
+	calls = nil
+	NewRunner[any](t.Context(), rl.EndpointPolicy("myapp", "myapp:mymethod"))(
+		func(context.Context) (any, error) { return nil, nil },
+	)
+	NewRunner[any](t.Context(), rl.ActorPreLockPolicy("myactor", "1"))(
+		func(context.Context) (any, error) { return nil, nil },
+	)
+	NewRunner[any](t.Context(), rl.ActorPostLockPolicy("myactor", "1"))(
+		func(context.Context) (any, error) { return nil, nil },
+	)
+	assert.Equal(t, []call{
+		{targetType: TargetTypeApp, name: "myapp"},
+		{targetType: TargetTypeActor, name: "myactor"},
+	}, calls)
+
+	// The fault injector is carried over when the policies are reloaded.
+	rl.Swap(New(log))
+	assert.NotNil(t, rl.current.Load().FaultInjector())
+}
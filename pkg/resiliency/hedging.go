@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+const (
+	defaultHedgingMaxAttempts = 2
+	// maxHedgingAttempts bounds the amplification of the load on the target caused by a hedging policy.
+	maxHedgingAttempts = 5
+)
+
+// Hedging sends speculative parallel attempts of an operation and uses the first successful one.
+type Hedging struct {
+	// Delay is the time to wait for a response before sending the next attempt.
+	Delay time.Duration
+	// MaxAttempts is the maximum number of attempts in flight, including the first one.
+	MaxAttempts int
+	// DryRun only logs and meters the attempts which would be sent, without sending them.
+	DryRun bool
+}
+
+// NewHedging returns a Hedging object with the given parameters.
+func NewHedging(delay time.Duration, maxAttempts int) *Hedging {
+	return &Hedging{
+		Delay:       delay,
+		MaxAttempts: maxAttempts,
+	}
+}
+
+// ParseHedging parses a hedging policy from the resiliency configuration.
+func ParseHedging(h resiliencyV1alpha.Hedging) (*Hedging, error) {
+	if h.Delay == "" {
+		return nil, errors.New("delay is required")
+	}
+	delay, err := parseDuration(h.Delay)
+	if err != nil {
+		return nil, fmt.Errorf("invalid delay %q: %w", h.Delay, err)
+	}
+	if delay <= 0 {
+		return nil, fmt.Errorf("delay must be greater than zero, got %q", h.Delay)
+	}
+
+	maxAttempts := h.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = defaultHedgingMaxAttempts
+	}
+	if maxAttempts < 2 || maxAttempts > maxHedgingAttempts {
+		return nil, fmt.Errorf("maxAttempts must be between 2 and %d, got %d", maxHedgingAttempts, h.MaxAttempts)
+	}
+
+	return NewHedging(delay, maxAttempts), nil
+}
+
+type hedgingCtxKey struct{}
+
+// hedgingAttempt is stored in the context of each attempt of a hedged operation.
+type hedgingAttempt struct {
+	attempt int32
+	group   *hedgingGroup
+}
+
+// hedgingGroup is shared by all attempts of a hedged operation.
+type hedgingGroup struct {
+	lock    sync.Mutex
+	targets []string
+}
+
+// GetHedgingAttempt returns the number of the hedged attempt from a context.
+// Attempts are numbered from 1 onwards.
+// If the operation is not hedged, returns 0.
+func GetHedgingAttempt(ctx context.Context) int32 {
+	a, ok := ctx.Value(hedgingCtxKey{}).(*hedgingAttempt)
+	if !ok {
+		return 0
+	}
+	return a.attempt
+}
+
+// PickHedgingTarget picks a random one of the candidates for an attempt of a hedged operation, preferring one that no other attempt of the same operation picked, so that each attempt is sent to another instance.
+// It returns false if the operation is not hedged.
+func PickHedgingTarget(ctx context.Context, candidates []string) (string, bool) {
+	a, ok := ctx.Value(hedgingCtxKey{}).(*hedgingAttempt)
+	if !ok || len(candidates) == 0 {
+		return "", false
+	}
+
+	a.group.lock.Lock()
+	defer a.group.lock.Unlock()
+
+	unpicked := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if !slices.Contains(a.group.targets, c) {
+			unpicked = append(unpicked, c)
+		}
+	}
+	if len(unpicked) == 0 {
+		// All instances already received an attempt.
+		unpicked = candidates
+	}
+	// We use math/rand here as we are just picking a random target, so we don't need a CSPRNG
+	//nolint:gosec
+	target := unpicked[rand.IntN(len(unpicked))]
+	a.group.targets = append(a.group.targets, target)
+	return target, true
+}
+
+// hedgingResult is the result of an attempt of a hedged operation.
+type hedgingResult[T any] struct {
+	res     T
+	err     error
+	attempt int
+}
+
+// runHedged invokes oper, then sends another attempt whenever the delay elapses without a response, or as soon as an attempt fails, up to the maximum number of attempts.
+// It returns the result of the first successful attempt and cancels the others; if all attempts fail, it returns the result of the last one.
+// The context of the attempt whose result is returned is not canceled, as its response may still be read.
+func runHedged[T any](ctx context.Context, h *Hedging, oper Operation[T], disposer func(T), activated func()) (T, error) {
+	group := &hedgingGroup{}
+	results := make(chan hedgingResult[T], h.MaxAttempts)
+	cancels := make([]context.CancelFunc, 0, h.MaxAttempts)
+
+	dispose := func(res T) {
+		if disposer != nil && !isZero(res) {
+			disposer(res)
+		}
+	}
+
+	launch := func() {
+		attempt := len(cancels)
+		attemptCtx, cancel := context.WithCancel(context.WithValue(ctx, hedgingCtxKey{}, &hedgingAttempt{
+			attempt: int32(attempt + 1), //nolint:gosec
+			group:   group,
+		}))
+		cancels = append(cancels, cancel)
+		go func() {
+			res, err := oper(attemptCtx)
+			results <- hedgingResult[T]{res: res, err: err, attempt: attempt}
+		}()
+	}
+
+	cancelOthers := func(attempt int) {
+		for i, cancel := range cancels {
+			if i != attempt {
+				cancel()
+			}
+		}
+	}
+
+	launch()
+	pending := 1
+
+	timer := time.NewTimer(h.Delay)
+	defer timer.Stop()
+
+	var (
+		last    *hedgingResult[T]
+		stopped bool
+	)
+	for pending > 0 {
+		var timerC <-chan time.Time
+		if !stopped && len(cancels) < h.MaxAttempts {
+			timerC = timer.C
+		}
+
+		select {
+		case <-timerC:
+			launch()
+			pending++
+			if activated != nil {
+				activated()
+			}
+			timer.Reset(h.Delay)
+
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				cancelOthers(r.attempt)
+				if last != nil {
+					dispose(last.res)
+				}
+				if pending > 0 {
+					// Dispose of the results of the attempts that are still in flight once they return.
+					go func(pending int) {
+						for range pending {
+							dispose((<-results).res)
+						}
+					}(pending)
+				}
+				return r.res, nil
+			}
+
+			if last != nil {
+				dispose(last.res)
+			}
+			last = &r
+
+			var permanent *backoff.PermanentError
+			if errors.As(r.err, &permanent) || ctx.Err() != nil {
+				// Any further attempt would fail in the same way.
+				stopped = true
+			}
+
+			if !stopped && len(cancels) < h.MaxAttempts {
+				launch()
+				pending++
+				if activated != nil {
+					activated()
+				}
+				timer.Reset(h.Delay)
+			}
+		}
+	}
+
+	cancelOthers(last.attempt)
+	return last.res, last.err
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/kit/retry"
+)
+
+func TestParseHedging(t *testing.T) {
+	h, err := ParseHedging(resiliencyV1alpha.Hedging{Delay: "100ms"})
+	require.NoError(t, err)
+	assert.Equal(t, 100*time.Millisecond, h.Delay)
+	assert.Equal(t, 2, h.MaxAttempts)
+
+	h, err = ParseHedging(resiliencyV1alpha.Hedging{Delay: "50", MaxAttempts: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 50*time.Millisecond, h.Delay)
+	assert.Equal(t, 3, h.MaxAttempts)
+
+	for _, invalid := range []resiliencyV1alpha.Hedging{
+		{},
+		{Delay: "soon"},
+		{Delay: "0s"},
+		{Delay: "10ms", MaxAttempts: 1},
+		{Delay: "10ms", MaxAttempts: maxHedgingAttempts + 1},
+	} {
+		_, err = ParseHedging(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestHedgingPolicy(t *testing.T) {
+	newPolicy := func(delay time.Duration, maxAttempts int) (*PolicyDefinition, *atomic.Int32) {
+		activations := &atomic.Int32{}
+		return &PolicyDefinition{
+			log:  testLog,
+			name: "hedging",
+			h:    NewHedging(delay, maxAttempts),
+			addHedgingActivatedMetric: func() {
+				activations.Add(1)
+			},
+		}, activations
+	}
+
+	t.Run("fast response sends a single attempt", func(t *testing.T) {
+		policy, activations := newPolicy(time.Second, 3)
+		res, err := NewRunner[int32](t.Context(), policy)(func(ctx context.Context) (int32, error) {
+			return GetHedgingAttempt(ctx), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), res)
+		assert.Equal(t, int32(0), activations.Load())
+	})
+
+	t.Run("slow response is hedged and the first response wins", func(t *testing.T) {
+		policy, activations := newPolicy(10*time.Millisecond, 2)
+		var disposed atomic.Int32
+		var canceled atomic.Bool
+		runner := NewRunnerWithOptions(t.Context(), policy, RunnerOpts[int32]{
+			Disposer: func(v int32) {
+				disposed.Store(v)
+			},
+		})
+		res, err := runner(func(ctx context.Context) (int32, error) {
+			attempt := GetHedgingAttempt(ctx)
+			if attempt == 1 {
+				<-ctx.Done()
+				canceled.Store(true)
+				return attempt, ctx.Err()
+			}
+			return attempt, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), res)
+		assert.Equal(t, int32(1), activations.Load())
+		assert.Eventually(t, canceled.Load, time.Second, 5*time.Millisecond)
+		assert.Eventually(t, func() bool { return disposed.Load() == 1 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("failed attempt sends the next one without waiting", func(t *testing.T) {
+		policy, _ := newPolicy(time.Hour, 2)
+		start := time.Now()
+		res, err := NewRunner[int32](t.Context(), policy)(func(ctx context.Context) (int32, error) {
+			attempt := GetHedgingAttempt(ctx)
+			if attempt == 1 {
+				return 0, errors.New("failed")
+			}
+			return attempt, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), res)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("attempts are capped", func(t *testing.T) {
+		policy, activations := newPolicy(time.Millisecond, 3)
+		var calls atomic.Int32
+		_, err := NewRunner[int32](t.Context(), policy)(func(ctx context.Context) (int32, error) {
+			calls.Add(1)
+			time.Sleep(20 * time.Millisecond)
+			return 0, errors.New("failed")
+		})
+		require.EqualError(t, err, "failed")
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, int32(2), activations.Load())
+	})
+
+	t.Run("permanent error stops hedging", func(t *testing.T) {
+		policy, _ := newPolicy(time.Hour, 3)
+		var calls atomic.Int32
+		_, err := NewRunner[int32](t.Context(), policy)(func(ctx context.Context) (int32, error) {
+			calls.Add(1)
+			return 0, backoff.Permanent(errors.New("permanent"))
+		})
+		require.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("hedged operation is retried as a whole", func(t *testing.T) {
+		policy, _ := newPolicy(time.Hour, 2)
+		policy.r = NewRetry(retry.Config{Policy: retry.PolicyConstant, MaxRetries: 1}, NewRetryConditionMatch())
+		var calls atomic.Int32
+		res, err := NewRunner[int32](t.Context(), policy)(func(ctx context.Context) (int32, error) {
+			if calls.Add(1) <= 2 {
+				return 0, errors.New("failed")
+			}
+			return GetAttempt(ctx), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), res)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("without hedging", func(t *testing.T) {
+		policy, _ := newPolicy(time.Millisecond, 2)
+		assert.True(t, policy.HasHedging())
+		assert.False(t, policy.WithoutHedging().HasHedging())
+		assert.True(t, policy.HasHedging())
+	})
+}
+
+func TestPickHedgingTarget(t *testing.T) {
+	_, ok := PickHedgingTarget(t.Context(), []string{"a", "b"})
+	assert.False(t, ok)
+
+	policy := &PolicyDefinition{
+		log:  testLog,
+		name: "hedging",
+		h:    NewHedging(time.Millisecond, 3),
+	}
+
+	var lock sync.Mutex
+	var targets []string
+	_, err := NewRunner[struct{}](t.Context(), policy)(func(ctx context.Context) (struct{}, error) {
+		target, ok := PickHedgingTarget(ctx, []string{"a", "b"})
+		assert.True(t, ok)
+		lock.Lock()
+		targets = append(targets, target)
+		lock.Unlock()
+		return struct{}{}, errors.New("failed")
+	})
+	require.Error(t, err)
+
+	require.Len(t, targets, 3)
+	// The first two attempts are sent to different targets.
+	assert.ElementsMatch(t, []string{"a", "b"}, targets[:2])
+}
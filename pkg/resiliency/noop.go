@@ -46,6 +46,26 @@ func (NoOp) ComponentOutboundPolicy(name string, componentName ComponentType) *P
 	return nil
 }
 
+// ActorPreLockMethodPolicy returns a NoOp policy definition for a method of an actor instance.
+func (NoOp) ActorPreLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition {
+	return nil
+}
+
+// ActorPostLockMethodPolicy returns a NoOp policy definition for a method of an actor instance.
+func (NoOp) ActorPostLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition {
+	return nil
+}
+
+// ComponentInboundOperationPolicy returns a NoOp inbound policy definition for an operation of a component.
+func (NoOp) ComponentInboundOperationPolicy(name string, componentName ComponentType, operation string) *PolicyDefinition {
+	return nil
+}
+
+// ComponentOutboundOperationPolicy returns a NoOp outbound policy definition for an operation of a component.
+func (NoOp) ComponentOutboundOperationPolicy(name string, componentName ComponentType, operation string) *PolicyDefinition {
+	return nil
+}
+
 // ComponentContextDecorator returns nil; NoOp never decorates component contexts.
 func (NoOp) ComponentContextDecorator() ComponentContextFn {
 	return nil
@@ -59,3 +79,8 @@ func (NoOp) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
 func (NoOp) PolicyDefined(target string, policyType PolicyType) bool {
 	return true
 }
+
+// CircuitBreakers returns nil; NoOp never creates circuit breakers.
+func (NoOp) CircuitBreakers() []CircuitBreakerStatus {
+	return nil
+}
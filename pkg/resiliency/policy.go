@@ -24,6 +24,7 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/retry"
@@ -52,18 +53,29 @@ type attemptsCtxKey struct{}
 
 // PolicyDefinition contains a definition for a policy, used to create a Runner.
 type PolicyDefinition struct {
-	log                       logger.Logger
-	name                      string
-	t                         time.Duration
-	r                         *Retry
-	cb                        *breaker.CircuitBreaker
-	addTimeoutActivatedMetric func()
-	addRetryActivatedMetric   func()
-	addCBStateChangedMetric   func()
+	log                          logger.Logger
+	name                         string
+	t                            time.Duration
+	r                            *Retry
+	cb                           *breaker.CircuitBreaker
+	h                            *Hedging
+	budget                       *retryBudgetCounter
+	addTimeoutActivatedMetric    func()
+	addRetryActivatedMetric      func()
+	addCBStateChangedMetric      func()
+	addHedgingActivatedMetric    func()
+	addRetryBudgetRejectedMetric func()
+	addDryRunActivatedMetric     func(policy diag.PolicyType)
+	// dryRunTimeout is true if the timeout is only logged and metered.
+	dryRunTimeout bool
 
 	// componentCtxFn decorates the operation context for component policies
 	// only. It is nil for all other policy kinds (service, actor, built-in).
 	componentCtxFn ComponentContextFn
+
+	// faultFn injects the faults of the target in each attempt of the
+	// operation. It is nil if no fault injector is set.
+	faultFn func(ctx context.Context) error
 }
 
 // ComponentContext decorates ctx for this policy. For component policies (those
@@ -93,14 +105,40 @@ func NewPolicyDefinition(log logger.Logger, name string, t time.Duration, r *Ret
 // String implements fmt.Stringer and is used for debugging.
 func (p PolicyDefinition) String() string {
 	return fmt.Sprintf(
-		"Policy: name='%s' timeout='%v' retry=(%v) circuitBreaker=(%v)",
-		p.name, p.t, p.r, p.cb,
+		"Policy: name='%s' timeout='%v' retry=(%v) circuitBreaker=(%v) hedging=(%v)",
+		p.name, p.t, p.r, p.cb, p.h,
 	)
 }
 
 // HasRetries returns true if the policy is configured to have more than 1 retry.
+// Retries in dry-run mode are not counted, as the operation is invoked once.
 func (p PolicyDefinition) HasRetries() bool {
-	return p.r != nil && p.r.MaxRetries != 0
+	return p.r != nil && p.r.MaxRetries != 0 && !p.r.DryRun
+}
+
+// HasHedging returns true if the policy is configured to send parallel attempts of the operation.
+// Because attempts run concurrently, callers must give each attempt its own copy of the request.
+// Hedging in dry-run mode is not counted, as a single attempt is sent.
+func (p PolicyDefinition) HasHedging() bool {
+	return p.h != nil && !p.h.DryRun
+}
+
+// hasDryRun returns true if any of the policies is in dry-run mode.
+func (p PolicyDefinition) hasDryRun() bool {
+	return (p.t > 0 && p.dryRunTimeout) ||
+		(p.r != nil && p.r.DryRun) ||
+		(p.cb != nil && p.cb.DryRun) ||
+		(p.h != nil && p.h.DryRun)
+}
+
+// WithoutHedging returns a copy of the policy that sends a single attempt of the operation at a time.
+func (p *PolicyDefinition) WithoutHedging() *PolicyDefinition {
+	if p == nil || p.h == nil {
+		return p
+	}
+	c := *p
+	c.h = nil
+	return &c
 }
 
 type RunnerOpts[T any] struct {
@@ -140,7 +178,32 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 	timeoutMetricsActivated := atomic.Bool{}
 	return func(oper Operation[T]) (T, error) {
 		operation := oper
-		if def.t > 0 {
+		if def.faultFn != nil {
+			// The faults are injected in the attempts, so they are subject to
+			// the timeout and handled by the retries and the circuit breaker.
+			operCopy := operation
+			operation = func(ctx context.Context) (T, error) {
+				if err := def.faultFn(ctx); err != nil {
+					return zero, err
+				}
+				return operCopy(ctx)
+			}
+		}
+		if def.t > 0 && def.dryRunTimeout {
+			// The operation isn't timed out, it's only timed
+			operCopy := operation
+			operation = func(ctx context.Context) (T, error) {
+				start := time.Now()
+				rRes, rErr := operCopy(ctx)
+				if elapsed := time.Since(start); elapsed > def.t {
+					if def.addDryRunActivatedMetric != nil {
+						def.addDryRunActivatedMetric(diag.TimeoutPolicy)
+					}
+					def.log.Warnf("Dry-run: operation %s would have timed out after %v, it completed in %v", def.name, def.t, elapsed)
+				}
+				return rRes, rErr
+			}
+		} else if def.t > 0 {
 			// Handle timeout
 			operCopy := operation
 			operation = func(ctx context.Context) (T, error) {
@@ -213,6 +276,14 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 				if def.addCBStateChangedMetric != nil && prevState != def.cb.State() {
 					def.addCBStateChangedMetric()
 				}
+				if def.cb.DryRun && breaker.IsErrorPermanent(err) {
+					// The operation rejected by the circuit breaker is invoked anyway
+					if def.addDryRunActivatedMetric != nil {
+						def.addDryRunActivatedMetric(diag.CircuitBreakerPolicy)
+					}
+					def.log.Warnf("Dry-run: circuit breaker would have rejected operation %s: %v", def.name, err)
+					resAny, err = operCopy(ctx)
+				}
 				if def.r != nil && breaker.IsErrorPermanent(err) {
 					// Break out of retry
 					err = backoff.Permanent(err)
@@ -229,16 +300,76 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 			}
 		}
 
+		if def.h != nil && def.h.DryRun {
+			// A single attempt is sent, and timed
+			operCopy := operation
+			operation = func(ctx context.Context) (T, error) {
+				start := time.Now()
+				rRes, rErr := operCopy(ctx)
+				if time.Since(start) > def.h.Delay {
+					if def.addDryRunActivatedMetric != nil {
+						def.addDryRunActivatedMetric(diag.HedgingPolicy)
+					}
+					def.log.Warnf("Dry-run: operation %s would have sent a hedged attempt after %v", def.name, def.h.Delay)
+				}
+				return rRes, rErr
+			}
+		} else if def.h != nil {
+			// Each attempt of the hedged operation goes through the timeout and circuit breaker, while the hedged operation as a whole is retried
+			operCopy := operation
+			operation = func(ctx context.Context) (T, error) {
+				return runHedged(ctx, def.h, operCopy, opts.Disposer, def.addHedgingActivatedMetric)
+			}
+		}
+
 		if def.r == nil {
 			return operation(ctx)
 		}
 
+		if def.r.DryRun {
+			// The operation is invoked once, and the retry which would follow a failure is only logged
+			rRes, rErr := operation(context.WithValue(ctx, attemptsCtxKey{}, int32(1)))
+			if rErr == nil {
+				return rRes, nil
+			}
+			retriable := def.r.MaxRetries != 0
+			var pErr *backoff.PermanentError
+			if errors.As(rErr, &pErr) {
+				retriable = false
+				rErr = pErr.Unwrap()
+			}
+			var cErr CodeError
+			if errors.As(rErr, &cErr) && !def.r.statusCodeNeedRetry(cErr.StatusCode) {
+				retriable = false
+			}
+			if retriable {
+				if def.addDryRunActivatedMetric != nil {
+					def.addDryRunActivatedMetric(diag.RetryPolicy)
+				}
+				def.log.Warnf("Dry-run: error processing operation %s would have been retried", def.name)
+				def.log.Debugf("Error for operation %s was: %v", def.name, rErr)
+			}
+			return rRes, rErr
+		}
+
 		// Use retry/back off
+		if def.budget != nil {
+			def.budget.addRequest()
+		}
 		b := def.r.NewBackOffWithContext(ctx)
 		attempts := atomic.Int32{}
+		var lastErr error
 		return retry.NotifyRecoverWithData(
 			func() (T, error) {
 				attempt := attempts.Add(1)
+				// Retries beyond the budget of the target fail with the error of the last attempt
+				if attempt > 1 && def.budget != nil && !def.budget.allowRetry() {
+					if def.addRetryBudgetRejectedMetric != nil {
+						def.addRetryBudgetRejectedMetric()
+					}
+					def.log.Warnf("Retry budget exhausted for operation %s, not retrying", def.name)
+					return zero, backoff.Permanent(lastErr)
+				}
 				opCtx := context.WithValue(ctx, attemptsCtxKey{}, attempt)
 				rRes, rErr := operation(opCtx)
 				// In case of an error, if we have a disposer we invoke it with the return value, then reset the return value
@@ -246,6 +377,7 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 					opts.Disposer(rRes)
 					rRes = zero
 				}
+				lastErr = rErr
 				var cErr CodeError
 				if errors.As(rErr, &cErr) {
 					if def.r.statusCodeNeedRetry(cErr.StatusCode) {
@@ -27,6 +27,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/retry"
@@ -432,3 +434,135 @@ func TestPolicyDisposer(t *testing.T) {
 	slices.Sort(disposed)
 	assert.Equal(t, []int32{1, 2, 3}, disposed)
 }
+
+func TestPolicyDryRun(t *testing.T) {
+	newPolicyDef := func(activated *[]diag.PolicyType) *PolicyDefinition {
+		return &PolicyDefinition{
+			log:  testLog,
+			name: "dryrun",
+			addDryRunActivatedMetric: func(policy diag.PolicyType) {
+				*activated = append(*activated, policy)
+			},
+		}
+	}
+
+	t.Run("timeout", func(t *testing.T) {
+		var activated []diag.PolicyType
+		policyDef := newPolicyDef(&activated)
+		policyDef.t = 10 * time.Millisecond
+		policyDef.dryRunTimeout = true
+
+		res, err := NewRunner[int](t.Context(), policyDef)(func(ctx context.Context) (int, error) {
+			time.Sleep(30 * time.Millisecond)
+			return 42, ctx.Err()
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 42, res)
+		assert.Equal(t, []diag.PolicyType{diag.TimeoutPolicy}, activated)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		var activated []diag.PolicyType
+		policyDef := newPolicyDef(&activated)
+		policyDef.r = NewRetry(retry.Config{MaxRetries: 3}, NewRetryConditionMatch())
+		policyDef.r.DryRun = true
+		assert.False(t, policyDef.HasRetries())
+
+		var calls int
+		_, err := NewRunner[any](t.Context(), policyDef)(func(ctx context.Context) (any, error) {
+			calls++
+			assert.Equal(t, int32(1), GetAttempt(ctx))
+			return nil, errors.New("fake error")
+		})
+		require.EqualError(t, err, "fake error")
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, []diag.PolicyType{diag.RetryPolicy}, activated)
+
+		// Permanent errors wouldn't be retried.
+		activated = nil
+		_, err = NewRunner[any](t.Context(), policyDef)(func(ctx context.Context) (any, error) {
+			return nil, backoff.Permanent(errors.New("permanent error"))
+		})
+		require.EqualError(t, err, "permanent error")
+		assert.Empty(t, activated)
+	})
+
+	t.Run("circuit breaker", func(t *testing.T) {
+		var activated []diag.PolicyType
+		policyDef := newPolicyDef(&activated)
+		var trip expr.Expr
+		require.NoError(t, trip.DecodeString("consecutiveFailures > 0"))
+		policyDef.cb = &breaker.CircuitBreaker{
+			Name:        "dryrun",
+			MaxRequests: 1,
+			Timeout:     time.Minute,
+			Trip:        &trip,
+			DryRun:      true,
+		}
+		policyDef.cb.Initialize(testLog)
+
+		_, err := NewRunner[any](t.Context(), policyDef)(func(ctx context.Context) (any, error) {
+			return nil, errors.New("fake error")
+		})
+		require.EqualError(t, err, "fake error")
+		assert.Equal(t, breaker.StateOpen, policyDef.cb.State())
+		assert.Empty(t, activated)
+
+		// The operation is invoked even though the circuit breaker is open.
+		res, err := NewRunner[int](t.Context(), policyDef)(func(ctx context.Context) (int, error) {
+			return 42, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 42, res)
+		assert.Equal(t, []diag.PolicyType{diag.CircuitBreakerPolicy}, activated)
+	})
+
+	t.Run("hedging", func(t *testing.T) {
+		var activated []diag.PolicyType
+		policyDef := newPolicyDef(&activated)
+		policyDef.h = NewHedging(10*time.Millisecond, 2)
+		policyDef.h.DryRun = true
+		assert.False(t, policyDef.HasHedging())
+
+		var calls atomic.Int32
+		res, err := NewRunner[int](t.Context(), policyDef)(func(ctx context.Context) (int, error) {
+			calls.Add(1)
+			time.Sleep(30 * time.Millisecond)
+			return 42, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 42, res)
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, []diag.PolicyType{diag.HedgingPolicy}, activated)
+	})
+
+	t.Run("policies of dry-run resources", func(t *testing.T) {
+		r := FromConfigurations(testLog, &resiliencyV1alpha.Resiliency{
+			Spec: resiliencyV1alpha.ResiliencySpec{
+				DryRun: true,
+				Policies: resiliencyV1alpha.Policies{
+					Timeouts: map[string]string{"fast": "10ms"},
+					Retries: map[string]resiliencyV1alpha.Retry{
+						"retry":                       {Policy: "constant", Duration: "10ms", MaxRetries: new(3)},
+						string(BuiltInServiceRetries): {Policy: "constant", Duration: "10ms", MaxRetries: new(10)},
+					},
+				},
+				Targets: resiliencyV1alpha.Targets{
+					Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+						"appB": {Timeout: "fast", Retry: "retry"},
+					},
+				},
+			},
+		})
+
+		policyDef := r.EndpointPolicy("appB", "method")
+		assert.True(t, policyDef.dryRunTimeout)
+		assert.True(t, policyDef.r.DryRun)
+		assert.NotNil(t, policyDef.addDryRunActivatedMetric)
+
+		// Built-in policies can't be overridden in dry-run mode.
+		builtIn := r.BuiltInPolicy(BuiltInServiceRetries)
+		assert.False(t, builtIn.r.DryRun)
+		assert.Equal(t, int64(3), builtIn.r.MaxRetries)
+	})
+}
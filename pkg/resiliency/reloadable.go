@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"sync/atomic"
+)
+
+// Reloadable is a `Provider` whose policies can be replaced while it's in use,
+// such as when the resiliency configurations are reloaded. Policies already
+// returned keep the configuration they were created with.
+type Reloadable struct {
+	current atomic.Pointer[Resiliency]
+}
+
+// NewReloadable returns a Reloadable provider which starts with the policies
+// of r.
+func NewReloadable(r *Resiliency) *Reloadable {
+	rl := new(Reloadable)
+	rl.current.Store(r)
+	return rl
+}
+
+// Swap replaces the policies with the ones of r. The component context
+// decorator, the circuit breaker store and the fault injector are carried over
+// to r, and the local state of the circuit breakers is reset.
+func (rl *Reloadable) Swap(r *Resiliency) {
+	current := rl.current.Load()
+	r.SetComponentContextDecorator(current.ComponentContextDecorator())
+	r.SetCircuitBreakerStore(current.CircuitBreakerStore())
+	r.SetFaultInjector(current.FaultInjector())
+	rl.current.Store(r)
+}
+
+// SetCircuitBreakerStore sets the function returning the store in which the
+// circuit breakers with persistence share their state.
+func (rl *Reloadable) SetCircuitBreakerStore(fn CircuitBreakerStoreFn) {
+	rl.current.Load().SetCircuitBreakerStore(fn)
+}
+
+// SetFaultInjector sets the function injecting faults in the operations run
+// with the outbound policies.
+func (rl *Reloadable) SetFaultInjector(fn FaultInjectorFn) {
+	rl.current.Load().SetFaultInjector(fn)
+}
+
+// EndpointPolicy returns the policy for a service endpoint.
+func (rl *Reloadable) EndpointPolicy(service string, endpoint string) *PolicyDefinition {
+	return rl.current.Load().EndpointPolicy(service, endpoint)
+}
+
+// ActorPreLockPolicy returns the policy for an actor instance to be used before the lock is acquired.
+func (rl *Reloadable) ActorPreLockPolicy(actorType string, id string) *PolicyDefinition {
+	return rl.current.Load().ActorPreLockPolicy(actorType, id)
+}
+
+// ActorPostLockPolicy returns the policy for an actor instance to be used after the lock is acquired.
+func (rl *Reloadable) ActorPostLockPolicy(actorType string, id string) *PolicyDefinition {
+	return rl.current.Load().ActorPostLockPolicy(actorType, id)
+}
+
+// ComponentOutboundPolicy returns the outbound policy for a component.
+func (rl *Reloadable) ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return rl.current.Load().ComponentOutboundPolicy(name, componentType)
+}
+
+// ComponentInboundPolicy returns the inbound policy for a component.
+func (rl *Reloadable) ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return rl.current.Load().ComponentInboundPolicy(name, componentType)
+}
+
+// ActorPreLockMethodPolicy returns the policy for a method of an actor instance to be used before the lock is acquired.
+func (rl *Reloadable) ActorPreLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition {
+	return rl.current.Load().ActorPreLockMethodPolicy(actorType, id, method)
+}
+
+// ActorPostLockMethodPolicy returns the policy for a method of an actor instance to be used after the lock is acquired.
+func (rl *Reloadable) ActorPostLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition {
+	return rl.current.Load().ActorPostLockMethodPolicy(actorType, id, method)
+}
+
+// ComponentOutboundOperationPolicy returns the outbound policy for an operation of a component.
+func (rl *Reloadable) ComponentOutboundOperationPolicy(name string, componentType ComponentType, operation string) *PolicyDefinition {
+	return rl.current.Load().ComponentOutboundOperationPolicy(name, componentType, operation)
+}
+
+// ComponentInboundOperationPolicy returns the inbound policy for an operation of a component.
+func (rl *Reloadable) ComponentInboundOperationPolicy(name string, componentType ComponentType, operation string) *PolicyDefinition {
+	return rl.current.Load().ComponentInboundOperationPolicy(name, componentType, operation)
+}
+
+// ComponentContextDecorator returns the component context decorator, or nil if none is set.
+func (rl *Reloadable) ComponentContextDecorator() ComponentContextFn {
+	return rl.current.Load().ComponentContextDecorator()
+}
+
+// BuiltInPolicy returns a policy that represents a specific built-in retry scenario.
+func (rl *Reloadable) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
+	return rl.current.Load().BuiltInPolicy(name)
+}
+
+// PolicyDefined returns true if there's policy that applies to the target.
+func (rl *Reloadable) PolicyDefined(target string, policyType PolicyType) bool {
+	return rl.current.Load().PolicyDefined(target, policyType)
+}
+
+// CircuitBreakers returns the state of the circuit breakers created for the targets.
+func (rl *Reloadable) CircuitBreakers() []CircuitBreakerStatus {
+	return rl.current.Load().CircuitBreakers()
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestReloadable(t *testing.T) {
+	withAppTimeout := func(timeout string) *resiliencyV1alpha.Resiliency {
+		return &resiliencyV1alpha.Resiliency{
+			ObjectMeta: v1.ObjectMeta{Name: "resiliency"},
+			Spec: resiliencyV1alpha.ResiliencySpec{
+				Policies: resiliencyV1alpha.Policies{
+					Timeouts: map[string]string{"app": timeout},
+				},
+				Targets: resiliencyV1alpha.Targets{
+					Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+						"appB": {Timeout: "app"},
+					},
+				},
+			},
+		}
+	}
+
+	initial := FromConfigurations(log, withAppTimeout("5s"))
+	type ctxKey struct{}
+	initial.SetComponentContextDecorator(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, ctxKey{}, true)
+	})
+
+	r := NewReloadable(initial)
+	var _ Provider = r
+	assert.Equal(t, 5*time.Second, r.EndpointPolicy("appB", "").t)
+
+	r.Swap(FromConfigurations(log, withAppTimeout("10s")))
+	assert.Equal(t, 10*time.Second, r.EndpointPolicy("appB", "").t)
+	assert.True(t, r.PolicyDefined("appB", EndpointPolicy{}))
+
+	decorate := r.ComponentContextDecorator()
+	require.NotNil(t, decorate)
+	assert.Equal(t, true, decorate(t.Context()).Value(ctxKey{}))
+
+	r.Swap(FromConfigurations(log))
+	assert.False(t, r.PolicyDefined("appB", EndpointPolicy{}))
+}
@@ -28,6 +28,7 @@ import (
 	grpcRetry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	lru "github.com/hashicorp/golang-lru/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/yaml"
 
 	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
@@ -70,6 +71,19 @@ const (
 	Outbound                      ComponentDirection    = "Outbound"
 )
 
+// Operations of the state stores, which the policies of the state store
+// components can be overridden for. They match the operations reported in the
+// metrics of the components.
+const (
+	StateGet         = "get"
+	StateBulkGet     = "bulk_get"
+	StateSet         = "set"
+	StateDelete      = "delete"
+	StateBulkDelete  = "bulk_delete"
+	StateTransaction = "transaction"
+	StateQuery       = "query"
+)
+
 // ActorCircuitBreakerScope indicates the scope of the circuit breaker for an actor.
 type ActorCircuitBreakerScope int
 
@@ -100,6 +114,14 @@ type (
 		ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition
 		// ComponentInboundPolicy returns the inbound policy for a component.
 		ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition
+		// ActorPreLockMethodPolicy returns the policy for a method of an actor instance to be used before the lock is acquired.
+		ActorPreLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition
+		// ActorPostLockMethodPolicy returns the policy for a method of an actor instance to be used after the lock is acquired.
+		ActorPostLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition
+		// ComponentOutboundOperationPolicy returns the outbound policy for an operation of a component.
+		ComponentOutboundOperationPolicy(name string, componentType ComponentType, operation string) *PolicyDefinition
+		// ComponentInboundOperationPolicy returns the inbound policy for an operation of a component.
+		ComponentInboundOperationPolicy(name string, componentType ComponentType, operation string) *PolicyDefinition
 		// ComponentContextDecorator returns the function that decorates the
 		// context of component operations (e.g. attaching the workload's SPIFFE
 		// identity), or nil if none is configured. Use it for component calls
@@ -109,6 +131,8 @@ type (
 		BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition
 		// PolicyDefined returns true if there's policy that applies to the target.
 		PolicyDefined(target string, policyType PolicyType) (exists bool)
+		// CircuitBreakers returns the state of the circuit breakers created for the targets.
+		CircuitBreakers() []CircuitBreakerStatus
 	}
 
 	// Resiliency encapsulates configuration for timeouts, retries, and circuit breakers.
@@ -118,10 +142,13 @@ type (
 		name      string
 		namespace string
 		log       logger.Logger
+		clock     clock.Clock
 
 		timeouts        map[string]time.Duration
+		dryRunTimeouts  map[string]bool
 		retries         map[string]*Retry
 		circuitBreakers map[string]*breaker.CircuitBreaker
+		hedging         map[string]*Hedging
 
 		actorCBCaches    map[string]*lru.Cache[string, *breaker.CircuitBreaker]
 		actorCBsCachesMu sync.RWMutex
@@ -130,6 +157,9 @@ type (
 
 		componentCBs *circuitBreakerInstances
 
+		retryBudgets   map[retryBudgetKey]*retryBudgetCounter
+		retryBudgetsMu sync.Mutex
+
 		apps       map[string]PolicyNames
 		actors     map[string]ActorPolicies
 		components map[string]ComponentPolicyNames
@@ -138,6 +168,22 @@ type (
 		// (outbound and inbound). Dapr wires this to attach the workload's
 		// SPIFFE identity. Nil leaves component contexts untouched.
 		componentCtxFn ComponentContextFn
+
+		// circuitBreakerStoreFn returns the store in which the circuit
+		// breakers with persistence share their state.
+		circuitBreakerStoreFn CircuitBreakerStoreFn
+
+		// faultInjectorFn injects faults in the operations run with the
+		// outbound policies.
+		faultInjectorFn FaultInjectorFn
+	}
+
+	// retryBudgetKey identifies the retry budget of a target for a retry
+	// policy in a direction.
+	retryBudgetKey struct {
+		target    string
+		direction diag.PolicyFlowDirection
+		retry     *Retry
 	}
 
 	// circuitBreakerInstances stores circuit breaker state for components
@@ -151,6 +197,9 @@ type (
 	ComponentPolicyNames struct {
 		Inbound  PolicyNames
 		Outbound PolicyNames
+		// Operations contains the policies overridden for operations of the
+		// component, keyed by operation.
+		Operations map[string]ComponentPolicyNames
 	}
 
 	// PolicyNames contains the policy names for a timeout, retry, and circuit breaker.
@@ -159,12 +208,24 @@ type (
 		Timeout        string
 		Retry          string
 		CircuitBreaker string
+		// Hedging is only supported for apps.
+		Hedging string
 	}
 
 	// Actors have different behavior before and after locking.
 	ActorPolicies struct {
 		PreLockPolicies  ActorPreLockPolicyNames
 		PostLockPolicies ActorPostLockPolicyNames
+		// Methods contains the policies overridden for methods of the actor
+		// type, keyed by method.
+		Methods map[string]ActorMethodPolicyNames
+	}
+
+	// ActorMethodPolicyNames contains the policies overridden for a method of
+	// an actor type. Empty values fall back to the policies of the actor type.
+	ActorMethodPolicyNames struct {
+		Timeout string
+		Retry   string
 	}
 
 	// Policy used before an actor is locked. It does not include a timeout as we want to wait forever for the actor.
@@ -318,17 +379,21 @@ func FromConfigurations(log logger.Logger, c ...*resiliencyV1alpha.Resiliency) *
 func New(log logger.Logger) *Resiliency {
 	return &Resiliency{
 		log:             log,
+		clock:           clock.RealClock{},
 		timeouts:        make(map[string]time.Duration),
+		dryRunTimeouts:  make(map[string]bool),
 		retries:         make(map[string]*Retry),
 		circuitBreakers: make(map[string]*breaker.CircuitBreaker),
+		hedging:         make(map[string]*Hedging),
 		actorCBCaches:   make(map[string]*lru.Cache[string, *breaker.CircuitBreaker]),
 		serviceCBs:      make(map[string]*lru.Cache[string, *breaker.CircuitBreaker]),
 		componentCBs: &circuitBreakerInstances{
 			cbs: make(map[string]*breaker.CircuitBreaker, 10),
 		},
-		apps:       make(map[string]PolicyNames),
-		actors:     make(map[string]ActorPolicies),
-		components: make(map[string]ComponentPolicyNames),
+		retryBudgets: make(map[retryBudgetKey]*retryBudgetCounter),
+		apps:         make(map[string]PolicyNames),
+		actors:       make(map[string]ActorPolicies),
+		components:   make(map[string]ComponentPolicyNames),
 	}
 }
 
@@ -350,6 +415,24 @@ func (r *Resiliency) ComponentContextDecorator() ComponentContextFn {
 	return r.componentCtxFn
 }
 
+// SetCircuitBreakerStore sets the function returning the store in which the
+// circuit breakers with persistence share their state with the other replicas
+// of the app. As the state stores are initialized after the resiliency
+// policies are loaded, the store is only looked up when the state is read or
+// saved.
+func (r *Resiliency) SetCircuitBreakerStore(fn CircuitBreakerStoreFn) {
+	r.circuitBreakerStoreFn = fn
+}
+
+// CircuitBreakerStore returns the function set with SetCircuitBreakerStore,
+// or nil if none is set.
+func (r *Resiliency) CircuitBreakerStore() CircuitBreakerStoreFn {
+	if r == nil {
+		return nil
+	}
+	return r.circuitBreakerStoreFn
+}
+
 // DecodeConfiguration reads in a single resiliency configuration.
 func (r *Resiliency) DecodeConfiguration(c *resiliencyV1alpha.Resiliency) error {
 	if c == nil {
@@ -437,6 +520,7 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 		if r.timeouts[name], err = parseDuration(t); err != nil {
 			return fmt.Errorf("invalid duration %q, %s: %w", name, t, err)
 		}
+		r.dryRunTimeouts[name] = c.Spec.DryRun
 	}
 
 	for name, t := range policies.Retries {
@@ -450,6 +534,11 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 		}
 
 		if !r.isProtectedPolicy(name) {
+			if r.isBuiltInPolicy(name) && c.Spec.DryRun {
+				// Dry-run policies would disable the built-in retries instead of being evaluated next to them.
+				r.log.Warnf("Attempted override of %s in dry-run mode which is not allowed. Ignoring provided policy.", name)
+				continue
+			}
 			if r.isBuiltInPolicy(name) && rc.MaxRetries < 3 {
 				r.log.Warnf("Attempted override of %s did not meet minimum retry count, resetting to 3.", name)
 				rc.MaxRetries = 3
@@ -458,10 +547,16 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 			if err != nil {
 				return err
 			}
+			budget, err := ParseRetryBudget(t.Budget)
+			if err != nil {
+				return fmt.Errorf("invalid retry budget %q: %w", name, err)
+			}
 
 			r.retries[name] = &Retry{
 				Config:              rc,
 				RetryConditionMatch: match,
+				Budget:              budget,
+				DryRun:              c.Spec.DryRun,
 			}
 		} else {
 			r.log.Warnf("Attempted to override protected policy %s which is not allowed. Ignoring provided policy and using default.", name)
@@ -478,10 +573,29 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 			return fmt.Errorf("invalid retry configuration %q: %w", name, err)
 		}
 		cb.Name = name
+		cb.DryRun = c.Spec.DryRun
+		if p := t.Persistence; p != nil {
+			if p.StateStore == "" {
+				return fmt.Errorf("invalid circuit breaker persistence %q: stateStore is required", name)
+			}
+			if p.SyncInterval != "" {
+				if cb.SyncInterval, err = parseDuration(p.SyncInterval); err != nil {
+					return fmt.Errorf("invalid circuit breaker persistence %q: invalid syncInterval %q: %w", name, p.SyncInterval, err)
+				}
+			}
+			cb.Store = &circuitBreakerStore{r: r, resiliencyName: c.Name, storeName: p.StateStore}
+		}
 		cb.Initialize(r.log)
 		r.circuitBreakers[name] = &cb
 	}
 
+	for name, h := range policies.Hedging {
+		if r.hedging[name], err = ParseHedging(h); err != nil {
+			return fmt.Errorf("invalid hedging configuration %q: %w", name, err)
+		}
+		r.hedging[name].DryRun = c.Spec.DryRun
+	}
+
 	return nil
 }
 
@@ -493,6 +607,7 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 			Timeout:        t.Timeout,
 			Retry:          t.Retry,
 			CircuitBreaker: t.CircuitBreaker,
+			Hedging:        t.Hedging,
 		}
 		if t.CircuitBreakerCacheSize == 0 {
 			t.CircuitBreakerCacheSize = defaultEndpointCacheSize
@@ -536,6 +651,18 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 			}
 		}
 
+		if len(t.Methods) > 0 {
+			policies := r.actors[name]
+			policies.Methods = make(map[string]ActorMethodPolicyNames, len(t.Methods))
+			for method, m := range t.Methods {
+				policies.Methods[method] = ActorMethodPolicyNames{
+					Timeout: m.Timeout,
+					Retry:   m.Retry,
+				}
+			}
+			r.actors[name] = policies
+		}
+
 		if t.CircuitBreakerCacheSize == 0 {
 			t.CircuitBreakerCacheSize = defaultActorCacheSize
 		}
@@ -546,23 +673,48 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 	}
 
 	for name, t := range targets.Components {
-		r.components[name] = ComponentPolicyNames{
-			Inbound: PolicyNames{
-				Timeout:        t.Inbound.Timeout,
-				Retry:          t.Inbound.Retry,
-				CircuitBreaker: t.Inbound.CircuitBreaker,
-			},
-			Outbound: PolicyNames{
-				Timeout:        t.Outbound.Timeout,
-				Retry:          t.Outbound.Retry,
-				CircuitBreaker: t.Outbound.CircuitBreaker,
-			},
+		policies := ComponentPolicyNames{
+			Inbound:  toPolicyNames(t.Inbound),
+			Outbound: toPolicyNames(t.Outbound),
+		}
+		if len(t.Operations) > 0 {
+			policies.Operations = make(map[string]ComponentPolicyNames, len(t.Operations))
+			for operation, o := range t.Operations {
+				policies.Operations[operation] = ComponentPolicyNames{
+					Inbound:  toPolicyNames(o.Inbound),
+					Outbound: toPolicyNames(o.Outbound),
+				}
+			}
 		}
+		r.components[name] = policies
 	}
 
 	return nil
 }
 
+func toPolicyNames(p resiliencyV1alpha.PolicyNames) PolicyNames {
+	return PolicyNames{
+		Timeout:        p.Timeout,
+		Retry:          p.Retry,
+		CircuitBreaker: p.CircuitBreaker,
+	}
+}
+
+// withOverrides returns the policy names with the ones set in o taking
+// precedence.
+func (p PolicyNames) withOverrides(o PolicyNames) PolicyNames {
+	if o.Timeout != "" {
+		p.Timeout = o.Timeout
+	}
+	if o.Retry != "" {
+		p.Retry = o.Retry
+	}
+	if o.CircuitBreaker != "" {
+		p.CircuitBreaker = o.CircuitBreaker
+	}
+	return p
+}
+
 func (r *Resiliency) isBuiltInPolicy(name string) bool {
 	switch name {
 	case string(BuiltInServiceRetries):
@@ -589,6 +741,25 @@ func (r *Resiliency) isProtectedPolicy(name string) bool {
 	}
 }
 
+// addRetryBudgetToPolicy adds the counter of the retry budget of the target to
+// the policy, if its retry policy has a budget. The counter is shared by all
+// the policies of the target in the direction with the same retry policy.
+func (r *Resiliency) addRetryBudgetToPolicy(policyDef *PolicyDefinition, target string, direction diag.PolicyFlowDirection) {
+	if policyDef.r == nil || policyDef.r.Budget == nil {
+		return
+	}
+
+	key := retryBudgetKey{target: target, direction: direction, retry: policyDef.r}
+	r.retryBudgetsMu.Lock()
+	defer r.retryBudgetsMu.Unlock()
+	counter, ok := r.retryBudgets[key]
+	if !ok {
+		counter = newRetryBudgetCounter(*policyDef.r.Budget, r.clock)
+		r.retryBudgets[key] = counter
+	}
+	policyDef.budget = counter
+}
+
 // addMetricsToPolicy adds metrics for resiliency policies for count on instantiation and activation for each policy that is defined.
 func (r *Resiliency) addMetricsToPolicy(policyDef *PolicyDefinition, target string, direction diag.PolicyFlowDirection) {
 	if policyDef.t != 0 {
@@ -602,6 +773,11 @@ func (r *Resiliency) addMetricsToPolicy(policyDef *PolicyDefinition, target stri
 		policyDef.addRetryActivatedMetric = func() {
 			diag.DefaultResiliencyMonitoring.PolicyActivated(r.name, r.namespace, diag.RetryPolicy, direction, target)
 		}
+		if policyDef.budget != nil {
+			policyDef.addRetryBudgetRejectedMetric = func() {
+				diag.DefaultResiliencyMonitoring.RetryBudgetRejected(r.name, r.namespace, direction, target)
+			}
+		}
 	}
 	if policyDef.cb != nil {
 		diag.DefaultResiliencyMonitoring.PolicyWithStatusExecuted(r.name, r.namespace, diag.CircuitBreakerPolicy, direction, target, string(policyDef.cb.State()))
@@ -614,6 +790,17 @@ func (r *Resiliency) addMetricsToPolicy(policyDef *PolicyDefinition, target stri
 			diag.DefaultResiliencyMonitoring.RecordCircuitBreakerState(r.name, r.namespace, direction, target, state)
 		}
 	}
+	if policyDef.hasDryRun() {
+		policyDef.addDryRunActivatedMetric = func(policy diag.PolicyType) {
+			diag.DefaultResiliencyMonitoring.DryRunPolicyActivated(r.name, r.namespace, policy, direction, target)
+		}
+	}
+	if policyDef.h != nil {
+		diag.DefaultResiliencyMonitoring.PolicyExecuted(r.name, r.namespace, diag.HedgingPolicy, direction, target)
+		policyDef.addHedgingActivatedMetric = func() {
+			diag.DefaultResiliencyMonitoring.PolicyActivated(r.name, r.namespace, diag.HedgingPolicy, direction, target)
+		}
+	}
 }
 
 // EndpointPolicy returns the policy for a service endpoint.
@@ -626,11 +813,14 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 	if ok {
 		r.log.Debugf("Found Endpoint Policy for %s: %+v", app, policyNames)
 		if policyNames.Timeout != "" {
-			policyDef.t = r.timeouts[policyNames.Timeout]
+			policyDef.t, policyDef.dryRunTimeout = r.timeout(policyNames.Timeout)
 		}
 		if policyNames.Retry != "" {
 			policyDef.r = r.retries[policyNames.Retry]
 		}
+		if policyNames.Hedging != "" {
+			policyDef.h = r.hedging[policyNames.Hedging]
+		}
 		if policyNames.CircuitBreaker != "" {
 			template, ok := r.circuitBreakers[policyNames.CircuitBreaker]
 			if ok {
@@ -651,7 +841,7 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 				policyDef.r = r.retries[defaultNames.Retry]
 			}
 			if defaultNames.Timeout != "" {
-				policyDef.t = r.timeouts[defaultNames.Timeout]
+				policyDef.t, policyDef.dryRunTimeout = r.timeout(defaultNames.Timeout)
 			}
 
 			if defaultNames.CircuitBreaker != "" {
@@ -666,18 +856,33 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 			}
 		}
 	}
+	r.addRetryBudgetToPolicy(policyDef, diag.ResiliencyAppTarget(app), diag.OutboundPolicyFlowDirection)
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyAppTarget(app), diag.OutboundPolicyFlowDirection)
+	r.addFaultsToPolicy(policyDef, TargetTypeApp, "", app)
 
 	return policyDef
 }
 
+// timeout returns the timeout policy with the given name, and whether it's in
+// dry-run mode.
+func (r *Resiliency) timeout(name string) (time.Duration, bool) {
+	return r.timeouts[name], r.dryRunTimeouts[name]
+}
+
 func newCB(cbName string, template *breaker.CircuitBreaker, l logger.Logger) *breaker.CircuitBreaker {
 	cb := &breaker.CircuitBreaker{
 		Name:        cbName,
+		Policy:      template.Name,
 		MaxRequests: template.MaxRequests,
 		Interval:    template.Interval,
 		Timeout:     template.Timeout,
 		Trip:        template.Trip,
+		DryRun:      template.DryRun,
+	}
+	if template.Store != nil {
+		cb.Store = template.Store
+		cb.StoreKey = template.Name + "||" + cbName
+		cb.SyncInterval = template.SyncInterval
 	}
 	cb.Initialize(l)
 	return cb
@@ -755,6 +960,12 @@ func (r *Resiliency) getCBFromCache(cache *lru.Cache[string, *breaker.CircuitBre
 
 // ActorPreLockPolicy returns the policy for an actor instance to be used before an actor lock is acquired.
 func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefinition {
+	return r.ActorPreLockMethodPolicy(actorType, id, "")
+}
+
+// ActorPreLockMethodPolicy returns the policy for a method of an actor instance to be used before an actor lock is acquired.
+// The retry policy configured for the method takes precedence over the one of the actor type.
+func (r *Resiliency) ActorPreLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition {
 	policyDef := &PolicyDefinition{
 		log:  r.log,
 		name: "actor[" + actorType + ", " + id + "]",
@@ -762,6 +973,10 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 	actorPolicies, ok := r.actors[actorType]
 	if policyNames := actorPolicies.PreLockPolicies; ok {
 		r.log.Debugf("Found Actor Policy for type %s: %+v", actorType, policyNames)
+		if override, ok := actorPolicies.Methods[method]; ok && override.Retry != "" {
+			r.log.Debugf("Found Actor Policy for method %s of type %s: %+v", method, actorType, override)
+			policyNames.Retry = override.Retry
+		}
 		if policyNames.Retry != "" {
 			policyDef.r = r.retries[policyNames.Retry]
 		}
@@ -803,13 +1018,21 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 			}
 		}
 	}
+	r.addRetryBudgetToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), diag.OutboundPolicyFlowDirection)
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), diag.OutboundPolicyFlowDirection)
+	r.addFaultsToPolicy(policyDef, TargetTypeActor, "", actorType)
 
 	return policyDef
 }
 
 // ActorPostLockPolicy returns the policy for an actor instance to be used after an actor lock is acquired.
 func (r *Resiliency) ActorPostLockPolicy(actorType string, id string) *PolicyDefinition {
+	return r.ActorPostLockMethodPolicy(actorType, id, "")
+}
+
+// ActorPostLockMethodPolicy returns the policy for a method of an actor instance to be used after an actor lock is acquired.
+// The timeout policy configured for the method takes precedence over the one of the actor type.
+func (r *Resiliency) ActorPostLockMethodPolicy(actorType string, id string, method string) *PolicyDefinition {
 	policyDef := &PolicyDefinition{
 		log:  r.log,
 		name: "actor[" + actorType + ", " + id + "]",
@@ -817,17 +1040,22 @@ func (r *Resiliency) ActorPostLockPolicy(actorType string, id string) *PolicyDef
 	actorPolicies, ok := r.actors[actorType]
 	if policyNames := actorPolicies.PostLockPolicies; ok {
 		r.log.Debugf("Found Actor Policy for type %s: %+v", actorType, policyNames)
+		if override, ok := actorPolicies.Methods[method]; ok && override.Timeout != "" {
+			r.log.Debugf("Found Actor Policy for method %s of type %s: %+v", method, actorType, override)
+			policyNames.Timeout = override.Timeout
+		}
 		if policyNames.Timeout != "" {
-			policyDef.t = r.timeouts[policyNames.Timeout]
+			policyDef.t, policyDef.dryRunTimeout = r.timeout(policyNames.Timeout)
 		}
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ActorPolicy{}); ok {
 			r.log.Debugf("Found Default Policy for Actor type %s: %+v", actorType, defaultPolicies)
 			if defaultPolicies.Timeout != "" {
-				policyDef.t = r.timeouts[defaultPolicies.Timeout]
+				policyDef.t, policyDef.dryRunTimeout = r.timeout(defaultPolicies.Timeout)
 			}
 		}
 	}
+	r.addRetryBudgetToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), diag.OutboundPolicyFlowDirection)
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), diag.OutboundPolicyFlowDirection)
 
 	return policyDef
@@ -835,6 +1063,13 @@ func (r *Resiliency) ActorPostLockPolicy(actorType string, id string) *PolicyDef
 
 // ComponentOutboundPolicy returns the outbound policy for a component.
 func (r *Resiliency) ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return r.ComponentOutboundOperationPolicy(name, componentType, "")
+}
+
+// ComponentOutboundOperationPolicy returns the outbound policy for an operation of a component, such as the "get"
+// operation of a state store or the topic of a pub/sub. The policies configured for the operation take precedence
+// over the ones of the component.
+func (r *Resiliency) ComponentOutboundOperationPolicy(name string, componentType ComponentType, operation string) *PolicyDefinition {
 	policyDef := &PolicyDefinition{
 		log:            r.log,
 		name:           "component[" + name + "] output",
@@ -842,22 +1077,23 @@ func (r *Resiliency) ComponentOutboundPolicy(name string, componentType Componen
 	}
 	componentPolicies, ok := r.components[name]
 	if ok {
-		r.log.Debugf("Found Component Outbound Policy for component %s: %+v", name, componentPolicies.Outbound)
-		if componentPolicies.Outbound.Timeout != "" {
-			policyDef.t = r.timeouts[componentPolicies.Outbound.Timeout]
+		policyNames, cbInstance := r.componentOperationPolicyNames(name, operation, componentPolicies, Outbound)
+		r.log.Debugf("Found Component Outbound Policy for component %s: %+v", name, policyNames)
+		if policyNames.Timeout != "" {
+			policyDef.t, policyDef.dryRunTimeout = r.timeout(policyNames.Timeout)
 		}
-		if componentPolicies.Outbound.Retry != "" {
-			policyDef.r = r.retries[componentPolicies.Outbound.Retry]
+		if policyNames.Retry != "" {
+			policyDef.r = r.retries[policyNames.Retry]
 		}
-		if componentPolicies.Outbound.CircuitBreaker != "" {
-			template := r.circuitBreakers[componentPolicies.Outbound.CircuitBreaker]
-			policyDef.cb = r.componentCBs.Get(r.log, name, template)
+		if policyNames.CircuitBreaker != "" {
+			template := r.circuitBreakers[policyNames.CircuitBreaker]
+			policyDef.cb = r.componentCBs.Get(r.log, cbInstance, template)
 		}
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: "Outbound"}); ok {
 			r.log.Debugf("Found Default Policy for Component: %s: %+v", name, defaultPolicies)
 			if defaultPolicies.Timeout != "" {
-				policyDef.t = r.timeouts[defaultPolicies.Timeout]
+				policyDef.t, policyDef.dryRunTimeout = r.timeout(defaultPolicies.Timeout)
 			}
 			if defaultPolicies.Retry != "" {
 				policyDef.r = r.retries[defaultPolicies.Retry]
@@ -868,13 +1104,21 @@ func (r *Resiliency) ComponentOutboundPolicy(name string, componentType Componen
 			}
 		}
 	}
+	r.addRetryBudgetToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.OutboundPolicyFlowDirection)
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.OutboundPolicyFlowDirection)
+	r.addFaultsToPolicy(policyDef, TargetTypeComponent, componentType, name)
 
 	return policyDef
 }
 
 // ComponentInboundPolicy returns the inbound policy for a component.
 func (r *Resiliency) ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return r.ComponentInboundOperationPolicy(name, componentType, "")
+}
+
+// ComponentInboundOperationPolicy returns the inbound policy for an operation of a component, such as the topic of a
+// pub/sub. The policies configured for the operation take precedence over the ones of the component.
+func (r *Resiliency) ComponentInboundOperationPolicy(name string, componentType ComponentType, operation string) *PolicyDefinition {
 	policyDef := &PolicyDefinition{
 		log:            r.log,
 		name:           "component[" + name + "] input",
@@ -882,22 +1126,23 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 	}
 	componentPolicies, ok := r.components[name]
 	if ok {
-		r.log.Debugf("Found Component Inbound Policy for component %s: %+v", name, componentPolicies.Inbound)
-		if componentPolicies.Inbound.Timeout != "" {
-			policyDef.t = r.timeouts[componentPolicies.Inbound.Timeout]
+		policyNames, cbInstance := r.componentOperationPolicyNames(name, operation, componentPolicies, Inbound)
+		r.log.Debugf("Found Component Inbound Policy for component %s: %+v", name, policyNames)
+		if policyNames.Timeout != "" {
+			policyDef.t, policyDef.dryRunTimeout = r.timeout(policyNames.Timeout)
 		}
-		if componentPolicies.Inbound.Retry != "" {
-			policyDef.r = r.retries[componentPolicies.Inbound.Retry]
+		if policyNames.Retry != "" {
+			policyDef.r = r.retries[policyNames.Retry]
 		}
-		if componentPolicies.Inbound.CircuitBreaker != "" {
-			template := r.circuitBreakers[componentPolicies.Inbound.CircuitBreaker]
-			policyDef.cb = r.componentCBs.Get(r.log, name, template)
+		if policyNames.CircuitBreaker != "" {
+			template := r.circuitBreakers[policyNames.CircuitBreaker]
+			policyDef.cb = r.componentCBs.Get(r.log, cbInstance, template)
 		}
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: Inbound}); ok {
 			r.log.Debugf("Found Default Policy for Component: %s: %+v", name, defaultPolicies)
 			if defaultPolicies.Timeout != "" {
-				policyDef.t = r.timeouts[defaultPolicies.Timeout]
+				policyDef.t, policyDef.dryRunTimeout = r.timeout(defaultPolicies.Timeout)
 			}
 			if defaultPolicies.Retry != "" {
 				policyDef.r = r.retries[defaultPolicies.Retry]
@@ -908,11 +1153,34 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 			}
 		}
 	}
+	r.addRetryBudgetToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.InboundPolicyFlowDirection)
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.InboundPolicyFlowDirection)
 
 	return policyDef
 }
 
+// componentOperationPolicyNames returns the policy names of the component in
+// the direction, with the ones overridden for the operation taking precedence,
+// and the name of the circuit breaker instance. Operations overriding the
+// circuit breaker get their own instance, while the others share the one of
+// the component.
+func (r *Resiliency) componentOperationPolicyNames(name string, operation string, policies ComponentPolicyNames, direction ComponentDirection) (PolicyNames, string) {
+	policyNames, override := policies.Outbound, policies.Operations[operation].Outbound
+	if direction == Inbound {
+		policyNames, override = policies.Inbound, policies.Operations[operation].Inbound
+	}
+	if operation == "" || override == (PolicyNames{}) {
+		return policyNames, name
+	}
+
+	r.log.Debugf("Found Component %s Policy for operation %s of component %s: %+v", direction, operation, name, override)
+	cbInstance := name
+	if override.CircuitBreaker != "" {
+		cbInstance = name + "||" + operation
+	}
+	return policyNames.withOverrides(override), cbInstance
+}
+
 // BuiltInPolicy returns a policy that represents a specific built-in retry scenario.
 func (r *Resiliency) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
 	nameStr := string(name)
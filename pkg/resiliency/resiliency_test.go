@@ -221,6 +221,87 @@ func TestPoliciesForTargets(t *testing.T) {
 	}
 }
 
+func TestOperationPolicyOverrides(t *testing.T) {
+	maxRetries := 1
+	r := FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Timeouts: map[string]string{"fast": "1s", "slow": "10s"},
+				Retries: map[string]resiliencyV1alpha.Retry{
+					"once": {Policy: "constant", Duration: "10ms", MaxRetries: &maxRetries},
+				},
+				CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+					"cb": {MaxRequests: 1, Timeout: "30s", Trip: "consecutiveFailures > 1"},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore": {
+						Outbound: resiliencyV1alpha.PolicyNames{Timeout: "slow", CircuitBreaker: "cb"},
+						Operations: map[string]resiliencyV1alpha.ComponentOperationPolicyNames{
+							StateGet:         {Outbound: resiliencyV1alpha.PolicyNames{Timeout: "fast"}},
+							StateTransaction: {Outbound: resiliencyV1alpha.PolicyNames{CircuitBreaker: "cb"}},
+						},
+					},
+					"pubsub": {
+						Operations: map[string]resiliencyV1alpha.ComponentOperationPolicyNames{
+							"orders": {Inbound: resiliencyV1alpha.PolicyNames{Retry: "once"}},
+						},
+					},
+				},
+				Actors: map[string]resiliencyV1alpha.ActorPolicyNames{
+					"myActorType": {
+						Timeout: "slow",
+						Methods: map[string]resiliencyV1alpha.ActorMethodPolicyNames{
+							"fastMethod": {Timeout: "fast", Retry: "once"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	t.Run("component operation overrides the component policies", func(t *testing.T) {
+		def := r.ComponentOutboundPolicy("statestore", Statestore)
+		assert.Equal(t, 10*time.Second, def.t)
+
+		get := r.ComponentOutboundOperationPolicy("statestore", Statestore, StateGet)
+		assert.Equal(t, time.Second, get.t)
+		assert.Same(t, def.cb, get.cb)
+
+		set := r.ComponentOutboundOperationPolicy("statestore", Statestore, StateSet)
+		assert.Equal(t, 10*time.Second, set.t)
+		assert.Same(t, def.cb, set.cb)
+	})
+
+	t.Run("operation overriding the circuit breaker gets its own instance", func(t *testing.T) {
+		def := r.ComponentOutboundPolicy("statestore", Statestore)
+		tx := r.ComponentOutboundOperationPolicy("statestore", Statestore, StateTransaction)
+		require.NotNil(t, tx.cb)
+		assert.NotSame(t, def.cb, tx.cb)
+		assert.Same(t, tx.cb, r.ComponentOutboundOperationPolicy("statestore", Statestore, StateTransaction).cb)
+		assert.Equal(t, 10*time.Second, tx.t)
+	})
+
+	t.Run("topic overrides the inbound policies of the pubsub", func(t *testing.T) {
+		assert.Nil(t, r.ComponentInboundPolicy("pubsub", Pubsub).r)
+		assert.Nil(t, r.ComponentInboundOperationPolicy("pubsub", Pubsub, "payments").r)
+		orders := r.ComponentInboundOperationPolicy("pubsub", Pubsub, "orders")
+		require.NotNil(t, orders.r)
+		assert.Equal(t, int64(1), orders.r.MaxRetries)
+		assert.Nil(t, r.ComponentOutboundOperationPolicy("pubsub", Pubsub, "orders").r)
+	})
+
+	t.Run("actor method overrides the actor type policies", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, r.ActorPostLockPolicy("myActorType", "id").t)
+		assert.Equal(t, 10*time.Second, r.ActorPostLockMethodPolicy("myActorType", "id", "otherMethod").t)
+		assert.Equal(t, time.Second, r.ActorPostLockMethodPolicy("myActorType", "id", "fastMethod").t)
+
+		assert.Nil(t, r.ActorPreLockPolicy("myActorType", "id").r)
+		assert.NotNil(t, r.ActorPreLockMethodPolicy("myActorType", "id", "fastMethod").r)
+	})
+}
+
 func TestLoadKubernetesResiliency(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
@@ -344,6 +425,30 @@ func TestParseRetryWithMatch(t *testing.T) {
 	assert.False(t, r.retries["withMatch"].statusCodeNeedRetry(400))
 }
 
+func TestParseHedgingPolicy(t *testing.T) {
+	configs := LoadLocalResiliency(log, "appC", "./testdata")
+	require.Len(t, configs, 1)
+
+	r := FromConfigurations(log, configs[0])
+	require.NotNil(t, r.hedging["tailLatency"])
+	assert.Equal(t, 50*time.Millisecond, r.hedging["tailLatency"].Delay)
+	assert.Equal(t, 3, r.hedging["tailLatency"].MaxAttempts)
+
+	assert.True(t, r.EndpointPolicy("appC", "appC:method").HasHedging())
+	assert.False(t, r.EndpointPolicy("appB", "appB:method").HasHedging())
+
+	err := New(log).DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Hedging: map[string]resiliencyV1alpha.Hedging{
+					"unbounded": {Delay: "10ms", MaxAttempts: 100},
+				},
+			},
+		},
+	})
+	require.ErrorContains(t, err, "invalid hedging configuration")
+}
+
 func TestResiliencyScopeIsRespected(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
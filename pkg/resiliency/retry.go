@@ -62,6 +62,12 @@ func NewCodeError(statusCode int32, err error) CodeError {
 type Retry struct {
 	retry.Config
 	RetryConditionMatch
+	// Budget limits the retries to each target, or nil if the retries are
+	// only limited by the retry configuration.
+	Budget *RetryBudget
+	// DryRun only logs and meters the retries, without retrying the
+	// operation.
+	DryRun bool
 }
 
 func NewRetry(retryConfig retry.Config, statusCodeMatch RetryConditionMatch) *Retry {
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+const (
+	defaultRetryBudgetRatio      = 0.2
+	defaultRetryBudgetMinRetries = 10
+	defaultRetryBudgetWindow     = 10 * time.Second
+
+	// retryBudgetBuckets is the number of buckets the window of a retry budget
+	// is divided in, so that it slides in steps of a tenth of the window.
+	retryBudgetBuckets = 10
+)
+
+// RetryBudget limits the retries to a target to a fraction of the requests to it, so that retries don't amplify
+// cascading failures. The budget is shared by all the operations on a target using the same retry policy.
+type RetryBudget struct {
+	// Ratio is the maximum fraction of the requests to a target which may be retries.
+	Ratio float64
+	// MinRetries is the number of retries in a window which are allowed regardless of the ratio.
+	MinRetries int
+	// Window is the duration over which the requests and the retries are counted.
+	Window time.Duration
+}
+
+// ParseRetryBudget parses a retry budget from the resiliency configuration.
+// It returns nil if the retry policy doesn't have a budget.
+func ParseRetryBudget(b *resiliencyV1alpha.RetryBudget) (*RetryBudget, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	budget := &RetryBudget{
+		Ratio:      defaultRetryBudgetRatio,
+		MinRetries: defaultRetryBudgetMinRetries,
+		Window:     defaultRetryBudgetWindow,
+	}
+
+	if b.Ratio != "" {
+		ratio, err := strconv.ParseFloat(b.Ratio, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ratio %q: %w", b.Ratio, err)
+		}
+		if ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("ratio must be between 0 and 1, got %q", b.Ratio)
+		}
+		budget.Ratio = ratio
+	}
+
+	if b.MinRetries != nil {
+		if *b.MinRetries < 0 {
+			return nil, fmt.Errorf("minRetries must not be negative, got %d", *b.MinRetries)
+		}
+		budget.MinRetries = *b.MinRetries
+	}
+
+	if b.Window != "" {
+		window, err := parseDuration(b.Window)
+		if err != nil {
+			return nil, fmt.Errorf("invalid window %q: %w", b.Window, err)
+		}
+		if window < retryBudgetBuckets*time.Millisecond {
+			return nil, fmt.Errorf("window must be at least %v, got %q", retryBudgetBuckets*time.Millisecond, b.Window)
+		}
+		budget.Window = window
+	}
+
+	return budget, nil
+}
+
+// retryBudgetCounter counts the requests and the retries to a target over the
+// sliding window of its retry budget.
+type retryBudgetCounter struct {
+	budget RetryBudget
+	clock  clock.Clock
+
+	lock    sync.Mutex
+	buckets [retryBudgetBuckets]retryBudgetBucket
+}
+
+type retryBudgetBucket struct {
+	// slot is the index of the bucket since the Unix epoch.
+	slot     int64
+	requests int64
+	retries  int64
+}
+
+func newRetryBudgetCounter(budget RetryBudget, clk clock.Clock) *retryBudgetCounter {
+	return &retryBudgetCounter{
+		budget: budget,
+		clock:  clk,
+	}
+}
+
+// addRequest records a request to the target.
+func (c *retryBudgetCounter) addRequest() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.current().requests++
+}
+
+// allowRetry returns true and records the retry if it is within the budget.
+func (c *retryBudgetCounter) allowRetry() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	bucket := c.current()
+	var requests, retries int64
+	for _, b := range c.buckets {
+		if bucket.slot-b.slot < retryBudgetBuckets {
+			requests += b.requests
+			retries += b.retries
+		}
+	}
+
+	if retries >= max(int64(c.budget.MinRetries), int64(c.budget.Ratio*float64(requests))) {
+		return false
+	}
+
+	bucket.retries++
+	return true
+}
+
+// current returns the bucket of the current time, resetting it if it was
+// last used in a previous window.
+func (c *retryBudgetCounter) current() *retryBudgetBucket {
+	slot := c.clock.Now().UnixNano() / int64(c.budget.Window/retryBudgetBuckets)
+	bucket := &c.buckets[slot%retryBudgetBuckets]
+	if bucket.slot != slot {
+		*bucket = retryBudgetBucket{slot: slot}
+	}
+	return bucket
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestParseRetryBudget(t *testing.T) {
+	t.Run("no budget", func(t *testing.T) {
+		budget, err := ParseRetryBudget(nil)
+		require.NoError(t, err)
+		assert.Nil(t, budget)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		budget, err := ParseRetryBudget(&resiliencyV1alpha.RetryBudget{})
+		require.NoError(t, err)
+		assert.Equal(t, &RetryBudget{Ratio: 0.2, MinRetries: 10, Window: 10 * time.Second}, budget)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		budget, err := ParseRetryBudget(&resiliencyV1alpha.RetryBudget{Ratio: "0.5", MinRetries: new(0), Window: "1m"})
+		require.NoError(t, err)
+		assert.Equal(t, &RetryBudget{Ratio: 0.5, MinRetries: 0, Window: time.Minute}, budget)
+	})
+
+	tests := map[string]resiliencyV1alpha.RetryBudget{
+		"invalid ratio":       {Ratio: "a"},
+		"ratio out of range":  {Ratio: "1.5"},
+		"negative minRetries": {MinRetries: new(-1)},
+		"invalid window":      {Window: "a"},
+		"window too short":    {Window: "1ms"},
+	}
+	for name, b := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseRetryBudget(&b)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRetryBudgetCounter(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	c := newRetryBudgetCounter(RetryBudget{Ratio: 0.5, MinRetries: 1, Window: 10 * time.Second}, clock)
+
+	// The minimum number of retries is allowed without requests.
+	assert.True(t, c.allowRetry())
+	assert.False(t, c.allowRetry())
+
+	for range 4 {
+		c.addRequest()
+	}
+	assert.True(t, c.allowRetry())
+	assert.False(t, c.allowRetry())
+
+	clock.Step(5 * time.Second)
+	c.addRequest()
+	c.addRequest()
+	assert.True(t, c.allowRetry())
+	assert.False(t, c.allowRetry())
+
+	// The requests and the retries slide out of the window.
+	clock.Step(6 * time.Second)
+	assert.False(t, c.allowRetry())
+	clock.Step(5 * time.Second)
+	assert.True(t, c.allowRetry())
+	assert.False(t, c.allowRetry())
+}
+
+func TestRetryBudgetRunner(t *testing.T) {
+	r := FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Retries: map[string]resiliencyV1alpha.Retry{
+					"budgeted": {
+						Policy:     "constant",
+						Duration:   "1ms",
+						MaxRetries: new(5),
+						Budget:     &resiliencyV1alpha.RetryBudget{MinRetries: new(3)},
+					},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore": {Outbound: resiliencyV1alpha.PolicyNames{Retry: "budgeted"}},
+				},
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"appB": {Retry: "budgeted"},
+				},
+			},
+		},
+	})
+
+	run := func(def *PolicyDefinition) (int, error) {
+		var calls int
+		_, err := NewRunner[any](t.Context(), def)(func(context.Context) (any, error) {
+			calls++
+			return nil, errors.New("fake error")
+		})
+		return calls, err
+	}
+
+	// The budget of the target is shared by its operations.
+	calls, err := run(r.ComponentOutboundOperationPolicy("statestore", Statestore, StateGet))
+	require.EqualError(t, err, "fake error")
+	assert.Equal(t, 4, calls)
+	calls, err = run(r.ComponentOutboundOperationPolicy("statestore", Statestore, StateSet))
+	require.EqualError(t, err, "fake error")
+	assert.Equal(t, 1, calls)
+
+	// Other targets have their own budget.
+	calls, err = run(r.EndpointPolicy("appB", "method"))
+	require.EqualError(t, err, "fake error")
+	assert.Equal(t, 4, calls)
+}
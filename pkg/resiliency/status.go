@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+// Types of the targets of the resiliency policies.
+const (
+	TargetTypeApp       = "app"
+	TargetTypeActor     = "actor"
+	TargetTypeComponent = "component"
+)
+
+// CircuitBreakerStatus is the current state of a circuit breaker created for a
+// target of a resiliency policy.
+type CircuitBreakerStatus struct {
+	// Name is the name of the circuit breaker instance.
+	Name string
+	// Policy is the name of the circuit breaker policy.
+	Policy string
+	// TargetType is one of TargetTypeApp, TargetTypeActor and
+	// TargetTypeComponent.
+	TargetType string
+	// TargetName is the ID of the app, the type of the actors, or the name of
+	// the component.
+	TargetName string
+	State      breaker.CircuitBreakerState
+}
+
+// CircuitBreakers returns the state of the circuit breakers created for the
+// targets, sorted by target and name.
+func (r *Resiliency) CircuitBreakers() []CircuitBreakerStatus {
+	var res []CircuitBreakerStatus
+	add := func(targetType, targetName string, cb *breaker.CircuitBreaker) {
+		if cb == nil {
+			return
+		}
+		res = append(res, CircuitBreakerStatus{
+			Name:       cb.Name,
+			Policy:     cb.Policy,
+			TargetType: targetType,
+			TargetName: targetName,
+			State:      cb.State(),
+		})
+	}
+	addCache := func(targetType, targetName string, cache *lru.Cache[string, *breaker.CircuitBreaker]) {
+		for _, key := range cache.Keys() {
+			// Peek doesn't update the recency of the circuit breakers.
+			cb, _ := cache.Peek(key)
+			add(targetType, targetName, cb)
+		}
+	}
+
+	r.serviceCBsMu.RLock()
+	for app, cache := range r.serviceCBs {
+		addCache(TargetTypeApp, app, cache)
+	}
+	r.serviceCBsMu.RUnlock()
+
+	r.actorCBsCachesMu.RLock()
+	for actorType, cache := range r.actorCBCaches {
+		addCache(TargetTypeActor, actorType, cache)
+	}
+	r.actorCBsCachesMu.RUnlock()
+
+	r.componentCBs.RLock()
+	for instanceName, cb := range r.componentCBs.cbs {
+		// The circuit breakers of operations are keyed by component||operation.
+		name, _, _ := strings.Cut(instanceName, "||")
+		add(TargetTypeComponent, name, cb)
+	}
+	r.componentCBs.RUnlock()
+
+	slices.SortFunc(res, func(a, b CircuitBreakerStatus) int {
+		return cmp.Or(
+			cmp.Compare(a.TargetType, b.TargetType),
+			cmp.Compare(a.TargetName, b.TargetName),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return res
+}
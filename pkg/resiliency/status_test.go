@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	r := New(log)
+	require.NoError(t, r.DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{Name: "myresiliency"},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+					"failFast": {
+						MaxRequests: 1,
+						Timeout:     "30s",
+						Trip:        "consecutiveFailures > 0",
+					},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"myapp": {CircuitBreaker: "failFast"},
+				},
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"statestore": {
+						Outbound: resiliencyV1alpha.PolicyNames{CircuitBreaker: "failFast"},
+						Operations: map[string]resiliencyV1alpha.ComponentOperationPolicyNames{
+							"get": {Outbound: resiliencyV1alpha.PolicyNames{CircuitBreaker: "failFast"}},
+						},
+					},
+				},
+			},
+		},
+	}))
+
+	// Circuit breakers are created when the policies are first used.
+	assert.Empty(t, r.CircuitBreakers())
+
+	succeed := func(context.Context) (any, error) { return nil, nil }
+	fail := func(context.Context) (any, error) { return nil, errors.New("fake error") }
+	NewRunner[any](t.Context(), r.EndpointPolicy("myapp", "myapp:mymethod"))(succeed)
+	NewRunner[any](t.Context(), r.ComponentOutboundPolicy("statestore", Statestore))(succeed)
+	NewRunner[any](t.Context(), r.ComponentOutboundOperationPolicy("statestore", Statestore, "get"))(fail)
+
+	assert.Equal(t, []CircuitBreakerStatus{
+		{
+			Name:       "myapp:mymethod",
+			Policy:     "failFast",
+			TargetType: TargetTypeApp,
+			TargetName: "myapp",
+			State:      breaker.StateClosed,
+		},
+		{
+			Name:       "failFast-statestore",
+			Policy:     "failFast",
+			TargetType: TargetTypeComponent,
+			TargetName: "statestore",
+			State:      breaker.StateClosed,
+		},
+		{
+			Name:       "failFast-statestore||get",
+			Policy:     "failFast",
+			TargetType: TargetTypeComponent,
+			TargetName: "statestore",
+			State:      breaker.StateOpen,
+		},
+	}, r.CircuitBreakers())
+
+	assert.Empty(t, NoOp{}.CircuitBreakers())
+	assert.Len(t, NewReloadable(r).CircuitBreakers(), 3)
+}
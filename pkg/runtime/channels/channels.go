@@ -14,22 +14,26 @@ limitations under the License.
 package channels
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"strconv"
 	"sync"
 	"time"
 
-	"golang.org/x/net/http2"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/dapr/dapr/pkg/actors/callbackstream"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	httpendpapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/channel/concurrency"
 	grpcchannel "github.com/dapr/dapr/pkg/channel/grpc"
 	channelhttp "github.com/dapr/dapr/pkg/channel/http"
 	compmiddlehttp "github.com/dapr/dapr/pkg/components/middleware/http"
@@ -72,6 +76,9 @@ type Options struct {
 	GRPC        *manager.Manager
 	AppAPIToken string
 
+	// AppLimiter limits the requests in flight toward the app, if set.
+	AppLimiter *concurrency.Limiter
+
 	// ActorCallbackStream is the runtime-owned stream manager attached to
 	// the gRPC app channel, if any. Its event loop is driven by the
 	// runtime's RunnerCloserManager.
@@ -84,11 +91,13 @@ type Channels struct {
 	meta                *meta.Meta
 	appConnectionConfig config.AppConnectionConfig
 	tracingSpec         *config.TracingSpec
+	apiSpec             config.APISpec
 	maxRequestBodySize  int
 	appMiddlware        middleware.HTTP
 	httpClient          *http.Client
 	grpc                *manager.Manager
 	actorCallbackStream *callbackstream.Manager
+	appLimiter          *concurrency.Limiter
 
 	appAPIToken     string
 	appChannel      channel.AppChannel
@@ -104,11 +113,13 @@ func New(opts Options) *Channels {
 		meta:                opts.Meta,
 		appConnectionConfig: opts.AppConnectionConfig,
 		tracingSpec:         opts.GlobalConfig.Spec.TracingSpec,
+		apiSpec:             opts.GlobalConfig.GetAPISpec(),
 		maxRequestBodySize:  opts.MaxRequestBodySize,
 		appMiddlware:        opts.AppMiddleware,
 		grpc:                opts.GRPC,
 		actorCallbackStream: opts.ActorCallbackStream,
 		appAPIToken:         opts.AppAPIToken,
+		appLimiter:          opts.AppLimiter,
 		httpClient:          appHTTPClient(opts.AppConnectionConfig, opts.GlobalConfig, opts.ReadBufferSize),
 		endpChannels:        make(map[string]channel.HTTPEndpointAppChannel),
 	}
@@ -141,7 +152,9 @@ func (c *Channels) Refresh() error {
 	var appChannel channel.AppChannel
 	if c.appConnectionConfig.Protocol.IsHTTP() {
 		// Create a HTTP channel
-		appChannel, err = channelhttp.CreateHTTPChannel(c.appHTTPChannelConfig())
+		conf := c.appHTTPChannelConfig()
+		conf.Limiter = c.appLimiter
+		appChannel, err = channelhttp.CreateHTTPChannel(conf)
 		if err != nil {
 			return fmt.Errorf("failed to create HTTP app channel: %w", err)
 		}
@@ -175,6 +188,13 @@ func (c *Channels) AppChannel() channel.AppChannel {
 	return c.appChannel
 }
 
+// AppCallbackAllowed returns true if the sidecar is allowed to invoke the
+// callback of the app, one of the config.AppCallback names, given the API
+// allowlist and denylist of the app protocol.
+func (c *Channels) AppCallbackAllowed(name string) bool {
+	return c.apiSpec.AppCallbackAllowed(name)
+}
+
 // ActorCallbackStream returns the runtime-owned actor callback stream
 // manager. It is available even when no app channel exists (no app-port
 // configured): apps hosting actors over SubscribeActorEventsAlpha1 dial
@@ -320,24 +340,56 @@ func (c *Channels) getHTTPEndpointAppChannel(endpoint httpendpapi.HTTPEndpoint)
 		Transport: tr,
 	}
 
+	if endpoint.Spec.OAuth2 != nil {
+		var err error
+		conf.Client.Transport, err = oauth2Transport(endpoint, tr)
+		if err != nil {
+			return channelhttp.ChannelConfiguration{}, err
+		}
+	}
+
 	return conf, nil
 }
 
+// oauth2Transport returns the transport sending the requests to the endpoint
+// with the access tokens of its OAuth2 client credentials flow. The tokens are
+// acquired when first needed, and acquired again when they expire.
+func oauth2Transport(endpoint httpendpapi.HTTPEndpoint, base http.RoundTripper) (http.RoundTripper, error) {
+	o := endpoint.Spec.OAuth2
+	if o.TokenURL == "" || o.ClientID == "" {
+		return nil, fmt.Errorf("oauth2 token url and client id are required for http endpoint %s", endpoint.Name)
+	}
+
+	cfg := clientcredentials.Config{
+		ClientID: o.ClientID,
+		TokenURL: o.TokenURL,
+		Scopes:   o.Scopes,
+	}
+	if o.ClientSecret.Value != nil {
+		cfg.ClientSecret = o.ClientSecret.Value.String()
+	}
+	if len(o.EndpointParams) > 0 {
+		cfg.EndpointParams = make(url.Values, len(o.EndpointParams))
+		for k, v := range o.EndpointParams {
+			cfg.EndpointParams.Set(k, v)
+		}
+	}
+
+	return &oauth2.Transport{
+		Source: cfg.TokenSource(context.Background()),
+		Base:   base,
+	}, nil
+}
+
 // appHTTPClient Initializes the appHTTPClient property.
 func appHTTPClient(connConfig config.AppConnectionConfig, globalConfig *config.Configuration, readBufferSize int) *http.Client {
 	var transport http.RoundTripper
 
-	if connConfig.Protocol == protocol.H2CProtocol {
-		// Enable HTTP/2 Cleartext transport
-		transport = &http2.Transport{
-			AllowHTTP: true, // To enable using "http" as protocol
-			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
-				// Return the TCP socket without TLS
-				return net.Dial(network, addr)
-			},
-			// TODO: This may not be exactly the same as "MaxResponseHeaderBytes" so check before enabling this
-			// MaxHeaderListSize: uint32(a.runtimeConfig.readBufferSize),
-		}
+	http2Spec := globalConfig.GetAppChannelHTTP2Spec()
+	if connConfig.Protocol == protocol.H2CProtocol || (connConfig.Protocol == protocol.HTTPProtocol && http2Spec.PriorKnowledge) {
+		// Enable HTTP/2 Cleartext transport, multiplexing the requests over
+		// the configured number of connections
+		transport = channelhttp.NewH2CTransport(http2Spec.Connections)
 	} else {
 		var tlsConfig *tls.Config
 		if connConfig.Protocol == protocol.HTTPSProtocol {
@@ -21,6 +21,8 @@ import (
 	"encoding/pem"
 	"math/big"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -31,8 +33,10 @@ import (
 
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	httpendpapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	channelhttp "github.com/dapr/dapr/pkg/channel/http"
 	httpMiddlewareLoader "github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/config/protocol"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/meta"
@@ -213,4 +217,98 @@ func TestGetHTTPEndpointAppChannel(t *testing.T) {
 
 		require.Error(t, err)
 	})
+	t.Run("OAuth2 channel", func(t *testing.T) {
+		var tokenRequests atomic.Int32
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenRequests.Add(1)
+			id, secret, _ := r.BasicAuth()
+			if id != "myclient" || secret != "mysecret" || r.FormValue("audience") != "payments" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"mytoken","token_type":"Bearer","expires_in":3600}`))
+		}))
+		defer tokenServer.Close()
+
+		var gotAuth []string
+		endpointServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		}))
+		defer endpointServer.Close()
+
+		ch := &Channels{
+			compStore: compstore.New(),
+			meta:      meta.New(meta.Options{Mode: modes.StandaloneMode}),
+			registry: registry.New(registry.NewOptions().WithHTTPMiddlewares(
+				httpMiddlewareLoader.NewRegistry(),
+			)).HTTPMiddlewares(),
+		}
+
+		conf, err := ch.getHTTPEndpointAppChannel(httpendpapi.HTTPEndpoint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: httpendpapi.HTTPEndpointSpec{
+				BaseURL: endpointServer.URL,
+				OAuth2: &httpendpapi.OAuth2{
+					TokenURL: tokenServer.URL,
+					ClientID: "myclient",
+					ClientSecret: httpendpapi.OAuth2ClientSecret{
+						Value: &commonapi.DynamicValue{JSON: v1.JSON{Raw: []byte(`"mysecret"`)}},
+					},
+					EndpointParams: map[string]string{"audience": "payments"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		for range 2 {
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, endpointServer.URL, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer fromapp")
+			resp, err := conf.Client.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+		assert.Equal(t, []string{"Bearer mytoken", "Bearer mytoken"}, gotAuth)
+		assert.Equal(t, int32(1), tokenRequests.Load())
+	})
+
+	t.Run("OAuth2 channel without token url", func(t *testing.T) {
+		ch := &Channels{
+			compStore: compstore.New(),
+			meta:      meta.New(meta.Options{Mode: modes.StandaloneMode}),
+		}
+
+		_, err := ch.getHTTPEndpointAppChannel(httpendpapi.HTTPEndpoint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: httpendpapi.HTTPEndpointSpec{
+				OAuth2: &httpendpapi.OAuth2{ClientID: "myclient"},
+			},
+		})
+		require.ErrorContains(t, err, "oauth2 token url and client id are required for http endpoint test")
+	})
+}
+
+func TestAppHTTPClient(t *testing.T) {
+	h2cConfig := &config.Configuration{Spec: config.ConfigurationSpec{
+		AppChannelSpec: &config.AppChannelSpec{
+			HTTP2: &config.AppChannelHTTP2Spec{PriorKnowledge: true, Connections: 4},
+		},
+	}}
+
+	client := appHTTPClient(config.AppConnectionConfig{Protocol: protocol.HTTPProtocol}, &config.Configuration{}, 4096)
+	assert.IsType(t, &http.Transport{}, client.Transport)
+
+	client = appHTTPClient(config.AppConnectionConfig{Protocol: protocol.H2CProtocol}, &config.Configuration{}, 4096)
+	assert.IsType(t, &channelhttp.H2CTransport{}, client.Transport)
+
+	client = appHTTPClient(config.AppConnectionConfig{Protocol: protocol.HTTPProtocol}, h2cConfig, 4096)
+	assert.IsType(t, &channelhttp.H2CTransport{}, client.Transport)
+
+	client = appHTTPClient(config.AppConnectionConfig{Protocol: protocol.HTTPSProtocol}, h2cConfig, 4096)
+	assert.IsType(t, &http.Transport{}, client.Transport)
 }
@@ -15,7 +15,10 @@ limitations under the License.
 
 package channels
 
-import "github.com/dapr/dapr/pkg/channel"
+import (
+	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
+)
 
 // WithAppChannel is used for testing to override the underlying app channel.
 func (c *Channels) WithAppChannel(appChannel channel.AppChannel) *Channels {
@@ -37,3 +40,14 @@ func (c *Channels) WithEndpointChannels(endpChannels map[string]channel.HTTPEndp
 
 	return c
 }
+
+// WithAPISpec is used for testing to override the API configuration governing
+// the callbacks of the app.
+func (c *Channels) WithAPISpec(apiSpec config.APISpec) *Channels {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.apiSpec = apiSpec
+
+	return c
+}
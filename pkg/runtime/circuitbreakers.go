@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+// circuitBreakerKeyPrefix is the prefix of the keys of the state of the
+// circuit breakers, after the ID of the app.
+const circuitBreakerKeyPrefix = "||circuitbreaker||"
+
+// newCircuitBreakerStoreFn returns the function looking up the state stores
+// in which circuit breakers with persistence share their state.
+func newCircuitBreakerStoreFn(compStore *compstore.ComponentStore, appID string) resiliency.CircuitBreakerStoreFn {
+	return func(storeName string) (breaker.SharedStore, error) {
+		store, ok := compStore.GetStateStore(storeName)
+		if !ok {
+			return nil, fmt.Errorf("state store %s is not found", storeName)
+		}
+		return &circuitBreakerStore{
+			store: store,
+			appID: appID,
+		}, nil
+	}
+}
+
+// circuitBreakerStore saves the state of circuit breakers in a state store.
+// The keys are prefixed with the ID of the app, so the state is shared by the
+// replicas of the app only. The state store isn't called through the
+// resiliency policies, as they may themselves use the circuit breakers.
+type circuitBreakerStore struct {
+	store state.Store
+	appID string
+}
+
+func (s *circuitBreakerStore) Get(ctx context.Context, key string) (*breaker.SharedState, string, error) {
+	res, err := s.store.Get(ctx, &state.GetRequest{Key: s.appID + circuitBreakerKeyPrefix + key})
+	if err != nil {
+		return nil, "", err
+	}
+	if res == nil || len(res.Data) == 0 {
+		return nil, "", nil
+	}
+
+	var shared breaker.SharedState
+	if err = json.Unmarshal(res.Data, &shared); err != nil {
+		return nil, "", fmt.Errorf("invalid circuit breaker state: %w", err)
+	}
+	var etag string
+	if res.ETag != nil {
+		etag = *res.ETag
+	}
+	return &shared, etag, nil
+}
+
+func (s *circuitBreakerStore) Set(ctx context.Context, key string, shared breaker.SharedState, etag string) error {
+	data, err := json.Marshal(shared)
+	if err != nil {
+		return err
+	}
+	req := &state.SetRequest{
+		Key:   s.appID + circuitBreakerKeyPrefix + key,
+		Value: data,
+	}
+	if etag != "" {
+		req.ETag = &etag
+	}
+
+	err = s.store.Set(ctx, req)
+	var etagErr *state.ETagError
+	if errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch {
+		return fmt.Errorf("%w: %w", breaker.ErrSharedStateConflict, err)
+	}
+	return err
+}
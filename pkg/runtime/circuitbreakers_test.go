@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+func TestCircuitBreakerStore(t *testing.T) {
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+
+	compStore := compstore.New()
+	compStore.AddStateStore("mystore", store)
+	storeFn := newCircuitBreakerStoreFn(compStore, "myapp")
+
+	_, err := storeFn("notfound")
+	require.Error(t, err)
+
+	cbStore, err := storeFn("mystore")
+	require.NoError(t, err)
+
+	shared, etag, err := cbStore.Get(t.Context(), "key")
+	require.NoError(t, err)
+	assert.Nil(t, shared)
+	assert.Empty(t, etag)
+
+	until := time.Now().Add(time.Minute).UTC()
+	require.NoError(t, cbStore.Set(t.Context(), "key", breaker.SharedState{State: breaker.StateOpen, Until: until}, ""))
+
+	// The state is saved under the keys of the app.
+	res, err := store.Get(t.Context(), &state.GetRequest{Key: "myapp||circuitbreaker||key"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.Data)
+
+	shared, etag, err = cbStore.Get(t.Context(), "key")
+	require.NoError(t, err)
+	require.NotNil(t, shared)
+	assert.Equal(t, breaker.StateOpen, shared.State)
+	assert.True(t, until.Equal(shared.Until))
+	assert.NotEmpty(t, etag)
+
+	require.NoError(t, cbStore.Set(t.Context(), "key", breaker.SharedState{State: breaker.StateHalfOpen}, etag))
+	err = cbStore.Set(t.Context(), "key", breaker.SharedState{State: breaker.StateHalfOpen}, etag)
+	require.ErrorIs(t, err, breaker.ErrSharedStateConflict)
+}
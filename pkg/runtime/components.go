@@ -16,8 +16,10 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"golang.org/x/sync/errgroup"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
@@ -27,12 +29,13 @@ import (
 	"github.com/dapr/dapr/pkg/internal/loader/disk"
 	"github.com/dapr/dapr/pkg/internal/loader/kubernetes"
 	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/runtime/meta"
 )
 
 // loadComponents loads every component manifest the runtime is configured
-// for, splits them into secret stores (first pass) and everything else
-// (second pass), and waits for each Init result before continuing. Returning
-// a non-nil error here triggers daprd shutdown via the runner manager.
+// for and initializes them concurrently, each after its dependencies,
+// waiting for all the Init results before returning. Returning a non-nil error here triggers daprd shutdown
+// via the runner manager.
 func (a *DaprRuntime) loadComponents(ctx context.Context) error {
 	var l loader.Loader[compapi.Component]
 
@@ -61,30 +64,154 @@ func (a *DaprRuntime) loadComponents(ctx context.Context) error {
 
 	authorizedComps := a.authz.GetAuthorizedObjects(comps, a.authz.IsObjectAuthorized).([]compapi.Component)
 
-	// Iterate through the list twice. First, we look for secret stores and
-	// load those, then all other components. This avoids stashing components
-	// behind unready secret store dependencies during bootstrap.
-	for _, comp := range authorizedComps {
-		if strings.HasPrefix(comp.Spec.Type, string(components.CategorySecretStore)+".") {
-			log.Debug("Found component: " + comp.LogName())
+	// Components are initialized after the components they depend on: the
+	// secret stores their metadata references and the components they declare
+	// in InitAfter. This avoids stashing components behind unready
+	// dependencies during bootstrap.
+	ordered, err := orderComponents(authorizedComps, a.meta.AuthSecretStoreOrDefault)
+	if err != nil {
+		return err
+	}
+
+	for _, comp := range ordered {
+		log.Debug("Found component: " + comp.LogName())
+	}
 
-			if err := a.initComponentBlocking(ctx, comp); err != nil {
+	return initComponentsConcurrently(ctx, ordered,
+		func(comp compapi.Component) []string {
+			return componentDependencies(comp, a.meta.AuthSecretStoreOrDefault)
+		},
+		a.runtimeConfig.componentInitConcurrency,
+		a.initComponentBlocking,
+	)
+}
+
+// initComponentsConcurrently initializes the components, which must be
+// ordered after their dependencies, running up to concurrency inits at once.
+// The init of every component starts once the inits of the components it
+// depends on are done, so independent components are initialized
+// concurrently. Returns the first error returned by init, which cancels the
+// inits which didn't start yet.
+func initComponentsConcurrently(ctx context.Context, comps []compapi.Component, dependencies func(compapi.Component) []string, concurrency int, init func(context.Context, compapi.Component) error) error {
+	done := make(map[string]chan struct{}, len(comps))
+	for _, comp := range comps {
+		done[comp.Name] = make(chan struct{})
+	}
+
+	sem := make(chan struct{}, max(concurrency, 1))
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, comp := range comps {
+		eg.Go(func() error {
+			for _, dep := range dependencies(comp) {
+				depDone, ok := done[dep]
+				if !ok || dep == comp.Name {
+					continue
+				}
+				select {
+				case <-depDone:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return nil
+			}
+			defer func() { <-sem }()
+
+			// The dependents of a component failing to init are released by
+			// the cancellation of the group instead.
+			if err := init(ctx, comp); err != nil {
 				return err
 			}
-		}
+			close(done[comp.Name])
+			return nil
+		})
 	}
 
-	for _, comp := range authorizedComps {
-		if !strings.HasPrefix(comp.Spec.Type, string(components.CategorySecretStore)+".") {
-			log.Debug("Found component: " + comp.LogName())
+	return eg.Wait()
+}
+
+// orderComponents returns the components ordered so every component comes
+// after its dependencies, otherwise keeping secret stores first and the order
+// of the manifests. Dependencies which aren't in comps are ignored, as they
+// may be loaded later. Returns an error if the dependencies form a cycle.
+func orderComponents(comps []compapi.Component, secretStoreOf func(meta.Resource) string) ([]compapi.Component, error) {
+	byName := make(map[string]int, len(comps))
+	for i, comp := range comps {
+		byName[comp.Name] = i
+	}
 
-			if err := a.initComponentBlocking(ctx, comp); err != nil {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(comps))
+	ordered := make([]compapi.Component, 0, len(comps))
+	var path []string
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			start := slices.Index(path, comps[i].Name)
+			cycle := append(slices.Clone(path[start:]), comps[i].Name)
+			return fmt.Errorf("components have a dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+
+		state[i] = visiting
+		path = append(path, comps[i].Name)
+		for _, dep := range componentDependencies(comps[i], secretStoreOf) {
+			j, ok := byName[dep]
+			if !ok || dep == comps[i].Name {
+				continue
+			}
+			if err := visit(j); err != nil {
 				return err
 			}
 		}
+		path = path[:len(path)-1]
+		state[i] = visited
+		ordered = append(ordered, comps[i])
+		return nil
 	}
 
-	return nil
+	isSecretStore := func(comp compapi.Component) bool {
+		return strings.HasPrefix(comp.Spec.Type, string(components.CategorySecretStore)+".")
+	}
+	for _, secretStores := range []bool{true, false} {
+		for i, comp := range comps {
+			if isSecretStore(comp) != secretStores {
+				continue
+			}
+			if err := visit(i); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ordered, nil
+}
+
+// componentDependencies returns the names of the components the component
+// is initialized after: the components it declares in InitAfter and the
+// secret store its metadata references.
+func componentDependencies(comp compapi.Component, secretStoreOf func(meta.Resource) string) []string {
+	deps := comp.Spec.InitAfter
+	for _, item := range comp.Spec.Metadata {
+		if item.SecretKeyRef.Name != "" {
+			if store := secretStoreOf(&comp); store != "" {
+				deps = append(slices.Clip(deps), store)
+			}
+			break
+		}
+	}
+	return deps
 }
 
 // initComponentBlocking enqueues the component for init and waits for the
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+)
+
+func TestOrderComponents(t *testing.T) {
+	comp := func(name, typ string, initAfter ...string) compapi.Component {
+		return compapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       compapi.ComponentSpec{Type: typ, Version: "v1", InitAfter: initAfter},
+		}
+	}
+	withSecretRef := func(c compapi.Component, store string) compapi.Component {
+		c.Auth.SecretStore = store
+		c.Spec.Metadata = []commonapi.NameValuePair{{
+			Name:         "password",
+			SecretKeyRef: commonapi.SecretKeyRef{Name: "secret", Key: "key"},
+		}}
+		return c
+	}
+	names := func(comps []compapi.Component) []string {
+		n := make([]string, len(comps))
+		for i, c := range comps {
+			n[i] = c.Name
+		}
+		return n
+	}
+	secretStoreOf := meta.New(meta.Options{Mode: modes.KubernetesMode}).AuthSecretStoreOrDefault
+
+	t.Run("secret stores first, otherwise in order", func(t *testing.T) {
+		ordered, err := orderComponents([]compapi.Component{
+			comp("state", "state.redis"),
+			comp("vault", "secretstores.hashicorp.vault"),
+			comp("pubsub", "pubsub.redis"),
+		}, secretStoreOf)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vault", "state", "pubsub"}, names(ordered))
+	})
+
+	t.Run("chains of secret stores referencing secret stores", func(t *testing.T) {
+		ordered, err := orderComponents([]compapi.Component{
+			withSecretRef(comp("state", "state.redis"), "vault"),
+			withSecretRef(comp("vault", "secretstores.hashicorp.vault"), "azure"),
+			withSecretRef(comp("azure", "secretstores.azure.keyvault"), ""),
+			comp("kubernetes", "secretstores.kubernetes"),
+		}, secretStoreOf)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"kubernetes", "azure", "vault", "state"}, names(ordered))
+	})
+
+	t.Run("init after", func(t *testing.T) {
+		ordered, err := orderComponents([]compapi.Component{
+			comp("outbox", "pubsub.redis", "state"),
+			comp("state", "state.redis", "lock"),
+			comp("lock", "lock.redis"),
+			comp("other", "state.redis", "not-loaded"),
+		}, secretStoreOf)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"lock", "state", "outbox", "other"}, names(ordered))
+	})
+
+	t.Run("cycles are an error", func(t *testing.T) {
+		_, err := orderComponents([]compapi.Component{
+			comp("state", "state.redis", "pubsub"),
+			withSecretRef(comp("pubsub", "pubsub.redis", "lock"), "vault"),
+			comp("vault", "secretstores.hashicorp.vault", "state"),
+		}, secretStoreOf)
+		require.EqualError(t, err, "components have a dependency cycle: vault -> state -> pubsub -> vault")
+	})
+}
+
+func TestInitComponentsConcurrently(t *testing.T) {
+	comp := func(name string, initAfter ...string) compapi.Component {
+		return compapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       compapi.ComponentSpec{Type: "state.redis", Version: "v1", InitAfter: initAfter},
+		}
+	}
+	initAfter := func(c compapi.Component) []string {
+		return c.Spec.InitAfter
+	}
+
+	t.Run("independent components are initialized concurrently, up to the limit", func(t *testing.T) {
+		var running, maxRunning atomic.Int32
+		err := initComponentsConcurrently(t.Context(), []compapi.Component{
+			comp("a"), comp("b"), comp("c"), comp("d"), comp("e"),
+		}, initAfter, 3, func(context.Context, compapi.Component) error {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), maxRunning.Load())
+	})
+
+	t.Run("components are initialized after their dependencies", func(t *testing.T) {
+		var lock sync.Mutex
+		var started, finished []string
+		err := initComponentsConcurrently(t.Context(), []compapi.Component{
+			comp("lock"), comp("other"), comp("state", "lock"), comp("outbox", "state", "other"),
+		}, initAfter, 8, func(_ context.Context, c compapi.Component) error {
+			lock.Lock()
+			for _, dep := range c.Spec.InitAfter {
+				assert.Contains(t, finished, dep, "%s started before %s finished", c.Name, dep)
+			}
+			started = append(started, c.Name)
+			lock.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			lock.Lock()
+			finished = append(finished, c.Name)
+			lock.Unlock()
+			return nil
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"lock", "other", "state", "outbox"}, started)
+	})
+
+	t.Run("the first error cancels the components which are waiting", func(t *testing.T) {
+		var initialized []string
+		var lock sync.Mutex
+		err := initComponentsConcurrently(t.Context(), []compapi.Component{
+			comp("failing"), comp("dependent", "failing"),
+		}, initAfter, 8, func(_ context.Context, c compapi.Component) error {
+			lock.Lock()
+			initialized = append(initialized, c.Name)
+			lock.Unlock()
+			if c.Name == "failing" {
+				return errors.New("init failed")
+			}
+			return nil
+		})
+		require.EqualError(t, err, "init failed")
+		assert.Equal(t, []string{"failing"}, initialized)
+	})
+}
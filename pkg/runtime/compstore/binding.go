@@ -19,6 +19,15 @@ import (
 	"github.com/dapr/components-contrib/bindings"
 )
 
+// InputBindingDeadLetter is the destination of the events of an input binding
+// which the app fails to process: either an output binding, or a topic of a
+// pub/sub.
+type InputBindingDeadLetter struct {
+	Binding    string
+	PubsubName string
+	Topic      string
+}
+
 func (c *ComponentStore) AddInputBinding(name string, binding bindings.InputBinding) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -79,6 +88,29 @@ func (c *ComponentStore) DeleteInputBindingRoute(name string) {
 	delete(c.inputBindingRoutes, name)
 }
 
+func (c *ComponentStore) AddInputBindingDeadLetter(name string, deadLetter InputBindingDeadLetter) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.inputBindingDeadLetters[name] = deadLetter
+}
+
+func (c *ComponentStore) GetInputBindingDeadLetter(name string) (InputBindingDeadLetter, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	deadLetter, ok := c.inputBindingDeadLetters[name]
+
+	return deadLetter, ok
+}
+
+func (c *ComponentStore) DeleteInputBindingDeadLetter(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.inputBindingDeadLetters, name)
+}
+
 func (c *ComponentStore) AddOutputBinding(name string, binding bindings.OutputBinding) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
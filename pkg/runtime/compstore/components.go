@@ -14,8 +14,8 @@ limitations under the License.
 package compstore
 
 import (
-	"errors"
 	"fmt"
+	"time"
 
 	compsv1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 )
@@ -33,57 +33,89 @@ func (c *ComponentStore) GetComponent(name string) (compsv1alpha1.Component, boo
 	return compsv1alpha1.Component{}, false
 }
 
+// AddPendingComponentForCommit adds the component as pending while it is
+// initialized, until it is committed or dropped. Components with different
+// names may be pending at the same time.
 func (c *ComponentStore) AddPendingComponentForCommit(component compsv1alpha1.Component) error {
-	c.compPendingLock.Lock()
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.compPending != nil {
-		c.compPendingLock.Unlock()
-		return errors.New("pending component not yet committed")
+	if _, ok := c.compsPending[component.Name]; ok {
+		return fmt.Errorf("component %s is pending and not yet committed", component.Name)
 	}
 
 	for _, existing := range c.components {
 		if existing.Name == component.Name {
-			c.compPendingLock.Unlock()
 			return fmt.Errorf("component %s already exists", existing.Name)
 		}
 	}
 
-	c.compPending = &component
+	c.compsPending[component.Name] = component
 
 	return nil
 }
 
-func (c *ComponentStore) DropPendingComponent() error {
+func (c *ComponentStore) DropPendingComponent(name string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.compPending == nil {
-		return errors.New("no pending component to drop")
+	if _, ok := c.compsPending[name]; !ok {
+		return fmt.Errorf("no pending component %s to drop", name)
 	}
 
-	c.compPending = nil
-	c.compPendingLock.Unlock()
+	delete(c.compsPending, name)
 
 	return nil
 }
 
-func (c *ComponentStore) CommitPendingComponent() error {
+func (c *ComponentStore) CommitPendingComponent(name string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.compPending == nil {
-		return errors.New("no pending component to commit")
+	comp, ok := c.compsPending[name]
+	if !ok {
+		return fmt.Errorf("no pending component %s to commit", name)
 	}
 
-	c.components = append(c.components, *c.compPending)
-	c.compPending = nil
-	c.compPendingLock.Unlock()
+	c.components = append(c.components, comp)
+	delete(c.compsPending, name)
 
 	return nil
 }
 
+// SetComponentInitDuration records the time initializing the component took.
+func (c *ComponentStore) SetComponentInitDuration(name string, d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.componentInitDurations[name] = d
+}
+
+// GetComponentInitDuration returns the time initializing the component took.
+func (c *ComponentStore) GetComponentInitDuration(name string) (time.Duration, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	d, ok := c.componentInitDurations[name]
+	return d, ok
+}
+
+// ReplaceComponent replaces the component with the same name, once a new
+// instance of the component replaced the existing one.
+func (c *ComponentStore) ReplaceComponent(component compsv1alpha1.Component) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for i, existing := range c.components {
+		if existing.Name == component.Name {
+			c.components[i] = component
+			return
+		}
+	}
+
+	c.components = append(c.components, component)
+}
+
 func (c *ComponentStore) ListComponents() []compsv1alpha1.Component {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -98,6 +130,7 @@ func (c *ComponentStore) DeleteComponent(name string) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	delete(c.componentInitDurations, name)
 	for i, comp := range c.components {
 		if comp.Name == name {
 			c.components = append(c.components[:i], c.components[i+1:]...)
@@ -105,3 +138,42 @@ func (c *ComponentStore) DeleteComponent(name string) {
 		}
 	}
 }
+
+// componentReloadsRetained is the number of the latest component reloads
+// which are kept.
+const componentReloadsRetained = 20
+
+// ComponentReload is the result of hot reloading a component whose spec
+// changed.
+type ComponentReload struct {
+	Name    string
+	Type    string
+	Version string
+	// Swapped is true if the new instance of the component was initialized
+	// while the existing instance kept serving, rather than after closing it.
+	Swapped bool
+	Time    time.Time
+	Err     error
+}
+
+// AddComponentReload records the result of hot reloading a component.
+func (c *ComponentStore) AddComponentReload(reload ComponentReload) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.componentReloads = append(c.componentReloads, reload)
+	if n := len(c.componentReloads) - componentReloadsRetained; n > 0 {
+		c.componentReloads = append(c.componentReloads[:0:0], c.componentReloads[n:]...)
+	}
+}
+
+// ListComponentReloads returns the latest component reloads, oldest first.
+func (c *ComponentStore) ListComponentReloads() []ComponentReload {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	reloads := make([]ComponentReload, len(c.componentReloads))
+	copy(reloads, c.componentReloads)
+
+	return reloads
+}
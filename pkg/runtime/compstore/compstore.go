@@ -16,6 +16,7 @@ package compstore
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/configuration"
@@ -31,6 +32,8 @@ import (
 	mcpserverV1alpha1 "github.com/dapr/dapr/pkg/apis/mcpserver/v1alpha1"
 	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	wfaclapi "github.com/dapr/dapr/pkg/apis/workflowaccesspolicy/v1alpha1"
+	secretcache "github.com/dapr/dapr/pkg/components/secretstores/cache"
+	statecache "github.com/dapr/dapr/pkg/components/state/cache"
 	"github.com/dapr/dapr/pkg/config"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/durabletask-go/backend"
@@ -43,12 +46,15 @@ type ComponentStore struct {
 	lock sync.RWMutex
 
 	states                  map[string]state.Store
+	stateCaches             map[string]*statecache.Cache
 	configurations          map[string]configuration.Store
 	configurationSubscribes map[string]chan struct{}
 	secretsConfigurations   map[string]config.SecretsScope
 	secrets                 map[string]secretstores.SecretStore
+	secretCaches            map[string]*secretcache.Cache
 	inputBindings           map[string]bindings.InputBinding
 	inputBindingRoutes      map[string]string
+	inputBindingDeadLetters map[string]InputBindingDeadLetter
 	outputBindings          map[string]bindings.OutputBinding
 	locks                   map[string]lock.Store
 	pubSubs                 map[string]*rtpubsub.PubsubItem
@@ -56,10 +62,12 @@ type ComponentStore struct {
 	workflowBackends        map[string]backend.Backend
 	cryptoProviders         map[string]crypto.SubtleCrypto
 	components              []compsv1alpha1.Component
+	componentReloads        []ComponentReload
 	subscriptions           *subscriptions
 	httpEndpoints           []httpEndpointV1alpha1.HTTPEndpoint
 	mcpServers              []mcpserverV1alpha1.MCPServer
 	configurationResources  []configapi.Configuration
+	configurationReloads    []ConfigurationReload
 	resiliencyResources     []resiliencyapi.Resiliency
 	workflowAccessPolicies  []wfaclapi.WorkflowAccessPolicy
 	actorStateStore         struct {
@@ -69,8 +77,10 @@ type ComponentStore struct {
 
 	conversations map[string]conversation.Conversation
 
-	compPendingLock sync.Mutex
-	compPending     *compsv1alpha1.Component
+	// compsPending are the components being initialized, keyed by name, which
+	// are added to components once committed.
+	compsPending           map[string]compsv1alpha1.Component
+	componentInitDurations map[string]time.Duration
 
 	// subscribersIndex is used to generate unique connection IDs for streaming subscriptions
 	subscribersIndex atomic.Uint64
@@ -79,12 +89,15 @@ type ComponentStore struct {
 func New() *ComponentStore {
 	return &ComponentStore{
 		states:                  make(map[string]state.Store),
+		stateCaches:             make(map[string]*statecache.Cache),
 		configurations:          make(map[string]configuration.Store),
 		configurationSubscribes: make(map[string]chan struct{}),
 		secretsConfigurations:   make(map[string]config.SecretsScope),
 		secrets:                 make(map[string]secretstores.SecretStore),
+		secretCaches:            make(map[string]*secretcache.Cache),
 		inputBindings:           make(map[string]bindings.InputBinding),
 		inputBindingRoutes:      make(map[string]string),
+		inputBindingDeadLetters: make(map[string]InputBindingDeadLetter),
 		outputBindings:          make(map[string]bindings.OutputBinding),
 		locks:                   make(map[string]lock.Store),
 		pubSubs:                 make(map[string]*rtpubsub.PubsubItem),
@@ -95,6 +108,8 @@ func New() *ComponentStore {
 			declaratives: make(map[string]*DeclarativeSubscription),
 			streams:      make(map[string][]*DeclarativeSubscription),
 		},
-		conversations: make(map[string]conversation.Conversation),
+		conversations:          make(map[string]conversation.Conversation),
+		compsPending:           make(map[string]compsv1alpha1.Component),
+		componentInitDurations: make(map[string]time.Duration),
 	}
 }
@@ -13,7 +13,28 @@ limitations under the License.
 
 package compstore
 
-import configapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+import (
+	"time"
+
+	configapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+)
+
+// configurationReloadsRetained is the number of the latest configuration
+// reloads which are kept.
+const configurationReloadsRetained = 10
+
+// ConfigurationReload is the result of reloading the configuration and the
+// resiliency policies of the sidecar without restarting it.
+type ConfigurationReload struct {
+	Time time.Time
+	// Applied are the changes which were applied, such as
+	// "resiliency/myresiliency updated".
+	Applied []string
+	// RestartRequired are the changes which only take effect once the sidecar
+	// is restarted, such as "configuration/tracing".
+	RestartRequired []string
+	Err             error
+}
 
 func (c *ComponentStore) GetConfigurationResource(name string) (configapi.Configuration, bool) {
 	c.lock.RLock()
@@ -59,3 +80,26 @@ func (c *ComponentStore) DeleteConfigurationResource(name string) {
 		}
 	}
 }
+
+// AddConfigurationReload records the result of reloading the configuration.
+func (c *ComponentStore) AddConfigurationReload(reload ConfigurationReload) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.configurationReloads = append(c.configurationReloads, reload)
+	if n := len(c.configurationReloads) - configurationReloadsRetained; n > 0 {
+		c.configurationReloads = append(c.configurationReloads[:0:0], c.configurationReloads[n:]...)
+	}
+}
+
+// ListConfigurationReloads returns the latest configuration reloads, oldest
+// first.
+func (c *ComponentStore) ListConfigurationReloads() []ConfigurationReload {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	reloads := make([]ConfigurationReload, len(c.configurationReloads))
+	copy(reloads, c.configurationReloads)
+
+	return reloads
+}
@@ -19,6 +19,7 @@ import (
 	"maps"
 
 	"github.com/dapr/components-contrib/secretstores"
+	secretcache "github.com/dapr/dapr/pkg/components/secretstores/cache"
 )
 
 func (c *ComponentStore) AddSecretStore(name string, store secretstores.SecretStore) {
@@ -37,6 +38,23 @@ func (c *ComponentStore) GetSecretStore(name string) (secretstores.SecretStore,
 	return store, ok
 }
 
+// AddSecretStoreCache sets the cache of the secret store.
+func (c *ComponentStore) AddSecretStoreCache(name string, cache *secretcache.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.secretCaches[name] = cache
+}
+
+// GetSecretStoreCache returns the cache of the secret store, or nil if the
+// cache is not enabled for it.
+func (c *ComponentStore) GetSecretStoreCache(name string) *secretcache.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.secretCaches[name]
+}
+
 func (c *ComponentStore) ListSecretStores() map[string]secretstores.SecretStore {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -49,6 +67,7 @@ func (c *ComponentStore) DeleteSecretStore(name string) {
 	defer c.lock.Unlock()
 
 	delete(c.secrets, name)
+	delete(c.secretCaches, name)
 }
 
 func (c *ComponentStore) SecretStoresLen() int {
@@ -18,6 +18,7 @@ import (
 	"maps"
 
 	"github.com/dapr/components-contrib/state"
+	statecache "github.com/dapr/dapr/pkg/components/state/cache"
 )
 
 func (c *ComponentStore) AddStateStore(name string, store state.Store) {
@@ -51,6 +52,47 @@ func (c *ComponentStore) GetStateStore(name string) (state.Store, bool) {
 	return store, ok
 }
 
+// SetStateStore sets the state store and its read-through cache, or nil if
+// the cache is not enabled for it, replacing both of an existing state store
+// with the same name at once.
+func (c *ComponentStore) SetStateStore(name string, store state.Store, cache *statecache.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.states[name] = store
+	if cache != nil {
+		c.stateCaches[name] = cache
+	} else {
+		delete(c.stateCaches, name)
+	}
+}
+
+// AddStateStoreCache sets the read-through cache of the state store.
+func (c *ComponentStore) AddStateStoreCache(name string, cache *statecache.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.stateCaches[name] = cache
+}
+
+// GetStateStoreCache returns the read-through cache of the state store, or nil
+// if the cache is not enabled for it.
+func (c *ComponentStore) GetStateStoreCache(name string) *statecache.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.stateCaches[name]
+}
+
+// ListStateStoreCaches returns the read-through caches of the state stores
+// which have them.
+func (c *ComponentStore) ListStateStoreCaches() map[string]*statecache.Cache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return maps.Clone(c.stateCaches)
+}
+
 func (c *ComponentStore) ListStateStores() map[string]state.Store {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -68,6 +110,7 @@ func (c *ComponentStore) DeleteStateStore(name string) {
 	}
 
 	delete(c.states, name)
+	delete(c.stateCaches, name)
 }
 
 func (c *ComponentStore) StateStoresLen() int {
@@ -24,6 +24,8 @@ import (
 
 	"go.opencensus.io/stats/view"
 
+	"github.com/dapr/kit/logger"
+
 	"github.com/dapr/dapr/pkg/acl"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	configapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
@@ -42,6 +44,7 @@ import (
 	resiliencyConfig "github.com/dapr/dapr/pkg/resiliency"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/registry"
+	"github.com/dapr/dapr/pkg/runtime/shutdown"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/dapr/pkg/validation"
 	"github.com/dapr/dapr/utils"
@@ -78,6 +81,9 @@ const (
 	DefaultAppHealthCheckPath = "/healthz"
 	// DefaultChannelAddress is the default local network address that user application listen on.
 	DefaultChannelAddress = "127.0.0.1"
+	// DefaultComponentInitConcurrency is the default maximum number of
+	// components initialized concurrently at startup.
+	DefaultComponentInitConcurrency = 8
 
 	// DisableSubscribeInitEndpoint is the config value to disable subscribe endpoint.
 	DisableSubscribeInitEndpoint = "subscribe"
@@ -108,13 +114,16 @@ type Config struct {
 	ProfilePort                   string
 	DaprInternalGRPCPort          string
 	DaprInternalGRPCListenAddress string
+	DaprInternalGRPCSocketDir     string
 	DaprPublicPort                string
 	DaprPublicListenAddress       string
 	ApplicationPort               string
 	DaprGracefulShutdownSeconds   int
 	DaprBlockShutdownDuration     *time.Duration
+	DaprShutdownPhaseTimeouts     map[shutdown.Phase]time.Duration
 	ActorsService                 string
 	ActorsDisseminationTimeout    time.Duration
+	PlacementHostLabels           map[string]string
 	RemindersService              string
 	SchedulerAddress              []string
 	SchedulerStreams              uint
@@ -136,9 +145,18 @@ type Config struct {
 	Healthz                       healthz.Healthz
 	WorkflowEventSink             orchestrator.EventSink
 	DisableInitEndpoints          []string
+	// JWTAudiences are the audiences of the JWT-SVIDs requested from sentry,
+	// served to the app by the identity API.
+	JWTAudiences []string
 	// HotReloadReconcileInterval overrides the hot-reload backup reconcile
 	// period. Zero uses the reconciler default (60s).
 	HotReloadReconcileInterval time.Duration
+	// ComponentInitConcurrency is the maximum number of components initialized
+	// concurrently at startup. Values lower than 1 use the default.
+	ComponentInitConcurrency int
+	// Logger are the options the loggers were configured with, which are
+	// applied again with the log level of the configuration when it's set.
+	Logger *logger.Options
 }
 
 type internalConfig struct {
@@ -151,11 +169,13 @@ type internalConfig struct {
 	apiGRPCPort                  int
 	internalGRPCPort             int
 	internalGRPCListenAddress    string
+	internalGRPCSocketDir        string
 	apiListenAddresses           []string
 	appConnectionConfig          config.AppConnectionConfig
 	mode                         modes.DaprMode
 	actorsService                string
 	actorsDisseminationTimeout   time.Duration
+	placementHostLabels          map[string]string
 	remindersService             string
 	schedulerAddress             []string
 	schedulerStreams             uint
@@ -169,6 +189,7 @@ type internalConfig struct {
 	readBufferSize               int // In bytes
 	gracefulShutdownDuration     time.Duration
 	blockShutdownDuration        *time.Duration
+	shutdownPhaseTimeouts        map[shutdown.Phase]time.Duration
 	enableAPILogging             *bool
 	disableBuiltinK8sSecretStore bool
 	config                       []string
@@ -178,7 +199,10 @@ type internalConfig struct {
 	outboundHealthz              healthz.Healthz
 	workflowEventSink            orchestrator.EventSink
 	disableInitEndpoints         []string
+	jwtAudiences                 []string
 	hotReloadReconcileInterval   time.Duration
+	componentInitConcurrency     int
+	loggerOptions                *logger.Options
 }
 
 func (i internalConfig) SchedulerEnabled() bool {
@@ -276,6 +300,12 @@ func FromConfig(ctx context.Context, cfg *Config) (*DaprRuntime, error) {
 		log.Info("Enabled features: " + strings.Join(enabledFeatures, " "))
 	}
 
+	if level := globalConfig.GetLogLevel(); level != "" {
+		if err = applyLogLevel(intc.loggerOptions, level); err != nil {
+			return nil, fmt.Errorf("error applying the log level of the configuration: %w", err)
+		}
+	}
+
 	// Initialize metrics only if MetricSpec is enabled.
 	metricsSpec := globalConfig.GetMetricsSpec()
 	if metricsSpec.GetEnabled() {
@@ -344,7 +374,7 @@ func FromConfig(ctx context.Context, cfg *Config) (*DaprRuntime, error) {
 		intc.enableAPILogging = new(globalConfig.GetAPILoggingSpec().Enabled)
 	}
 
-	return newDaprRuntime(ctx, cfg.Security, intc, globalConfig, accessControlList, resiliencyProvider, configAPIResource, resiliencyConfigs)
+	return newDaprRuntime(ctx, cfg.Security, intc, globalConfig, accessControlList, resiliencyConfig.NewReloadable(resiliencyProvider), configAPIResource, resiliencyConfigs)
 }
 
 func (c *Config) toInternal() (*internalConfig, error) {
@@ -375,17 +405,23 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		registry:                   registry.New(c.Registry),
 		metricsExporter:            metrics.New(c.Metrics),
 		blockShutdownDuration:      c.DaprBlockShutdownDuration,
+		shutdownPhaseTimeouts:      c.DaprShutdownPhaseTimeouts,
+		loggerOptions:              c.Logger,
 		actorsService:              c.ActorsService,
 		actorsDisseminationTimeout: c.ActorsDisseminationTimeout,
+		placementHostLabels:        c.PlacementHostLabels,
 		hotReloadReconcileInterval: c.HotReloadReconcileInterval,
 		remindersService:           c.RemindersService,
 		schedulerAddress:           c.SchedulerAddress,
 		schedulerStreams:           c.SchedulerStreams,
 		publicListenAddress:        c.DaprPublicListenAddress,
 		internalGRPCListenAddress:  c.DaprInternalGRPCListenAddress,
+		internalGRPCSocketDir:      c.DaprInternalGRPCSocketDir,
 		healthz:                    c.Healthz,
 		outboundHealthz:            healthz.New(),
 		workflowEventSink:          c.WorkflowEventSink,
+		jwtAudiences:               c.JWTAudiences,
+		componentInitConcurrency:   c.ComponentInitConcurrency,
 	}
 
 	if len(intc.standalone.ResourcesPath) == 0 && c.ComponentsPath != "" {
@@ -476,6 +512,10 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		intc.actorsDisseminationTimeout = DefaultActorsDisseminationTimeout
 	}
 
+	if intc.componentInitConcurrency < 1 {
+		intc.componentInitConcurrency = DefaultComponentInitConcurrency
+	}
+
 	if intc.appConnectionConfig.MaxConcurrency == -1 {
 		intc.appConnectionConfig.MaxConcurrency = 0
 	}
@@ -65,9 +65,13 @@ func New(opts Options) (loader.Interface, error) {
 		return nil, fmt.Errorf("failed to create watcher: %w", err)
 	}
 
+	// Files which can't be parsed, such as those saved half way through being
+	// edited, fail the load so the loaded resources are kept until the files
+	// are fixed, as with invalid resources rejected by Kubernetes.
 	diskOpts := loaderdisk.Options{
-		AppID: opts.AppID,
-		Paths: opts.Dirs,
+		AppID:  opts.AppID,
+		Paths:  opts.Dirs,
+		Strict: true,
 	}
 
 	return &disk{
@@ -105,20 +109,14 @@ func New(opts Options) (loader.Interface, error) {
 		),
 		mcpServers: newResource[mcpserverapi.MCPServer](
 			resourceOptions[mcpserverapi.MCPServer]{
-				loader: loaderdisk.NewMCPServers(loaderdisk.Options{
-					AppID: opts.AppID,
-					Paths: opts.Dirs,
-				}),
-				store: store.NewMCPServers(opts.ComponentStore),
+				loader: loaderdisk.NewMCPServers(diskOpts),
+				store:  store.NewMCPServers(opts.ComponentStore),
 			},
 		),
 		workflowAccessPolicies: newResource[wfaclapi.WorkflowAccessPolicy](
 			resourceOptions[wfaclapi.WorkflowAccessPolicy]{
-				loader: loaderdisk.NewWorkflowAccessPolicies(loaderdisk.Options{
-					AppID: opts.AppID,
-					Paths: opts.Dirs,
-				}),
-				store: store.NewWorkflowAccessPolicies(opts.ComponentStore),
+				loader: loaderdisk.NewWorkflowAccessPolicies(diskOpts),
+				store:  store.NewWorkflowAccessPolicies(opts.ComponentStore),
 			},
 		),
 	}, nil
@@ -151,7 +149,10 @@ func (d *disk) Run(ctx context.Context) error {
 					// Windows.
 					dirData, err := dirdata.ReadDirs(d.dirs)
 					if err != nil {
-						return fmt.Errorf("failed to read resource directories: %w", err)
+						// The directories may be being replaced; they are read
+						// again on the next change.
+						log.Warnf("Failed to read resource directories, keeping the loaded resources: %s", err)
+						continue
 					}
 					if err := d.components.trigger(ctx, dirData); err != nil {
 						return err
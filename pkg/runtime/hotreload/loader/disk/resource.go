@@ -15,7 +15,6 @@ package disk
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"sync/atomic"
 
@@ -212,7 +211,9 @@ func (r *resource[T]) run(ctx context.Context) error {
 		// which reside as in a resource file on disk.
 		resources, err := r.list(ctx, dirData)
 		if err != nil {
-			return fmt.Errorf("failed to load resources from disk: %s", err)
+			var zero T
+			log.Warnf("Failed to load %s resources from disk, keeping the loaded resources until the files are fixed: %s", zero.Kind(), err)
+			continue
 		}
 
 		// Reconcile the differences between what we have loaded locally, and what
@@ -144,6 +144,71 @@ func Test_Disk(t *testing.T) {
 	}, events)
 }
 
+func Test_Disk_invalidFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store := compstore.New()
+
+	d, err := New(Options{
+		Dirs:           []string{dir},
+		ComponentStore: store,
+	})
+	require.NoError(t, err)
+
+	errCh := make(chan error)
+	ctx, cancel := context.WithCancel(t.Context())
+
+	go func() {
+		errCh <- d.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	conn, err := d.Components().Stream(t.Context())
+	require.NoError(t, err)
+
+	recv := func(t *testing.T) *loader.Event[componentsapi.Component] {
+		t.Helper()
+		select {
+		case event := <-conn.EventCh:
+			return event
+		case <-time.After(time.Second * 3):
+			require.Fail(t, "expected to receive event")
+			return nil
+		}
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "f.yaml"), []byte(comp1), 0o600))
+	event := recv(t)
+	assert.Equal(t, operatorpb.ResourceEventType_CREATED, event.Type)
+	assert.Equal(t, "comp1", event.Resource.Name)
+	require.NoError(t, store.AddPendingComponentForCommit(event.Resource))
+	require.NoError(t, store.CommitPendingComponent(event.Resource.Name))
+
+	// Files which can't be parsed, and duplicate resources, keep the loaded
+	// resources rather than deleting them or stopping the loader.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "f.yaml"), []byte("INVALID_YAML_HERE\n"+comp2), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "g.yaml"), []byte(comp1), 0o600))
+	select {
+	case event := <-conn.EventCh:
+		assert.Fail(t, "unexpected event", event)
+	case <-time.After(time.Millisecond * 500):
+	}
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "g.yaml")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "f.yaml"), []byte(comp2), 0o600))
+
+	events := []*loader.Event[componentsapi.Component]{recv(t), recv(t)}
+	assert.Equal(t, operatorpb.ResourceEventType_DELETED, events[0].Type)
+	assert.Equal(t, "comp1", events[0].Resource.Name)
+	assert.Equal(t, operatorpb.ResourceEventType_CREATED, events[1].Type)
+	assert.Equal(t, "comp2", events[1].Resource.Name)
+}
+
 func Test_Stream(t *testing.T) {
 	t.Parallel()
 
@@ -233,7 +298,7 @@ func Test_Stream(t *testing.T) {
 			TypeMeta:   metav1.TypeMeta{APIVersion: "dapr.io/v1alpha1", Kind: "Component"},
 			Spec:       componentsapi.ComponentSpec{Type: "state.in-memory", Version: "v1"},
 		}))
-		require.NoError(t, store.CommitPendingComponent())
+		require.NoError(t, store.CommitPendingComponent("comp1"))
 
 		r := newResource[componentsapi.Component](resourceOptions[componentsapi.Component]{
 			store: loadercompstore.NewComponents(store),
@@ -305,7 +370,7 @@ func Test_Stream(t *testing.T) {
 			TypeMeta:   metav1.TypeMeta{APIVersion: "dapr.io/v1alpha1", Kind: "Component"},
 			Spec:       componentsapi.ComponentSpec{Type: "state.in-memory", Version: "v1"},
 		}))
-		require.NoError(t, store.CommitPendingComponent())
+		require.NoError(t, store.CommitPendingComponent("comp1"))
 		require.NoError(t, store.AddPendingComponentForCommit(componentsapi.Component{
 			ObjectMeta: metav1.ObjectMeta{Name: "comp2"},
 			TypeMeta:   metav1.TypeMeta{APIVersion: "dapr.io/v1alpha1", Kind: "Component"},
@@ -314,7 +379,7 @@ func Test_Stream(t *testing.T) {
 				Metadata: []commonapi.NameValuePair{{Name: "foo", EnvRef: "bar"}},
 			},
 		}))
-		require.NoError(t, store.CommitPendingComponent())
+		require.NoError(t, store.CommitPendingComponent("comp2"))
 
 		r := newResource[componentsapi.Component](resourceOptions[componentsapi.Component]{
 			store: loadercompstore.NewComponents(store),
@@ -25,6 +25,7 @@ import (
 
 type components struct {
 	operatorpb.Operator_ComponentUpdateClient
+	seq sequence
 }
 
 // The go linter does not yet understand that these functions are being used by
@@ -54,6 +55,15 @@ func (c *components) close() error {
 //nolint:unused
 func (c *components) recv(context.Context) (*loader.Event[componentsapi.Component], error) {
 	event, err := c.Recv()
+	for err == nil {
+		if err = c.seq.observe(componentsapi.Kind, event.GetType(), event.GetSequence()); err != nil {
+			return nil, err
+		}
+		if event.GetType() != operatorpb.ResourceEventType_RESYNC {
+			break
+		}
+		event, err = c.Recv()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +83,14 @@ func (c *components) recv(context.Context) (*loader.Event[componentsapi.Componen
 func (c *components) establish(ctx context.Context, opclient operatorpb.OperatorClient, ns string) error {
 	stream, err := opclient.ComponentUpdate(ctx, &operatorpb.ComponentUpdateRequest{
 		Namespace: ns,
+		Resync:    true,
 	})
 	if err != nil {
 		return err
 	}
 
 	c.Operator_ComponentUpdateClient = stream
+	c.seq.reset()
 
 	return nil
 }
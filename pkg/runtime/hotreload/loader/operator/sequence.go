@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"errors"
+	"fmt"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	operatorpb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+// errMissedEvents is returned by the streams which missed events, so the
+// stream is re-established and the resources are reconciled.
+var errMissedEvents = errors.New("missed events")
+
+// sequence detects the events missed by a stream from the sequences of the
+// events it receives.
+type sequence struct {
+	last uint64
+}
+
+// reset is called when the stream is established, as the sequences of a new
+// stream start again at 1.
+func (s *sequence) reset() {
+	s.last = 0
+}
+
+// observe records the sequence of an event received on the stream of the kind
+// of resource, returning errMissedEvents if events were missed.
+func (s *sequence) observe(kind string, eventType operatorpb.ResourceEventType, seq uint64) error {
+	expected := s.last + 1
+	switch {
+	case eventType == operatorpb.ResourceEventType_RESYNC:
+		// RESYNC carries the sequence of the last event sent.
+		expected = s.last
+	case seq == 0:
+		// The operator doesn't sequence the events.
+		return nil
+	}
+
+	if seq != expected {
+		diag.DefaultMonitoring.ResourceStreamGapDetected(kind)
+		return fmt.Errorf("%w on the %s stream: received sequence %d, expected %d", errMissedEvents, kind, seq, expected)
+	}
+
+	s.last = seq
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	operatorpb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+func Test_sequence(t *testing.T) {
+	const (
+		created = operatorpb.ResourceEventType_CREATED
+		resync  = operatorpb.ResourceEventType_RESYNC
+	)
+
+	t.Run("consecutive events and resyncs are observed", func(t *testing.T) {
+		var s sequence
+		require.NoError(t, s.observe("Component", resync, 0))
+		require.NoError(t, s.observe("Component", created, 1))
+		require.NoError(t, s.observe("Component", created, 2))
+		require.NoError(t, s.observe("Component", resync, 2))
+		require.NoError(t, s.observe("Component", created, 3))
+	})
+
+	t.Run("missed events are detected", func(t *testing.T) {
+		var s sequence
+		require.NoError(t, s.observe("Component", created, 1))
+		require.ErrorIs(t, s.observe("Component", created, 3), errMissedEvents)
+	})
+
+	t.Run("resyncs detect missed trailing events", func(t *testing.T) {
+		var s sequence
+		require.NoError(t, s.observe("Component", created, 1))
+		require.ErrorIs(t, s.observe("Component", resync, 2), errMissedEvents)
+	})
+
+	t.Run("unsequenced events are ignored", func(t *testing.T) {
+		var s sequence
+		require.NoError(t, s.observe("Component", created, 0))
+		require.NoError(t, s.observe("Component", created, 0))
+	})
+
+	t.Run("sequences start again once reset", func(t *testing.T) {
+		var s sequence
+		require.NoError(t, s.observe("Component", created, 1))
+		s.reset()
+		require.NoError(t, s.observe("Component", created, 1))
+	})
+}
@@ -29,6 +29,7 @@ import (
 
 type subscriptions struct {
 	operatorpb.Operator_SubscriptionUpdateClient
+	seq sequence
 }
 
 // The go linter does not yet understand that these functions are being used by
@@ -65,6 +66,15 @@ func (s *subscriptions) close() error {
 //nolint:unused
 func (s *subscriptions) recv(ctx context.Context) (*loader.Event[subapi.Subscription], error) {
 	event, err := s.Recv()
+	for err == nil {
+		if err = s.seq.observe(subapi.Kind, event.GetType(), event.GetSequence()); err != nil {
+			return nil, err
+		}
+		if event.GetType() != operatorpb.ResourceEventType_RESYNC {
+			break
+		}
+		event, err = s.Recv()
+	}
 
 	// Ignore servers which don't implement the subscription update stream.
 	status, ok := status.FromError(err)
@@ -94,12 +104,14 @@ func (s *subscriptions) recv(ctx context.Context) (*loader.Event[subapi.Subscrip
 func (s *subscriptions) establish(ctx context.Context, opclient operatorpb.OperatorClient, ns string) error {
 	stream, err := opclient.SubscriptionUpdate(ctx, &operatorpb.SubscriptionUpdateRequest{
 		Namespace: ns,
+		Resync:    true,
 	})
 	if err != nil {
 		return err
 	}
 
 	s.Operator_SubscriptionUpdateClient = stream
+	s.seq.reset()
 
 	return nil
 }
@@ -36,9 +36,9 @@ func Test_component(t *testing.T) {
 	}
 
 	require.NoError(t, compStore.AddPendingComponentForCommit(comp1))
-	require.NoError(t, compStore.CommitPendingComponent())
+	require.NoError(t, compStore.CommitPendingComponent(comp1.Name))
 	require.NoError(t, compStore.AddPendingComponentForCommit(comp2))
-	require.NoError(t, compStore.CommitPendingComponent())
+	require.NoError(t, compStore.CommitPendingComponent(comp2.Name))
 	assert.ElementsMatch(t, []componentsapi.Component{comp1, comp2}, store.List())
 
 	compStore.DeleteComponent("1")
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/utils/clock"
 
@@ -79,10 +80,18 @@ func (c *components) update(ctx context.Context, comp compapi.Component) error {
 			return nil
 		}
 
+		// Components whose instances can be swapped keep serving until the new
+		// instance is initialized, rather than being closed first.
+		if c.auth.IsObjectAuthorized(comp) && c.proc.CanSwap(oldComp, comp) {
+			log.Infof("Swapping Component to reload: %s", comp.LogName())
+			return c.wait(ctx, comp, c.proc.SwapPendingComponent(ctx, comp), true, true)
+		}
+
 		log.Infof("Closing existing Component to reload: %s", oldComp.LogName())
 		// TODO: change close to accept pointer
 		if err := c.proc.Close(ctx, oldComp); err != nil {
 			log.Errorf("error closing old component: %s", err)
+			c.recordReload(comp, false, err)
 			return nil
 		}
 	}
@@ -94,7 +103,12 @@ func (c *components) update(ctx context.Context, comp compapi.Component) error {
 
 	log.Infof("Adding Component for processing: %s", comp.LogName())
 
-	res := c.proc.AddPendingComponent(ctx, comp)
+	return c.wait(ctx, comp, c.proc.AddPendingComponent(ctx, comp), exists, false)
+}
+
+// wait waits for the result of initializing the component, recording it when
+// the component is reloaded.
+func (c *components) wait(ctx context.Context, comp compapi.Component, res <-chan error, reload, swapped bool) error {
 	if res == nil {
 		return nil
 	}
@@ -102,6 +116,9 @@ func (c *components) update(ctx context.Context, comp compapi.Component) error {
 	case <-ctx.Done():
 		return nil
 	case err := <-res:
+		if reload {
+			c.recordReload(comp, swapped, err)
+		}
 		if err == nil {
 			log.Infof("Component updated: %s", comp.LogName())
 			return nil
@@ -116,6 +133,17 @@ func (c *components) update(ctx context.Context, comp compapi.Component) error {
 	}
 }
 
+func (c *components) recordReload(comp compapi.Component, swapped bool, err error) {
+	c.store.AddComponentReload(compstore.ComponentReload{
+		Name:    comp.Name,
+		Type:    comp.Spec.Type,
+		Version: comp.Spec.Version,
+		Swapped: swapped,
+		Time:    time.Now(),
+		Err:     err,
+	})
+}
+
 func (c *components) delete(ctx context.Context, comp compapi.Component) error {
 	if !c.verify(comp) {
 		return nil
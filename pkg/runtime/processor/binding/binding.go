@@ -33,6 +33,7 @@ import (
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/processor/binding/input"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/kit/logger"
 )
 
@@ -49,6 +50,7 @@ const (
 var log = logger.NewLogger("dapr.runtime.processor.binding")
 
 type Options struct {
+	AppID  string
 	IsHTTP bool
 
 	Registry       *compbindings.Registry
@@ -58,9 +60,20 @@ type Options struct {
 	GRPC           *manager.Manager
 	TracingSpec    *config.TracingSpec
 	Channels       *channels.Channels
+	// Adapter publishes the events of the input bindings which the app fails
+	// to process to their dead-letter topics, and the events of the routed
+	// input bindings.
+	Adapter rtpubsub.Adapter
+	// Routes are the routes forwarding the events of input bindings to topics
+	// or output bindings instead of delivering them to the app.
+	Routes []config.BindingRouteSpec
+	// PauseStateStore is the name of the state store which keeps the input
+	// bindings paused with persistence.
+	PauseStateStore string
 }
 
 type binding struct {
+	appID  string
 	isHTTP bool
 
 	registry    *compbindings.Registry
@@ -70,6 +83,10 @@ type binding struct {
 	channels    *channels.Channels
 	tracingSpec *config.TracingSpec
 	grpc        *manager.Manager
+	adapter     rtpubsub.Adapter
+	routeSpecs  []config.BindingRouteSpec
+
+	pauseStateStore string
 
 	lock            sync.Mutex
 	readingBindings bool
@@ -77,20 +94,29 @@ type binding struct {
 
 	subscribeBindingList []string
 	activeInputs         map[string]*input.Input
+	inputRoutes          map[string][]route
+	paused               map[string]struct{}
+	pausedLoaded         bool
 	wg                   sync.WaitGroup
 }
 
 func New(opts Options) *binding {
 	return &binding{
-		registry:     opts.Registry,
-		compStore:    opts.ComponentStore,
-		meta:         opts.Meta,
-		isHTTP:       opts.IsHTTP,
-		resiliency:   opts.Resiliency,
-		tracingSpec:  opts.TracingSpec,
-		grpc:         opts.GRPC,
-		channels:     opts.Channels,
-		activeInputs: make(map[string]*input.Input),
+		appID:           opts.AppID,
+		pauseStateStore: opts.PauseStateStore,
+		registry:        opts.Registry,
+		compStore:       opts.ComponentStore,
+		meta:            opts.Meta,
+		isHTTP:          opts.IsHTTP,
+		resiliency:      opts.Resiliency,
+		tracingSpec:     opts.TracingSpec,
+		grpc:            opts.GRPC,
+		channels:        opts.Channels,
+		adapter:         opts.Adapter,
+		routeSpecs:      opts.Routes,
+		activeInputs:    make(map[string]*input.Input),
+		inputRoutes:     make(map[string][]route),
+		paused:          make(map[string]struct{}),
 	}
 }
 
@@ -135,12 +161,14 @@ func (b *binding) Close(comp compapi.Component) error {
 	inbinding, ok := b.compStore.GetInputBinding(comp.Name)
 	if ok {
 		defer b.compStore.DeleteInputBinding(comp.Name)
+		defer b.compStore.DeleteInputBindingDeadLetter(comp.Name)
 
 		if input := b.activeInputs[comp.Name]; input != nil {
 			input.Stop()
 		}
 
 		delete(b.activeInputs, comp.Name)
+		delete(b.inputRoutes, comp.Name)
 
 		err := inbinding.Close()
 		if err != nil {
@@ -184,6 +212,17 @@ func (b *binding) initInputBinding(ctx context.Context, comp compapi.Component)
 		return rterrors.NewInit(rterrors.CreateComponentFailure, fName, err)
 	}
 
+	if _, err = deliveryLimitsFromMetadata(meta.Properties); err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
+	routes, err := routesOfBinding(b.routeSpecs, comp.Name)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	err = binding.Init(ctx, bindings.Metadata{Base: meta})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
@@ -200,6 +239,18 @@ func (b *binding) initInputBinding(ctx context.Context, comp compapi.Component)
 		}
 	}
 
+	deadLetter, err := deadLetterFromMetadata(comp.Spec.Metadata)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+	if deadLetter != nil {
+		b.compStore.AddInputBindingDeadLetter(comp.Name, *deadLetter)
+	}
+	if len(routes) > 0 {
+		b.inputRoutes[comp.Name] = routes
+	}
+
 	b.compStore.AddInputBinding(comp.Name, binding)
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 
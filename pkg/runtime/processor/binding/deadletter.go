@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dapr/components-contrib/bindings"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/apis/common"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const (
+	// Metadata of the input bindings configuring the destination of the events
+	// which the app fails to process, after the retries of the inbound
+	// resiliency policy: either an output binding, or a topic of a pub/sub.
+	DeadLetterBinding = "deadLetterBinding"
+	DeadLetterPubsub  = "deadLetterPubsub"
+	DeadLetterTopic   = "deadLetterTopic"
+
+	// deadLetterTimeout caps the delivery of an event to its dead-letter
+	// destination, which isn't bound by the context of the event as it may
+	// have been consumed by the retries.
+	deadLetterTimeout = 30 * time.Second
+)
+
+// deadLetterFromMetadata returns the dead-letter destination configured in the
+// metadata of an input binding, or nil if none is.
+func deadLetterFromMetadata(metadata []common.NameValuePair) (*compstore.InputBindingDeadLetter, error) {
+	var deadLetter compstore.InputBindingDeadLetter
+	for _, item := range metadata {
+		switch item.Name {
+		case DeadLetterBinding:
+			deadLetter.Binding = item.Value.String()
+		case DeadLetterPubsub:
+			deadLetter.PubsubName = item.Value.String()
+		case DeadLetterTopic:
+			deadLetter.Topic = item.Value.String()
+		}
+	}
+
+	switch {
+	case deadLetter == compstore.InputBindingDeadLetter{}:
+		return nil, nil
+	case deadLetter.Binding != "" && (deadLetter.PubsubName != "" || deadLetter.Topic != ""):
+		return nil, fmt.Errorf("only one of %s and %s can be set", DeadLetterBinding, DeadLetterPubsub)
+	case deadLetter.Binding == "" && (deadLetter.PubsubName == "" || deadLetter.Topic == ""):
+		return nil, fmt.Errorf("both %s and %s are required", DeadLetterPubsub, DeadLetterTopic)
+	}
+
+	return &deadLetter, nil
+}
+
+// handleBindingEvent sends an event of an input binding to the app. If the app
+// fails to process it, the event is sent to the dead-letter destination of the
+// binding, if any, and is acknowledged to the binding once it's delivered
+// there.
+func (b *binding) handleBindingEvent(ctx context.Context, bindingName string, data []byte, metadata map[string]string) ([]byte, error) {
+	resp, err := b.sendBindingEventToApp(ctx, bindingName, data, metadata)
+	if err == nil {
+		return resp, nil
+	}
+
+	deadLetter, ok := b.compStore.GetInputBindingDeadLetter(bindingName)
+	if !ok {
+		return nil, err
+	}
+
+	// Don't block the shutdown of the runtime on the dead-letter destination.
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return nil, err
+	}
+
+	dlCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deadLetterTimeout)
+	defer cancel()
+	if dlErr := b.sendToDeadLetter(dlCtx, bindingName, deadLetter, data, metadata); dlErr != nil {
+		log.Errorf("Error sending the event of input binding %s to its dead letter after the app failed to process it (%v): %v", bindingName, err, dlErr)
+		return nil, err
+	}
+
+	log.Warnf("Sent the event of input binding %s to its dead letter after the app failed to process it: %v", bindingName, err)
+	return nil, nil
+}
+
+func (b *binding) sendToDeadLetter(ctx context.Context, bindingName string, deadLetter compstore.InputBindingDeadLetter, data []byte, metadata map[string]string) error {
+	if deadLetter.Binding != "" {
+		_, err := b.SendToOutputBinding(ctx, deadLetter.Binding, &bindings.InvokeRequest{
+			Data:      data,
+			Metadata:  metadata,
+			Operation: bindings.CreateOperation,
+		})
+		return err
+	}
+
+	return b.publishBindingEvent(ctx, bindingName, deadLetter.PubsubName, deadLetter.Topic, data, metadata)
+}
+
+// publishBindingEvent publishes an event of an input binding to a topic, as a
+// cloud event whose source is the binding.
+func (b *binding) publishBindingEvent(ctx context.Context, bindingName, pubsubName, topic string, data []byte, metadata map[string]string) error {
+	if b.adapter == nil {
+		return errors.New("pub/sub is not available")
+	}
+
+	envelope, err := rtpubsub.NewCloudEvent(&rtpubsub.CloudEvent{
+		Source:          bindingName,
+		Topic:           topic,
+		Pubsub:          pubsubName,
+		DataContentType: invokev1.JSONContentType,
+		Data:            data,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	return b.adapter.Publish(ctx, &contribpubsub.PublishRequest{
+		Data:       payload,
+		PubsubName: pubsubName,
+		Topic:      topic,
+		Metadata:   metadata,
+	}, rtpubsub.TransportModeGRPC)
+}
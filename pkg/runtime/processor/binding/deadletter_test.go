@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/dapr/components-contrib/bindings"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestDeadLetterFromMetadata(t *testing.T) {
+	metadata := func(kv ...string) []commonapi.NameValuePair {
+		res := make([]commonapi.NameValuePair, 0, len(kv)/2)
+		for i := 0; i < len(kv); i += 2 {
+			res = append(res, commonapi.NameValuePair{
+				Name:  kv[i],
+				Value: commonapi.DynamicValue{JSON: v1.JSON{Raw: []byte(kv[i+1])}},
+			})
+		}
+		return res
+	}
+
+	deadLetter, err := deadLetterFromMetadata(metadata("route", "/orders"))
+	require.NoError(t, err)
+	assert.Nil(t, deadLetter)
+
+	deadLetter, err = deadLetterFromMetadata(metadata(DeadLetterBinding, "dlq"))
+	require.NoError(t, err)
+	assert.Equal(t, &compstore.InputBindingDeadLetter{Binding: "dlq"}, deadLetter)
+
+	deadLetter, err = deadLetterFromMetadata(metadata(DeadLetterPubsub, "mypubsub", DeadLetterTopic, "dlq"))
+	require.NoError(t, err)
+	assert.Equal(t, &compstore.InputBindingDeadLetter{PubsubName: "mypubsub", Topic: "dlq"}, deadLetter)
+
+	_, err = deadLetterFromMetadata(metadata(DeadLetterPubsub, "mypubsub"))
+	require.Error(t, err)
+	_, err = deadLetterFromMetadata(metadata(DeadLetterBinding, "dlq", DeadLetterTopic, "dlq"))
+	require.Error(t, err)
+}
+
+func TestHandleBindingEvent(t *testing.T) {
+	const bindingName = "orders"
+
+	newBinding := func(t *testing.T, status int32) *binding {
+		t.Helper()
+
+		mockAppChannel := new(channelt.MockAppChannel)
+		resp := invokev1.NewInvokeMethodResponse(status, "", nil)
+		t.Cleanup(func() { resp.Close() })
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.Anything, mock.Anything).Return(resp, nil)
+
+		b := New(Options{
+			IsHTTP:         true,
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compstore.New(),
+			Meta:           meta.New(meta.Options{}),
+		})
+		b.channels = new(channels.Channels).WithAppChannel(mockAppChannel)
+		return b
+	}
+
+	t.Run("app fails without dead letter", func(t *testing.T) {
+		b := newBinding(t, 500)
+
+		_, err := b.handleBindingEvent(t.Context(), bindingName, []byte(`{"id":1}`), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("app fails with dead-letter binding", func(t *testing.T) {
+		b := newBinding(t, 500)
+		dlq := new(daprt.MockBinding)
+		dlq.On("Invoke", mock.Anything).Return(nil)
+		b.compStore.AddOutputBinding("dlq", dlq)
+		b.compStore.AddInputBindingDeadLetter(bindingName, compstore.InputBindingDeadLetter{Binding: "dlq"})
+
+		_, err := b.handleBindingEvent(t.Context(), bindingName, []byte(`{"id":1}`), map[string]string{"key": "value"})
+		require.NoError(t, err)
+		dlq.AssertCalled(t, "Invoke", &bindings.InvokeRequest{
+			Data:      []byte(`{"id":1}`),
+			Metadata:  map[string]string{"key": "value"},
+			Operation: bindings.CreateOperation,
+		})
+	})
+
+	t.Run("app fails with dead-letter topic", func(t *testing.T) {
+		b := newBinding(t, 500)
+		var published *contribpubsub.PublishRequest
+		b.adapter = fake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			published = req
+			return nil
+		})
+		b.compStore.AddInputBindingDeadLetter(bindingName, compstore.InputBindingDeadLetter{PubsubName: "mypubsub", Topic: "dlq"})
+
+		_, err := b.handleBindingEvent(t.Context(), bindingName, []byte(`{"id":1}`), nil)
+		require.NoError(t, err)
+		require.NotNil(t, published)
+		assert.Equal(t, "mypubsub", published.PubsubName)
+		assert.Equal(t, "dlq", published.Topic)
+
+		var ce map[string]any
+		require.NoError(t, json.Unmarshal(published.Data, &ce))
+		assert.Equal(t, bindingName, ce[contribpubsub.SourceField])
+		assert.Equal(t, map[string]any{"id": 1.0}, ce[contribpubsub.DataField])
+	})
+
+	t.Run("dead letter fails", func(t *testing.T) {
+		b := newBinding(t, 500)
+		b.adapter = fake.New().WithPublishFn(func(context.Context, *contribpubsub.PublishRequest) error {
+			return errors.New("unavailable")
+		})
+		b.compStore.AddInputBindingDeadLetter(bindingName, compstore.InputBindingDeadLetter{PubsubName: "mypubsub", Topic: "dlq"})
+
+		_, err := b.handleBindingEvent(t.Context(), bindingName, []byte(`{"id":1}`), nil)
+		require.ErrorContains(t, err, "status code: 500")
+	})
+
+	t.Run("app acknowledges with a 2xx status", func(t *testing.T) {
+		b := newBinding(t, 204)
+		b.compStore.AddInputBindingDeadLetter(bindingName, compstore.InputBindingDeadLetter{Binding: "dlq"})
+
+		_, err := b.handleBindingEvent(t.Context(), bindingName, []byte(`{"id":1}`), nil)
+		require.NoError(t, err)
+	})
+}
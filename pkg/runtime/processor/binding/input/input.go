@@ -16,10 +16,13 @@ package input
 import (
 	"context"
 	"errors"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"github.com/dapr/components-contrib/bindings"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/kit/logger"
@@ -37,6 +40,14 @@ type Options struct {
 	// workload's SPIFFE identity so the binding can authenticate to its backing
 	// infrastructure service.
 	DecorateContext func(context.Context) context.Context
+
+	// MaxConcurrency is the maximum number of events delivered to the app
+	// concurrently. Zero means no limit.
+	MaxConcurrency int
+
+	// EventsPerSecond is the maximum rate at which events are delivered to the
+	// app. Zero means no limit.
+	EventsPerSecond float64
 }
 
 type Input struct {
@@ -48,6 +59,12 @@ type Input struct {
 	closed   atomic.Bool
 	wg       sync.WaitGroup
 	inflight atomic.Int64
+
+	// sem and limiter hold back the events delivered by the binding, so the
+	// binding is slowed down rather than the app overloaded. They're nil if
+	// there's no limit.
+	sem     chan struct{}
+	limiter *rate.Limiter
 }
 
 func Run(opts Options) (*Input, error) {
@@ -64,6 +81,13 @@ func Run(opts Options) (*Input, error) {
 		cancel:  cancel,
 	}
 
+	if opts.MaxConcurrency > 0 {
+		i.sem = make(chan struct{}, opts.MaxConcurrency)
+	}
+	if opts.EventsPerSecond > 0 {
+		i.limiter = rate.NewLimiter(rate.Limit(opts.EventsPerSecond), int(math.Ceil(opts.EventsPerSecond)))
+	}
+
 	return i, i.read(ctx)
 }
 
@@ -101,6 +125,12 @@ func (i *Input) read(ctx context.Context) error {
 			return nil, nil
 		}
 
+		release, err := i.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
 		start := time.Now()
 		b, err := i.handler(ctx, i.name, resp.Data, resp.Metadata)
 		elapsed := diag.ElapsedSince(start)
@@ -115,3 +145,23 @@ func (i *Input) read(ctx context.Context) error {
 		return b, nil
 	})
 }
+
+// acquire waits until the event can be delivered to the app within the limits
+// of the binding, and returns the function to call once it's delivered.
+func (i *Input) acquire(ctx context.Context) (func(), error) {
+	if i.limiter != nil {
+		if err := i.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if i.sem == nil {
+		return func() {}, nil
+	}
+	select {
+	case i.sem <- struct{}{}:
+		return func() { <-i.sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package input
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/bindings"
+)
+
+// fakeBinding keeps the handler of Read, so tests deliver the events.
+type fakeBinding struct {
+	bindings.InputBinding
+	handler bindings.Handler
+}
+
+func (f *fakeBinding) Read(_ context.Context, handler bindings.Handler) error {
+	f.handler = handler
+	return nil
+}
+
+func TestLimits(t *testing.T) {
+	t.Run("max concurrency", func(t *testing.T) {
+		var inflight, maxInflight atomic.Int64
+		release := make(chan struct{})
+		binding := new(fakeBinding)
+		i, err := Run(Options{
+			Name:    "mybinding",
+			Binding: binding,
+			Handler: func(context.Context, string, []byte, map[string]string) ([]byte, error) {
+				n := inflight.Add(1)
+				defer inflight.Add(-1)
+				for {
+					m := maxInflight.Load()
+					if n <= m || maxInflight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				<-release
+				return nil, nil
+			},
+			MaxConcurrency: 2,
+		})
+		require.NoError(t, err)
+		t.Cleanup(i.Stop)
+
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := binding.handler(t.Context(), &bindings.ReadResponse{})
+				assert.NoError(t, err)
+			}()
+		}
+
+		assert.Eventually(t, func() bool { return inflight.Load() == 2 }, time.Second, 10*time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int64(2), inflight.Load())
+		close(release)
+		wg.Wait()
+		assert.Equal(t, int64(2), maxInflight.Load())
+	})
+
+	t.Run("events per second", func(t *testing.T) {
+		var delivered atomic.Int64
+		binding := new(fakeBinding)
+		i, err := Run(Options{
+			Name:    "mybinding",
+			Binding: binding,
+			Handler: func(context.Context, string, []byte, map[string]string) ([]byte, error) {
+				delivered.Add(1)
+				return nil, nil
+			},
+			EventsPerSecond: 1,
+		})
+		require.NoError(t, err)
+		t.Cleanup(i.Stop)
+
+		_, err = binding.handler(t.Context(), &bindings.ReadResponse{})
+		require.NoError(t, err)
+
+		// The next event waits for the rate limit, until it's canceled.
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		_, err = binding.handler(ctx, &bindings.ReadResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int64(1), delivered.Load())
+	})
+}
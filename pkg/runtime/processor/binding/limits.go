@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// Metadata of the input bindings limiting the delivery of their events to
+	// the app, regardless of how fast the component reads them.
+	MaxConcurrency  = "maxConcurrency"
+	EventsPerSecond = "eventsPerSecond"
+)
+
+// deliveryLimits are the limits of the delivery of the events of an input
+// binding to the app. Zero values mean no limit.
+type deliveryLimits struct {
+	maxConcurrency  int
+	eventsPerSecond float64
+}
+
+// deliveryLimitsFromMetadata returns the delivery limits configured in the
+// metadata of an input binding.
+func deliveryLimitsFromMetadata(metadata map[string]string) (deliveryLimits, error) {
+	var limits deliveryLimits
+
+	if val := metadata[MaxConcurrency]; val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return limits, fmt.Errorf("invalid %s %q: must be a non-negative integer", MaxConcurrency, val)
+		}
+		limits.maxConcurrency = n
+	}
+
+	if val := metadata[EventsPerSecond]; val != "" {
+		n, err := strconv.ParseFloat(val, 64)
+		if err != nil || n < 0 {
+			return limits, fmt.Errorf("invalid %s %q: must be a non-negative number", EventsPerSecond, val)
+		}
+		limits.eventsPerSecond = n
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryLimitsFromMetadata(t *testing.T) {
+	limits, err := deliveryLimitsFromMetadata(map[string]string{"route": "/orders"})
+	require.NoError(t, err)
+	assert.Equal(t, deliveryLimits{}, limits)
+
+	limits, err = deliveryLimitsFromMetadata(map[string]string{
+		MaxConcurrency:  "10",
+		EventsPerSecond: "2.5",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, deliveryLimits{maxConcurrency: 10, eventsPerSecond: 2.5}, limits)
+
+	for _, m := range []map[string]string{
+		{MaxConcurrency: "-1"},
+		{MaxConcurrency: "ten"},
+		{EventsPerSecond: "-1"},
+		{EventsPerSecond: "fast"},
+	} {
+		_, err = deliveryLimitsFromMetadata(m)
+		require.Error(t, err, m)
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// PauseInputBinding stops reading the events of an input binding, such as to
+// mitigate an incident downstream of the app, until it's resumed. If persist
+// is true, the input binding stays paused when the sidecar restarts.
+func (b *binding) PauseInputBinding(ctx context.Context, name string, persist bool) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if persist {
+		store, err := b.pauseStore()
+		if err != nil {
+			return err
+		}
+		err = store.Set(ctx, &state.SetRequest{Key: b.pauseKey(name), Value: []byte("true")})
+		if err != nil {
+			return fmt.Errorf("failed to persist the paused input binding: %w", err)
+		}
+	}
+
+	b.paused[name] = struct{}{}
+	if input := b.activeInputs[name]; input != nil {
+		input.Stop()
+		delete(b.activeInputs, name)
+	}
+
+	log.Infof("Paused input binding %s", name)
+	return nil
+}
+
+// ResumeInputBinding resumes reading the events of a paused input binding.
+func (b *binding) ResumeInputBinding(ctx context.Context, name string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.pauseStateStore != "" {
+		store, err := b.pauseStore()
+		if err != nil {
+			return err
+		}
+		if err = store.Delete(ctx, &state.DeleteRequest{Key: b.pauseKey(name)}); err != nil {
+			return fmt.Errorf("failed to delete the persisted paused input binding: %w", err)
+		}
+	}
+
+	if _, ok := b.paused[name]; !ok {
+		return nil
+	}
+	delete(b.paused, name)
+	log.Infof("Resumed input binding %s", name)
+
+	if !b.readingBindings {
+		return nil
+	}
+	comp, _ := b.compStore.GetComponent(name)
+	inbinding, ok := b.compStore.GetInputBinding(name)
+	if !ok {
+		return nil
+	}
+	return b.startInputBinding(comp, inbinding)
+}
+
+// PausedInputBindings returns the names of the paused input bindings, sorted.
+func (b *binding) PausedInputBindings() []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	names := make([]string, 0, len(b.paused))
+	for name := range b.paused {
+		if _, ok := b.compStore.GetInputBinding(name); ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// loadPausedInputBindings reads the input bindings paused with persistence by
+// a previous run of the sidecar. It's called with the lock held, once the
+// components are initialized.
+func (b *binding) loadPausedInputBindings(ctx context.Context) {
+	if b.pausedLoaded || b.pauseStateStore == "" {
+		return
+	}
+
+	store, err := b.pauseStore()
+	if err != nil {
+		log.Warnf("Failed to load the paused input bindings: %v", err)
+		return
+	}
+
+	for name := range b.compStore.ListInputBindings() {
+		res, err := store.Get(ctx, &state.GetRequest{Key: b.pauseKey(name)})
+		if err != nil {
+			log.Warnf("Failed to load whether input binding %s is paused: %v", name, err)
+			continue
+		}
+		if res != nil && len(res.Data) > 0 {
+			log.Infof("Input binding %s is paused", name)
+			b.paused[name] = struct{}{}
+		}
+	}
+	b.pausedLoaded = true
+}
+
+func (b *binding) pauseStore() (state.Store, error) {
+	if b.pauseStateStore == "" {
+		return nil, errors.New("the state store of the paused input bindings is not configured")
+	}
+	store, ok := b.compStore.GetStateStore(b.pauseStateStore)
+	if !ok {
+		return nil, fmt.Errorf("state store %s of the paused input bindings is not found", b.pauseStateStore)
+	}
+	return store, nil
+}
+
+func (b *binding) pauseKey(name string) string {
+	return b.appID + "||bindings-paused||" + name
+}
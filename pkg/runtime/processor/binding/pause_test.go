@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	rtmock "github.com/dapr/dapr/pkg/runtime/mock"
+	"github.com/dapr/kit/logger"
+)
+
+func TestPauseInputBinding(t *testing.T) {
+	const bindingName = "orders"
+
+	newBinding := func(t *testing.T, compStore *compstore.ComponentStore, pauseStateStore string) (*binding, *channelt.MockAppChannel) {
+		t.Helper()
+
+		mockAppChannel := new(channelt.MockAppChannel)
+		resp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		t.Cleanup(func() { resp.Close() })
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.Anything, mock.Anything).Return(resp, nil)
+
+		b := New(Options{
+			AppID:           "myapp",
+			IsHTTP:          true,
+			Resiliency:      resiliency.New(log),
+			ComponentStore:  compStore,
+			Meta:            meta.New(meta.Options{}),
+			PauseStateStore: pauseStateStore,
+		})
+		b.channels = new(channels.Channels).WithAppChannel(mockAppChannel)
+		t.Cleanup(func() { b.StopReadingFromBindings(true) })
+		return b, mockAppChannel
+	}
+
+	newCompStore := func(t *testing.T) *compstore.ComponentStore {
+		t.Helper()
+
+		compStore := compstore.New()
+		compStore.AddInputBinding(bindingName, &rtmock.Binding{Metadata: map[string]string{}})
+		require.NoError(t, compStore.AddPendingComponentForCommit(compapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: bindingName},
+			Spec:       compapi.ComponentSpec{Type: "bindings.test"},
+		}))
+		require.NoError(t, compStore.CommitPendingComponent(bindingName))
+		return compStore
+	}
+
+	// deliveries returns the number of events of the input binding delivered
+	// to the app, excluding the OPTIONS request checking the subscription.
+	deliveries := func(mockAppChannel *channelt.MockAppChannel) int {
+		var n int
+		for _, call := range mockAppChannel.Calls {
+			if req, ok := call.Arguments.Get(1).(*invokev1.InvokeMethodRequest); ok && req.Message().GetHttpExtension().GetVerb().String() == "POST" {
+				n++
+			}
+		}
+		return n
+	}
+
+	t.Run("pause stops reading and resume restarts it", func(t *testing.T) {
+		b, mockAppChannel := newBinding(t, newCompStore(t), "")
+		require.NoError(t, b.StartReadingFromBindings(t.Context()))
+		assert.Equal(t, 1, deliveries(mockAppChannel))
+		assert.Empty(t, b.PausedInputBindings())
+
+		require.NoError(t, b.PauseInputBinding(t.Context(), bindingName, false))
+		assert.Equal(t, []string{bindingName}, b.PausedInputBindings())
+		assert.NotContains(t, b.activeInputs, bindingName)
+
+		// Restarting the reads, such as when the app becomes healthy again,
+		// keeps the binding paused.
+		require.NoError(t, b.StartReadingFromBindings(t.Context()))
+		assert.Equal(t, 1, deliveries(mockAppChannel))
+
+		require.NoError(t, b.ResumeInputBinding(t.Context(), bindingName))
+		assert.Empty(t, b.PausedInputBindings())
+		assert.Contains(t, b.activeInputs, bindingName)
+		assert.Equal(t, 2, deliveries(mockAppChannel))
+	})
+
+	t.Run("persist requires the state store", func(t *testing.T) {
+		b, _ := newBinding(t, newCompStore(t), "")
+		require.Error(t, b.PauseInputBinding(t.Context(), bindingName, true))
+		assert.Empty(t, b.PausedInputBindings())
+
+		b, _ = newBinding(t, newCompStore(t), "nostore")
+		require.Error(t, b.PauseInputBinding(t.Context(), bindingName, true))
+		assert.Empty(t, b.PausedInputBindings())
+	})
+
+	t.Run("persisted pause survives restarts", func(t *testing.T) {
+		store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+		require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+
+		compStore := newCompStore(t)
+		compStore.AddStateStore("mystore", store)
+		b, _ := newBinding(t, compStore, "mystore")
+		require.NoError(t, b.PauseInputBinding(t.Context(), bindingName, true))
+
+		res, err := store.Get(t.Context(), &state.GetRequest{Key: "myapp||bindings-paused||" + bindingName})
+		require.NoError(t, err)
+		assert.NotEmpty(t, res.Data)
+
+		// A new sidecar doesn't read from the paused binding.
+		compStore = newCompStore(t)
+		compStore.AddStateStore("mystore", store)
+		b, mockAppChannel := newBinding(t, compStore, "mystore")
+		require.NoError(t, b.StartReadingFromBindings(t.Context()))
+		assert.Equal(t, []string{bindingName}, b.PausedInputBindings())
+		assert.Equal(t, 0, deliveries(mockAppChannel))
+
+		require.NoError(t, b.ResumeInputBinding(t.Context(), bindingName))
+		assert.Equal(t, 1, deliveries(mockAppChannel))
+		res, err = store.Get(t.Context(), &state.GetRequest{Key: "myapp||bindings-paused||" + bindingName})
+		require.NoError(t, err)
+		assert.Empty(t, res.Data)
+	})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+// route forwards the events of an input binding to a topic or an output
+// binding.
+type route struct {
+	spec      config.BindingRouteSpec
+	transform *template.Template
+}
+
+// routeTemplateData is the data the transform of a route is executed with.
+type routeTemplateData struct {
+	// Binding is the name of the input binding.
+	Binding string
+	// Data is the data of the event, decoded if it is JSON.
+	Data any
+	// Metadata is the metadata of the event.
+	Metadata map[string]string
+}
+
+var routeTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// routesOfBinding returns the routes of the events of the input binding, or
+// nil if its events are delivered to the app.
+func routesOfBinding(specs []config.BindingRouteSpec, bindingName string) ([]route, error) {
+	var routes []route
+	for _, spec := range specs {
+		if spec.Binding != bindingName {
+			continue
+		}
+
+		if spec.Name == "" {
+			return nil, errors.New("binding route name is required")
+		}
+		switch {
+		case spec.OutputBinding != "" && (spec.PubsubName != "" || spec.Topic != ""):
+			return nil, fmt.Errorf("binding route %q: only one of outputBinding and pubsubName can be set", spec.Name)
+		case spec.OutputBinding == "" && (spec.PubsubName == "" || spec.Topic == ""):
+			return nil, fmt.Errorf("binding route %q: either outputBinding or both pubsubName and topic are required", spec.Name)
+		}
+
+		r := route{spec: spec}
+		if r.spec.OutputBinding != "" && r.spec.Operation == "" {
+			r.spec.Operation = string(bindings.CreateOperation)
+		}
+		if spec.Transform != "" {
+			tmpl, err := template.New(spec.Name).
+				Option("missingkey=error").
+				Funcs(routeTemplateFuncs).
+				Parse(spec.Transform)
+			if err != nil {
+				return nil, fmt.Errorf("binding route %q: invalid transform: %w", spec.Name, err)
+			}
+			r.transform = tmpl
+		}
+		routes = append(routes, r)
+	}
+
+	return routes, nil
+}
+
+// routeBindingEvent forwards an event of an input binding to all its routes.
+// The event is acknowledged to the binding only if all the routes succeed, so
+// the routes which succeeded receive it again if the binding redelivers it.
+func (b *binding) routeBindingEvent(ctx context.Context, routes []route, bindingName string, data []byte, metadata map[string]string) ([]byte, error) {
+	var errs []error
+	for _, r := range routes {
+		if err := b.forwardBindingEvent(ctx, r, bindingName, data, metadata); err != nil {
+			errs = append(errs, fmt.Errorf("binding route %s: %w", r.spec.Name, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		log.Debugf("Error forwarding the event of input binding %s: %v", bindingName, err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (b *binding) forwardBindingEvent(ctx context.Context, r route, bindingName string, data []byte, metadata map[string]string) error {
+	if r.transform != nil {
+		var err error
+		data, err = renderRouteTransform(r.transform, bindingName, data, metadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.spec.OutputBinding != "" {
+		_, err := b.SendToOutputBinding(ctx, r.spec.OutputBinding, &bindings.InvokeRequest{
+			Data:      data,
+			Metadata:  metadata,
+			Operation: bindings.OperationKind(r.spec.Operation),
+		})
+		return err
+	}
+
+	return b.publishBindingEvent(ctx, bindingName, r.spec.PubsubName, r.spec.Topic, data, metadata)
+}
+
+// renderRouteTransform executes the transform of a route with the event.
+func renderRouteTransform(tmpl *template.Template, bindingName string, data []byte, metadata map[string]string) ([]byte, error) {
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		decoded = string(data)
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, routeTemplateData{
+		Binding:  bindingName,
+		Data:     decoded,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform the event: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
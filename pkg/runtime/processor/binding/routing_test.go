@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/components-contrib/bindings"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/dapr/pkg/runtime/meta"
+	rtmock "github.com/dapr/dapr/pkg/runtime/mock"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestRoutesOfBinding(t *testing.T) {
+	routes, err := routesOfBinding([]config.BindingRouteSpec{
+		{Name: "a", Binding: "orders", OutputBinding: "archive"},
+		{Name: "b", Binding: "other", PubsubName: "mypubsub", Topic: "other"},
+		{Name: "c", Binding: "orders", PubsubName: "mypubsub", Topic: "orders", Transform: `{{ .Data.id }}`},
+	}, "orders")
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+	assert.Equal(t, "a", routes[0].spec.Name)
+	assert.Equal(t, string(bindings.CreateOperation), routes[0].spec.Operation)
+	assert.Nil(t, routes[0].transform)
+	assert.Equal(t, "c", routes[1].spec.Name)
+	assert.NotNil(t, routes[1].transform)
+
+	routes, err = routesOfBinding(nil, "orders")
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+
+	for _, spec := range []config.BindingRouteSpec{
+		{Binding: "orders", OutputBinding: "archive"},
+		{Name: "a", Binding: "orders"},
+		{Name: "a", Binding: "orders", PubsubName: "mypubsub"},
+		{Name: "a", Binding: "orders", OutputBinding: "archive", Topic: "orders"},
+		{Name: "a", Binding: "orders", OutputBinding: "archive", Transform: `{{ .Data`},
+	} {
+		_, err = routesOfBinding([]config.BindingRouteSpec{spec}, "orders")
+		require.Error(t, err, spec)
+	}
+}
+
+func TestRouteBindingEvent(t *testing.T) {
+	const bindingName = "orders"
+
+	newBinding := func(t *testing.T, specs ...config.BindingRouteSpec) (*binding, []route) {
+		t.Helper()
+
+		b := New(Options{
+			Resiliency:     resiliency.New(log),
+			ComponentStore: compstore.New(),
+			Meta:           meta.New(meta.Options{}),
+			Routes:         specs,
+		})
+		b.channels = new(channels.Channels)
+		routes, err := routesOfBinding(specs, bindingName)
+		require.NoError(t, err)
+		return b, routes
+	}
+
+	t.Run("output binding with transform", func(t *testing.T) {
+		b, routes := newBinding(t, config.BindingRouteSpec{
+			Name:          "archive",
+			Binding:       bindingName,
+			OutputBinding: "archive",
+			Transform:     `{"order":{{ json .Data.id }},"source":"{{ .Binding }}","key":"{{ .Metadata.key }}"}`,
+		})
+		archive := new(daprt.MockBinding)
+		archive.On("Invoke", mock.Anything).Return(nil)
+		b.compStore.AddOutputBinding("archive", archive)
+
+		_, err := b.routeBindingEvent(t.Context(), routes, bindingName, []byte(`{"id":1}`), map[string]string{"key": "value"})
+		require.NoError(t, err)
+		archive.AssertCalled(t, "Invoke", &bindings.InvokeRequest{
+			Data:      []byte(`{"order":1,"source":"orders","key":"value"}`),
+			Metadata:  map[string]string{"key": "value"},
+			Operation: bindings.CreateOperation,
+		})
+	})
+
+	t.Run("topic", func(t *testing.T) {
+		b, routes := newBinding(t, config.BindingRouteSpec{
+			Name:       "ingest",
+			Binding:    bindingName,
+			PubsubName: "mypubsub",
+			Topic:      "orders",
+		})
+		var published *contribpubsub.PublishRequest
+		b.adapter = fake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			published = req
+			return nil
+		})
+
+		_, err := b.routeBindingEvent(t.Context(), routes, bindingName, []byte(`{"id":1}`), nil)
+		require.NoError(t, err)
+		require.NotNil(t, published)
+		assert.Equal(t, "mypubsub", published.PubsubName)
+		assert.Equal(t, "orders", published.Topic)
+
+		var ce map[string]any
+		require.NoError(t, json.Unmarshal(published.Data, &ce))
+		assert.Equal(t, bindingName, ce[contribpubsub.SourceField])
+		assert.Equal(t, map[string]any{"id": 1.0}, ce[contribpubsub.DataField])
+	})
+
+	t.Run("failed route fails the event", func(t *testing.T) {
+		b, routes := newBinding(t,
+			config.BindingRouteSpec{Name: "archive", Binding: bindingName, OutputBinding: "archive"},
+			config.BindingRouteSpec{Name: "ingest", Binding: bindingName, PubsubName: "mypubsub", Topic: "orders"},
+		)
+		archive := new(daprt.MockBinding)
+		archive.On("Invoke", mock.Anything).Return(nil)
+		b.compStore.AddOutputBinding("archive", archive)
+		b.adapter = fake.New().WithPublishFn(func(context.Context, *contribpubsub.PublishRequest) error {
+			return errors.New("unavailable")
+		})
+
+		_, err := b.routeBindingEvent(t.Context(), routes, bindingName, []byte(`{"id":1}`), nil)
+		require.ErrorContains(t, err, "binding route ingest: unavailable")
+		archive.AssertNumberOfCalls(t, "Invoke", 1)
+	})
+
+	t.Run("transform fails", func(t *testing.T) {
+		b, routes := newBinding(t, config.BindingRouteSpec{
+			Name:          "archive",
+			Binding:       bindingName,
+			OutputBinding: "archive",
+			Transform:     `{{ .Data.missing }}`,
+		})
+
+		_, err := b.routeBindingEvent(t.Context(), routes, bindingName, []byte(`{"id":1}`), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("routed binding is read without app channel", func(t *testing.T) {
+		b, routes := newBinding(t, config.BindingRouteSpec{
+			Name:          "archive",
+			Binding:       bindingName,
+			OutputBinding: "archive",
+		})
+		b.inputRoutes[bindingName] = routes
+		archive := new(daprt.MockBinding)
+		archive.On("Invoke", mock.Anything).Return(nil)
+		b.compStore.AddOutputBinding("archive", archive)
+		b.compStore.AddInputBinding(bindingName, new(rtmock.Binding))
+		require.NoError(t, b.compStore.AddPendingComponentForCommit(compapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: bindingName},
+			Spec:       compapi.ComponentSpec{Type: "bindings.test"},
+		}))
+		require.NoError(t, b.compStore.CommitPendingComponent(bindingName))
+
+		require.NoError(t, b.StartReadingFromBindings(t.Context()))
+		t.Cleanup(func() { b.StopReadingFromBindings(true) })
+		archive.AssertCalled(t, "Invoke", &bindings.InvokeRequest{
+			Data:      rtmock.TestInputBindingData,
+			Metadata:  map[string]string{},
+			Operation: bindings.CreateOperation,
+		})
+	})
+}
@@ -34,6 +34,7 @@ import (
 	componentsV1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	"github.com/dapr/dapr/pkg/components"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
@@ -53,9 +54,7 @@ func (b *binding) StartReadingFromBindings(ctx context.Context) error {
 
 	b.readingBindings = true
 
-	if b.channels.AppChannel() == nil {
-		return errors.New("app channel not initialized")
-	}
+	b.loadPausedInputBindings(ctx)
 
 	// Clean any previous state
 	var wg sync.WaitGroup
@@ -91,7 +90,10 @@ func (b *binding) StartReadingFromBindings(ctx context.Context) error {
 }
 
 func (b *binding) startInputBinding(comp componentsV1alpha1.Component, binding bindings.InputBinding) error {
-	var isSubscribed bool
+	if _, ok := b.paused[comp.Name]; ok {
+		log.Infof("Not reading from input binding %s: it is paused", comp.Name)
+		return nil
+	}
 
 	meta, err := b.meta.ToBaseMetadata(comp)
 	if err != nil {
@@ -100,31 +102,58 @@ func (b *binding) startInputBinding(comp componentsV1alpha1.Component, binding b
 
 	m := meta.Properties
 
-	if isBindingOfExplicitDirection(ComponentTypeInput, m) {
-		isSubscribed = true
+	// The events of the routed bindings are forwarded by the sidecar, so they
+	// are read regardless of the app.
+	handler := b.handleBindingEvent
+	if routes := b.inputRoutes[comp.Name]; len(routes) > 0 {
+		handler = func(ctx context.Context, bindingName string, data []byte, metadata map[string]string) ([]byte, error) {
+			return b.routeBindingEvent(ctx, routes, bindingName, data, metadata)
+		}
 	} else {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
-		defer cancel()
+		if b.channels.AppChannel() == nil {
+			log.Debugf("Not reading from input binding %s: app channel not initialized", comp.Name)
+			return nil
+		}
 
-		isSubscribed, err = b.isAppSubscribedToBinding(ctx, comp.Name)
-		if err != nil {
-			return err
+		if !b.channels.AppCallbackAllowed(config.AppCallbackBindings) {
+			log.Infof("Not reading from input binding %s: the bindings callbacks of the app are denied by the API configuration", comp.Name)
+			return nil
+		}
+
+		var isSubscribed bool
+		if isBindingOfExplicitDirection(ComponentTypeInput, m) {
+			isSubscribed = true
+		} else {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+			defer cancel()
+
+			isSubscribed, err = b.isAppSubscribedToBinding(ctx, comp.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !isSubscribed {
+			log.Infof("app has not subscribed to binding %s.", comp.Name)
+			return nil
 		}
 	}
 
-	if !isSubscribed {
-		log.Infof("app has not subscribed to binding %s.", comp.Name)
-		return nil
+	limits, err := deliveryLimitsFromMetadata(m)
+	if err != nil {
+		return err
 	}
 
 	input, err := input.Run(input.Options{
 		Name:    comp.Name,
 		Binding: binding,
-		Handler: b.sendBindingEventToApp,
+		Handler: handler,
 		// Read runs the binding directly on a long-lived loop rather than through
 		// a policy Runner, so decorate its context with the workload's SPIFFE
 		// identity just as the Runner does for other component operations.
 		DecorateContext: b.resiliency.ComponentContextDecorator(),
+		MaxConcurrency:  limits.maxConcurrency,
+		EventsPerSecond: limits.eventsPerSecond,
 	})
 	if err != nil {
 		log.Errorf("error reading from input binding %s: %s", comp.Name, err)
@@ -202,7 +231,7 @@ func (b *binding) SendToOutputBinding(ctx context.Context, name string, req *bin
 		ops := binding.Operations()
 		if slices.Contains(ops, req.Operation) {
 			policyRunner := resiliency.NewRunner[*bindings.InvokeResponse](ctx,
-				b.resiliency.ComponentOutboundPolicy(name, resiliency.Binding),
+				b.resiliency.ComponentOutboundOperationPolicy(name, resiliency.Binding, string(req.Operation)),
 			)
 
 			return policyRunner(func(ctx context.Context) (*bindings.InvokeResponse, error) {
@@ -234,7 +263,7 @@ func (b *binding) onAppResponse(ctx context.Context, response *bindings.AppRespo
 			}
 
 			err := stateLoader.PerformBulkStoreOperation(ctx, reqs,
-				b.resiliency.ComponentOutboundPolicy(response.StoreName, resiliency.Statestore),
+				b.resiliency.ComponentOutboundOperationPolicy(response.StoreName, resiliency.Statestore, resiliency.StateSet),
 				state.BulkStoreOpts{},
 				store.Set,
 				store.BulkSet,
@@ -331,7 +360,7 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 		start := time.Now()
 
 		policyRunner := resiliency.NewRunner[*runtimev1pb.BindingEventResponse](ctx,
-			b.resiliency.ComponentInboundPolicy(bindingName, resiliency.Binding),
+			b.resiliency.ComponentInboundOperationPolicy(bindingName, resiliency.Binding, path),
 		)
 		resp, err := policyRunner(func(ctx context.Context) (*runtimev1pb.BindingEventResponse, error) {
 			return client.OnBindingEvent(ctx, req)
@@ -379,7 +408,7 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 			}
 		}
 	} else {
-		policyDef := b.resiliency.ComponentInboundPolicy(bindingName, resiliency.Binding)
+		policyDef := b.resiliency.ComponentInboundOperationPolicy(bindingName, resiliency.Binding, path)
 
 		reqMetadata := make(map[string][]string, len(metadata))
 		for k, v := range metadata {
@@ -411,8 +440,12 @@ func (b *binding) sendBindingEventToApp(ctx context.Context, bindingName string,
 				return rResp, rErr
 			}
 
-			if rResp != nil && rResp.Status().GetCode() != http.StatusOK {
-				return rResp, resiliency.NewCodeError(rResp.Status().GetCode(), fmt.Errorf("%w, status %d", respErr, rResp.Status().GetCode()))
+			// Any status other than 2xx NACKs the event, like the final
+			// check of the response below, so it's retried consistently.
+			if rResp != nil {
+				if code := rResp.Status().GetCode(); code < 200 || code > 299 {
+					return rResp, resiliency.NewCodeError(code, fmt.Errorf("%w, status %d", respErr, code))
+				}
 			}
 
 			return rResp, nil
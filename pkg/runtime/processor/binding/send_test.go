@@ -169,7 +169,7 @@ func TestStartReadingFromBindings(t *testing.T) {
 				},
 			},
 		}))
-		require.NoError(t, b.compStore.CommitPendingComponent())
+		require.NoError(t, b.compStore.CommitPendingComponent("test"))
 		err := b.StartReadingFromBindings(t.Context())
 		require.NoError(t, err)
 		assert.True(t, mockAppChannel.AssertCalled(t, "InvokeMethod", mock.Anything, mock.Anything))
@@ -24,6 +24,7 @@ import (
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/runtime/processor/loops"
+	"github.com/dapr/dapr/pkg/runtime/processor/loops/instance"
 	"github.com/dapr/dapr/pkg/runtime/processor/loops/root"
 )
 
@@ -39,6 +40,32 @@ func (p *Processor) AddPendingComponent(ctx context.Context, comp compapi.Compon
 	return res
 }
 
+// SwapPendingComponent enqueues the init of a component replacing the
+// existing instance with the same name, which keeps serving until the new
+// instance is initialized and is closed once drained. Like
+// AddPendingComponent, it returns a buffered chan receiving exactly one error,
+// or nil if the processor is shut down.
+func (p *Processor) SwapPendingComponent(ctx context.Context, comp compapi.Component) <-chan error {
+	if p.closed.Load() || ctx.Err() != nil {
+		return nil
+	}
+	res := make(chan error, 1)
+	p.rootLoop.Loop().Enqueue(&loops.Init{Component: comp, Result: res, Swap: true})
+	return res
+}
+
+// CanSwap returns true if the existing component can be replaced by comp
+// without closing it first, which requires both to be of a category whose
+// manager swaps instances.
+func (p *Processor) CanSwap(existing, comp compapi.Component) bool {
+	cat := p.category(comp)
+	if cat == "" || cat != p.category(existing) {
+		return false
+	}
+	_, ok := p.inlineManagers[cat].(instance.Swapper)
+	return ok
+}
+
 // Init synchronously initialises a component. If Process is running, the
 // init is routed through the loop hierarchy and waits for the result;
 // otherwise the init runs inline. Tests that drive the processor without
@@ -121,15 +148,17 @@ func (p *Processor) runInlineInit(ctx context.Context, comp compapi.Component, m
 	if err := p.compStore.AddPendingComponentForCommit(comp); err != nil {
 		return err
 	}
+	start := time.Now()
 	if err := mgr.Init(p.security.WithSVIDContext(ctx), comp); err != nil {
-		if derr := p.compStore.DropPendingComponent(); derr != nil {
+		if derr := p.compStore.DropPendingComponent(comp.Name); derr != nil {
 			return errors.Join(err, derr)
 		}
 		return err
 	}
-	if err := p.compStore.CommitPendingComponent(); err != nil {
+	if err := p.compStore.CommitPendingComponent(comp.Name); err != nil {
 		return fmt.Errorf("error committing component: %w", err)
 	}
+	p.compStore.SetComponentInitDuration(comp.Name, time.Since(start))
 	return nil
 }
 
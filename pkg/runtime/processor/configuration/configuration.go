@@ -20,6 +20,7 @@ import (
 	contribconfig "github.com/dapr/components-contrib/configuration"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	compconfig "github.com/dapr/dapr/pkg/components/configuration"
+	"github.com/dapr/dapr/pkg/components/configuration/layered"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
@@ -53,7 +54,13 @@ func (c *configuration) Init(ctx context.Context, comp compapi.Component) error
 
 	fName := comp.LogName()
 
-	config, err := c.registry.Create(comp.Spec.Type, comp.Spec.Version, fName)
+	var config contribconfig.Store
+	var err error
+	if comp.Spec.Type == layered.ComponentType {
+		config = layered.New(comp.Name, c.compStore.GetConfiguration)
+	} else {
+		config, err = c.registry.Create(comp.Spec.Type, comp.Spec.Version, fName)
+	}
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "creation", comp.Name)
 		return rterrors.NewInit(rterrors.CreateComponentFailure, fName, err)
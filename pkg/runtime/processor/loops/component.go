@@ -25,14 +25,19 @@ import (
 // online; it suppresses double counting in the root's in-flight counter.
 // Timeout bounds the actual component init on the instance loop; when zero the
 // instance applies no deadline.
+// Swap is set when the component replaces the existing instance with the same
+// name, which keeps serving until the new instance is initialized. The
+// replaced instance is closed once DrainTimeout elapses.
 type Init struct {
 	*rootbase
 	*catbase
 	*instbase
-	Component compapi.Component
-	Result    chan<- error
-	Internal  bool
-	Timeout   time.Duration
+	Component    compapi.Component
+	Result       chan<- error
+	Internal     bool
+	Timeout      time.Duration
+	Swap         bool
+	DrainTimeout time.Duration
 }
 
 // Close asks the named instance to close a component. Result receives one
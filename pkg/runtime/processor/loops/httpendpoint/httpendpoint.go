@@ -114,7 +114,7 @@ func (h *Handler) processSecrets(ctx context.Context, endpoint *httpendpointsapi
 		Pairs:       []commonapi.NameValuePair{},
 	}
 
-	root, clientCert, clientKey := "root", "clientCert", "clientKey"
+	root, clientCert, clientKey, oauth2Secret := "root", "clientCert", "clientKey", "oauth2ClientSecret"
 
 	ca := commonapi.NameValuePair{Name: root}
 	if endpoint.HasTLSRootCA() {
@@ -146,6 +146,13 @@ func (h *Handler) processSecrets(ctx context.Context, endpoint *httpendpointsapi
 		tlsResource.Pairs = append(tlsResource.Pairs, cKey)
 	}
 
+	if endpoint.HasOAuth2ClientSecretRef() {
+		tlsResource.Pairs = append(tlsResource.Pairs, commonapi.NameValuePair{
+			Name:         oauth2Secret,
+			SecretKeyRef: *endpoint.Spec.OAuth2.ClientSecret.SecretKeyRef,
+		})
+	}
+
 	updated, _ := h.secret.ProcessResource(ctx, tlsResource)
 	if !updated {
 		return
@@ -170,6 +177,8 @@ func (h *Handler) processSecrets(ctx context.Context, endpoint *httpendpointsapi
 				endpoint.Spec.ClientTLS.PrivateKey = new(commonapi.TLSDocument)
 			}
 			endpoint.Spec.ClientTLS.PrivateKey.Value = dv
+		case oauth2Secret:
+			endpoint.Spec.OAuth2.ClientSecret.Value = dv
 		}
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -52,6 +53,15 @@ type PostInit interface {
 	StopInput(comp compapi.Component)
 }
 
+// Swapper is an optional capability for managers which can initialize a new
+// instance of a component while the existing instance with the same name
+// keeps serving. Swap replaces the existing instance only once the new one is
+// initialized, and returns the function closing the replaced instance, which
+// is nil when there was none. The existing instance is kept when Swap fails.
+type Swapper interface {
+	Swap(ctx context.Context, comp compapi.Component) (closeOld func() error, err error)
+}
+
 type Options struct {
 	Manager   Manager
 	CompStore *compstore.ComponentStore
@@ -78,6 +88,12 @@ type Instance struct {
 	// alsoStartInput indicates that successful Init should be immediately
 	// followed by a StartInput.
 	alsoStartInput bool
+
+	// drainCtx is cancelled on shutdown, closing the instances replaced by
+	// swaps without waiting for them to drain.
+	drainCtx    context.Context
+	drainCancel context.CancelFunc
+	drains      sync.WaitGroup
 }
 
 func New(opts Options) *Instance {
@@ -88,6 +104,7 @@ func New(opts Options) *Instance {
 		security:       opts.Security,
 		alsoStartInput: opts.AlsoStartInput,
 	}
+	i.drainCtx, i.drainCancel = context.WithCancel(context.Background())
 	i.loop = loops.InstanceFactory.NewLoop(i)
 	return i
 }
@@ -110,7 +127,10 @@ func (i *Instance) Handle(ctx context.Context, e loops.EventInstance) error {
 	case *loops.Shutdown:
 		// Components are closed by an explicit Close event from the caller
 		// (see processor.Close and the runtime shutdown path). The Shutdown
-		// sentinel exits the loop without performing component close.
+		// sentinel exits the loop without performing component close, other
+		// than of the instances replaced by swaps which are still draining.
+		i.drainCancel()
+		i.drains.Wait()
 	default:
 		log.Errorf("instance loop: unknown event type %T", ev)
 	}
@@ -128,7 +148,12 @@ func (i *Instance) handleInit(ctx context.Context, ev *loops.Init) {
 		initCtx, cancel = context.WithTimeout(ctx, ev.Timeout)
 		defer cancel()
 	}
-	initerr := i.runInit(initCtx, comp)
+	var initerr error
+	if ev.Swap {
+		initerr = i.runSwap(initCtx, comp, ev.DrainTimeout)
+	} else {
+		initerr = i.runInit(initCtx, comp)
+	}
 	// A timed-out init whose manager returned nil must still surface as an
 	// error, matching the legacy synchronous Init and the inline path. Checked
 	// against the init context the manager actually used.
@@ -153,18 +178,57 @@ func (i *Instance) runInit(ctx context.Context, comp compapi.Component) error {
 	if err := i.compStore.AddPendingComponentForCommit(comp); err != nil {
 		return err
 	}
+	start := time.Now()
 	if err := i.manager.Init(i.security.WithSVIDContext(ctx), comp); err != nil {
-		if derr := i.compStore.DropPendingComponent(); derr != nil {
+		if derr := i.compStore.DropPendingComponent(comp.Name); derr != nil {
 			return errors.Join(err, derr)
 		}
 		return err
 	}
-	if err := i.compStore.CommitPendingComponent(); err != nil {
+	if err := i.compStore.CommitPendingComponent(comp.Name); err != nil {
 		return fmt.Errorf("error committing component: %w", err)
 	}
+	i.compStore.SetComponentInitDuration(comp.Name, time.Since(start))
+	return nil
+}
+
+func (i *Instance) runSwap(ctx context.Context, comp compapi.Component, drainTimeout time.Duration) error {
+	swapper, ok := i.manager.(Swapper)
+	if !ok {
+		return fmt.Errorf("component %s can't be reloaded without closing it", comp.LogName())
+	}
+	start := time.Now()
+	closeOld, err := swapper.Swap(i.security.WithSVIDContext(ctx), comp)
+	if err != nil {
+		return err
+	}
+	i.compStore.ReplaceComponent(comp)
+	i.compStore.SetComponentInitDuration(comp.Name, time.Since(start))
+	if closeOld != nil {
+		i.drain(comp, closeOld, drainTimeout)
+	}
 	return nil
 }
 
+// drain closes the instance of the component replaced by a swap once the
+// operations in flight against it had the drain timeout to complete, or
+// straight away on shutdown.
+func (i *Instance) drain(comp compapi.Component, closeOld func() error, timeout time.Duration) {
+	i.drains.Go(func() {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-i.drainCtx.Done():
+		}
+		if err := closeOld(); err != nil {
+			log.Errorf("Error closing the replaced instance of component %s: %s", comp.LogName(), err)
+			return
+		}
+		log.Infof("Closed the replaced instance of component %s", comp.LogName())
+	})
+}
+
 func (i *Instance) handleClose(ev *loops.Close) {
 	comp := ev.Component
 	closeErr := i.manager.Close(comp)
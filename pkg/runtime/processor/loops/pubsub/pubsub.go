@@ -20,6 +20,8 @@ import (
 	"github.com/dapr/kit/events/loop"
 	"github.com/dapr/kit/logger"
 
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/processor/loops"
 	"github.com/dapr/dapr/pkg/runtime/processor/loops/instance"
@@ -35,23 +37,29 @@ type Manager interface {
 	instance.Manager
 }
 
+// SubscriptionReporter reports the result of loading a declarative
+// subscription.
+type SubscriptionReporter func(context.Context, subapi.Subscription, *operatorv1.ResourceResult) error
+
 type Options struct {
-	AppID      string
-	PubSub     Manager
-	Subscriber Subscriber
-	CompStore  *compstore.ComponentStore
-	Reporter   registry.Reporter
-	Security   security.Handler
+	AppID                string
+	PubSub               Manager
+	Subscriber           Subscriber
+	CompStore            *compstore.ComponentStore
+	Reporter             registry.Reporter
+	SubscriptionReporter SubscriptionReporter
+	Security             security.Handler
 }
 
 // Category is the pubsub category loop handler.
 type Category struct {
-	appID      string
-	pubsub     Manager
-	subscriber Subscriber
-	compStore  *compstore.ComponentStore
-	reporter   registry.Reporter
-	security   security.Handler
+	appID       string
+	pubsub      Manager
+	subscriber  Subscriber
+	compStore   *compstore.ComponentStore
+	reporter    registry.Reporter
+	subReporter SubscriptionReporter
+	security    security.Handler
 
 	instances map[string]loop.Interface[loops.EventInstance]
 
@@ -61,13 +69,14 @@ type Category struct {
 
 func New(opts Options) *Category {
 	c := &Category{
-		appID:      opts.AppID,
-		pubsub:     opts.PubSub,
-		subscriber: opts.Subscriber,
-		compStore:  opts.CompStore,
-		reporter:   opts.Reporter,
-		security:   opts.Security,
-		instances:  make(map[string]loop.Interface[loops.EventInstance]),
+		appID:       opts.AppID,
+		pubsub:      opts.PubSub,
+		subscriber:  opts.Subscriber,
+		compStore:   opts.CompStore,
+		reporter:    opts.Reporter,
+		subReporter: opts.SubscriptionReporter,
+		security:    opts.Security,
+		instances:   make(map[string]loop.Interface[loops.EventInstance]),
 	}
 	c.loop = loops.CategoryFactory.NewLoop(c)
 	return c
@@ -114,9 +123,9 @@ func (c *Category) Handle(ctx context.Context, e loops.EventCategory) error {
 	case *loops.InitProgrammaticSubscriptions:
 		sendResult(ev.Result, c.subscriber.InitProgramaticSubscriptions(ctx))
 	case *loops.SubscriptionAdd:
-		c.handleSubscriptionAdd(ev)
+		c.handleSubscriptionAdd(ctx, ev)
 	case *loops.SubscriptionClose:
-		c.handleSubscriptionClose(ev)
+		c.handleSubscriptionClose(ctx, ev)
 	case *loops.Shutdown:
 		shutdown := *ev
 		for name, inst := range c.instances {
@@ -14,22 +14,41 @@ limitations under the License.
 package pubsub
 
 import (
+	"context"
+	"fmt"
 	"slices"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/runtime/processor/loops"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
 
 // handleSubscriptionAdd processes a declarative subscription resource. It
 // filters by app scope, materialises the subscription spec, writes it to
-// compstore and reloads the matching pubsub via the subscriber.
-func (c *Category) handleSubscriptionAdd(ev *loops.SubscriptionAdd) {
+// compstore and reloads the matching pubsub via the subscriber, reporting the
+// result.
+func (c *Category) handleSubscriptionAdd(ctx context.Context, ev *loops.SubscriptionAdd) {
 	comp := ev.Subscription
 	if len(comp.Scopes) > 0 && !slices.Contains(comp.Scopes, c.appID) {
+		// The app may have been removed from the scopes of a subscription it
+		// loaded before.
+		c.reportSubscription(ctx, comp, operatorv1.EventType_EVENT_CLOSE, nil)
 		sendResult(ev.Result, nil)
 		return
 	}
 
+	err := c.addSubscription(comp)
+	c.reportSubscription(ctx, comp, operatorv1.EventType_EVENT_INIT, err)
+	sendResult(ev.Result, err)
+}
+
+// addSubscription materialises the subscription spec, writes it to compstore
+// and reloads the matching pubsub.
+func (c *Category) addSubscription(comp subapi.Subscription) error {
 	sub := rtpubsub.Subscription{
 		PubsubName:      comp.Spec.Pubsubname,
 		Topic:           comp.Spec.Topic,
@@ -45,28 +64,54 @@ func (c *Category) handleSubscriptionAdd(ev *loops.SubscriptionAdd) {
 	for _, rule := range comp.Spec.Routes.Rules {
 		erule, err := rtpubsub.CreateRoutingRule(rule.Match, rule.Path)
 		if err != nil {
-			sendResult(ev.Result, err)
-			return
+			return err
 		}
 		sub.Rules = append(sub.Rules, erule)
 	}
 	if len(comp.Spec.Routes.Default) > 0 {
 		sub.Rules = append(sub.Rules, &rtpubsub.Rule{Path: comp.Spec.Routes.Default})
 	}
+	if wf := comp.Spec.WorkflowEvent; wf != nil {
+		if err := validateWorkflowEvent(&comp); err != nil {
+			return err
+		}
+		sub.WorkflowEvent = &rtpubsub.WorkflowEvent{
+			EventName:       wf.EventName,
+			InstanceIDField: wf.InstanceIDField,
+		}
+		// Messages are not routed to the app, so every message is raised as
+		// an event unless rules select only some of them.
+		if len(sub.Rules) == 0 {
+			sub.Rules = append(sub.Rules, &rtpubsub.Rule{})
+		}
+	}
 
 	c.compStore.AddDeclarativeSubscription(&comp, sub)
 	if err := c.subscriber.ReloadDeclaredAppSubscription(comp.Name, comp.Spec.Pubsubname); err != nil {
 		c.compStore.DeleteDeclarativeSubscription(comp.Name)
-		sendResult(ev.Result, err)
-		return
+		return err
+	}
+	return nil
+}
+
+// validateWorkflowEvent returns an error if the workflow event of the
+// subscription is incomplete, or cannot be delivered.
+func validateWorkflowEvent(comp *subapi.Subscription) error {
+	wf := comp.Spec.WorkflowEvent
+	if wf.EventName == "" || wf.InstanceIDField == "" {
+		return fmt.Errorf("subscription %s: workflowEvent requires both eventName and instanceIDField", comp.Name)
+	}
+	if comp.Spec.BulkSubscribe.Enabled {
+		return fmt.Errorf("subscription %s: workflowEvent cannot be used with bulkSubscribe", comp.Name)
 	}
-	sendResult(ev.Result, nil)
+	return nil
 }
 
 // handleSubscriptionClose removes a declarative subscription resource and
 // reloads the matching pubsub via the subscriber.
-func (c *Category) handleSubscriptionClose(ev *loops.SubscriptionClose) {
+func (c *Category) handleSubscriptionClose(ctx context.Context, ev *loops.SubscriptionClose) {
 	sub := ev.Subscription
+	c.reportSubscription(ctx, sub, operatorv1.EventType_EVENT_CLOSE, nil)
 	if _, ok := c.compStore.GetDeclarativeSubscription(sub.Name); !ok {
 		sendResult(ev.Result, nil)
 		return
@@ -74,3 +119,32 @@ func (c *Category) handleSubscriptionClose(ev *loops.SubscriptionClose) {
 	c.compStore.DeleteDeclarativeSubscription(sub.Name)
 	sendResult(ev.Result, c.subscriber.ReloadDeclaredAppSubscription(sub.Name, sub.Spec.Pubsubname))
 }
+
+// reportSubscription reports the result of loading or closing the
+// subscription, mirroring the reporting of the components.
+func (c *Category) reportSubscription(ctx context.Context, sub subapi.Subscription, et operatorv1.EventType, opErr error) {
+	if c.subReporter == nil {
+		return
+	}
+	condition := operatorv1.ResourceConditionStatus_STATUS_SUCCESS
+	var reason, message *string
+	if opErr != nil {
+		condition = operatorv1.ResourceConditionStatus_STATUS_FAILURE
+		r := "ERROR"
+		m := opErr.Error()
+		reason = &r
+		message = &m
+	}
+	if err := c.subReporter(ctx, sub, &operatorv1.ResourceResult{
+		ResourceType:        operatorv1.ResourceType_RESOURCE_SUBSCRIPTION,
+		EventType:           et,
+		Name:                sub.GetName(),
+		Condition:           condition,
+		Reason:              reason,
+		Message:             message,
+		ObservedGeneration:  sub.GetGeneration(),
+		LastTransactionTime: timestamppb.New(time.Now()),
+	}); err != nil {
+		log.Errorf("error reporting subscription %s result: %s", et, err)
+	}
+}
@@ -19,7 +19,6 @@ import (
 	"time"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
-	"github.com/dapr/dapr/pkg/components"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/processor/loops"
 )
@@ -29,25 +28,37 @@ import (
 // processor's inline (test) init path applies the same default.
 const DefaultComponentInitTimeout = time.Second * 5
 
+// DefaultComponentDrainTimeout is the time given to the operations in flight
+// against a component instance replaced by a hot reload, for components whose
+// spec does not set a valid DrainTimeout.
+const DefaultComponentDrainTimeout = time.Second * 5
+
 func (r *Root) handleInit(ctx context.Context, ev *loops.Init) {
 	comp := ev.Component
 
 	// Preprocess: resolve secret refs on the component, detect unresolved
 	// secret-store dependencies.
 	_, unreadyStore := r.secret.ProcessResource(ctx, &comp)
+	if unreadyStore != "" && ev.Swap {
+		// The existing instance keeps serving until the secret store is ready
+		// and the component is reloaded again.
+		sendResult(ev.Result, fmt.Errorf("secret store %s of component %s is not ready", unreadyStore, comp.LogName()))
+		return
+	}
 	if unreadyStore != "" {
-		r.pendingDependents[unreadyStore] = append(r.pendingDependents[unreadyStore], comp)
-		// Defer indication: report success to caller (matches legacy semantics
-		// where AddPendingComponent returns true even when a component is
-		// queued behind an unready secret store).
-		sendResult(ev.Result, nil)
-		// An Internal re-enqueue was pre-counted in inFlight. The component is
-		// parked again behind another store, so release its slot now; it will
-		// be pre-counted again when that store completes. Without this the
-		// counter never returns to zero and barriers hang.
-		if ev.Internal {
-			r.decInFlight()
+		r.deferInit(ev, comp, unreadyStore)
+		return
+	}
+
+	// Components declaring InitAfter are held back until all the components
+	// they declare are initialized.
+	if dep := r.uninitializedDependency(comp); dep != "" {
+		if ev.Swap {
+			sendResult(ev.Result, fmt.Errorf("component %s which %s is initialized after is not ready", dep, comp.LogName()))
+			return
 		}
+		log.Infof("Deferring init of component %s until component %s is initialized", comp.LogName(), dep)
+		r.deferInit(ev, comp, dep)
 		return
 	}
 
@@ -74,15 +85,23 @@ func (r *Root) handleInit(ctx context.Context, ev *loops.Init) {
 		timeout = DefaultComponentInitTimeout
 	}
 
+	// A zero DrainTimeout closes the replaced instance straight away.
+	drainTimeout, err := time.ParseDuration(comp.Spec.DrainTimeout)
+	if err != nil || drainTimeout < 0 {
+		drainTimeout = DefaultComponentDrainTimeout
+	}
+
 	// Intercept the Result so we can flush dependents on a successful secret
 	// store init. The timeout is propagated so the instance loop bounds the
 	// actual component init with that deadline and synthesises a timeout error
 	// (including when a timed-out init returns nil) before replying here.
 	intercept := make(chan error, 1)
 	catLoop.Enqueue(&loops.Init{
-		Component: comp,
-		Result:    intercept,
-		Timeout:   timeout,
+		Component:    comp,
+		Result:       intercept,
+		Timeout:      timeout,
+		Swap:         ev.Swap,
+		DrainTimeout: drainTimeout,
 	})
 
 	if !ev.Internal {
@@ -120,6 +139,37 @@ func (r *Root) handleInit(ctx context.Context, ev *loops.Init) {
 	})
 }
 
+// deferInit parks the component until the component it depends on is
+// initialized.
+func (r *Root) deferInit(ev *loops.Init, comp compapi.Component, dependency string) {
+	r.pendingDependents[dependency] = append(r.pendingDependents[dependency], comp)
+	// Defer indication: report success to caller (matches legacy semantics
+	// where AddPendingComponent returns true even when a component is
+	// queued behind an unready secret store).
+	sendResult(ev.Result, nil)
+	// An Internal re-enqueue was pre-counted in inFlight. The component is
+	// parked again behind another dependency, so release its slot now; it will
+	// be pre-counted again when that dependency completes. Without this the
+	// counter never returns to zero and barriers hang.
+	if ev.Internal {
+		r.decInFlight()
+	}
+}
+
+// uninitializedDependency returns the first of the components the component
+// is initialized after which isn't initialized yet, if any.
+func (r *Root) uninitializedDependency(comp compapi.Component) string {
+	for _, dep := range comp.Spec.InitAfter {
+		if dep == "" || dep == comp.Name {
+			continue
+		}
+		if _, ok := r.compStore.GetComponent(dep); !ok {
+			return dep
+		}
+	}
+	return ""
+}
+
 func (r *Root) handleClose(_ context.Context, ev *loops.Close) {
 	comp := ev.Component
 	cat := r.category(comp)
@@ -142,13 +192,14 @@ func (r *Root) handleInstanceInitDone(ev *loops.InstanceInitDone) {
 		sendResult(ev.UserChan, ev.Err)
 	}
 
-	// If this was a secret store coming online, gather and re-enqueue any
-	// dependents. We pre-increment inFlight for each dependent so the
-	// Barrier does not see a transient zero between completion of the parent
-	// and dispatch of the dependents. The dependents are enqueued with
-	// Internal=true so handleInit does not double count.
+	// If this was a secret store, or a component others are initialized
+	// after, coming online, gather and re-enqueue any dependents. We
+	// pre-increment inFlight for each dependent so the Barrier does not see a
+	// transient zero between completion of the parent and dispatch of the
+	// dependents. The dependents are enqueued with Internal=true so handleInit
+	// does not double count.
 	var deps []compapi.Component
-	if ev.Err == nil && components.Category(ev.Category) == components.CategorySecretStore {
+	if ev.Err == nil {
 		deps = r.pendingDependents[ev.Name]
 		delete(r.pendingDependents, ev.Name)
 	}
@@ -15,16 +15,20 @@ package processor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
+	"github.com/dapr/components-contrib/state"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	outboxfake "github.com/dapr/dapr/pkg/outbox/fake"
 	rtmock "github.com/dapr/dapr/pkg/runtime/mock"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
@@ -106,3 +110,114 @@ func TestProcessorSecretStoreDependentReenqueue(t *testing.T) {
 	_, ok = proc.compStore.GetComponent("needs-secret")
 	assert.True(t, ok, "dependent must be processed once its secret store initialises")
 }
+
+type closeNotifyStateStore struct {
+	*daprt.MockStateStore
+	closed chan struct{}
+}
+
+func (s *closeNotifyStateStore) Close() error {
+	close(s.closed)
+	return nil
+}
+
+// TestProcessorSwapStateStore covers reloading a state store by swapping: the
+// existing instance keeps serving until the new one is initialized, and is
+// only closed once the drain timeout elapsed.
+func TestProcessorSwapStateStore(t *testing.T) {
+	proc, reg := newTestProc(func(opts *Options) {
+		opts.Outbox = outboxfake.New()
+	})
+	startProc(t, proc)
+
+	stores := make(chan *closeNotifyStateStore, 2)
+	reg.StateStores().RegisterComponent(
+		func(logger.Logger) state.Store {
+			store := &closeNotifyStateStore{MockStateStore: new(daprt.MockStateStore), closed: make(chan struct{})}
+			store.On("Init", mock.Anything).Return(nil)
+			stores <- store
+			return store
+		},
+		"mockState",
+	)
+
+	comp := componentsapi.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "statestore"},
+		Spec: componentsapi.ComponentSpec{
+			Type:         "state.mockState",
+			Version:      "v1",
+			DrainTimeout: "200ms",
+		},
+	}
+	ch := proc.AddPendingComponent(t.Context(), comp)
+	require.NotNil(t, ch)
+	require.NoError(t, <-ch)
+	first := <-stores
+
+	updated := comp.DeepCopy()
+	updated.Spec.Metadata = []commonapi.NameValuePair{{
+		Name:  "foo",
+		Value: commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(`"bar"`)}},
+	}}
+	require.True(t, proc.CanSwap(comp, *updated))
+	ch = proc.SwapPendingComponent(t.Context(), *updated)
+	require.NotNil(t, ch)
+	require.NoError(t, <-ch)
+	second := <-stores
+
+	got, ok := proc.compStore.GetStateStore("statestore")
+	require.True(t, ok)
+	assert.Same(t, second, got)
+	gotComp, ok := proc.compStore.GetComponent("statestore")
+	require.True(t, ok)
+	assert.Equal(t, updated.Spec.Metadata, gotComp.Spec.Metadata)
+
+	select {
+	case <-first.closed:
+		require.Fail(t, "replaced state store closed before the drain timeout")
+	case <-time.After(100 * time.Millisecond):
+	}
+	select {
+	case <-first.closed:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "replaced state store wasn't closed after the drain timeout")
+	}
+	select {
+	case <-second.closed:
+		require.Fail(t, "new state store must not be closed")
+	default:
+	}
+}
+
+// TestProcessorInitAfterDeferred covers components declaring InitAfter: the
+// component is parked until the components it is initialized after are, and
+// the root loop then processes it automatically.
+func TestProcessorInitAfterDeferred(t *testing.T) {
+	proc, reg := newTestProc()
+	startProc(t, proc)
+
+	mockPubSub := new(daprt.MockPubSub)
+	reg.PubSubs().RegisterComponent(
+		func(logger.Logger) pubsub.PubSub { return mockPubSub },
+		"mockPubSub",
+	)
+	mockPubSub.On("Init", mock.Anything).Return(nil)
+
+	dependent := inlinePubsubComp("dependent")
+	dependent.Spec.InitAfter = []string{"first"}
+
+	ch := proc.AddPendingComponent(t.Context(), dependent)
+	require.NotNil(t, ch)
+	require.NoError(t, <-ch)
+	require.NoError(t, proc.Flush(t.Context()))
+	_, ok := proc.compStore.GetComponent("dependent")
+	require.False(t, ok, "dependent must not be initialized before the components it is initialized after")
+
+	ch = proc.AddPendingComponent(t.Context(), inlinePubsubComp("first"))
+	require.NotNil(t, ch)
+	require.NoError(t, <-ch)
+	require.NoError(t, proc.Flush(t.Context()))
+
+	_, ok = proc.compStore.GetComponent("dependent")
+	assert.True(t, ok, "dependent must be initialized once the components it is initialized after are")
+}
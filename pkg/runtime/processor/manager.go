@@ -31,6 +31,7 @@ import (
 // Close are driven by the state category loop.
 type StateManager interface {
 	ActorStateStoreName() (string, bool)
+	SubscribeToCacheInvalidation(context.Context) error
 }
 
 // SecretManager exposes the secret sub-processor's ProcessResource read API
@@ -55,16 +56,23 @@ type SubscribeManager interface {
 	StopStreamerSubscription(sub *subapi.Subscription, connectionID rtpubsub.ConnectionID)
 	ReloadPubSub(string) error
 	StopPubSub(string)
+	PauseSubscription(pubsubName, topic string) error
+	ResumeSubscription(pubsubName, topic string) error
+	IsSubscriptionPaused(pubsubName, topic string) bool
 }
 
 // BindingManager exposes the binding sub-processor's read paths
 // (SendToOutputBinding) and category-wide operations
-// (StartReadingFromBindings, StopReadingFromBindings). Init and Close are
-// driven by the bindings category loop.
+// (StartReadingFromBindings, StopReadingFromBindings), and pauses and
+// resumes single input bindings. Init and Close are driven by the bindings
+// category loop.
 type BindingManager interface {
 	SendToOutputBinding(context.Context, string, *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
 	StartReadingFromBindings(context.Context) error
 	StopReadingFromBindings(forever bool)
+	PauseInputBinding(ctx context.Context, name string, persist bool) error
+	ResumeInputBinding(ctx context.Context, name string) error
+	PausedInputBindings() []string
 }
 
 type WorkflowBackendManager interface {
@@ -44,6 +44,7 @@ import (
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/outbox"
 	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
@@ -94,7 +95,9 @@ type Options struct {
 	Adapter                         rtpubsub.Adapter
 	AdapterStreamer                 rtpubsub.AdapterStreamer
 	Reporter                        registry.Reporter
+	SubscriptionReporter            looppubsub.SubscriptionReporter
 	ProgrammaticSubscriptionEnabled bool
+	RateLimiter                     *ratelimit.Limiter
 }
 
 // Processor manages the lifecycle of all components, HTTP endpoints, MCP
@@ -151,7 +154,8 @@ type Processor struct {
 	convCat   *category.Category
 
 	// inlineManagers is used by Init/Close when Process is not running
-	// (test-only path). The loop path never reads this map.
+	// (test-only path). The loop path never reads this map, other than to
+	// look up the capabilities of the managers.
 	inlineManagers map[components.Category]inlineManager
 
 	running atomic.Bool
@@ -182,9 +186,11 @@ func New(opts Options) *Processor {
 		Adapter:                         opts.Adapter,
 		AdapterStreamer:                 opts.AdapterStreamer,
 		ProgrammaticSubscriptionEnabled: opts.ProgrammaticSubscriptionEnabled,
+		RateLimiter:                     opts.RateLimiter,
 	})
 
 	stateProc := state.New(state.Options{
+		AppID:          opts.ID,
 		ActorsEnabled:  opts.ActorsEnabled,
 		Registry:       opts.Registry.StateStores(),
 		ComponentStore: opts.ComponentStore,
@@ -200,14 +206,18 @@ func New(opts Options) *Processor {
 	})
 
 	bindingProc := binding.New(binding.Options{
-		Registry:       opts.Registry.Bindings(),
-		ComponentStore: opts.ComponentStore,
-		Meta:           opts.Meta,
-		IsHTTP:         opts.IsHTTP,
-		Resiliency:     opts.Resiliency,
-		GRPC:           opts.GRPC,
-		TracingSpec:    opts.GlobalConfig.Spec.TracingSpec,
-		Channels:       opts.Channels,
+		AppID:           opts.ID,
+		Registry:        opts.Registry.Bindings(),
+		ComponentStore:  opts.ComponentStore,
+		Meta:            opts.Meta,
+		IsHTTP:          opts.IsHTTP,
+		Resiliency:      opts.Resiliency,
+		GRPC:            opts.GRPC,
+		TracingSpec:     opts.GlobalConfig.Spec.TracingSpec,
+		Channels:        opts.Channels,
+		Adapter:         opts.Adapter,
+		Routes:          opts.GlobalConfig.GetBindingRoutes(),
+		PauseStateStore: opts.GlobalConfig.GetBindingsSpec().PauseStateStore,
 	})
 
 	pubsubProc := pubsub.New(pubsub.Options{
@@ -292,12 +302,13 @@ func New(opts Options) *Processor {
 		Security:  opts.Security,
 	})
 	p.psCat = looppubsub.New(looppubsub.Options{
-		AppID:      opts.ID,
-		PubSub:     pubsubProc,
-		Subscriber: subscriberProc,
-		CompStore:  opts.ComponentStore,
-		Reporter:   reporter,
-		Security:   opts.Security,
+		AppID:                opts.ID,
+		PubSub:               pubsubProc,
+		Subscriber:           subscriberProc,
+		CompStore:            opts.ComponentStore,
+		Reporter:             reporter,
+		Security:             opts.Security,
+		SubscriptionReporter: opts.SubscriptionReporter,
 	})
 	p.confCat = category.New(category.Options{
 		Name:      string(components.CategoryConfiguration),
@@ -24,6 +24,7 @@ import (
 	"github.com/dapr/components-contrib/secretstores"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	compsecret "github.com/dapr/dapr/pkg/components/secretstores"
+	secretcache "github.com/dapr/dapr/pkg/components/secretstores/cache"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
@@ -77,6 +78,12 @@ func (s *secret) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
 	}
 
+	cacheOpts, err := secretcache.OptionsFromMetadata(comp.Name, meta.Properties)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	err = secretStore.Init(ctx, secretstores.Metadata{Base: meta})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
@@ -84,6 +91,10 @@ func (s *secret) Init(ctx context.Context, comp compapi.Component) error {
 	}
 
 	s.compStore.AddSecretStore(comp.Name, secretStore)
+	if cacheOpts != nil {
+		s.compStore.AddSecretStoreCache(comp.Name, secretcache.New(*cacheOpts))
+		log.Infof("Cache enabled for secret store '%s' with a TTL of %s", comp.Name, cacheOpts.TTL)
+	}
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 
 	return nil
@@ -18,6 +18,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/dapr/components-contrib/secretstores"
@@ -194,6 +195,53 @@ func TestProcessResourceSecrets(t *testing.T) {
 	})
 }
 
+func TestInitSecretStoreCache(t *testing.T) {
+	compStore := compstore.New()
+	sec := New(Options{
+		Registry:       registry.New(registry.NewOptions()).SecretStores(),
+		ComponentStore: compStore,
+		Meta: meta.New(meta.Options{
+			ID:   "test",
+			Mode: modes.StandaloneMode,
+		}),
+	})
+	sec.registry.RegisterComponent(
+		func(_ logger.Logger) secretstores.SecretStore {
+			return mock.NewMockKubernetesStore()
+		},
+		"mock",
+	)
+
+	component := func(name, ttl string) componentsapi.Component {
+		return componentsapi.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: componentsapi.ComponentSpec{
+				Type:    "secretstores.mock",
+				Version: "v1",
+				Metadata: []commonapi.NameValuePair{{
+					Name:  "cacheTTL",
+					Value: commonapi.DynamicValue{JSON: v1.JSON{Raw: []byte(ttl)}},
+				}},
+			},
+		}
+	}
+
+	require.NoError(t, sec.Init(t.Context(), component("cached", "5m")))
+	assert.NotNil(t, compStore.GetSecretStoreCache("cached"))
+
+	require.NoError(t, sec.Init(t.Context(), component("uncached", "0s")))
+	assert.Nil(t, compStore.GetSecretStoreCache("uncached"))
+
+	require.Error(t, sec.Init(t.Context(), component("invalid", "soon")))
+	_, ok := compStore.GetSecretStore("invalid")
+	assert.False(t, ok)
+
+	require.NoError(t, sec.Close(component("cached", "5m")))
+	assert.Nil(t, compStore.GetSecretStoreCache("cached"))
+}
+
 func TestIsEnvVarAllowed(t *testing.T) {
 	t.Run("no allowlist", func(t *testing.T) {
 		tests := []struct {
@@ -15,13 +15,19 @@ package state
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/secretstores"
 	contribstate "github.com/dapr/components-contrib/state"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	compstate "github.com/dapr/dapr/pkg/components/state"
+	statecache "github.com/dapr/dapr/pkg/components/state/cache"
+	statequery "github.com/dapr/dapr/pkg/components/state/query"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/encryption"
 	"github.com/dapr/dapr/pkg/outbox"
@@ -39,6 +45,7 @@ const (
 var log = logger.NewLogger("dapr.runtime.processor.state")
 
 type Options struct {
+	AppID          string
 	Registry       *compstate.Registry
 	ComponentStore *compstore.ComponentStore
 	Meta           *meta.Meta
@@ -47,6 +54,7 @@ type Options struct {
 }
 
 type state struct {
+	appID     string
 	registry  *compstate.Registry
 	compStore *compstore.ComponentStore
 	meta      *meta.Meta
@@ -55,15 +63,21 @@ type state struct {
 	actorStateStoreName *string
 	actorsEnabled       bool
 	outbox              outbox.Outbox
+
+	// keyRotations cancels the encryption key rotation of the state stores
+	// which rotate their keys.
+	keyRotations map[string]context.CancelFunc
 }
 
 func New(opts Options) *state {
 	return &state{
+		appID:         opts.AppID,
 		registry:      opts.Registry,
 		compStore:     opts.ComponentStore,
 		meta:          opts.Meta,
 		actorsEnabled: opts.ActorsEnabled,
 		outbox:        opts.Outbox,
+		keyRotations:  make(map[string]context.CancelFunc),
 	}
 }
 
@@ -71,6 +85,41 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	return s.init(ctx, comp)
+}
+
+// Swap initializes the state store, replacing the existing state store with
+// the same name only once initialized. It implements instance.Swapper.
+func (s *state) Swap(ctx context.Context, comp compapi.Component) (func() error, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	old, exists := s.compStore.GetStateStore(comp.Name)
+
+	// The key rotation of the existing state store is stopped once replaced.
+	oldRotation := s.keyRotations[comp.Name]
+	delete(s.keyRotations, comp.Name)
+
+	if err := s.init(ctx, comp); err != nil {
+		if cancel, ok := s.keyRotations[comp.Name]; ok {
+			cancel()
+		}
+		if oldRotation != nil {
+			s.keyRotations[comp.Name] = oldRotation
+		}
+		return nil, err
+	}
+
+	if oldRotation != nil {
+		oldRotation()
+	}
+	if !exists {
+		return nil, nil
+	}
+	return old.Close, nil
+}
+
+func (s *state) init(ctx context.Context, comp compapi.Component) error {
 	fName := comp.LogName()
 
 	store, err := s.registry.Create(comp.Spec.Type, comp.Spec.Version, fName)
@@ -99,6 +148,9 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 			log.Infof("Automatic encryption enabled for state store %s", comp.Name)
 			log.Info("WARNING: Automatic state store encryption should never be used to store more than 4 billion items in the state store (including updates). Storing more items than that can cause the private key to be exposed.")
 		}
+		if encKeys.RotationInterval > 0 {
+			s.startKeyRotation(comp, secretStore, encKeys.RotationInterval)
+		}
 	}
 
 	meta, err := s.meta.ToBaseMetadata(comp)
@@ -140,8 +192,6 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 		}
 	}
 
-	s.compStore.AddStateStore(comp.Name, store)
-
 	err = compstate.SaveStateConfiguration(comp.Name, props)
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
@@ -151,6 +201,37 @@ func (s *state) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, wrapError)
 	}
 
+	if compstate.QueryIndexEnabled(comp.Name) {
+		if _, ok := store.(contribstate.Querier); ok {
+			log.Infof("State store '%s' supports querying natively, so no query index is used for it", comp.Name)
+		} else if _, ok := store.(contribstate.KeysLiker); !ok && !encryption.EncryptedStateStore(comp.Name) {
+			// The keys of state stores which cannot list them are indexed as
+			// they are saved and deleted.
+			prefix, err := compstate.GetModifiedStateKey("", comp.Name, s.appID)
+			if err != nil {
+				diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
+				return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+			}
+			store = statequery.NewIndexedStore(store, prefix)
+			log.Infof("State store '%s' cannot list its keys, so the runtime maintains its query index", comp.Name)
+		}
+	}
+
+	cacheOpts, err := statecache.OptionsFromMetadata(comp.Name, props)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+	var cache *statecache.Cache
+	if cacheOpts != nil {
+		cache = statecache.New(*cacheOpts)
+		log.Infof("Read-through cache enabled for state store '%s' with a TTL of %s", comp.Name, cacheOpts.TTL)
+	}
+
+	// The state store is only added once initialized, so it atomically
+	// replaces the state store with the same name when swapped.
+	s.compStore.SetStateStore(comp.Name, store, cache)
+
 	s.outbox.AddOrUpdateOutbox(comp)
 
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
@@ -169,6 +250,11 @@ func (s *state) Close(comp compapi.Component) error {
 
 	defer s.compStore.DeleteStateStore(comp.Name)
 
+	if cancel, ok := s.keyRotations[comp.Name]; ok {
+		cancel()
+		delete(s.keyRotations, comp.Name)
+	}
+
 	err := ss.Close()
 	if err != nil {
 		return err
@@ -177,6 +263,79 @@ func (s *state) Close(comp compapi.Component) error {
 	return nil
 }
 
+// startKeyRotation reads the encryption keys of the state store from the
+// secret store at every interval until the state store is closed, so that the
+// state store encrypts with a rotated primary key and still decrypts with the
+// keys rotated out.
+func (s *state) startKeyRotation(comp compapi.Component, secretStore secretstores.SecretStore, interval time.Duration) {
+	if cancel, ok := s.keyRotations[comp.Name]; ok {
+		cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.keyRotations[comp.Name] = cancel
+
+	log.Infof("Encryption keys of state store %s are rotated every %s", comp.Name, interval)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			keys, err := encryption.RefreshComponentEncryptionKey(comp, secretStore)
+			if err != nil {
+				log.Warnf("Failed to read the encryption keys of state store %s for rotation: %v", comp.Name, err)
+				continue
+			}
+			if encryption.RotateEncryptedStateStore(comp.Name, keys) {
+				log.Infof("Rotated the primary encryption key of state store %s to %s", comp.Name, keys.Primary.Name)
+				diag.DefaultComponentMonitoring.StateEncryptionKeyRotated(ctx, comp.Name)
+			}
+		}
+	}()
+}
+
+// SubscribeToCacheInvalidation subscribes the read-through caches of the state
+// stores to their invalidation topics, to drop the keys written by other
+// sidecars. It is called once the components are loaded, as the pub/sub
+// components may be loaded after the state stores.
+func (s *state) SubscribeToCacheInvalidation(ctx context.Context) error {
+	for storeName, cache := range s.compStore.ListStateStoreCaches() {
+		pubsubName, topic := cache.InvalidationTopic()
+		if pubsubName == "" {
+			continue
+		}
+
+		ps, ok := s.compStore.GetPubSub(pubsubName)
+		if !ok {
+			log.Warnf("Could not subscribe to cache invalidation topic of state store %s: pubsub %s not loaded", storeName, pubsubName)
+			continue
+		}
+
+		err := ps.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+			Topic: topic,
+		}, func(_ context.Context, msg *contribpubsub.NewMessage) error {
+			var event statecache.InvalidationEvent
+			if err := json.Unmarshal(msg.Data, &event); err != nil {
+				log.Warnf("Dropping invalid cache invalidation event of state store %s: %v", storeName, err)
+				return nil
+			}
+			if event.StoreName == storeName {
+				cache.Invalidate(event.Keys...)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to cache invalidation topic of state store %s: %w", storeName, err)
+		}
+	}
+
+	return nil
+}
+
 func (s *state) ActorStateStoreName() (string, bool) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
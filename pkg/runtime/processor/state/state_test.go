@@ -25,9 +25,11 @@ import (
 
 	"github.com/dapr/components-contrib/metadata"
 	contribstate "github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
 	"github.com/dapr/dapr/pkg/apis/common"
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	statequery "github.com/dapr/dapr/pkg/components/state/query"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/encryption"
 	"github.com/dapr/dapr/pkg/modes"
@@ -140,6 +142,48 @@ func TestInitState(t *testing.T) {
 	})
 }
 
+func TestInitStateQueryIndex(t *testing.T) {
+	reg := registry.New(registry.NewOptions().WithStateStores(stateLoader.NewRegistry()))
+	reg.StateStores().RegisterComponent(
+		func(log logger.Logger) contribstate.Store {
+			// The in-memory state store, without listing its keys.
+			return struct{ contribstate.Store }{inmemory.NewInMemoryStateStore(log)}
+		},
+		"unlistedState",
+	)
+	compStore := compstore.New()
+	proc := processor.New(processor.Options{
+		ID:             "myapp",
+		Registry:       reg,
+		ComponentStore: compStore,
+		GlobalConfig:   new(config.Configuration),
+		Meta:           meta.New(meta.Options{ID: "myapp", Mode: modes.StandaloneMode}),
+		Security:       fake.New(),
+		Outbox:         outboxfake.New(),
+	})
+
+	require.NoError(t, proc.Init(t.Context(), compapi.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "indexed"},
+		Spec: compapi.ComponentSpec{
+			Type:    "state.unlistedState",
+			Version: "v1",
+			Metadata: []common.NameValuePair{{
+				Name:  "queryIndex",
+				Value: common.DynamicValue{JSON: apiextv1.JSON{Raw: []byte("true")}},
+			}},
+		},
+	}))
+
+	// The state store cannot list its keys, so they are indexed as saved.
+	store, ok := compStore.GetStateStore("indexed")
+	require.True(t, ok)
+	require.NoError(t, store.Set(t.Context(), &contribstate.SetRequest{Key: "myapp||a", Value: []byte(`1`)}))
+
+	keys, err := statequery.NewIndex(store, "myapp||").Keys(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"myapp||a"}, keys)
+}
+
 func initMockStateStoreForRegistry(reg *registry.Registry, name, encryptKey string, e error) *daprt.MockStateStore {
 	mockStateStore := new(daprt.MockStateStore)
 
@@ -24,11 +24,13 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/workflows"
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/config"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
@@ -38,6 +40,7 @@ import (
 	postmangrpc "github.com/dapr/dapr/pkg/runtime/subscription/postman/grpc"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman/http"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman/streaming"
+	postmanworkflow "github.com/dapr/dapr/pkg/runtime/subscription/postman/workflow"
 	"github.com/dapr/kit/logger"
 )
 
@@ -53,6 +56,7 @@ type Options struct {
 	Adapter                         rtpubsub.Adapter
 	AdapterStreamer                 rtpubsub.AdapterStreamer
 	ProgrammaticSubscriptionEnabled bool
+	RateLimiter                     *ratelimit.Limiter
 }
 
 type Subscriber struct {
@@ -66,6 +70,8 @@ type Subscriber struct {
 	compStore       *compstore.ComponentStore
 	adapter         rtpubsub.Adapter
 	adapterStreamer rtpubsub.AdapterStreamer
+	workflow        workflows.Workflow
+	rateLimiter     *ratelimit.Limiter
 
 	appSubs      map[string][]*namedSubscription
 	streamSubs   map[string]map[rtpubsub.ConnectionID]*namedSubscription
@@ -77,6 +83,9 @@ type Subscriber struct {
 	retryCtx                        map[string]context.Context
 	retryCancel                     map[string]context.CancelFunc
 	programmaticSubscriptionEnabled bool
+
+	// paused are the topics, by pubsub, whose app subscriptions are paused.
+	paused map[string]map[string]struct{}
 }
 
 type namedSubscription struct {
@@ -103,9 +112,20 @@ func New(opts Options) *Subscriber {
 		retryCtx:                        make(map[string]context.Context),
 		retryCancel:                     make(map[string]context.CancelFunc),
 		programmaticSubscriptionEnabled: opts.ProgrammaticSubscriptionEnabled,
+		rateLimiter:                     opts.RateLimiter,
+		paused:                          make(map[string]map[string]struct{}),
 	}
 }
 
+// SetWorkflow installs the workflow client used to raise the messages of
+// workflow event subscriptions as external events. Subscriptions started
+// before it is set fail and are retried.
+func (s *Subscriber) SetWorkflow(workflow workflows.Workflow) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.workflow = workflow
+}
+
 func (s *Subscriber) Run(ctx context.Context) error {
 	<-ctx.Done()
 	s.closed.Store(true)
@@ -266,7 +286,7 @@ func (s *Subscriber) ReloadDeclaredAppSubscription(name, pubsubName string) erro
 		return nil
 	}
 
-	if !rtpubsub.IsOperationAllowed(sub.Topic, ps, ps.ScopedSubscriptions) {
+	if !rtpubsub.IsOperationAllowed(sub.Topic, ps, ps.ScopedSubscriptions) || !s.deliverable(sub.NamedSubscription) || s.isPaused(pubsubName, sub.Topic) {
 		return nil
 	}
 
@@ -351,6 +371,10 @@ func (s *Subscriber) StartAppSubscriptions() error {
 
 	for name, ps := range s.compStore.ListPubSubs() {
 		for _, sub := range s.compStore.ListSubscriptionsAppByPubSub(name) {
+			if !s.deliverable(sub) || s.isPaused(name, sub.Topic) {
+				continue
+			}
+
 			ss, err := s.startSubscription(ps, sub, false)
 			if err != nil {
 				errs = append(errs, err)
@@ -390,6 +414,14 @@ func (s *Subscriber) retrySubscription(pubsubName string, pubsub *rtpubsub.Pubsu
 			return nil
 		}
 
+		s.lock.RLock()
+		paused := s.isPaused(pubsubName, sub.Topic)
+		s.lock.RUnlock()
+		if paused {
+			log.Debugf("Stopping retry for subscription pubsub %s, topic %s as it is paused", pubsubName, sub.Topic)
+			return nil
+		}
+
 		ss, err := s.startSubscription(pubsub, sub, false)
 		if err != nil {
 			log.Errorf("Retry failed for subscription pubsub %s, topic %s: %s. Will retry.", pubsubName, sub.Topic, err)
@@ -397,14 +429,15 @@ func (s *Subscriber) retrySubscription(pubsubName string, pubsub *rtpubsub.Pubsu
 		}
 
 		s.lock.Lock()
-		if !s.closed.Load() && s.appSubActive {
+		if !s.closed.Load() && s.appSubActive && !s.isPaused(pubsubName, sub.Topic) {
 			s.appSubs[pubsubName] = append(s.appSubs[pubsubName], &namedSubscription{
 				name:         sub.Name,
 				Subscription: ss,
 			})
 			log.Infof("Successfully started subscription after retry for pubsub %s, topic %s", pubsubName, sub.Topic)
 		} else {
-			// Subscriber was closed while we were retrying, stop the subscription
+			// Subscriber was closed, or the topic paused, while we were
+			// retrying, stop the subscription
 			ss.Stop()
 		}
 		s.lock.Unlock()
@@ -441,6 +474,114 @@ func (s *Subscriber) StopAppSubscriptions() {
 	s.appSubs = make(map[string][]*namedSubscription)
 }
 
+// PauseSubscription stops delivering the messages of the topic to the app,
+// until the subscription is resumed. The messages stay in the broker in the
+// meantime. Pausing a paused topic is a no-op.
+func (s *Subscriber) PauseSubscription(pubsubName, topic string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed.Load() {
+		return errors.New("subscriber is closed")
+	}
+
+	if !s.hasAppSubscription(pubsubName, topic) {
+		return fmt.Errorf("no subscription to topic %s on pubsub %s", topic, pubsubName)
+	}
+
+	if s.isPaused(pubsubName, topic) {
+		return nil
+	}
+
+	if s.paused[pubsubName] == nil {
+		s.paused[pubsubName] = make(map[string]struct{})
+	}
+	s.paused[pubsubName][topic] = struct{}{}
+
+	var wg sync.WaitGroup
+	subs := make([]*namedSubscription, 0, len(s.appSubs[pubsubName]))
+	for _, sub := range s.appSubs[pubsubName] {
+		if sub.Topic() != topic {
+			subs = append(subs, sub)
+			continue
+		}
+
+		wg.Add(1)
+		go func(sub *namedSubscription) {
+			sub.Stop()
+			wg.Done()
+		}(sub)
+	}
+
+	wg.Wait()
+
+	s.appSubs[pubsubName] = subs
+
+	log.Infof("Paused subscription to topic %s on pubsub %s", topic, pubsubName)
+
+	return nil
+}
+
+// ResumeSubscription resumes delivering the messages of the paused topic to
+// the app. Resuming a topic which isn't paused is a no-op.
+func (s *Subscriber) ResumeSubscription(pubsubName, topic string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed.Load() {
+		return errors.New("subscriber is closed")
+	}
+
+	if !s.isPaused(pubsubName, topic) {
+		return nil
+	}
+
+	delete(s.paused[pubsubName], topic)
+	if len(s.paused[pubsubName]) == 0 {
+		delete(s.paused, pubsubName)
+	}
+
+	log.Infof("Resumed subscription to topic %s on pubsub %s", topic, pubsubName)
+
+	ps, ok := s.compStore.GetPubSub(pubsubName)
+	if !s.appSubActive || !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, sub := range s.compStore.ListSubscriptionsAppByPubSub(pubsubName) {
+		if sub.Topic != topic || !s.deliverable(sub) {
+			continue
+		}
+
+		ss, err := s.startSubscription(ps, sub, false)
+		if err != nil {
+			log.Errorf("Failed to resume subscription for pubsub %s, topic %s: %s", pubsubName, topic, err)
+			errs = append(errs, fmt.Errorf("failed to create subscription for %s: %s", pubsubName, err))
+
+			go s.retrySubscription(pubsubName, ps, sub)
+
+			continue
+		}
+
+		s.appSubs[pubsubName] = append(s.appSubs[pubsubName], &namedSubscription{
+			name:         sub.Name,
+			Subscription: ss,
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+// IsSubscriptionPaused returns true if the app subscriptions to the topic are
+// paused.
+func (s *Subscriber) IsSubscriptionPaused(pubsubName, topic string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.isPaused(pubsubName, topic)
+}
+
 func (s *Subscriber) InitProgramaticSubscriptions(ctx context.Context) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -522,6 +663,10 @@ func (s *Subscriber) reloadPubSubApp(name string, pubsub *rtpubsub.PubsubItem) e
 	subs := make([]*namedSubscription, 0, len(appSubs))
 
 	for _, sub := range appSubs {
+		if !s.deliverable(sub) || s.isPaused(name, sub.Topic) {
+			continue
+		}
+
 		ss, err := s.startSubscription(pubsub, sub, false)
 		if err != nil {
 			log.Errorf("Failed to reload subscription for pubsub %s, topic %s: %s", name, sub.Topic, err)
@@ -561,6 +706,11 @@ func (s *Subscriber) initProgrammaticSubscriptions(ctx context.Context) error {
 
 	s.hasInitProg = true
 
+	if !s.channels.AppCallbackAllowed(config.AppCallbackSubscribe) {
+		log.Warn("Skipping programmatic subscription loading: the subscribe callbacks of the app are denied by the API configuration")
+		return nil
+	}
+
 	if !s.programmaticSubscriptionEnabled {
 		log.Warn("Skipping programmatic subscription loading (see 'disable-init-endpoints' flag/annotation)")
 		return nil
@@ -604,6 +754,29 @@ func (s *Subscriber) initProgrammaticSubscriptions(ctx context.Context) error {
 	return nil
 }
 
+func (s *Subscriber) isPaused(pubsubName, topic string) bool {
+	_, ok := s.paused[pubsubName][topic]
+	return ok
+}
+
+func (s *Subscriber) hasAppSubscription(pubsubName, topic string) bool {
+	for _, sub := range s.compStore.ListSubscriptionsAppByPubSub(pubsubName) {
+		if sub.Topic == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// deliverable returns true if the messages of the app subscription have
+// somewhere to go. Workflow event subscriptions are raised on workflow
+// instances, so do not need the app channel, nor the subscribe callbacks of
+// the app to be allowed.
+func (s *Subscriber) deliverable(sub *compstore.NamedSubscription) bool {
+	return sub.WorkflowEvent != nil ||
+		(s.channels.AppChannel() != nil && s.channels.AppCallbackAllowed(config.AppCallbackSubscribe))
+}
+
 func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compstore.NamedSubscription, isStreamer bool) (*subscription.Subscription, error) {
 	// TODO: @joshvanl
 	var (
@@ -611,26 +784,33 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 		streamer rtpubsub.AdapterStreamer
 	)
 
-	if isStreamer {
+	switch {
+	case comp.WorkflowEvent != nil && !isStreamer:
+		if s.workflow == nil {
+			return nil, fmt.Errorf("workflow engine is not available for workflow event subscription to topic %s", comp.Topic)
+		}
+		postman = postmanworkflow.New(postmanworkflow.Options{
+			Workflow: s.workflow,
+			Event:    comp.WorkflowEvent,
+		})
+	case isStreamer:
 		streamer = s.adapterStreamer
 		postman = streaming.New(streaming.Options{
 			Tracing: s.tracingSpec,
 			Channel: s.adapterStreamer,
 		})
-	} else {
-		if s.isHTTP {
-			postman = http.New(http.Options{
-				Channels: s.channels,
-				Tracing:  s.tracingSpec,
-				Adapter:  s.adapter,
-			})
-		} else {
-			postman = postmangrpc.New(postmangrpc.Options{
-				Channel: s.grpc,
-				Tracing: s.tracingSpec,
-				Adapter: s.adapter,
-			})
-		}
+	case s.isHTTP:
+		postman = http.New(http.Options{
+			Channels: s.channels,
+			Tracing:  s.tracingSpec,
+			Adapter:  s.adapter,
+		})
+	default:
+		postman = postmangrpc.New(postmangrpc.Options{
+			Channel: s.grpc,
+			Tracing: s.tracingSpec,
+			Adapter: s.adapter,
+		})
 	}
 
 	return subscription.New(subscription.Options{
@@ -647,6 +827,7 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 		AdapterStreamer: streamer,
 		ConnectionID:    comp.ConnectionID,
 		Postman:         postman,
+		RateLimiter:     s.rateLimiter,
 	})
 }
 
@@ -34,6 +34,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	wffake "github.com/dapr/dapr/pkg/runtime/wfengine/fake"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 )
@@ -612,6 +613,66 @@ func TestReloadPubSubStartsAllListedSubscriptions(t *testing.T) {
 	subs.StopAllSubscriptionsForever()
 }
 
+func TestWorkflowEventSubscriptionsWithoutAppChannel(t *testing.T) {
+	mockPubSub := new(daprt.InMemoryPubsub)
+
+	mockPubSub.On("Init", mock.Anything).Return(nil)
+	require.NoError(t, mockPubSub.Init(t.Context(), contribpubsub.Metadata{}))
+
+	var subscribedTopics []string
+	mockPubSub.
+		On("Subscribe", mock.AnythingOfType("pubsub.SubscribeRequest"), mock.AnythingOfType("pubsub.Handler")).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			req := args.Get(0).(contribpubsub.SubscribeRequest)
+			subscribedTopics = append(subscribedTopics, req.Topic)
+		})
+	mockPubSub.On("unsubscribed", mock.Anything).Return(nil)
+
+	compStore := compstore.New()
+	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{Component: mockPubSub})
+	addAppSub := func(name, topic string, wf *rtpubsub.WorkflowEvent) {
+		compStore.AddDeclarativeSubscription(&subapi.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: subapi.SubscriptionSpec{
+				Pubsubname: TestPubsubName,
+				Topic:      topic,
+			},
+		}, rtpubsub.Subscription{
+			PubsubName:    TestPubsubName,
+			Topic:         topic,
+			Rules:         []*rtpubsub.Rule{{Path: "/"}},
+			WorkflowEvent: wf,
+		})
+	}
+
+	addAppSub("app-sub", "app-topic", nil)
+	addAppSub("workflow-sub", "workflow-topic", &rtpubsub.WorkflowEvent{
+		EventName:       "approval",
+		InstanceIDField: "subject",
+	})
+
+	subs := New(Options{
+		CompStore:  compStore,
+		IsHTTP:     true,
+		Resiliency: resiliency.New(logger.NewLogger("test")),
+		Namespace:  "ns1",
+		AppID:      TestRuntimeConfigID,
+		Channels:   new(channels.Channels),
+	})
+	t.Cleanup(subs.StopAllSubscriptionsForever)
+	subs.SetWorkflow(wffake.NewClient())
+
+	require.NoError(t, subs.StartAppSubscriptions())
+	require.Len(t, subs.appSubs[TestPubsubName], 1)
+	assert.Equal(t, []string{"workflow-topic"}, subscribedTopics)
+
+	require.NoError(t, subs.ReloadDeclaredAppSubscription("app-sub", TestPubsubName))
+	require.Len(t, subs.appSubs[TestPubsubName], 1)
+
+	subs.StopAllSubscriptionsForever()
+}
+
 func TestSubscriptionRetryMechanisms(t *testing.T) {
 	createMockSetup := func() (*daprt.InMemoryPubsub, *compstore.ComponentStore) {
 		mockPubSub := new(daprt.InMemoryPubsub)
@@ -978,3 +1039,81 @@ func TestProgrammaticSubscriptionEnabled(t *testing.T) {
 		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
 	})
 }
+
+func TestPauseSubscription(t *testing.T) {
+	mockPubSub := new(daprt.InMemoryPubsub)
+	mockPubSub.On("Init", mock.Anything).Return(nil)
+	mockPubSub.On("Subscribe", mock.AnythingOfType("pubsub.SubscribeRequest"), mock.AnythingOfType("pubsub.Handler")).Return(nil)
+	mockPubSub.On("unsubscribed", mock.Anything).Return(nil)
+	require.NoError(t, mockPubSub.Init(t.Context(), contribpubsub.Metadata{}))
+
+	compStore := compstore.New()
+	compStore.AddPubSub(TestPubsubName, &rtpubsub.PubsubItem{Component: mockPubSub})
+	compStore.SetProgramaticSubscriptions(
+		rtpubsub.Subscription{
+			PubsubName: TestPubsubName,
+			Topic:      "topic1",
+			Rules:      []*rtpubsub.Rule{{Path: "/"}},
+		},
+		rtpubsub.Subscription{
+			PubsubName: TestPubsubName,
+			Topic:      "topic2",
+			Rules:      []*rtpubsub.Rule{{Path: "/"}},
+		},
+	)
+
+	subs := New(Options{
+		CompStore:  compStore,
+		IsHTTP:     true,
+		Resiliency: resiliency.New(logger.NewLogger("test")),
+		Namespace:  "ns1",
+		AppID:      TestRuntimeConfigID,
+		Channels:   new(channels.Channels).WithAppChannel(new(channelt.MockAppChannel)),
+	})
+	t.Cleanup(subs.StopAllSubscriptionsForever)
+	subs.hasInitProg = true
+
+	var gotTopics atomic.Value
+	mockPubSub.SetOnSubscribedTopicsChanged(func(topics []string) {
+		slices.Sort(topics)
+		gotTopics.Store(topics)
+	})
+
+	require.NoError(t, subs.StartAppSubscriptions())
+	assert.Equal(t, []string{"topic1", "topic2"}, gotTopics.Load())
+
+	t.Run("pausing a topic stops its subscription", func(t *testing.T) {
+		require.NoError(t, subs.PauseSubscription(TestPubsubName, "topic1"))
+		assert.True(t, subs.IsSubscriptionPaused(TestPubsubName, "topic1"))
+		assert.False(t, subs.IsSubscriptionPaused(TestPubsubName, "topic2"))
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, []string{"topic2"}, gotTopics.Load())
+		}, time.Second*5, time.Millisecond*10)
+
+		require.NoError(t, subs.PauseSubscription(TestPubsubName, "topic1"))
+	})
+
+	t.Run("pausing a topic without subscription fails", func(t *testing.T) {
+		require.Error(t, subs.PauseSubscription(TestPubsubName, "topic3"))
+		require.Error(t, subs.PauseSubscription("unknown", "topic1"))
+		assert.False(t, subs.IsSubscriptionPaused(TestPubsubName, "topic3"))
+	})
+
+	t.Run("paused topics stay paused when the app subscriptions are restarted", func(t *testing.T) {
+		subs.StopAppSubscriptions()
+		require.NoError(t, subs.StartAppSubscriptions())
+		assert.Equal(t, []string{"topic2"}, gotTopics.Load())
+
+		require.NoError(t, subs.ReloadPubSub(TestPubsubName))
+		assert.Equal(t, []string{"topic2"}, gotTopics.Load())
+	})
+
+	t.Run("resuming a topic starts its subscription", func(t *testing.T) {
+		require.NoError(t, subs.ResumeSubscription(TestPubsubName, "topic1"))
+		assert.False(t, subs.IsSubscriptionPaused(TestPubsubName, "topic1"))
+		assert.Equal(t, []string{"topic1", "topic2"}, gotTopics.Load())
+
+		require.NoError(t, subs.ResumeSubscription(TestPubsubName, "topic1"))
+		assert.Equal(t, []string{"topic1", "topic2"}, gotTopics.Load())
+	})
+}
@@ -16,6 +16,7 @@ package processor
 import (
 	"context"
 
+	"github.com/dapr/components-contrib/workflows"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/runtime/processor/loops"
 )
@@ -53,3 +54,10 @@ func (p *Processor) CloseSubscription(ctx context.Context, sub *subapi.Subscript
 		return err
 	}
 }
+
+// SetWorkflow installs the workflow client used to raise the messages of
+// workflow event subscriptions as external events. Called once the workflow
+// engine has been created.
+func (p *Processor) SetWorkflow(workflow workflows.Workflow) {
+	p.subscriber.SetWorkflow(workflow)
+}
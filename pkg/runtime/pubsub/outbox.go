@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -28,6 +29,7 @@ import (
 	contribPubsub "github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/outbox"
 	"github.com/dapr/kit/logger"
 	kitstrings "github.com/dapr/kit/strings"
@@ -38,6 +40,9 @@ const (
 	outboxPublishTopicKey            = "outboxPublishTopic"
 	outboxPubsubKey                  = "outboxPubsub"
 	outboxDiscardWhenMissingStateKey = "outboxDiscardWhenMissingState"
+	outboxRoutesKey                  = "outboxRoutes"
+	outboxPayloadTemplateKey         = "outboxPayloadTemplate"
+	outboxRoutePubsubField           = "outboxpublishpubsub"
 	outboxStatePrefix                = "outbox"
 	defaultStateScanDelay            = time.Second * 1
 )
@@ -49,6 +54,76 @@ type outboxConfig struct {
 	publishTopic                  string
 	outboxPubsub                  string
 	outboxDiscardWhenMissingState bool
+	routes                        []outboxRoute
+	payloadTemplate               *template.Template
+}
+
+// outboxRoute routes the messages of the operations matching its key prefix
+// and metadata to a topic, instead of the publish topic of the state store.
+type outboxRoute struct {
+	KeyPrefix string            `json:"keyPrefix,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Pubsub    string            `json:"pubsub,omitempty"`
+	Topic     string            `json:"topic"`
+}
+
+// matches returns true if the operation of the key matches the route. The
+// metadata of the route is matched against the metadata of the operation,
+// falling back to the metadata of the transaction.
+func (r outboxRoute) matches(key string, opMetadata, txMetadata map[string]string) bool {
+	if !strings.HasPrefix(stateLoader.GetOriginalStateKey(key), r.KeyPrefix) {
+		return false
+	}
+
+	for k, v := range r.Metadata {
+		val, ok := opMetadata[k]
+		if !ok {
+			val, ok = txMetadata[k]
+		}
+		if !ok || val != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// destination returns the pubsub and topic the message of the operation of
+// the key is published to, which is the first matching route or the publish
+// topic of the state store.
+func (c outboxConfig) destination(key string, opMetadata, txMetadata map[string]string) (string, string, error) {
+	for _, r := range c.routes {
+		if r.matches(key, opMetadata, txMetadata) {
+			if r.Pubsub == "" {
+				return c.publishPubSub, r.Topic, nil
+			}
+			return r.Pubsub, r.Topic, nil
+		}
+	}
+
+	if c.publishTopic == "" {
+		return "", "", fmt.Errorf("no outbox route matches key %s and no outbox publish topic is configured", key)
+	}
+
+	return c.publishPubSub, c.publishTopic, nil
+}
+
+func parseOutboxRoutes(val string) ([]outboxRoute, error) {
+	var routes []outboxRoute
+	if err := json.Unmarshal([]byte(val), &routes); err != nil {
+		return nil, err
+	}
+
+	for i, r := range routes {
+		if r.Topic == "" {
+			return nil, fmt.Errorf("route %d has no topic", i)
+		}
+		if r.KeyPrefix == "" && len(r.Metadata) == 0 {
+			return nil, fmt.Errorf("route %d has neither a key prefix nor metadata to match", i)
+		}
+	}
+
+	return routes, nil
 }
 
 type outboxImpl struct {
@@ -103,6 +178,8 @@ func (o *outboxImpl) AddOrUpdateOutbox(stateStore v1alpha1.Component) {
 	var (
 		publishPubSub, publishTopicKey, outboxPubsub string
 		outboxDiscardWhenMissingState                bool
+		routes                                       []outboxRoute
+		payloadTemplate                              *template.Template
 	)
 
 	for _, v := range stateStore.Spec.Metadata {
@@ -115,10 +192,24 @@ func (o *outboxImpl) AddOrUpdateOutbox(stateStore v1alpha1.Component) {
 			outboxPubsub = v.Value.String()
 		case outboxDiscardWhenMissingStateKey:
 			outboxDiscardWhenMissingState = kitstrings.IsTruthy(v.Value.String())
+		case outboxRoutesKey:
+			var err error
+			routes, err = parseOutboxRoutes(v.Value.String())
+			if err != nil {
+				outboxLogger.Errorf("outbox is disabled for state store %s: invalid %s: %s", stateStore.Name, outboxRoutesKey, err)
+				return
+			}
+		case outboxPayloadTemplateKey:
+			var err error
+			payloadTemplate, err = parseOutboxPayloadTemplate(v.Value.String())
+			if err != nil {
+				outboxLogger.Errorf("outbox is disabled for state store %s: invalid %s: %s", stateStore.Name, outboxPayloadTemplateKey, err)
+				return
+			}
 		}
 	}
 
-	if publishPubSub != "" && publishTopicKey != "" {
+	if publishPubSub != "" && (publishTopicKey != "" || len(routes) > 0) {
 		o.lock.Lock()
 		defer o.lock.Unlock()
 
@@ -131,6 +222,8 @@ func (o *outboxImpl) AddOrUpdateOutbox(stateStore v1alpha1.Component) {
 			publishTopic:                  publishTopicKey,
 			outboxPubsub:                  outboxPubsub,
 			outboxDiscardWhenMissingState: outboxDiscardWhenMissingState,
+			routes:                        routes,
+			payloadTemplate:               payloadTemplate,
 		}
 	}
 }
@@ -158,7 +251,7 @@ func transaction() (state.TransactionalStateOperation, error) {
 }
 
 // PublishInternal publishes the state to an internal topic for outbox processing and returns the updated list of transactions
-func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, operations []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
+func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, operations []state.TransactionalStateOperation, txMetadata map[string]string, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
 	o.lock.RLock()
 	c, ok := o.outboxStores[stateStore]
 	o.lock.RUnlock()
@@ -191,6 +284,11 @@ func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, ope
 				return nil, err
 			}
 
+			publishPubsub, publishTopic, err := c.destination(sr.Key, sr.Metadata, txMetadata)
+			if err != nil {
+				return nil, err
+			}
+
 			var (
 				payload     any
 				contentType string
@@ -206,6 +304,12 @@ func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, ope
 				}
 			} else {
 				payload = sr.Value
+				if c.payloadTemplate != nil {
+					payload, err = renderOutboxPayload(c.payloadTemplate, sr)
+					if err != nil {
+						return nil, err
+					}
+				}
 
 				if sr.ContentType != nil {
 					contentType = *sr.ContentType
@@ -235,11 +339,12 @@ func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, ope
 				dataContentType = contentType
 			}
 
-			ce := contribPubsub.NewCloudEventsEnvelope(tr.GetKey(), source, "", "", "", c.outboxPubsub, dataContentType, ceData, "", traceState)
+			ce := contribPubsub.NewCloudEventsEnvelope(tr.GetKey(), source, "", "", publishTopic, c.outboxPubsub, dataContentType, ceData, "", traceState)
 			ce[contribPubsub.TraceIDField] = traceID
+			ce[outboxRoutePubsubField] = publishPubsub
 
 			for k, v := range op.GetMetadata() {
-				if k == contribPubsub.DataField || k == contribPubsub.IDField {
+				if k == contribPubsub.DataField || k == contribPubsub.IDField || k == contribPubsub.TopicField || k == outboxRoutePubsubField {
 					continue
 				}
 
@@ -299,6 +404,17 @@ func (o *outboxImpl) SubscribeToInternalTopics(ctx context.Context, appID string
 
 			stateKey := o.cloudEventExtractorFn(cloudEvent, contribPubsub.IDField)
 
+			// Messages published before routes were supported carry no
+			// destination, and are published to the topic of the state store.
+			publishPubsub, publishTopic := c.publishPubSub, c.publishTopic
+			if topic := o.cloudEventExtractorFn(cloudEvent, contribPubsub.TopicField); topic != "" {
+				publishTopic = topic
+				if pubsub := o.cloudEventExtractorFn(cloudEvent, outboxRoutePubsubField); pubsub != "" {
+					publishPubsub = pubsub
+				}
+			}
+			delete(cloudEvent, outboxRoutePubsubField)
+
 			store, ok := o.getStateFn(stateStore)
 			if !ok {
 				return fmt.Errorf("cannot get outbox state: state store %s not found", stateStore)
@@ -327,22 +443,22 @@ func (o *outboxImpl) SubscribeToInternalTopics(ctx context.Context, appID string
 					return nil
 				}
 
-				return fmt.Errorf("cannot publish outbox message to topic %s with pubsub %s: outbox state not found", c.publishTopic, c.publishPubSub)
+				return fmt.Errorf("cannot publish outbox message to topic %s with pubsub %s: outbox state not found", publishTopic, publishPubsub)
 			}, bo)
 			if err != nil {
 				if c.outboxDiscardWhenMissingState {
-					outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, discarding message", c.publishPubSub, err)
+					outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, discarding message", publishPubsub, err)
 					//lint:ignore nilerr dropping message
 					return nil
 				}
 
-				outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, rejecting for later processing", c.publishPubSub, err)
+				outboxLogger.Errorf("failed to publish outbox topic to pubsub %s: %s, rejecting for later processing", publishPubsub, err)
 
 				return err
 			}
 
-			cloudEvent[contribPubsub.TopicField] = c.publishTopic
-			cloudEvent[contribPubsub.PubsubField] = c.publishPubSub
+			cloudEvent[contribPubsub.TopicField] = publishTopic
+			cloudEvent[contribPubsub.PubsubField] = publishPubsub
 
 			b, err := json.Marshal(cloudEvent)
 			if err != nil {
@@ -352,9 +468,9 @@ func (o *outboxImpl) SubscribeToInternalTopics(ctx context.Context, appID string
 			contentType := cloudEvent[contribPubsub.DataContentTypeField].(string)
 
 			err = o.publisher.Publish(ctx, &contribPubsub.PublishRequest{
-				PubsubName:  c.publishPubSub,
+				PubsubName:  publishPubsub,
 				Data:        b,
-				Topic:       c.publishTopic,
+				Topic:       publishTopic,
 				ContentType: &contentType,
 			}, TransportModeGRPC)
 			if err != nil {
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/dapr/components-contrib/state"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+)
+
+// outboxPayloadTemplateData is the data the payload template of an outbox is
+// executed with.
+type outboxPayloadTemplateData struct {
+	// Key is the key of the state, without the prefix of the app.
+	Key string
+	// Value is the value of the state, decoded if it is JSON.
+	Value any
+	// Metadata is the metadata of the operation.
+	Metadata map[string]string
+}
+
+var outboxPayloadTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// parseOutboxPayloadTemplate parses the template which maps the value of the
+// state to the payload of the published message.
+func parseOutboxPayloadTemplate(val string) (*template.Template, error) {
+	return template.New(outboxPayloadTemplateKey).
+		Option("missingkey=error").
+		Funcs(outboxPayloadTemplateFuncs).
+		Parse(val)
+}
+
+// renderOutboxPayload executes the payload template with the state of the
+// operation.
+func renderOutboxPayload(tmpl *template.Template, sr state.SetRequest) ([]byte, error) {
+	value := sr.Value
+	if b, ok := value.([]byte); ok {
+		var decoded any
+		if err := json.Unmarshal(b, &decoded); err == nil {
+			value = decoded
+		} else {
+			value = string(b)
+		}
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, outboxPayloadTemplateData{
+		Key:      stateLoader.GetOriginalStateKey(sr.Key),
+		Value:    value,
+		Metadata: sr.Metadata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render outbox payload of key %s: %w", sr.Key, err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -210,6 +210,118 @@ func TestAddOrUpdateOutbox(t *testing.T) {
 		assert.Equal(t, "a", c.publishPubSub)
 		assert.Equal(t, "1", c.publishTopic)
 	})
+
+	t.Run("routes without publish topic", func(t *testing.T) {
+		o := newTestOutbox(nil).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey: "a",
+			outboxRoutesKey:        `[{"keyPrefix":"order-","pubsub":"b","topic":"orders"},{"metadata":{"type":"payment"},"topic":"payments"}]`,
+		}))
+
+		require.True(t, o.Enabled("test"))
+		c := o.outboxStores["test"]
+		assert.Empty(t, c.publishTopic)
+		assert.Equal(t, []outboxRoute{
+			{KeyPrefix: "order-", Pubsub: "b", Topic: "orders"},
+			{Metadata: map[string]string{"type": "payment"}, Topic: "payments"},
+		}, c.routes)
+	})
+
+	t.Run("invalid routes disable the outbox", func(t *testing.T) {
+		for _, routes := range []string{
+			`{"topic":"orders"}`,
+			`[{"keyPrefix":"order-"}]`,
+			`[{"topic":"orders"}]`,
+		} {
+			o := newTestOutbox(nil).(*outboxImpl)
+			o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+				outboxPublishPubsubKey: "a",
+				outboxPublishTopicKey:  "1",
+				outboxRoutesKey:        routes,
+			}))
+			assert.False(t, o.Enabled("test"), routes)
+		}
+	})
+
+	t.Run("invalid payload template disables the outbox", func(t *testing.T) {
+		o := newTestOutbox(nil).(*outboxImpl)
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey:   "a",
+			outboxPublishTopicKey:    "1",
+			outboxPayloadTemplateKey: `{{ .Value`,
+		}))
+		assert.False(t, o.Enabled("test"))
+	})
+}
+
+func TestOutboxDestination(t *testing.T) {
+	c := outboxConfig{
+		publishPubSub: "a",
+		publishTopic:  "1",
+		routes: []outboxRoute{
+			{KeyPrefix: "order-", Pubsub: "b", Topic: "orders"},
+			{Metadata: map[string]string{"type": "payment"}, Topic: "payments"},
+			{KeyPrefix: "refund-", Metadata: map[string]string{"type": "payment"}, Topic: "refunds"},
+		},
+	}
+
+	tests := map[string]struct {
+		key        string
+		opMetadata map[string]string
+		txMetadata map[string]string
+		pubsub     string
+		topic      string
+	}{
+		"no matching route": {
+			key: "myapp||item-1", pubsub: "a", topic: "1",
+		},
+		"key prefix": {
+			key: "myapp||order-1", pubsub: "b", topic: "orders",
+		},
+		"operation metadata": {
+			key: "myapp||refund-1", opMetadata: map[string]string{"type": "payment"}, pubsub: "a", topic: "payments",
+		},
+		"transaction metadata": {
+			key: "myapp||item-1", txMetadata: map[string]string{"type": "payment"}, pubsub: "a", topic: "payments",
+		},
+		"operation metadata takes precedence over transaction metadata": {
+			key: "myapp||item-1", opMetadata: map[string]string{"type": "invoice"}, txMetadata: map[string]string{"type": "payment"}, pubsub: "a", topic: "1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pubsub, topic, err := c.destination(tc.key, tc.opMetadata, tc.txMetadata)
+			require.NoError(t, err)
+			assert.Equal(t, tc.pubsub, pubsub)
+			assert.Equal(t, tc.topic, topic)
+		})
+	}
+
+	t.Run("no matching route and no publish topic", func(t *testing.T) {
+		c := outboxConfig{publishPubSub: "a", routes: c.routes}
+		_, _, err := c.destination("myapp||item-1", nil, nil)
+		require.Error(t, err)
+	})
+}
+
+func outboxComponent(name string, md map[string]string) v1alpha1.Component {
+	comp := v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	for k, v := range md {
+		comp.Spec.Metadata = append(comp.Spec.Metadata, common.NameValuePair{
+			Name: k,
+			Value: common.DynamicValue{
+				JSON: v1.JSON{
+					Raw: []byte(v),
+				},
+			},
+		})
+	}
+	return comp
 }
 
 func TestPublishInternal(t *testing.T) {
@@ -261,7 +373,7 @@ func TestPublishInternal(t *testing.T) {
 				Key:   "key",
 				Value: "test",
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
 
 		require.NoError(t, err)
 	})
@@ -315,7 +427,7 @@ func TestPublishInternal(t *testing.T) {
 				Value:    "test",
 				Metadata: map[string]string{"source": "testsource"},
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
 
 		require.NoError(t, err)
 	})
@@ -370,7 +482,7 @@ func TestPublishInternal(t *testing.T) {
 				Value:       "test",
 				ContentType: &contentType,
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
 
 		require.NoError(t, err)
 	})
@@ -441,7 +553,7 @@ func TestPublishInternal(t *testing.T) {
 				Value:       string(b),
 				ContentType: &contentType,
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
 
 		require.NoError(t, err)
 	})
@@ -507,7 +619,7 @@ func TestPublishInternal(t *testing.T) {
 				Value:    string(b),
 				Metadata: map[string]string{"contentType": "application/json"},
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
 
 		require.NoError(t, err)
 	})
@@ -585,9 +697,86 @@ func TestPublishInternal(t *testing.T) {
 				Value:    string(jp),
 				Metadata: map[string]string{"contentType": "application/json", "outbox.projection": "true"},
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
+
+		require.NoError(t, err)
+	})
 
+	t.Run("routed operations", func(t *testing.T) {
+		var destinations [][2]string
+		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+			var cloudEvent map[string]any
+
+			err := json.Unmarshal(pr.Data, &cloudEvent)
+			require.NoError(t, err)
+
+			// All messages are published to the internal topic of the state
+			// store, carrying their destination.
+			assert.Equal(t, "a", pr.PubsubName)
+			assert.Equal(t, "testapp1outbox", pr.Topic)
+			assert.Equal(t, "a", cloudEvent["pubsubname"])
+			destinations = append(destinations, [2]string{cloudEvent[outboxRoutePubsubField].(string), cloudEvent["topic"].(string)})
+
+			return nil
+		}).(*outboxImpl)
+
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey: "a",
+			outboxPublishTopicKey:  "1",
+			outboxRoutesKey:        `[{"keyPrefix":"order-","pubsub":"b","topic":"orders"},{"metadata":{"type":"payment"},"topic":"payments"}]`,
+		}))
+
+		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			state.SetRequest{Key: "testapp||order-1", Value: "test"},
+			state.SetRequest{Key: "testapp||item-1", Value: "test"},
+			state.SetRequest{Key: "testapp||item-2", Value: "test", Metadata: map[string]string{"topic": "other"}},
+		}, map[string]string{"type": "payment"}, "testapp", "", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, [][2]string{{"b", "orders"}, {"a", "payments"}, {"a", "payments"}}, destinations)
+	})
+
+	t.Run("payload template", func(t *testing.T) {
+		var payloads []string
+		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+			var cloudEvent map[string]any
+
+			err := json.Unmarshal(pr.Data, &cloudEvent)
+			require.NoError(t, err)
+			payloads = append(payloads, fmt.Sprint(cloudEvent["data"]))
+
+			return nil
+		}).(*outboxImpl)
+
+		o.AddOrUpdateOutbox(outboxComponent("test", map[string]string{
+			outboxPublishPubsubKey:   "a",
+			outboxPublishTopicKey:    "1",
+			outboxPayloadTemplateKey: `{{ .Key }}:{{ .Value.id }}:{{ json .Value.tags }}`,
+		}))
+
+		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			state.SetRequest{Key: "testapp||order-1", Value: []byte(`{"id":"1","tags":["a"],"internal":"secret"}`)},
+			state.SetRequest{Key: "testapp||order-2", Value: map[string]any{"id": "2", "tags": []string{}}},
+		}, nil, "testapp", "", "")
 		require.NoError(t, err)
+		assert.Equal(t, []string{`order-1:1:["a"]`, `order-2:2:[]`}, payloads)
+
+		t.Run("projections are not rendered", func(t *testing.T) {
+			payloads = nil
+			_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+				state.SetRequest{Key: "testapp||order-1", Value: []byte(`{"id":"1"}`)},
+				state.SetRequest{Key: "testapp||order-1", Value: "projected", Metadata: map[string]string{"outbox.projection": "true"}},
+			}, nil, "testapp", "", "")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"projected"}, payloads)
+		})
+
+		t.Run("missing fields fail the transaction", func(t *testing.T) {
+			_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+				state.SetRequest{Key: "testapp||order-3", Value: []byte(`{"tags":[]}`)},
+			}, nil, "testapp", "", "")
+			require.Error(t, err)
+		})
 	})
 
 	t.Run("missing state store", func(t *testing.T) {
@@ -598,7 +787,7 @@ func TestPublishInternal(t *testing.T) {
 				Key:   "key",
 				Value: "test",
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
 		require.Error(t, err)
 	})
 
@@ -634,7 +823,7 @@ func TestPublishInternal(t *testing.T) {
 			},
 		})
 
-		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{}, "testapp", "", "")
+		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{}, nil, "testapp", "", "")
 
 		require.NoError(t, err)
 	})
@@ -675,7 +864,7 @@ func TestPublishInternal(t *testing.T) {
 				Key:   "1",
 				Value: "hello",
 			},
-		}, "testapp", "", "")
+		}, nil, "testapp", "", "")
 
 		require.Error(t, err)
 	})
@@ -778,7 +967,7 @@ func TestSubscribeToInternalTopics(t *testing.T) {
 					Value:    "hello",
 					Metadata: map[string]string{"outbox.cloudevent.customfield": "a", "data": "a", "id": "b"},
 				},
-			}, appID, "00-ecdf5aaa79bff09b62b201442c0f3061-d2597ed7bfd029e4-01", "00-ecdf5aaa79bff09b62b201442c0f3061-d2597ed7bfd029e4-01")
+			}, nil, appID, "00-ecdf5aaa79bff09b62b201442c0f3061-d2597ed7bfd029e4-01", "00-ecdf5aaa79bff09b62b201442c0f3061-d2597ed7bfd029e4-01")
 
 			trs = append(trs[:0], trs[0+1:]...)
 
@@ -864,7 +1053,7 @@ func TestSubscribeToInternalTopics(t *testing.T) {
 				Key:   "1",
 				Value: "hello",
 			},
-		}, appID, "", "")
+		}, nil, appID, "", "")
 
 		require.Error(t, pErr)
 		assert.Empty(t, trs)
@@ -940,7 +1129,7 @@ func TestSubscribeToInternalTopics(t *testing.T) {
 					Key:   "1",
 					Value: "hello",
 				},
-			}, appID, "", "")
+			}, nil, appID, "", "")
 
 			trs = append(trs[:0], trs[0+1:]...)
 
@@ -1074,7 +1263,7 @@ func TestSubscribeToInternalTopics(t *testing.T) {
 					Key:   "1",
 					Value: "hello",
 				},
-			}, appID, "", "")
+			}, nil, appID, "", "")
 
 			trs = append(trs[:0], trs[0+1:]...)
 
@@ -65,13 +65,14 @@ func (p *publisher) Publish(ctx context.Context, req *contribpubsub.PublishReque
 		return rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.appID}
 	}
 
+	// The policies of the topic are looked up before it is prefixed with the namespace.
+	policyDef := p.resiliency.ComponentOutboundOperationPolicy(req.PubsubName, resiliency.Pubsub, req.Topic)
+
 	if pubsub.NamespaceScoped {
 		req.Topic = p.namespace + req.Topic
 	}
 
-	policyRunner := resiliency.NewRunner[any](ctx,
-		p.resiliency.ComponentOutboundPolicy(req.PubsubName, resiliency.Pubsub),
-	)
+	policyRunner := resiliency.NewRunner[any](ctx, policyDef)
 	_, err := policyRunner(func(ctx context.Context) (any, error) {
 		err := pubsub.Component.Publish(ctx, req)
 		if err != nil {
@@ -95,12 +96,12 @@ func (p *publisher) BulkPublish(ctx context.Context, req *contribpubsub.BulkPubl
 		return contribpubsub.BulkPublishResponse{}, rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.appID}
 	}
 
+	policyDef := p.resiliency.ComponentOutboundOperationPolicy(req.PubsubName, resiliency.Pubsub, req.Topic)
+
 	if pubsub.NamespaceScoped {
 		req.Topic = p.namespace + req.Topic
 	}
 
-	policyDef := p.resiliency.ComponentOutboundPolicy(req.PubsubName, resiliency.Pubsub)
-
 	if contribpubsub.FeatureBulkPublish.IsPresent(pubsub.Component.Features()) {
 		return rtpubsub.ApplyBulkPublishResiliency(ctx, req, policyDef, pubsub.Component.(contribpubsub.BulkPublisher), mode)
 	}
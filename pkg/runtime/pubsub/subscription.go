@@ -10,6 +10,14 @@ type Subscription struct {
 	Rules           []*Rule           `json:"rules,omitempty"`
 	Scopes          []string          `json:"scopes"`
 	BulkSubscribe   *BulkSubscribe    `json:"bulkSubscribe"`
+	WorkflowEvent   *WorkflowEvent    `json:"workflowEvent,omitempty"`
+}
+
+// WorkflowEvent raises the messages of a subscription as external events on
+// the workflow instance whose ID is read from InstanceIDField.
+type WorkflowEvent struct {
+	EventName       string `json:"eventName"`
+	InstanceIDField string `json:"instanceIDField"`
 }
 
 type BulkSubscribe struct {
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/dapr/kit/logger"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+// applyLogLevel applies the log level of the configuration to the loggers, or
+// the level they were configured with if it's empty. It's a no-op if the
+// options of the loggers are unknown, such as when the runtime is embedded.
+func applyLogLevel(opts *logger.Options, level string) error {
+	if opts == nil {
+		return nil
+	}
+
+	o := *opts
+	if level != "" {
+		if err := o.SetOutputLevel(level); err != nil {
+			return err
+		}
+	}
+
+	return logger.ApplyOptionsToLoggers(&o)
+}
+
+// reload re-reads the configuration files and the resiliency resources of a
+// self-hosted sidecar. The log level and the resiliency policies are applied
+// without restarting the sidecar, while the other changes of the
+// configuration are only reported, as they take effect on restart.
+func (a *DaprRuntime) reload(context.Context) (compstore.ConfigurationReload, error) {
+	a.reloadLock.Lock()
+	defer a.reloadLock.Unlock()
+
+	reload, err := a.reloadResources()
+	reload.Time = a.clock.Now()
+	reload.Err = err
+	a.compStore.AddConfigurationReload(reload)
+
+	if err != nil {
+		log.Errorf("Failed to reload the configuration: %s", err)
+		return reload, err
+	}
+
+	if len(reload.Applied) == 0 && len(reload.RestartRequired) == 0 {
+		log.Info("Reloaded the configuration: no changes")
+	} else {
+		log.Infof("Reloaded the configuration: applied [%s], restart required [%s]",
+			strings.Join(reload.Applied, ", "), strings.Join(reload.RestartRequired, ", "))
+	}
+
+	return reload, nil
+}
+
+func (a *DaprRuntime) reloadResources() (compstore.ConfigurationReload, error) {
+	var reload compstore.ConfigurationReload
+
+	globalConfig := config.LoadDefaultConfiguration()
+	if len(a.runtimeConfig.config) > 0 {
+		var err error
+		globalConfig, err = config.LoadStandaloneConfiguration(a.runtimeConfig.config...)
+		if err != nil {
+			return reload, fmt.Errorf("error loading configuration: %w", err)
+		}
+	}
+	if err := config.SetTracingSpecFromEnv(globalConfig); err != nil {
+		return reload, fmt.Errorf("error setting tracing spec from env: %w", err)
+	}
+	globalConfig.SetDefaultFeatures()
+
+	for _, section := range changedConfigurationSections(a.globalConfig.Spec, globalConfig.Spec) {
+		reload.RestartRequired = append(reload.RestartRequired, "configuration/"+section)
+	}
+
+	if level := globalConfig.GetLogLevel(); level != a.logLevel {
+		if err := applyLogLevel(a.runtimeConfig.loggerOptions, level); err != nil {
+			return reload, fmt.Errorf("error applying the log level: %w", err)
+		}
+		a.logLevel = level
+		reload.Applied = append(reload.Applied, "configuration/logging.level")
+	}
+
+	provider, ok := a.resiliency.(*resiliency.Reloadable)
+	if !ok {
+		return reload, nil
+	}
+
+	var resiliencies []*resiliencyapi.Resiliency
+	if len(a.runtimeConfig.standalone.ResourcesPath) > 0 {
+		resiliencies = resiliency.LoadLocalResiliency(log, a.runtimeConfig.id, a.runtimeConfig.standalone.ResourcesPath...)
+	}
+
+	running := a.compStore.ListResiliencyResources()
+	changes := changedResiliencies(running, resiliencies)
+	if len(changes) == 0 {
+		return reload, nil
+	}
+
+	provider.Swap(resiliency.FromConfigurations(log, resiliencies...))
+	for _, res := range running {
+		a.compStore.DeleteResiliencyResource(res.Name)
+	}
+	for _, res := range resiliencies {
+		a.compStore.AddResiliencyResource(*res)
+	}
+	reload.Applied = append(reload.Applied, changes...)
+
+	return reload, nil
+}
+
+// changedConfigurationSections returns the names of the sections of the
+// configuration spec which differ. The log level is left out, as it's applied
+// when the configuration is reloaded.
+func changedConfigurationSections(running, loaded config.ConfigurationSpec) []string {
+	for _, spec := range []*config.ConfigurationSpec{&running, &loaded} {
+		if spec.LoggingSpec != nil {
+			logging := *spec.LoggingSpec
+			logging.Level = ""
+			spec.LoggingSpec = &logging
+			if logging == (config.LoggingSpec{}) {
+				spec.LoggingSpec = nil
+			}
+		}
+		// The default features are added in no particular order.
+		spec.Features = slices.SortedFunc(slices.Values(spec.Features), func(a, b config.FeatureSpec) int {
+			return cmp.Compare(a.Name, b.Name)
+		})
+	}
+
+	rv, lv := reflect.ValueOf(running), reflect.ValueOf(loaded)
+	var changed []string
+	for i := range rv.NumField() {
+		if !reflect.DeepEqual(rv.Field(i).Interface(), lv.Field(i).Interface()) {
+			name, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("json"), ",")
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// changedResiliencies returns the resiliency resources which were added,
+// updated or removed, such as "resiliency/myresiliency updated".
+func changedResiliencies(running []resiliencyapi.Resiliency, loaded []*resiliencyapi.Resiliency) []string {
+	runningByName := make(map[string]resiliencyapi.Resiliency, len(running))
+	for _, res := range running {
+		runningByName[res.Name] = res
+	}
+
+	var changes []string
+	for _, res := range loaded {
+		prev, ok := runningByName[res.Name]
+		switch {
+		case !ok:
+			changes = append(changes, "resiliency/"+res.Name+" added")
+		case !reflect.DeepEqual(prev.Spec, res.Spec) || !slices.Equal(prev.Scopes, res.Scopes):
+			changes = append(changes, "resiliency/"+res.Name+" updated")
+		}
+		delete(runningByName, res.Name)
+	}
+	for name := range runningByName {
+		changes = append(changes, "resiliency/"+name+" removed")
+	}
+
+	slices.Sort(changes)
+	return changes
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestChangedConfigurationSections(t *testing.T) {
+	running := config.ConfigurationSpec{
+		LoggingSpec: &config.LoggingSpec{Level: "info"},
+		Features: []config.FeatureSpec{
+			{Name: "b", Enabled: true},
+			{Name: "a", Enabled: false},
+		},
+	}
+
+	t.Run("log level and order of the features are ignored", func(t *testing.T) {
+		loaded := config.ConfigurationSpec{
+			LoggingSpec: &config.LoggingSpec{Level: "debug"},
+			Features: []config.FeatureSpec{
+				{Name: "a", Enabled: false},
+				{Name: "b", Enabled: true},
+			},
+		}
+		assert.Empty(t, changedConfigurationSections(running, loaded))
+		assert.Equal(t, "info", running.LoggingSpec.Level)
+	})
+
+	t.Run("changed sections are returned", func(t *testing.T) {
+		loaded := config.ConfigurationSpec{
+			TracingSpec: &config.TracingSpec{SamplingRate: "1"},
+			Features: []config.FeatureSpec{
+				{Name: "a", Enabled: true},
+				{Name: "b", Enabled: true},
+			},
+		}
+		assert.Equal(t, []string{"tracing", "features"}, changedConfigurationSections(running, loaded))
+	})
+}
+
+func TestChangedResiliencies(t *testing.T) {
+	withTimeout := func(name, timeout string) resiliencyapi.Resiliency {
+		return resiliencyapi.Resiliency{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: resiliencyapi.ResiliencySpec{
+				Policies: resiliencyapi.Policies{
+					Timeouts: map[string]string{"general": timeout},
+				},
+			},
+		}
+	}
+
+	running := []resiliencyapi.Resiliency{
+		withTimeout("unchanged", "5s"),
+		withTimeout("updated", "5s"),
+		withTimeout("removed", "5s"),
+	}
+	loaded := []*resiliencyapi.Resiliency{
+		new(withTimeout("unchanged", "5s")),
+		new(withTimeout("updated", "10s")),
+		new(withTimeout("added", "5s")),
+	}
+
+	assert.Equal(t, []string{
+		"resiliency/added added",
+		"resiliency/removed removed",
+		"resiliency/updated updated",
+	}, changedResiliencies(running, loaded))
+	assert.Empty(t, changedResiliencies(running[:1], loaded[:1]))
+}
@@ -25,6 +25,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
@@ -45,6 +46,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/callbackstream"
 	"github.com/dapr/dapr/pkg/actors/hostconfig"
+	"github.com/dapr/dapr/pkg/api/apitoken"
 	"github.com/dapr/dapr/pkg/api/grpc"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	"github.com/dapr/dapr/pkg/api/grpc/proxy/codec"
@@ -55,19 +57,27 @@ import (
 	configapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
 	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	"github.com/dapr/dapr/pkg/apphealth"
+	"github.com/dapr/dapr/pkg/audit"
+	appconcurrency "github.com/dapr/dapr/pkg/channel/concurrency"
 	"github.com/dapr/dapr/pkg/components"
+	"github.com/dapr/dapr/pkg/components/nameresolution"
 	"github.com/dapr/dapr/pkg/components/pluggable"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
 	"github.com/dapr/dapr/pkg/config"
+	env "github.com/dapr/dapr/pkg/config/env"
 	"github.com/dapr/dapr/pkg/config/protocol"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/faults"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/middleware"
 	middlewarehttp "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/client"
 	"github.com/dapr/dapr/pkg/outbox"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/authorizer"
 	"github.com/dapr/dapr/pkg/runtime/channels"
@@ -82,6 +92,8 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/pubsub/streamer"
 	"github.com/dapr/dapr/pkg/runtime/registry"
 	"github.com/dapr/dapr/pkg/runtime/scheduler"
+	"github.com/dapr/dapr/pkg/runtime/shutdown"
+	"github.com/dapr/dapr/pkg/runtime/statusreport"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/inprocess"
 	"github.com/dapr/dapr/pkg/security"
@@ -103,6 +115,8 @@ type DaprRuntime struct {
 	actors                 actors.Interface
 	wfengine               wfengine.Interface
 	workflowAccessPolicies *workflowacl.Holder
+	rateLimiter            *ratelimit.Limiter
+	faultInjector          *faults.Injector
 
 	nameResolver          nr.Resolver
 	hostAddress           string
@@ -116,6 +130,8 @@ type DaprRuntime struct {
 	appHealth             *apphealth.AppHealth
 	appHealthReady        func(context.Context) error // Invoked the first time the app health becomes ready
 	appHealthLock         sync.Mutex
+	appStarted            bool        // Whether the building blocks were started for the app
+	appUnhealthy          atomic.Bool // Whether the app health checks report the app as unhealthy
 	httpMiddleware        *middlewarehttp.HTTP
 	compStore             *compstore.ComponentStore
 	pubsubAdapter         pubsub.Adapter
@@ -126,8 +142,12 @@ type DaprRuntime struct {
 	authz                 *authorizer.Authorizer
 	sec                   security.Handler
 	runnerCloser          *concurrency.RunnerCloserManager
+	shutdown              *shutdown.Sequence
 	clock                 clock.Clock
 	reloader              *hotreload.Reloader
+	apiTokens             *apitoken.Tokens
+	auditLog              *audit.Log
+	statusReporter        *statusreport.Reporter
 
 	grpcAPIServer      grpc.Server
 	grpcInternalServer grpc.Server
@@ -143,6 +163,11 @@ type DaprRuntime struct {
 
 	resiliency resiliency.Provider
 
+	// reloadLock serializes the reloads of the configuration, and logLevel is
+	// the log level of the configuration currently applied.
+	reloadLock sync.Mutex
+	logLevel   string
+
 	tracerProvider *sdktrace.TracerProvider
 
 	wg sync.WaitGroup
@@ -189,6 +214,12 @@ func newDaprRuntime(ctx context.Context,
 		}
 	}
 
+	// Circuit breakers with persistence share their state with the other
+	// replicas of the app through the state stores.
+	if rl, ok := resiliencyProvider.(*resiliency.Reloadable); ok {
+		rl.SetCircuitBreakerStore(newCircuitBreakerStoreFn(compStore, runtimeConfig.id))
+	}
+
 	namespace := security.CurrentNamespace()
 
 	meta := meta.New(meta.Options{
@@ -204,8 +235,18 @@ func newDaprRuntime(ctx context.Context,
 		return nil, err
 	}
 
+	// The limit of the requests in flight toward the app is shared by the
+	// channels of the app, which are recreated when the app channel refreshes.
+	appLimiter, err := appconcurrency.New(appconcurrency.Options{
+		MaxConcurrency: runtimeConfig.appConnectionConfig.MaxConcurrency,
+		Spec:           globalConfig.GetAdaptiveConcurrencySpec(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid app channel configuration: %w", err)
+	}
+
 	appAPIToken := security.GetAppToken()
-	grpc := createGRPCManager(sec, runtimeConfig, globalConfig, appAPIToken)
+	grpc := createGRPCManager(sec, runtimeConfig, globalConfig, appAPIToken, appLimiter)
 
 	authz := authorizer.New(authorizer.Options{
 		ID:           runtimeConfig.id,
@@ -228,6 +269,7 @@ func newDaprRuntime(ctx context.Context,
 		GRPC:                grpc,
 		AppMiddleware:       httpMiddlewareApp,
 		AppAPIToken:         appAPIToken,
+		AppLimiter:          appLimiter,
 		ActorCallbackStream: actorCallbackStream,
 	})
 
@@ -266,10 +308,46 @@ func newDaprRuntime(ctx context.Context,
 		StateTTLEnabled:      globalConfig.IsFeatureEnabled(config.ActorStateTTL),
 		MaxRequestBodySize:   runtimeConfig.maxRequestBodySize,
 		Mode:                 runtimeConfig.mode,
+		PubSub:               pubsubAdapter,
+		PlacementHostLabels:  runtimeConfig.placementHostLabels,
 		DisseminationTimeout: runtimeConfig.actorsDisseminationTimeout,
 	})
 	inProcessExec := inprocess.NewExecutor()
 
+	rateLimiter, err := ratelimit.New(ratelimit.Options{
+		AppID:     runtimeConfig.id,
+		Spec:      globalConfig.Spec.RateLimitSpec,
+		CompStore: compStore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit configuration: %w", err)
+	}
+
+	// The faults are injected in the calls run with the resiliency policies.
+	faultInjector, err := faults.New(faults.Options{
+		Spec: globalConfig.Spec.FaultInjectionSpec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid fault injection configuration: %w", err)
+	}
+	if rl, ok := resiliencyProvider.(*resiliency.Reloadable); ok && faultInjector != nil {
+		log.Warn("Fault injection is enabled, do not use it in production")
+		rl.SetFaultInjector(faultInjector.Inject)
+	}
+
+	// In Kubernetes, the results of loading the components and subscriptions
+	// are reported to the operator, which reflects them in their status.
+	reporter := runtimeConfig.registry.Reporter()
+	var statusReporter *statusreport.Reporter
+	if operatorClient != nil {
+		statusReporter = statusreport.New(statusreport.Options{
+			Client:    operatorClient,
+			Namespace: namespace,
+			PodName:   os.Getenv("POD_NAME"),
+		})
+		reporter = chainReporters(reporter, statusReporter.ReportComponent)
+	}
+
 	processor := processor.New(processor.Options{
 		ID:                              runtimeConfig.id,
 		Namespace:                       namespace,
@@ -291,7 +369,9 @@ func newDaprRuntime(ctx context.Context,
 		Outbox:                          outbox,
 		Adapter:                         pubsubAdapter,
 		AdapterStreamer:                 pubsubAdapterStreamer,
-		Reporter:                        runtimeConfig.registry.Reporter(),
+		Reporter:                        reporter,
+		SubscriptionReporter:            statusReporter.ReportSubscription,
+		RateLimiter:                     rateLimiter,
 	})
 
 	var reloader *hotreload.Reloader
@@ -357,6 +437,17 @@ func newDaprRuntime(ctx context.Context,
 
 	// Install the wfengine as the processor's internal workflow registrar.
 	processor.SetInProcessWorkflows(wfe)
+	processor.SetWorkflow(wfe.Client())
+
+	// The universal API expiring state is only created once the runtime is
+	// initialized, before the scheduler streams are connected.
+	var rt *DaprRuntime
+	stateTTL := statettl.ExpirerFunc(func(ctx context.Context, storeName, key string) error {
+		if rt == nil || rt.daprUniversal == nil {
+			return errors.New("runtime not initialized")
+		}
+		return rt.daprUniversal.ExpireState(ctx, storeName, key)
+	})
 
 	jobsManager, err := scheduler.New(scheduler.Options{
 		Namespace:        namespace,
@@ -366,6 +457,7 @@ func newDaprRuntime(ctx context.Context,
 		Addresses:        runtimeConfig.schedulerAddress,
 		Security:         sec,
 		WFEngine:         wfe,
+		StateTTL:         stateTTL,
 		WorkflowSpec:     globalConfig.Spec.WorkflowSpec,
 		Healthz:          runtimeConfig.healthz,
 		SchedulerStreams: runtimeConfig.schedulerStreams,
@@ -374,7 +466,23 @@ func newDaprRuntime(ctx context.Context,
 		return nil, err
 	}
 
-	rt := &DaprRuntime{
+	auditSpec := globalConfig.GetAuditLogSpec()
+	var auditOtelHeaders map[string]string
+	if auditSpec.Otel != nil {
+		auditOtelHeaders = parseOtelHeaders(auditSpec.Otel.Headers)
+	}
+	auditLog, err := audit.New(audit.Options{
+		AppID:       runtimeConfig.id,
+		Namespace:   namespace,
+		Spec:        auditSpec,
+		OtelHeaders: auditOtelHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+	audit.SetDefault(auditLog)
+
+	rt = &DaprRuntime{
 		runtimeConfig:          runtimeConfig,
 		globalConfig:           globalConfig,
 		accessControlList:      accessControlList,
@@ -402,6 +510,19 @@ func newDaprRuntime(ctx context.Context,
 		actors:                 actors,
 		wfengine:               wfe,
 		workflowAccessPolicies: workflowAccessPolicies,
+		rateLimiter:            rateLimiter,
+		faultInjector:          faultInjector,
+		apiTokens: apitoken.New(apitoken.Options{
+			Token:       security.GetAPIToken(),
+			Specs:       globalConfig.GetAPISpec().Tokens,
+			SecretStore: compStore.GetSecretStore,
+		}),
+		auditLog:       auditLog,
+		statusReporter: statusReporter,
+		shutdown: shutdown.New(shutdown.Options{
+			Timeouts: runtimeConfig.shutdownPhaseTimeouts,
+		}),
+		logLevel: globalConfig.GetLogLevel(),
 	}
 	close(rt.isAppHealthy)
 
@@ -418,6 +539,9 @@ func newDaprRuntime(ctx context.Context,
 		rt.actors.Run,
 		rt.wfengine.Run,
 		rt.jobsManager.Run,
+		rt.apiTokens.Run,
+		rt.auditLog.Run,
+		rt.statusReporter.Run,
 		actorCallbackStream.Run,
 		func(ctx context.Context) error {
 			start := time.Now()
@@ -459,22 +583,33 @@ func newDaprRuntime(ctx context.Context,
 
 			return nil
 		},
-		func(ctx context.Context) error {
-			<-ctx.Done()
-
-			if server := rt.grpcAPIServer; server != nil {
-				return server.Close()
-			}
+		inProcessExec.Run,
+	)
 
+	rt.shutdown.Add(shutdown.PhaseSubscriptions,
+		func(context.Context) error {
+			rt.processor.Subscriber().StopAllSubscriptionsForever()
+			return nil
+		},
+		func(context.Context) error {
+			rt.processor.Binding().StopReadingFromBindings(true)
 			return nil
 		},
-		inProcessExec.Run,
 	)
-
-	if err := rt.runnerCloser.AddCloser(
-		func() error {
-			log.Info("Dapr is shutting down")
-
+	rt.shutdown.Add(shutdown.PhaseActors, func(ctx context.Context) error {
+		tbl, err := rt.actors.Table(ctx)
+		if err != nil || tbl == nil {
+			// Actors are disabled or the actor runtime has already stopped.
+			return nil
+		}
+		return tbl.HaltAll(ctx)
+	})
+	// Only the spans need flushing: the metrics exporter serves the metrics
+	// to be scraped, and an outbox message whose delivery is cut short when
+	// the components close is redelivered by the outbox pub/sub.
+	rt.shutdown.Add(shutdown.PhaseFlush, rt.stopTrace)
+	rt.shutdown.Add(shutdown.PhaseComponents,
+		func(ctx context.Context) error {
 			comps := rt.compStore.ListComponents()
 			errCh := make(chan error)
 
@@ -482,7 +617,7 @@ func newDaprRuntime(ctx context.Context,
 				go func(comp compapi.Component) {
 					log.Infof("Shutting down component %s", comp.LogName())
 
-					errCh <- rt.processor.Close(context.Background(), comp)
+					errCh <- rt.processor.Close(ctx, comp)
 				}(comp)
 			}
 
@@ -501,8 +636,14 @@ func newDaprRuntime(ctx context.Context,
 			errs[len(comps)+1] = rt.grpc.Close()
 			return errors.Join(errs...)
 		},
-		rt.stopTrace,
-	); err != nil {
+	)
+
+	// The phases which didn't run while draining, such as when the runtime is
+	// closed before it's initialized, run once the runners have returned.
+	if err := rt.runnerCloser.AddCloser(func(ctx context.Context) error {
+		log.Info("Dapr is shutting down")
+		return rt.shutdown.Run(ctx)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -511,19 +652,19 @@ func newDaprRuntime(ctx context.Context,
 
 // Run performs initialization of the runtime with the runtime and global configurations.
 func (a *DaprRuntime) Run(parentCtx context.Context) error {
-	ctx := parentCtx
-	if a.runtimeConfig.blockShutdownDuration != nil {
-		// Override context with Background. Runner context will be cancelled when
-		// blocking graceful shutdown returns.
-		ctx = context.Background()
-		a.runnerCloser.Add(func(ctx context.Context) error {
-			select {
-			case <-parentCtx.Done():
-			case <-ctx.Done():
-				// Return nil as another routine has returned, not due to an interrupt.
-				return nil
-			}
+	// Override context with Background. Runner context will be cancelled once
+	// the building blocks have been drained, so that the runners they depend
+	// on, such as the internal gRPC server and the actor runtime, keep running
+	// while draining.
+	a.runnerCloser.Add(func(ctx context.Context) error {
+		select {
+		case <-parentCtx.Done():
+		case <-ctx.Done():
+			// Return nil as another routine has returned, not due to an interrupt.
+			return nil
+		}
 
+		if a.runtimeConfig.blockShutdownDuration != nil {
 			log.Infof("Blocking graceful shutdown for %s or until app reports unhealthy...", *a.runtimeConfig.blockShutdownDuration)
 
 			a.processor.Subscriber().StopAllSubscriptionsForever()
@@ -535,12 +676,45 @@ func (a *DaprRuntime) Run(parentCtx context.Context) error {
 			case <-a.isAppHealthy:
 				log.Info("App reported unhealthy, entering shutdown...")
 			}
+		}
 
+		select {
+		case <-a.initComplete:
+		default:
+			// The building blocks haven't all started, so there is nothing to
+			// drain yet.
 			return nil
-		})
-	}
+		}
+
+		drainCtx := context.Background()
+		if duration := a.runtimeConfig.gracefulShutdownDuration; duration > 0 {
+			var cancel context.CancelFunc
+			drainCtx, cancel = context.WithTimeout(drainCtx, duration)
+			defer cancel()
+		}
 
-	return a.runnerCloser.Run(ctx)
+		if err := a.shutdown.RunUntil(drainCtx, shutdown.PhaseActors); err != nil {
+			log.Warnf("Error draining the runtime: %s", err)
+		}
+
+		return nil
+	})
+
+	return a.runnerCloser.Run(context.Background())
+}
+
+// chainReporters returns a reporter which calls all the non-nil reporters,
+// returning their errors joined.
+func chainReporters(reporters ...registry.Reporter) registry.Reporter {
+	return func(ctx context.Context, comp compapi.Component, res *operatorv1pb.ResourceResult) error {
+		errs := make([]error, 0, len(reporters))
+		for _, r := range reporters {
+			if r != nil {
+				errs = append(errs, r(ctx, comp, res))
+			}
+		}
+		return errors.Join(errs...)
+	}
 }
 
 func getOperatorClient(ctx context.Context, sec security.Handler, cfg *internalConfig) (operatorv1pb.OperatorClient, error) {
@@ -743,6 +917,10 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		return err
 	}
 
+	if err = a.processor.State().SubscribeToCacheInvalidation(ctx); err != nil {
+		log.Warnf("failed to subscribe to state cache invalidation topics: %s", err)
+	}
+
 	err = a.loadHTTPEndpoints(ctx)
 	if err != nil {
 		log.Warnf("failed to load HTTP endpoints: %s", err)
@@ -766,6 +944,13 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 
 	a.namespace = security.CurrentNamespace()
 
+	// The configuration is reloaded by restarting the runtime in Kubernetes
+	// mode, when the Configuration and Resiliency resources are updated.
+	var reloadFn func(context.Context) (compstore.ConfigurationReload, error)
+	if a.runtimeConfig.mode == modes.StandaloneMode {
+		reloadFn = a.reload
+	}
+
 	// Create and start the external gRPC server
 	a.daprUniversal = universal.New(universal.Options{
 		AppID:                       a.runtimeConfig.id,
@@ -775,11 +960,16 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		Resiliency:                  a.resiliency,
 		GetComponentsCapabilitiesFn: a.getComponentsCapabilitesMap,
 		ShutdownFn:                  a.ShutdownWithWait,
+		ReloadFn:                    reloadFn,
 		AppConnectionConfig:         a.runtimeConfig.appConnectionConfig,
 		GlobalConfig:                a.globalConfig,
+		Configurations:              a.runtimeConfig.config,
 		Scheduler:                   a.jobsManager.Client(),
 		Actors:                      a.actors,
 		WorkflowEngine:              a.wfengine,
+		Subscriptions:               a.processor.Subscriber(),
+		InputBindings:               a.processor.Binding(),
+		FaultInjector:               a.faultInjector,
 	})
 
 	// Create and start internal and external gRPC servers
@@ -796,6 +986,8 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		AccessControlList:      a.accessControlList,
 		Processor:              a.processor,
 		WorkflowAccessPolicies: a.workflowAccessPolicies,
+		IsAppUnhealthy:         a.isAppUnhealthyFn(),
+		RateLimiter:            a.rateLimiter,
 	})
 
 	// Load and apply workflow access policies before starting servers.
@@ -869,6 +1061,9 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 	if a.runtimeConfig.appConnectionConfig.MaxConcurrency > 0 {
 		log.Infof("app max concurrency set to %v", a.runtimeConfig.appConnectionConfig.MaxConcurrency)
 	}
+	if spec := a.globalConfig.GetAdaptiveConcurrencySpec(); spec != nil {
+		log.Infof("app adaptive concurrency enabled with latency threshold %s", spec.LatencyThreshold)
+	}
 
 	a.appHealthReady = a.appHealthReadyInit
 	if a.runtimeConfig.appConnectionConfig.HealthCheck != nil && a.channels.AppChannel() != nil {
@@ -922,6 +1117,8 @@ func (a *DaprRuntime) appHealthReadyInit(ctx context.Context) error {
 
 // initPluggableComponents discover pluggable components and initialize with their respective registries.
 func (a *DaprRuntime) initPluggableComponents(ctx context.Context) {
+	a.initRemotePluggableComponents(ctx)
+
 	if runtime.GOOS == "windows" {
 		log.Debugf("the current OS does not support pluggable components feature, skipping initialization")
 		return
@@ -933,12 +1130,51 @@ func (a *DaprRuntime) initPluggableComponents(ctx context.Context) {
 	}
 }
 
+// initRemotePluggableComponents discovers the pluggable components served by
+// the remote endpoints of the configuration, which are authenticated with mTLS.
+func (a *DaprRuntime) initRemotePluggableComponents(ctx context.Context) {
+	spec := a.globalConfig.Spec.ComponentsSpec
+	if spec == nil || len(spec.Remote) == 0 {
+		return
+	}
+
+	endpoints := make([]pluggable.RemoteEndpoint, 0, len(spec.Remote))
+	for _, remote := range spec.Remote {
+		if remote.Name == "" || remote.Address == "" || remote.AppID == "" {
+			log.Errorf("Ignoring remote pluggable component '%s': name, address, and appId are required", remote.Name)
+			continue
+		}
+		namespace := remote.Namespace
+		if namespace == "" {
+			namespace = a.namespace
+		}
+		endpoints = append(endpoints, pluggable.RemoteEndpoint{
+			Name:        remote.Name,
+			Address:     remote.Address,
+			Credentials: a.sec.GRPCDialOptionMTLSUnknownTrustDomain(namespace, remote.AppID),
+		})
+	}
+
+	pluggable.DiscoverRemote(ctx, endpoints)
+}
+
+// isAppUnhealthyFn returns the function which reports whether the invocations
+// of the app are rejected, or nil if they never are.
+func (a *DaprRuntime) isAppUnhealthyFn() func() bool {
+	if spec := a.globalConfig.Spec.AppHealthSpec; spec == nil || !spec.RejectInvocations {
+		return nil
+	}
+	return a.appUnhealthy.Load
+}
+
 // Sets the status of the app to healthy or un-healthy
 // Callback for apphealth when the detected status changed
 func (a *DaprRuntime) appHealthChanged(ctx context.Context, status *apphealth.Status) {
 	a.appHealthLock.Lock()
 	defer a.appHealthLock.Unlock()
 
+	appHealthSpec := a.globalConfig.Spec.AppHealthSpec
+
 	if status.IsHealthy {
 		select {
 		case <-a.isAppHealthy:
@@ -957,12 +1193,23 @@ func (a *DaprRuntime) appHealthChanged(ctx context.Context, status *apphealth.St
 			a.appHealthReady = nil
 		}
 
-		if a.channels.AppChannel() != nil {
-			// Start subscribing to topics and reading from input bindings
+		// The building blocks which don't pause while the app is unhealthy are
+		// only started the first time the app becomes healthy.
+		resume := func(block string) bool {
+			return !a.appStarted || appHealthSpec.Pauses(block)
+		}
+
+		if resume(config.AppHealthPausePubSub) {
+			// Start subscribing to topics. Without an app channel, only workflow
+			// event subscriptions are started.
 			if err := a.processor.Subscriber().StartAppSubscriptions(); err != nil {
 				log.Warnf("failed to subscribe to topics: %s ", err)
 			}
+		}
 
+		if resume(config.AppHealthPauseBindings) {
+			// Start reading from input bindings. Without an app channel, only
+			// the routed input bindings are read.
 			err := a.processor.Binding().StartReadingFromBindings(ctx)
 			if err != nil {
 				log.Warnf("failed to read from bindings: %s ", err)
@@ -975,35 +1222,52 @@ func (a *DaprRuntime) appHealthChanged(ctx context.Context, status *apphealth.St
 			log.Warnf("failed to subscribe to outbox topics: %s", err)
 		}
 
-		err = a.actors.RegisterHosted(ctx, hostconfig.Config{
-			EntityConfigs:           a.appConfig.EntityConfigs,
-			DrainRebalancedActors:   a.appConfig.DrainRebalancedActors,
-			DrainOngoingCallTimeout: a.appConfig.DrainOngoingCallTimeout,
-			HostedActorTypes:        a.appConfig.Entities,
-			DefaultIdleTimeout:      a.appConfig.ActorIdleTimeout,
-			Reentrancy:              a.appConfig.Reentrancy,
-			AppChannel:              a.channels.AppChannel(),
-		})
-		if err != nil {
-			log.Warnf("Failed to register hosted actors: %s", err)
+		if resume(config.AppHealthPauseActors) {
+			err = a.actors.RegisterHosted(ctx, hostconfig.Config{
+				EntityConfigs:           a.appConfig.EntityConfigs,
+				DrainRebalancedActors:   a.appConfig.DrainRebalancedActors,
+				DrainOngoingCallTimeout: a.appConfig.DrainOngoingCallTimeout,
+				HostedActorTypes:        a.appConfig.Entities,
+				DefaultIdleTimeout:      a.appConfig.ActorIdleTimeout,
+				Reentrancy:              a.appConfig.Reentrancy,
+				AppChannel:              a.channels.AppChannel(),
+			})
+			if err != nil {
+				log.Warnf("Failed to register hosted actors: %s", err)
+			}
+		}
+
+		if resume(config.AppHealthPauseJobs) {
+			a.jobsManager.StartApp()
 		}
 
-		a.jobsManager.StartApp()
+		a.appStarted = true
+		a.appUnhealthy.Store(false)
 	} else {
+		a.appUnhealthy.Store(true)
+
 		select {
 		case <-a.isAppHealthy:
 		default:
 			close(a.isAppHealthy)
 		}
 
-		a.jobsManager.StopApp()
+		if appHealthSpec.Pauses(config.AppHealthPauseJobs) {
+			a.jobsManager.StopApp()
+		}
 
 		// Stop topic subscriptions and input bindings
-		a.processor.Subscriber().StopAppSubscriptions()
-		a.processor.Binding().StopReadingFromBindings(false)
+		if appHealthSpec.Pauses(config.AppHealthPausePubSub) {
+			a.processor.Subscriber().StopAppSubscriptions()
+		}
+		if appHealthSpec.Pauses(config.AppHealthPauseBindings) {
+			a.processor.Binding().StopReadingFromBindings(false)
+		}
 
-		if err := a.actors.UnRegisterHosted(ctx, a.appConfig.Entities...); err != nil {
-			log.Warnf("Failed to unregister hosted actors: %s", err)
+		if appHealthSpec.Pauses(config.AppHealthPauseActors) {
+			if err := a.actors.UnRegisterHosted(ctx, a.appConfig.Entities...); err != nil {
+				log.Warnf("Failed to unregister hosted actors: %s", err)
+			}
 		}
 	}
 }
@@ -1033,6 +1297,12 @@ func (a *DaprRuntime) initDirectMessaging(resolver nr.Resolver) {
 		ReadBufferSize:     a.runtimeConfig.readBufferSize,
 		Resiliency:         a.resiliency,
 		CompStore:          a.compStore,
+		LoadBalancing:      a.globalConfig.GetLoadBalancingSpec(),
+		Zone:               os.Getenv(env.DaprZone),
+		ResponseCaching:    a.globalConfig.GetResponseCachingSpec(),
+		Federation:         a.globalConfig.GetFederationSpec(),
+		HeaderPolicies:     a.globalConfig.GetHeaderPolicies(),
+		OutboundPipeline:   a.httpMiddleware.BuildOutboundPipelineFromSpec("outbound", a.globalConfig.Spec.OutboundHTTPPipelineSpec).For,
 	})
 	a.runnerCloser.AddCloser(a.directMessaging)
 }
@@ -1046,6 +1316,7 @@ func (a *DaprRuntime) initProxy() {
 		Resiliency:         a.resiliency,
 		MaxRequestBodySize: a.runtimeConfig.maxRequestBodySize,
 		AppendAppTokenFn:   a.grpc.AddAppTokenToContext,
+		HeaderPolicies:     a.globalConfig.GetHeaderPolicies(),
 	})
 }
 
@@ -1063,6 +1334,9 @@ func (a *DaprRuntime) startHTTPServer(ctx context.Context) error {
 		MaxRequestBodySize:    int64(a.runtimeConfig.maxRequestBodySize),
 		Healthz:               a.runtimeConfig.healthz,
 		OutboundHealthz:       a.runtimeConfig.outboundHealthz,
+		APISpec:               a.globalConfig.GetAPISpec(),
+		Security:              a.sec,
+		JWTAudiences:          a.runtimeConfig.jwtAudiences,
 	})
 
 	serverConf := http.ServerConfig{
@@ -1090,16 +1364,16 @@ func (a *DaprRuntime) startHTTPServer(ctx context.Context) error {
 		MetricSpec:  a.globalConfig.GetMetricsSpec(),
 		Middleware:  a.httpMiddleware.BuildPipelineFromSpec("server", a.globalConfig.Spec.HTTPPipelineSpec),
 		APISpec:     a.globalConfig.GetAPISpec(),
+		APITokens:   a.apiTokens,
 	})
 	err := server.StartNonBlocking(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = a.runnerCloser.AddCloser(server)
-	if err != nil {
-		return err
-	}
+	a.shutdown.Add(shutdown.PhaseAPI, func(context.Context) error {
+		return server.Close()
+	})
 
 	return nil
 }
@@ -1149,7 +1423,10 @@ func (a *DaprRuntime) startGRPCInternalServer(ctx context.Context, api grpc.API)
 		Healthz:     a.runtimeConfig.healthz,
 		// Serve the listener reserved before component initialization rather
 		// than binding the port again here. See reserveInternalGRPCServerPort.
-		Listener: a.grpcInternalServerListener,
+		Listener:   a.grpcInternalServerListener,
+		EnableQUIC: a.globalConfig.IsFeatureEnabled(config.ServiceInvocationQUIC),
+		Zone:       os.Getenv(env.DaprZone),
+		SocketDir:  a.runtimeConfig.internalGRPCSocketDir,
 	})
 
 	if err := server.StartNonBlocking(ctx); err != nil {
@@ -1165,6 +1442,11 @@ func (a *DaprRuntime) startGRPCInternalServer(ctx context.Context, api grpc.API)
 
 func (a *DaprRuntime) startGRPCAPIServer(ctx context.Context, api grpc.API, port int) error {
 	serverConf := a.getNewServerConfig(a.runtimeConfig.apiListenAddresses, port)
+	var grpcMiddleware middleware.HTTP
+	if spec := a.globalConfig.Spec.GRPCPipelineSpec; spec != nil && len(spec.Handlers) > 0 {
+		grpcMiddleware = a.httpMiddleware.BuildPipelineFromSpec("grpc", spec)
+	}
+
 	a.grpcAPIServer = grpc.NewAPIServer(grpc.Options{
 		API:            api,
 		Config:         serverConf,
@@ -1174,6 +1456,8 @@ func (a *DaprRuntime) startGRPCAPIServer(ctx context.Context, api grpc.API, port
 		Proxy:          a.proxy,
 		WorkflowEngine: a.wfengine,
 		Healthz:        a.runtimeConfig.healthz,
+		Middleware:     grpcMiddleware,
+		APITokens:      a.apiTokens,
 	})
 
 	err := a.grpcAPIServer.StartNonBlocking(ctx)
@@ -1181,6 +1465,11 @@ func (a *DaprRuntime) startGRPCAPIServer(ctx context.Context, api grpc.API, port
 		return err
 	}
 
+	server := a.grpcAPIServer
+	a.shutdown.Add(shutdown.PhaseAPI, func(context.Context) error {
+		return server.Close()
+	})
+
 	return nil
 }
 
@@ -1246,6 +1535,28 @@ func (a *DaprRuntime) initNameResolution(ctx context.Context) (err error) {
 	if a.globalConfig.Spec.NameResolutionSpec != nil {
 		resolverMetadata.Configuration = a.globalConfig.Spec.NameResolutionSpec.Configuration
 	}
+
+	// Chain the failover resolvers after the resolver, each with its own
+	// configuration.
+	resolverNames := []string{resolverName}
+	if spec := a.globalConfig.Spec.NameResolutionSpec; spec != nil && len(spec.Failover) > 0 {
+		members := []nameresolution.ChainMember{{Name: resolverName, Resolver: a.nameResolver, Configuration: resolverMetadata.Configuration}}
+		for _, failover := range spec.Failover {
+			version := failover.Version
+			if version == "" {
+				version = components.FirstStableVersion
+			}
+			failoverName := utils.ComponentLogName("nr", failover.Component, version)
+			resolver, cerr := a.runtimeConfig.registry.NameResolutions().Create(failover.Component, version, failoverName)
+			if cerr != nil {
+				diag.DefaultMonitoring.ComponentInitFailed("nameResolution", "creation", failover.Component)
+				return rterrors.NewInit(rterrors.CreateComponentFailure, failoverName, cerr)
+			}
+			members = append(members, nameresolution.ChainMember{Name: failover.Component, Resolver: resolver, Configuration: failover.Configuration})
+			resolverNames = append(resolverNames, failover.Component)
+		}
+		a.nameResolver = nameresolution.NewChain(members...)
+	}
 	// Override host address if the internal gRPC listen address is localhost.
 	hostAddress := a.hostAddress
 	if utils.Contains(
@@ -1274,7 +1585,7 @@ func (a *DaprRuntime) initNameResolution(ctx context.Context) (err error) {
 		return err
 	}
 
-	log.Infof("Initialized name resolution to %s", resolverName)
+	log.Infof("Initialized name resolution to %s", strings.Join(resolverNames, ", "))
 
 	return nil
 }
@@ -1397,6 +1708,11 @@ func (a *DaprRuntime) loadAppConfiguration(ctx context.Context) {
 		return
 	}
 
+	if !a.channels.AppCallbackAllowed(config.AppCallbackActors) {
+		log.Warn("Skipping programmatic dapr configuration loading: the actors callbacks of the app are denied by the API configuration")
+		return
+	}
+
 	if utils.Contains(a.runtimeConfig.disableInitEndpoints, DisableConfigInitEndpoint) {
 		log.Warn("Skipping programmatic dapr configuration loading (see 'disable-init-endpoints' flag/annotation)")
 		return
@@ -1478,7 +1794,7 @@ func featureTypeToString(features any) []string {
 	return featureStr
 }
 
-func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, globalConfig *config.Configuration, appAPIToken string) *manager.Manager {
+func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, globalConfig *config.Configuration, appAPIToken string, appLimiter *appconcurrency.Limiter) *manager.Manager {
 	grpcAppChannelConfig := &manager.AppChannelConfig{}
 	if globalConfig != nil {
 		grpcAppChannelConfig.TracingSpec = globalConfig.GetTracingSpec()
@@ -1494,7 +1810,19 @@ func createGRPCManager(sec security.Handler, runtimeConfig *internalConfig, glob
 	}
 
 	grpcAppChannelConfig.AppAPIToken = appAPIToken
+	grpcAppChannelConfig.Limiter = appLimiter
 	m := manager.NewManager(sec, runtimeConfig.mode, grpcAppChannelConfig)
+	if globalConfig != nil && globalConfig.IsFeatureEnabled(config.ServiceInvocationQUIC) {
+		m.EnableQUIC()
+	}
+	if globalConfig != nil {
+		if spec := globalConfig.GetConnectionPoolSpec(); spec != nil {
+			m.SetConnectionPoolSpec(*spec)
+		}
+	}
+	if runtimeConfig != nil && runtimeConfig.internalGRPCSocketDir != "" {
+		m.EnableInternalSocket(runtimeConfig.internalGRPCSocketDir)
+	}
 	m.StartCollector()
 
 	return m
@@ -525,6 +525,43 @@ func TestInitNameResolution(t *testing.T) {
 		require.NoError(t, err, "expected no error")
 	})
 
+	t.Run("test init nameresolution with failover", func(t *testing.T) {
+		rt, err := NewTestDaprRuntime(t, modes.StandaloneMode)
+		require.NoError(t, err)
+
+		rt.globalConfig.Spec.NameResolutionSpec = &config.NameResolutionSpec{
+			Component: "someResolver",
+			Failover:  []config.NameResolverSpec{{Component: "failoverResolver"}},
+		}
+
+		someResolver := initMockResolverForRuntime(rt, "someResolver", nil)
+		failoverResolver := initMockResolverForRuntime(rt, "failoverResolver", nil)
+
+		require.NoError(t, rt.initNameResolution(t.Context()))
+		someResolver.AssertExpectations(t)
+		failoverResolver.AssertExpectations(t)
+
+		someResolver.On("ResolveID", mock.Anything).Return("", errors.New("not found"))
+		failoverResolver.On("ResolveID", mock.Anything).Return("10.0.0.1:50002", nil)
+		address, err := rt.nameResolver.ResolveID(t.Context(), nameresolution.ResolveRequest{ID: "app"})
+		require.NoError(t, err)
+		assert.Equal(t, "10.0.0.1:50002", address)
+	})
+
+	t.Run("error on unknown failover resolver", func(t *testing.T) {
+		rt, err := NewTestDaprRuntime(t, modes.StandaloneMode)
+		require.NoError(t, err)
+
+		rt.globalConfig.Spec.NameResolutionSpec = &config.NameResolutionSpec{
+			Component: "someResolver",
+			Failover:  []config.NameResolverSpec{{Component: "targetResolver"}},
+		}
+
+		initMockResolverForRuntime(rt, "someResolver", nil)
+
+		require.Error(t, rt.initNameResolution(t.Context()))
+	})
+
 	t.Run("test init nameresolution default in StandaloneMode", func(t *testing.T) {
 		// given
 		rt, err := NewTestDaprRuntime(t, modes.StandaloneMode)
@@ -2204,6 +2241,26 @@ func TestBlockShutdownBindings(t *testing.T) {
 	})
 }
 
+func TestAppHealthRejectInvocations(t *testing.T) {
+	rt, err := NewTestDaprRuntime(t, modes.StandaloneMode)
+	require.NoError(t, err)
+	assert.Nil(t, rt.isAppUnhealthyFn())
+
+	rt.globalConfig.Spec.AppHealthSpec = &config.AppHealthSpec{
+		RejectInvocations: true,
+		Pause:             []string{config.AppHealthPauseNone},
+	}
+	isAppUnhealthy := rt.isAppUnhealthyFn()
+	require.NotNil(t, isAppUnhealthy)
+
+	rt.appHealthChanged(t.Context(), apphealth.NewStatus(true, nil))
+	assert.False(t, isAppUnhealthy())
+	rt.appHealthChanged(t.Context(), apphealth.NewStatus(false, nil))
+	assert.True(t, isAppUnhealthy())
+	rt.appHealthChanged(t.Context(), apphealth.NewStatus(true, nil))
+	assert.False(t, isAppUnhealthy())
+}
+
 func TestGracefulShutdownPubSub(t *testing.T) {
 	rt, err := NewTestDaprRuntime(t, modes.StandaloneMode)
 	require.NoError(t, err)
@@ -14,11 +14,15 @@ limitations under the License.
 package client
 
 import (
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 )
 
 type Interface interface {
 	Addresses() []string
+	// JobFailures returns the jobs whose most recent trigger delivered to this
+	// sidecar failed.
+	JobFailures() []*runtimev1pb.MetadataJobFailure
 	schedulerv1pb.SchedulerClient
 }
 
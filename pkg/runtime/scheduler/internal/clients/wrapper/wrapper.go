@@ -21,23 +21,28 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	v1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/retry"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients"
+	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/failures"
 )
 
 type Options struct {
-	Clients *clients.Clients
+	Clients  *clients.Clients
+	Failures *failures.Failures
 }
 
 type wrapper struct {
-	clients *clients.Clients
+	clients  *clients.Clients
+	failures *failures.Failures
 }
 
 func New(opts Options) client.Interface {
 	return &wrapper{
-		clients: opts.Clients,
+		clients:  opts.Clients,
+		failures: opts.Failures,
 	}
 }
 
@@ -45,6 +50,10 @@ func (w *wrapper) Addresses() []string {
 	return w.clients.Addresses()
 }
 
+func (w *wrapper) JobFailures() []*runtimev1pb.MetadataJobFailure {
+	return w.failures.List()
+}
+
 func (w *wrapper) DeleteJob(ctx context.Context, req *v1pb.DeleteJobRequest, opts ...grpc.CallOption) (*v1pb.DeleteJobResponse, error) {
 	var resp *v1pb.DeleteJobResponse
 
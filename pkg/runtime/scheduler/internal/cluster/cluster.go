@@ -20,9 +20,11 @@ import (
 	"io"
 
 	"github.com/dapr/dapr/pkg/actors"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
 	"github.com/dapr/dapr/pkg/config"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/failures"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/logger"
@@ -41,6 +43,8 @@ type Options struct {
 	Actors   actors.Interface
 	Channels *channels.Channels
 	WFEngine wfengine.Interface
+	StateTTL statettl.Expirer
+	Failures *failures.Failures
 }
 
 // Cluster manages connections to multiple schedulers.
@@ -55,6 +59,8 @@ type Cluster struct {
 	actors   actors.Interface
 	channels *channels.Channels
 	wfengine wfengine.Interface
+	stateTTL statettl.Expirer
+	failures *failures.Failures
 }
 
 func New(opts Options) *Cluster {
@@ -68,6 +74,8 @@ func New(opts Options) *Cluster {
 		actors:       opts.Actors,
 		channels:     opts.Channels,
 		wfengine:     opts.WFEngine,
+		stateTTL:     opts.StateTTL,
+		failures:     opts.Failures,
 	}
 }
 
@@ -132,6 +140,8 @@ func (c *Cluster) watchJobs(ctx context.Context) error {
 			channels: c.channels,
 			actors:   router,
 			wfengine: c.wfengine,
+			stateTTL: c.stateTTL,
+			failures: c.failures,
 		}
 		runners[i] = connectors[i].run
 	}
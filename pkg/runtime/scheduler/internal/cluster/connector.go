@@ -18,9 +18,11 @@ import (
 	"time"
 
 	"github.com/dapr/dapr/pkg/actors/router"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/retry"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/failures"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 )
 
@@ -30,6 +32,8 @@ type connector struct {
 	channels *channels.Channels
 	actors   router.Interface
 	wfengine wfengine.Interface
+	stateTTL statettl.Expirer
+	failures *failures.Failures
 }
 
 // run starts the scheduler connector, retrying on failures so that a single
@@ -88,6 +92,8 @@ func (c *connector) run(ctx context.Context) error {
 			channels: c.channels,
 			actors:   c.actors,
 			wfengine: c.wfengine,
+			stateTTL: c.stateTTL,
+			failures: c.failures,
 		}).run(ctx)
 		if err == nil {
 			log.Infof("Scheduler stream disconnected")
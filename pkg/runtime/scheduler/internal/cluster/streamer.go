@@ -29,9 +29,13 @@ import (
 	"github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	"github.com/dapr/dapr/pkg/actors/router"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/failures"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/concurrency"
 )
@@ -43,6 +47,8 @@ type streamer struct {
 	actors   router.Interface
 	channels *channels.Channels
 	wfengine wfengine.Interface
+	stateTTL statettl.Expirer
+	failures *failures.Failures
 
 	wg       sync.WaitGroup
 	inflight atomic.Int64
@@ -81,23 +87,50 @@ func (s *streamer) receive(ctx context.Context) error {
 				s.inflight.Add(-1)
 			}()
 
-			result := s.handleJob(ctx, resp)
-			select {
-			case s.resultCh <- &schedulerv1pb.WatchJobsRequest{
-				WatchJobRequestType: &schedulerv1pb.WatchJobsRequest_Result{
-					Result: &schedulerv1pb.WatchJobsRequestResult{
-						Id:     resp.GetId(),
-						Status: result,
-					},
-				},
-			}:
-			case <-s.stream.Context().Done():
-			case <-ctx.Done():
+			// Acknowledge receipt of exactly-once triggers before invoking the
+			// app, so that the scheduler does not redeliver the trigger should
+			// this sidecar crash before reporting the result.
+			if resp.GetMetadata().GetIdempotencyToken() != "" {
+				if !s.sendResult(ctx, &schedulerv1pb.WatchJobsRequestResult{
+					Id:     resp.GetId(),
+					Status: schedulerv1pb.WatchJobsRequestResultStatus_RECEIVED,
+				}) {
+					return
+				}
 			}
+
+			resultStatus, err := s.handleJob(ctx, resp)
+			result := &schedulerv1pb.WatchJobsRequestResult{
+				Id:     resp.GetId(),
+				Status: resultStatus,
+			}
+			if err != nil {
+				result.Error = new(err.Error())
+			}
+			s.failures.Record(resp, err)
+
+			s.sendResult(ctx, result)
 		}()
 	}
 }
 
+// sendResult queues the result to be sent to the Scheduler, returning false if
+// the stream was closed first.
+func (s *streamer) sendResult(ctx context.Context, result *schedulerv1pb.WatchJobsRequestResult) bool {
+	select {
+	case s.resultCh <- &schedulerv1pb.WatchJobsRequest{
+		WatchJobRequestType: &schedulerv1pb.WatchJobsRequest_Result{
+			Result: result,
+		},
+	}:
+		return true
+	case <-s.stream.Context().Done():
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // outgoing is a long running blocking process which sends ACK scheduler job
 // results back to the Scheduler. Ack messages are collected via a channel to
 // ensure they are sent unary over the stream- gRPC does not support parallel
@@ -120,43 +153,53 @@ func (s *streamer) outgoing(ctx context.Context) error {
 	}
 }
 
-// handleJob invokes the appropriate app or actor reminder based on the job metadata.
-func (s *streamer) handleJob(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) schedulerv1pb.WatchJobsRequestResultStatus {
+// handleJob invokes the appropriate app or actor reminder based on the job
+// metadata. A failed result is returned along with the reason it failed.
+func (s *streamer) handleJob(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) (schedulerv1pb.WatchJobsRequestResultStatus, error) {
 	meta := job.GetMetadata()
 
 	switch t := meta.GetTarget(); t.GetType().(type) {
 	case *schedulerv1pb.JobTargetMetadata_Job:
+		if statettl.IsJobName(job.GetName()) {
+			if err := s.expireState(ctx, job); err != nil {
+				log.Errorf("failed to delete expired state: %s", err)
+				return schedulerv1pb.WatchJobsRequestResultStatus_FAILED, err
+			}
+
+			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, nil
+		}
+
 		err := s.invokeApp(ctx, job)
 		if err != nil {
 			log.Errorf("failed to invoke schedule app job: %s", err)
-			return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
+			return schedulerv1pb.WatchJobsRequestResultStatus_FAILED, err
 		}
 
-		return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
+		return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, nil
 
 	case *schedulerv1pb.JobTargetMetadata_Actor:
 		actorType := meta.GetTarget().GetActor().GetType()
 
 		err := s.invokeActorReminder(ctx, job)
 		if err == nil {
-			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
+			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, nil
 		}
 
 		if errors.Is(err, context.Canceled) {
-			return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
+			return schedulerv1pb.WatchJobsRequestResultStatus_FAILED, err
 		}
 
 		if errors.Is(err, actorerrors.ErrReminderCanceled) {
 			// TODO: @joshvanl add support for cancelling a job with a new
 			// WatchJobsRequestResultStatus_CANCELED.
-			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
+			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, nil
 		}
 
 		// If the actor was hosted on another instance, the error will be a gRPC status error,
 		// so we need to unwrap it and match on the error message.
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.FailedPrecondition {
-				return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
+				return schedulerv1pb.WatchJobsRequestResultStatus_FAILED, err
 			}
 
 			// Recognise the user-app "cancel this reminder" signal that crossed a
@@ -167,18 +210,35 @@ func (s *streamer) handleJob(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 			// than retrying forever.
 			_, isInternalActor := workflowacl.ParseActorType(actorType)
 			if !isInternalActor && st.Message() == actorerrors.ErrReminderCanceled.Error() {
-				return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
+				return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, nil
 			}
 		}
 
 		log.Errorf("failed to invoke scheduled actor reminder named: %s due to: %s", job.GetName(), err)
 
-		return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
+		return schedulerv1pb.WatchJobsRequestResultStatus_FAILED, err
 
 	default:
 		log.Errorf("Unknown job metadata type: %+v", t)
-		return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
+		return schedulerv1pb.WatchJobsRequestResultStatus_FAILED, fmt.Errorf("unknown job metadata type: %+v", t)
+	}
+}
+
+// expireState deletes the state of the job the runtime scheduled to delete
+// state once its emulated TTL expires. These jobs are never sent to the app.
+func (s *streamer) expireState(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) error {
+	storeName, key, ok := statettl.ParseJobName(job.GetName())
+	if !ok {
+		// Not retriable, so the job is not triggered again.
+		log.Warnf("Ignoring state expiry job with invalid name %s", job.GetName())
+		return nil
 	}
+
+	if s.stateTTL == nil {
+		return errors.New("received state expiry job, but state expiry not initialized")
+	}
+
+	return s.stateTTL.ExpireState(ctx, storeName, key)
 }
 
 // invokeApp calls the local app with the given job data.
@@ -187,10 +247,13 @@ func (s *streamer) invokeApp(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 	if appChannel == nil {
 		return errors.New("received job, but app channel not initialized")
 	}
+	if !s.channels.AppCallbackAllowed(config.AppCallbackJobs) {
+		return fmt.Errorf(messages.ErrAppCallbackDenied, config.AppCallbackJobs)
+	}
 
 	start := time.Now()
 
-	response, err := appChannel.TriggerJob(ctx, job.GetName(), job.GetData())
+	response, err := appChannel.TriggerJob(ctx, job.GetName(), job.GetData(), job.GetMetadata().GetIdempotencyToken())
 	if err != nil {
 		return fmt.Errorf("error returned from app channel while sending triggered job to app: %w", err)
 	}
@@ -234,12 +297,17 @@ func (s *streamer) invokeActorReminder(ctx context.Context, job *schedulerv1pb.W
 
 	actor := job.GetMetadata().GetTarget().GetActor()
 
+	// The span of the execution is linked to the span which created the
+	// reminder, if its trace context was stored with it.
+	scheduledBy, _ := diag.SpanContextFromW3CString(job.GetMetadata().GetTraceparent())
+
 	err := s.actors.CallReminder(ctx, &api.Reminder{
-		Name:      job.GetName(),
-		ActorType: actor.GetType(),
-		ActorID:   actor.GetId(),
-		Data:      job.GetData(),
-		SkipLock:  actor.GetType() == s.wfengine.ActivityActorType(),
+		Name:        job.GetName(),
+		ActorType:   actor.GetType(),
+		ActorID:     actor.GetId(),
+		Data:        job.GetData(),
+		SkipLock:    actor.GetType() == s.wfengine.ActivityActorType(),
+		SpanContext: scheduledBy,
 	})
 	diag.DefaultMonitoring.ActorReminderFired(actor.GetType(), err == nil)
 
@@ -15,15 +15,18 @@ package cluster
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	actorapi "github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	wfenginefake "github.com/dapr/dapr/pkg/runtime/wfengine/fake"
 )
@@ -84,7 +87,7 @@ func Test_handleJob_ErrReminderCanceled_remoteGRPC(t *testing.T) {
 				},
 			}
 
-			got := s.handleJob(t.Context(), job)
+			got, _ := s.handleJob(t.Context(), job)
 			assert.Equal(t, tc.want, got)
 		})
 	}
@@ -130,8 +133,95 @@ func Test_handleJob_ErrReminderCanceled_localSentinel(t *testing.T) {
 				},
 			}
 
-			got := s.handleJob(t.Context(), job)
+			got, _ := s.handleJob(t.Context(), job)
 			assert.Equal(t, schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, got)
 		})
 	}
 }
+
+func Test_handleJob_stateExpiry(t *testing.T) {
+	t.Parallel()
+
+	job := func(name string) *schedulerv1pb.WatchJobsResponse {
+		return &schedulerv1pb.WatchJobsResponse{
+			Name: name,
+			Metadata: &schedulerv1pb.JobMetadata{
+				Target: &schedulerv1pb.JobTargetMetadata{
+					Type: &schedulerv1pb.JobTargetMetadata_Job{
+						Job: new(schedulerv1pb.TargetJob),
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("expires the state instead of invoking the app", func(t *testing.T) {
+		t.Parallel()
+
+		var storeName, key string
+		s := &streamer{
+			stateTTL: statettl.ExpirerFunc(func(_ context.Context, s, k string) error {
+				storeName, key = s, k
+				return nil
+			}),
+		}
+
+		got, err := s.handleJob(t.Context(), job(statettl.JobName("mystore", "myapp||key1")))
+		require.NoError(t, err)
+		assert.Equal(t, schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, got)
+		assert.Equal(t, "mystore", storeName)
+		assert.Equal(t, "myapp||key1", key)
+	})
+
+	t.Run("failures are retried", func(t *testing.T) {
+		t.Parallel()
+
+		s := &streamer{
+			stateTTL: statettl.ExpirerFunc(func(context.Context, string, string) error {
+				return errors.New("delete failed")
+			}),
+		}
+
+		got, err := s.handleJob(t.Context(), job(statettl.JobName("mystore", "key1")))
+		require.Error(t, err)
+		assert.Equal(t, schedulerv1pb.WatchJobsRequestResultStatus_FAILED, got)
+	})
+}
+
+func Test_handleJob_reminderTraceparent(t *testing.T) {
+	t.Parallel()
+
+	var got *actorapi.Reminder
+	s := &streamer{
+		actors: routerfake.New().WithCallReminderFn(
+			func(_ context.Context, req *actorapi.Reminder) error {
+				got = req
+				return nil
+			},
+		),
+		wfengine: wfenginefake.New(),
+	}
+
+	const traceparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	job := &schedulerv1pb.WatchJobsResponse{
+		Name: "test-job",
+		Metadata: &schedulerv1pb.JobMetadata{
+			Traceparent: new(traceparent),
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Actor{
+					Actor: &schedulerv1pb.TargetActorReminder{
+						Type: "myactortype",
+						Id:   "instance-1",
+					},
+				},
+			},
+		},
+	}
+
+	status, _ := s.handleJob(t.Context(), job)
+	assert.Equal(t, schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS, status)
+	require.NotNil(t, got)
+	require.True(t, got.SpanContext.IsValid())
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", got.SpanContext.TraceID().String())
+	assert.Equal(t, "b7ad6b7169203331", got.SpanContext.SpanID().String())
+}
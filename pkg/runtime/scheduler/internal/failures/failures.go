@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failures
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+// maxFailures is the maximum number of failed jobs retained. When exceeded,
+// the oldest failure is dropped.
+const maxFailures = 100
+
+type failure struct {
+	time time.Time
+	err  string
+}
+
+// Failures records the most recent failed trigger of each app job delivered
+// to this sidecar, which is reported by the metadata API. A failure is removed
+// once a later trigger of the same job succeeds.
+type Failures struct {
+	lock  sync.RWMutex
+	jobs  map[string]failure
+	clock clock.Clock
+}
+
+func New() *Failures {
+	return &Failures{
+		jobs:  make(map[string]failure),
+		clock: clock.RealClock{},
+	}
+}
+
+// Record records the outcome of a job trigger, where a nil error is a
+// successful trigger. Actor reminders are not recorded.
+func (f *Failures) Record(job *schedulerv1pb.WatchJobsResponse, err error) {
+	if f == nil || job.GetMetadata().GetTarget().GetJob() == nil {
+		return
+	}
+
+	name := job.GetName()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err == nil {
+		delete(f.jobs, name)
+		return
+	}
+
+	if _, ok := f.jobs[name]; !ok && len(f.jobs) >= maxFailures {
+		oldest := ""
+		for n, fail := range f.jobs {
+			if oldest == "" || fail.time.Before(f.jobs[oldest].time) {
+				oldest = n
+			}
+		}
+		delete(f.jobs, oldest)
+	}
+
+	f.jobs[name] = failure{time: f.clock.Now(), err: err.Error()}
+}
+
+// List returns the recorded failures, ordered by job name.
+func (f *Failures) List() []*runtimev1pb.MetadataJobFailure {
+	if f == nil {
+		return nil
+	}
+
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	list := make([]*runtimev1pb.MetadataJobFailure, 0, len(f.jobs))
+	for name, fail := range f.jobs {
+		list = append(list, &runtimev1pb.MetadataJobFailure{
+			Name:  name,
+			Time:  fail.time.UTC().Format(time.RFC3339),
+			Error: fail.err,
+		})
+	}
+
+	slices.SortFunc(list, func(a, b *runtimev1pb.MetadataJobFailure) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	return list
+}
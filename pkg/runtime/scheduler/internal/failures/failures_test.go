@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failures
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+func appJob(name string) *schedulerv1pb.WatchJobsResponse {
+	return &schedulerv1pb.WatchJobsResponse{
+		Name: name,
+		Metadata: &schedulerv1pb.JobMetadata{
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Job{Job: new(schedulerv1pb.TargetJob)},
+			},
+		},
+	}
+}
+
+func TestFailures(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := clocktesting.NewFakeClock(now)
+	f := New()
+	f.clock = clock
+
+	f.Record(appJob("b"), errors.New("app returned 500"))
+	f.Record(appJob("a"), errors.New("app channel not initialized"))
+	f.Record(&schedulerv1pb.WatchJobsResponse{
+		Name: "reminder",
+		Metadata: &schedulerv1pb.JobMetadata{
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Actor{Actor: new(schedulerv1pb.TargetActorReminder)},
+			},
+		},
+	}, errors.New("actor failed"))
+
+	assert.Equal(t, []*runtimev1pb.MetadataJobFailure{
+		{Name: "a", Time: "2026-01-02T03:04:05Z", Error: "app channel not initialized"},
+		{Name: "b", Time: "2026-01-02T03:04:05Z", Error: "app returned 500"},
+	}, f.List())
+
+	t.Run("a successful trigger clears the failure", func(t *testing.T) {
+		f.Record(appJob("a"), nil)
+		list := f.List()
+		require.Len(t, list, 1)
+		assert.Equal(t, "b", list[0].GetName())
+	})
+
+	t.Run("the oldest failure is dropped when full", func(t *testing.T) {
+		for i := range maxFailures {
+			clock.Step(time.Second)
+			f.Record(appJob("job-"+strconv.Itoa(i)), errors.New("boom"))
+		}
+		list := f.List()
+		assert.Len(t, list, maxFailures)
+		for _, fail := range list {
+			assert.NotEqual(t, "b", fail.GetName())
+		}
+	})
+
+	t.Run("nil failures is a no-op", func(t *testing.T) {
+		var nilFailures *Failures
+		nilFailures.Record(appJob("a"), errors.New("boom"))
+		assert.Nil(t, nilFailures.List())
+	})
+}
@@ -19,10 +19,12 @@ import (
 	"fmt"
 
 	"github.com/dapr/dapr/pkg/actors"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
 	"github.com/dapr/dapr/pkg/config"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/cluster"
+	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/failures"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/loops"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/events/loop"
@@ -39,6 +41,8 @@ type Options struct {
 	Actors   actors.Interface
 	Channels *channels.Channels
 	WFEngine wfengine.Interface
+	StateTTL statettl.Expirer
+	Failures *failures.Failures
 }
 
 type connector struct {
@@ -48,6 +52,8 @@ type connector struct {
 	actors       actors.Interface
 	channels     *channels.Channels
 	wfEngine     wfengine.Interface
+	stateTTL     statettl.Expirer
+	failures     *failures.Failures
 
 	currentAppRunning bool
 	currentActorTypes []string
@@ -65,6 +71,8 @@ func New(opts Options) loop.Interface[loops.EventConn] {
 		actors:       opts.Actors,
 		channels:     opts.Channels,
 		wfEngine:     opts.WFEngine,
+		stateTTL:     opts.StateTTL,
+		failures:     opts.Failures,
 	})
 }
 
@@ -140,6 +148,8 @@ func (c *connector) maybeClientConnect(ctx context.Context) {
 		Actors:       c.actors,
 		Channels:     c.channels,
 		WFEngine:     c.wfEngine,
+		StateTTL:     c.stateTTL,
+		Failures:     c.failures,
 
 		AppTarget:  c.currentAppRunning,
 		ActorTypes: c.currentActorTypes,
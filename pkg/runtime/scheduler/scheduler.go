@@ -19,12 +19,14 @@ import (
 	"slices"
 
 	"github.com/dapr/dapr/pkg/actors"
+	statettl "github.com/dapr/dapr/pkg/components/state/ttl"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients/wrapper"
+	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/failures"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/loops"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/loops/connector"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/loops/hosts"
@@ -41,6 +43,7 @@ type Options struct {
 	Actors           actors.Interface
 	Channels         *channels.Channels
 	WFEngine         wfengine.Interface
+	StateTTL         statettl.Expirer
 	WorkflowSpec     *config.WorkflowSpec
 	Addresses        []string
 	Security         security.Handler
@@ -59,6 +62,8 @@ type Scheduler struct {
 }
 
 func New(opts Options) (*Scheduler, error) {
+	failures := failures.New()
+
 	connector := connector.New(connector.Options{
 		Namespace:    opts.Namespace,
 		AppID:        opts.AppID,
@@ -66,6 +71,8 @@ func New(opts Options) (*Scheduler, error) {
 		Actors:       opts.Actors,
 		Channels:     opts.Channels,
 		WFEngine:     opts.WFEngine,
+		StateTTL:     opts.StateTTL,
+		Failures:     failures,
 	})
 
 	if opts.SchedulerStreams < 1 {
@@ -95,7 +102,8 @@ func New(opts Options) (*Scheduler, error) {
 		hosts:      hosts,
 		watchhosts: watchhosts,
 		client: wrapper.New(wrapper.Options{
-			Clients: clients,
+			Clients:  clients,
+			Failures: failures,
 		}),
 	}, nil
 }
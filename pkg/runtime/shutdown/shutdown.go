@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shutdown implements the ordered graceful shutdown of daprd, which
+// drains each building block in turn before the components are closed.
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.shutdown")
+
+// Phase is a step of the graceful shutdown.
+type Phase string
+
+const (
+	// PhaseAPI stops accepting calls to the Dapr APIs.
+	PhaseAPI Phase = "api"
+	// PhaseSubscriptions unsubscribes from the topics and input bindings, and
+	// drains the in-flight deliveries to the app.
+	PhaseSubscriptions Phase = "subscriptions"
+	// PhaseActors deactivates the actors hosted by the app.
+	PhaseActors Phase = "actors"
+	// PhaseFlush flushes the telemetry buffered by daprd, which is the spans
+	// of the tracing exporter. The metrics are not buffered, as they are
+	// pulled from the metrics endpoint, and neither are the messages of the
+	// outbox, which stay in the outbox pub/sub until they are published.
+	PhaseFlush Phase = "flush"
+	// PhaseComponents closes the components.
+	PhaseComponents Phase = "components"
+)
+
+// Phases are the phases of the shutdown, in the order they run.
+var Phases = []Phase{PhaseAPI, PhaseSubscriptions, PhaseActors, PhaseFlush, PhaseComponents}
+
+// ParseTimeouts parses a comma separated list of `phase=duration` pairs, such
+// as `subscriptions=30s,actors=1m`.
+func ParseTimeouts(s string) (map[Phase]time.Duration, error) {
+	timeouts := make(map[Phase]time.Duration)
+	for pair := range strings.SplitSeq(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		phase := Phase(strings.TrimSpace(k))
+		if !ok || !slices.Contains(Phases, phase) {
+			return nil, fmt.Errorf("invalid phase timeout %q: expected phase=duration with phase one of %v", pair, Phases)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid phase timeout %q: expected a positive duration", pair)
+		}
+		timeouts[phase] = d
+	}
+	return timeouts, nil
+}
+
+type Options struct {
+	// Timeouts bound the duration of the phases. Phases without a timeout are
+	// only bounded by the context they are run with.
+	Timeouts map[Phase]time.Duration
+}
+
+// Sequence runs the functions added to each phase, one phase after the other.
+// Each phase runs once, even if the sequence is run more than once.
+type Sequence struct {
+	timeouts map[Phase]time.Duration
+	clock    clock.Clock
+
+	lock sync.Mutex
+	fns  map[Phase][]func(context.Context) error
+	next int
+}
+
+func New(opts Options) *Sequence {
+	return &Sequence{
+		timeouts: opts.Timeouts,
+		clock:    clock.RealClock{},
+		fns:      make(map[Phase][]func(context.Context) error),
+	}
+}
+
+// Add adds functions to run in the phase. The functions of a phase run
+// concurrently.
+func (s *Sequence) Add(phase Phase, fns ...func(context.Context) error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.fns[phase] = append(s.fns[phase], fns...)
+}
+
+// RunUntil runs, in order, the phases which haven't run yet up to and
+// including the given phase.
+func (s *Sequence) RunUntil(ctx context.Context, until Phase) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var errs []error
+	for s.next < len(Phases) && s.next <= slices.Index(Phases, until) {
+		phase := Phases[s.next]
+		s.next++
+		errs = append(errs, s.runPhase(ctx, phase))
+	}
+	return errors.Join(errs...)
+}
+
+// Run runs all the phases which haven't run yet.
+func (s *Sequence) Run(ctx context.Context) error {
+	return s.RunUntil(ctx, Phases[len(Phases)-1])
+}
+
+func (s *Sequence) runPhase(ctx context.Context, phase Phase) error {
+	fns := s.fns[phase]
+	if len(fns) == 0 {
+		return nil
+	}
+
+	log.Infof("Shutdown phase %s started", phase)
+	start := s.clock.Now()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var timeoutCh <-chan time.Time
+	if timeout, ok := s.timeouts[phase]; ok {
+		t := s.clock.NewTimer(timeout)
+		defer t.Stop()
+		timeoutCh = t.C()
+	}
+
+	errCh := make(chan error, len(fns))
+	for _, fn := range fns {
+		go func() {
+			errCh <- fn(ctx)
+		}()
+	}
+
+	errs := make([]error, 0, len(fns))
+	for range fns {
+		select {
+		case err := <-errCh:
+			errs = append(errs, err)
+		case <-timeoutCh:
+			log.Warnf("Shutdown phase %s timed out after %s, continuing the shutdown", phase, s.timeouts[phase])
+			return errors.Join(errs...)
+		case <-ctx.Done():
+			log.Warnf("Shutdown phase %s was interrupted: %s", phase, ctx.Err())
+			return errors.Join(append(errs, ctx.Err())...)
+		}
+	}
+
+	log.Infof("Shutdown phase %s completed in %s", phase, s.clock.Since(start))
+	return errors.Join(errs...)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestParseTimeouts(t *testing.T) {
+	got, err := ParseTimeouts(" subscriptions=30s, actors=1m ,,")
+	require.NoError(t, err)
+	assert.Equal(t, map[Phase]time.Duration{
+		PhaseSubscriptions: 30 * time.Second,
+		PhaseActors:        time.Minute,
+	}, got)
+
+	got, err = ParseTimeouts("")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	for _, s := range []string{"actors", "unknown=1s", "actors=abc", "actors=0s", "actors=-1s"} {
+		_, err = ParseTimeouts(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	t.Run("phases run in order and once", func(t *testing.T) {
+		s := New(Options{})
+
+		var lock sync.Mutex
+		var ran []Phase
+		record := func(phase Phase) func(context.Context) error {
+			return func(context.Context) error {
+				lock.Lock()
+				defer lock.Unlock()
+				ran = append(ran, phase)
+				return nil
+			}
+		}
+		for i := len(Phases) - 1; i >= 0; i-- {
+			s.Add(Phases[i], record(Phases[i]))
+		}
+		s.Add(PhaseActors, record(PhaseActors))
+
+		require.NoError(t, s.RunUntil(t.Context(), PhaseSubscriptions))
+		assert.Equal(t, []Phase{PhaseAPI, PhaseSubscriptions}, ran)
+
+		require.NoError(t, s.Run(t.Context()))
+		require.NoError(t, s.Run(t.Context()))
+		assert.Equal(t, []Phase{PhaseAPI, PhaseSubscriptions, PhaseActors, PhaseActors, PhaseFlush, PhaseComponents}, ran)
+	})
+
+	t.Run("errors are returned and don't stop the sequence", func(t *testing.T) {
+		s := New(Options{})
+		s.Add(PhaseAPI, func(context.Context) error { return errors.New("api") })
+		closed := false
+		s.Add(PhaseComponents, func(context.Context) error { closed = true; return nil })
+
+		require.ErrorContains(t, s.Run(t.Context()), "api")
+		assert.True(t, closed)
+	})
+
+	t.Run("a phase which times out is abandoned", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		s := New(Options{Timeouts: map[Phase]time.Duration{PhaseActors: 5 * time.Second}})
+		s.clock = clock
+
+		drainCanceled := make(chan struct{})
+		s.Add(PhaseActors, func(ctx context.Context) error {
+			<-ctx.Done()
+			close(drainCanceled)
+			return ctx.Err()
+		})
+		closed := make(chan struct{})
+		s.Add(PhaseComponents, func(context.Context) error { close(closed); return nil })
+
+		errCh := make(chan error)
+		go func() { errCh <- s.Run(context.Background()) }()
+
+		assert.Eventually(t, clock.HasWaiters, time.Second, time.Millisecond)
+		clock.Step(5 * time.Second)
+
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			require.Fail(t, "sequence didn't complete")
+		}
+		<-drainCanceled
+		<-closed
+	})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statusreport reports the results of loading Components and
+// Subscriptions to the operator, which reflects them in the status of the
+// resources.
+package statusreport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.statusreport")
+
+type Options struct {
+	Client    operatorv1pb.OperatorClient
+	Namespace string
+	PodName   string
+}
+
+type resourceKey struct {
+	resourceType operatorv1pb.ResourceType
+	name         string
+}
+
+// Reporter streams the results of loading the resources to the operator. It
+// keeps the latest result of every loaded resource, so they are all sent
+// again whenever the stream is re-established.
+type Reporter struct {
+	client    operatorv1pb.OperatorClient
+	namespace string
+	podName   string
+
+	lock    sync.Mutex
+	results map[resourceKey]*operatorv1pb.ResourceResult
+	// queue holds the results to send on the current stream. Results aren't
+	// queued while there is no stream, as they are all sent when it is
+	// established.
+	queue     []*operatorv1pb.ResourceResult
+	connected bool
+	notify    chan struct{}
+}
+
+func New(opts Options) *Reporter {
+	return &Reporter{
+		client:    opts.Client,
+		namespace: opts.Namespace,
+		podName:   opts.PodName,
+		results:   make(map[resourceKey]*operatorv1pb.ResourceResult),
+		notify:    make(chan struct{}, 1),
+	}
+}
+
+// ReportComponent records the result of loading the component. It matches
+// registry.Reporter.
+func (r *Reporter) ReportComponent(_ context.Context, _ componentsapi.Component, res *operatorv1pb.ResourceResult) error {
+	r.report(res)
+	return nil
+}
+
+// ReportSubscription records the result of loading the subscription.
+func (r *Reporter) ReportSubscription(_ context.Context, _ subapi.Subscription, res *operatorv1pb.ResourceResult) error {
+	r.report(res)
+	return nil
+}
+
+func (r *Reporter) report(res *operatorv1pb.ResourceResult) {
+	if r == nil || res == nil {
+		return
+	}
+
+	key := resourceKey{resourceType: res.GetResourceType(), name: res.GetName()}
+
+	r.lock.Lock()
+	if res.GetEventType() == operatorv1pb.EventType_EVENT_CLOSE {
+		delete(r.results, key)
+	} else {
+		r.results[key] = res
+	}
+	if r.connected {
+		r.queue = append(r.queue, res)
+	}
+	r.lock.Unlock()
+
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run streams the results to the operator until the context is cancelled,
+// re-establishing the stream when it fails.
+func (r *Reporter) Run(ctx context.Context) error {
+	if r == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	err := backoff.Retry(func() error {
+		serr := r.stream(ctx)
+		if ctx.Err() != nil {
+			return backoff.Permanent(ctx.Err())
+		}
+		if status.Code(serr) == codes.Unimplemented {
+			return backoff.Permanent(serr)
+		}
+		if serr == nil {
+			serr = errors.New("stream closed by the operator")
+		}
+		log.Warnf("Failed to report the status of the resources to the operator, retrying: %s", serr)
+		return serr
+	}, backoff.WithContext(backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(0)), ctx))
+
+	if ctx.Err() == nil && status.Code(err) == codes.Unimplemented {
+		log.Info("Operator doesn't support reporting the status of the resources")
+		<-ctx.Done()
+	}
+	return nil
+}
+
+// stream sends all the current results to the operator, then the new ones
+// as they are reported, until the stream fails.
+func (r *Reporter) stream(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := r.client.ReportResourceResults(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The operator only responds when the stream ends, which is otherwise not
+	// noticed until the next result is sent.
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- stream.RecvMsg(new(emptypb.Empty))
+	}()
+
+	r.lock.Lock()
+	pending := make([]*operatorv1pb.ResourceResult, 0, len(r.results))
+	for _, res := range r.results {
+		pending = append(pending, res)
+	}
+	r.queue = nil
+	r.connected = true
+	r.lock.Unlock()
+
+	defer func() {
+		r.lock.Lock()
+		r.queue = nil
+		r.connected = false
+		r.lock.Unlock()
+	}()
+
+	for {
+		for _, res := range pending {
+			if err := stream.Send(&operatorv1pb.ReportResourceResultsRequest{
+				Namespace: r.namespace,
+				PodName:   r.podName,
+				Result:    res,
+			}); err != nil {
+				if errors.Is(err, io.EOF) {
+					// The actual error is received from the operator.
+					select {
+					case err = <-recvErr:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-recvErr:
+			return err
+		case <-r.notify:
+		}
+
+		r.lock.Lock()
+		pending = r.queue
+		r.queue = nil
+		r.lock.Unlock()
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusreport
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+type server struct {
+	operatorv1pb.UnimplementedOperatorServer
+	fn func(operatorv1pb.Operator_ReportResourceResultsServer) error
+}
+
+func (s *server) ReportResourceResults(srv operatorv1pb.Operator_ReportResourceResultsServer) error {
+	return s.fn(srv)
+}
+
+func newClient(t *testing.T, srv operatorv1pb.OperatorServer) operatorv1pb.OperatorClient {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	operatorv1pb.RegisterOperatorServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return operatorv1pb.NewOperatorClient(conn)
+}
+
+func result(typ operatorv1pb.ResourceType, et operatorv1pb.EventType, name string) *operatorv1pb.ResourceResult {
+	return &operatorv1pb.ResourceResult{ResourceType: typ, EventType: et, Name: name}
+}
+
+func run(t *testing.T, r *Reporter) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- r.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "reporter didn't return")
+		}
+	})
+}
+
+func TestReporter(t *testing.T) {
+	t.Run("results are sent, and all sent again on reconnect", func(t *testing.T) {
+		var calls atomic.Int32
+		streams := make(chan []*operatorv1pb.ReportResourceResultsRequest, 2)
+		reqs := make(chan *operatorv1pb.ReportResourceResultsRequest, 10)
+		client := newClient(t, &server{fn: func(srv operatorv1pb.Operator_ReportResourceResultsServer) error {
+			call := calls.Add(1)
+			var got []*operatorv1pb.ReportResourceResultsRequest
+			for {
+				req, err := srv.Recv()
+				if err != nil {
+					return err
+				}
+				reqs <- req
+				got = append(got, req)
+				switch {
+				case call == 1 && len(got) == 3:
+					// The first stream fails once a result is streamed after
+					// the existing ones are sent.
+					streams <- got
+					return status.Error(codes.Unavailable, "restarting")
+				case call == 2 && len(got) == 2:
+					streams <- got
+					return srv.SendAndClose(new(emptypb.Empty))
+				}
+			}
+		}})
+
+		r := New(Options{Client: client, Namespace: "default", PodName: "app-a"})
+		require.NoError(t, r.ReportComponent(t.Context(), componentsapi.Component{},
+			result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, operatorv1pb.EventType_EVENT_INIT, "statestore")))
+		require.NoError(t, r.ReportComponent(t.Context(), componentsapi.Component{},
+			result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, operatorv1pb.EventType_EVENT_INIT, "pubsub")))
+		run(t, r)
+
+		for range 2 {
+			select {
+			case <-reqs:
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "results weren't sent")
+			}
+		}
+
+		require.NoError(t, r.ReportComponent(t.Context(), componentsapi.Component{},
+			result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, operatorv1pb.EventType_EVENT_CLOSE, "pubsub")))
+		require.NoError(t, r.ReportSubscription(t.Context(), subapi.Subscription{},
+			result(operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION, operatorv1pb.EventType_EVENT_INIT, "orders")))
+
+		var first, second []*operatorv1pb.ReportResourceResultsRequest
+		for _, got := range []*[]*operatorv1pb.ReportResourceResultsRequest{&first, &second} {
+			select {
+			case *got = <-streams:
+			case <-time.After(10 * time.Second):
+				require.Fail(t, "stream wasn't closed")
+			}
+		}
+
+		assert.Equal(t, "default", first[2].GetNamespace())
+		assert.Equal(t, "app-a", first[2].GetPodName())
+		assert.Equal(t, "pubsub", first[2].GetResult().GetName())
+		assert.Equal(t, operatorv1pb.EventType_EVENT_CLOSE, first[2].GetResult().GetEventType())
+
+		names := []string{second[0].GetResult().GetName(), second[1].GetResult().GetName()}
+		assert.ElementsMatch(t, []string{"statestore", "orders"}, names)
+	})
+
+	t.Run("operators without support are ignored", func(t *testing.T) {
+		r := New(Options{Client: newClient(t, new(operatorv1pb.UnimplementedOperatorServer))})
+		require.NoError(t, r.ReportComponent(t.Context(), componentsapi.Component{},
+			result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, operatorv1pb.EventType_EVENT_INIT, "statestore")))
+		run(t, r)
+	})
+
+	t.Run("nil reporter", func(t *testing.T) {
+		var r *Reporter
+		require.NoError(t, r.ReportComponent(t.Context(), componentsapi.Component{},
+			result(operatorv1pb.ResourceType_RESOURCE_COMPONENT, operatorv1pb.EventType_EVENT_INIT, "statestore")))
+		run(t, r)
+	})
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workflow delivers subscribed messages as external events raised on
+// workflow instances, rather than to the app.
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/workflows"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
+	"github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.processor.pubsub.subscription.workflow")
+
+const dataFieldPrefix = contribpubsub.DataField + "."
+
+type Options struct {
+	Workflow workflows.Workflow
+	Event    *pubsub.WorkflowEvent
+}
+
+type workflow struct {
+	workflow workflows.Workflow
+	event    *pubsub.WorkflowEvent
+}
+
+func New(opts Options) postman.Interface {
+	return &workflow{
+		workflow: opts.Workflow,
+		event:    opts.Event,
+	}
+}
+
+func (w *workflow) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error {
+	start := time.Now()
+
+	instanceID, ok := w.instanceID(msg.CloudEvent)
+	if !ok {
+		log.Warnf("Dropping pub/sub event %v: no workflow instance ID in field '%s'", msg.CloudEvent[contribpubsub.IDField], w.event.InstanceIDField)
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), "", msg.Topic, diag.ElapsedSince(start))
+		return pubsub.ErrMessageDropped
+	}
+
+	eventData, err := payload(msg.CloudEvent[contribpubsub.DataField])
+	if err != nil {
+		log.Warnf("Dropping pub/sub event %v: %s", msg.CloudEvent[contribpubsub.IDField], err)
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), "", msg.Topic, diag.ElapsedSince(start))
+		return pubsub.ErrMessageDropped
+	}
+
+	err = w.workflow.RaiseEvent(ctx, &workflows.RaiseEventRequest{
+		InstanceID: instanceID,
+		EventName:  w.event.EventName,
+		EventData:  eventData,
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	switch {
+	case err == nil:
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Success)), "", msg.Topic, elapsed)
+		return nil
+	case errors.Is(err, api.ErrInstanceNotFound):
+		log.Warnf("Dropping pub/sub event %v: workflow instance '%s' not found", msg.CloudEvent[contribpubsub.IDField], instanceID)
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), "", msg.Topic, elapsed)
+		return pubsub.ErrMessageDropped
+	default:
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Retry)), "", msg.Topic, elapsed)
+		return fmt.Errorf("failed to raise event '%s' on workflow instance '%s' for pub/sub event %v: %w", w.event.EventName, instanceID, msg.CloudEvent[contribpubsub.IDField], rterrors.NewRetriable(err))
+	}
+}
+
+func (w *workflow) DeliverBulk(context.Context, *postman.DeliverBulkRequest) error {
+	return errors.New("bulk subscribe is not supported for workflow event subscriptions")
+}
+
+// instanceID returns the workflow instance ID from the CloudEvent attribute,
+// or the field of the event data, named by the subscription.
+func (w *workflow) instanceID(cloudEvent map[string]any) (string, bool) {
+	var value any = cloudEvent
+	path, ok := strings.CutPrefix(w.event.InstanceIDField, dataFieldPrefix)
+	if ok {
+		value = cloudEvent[contribpubsub.DataField]
+	} else {
+		path = w.event.InstanceIDField
+	}
+
+	for key := range strings.SplitSeq(path, ".") {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		value = fields[key]
+	}
+
+	id, ok := value.(string)
+	return id, ok && id != ""
+}
+
+// payload returns the event data of the CloudEvent as the raw JSON input of
+// the external event.
+func payload(data any) (*wrapperspb.StringValue, error) {
+	switch data := data.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return wrapperspb.String(string(data)), nil
+	default:
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode event data: %w", err)
+		}
+		return wrapperspb.String(string(b)), nil
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/workflows"
+	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
+	"github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/durabletask-go/api"
+)
+
+func TestDeliver(t *testing.T) {
+	t.Parallel()
+
+	deliver := func(t *testing.T, field string, cloudEvent map[string]any, raiseErr error) (*workflows.RaiseEventRequest, error) {
+		t.Helper()
+
+		var got *workflows.RaiseEventRequest
+		client := fake.NewClient().WithRaiseEvent(func(_ context.Context, req *workflows.RaiseEventRequest) error {
+			got = req
+			return raiseErr
+		})
+		p := New(Options{
+			Workflow: client,
+			Event:    &pubsub.WorkflowEvent{EventName: "approval", InstanceIDField: field},
+		})
+		err := p.Deliver(t.Context(), &pubsub.SubscribedMessage{
+			CloudEvent: cloudEvent,
+			Topic:      "orders",
+			PubSub:     "mypubsub",
+		})
+		return got, err
+	}
+
+	t.Run("instance ID from CloudEvent attribute", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := deliver(t, "subject", map[string]any{
+			contribpubsub.IDField: "1",
+			"subject":             "order-1",
+			"data":                map[string]any{"approved": true},
+		}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, req)
+		assert.Equal(t, "order-1", req.InstanceID)
+		assert.Equal(t, "approval", req.EventName)
+		assert.JSONEq(t, `{"approved":true}`, req.EventData.GetValue())
+	})
+
+	t.Run("instance ID from event data", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := deliver(t, "data.order.id", map[string]any{
+			"data": map[string]any{"order": map[string]any{"id": "order-2"}},
+		}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, req)
+		assert.Equal(t, "order-2", req.InstanceID)
+		assert.JSONEq(t, `{"order":{"id":"order-2"}}`, req.EventData.GetValue())
+	})
+
+	t.Run("raw event data", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := deliver(t, "subject", map[string]any{
+			"subject": "order-3",
+			"data":    []byte("approved"),
+		}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, req)
+		assert.Equal(t, "approved", req.EventData.GetValue())
+
+		req, err = deliver(t, "subject", map[string]any{"subject": "order-3"}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, req)
+		assert.Nil(t, req.EventData)
+	})
+
+	t.Run("missing instance ID is dropped", func(t *testing.T) {
+		t.Parallel()
+
+		for _, ce := range []map[string]any{
+			{"data": map[string]any{"id": "order-4"}},
+			{"subject": 4},
+			{"subject": ""},
+		} {
+			req, err := deliver(t, "subject", ce, nil)
+			require.ErrorIs(t, err, pubsub.ErrMessageDropped)
+			assert.Nil(t, req)
+		}
+
+		req, err := deliver(t, "data.order.id", map[string]any{"data": "order-4"}, nil)
+		require.ErrorIs(t, err, pubsub.ErrMessageDropped)
+		assert.Nil(t, req)
+	})
+
+	t.Run("unknown instance is dropped", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := deliver(t, "subject", map[string]any{"subject": "order-5"}, fmt.Errorf("failed: %w", api.ErrInstanceNotFound))
+		require.ErrorIs(t, err, pubsub.ErrMessageDropped)
+	})
+
+	t.Run("other errors are retried", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := deliver(t, "subject", map[string]any{"subject": "order-6"}, errors.New("unavailable"))
+		var rErr *rterrors.RetriableError
+		require.ErrorAs(t, err, &rErr)
+		require.ErrorContains(t, err, "unavailable")
+	})
+}
@@ -29,6 +29,8 @@ import (
 	pluggablepubsub "github.com/dapr/dapr/pkg/components/pubsub"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/ratelimit"
 	"github.com/dapr/dapr/pkg/resiliency"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
@@ -50,6 +52,7 @@ type Options struct {
 	AdapterStreamer rtpubsub.AdapterStreamer
 	ConnectionID    rtpubsub.ConnectionID
 	Postman         postman.Interface
+	RateLimiter     *ratelimit.Limiter
 }
 
 type Subscription struct {
@@ -72,7 +75,8 @@ type Subscription struct {
 	closed      atomic.Bool
 	inflight    atomic.Int64
 
-	postman postman.Interface
+	postman     postman.Interface
+	rateLimiter *ratelimit.Limiter
 }
 
 var log = logger.NewLogger("dapr.runtime.processor.subscription")
@@ -89,6 +93,8 @@ var drainMaxDuration = 30 * time.Second
 // indefinitely.
 const deadLetterPublishTimeout = 30 * time.Second
 
+var errRateLimited = errors.New(messages.ErrRateLimited)
+
 const (
 	BinaryCloudEventHeaderPrefix = "ce_"
 	DefaultCloudEventContentType = "application/json"
@@ -118,11 +124,12 @@ func New(opts Options) (*Subscription, error) {
 		connectionID:    opts.ConnectionID,
 		adapterStreamer: opts.AdapterStreamer,
 		postman:         opts.Postman,
+		rateLimiter:     opts.RateLimiter,
 	}
 
 	name := s.pubsubName
 	route := s.route
-	policyDef := s.resiliency.ComponentInboundPolicy(name, resiliency.Pubsub)
+	policyDef := s.resiliency.ComponentInboundOperationPolicy(name, resiliency.Pubsub, s.topic)
 	routeMetadata := route.Metadata
 
 	namespaced := s.pubsub.NamespaceScoped
@@ -346,6 +353,15 @@ func New(opts Options) (*Subscription, error) {
 			return nil
 		}
 
+		// Messages exceeding the rate limits are redelivered by the broker, rather
+		// than sent to the dead letter topic.
+		publisherAppID, _ := cloudEvent[contribpubsub.SourceField].(string)
+		if !s.rateLimiter.Allow(ctx, config.RateLimitTrafficPubSub, publisherAppID, routePath) {
+			log.Debugf("rate limit exceeded for event %v in pubsub %s and topic %s", cloudEvent[contribpubsub.IDField], name, msgTopic)
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+			return errRateLimited
+		}
+
 		sm := &rtpubsub.SubscribedMessage{
 			CloudEvent:   cloudEvent,
 			Data:         data,
@@ -420,6 +436,11 @@ func New(opts Options) (*Subscription, error) {
 	return s, nil
 }
 
+// Topic returns the topic of the subscription.
+func (s *Subscription) Topic() string {
+	return s.topic
+}
+
 func (s *Subscription) Stop(err ...error) {
 	cause := errors.Join(err...)
 	graceful := errors.Is(cause, contribpubsub.ErrGracefulShutdown)
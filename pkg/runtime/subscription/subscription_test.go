@@ -24,7 +24,9 @@ import (
 
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
@@ -104,3 +106,55 @@ func TestTracingOnNewPublishedMessage(t *testing.T) {
 		}
 	})
 }
+
+func TestRateLimitedDelivery(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	respB, _ := json.Marshal(contribpubsub.AppResponse{Status: contribpubsub.Success})
+	fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+		WithRawDataBytes(respB).
+		WithContentType("application/json")
+	defer fakeResp.Close()
+
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.Init()
+	mockAppChannel.On("InvokeMethod", mock.MatchedBy(matchContextInterface), mock.Anything).Return(fakeResp, nil)
+
+	rateLimiter, err := ratelimit.New(ratelimit.Options{
+		Spec: &config.RateLimitSpec{Policies: []config.RateLimitPolicy{
+			{Routes: []string{"orders"}, Traffic: []string{config.RateLimitTrafficPubSub}, RequestsPerSecond: 1},
+		}},
+	})
+	require.NoError(t, err)
+
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman: http.New(http.Options{
+			Channels: new(channels.Channels).WithAppChannel(mockAppChannel),
+		}),
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			Rules: []*runtimePubsub.Rule{{Path: "orders"}},
+		},
+		RateLimiter: rateLimiter,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		ps.Stop()
+	})
+
+	for _, source := range []string{"publisher", "publisher", "other"} {
+		require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "testpubsub",
+			Topic:      "topic0",
+			Data:       []byte(`{"id":"1","source":"` + source + `","specversion":"1.0","type":"com.dapr.event.sent","data":{}}`),
+		}))
+	}
+
+	// The second message of the publisher is rejected, to be redelivered.
+	mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 2)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfengine
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+// Kinds of manual interventions on a workflow instance.
+const (
+	InterventionPause     = "pause"
+	InterventionResume    = "resume"
+	InterventionTerminate = "terminate"
+)
+
+// Audit is the reason and actor of a manual intervention, recorded in the
+// history of the workflow instance.
+type Audit struct {
+	Reason string `json:"reason,omitempty"`
+	Actor  string `json:"actor,omitempty"`
+}
+
+// Intervention is the audit record of a manual intervention on a workflow
+// instance, read from its history.
+type Intervention struct {
+	Type      string
+	Reason    string
+	Actor     string
+	Timestamp time.Time
+}
+
+// InterventionLister is implemented by workflow clients which can list the
+// manual interventions recorded in the history of workflow instances.
+type InterventionLister interface {
+	Interventions(ctx context.Context, instanceID string) ([]*Intervention, error)
+}
+
+type auditKey struct{}
+
+// WithAudit returns a context which records the given audit in the history of
+// the workflow instance paused, resumed or terminated with it.
+func WithAudit(ctx context.Context, audit Audit) context.Context {
+	return context.WithValue(ctx, auditKey{}, audit)
+}
+
+// auditFromContext returns the audit of the context, if any.
+func auditFromContext(ctx context.Context) (Audit, bool) {
+	audit, ok := ctx.Value(auditKey{}).(Audit)
+	return audit, ok && (audit.Reason != "" || audit.Actor != "")
+}
+
+// encodeAudit returns the audit of the context encoded as the input of a
+// suspend or resume event, or an empty string if there is none.
+func encodeAudit(ctx context.Context) (string, error) {
+	audit, ok := auditFromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	b, err := json.Marshal(audit)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Interventions returns the pause, resume and terminate interventions recorded
+// in the history of a workflow instance, oldest first.
+func (c *client) Interventions(ctx context.Context, instanceID string) ([]*Intervention, error) {
+	history, err := c.instanceHistory(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var interventions []*Intervention
+	for _, e := range history {
+		var (
+			kind  string
+			input string
+		)
+		switch {
+		case e.GetExecutionSuspended() != nil:
+			kind, input = InterventionPause, e.GetExecutionSuspended().GetInput().GetValue()
+		case e.GetExecutionResumed() != nil:
+			kind, input = InterventionResume, e.GetExecutionResumed().GetInput().GetValue()
+		case e.GetExecutionTerminated() != nil:
+			kind, input = InterventionTerminate, e.GetExecutionTerminated().GetInput().GetValue()
+		default:
+			continue
+		}
+
+		interventions = append(interventions, decodeIntervention(kind, input, e))
+	}
+
+	return interventions, nil
+}
+
+// decodeIntervention reads the audit of an intervention event. Events which
+// were not given an audit, such as terminations by the runtime or by SDK
+// clients, carry their reason or output as a JSON string or as other data,
+// which is used as the reason.
+func decodeIntervention(kind, input string, e *protos.HistoryEvent) *Intervention {
+	intervention := &Intervention{
+		Type:      kind,
+		Timestamp: e.GetTimestamp().AsTime(),
+	}
+
+	var audit Audit
+	var reason string
+	switch {
+	case input == "":
+	case json.Unmarshal([]byte(input), &audit) == nil && (audit.Reason != "" || audit.Actor != ""):
+		intervention.Reason = audit.Reason
+		intervention.Actor = audit.Actor
+	case json.Unmarshal([]byte(input), &reason) == nil:
+		intervention.Reason = reason
+	default:
+		intervention.Reason = input
+	}
+
+	return intervention
+}
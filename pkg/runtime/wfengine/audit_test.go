@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfengine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/components-contrib/workflows"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/kit/logger"
+)
+
+// fakeAuditClient records the reasons given to suspend and resume, and the
+// termination request.
+type fakeAuditClient struct {
+	fakeTaskHubClient
+	reasons   []string
+	terminate *protos.TerminateRequest
+}
+
+func (f *fakeAuditClient) SuspendWorkflow(_ context.Context, _ api.InstanceID, reason string) error {
+	f.reasons = append(f.reasons, reason)
+	return nil
+}
+
+func (f *fakeAuditClient) ResumeWorkflow(_ context.Context, _ api.InstanceID, reason string) error {
+	f.reasons = append(f.reasons, reason)
+	return nil
+}
+
+func (f *fakeAuditClient) TerminateWorkflow(_ context.Context, id api.InstanceID, opts ...api.TerminateOptions) error {
+	f.terminate = &protos.TerminateRequest{InstanceId: string(id)}
+	for _, opt := range opts {
+		if err := opt(f.terminate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestAudit(t *testing.T) {
+	fake := new(fakeAuditClient)
+	c := &client{logger: logger.NewLogger("test"), client: fake}
+
+	ctx := WithAudit(t.Context(), Audit{Reason: "incident 42", Actor: "alice"})
+	require.NoError(t, c.Pause(ctx, &workflows.PauseRequest{InstanceID: "wf1"}))
+	require.NoError(t, c.Resume(t.Context(), &workflows.ResumeRequest{InstanceID: "wf1"}))
+	require.NoError(t, c.Resume(WithAudit(t.Context(), Audit{}), &workflows.ResumeRequest{InstanceID: "wf1"}))
+	assert.Equal(t, []string{`{"reason":"incident 42","actor":"alice"}`, "", ""}, fake.reasons)
+
+	require.NoError(t, c.Terminate(WithAudit(t.Context(), Audit{Actor: "bob"}), &workflows.TerminateRequest{InstanceID: "wf1"}))
+	assert.JSONEq(t, `{"actor":"bob"}`, fake.terminate.GetOutput().GetValue())
+
+	require.NoError(t, c.Terminate(t.Context(), &workflows.TerminateRequest{InstanceID: "wf1"}))
+	assert.Nil(t, fake.terminate.GetOutput())
+}
+
+func TestInterventions(t *testing.T) {
+	now := time.Now().UTC()
+	at := func(d time.Duration) *timestamppb.Timestamp { return timestamppb.New(now.Add(d)) }
+
+	history := []*protos.HistoryEvent{
+		{
+			EventId: -1, Timestamp: at(0),
+			EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: &protos.ExecutionStartedEvent{Name: "wf"}},
+		},
+		{
+			EventId: -1, Timestamp: at(time.Second),
+			EventType: &protos.HistoryEvent_ExecutionSuspended{ExecutionSuspended: &protos.ExecutionSuspendedEvent{
+				Input: wrapperspb.String(`{"reason":"incident 42","actor":"alice"}`),
+			}},
+		},
+		{
+			EventId: -1, Timestamp: at(2 * time.Second),
+			EventType: &protos.HistoryEvent_ExecutionResumed{ExecutionResumed: &protos.ExecutionResumedEvent{}},
+		},
+		{
+			EventId: 0, Timestamp: at(3 * time.Second),
+			EventType: &protos.HistoryEvent_TaskScheduled{TaskScheduled: &protos.TaskScheduledEvent{Name: "act"}},
+		},
+		{
+			EventId: -1, Timestamp: at(4 * time.Second),
+			EventType: &protos.HistoryEvent_ExecutionTerminated{ExecutionTerminated: &protos.ExecutionTerminatedEvent{
+				Input: wrapperspb.String(`"workflow exceeded its max duration"`),
+			}},
+		},
+		{
+			EventId: -1, Timestamp: at(5 * time.Second),
+			EventType: &protos.HistoryEvent_ExecutionTerminated{ExecutionTerminated: &protos.ExecutionTerminatedEvent{
+				Input: wrapperspb.String(`{"result":1}`),
+			}},
+		},
+	}
+
+	c := &client{history: &fakeHistory{history: history}}
+	got, err := c.Interventions(t.Context(), "wf1")
+	require.NoError(t, err)
+	assert.Equal(t, []*Intervention{
+		{Type: InterventionPause, Reason: "incident 42", Actor: "alice", Timestamp: now.Add(time.Second)},
+		{Type: InterventionResume, Timestamp: now.Add(2 * time.Second)},
+		{Type: InterventionTerminate, Reason: "workflow exceeded its max duration", Timestamp: now.Add(4 * time.Second)},
+		{Type: InterventionTerminate, Reason: `{"result":1}`, Timestamp: now.Add(5 * time.Second)},
+	}, got)
+}
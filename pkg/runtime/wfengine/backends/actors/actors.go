@@ -19,7 +19,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -35,10 +37,12 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	actorsapi "github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
+	actorsreminders "github.com/dapr/dapr/pkg/actors/reminders"
 	"github.com/dapr/dapr/pkg/actors/table"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/activity"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/common"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/cron"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/executor"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/retentioner"
@@ -70,6 +74,7 @@ const (
 	ActivityNameLabelKey    = "activity"
 	ExecutorNameLabelKey    = "executor"
 	RetentionerNameLabelKey = "retentioner"
+	CronNameLabelKey        = "cron"
 )
 
 type Options struct {
@@ -104,6 +109,16 @@ type Options struct {
 
 	// May be nil when the WorkflowAccessPolicy feature is disabled.
 	WorkflowAccessPolicies *workflowacl.Holder
+
+	// FanOutLimits are the limits on the concurrent child workflows and
+	// activities of each workflow instance, keyed by workflow name.
+	FanOutLimits map[string]config.FanOutLimit
+
+	// WorkflowPartitions is the number of partitions workflow work items are
+	// dispatched from, defaulting to 1. WorkflowRebalanceStrategy is the order
+	// in which the partitions are drained, defaulting to round robin.
+	WorkflowPartitions        int
+	WorkflowRebalanceStrategy string
 }
 
 type Actors struct {
@@ -112,6 +127,7 @@ type Actors struct {
 	workflowActorType    string
 	activityActorType    string
 	retentionerActorType string
+	cronActorType        string
 	executorActorType    string
 
 	pendingTasksBackend    PendingTasksBackend
@@ -123,12 +139,13 @@ type Actors struct {
 	signer                 *signer.Signer
 	maxRequestBodySize     int
 	workflowAccessPolicies *workflowacl.Holder
+	fanOutLimits           map[string]config.FanOutLimit
 
 	enableClusteredDeployment       bool
 	workflowsRemoteActivityReminder bool
 
-	orchestrationWorkItemChan chan *backend.WorkflowWorkItem
-	activityWorkItemChan      chan *backend.ActivityWorkItem
+	orchestrationWorkItems *partitions[*backend.WorkflowWorkItem]
+	activityWorkItemChan   chan *backend.ActivityWorkItem
 
 	// lastEventNano is the highest external-event ingestion timestamp (unix
 	// nanoseconds) this backend has issued. It is used to hand out strictly
@@ -137,6 +154,12 @@ type Actors struct {
 	// timestamp) can tell two distinct concurrent RaiseEvent calls apart.
 	lastEventNano atomic.Int64
 
+	// The cron actor type is only registered once the app has workflow
+	// schedules.
+	cronLock       sync.Mutex
+	cronRegistered bool
+	cronCancel     context.CancelFunc
+
 	stopped atomic.Bool
 }
 
@@ -151,24 +174,33 @@ func New(opts Options) *Actors {
 		pendingTasksBackend = local.NewTasksBackend()
 	}
 
+	orchestrationWorkItems := newPartitions[*backend.WorkflowWorkItem](
+		opts.WorkflowPartitions, opts.WorkflowRebalanceStrategy,
+		func(partition, backlog int) {
+			diag.DefaultWorkflowMonitoring.WorkflowPartitionBacklog(context.Background(), partition, backlog)
+		},
+	)
+
 	return &Actors{
-		appID:                     opts.AppID,
-		namespace:                 opts.Namespace,
-		workflowActorType:         todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + WorkflowNameLabelKey,
-		activityActorType:         todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ActivityNameLabelKey,
-		executorActorType:         todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ExecutorNameLabelKey,
-		retentionerActorType:      todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + RetentionerNameLabelKey,
-		actors:                    opts.Actors,
-		resiliency:                opts.Resiliency,
-		pendingTasksBackend:       pendingTasksBackend,
-		compStore:                 opts.ComponentStore,
-		orchestrationWorkItemChan: make(chan *backend.WorkflowWorkItem, 1),
-		activityWorkItemChan:      make(chan *backend.ActivityWorkItem, 1),
-		eventSink:                 opts.EventSink,
-		retentionPolicy:           opts.RetentionPolicy,
-		signer:                    opts.Signer,
-		maxRequestBodySize:        opts.MaxRequestBodySize,
-		workflowAccessPolicies:    opts.WorkflowAccessPolicies,
+		appID:                  opts.AppID,
+		namespace:              opts.Namespace,
+		workflowActorType:      todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + WorkflowNameLabelKey,
+		activityActorType:      todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ActivityNameLabelKey,
+		executorActorType:      todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ExecutorNameLabelKey,
+		retentionerActorType:   todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + RetentionerNameLabelKey,
+		cronActorType:          todo.ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + CronNameLabelKey,
+		actors:                 opts.Actors,
+		resiliency:             opts.Resiliency,
+		pendingTasksBackend:    pendingTasksBackend,
+		compStore:              opts.ComponentStore,
+		orchestrationWorkItems: orchestrationWorkItems,
+		activityWorkItemChan:   make(chan *backend.ActivityWorkItem, 1),
+		eventSink:              opts.EventSink,
+		retentionPolicy:        opts.RetentionPolicy,
+		signer:                 opts.Signer,
+		maxRequestBodySize:     opts.MaxRequestBodySize,
+		workflowAccessPolicies: opts.WorkflowAccessPolicies,
+		fanOutLimits:           opts.FanOutLimits,
 
 		enableClusteredDeployment:       opts.EnableClusteredDeployment,
 		workflowsRemoteActivityReminder: opts.WorkflowsRemoteActivityReminder,
@@ -194,15 +226,13 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		Signer:                 abe.signer,
 		MaxRequestBodySize:     abe.maxRequestBodySize,
 		WorkflowAccessPolicies: abe.workflowAccessPolicies,
+		FanOutLimits:           abe.fanOutLimits,
 		Scheduler: func(ctx context.Context, wi *backend.WorkflowWorkItem) error {
 			log.Debugf("%s: scheduling workflow execution with durabletask engine", wi.InstanceID)
 
-			select {
-			case <-ctx.Done(): // <-- engine is shutting down or a caller timeout expired
-				return ctx.Err()
-			case abe.orchestrationWorkItemChan <- wi: // blocks until the engine is ready to process the work item
-				return nil
-			}
+			// Blocks until the engine is ready to process the work item, or the
+			// engine is shutting down or a caller timeout expired.
+			return abe.orchestrationWorkItems.push(ctx, wi.InstanceID.String(), wi)
 		},
 		EventSink:        abe.eventSink,
 		ActorTypeBuilder: actorTypeBuilder,
@@ -264,9 +294,98 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		Factories: factories,
 	})
 
+	// Register the cron actor type in the background if the app already has
+	// workflow schedules, so that their runs are delivered after a restart.
+	abe.cronLock.Lock()
+	if abe.cronCancel == nil {
+		var cronCtx context.Context
+		cronCtx, abe.cronCancel = context.WithCancel(context.Background())
+		go abe.registerScheduledCronActor(cronCtx)
+	}
+	abe.cronLock.Unlock()
+
+	return nil
+}
+
+// registerCronActor registers the cron actor type which starts the runs of
+// the workflow schedules, if it's not registered yet.
+func (abe *Actors) registerCronActor(ctx context.Context) error {
+	abe.cronLock.Lock()
+	defer abe.cronLock.Unlock()
+
+	if abe.cronRegistered {
+		return nil
+	}
+	// The actor types were unregistered while looking up the schedules.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	atable, err := abe.actors.Table(ctx)
+	if err != nil {
+		return err
+	}
+
+	factory, err := cron.New(ctx, cron.Options{
+		Actors:            abe.actors,
+		WorkflowActorType: abe.workflowActorType,
+		ActorType:         abe.cronActorType,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Registering workflow cron actor type")
+	atable.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{{
+			Type:    abe.cronActorType,
+			Factory: factory,
+		}},
+	})
+	abe.cronRegistered = true
+
 	return nil
 }
 
+// registerScheduledCronActor registers the cron actor type if the scheduler
+// holds a workflow schedule of the app.
+func (abe *Actors) registerScheduledCronActor(ctx context.Context) {
+	err := backoff.Retry(func() error {
+		reminders, err := abe.actors.Reminders(ctx)
+		if err != nil {
+			return err
+		}
+		sched, err := reminders.Scheduler()
+		if errors.Is(err, actorsreminders.ErrReminderStorageNotSet) {
+			// Schedules require the scheduler.
+			return nil
+		}
+		if err != nil || sched == nil {
+			return err
+		}
+
+		resp, err := sched.ListPage(ctx, &actorsapi.ListRemindersPageRequest{
+			ActorType: abe.cronActorType,
+			PageSize:  1,
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.Reminders) == 0 {
+			return nil
+		}
+		return abe.registerCronActor(ctx)
+	}, backoff.WithContext(
+		backoff.NewExponentialBackOff(
+			backoff.WithMaxInterval(10*time.Second),
+			backoff.WithMaxElapsedTime(0),
+		), ctx),
+	)
+	if err != nil && ctx.Err() == nil {
+		log.Warnf("Failed to look up the workflow schedules: %s", err)
+	}
+}
+
 func (abe *Actors) UnRegisterActors(ctx context.Context) error {
 	table, err := abe.actors.Table(ctx)
 	if err != nil {
@@ -282,6 +401,17 @@ func (abe *Actors) UnRegisterActors(ctx context.Context) error {
 		actorTypes = append(actorTypes, abe.executorActorType)
 	}
 
+	abe.cronLock.Lock()
+	if abe.cronCancel != nil {
+		abe.cronCancel()
+		abe.cronCancel = nil
+	}
+	if abe.cronRegistered {
+		actorTypes = append(actorTypes, abe.cronActorType)
+		abe.cronRegistered = false
+	}
+	abe.cronLock.Unlock()
+
 	return table.UnRegisterActorTypes(actorTypes...)
 }
 
@@ -342,6 +472,12 @@ func (abe *Actors) CreateWorkflowInstance(ctx context.Context, e *backend.Histor
 		return errors.New("the ExecutionStartedEvent did not contain orchestration instance information")
 	} else {
 		workflowInstanceID = oi.GetInstanceId()
+		if tags := todo.StartTags(ctx); len(tags) > 0 {
+			if es.Tags == nil {
+				es.Tags = make(map[string]string, len(tags))
+			}
+			maps.Copy(es.Tags, tags)
+		}
 	}
 
 	requestBytes, err := proto.Marshal(&backend.CreateWorkflowInstanceRequest{
@@ -739,13 +875,12 @@ func (abe *Actors) loadInternalState(ctx context.Context, id api.InstanceID) (*s
 // NextWorkflowWorkItem implements backend.Backend
 func (abe *Actors) NextWorkflowWorkItem(ctx context.Context) (*backend.WorkflowWorkItem, error) {
 	// Wait for the workflow actor to signal us with some work to do
-	select {
-	case wi := <-abe.orchestrationWorkItemChan:
-		log.Debugf("Actor backend received a workflow task for workflow '%s'.", wi.InstanceID)
-		return wi, nil
-	case <-ctx.Done():
-		return nil, ctx.Err()
+	wi, err := abe.orchestrationWorkItems.pop(ctx)
+	if err != nil {
+		return nil, err
 	}
+	log.Debugf("Actor backend received a workflow task for workflow '%s'.", wi.InstanceID)
+	return wi, nil
 }
 
 // NextActivityWorkItem implements backend.Backend
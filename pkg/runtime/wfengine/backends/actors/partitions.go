@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"hash/fnv"
+	"slices"
+	"sync"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+// partitions dispatches work items to the durabletask engine from a fixed
+// number of partitions. Work items are assigned to a partition by hashing
+// their workflow instance ID, and partitions are drained in the order of the
+// rebalance strategy.
+type partitions[T any] struct {
+	lock     sync.Mutex
+	queues   [][]*pendingItem[T]
+	strategy string
+	// next is the partition drained next by the round robin strategy.
+	next int
+
+	// readyCh is signalled whenever an item is queued, waking up a waiting
+	// dispatcher.
+	readyCh chan struct{}
+
+	// onBacklog is called with the new backlog of a partition whenever it
+	// changes.
+	onBacklog func(partition, backlog int)
+}
+
+type pendingItem[T any] struct {
+	item    T
+	takenCh chan struct{}
+}
+
+// newPartitions returns n partitions drained with the given strategy. Fewer
+// than one partition defaults to a single partition, and an unknown strategy
+// to round robin.
+func newPartitions[T any](n int, strategy string, onBacklog func(partition, backlog int)) *partitions[T] {
+	if onBacklog == nil {
+		onBacklog = func(int, int) {}
+	}
+
+	return &partitions[T]{
+		queues:    make([][]*pendingItem[T], max(n, 1)),
+		strategy:  strategy,
+		readyCh:   make(chan struct{}, 1),
+		onBacklog: onBacklog,
+	}
+}
+
+// partition returns the partition of the given workflow instance ID.
+func (p *partitions[T]) partition(instanceID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(instanceID))
+	return int(h.Sum32() % uint32(len(p.queues)))
+}
+
+// push queues the item on the partition of the given workflow instance ID and
+// blocks until it has been taken by the engine, or the context is done.
+func (p *partitions[T]) push(ctx context.Context, instanceID string, item T) error {
+	i := p.partition(instanceID)
+	pending := &pendingItem[T]{item: item, takenCh: make(chan struct{})}
+
+	p.lock.Lock()
+	p.queues[i] = append(p.queues[i], pending)
+	p.onBacklog(i, len(p.queues[i]))
+	p.lock.Unlock()
+	p.signal()
+
+	select {
+	case <-pending.takenCh:
+		return nil
+	case <-ctx.Done():
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		idx := slices.Index(p.queues[i], pending)
+		if idx < 0 {
+			// Taken concurrently with the context being done.
+			return nil
+		}
+		p.queues[i] = slices.Delete(p.queues[i], idx, idx+1)
+		p.onBacklog(i, len(p.queues[i]))
+		return ctx.Err()
+	}
+}
+
+// pop blocks until an item is queued and returns it, taking it from the
+// partition chosen by the rebalance strategy.
+func (p *partitions[T]) pop(ctx context.Context) (T, error) {
+	for {
+		if item, ok := p.take(); ok {
+			return item, nil
+		}
+
+		select {
+		case <-p.readyCh:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+func (p *partitions[T]) take() (T, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	i := p.choose()
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+
+	pending := p.queues[i][0]
+	p.queues[i][0] = nil
+	p.queues[i] = p.queues[i][1:]
+	p.onBacklog(i, len(p.queues[i]))
+	close(pending.takenCh)
+
+	// Wake up another dispatcher if there is more work queued.
+	if slices.ContainsFunc(p.queues, func(q []*pendingItem[T]) bool { return len(q) > 0 }) {
+		p.signal()
+	}
+
+	return pending.item, true
+}
+
+// choose returns the partition to take the next item from, or -1 if all
+// partitions are empty. Must be called with the lock held.
+func (p *partitions[T]) choose() int {
+	n := len(p.queues)
+
+	if p.strategy == config.WorkflowRebalanceBacklog {
+		chosen := -1
+		for i, q := range p.queues {
+			if len(q) > 0 && (chosen < 0 || len(q) > len(p.queues[chosen])) {
+				chosen = i
+			}
+		}
+		return chosen
+	}
+
+	for j := range n {
+		i := (p.next + j) % n
+		if len(p.queues[i]) > 0 {
+			p.next = (i + 1) % n
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *partitions[T]) signal() {
+	select {
+	case p.readyCh <- struct{}{}:
+	default:
+	}
+}
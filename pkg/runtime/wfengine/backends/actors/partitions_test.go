@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestPartitions(t *testing.T) {
+	// idsFor returns n instance IDs which hash to the given partition.
+	idsFor := func(p *partitions[string], partition, n int) []string {
+		var ids []string
+		for i := 0; len(ids) < n; i++ {
+			id := "wf-" + strconv.Itoa(i)
+			if p.partition(id) == partition {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	// queue pushes the IDs in order, waiting for each to be queued, and
+	// returns a wait group which is done once all have been taken.
+	queue := func(t *testing.T, p *partitions[string], backlog func() int, ids ...string) *sync.WaitGroup {
+		t.Helper()
+		var wg sync.WaitGroup
+		for _, id := range ids {
+			want := backlog() + 1
+			wg.Go(func() {
+				assert.NoError(t, p.push(t.Context(), id, id))
+			})
+			require.Eventually(t, func() bool { return backlog() == want }, time.Second*5, time.Millisecond)
+		}
+		return &wg
+	}
+
+	newTracked := func(n int, strategy string) (*partitions[string], func() int, func(int) int) {
+		var lock sync.Mutex
+		backlogs := make(map[int]int)
+		p := newPartitions[string](n, strategy, func(partition, backlog int) {
+			lock.Lock()
+			defer lock.Unlock()
+			backlogs[partition] = backlog
+		})
+		total := func() int {
+			lock.Lock()
+			defer lock.Unlock()
+			var sum int
+			for _, b := range backlogs {
+				sum += b
+			}
+			return sum
+		}
+		of := func(partition int) int {
+			lock.Lock()
+			defer lock.Unlock()
+			return backlogs[partition]
+		}
+		return p, total, of
+	}
+
+	t.Run("defaults to a single partition", func(t *testing.T) {
+		p := newPartitions[string](0, config.WorkflowRebalanceRoundRobin, nil)
+		assert.Len(t, p.queues, 1)
+		assert.Equal(t, 0, p.partition("abc"))
+	})
+
+	t.Run("push blocks until taken", func(t *testing.T) {
+		p := newPartitions[string](4, config.WorkflowRebalanceRoundRobin, nil)
+
+		pushed := make(chan error)
+		go func() { pushed <- p.push(t.Context(), "wf1", "item") }()
+
+		select {
+		case <-pushed:
+			require.Fail(t, "push returned before the item was taken")
+		case <-time.After(time.Millisecond * 100):
+		}
+
+		item, err := p.pop(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "item", item)
+		require.NoError(t, <-pushed)
+	})
+
+	t.Run("round robin drains partitions in turn", func(t *testing.T) {
+		p, total, _ := newTracked(2, config.WorkflowRebalanceRoundRobin)
+		a := idsFor(p, 0, 3)
+		b := idsFor(p, 1, 1)
+
+		wg := queue(t, p, total, a[0], a[1], a[2], b[0])
+
+		var got []string
+		for range 4 {
+			item, err := p.pop(t.Context())
+			require.NoError(t, err)
+			got = append(got, item)
+		}
+		wg.Wait()
+		assert.Equal(t, []string{a[0], b[0], a[1], a[2]}, got)
+	})
+
+	t.Run("backlog drains the largest partition first", func(t *testing.T) {
+		p, total, of := newTracked(2, config.WorkflowRebalanceBacklog)
+		a := idsFor(p, 0, 1)
+		b := idsFor(p, 1, 3)
+
+		wg := queue(t, p, total, a[0], b[0], b[1], b[2])
+		assert.Equal(t, 1, of(0))
+		assert.Equal(t, 3, of(1))
+
+		var got []string
+		for range 4 {
+			item, err := p.pop(t.Context())
+			require.NoError(t, err)
+			got = append(got, item)
+		}
+		wg.Wait()
+		assert.Equal(t, []string{b[0], b[1], a[0], b[2]}, got)
+		assert.Equal(t, 0, total())
+	})
+
+	t.Run("cancelled push is removed from the backlog", func(t *testing.T) {
+		p, total, _ := newTracked(2, config.WorkflowRebalanceRoundRobin)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		pushed := make(chan error)
+		go func() { pushed <- p.push(ctx, "wf1", "item") }()
+		require.Eventually(t, func() bool { return total() == 1 }, time.Second*5, time.Millisecond)
+
+		cancel()
+		require.ErrorIs(t, <-pushed, context.Canceled)
+		assert.Equal(t, 0, total())
+
+		popCtx, popCancel := context.WithTimeout(t.Context(), time.Millisecond*100)
+		defer popCancel()
+		_, err := p.pop(popCtx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("concurrent dispatchers take every item", func(t *testing.T) {
+		p := newPartitions[string](8, config.WorkflowRebalanceBacklog, nil)
+		const n = 200
+
+		var pushers sync.WaitGroup
+		for i := range n {
+			pushers.Go(func() {
+				assert.NoError(t, p.push(t.Context(), "wf-"+strconv.Itoa(i), strconv.Itoa(i)))
+			})
+		}
+
+		var lock sync.Mutex
+		seen := make(map[string]struct{}, n)
+		var poppers sync.WaitGroup
+		for range 4 {
+			poppers.Go(func() {
+				for {
+					lock.Lock()
+					done := len(seen) == n
+					lock.Unlock()
+					if done {
+						return
+					}
+					ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond*50)
+					item, err := p.pop(ctx)
+					cancel()
+					if err == nil {
+						lock.Lock()
+						seen[item] = struct{}{}
+						lock.Unlock()
+					}
+				}
+			})
+		}
+
+		pushers.Wait()
+		poppers.Wait()
+		assert.Len(t, seen, n)
+	})
+}
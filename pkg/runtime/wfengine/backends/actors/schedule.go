@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	actorsapi "github.com/dapr/dapr/pkg/actors/api"
+	actorsreminders "github.com/dapr/dapr/pkg/actors/reminders"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// ScheduleWorkflow creates, or replaces, the cron schedule which starts the
+// workflow of the given ExecutionStarted event. Each run reuses the instance
+// ID of the event.
+func (abe *Actors) ScheduleWorkflow(ctx context.Context, e *backend.HistoryEvent, schedule string) error {
+	es := e.GetExecutionStarted()
+	if es == nil {
+		return errors.New("the history event must be an ExecutionStartedEvent")
+	}
+
+	data, err := anypb.New(e)
+	if err != nil {
+		return err
+	}
+
+	// The cron actor type must be hosted to create its reminder.
+	if err = abe.registerCronActor(ctx); err != nil {
+		return err
+	}
+
+	reminders, err := abe.actors.Reminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	return reminders.Create(ctx, &actorsapi.CreateReminderRequest{
+		Name:      todo.CronReminderName,
+		ActorType: abe.cronActorType,
+		ActorID:   es.GetWorkflowInstance().GetInstanceId(),
+		Data:      data,
+		Schedule:  schedule,
+		// Retry every second until the run starts, which queues a run behind
+		// a previous run that is still running.
+		FailurePolicy: &commonv1pb.JobFailurePolicy{
+			Policy: &commonv1pb.JobFailurePolicy_Constant{
+				Constant: &commonv1pb.JobFailurePolicyConstant{
+					Interval:   durationpb.New(time.Second),
+					MaxRetries: nil,
+				},
+			},
+		},
+	})
+}
+
+// DeleteWorkflowSchedule deletes the cron schedule of the workflow with the
+// given instance ID, and returns true if the workflow had a schedule.
+func (abe *Actors) DeleteWorkflowSchedule(ctx context.Context, id api.InstanceID) (bool, error) {
+	reminders, err := abe.actors.Reminders(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// The scheduler is used directly since the cron actor type is only
+	// registered once the app has schedules.
+	sched, err := reminders.Scheduler()
+	if errors.Is(err, actorsreminders.ErrReminderStorageNotSet) {
+		// Schedules require the scheduler.
+		return false, nil
+	}
+	if err != nil || sched == nil {
+		return false, err
+	}
+
+	reminder, err := sched.Get(ctx, &actorsapi.GetReminderRequest{
+		Name:      todo.CronReminderName,
+		ActorType: abe.cronActorType,
+		ActorID:   id.String(),
+	})
+	if err != nil || reminder == nil {
+		return false, err
+	}
+
+	err = sched.Delete(ctx, &actorsapi.DeleteReminderRequest{
+		Name:      todo.CronReminderName,
+		ActorType: abe.cronActorType,
+		ActorID:   id.String(),
+	})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
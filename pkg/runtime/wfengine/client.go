@@ -18,9 +18,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 
 	"github.com/dapr/components-contrib/workflows"
@@ -39,9 +42,56 @@ var statusMap = map[int32]string{
 	7: "SUSPENDED",
 }
 
+// defaultListPageSize is the number of workflow instances listed per page if
+// no page size is requested.
+const defaultListPageSize = 100
+
 type client struct {
 	logger logger.Logger
 	client backend.TaskHubClient
+	lister instanceIDLister
+
+	history   instanceHistoryGetter
+	executor  workflowExecutor
+	schedules workflowScheduler
+}
+
+// workflowScheduler manages the cron schedules of workflows.
+type workflowScheduler interface {
+	ScheduleWorkflow(ctx context.Context, e *backend.HistoryEvent, schedule string) error
+	DeleteWorkflowSchedule(ctx context.Context, id api.InstanceID) (bool, error)
+}
+
+// instanceIDLister lists the IDs of the workflow instances of the app.
+type instanceIDLister interface {
+	ListInstanceIDs(ctx context.Context, req *protos.ListInstanceIDsRequest) (*protos.ListInstanceIDsResponse, error)
+}
+
+// InstanceLister is implemented by workflow clients which can list workflow
+// instances.
+type InstanceLister interface {
+	List(ctx context.Context, req *ListRequest) (*ListResponse, error)
+}
+
+// ListRequest is the request to list workflow instances. Instances must match
+// all of the set filters.
+type ListRequest struct {
+	// RuntimeStatuses selects instances with one of the given runtime
+	// statuses, for example "RUNNING".
+	RuntimeStatuses []string
+	WorkflowName    *string
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+
+	PageSize          *uint32
+	ContinuationToken *string
+}
+
+// ListResponse is a page of listed workflow instances.
+type ListResponse struct {
+	Workflows []*workflows.WorkflowState
+	// ContinuationToken is set if there are more instances to list.
+	ContinuationToken *string
 }
 
 func (c *client) Init(metadata workflows.Metadata) error {
@@ -68,6 +118,7 @@ func (c *client) Start(ctx context.Context, req *workflows.StartRequest) (*workf
 	}
 
 	// Start time is also optional and must be in the RFC3339 format (e.g. 2009-11-10T23:00:00Z).
+	tags := make(map[string]string, 2)
 	if req.Options != nil {
 		if startTimeRFC3339, ok := req.Options["dapr.workflow.start_time"]; ok {
 			if startTime, err := time.Parse(time.RFC3339, startTimeRFC3339); err != nil {
@@ -76,6 +127,28 @@ func (c *client) Start(ctx context.Context, req *workflows.StartRequest) (*workf
 				opts = append(opts, api.WithStartTime(startTime))
 			}
 		}
+
+		// The max duration is optional and must be a positive Go duration
+		// (e.g. "1h30m"). The compensation activity requires a max duration.
+		if maxDuration, ok := req.Options[todo.TagMaxDuration]; ok {
+			if d, err := time.ParseDuration(maxDuration); err != nil || d <= 0 {
+				return nil, fmt.Errorf(`max duration must be a positive duration (e.g. "1h30m"), got %q`, maxDuration)
+			}
+			tags[todo.TagMaxDuration] = maxDuration
+		}
+		if activity, ok := req.Options[todo.TagCompensationActivity]; ok {
+			if _, ok = tags[todo.TagMaxDuration]; !ok || activity == "" {
+				return nil, fmt.Errorf("a compensation activity requires a non-empty name and the %q option", todo.TagMaxDuration)
+			}
+			tags[todo.TagCompensationActivity] = activity
+		}
+		if len(tags) > 0 {
+			ctx = todo.WithStartTags(ctx, tags)
+		}
+
+		if schedule, ok := req.Options[todo.OptionCron]; ok {
+			return c.startSchedule(ctx, req, schedule, tags)
+		}
 	}
 
 	workflowID, err := c.client.ScheduleNewWorkflow(ctx, req.WorkflowName, opts...)
@@ -91,19 +164,93 @@ func (c *client) Start(ctx context.Context, req *workflows.StartRequest) (*workf
 	return res, nil
 }
 
+// startSchedule creates the cron schedule which starts the workflow, each run
+// reusing the instance ID of the request.
+func (c *client) startSchedule(ctx context.Context, req *workflows.StartRequest, schedule string, tags map[string]string) (*workflows.StartResponse, error) {
+	if c.schedules == nil {
+		return nil, errors.New("scheduled workflows are not supported")
+	}
+	if req.InstanceID == nil || *req.InstanceID == "" {
+		return nil, errors.New("a scheduled workflow requires an instance ID")
+	}
+	if schedule == "" {
+		return nil, errors.New("a cron schedule must not be empty")
+	}
+	if _, ok := req.Options["dapr.workflow.start_time"]; ok {
+		return nil, errors.New("a scheduled workflow cannot have a start time")
+	}
+
+	switch policy := req.Options[todo.OptionOverlapPolicy]; policy {
+	case "", todo.OverlapPolicySkip, todo.OverlapPolicyQueue, todo.OverlapPolicyTerminatePrevious:
+		if policy != "" {
+			tags[todo.OptionOverlapPolicy] = policy
+		}
+	default:
+		return nil, fmt.Errorf("invalid overlap policy %q: must be one of %q, %q or %q",
+			policy, todo.OverlapPolicySkip, todo.OverlapPolicyQueue, todo.OverlapPolicyTerminatePrevious)
+	}
+
+	err := c.schedules.ScheduleWorkflow(ctx, &protos.HistoryEvent{
+		EventId: -1,
+		EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{
+				Name:             req.WorkflowName,
+				Input:            req.WorkflowInput,
+				Tags:             tags,
+				WorkflowInstance: &protos.WorkflowInstance{InstanceId: *req.InstanceID},
+			},
+		},
+	}, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule workflow: %w", err)
+	}
+
+	c.logger.Debugf("Scheduled workflow '%s' with ID '%s' on schedule '%s'", req.WorkflowName, *req.InstanceID, schedule)
+
+	return &workflows.StartResponse{InstanceID: *req.InstanceID}, nil
+}
+
+// deleteSchedule deletes the cron schedule of the workflow, and returns true
+// if it had one.
+func (c *client) deleteSchedule(ctx context.Context, instanceID string) (bool, error) {
+	if c.schedules == nil {
+		return false, nil
+	}
+	scheduled, err := c.schedules.DeleteWorkflowSchedule(ctx, api.InstanceID(instanceID))
+	if err != nil {
+		return false, fmt.Errorf("failed to delete schedule of workflow %s: %w", instanceID, err)
+	}
+	return scheduled, nil
+}
+
 func (c *client) Terminate(ctx context.Context, req *workflows.TerminateRequest) error {
 	if req.InstanceID == "" {
 		return errors.New("a workflow instance ID is required")
 	}
 
+	// Terminating a scheduled workflow also stops its schedule, so that no
+	// new run starts once the current run is terminated.
+	scheduled, err := c.deleteSchedule(ctx, req.InstanceID)
+	if err != nil {
+		return err
+	}
+
 	var opts []api.TerminateOptions
 	if req.Recursive != nil {
 		opts = append(opts, api.WithRecursiveTerminate(*req.Recursive))
 	}
+	// The audit of the termination is the output of the terminated workflow.
+	if audit, ok := auditFromContext(ctx); ok {
+		opts = append(opts, api.WithOutput(audit))
+	}
 
-	err := c.client.TerminateWorkflow(ctx, api.InstanceID(req.InstanceID), opts...)
+	err = c.client.TerminateWorkflow(ctx, api.InstanceID(req.InstanceID), opts...)
 	if err != nil {
 		if errors.Is(err, api.ErrInstanceNotFound) {
+			// A schedule without a run yet was stopped.
+			if scheduled {
+				return nil
+			}
 			c.logger.Infof("No such instance exists: '%s'", req.InstanceID)
 			return err
 		}
@@ -121,14 +268,25 @@ func (c *client) Purge(ctx context.Context, req *workflows.PurgeRequest) error {
 		return errors.New("a workflow instance ID is required")
 	}
 
+	// Purging a scheduled workflow also deletes its schedule, before the
+	// current run is purged so no new run can start in between.
+	scheduled, err := c.deleteSchedule(ctx, req.InstanceID)
+	if err != nil {
+		return err
+	}
+
 	var opts []api.PurgeOptions
 	if req.Recursive != nil {
 		opts = append(opts, api.WithRecursivePurge(*req.Recursive))
 	}
 
-	err := c.client.PurgeWorkflowState(ctx, api.InstanceID(req.InstanceID), opts...)
+	err = c.client.PurgeWorkflowState(ctx, api.InstanceID(req.InstanceID), opts...)
 	if err != nil {
 		if errors.Is(err, api.ErrInstanceNotFound) {
+			// A schedule without a run yet was deleted.
+			if scheduled {
+				return nil
+			}
 			c.logger.Warnf("Unable to purge the instance: '%s', no such instance exists", req.InstanceID)
 			return err
 		}
@@ -181,23 +339,28 @@ func (c *client) Get(ctx context.Context, req *workflows.GetRequest) (*workflows
 		return nil, fmt.Errorf("failed to get workflow metadata for '%s': %w", req.InstanceID, err)
 	}
 
-	res := &workflows.StateResponse{
-		Workflow: &workflows.WorkflowState{
-			InstanceID:    req.InstanceID,
-			WorkflowName:  metadata.GetName(),
-			CreatedAt:     metadata.GetCreatedAt().AsTime(),
-			LastUpdatedAt: metadata.GetLastUpdatedAt().AsTime(),
-			RuntimeStatus: getStatusString(int32(metadata.GetRuntimeStatus())),
-			Properties:    make(map[string]string),
-		},
+	return &workflows.StateResponse{
+		Workflow: workflowState(req.InstanceID, metadata),
+	}, nil
+}
+
+// workflowState returns the state of a workflow instance from its metadata.
+func workflowState(instanceID string, metadata *backend.WorkflowMetadata) *workflows.WorkflowState {
+	res := &workflows.WorkflowState{
+		InstanceID:    instanceID,
+		WorkflowName:  metadata.GetName(),
+		CreatedAt:     metadata.GetCreatedAt().AsTime(),
+		LastUpdatedAt: metadata.GetLastUpdatedAt().AsTime(),
+		RuntimeStatus: getStatusString(int32(metadata.GetRuntimeStatus())),
+		Properties:    make(map[string]string),
 	}
 
 	if metadata.GetCustomStatus() != nil {
-		res.Workflow.Properties["dapr.workflow.custom_status"] = metadata.GetCustomStatus().GetValue()
+		res.Properties["dapr.workflow.custom_status"] = metadata.GetCustomStatus().GetValue()
 	}
 
 	if metadata.Input != nil {
-		res.Workflow.Properties["dapr.workflow.input"] = metadata.GetInput().GetValue()
+		res.Properties["dapr.workflow.input"] = metadata.GetInput().GetValue()
 	}
 
 	// A failed workflow has no successful output: durabletask-go stores the
@@ -205,28 +368,93 @@ func (c *client) Get(ctx context.Context, req *workflows.GetRequest) (*workflows
 	// but the failure is already reported via dapr.workflow.failure.* below, so
 	// it must not also be exposed as dapr.workflow.output.
 	if metadata.Output != nil && metadata.FailureDetails == nil {
-		res.Workflow.Properties["dapr.workflow.output"] = metadata.GetOutput().GetValue()
+		res.Properties["dapr.workflow.output"] = metadata.GetOutput().GetValue()
 	}
 
 	// Status-specific fields
 	if metadata.FailureDetails != nil {
-		res.Workflow.Properties["dapr.workflow.failure.error_type"] = metadata.GetFailureDetails().GetErrorType()
+		res.Properties["dapr.workflow.failure.error_type"] = metadata.GetFailureDetails().GetErrorType()
 
-		res.Workflow.Properties["dapr.workflow.failure.error_message"] = metadata.GetFailureDetails().GetErrorMessage()
+		res.Properties["dapr.workflow.failure.error_message"] = metadata.GetFailureDetails().GetErrorMessage()
 		if trace := metadata.GetFailureDetails().GetStackTrace(); trace != nil {
-			res.Workflow.Properties["dapr.workflow.failure.stack_trace"] = trace.GetValue()
+			res.Properties["dapr.workflow.failure.stack_trace"] = trace.GetValue()
+		}
+	}
+
+	return res
+}
+
+// List lists a page of the workflow instances of the app. Filters are applied
+// to each page of instances read from the state store, so a page may hold
+// fewer instances than the page size even if more instances match.
+func (c *client) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
+	if c.lister == nil {
+		return nil, errors.New("listing workflow instances is not supported")
+	}
+
+	pageSize := req.PageSize
+	if pageSize == nil || *pageSize == 0 {
+		pageSize = new(uint32(defaultListPageSize))
+	}
+
+	ids, err := c.lister.ListInstanceIDs(ctx, &protos.ListInstanceIDsRequest{
+		PageSize:          pageSize,
+		ContinuationToken: req.ContinuationToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow instances: %w", err)
+	}
+
+	res := &ListResponse{
+		Workflows:         make([]*workflows.WorkflowState, 0, len(ids.GetInstanceIds())),
+		ContinuationToken: ids.ContinuationToken,
+	}
+	for _, id := range ids.GetInstanceIds() {
+		metadata, err := c.client.FetchWorkflowMetadata(ctx, api.InstanceID(id))
+		if err != nil {
+			// The instance may have been purged since it was listed.
+			if errors.Is(err, api.ErrInstanceNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get workflow metadata for '%s': %w", id, err)
+		}
+
+		state := workflowState(id, metadata)
+		if req.matches(state) {
+			res.Workflows = append(res.Workflows, state)
 		}
 	}
 
 	return res, nil
 }
 
+func (r *ListRequest) matches(state *workflows.WorkflowState) bool {
+	if len(r.RuntimeStatuses) > 0 && !slices.Contains(r.RuntimeStatuses, state.RuntimeStatus) {
+		return false
+	}
+	if r.WorkflowName != nil && *r.WorkflowName != state.WorkflowName {
+		return false
+	}
+	if r.CreatedAfter != nil && state.CreatedAt.Before(*r.CreatedAfter) {
+		return false
+	}
+	if r.CreatedBefore != nil && !state.CreatedAt.Before(*r.CreatedBefore) {
+		return false
+	}
+	return true
+}
+
 func (c *client) Pause(ctx context.Context, req *workflows.PauseRequest) error {
 	if req.InstanceID == "" {
 		return errors.New("a workflow instance ID is required")
 	}
 
-	err := c.client.SuspendWorkflow(ctx, api.InstanceID(req.InstanceID), "")
+	reason, err := encodeAudit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit of pausing workflow %s: %w", req.InstanceID, err)
+	}
+
+	err = c.client.SuspendWorkflow(ctx, api.InstanceID(req.InstanceID), reason)
 	if err != nil {
 		return fmt.Errorf("failed to pause workflow %s: %w", req.InstanceID, err)
 	}
@@ -241,7 +469,12 @@ func (c *client) Resume(ctx context.Context, req *workflows.ResumeRequest) error
 		return errors.New("a workflow instance ID is required")
 	}
 
-	err := c.client.ResumeWorkflow(ctx, api.InstanceID(req.InstanceID), "")
+	reason, err := encodeAudit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit of resuming workflow %s: %w", req.InstanceID, err)
+	}
+
+	err = c.client.ResumeWorkflow(ctx, api.InstanceID(req.InstanceID), reason)
 	if err != nil {
 		return fmt.Errorf("failed to resume workflow %s: %w", req.InstanceID, err)
 	}
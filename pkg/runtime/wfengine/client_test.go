@@ -16,9 +16,11 @@ package wfengine
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/dapr/durabletask-go/api"
@@ -132,3 +134,125 @@ func TestGetOutputOnFailure(t *testing.T) {
 		})
 	}
 }
+
+// fakeListClient serves the metadata of a set of workflow instances, listed in a
+// single page.
+type fakeListClient struct {
+	fakeTaskHubClient
+	ids       []string
+	instances map[string]*backend.WorkflowMetadata
+	pageSize  *uint32
+}
+
+func (f *fakeListClient) FetchWorkflowMetadata(_ context.Context, id api.InstanceID) (*backend.WorkflowMetadata, error) {
+	metadata, ok := f.instances[string(id)]
+	if !ok {
+		return nil, api.ErrInstanceNotFound
+	}
+	return metadata, nil
+}
+
+func (f *fakeListClient) ListInstanceIDs(_ context.Context, req *protos.ListInstanceIDsRequest) (*protos.ListInstanceIDsResponse, error) {
+	f.pageSize = req.PageSize
+	return &protos.ListInstanceIDsResponse{
+		InstanceIds:       f.ids,
+		ContinuationToken: new("next"),
+	}, nil
+}
+
+func TestList(t *testing.T) {
+	now := time.Now()
+	fake := &fakeListClient{
+		ids: []string{"wf1", "wf2", "wf3", "purged"},
+		instances: map[string]*backend.WorkflowMetadata{
+			"wf1": {
+				Name:          "order",
+				RuntimeStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING,
+				CreatedAt:     timestamppb.New(now.Add(-time.Hour)),
+			},
+			"wf2": {
+				Name:          "order",
+				RuntimeStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED,
+				CreatedAt:     timestamppb.New(now),
+			},
+			"wf3": {
+				Name:          "payment",
+				RuntimeStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING,
+				CreatedAt:     timestamppb.New(now),
+			},
+		},
+	}
+	c := &client{
+		logger: logger.NewLogger("test"),
+		client: fake,
+		lister: fake,
+	}
+
+	ids := func(t *testing.T, req *ListRequest) []string {
+		t.Helper()
+		res, err := c.List(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "next", *res.ContinuationToken)
+		got := make([]string, 0, len(res.Workflows))
+		for _, wf := range res.Workflows {
+			got = append(got, wf.InstanceID)
+		}
+		return got
+	}
+
+	assert.Equal(t, []string{"wf1", "wf2", "wf3"}, ids(t, &ListRequest{}))
+	assert.Equal(t, uint32(defaultListPageSize), *fake.pageSize)
+
+	assert.Equal(t, []string{"wf1", "wf3"}, ids(t, &ListRequest{RuntimeStatuses: []string{"RUNNING"}, PageSize: new(uint32(10))}))
+	assert.Equal(t, uint32(10), *fake.pageSize)
+
+	assert.Equal(t, []string{"wf1", "wf2"}, ids(t, &ListRequest{WorkflowName: new("order")}))
+	assert.Equal(t, []string{"wf2", "wf3"}, ids(t, &ListRequest{CreatedAfter: new(now.Add(-time.Minute))}))
+	assert.Equal(t, []string{"wf1"}, ids(t, &ListRequest{CreatedBefore: new(now.Add(-time.Minute))}))
+	assert.Empty(t, ids(t, &ListRequest{WorkflowName: new("payment"), RuntimeStatuses: []string{"FAILED"}}))
+}
+
+// fakeScheduledClient has no workflow instances.
+type fakeScheduledClient struct {
+	fakeTaskHubClient
+}
+
+func (f *fakeScheduledClient) TerminateWorkflow(context.Context, api.InstanceID, ...api.TerminateOptions) error {
+	return api.ErrInstanceNotFound
+}
+
+func (f *fakeScheduledClient) PurgeWorkflowState(context.Context, api.InstanceID, ...api.PurgeOptions) error {
+	return api.ErrInstanceNotFound
+}
+
+// fakeSchedules holds the IDs of the scheduled workflows.
+type fakeSchedules struct {
+	ids map[api.InstanceID]bool
+}
+
+func (f *fakeSchedules) ScheduleWorkflow(context.Context, *backend.HistoryEvent, string) error {
+	return nil
+}
+
+func (f *fakeSchedules) DeleteWorkflowSchedule(_ context.Context, id api.InstanceID) (bool, error) {
+	scheduled := f.ids[id]
+	delete(f.ids, id)
+	return scheduled, nil
+}
+
+func TestStopSchedule(t *testing.T) {
+	schedules := &fakeSchedules{ids: map[api.InstanceID]bool{"wf1": true, "wf2": true}}
+	c := &client{
+		logger:    logger.NewLogger("test"),
+		client:    &fakeScheduledClient{},
+		schedules: schedules,
+	}
+
+	// A schedule without a run yet is stopped by terminating or purging it.
+	require.NoError(t, c.Terminate(t.Context(), &workflows.TerminateRequest{InstanceID: "wf1"}))
+	require.NoError(t, c.Purge(t.Context(), &workflows.PurgeRequest{InstanceID: "wf2"}))
+	assert.Empty(t, schedules.ids)
+
+	require.ErrorIs(t, c.Terminate(t.Context(), &workflows.TerminateRequest{InstanceID: "wf1"}), api.ErrInstanceNotFound)
+	require.ErrorIs(t, c.Purge(t.Context(), &workflows.PurgeRequest{InstanceID: "wf3"}), api.ErrInstanceNotFound)
+}
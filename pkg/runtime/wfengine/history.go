@@ -0,0 +1,386 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfengine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// instanceHistoryGetter reads the history of a workflow instance.
+type instanceHistoryGetter interface {
+	GetInstanceHistory(ctx context.Context, req *protos.GetInstanceHistoryRequest) (*protos.GetInstanceHistoryResponse, error)
+}
+
+// workflowExecutor executes workflows on the connected workflow worker.
+type workflowExecutor interface {
+	ExecuteWorkflow(ctx context.Context, iid api.InstanceID, oldEvents []*protos.HistoryEvent, newEvents []*protos.HistoryEvent, opts backend.ExecuteOptions) (*protos.WorkflowResponse, error)
+}
+
+// HistoryExporter is implemented by workflow clients which can export the
+// history of workflow instances.
+type HistoryExporter interface {
+	History(ctx context.Context, instanceID string) ([]*HistoryEvent, error)
+}
+
+// Replayer is implemented by workflow clients which can replay completed
+// workflow instances against the current workflow code.
+type Replayer interface {
+	Replay(ctx context.Context, instanceID string) (*ReplayResponse, error)
+}
+
+// HistoryEvent is an exported event of the history of a workflow instance.
+type HistoryEvent struct {
+	EventID int32
+	// EventType is the name of the event type, for example "taskScheduled".
+	EventType string
+	Timestamp time.Time
+	// Name is the name of the activity, child workflow, timer or external
+	// event, if any.
+	Name *string
+	// PayloadHash is the hex encoded SHA-256 hash of the input or result of
+	// the event, if any.
+	PayloadHash *string
+	// Event is the serialized event.
+	Event []byte
+}
+
+// ReplayResponse is the outcome of replaying a workflow instance.
+type ReplayResponse struct {
+	RecordedStatus string
+	ReplayedStatus string
+	RecordedOutput *string
+	ReplayedOutput *string
+	// Divergences describes where the replay differs from the recorded
+	// history. The replay is deterministic if empty.
+	Divergences []string
+}
+
+// History returns the events of the history of a workflow instance.
+func (c *client) History(ctx context.Context, instanceID string) ([]*HistoryEvent, error) {
+	history, err := c.instanceHistory(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*HistoryEvent, 0, len(history))
+	for _, e := range history {
+		event, err := exportEvent(e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export event %d of workflow instance '%s': %w", e.GetEventId(), instanceID, err)
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// Replay re-runs the recorded history of a completed workflow instance on the
+// connected workflow worker, and compares the actions of the replay with the
+// recorded ones. The history is replayed up to each point the workflow
+// scheduled work, and the replay stops at the first divergence. The replay has
+// no side effects: the actions of the replay are not applied.
+func (c *client) Replay(ctx context.Context, instanceID string) (*ReplayResponse, error) {
+	if c.executor == nil {
+		return nil, errors.New("replaying workflow instances is not supported")
+	}
+
+	history, err := c.instanceHistory(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := completedEventIndex(history)
+	if idx < 0 {
+		return nil, fmt.Errorf("workflow instance '%s' has not completed: only completed instances can be replayed", instanceID)
+	}
+	history = history[:idx+1]
+
+	if started := executionStarted(history); started == nil {
+		return nil, fmt.Errorf("history of workflow instance '%s' has no execution started event", instanceID)
+	} else if strings.HasPrefix(started.GetName(), ReservedWorkflowNamePrefix) {
+		return nil, fmt.Errorf("workflow instance '%s' is an internal workflow and cannot be replayed", instanceID)
+	}
+
+	recorded := history[idx].GetExecutionCompleted()
+	res := &ReplayResponse{
+		RecordedStatus: getStatusString(int32(recorded.GetWorkflowStatus())),
+	}
+	if recorded.GetResult() != nil {
+		res.RecordedOutput = new(recorded.GetResult().GetValue())
+	}
+
+	for _, step := range replaySteps(history) {
+		resp, err := c.executor.ExecuteWorkflow(ctx, api.InstanceID(instanceID), history[:step.start], nil, backend.ExecuteOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay workflow instance '%s': %w", instanceID, err)
+		}
+
+		res.compare(history[step.start:step.end], resp.GetActions())
+		if len(res.Divergences) > 0 {
+			break
+		}
+	}
+
+	c.logger.Debugf("Replayed workflow instance '%s' with %d divergences", instanceID, len(res.Divergences))
+
+	return res, nil
+}
+
+func (c *client) instanceHistory(ctx context.Context, instanceID string) ([]*protos.HistoryEvent, error) {
+	if instanceID == "" {
+		return nil, errors.New("a workflow instance ID is required")
+	}
+	if c.history == nil {
+		return nil, errors.New("exporting workflow history is not supported")
+	}
+
+	resp, err := c.history.GetInstanceHistory(ctx, &protos.GetInstanceHistoryRequest{InstanceId: instanceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of workflow instance '%s': %w", instanceID, err)
+	}
+
+	if len(resp.GetEvents()) == 0 {
+		return nil, fmt.Errorf("workflow instance '%s' has no history", instanceID)
+	}
+
+	return resp.GetEvents(), nil
+}
+
+// completedEventIndex returns the index of the execution completed event of
+// the history, or -1.
+func completedEventIndex(history []*protos.HistoryEvent) int {
+	for i, e := range history {
+		if e.GetExecutionCompleted() != nil {
+			return i
+		}
+	}
+	return -1
+}
+
+func executionStarted(history []*protos.HistoryEvent) *protos.ExecutionStartedEvent {
+	for _, e := range history {
+		if started := e.GetExecutionStarted(); started != nil {
+			return started
+		}
+	}
+	return nil
+}
+
+// exportEvent returns the exported form of a history event. The name and
+// payload are read by field name, as they are common to most event types.
+func exportEvent(e *protos.HistoryEvent) (*HistoryEvent, error) {
+	b, err := proto.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &HistoryEvent{
+		EventID:   e.GetEventId(),
+		Timestamp: e.GetTimestamp().AsTime(),
+		Event:     b,
+	}
+
+	msg := e.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("eventType"))
+	if field == nil {
+		return event, nil
+	}
+
+	event.EventType = string(field.Name())
+	typed := msg.Get(field).Message()
+	fields := typed.Descriptor().Fields()
+
+	if name := fields.ByName("name"); name != nil && name.Kind() == protoreflect.StringKind && typed.Has(name) {
+		event.Name = new(typed.Get(name).String())
+	}
+
+	for _, p := range []protoreflect.Name{"input", "result"} {
+		payload := fields.ByName(p)
+		if payload == nil || payload.Kind() != protoreflect.MessageKind || !typed.Has(payload) {
+			continue
+		}
+		if v, ok := typed.Get(payload).Message().Interface().(*wrapperspb.StringValue); ok {
+			sum := sha256.Sum256([]byte(v.GetValue()))
+			event.PayloadHash = new(hex.EncodeToString(sum[:]))
+			break
+		}
+	}
+
+	return event, nil
+}
+
+// replayStep is a range of consecutive history events which were created by
+// the actions of a single workflow execution.
+type replayStep struct {
+	start, end int
+}
+
+// replaySteps returns the ranges of the history created by workflow actions.
+// Replaying the history before a range yields the actions of the range.
+func replaySteps(history []*protos.HistoryEvent) []replayStep {
+	var steps []replayStep
+	for i := 0; i < len(history); i++ {
+		if _, ok := actionEvent(history[i]); !ok {
+			continue
+		}
+		step := replayStep{start: i}
+		for i < len(history) {
+			if _, ok := actionEvent(history[i]); !ok {
+				break
+			}
+			i++
+		}
+		step.end = i
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// actionEvent returns the name of the history event if it is created by a
+// workflow action, in the form "<event type> '<name>'".
+func actionEvent(e *protos.HistoryEvent) (string, bool) {
+	switch {
+	case e.GetTaskScheduled() != nil:
+		return fmt.Sprintf("taskScheduled '%s'", e.GetTaskScheduled().GetName()), true
+	case e.GetTimerCreated() != nil:
+		return fmt.Sprintf("timerCreated '%s'", e.GetTimerCreated().GetName()), true
+	case e.GetChildWorkflowInstanceCreated() != nil:
+		return fmt.Sprintf("childWorkflowInstanceCreated '%s'", e.GetChildWorkflowInstanceCreated().GetName()), true
+	case e.GetDetachedWorkflowInstanceCreated() != nil:
+		return fmt.Sprintf("detachedWorkflowInstanceCreated '%s'", e.GetDetachedWorkflowInstanceCreated().GetInstanceId()), true
+	case e.GetEventSent() != nil:
+		return fmt.Sprintf("eventSent '%s'", e.GetEventSent().GetName()), true
+	case e.GetExecutionCompleted() != nil:
+		return "executionCompleted", true
+	default:
+		return "", false
+	}
+}
+
+// actionName returns the name of the history event the workflow action
+// creates, in the form returned by actionEvent.
+func actionName(action *protos.WorkflowAction) string {
+	switch {
+	case action.GetScheduleTask() != nil:
+		return fmt.Sprintf("taskScheduled '%s'", action.GetScheduleTask().GetName())
+	case action.GetCreateTimer() != nil:
+		return fmt.Sprintf("timerCreated '%s'", action.GetCreateTimer().GetName())
+	case action.GetCreateChildWorkflow() != nil:
+		return fmt.Sprintf("childWorkflowInstanceCreated '%s'", action.GetCreateChildWorkflow().GetName())
+	case action.GetCreateDetachedWorkflow() != nil:
+		return fmt.Sprintf("detachedWorkflowInstanceCreated '%s'", action.GetCreateDetachedWorkflow().GetInstanceId())
+	case action.GetSendEvent() != nil:
+		return fmt.Sprintf("eventSent '%s'", action.GetSendEvent().GetName())
+	case action.GetCompleteWorkflow() != nil:
+		return "executionCompleted"
+	default:
+		msg := action.ProtoReflect()
+		if field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("workflowActionType")); field != nil {
+			return string(field.Name())
+		}
+		return "unknown"
+	}
+}
+
+// compare records the divergences between the recorded events of a workflow
+// execution and the actions of its replay. Actions are matched to events by
+// their ID, which is the sequence number of the action in the workflow.
+func (r *ReplayResponse) compare(events []*protos.HistoryEvent, actions []*protos.WorkflowAction) {
+	recorded := make(map[int32]*protos.HistoryEvent, len(events))
+	for _, e := range events {
+		recorded[e.GetEventId()] = e
+	}
+
+	for _, action := range actions {
+		name := actionName(action)
+		e, ok := recorded[action.GetId()]
+		delete(recorded, action.GetId())
+
+		if complete := action.GetCompleteWorkflow(); complete != nil {
+			r.ReplayedStatus = getStatusString(int32(complete.GetWorkflowStatus()))
+			r.ReplayedOutput = nil
+			if complete.GetResult() != nil {
+				r.ReplayedOutput = new(complete.GetResult().GetValue())
+			}
+			if ok && e.GetExecutionCompleted() != nil {
+				r.compareCompletion(e.GetExecutionCompleted(), complete)
+				continue
+			}
+		}
+
+		if !ok {
+			r.Divergences = append(r.Divergences, fmt.Sprintf("replay created %s with ID %d, which is not in the recorded history%s", name, action.GetId(), failureSuffix(action.GetCompleteWorkflow().GetFailureDetails())))
+			continue
+		}
+
+		if recordedName, _ := actionEvent(e); recordedName != name {
+			r.Divergences = append(r.Divergences, fmt.Sprintf("replay created %s with ID %d, recorded history has %s%s", name, action.GetId(), recordedName, failureSuffix(action.GetCompleteWorkflow().GetFailureDetails())))
+		}
+	}
+
+	for _, e := range events {
+		if _, ok := recorded[e.GetEventId()]; ok {
+			name, _ := actionEvent(e)
+			r.Divergences = append(r.Divergences, fmt.Sprintf("recorded history has %s with ID %d, which the replay did not create", name, e.GetEventId()))
+		}
+	}
+}
+
+// compareCompletion records the divergences between the recorded and the
+// replayed completion of the workflow.
+func (r *ReplayResponse) compareCompletion(recorded *protos.ExecutionCompletedEvent, replayed *protos.CompleteWorkflowAction) {
+	if recorded.GetWorkflowStatus() != replayed.GetWorkflowStatus() {
+		r.Divergences = append(r.Divergences, fmt.Sprintf("replay completed the workflow with status %s, recorded status is %s%s",
+			getStatusString(int32(replayed.GetWorkflowStatus())), getStatusString(int32(recorded.GetWorkflowStatus())), failureSuffix(replayed.GetFailureDetails())))
+		return
+	}
+
+	// Failure messages may hold stack traces, so failures are compared by
+	// their error type and message only.
+	if recorded.GetWorkflowStatus() == protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED {
+		rfd, cfd := recorded.GetFailureDetails(), replayed.GetFailureDetails()
+		if rfd.GetErrorType() != cfd.GetErrorType() || rfd.GetErrorMessage() != cfd.GetErrorMessage() {
+			r.Divergences = append(r.Divergences, fmt.Sprintf("replay failed the workflow with %s: %s, recorded failure is %s: %s",
+				cfd.GetErrorType(), cfd.GetErrorMessage(), rfd.GetErrorType(), rfd.GetErrorMessage()))
+		}
+		return
+	}
+
+	if !proto.Equal(recorded.GetResult(), replayed.GetResult()) {
+		r.Divergences = append(r.Divergences, "replay completed the workflow with a different output than the recorded output")
+	}
+}
+
+// failureSuffix returns the failure of the workflow, as workflow code failing
+// on replay is a common symptom of non-determinism.
+func failureSuffix(fd *protos.TaskFailureDetails) string {
+	if fd == nil {
+		return ""
+	}
+	return fmt.Sprintf(": %s: %s", fd.GetErrorType(), fd.GetErrorMessage())
+}
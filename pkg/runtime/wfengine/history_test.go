@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfengine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+
+	"github.com/dapr/kit/logger"
+)
+
+// fakeHistory serves a recorded history, and replays it with the actions
+// given for the number of replayed events.
+type fakeHistory struct {
+	history  []*protos.HistoryEvent
+	actions  map[int][]*protos.WorkflowAction
+	replayed []int
+}
+
+func (f *fakeHistory) GetInstanceHistory(context.Context, *protos.GetInstanceHistoryRequest) (*protos.GetInstanceHistoryResponse, error) {
+	return &protos.GetInstanceHistoryResponse{Events: f.history}, nil
+}
+
+func (f *fakeHistory) ExecuteWorkflow(_ context.Context, iid api.InstanceID, oldEvents []*protos.HistoryEvent, _ []*protos.HistoryEvent, _ backend.ExecuteOptions) (*protos.WorkflowResponse, error) {
+	f.replayed = append(f.replayed, len(oldEvents))
+	return &protos.WorkflowResponse{InstanceId: string(iid), Actions: f.actions[len(oldEvents)]}, nil
+}
+
+func recordedHistory(now time.Time) []*protos.HistoryEvent {
+	return []*protos.HistoryEvent{
+		{
+			EventId:   -1,
+			Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_WorkflowStarted{WorkflowStarted: &protos.WorkflowStartedEvent{}},
+		},
+		{
+			EventId:   -1,
+			Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: &protos.ExecutionStartedEvent{
+				Name:  "order",
+				Input: wrapperspb.String(`"input"`),
+			}},
+		},
+		{
+			EventId:   0,
+			Timestamp: timestamppb.New(now),
+			EventType: &protos.HistoryEvent_TaskScheduled{TaskScheduled: &protos.TaskScheduledEvent{Name: "pay"}},
+		},
+		{
+			EventId:   -1,
+			Timestamp: timestamppb.New(now.Add(time.Second)),
+			EventType: &protos.HistoryEvent_TaskCompleted{TaskCompleted: &protos.TaskCompletedEvent{
+				TaskScheduledId: 0,
+				Result:          wrapperspb.String(`"paid"`),
+			}},
+		},
+		{
+			EventId:   1,
+			Timestamp: timestamppb.New(now.Add(time.Second)),
+			EventType: &protos.HistoryEvent_ExecutionCompleted{ExecutionCompleted: &protos.ExecutionCompletedEvent{
+				WorkflowStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED,
+				Result:         wrapperspb.String(`"done"`),
+			}},
+		},
+	}
+}
+
+func scheduleAction(name string) *protos.WorkflowAction {
+	return &protos.WorkflowAction{
+		Id:                 0,
+		WorkflowActionType: &protos.WorkflowAction_ScheduleTask{ScheduleTask: &protos.ScheduleTaskAction{Name: name}},
+	}
+}
+
+func completeAction(status protos.OrchestrationStatus, result string) *protos.WorkflowAction {
+	return &protos.WorkflowAction{
+		Id: 1,
+		WorkflowActionType: &protos.WorkflowAction_CompleteWorkflow{CompleteWorkflow: &protos.CompleteWorkflowAction{
+			WorkflowStatus: status,
+			Result:         wrapperspb.String(result),
+		}},
+	}
+}
+
+func TestHistory(t *testing.T) {
+	now := time.Now()
+	fake := &fakeHistory{history: recordedHistory(now)}
+	c := &client{logger: logger.NewLogger("test"), history: fake}
+
+	events, err := c.History(t.Context(), "wf1")
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	assert.Equal(t, "workflowStarted", events[0].EventType)
+	assert.Nil(t, events[0].PayloadHash)
+
+	hash := func(s string) *string {
+		sum := sha256.Sum256([]byte(s))
+		return new(hex.EncodeToString(sum[:]))
+	}
+
+	assert.Equal(t, "executionStarted", events[1].EventType)
+	assert.Equal(t, new("order"), events[1].Name)
+	assert.Equal(t, hash(`"input"`), events[1].PayloadHash)
+	assert.True(t, now.Equal(events[1].Timestamp))
+
+	assert.Equal(t, "taskScheduled", events[2].EventType)
+	assert.Equal(t, int32(0), events[2].EventID)
+	assert.Equal(t, new("pay"), events[2].Name)
+	assert.Nil(t, events[2].PayloadHash)
+
+	assert.Equal(t, "taskCompleted", events[3].EventType)
+	assert.Nil(t, events[3].Name)
+	assert.Equal(t, hash(`"paid"`), events[3].PayloadHash)
+
+	var event protos.HistoryEvent
+	require.NoError(t, proto.Unmarshal(events[4].Event, &event))
+	assert.True(t, proto.Equal(fake.history[4], &event))
+
+	_, err = c.History(t.Context(), "")
+	require.Error(t, err)
+}
+
+func TestReplay(t *testing.T) {
+	replay := func(t *testing.T, history []*protos.HistoryEvent, actions map[int][]*protos.WorkflowAction) (*ReplayResponse, *fakeHistory, error) {
+		t.Helper()
+		fake := &fakeHistory{history: history, actions: actions}
+		c := &client{logger: logger.NewLogger("test"), history: fake, executor: fake}
+		res, err := c.Replay(t.Context(), "wf1")
+		return res, fake, err
+	}
+
+	t.Run("deterministic", func(t *testing.T) {
+		res, fake, err := replay(t, recordedHistory(time.Now()), map[int][]*protos.WorkflowAction{
+			2: {scheduleAction("pay")},
+			4: {completeAction(protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED, `"done"`)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 4}, fake.replayed, "history is replayed up to each step")
+		assert.Empty(t, res.Divergences)
+		assert.Equal(t, "COMPLETED", res.RecordedStatus)
+		assert.Equal(t, "COMPLETED", res.ReplayedStatus)
+		assert.Equal(t, new(`"done"`), res.RecordedOutput)
+		assert.Equal(t, new(`"done"`), res.ReplayedOutput)
+	})
+
+	t.Run("different activity", func(t *testing.T) {
+		res, fake, err := replay(t, recordedHistory(time.Now()), map[int][]*protos.WorkflowAction{
+			2: {scheduleAction("ship")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{2}, fake.replayed, "replay stops at the first divergence")
+		assert.Equal(t, []string{"replay created taskScheduled 'ship' with ID 0, recorded history has taskScheduled 'pay'"}, res.Divergences)
+		assert.Empty(t, res.ReplayedStatus)
+	})
+
+	t.Run("missing and new actions", func(t *testing.T) {
+		action := scheduleAction("ship")
+		action.Id = 3
+		res, _, err := replay(t, recordedHistory(time.Now()), map[int][]*protos.WorkflowAction{
+			2: {action},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"replay created taskScheduled 'ship' with ID 3, which is not in the recorded history",
+			"recorded history has taskScheduled 'pay' with ID 0, which the replay did not create",
+		}, res.Divergences)
+	})
+
+	t.Run("different output", func(t *testing.T) {
+		res, _, err := replay(t, recordedHistory(time.Now()), map[int][]*protos.WorkflowAction{
+			2: {scheduleAction("pay")},
+			4: {completeAction(protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED, `"other"`)},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Divergences, 1)
+		assert.Contains(t, res.Divergences[0], "different output")
+		assert.Equal(t, new(`"other"`), res.ReplayedOutput)
+	})
+
+	t.Run("workflow fails on replay", func(t *testing.T) {
+		action := completeAction(protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED, "")
+		action.Id = 0
+		action.GetCompleteWorkflow().FailureDetails = &protos.TaskFailureDetails{
+			ErrorType:    "NonDeterminism",
+			ErrorMessage: "a previous execution called CallActivity for 'pay'",
+		}
+		res, _, err := replay(t, recordedHistory(time.Now()), map[int][]*protos.WorkflowAction{
+			2: {action},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "FAILED", res.ReplayedStatus)
+		assert.Equal(t, []string{
+			"replay created executionCompleted with ID 0, recorded history has taskScheduled 'pay': NonDeterminism: a previous execution called CallActivity for 'pay'",
+		}, res.Divergences)
+	})
+
+	t.Run("running instance", func(t *testing.T) {
+		history := recordedHistory(time.Now())
+		_, _, err := replay(t, history[:4], nil)
+		require.ErrorContains(t, err, "has not completed")
+	})
+
+	t.Run("internal workflow", func(t *testing.T) {
+		history := recordedHistory(time.Now())
+		history[1].GetExecutionStarted().Name = ReservedWorkflowNamePrefix + "mcp"
+		_, _, err := replay(t, history, nil)
+		require.ErrorContains(t, err, "internal workflow")
+	})
+}
@@ -42,6 +42,31 @@ const (
 	MetadataCheckSubtreeTerminal = "CheckSubtreeTerminal"
 
 	ActorTypePrefix = "dapr.internal."
+
+	// Tags set on the ExecutionStarted event of a workflow instance. The
+	// instance is terminated once it has run for longer than the max
+	// duration, after which the optional compensation activity is invoked
+	// with the workflow input.
+	TagMaxDuration          = "dapr.workflow.max_duration"
+	TagCompensationActivity = "dapr.workflow.compensation_activity"
+
+	// Tags set on the ExecutionStarted event of a workflow instance with the
+	// fan-out limits configured for the workflow when the instance started.
+	TagMaxConcurrentActivities     = "dapr.workflow.max_concurrent_activities"
+	TagMaxConcurrentChildWorkflows = "dapr.workflow.max_concurrent_child_workflows"
+
+	// Start options of a workflow started on a cron schedule, and the policy
+	// applied when a run is due while the previous run is still running.
+	OptionCron          = "dapr.workflow.cron"
+	OptionOverlapPolicy = "dapr.workflow.overlap_policy"
+
+	OverlapPolicySkip              = "skip"
+	OverlapPolicyQueue             = "queue"
+	OverlapPolicyTerminatePrevious = "terminate_previous"
+
+	// CronReminderName is the name of the reminder on the cron actor of a
+	// scheduled workflow.
+	CronReminderName = "cron"
 )
 
 var (
@@ -49,6 +74,22 @@ var (
 	ErrDuplicateInvocation = errors.New("duplicate invocation")
 )
 
+type startTagsKey struct{}
+
+// WithStartTags returns a context which sets the given tags on the
+// ExecutionStarted event of a workflow instance created with it. The
+// durabletask client does not copy the tags of a create request onto the
+// event.
+func WithStartTags(ctx context.Context, tags map[string]string) context.Context {
+	return context.WithValue(ctx, startTagsKey{}, tags)
+}
+
+// StartTags returns the tags set on the context with WithStartTags.
+func StartTags(ctx context.Context) map[string]string {
+	tags, _ := ctx.Value(startTagsKey{}).(map[string]string)
+	return tags
+}
+
 // WorkflowScheduler is a func interface for pushing workflow (orchestration) work items into the durabletask backend
 type WorkflowScheduler func(ctx context.Context, wi *backend.WorkflowWorkItem) error
 
@@ -132,6 +132,13 @@ func New(opts Options) (Interface, error) {
 		retPolicy = opts.Spec.StateRetentionPolicy
 	}
 
+	switch strategy := opts.Spec.GetRebalanceStrategy(); strategy {
+	case config.WorkflowRebalanceRoundRobin, config.WorkflowRebalanceBacklog:
+	default:
+		return nil, fmt.Errorf("invalid workflow partition rebalance strategy %q: must be %q or %q",
+			strategy, config.WorkflowRebalanceRoundRobin, config.WorkflowRebalanceBacklog)
+	}
+
 	// Disable history signing if the WorkflowHistorySigning feature flag is not
 	// enabled.
 	s := opts.Signer
@@ -156,6 +163,10 @@ func New(opts Options) (Interface, error) {
 		Signer:                 s,
 		MaxRequestBodySize:     opts.MaxRequestBodySize,
 		WorkflowAccessPolicies: opts.WorkflowAccessPolicies,
+		FanOutLimits:           opts.Spec.GetFanOutLimits(),
+
+		WorkflowPartitions:        opts.Spec.GetPartitions(),
+		WorkflowRebalanceStrategy: opts.Spec.GetRebalanceStrategy(),
 
 		EnableClusteredDeployment:       opts.EnableClusteredDeployment,
 		WorkflowsRemoteActivityReminder: opts.WorkflowsRemoteActivityReminder,
@@ -269,8 +280,12 @@ func New(opts Options) (Interface, error) {
 	wfe.worker = worker
 	wfe.registerGrpcServerFn = registerGrpcServerFn
 	wfe.client = &client{
-		logger: wfBackendLogger,
-		client: backend.NewTaskHubClient(abackend),
+		logger:    wfBackendLogger,
+		client:    backend.NewTaskHubClient(abackend),
+		lister:    abackend,
+		history:   abackend,
+		executor:  grpcExec,
+		schedules: abackend,
 	}
 	return wfe, nil
 }
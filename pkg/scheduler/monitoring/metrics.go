@@ -49,6 +49,14 @@ var (
 		"scheduler/jobs_undelivered_total",
 		"The total number of undelivered jobs.",
 		stats.UnitDimensionless)
+	jobsSkippedTotal = stats.Int64(
+		"scheduler/jobs_skipped_total",
+		"The total number of job triggers skipped by the catch-up policy or an exclusion calendar.",
+		stats.UnitDimensionless)
+	jobsRateLimitedTotal = stats.Int64(
+		"scheduler/jobs_rate_limited_total",
+		"The total number of job triggers delayed by trigger rate limits.",
+		stats.UnitDimensionless)
 	triggerLatency = stats.Float64(
 		"scheduler/trigger_latency",
 		"The total time it takes to trigger a job from the scheduler service.",
@@ -199,6 +207,49 @@ func RecordJobsUndeliveredCount(jobMetadata *schedulerv1pb.JobMetadata) {
 	stats.RecordWithTags(context.Background(), tag, jobsUndeliveredTotal.M(1))
 }
 
+var (
+	tagSkippedJob     = utils.WithTags(jobsSkippedTotal.Name(), tagType, "job")
+	tagSkippedActor   = utils.WithTags(jobsSkippedTotal.Name(), tagType, "actor")
+	tagSkippedUnknown = utils.WithTags(jobsSkippedTotal.Name(), tagType, "unknown")
+)
+
+// RecordJobsSkippedCount records the total number of skipped job triggers
+func RecordJobsSkippedCount(jobMetadata *schedulerv1pb.JobMetadata) {
+	var tag []tag.Mutator
+	switch jobMetadata.GetTarget().GetType().(type) {
+	case *schedulerv1pb.JobTargetMetadata_Job:
+		tag = tagSkippedJob
+	case *schedulerv1pb.JobTargetMetadata_Actor:
+		tag = tagSkippedActor
+	default:
+		tag = tagSkippedUnknown
+	}
+
+	stats.RecordWithTags(context.Background(), tag, jobsSkippedTotal.M(1))
+}
+
+var (
+	tagRateLimitedJob     = utils.WithTags(jobsRateLimitedTotal.Name(), tagType, "job")
+	tagRateLimitedActor   = utils.WithTags(jobsRateLimitedTotal.Name(), tagType, "actor")
+	tagRateLimitedUnknown = utils.WithTags(jobsRateLimitedTotal.Name(), tagType, "unknown")
+)
+
+// RecordJobsRateLimitedCount records the total number of job triggers delayed
+// by rate limiting
+func RecordJobsRateLimitedCount(jobMetadata *schedulerv1pb.JobMetadata) {
+	var tag []tag.Mutator
+	switch jobMetadata.GetTarget().GetType().(type) {
+	case *schedulerv1pb.JobTargetMetadata_Job:
+		tag = tagRateLimitedJob
+	case *schedulerv1pb.JobTargetMetadata_Actor:
+		tag = tagRateLimitedActor
+	default:
+		tag = tagRateLimitedUnknown
+	}
+
+	stats.RecordWithTags(context.Background(), tag, jobsRateLimitedTotal.M(1))
+}
+
 // RecordConcurrencyInflight records the current in-flight count for a
 // concurrency gate on this scheduler instance.
 func RecordConcurrencyInflight(key string, count int64) {
@@ -305,6 +356,8 @@ func InitMetrics() error {
 		utils.NewMeasureView(triggerLatency, []tag.Key{tagType}, view.Distribution(0, 100, 500, 1000, 5000, 10000)),
 		utils.NewMeasureView(jobsFailedTotal, []tag.Key{tagType}, view.Count()),
 		utils.NewMeasureView(jobsUndeliveredTotal, []tag.Key{tagType}, view.Count()),
+		utils.NewMeasureView(jobsSkippedTotal, []tag.Key{tagType}, view.Count()),
+		utils.NewMeasureView(jobsRateLimitedTotal, []tag.Key{tagType}, view.Count()),
 		utils.NewMeasureView(concurrencyInflightGauge, []tag.Key{tagConcurrencyKey}, view.LastValue()),
 		utils.NewMeasureView(concurrencyPendingGauge, []tag.Key{tagConcurrencyKey}, view.LastValue()),
 		utils.NewMeasureView(concurrencyThrottledTotal, []tag.Key{tagConcurrencyKey}, view.Count()),
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	apierrors "github.com/diagridio/go-etcd-cron/api/errors"
 
@@ -28,6 +29,8 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/scheduler/monitoring"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/calendar"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/cron"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/serialize"
 )
 
@@ -44,9 +47,33 @@ func (s *Server) ScheduleJob(ctx context.Context, req *schedulerv1pb.ScheduleJob
 
 	job := req.GetJob()
 
+	// Triggers with an idempotency token are not redelivered once received,
+	// which is only meaningful for jobs which are triggered once.
+	//nolint:protogetter
+	if req.GetMetadata().GetIdempotencyToken() != "" && (job.Schedule != nil || job.GetRepeats() > 1) {
+		return nil, status.Error(codes.InvalidArgument, "exactly-once trigger semantics are only supported for one-time jobs without a schedule")
+	}
+
+	schedule := job.Schedule //nolint:protogetter
+	if job.TimeZone != nil { //nolint:protogetter
+		if schedule == nil {
+			return nil, status.Error(codes.InvalidArgument, "a time zone requires the job to have a schedule")
+		}
+
+		sched, terr := calendar.WithTimeZone(job.GetSchedule(), job.GetTimeZone())
+		if terr != nil {
+			return nil, status.Error(codes.InvalidArgument, terr.Error())
+		}
+		schedule = &sched
+	}
+
+	if err = calendar.Validate(req.GetMetadata().GetCalendar()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	//nolint:protogetter
 	apiJob := &api.Job{
-		Schedule:      job.Schedule,
+		Schedule:      schedule,
 		DueTime:       job.DueTime,
 		Ttl:           job.Ttl,
 		Repeats:       job.Repeats,
@@ -93,6 +120,10 @@ func (s *Server) DeleteJob(ctx context.Context, req *schedulerv1pb.DeleteJobRequ
 		return nil, err
 	}
 
+	if err = s.history.Delete(ctx, job.Name(), false); err != nil {
+		log.Warnf("error deleting trigger history of job %s: %s", job.Name(), err)
+	}
+
 	monitoring.RecordJobsDeletedCount(req.GetMetadata())
 	return &schedulerv1pb.DeleteJobResponse{}, nil
 }
@@ -118,80 +149,39 @@ func (s *Server) GetJob(ctx context.Context, req *schedulerv1pb.GetJobRequest) (
 		return nil, status.Error(codes.NotFound, "job not found: "+req.GetName())
 	}
 
+	var meta schedulerv1pb.JobMetadata
+	if err := job.GetMetadata().UnmarshalTo(&meta); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job metadata: %w", err)
+	}
+
+	// The trigger history is for debugging only, so failing to read it does
+	// not fail getting the job.
+	history, err := s.history.Get(ctx, serialized.Name())
+	if err != nil {
+		log.Warnf("error getting trigger history of job %s: %s", serialized.Name(), err)
+	}
+
 	return &schedulerv1pb.GetJobResponse{
-		//nolint:protogetter
-		Job: &schedulerv1pb.Job{
-			Schedule:      job.Schedule,
-			DueTime:       job.DueTime,
-			Ttl:           job.Ttl,
-			Repeats:       job.Repeats,
-			Data:          job.GetPayload(),
-			FailurePolicy: cronFPToSched(job.FailurePolicy),
-		},
+		Labels:      meta.GetLabels(),
+		History:     history,
+		ExactlyOnce: meta.GetIdempotencyToken() != "",
+		Calendar:    calendarName(meta.GetCalendar()),
+		Job:         cronJobToSched(job),
 	}, nil
 }
 
 func (s *Server) ListJobs(ctx context.Context, req *schedulerv1pb.ListJobsRequest) (*schedulerv1pb.ListJobsResponse, error) {
-	cron, err := s.cron.Client(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	prefix, err := s.serializer.KeyFromMetadata(ctx, req.GetMetadata(), false)
 	if err != nil {
 		return nil, err
 	}
 
-	list, err := cron.List(ctx, prefix)
+	resp, err := listJobs(ctx, s.cron, req, prefix, startAfterKey(req, prefix))
 	if err != nil {
 		return nil, fmt.Errorf("failed to query job list: %w", err)
 	}
 
-	jobs := make([]*schedulerv1pb.NamedJob, 0, len(list.GetJobs()))
-	for _, job := range list.GetJobs() {
-		// Recover the metadata from the stored protobuf rather than re-parsing
-		// the job key. Re-splitting the key on "||" corrupts the namespace,
-		// actor type and id when any of those values themselves contain "||".
-		var meta schedulerv1pb.JobMetadata
-		if err := job.GetJob().GetMetadata().UnmarshalTo(&meta); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal job metadata: %w", err)
-		}
-
-		// Recover the reminder/job name by trimming the known key prefix built
-		// from the metadata. The cron key is "<etcdNamespace>/jobs/<composed>";
-		// names cannot contain '/', so the segment after the final '/' is the
-		// composed name. Trimming the metadata prefix off it then yields the
-		// reminder/job name unambiguously, even when the name or actor id
-		// contains "||".
-		prefix, err := serialize.PrefixFromMetadata(&meta)
-		if err != nil {
-			return nil, fmt.Errorf("failed to build job key prefix: %w", err)
-		}
-
-		composed := job.GetName()[strings.LastIndex(job.GetName(), "/")+1:]
-		if !strings.HasPrefix(composed, prefix) {
-			return nil, fmt.Errorf("job key %q does not match expected prefix %q from its metadata", job.GetName(), prefix)
-		}
-
-		j := job.GetJob()
-		jobs = append(jobs, &schedulerv1pb.NamedJob{
-			Name:     strings.TrimPrefix(composed, prefix),
-			Metadata: &meta,
-			//nolint:protogetter
-			Job: &schedulerv1pb.Job{
-				Schedule:      j.Schedule,
-				DueTime:       j.DueTime,
-				Ttl:           j.Ttl,
-				Repeats:       j.Repeats,
-				Data:          j.GetPayload(),
-				FailurePolicy: cronFPToSched(j.FailurePolicy),
-			},
-		})
-	}
-
-	return &schedulerv1pb.ListJobsResponse{
-		Jobs: jobs,
-	}, nil
+	return resp, nil
 }
 
 // WatchJobs sends jobs to Dapr sidecars upon component changes.
@@ -242,6 +232,10 @@ func (s *Server) DeleteByMetadata(ctx context.Context, req *schedulerv1pb.Delete
 		return nil, err
 	}
 
+	if err = s.history.Delete(ctx, prefix, true); err != nil {
+		log.Warnf("Failed to delete trigger history of cron jobs for metadata: %s", err)
+	}
+
 	monitoring.RecordJobsBulkDeletedCount()
 	return new(schedulerv1pb.DeleteByMetadataResponse), nil
 }
@@ -267,10 +261,130 @@ func (s *Server) DeleteByNamePrefix(ctx context.Context, req *schedulerv1pb.Dele
 		return nil, err
 	}
 
+	if err = s.history.Delete(ctx, prefix, true); err != nil {
+		log.Warnf("Failed to delete trigger history of scheduler jobs for metadata: %s", err)
+	}
+
 	monitoring.RecordJobsBulkDeletedCount()
 	return new(schedulerv1pb.DeleteByNamePrefixResponse), nil
 }
 
+// listJobs lists the jobs under the job name prefix which are ordered after
+// the job name startAfter and match the filters of the request, reading a
+// page of jobs at a time until the page size of the request is reached.
+func listJobs(ctx context.Context, pager cron.Pager, req *schedulerv1pb.ListJobsRequest, prefix, startAfter string) (*schedulerv1pb.ListJobsResponse, error) {
+	size := req.GetPageSize()
+	resp := new(schedulerv1pb.ListJobsResponse)
+
+	for {
+		// One more job than the page has room for is read to know whether
+		// there are more.
+		var limit uint32
+		if size > 0 {
+			limit = size - uint32(len(resp.GetJobs())) + 1
+		}
+
+		jobs, more, err := pager.ListPage(ctx, prefix, startAfter, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, job := range jobs {
+			startAfter = job.GetName()
+
+			named, err := namedJob(job)
+			if err != nil {
+				return nil, err
+			}
+			if !jobMatches(req, named) {
+				continue
+			}
+			if size > 0 && uint32(len(resp.GetJobs())) == size {
+				resp.HasMore = true
+				return resp, nil
+			}
+			resp.Jobs = append(resp.Jobs, named)
+		}
+
+		if !more || len(jobs) == 0 {
+			return resp, nil
+		}
+	}
+}
+
+// namedJob returns the listed cron job with its metadata and name.
+func namedJob(job *api.NamedJob) (*schedulerv1pb.NamedJob, error) {
+	// Recover the metadata from the stored protobuf rather than re-parsing
+	// the job key. Re-splitting the key on "||" corrupts the namespace,
+	// actor type and id when any of those values themselves contain "||".
+	var meta schedulerv1pb.JobMetadata
+	if err := job.GetJob().GetMetadata().UnmarshalTo(&meta); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job metadata: %w", err)
+	}
+
+	// Recover the reminder/job name by trimming the known key prefix built
+	// from the metadata off the job name, which is unambiguous even when the
+	// name or actor id contains "||".
+	prefix, err := serialize.PrefixFromMetadata(&meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build job key prefix: %w", err)
+	}
+	if !strings.HasPrefix(job.GetName(), prefix) {
+		return nil, fmt.Errorf("job key %q does not match expected prefix %q from its metadata", job.GetName(), prefix)
+	}
+
+	return &schedulerv1pb.NamedJob{
+		Name:     strings.TrimPrefix(job.GetName(), prefix),
+		Metadata: &meta,
+		Job:      cronJobToSched(job.GetJob()),
+	}, nil
+}
+
+// startAfterKey returns the job name, under the job name prefix of the list
+// request, of the position the jobs are listed after, or empty to list from
+// the first job. The actor ID of the position is only part of the job name
+// when the jobs of an actor type are listed.
+func startAfterKey(req *schedulerv1pb.ListJobsRequest, prefix string) string {
+	pos := req.GetStartAfter()
+	if pos == nil {
+		return ""
+	}
+	if actor := req.GetMetadata().GetTarget().GetActor(); actor != nil && len(actor.GetId()) == 0 {
+		return prefix + pos.GetActorId() + "||" + pos.GetName()
+	}
+	return prefix + pos.GetName()
+}
+
+// jobMatches returns true if the job has all the labels of the label selector,
+// and its absolute due time falls within the due time bounds. Both bounds are
+// inclusive.
+func jobMatches(req *schedulerv1pb.ListJobsRequest, job *schedulerv1pb.NamedJob) bool {
+	labels := job.GetMetadata().GetLabels()
+	for k, v := range req.GetLabelSelector() {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+
+	if req.DueAfter == nil && req.DueBefore == nil {
+		return true
+	}
+
+	due, err := time.Parse(time.RFC3339Nano, job.GetJob().GetDueTime())
+	if err != nil {
+		return false
+	}
+
+	if req.DueAfter != nil && due.Before(req.GetDueAfter().AsTime()) {
+		return false
+	}
+	if req.DueBefore != nil && due.After(req.GetDueBefore().AsTime()) {
+		return false
+	}
+
+	return true
+}
+
 //nolint:protogetter
 func schedFPToCron(fp *commonv1pb.JobFailurePolicy) *api.FailurePolicy {
 	if fp == nil {
@@ -299,6 +413,37 @@ func schedFPToCron(fp *commonv1pb.JobFailurePolicy) *api.FailurePolicy {
 	}
 }
 
+// cronJobToSched converts a cron job to the scheduler API representation,
+// recovering the time zone from the schedule.
+//
+//nolint:protogetter
+func cronJobToSched(job *api.Job) *schedulerv1pb.Job {
+	sjob := &schedulerv1pb.Job{
+		Schedule:      job.Schedule,
+		DueTime:       job.DueTime,
+		Ttl:           job.Ttl,
+		Repeats:       job.Repeats,
+		Data:          job.GetPayload(),
+		FailurePolicy: cronFPToSched(job.FailurePolicy),
+	}
+
+	if job.Schedule != nil {
+		schedule, timeZone := calendar.SplitTimeZone(job.GetSchedule())
+		sjob.Schedule = &schedule
+		sjob.TimeZone = timeZone
+	}
+
+	return sjob
+}
+
+// calendarName returns the name of the exclusion calendar, if any.
+func calendarName(cal *schedulerv1pb.JobCalendar) *string {
+	if cal == nil {
+		return nil
+	}
+	return new(cal.GetName())
+}
+
 //nolint:protogetter
 func cronFPToSched(fp *api.FailurePolicy) *commonv1pb.JobFailurePolicy {
 	if fp == nil {
@@ -14,14 +14,20 @@ limitations under the License.
 package server
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/diagridio/go-etcd-cron/api"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 )
 
 func Test_schedFPToCron(t *testing.T) {
@@ -227,3 +233,151 @@ func Test_cronFPToSched(t *testing.T) {
 		})
 	}
 }
+
+// pager pages the jobs, ordered by name, recording the page sizes read.
+type pager struct {
+	jobs   []*api.NamedJob
+	limits []uint32
+}
+
+func (p *pager) ListPage(_ context.Context, prefix, startAfter string, limit uint32) ([]*api.NamedJob, bool, error) {
+	p.limits = append(p.limits, limit)
+	var jobs []*api.NamedJob
+	for _, job := range p.jobs {
+		if strings.HasPrefix(job.GetName(), prefix) && job.GetName() > startAfter {
+			jobs = append(jobs, job)
+		}
+	}
+	if limit > 0 && uint32(len(jobs)) > limit {
+		return jobs[:limit], true, nil
+	}
+	return jobs, false, nil
+}
+
+func Test_listJobs(t *testing.T) {
+	job := func(name, dueTime string, labels map[string]string) *api.NamedJob {
+		meta, err := anypb.New(&schedulerv1pb.JobMetadata{
+			AppId:     "app",
+			Namespace: "ns",
+			Labels:    labels,
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Job{Job: new(schedulerv1pb.TargetJob)},
+			},
+		})
+		require.NoError(t, err)
+		return &api.NamedJob{
+			Name: "app||ns||app||" + name,
+			Job:  &api.Job{DueTime: &dueTime, Metadata: meta},
+		}
+	}
+
+	jobs := []*api.NamedJob{
+		job("a", "2026-01-01T00:00:00Z", map[string]string{"team": "a", "tier": "1"}),
+		job("b", "2026-01-02T00:00:00Z", map[string]string{"team": "a"}),
+		job("c", "10s", map[string]string{"team": "a"}),
+		job("d", "2026-01-04T00:00:00Z", map[string]string{"team": "b"}),
+	}
+
+	names := func(resp *schedulerv1pb.ListJobsResponse) []string {
+		n := make([]string, 0, len(resp.GetJobs()))
+		for _, j := range resp.GetJobs() {
+			n = append(n, j.GetName())
+		}
+		return n
+	}
+
+	tests := map[string]struct {
+		req       *schedulerv1pb.ListJobsRequest
+		expNames  []string
+		expMore   bool
+		expLimits []uint32
+	}{
+		"no filter returns all jobs": {
+			req:       new(schedulerv1pb.ListJobsRequest),
+			expNames:  []string{"a", "b", "c", "d"},
+			expLimits: []uint32{0},
+		},
+		"label selector": {
+			req:       &schedulerv1pb.ListJobsRequest{LabelSelector: map[string]string{"team": "a"}},
+			expNames:  []string{"a", "b", "c"},
+			expLimits: []uint32{0},
+		},
+		"label selector matches all labels": {
+			req:       &schedulerv1pb.ListJobsRequest{LabelSelector: map[string]string{"team": "a", "tier": "1"}},
+			expNames:  []string{"a"},
+			expLimits: []uint32{0},
+		},
+		"due time bounds are inclusive and exclude relative due times": {
+			req: &schedulerv1pb.ListJobsRequest{
+				DueAfter:  timestamppb.New(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+				DueBefore: timestamppb.New(time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)),
+			},
+			expNames:  []string{"b", "d"},
+			expLimits: []uint32{0},
+		},
+		"due after only": {
+			req: &schedulerv1pb.ListJobsRequest{
+				DueAfter: timestamppb.New(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+			expNames:  []string{"d"},
+			expLimits: []uint32{0},
+		},
+		"first page reads one job more than the page size": {
+			req:       &schedulerv1pb.ListJobsRequest{PageSize: 2},
+			expNames:  []string{"a", "b"},
+			expMore:   true,
+			expLimits: []uint32{3},
+		},
+		"last page": {
+			req:       &schedulerv1pb.ListJobsRequest{PageSize: 2, StartAfter: &schedulerv1pb.ListJobsPosition{Name: "b"}},
+			expNames:  []string{"c", "d"},
+			expLimits: []uint32{3},
+		},
+		"page with filter reads until the page is full": {
+			req:       &schedulerv1pb.ListJobsRequest{PageSize: 1, LabelSelector: map[string]string{"team": "b"}},
+			expNames:  []string{"d"},
+			expLimits: []uint32{2, 2},
+		},
+		"page with filter after a position": {
+			req:       &schedulerv1pb.ListJobsRequest{PageSize: 2, StartAfter: &schedulerv1pb.ListJobsPosition{Name: "a"}, LabelSelector: map[string]string{"team": "a"}},
+			expNames:  []string{"b", "c"},
+			expLimits: []uint32{3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := &pager{jobs: jobs}
+			prefix := "app||ns||app||"
+			resp, err := listJobs(t.Context(), p, test.req, prefix, startAfterKey(test.req, prefix))
+			require.NoError(t, err)
+			assert.Equal(t, test.expNames, names(resp))
+			assert.Equal(t, test.expMore, resp.GetHasMore())
+			assert.Equal(t, test.expLimits, p.limits)
+		})
+	}
+}
+
+func Test_startAfterKey(t *testing.T) {
+	actor := func(id string) *schedulerv1pb.JobMetadata {
+		return &schedulerv1pb.JobMetadata{
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Actor{
+					Actor: &schedulerv1pb.TargetActorReminder{Type: "mytype", Id: id},
+				},
+			},
+		}
+	}
+	pos := &schedulerv1pb.ListJobsPosition{ActorId: "a||b", Name: "r1"}
+
+	assert.Empty(t, startAfterKey(&schedulerv1pb.ListJobsRequest{Metadata: actor("")}, "actorreminder||ns||mytype||"))
+	assert.Equal(t, "actorreminder||ns||mytype||a||b||r1",
+		startAfterKey(&schedulerv1pb.ListJobsRequest{Metadata: actor(""), StartAfter: pos}, "actorreminder||ns||mytype||"),
+	)
+	assert.Equal(t, "actorreminder||ns||mytype||a||b||r1",
+		startAfterKey(&schedulerv1pb.ListJobsRequest{Metadata: actor("a||b"), StartAfter: pos}, "actorreminder||ns||mytype||a||b||"),
+	)
+	assert.Equal(t, "app||ns||app||r1",
+		startAfterKey(&schedulerv1pb.ListJobsRequest{StartAfter: &schedulerv1pb.ListJobsPosition{Name: "r1"}}, "app||ns||app||"),
+	)
+}
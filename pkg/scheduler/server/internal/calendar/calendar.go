@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package calendar evaluates job time zones and exclusion calendars.
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+const (
+	dateLayout   = "2006-01-02"
+	annualLayout = "01-02"
+
+	// cronTZPrefix is the schedule prefix understood by the cron parser to
+	// evaluate the schedule in a time zone.
+	cronTZPrefix = "CRON_TZ="
+)
+
+// locations caches loaded time zones, as loading reads the time zone
+// database.
+var locations sync.Map // map[string]*time.Location
+
+// LoadLocation returns the time zone with the given IANA name. An empty name
+// is UTC.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", name, err)
+	}
+
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// WithTimeZone returns the schedule evaluated in the given time zone by the
+// cron engine.
+func WithTimeZone(schedule, timeZone string) (string, error) {
+	if _, err := LoadLocation(timeZone); err != nil {
+		return "", err
+	}
+
+	if strings.HasPrefix(schedule, cronTZPrefix) || strings.HasPrefix(schedule, "TZ=") {
+		return "", fmt.Errorf("schedule %q already defines a time zone", schedule)
+	}
+
+	return cronTZPrefix + timeZone + " " + schedule, nil
+}
+
+// SplitTimeZone returns the schedule without its time zone, and the time zone
+// it is evaluated in, if any.
+func SplitTimeZone(schedule string) (string, *string) {
+	rest, ok := strings.CutPrefix(schedule, cronTZPrefix)
+	if !ok {
+		return schedule, nil
+	}
+
+	timeZone, spec, ok := strings.Cut(rest, " ")
+	if !ok {
+		return schedule, nil
+	}
+
+	return spec, &timeZone
+}
+
+// Validate returns an error if the calendar has an invalid time zone or
+// excluded date.
+func Validate(cal *schedulerv1pb.JobCalendar) error {
+	if cal == nil {
+		return nil
+	}
+
+	if _, err := LoadLocation(cal.GetTimeZone()); err != nil {
+		return fmt.Errorf("calendar %q: %w", cal.GetName(), err)
+	}
+
+	for _, date := range cal.GetExcludedDates() {
+		if _, err := time.Parse(dateLayout, date); err == nil {
+			continue
+		}
+		if _, err := time.Parse(annualLayout, date); err != nil {
+			return fmt.Errorf("calendar %q: invalid excluded date %q: must be YYYY-MM-DD or MM-DD", cal.GetName(), date)
+		}
+	}
+
+	return nil
+}
+
+// Excluded returns true if the given time falls on a date excluded by the
+// calendar, evaluated in the time zone of the calendar.
+func Excluded(cal *schedulerv1pb.JobCalendar, now time.Time) bool {
+	if len(cal.GetExcludedDates()) == 0 {
+		return false
+	}
+
+	loc, err := LoadLocation(cal.GetTimeZone())
+	if err != nil {
+		// Calendars are validated when the job is scheduled.
+		return false
+	}
+
+	local := now.In(loc)
+	date, annual := local.Format(dateLayout), local.Format(annualLayout)
+	for _, excluded := range cal.GetExcludedDates() {
+		if excluded == date || excluded == annual {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+func TestTimeZone(t *testing.T) {
+	sched, err := WithTimeZone("0 0 9 * * 1-5", "America/New_York")
+	require.NoError(t, err)
+	assert.Equal(t, "CRON_TZ=America/New_York 0 0 9 * * 1-5", sched)
+
+	got, tz := SplitTimeZone(sched)
+	assert.Equal(t, "0 0 9 * * 1-5", got)
+	require.NotNil(t, tz)
+	assert.Equal(t, "America/New_York", *tz)
+
+	got, tz = SplitTimeZone("@every 1h")
+	assert.Equal(t, "@every 1h", got)
+	assert.Nil(t, tz)
+
+	_, err = WithTimeZone("@daily", "Mars/Olympus_Mons")
+	require.Error(t, err)
+	_, err = WithTimeZone("CRON_TZ=UTC @daily", "Europe/London")
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(nil))
+	require.NoError(t, Validate(&schedulerv1pb.JobCalendar{
+		Name:          "holidays",
+		ExcludedDates: []string{"2026-12-25", "01-01"},
+		TimeZone:      new("Europe/London"),
+	}))
+	require.Error(t, Validate(&schedulerv1pb.JobCalendar{
+		Name:          "holidays",
+		ExcludedDates: []string{"25/12/2026"},
+	}))
+	require.Error(t, Validate(&schedulerv1pb.JobCalendar{
+		Name:     "holidays",
+		TimeZone: new("Mars/Olympus_Mons"),
+	}))
+}
+
+func TestExcluded(t *testing.T) {
+	cal := &schedulerv1pb.JobCalendar{
+		Name:          "holidays",
+		ExcludedDates: []string{"2026-12-25", "01-01"},
+		TimeZone:      new("America/New_York"),
+	}
+
+	assert.False(t, Excluded(nil, time.Now()))
+	assert.True(t, Excluded(cal, time.Date(2026, 12, 25, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, Excluded(cal, time.Date(2027, 12, 25, 12, 0, 0, 0, time.UTC)))
+	assert.True(t, Excluded(cal, time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)), "annual date")
+
+	// 2026-12-26 02:00 UTC is still 2026-12-25 in New York.
+	assert.True(t, Excluded(cal, time.Date(2026, 12, 26, 2, 0, 0, 0, time.UTC)))
+	assert.False(t, Excluded(cal, time.Date(2026, 12, 25, 2, 0, 0, 0, time.UTC)))
+}
@@ -25,24 +25,35 @@ import (
 	"github.com/diagridio/go-etcd-cron/api"
 	etcdcron "github.com/diagridio/go-etcd-cron/cron"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/scheduler/monitoring"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/calendar"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/etcd"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/history"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/pool"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/serialize"
+	// Register the SQL storage backends of the jobs.
+	_ "github.com/dapr/dapr/pkg/scheduler/server/internal/cron/sqlbackend"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/throttle"
 	"github.com/dapr/kit/concurrency"
+	kitcron "github.com/dapr/kit/cron"
 	"github.com/dapr/kit/events/broadcaster"
 	"github.com/dapr/kit/events/loop"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/ptr"
+	"github.com/dapr/kit/ttlcache"
 )
 
 var log = logger.NewLogger("dapr.scheduler.server.cron")
 
 const BackendEtcd = etcdcron.BackendEtcd
 
+// etcdNamespace is the key prefix of the jobs stored in Etcd.
+const etcdNamespace = "dapr"
+
 type Options struct {
 	ID   string
 	Host *schedulerv1pb.Host
@@ -54,6 +65,13 @@ type Options struct {
 	BackendConfig any
 
 	Workers uint32
+
+	// History records the trigger attempts of jobs.
+	History *history.History
+
+	// Throttle rate limits job triggers and applies the catch-up policy to
+	// overdue triggers.
+	Throttle *throttle.Throttle
 }
 
 // Interface manages the cron framework, exposing a client to schedule jobs.
@@ -65,6 +83,10 @@ type Interface interface {
 	// framework and database. Blocks until Etcd and the Cron library are ready.
 	Client(ctx context.Context) (api.Interface, error)
 
+	// Pager lists the jobs a page at a time. Blocks until Etcd and the Cron
+	// library are ready.
+	Pager
+
 	// JobsWatch adds a watch for jobs to the connection pool.
 	JobsWatch(*schedulerv1pb.WatchJobsRequestInitial, schedulerv1pb.Scheduler_WatchJobsServer) (context.Context, error)
 
@@ -85,6 +107,10 @@ type cron struct {
 	backend         *string
 	backendConfig   any
 	workers         uint32
+	history         *history.History
+	throttle        *throttle.Throttle
+	schedules       *ttlcache.Cache[jobSchedule]
+	parser          kitcron.Parser
 
 	readyCh chan struct{}
 	closeCh chan struct{}
@@ -96,11 +122,21 @@ func New(opts Options) Interface {
 		host:            opts.Host,
 		hostBroadcaster: broadcaster.New[[]*schedulerv1pb.Host](),
 		workers:         opts.Workers,
-		readyCh:         make(chan struct{}),
-		closeCh:         make(chan struct{}),
-		etcd:            opts.Etcd,
-		backend:         opts.Backend,
-		backendConfig:   opts.BackendConfig,
+		history:         opts.History,
+		throttle:        opts.Throttle,
+		parser: kitcron.NewParser(kitcron.Second |
+			kitcron.Minute |
+			kitcron.Hour |
+			kitcron.Dom |
+			kitcron.Month |
+			kitcron.Dow |
+			kitcron.Descriptor,
+		),
+		readyCh:       make(chan struct{}),
+		closeCh:       make(chan struct{}),
+		etcd:          opts.Etcd,
+		backend:       opts.Backend,
+		backendConfig: opts.BackendConfig,
 	}
 }
 
@@ -131,7 +167,7 @@ func (c *cron) Run(ctx context.Context) error {
 		}
 		cronOpts.Backend = ptr.Of(etcdcron.BackendEtcd)
 		cronOpts.Client = client
-		cronOpts.Namespace = "dapr"
+		cronOpts.Namespace = etcdNamespace
 	}
 
 	c.etcdcron, err = etcdcron.New(cronOpts)
@@ -143,6 +179,11 @@ func (c *cron) Run(ctx context.Context) error {
 		Cron: c.etcdcron,
 	})
 
+	if c.throttle.CatchUpEnabled() {
+		c.schedules = ttlcache.NewCache[jobSchedule](ttlcache.CacheOptions{})
+		defer c.schedules.Stop()
+	}
+
 	// Use a loop to process leadership updates. The loop's Enqueue is
 	// non-blocking, which prevents the go-etcd-cron wleaderCh send from
 	// blocking when the consumer is busy broadcasting to WatchHosts
@@ -263,12 +304,97 @@ func (c *cron) triggerHandler(req *api.TriggerRequest, fn func(*api.TriggerRespo
 		return
 	}
 
-	c.connectionPool.Trigger(&internalsv1pb.JobEvent{
-		Key:      req.GetName(),
-		Name:     name,
-		Data:     req.GetPayload(),
-		Metadata: &meta,
-	}, c.respHandler(req.GetName(), &meta, fn))
+	var sched kitcron.Schedule
+	if c.throttle.CatchUpEnabled() {
+		sched = c.schedule(req.GetName())
+	}
+
+	if calendar.Excluded(meta.GetCalendar(), time.Now()) {
+		log.Debugf("Skipping trigger of job %s on a date excluded by calendar %s", req.GetName(), meta.GetCalendar().GetName())
+		monitoring.RecordJobsSkippedCount(&meta)
+		c.throttle.Done(req.GetName(), sched, api.TriggerResponseResult_SUCCESS)
+		fn(&api.TriggerResponse{Result: api.TriggerResponseResult_SUCCESS})
+		return
+	}
+
+	if c.throttle.Skip(req.GetName(), sched) {
+		log.Debugf("Skipping overdue trigger of job %s", req.GetName())
+		monitoring.RecordJobsSkippedCount(&meta)
+		c.throttle.Done(req.GetName(), sched, api.TriggerResponseResult_SUCCESS)
+		fn(&api.TriggerResponse{Result: api.TriggerResponseResult_SUCCESS})
+		return
+	}
+
+	trigger := func() {
+		resultFn, errFn := c.respHandler(req.GetName(), &meta, fn)
+		c.connectionPool.Trigger(&internalsv1pb.JobEvent{
+			Key:      req.GetName(),
+			Name:     name,
+			Data:     req.GetPayload(),
+			Metadata: &meta,
+		}, func(result api.TriggerResponseResult) {
+			c.throttle.Done(req.GetName(), sched, result)
+			resultFn(result)
+		}, errFn)
+	}
+
+	delay := c.throttle.Delay(meta.GetNamespace(), meta.GetAppId())
+	if delay <= 0 {
+		trigger()
+		return
+	}
+
+	log.Debugf("Delaying trigger of job %s by %s due to rate limiting", req.GetName(), delay)
+	monitoring.RecordJobsRateLimitedCount(&meta)
+	time.AfterFunc(delay, func() {
+		select {
+		case <-c.closeCh:
+			fn(&api.TriggerResponse{Result: api.TriggerResponseResult_UNDELIVERABLE})
+		default:
+			trigger()
+		}
+	})
+}
+
+// jobSchedule is the cached schedule of a job, which is nil if the job does
+// not recur.
+type jobSchedule struct {
+	sched kitcron.Schedule
+}
+
+// scheduleCacheTTL is the number of seconds the schedule of a job is cached
+// for, bounding how long an overwritten job is treated with its previous
+// schedule by the catch-up policy.
+const scheduleCacheTTL = 60
+
+// schedule returns the schedule of the job with the given key, or nil if the
+// job does not recur or its schedule could not be read.
+func (c *cron) schedule(key string) kitcron.Schedule {
+	if cached, ok := c.schedules.Get(key); ok {
+		return cached.sched
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	job, err := c.etcdcron.Get(ctx, key)
+	if err != nil {
+		log.Warnf("Failed to get schedule of job %s, delivering trigger: %s", key, err)
+		return nil
+	}
+
+	var cached jobSchedule
+	if job.Schedule != nil {
+		cached.sched, err = c.parser.Parse(job.GetSchedule())
+		if err != nil {
+			log.Warnf("Failed to parse schedule of job %s, delivering trigger: %s", key, err)
+			return nil
+		}
+	}
+
+	c.schedules.Set(key, cached, scheduleCacheTTL)
+
+	return cached.sched
 }
 
 // nameFromKey recovers the reminder/job name delivered to the app from the
@@ -290,27 +416,51 @@ func nameFromKey(key string, meta *schedulerv1pb.JobMetadata) (string, error) {
 	return strings.TrimPrefix(key, prefix), nil
 }
 
-func (c *cron) respHandler(name string, meta *schedulerv1pb.JobMetadata, fn func(*api.TriggerResponse)) func(api.TriggerResponseResult) {
+// respHandler returns the function called with the result of a trigger,
+// along with the function called with the failure reason reported by the
+// sidecar. The failure reason is always reported before the result.
+func (c *cron) respHandler(name string, meta *schedulerv1pb.JobMetadata, fn func(*api.TriggerResponse)) (func(api.TriggerResponseResult), func(string)) {
 	start := time.Now()
-	return func(result api.TriggerResponseResult) {
+	var reason *string
+
+	resultFn := func(result api.TriggerResponseResult) {
 		duration := time.Since(start)
 
+		attempt := &schedulerv1pb.JobTriggerAttempt{
+			Time:  timestamppb.New(start),
+			Error: reason,
+		}
+
 		switch result {
 		case api.TriggerResponseResult_SUCCESS:
 			monitoring.RecordJobsTriggeredCount(meta)
 			monitoring.RecordTriggerDuration(meta, duration)
+			attempt.Result = schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_SUCCESS
 		case api.TriggerResponseResult_FAILED:
 			monitoring.RecordJobsFailedCount(meta)
 			monitoring.RecordTriggerDuration(meta, duration)
+			attempt.Result = schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_FAILED
 		case api.TriggerResponseResult_UNDELIVERABLE:
 			monitoring.RecordJobsUndeliveredCount(meta)
+			attempt.Result = schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_UNDELIVERABLE
 		default:
 			log.Warnf("Unknown trigger result: %s", result)
 			monitoring.RecordJobsUndeliveredCount(meta)
+			attempt.Result = schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_UNDELIVERABLE
 		}
 
 		log.Debugf("Triggered job %s in %s (%s)", name, duration, result)
 
+		if c.history != nil {
+			c.history.Record(name, attempt)
+		}
+
 		fn(&api.TriggerResponse{Result: result})
 	}
+
+	errFn := func(err string) {
+		reason = &err
+	}
+
+	return resultFn, errFn
 }
@@ -24,6 +24,7 @@ import (
 type Fake struct {
 	runFn        func(context.Context) error
 	clientFn     func(context.Context) (api.Interface, error)
+	listPageFn   func(context.Context, string, string, uint32) ([]*api.NamedJob, bool, error)
 	jobsWatchFn  func(*schedulerv1pb.WatchJobsRequestInitial, schedulerv1pb.Scheduler_WatchJobsServer) (context.Context, error)
 	hostsWatchFn func(stream schedulerv1pb.Scheduler_WatchHostsServer) error
 }
@@ -37,6 +38,9 @@ func New() *Fake {
 		clientFn: func(context.Context) (api.Interface, error) {
 			return nil, nil
 		},
+		listPageFn: func(context.Context, string, string, uint32) ([]*api.NamedJob, bool, error) {
+			return nil, false, nil
+		},
 		jobsWatchFn: func(*schedulerv1pb.WatchJobsRequestInitial, schedulerv1pb.Scheduler_WatchJobsServer) (context.Context, error) {
 			return context.Background(), nil
 		},
@@ -56,6 +60,11 @@ func (f *Fake) WithClient(fn func(context.Context) (api.Interface, error)) *Fake
 	return f
 }
 
+func (f *Fake) WithListPage(fn func(context.Context, string, string, uint32) ([]*api.NamedJob, bool, error)) *Fake {
+	f.listPageFn = fn
+	return f
+}
+
 func (f *Fake) WithJobsWatch(fn func(*schedulerv1pb.WatchJobsRequestInitial, schedulerv1pb.Scheduler_WatchJobsServer) (context.Context, error)) *Fake {
 	f.jobsWatchFn = fn
 	return f
@@ -74,6 +83,10 @@ func (f *Fake) Client(ctx context.Context) (api.Interface, error) {
 	return f.clientFn(ctx)
 }
 
+func (f *Fake) ListPage(ctx context.Context, prefix, startAfter string, limit uint32) ([]*api.NamedJob, bool, error) {
+	return f.listPageFn(ctx, prefix, startAfter, limit)
+}
+
 func (f *Fake) JobsWatch(req *schedulerv1pb.WatchJobsRequestInitial, srv schedulerv1pb.Scheduler_WatchJobsServer) (context.Context, error) {
 	return f.jobsWatchFn(req, srv)
 }
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"context"
+	"strings"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Pager is implemented by cron clients which list the jobs under a job name
+// prefix a page at a time.
+type Pager interface {
+	// ListPage returns up to limit jobs under the job name prefix, ordered by
+	// name, starting after the job name startAfter, or from the first job if
+	// empty. It also returns whether there are more jobs after them. If limit
+	// is 0, all the jobs are returned.
+	ListPage(ctx context.Context, prefix, startAfter string, limit uint32) ([]*api.NamedJob, bool, error)
+}
+
+// ListPage implements Pager, blocking until Etcd and the Cron library are
+// ready. Storage backends which do not page their jobs natively are listed in
+// full, and paged in memory.
+func (c *cron) ListPage(ctx context.Context, prefix, startAfter string, limit uint32) ([]*api.NamedJob, bool, error) {
+	client, err := c.Client(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if pager, ok := client.(Pager); ok {
+		return pager.ListPage(ctx, prefix, startAfter, limit)
+	}
+
+	if c.etcd != nil && (c.backend == nil || *c.backend == BackendEtcd) {
+		return c.listEtcdPage(ctx, client, prefix, startAfter, limit)
+	}
+
+	list, err := client.List(ctx, prefix)
+	if err != nil {
+		return nil, false, err
+	}
+
+	jobs := make([]*api.NamedJob, 0, len(list.GetJobs()))
+	for _, job := range list.GetJobs() {
+		if name := jobName(job.GetName()); name > startAfter {
+			jobs = append(jobs, &api.NamedJob{Name: name, Job: job.GetJob()})
+		}
+	}
+	if limit > 0 && uint32(len(jobs)) > limit { //nolint:gosec
+		return jobs[:limit], true, nil
+	}
+	return jobs, false, nil
+}
+
+// listEtcdPage lists a page of the job names from Etcd, starting at the key of
+// the job name startAfter, and gets each job through the Cron library, which
+// owns the format of the stored jobs. Jobs deleted in between are skipped.
+func (c *cron) listEtcdPage(ctx context.Context, client api.Interface, prefix, startAfter string, limit uint32) ([]*api.NamedJob, bool, error) {
+	etcdClient, err := c.etcd.Client(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	jobsKey := etcdNamespace + "/jobs/"
+	start := jobsKey + prefix
+	if startAfter >= prefix {
+		start = jobsKey + startAfter + "\x00"
+	}
+
+	opts := []clientv3.OpOption{
+		clientv3.WithRange(clientv3.GetPrefixRangeEnd(jobsKey + prefix)),
+		clientv3.WithKeysOnly(),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+	}
+	if limit > 0 {
+		opts = append(opts, clientv3.WithLimit(int64(limit)))
+	}
+
+	for {
+		resp, err := etcdClient.Get(ctx, start, opts...)
+		if err != nil {
+			return nil, false, err
+		}
+
+		jobs := make([]*api.NamedJob, 0, len(resp.Kvs))
+		for _, kv := range resp.Kvs {
+			name := strings.TrimPrefix(string(kv.Key), jobsKey)
+			job, err := client.Get(ctx, name)
+			if err != nil {
+				return nil, false, err
+			}
+			if job != nil {
+				jobs = append(jobs, &api.NamedJob{Name: name, Job: job})
+			}
+		}
+
+		if len(jobs) > 0 || !resp.More {
+			return jobs, resp.More, nil
+		}
+
+		// Every job of the page was deleted since it was listed.
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// jobName returns the job name of a listed job, which the Etcd storage backend
+// lists by its key.
+func jobName(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+func Test_ListPage(t *testing.T) {
+	t.Parallel()
+
+	cr := New(Options{
+		ID:      "0",
+		Host:    &schedulerv1pb.Host{Address: "127.0.0.1:50000"},
+		Etcd:    &fakeEtcd{client: embeddedEtcdClient(t)},
+		Workers: 1,
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() { errCh <- cr.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case <-time.After(time.Second * 10):
+			t.Fatal("timeout waiting for cron shutdown")
+		case err := <-errCh:
+			require.NoError(t, err)
+		}
+	})
+
+	client, err := cr.Client(ctx)
+	require.NoError(t, err)
+	for _, name := range []string{"app||b", "app||a||b", "app||c", "other||a"} {
+		require.NoError(t, client.Add(ctx, name, &api.Job{Schedule: ptr.Of("@every 1h")}))
+	}
+
+	names := func(jobs []*api.NamedJob) []string {
+		n := make([]string, 0, len(jobs))
+		for _, job := range jobs {
+			n = append(n, job.GetName())
+			assert.Equal(t, "@every 1h", job.GetJob().GetSchedule())
+		}
+		return n
+	}
+
+	jobs, more, err := cr.ListPage(ctx, "app||", "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app||a||b", "app||b"}, names(jobs))
+	assert.True(t, more)
+
+	jobs, more, err = cr.ListPage(ctx, "app||", "app||b", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app||c"}, names(jobs))
+	assert.False(t, more)
+
+	jobs, more, err = cr.ListPage(ctx, "app||", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app||a||b", "app||b", "app||c"}, names(jobs))
+	assert.False(t, more)
+
+	t.Run("deleted jobs are not listed", func(t *testing.T) {
+		require.NoError(t, client.Delete(ctx, "app||a||b"))
+		jobs, more, err := cr.ListPage(ctx, "app||", "", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app||b"}, names(jobs))
+		assert.True(t, more)
+	})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlbackend
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultTablePrefix   = "dapr_scheduler_"
+	defaultPollInterval  = time.Second
+	defaultLeaseDuration = 10 * time.Second
+	defaultClaimDuration = 5 * time.Minute
+)
+
+var tablePrefixRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// config is the configuration of the backend, given as YAML or JSON with the
+// --storage-backend-config flag of the scheduler.
+type config struct {
+	// ConnectionString is the connection string of the database.
+	ConnectionString string `json:"connectionString"`
+	// TablePrefix is the prefix of the tables of the jobs and of the replicas.
+	TablePrefix string `json:"tablePrefix"`
+	// PollInterval is the maximum interval between two lookups of the due
+	// jobs.
+	PollInterval string `json:"pollInterval"`
+	// LeaseDuration is the duration after which a replica which stopped
+	// sending heartbeats no longer owns its partition of the jobs.
+	LeaseDuration string `json:"leaseDuration"`
+	// ClaimDuration is the duration after which a triggered job whose outcome
+	// wasn't recorded, for example because its replica crashed, is triggered
+	// again.
+	ClaimDuration string `json:"claimDuration"`
+}
+
+type options struct {
+	connectionString string
+	tablePrefix      string
+	pollInterval     time.Duration
+	leaseDuration    time.Duration
+	claimDuration    time.Duration
+}
+
+func parseConfig(raw any) (*options, error) {
+	var b []byte
+	switch v := raw.(type) {
+	case nil:
+		return nil, errors.New("backend config with a connection string is required")
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return nil, fmt.Errorf("unsupported backend config type %T", raw)
+	}
+
+	var cfg config
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse backend config: %w", err)
+	}
+
+	opts := &options{
+		connectionString: strings.TrimSpace(cfg.ConnectionString),
+		tablePrefix:      defaultTablePrefix,
+	}
+	if opts.connectionString == "" {
+		return nil, errors.New("backend config connectionString is required")
+	}
+
+	if cfg.TablePrefix != "" {
+		if !tablePrefixRegexp.MatchString(cfg.TablePrefix) {
+			return nil, fmt.Errorf("backend config tablePrefix %q must only contain letters, digits and underscores", cfg.TablePrefix)
+		}
+		opts.tablePrefix = cfg.TablePrefix
+	}
+
+	var err error
+	if opts.pollInterval, err = parseDuration("pollInterval", cfg.PollInterval, defaultPollInterval); err != nil {
+		return nil, err
+	}
+	if opts.leaseDuration, err = parseDuration("leaseDuration", cfg.LeaseDuration, defaultLeaseDuration); err != nil {
+		return nil, err
+	}
+	if opts.claimDuration, err = parseDuration("claimDuration", cfg.ClaimDuration, defaultClaimDuration); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+func parseDuration(key, value string, def time.Duration) (time.Duration, error) {
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid backend config %s %q: %w", key, value, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("backend config %s must be positive", key)
+	}
+	return d, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlbackend
+
+import (
+	"strconv"
+	"strings"
+
+	// Register the "pgx" and "sqlite" database/sql drivers.
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+// dialect holds the differences between the supported databases. The queries
+// are written with "?" placeholders, and with "{jobs}" and "{replicas}" in
+// place of the table names.
+type dialect struct {
+	driver     string
+	blobType   string
+	positional bool
+	// byteOrder is the collation of the text columns which orders them by
+	// their bytes, if that is not the default.
+	byteOrder string
+}
+
+var (
+	postgres = dialect{driver: "pgx", blobType: "BYTEA", positional: true, byteOrder: `COLLATE "C"`}
+	sqlite   = dialect{driver: "sqlite", blobType: "BLOB"}
+)
+
+// schema returns the statements creating the tables, which are idempotent.
+func (d dialect) schema() []string {
+	return []string{
+		`CREATE TABLE IF NOT EXISTS {jobs} (
+			name TEXT NOT NULL PRIMARY KEY,
+			job ` + d.blobType + ` NOT NULL,
+			partition_id BIGINT NOT NULL,
+			version BIGINT NOT NULL,
+			begin_at BIGINT NOT NULL,
+			expires_at BIGINT,
+			trigger_count BIGINT NOT NULL,
+			tick_at BIGINT NOT NULL,
+			attempts BIGINT NOT NULL,
+			due_at BIGINT
+		)`,
+		`CREATE INDEX IF NOT EXISTS {jobs}_due_at ON {jobs} (due_at)`,
+		`CREATE TABLE IF NOT EXISTS {replicas} (
+			id TEXT NOT NULL PRIMARY KEY,
+			data ` + d.blobType + ` NOT NULL,
+			expires_at BIGINT NOT NULL
+		)`,
+	}
+}
+
+// bytewise returns the text column, compared and ordered by its bytes.
+func (d dialect) bytewise(column string) string {
+	if d.byteOrder == "" {
+		return column
+	}
+	return column + " " + d.byteOrder
+}
+
+// rebind replaces the "?" placeholders of the query with the "$n" positional
+// placeholders when the database requires them.
+func (d dialect) rebind(query string) string {
+	if !d.positional {
+		return query
+	}
+
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, r := range query {
+		if r != '?' {
+			b.WriteRune(r)
+			continue
+		}
+		n++
+		b.WriteByte('$')
+		b.WriteString(strconv.Itoa(n))
+	}
+	return b.String()
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlbackend
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/dapr/kit/concurrency"
+)
+
+// dueJob is a job claimed for a trigger.
+type dueJob struct {
+	name     string
+	job      *api.Job
+	version  int64
+	begin    time.Time
+	exp      *time.Time
+	count    uint32
+	tick     time.Time
+	attempts uint32
+}
+
+func (b *backend) Run(ctx context.Context) error {
+	if !b.running.CompareAndSwap(false, true) {
+		return errors.New("cron already running")
+	}
+	defer b.db.Close()
+
+	if err := b.migrate(ctx); err != nil {
+		return fmt.Errorf("failed to migrate the %s database: %w", b.dialect.driver, err)
+	}
+
+	err := concurrency.NewRunnerManager(
+		b.runMembership,
+		b.runTriggers,
+	).Run(ctx)
+
+	// Wait for the outcomes of the triggers being recorded. The outcomes
+	// received later are dropped, and their jobs are triggered again once
+	// their claim expires.
+	b.lock.Lock()
+	b.closed = true
+	b.lock.Unlock()
+	b.wg.Wait()
+
+	return err
+}
+
+// runMembership sends the heartbeats of the replica, and computes the
+// partition of the jobs it owns from the live replicas.
+func (b *backend) runMembership(ctx context.Context) error {
+	ticker := b.clock.NewTicker(b.leaseDuration / 3)
+	defer ticker.Stop()
+	defer b.leave()
+
+	var members []string
+	for {
+		var err error
+		members, err = b.heartbeat(ctx, members)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Errorf("Failed to send the scheduler replica heartbeat: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		}
+	}
+}
+
+func (b *backend) heartbeat(ctx context.Context, members []string) ([]string, error) {
+	now := b.clock.Now()
+
+	_, err := b.db.ExecContext(ctx,
+		b.query(`INSERT INTO {replicas} (id, data, expires_at) VALUES (?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET data = excluded.data, expires_at = excluded.expires_at`),
+		b.id, b.replicaData, now.Add(b.leaseDuration).UnixNano(),
+	)
+	if err != nil {
+		return members, err
+	}
+
+	_, err = b.db.ExecContext(ctx, b.query(`DELETE FROM {replicas} WHERE expires_at < ?`), now.UnixNano())
+	if err != nil {
+		return members, err
+	}
+
+	rows, err := b.db.QueryContext(ctx, b.query(`SELECT id, data FROM {replicas}`))
+	if err != nil {
+		return members, err
+	}
+	defer rows.Close()
+
+	type replica struct {
+		id   string
+		data []byte
+	}
+	var replicas []replica
+	for rows.Next() {
+		var r replica
+		if err = rows.Scan(&r.id, &r.data); err != nil {
+			return members, err
+		}
+		replicas = append(replicas, r)
+	}
+	if err = rows.Err(); err != nil {
+		return members, err
+	}
+
+	slices.SortFunc(replicas, func(a, b replica) int {
+		return strings.Compare(a.id, b.id)
+	})
+	ids := make([]string, len(replicas))
+	for i, r := range replicas {
+		ids[i] = r.id
+	}
+	if slices.Equal(ids, members) {
+		return members, nil
+	}
+
+	idx := slices.Index(ids, b.id)
+	if idx < 0 {
+		return members, errors.New("replica heartbeat is missing")
+	}
+
+	data := make([]*anypb.Any, 0, len(replicas))
+	for _, r := range replicas {
+		var a anypb.Any
+		if err = proto.Unmarshal(r.data, &a); err != nil {
+			return members, fmt.Errorf("failed to unmarshal the data of replica %q: %w", r.id, err)
+		}
+		data = append(data, &a)
+	}
+
+	log.Infof("Scheduler replica %q owns partition %d of %d of the jobs", b.id, idx, len(ids))
+
+	b.lock.Lock()
+	b.partitions = int64(len(ids))
+	b.partition = int64(idx)
+	b.lock.Unlock()
+	b.elected.Store(true)
+	b.wake()
+
+	if b.leadership != nil {
+		select {
+		case b.leadership <- data:
+		case <-ctx.Done():
+			return ids, ctx.Err()
+		}
+	}
+
+	return ids, nil
+}
+
+// leave deletes the heartbeat of the replica, so that the other replicas take
+// over its partition without waiting for its lease to expire.
+func (b *backend) leave() {
+	b.elected.Store(false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := b.db.ExecContext(ctx, b.query(`DELETE FROM {replicas} WHERE id = ?`), b.id); err != nil {
+		log.Warnf("Failed to delete the scheduler replica heartbeat: %s", err)
+	}
+}
+
+// runTriggers triggers the due jobs of the partition of the replica.
+func (b *backend) runTriggers(ctx context.Context) error {
+	for {
+		wait, err := b.triggerDue(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Errorf("Failed to trigger the due jobs: %s", err)
+			wait = b.pollInterval
+		}
+
+		if wait <= 0 {
+			continue
+		}
+
+		timer := b.clock.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-b.wakeCh:
+			timer.Stop()
+		case <-timer.C():
+		}
+	}
+}
+
+// triggerDue triggers a batch of due jobs, and returns the duration until the
+// next lookup.
+func (b *backend) triggerDue(ctx context.Context) (time.Duration, error) {
+	if !b.elected.Load() {
+		return b.pollInterval, nil
+	}
+
+	b.lock.RLock()
+	partitions, partition := b.partitions, b.partition
+	b.lock.RUnlock()
+
+	now := b.clock.Now()
+	limit := cap(b.workers)
+
+	due, err := b.dueJobs(ctx, now, partitions, partition, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, j := range due {
+		claimed, err := b.claim(ctx, j, now)
+		if err != nil {
+			return 0, err
+		}
+		if !claimed {
+			continue
+		}
+		select {
+		case b.workers <- struct{}{}:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+		b.trigger(j)
+	}
+
+	if len(due) == limit {
+		return 0, nil
+	}
+
+	var next dbsql.NullInt64
+	err = b.db.QueryRowContext(ctx,
+		b.query(`SELECT MIN(due_at) FROM {jobs} WHERE partition_id % ? = ?`),
+		partitions, partition,
+	).Scan(&next)
+	if err != nil {
+		return 0, err
+	}
+
+	wait := b.pollInterval
+	if next.Valid {
+		wait = min(wait, time.Unix(0, next.Int64).Sub(b.clock.Now()))
+	}
+	return wait, nil
+}
+
+func (b *backend) dueJobs(ctx context.Context, now time.Time, partitions, partition int64, limit int) ([]*dueJob, error) {
+	rows, err := b.db.QueryContext(ctx,
+		b.query(`SELECT name, job, version, begin_at, expires_at, trigger_count, tick_at, attempts FROM {jobs}
+			WHERE due_at <= ? AND partition_id % ? = ?
+			ORDER BY due_at LIMIT ?`),
+		now.UnixNano(), partitions, partition, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var due []*dueJob
+	for rows.Next() {
+		var (
+			j               dueJob
+			data            []byte
+			begin, tick     int64
+			exp             dbsql.NullInt64
+			count, attempts int64
+		)
+		if err := rows.Scan(&j.name, &data, &j.version, &begin, &exp, &count, &tick, &attempts); err != nil {
+			return nil, err
+		}
+
+		j.job = new(api.Job)
+		if err := proto.Unmarshal(data, j.job); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal job %q: %w", j.name, err)
+		}
+		j.begin = time.Unix(0, begin).UTC()
+		j.tick = time.Unix(0, tick).UTC()
+		if exp.Valid {
+			t := time.Unix(0, exp.Int64).UTC()
+			j.exp = &t
+		}
+		//nolint:gosec
+		j.count, j.attempts = uint32(count), uint32(attempts)
+
+		due = append(due, &j)
+	}
+
+	return due, rows.Err()
+}
+
+// claim takes the job for a trigger, unless another replica took it first.
+// The job is due again after the claim duration, if the outcome of the
+// trigger isn't recorded by then.
+func (b *backend) claim(ctx context.Context, j *dueJob, now time.Time) (bool, error) {
+	claimed, err := b.update(ctx, j,
+		`UPDATE {jobs} SET version = version + 1, due_at = ? WHERE name = ? AND version = ?`,
+		now.Add(b.claimDuration).UnixNano(),
+	)
+	if claimed {
+		j.version++
+	}
+	return claimed, err
+}
+
+func (b *backend) trigger(j *dueJob) {
+	b.triggerFn(&api.TriggerRequest{
+		Name:     j.name,
+		Metadata: j.job.GetMetadata(),
+		Payload:  j.job.GetPayload(),
+	}, func(resp *api.TriggerResponse) {
+		b.lock.RLock()
+		defer b.lock.RUnlock()
+		if b.closed {
+			<-b.workers
+			return
+		}
+
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			defer func() { <-b.workers }()
+
+			ctx, cancel := context.WithTimeout(context.Background(), b.leaseDuration)
+			defer cancel()
+			if err := b.record(ctx, j, resp.GetResult()); err != nil {
+				log.Errorf("Failed to record the trigger of job %q: %s", j.name, err)
+			}
+		}()
+	})
+}
+
+// record records the outcome of the trigger of the job.
+func (b *backend) record(ctx context.Context, j *dueJob, result api.TriggerResponseResult) error {
+	switch result {
+	case api.TriggerResponseResult_SUCCESS:
+		return b.tick(ctx, j)
+
+	case api.TriggerResponseResult_UNDELIVERABLE:
+		staged, err := b.update(ctx, j,
+			`UPDATE {jobs} SET version = version + 1, due_at = NULL WHERE name = ? AND version = ?`,
+		)
+		if err != nil || !staged {
+			return err
+		}
+		if b.isDeliverable(j.name) {
+			return b.unstage(ctx, j.name)
+		}
+		return nil
+
+	default:
+		policy := j.job.GetFailurePolicy().GetConstant()
+		if policy == nil || (policy.MaxRetries != nil && j.attempts >= policy.GetMaxRetries()) {
+			return b.tick(ctx, j)
+		}
+		_, err := b.update(ctx, j,
+			`UPDATE {jobs} SET version = version + 1, attempts = attempts + 1, due_at = ? WHERE name = ? AND version = ?`,
+			b.clock.Now().Add(policy.GetInterval().AsDuration()).UnixNano(),
+		)
+		return err
+	}
+}
+
+// tick moves the job to its next trigger, or deletes it if it has none.
+func (b *backend) tick(ctx context.Context, j *dueJob) error {
+	sched, err := newSchedule(j.job, j.begin, j.exp)
+	if err != nil {
+		return err
+	}
+
+	count := j.count + 1
+	next := sched.next(count, &j.tick)
+	if next == nil {
+		_, err = b.update(ctx, j, `DELETE FROM {jobs} WHERE name = ? AND version = ?`)
+		return err
+	}
+
+	_, err = b.update(ctx, j,
+		`UPDATE {jobs} SET version = version + 1, trigger_count = ?, tick_at = ?, attempts = 0, due_at = ?
+			WHERE name = ? AND version = ?`,
+		count, next.UnixNano(), next.UnixNano(),
+	)
+	if err == nil {
+		b.wake()
+	}
+	return err
+}
+
+// update executes the statement on the job if it wasn't changed since it was
+// claimed, and returns true if it was executed. The name and the version of
+// the job are appended to the arguments.
+func (b *backend) update(ctx context.Context, j *dueJob, stmt string, args ...any) (bool, error) {
+	res, err := b.db.ExecContext(ctx, b.query(stmt), append(args, j.name, j.version)...)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlbackend
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/dapr/kit/cron"
+	"github.com/dapr/kit/ptr"
+	kittime "github.com/dapr/kit/time"
+)
+
+const maxJobNameLength = 512
+
+var parser = cron.NewParser(cron.Second |
+	cron.Minute |
+	cron.Hour |
+	cron.Dom |
+	cron.Month |
+	cron.Dow |
+	cron.Descriptor,
+)
+
+// schedule computes the trigger times of a job, with the same semantics as
+// the etcd backend of the cron library.
+type schedule struct {
+	// cron is the schedule of a recurring job, or nil for a oneshot job.
+	cron cron.Schedule
+
+	// begin is the due time of the job, or the time it was added at if it has
+	// no due time.
+	begin time.Time
+
+	// dueTime is true if the job triggers at begin.
+	dueTime bool
+
+	// exp is the optional time at which the schedule ends.
+	exp *time.Time
+
+	// total is the optional total number of triggers.
+	total *uint32
+}
+
+// parseJob validates a new job and returns its schedule. The job is given the
+// default failure policy if it has none.
+func parseJob(job *api.Job, now time.Time) (*schedule, error) {
+	switch {
+	case job.DueTime == nil && job.Schedule == nil:
+		return nil, errors.New("job must have either a due time or a schedule")
+	case job.Schedule == nil && job.GetRepeats() > 1:
+		return nil, errors.New("job must have a schedule to repeat")
+	case job.Repeats != nil && job.GetRepeats() < 1:
+		return nil, errors.New("defined repeats must be greater than 0")
+	case job.Schedule == nil && job.Ttl != nil:
+		return nil, errors.New("job must have a schedule to have a ttl")
+	}
+
+	//nolint:protogetter
+	if job.FailurePolicy == nil {
+		job.FailurePolicy = &api.FailurePolicy{
+			Policy: &api.FailurePolicy_Constant{
+				Constant: &api.FailurePolicyConstant{
+					Interval:   durationpb.New(time.Second),
+					MaxRetries: ptr.Of(uint32(3)),
+				},
+			},
+		}
+	}
+
+	begin := now
+	if job.DueTime != nil {
+		var err error
+		begin, err = parsePointInTime(job.GetDueTime(), now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var exp *time.Time
+	if job.Ttl != nil {
+		expiration, err := parsePointInTime(job.GetTtl(), begin)
+		if err != nil {
+			return nil, errors.New("ttl format not recognized")
+		}
+		if job.DueTime != nil && begin.After(expiration) {
+			return nil, errors.New("ttl must be greater than due time")
+		}
+		exp = &expiration
+	}
+
+	return newSchedule(job, begin, exp)
+}
+
+// newSchedule returns the schedule of a stored job.
+func newSchedule(job *api.Job, begin time.Time, exp *time.Time) (*schedule, error) {
+	s := &schedule{
+		begin:   begin,
+		dueTime: job.DueTime != nil,
+		exp:     exp,
+		total:   job.Repeats,
+	}
+	if job.Schedule != nil {
+		var err error
+		s.cron, err = parser.Parse(job.GetSchedule())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// next returns the time of the trigger following the count triggers of the
+// job, the last of which was scheduled at last, or nil if the job has no more
+// triggers.
+func (s *schedule) next(count uint32, last *time.Time) *time.Time {
+	if s.cron == nil {
+		if count > 0 {
+			return nil
+		}
+		return &s.begin
+	}
+
+	if s.total != nil && count >= *s.total {
+		return nil
+	}
+
+	if last == nil {
+		if s.dueTime {
+			return &s.begin
+		}
+		return ptr.Of(s.cron.Next(s.begin))
+	}
+
+	next := s.cron.Next(*last)
+	if s.exp != nil && next.After(*s.exp) {
+		return nil
+	}
+	return &next
+}
+
+func parsePointInTime(str string, now time.Time) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, str)
+	if err == nil {
+		return t.UTC(), nil
+	}
+
+	dur, err := time.ParseDuration(str)
+	if err == nil {
+		return now.Add(dur), nil
+	}
+
+	years, months, days, duration, repeats, err := kittime.ParseISO8601Duration(str)
+	if err != nil {
+		return time.Time{}, errors.New("due time format not recognized")
+	}
+	if repeats > 0 {
+		return time.Time{}, errors.New("repeats not supported for due time")
+	}
+
+	return now.AddDate(years, months, days).Add(duration), nil
+}
+
+// validateName validates a job name with the rules of the cron library.
+func validateName(name string) error {
+	if len(name) == 0 {
+		return errors.New("job name cannot be empty")
+	}
+	if len(name) > maxJobNameLength {
+		return fmt.Errorf("job name is invalid %q: must be at most %d characters", name, maxJobNameLength)
+	}
+	if strings.ContainsAny(name, "#?/\\") {
+		return fmt.Errorf("job name is invalid %q: must not contain '/', '\\', '#' or '?'", name)
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return fmt.Errorf("job name is invalid %q: must not contain control characters", name)
+		}
+	}
+	if name == "." || name == ".." {
+		return fmt.Errorf("job name is invalid %q: must not be a path traversal sequence", name)
+	}
+	return nil
+}
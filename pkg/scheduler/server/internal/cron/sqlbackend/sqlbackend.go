@@ -0,0 +1,440 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqlbackend implements the storage backends of the scheduler jobs in
+// a SQL database, as an alternative to Etcd. The jobs are split in partitions
+// between the scheduler replicas, which discover each other through a table
+// of heartbeats.
+package sqlbackend
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	apierrors "github.com/diagridio/go-etcd-cron/api/errors"
+	etcdcron "github.com/diagridio/go-etcd-cron/cron"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.scheduler.server.cron.sqlbackend")
+
+const (
+	// BackendPostgres stores the jobs in a PostgreSQL database.
+	BackendPostgres = "postgres"
+	// BackendSQLite stores the jobs in a SQLite database. It is meant for
+	// development, with a single scheduler replica.
+	BackendSQLite = "sqlite"
+)
+
+const defaultWorkers = 128
+
+func init() {
+	etcdcron.Register(BackendPostgres, factory(postgres))
+	etcdcron.Register(BackendSQLite, factory(sqlite))
+}
+
+func factory(d dialect) etcdcron.Factory {
+	return func(opts etcdcron.Options) (api.Interface, error) {
+		return newBackend(d, opts)
+	}
+}
+
+type backend struct {
+	db       *dbsql.DB
+	dialect  dialect
+	replacer *strings.Replacer
+	clock    clock.WithTicker
+
+	id          string
+	replicaData []byte
+	leadership  chan<- []*anypb.Any
+	triggerFn   api.TriggerFunction
+	workers     chan struct{}
+
+	pollInterval  time.Duration
+	leaseDuration time.Duration
+	claimDuration time.Duration
+
+	lock        sync.RWMutex
+	partitions  int64
+	partition   int64
+	deliverable map[string]int
+	closed      bool
+	wg          sync.WaitGroup
+
+	elected atomic.Bool
+	running atomic.Bool
+	wakeCh  chan struct{}
+}
+
+func newBackend(d dialect, opts etcdcron.Options) (*backend, error) {
+	if opts.TriggerFn == nil {
+		return nil, errors.New("trigger function is required")
+	}
+	if opts.ID == "" {
+		return nil, errors.New("replica ID is required")
+	}
+	if opts.ConsumerSink != nil {
+		return nil, errors.New("consumer sink is not supported by the SQL storage backends")
+	}
+
+	cfg, err := parseConfig(opts.BackendConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	workers := uint32(defaultWorkers)
+	if opts.Workers != nil {
+		if *opts.Workers == 0 {
+			return nil, errors.New("workers must be greater than 0")
+		}
+		workers = *opts.Workers
+	}
+
+	var replicaData []byte
+	if opts.ReplicaData != nil {
+		replicaData, err = proto.Marshal(opts.ReplicaData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal replica data: %w", err)
+		}
+	}
+
+	db, err := dbsql.Open(d.driver, cfg.connectionString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s database: %w", d.driver, err)
+	}
+
+	return &backend{
+		db:      db,
+		dialect: d,
+		replacer: strings.NewReplacer(
+			"{jobs}", cfg.tablePrefix+"jobs",
+			"{replicas}", cfg.tablePrefix+"replicas",
+		),
+		clock:         clock.RealClock{},
+		id:            opts.ID,
+		replicaData:   replicaData,
+		leadership:    opts.WatchLeadership,
+		triggerFn:     opts.TriggerFn,
+		workers:       make(chan struct{}, workers),
+		pollInterval:  cfg.pollInterval,
+		leaseDuration: cfg.leaseDuration,
+		claimDuration: cfg.claimDuration,
+		deliverable:   make(map[string]int),
+		wakeCh:        make(chan struct{}, 1),
+	}, nil
+}
+
+// query returns the query with the table names and the placeholders of the
+// database.
+func (b *backend) query(query string) string {
+	return b.dialect.rebind(b.replacer.Replace(query))
+}
+
+func (b *backend) migrate(ctx context.Context) error {
+	for _, stmt := range b.dialect.schema() {
+		if _, err := b.db.ExecContext(ctx, b.replacer.Replace(stmt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wake makes the trigger loop look up the due jobs.
+func (b *backend) wake() {
+	select {
+	case b.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (b *backend) Add(ctx context.Context, name string, job *api.Job) error {
+	return b.put(ctx, name, job, true)
+}
+
+func (b *backend) AddIfNotExists(ctx context.Context, name string, job *api.Job) error {
+	return b.put(ctx, name, job, false)
+}
+
+func (b *backend) put(ctx context.Context, name string, job *api.Job, upsert bool) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	if job == nil {
+		return errors.New("job cannot be nil")
+	}
+
+	job = proto.Clone(job).(*api.Job)
+	sched, err := parseJob(job, b.clock.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	next := sched.next(0, nil)
+	if next == nil {
+		// The job expires before its first trigger.
+		if upsert {
+			return b.Delete(ctx, name)
+		}
+		return nil
+	}
+
+	data, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO {jobs}
+		(name, job, partition_id, version, begin_at, expires_at, trigger_count, tick_at, attempts, due_at)
+		VALUES (?, ?, ?, 1, ?, ?, 0, ?, 0, ?)`
+	if upsert {
+		query += ` ON CONFLICT (name) DO UPDATE SET
+			job = excluded.job,
+			partition_id = excluded.partition_id,
+			version = {jobs}.version + 1,
+			begin_at = excluded.begin_at,
+			expires_at = excluded.expires_at,
+			trigger_count = 0,
+			tick_at = excluded.tick_at,
+			attempts = 0,
+			due_at = excluded.due_at`
+	} else {
+		query += ` ON CONFLICT (name) DO NOTHING`
+	}
+
+	var exp dbsql.NullInt64
+	if sched.exp != nil {
+		exp = dbsql.NullInt64{Int64: sched.exp.UnixNano(), Valid: true}
+	}
+
+	//nolint:gosec
+	res, err := b.db.ExecContext(ctx, b.query(query),
+		name, data, rand.Int63(), sched.begin.UnixNano(), exp, next.UnixNano(), next.UnixNano(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !upsert {
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return apierrors.NewJobAlreadyExists(name)
+		}
+	}
+
+	b.wake()
+	return nil
+}
+
+func (b *backend) Get(ctx context.Context, name string) (*api.Job, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	err := b.db.QueryRowContext(ctx, b.query(`SELECT job FROM {jobs} WHERE name = ?`), name).Scan(&data)
+	if errors.Is(err, dbsql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var job api.Job
+	if err := proto.Unmarshal(data, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (b *backend) Delete(ctx context.Context, name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	_, err := b.db.ExecContext(ctx, b.query(`DELETE FROM {jobs} WHERE name = ?`), name)
+	return err
+}
+
+func (b *backend) DeletePrefixes(ctx context.Context, prefixes ...string) error {
+	for _, prefix := range prefixes {
+		_, err := b.db.ExecContext(ctx,
+			b.query(`DELETE FROM {jobs} WHERE substr(name, 1, ?) = ?`),
+			utf8.RuneCountInString(prefix), prefix,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *backend) List(ctx context.Context, prefix string) (*api.ListResponse, error) {
+	rows, err := b.db.QueryContext(ctx,
+		b.query(`SELECT name, job FROM {jobs} WHERE substr(name, 1, ?) = ?`),
+		utf8.RuneCountInString(prefix), prefix,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*api.NamedJob
+	for rows.Next() {
+		var (
+			name string
+			data []byte
+		)
+		if err := rows.Scan(&name, &data); err != nil {
+			return nil, err
+		}
+		var job api.Job
+		if err := proto.Unmarshal(data, &job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, &api.NamedJob{Name: name, Job: &job})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// The order of the text collation of the database may differ from the
+	// byte order.
+	slices.SortFunc(jobs, func(a, b *api.NamedJob) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	return &api.ListResponse{Jobs: jobs}, nil
+}
+
+// ListPage lists a page of the jobs under the job name prefix, ordered by the
+// bytes of their names as the jobs stored in Etcd are.
+func (b *backend) ListPage(ctx context.Context, prefix, startAfter string, limit uint32) ([]*api.NamedJob, bool, error) {
+	name := b.dialect.bytewise("name")
+	query := `SELECT name, job FROM {jobs} WHERE substr(name, 1, ?) = ? AND ` + name + ` > ? ORDER BY ` + name
+	args := []any{utf8.RuneCountInString(prefix), prefix, startAfter}
+	if limit > 0 {
+		// One more job is read to know whether there are more.
+		query += ` LIMIT ?`
+		args = append(args, int64(limit)+1)
+	}
+
+	rows, err := b.db.QueryContext(ctx, b.query(query), args...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	var jobs []*api.NamedJob
+	for rows.Next() {
+		var data []byte
+		job := new(api.NamedJob)
+		if err := rows.Scan(&job.Name, &data); err != nil {
+			return nil, false, err
+		}
+		job.Job = new(api.Job)
+		if err := proto.Unmarshal(data, job.Job); err != nil {
+			return nil, false, err
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	if limit > 0 && len(jobs) > int(limit) {
+		return jobs[:limit], true, nil
+	}
+	return jobs, false, nil
+}
+
+func (b *backend) DeliverablePrefixes(ctx context.Context, prefixes ...string) (context.CancelCauseFunc, error) {
+	if len(prefixes) == 0 {
+		return nil, errors.New("no prefixes provided")
+	}
+
+	b.lock.Lock()
+	for _, prefix := range prefixes {
+		b.deliverable[prefix]++
+	}
+	b.lock.Unlock()
+
+	var once sync.Once
+	cancel := func(error) {
+		once.Do(func() {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			for _, prefix := range prefixes {
+				if b.deliverable[prefix]--; b.deliverable[prefix] <= 0 {
+					delete(b.deliverable, prefix)
+				}
+			}
+		})
+	}
+
+	if err := b.unstage(ctx, prefixes...); err != nil {
+		cancel(err)
+		return nil, err
+	}
+
+	return cancel, nil
+}
+
+// isDeliverable returns true if the job name matches a deliverable prefix.
+func (b *backend) isDeliverable(name string) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	for prefix := range b.deliverable {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// unstage makes the staged jobs matching the prefixes due now.
+func (b *backend) unstage(ctx context.Context, prefixes ...string) error {
+	now := b.clock.Now().UnixNano()
+	for _, prefix := range prefixes {
+		_, err := b.db.ExecContext(ctx,
+			b.query(`UPDATE {jobs} SET due_at = ?, version = version + 1
+				WHERE due_at IS NULL AND substr(name, 1, ?) = ?`),
+			now, utf8.RuneCountInString(prefix), prefix,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	b.wake()
+	return nil
+}
+
+func (b *backend) IsElected() bool {
+	return b.elected.Load()
+}
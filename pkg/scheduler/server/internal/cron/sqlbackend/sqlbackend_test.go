@@ -0,0 +1,394 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlbackend
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	apierrors "github.com/diagridio/go-etcd-cron/api/errors"
+	etcdcron "github.com/diagridio/go-etcd-cron/cron"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/kit/ptr"
+)
+
+type triggers struct {
+	lock   sync.Mutex
+	counts map[string]int
+	result func(name string, count int) api.TriggerResponseResult
+}
+
+func (tr *triggers) fn(req *api.TriggerRequest, respond func(*api.TriggerResponse)) {
+	tr.lock.Lock()
+	tr.counts[req.GetName()]++
+	count := tr.counts[req.GetName()]
+	tr.lock.Unlock()
+
+	result := api.TriggerResponseResult_SUCCESS
+	if tr.result != nil {
+		result = tr.result(req.GetName(), count)
+	}
+	respond(&api.TriggerResponse{Result: result})
+}
+
+func (tr *triggers) count(name string) int {
+	tr.lock.Lock()
+	defer tr.lock.Unlock()
+	return tr.counts[name]
+}
+
+type replica struct {
+	*backend
+	leaders atomic.Pointer[[]*anypb.Any]
+}
+
+func newReplica(t *testing.T, dir, id string, tr *triggers) *replica {
+	t.Helper()
+
+	leadershipCh := make(chan []*anypb.Any)
+	cron, err := etcdcron.New(etcdcron.Options{
+		ID:              id,
+		TriggerFn:       tr.fn,
+		ReplicaData:     must(anypb.New(wrapperspb.String(id))),
+		WatchLeadership: leadershipCh,
+		Backend:         ptr.Of(BackendSQLite),
+		BackendConfig: []byte(`
+connectionString: "file:` + filepath.Join(dir, "jobs.db") + `?_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)"
+pollInterval: 50ms
+leaseDuration: 1s
+claimDuration: 1s
+`),
+	})
+	require.NoError(t, err)
+
+	r := &replica{backend: cron.(*backend)}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- r.Run(ctx) }()
+	go func() {
+		for {
+			select {
+			case leaders := <-leadershipCh:
+				r.leaders.Store(&leaders)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.True(c, r.IsElected())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return r
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestBackendRegistered(t *testing.T) {
+	for _, name := range []string{BackendPostgres, BackendSQLite} {
+		_, err := etcdcron.New(etcdcron.Options{
+			ID:        "scheduler-0",
+			TriggerFn: func(*api.TriggerRequest, func(*api.TriggerResponse)) {},
+			Backend:   ptr.Of(name),
+		})
+		require.ErrorContains(t, err, "connection string is required", name)
+	}
+}
+
+func TestJobs(t *testing.T) {
+	tr := &triggers{counts: make(map[string]int)}
+	r := newReplica(t, t.TempDir(), "scheduler-0", tr)
+	ctx := t.Context()
+
+	job := &api.Job{
+		Schedule: ptr.Of("@every 1h"),
+		Payload:  must(anypb.New(wrapperspb.String("payload"))),
+	}
+	require.NoError(t, r.Add(ctx, "app||a", job))
+	require.NoError(t, r.Add(ctx, "app||b", job))
+	require.NoError(t, r.Add(ctx, "other||c", job))
+
+	got, err := r.Get(ctx, "app||a")
+	require.NoError(t, err)
+	assert.Equal(t, "@every 1h", got.GetSchedule())
+	assert.Equal(t, uint32(3), got.GetFailurePolicy().GetConstant().GetMaxRetries(), "default failure policy")
+
+	got, err = r.Get(ctx, "app||unknown")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	err = r.AddIfNotExists(ctx, "app||a", job)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsJobAlreadyExists(err))
+
+	require.Error(t, r.Add(ctx, "app/a", job))
+	require.Error(t, r.Add(ctx, "app||d", &api.Job{Repeats: ptr.Of(uint32(2)), DueTime: ptr.Of("1h")}))
+
+	list, err := r.List(ctx, "app||")
+	require.NoError(t, err)
+	require.Len(t, list.GetJobs(), 2)
+	assert.Equal(t, "app||a", list.GetJobs()[0].GetName())
+	assert.Equal(t, "app||b", list.GetJobs()[1].GetName())
+
+	jobs, more, err := r.ListPage(ctx, "app||", "", 1)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "app||a", jobs[0].GetName())
+	assert.Equal(t, "@every 1h", jobs[0].GetJob().GetSchedule())
+	assert.True(t, more)
+
+	jobs, more, err = r.ListPage(ctx, "app||", "app||a", 1)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "app||b", jobs[0].GetName())
+	assert.False(t, more)
+
+	jobs, more, err = r.ListPage(ctx, "", "app||a", 0)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "app||b", jobs[0].GetName())
+	assert.Equal(t, "other||c", jobs[1].GetName())
+	assert.False(t, more)
+
+	require.NoError(t, r.Delete(ctx, "app||a"))
+	require.NoError(t, r.DeletePrefixes(ctx, "other||"))
+
+	list, err = r.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, list.GetJobs(), 1)
+	assert.Equal(t, "app||b", list.GetJobs()[0].GetName())
+}
+
+func TestTriggers(t *testing.T) {
+	t.Run("oneshot job is triggered once and deleted", func(t *testing.T) {
+		tr := &triggers{counts: make(map[string]int)}
+		r := newReplica(t, t.TempDir(), "scheduler-0", tr)
+
+		require.NoError(t, r.Add(t.Context(), "oneshot", &api.Job{DueTime: ptr.Of("0s")}))
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			job, err := r.Get(t.Context(), "oneshot")
+			assert.NoError(c, err)
+			assert.Nil(c, job)
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 1, tr.count("oneshot"))
+	})
+
+	t.Run("recurring job is triggered up to its repeats", func(t *testing.T) {
+		tr := &triggers{counts: make(map[string]int)}
+		r := newReplica(t, t.TempDir(), "scheduler-0", tr)
+
+		require.NoError(t, r.Add(t.Context(), "recurring", &api.Job{
+			DueTime:  ptr.Of("0s"),
+			Schedule: ptr.Of("@every 1s"),
+			Repeats:  ptr.Of(uint32(2)),
+		}))
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			job, err := r.Get(t.Context(), "recurring")
+			assert.NoError(c, err)
+			assert.Nil(c, job)
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 2, tr.count("recurring"))
+	})
+
+	t.Run("failed trigger is retried with the failure policy", func(t *testing.T) {
+		tr := &triggers{
+			counts: make(map[string]int),
+			result: func(string, int) api.TriggerResponseResult {
+				return api.TriggerResponseResult_FAILED
+			},
+		}
+		r := newReplica(t, t.TempDir(), "scheduler-0", tr)
+
+		require.NoError(t, r.Add(t.Context(), "failing", &api.Job{
+			DueTime: ptr.Of("0s"),
+			FailurePolicy: &api.FailurePolicy{Policy: &api.FailurePolicy_Constant{
+				Constant: &api.FailurePolicyConstant{
+					Interval:   durationpb.New(10 * time.Millisecond),
+					MaxRetries: ptr.Of(uint32(2)),
+				},
+			}},
+		}))
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			job, err := r.Get(t.Context(), "failing")
+			assert.NoError(c, err)
+			assert.Nil(c, job)
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 3, tr.count("failing"))
+	})
+
+	t.Run("undeliverable job is triggered once its prefix is deliverable", func(t *testing.T) {
+		tr := &triggers{
+			counts: make(map[string]int),
+			result: func(_ string, count int) api.TriggerResponseResult {
+				if count == 1 {
+					return api.TriggerResponseResult_UNDELIVERABLE
+				}
+				return api.TriggerResponseResult_SUCCESS
+			},
+		}
+		r := newReplica(t, t.TempDir(), "scheduler-0", tr)
+
+		require.NoError(t, r.Add(t.Context(), "app||staged", &api.Job{DueTime: ptr.Of("0s")}))
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, 1, tr.count("app||staged"))
+		}, 5*time.Second, 10*time.Millisecond)
+
+		time.Sleep(200 * time.Millisecond)
+		assert.Equal(t, 1, tr.count("app||staged"))
+
+		cancel, err := r.DeliverablePrefixes(t.Context(), "app||")
+		require.NoError(t, err)
+		t.Cleanup(func() { cancel(nil) })
+
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			job, err := r.Get(t.Context(), "app||staged")
+			assert.NoError(c, err)
+			assert.Nil(c, job)
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 2, tr.count("app||staged"))
+	})
+}
+
+func TestReplicas(t *testing.T) {
+	dir := t.TempDir()
+	tr := &triggers{counts: make(map[string]int)}
+	r0 := newReplica(t, dir, "scheduler-0", tr)
+	r1 := newReplica(t, dir, "scheduler-1", tr)
+
+	for _, r := range []*replica{r0, r1} {
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			leaders := r.leaders.Load()
+			if assert.NotNil(c, leaders) && assert.Len(c, *leaders, 2) {
+				for i, leader := range *leaders {
+					var id wrapperspb.StringValue
+					assert.NoError(c, leader.UnmarshalTo(&id))
+					assert.Equal(c, "scheduler-"+strconv.Itoa(i), id.GetValue())
+				}
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	const n = 20
+	for i := range n {
+		require.NoError(t, r0.Add(t.Context(), "job-"+strconv.Itoa(i), &api.Job{DueTime: ptr.Of("0s")}))
+	}
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		list, err := r1.List(t.Context(), "")
+		assert.NoError(c, err)
+		assert.Empty(c, list.GetJobs())
+	}, 10*time.Second, 10*time.Millisecond)
+	for i := range n {
+		assert.Equal(t, 1, tr.count("job-"+strconv.Itoa(i)))
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("oneshot", func(t *testing.T) {
+		s, err := parseJob(&api.Job{DueTime: ptr.Of("10s")}, now)
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(10*time.Second), *s.next(0, nil))
+		assert.Nil(t, s.next(1, ptr.Of(now.Add(10*time.Second))))
+	})
+
+	t.Run("recurring without due time starts after the time it was added", func(t *testing.T) {
+		s, err := parseJob(&api.Job{Schedule: ptr.Of("@every 1m"), Ttl: ptr.Of("150s")}, now)
+		require.NoError(t, err)
+		first := s.next(0, nil)
+		assert.Equal(t, now.Add(time.Minute), *first)
+		second := s.next(1, first)
+		assert.Equal(t, now.Add(2*time.Minute), *second)
+		assert.Nil(t, s.next(2, second), "expired")
+	})
+
+	t.Run("recurring with due time and repeats", func(t *testing.T) {
+		s, err := parseJob(&api.Job{
+			Schedule: ptr.Of("@every 1m"),
+			DueTime:  ptr.Of(now.Add(time.Hour).Format(time.RFC3339)),
+			Repeats:  ptr.Of(uint32(2)),
+		}, now)
+		require.NoError(t, err)
+		first := s.next(0, nil)
+		assert.Equal(t, now.Add(time.Hour), *first)
+		assert.Equal(t, now.Add(time.Hour+time.Minute), *s.next(1, first))
+		assert.Nil(t, s.next(2, first))
+	})
+
+	t.Run("invalid jobs", func(t *testing.T) {
+		for _, job := range []*api.Job{
+			{},
+			{DueTime: ptr.Of("1s"), Repeats: ptr.Of(uint32(2))},
+			{Schedule: ptr.Of("@every 1s"), Repeats: ptr.Of(uint32(0))},
+			{DueTime: ptr.Of("1s"), Ttl: ptr.Of("1h")},
+			{Schedule: ptr.Of("not a schedule")},
+			{DueTime: ptr.Of("tomorrow")},
+			{Schedule: ptr.Of("@every 1s"), DueTime: ptr.Of("1h"), Ttl: ptr.Of(now.Add(time.Minute).Format(time.RFC3339))},
+		} {
+			_, err := parseJob(job, now)
+			require.Error(t, err, job)
+		}
+	})
+}
+
+func TestParseConfig(t *testing.T) {
+	opts, err := parseConfig([]byte(`{"connectionString": "postgres://localhost", "pollInterval": "5s"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "postgres://localhost", opts.connectionString)
+	assert.Equal(t, defaultTablePrefix, opts.tablePrefix)
+	assert.Equal(t, 5*time.Second, opts.pollInterval)
+	assert.Equal(t, defaultLeaseDuration, opts.leaseDuration)
+	assert.Equal(t, defaultClaimDuration, opts.claimDuration)
+
+	for _, raw := range []any{
+		nil,
+		42,
+		[]byte("pollInterval: 1s"),
+		[]byte("connectionString: x\ntablePrefix: 'jobs; DROP TABLE x'"),
+		[]byte("connectionString: x\nleaseDuration: soon"),
+		[]byte("connectionString: x\nclaimDuration: -1s"),
+	} {
+		_, err = parseConfig(raw)
+		require.Error(t, err, raw)
+	}
+}
+
+func TestRebind(t *testing.T) {
+	const query = `UPDATE t SET a = ? WHERE b = ? AND c = ?`
+	assert.Equal(t, query, sqlite.rebind(query))
+	assert.Equal(t, `UPDATE t SET a = $1 WHERE b = $2 AND c = $3`, postgres.rebind(query))
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package history persists the most recent trigger attempts of each job in
+// etcd, so that failed trigger deliveries can be inspected through GetJob.
+//
+// The history of a job is stored under its own key, alongside the job, and is
+// written asynchronously from the trigger path. Each history key is attached
+// to a lease which is refreshed on every write, so the history of jobs which
+// have since completed or been deleted expires after the configured TTL.
+package history
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/protobuf/proto"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/etcd"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.scheduler.server.history")
+
+// keyPrefix is the etcd key prefix of the job trigger histories. It lives
+// under the same "dapr" namespace as the cron jobs.
+const keyPrefix = "dapr/history"
+
+// queueSize is the number of trigger attempts which may be waiting to be
+// written before new attempts are dropped.
+const queueSize = 1024
+
+type Options struct {
+	// Etcd is the etcd client used to store the histories. If nil, trigger
+	// history is disabled.
+	Etcd etcd.Interface
+
+	// Size is the number of trigger attempts retained per job. If 0, trigger
+	// history is disabled.
+	Size uint32
+
+	// TTL is the duration the history of a job is retained after its last
+	// trigger attempt.
+	TTL time.Duration
+}
+
+type record struct {
+	key     string
+	attempt *schedulerv1pb.JobTriggerAttempt
+}
+
+// History records and retrieves job trigger histories.
+type History struct {
+	etcd etcd.Interface
+	size int
+	ttl  int64
+
+	recordCh chan record
+	readyCh  chan struct{}
+	client   *clientv3.Client
+}
+
+func New(opts Options) *History {
+	h := &History{
+		etcd:    opts.Etcd,
+		size:    int(opts.Size),
+		ttl:     max(int64(opts.TTL.Seconds()), 1),
+		readyCh: make(chan struct{}),
+	}
+
+	if h.Enabled() {
+		h.recordCh = make(chan record, queueSize)
+	}
+
+	return h
+}
+
+// Enabled returns true if trigger history is recorded.
+func (h *History) Enabled() bool {
+	return h != nil && h.etcd != nil && h.size > 0
+}
+
+// Run writes recorded trigger attempts until the context is cancelled.
+func (h *History) Run(ctx context.Context) error {
+	if !h.Enabled() {
+		<-ctx.Done()
+		return nil
+	}
+
+	client, err := h.etcd.Client(ctx)
+	if err != nil {
+		return err
+	}
+	h.client = client
+	close(h.readyCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case r := <-h.recordCh:
+			if err := h.write(ctx, r); err != nil && ctx.Err() == nil {
+				log.Warnf("Failed to record trigger history of job %s: %s", r.key, err)
+			}
+		}
+	}
+}
+
+// Record queues a trigger attempt to be appended to the history of the job
+// with the given key. Attempts are dropped if the write queue is full, so
+// that recording never blocks the trigger path.
+func (h *History) Record(key string, attempt *schedulerv1pb.JobTriggerAttempt) {
+	if !h.Enabled() {
+		return
+	}
+
+	select {
+	case h.recordCh <- record{key: key, attempt: attempt}:
+	default:
+		log.Debugf("Trigger history queue full, dropping attempt of job %s", key)
+	}
+}
+
+// Get returns the trigger history of the job with the given key, oldest
+// first.
+func (h *History) Get(ctx context.Context, key string) ([]*schedulerv1pb.JobTriggerAttempt, error) {
+	client, err := h.ready(ctx)
+	if client == nil || err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, historyKey(key))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	var hist schedulerv1pb.JobTriggerHistory
+	if err := proto.Unmarshal(resp.Kvs[0].Value, &hist); err != nil {
+		return nil, err
+	}
+
+	return hist.GetAttempts(), nil
+}
+
+// Delete deletes the trigger history of the job with the given key. If prefix
+// is true, the histories of all jobs whose key has the given prefix are
+// deleted.
+func (h *History) Delete(ctx context.Context, key string, prefix bool) error {
+	client, err := h.ready(ctx)
+	if client == nil || err != nil {
+		return err
+	}
+
+	var opts []clientv3.OpOption
+	if prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+
+	_, err = client.Delete(ctx, historyKey(key), opts...)
+	return err
+}
+
+// ready returns the etcd client once Run has started, or nil if trigger
+// history is disabled.
+func (h *History) ready(ctx context.Context) (*clientv3.Client, error) {
+	if !h.Enabled() {
+		return nil, nil
+	}
+
+	select {
+	case <-h.readyCh:
+		return h.client, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// write appends the attempt to the stored history of the job, dropping the
+// oldest attempts beyond the configured size. Jobs are triggered by a single
+// scheduler at a time, and attempts are written in order by a single
+// goroutine, so a read-modify-write is sufficient.
+func (h *History) write(ctx context.Context, r record) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	key := historyKey(r.key)
+
+	resp, err := h.client.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	var hist schedulerv1pb.JobTriggerHistory
+	var lease clientv3.LeaseID
+	if len(resp.Kvs) > 0 {
+		if err = proto.Unmarshal(resp.Kvs[0].Value, &hist); err != nil {
+			log.Warnf("Discarding malformed trigger history of job %s: %s", r.key, err)
+			hist.Reset()
+		}
+		lease = clientv3.LeaseID(resp.Kvs[0].Lease)
+	}
+
+	hist.Attempts = append(hist.Attempts, r.attempt)
+	if n := len(hist.GetAttempts()); n > h.size {
+		hist.Attempts = hist.Attempts[n-h.size:]
+	}
+
+	data, err := proto.Marshal(&hist)
+	if err != nil {
+		return err
+	}
+
+	// Refresh the existing lease of the history, rather than granting a new
+	// lease for every attempt. The lease may have expired since it was read.
+	if lease != clientv3.NoLease {
+		if _, err = h.client.KeepAliveOnce(ctx, lease); errors.Is(err, rpctypes.ErrLeaseNotFound) {
+			lease = clientv3.NoLease
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if lease == clientv3.NoLease {
+		grant, gerr := h.client.Grant(ctx, h.ttl)
+		if gerr != nil {
+			return gerr
+		}
+		lease = grant.ID
+	}
+
+	_, err = h.client.Put(ctx, key, string(data), clientv3.WithLease(lease))
+	return err
+}
+
+// historyKey returns the etcd key of the history of the job with the given
+// key. The job key is not cleaned as a path, since job names may contain any
+// character.
+func historyKey(key string) string {
+	return keyPrefix + "/" + key
+}
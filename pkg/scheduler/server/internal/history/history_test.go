@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+type fakeEtcd struct {
+	client *clientv3.Client
+}
+
+func (f *fakeEtcd) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (f *fakeEtcd) Client(context.Context) (*clientv3.Client, error) {
+	return f.client, nil
+}
+
+func Test_History(t *testing.T) {
+	t.Parallel()
+
+	client := embeddedEtcdClient(t)
+	h := New(Options{
+		Etcd: &fakeEtcd{client: client},
+		Size: 3,
+		TTL:  time.Hour,
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+	go func() { errCh <- h.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	start := time.Now().Truncate(time.Second)
+	attempt := func(i int, result schedulerv1pb.JobTriggerResult, err *string) *schedulerv1pb.JobTriggerAttempt {
+		return &schedulerv1pb.JobTriggerAttempt{
+			Time:   timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+			Result: result,
+			Error:  err,
+		}
+	}
+
+	for i := range 5 {
+		h.Record("ns||job||app||job1", attempt(i, schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_FAILED, new("boom")))
+	}
+	h.Record("ns||job||app||job2", attempt(0, schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_SUCCESS, nil))
+	h.Record("ns||job||other||job3", attempt(0, schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_UNDELIVERABLE, nil))
+
+	t.Run("retains the most recent attempts, oldest first", func(t *testing.T) {
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			got, err := h.Get(t.Context(), "ns||job||other||job3")
+			require.NoError(c, err)
+			assert.Len(c, got, 1)
+		}, time.Second*5, time.Millisecond*10)
+
+		got, err := h.Get(t.Context(), "ns||job||app||job1")
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		for i, a := range got {
+			assert.Equal(t, start.Add(time.Duration(i+2)*time.Second), a.GetTime().AsTime().Local())
+			assert.Equal(t, schedulerv1pb.JobTriggerResult_JOB_TRIGGER_RESULT_FAILED, a.GetResult())
+			assert.Equal(t, "boom", a.GetError())
+		}
+	})
+
+	t.Run("history is attached to a lease", func(t *testing.T) {
+		resp, err := client.Get(t.Context(), "dapr/history/ns||job||app||job1")
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		assert.NotZero(t, resp.Kvs[0].Lease)
+	})
+
+	t.Run("unknown job has no history", func(t *testing.T) {
+		got, err := h.Get(t.Context(), "ns||job||app||unknown")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("deleting a prefix deletes the history of matching jobs", func(t *testing.T) {
+		require.NoError(t, h.Delete(t.Context(), "ns||job||app||", true))
+
+		got, err := h.Get(t.Context(), "ns||job||app||job1")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		got, err = h.Get(t.Context(), "ns||job||app||job2")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		got, err = h.Get(t.Context(), "ns||job||other||job3")
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+}
+
+func Test_History_disabled(t *testing.T) {
+	t.Parallel()
+
+	h := New(Options{})
+	assert.False(t, h.Enabled())
+
+	h.Record("key", new(schedulerv1pb.JobTriggerAttempt))
+	got, err := h.Get(t.Context(), "key")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	require.NoError(t, h.Delete(t.Context(), "key", false))
+
+	var nilHistory *History
+	assert.False(t, nilHistory.Enabled())
+}
+
+func embeddedEtcdClient(t *testing.T) *clientv3.Client {
+	t.Helper()
+
+	cfg := embed.NewConfig()
+	cfg.LogLevel = "error"
+	cfg.Dir = t.TempDir()
+	lurl, err := url.Parse("http://127.0.0.1:0")
+	require.NoError(t, err)
+	cfg.ListenPeerUrls = []url.URL{*lurl}
+	cfg.ListenClientUrls = []url.URL{*lurl}
+
+	etcd, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	t.Cleanup(etcd.Close)
+
+	cl, err := clientv3.New(clientv3.Config{
+		Endpoints: []string{etcd.Clients[0].Addr().String()},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, cl.Close()) })
+
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "etcd took too long to start")
+	}
+
+	return cl
+}
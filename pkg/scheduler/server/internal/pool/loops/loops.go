@@ -47,6 +47,9 @@ type TriggerRequest struct {
 	*streambase
 	Job      *internalsv1pb.JobEvent
 	ResultFn func(api.TriggerResponseResult)
+	// ErrorFn is optionally called with the failure reason reported by the
+	// daprd sidecar, before ResultFn is called with the failed result.
+	ErrorFn func(string)
 }
 
 // StreamShutdown is the event for shutting down the scheduler stream.
@@ -61,16 +61,33 @@ func (s *stream) recv() error {
 		return errors.New("received nil result from stream")
 	}
 
+	if result.GetStatus() == schedulerv1pb.WatchJobsRequestResultStatus_RECEIVED {
+		inf, ok := s.inflight.Load(result.GetId())
+		if !ok {
+			return errors.New("received unknown trigger response from stream")
+		}
+		// Only exactly-once triggers are completed on receipt.
+		if inf.(*inflight).Job.GetMetadata().GetIdempotencyToken() != "" {
+			inf.(*inflight).received.Store(true)
+		}
+		return nil
+	}
+
 	inf, ok := s.inflight.LoadAndDelete(result.GetId())
 	if !ok {
 		return errors.New("received unknown trigger response from stream")
 	}
 
+	req := inf.(*inflight)
+
 	switch result.GetStatus() {
 	case schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS:
-		inf.(func(api.TriggerResponseResult))(api.TriggerResponseResult_SUCCESS)
+		req.ResultFn(api.TriggerResponseResult_SUCCESS)
 	case schedulerv1pb.WatchJobsRequestResultStatus_FAILED:
-		inf.(func(api.TriggerResponseResult))(api.TriggerResponseResult_FAILED)
+		if result.Error != nil && req.ErrorFn != nil {
+			req.ErrorFn(result.GetError())
+		}
+		req.ResultFn(api.TriggerResponseResult_FAILED)
 	default:
 		return fmt.Errorf("unknown trigger response status: %s", result.GetStatus())
 	}
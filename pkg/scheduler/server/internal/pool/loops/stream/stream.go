@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/diagridio/go-etcd-cron/api"
 
@@ -44,6 +45,16 @@ type Options struct {
 	NamespaceLoop loop.Interface[loops.EventNS]
 }
 
+// inflight is a trigger which has been sent to the stream and is awaiting its
+// result.
+type inflight struct {
+	*loops.TriggerRequest
+
+	// received is set once the sidecar acknowledges receipt of an exactly-once
+	// trigger.
+	received atomic.Bool
+}
+
 // stream is a loop that handles a single stream of a connection. It handles
 // triggering and responding to triggers.
 type stream struct {
@@ -68,7 +79,7 @@ type stream struct {
 	// single client.
 	triggerIDx uint64
 
-	inflight sync.Map // map[uint64]func(api.TriggerResponseResult)
+	inflight sync.Map // map[uint64]*inflight
 
 	wg sync.WaitGroup
 }
@@ -140,7 +151,7 @@ func (s *stream) Handle(ctx context.Context, event loops.EventStream) error {
 // the job to the stream and stores the result function in the inflight map.
 func (s *stream) handleTriggerRequest(req *loops.TriggerRequest) {
 	s.triggerIDx++
-	s.inflight.Store(s.triggerIDx, req.ResultFn)
+	s.inflight.Store(s.triggerIDx, &inflight{TriggerRequest: req})
 
 	job := &schedulerv1pb.WatchJobsResponse{
 		Name:     req.Job.GetName(),
@@ -155,8 +166,8 @@ func (s *stream) handleTriggerRequest(req *loops.TriggerRequest) {
 		// Resolve the trigger promptly so the cron engine redelivers it to a
 		// live stream instead of parking it until this stream is reaped.
 		// LoadAndDelete guarantees the shutdown drain cannot double-resolve.
-		if fn, ok := s.inflight.LoadAndDelete(s.triggerIDx); ok {
-			fn.(func(api.TriggerResponseResult))(api.TriggerResponseResult_UNDELIVERABLE)
+		if inf, ok := s.inflight.LoadAndDelete(s.triggerIDx); ok {
+			inf.(*inflight).ResultFn(api.TriggerResponseResult_UNDELIVERABLE)
 		}
 		s.closeStream(err)
 	}
@@ -166,13 +177,20 @@ var errStreamShutdown = errors.New("stream shutdown")
 
 // handleShutdown handles a shutdown request from the scheduler. It closes
 // the stream and calls all inflight result functions with an undeliverable
-// result.
+// result. Exactly-once triggers which the sidecar has received are instead
+// completed as successful, so that they are not redelivered.
 func (s *stream) handleShutdown() {
 	log.Infof("Closing connection to %s/%s", s.ns, s.appID)
 	s.cancel(errStreamShutdown)
 	s.wg.Wait()
-	s.inflight.Range(func(_, fn any) bool {
-		fn.(func(api.TriggerResponseResult))(api.TriggerResponseResult_UNDELIVERABLE)
+	s.inflight.Range(func(_, v any) bool {
+		inf := v.(*inflight)
+		if inf.received.Load() {
+			log.Warnf("Stream to %s/%s closed before the result of received exactly-once job %s, not redelivering", s.ns, s.appID, inf.Job.GetName())
+			inf.ResultFn(api.TriggerResponseResult_SUCCESS)
+			return true
+		}
+		inf.ResultFn(api.TriggerResponseResult_UNDELIVERABLE)
 		return true
 	})
 	s.inflight.Clear()
@@ -253,6 +253,53 @@ func Test_Stream(t *testing.T) {
 		suite.expectEvent(t, &loops.ConnCloseStream{StreamIDx: 123, Namespace: "ns"})
 	})
 
+	t.Run("failure reason reported by the client should be passed to the error callback before the result", func(t *testing.T) {
+		t.Parallel()
+
+		suite := newSuite(t)
+
+		var reason atomic.Pointer[string]
+		var called atomic.Pointer[api.TriggerResponseResult]
+		suite.streamLoop.Enqueue(&loops.TriggerRequest{
+			Job: &internalsv1pb.JobEvent{
+				Key: "job-key-1", Name: "job-name-1",
+			},
+			ErrorFn: func(err string) { reason.Store(&err) },
+			ResultFn: func(res api.TriggerResponseResult) {
+				assert.NotNil(t, reason.Load())
+				called.Store(&res)
+			},
+		})
+
+		_, err := suite.clientstream.Recv()
+		require.NoError(t, err)
+
+		require.NoError(t, suite.clientstream.Send(
+			&schedulerv1pb.WatchJobsRequest{
+				WatchJobRequestType: &schedulerv1pb.WatchJobsRequest_Result{
+					Result: &schedulerv1pb.WatchJobsRequestResult{
+						Id:     1,
+						Status: schedulerv1pb.WatchJobsRequestResultStatus_FAILED,
+						Error:  new("app returned 500"),
+					},
+				},
+			}),
+		)
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			res := called.Load()
+			if !assert.NotNil(c, res) {
+				return
+			}
+			assert.Equal(c, api.TriggerResponseResult_FAILED, *res)
+			assert.Equal(c, "app returned 500", *reason.Load())
+		}, time.Second*10, time.Millisecond*10)
+
+		suite.closeserver()
+		suite.streamLoop.Close(new(loops.StreamShutdown))
+		suite.expectEvent(t, &loops.ConnCloseStream{StreamIDx: 123, Namespace: "ns"})
+	})
+
 	t.Run("receiving client request for no in-flight request should be a fatal stream error", func(t *testing.T) {
 		t.Parallel()
 
@@ -288,6 +335,55 @@ func Test_Stream(t *testing.T) {
 		suite.streamLoop.Close(new(loops.StreamShutdown))
 	})
 
+	t.Run("received exactly-once triggers should not be redelivered on shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		suite := newSuite(t)
+
+		var called1, called2 atomic.Pointer[api.TriggerResponseResult]
+		suite.streamLoop.Enqueue(&loops.TriggerRequest{
+			Job: &internalsv1pb.JobEvent{
+				Key: "job-key-1", Name: "job-name-1",
+				Metadata: &schedulerv1pb.JobMetadata{IdempotencyToken: new("token")},
+			},
+			ResultFn: func(res api.TriggerResponseResult) { called1.Store(&res) },
+		})
+		suite.streamLoop.Enqueue(&loops.TriggerRequest{
+			Job: &internalsv1pb.JobEvent{
+				Key: "job-key-2", Name: "job-name-2",
+			},
+			ResultFn: func(res api.TriggerResponseResult) { called2.Store(&res) },
+		})
+
+		for id := range uint64(2) {
+			_, err := suite.clientstream.Recv()
+			require.NoError(t, err)
+			require.NoError(t, suite.clientstream.Send(
+				&schedulerv1pb.WatchJobsRequest{
+					WatchJobRequestType: &schedulerv1pb.WatchJobsRequest_Result{
+						Result: &schedulerv1pb.WatchJobsRequestResult{
+							Id:     id + 1,
+							Status: schedulerv1pb.WatchJobsRequestResultStatus_RECEIVED,
+						},
+					},
+				}),
+			)
+		}
+
+		time.Sleep(time.Second / 2)
+		assert.Nil(t, called1.Load())
+		assert.Nil(t, called2.Load())
+
+		suite.closeserver()
+		suite.streamLoop.Close(new(loops.StreamShutdown))
+		suite.expectEvent(t, &loops.ConnCloseStream{StreamIDx: 123, Namespace: "ns"})
+
+		require.NotNil(t, called1.Load())
+		require.NotNil(t, called2.Load())
+		assert.Equal(t, api.TriggerResponseResult_SUCCESS, *called1.Load())
+		assert.Equal(t, api.TriggerResponseResult_UNDELIVERABLE, *called2.Load())
+	})
+
 	t.Run("shutdown stream, all inflight should be marked as undeliverable", func(t *testing.T) {
 		t.Parallel()
 
@@ -87,13 +87,16 @@ func (p *Pool) AddConnection(req *schedulerv1pb.WatchJobsRequestInitial, stream
 	return ctx
 }
 
-// Trigger triggers a job event to the pool. It returns a response result.
-func (p *Pool) Trigger(job *internalsv1pb.JobEvent, fn func(api.TriggerResponseResult)) {
+// Trigger triggers a job event to the pool. It returns a response result,
+// along with the failure reason reported by the sidecar to errFn, which may be
+// nil.
+func (p *Pool) Trigger(job *internalsv1pb.JobEvent, fn func(api.TriggerResponseResult), errFn func(string)) {
 	<-p.readyCh
 
 	p.nsLoop.Enqueue(&loops.TriggerRequest{
 		Job:      job,
 		ResultFn: fn,
+		ErrorFn:  errFn,
 	})
 }
 
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle limits the rate at which the scheduler triggers jobs, and
+// applies the catch-up policy to overdue triggers of recurring jobs.
+//
+// When a recurring job has missed triggers, for example because its target
+// app was down, the cron engine triggers each missed tick back to back as
+// soon as the previous trigger is acknowledged. A trigger is therefore
+// considered a catch-up trigger when it follows the previous successful
+// trigger of the same job by less than half the time to the next scheduled
+// tick after it.
+package throttle
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	"golang.org/x/time/rate"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/kit/cron"
+)
+
+// CatchUpPolicy is the policy applied to overdue triggers of recurring jobs.
+type CatchUpPolicy string
+
+const (
+	// CatchUpAll delivers every overdue trigger.
+	CatchUpAll CatchUpPolicy = "all"
+	// CatchUpSkip drops overdue triggers, resuming delivery on schedule.
+	CatchUpSkip CatchUpPolicy = "skip"
+	// CatchUpLatestOnly delivers a single trigger in place of all overdue
+	// triggers of a job.
+	CatchUpLatestOnly CatchUpPolicy = "latest-only"
+)
+
+// ParseCatchUpPolicy parses a catch-up policy. An empty policy is CatchUpAll.
+func ParseCatchUpPolicy(policy string) (CatchUpPolicy, error) {
+	switch p := CatchUpPolicy(policy); p {
+	case "":
+		return CatchUpAll, nil
+	case CatchUpAll, CatchUpSkip, CatchUpLatestOnly:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid trigger catch-up policy %q: must be one of %q, %q or %q", policy, CatchUpAll, CatchUpSkip, CatchUpLatestOnly)
+	}
+}
+
+// retention is the duration the last trigger of a job is remembered for after
+// the job has missed a tick. A trigger of a job whose last trigger has been
+// forgotten is always delivered.
+const retention = time.Hour
+
+type Options struct {
+	// Rate is the maximum number of triggers delivered per second across all
+	// apps. If 0, triggers are not rate limited.
+	Rate float64
+
+	// AppRate is the maximum number of triggers delivered per second to each
+	// app. If 0, triggers are not rate limited per app.
+	AppRate float64
+
+	// CatchUp is the policy applied to overdue triggers. Defaults to
+	// CatchUpAll.
+	CatchUp CatchUpPolicy
+
+	Clock clock.Clock
+}
+
+type trigger struct {
+	time   time.Time
+	expiry time.Time
+	failed bool
+}
+
+// Throttle rate limits job triggers and applies the catch-up policy.
+type Throttle struct {
+	clock   clock.Clock
+	catchUp CatchUpPolicy
+
+	global  *rate.Limiter
+	appRate float64
+
+	lock      sync.Mutex
+	apps      map[string]*rate.Limiter
+	triggers  map[string]trigger
+	lastPrune time.Time
+}
+
+func New(opts Options) *Throttle {
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+
+	catchUp := opts.CatchUp
+	if catchUp == "" {
+		catchUp = CatchUpAll
+	}
+
+	t := &Throttle{
+		clock:     cl,
+		catchUp:   catchUp,
+		appRate:   opts.AppRate,
+		apps:      make(map[string]*rate.Limiter),
+		triggers:  make(map[string]trigger),
+		lastPrune: cl.Now(),
+	}
+
+	if opts.Rate > 0 {
+		t.global = newLimiter(opts.Rate)
+	}
+
+	return t
+}
+
+// CatchUpEnabled returns true if catch-up triggers are not all delivered, in
+// which case the schedule of each triggered job must be given to Skip.
+func (t *Throttle) CatchUpEnabled() bool {
+	return t != nil && t.catchUp != CatchUpAll
+}
+
+// Delay reserves the delivery of a trigger to the given app, returning how
+// long the trigger must wait before being delivered.
+func (t *Throttle) Delay(namespace, appID string) time.Duration {
+	if t == nil || (t.global == nil && t.appRate <= 0) {
+		return 0
+	}
+
+	now := t.clock.Now()
+
+	var delay time.Duration
+	if t.global != nil {
+		delay = t.global.ReserveN(now, 1).DelayFrom(now)
+	}
+
+	if t.appRate > 0 {
+		key := namespace + "/" + appID
+		t.lock.Lock()
+		limiter, ok := t.apps[key]
+		if !ok {
+			limiter = newLimiter(t.appRate)
+			t.apps[key] = limiter
+		}
+		t.lock.Unlock()
+
+		delay = max(delay, limiter.ReserveN(now, 1).DelayFrom(now))
+	}
+
+	return delay
+}
+
+// Skip returns true if the trigger of the job with the given key is an
+// overdue trigger which must not be delivered according to the catch-up
+// policy. The schedule is nil if the job does not recur.
+func (t *Throttle) Skip(key string, sched cron.Schedule) bool {
+	if !t.CatchUpEnabled() || sched == nil {
+		return false
+	}
+
+	now := t.clock.Now()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	prev, ok := t.triggers[key]
+
+	// Retries of a failed trigger are governed by the failure policy of the
+	// job.
+	if !ok || prev.failed {
+		return false
+	}
+
+	next := sched.Next(prev.time)
+	if next.IsZero() {
+		return false
+	}
+
+	if now.Sub(prev.time) < next.Sub(prev.time)/2 {
+		return true
+	}
+
+	// The first trigger after missed ticks is overdue, but is delivered in
+	// place of the missed ticks with the latest-only policy.
+	return t.catchUp == CatchUpSkip && !now.Before(sched.Next(next))
+}
+
+// Done records the result of a trigger of the job with the given key and
+// schedule, whether it was delivered or skipped.
+func (t *Throttle) Done(key string, sched cron.Schedule, result api.TriggerResponseResult) {
+	if !t.CatchUpEnabled() || sched == nil {
+		return
+	}
+
+	now := t.clock.Now()
+	trig := trigger{
+		time:   now,
+		expiry: sched.Next(sched.Next(now)).Add(retention),
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	switch result {
+	case api.TriggerResponseResult_SUCCESS:
+		t.triggers[key] = trig
+	case api.TriggerResponseResult_FAILED:
+		trig.failed = true
+		t.triggers[key] = trig
+	default:
+		// Undeliverable triggers are redelivered by the cron engine, and do not
+		// count as the last trigger of the job.
+	}
+
+	if now.Sub(t.lastPrune) >= retention {
+		t.prune(now)
+	}
+}
+
+// prune forgets the last trigger of jobs which have not been triggered since
+// their expiry. Must be called with the lock held.
+func (t *Throttle) prune(now time.Time) {
+	t.lastPrune = now
+	for key, trig := range t.triggers {
+		if now.After(trig.expiry) {
+			delete(t.triggers, key)
+		}
+	}
+}
+
+func newLimiter(r float64) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(r), int(math.Max(1, math.Ceil(r))))
+}
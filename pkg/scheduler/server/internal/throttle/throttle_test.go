@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diagridio/go-etcd-cron/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/kit/cron"
+)
+
+func TestParseCatchUpPolicy(t *testing.T) {
+	for policy, exp := range map[string]CatchUpPolicy{
+		"":            CatchUpAll,
+		"all":         CatchUpAll,
+		"skip":        CatchUpSkip,
+		"latest-only": CatchUpLatestOnly,
+	} {
+		got, err := ParseCatchUpPolicy(policy)
+		require.NoError(t, err)
+		assert.Equal(t, exp, got)
+	}
+
+	_, err := ParseCatchUpPolicy("latest")
+	require.Error(t, err)
+}
+
+func TestDelay(t *testing.T) {
+	t.Run("nil and unlimited throttles do not delay", func(t *testing.T) {
+		var nilThrottle *Throttle
+		assert.Zero(t, nilThrottle.Delay("ns", "app"))
+
+		th := New(Options{})
+		for range 100 {
+			assert.Zero(t, th.Delay("ns", "app"))
+		}
+	})
+
+	t.Run("global rate", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		th := New(Options{Rate: 2, Clock: clock})
+
+		assert.Zero(t, th.Delay("ns", "app1"))
+		assert.Zero(t, th.Delay("ns", "app2"))
+		assert.Equal(t, 500*time.Millisecond, th.Delay("ns", "app3"))
+		assert.Equal(t, time.Second, th.Delay("ns", "app1"))
+
+		clock.Step(10 * time.Second)
+		assert.Zero(t, th.Delay("ns", "app1"))
+	})
+
+	t.Run("app rate is per app", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		th := New(Options{AppRate: 1, Clock: clock})
+
+		assert.Zero(t, th.Delay("ns", "app1"))
+		assert.Equal(t, time.Second, th.Delay("ns", "app1"))
+		assert.Zero(t, th.Delay("ns", "app2"))
+		assert.Zero(t, th.Delay("other", "app1"))
+	})
+}
+
+func TestSkip(t *testing.T) {
+	sched, err := cron.ParseStandard("@every 10s")
+	require.NoError(t, err)
+
+	t.Run("all policy never skips", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		th := New(Options{Clock: clock})
+		assert.False(t, th.CatchUpEnabled())
+
+		th.Done("job", sched, api.TriggerResponseResult_SUCCESS)
+		clock.Step(time.Second)
+		assert.False(t, th.Skip("job", sched))
+	})
+
+	t.Run("one-shot and unknown jobs are not skipped", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		th := New(Options{CatchUp: CatchUpSkip, Clock: clock})
+
+		assert.False(t, th.Skip("job", sched))
+		th.Done("job", nil, api.TriggerResponseResult_SUCCESS)
+		assert.False(t, th.Skip("job", nil))
+	})
+
+	for _, policy := range []CatchUpPolicy{CatchUpSkip, CatchUpLatestOnly} {
+		t.Run(string(policy), func(t *testing.T) {
+			clock := clocktesting.NewFakeClock(time.Now())
+			th := New(Options{CatchUp: policy, Clock: clock})
+			require.True(t, th.CatchUpEnabled())
+
+			th.Done("job", sched, api.TriggerResponseResult_SUCCESS)
+
+			clock.Step(10 * time.Second)
+			assert.False(t, th.Skip("job", sched), "trigger on schedule is delivered")
+			th.Done("job", sched, api.TriggerResponseResult_SUCCESS)
+
+			clock.Step(25 * time.Second)
+			assert.Equal(t, policy == CatchUpSkip, th.Skip("job", sched), "first trigger after missed ticks")
+			th.Done("job", sched, api.TriggerResponseResult_SUCCESS)
+
+			clock.Step(10 * time.Millisecond)
+			assert.True(t, th.Skip("job", sched), "back to back catch-up trigger")
+		})
+	}
+
+	t.Run("retries of failed triggers are not skipped", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		th := New(Options{CatchUp: CatchUpSkip, Clock: clock})
+
+		th.Done("job", sched, api.TriggerResponseResult_FAILED)
+		clock.Step(time.Second)
+		assert.False(t, th.Skip("job", sched))
+
+		th.Done("job", sched, api.TriggerResponseResult_SUCCESS)
+		clock.Step(time.Second)
+		assert.True(t, th.Skip("job", sched))
+	})
+
+	t.Run("undeliverable triggers are not recorded", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		th := New(Options{CatchUp: CatchUpSkip, Clock: clock})
+
+		th.Done("job", sched, api.TriggerResponseResult_UNDELIVERABLE)
+		clock.Step(time.Second)
+		assert.False(t, th.Skip("job", sched))
+	})
+
+	t.Run("forgotten triggers are delivered", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		th := New(Options{CatchUp: CatchUpSkip, Clock: clock})
+
+		th.Done("job", sched, api.TriggerResponseResult_SUCCESS)
+		clock.Step(2 * retention)
+		th.Done("other", sched, api.TriggerResponseResult_SUCCESS)
+		assert.NotContains(t, th.triggers, "job")
+		assert.False(t, th.Skip("job", sched))
+	})
+}
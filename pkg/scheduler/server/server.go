@@ -32,7 +32,9 @@ import (
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/controller"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/cron"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/etcd"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/history"
 	"github.com/dapr/dapr/pkg/scheduler/server/internal/serialize"
+	"github.com/dapr/dapr/pkg/scheduler/server/internal/throttle"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/dapr/utils"
 	"github.com/dapr/kit/concurrency"
@@ -64,6 +66,25 @@ type Options struct {
 
 	Workers uint32
 
+	// JobTriggerHistorySize is the number of trigger attempts retained per
+	// job. If 0, trigger history is disabled.
+	JobTriggerHistorySize uint32
+	JobTriggerHistoryTTL  time.Duration
+
+	// TriggerRateLimit and AppTriggerRateLimit are the maximum number of job
+	// triggers delivered per second, across all apps and to each app
+	// respectively. If 0, triggers are not rate limited.
+	TriggerRateLimit    float64
+	AppTriggerRateLimit float64
+
+	// TriggerCatchUpPolicy is the policy applied to overdue triggers of
+	// recurring jobs, one of "all", "skip" or "latest-only".
+	TriggerCatchUpPolicy string
+
+	// Backend is the name of the cron storage backend of the jobs. If nil, or
+	// "etcd", jobs are stored in the embedded or external Etcd database
+	// configured by the Etcd options. Other backends are registered with the
+	// cron library, and receive BackendConfig as is.
 	Backend       *string
 	BackendConfig any
 
@@ -100,6 +121,7 @@ type Server struct {
 	serializer *serialize.Serializer
 	cron       cron.Interface
 	etcd       etcd.Interface
+	history    *history.History
 	controller *controller.Controller
 
 	hzAPIServer healthz.Target
@@ -159,6 +181,17 @@ func New(ctx context.Context, opts Options) (*Server, error) {
 		}
 	}
 
+	catchUp, err := throttle.ParseCatchUpPolicy(opts.TriggerCatchUpPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	history := history.New(history.Options{
+		Etcd: etcdServer,
+		Size: opts.JobTriggerHistorySize,
+		TTL:  opts.JobTriggerHistoryTTL,
+	})
+
 	cron := cron.New(cron.Options{
 		ID:            opts.EtcdName,
 		Host:          &schedulerv1pb.Host{Address: broadcastAddr},
@@ -166,6 +199,12 @@ func New(ctx context.Context, opts Options) (*Server, error) {
 		Backend:       opts.Backend,
 		BackendConfig: opts.BackendConfig,
 		Workers:       opts.Workers,
+		History:       history,
+		Throttle: throttle.New(throttle.Options{
+			Rate:    opts.TriggerRateLimit,
+			AppRate: opts.AppTriggerRateLimit,
+			CatchUp: catchUp,
+		}),
 	})
 
 	if opts.Controller != nil {
@@ -179,6 +218,7 @@ func New(ctx context.Context, opts Options) (*Server, error) {
 		controller:    opts.Controller,
 		cron:          cron,
 		etcd:          etcdServer,
+		history:       history,
 		serializer: serialize.New(serialize.Options{
 			Security: opts.Security,
 		}),
@@ -196,6 +236,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	runners := []concurrency.Runner{
 		s.runServer,
+		s.history.Run,
 		func(ctx context.Context) error {
 			err := s.cron.Run(ctx)
 			if ctx.Err() != nil {
@@ -15,11 +15,15 @@ package security
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
 	"os"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -338,7 +342,7 @@ func (s *security) GRPCDialOptionMTLS(appID spiffeid.ID) grpc.DialOption {
 	}
 
 	return grpc.WithTransportCredentials(
-		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(appID)),
+		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, authorizeTrustAnchor(tlsconfig.AuthorizeID(appID))),
 	)
 }
 
@@ -352,7 +356,7 @@ func (s *security) GRPCServerOptionMTLS() grpc.ServerOption {
 	return grpc.Creds(
 		// TODO: It would be better if we could give a subset of trust domains in
 		// which this server authorizes.
-		grpccredentials.MTLSServerCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeAny()),
+		grpccredentials.MTLSServerCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, authorizeTrustAnchor(tlsconfig.AuthorizeAny())),
 	)
 }
 
@@ -382,7 +386,7 @@ func (s *security) GRPCDialOptionMTLSUnknownTrustDomain(ns, appID string) grpc.D
 	}
 
 	return grpc.WithTransportCredentials(
-		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AdaptMatcher(matcher)),
+		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, authorizeTrustAnchor(tlsconfig.AdaptMatcher(matcher))),
 	)
 }
 
@@ -428,7 +432,7 @@ func (s *security) NetListenerID(lis net.Listener, id spiffeid.ID) net.Listener
 		return lis
 	}
 	return tls.NewListener(lis,
-		tlsconfig.MTLSServerConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(id)),
+		tlsconfig.MTLSServerConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, authorizeTrustAnchor(tlsconfig.AuthorizeID(id))),
 	)
 }
 
@@ -441,7 +445,7 @@ func (s *security) NetDialerID(ctx context.Context, spiffeID spiffeid.ID, timeou
 	}
 	return (&tls.Dialer{
 		NetDialer: (&net.Dialer{Timeout: timeout, Cancel: ctx.Done()}),
-		Config:    tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(spiffeID)),
+		Config:    tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, authorizeTrustAnchor(tlsconfig.AuthorizeID(spiffeID))),
 	}).Dial
 }
 
@@ -456,7 +460,47 @@ func (s *security) MTLSClientConfig(id spiffeid.ID) *tls.Config {
 		return nil
 	}
 
-	return tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(id))
+	return tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, authorizeTrustAnchor(tlsconfig.AuthorizeID(id)))
+}
+
+// authorizeTrustAnchor records the trust anchors the certificate chain of an
+// authorized peer chains to, so the progress of a rotation of the trust
+// anchors can be followed until no peer presents the old root anymore. During
+// a rotation, an intermediate cross-signed by both roots verifies up to each
+// of them, so every distinct root of the verified chains is recorded.
+func authorizeTrustAnchor(authorizer tlsconfig.Authorizer) tlsconfig.Authorizer {
+	return func(id spiffeid.ID, verifiedChains [][]*x509.Certificate) error {
+		if err := authorizer(id, verifiedChains); err != nil {
+			return err
+		}
+		for _, fingerprint := range trustAnchorFingerprints(verifiedChains) {
+			diagnostics.DefaultMonitoring.MTLSPeerTrustAnchor(fingerprint)
+		}
+		return nil
+	}
+}
+
+// trustAnchorFingerprints returns the fingerprints of the distinct roots of
+// the verified chains.
+func trustAnchorFingerprints(verifiedChains [][]*x509.Certificate) []string {
+	var fingerprints []string
+	for _, chain := range verifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		fingerprint := TrustAnchorFingerprint(chain[len(chain)-1])
+		if !slices.Contains(fingerprints, fingerprint) {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+	return fingerprints
+}
+
+// TrustAnchorFingerprint returns the hex encoded SHA-256 fingerprint of a
+// trust anchor, which identifies the root in the metrics.
+func TrustAnchorFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
 }
 
 // CurrentNamespace returns the namespace of this workload.
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -503,3 +504,38 @@ func TestNew_OptionsTrustAnchorsAndJWKS(t *testing.T) {
 		assert.NotNil(t, p)
 	})
 }
+
+func Test_authorizeTrustAnchor(t *testing.T) {
+	td := spiffeid.RequireTrustDomainFromString("example.com")
+	root := &x509.Certificate{Raw: []byte("root")}
+	leaf := &x509.Certificate{Raw: []byte("leaf")}
+	chains := [][]*x509.Certificate{{leaf, root}}
+
+	t.Run("authorized peers are passed through", func(t *testing.T) {
+		authorizer := authorizeTrustAnchor(tlsconfig.AuthorizeMemberOf(td))
+		require.NoError(t, authorizer(spiffeid.RequireFromPath(td, "/ns/default/app"), chains))
+		require.NoError(t, authorizer(spiffeid.RequireFromPath(td, "/ns/default/app"), nil))
+	})
+
+	t.Run("unauthorized peers are rejected", func(t *testing.T) {
+		authorizer := authorizeTrustAnchor(tlsconfig.AuthorizeMemberOf(td))
+		other := spiffeid.RequireTrustDomainFromString("other.com")
+		require.Error(t, authorizer(spiffeid.RequireFromPath(other, "/ns/default/app"), chains))
+	})
+
+	t.Run("distinct roots of the verified chains", func(t *testing.T) {
+		newRoot := &x509.Certificate{Raw: []byte("new root")}
+		intermediate := &x509.Certificate{Raw: []byte("intermediate")}
+		assert.Equal(t, []string{TrustAnchorFingerprint(root), TrustAnchorFingerprint(newRoot)}, trustAnchorFingerprints([][]*x509.Certificate{
+			{leaf, intermediate, root},
+			{leaf, intermediate, newRoot},
+			{leaf, root},
+			{},
+		}))
+		assert.Empty(t, trustAnchorFingerprints(nil))
+	})
+
+	t.Run("fingerprint of the trust anchor", func(t *testing.T) {
+		assert.Equal(t, "4813494d137e1631bba301d5acab6e7bb7aa74ce1185d456565ef51d737677b2", TrustAnchorFingerprint(root))
+	})
+}
@@ -80,6 +80,32 @@ type Config struct {
 	Validators       map[sentryv1pb.SignCertificateRequest_TokenValidator]map[string]string
 	DefaultValidator sentryv1pb.SignCertificateRequest_TokenValidator
 	Features         []daprGlobalConfig.FeatureSpec
+
+	// AdditionalTrustAnchorsPath is the path to the PEM file of the roots added
+	// to the trust bundle, so the workloads trust the certificates of a new
+	// root before the issuer is rotated to it.
+	AdditionalTrustAnchorsPath string
+
+	// CAProvider is the name of the provider of the CA which signs the
+	// workload certificates. Empty uses the issuer certificate of the bundle.
+	CAProvider        string
+	CAProviderOptions map[string]string
+
+	// MaxWorkloadCertTTL is the upper bound of the TTL of the workload
+	// certificates. Zero means no bound other than WorkloadCertTTL for the TTLs
+	// requested by the workloads.
+	MaxWorkloadCertTTL time.Duration
+	// WorkloadCertTTLs are the TTLs of the workload certificates of namespaces
+	// or apps, overriding WorkloadCertTTL.
+	WorkloadCertTTLs []WorkloadCertTTL
+}
+
+// WorkloadCertTTL is the TTL of the workload certificates of a namespace, or
+// of an app of the namespace if AppID is set.
+type WorkloadCertTTL struct {
+	Namespace string
+	AppID     string
+	TTL       time.Duration
 }
 
 type ConfigJWT struct {
@@ -214,6 +240,54 @@ func parseConfiguration(conf Config, daprConfig *daprGlobalConfig.Configuration)
 		conf.TrustDomain = daprConfig.Spec.MTLSSpec.ControlPlaneTrustDomain
 	}
 
+	if mtlsSpec != nil && mtlsSpec.CAProvider != nil {
+		conf.CAProvider = strings.ToLower(mtlsSpec.CAProvider.Name)
+		conf.CAProviderOptions = mtlsSpec.CAProvider.OptionsMap()
+	}
+
+	if mtlsSpec != nil && mtlsSpec.MaxWorkloadCertTTL != "" {
+		d, err := time.ParseDuration(mtlsSpec.MaxWorkloadCertTTL)
+		if err != nil {
+			return conf, fmt.Errorf("error parsing MaxWorkloadCertTTL duration: %w", err)
+		}
+		if d <= 0 {
+			return conf, errors.New("MaxWorkloadCertTTL must be positive")
+		}
+		if conf.WorkloadCertTTL > d {
+			if mtlsSpec.WorkloadCertTTL != "" {
+				return conf, fmt.Errorf("WorkloadCertTTL %s exceeds MaxWorkloadCertTTL %s", conf.WorkloadCertTTL, d)
+			}
+			// The default TTL is capped to the maximum.
+			conf.WorkloadCertTTL = d
+		}
+
+		conf.MaxWorkloadCertTTL = d
+	}
+
+	if mtlsSpec != nil {
+		for _, t := range mtlsSpec.WorkloadCertTTLs {
+			if t.Namespace == "" {
+				return conf, errors.New("namespace is required for workload certificate TTLs")
+			}
+			d, err := time.ParseDuration(t.TTL)
+			if err != nil {
+				return conf, fmt.Errorf("error parsing workload certificate TTL of %s: %w", ttlScope(t.Namespace, t.AppID), err)
+			}
+			if d <= 0 {
+				return conf, fmt.Errorf("workload certificate TTL of %s must be positive", ttlScope(t.Namespace, t.AppID))
+			}
+			if conf.MaxWorkloadCertTTL > 0 && d > conf.MaxWorkloadCertTTL {
+				return conf, fmt.Errorf("workload certificate TTL %s of %s exceeds MaxWorkloadCertTTL %s", d, ttlScope(t.Namespace, t.AppID), conf.MaxWorkloadCertTTL)
+			}
+
+			conf.WorkloadCertTTLs = append(conf.WorkloadCertTTLs, WorkloadCertTTL{
+				Namespace: t.Namespace,
+				AppID:     t.AppID,
+				TTL:       d,
+			})
+		}
+	}
+
 	daprConfig.SetDefaultFeatures()
 	conf.Features = daprConfig.Spec.Features
 
@@ -251,3 +325,47 @@ func parseConfiguration(conf Config, daprConfig *daprGlobalConfig.Configuration)
 
 	return conf, nil
 }
+
+// WorkloadCertTTLFor returns the TTL of the workload certificate of the app.
+// The TTL of the app takes precedence over the TTL of its namespace, which
+// takes precedence over WorkloadCertTTL. A requested TTL, if not zero,
+// shortens the TTL, or extends it up to MaxWorkloadCertTTL. Requests for
+// longer TTLs are rejected.
+func (c Config) WorkloadCertTTLFor(namespace, appID string, requested time.Duration) (time.Duration, error) {
+	ttl := c.WorkloadCertTTL
+	var appMatched bool
+	for _, t := range c.WorkloadCertTTLs {
+		if t.Namespace != namespace {
+			continue
+		}
+		switch {
+		case t.AppID == appID:
+			ttl = t.TTL
+			appMatched = true
+		case t.AppID == "" && !appMatched:
+			ttl = t.TTL
+		}
+	}
+
+	if requested == 0 {
+		return ttl, nil
+	}
+	if requested < 0 {
+		return 0, fmt.Errorf("requested certificate TTL %s must be positive", requested)
+	}
+	limit := c.MaxWorkloadCertTTL
+	if limit == 0 {
+		limit = ttl
+	}
+	if requested > limit {
+		return 0, fmt.Errorf("requested certificate TTL %s exceeds the maximum of %s", requested, limit)
+	}
+	return requested, nil
+}
+
+func ttlScope(namespace, appID string) string {
+	if appID == "" {
+		return "namespace " + namespace
+	}
+	return "app " + namespace + "/" + appID
+}
@@ -15,6 +15,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,6 +67,103 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, "1h0m0s", conf.AllowedClockSkew.String())
 	})
 
+	t.Run("parse workload certificate TTLs", func(t *testing.T) {
+		daprConfig := daprDaprConfig.Configuration{
+			Spec: daprDaprConfig.ConfigurationSpec{
+				MTLSSpec: &daprDaprConfig.MTLSSpec{
+					Enabled:            true,
+					WorkloadCertTTL:    "1h",
+					MaxWorkloadCertTTL: "2h",
+					WorkloadCertTTLs: []daprDaprConfig.WorkloadCertTTLSpec{
+						{Namespace: "payments", TTL: "10m"},
+						{Namespace: "payments", AppID: "ledger", TTL: "5m"},
+					},
+				},
+			},
+		}
+
+		conf, err := parseConfiguration(getDefaultConfig(), &daprConfig)
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Hour, conf.MaxWorkloadCertTTL)
+		assert.Equal(t, []WorkloadCertTTL{
+			{Namespace: "payments", TTL: 10 * time.Minute},
+			{Namespace: "payments", AppID: "ledger", TTL: 5 * time.Minute},
+		}, conf.WorkloadCertTTLs)
+
+		daprConfig.Spec.MTLSSpec.WorkloadCertTTLs[1].TTL = "3h"
+		_, err = parseConfiguration(getDefaultConfig(), &daprConfig)
+		require.ErrorContains(t, err, "workload certificate TTL 3h0m0s of app payments/ledger exceeds MaxWorkloadCertTTL 2h0m0s")
+
+		daprConfig.Spec.MTLSSpec.WorkloadCertTTLs = nil
+		daprConfig.Spec.MTLSSpec.WorkloadCertTTL = "3h"
+		_, err = parseConfiguration(getDefaultConfig(), &daprConfig)
+		require.ErrorContains(t, err, "WorkloadCertTTL 3h0m0s exceeds MaxWorkloadCertTTL 2h0m0s")
+
+		daprConfig.Spec.MTLSSpec.WorkloadCertTTL = ""
+		conf, err = parseConfiguration(getDefaultConfig(), &daprConfig)
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Hour, conf.WorkloadCertTTL)
+
+		daprConfig.Spec.MTLSSpec.WorkloadCertTTLs = []daprDaprConfig.WorkloadCertTTLSpec{{TTL: "1m"}}
+		_, err = parseConfiguration(getDefaultConfig(), &daprConfig)
+		require.ErrorContains(t, err, "namespace is required")
+	})
+
+	t.Run("workload certificate TTL of an app", func(t *testing.T) {
+		conf := Config{
+			WorkloadCertTTL: time.Hour,
+			WorkloadCertTTLs: []WorkloadCertTTL{
+				{Namespace: "payments", AppID: "ledger", TTL: 5 * time.Minute},
+				{Namespace: "payments", TTL: 10 * time.Minute},
+			},
+		}
+
+		for _, tc := range []struct {
+			namespace, appID string
+			requested        time.Duration
+			exp              time.Duration
+			expErr           string
+		}{
+			{namespace: "default", appID: "myapp", exp: time.Hour},
+			{namespace: "payments", appID: "myapp", exp: 10 * time.Minute},
+			{namespace: "payments", appID: "ledger", exp: 5 * time.Minute},
+			{namespace: "payments", appID: "ledger", requested: time.Minute, exp: time.Minute},
+			{namespace: "payments", appID: "ledger", requested: time.Hour, expErr: "requested certificate TTL 1h0m0s exceeds the maximum of 5m0s"},
+		} {
+			ttl, err := conf.WorkloadCertTTLFor(tc.namespace, tc.appID, tc.requested)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				continue
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, ttl, "%s/%s", tc.namespace, tc.appID)
+		}
+
+		conf.MaxWorkloadCertTTL = 2 * time.Hour
+		ttl, err := conf.WorkloadCertTTLFor("payments", "ledger", 90*time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 90*time.Minute, ttl)
+	})
+
+	t.Run("parse CA provider", func(t *testing.T) {
+		daprConfig := daprDaprConfig.Configuration{
+			Spec: daprDaprConfig.ConfigurationSpec{
+				MTLSSpec: &daprDaprConfig.MTLSSpec{
+					Enabled: true,
+					CAProvider: &daprDaprConfig.CAProviderSpec{
+						Name:    "Vault",
+						Options: map[string]any{"address": "https://vault:8200", "role": "dapr"},
+					},
+				},
+			},
+		}
+
+		conf, err := parseConfiguration(getDefaultConfig(), &daprConfig)
+		require.NoError(t, err)
+		assert.Equal(t, "vault", conf.CAProvider)
+		assert.Equal(t, map[string]string{"address": "https://vault:8200", "role": "dapr"}, conf.CAProviderOptions)
+	})
+
 	t.Run("set validators", func(t *testing.T) {
 		t.Run("kubernetes mode", func(t *testing.T) {
 			defaultConfig := getDefaultConfig()
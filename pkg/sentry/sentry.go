@@ -103,11 +103,17 @@ func New(ctx context.Context, opts Options) (CertificateAuthority, error) {
 				monitoring.ServerCertIssueFailed("invalid_csr")
 				return nil, csrErr
 			}
+			ttl, csrErr := opts.Config.WorkloadCertTTLFor(ns, "dapr-sentry", 0)
+			if csrErr != nil {
+				return nil, csrErr
+			}
 			certs, csrErr := camngr.SignIdentity(ctx, &ca.SignRequest{
 				PublicKey:   csr.PublicKey.(crypto.PublicKey),
 				TrustDomain: opts.Config.TrustDomain,
 				Namespace:   ns,
 				AppID:       "dapr-sentry",
+				TTL:         ttl,
+				CSR:         csr,
 			})
 			if csrErr != nil {
 				monitoring.ServerCertIssueFailed("ca_error")
@@ -146,6 +152,7 @@ func New(ctx context.Context, opts Options) (CertificateAuthority, error) {
 			JWTEnabled:       opts.Config.JWT.Enabled,
 			JWTTTL:           opts.Config.JWT.TTL,
 			TLD:              tld,
+			WorkloadCertTTL:  opts.Config.WorkloadCertTTLFor,
 		}).Start,
 	)
 
@@ -23,15 +23,15 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwk"
-	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/security"
-	"github.com/dapr/dapr/pkg/security/spiffe"
 	"github.com/dapr/dapr/pkg/sentry/config"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
 	bundle "github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
@@ -58,6 +58,14 @@ type SignRequest struct {
 
 	// Optional DNS names to add to the certificate.
 	DNS []string
+
+	// TTL of the certificate. Zero uses the workload certificate TTL of the
+	// configuration.
+	TTL time.Duration
+
+	// CSR is the certificate request, required by the CA providers which sign
+	// the request instead of a certificate template.
+	CSR *x509.CertificateRequest
 }
 
 // Signer is the interface for the CA.
@@ -85,8 +93,9 @@ type store interface {
 
 // ca is the implementation of the CA Signer.
 type ca struct {
-	bundle bundle.Bundle
-	config config.Config
+	bundle   bundle.Bundle
+	config   config.Config
+	provider provider
 	jwt.Issuer
 }
 
@@ -164,11 +173,53 @@ func New(ctx context.Context, conf config.Config) (Signer, error) {
 		log.Info("Generating JWT signing key and persisting to store")
 	}
 
+	prov, err := newProvider(conf, bndle.X509)
+	if err != nil {
+		return nil, err
+	}
+	if conf.CAProvider != "" && conf.CAProvider != ProviderLocal {
+		log.Infof("Signing workload certificates with the %s CA provider", conf.CAProvider)
+
+		// The roots of the provider are distributed with the trust bundle, so
+		// the workloads trust the certificates it signs.
+		anchors, err := prov.trustAnchors(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trust anchors of the CA provider: %w", err)
+		}
+		merged, changed, err := mergeTrustAnchors(bndle.X509.TrustAnchors, anchors)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			log.Info("Adding the trust anchors of the CA provider to the trust bundle")
+			bndle.X509.TrustAnchors = merged
+			needsWrite = true
+		}
+	}
+
+	if conf.AdditionalTrustAnchorsPath != "" {
+		// Distributing the next root before the issuer is rotated to it lets the
+		// workloads trust both roots during the rotation.
+		anchors, err := os.ReadFile(conf.AdditionalTrustAnchorsPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read additional trust anchors: %w", err)
+		}
+		merged, changed, err := mergeTrustAnchors(bndle.X509.TrustAnchors, anchors)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			log.Info("Adding the additional trust anchors to the trust bundle")
+			bndle.X509.TrustAnchors = merged
+			needsWrite = true
+		}
+	}
+
 	if needsWrite {
 		if err := castore.store(ctx, bndle); err != nil {
 			return nil, fmt.Errorf("failed to store CA bundle: %w", err)
 		}
-		log.Info("Self-signed certs and JWT root key generated and persisted successfully")
+		log.Info("Trust bundle persisted successfully")
 
 		monitoring.IssuerCertChanged()
 	} else {
@@ -248,40 +299,15 @@ func New(ctx context.Context, conf config.Config) (Signer, error) {
 	}
 
 	return &ca{
-		bundle: bndle,
-		config: conf,
-		Issuer: jwtIss,
+		bundle:   bndle,
+		config:   conf,
+		provider: prov,
+		Issuer:   jwtIss,
 	}, nil
 }
 
 func (c *ca) SignIdentity(ctx context.Context, req *SignRequest) ([]*x509.Certificate, error) {
-	td, err := spiffeid.TrustDomainFromString(req.TrustDomain)
-	if err != nil {
-		return nil, err
-	}
-
-	spiffeID, err := spiffe.FromStrings(td, req.Namespace, req.AppID)
-	if err != nil {
-		return nil, err
-	}
-
-	tmpl, err := bundle.GenerateWorkloadCert(c.config.WorkloadCertTTL, c.config.AllowedClockSkew, spiffeID)
-	if err != nil {
-		return nil, err
-	}
-	tmpl.DNSNames = append(tmpl.DNSNames, req.DNS...)
-
-	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, c.bundle.X509.IssChain[0], req.PublicKey, c.bundle.X509.IssKey)
-	if err != nil {
-		return nil, err
-	}
-
-	cert, err := x509.ParseCertificate(certDER)
-	if err != nil {
-		return nil, err
-	}
-
-	return append([]*x509.Certificate{cert}, c.bundle.X509.IssChain...), nil
+	return c.provider.signIdentity(ctx, req)
 }
 
 // TODO: Remove this method in v1.12 since it is not used any more.
@@ -312,6 +312,44 @@ func TestNew(t *testing.T) {
 			_, err := New(t.Context(), config)
 			require.Error(t, err)
 		})
+
+	t.Run("additional trust anchors are distributed with the trust bundle", func(t *testing.T) {
+		dir := t.TempDir()
+		nextRootPEM, nextRootCrt, _, _ := genCrt(t, "next-root", nil, nil)
+		nextRootPath := filepath.Join(dir, "next-root.cert")
+		require.NoError(t, os.WriteFile(nextRootPath, nextRootPEM, 0o600))
+
+		config := config.Config{
+			RootCertPath:               filepath.Join(dir, "root.cert"),
+			IssuerCertPath:             filepath.Join(dir, "issuer.cert"),
+			IssuerKeyPath:              filepath.Join(dir, "issuer.key"),
+			AdditionalTrustAnchorsPath: nextRootPath,
+			TrustDomain:                "test.example.com",
+			Mode:                       modes.StandaloneMode,
+		}
+
+		caObj, err := New(t.Context(), config)
+		require.NoError(t, err)
+
+		anchors, err := pem.DecodePEMCertificates(caObj.TrustAnchors())
+		require.NoError(t, err)
+		require.Len(t, anchors, 2)
+		assert.True(t, anchors[1].Equal(nextRootCrt))
+
+		root, err := os.ReadFile(config.RootCertPath)
+		require.NoError(t, err)
+		assert.Equal(t, caObj.TrustAnchors(), root)
+
+		// The issuer still chains to the current root, and the stored bundle is
+		// loaded again without adding the next root twice.
+		caObj, err = New(t.Context(), config)
+		require.NoError(t, err)
+		assert.Equal(t, root, caObj.TrustAnchors())
+
+		config.AdditionalTrustAnchorsPath = filepath.Join(dir, "missing.cert")
+		_, err = New(t.Context(), config)
+		require.ErrorContains(t, err, "failed to read additional trust anchors")
+	})
 }
 
 func TestSignIdentity(t *testing.T) {
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+
+	"github.com/dapr/dapr/pkg/security/spiffe"
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
+	"github.com/dapr/kit/crypto/pem"
+)
+
+const (
+	// ProviderLocal signs the workload certificates with the issuer certificate
+	// of the trust bundle.
+	ProviderLocal = "local"
+
+	// ProviderVault signs the workload certificates with a HashiCorp Vault PKI
+	// secrets engine.
+	ProviderVault = "vault"
+)
+
+// provider signs the workload certificates. Sentry validates the identity of
+// the requester before the request reaches the provider.
+type provider interface {
+	// signIdentity returns the signed workload certificate followed by the
+	// chain of intermediates, without the trust anchors.
+	signIdentity(context.Context, *SignRequest) ([]*x509.Certificate, error)
+
+	// trustAnchors returns the roots the certificates signed by the provider
+	// chain to in PEM format.
+	trustAnchors(context.Context) ([]byte, error)
+}
+
+// newProvider returns the provider of the configuration.
+func newProvider(conf config.Config, x509Bundle *bundle.X509) (provider, error) {
+	switch conf.CAProvider {
+	case "", ProviderLocal:
+		return &local{bundle: x509Bundle, config: conf}, nil
+	case ProviderVault:
+		return newVault(conf)
+	default:
+		return nil, fmt.Errorf("unknown CA provider %q", conf.CAProvider)
+	}
+}
+
+// local signs the workload certificates with the issuer of the bundle.
+type local struct {
+	bundle *bundle.X509
+	config config.Config
+}
+
+func (l *local) signIdentity(_ context.Context, req *SignRequest) ([]*x509.Certificate, error) {
+	spiffeID, err := spiffeIDOf(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = l.config.WorkloadCertTTL
+	}
+	tmpl, err := bundle.GenerateWorkloadCert(ttl, l.config.AllowedClockSkew, spiffeID)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.DNSNames = append(tmpl.DNSNames, req.DNS...)
+
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, l.bundle.IssChain[0], req.PublicKey, l.bundle.IssKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*x509.Certificate{cert}, l.bundle.IssChain...), nil
+}
+
+func (l *local) trustAnchors(context.Context) ([]byte, error) {
+	return l.bundle.TrustAnchors, nil
+}
+
+func spiffeIDOf(req *SignRequest) (*spiffe.Parsed, error) {
+	td, err := spiffeid.TrustDomainFromString(req.TrustDomain)
+	if err != nil {
+		return nil, err
+	}
+	return spiffe.FromStrings(td, req.Namespace, req.AppID)
+}
+
+// mergeTrustAnchors appends the certificates of extra missing from anchors.
+// It returns false if anchors already contains all of them.
+func mergeTrustAnchors(anchors, extra []byte) ([]byte, bool, error) {
+	existing, err := pem.DecodePEMCertificates(anchors)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode trust anchors: %w", err)
+	}
+	add, err := pem.DecodePEMCertificates(extra)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode trust anchors to add: %w", err)
+	}
+
+	merged := anchors
+	var changed bool
+	for _, cert := range add {
+		var found bool
+		for _, e := range existing {
+			if e.Equal(cert) {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		b, err := pem.EncodeX509(cert)
+		if err != nil {
+			return nil, false, err
+		}
+		merged = append(merged, b...)
+		existing = append(existing, cert)
+		changed = true
+	}
+	return merged, changed, nil
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/sentry/config"
+	kitpem "github.com/dapr/kit/crypto/pem"
+)
+
+// vault signs the workload certificates with the sign endpoint of a role of a
+// HashiCorp Vault PKI secrets engine. The role must allow the SPIFFE IDs of
+// the workloads as URI SANs, and must not require a common name. The role must
+// also set use_csr_sans and use_csr_common_name to false, so that the SANs of
+// the certificates are those computed by sentry rather than those the
+// workloads ask for in their certificate requests. Certificates whose SANs or
+// public key differ from those of the request are rejected.
+//
+// Options:
+//   - address: address of the Vault server (required).
+//   - role: role of the PKI secrets engine signing the certificates (required).
+//   - token or tokenFile: token authenticating sentry. The token file is read
+//     on every request, so it can be rotated.
+//   - mount: mount path of the PKI secrets engine. Defaults to "pki".
+//   - namespace: Vault Enterprise namespace.
+//   - caBundleFile: PEM file of the CAs trusted to serve the Vault API.
+//   - trustAnchorsFile: PEM file of the roots the certificates chain to.
+//     Defaults to the self-signed certificates of the CA chain of the mount.
+type vault struct {
+	address   string
+	mount     string
+	role      string
+	namespace string
+	token     string
+	tokenFile string
+	anchors   string
+	ttl       time.Duration
+	client    *http.Client
+}
+
+func newVault(conf config.Config) (*vault, error) {
+	opts := conf.CAProviderOptions
+	v := &vault{
+		address:   strings.TrimSuffix(opts["address"], "/"),
+		mount:     strings.Trim(opts["mount"], "/"),
+		role:      opts["role"],
+		namespace: opts["namespace"],
+		token:     opts["token"],
+		tokenFile: opts["tokenFile"],
+		anchors:   opts["trustAnchorsFile"],
+		ttl:       conf.WorkloadCertTTL,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}
+	if v.address == "" {
+		return nil, errors.New("vault CA provider: address is required")
+	}
+	if v.role == "" {
+		return nil, errors.New("vault CA provider: role is required")
+	}
+	if v.token == "" && v.tokenFile == "" {
+		return nil, errors.New("vault CA provider: token or tokenFile is required")
+	}
+	if v.mount == "" {
+		v.mount = "pki"
+	}
+
+	if caFile := opts["caBundleFile"]; caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("vault CA provider: failed to read CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("vault CA provider: no certificates found in CA bundle %s", caFile)
+		}
+		v.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+		}
+	}
+
+	return v, nil
+}
+
+type vaultSignResponse struct {
+	Data struct {
+		Certificate string   `json:"certificate"`
+		IssuingCA   string   `json:"issuing_ca"`
+		CAChain     []string `json:"ca_chain"`
+	} `json:"data"`
+}
+
+func (v *vault) signIdentity(ctx context.Context, req *SignRequest) ([]*x509.Certificate, error) {
+	if req.CSR == nil {
+		return nil, errors.New("vault CA provider: certificate signing request is required")
+	}
+	spiffeID, err := spiffeIDOf(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]any{
+		"csr":      string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: req.CSR.Raw})),
+		"uri_sans": spiffeID.URL().String(),
+		"format":   "pem",
+		// The common name of the request, if Vault takes it, is not a SAN.
+		"exclude_cn_from_sans": true,
+	}
+	if len(req.DNS) > 0 {
+		body["alt_names"] = strings.Join(req.DNS, ",")
+	}
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = v.ttl
+	}
+	if ttl > 0 {
+		body["ttl"] = ttl.String()
+	}
+
+	var resp vaultSignResponse
+	if err = v.do(ctx, http.MethodPost, "/sign/"+v.role, body, &resp); err != nil {
+		return nil, err
+	}
+
+	chainPEM := resp.Data.Certificate + "\n"
+	if len(resp.Data.CAChain) > 0 {
+		chainPEM += strings.Join(resp.Data.CAChain, "\n")
+	} else {
+		chainPEM += resp.Data.IssuingCA
+	}
+	certs, err := kitpem.DecodePEMCertificates([]byte(chainPEM))
+	if err != nil {
+		return nil, fmt.Errorf("vault CA provider: failed to decode signed certificate: %w", err)
+	}
+
+	if err = verifyVaultLeaf(certs[0], spiffeID.URL().String(), req.DNS, req.CSR.PublicKey); err != nil {
+		return nil, err
+	}
+
+	// The trust anchors are distributed separately.
+	chain := certs[:1]
+	for _, cert := range certs[1:] {
+		if cert.CheckSignatureFrom(cert) != nil {
+			chain = append(chain, cert)
+		}
+	}
+	return chain, nil
+}
+
+// verifyVaultLeaf verifies that the certificate signed by Vault is the one
+// sentry asked for: its only URI SAN is the SPIFFE ID of the workload, its DNS
+// SANs are among those of the request, it has no other SANs, and it certifies
+// the public key of the certificate request. This guards against a role which
+// takes the SANs of the certificate request, which the workload controls.
+func verifyVaultLeaf(leaf *x509.Certificate, spiffeID string, dns []string, pub crypto.PublicKey) error {
+	if len(leaf.URIs) != 1 || leaf.URIs[0].String() != spiffeID {
+		uris := make([]string, len(leaf.URIs))
+		for i, uri := range leaf.URIs {
+			uris[i] = uri.String()
+		}
+		return fmt.Errorf("vault CA provider: signed certificate has URI SANs %v, expected [%s]; the role must set use_csr_sans to false", uris, spiffeID)
+	}
+	for _, name := range leaf.DNSNames {
+		if !slices.Contains(dns, name) {
+			return fmt.Errorf("vault CA provider: signed certificate has unexpected DNS SAN %q; the role must set use_csr_sans to false", name)
+		}
+	}
+	if len(leaf.IPAddresses) > 0 || len(leaf.EmailAddresses) > 0 {
+		return errors.New("vault CA provider: signed certificate has unexpected IP or email SANs; the role must set use_csr_sans to false")
+	}
+	if key, ok := leaf.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !key.Equal(pub) {
+		return errors.New("vault CA provider: signed certificate does not certify the public key of the certificate request")
+	}
+	return nil
+}
+
+func (v *vault) trustAnchors(ctx context.Context) ([]byte, error) {
+	if v.anchors != "" {
+		b, err := os.ReadFile(v.anchors)
+		if err != nil {
+			return nil, fmt.Errorf("vault CA provider: failed to read trust anchors: %w", err)
+		}
+		return b, nil
+	}
+
+	var resp struct {
+		Data struct {
+			Certificate string `json:"certificate"`
+			CAChain     string `json:"ca_chain"`
+		} `json:"data"`
+	}
+	if err := v.do(ctx, http.MethodGet, "/cert/ca_chain", nil, &resp); err != nil {
+		return nil, err
+	}
+	chainPEM := resp.Data.CAChain
+	if chainPEM == "" {
+		chainPEM = resp.Data.Certificate
+	}
+	certs, err := kitpem.DecodePEMCertificates([]byte(chainPEM))
+	if err != nil {
+		return nil, fmt.Errorf("vault CA provider: failed to decode CA chain of mount %s: %w", v.mount, err)
+	}
+
+	var anchors []byte
+	for _, cert := range certs {
+		if cert.CheckSignatureFrom(cert) != nil {
+			continue
+		}
+		b, err := kitpem.EncodeX509(cert)
+		if err != nil {
+			return nil, err
+		}
+		anchors = append(anchors, b...)
+	}
+	if len(anchors) == 0 {
+		return nil, fmt.Errorf("vault CA provider: CA chain of mount %s has no root certificate, trustAnchorsFile is required", v.mount)
+	}
+	return anchors, nil
+}
+
+// do sends a request to the PKI secrets engine and decodes the response.
+func (v *vault) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	token := v.token
+	if v.tokenFile != "" {
+		b, err := os.ReadFile(v.tokenFile)
+		if err != nil {
+			return fmt.Errorf("vault CA provider: failed to read token: %w", err)
+		}
+		token = strings.TrimSpace(string(b))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, v.address+"/v1/"+v.mount+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("vault CA provider: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("vault CA provider: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(b, &errResp)
+		return fmt.Errorf("vault CA provider: %s %s returned status %d: %s", method, path, resp.StatusCode, strings.Join(errResp.Errors, "; "))
+	}
+	if err = json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("vault CA provider: failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
+)
+
+// fakeVault emulates the sign and CA chain endpoints of a Vault PKI secrets
+// engine mounted at pki, with the role dapr. If useCSRSANs is true, the role
+// takes the SANs of the certificate request rather than those of the sign
+// request, as Vault roles do by default.
+func fakeVault(t *testing.T, useCSRSANs bool) (*httptest.Server, *bundle.X509) {
+	t.Helper()
+
+	_, rootKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	x509Bundle, err := bundle.GenerateX509(bundle.OptionsX509{
+		X509RootKey: rootKey,
+		TrustDomain: "vault.example.com",
+	})
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/pki/cert/ca_chain":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"ca_chain": string(x509Bundle.IssChainPEM) + string(x509Bundle.TrustAnchors),
+			}})
+
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/pki/sign/dapr":
+			var req struct {
+				CSR      string `json:"csr"`
+				URISANs  string `json:"uri_sans"`
+				AltNames string `json:"alt_names"`
+				TTL      string `json:"ttl"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			block, _ := pem.Decode([]byte(req.CSR))
+			csr, err := x509.ParseCertificateRequest(block.Bytes)
+			require.NoError(t, err)
+			uri, err := url.Parse(req.URISANs)
+			require.NoError(t, err)
+			ttl, err := time.ParseDuration(req.TTL)
+			require.NoError(t, err)
+
+			tmpl := &x509.Certificate{
+				SerialNumber: x509Bundle.IssChain[0].SerialNumber,
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(ttl),
+				URIs:         []*url.URL{uri},
+			}
+			if req.AltNames != "" {
+				tmpl.DNSNames = strings.Split(req.AltNames, ",")
+			}
+			if useCSRSANs {
+				tmpl.URIs = csr.URIs
+				tmpl.DNSNames = csr.DNSNames
+			}
+			der, err := x509.CreateCertificate(rand.Reader, tmpl, x509Bundle.IssChain[0], csr.PublicKey, x509Bundle.IssKey)
+			require.NoError(t, err)
+
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+				"issuing_ca":  string(x509Bundle.IssChainPEM),
+				"ca_chain":    []string{string(x509Bundle.IssChainPEM), string(x509Bundle.TrustAnchors)},
+			}})
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, x509Bundle
+}
+
+func TestVault(t *testing.T) {
+	srv, vaultBundle := fakeVault(t, false)
+
+	vaultConfig := func(opts map[string]string) config.Config {
+		conf := config.Config{
+			WorkloadCertTTL: time.Hour,
+			CAProvider:      ProviderVault,
+			CAProviderOptions: map[string]string{
+				"address": srv.URL,
+				"role":    "dapr",
+				"token":   "s.token",
+			},
+		}
+		for k, v := range opts {
+			conf.CAProviderOptions[k] = v
+		}
+		return conf
+	}
+
+	newCSR := func(t *testing.T, tmpl *x509.CertificateRequest) *x509.CertificateRequest {
+		pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.CreateCertificateRequest(rand.Reader, tmpl, pk)
+		require.NoError(t, err)
+		csr, err := x509.ParseCertificateRequest(der)
+		require.NoError(t, err)
+		return csr
+	}
+
+	t.Run("identity is signed by vault", func(t *testing.T) {
+		v, err := newVault(vaultConfig(nil))
+		require.NoError(t, err)
+
+		csr := newCSR(t, new(x509.CertificateRequest))
+		chain, err := v.signIdentity(t.Context(), &SignRequest{
+			PublicKey:   csr.PublicKey,
+			TrustDomain: "example.com",
+			Namespace:   "default",
+			AppID:       "myapp",
+			DNS:         []string{"myapp.default.svc"},
+			CSR:         csr,
+		})
+		require.NoError(t, err)
+		require.Len(t, chain, 2)
+		assert.Equal(t, "spiffe://example.com/ns/default/myapp", chain[0].URIs[0].String())
+		assert.Equal(t, []string{"myapp.default.svc"}, chain[0].DNSNames)
+		assert.True(t, chain[1].Equal(vaultBundle.IssChain[0]))
+		require.NoError(t, chain[0].CheckSignatureFrom(chain[1]))
+	})
+
+	foreignCSR := func(t *testing.T) *x509.CertificateRequest {
+		foreign, err := url.Parse("spiffe://example.com/ns/kube-system/admin")
+		require.NoError(t, err)
+		return newCSR(t, &x509.CertificateRequest{
+			URIs:     []*url.URL{foreign},
+			DNSNames: []string{"admin.kube-system.svc"},
+		})
+	}
+
+	t.Run("SANs of the certificate request are ignored", func(t *testing.T) {
+		v, err := newVault(vaultConfig(nil))
+		require.NoError(t, err)
+
+		csr := foreignCSR(t)
+		chain, err := v.signIdentity(t.Context(), &SignRequest{
+			PublicKey:   csr.PublicKey,
+			TrustDomain: "example.com",
+			Namespace:   "default",
+			AppID:       "myapp",
+			CSR:         csr,
+		})
+		require.NoError(t, err)
+		require.Len(t, chain[0].URIs, 1)
+		assert.Equal(t, "spiffe://example.com/ns/default/myapp", chain[0].URIs[0].String())
+		assert.Empty(t, chain[0].DNSNames)
+	})
+
+	t.Run("role taking the SANs of the certificate request is rejected", func(t *testing.T) {
+		csrSrv, _ := fakeVault(t, true)
+		v, err := newVault(vaultConfig(map[string]string{"address": csrSrv.URL}))
+		require.NoError(t, err)
+
+		csr := foreignCSR(t)
+		_, err = v.signIdentity(t.Context(), &SignRequest{
+			PublicKey:   csr.PublicKey,
+			TrustDomain: "example.com",
+			Namespace:   "default",
+			AppID:       "myapp",
+			DNS:         []string{"myapp.default.svc"},
+			CSR:         csr,
+		})
+		require.ErrorContains(t, err, "the role must set use_csr_sans to false")
+	})
+
+	t.Run("token is read from the token file", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("s.wrong"), 0o600))
+		v, err := newVault(vaultConfig(map[string]string{"token": "", "tokenFile": tokenFile}))
+		require.NoError(t, err)
+
+		_, err = v.trustAnchors(t.Context())
+		require.ErrorContains(t, err, "returned status 403: permission denied")
+
+		require.NoError(t, os.WriteFile(tokenFile, []byte("s.token\n"), 0o600))
+		anchors, err := v.trustAnchors(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, vaultBundle.TrustAnchors, anchors)
+	})
+
+	t.Run("signing requires the certificate request", func(t *testing.T) {
+		v, err := newVault(vaultConfig(nil))
+		require.NoError(t, err)
+		_, err = v.signIdentity(t.Context(), &SignRequest{TrustDomain: "example.com", Namespace: "default", AppID: "myapp"})
+		require.ErrorContains(t, err, "certificate signing request is required")
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := newVault(vaultConfig(map[string]string{"address": ""}))
+		require.ErrorContains(t, err, "address is required")
+		_, err = newVault(vaultConfig(map[string]string{"role": ""}))
+		require.ErrorContains(t, err, "role is required")
+		_, err = newVault(vaultConfig(map[string]string{"token": ""}))
+		require.ErrorContains(t, err, "token or tokenFile is required")
+		_, err = newProvider(config.Config{CAProvider: "foo"}, nil)
+		require.ErrorContains(t, err, `unknown CA provider "foo"`)
+	})
+
+	t.Run("trust anchors of vault are added to the trust bundle", func(t *testing.T) {
+		dir := t.TempDir()
+		conf := vaultConfig(nil)
+		conf.RootCertPath = filepath.Join(dir, "root.cert")
+		conf.IssuerCertPath = filepath.Join(dir, "issuer.cert")
+		conf.IssuerKeyPath = filepath.Join(dir, "issuer.key")
+		conf.TrustDomain = "example.com"
+		conf.Mode = modes.StandaloneMode
+
+		signer, err := New(t.Context(), conf)
+		require.NoError(t, err)
+		anchors := signer.TrustAnchors()
+		assert.Contains(t, string(anchors), string(vaultBundle.TrustAnchors))
+
+		root, err := os.ReadFile(conf.RootCertPath)
+		require.NoError(t, err)
+		assert.Equal(t, anchors, root)
+
+		// The stored bundle is loaded again without adding the anchors twice.
+		signer, err = New(t.Context(), conf)
+		require.NoError(t, err)
+		assert.Equal(t, anchors, signer.TrustAnchors())
+	})
+}
+
+func TestVerifyVaultLeaf(t *testing.T) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	id, err := url.Parse("spiffe://example.com/ns/default/myapp")
+	require.NoError(t, err)
+	foreign, err := url.Parse("spiffe://example.com/ns/default/other")
+	require.NoError(t, err)
+
+	leaf := func(uris []*url.URL, dns []string) *x509.Certificate {
+		return &x509.Certificate{URIs: uris, DNSNames: dns, PublicKey: &pk.PublicKey}
+	}
+	dns := []string{"myapp.default.svc", "myapp"}
+
+	require.NoError(t, verifyVaultLeaf(leaf([]*url.URL{id}, []string{"myapp"}), id.String(), dns, &pk.PublicKey))
+	require.ErrorContains(t, verifyVaultLeaf(leaf([]*url.URL{id, foreign}, nil), id.String(), dns, &pk.PublicKey), "URI SANs")
+	require.ErrorContains(t, verifyVaultLeaf(leaf(nil, nil), id.String(), dns, &pk.PublicKey), "URI SANs")
+	require.ErrorContains(t, verifyVaultLeaf(leaf([]*url.URL{id}, []string{"other"}), id.String(), dns, &pk.PublicKey), `unexpected DNS SAN "other"`)
+	require.ErrorContains(t, verifyVaultLeaf(leaf([]*url.URL{id}, nil), id.String(), dns, &other.PublicKey), "does not certify the public key")
+}
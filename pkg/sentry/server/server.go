@@ -70,6 +70,10 @@ type Options struct {
 	JWTTTL time.Duration
 
 	TLD string
+
+	// WorkloadCertTTL returns the TTL of the workload certificate of an app,
+	// given the TTL requested for it. Zero uses the TTL of the CA.
+	WorkloadCertTTL func(namespace, appID string, requested time.Duration) (time.Duration, error)
 }
 
 // Server is the gRPC server for the Sentry service.
@@ -84,6 +88,7 @@ type Server struct {
 	jwtEnabled       bool
 	jwtTTL           time.Duration
 	tld              string
+	workloadCertTTL  func(namespace, appID string, requested time.Duration) (time.Duration, error)
 }
 
 func New(opts Options) *Server {
@@ -98,6 +103,7 @@ func New(opts Options) *Server {
 		jwtEnabled:       opts.JWTEnabled,
 		jwtTTL:           opts.JWTTTL,
 		tld:              opts.TLD,
+		workloadCertTTL:  opts.WorkloadCertTTL,
 	}
 }
 
@@ -209,12 +215,23 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 		}
 	}
 
+	var ttl time.Duration
+	if s.workloadCertTTL != nil {
+		ttl, err = s.workloadCertTTL(namespace, req.GetId(), res.CertTTL)
+		if err != nil {
+			log.Debugf("Invalid certificate TTL for %s/%s: %v", namespace, req.GetId(), err)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	chain, err := s.ca.SignIdentity(ctx, &ca.SignRequest{
 		PublicKey:   csr.PublicKey,
 		TrustDomain: res.TrustDomain.String(),
 		Namespace:   namespace,
 		AppID:       req.GetId(),
 		DNS:         dns,
+		TTL:         ttl,
+		CSR:         csr,
 	})
 	if err != nil {
 		log.Errorf("Error signing identity: %v", err)
@@ -231,6 +248,13 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 
 	audiences := append([]string{res.TrustDomain.String()}, req.GetJwtAudiences()...) // Default audience is the trust domain
 
+	// The JWTs don't outlive the certificate they are issued with, so the TTL
+	// of the certificate of the app also bounds the TTL of its JWTs.
+	jwtTTL := s.jwtTTL
+	if left := time.Until(chain[0].NotAfter); left > 0 && left < jwtTTL {
+		jwtTTL = left
+	}
+
 	var jwtToken *wrapperspb.StringValue
 	var perJWTToken map[string]*wrapperspb.StringValue
 	if s.jwtEnabled {
@@ -240,7 +264,7 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 			Audiences:   audiences,
 			Namespace:   req.GetNamespace(),
 			AppID:       req.GetId(),
-			TTL:         s.jwtTTL,
+			TTL:         jwtTTL,
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to generate JWT: %v", err)
@@ -256,7 +280,7 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 				Audiences:   []string{audience},
 				Namespace:   req.GetNamespace(),
 				AppID:       req.GetId(),
-				TTL:         s.jwtTTL,
+				TTL:         jwtTTL,
 			})
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to generate JWT for audience %s: %v", audience, err)
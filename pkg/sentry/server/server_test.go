@@ -37,12 +37,14 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/dapr/dapr/pkg/healthz"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
 	securityfake "github.com/dapr/dapr/pkg/security/fake"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
 	cafake "github.com/dapr/dapr/pkg/sentry/server/ca/fake"
+	"github.com/dapr/dapr/pkg/sentry/server/ca/jwt"
 	"github.com/dapr/dapr/pkg/sentry/server/validator"
 	validatorfake "github.com/dapr/dapr/pkg/sentry/server/validator/fake"
 )
@@ -69,11 +71,25 @@ func TestRun(t *testing.T) {
 	require.NoError(t, err)
 	crtPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: crt})
 
+	shortTmpl := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{Organization: []string{"test"}},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(5 * time.Minute),
+	}
+	shortCrt, err := x509.CreateCertificate(rand.Reader, shortTmpl, shortTmpl, pk.Public(), pk)
+	require.NoError(t, err)
+	shortCrtX509, err := x509.ParseCertificate(shortCrt)
+	require.NoError(t, err)
+
 	tests := map[string]struct {
 		sec *securityfake.Fake
 		val validator.Validator
 		ca  ca.Signer
 
+		workloadCertTTL func(namespace, appID string, requested time.Duration) (time.Duration, error)
+		jwtTTL          time.Duration
+
 		req     *sentryv1pb.SignCertificateRequest
 		expResp *sentryv1pb.SignCertificateResponse
 		expErr  bool
@@ -211,6 +227,110 @@ func TestRun(t *testing.T) {
 			expErr:  false,
 			expCode: codes.OK,
 		},
+		"certificate TTL of the app is passed to the CA": {
+			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
+				return grpc.Creds(insecure.NewCredentials())
+			}),
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (validator.ValidateResult, error) {
+				return validator.ValidateResult{
+					TrustDomain: spiffeid.RequireTrustDomainFromString("my-trust-domain"),
+					CertTTL:     time.Minute,
+				}, nil
+			}),
+			ca: cafake.New().WithSignIdentity(func(ctx context.Context, req *ca.SignRequest) ([]*x509.Certificate, error) {
+				assert.Equal(t, 5*time.Minute, req.TTL)
+				return []*x509.Certificate{crtX509}, nil
+			}).WithTrustAnchors(func() []byte {
+				return []byte("my-trust-anchors")
+			}),
+			workloadCertTTL: func(namespace, appID string, requested time.Duration) (time.Duration, error) {
+				assert.Equal(t, "my-namespace", namespace)
+				assert.Equal(t, "my-id", appID)
+				assert.Equal(t, time.Minute, requested)
+				return 5 * time.Minute, nil
+			},
+			req: &sentryv1pb.SignCertificateRequest{
+				Id:                        "my-id",
+				Token:                     "my-token",
+				TrustDomain:               "my-trust-domain",
+				Namespace:                 "my-namespace",
+				CertificateSigningRequest: csrPEM,
+				TokenValidator:            sentryv1pb.SignCertificateRequest_TokenValidator(-1),
+			},
+			expResp: &sentryv1pb.SignCertificateResponse{
+				WorkloadCertificate:    crtPEM,
+				TrustChainCertificates: [][]byte{[]byte("my-trust-anchors")},
+				ValidUntil:             timestamppb.New(time.Date(2023, 1, 1, 1, 1, 1, 0, time.UTC)),
+			},
+			expErr:  false,
+			expCode: codes.OK,
+		},
+		"request for a certificate TTL exceeding the maximum should error": {
+			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
+				return grpc.Creds(insecure.NewCredentials())
+			}),
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (validator.ValidateResult, error) {
+				return validator.ValidateResult{
+					TrustDomain: spiffeid.RequireTrustDomainFromString("my-trust-domain"),
+					CertTTL:     48 * time.Hour,
+				}, nil
+			}),
+			ca: cafake.New().WithSignIdentity(func(ctx context.Context, req *ca.SignRequest) ([]*x509.Certificate, error) {
+				assert.Fail(t, "unexpected signing")
+				return nil, nil
+			}),
+			workloadCertTTL: func(namespace, appID string, requested time.Duration) (time.Duration, error) {
+				return 0, errors.New("requested certificate TTL 48h0m0s exceeds the maximum of 24h0m0s")
+			},
+			req: &sentryv1pb.SignCertificateRequest{
+				Id:                        "my-id",
+				Token:                     "my-token",
+				TrustDomain:               "my-trust-domain",
+				Namespace:                 "my-namespace",
+				CertificateSigningRequest: csrPEM,
+				TokenValidator:            sentryv1pb.SignCertificateRequest_TokenValidator(-1),
+			},
+			expResp: nil,
+			expErr:  true,
+			expCode: codes.InvalidArgument,
+		},
+		"JWTs do not outlive the certificate": {
+			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
+				return grpc.Creds(insecure.NewCredentials())
+			}),
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (validator.ValidateResult, error) {
+				return validator.ValidateResult{
+					TrustDomain: spiffeid.RequireTrustDomainFromString("my-trust-domain"),
+				}, nil
+			}),
+			ca: cafake.New().WithSignIdentity(func(ctx context.Context, req *ca.SignRequest) ([]*x509.Certificate, error) {
+				return []*x509.Certificate{shortCrtX509}, nil
+			}).WithTrustAnchors(func() []byte {
+				return []byte("my-trust-anchors")
+			}).WithGenerateJWT(func(ctx context.Context, req *jwt.Request) (string, error) {
+				assert.LessOrEqual(t, req.TTL, 5*time.Minute)
+				assert.Greater(t, req.TTL, 4*time.Minute)
+				return "my-jwt", nil
+			}),
+			jwtTTL: time.Hour,
+			req: &sentryv1pb.SignCertificateRequest{
+				Id:                        "my-id",
+				Token:                     "my-token",
+				TrustDomain:               "my-trust-domain",
+				Namespace:                 "my-namespace",
+				CertificateSigningRequest: csrPEM,
+				TokenValidator:            sentryv1pb.SignCertificateRequest_TokenValidator(-1),
+			},
+			expResp: &sentryv1pb.SignCertificateResponse{
+				WorkloadCertificate:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: shortCrt}),
+				TrustChainCertificates: [][]byte{[]byte("my-trust-anchors")},
+				ValidUntil:             timestamppb.New(shortCrtX509.NotAfter),
+				Jwt:                    wrapperspb.String("my-jwt"),
+				PerAudienceJwts:        map[string]*wrapperspb.StringValue{"my-trust-domain": wrapperspb.String("my-jwt")},
+			},
+			expErr:  false,
+			expCode: codes.OK,
+		},
 		"if request is for injector, expect injector dns name": {
 			sec: securityfake.New().WithGRPCServerOptionNoClientAuthFn(func() grpc.ServerOption {
 				return grpc.Creds(insecure.NewCredentials())
@@ -287,8 +407,11 @@ func TestRun(t *testing.T) {
 					// This is an invalid validator that is just used for tests
 					-1: test.val,
 				},
-				CA:      test.ca,
-				Healthz: healthz.New(),
+				CA:              test.ca,
+				Healthz:         healthz.New(),
+				WorkloadCertTTL: test.workloadCertTTL,
+				JWTEnabled:      test.jwtTTL > 0,
+				JWTTTL:          test.jwtTTL,
 			}
 
 			serverClosed := make(chan struct{})
@@ -332,6 +455,7 @@ func TestRun(t *testing.T) {
 				assert.Equal(t, test.expResp.GetTrustChainCertificates(), resp.GetTrustChainCertificates())
 				assert.Equal(t, test.expResp.GetValidUntil(), resp.GetValidUntil())
 				assert.Equal(t, test.expResp.GetWorkloadCertificate(), resp.GetWorkloadCertificate())
+				assert.Equal(t, test.expResp.GetJwt().GetValue(), resp.GetJwt().GetValue())
 			}
 		})
 	}
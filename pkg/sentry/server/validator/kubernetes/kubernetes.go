@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
@@ -203,11 +204,20 @@ func (k *kubernetes) Validate(ctx context.Context, req *sentryv1pb.SignCertifica
 		}, nil
 	}
 
+	var certTTL time.Duration
+	if ttl, ok := pod.GetAnnotations()[annotations.KeyWorkloadCertTTL]; ok {
+		certTTL, err = time.ParseDuration(ttl)
+		if err != nil || certTTL <= 0 {
+			return validator.ValidateResult{}, fmt.Errorf("invalid %s annotation %q", annotations.KeyWorkloadCertTTL, ttl)
+		}
+	}
+
 	configName, ok := pod.GetAnnotations()[annotations.KeyConfig]
 	if !ok {
 		// Return early with default trust domain if no config annotation is found.
 		return validator.ValidateResult{
 			TrustDomain: spiffeid.RequireTrustDomainFromString("public"),
+			CertTTL:     certTTL,
 		}, nil
 	}
 
@@ -221,6 +231,7 @@ func (k *kubernetes) Validate(ctx context.Context, req *sentryv1pb.SignCertifica
 	if config.Spec.AccessControlSpec == nil || len(config.Spec.AccessControlSpec.TrustDomain) == 0 {
 		return validator.ValidateResult{
 			TrustDomain: spiffeid.RequireTrustDomainFromString("public"),
+			CertTTL:     certTTL,
 		}, nil
 	}
 
@@ -230,6 +241,7 @@ func (k *kubernetes) Validate(ctx context.Context, req *sentryv1pb.SignCertifica
 	}
 	return validator.ValidateResult{
 		TrustDomain: td,
+		CertTTL:     certTTL,
 	}, nil
 }
 
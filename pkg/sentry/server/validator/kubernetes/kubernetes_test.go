@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
@@ -61,6 +62,7 @@ func TestValidate(t *testing.T) {
 
 		expTD  spiffeid.TrustDomain
 		expAud []string
+		expTTL time.Duration
 		expErr bool
 	}{
 		"if pod in different namespace, expect error": {
@@ -504,6 +506,72 @@ func TestValidate(t *testing.T) {
 			expErr: false,
 			expTD:  spiffeid.RequireTrustDomainFromString("public"),
 		},
+		"valid authentication, workload cert TTL annotation should be returned": {
+			sentryAudience: "spiffe://cluster.local/ns/dapr-test/dapr-sentry",
+			reactor: func(t *testing.T) core.ReactionFunc {
+				return func(action core.Action) (bool, runtime.Object, error) {
+					return true, &kauthapi.TokenReview{Status: kauthapi.TokenReviewStatus{
+						Authenticated: true,
+						User: kauthapi.UserInfo{
+							Username: "system:serviceaccount:my-ns:my-sa",
+						},
+					}}, nil
+				}
+			},
+			req: &sentryv1pb.SignCertificateRequest{
+				CertificateSigningRequest: []byte("csr"),
+				Namespace:                 "my-ns",
+				Token:                     newToken(t, "my-ns", "my-pod"),
+				TrustDomain:               "example.test.dapr.io",
+				Id:                        "my-app-id",
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-pod",
+					Namespace: "my-ns",
+					Annotations: map[string]string{
+						"dapr.io/app-id":            "my-app-id",
+						"dapr.io/workload-cert-ttl": "15m",
+					},
+				},
+				Spec: corev1.PodSpec{ServiceAccountName: "my-sa"},
+			},
+			expErr: false,
+			expTD:  spiffeid.RequireTrustDomainFromString("public"),
+			expTTL: 15 * time.Minute,
+		},
+		"if workload cert TTL annotation is invalid, expect error": {
+			sentryAudience: "spiffe://cluster.local/ns/dapr-test/dapr-sentry",
+			reactor: func(t *testing.T) core.ReactionFunc {
+				return func(action core.Action) (bool, runtime.Object, error) {
+					return true, &kauthapi.TokenReview{Status: kauthapi.TokenReviewStatus{
+						Authenticated: true,
+						User: kauthapi.UserInfo{
+							Username: "system:serviceaccount:my-ns:my-sa",
+						},
+					}}, nil
+				}
+			},
+			req: &sentryv1pb.SignCertificateRequest{
+				CertificateSigningRequest: []byte("csr"),
+				Namespace:                 "my-ns",
+				Token:                     newToken(t, "my-ns", "my-pod"),
+				TrustDomain:               "example.test.dapr.io",
+				Id:                        "my-app-id",
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-pod",
+					Namespace: "my-ns",
+					Annotations: map[string]string{
+						"dapr.io/app-id":            "my-app-id",
+						"dapr.io/workload-cert-ttl": "-1h",
+					},
+				},
+				Spec: corev1.PodSpec{ServiceAccountName: "my-sa"},
+			},
+			expErr: true,
+		},
 		"valid authentication, config annotation, return the trust domain from config": {
 			sentryAudience: "spiffe://cluster.local/ns/dapr-test/dapr-sentry",
 			reactor: func(t *testing.T) core.ReactionFunc {
@@ -1297,6 +1365,7 @@ func TestValidate(t *testing.T) {
 			res, err := k.Validate(t.Context(), test.req)
 			assert.Equal(t, test.expErr, err != nil, "%v", err)
 			assert.Equal(t, test.expTD, res.TrustDomain, "%v", res.TrustDomain)
+			assert.Equal(t, test.expTTL, res.CertTTL)
 		})
 	}
 }
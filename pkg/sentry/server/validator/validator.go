@@ -15,6 +15,7 @@ package validator
 
 import (
 	"context"
+	"time"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 
@@ -25,6 +26,9 @@ import (
 type ValidateResult struct {
 	// TrustDomain is the trust domain of the client.
 	TrustDomain spiffeid.TrustDomain
+
+	// CertTTL is the TTL of the certificate requested for the client, if any.
+	CertTTL time.Duration
 }
 
 // Validator is used to validate the identity of a certificate requester by
@@ -118,3 +118,22 @@ func AddSSEHeaders(w http.ResponseWriter) {
 	w.Header().Set(headerConnection, connectionKeepAlive)
 	w.Header().Del(headerContentLength)
 }
+
+// WriteEvent writes an event with the given type, or the default type if
+// empty, and single-line data to the stream, and flushes it.
+func WriteEvent(w http.ResponseWriter, event string, data []byte) error {
+	var buf []byte
+	if event != "" {
+		buf = append(buf, "event: "+event+"\n"...)
+	}
+	buf = append(buf, "data: "...)
+	buf = append(buf, data...)
+	buf = append(buf, "\n\n"...)
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
@@ -45,6 +45,24 @@ func (c FakeSecretStore) BulkGetSecret(ctx context.Context, req secretstores.Bul
 	}, nil
 }
 
+// NamedSecretStore is a fake secret store whose bulk get returns a secret of
+// each of the names.
+type NamedSecretStore struct {
+	FakeSecretStore
+	Names []string
+}
+
+func (c NamedSecretStore) BulkGetSecret(ctx context.Context, req secretstores.BulkGetSecretRequest) (secretstores.BulkGetSecretResponse, error) {
+	response := make(map[string]map[string]string, len(c.Names))
+	for _, name := range c.Names {
+		response[name] = map[string]string{name: "value"}
+	}
+
+	return secretstores.BulkGetSecretResponse{
+		Data: response,
+	}, nil
+}
+
 func (c FakeSecretStore) Init(ctx context.Context, metadata secretstores.Metadata) error {
 	return nil
 }
@@ -170,6 +170,9 @@ func New(t *testing.T, fopts ...Option) *Daprd {
 	if opts.allowedOrigins != nil {
 		args = append(args, "--allowed-origins="+*opts.allowedOrigins)
 	}
+	if opts.internalGRPCSocketDir != nil {
+		args = append(args, "--dapr-internal-grpc-socket-dir="+*opts.internalGRPCSocketDir)
+	}
 	if len(opts.disableInitEndpoints) > 0 {
 		args = append(args, "--disable-init-endpoints="+strings.Join(opts.disableInitEndpoints, ","))
 	}
@@ -44,6 +44,7 @@ type options struct {
 	grpcPort                   int
 	httpPort                   int
 	internalGRPCPort           int
+	internalGRPCSocketDir      *string
 	publicPort                 int
 	metricsPort                int
 	profilePort                int
@@ -135,6 +136,12 @@ func WithInternalGRPCPort(port int) Option {
 	}
 }
 
+func WithInternalGRPCSocketDir(dir string) Option {
+	return func(o *options) {
+		o.internalGRPCSocketDir = &dir
+	}
+}
+
 func WithPublicPort(port int) Option {
 	return func(o *options) {
 		o.publicPort = port
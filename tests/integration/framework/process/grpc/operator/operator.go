@@ -291,6 +291,7 @@ func New(t *testing.T, fopts ...Option) *Operator {
 				subscriptionUpdateFn:         opts.subscriptionUpdateFn,
 				listWorkflowAccessPoliciesFn: opts.listWorkflowAccessPoliciesFn,
 				workflowAccessPolicyUpdateFn: opts.workflowAccessPolicyUpdateFn,
+				reportResourceResultsFn:      opts.reportResourceResultsFn,
 			}
 
 			operatorv1.RegisterOperatorServer(s, srv)
@@ -46,6 +46,7 @@ type options struct {
 	subscriptionUpdateFn         func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
 	listWorkflowAccessPoliciesFn func(context.Context, *operatorv1.ListWorkflowAccessPolicyRequest) (*operatorv1.ListWorkflowAccessPolicyResponse, error)
 	workflowAccessPolicyUpdateFn func(*operatorv1.WorkflowAccessPolicyUpdateRequest, operatorv1.Operator_WorkflowAccessPolicyUpdateServer) error
+	reportResourceResultsFn      func(operatorv1.Operator_ReportResourceResultsServer) error
 }
 
 func WithGRPCOptions(opts ...procgrpc.Option) func(*options) {
@@ -155,3 +156,9 @@ func WithWorkflowAccessPolicyUpdateFn(fn func(*operatorv1.WorkflowAccessPolicyUp
 		opts.workflowAccessPolicyUpdateFn = fn
 	}
 }
+
+func WithReportResourceResultsFn(fn func(operatorv1.Operator_ReportResourceResultsServer) error) func(*options) {
+	return func(opts *options) {
+		opts.reportResourceResultsFn = fn
+	}
+}
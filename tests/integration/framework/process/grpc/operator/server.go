@@ -38,6 +38,7 @@ type server struct {
 	subscriptionUpdateFn         func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
 	listWorkflowAccessPoliciesFn func(context.Context, *operatorv1.ListWorkflowAccessPolicyRequest) (*operatorv1.ListWorkflowAccessPolicyResponse, error)
 	workflowAccessPolicyUpdateFn func(*operatorv1.WorkflowAccessPolicyUpdateRequest, operatorv1.Operator_WorkflowAccessPolicyUpdateServer) error
+	reportResourceResultsFn      func(operatorv1.Operator_ReportResourceResultsServer) error
 }
 
 func (s *server) ComponentUpdate(req *operatorv1.ComponentUpdateRequest, srv operatorv1.Operator_ComponentUpdateServer) error {
@@ -152,3 +153,14 @@ func (s *server) WorkflowAccessPolicyUpdate(req *operatorv1.WorkflowAccessPolicy
 	}
 	return nil
 }
+
+func (s *server) ReportResourceResults(srv operatorv1.Operator_ReportResourceResultsServer) error {
+	if s.reportResourceResultsFn != nil {
+		return s.reportResourceResultsFn(srv)
+	}
+	for {
+		if _, err := srv.Recv(); err != nil {
+			return nil
+		}
+	}
+}
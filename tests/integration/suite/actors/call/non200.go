@@ -96,9 +96,9 @@ func (n *non200) Run(t *testing.T, ctx context.Context) {
 		body, err := io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		if i == 0 {
-			assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_INVOKE_METHOD","message":"error invoke actor method: error from actor service: (500) custom error"}`, string(body))
+			assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_INVOKE_METHOD","message":"error invoke actor method: error from actor service: (500) custom error","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_INVOKE_METHOD"}]}`, string(body))
 		} else {
-			assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_INVOKE_METHOD","message":"error invoke actor method: rpc error: code = Internal desc = error invoke actor method: error from actor service: (500) custom error"}`, string(body))
+			assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_INVOKE_METHOD","message":"error invoke actor method: rpc error: code = Internal desc = error invoke actor method: error from actor service: (500) custom error","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_INVOKE_METHOD"}]}`, string(body))
 		}
 		require.NoError(t, resp.Body.Close())
 		assert.Equal(t, int64(i+3), n.called.Load())
@@ -127,7 +127,7 @@ func (h *http) Run(t *testing.T, ctx context.Context) {
 	assert.Equal(t, nethttp.StatusInternalServerError, resp.StatusCode)
 	b, err := io.ReadAll(resp.Body)
 	require.NoError(t, err)
-	assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_STACK_DEPTH","message":"maximum stack depth exceeded"}`, string(b))
+	assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_STACK_DEPTH","message":"maximum stack depth exceeded","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_STACK_DEPTH"}]}`, string(b))
 	require.NoError(t, resp.Body.Close())
 	assert.Eventually(t, func() bool {
 		return h.app.Daprd().Metrics(t, ctx).MatchMetricAndSum(t, 1, "dapr_error_code_total", "category:actor", "error_code:ERR_ACTOR_STACK_DEPTH")
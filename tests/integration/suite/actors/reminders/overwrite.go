@@ -169,6 +169,6 @@ func (o *overwrite) Run(t *testing.T, ctx context.Context) {
 		b, err = io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		require.NoError(t, resp.Body.Close())
-		assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_REMINDER_ALREADY_EXISTS","message":"actor reminder already exists: helloworld"}`, strings.TrimSpace(string(b)))
+		assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_REMINDER_ALREADY_EXISTS","message":"actor reminder already exists: helloworld","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_REMINDER_ALREADY_EXISTS"}]}`, strings.TrimSpace(string(b)))
 	})
 }
@@ -74,15 +74,15 @@ func (n *nostore) Run(t *testing.T, ctx context.Context) {
 	}{
 		http.MethodPost: {
 			body: `{"dueTime": "100s"}`,
-			err:  `{"errorCode":"ERR_ACTOR_REMINDER_CREATE","message":"error creating actor reminder: scheduler clients are disabled"}`,
+			err:  `{"errorCode":"ERR_ACTOR_REMINDER_CREATE","message":"error creating actor reminder: scheduler clients are disabled","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_REMINDER_CREATE"}]}`,
 		},
 		http.MethodGet: {
 			body: `{"dueTime": "100s"}`,
-			err:  `{"errorCode":"ERR_ACTOR_REMINDER_GET","message":"error getting actor reminder: api error: code = Internal desc = failed to get job due to: scheduler clients are disabled"}`,
+			err:  `{"errorCode":"ERR_ACTOR_REMINDER_GET","message":"error getting actor reminder: api error: code = Internal desc = failed to get job due to: scheduler clients are disabled","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_REMINDER_GET"}]}`,
 		},
 		http.MethodDelete: {
 			body: `{"dueTime": "100s"}`,
-			err:  `{"errorCode":"ERR_ACTOR_REMINDER_DELETE","message":"error deleting actor reminder: scheduler clients are disabled"}`,
+			err:  `{"errorCode":"ERR_ACTOR_REMINDER_DELETE","message":"error deleting actor reminder: scheduler clients are disabled","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_REMINDER_DELETE"}]}`,
 		},
 	} {
 		var bodyReader io.Reader
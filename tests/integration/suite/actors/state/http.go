@@ -77,7 +77,7 @@ func (h *http) Run(t *testing.T, ctx context.Context) {
 	b, err = io.ReadAll(resp.Body)
 	require.NoError(t, err)
 	require.NoError(t, resp.Body.Close())
-	assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_INSTANCE_MISSING","message":"actor instance is missing"}`, string(b))
+	assert.JSONEq(t, `{"errorCode":"ERR_ACTOR_INSTANCE_MISSING","message":"actor instance is missing","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_ACTOR_INSTANCE_MISSING"}]}`, string(b))
 	assert.Eventually(t, func() bool {
 		return h.app.Daprd().Metrics(t, ctx).MatchMetricAndSum(t, 1, "dapr_error_code_total", "category:actor", "error_code:ERR_ACTOR_INSTANCE_MISSING")
 	}, 5*time.Second, 100*time.Millisecond)
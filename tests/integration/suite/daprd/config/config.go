@@ -14,5 +14,6 @@ limitations under the License.
 package config
 
 import (
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/config/grpc"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/config/http"
 )
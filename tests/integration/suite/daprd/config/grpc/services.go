@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	procdaprd "github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(services))
+}
+
+// services tests that the gRPC API server serves the health service, which
+// doesn't require the API token, and the reflection service, when enabled in
+// the configuration.
+type services struct {
+	daprd        *procdaprd.Daprd
+	daprdDefault *procdaprd.Daprd
+}
+
+func (s *services) Setup(t *testing.T) []framework.Option {
+	s.daprd = procdaprd.New(t,
+		procdaprd.WithDaprAPIToken(t, "token"),
+		procdaprd.WithConfigManifests(t, `
+apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: grpcservices
+spec:
+  api:
+    grpc:
+      healthService: true
+      reflection: false
+`))
+	s.daprdDefault = procdaprd.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(s.daprd, s.daprdDefault),
+	}
+}
+
+func (s *services) Run(t *testing.T, ctx context.Context) {
+	s.daprd.WaitUntilRunning(t, ctx)
+	s.daprdDefault.WaitUntilRunning(t, ctx)
+
+	listServices := func(t *testing.T, ctx context.Context, d *procdaprd.Daprd) ([]string, error) {
+		t.Helper()
+		stream, err := reflectionv1.NewServerReflectionClient(d.GRPCConn(t, ctx)).ServerReflectionInfo(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&reflectionv1.ServerReflectionRequest{
+			MessageRequest: &reflectionv1.ServerReflectionRequest_ListServices{},
+		}))
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, svc := range resp.GetListServicesResponse().GetService() {
+			names = append(names, svc.GetName())
+		}
+		return names, nil
+	}
+
+	t.Run("health service doesn't require the api token", func(t *testing.T) {
+		client := healthv1.NewHealthClient(s.daprd.GRPCConn(t, ctx))
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			resp, err := client.Check(ctx, &healthv1.HealthCheckRequest{})
+			if assert.NoError(c, err) {
+				assert.Equal(c, healthv1.HealthCheckResponse_SERVING, resp.GetStatus())
+			}
+		}, 10*time.Second, 10*time.Millisecond)
+
+		resp, err := client.Check(ctx, &healthv1.HealthCheckRequest{Service: "dapr.proto.runtime.v1.Dapr"})
+		require.NoError(t, err)
+		assert.Equal(t, healthv1.HealthCheckResponse_SERVING, resp.GetStatus())
+	})
+
+	t.Run("reflection is disabled", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(ctx, "dapr-api-token", "token")
+		_, err := listServices(t, ctx, s.daprd)
+		require.Error(t, err)
+	})
+
+	t.Run("health service is disabled by default", func(t *testing.T) {
+		_, err := healthv1.NewHealthClient(s.daprdDefault.GRPCConn(t, ctx)).Check(ctx, &healthv1.HealthCheckRequest{})
+		require.Error(t, err)
+		assert.NotEqual(t, codes.OK, status.Code(err))
+	})
+
+	t.Run("reflection is enabled by default", func(t *testing.T) {
+		names, err := listServices(t, ctx, s.daprdDefault)
+		require.NoError(t, err)
+		assert.Contains(t, names, "dapr.proto.runtime.v1.Dapr")
+		assert.NotContains(t, names, "grpc.health.v1.Health")
+	})
+}
@@ -46,12 +46,11 @@ func (s *set) Setup(t *testing.T) []framework.Option {
 	s.resDir = t.TempDir()
 
 	s.logline = logline.New(t, logline.WithStdoutLineContains(
-		`"Fatal error from runtime: failed to load resources from disk: duplicate definition of Component name foo (state.in-memory/v1) with existing foo (pubsub.in-memory/v1)"`,
+		`"Failed to load Component resources from disk, keeping the loaded resources until the files are fixed: duplicate definition of Component name foo (state.in-memory/v1) with existing foo (pubsub.in-memory/v1)"`,
 	))
 
 	s.daprd = daprd.New(t,
 		daprd.WithResourcesDir(s.resDir),
-		daprd.WithExit1(),
 		daprd.WithLogLineStdout(s.logline),
 		daprd.WithNamespace("mynamespace"),
 	)
@@ -200,4 +199,12 @@ spec:
 `), 0o600))
 
 	s.logline.EventuallyFoundAll(t)
+
+	// The duplicate definitions are rejected, keeping the loaded components.
+	assert.ElementsMatch(t, []*rtv1.RegisteredComponents{
+		{
+			Name: "123", Type: "pubsub.in-memory", Version: "v1",
+			Capabilities: []string{"SUBSCRIBE_WILDCARDS"},
+		},
+	}, s.daprd.GetMetaRegisteredComponents(t, ctx))
 }
@@ -46,12 +46,11 @@ func (u *unset) Setup(t *testing.T) []framework.Option {
 	u.resDir = t.TempDir()
 
 	u.logline = logline.New(t, logline.WithStdoutLineContains(
-		`Fatal error from runtime: failed to load resources from disk: duplicate definition of Component name foo (pubsub.in-memory/v1) with existing foo (state.in-memory/v1)\nduplicate definition of Component name foo (state.in-memory/v1) with existing foo (state.in-memory/v1)`,
+		`Failed to load Component resources from disk, keeping the loaded resources until the files are fixed: duplicate definition of Component name foo (pubsub.in-memory/v1) with existing foo (state.in-memory/v1)\nduplicate definition of Component name foo (state.in-memory/v1) with existing foo (state.in-memory/v1)`,
 	))
 
 	u.daprd = daprd.New(t,
 		daprd.WithResourcesDir(u.resDir),
-		daprd.WithExit1(),
 		daprd.WithLogLineStdout(u.logline),
 	)
 
@@ -193,4 +192,16 @@ spec:
 `), 0o600))
 
 	u.logline.EventuallyFoundAll(t)
+
+	// The duplicate definitions are rejected, keeping the loaded components.
+	assert.ElementsMatch(t, []*rtv1.RegisteredComponents{
+		{
+			Name: "123", Type: "state.in-memory", Version: "v1",
+			Capabilities: []string{"ETAG", "TRANSACTIONAL", "TTL", "DELETE_WITH_PREFIX", "KEYS_LIKE", "ACTOR"},
+		},
+		{
+			Name: "foo", Type: "state.in-memory", Version: "v1",
+			Capabilities: []string{"ETAG", "TRANSACTIONAL", "TTL", "DELETE_WITH_PREFIX", "KEYS_LIKE", "ACTOR"},
+		},
+	}, u.daprd.GetMetaRegisteredComponents(t, ctx))
 }
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobs
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/grpc/app"
+	"github.com/dapr/dapr/tests/integration/framework/process/scheduler"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(bulk))
+}
+
+type bulk struct {
+	daprd     *daprd.Daprd
+	scheduler *scheduler.Scheduler
+}
+
+func (b *bulk) Setup(t *testing.T) []framework.Option {
+	b.scheduler = scheduler.New(t)
+
+	srv := app.New(t)
+
+	b.daprd = daprd.New(t,
+		daprd.WithSchedulerAddresses(b.scheduler.Address()),
+		daprd.WithAppPort(srv.Port(t)),
+		daprd.WithAppProtocol("grpc"),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(b.scheduler, srv, b.daprd),
+	}
+}
+
+func (b *bulk) Run(t *testing.T, ctx context.Context) {
+	b.scheduler.WaitUntilRunning(t, ctx)
+	b.daprd.WaitUntilRunning(t, ctx)
+
+	client := b.daprd.GRPCClient(t, ctx)
+
+	req := new(rtv1.BulkScheduleJobsRequest)
+	for i := range 20 {
+		req.Jobs = append(req.Jobs, &rtv1.ScheduleJobRequest{
+			Job: &rtv1.Job{Name: "bulk-" + strconv.Itoa(i), Schedule: new("@daily")},
+		})
+	}
+	req.Jobs = append(req.Jobs,
+		&rtv1.ScheduleJobRequest{Job: &rtv1.Job{Name: "bulk-0", Schedule: new("@daily")}},
+		&rtv1.ScheduleJobRequest{Job: &rtv1.Job{Name: "noschedule"}},
+		&rtv1.ScheduleJobRequest{Job: &rtv1.Job{Name: "badschedule", Schedule: new("not a schedule")}},
+	)
+
+	resp, err := client.BulkScheduleJobs(ctx, req)
+	require.NoError(t, err)
+	failed := make([]string, 0, len(resp.GetFailedEntries()))
+	for _, entry := range resp.GetFailedEntries() {
+		assert.NotEmpty(t, entry.GetError())
+		failed = append(failed, entry.GetName())
+	}
+	assert.Equal(t, []string{"bulk-0", "noschedule", "badschedule"}, failed)
+
+	list, err := client.ListJobs(ctx, new(rtv1.ListJobsRequest))
+	require.NoError(t, err)
+	assert.Len(t, list.GetJobs(), 20)
+
+	delResp, err := client.BulkDeleteJobs(ctx, &rtv1.BulkDeleteJobsRequest{
+		Names: []string{"bulk-0", "bulk-1", "bulk-1", "", "bulk-2"},
+	})
+	require.NoError(t, err)
+	require.Len(t, delResp.GetFailedEntries(), 1)
+	assert.Empty(t, delResp.GetFailedEntries()[0].GetName())
+
+	list, err = client.ListJobs(ctx, new(rtv1.ListJobsRequest))
+	require.NoError(t, err)
+	assert.Len(t, list.GetJobs(), 17)
+}
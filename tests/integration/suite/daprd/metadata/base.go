@@ -132,4 +132,16 @@ func validateResponse(t *testing.T, appID string, appPort int, body io.Reader) {
 	require.True(t, ok)
 	require.Empty(t, rule["match"])
 	require.Equal(t, "/B", rule["path"])
+
+	// validate that the metadata contains the error codes of the APIs.
+	errorCodes, ok := bodyMap["errorCodes"].([]any)
+	require.True(t, ok)
+	apis := make(map[string]any, len(errorCodes))
+	for _, e := range errorCodes {
+		api, ok := e.(map[string]any)
+		require.True(t, ok)
+		apis[api["api"].(string)] = api["codes"]
+	}
+	require.Contains(t, apis, "state")
+	require.Contains(t, apis["state"], map[string]any{"code": "ERR_STATE_GET", "reason": "ERR_STATE_GET"})
 }
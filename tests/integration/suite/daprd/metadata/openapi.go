@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	procdaprd "github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(openapi))
+}
+
+// openapi tests that daprd serves the OpenAPI document of its HTTP API,
+// listing the names of the loaded components.
+type openapi struct {
+	daprd *procdaprd.Daprd
+}
+
+func (o *openapi) Setup(t *testing.T) []framework.Option {
+	o.daprd = procdaprd.New(t,
+		procdaprd.WithInMemoryStateStore("mystore"),
+		procdaprd.WithResourceFiles(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mypubsub
+spec:
+  type: pubsub.in-memory
+  version: v1
+`),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(o.daprd),
+	}
+}
+
+func (o *openapi) Run(t *testing.T, ctx context.Context) {
+	o.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	reqURL := fmt.Sprintf("http://localhost:%d/v1.0-alpha1/openapi", o.daprd.HTTPPort())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	require.NoError(t, err)
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name   string `json:"name"`
+				Schema struct {
+					Enum []string `json:"enum"`
+				} `json:"schema"`
+			} `json:"parameters"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+
+	getState := doc.Paths["/v1.0/state/{storeName}/{key}"]["get"]
+	assert.Equal(t, "GetState", getState.OperationID)
+	require.NotEmpty(t, getState.Parameters)
+	assert.Equal(t, []string{"mystore"}, getState.Parameters[0].Schema.Enum)
+
+	publish := doc.Paths["/v1.0/publish/{pubsubname}/{path}"]["post"]
+	require.NotEmpty(t, publish.Parameters)
+	assert.Equal(t, []string{"mypubsub"}, publish.Parameters[0].Schema.Enum)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	procdaprd "github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	prochttp "github.com/dapr/dapr/tests/integration/framework/process/http"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(routes))
+}
+
+// routes tests that the messages of the outbox are published to the topic
+// of the first route matching the key or metadata of the operation.
+type routes struct {
+	daprd *procdaprd.Daprd
+
+	lock     sync.Mutex
+	received map[string][]map[string]string
+}
+
+func (o *routes) Setup(t *testing.T) []framework.Option {
+	o.received = make(map[string][]map[string]string)
+
+	handler := http.NewServeMux()
+	for _, path := range []string{"/test", "/orders", "/payments"} {
+		handler.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			var ce map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&ce); err != nil {
+				t.Error(err)
+			}
+
+			o.lock.Lock()
+			o.received[path] = append(o.received[path], ce)
+			o.lock.Unlock()
+			w.WriteHeader(http.StatusOK)
+		})
+	}
+	srv := prochttp.New(t, prochttp.WithHandler(handler))
+
+	o.daprd = procdaprd.New(t, procdaprd.WithAppID("outboxtest"), procdaprd.WithAppPort(srv.Port()), procdaprd.WithResourceFiles(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.in-memory
+  version: v1
+  metadata:
+  - name: outboxPublishPubsub
+    value: "mypubsub"
+  - name: outboxPublishTopic
+    value: "test"
+  - name: outboxRoutes
+    value:
+    - keyPrefix: "order-"
+      pubsub: "otherpubsub"
+      topic: "orders"
+    - metadata:
+        type: "payment"
+      topic: "payments"
+`,
+		`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: 'mypubsub'
+spec:
+  type: pubsub.in-memory
+  version: v1
+`,
+		`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: 'otherpubsub'
+spec:
+  type: pubsub.in-memory
+  version: v1
+`,
+		`
+apiVersion: dapr.io/v2alpha1
+kind: Subscription
+metadata:
+  name: 'test'
+spec:
+  topic: 'test'
+  routes:
+    default: '/test'
+  pubsubname: 'mypubsub'
+---
+apiVersion: dapr.io/v2alpha1
+kind: Subscription
+metadata:
+  name: 'orders'
+spec:
+  topic: 'orders'
+  routes:
+    default: '/orders'
+  pubsubname: 'otherpubsub'
+---
+apiVersion: dapr.io/v2alpha1
+kind: Subscription
+metadata:
+  name: 'payments'
+spec:
+  topic: 'payments'
+  routes:
+    default: '/payments'
+  pubsubname: 'mypubsub'
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(srv, o.daprd),
+	}
+}
+
+func (o *routes) Run(t *testing.T, ctx context.Context) {
+	o.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+	postURL := fmt.Sprintf("http://localhost:%d/v1.0/state/mystore/transaction", o.daprd.HTTPPort())
+
+	transaction := func(t *testing.T, tr stateTransactionRequestBody) {
+		t.Helper()
+		b, err := json.Marshal(&tr)
+		require.NoError(t, err)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, bytes.NewReader(b))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusNoContent, resp.StatusCode, string(body))
+	}
+
+	upsert := func(key, value string, md map[string]string) stateTransactionRequestBodyOperation {
+		return stateTransactionRequestBodyOperation{
+			Operation: "upsert",
+			Request:   state.SetRequest{Key: key, Value: value, Metadata: md},
+		}
+	}
+
+	// The in-memory pubsub delivers the messages of a topic one at a time, so
+	// each transaction has a single operation.
+	transaction(t, stateTransactionRequestBody{
+		Operations: []stateTransactionRequestBodyOperation{upsert("order-1", "a", nil)},
+	})
+	transaction(t, stateTransactionRequestBody{
+		Operations: []stateTransactionRequestBodyOperation{upsert("item-1", "b", nil)},
+	})
+	transaction(t, stateTransactionRequestBody{
+		Operations: []stateTransactionRequestBodyOperation{upsert("item-2", "c", map[string]string{"type": "payment"})},
+	})
+	transaction(t, stateTransactionRequestBody{
+		Operations: []stateTransactionRequestBodyOperation{upsert("item-3", "d", nil)},
+		Metadata:   map[string]string{"type": "payment"},
+	})
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		o.lock.Lock()
+		defer o.lock.Unlock()
+
+		data := func(path string) []string {
+			var d []string
+			for _, ce := range o.received[path] {
+				d = append(d, ce["data"])
+			}
+			return d
+		}
+		assert.ElementsMatch(c, []string{"a"}, data("/orders"))
+		assert.ElementsMatch(c, []string{"b"}, data("/test"))
+		assert.ElementsMatch(c, []string{"c", "d"}, data("/payments"))
+	}, time.Second*20, time.Millisecond*10)
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	for _, ce := range o.received["/orders"] {
+		assert.Equal(t, "otherpubsub", ce["pubsubname"])
+		assert.Equal(t, "orders", ce["topic"])
+		assert.NotContains(t, ce, "outboxpublishpubsub")
+	}
+	for _, ce := range o.received["/payments"] {
+		assert.Equal(t, "mypubsub", ce["pubsubname"])
+		assert.Equal(t, "payments", ce["topic"])
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(grpc))
+}
+
+// grpc tests that the secrets of a bulk get are filtered by name, and are
+// streamed in chunks.
+type grpc struct {
+	daprd *daprd.Daprd
+}
+
+func (g *grpc) Setup(t *testing.T) []framework.Option {
+	secretFileName := filepath.Join(t.TempDir(), "secret.json")
+	require.NoError(t, os.WriteFile(secretFileName, []byte(`{
+  "db-password": "a",
+  "db-user": "b",
+  "api-key": "c",
+  "api-token": "d",
+  "other": "e"
+}`), 0o600))
+
+	g.daprd = daprd.New(t, daprd.WithResourceFiles(fmt.Sprintf(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: secretstores.local.file
+  version: v1
+  metadata:
+  - name: secretsFile
+    value: '%s'
+`, secretFileName)))
+
+	return []framework.Option{
+		framework.WithProcesses(g.daprd),
+	}
+}
+
+func (g *grpc) Run(t *testing.T, ctx context.Context) {
+	g.daprd.WaitUntilRunning(t, ctx)
+
+	client := g.daprd.GRPCClient(t, ctx)
+
+	t.Run("filters", func(t *testing.T) {
+		resp, err := client.GetBulkSecret(ctx, &rtv1.GetBulkSecretRequest{
+			StoreName:    "mystore",
+			NamePrefixes: []string{"db-", "api-"},
+			NameRegex:    "-(key|password)$",
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"db-password", "api-key"}, slices.Collect(maps.Keys(resp.GetData())))
+		assert.Equal(t, map[string]string{"api-key": "c"}, resp.GetData()["api-key"].GetSecrets())
+	})
+
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := client.GetBulkSecret(ctx, &rtv1.GetBulkSecretRequest{
+			StoreName: "mystore",
+			NameRegex: "(",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		stream, err := client.GetBulkSecretStreamAlpha1(ctx, &rtv1.GetBulkSecretStreamRequest{
+			StoreName:    "mystore",
+			NamePrefixes: []string{"db-", "api-"},
+			ChunkSize:    3,
+		})
+		require.NoError(t, err)
+
+		var chunks [][]string
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			chunks = append(chunks, slices.Sorted(maps.Keys(resp.GetData())))
+		}
+		assert.Equal(t, [][]string{{"api-key", "api-token", "db-password"}, {"db-user"}}, chunks)
+	})
+}
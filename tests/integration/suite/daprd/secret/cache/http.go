@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(http))
+}
+
+// http tests the cache of a secret store over HTTP, and purging it with the
+// management API.
+type http struct {
+	daprd *daprd.Daprd
+}
+
+func (h *http) Setup(t *testing.T) []framework.Option {
+	secretFileName := filepath.Join(t.TempDir(), "secret.json")
+	require.NoError(t, os.WriteFile(secretFileName, []byte(`{"key1": "value1"}`), 0o600))
+
+	h.daprd = daprd.New(t, daprd.WithResourceFiles(fmt.Sprintf(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: cached
+spec:
+  type: secretstores.local.file
+  version: v1
+  metadata:
+  - name: secretsFile
+    value: '%[1]s'
+  - name: cacheTTL
+    value: 1h
+  - name: cacheNegativeTTL
+    value: 1h
+---
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: uncached
+spec:
+  type: secretstores.local.file
+  version: v1
+  metadata:
+  - name: secretsFile
+    value: '%[1]s'
+`, secretFileName)))
+
+	return []framework.Option{
+		framework.WithProcesses(h.daprd),
+	}
+}
+
+func (h *http) Run(t *testing.T, ctx context.Context) {
+	h.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	do := func(t *testing.T, method, path string, expectedCode int) string {
+		t.Helper()
+		url := fmt.Sprintf("http://%s/%s", h.daprd.HTTPAddress(), path)
+		req, err := nethttp.NewRequestWithContext(ctx, method, url, nil)
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, expectedCode, resp.StatusCode, string(b))
+		return string(b)
+	}
+
+	t.Run("serves cached secrets", func(t *testing.T) {
+		for range 2 {
+			assert.JSONEq(t, `{"key1": "value1"}`, do(t, nethttp.MethodGet, "v1.0/secrets/cached/key1", nethttp.StatusOK))
+			assert.Contains(t, do(t, nethttp.MethodGet, "v1.0/secrets/cached/key2", nethttp.StatusInternalServerError), "secret key2 not found")
+		}
+
+		metrics := h.daprd.Metrics(t, ctx)
+		assert.True(t, metrics.MatchMetricAndSum(t, 2, "dapr_component_secret_cache_hit_count"))
+		assert.True(t, metrics.MatchMetricAndSum(t, 2, "dapr_component_secret_cache_miss_count"))
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		assert.JSONEq(t, `{"purged": 1}`, do(t, nethttp.MethodDelete, "v1.0-alpha1/secrets/cached/cache?name=key1", nethttp.StatusOK))
+		assert.JSONEq(t, `{}`, do(t, nethttp.MethodDelete, "v1.0-alpha1/secrets/cached/cache?name=key1", nethttp.StatusOK))
+		assert.JSONEq(t, `{"key1": "value1"}`, do(t, nethttp.MethodGet, "v1.0/secrets/cached/key1", nethttp.StatusOK))
+		assert.JSONEq(t, `{"purged": 2}`, do(t, nethttp.MethodDelete, "v1.0-alpha1/secrets/cached/cache", nethttp.StatusOK))
+	})
+
+	t.Run("purge of store without cache", func(t *testing.T) {
+		assert.Contains(t, do(t, nethttp.MethodDelete, "v1.0-alpha1/secrets/uncached/cache", nethttp.StatusBadRequest), "ERR_SECRET_CACHE_NOT_ENABLED")
+		assert.Contains(t, do(t, nethttp.MethodDelete, "v1.0-alpha1/secrets/nostore/cache", nethttp.StatusUnauthorized), "ERR_SECRET_STORE_NOT_FOUND")
+	})
+}
@@ -14,6 +14,9 @@ limitations under the License.
 package secret
 
 import (
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/secret/bulk"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/secret/cache"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/secret/http"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/secret/secretscoping"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/secret/watch"
 )
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(grpc))
+}
+
+// grpc tests that watches of secrets are validated, and that watchers are
+// notified once the watch is established.
+type grpc struct {
+	daprd *daprd.Daprd
+}
+
+func (g *grpc) Setup(t *testing.T) []framework.Option {
+	secretFileName := filepath.Join(t.TempDir(), "secret.json")
+	require.NoError(t, os.WriteFile(secretFileName, []byte(`{"key1": "a", "key2": "b"}`), 0o600))
+
+	g.daprd = daprd.New(t, daprd.WithResourceFiles(fmt.Sprintf(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: secretstores.local.file
+  version: v1
+  metadata:
+  - name: secretsFile
+    value: '%s'
+`, secretFileName)))
+
+	return []framework.Option{
+		framework.WithProcesses(g.daprd),
+	}
+}
+
+func (g *grpc) Run(t *testing.T, ctx context.Context) {
+	g.daprd.WaitUntilRunning(t, ctx)
+
+	client := g.daprd.GRPCClient(t, ctx)
+
+	watch := func(t *testing.T, req *rtv1.WatchSecretsRequest) (*rtv1.WatchSecretsResponse, error) {
+		t.Helper()
+		ctx, cancel := context.WithCancel(ctx)
+		t.Cleanup(cancel)
+		stream, err := client.WatchSecretsAlpha1(ctx, req)
+		require.NoError(t, err)
+		return stream.Recv()
+	}
+
+	t.Run("established", func(t *testing.T) {
+		resp, err := watch(t, &rtv1.WatchSecretsRequest{
+			StoreName:           "mystore",
+			Names:               []string{"key1", "key2"},
+			PollIntervalSeconds: 1,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetChanges())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, req := range []*rtv1.WatchSecretsRequest{
+			{StoreName: "mystore"},
+			{StoreName: "mystore", Names: []string{""}},
+			{StoreName: "mystore", Names: []string{"key1"}, PollIntervalSeconds: -1},
+		} {
+			_, err := watch(t, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		_, err := watch(t, &rtv1.WatchSecretsRequest{StoreName: "nostore", Names: []string{"key1"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown secret", func(t *testing.T) {
+		_, err := watch(t, &rtv1.WatchSecretsRequest{StoreName: "mystore", Names: []string{"key1", "key3"}})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
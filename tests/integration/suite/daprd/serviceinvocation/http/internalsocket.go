@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/http/app"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(internalsocket))
+}
+
+// internalsocket tests that the daprd sidecars sharing a socket directory
+// serve their internal gRPC server on a UNIX domain socket, and invoke each
+// other through it.
+type internalsocket struct {
+	daprd1 *daprd.Daprd
+	daprd2 *daprd.Daprd
+	dir    string
+}
+
+func (i *internalsocket) Setup(t *testing.T) []framework.Option {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping unix socket based test on windows")
+	}
+
+	// The paths of UNIX domain sockets are limited in length.
+	var err error
+	i.dir, err = os.MkdirTemp("", "dapr")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(i.dir) })
+
+	srv := app.New(t,
+		app.WithHandlerFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("world"))
+		}),
+	)
+
+	i.daprd1 = daprd.New(t, daprd.WithInternalGRPCSocketDir(i.dir))
+	i.daprd2 = daprd.New(t,
+		daprd.WithInternalGRPCSocketDir(i.dir),
+		daprd.WithAppPort(srv.Port()),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(srv, i.daprd1, i.daprd2),
+	}
+}
+
+func (i *internalsocket) Run(t *testing.T, ctx context.Context) {
+	i.daprd1.WaitUntilRunning(t, ctx)
+	i.daprd2.WaitUntilRunning(t, ctx)
+
+	for _, d := range []*daprd.Daprd{i.daprd1, i.daprd2} {
+		sockets, err := filepath.Glob(filepath.Join(i.dir, "dapr-internal-*-"+strconv.Itoa(d.InternalGRPCPort())+".socket"))
+		require.NoError(t, err)
+		assert.Len(t, sockets, 1)
+	}
+
+	httpClient := client.HTTP(t)
+	reqURL := fmt.Sprintf("http://localhost:%d/v1.0/invoke/%s/method/hello", i.daprd1.HTTPPort(), i.daprd2.AppID())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	require.NoError(t, err)
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "world", string(body))
+}
@@ -68,7 +68,7 @@ func (s *ssepanic) Run(t *testing.T, ctx context.Context) {
 	require.NoError(t, err)
 
 	assert.JSONEq(t, fmt.Sprintf(
-		`{"errorCode":"ERR_DIRECT_INVOKE","message":"failed to invoke, id: %s, err: rpc error: code = Internal desc = error invoking app channel: no response received from stream"}`,
+		`{"errorCode":"ERR_DIRECT_INVOKE","message":"failed to invoke, id: %s, err: rpc error: code = Internal desc = error invoking app channel: no response received from stream","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_DIRECT_INVOKE"}]}`,
 		s.daprd2.AppID(),
 	), string(b))
 	require.NoError(t, resp.Body.Close())
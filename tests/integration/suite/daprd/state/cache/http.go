@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/sqlite"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(http))
+}
+
+// http tests the read-through cache of a state store over HTTP. The state
+// store is shared by two apps, so that the writes of one app bypass the cache
+// of the other.
+type http struct {
+	daprd1 *daprd.Daprd
+	daprd2 *daprd.Daprd
+}
+
+func (h *http) Setup(t *testing.T) []framework.Option {
+	db := sqlite.New(t,
+		sqlite.WithMetadata("keyPrefix", "none"),
+		sqlite.WithMetadata("cacheTTL", "1h"),
+	)
+
+	h.daprd1 = daprd.New(t, daprd.WithAppID("app1"), daprd.WithResourceFiles(db.GetComponent(t)))
+	h.daprd2 = daprd.New(t, daprd.WithAppID("app2"), daprd.WithResourceFiles(db.GetComponent(t)))
+
+	return []framework.Option{
+		framework.WithProcesses(db, h.daprd1, h.daprd2),
+	}
+}
+
+func (h *http) Run(t *testing.T, ctx context.Context) {
+	h.daprd1.WaitUntilRunning(t, ctx)
+	h.daprd2.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	do := func(t *testing.T, d *daprd.Daprd, method, path, body string, expectedCode int) string {
+		t.Helper()
+		url := fmt.Sprintf("http://%s/v1.0/state/mystore%s", d.HTTPAddress(), path)
+		req, err := nethttp.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, expectedCode, resp.StatusCode, string(b))
+		return string(b)
+	}
+
+	do(t, h.daprd1, nethttp.MethodPost, "", `[{"key": "key1", "value": "value1"}]`, nethttp.StatusNoContent)
+	assert.JSONEq(t, `"value1"`, do(t, h.daprd1, nethttp.MethodGet, "/key1", "", nethttp.StatusOK))
+
+	t.Run("serves cached values", func(t *testing.T) {
+		do(t, h.daprd2, nethttp.MethodPost, "", `[{"key": "key1", "value": "value2"}]`, nethttp.StatusNoContent)
+		assert.JSONEq(t, `"value1"`, do(t, h.daprd1, nethttp.MethodGet, "/key1", "", nethttp.StatusOK))
+		assert.JSONEq(t, `"value2"`, do(t, h.daprd1, nethttp.MethodGet, "/key1?consistency=strong", "", nethttp.StatusOK))
+
+		metrics := h.daprd1.Metrics(t, ctx)
+		assert.True(t, metrics.MatchMetricAndSum(t, 1, "dapr_component_state_cache_hit_count"))
+		assert.True(t, metrics.MatchMetricAndSum(t, 1, "dapr_component_state_cache_miss_count"))
+	})
+
+	t.Run("local writes invalidate the cache", func(t *testing.T) {
+		do(t, h.daprd1, nethttp.MethodPost, "", `[{"key": "key1", "value": "value3"}]`, nethttp.StatusNoContent)
+		assert.JSONEq(t, `"value3"`, do(t, h.daprd1, nethttp.MethodGet, "/key1", "", nethttp.StatusOK))
+
+		do(t, h.daprd1, nethttp.MethodPost, "/transaction", `{
+			"operations": [{"operation": "upsert", "request": {"key": "key1", "value": "value4"}}]
+		}`, nethttp.StatusNoContent)
+		assert.JSONEq(t, `"value4"`, do(t, h.daprd1, nethttp.MethodGet, "/key1", "", nethttp.StatusOK))
+
+		do(t, h.daprd1, nethttp.MethodDelete, "/key1", "", nethttp.StatusNoContent)
+		do(t, h.daprd1, nethttp.MethodGet, "/key1", "", nethttp.StatusNoContent)
+	})
+
+	t.Run("missing keys are cached", func(t *testing.T) {
+		do(t, h.daprd2, nethttp.MethodPost, "", `[{"key": "key1", "value": "value5"}]`, nethttp.StatusNoContent)
+		do(t, h.daprd1, nethttp.MethodGet, "/key1", "", nethttp.StatusNoContent)
+	})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	procdaprd "github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(bulkstream))
+}
+
+// bulkstream tests that the state of the keys of a streamed bulk get is
+// received in chunks, in the order of the keys.
+type bulkstream struct {
+	daprd *procdaprd.Daprd
+}
+
+func (b *bulkstream) Setup(t *testing.T) []framework.Option {
+	b.daprd = procdaprd.New(t, procdaprd.WithInMemoryStateStore("mystore"))
+
+	return []framework.Option{
+		framework.WithProcesses(b.daprd),
+	}
+}
+
+func (b *bulkstream) Run(t *testing.T, ctx context.Context) {
+	b.daprd.WaitUntilRunning(t, ctx)
+
+	client := b.daprd.GRPCClient(t, ctx)
+
+	keys := make([]string, 25)
+	items := make([]*commonv1.StateItem, len(keys))
+	for i := range keys {
+		keys[i] = "key" + strconv.Itoa(i)
+		items[i] = &commonv1.StateItem{Key: keys[i], Value: []byte("value" + strconv.Itoa(i))}
+	}
+	_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{StoreName: "mystore", States: items})
+	require.NoError(t, err)
+
+	stream, err := client.GetBulkStateStreamAlpha1(ctx, &rtv1.GetBulkStateStreamRequest{
+		StoreName: "mystore",
+		Keys:      append(keys, "nokey"),
+		ChunkSize: 10,
+	})
+	require.NoError(t, err)
+
+	var chunks int
+	var got []*rtv1.BulkStateItem
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(resp.GetItems()), 10)
+		got = append(got, resp.GetItems()...)
+		chunks++
+	}
+
+	assert.Equal(t, 3, chunks)
+	require.Len(t, got, 26)
+	for i, key := range keys {
+		assert.Equal(t, key, got[i].GetKey())
+		assert.Equal(t, "value"+strconv.Itoa(i), string(got[i].GetData()))
+		assert.NotEmpty(t, got[i].GetEtag())
+	}
+	assert.Equal(t, "nokey", got[25].GetKey())
+	assert.Empty(t, got[25].GetData())
+}
@@ -465,4 +465,62 @@ func (e *errors) Run(t *testing.T, ctx context.Context) {
 		require.Equal(t, "https://docs.dapr.io/reference/components-reference/supported-state-stores/", help.GetLinks()[0].GetUrl())
 		require.Equal(t, "Check the list of state stores and the features they support", help.GetLinks()[0].GetDescription())
 	})
+
+	// Covers errutils.StateStoreTransactionETagMismatch()
+	t.Run("state transaction etag mismatch", func(t *testing.T) {
+		_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore",
+			States: []*commonv1.StateItem{
+				{Key: "etag-key1", Value: []byte("val1")},
+				{Key: "etag-key2", Value: []byte("val2")},
+			},
+		})
+		require.NoError(t, err)
+		resp, err := client.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "etag-key1"})
+		require.NoError(t, err)
+
+		_, err = client.ExecuteStateTransaction(ctx, &rtv1.ExecuteStateTransactionRequest{
+			StoreName: "mystore",
+			Operations: []*rtv1.TransactionalStateOperation{
+				{
+					OperationType: "upsert",
+					Request:       &commonv1.StateItem{Key: "etag-key1", Value: []byte("val3"), Etag: &commonv1.Etag{Value: resp.GetEtag()}},
+				},
+				{
+					OperationType: "delete",
+					Request:       &commonv1.StateItem{Key: "etag-key2", Etag: &commonv1.Etag{Value: "999"}},
+				},
+			},
+		})
+		require.Error(t, err)
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, grpcCodes.Aborted, s.Code())
+		require.Equal(t, "state store mystore transaction failed: etag mismatch for keys: etag-key2", s.Message())
+
+		var errInfo *errdetails.ErrorInfo
+		var precondition *errdetails.PreconditionFailure
+		for _, detail := range s.Details() {
+			switch d := detail.(type) {
+			case *errdetails.ErrorInfo:
+				errInfo = d
+			case *errdetails.PreconditionFailure:
+				precondition = d
+			}
+		}
+		require.NotNil(t, errInfo, "ErrorInfo should be present")
+		require.Equal(t, "DAPR_STATE_TRANSACTION_ETAG_MISMATCH", errInfo.GetReason())
+		require.Equal(t, "1", errInfo.GetMetadata()["conflictingOperations"])
+
+		require.NotNil(t, precondition, "PreconditionFailure should be present")
+		require.Len(t, precondition.GetViolations(), 1)
+		require.Equal(t, "ETAG_MISMATCH", precondition.GetViolations()[0].GetType())
+		require.Equal(t, "etag-key2", precondition.GetViolations()[0].GetSubject())
+
+		// The transaction was not applied.
+		resp, err = client.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "etag-key1"})
+		require.NoError(t, err)
+		require.Equal(t, "val1", string(resp.GetData()))
+	})
 }
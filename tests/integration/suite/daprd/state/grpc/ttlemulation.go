@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/state"
+	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	procdaprd "github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/grpc/app"
+	"github.com/dapr/dapr/tests/integration/framework/process/scheduler"
+	"github.com/dapr/dapr/tests/integration/framework/process/statestore"
+	"github.com/dapr/dapr/tests/integration/framework/process/statestore/inmemory"
+	"github.com/dapr/dapr/tests/integration/framework/socket"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(ttlemulation))
+}
+
+// ttlemulation tests that state saved with a TTL in a state store without
+// native TTLs is deleted once expired, when the state store enables the
+// ttlEmulation metadata property.
+type ttlemulation struct {
+	daprd     *procdaprd.Daprd
+	scheduler *scheduler.Scheduler
+}
+
+func (e *ttlemulation) Setup(t *testing.T) []framework.Option {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping unix socket based test on windows")
+	}
+
+	socket := socket.New(t)
+
+	storeWithNoTTL := statestore.New(t,
+		statestore.WithSocket(socket),
+		statestore.WithStateStore(inmemory.New(t,
+			inmemory.WithFeatures(state.FeatureETag, state.FeatureTransactional),
+		)),
+	)
+
+	e.scheduler = scheduler.New(t)
+	srv := app.New(t)
+
+	e.daprd = procdaprd.New(t,
+		procdaprd.WithResourceFiles(fmt.Sprintf(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.%[1]s
+  version: v1
+  metadata:
+  - name: ttlEmulation
+    value: "true"
+---
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore-nottl
+spec:
+  type: state.%[1]s
+  version: v1
+`, storeWithNoTTL.SocketName())),
+		procdaprd.WithSocket(t, socket),
+		procdaprd.WithSchedulerAddresses(e.scheduler.Address()),
+		procdaprd.WithAppPort(srv.Port(t)),
+		procdaprd.WithAppProtocol("grpc"),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(storeWithNoTTL, e.scheduler, srv, e.daprd),
+	}
+}
+
+func (e *ttlemulation) Run(t *testing.T, ctx context.Context) {
+	e.scheduler.WaitUntilRunning(t, ctx)
+	e.daprd.WaitUntilRunning(t, ctx)
+
+	client := e.daprd.GRPCClient(t, ctx)
+
+	t.Run("state store without ttls rejects ttl", func(t *testing.T) {
+		_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore-nottl",
+			States:    []*commonv1.StateItem{{Key: "key1", Value: []byte("value1"), TtlInSeconds: new(int64(2))}},
+		})
+		require.Error(t, err)
+		assert.Equal(t, grpcCodes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore",
+			States:    []*commonv1.StateItem{{Key: "key1", Value: []byte("value1"), TtlInSeconds: new(int64(0))}},
+		})
+		require.Error(t, err)
+		assert.Equal(t, grpcCodes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("reserved job names", func(t *testing.T) {
+		_, err := client.ScheduleJob(ctx, &rtv1.ScheduleJobRequest{
+			Job: &rtv1.Job{Name: "dapr.internal.state.ttl.mystore.a2V5", Schedule: new("@daily")},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("expired state is deleted", func(t *testing.T) {
+		_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore",
+			States: []*commonv1.StateItem{
+				{Key: "key1", Value: []byte("value1"), TtlInSeconds: new(int64(2))},
+				{Key: "key2", Value: []byte("value2")},
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err := client.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key1"})
+		require.NoError(t, err)
+		assert.Equal(t, "value1", string(resp.GetData()))
+		require.NotNil(t, resp.TtlInSeconds)
+		assert.LessOrEqual(t, resp.GetTtlInSeconds(), int64(2))
+
+		resp, err = client.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key2"})
+		require.NoError(t, err)
+		assert.Nil(t, resp.TtlInSeconds)
+
+		// The jobs which delete expired state are not jobs of the app.
+		jobs, err := client.ListJobs(ctx, new(rtv1.ListJobsRequest))
+		require.NoError(t, err)
+		assert.Empty(t, jobs.GetJobs())
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			resp, err := client.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key1"})
+			if assert.NoError(c, err) {
+				assert.Empty(c, resp.GetData())
+			}
+		}, 10*time.Second, 10*time.Millisecond)
+
+		resp, err = client.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key2"})
+		require.NoError(t, err)
+		assert.Equal(t, "value2", string(resp.GetData()))
+	})
+
+	t.Run("saving state without ttl cancels expiry", func(t *testing.T) {
+		_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore",
+			States:    []*commonv1.StateItem{{Key: "key3", Value: []byte("value3"), TtlInSeconds: new(int64(2))}},
+		})
+		require.NoError(t, err)
+		_, err = client.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore",
+			States:    []*commonv1.StateItem{{Key: "key3", Value: []byte("value4")}},
+		})
+		require.NoError(t, err)
+
+		time.Sleep(3 * time.Second)
+		resp, err := client.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key3"})
+		require.NoError(t, err)
+		assert.Equal(t, "value4", string(resp.GetData()))
+		assert.Nil(t, resp.TtlInSeconds)
+	})
+}
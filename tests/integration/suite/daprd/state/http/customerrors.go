@@ -128,7 +128,7 @@ func (c *customerrors) Run(t *testing.T, ctx context.Context) {
 	require.NoError(t, err)
 	require.NoError(t, resp.Body.Close())
 	assert.Equal(t, 506, resp.StatusCode)
-	assert.JSONEq(t, `{"errorCode":"ERR_STATE_GET","message":"fail to get key1 from state store mystore: api error: code = DataLoss desc = get-error"}`, string(b))
+	assert.JSONEq(t, `{"errorCode":"ERR_STATE_GET","message":"fail to get key1 from state store mystore: api error: code = DataLoss desc = get-error","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_STATE_GET"}]}`, string(b))
 
 	queryURL := fmt.Sprintf("http://%s/v1.0-alpha1/state/mystore/query", c.daprd.HTTPAddress())
 	query := strings.NewReader(`{"filter":{"EQ":{"state":"CA"}},"sort":[{"key":"person.id","order":"DESC"}]}`)
@@ -151,7 +151,7 @@ func (c *customerrors) Run(t *testing.T, ctx context.Context) {
 	require.NoError(t, err)
 	require.NoError(t, resp.Body.Close())
 	assert.Equal(t, 510, resp.StatusCode)
-	assert.JSONEq(t, `{"errorCode":"ERR_STATE_SAVE","message":"failed saving state in state store mystore: api error: code = Canceled desc = bulkset-error"}`, string(b))
+	assert.JSONEq(t, `{"errorCode":"ERR_STATE_SAVE","message":"failed saving state in state store mystore: api error: code = Canceled desc = bulkset-error","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_STATE_SAVE"}]}`, string(b))
 
 	req, err = nethttp.NewRequestWithContext(ctx, nethttp.MethodDelete, stateURL+"/key1", body)
 	require.NoError(t, err)
@@ -161,7 +161,7 @@ func (c *customerrors) Run(t *testing.T, ctx context.Context) {
 	require.NoError(t, err)
 	require.NoError(t, resp.Body.Close())
 	assert.Equal(t, 511, resp.StatusCode)
-	assert.JSONEq(t, `{"errorCode":"ERR_STATE_DELETE","message":"failed deleting state with key key1: api error: code = OutOfRange desc = delete-error"}`, string(b))
+	assert.JSONEq(t, `{"errorCode":"ERR_STATE_DELETE","message":"failed deleting state with key key1: api error: code = OutOfRange desc = delete-error","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_STATE_DELETE"}]}`, string(b))
 
 	body = strings.NewReader(`[{"key":"key1","value":"value1"}]`)
 	req, err = nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, stateURL, body)
@@ -172,7 +172,7 @@ func (c *customerrors) Run(t *testing.T, ctx context.Context) {
 	require.NoError(t, err)
 	require.NoError(t, resp.Body.Close())
 	assert.Equal(t, 512, resp.StatusCode)
-	assert.JSONEq(t, `{"errorCode":"ERR_STATE_SAVE","message":"failed saving state in state store mystore: api error: code = ResourceExhausted desc = set-error"}`, string(b))
+	assert.JSONEq(t, `{"errorCode":"ERR_STATE_SAVE","message":"failed saving state in state store mystore: api error: code = ResourceExhausted desc = set-error","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"dapr.io","metadata":null,"reason":"ERR_STATE_SAVE"}]}`, string(b))
 
 	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
 		assert.True(collect, c.daprd.Metrics(collect, ctx).MatchMetricAndSum(t, 2, "dapr_error_code_total", "category:state", "error_code:ERR_STATE_SAVE"))
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/sqlite"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(encryptionrotation))
+}
+
+// encryptionrotation tests that values encrypted with a rotated out key are
+// decrypted with it, and re-encrypted with the primary key on read. The state
+// store is shared by a daprd using the old key as primary key, and a daprd
+// using it as secondary key.
+type encryptionrotation struct {
+	db     *sqlite.SQLite
+	daprd1 *daprd.Daprd
+	daprd2 *daprd.Daprd
+}
+
+func (e *encryptionrotation) Setup(t *testing.T) []framework.Option {
+	tmp := t.TempDir()
+	secretsFile := filepath.Join(tmp, "secrets.json")
+	dbPath := filepath.Join(tmp, "state.db")
+
+	secretsJSON := fmt.Sprintf(`{"oldkey": "%s", "newkey": "%s"}`,
+		hex.EncodeToString(generateAesRandom(strings.Repeat("a", 128))),
+		hex.EncodeToString(generateAesRandom(strings.Repeat("b", 128))),
+	)
+	require.NoError(t, os.WriteFile(secretsFile, []byte(secretsJSON), 0o600))
+
+	e.db = sqlite.New(t, sqlite.WithDBPath(dbPath))
+
+	secretStore := fmt.Sprintf(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: secretstore
+spec:
+  type: secretstores.local.file
+  version: v1
+  metadata:
+  - name: secretsFile
+    value: '%s'
+`, secretsFile)
+
+	stateStore := func(keys string) string {
+		return fmt.Sprintf(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.sqlite
+  version: v1
+  metadata:
+  - name: connectionString
+    value: 'file:%s'
+  - name: tableName
+    value: '%s'
+  - name: keyPrefix
+    value: none
+%s
+auth:
+  secretStore: secretstore
+`, dbPath, e.db.TableName(), keys)
+	}
+
+	e.daprd1 = daprd.New(t, daprd.WithResourceFiles(secretStore, stateStore(`  - name: primaryEncryptionKey
+    secretKeyRef:
+      name: oldkey`)))
+	e.daprd2 = daprd.New(t, daprd.WithResourceFiles(secretStore, stateStore(`  - name: primaryEncryptionKey
+    secretKeyRef:
+      name: newkey
+  - name: secondaryEncryptionKey
+    secretKeyRef:
+      name: oldkey
+  - name: encryptionReencryptOnRead
+    value: "true"`)))
+
+	return []framework.Option{
+		framework.WithProcesses(e.db, e.daprd1, e.daprd2),
+	}
+}
+
+func (e *encryptionrotation) Run(t *testing.T, ctx context.Context) {
+	e.daprd1.WaitUntilRunning(t, ctx)
+	e.daprd2.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	do := func(t *testing.T, d *daprd.Daprd, method, path, body string, expectedCode int) string {
+		t.Helper()
+		url := fmt.Sprintf("http://%s/v1.0/state/mystore%s", d.HTTPAddress(), path)
+		req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, expectedCode, resp.StatusCode, string(b))
+		return string(b)
+	}
+
+	keyName := func(t *testing.T) string {
+		t.Helper()
+		var encoded string
+		require.NoError(t, e.db.GetConnection(t).QueryRowContext(ctx,
+			"SELECT value FROM "+e.db.TableName()+" WHERE key = ?", "key1",
+		).Scan(&encoded))
+		raw, err := base64.StdEncoding.DecodeString(encoded)
+		require.NoError(t, err)
+		return string(raw[strings.LastIndex(string(raw), "||")+2:])
+	}
+
+	do(t, e.daprd1, http.MethodPost, "", `[{"key": "key1", "value": "value1"}]`, http.StatusNoContent)
+	assert.Equal(t, "oldkey", keyName(t))
+
+	t.Run("value is re-encrypted with the primary key on read", func(t *testing.T) {
+		assert.Equal(t, "value1", do(t, e.daprd2, http.MethodGet, "/key1", "", http.StatusOK))
+		assert.Equal(t, "newkey", keyName(t))
+		assert.Equal(t, "value1", do(t, e.daprd2, http.MethodGet, "/key1", "", http.StatusOK))
+
+		metrics := e.daprd2.Metrics(t, ctx)
+		assert.True(t, metrics.MatchMetricAndSum(t, 1, "dapr_component_state_encryption_stale_key_count"))
+		assert.True(t, metrics.MatchMetricAndSum(t, 1, "dapr_component_state_encryption_reencrypt_count", "success:true"))
+	})
+
+	t.Run("returned etag is the etag after re-encryption", func(t *testing.T) {
+		do(t, e.daprd1, http.MethodPost, "", `[{"key": "key1", "value": "value2"}]`, http.StatusNoContent)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/v1.0/state/mystore/key1", e.daprd2.HTTPAddress()), nil)
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		etag := resp.Header.Get("ETag")
+		require.NotEmpty(t, etag)
+
+		do(t, e.daprd2, http.MethodPost, "", fmt.Sprintf(`[{"key": "key1", "value": "value3", "etag": "%s"}]`, etag), http.StatusNoContent)
+		assert.Equal(t, "value3", do(t, e.daprd2, http.MethodGet, "/key1", "", http.StatusOK))
+	})
+}
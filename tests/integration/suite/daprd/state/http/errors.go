@@ -48,10 +48,11 @@ type errors struct {
 }
 
 const (
-	ErrInfoType      = "type.googleapis.com/google.rpc.ErrorInfo"
-	ResourceInfoType = "type.googleapis.com/google.rpc.ResourceInfo"
-	BadRequestType   = "type.googleapis.com/google.rpc.BadRequest"
-	HelpType         = "type.googleapis.com/google.rpc.Help"
+	ErrInfoType             = "type.googleapis.com/google.rpc.ErrorInfo"
+	ResourceInfoType        = "type.googleapis.com/google.rpc.ResourceInfo"
+	BadRequestType          = "type.googleapis.com/google.rpc.BadRequest"
+	HelpType                = "type.googleapis.com/google.rpc.Help"
+	PreconditionFailureType = "type.googleapis.com/google.rpc.PreconditionFailure"
 )
 
 func (e *errors) Setup(t *testing.T) []framework.Option {
@@ -571,4 +572,72 @@ func (e *errors) Run(t *testing.T, ctx context.Context) {
 		require.True(t, ok, "Failed to assert link description as a string")
 		require.Equal(t, "Check the list of state stores and the features they support", linkDescription)
 	})
+
+	// Covers errutils.StateStoreTransactionETagMismatch()
+	t.Run("state transaction etag mismatch", func(t *testing.T) {
+		storeName := "mystore"
+		endpoint := fmt.Sprintf("http://localhost:%d/v1.0/state/%s", e.daprd.HTTPPort(), storeName)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(`[{"key": "etag-key1", "value": "val1"}, {"key": "etag-key2", "value": "val2"}]`))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/etag-key1", nil)
+		require.NoError(t, err)
+		resp, err = httpClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		etag := resp.Header.Get("ETag")
+		require.NotEmpty(t, etag)
+
+		payload := fmt.Sprintf(`{"operations": [
+			{"operation": "upsert", "request": {"key": "etag-key1", "value": "val3", "etag": "%s"}},
+			{"operation": "delete", "request": {"key": "etag-key2", "etag": "999"}}
+		]}`, etag)
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/transaction", strings.NewReader(payload))
+		require.NoError(t, err)
+		resp, err = httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		var data map[string]any
+		require.NoError(t, json.Unmarshal(body, &data))
+		require.Equal(t, "ERR_STATE_TRANSACTION", data["errorCode"])
+		require.Equal(t, "state store mystore transaction failed: etag mismatch for keys: etag-key2", data["message"])
+
+		detailsArray, ok := data["details"].([]any)
+		require.True(t, ok)
+
+		var errInfo, precondition map[string]any
+		for _, detail := range detailsArray {
+			d, innerOK := detail.(map[string]any)
+			require.True(t, innerOK)
+			switch d["@type"] {
+			case ErrInfoType:
+				errInfo = d
+			case PreconditionFailureType:
+				precondition = d
+			}
+		}
+
+		require.NotEmptyf(t, errInfo, "ErrorInfo not found in %+v", detailsArray)
+		require.Equal(t, "DAPR_STATE_TRANSACTION_ETAG_MISMATCH", errInfo["reason"])
+		require.Equal(t, map[string]any{"conflictingOperations": "1"}, errInfo["metadata"])
+
+		require.NotEmptyf(t, precondition, "PreconditionFailure not found in %+v", detailsArray)
+		violations, ok := precondition["violations"].([]any)
+		require.True(t, ok)
+		require.Len(t, violations, 1)
+		violation, ok := violations[0].(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, "ETAG_MISMATCH", violation["type"])
+		require.Equal(t, "etag-key2", violation["subject"])
+	})
 }
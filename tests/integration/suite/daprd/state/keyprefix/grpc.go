@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyprefix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/sqlite"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(grpc))
+}
+
+// grpc tests overriding the key prefix strategy of a state store per request
+// over gRPC, to share keys between apps.
+type grpc struct {
+	db     *sqlite.SQLite
+	daprd1 *daprd.Daprd
+	daprd2 *daprd.Daprd
+}
+
+func (g *grpc) Setup(t *testing.T) []framework.Option {
+	g.db = sqlite.New(t, sqlite.WithMetadata("allowedKeyPrefixes", "shared"))
+
+	g.daprd1 = daprd.New(t, daprd.WithAppID("app1"), daprd.WithResourceFiles(g.db.GetComponent(t)))
+	g.daprd2 = daprd.New(t, daprd.WithAppID("app2"), daprd.WithResourceFiles(g.db.GetComponent(t)))
+
+	return []framework.Option{
+		framework.WithProcesses(g.db, g.daprd1, g.daprd2),
+	}
+}
+
+func (g *grpc) Run(t *testing.T, ctx context.Context) {
+	g.daprd1.WaitUntilRunning(t, ctx)
+	g.daprd2.WaitUntilRunning(t, ctx)
+
+	client1 := g.daprd1.GRPCClient(t, ctx)
+	client2 := g.daprd2.GRPCClient(t, ctx)
+	shared := map[string]string{"keyPrefix": "shared"}
+
+	_, err := client1.SaveState(ctx, &rtv1.SaveStateRequest{
+		StoreName: "mystore",
+		States: []*commonv1.StateItem{
+			{Key: "key1", Value: []byte("shared-value"), Metadata: shared},
+			{Key: "key1", Value: []byte("app1-value")},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("read shared key from another app", func(t *testing.T) {
+		resp, err := client2.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key1", Metadata: shared})
+		require.NoError(t, err)
+		assert.Equal(t, "shared-value", string(resp.GetData()))
+
+		resp, err = client2.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key1"})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetData())
+
+		resp, err = client1.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key1"})
+		require.NoError(t, err)
+		assert.Equal(t, "app1-value", string(resp.GetData()))
+
+		bulk, err := client2.GetBulkState(ctx, &rtv1.GetBulkStateRequest{StoreName: "mystore", Keys: []string{"key1"}, Metadata: shared})
+		require.NoError(t, err)
+		require.Len(t, bulk.GetItems(), 1)
+		assert.Equal(t, "key1", bulk.GetItems()[0].GetKey())
+		assert.Equal(t, "shared-value", string(bulk.GetItems()[0].GetData()))
+	})
+
+	t.Run("key is saved with the overridden prefix", func(t *testing.T) {
+		var count int
+		require.NoError(t, g.db.GetConnection(t).QueryRowContext(ctx,
+			"SELECT COUNT(*) FROM "+g.db.TableName()+" WHERE key IN ('shared||key1', 'app1||key1')",
+		).Scan(&count))
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("transaction operations override the request", func(t *testing.T) {
+		_, err := client2.ExecuteStateTransaction(ctx, &rtv1.ExecuteStateTransactionRequest{
+			StoreName: "mystore",
+			Metadata:  shared,
+			Operations: []*rtv1.TransactionalStateOperation{
+				{OperationType: "upsert", Request: &commonv1.StateItem{Key: "key2", Value: []byte("shared-value2")}},
+				{OperationType: "upsert", Request: &commonv1.StateItem{Key: "key2", Value: []byte("app2-value2"), Metadata: map[string]string{"keyPrefix": "appid"}}},
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err := client1.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key2", Metadata: shared})
+		require.NoError(t, err)
+		assert.Equal(t, "shared-value2", string(resp.GetData()))
+
+		resp, err = client2.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key2"})
+		require.NoError(t, err)
+		assert.Equal(t, "app2-value2", string(resp.GetData()))
+	})
+
+	t.Run("delete shared key", func(t *testing.T) {
+		_, err := client2.DeleteState(ctx, &rtv1.DeleteStateRequest{StoreName: "mystore", Key: "key2", Metadata: shared})
+		require.NoError(t, err)
+
+		resp, err := client1.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key2", Metadata: shared})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetData())
+	})
+
+	t.Run("key prefix not allowed", func(t *testing.T) {
+		_, err := client2.GetState(ctx, &rtv1.GetStateRequest{StoreName: "mystore", Key: "key1", Metadata: map[string]string{"keyPrefix": "app1"}})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "key prefix 'app1' is not allowed for state store mystore")
+
+		_, err = client2.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore",
+			States:    []*commonv1.StateItem{{Key: "key1", Value: []byte("x"), Metadata: map[string]string{"keyPrefix": "none"}}},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyprefix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/sqlite"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(http))
+}
+
+// http tests overriding the key prefix strategy of a state store per request
+// over HTTP, to share keys between apps.
+type http struct {
+	daprd1 *daprd.Daprd
+	daprd2 *daprd.Daprd
+}
+
+func (h *http) Setup(t *testing.T) []framework.Option {
+	db := sqlite.New(t, sqlite.WithMetadata("allowedKeyPrefixes", "shared,none"))
+
+	h.daprd1 = daprd.New(t, daprd.WithAppID("app1"), daprd.WithResourceFiles(db.GetComponent(t)))
+	h.daprd2 = daprd.New(t, daprd.WithAppID("app2"), daprd.WithResourceFiles(db.GetComponent(t)))
+
+	return []framework.Option{
+		framework.WithProcesses(db, h.daprd1, h.daprd2),
+	}
+}
+
+func (h *http) Run(t *testing.T, ctx context.Context) {
+	h.daprd1.WaitUntilRunning(t, ctx)
+	h.daprd2.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	do := func(t *testing.T, d *daprd.Daprd, method, path, body string, expectedCode int) string {
+		t.Helper()
+		url := fmt.Sprintf("http://%s/v1.0/state/mystore%s", d.HTTPAddress(), path)
+		req, err := nethttp.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, expectedCode, resp.StatusCode, string(b))
+		return string(b)
+	}
+
+	do(t, h.daprd1, nethttp.MethodPost, "", `[
+		{"key": "key1", "value": "shared-value", "metadata": {"keyPrefix": "shared"}},
+		{"key": "key1", "value": "app1-value"}
+	]`, nethttp.StatusNoContent)
+
+	t.Run("read shared key from another app", func(t *testing.T) {
+		assert.JSONEq(t, `"shared-value"`, do(t, h.daprd2, nethttp.MethodGet, "/key1?metadata.keyPrefix=shared", "", nethttp.StatusOK))
+		do(t, h.daprd2, nethttp.MethodGet, "/key1", "", nethttp.StatusNoContent)
+		assert.JSONEq(t, `"app1-value"`, do(t, h.daprd1, nethttp.MethodGet, "/key1", "", nethttp.StatusOK))
+
+		var bulk []struct {
+			Key  string `json:"key"`
+			Data string `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(
+			do(t, h.daprd2, nethttp.MethodPost, "/bulk", `{"keys": ["key1"], "metadata": {"keyPrefix": "shared"}}`, nethttp.StatusOK),
+		), &bulk))
+		require.Len(t, bulk, 1)
+		assert.Equal(t, "key1", bulk[0].Key)
+		assert.Equal(t, "shared-value", bulk[0].Data)
+	})
+
+	t.Run("transaction operations override the request", func(t *testing.T) {
+		do(t, h.daprd2, nethttp.MethodPost, "/transaction", `{
+			"metadata": {"keyPrefix": "shared"},
+			"operations": [
+				{"operation": "upsert", "request": {"key": "key2", "value": "shared-value2"}},
+				{"operation": "upsert", "request": {"key": "key2", "value": "unprefixed-value2", "metadata": {"keyPrefix": "none"}}}
+			]
+		}`, nethttp.StatusNoContent)
+
+		assert.JSONEq(t, `"shared-value2"`, do(t, h.daprd1, nethttp.MethodGet, "/key2?metadata.keyPrefix=shared", "", nethttp.StatusOK))
+		assert.JSONEq(t, `"unprefixed-value2"`, do(t, h.daprd1, nethttp.MethodGet, "/key2?metadata.keyPrefix=none", "", nethttp.StatusOK))
+	})
+
+	t.Run("delete shared key", func(t *testing.T) {
+		do(t, h.daprd1, nethttp.MethodDelete, "/key2?metadata.keyPrefix=shared", "", nethttp.StatusNoContent)
+		do(t, h.daprd2, nethttp.MethodGet, "/key2?metadata.keyPrefix=shared", "", nethttp.StatusNoContent)
+	})
+
+	t.Run("key prefix not allowed", func(t *testing.T) {
+		body := do(t, h.daprd2, nethttp.MethodGet, "/key1?metadata.keyPrefix=app1", "", nethttp.StatusForbidden)
+		var errResp map[string]any
+		require.NoError(t, json.Unmarshal([]byte(body), &errResp))
+		assert.Equal(t, "ERR_STATE_KEY_PREFIX_NOT_ALLOWED", errResp["errorCode"])
+		assert.Equal(t, "key prefix 'app1' is not allowed for state store mystore", errResp["message"])
+
+		do(t, h.daprd2, nethttp.MethodPost, "", `[{"key": "key1", "value": "x", "metadata": {"keyPrefix": "app1"}}]`, nethttp.StatusForbidden)
+		do(t, h.daprd2, nethttp.MethodGet, "/key1?metadata.keyPrefix=a||b", "", nethttp.StatusBadRequest)
+	})
+}
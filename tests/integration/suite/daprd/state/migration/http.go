@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(http))
+}
+
+// http tests that the state of the app is copied from a state store to
+// another over the HTTP API, with the progress reported on the metadata API.
+type http struct {
+	daprd *daprd.Daprd
+}
+
+func (h *http) Setup(t *testing.T) []framework.Option {
+	h.daprd = daprd.New(t,
+		daprd.WithDaprAPIToken(t, "mytoken"),
+		daprd.WithResourceFiles(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: source
+spec:
+  type: state.in-memory
+  version: v1
+  metadata:
+  - name: queryIndex
+    value: "true"
+`, `apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: target
+spec:
+  type: state.in-memory
+  version: v1
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(h.daprd),
+	}
+}
+
+func (h *http) Run(t *testing.T, ctx context.Context) {
+	h.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	do := func(t *testing.T, method, path, body string, expectedCode int) []byte {
+		t.Helper()
+		req, err := nethttp.NewRequestWithContext(ctx, method, fmt.Sprintf("http://%s%s", h.daprd.HTTPAddress(), path), strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("dapr-api-token", "mytoken")
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, expectedCode, resp.StatusCode, string(b))
+		return b
+	}
+
+	do(t, nethttp.MethodPost, "/v1.0/state/source", `[{"key": "a", "value": "1"}, {"key": "b", "value": "2"}, {"key": "c", "value": "3"}]`, nethttp.StatusNoContent)
+	do(t, nethttp.MethodPost, "/v1.0/state/target", `[{"key": "c", "value": "old"}]`, nethttp.StatusNoContent)
+
+	type migration struct {
+		ID          string `json:"id"`
+		DryRun      bool   `json:"dryRun"`
+		Status      string `json:"status"`
+		KeysTotal   int64  `json:"keysTotal"`
+		KeysCopied  int64  `json:"keysCopied"`
+		KeysSkipped int64  `json:"keysSkipped"`
+	}
+
+	migrate := func(t *testing.T, body string) migration {
+		t.Helper()
+		var m migration
+		require.NoError(t, json.Unmarshal(do(t, nethttp.MethodPost, "/v1.0-alpha1/state/source/migrate", body, nethttp.StatusOK), &m))
+		assert.Equal(t, "COMPLETED", m.Status)
+		assert.Equal(t, int64(3), m.KeysTotal)
+		return m
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		m := migrate(t, `{"targetStoreName": "target", "dryRun": true}`)
+		assert.Equal(t, int64(2), m.KeysCopied)
+		assert.Equal(t, int64(1), m.KeysSkipped)
+		do(t, nethttp.MethodGet, "/v1.0/state/target/a", "", nethttp.StatusNoContent)
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		m := migrate(t, `{"targetStoreName": "target", "keysPerSecond": 100}`)
+		assert.Equal(t, int64(2), m.KeysCopied)
+		assert.Equal(t, int64(1), m.KeysSkipped)
+		assert.JSONEq(t, `"1"`, string(do(t, nethttp.MethodGet, "/v1.0/state/target/a", "", nethttp.StatusOK)))
+		assert.JSONEq(t, `"2"`, string(do(t, nethttp.MethodGet, "/v1.0/state/target/b", "", nethttp.StatusOK)))
+		assert.JSONEq(t, `"old"`, string(do(t, nethttp.MethodGet, "/v1.0/state/target/c", "", nethttp.StatusOK)))
+
+		m = migrate(t, `{"targetStoreName": "target", "overwrite": true}`)
+		assert.Equal(t, int64(3), m.KeysCopied)
+		assert.JSONEq(t, `"3"`, string(do(t, nethttp.MethodGet, "/v1.0/state/target/c", "", nethttp.StatusOK)))
+	})
+
+	t.Run("migrations are reported on the metadata api", func(t *testing.T) {
+		var md struct {
+			StateMigrations []migration `json:"stateMigrations"`
+		}
+		require.NoError(t, json.Unmarshal(do(t, nethttp.MethodGet, "/v1.0/metadata", "", nethttp.StatusOK), &md))
+		require.Len(t, md.StateMigrations, 3)
+		assert.True(t, md.StateMigrations[0].DryRun)
+		for _, m := range md.StateMigrations {
+			assert.Equal(t, "COMPLETED", m.Status)
+			assert.NotEmpty(t, m.ID)
+		}
+	})
+
+	t.Run("target state store must be listable to migrate back", func(t *testing.T) {
+		do(t, nethttp.MethodPost, "/v1.0-alpha1/state/target/migrate", `{"targetStoreName": "source"}`, nethttp.StatusNotImplemented)
+	})
+}
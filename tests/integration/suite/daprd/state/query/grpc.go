@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(grpc))
+}
+
+// grpc tests querying state stores without native query support over their
+// query index, federated across state stores.
+type grpc struct {
+	daprd *daprd.Daprd
+}
+
+func (g *grpc) Setup(t *testing.T) []framework.Option {
+	component := func(name string) string {
+		return fmt.Sprintf(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: %s
+spec:
+  type: state.in-memory
+  version: v1
+  metadata:
+  - name: queryIndex
+    value: "true"
+`, name)
+	}
+
+	g.daprd = daprd.New(t, daprd.WithResourceFiles(
+		component("east"),
+		component("west"),
+		`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: noindex
+spec:
+  type: state.in-memory
+  version: v1
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(g.daprd),
+	}
+}
+
+func (g *grpc) Run(t *testing.T, ctx context.Context) {
+	g.daprd.WaitUntilRunning(t, ctx)
+
+	client := g.daprd.GRPCClient(t, ctx)
+
+	_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+		StoreName: "east",
+		States: []*commonv1.StateItem{
+			{Key: "e1", Value: []byte(`{"city":"Seattle","n":1}`)},
+			{Key: "e2", Value: []byte(`{"city":"Seattle","n":4}`)},
+			{Key: "e3", Value: []byte(`{"city":"Portland","n":5}`)},
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.ExecuteStateTransaction(ctx, &rtv1.ExecuteStateTransactionRequest{
+		StoreName: "west",
+		Operations: []*rtv1.TransactionalStateOperation{
+			{OperationType: "upsert", Request: &commonv1.StateItem{Key: "w1", Value: []byte(`{"city":"Seattle","n":2}`)}},
+			{OperationType: "upsert", Request: &commonv1.StateItem{Key: "w2", Value: []byte(`{"city":"Seattle","n":3}`)}},
+			{OperationType: "upsert", Request: &commonv1.StateItem{Key: "w3", Value: []byte(`{"city":"Seattle","n":6}`)}},
+			{OperationType: "delete", Request: &commonv1.StateItem{Key: "w3"}},
+		},
+	})
+	require.NoError(t, err)
+
+	query := func(t *testing.T, q string) ([]string, string) {
+		t.Helper()
+		resp, err := client.QueryStateBeta1(ctx, &rtv1.QueryStateRequest{
+			StoreName:  "east",
+			StoreNames: []string{"west"},
+			Query:      q,
+		})
+		require.NoError(t, err)
+		var keys []string
+		for _, r := range resp.GetResults() {
+			keys = append(keys, r.GetStoreName()+"/"+r.GetKey())
+		}
+		return keys, resp.GetToken()
+	}
+
+	t.Run("sorted across state stores", func(t *testing.T) {
+		const q = `{"filter": {"EQ": {"city": "Seattle"}}, "sort": [{"key": "n"}], "page": {"limit": 3%s}}`
+
+		keys, token := query(t, fmt.Sprintf(q, ""))
+		assert.Equal(t, []string{"east/e1", "west/w1", "west/w2"}, keys)
+		require.NotEmpty(t, token)
+
+		keys, token = query(t, fmt.Sprintf(q, `, "token": "`+token+`"`))
+		assert.Equal(t, []string{"east/e2"}, keys)
+		assert.Empty(t, token)
+	})
+
+	t.Run("unsorted concatenates state stores", func(t *testing.T) {
+		keys, token := query(t, `{}`)
+		assert.Equal(t, []string{"east/e1", "east/e2", "east/e3", "west/w1", "west/w2"}, keys)
+		assert.Empty(t, token)
+	})
+
+	t.Run("deleted keys are removed from the index", func(t *testing.T) {
+		_, err := client.DeleteBulkState(ctx, &rtv1.DeleteBulkStateRequest{
+			StoreName: "east",
+			States:    []*commonv1.StateItem{{Key: "e1"}, {Key: "e2"}},
+		})
+		require.NoError(t, err)
+
+		keys, _ := query(t, `{"sort": [{"key": "n", "order": "DESC"}]}`)
+		assert.Equal(t, []string{"east/e3", "west/w2", "west/w1"}, keys)
+	})
+
+	t.Run("token of another query is rejected", func(t *testing.T) {
+		_, token := query(t, `{"page": {"limit": 1}}`)
+		require.NotEmpty(t, token)
+
+		_, err := client.QueryStateBeta1(ctx, &rtv1.QueryStateRequest{
+			StoreName: "east",
+			Query:     `{"page": {"limit": 1, "token": "` + token + `"}}`,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("state store without query index", func(t *testing.T) {
+		_, err := client.QueryStateBeta1(ctx, &rtv1.QueryStateRequest{
+			StoreName: "noindex",
+			Query:     `{}`,
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(http))
+}
+
+// http tests the HTTP API of the state query beta API.
+type http struct {
+	daprd *daprd.Daprd
+}
+
+func (h *http) Setup(t *testing.T) []framework.Option {
+	h.daprd = daprd.New(t, daprd.WithResourceFiles(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.in-memory
+  version: v1
+  metadata:
+  - name: queryIndex
+    value: "true"
+`, `apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: other
+spec:
+  type: state.in-memory
+  version: v1
+  metadata:
+  - name: queryIndex
+    value: "true"
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(h.daprd),
+	}
+}
+
+func (h *http) Run(t *testing.T, ctx context.Context) {
+	h.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	h.daprd.HTTPPost2xx(t, ctx, "/v1.0/state/mystore", strings.NewReader(
+		`[{"key": "a", "value": {"person": {"age": 30}}}, {"key": "b", "value": {"person": {"age": 20}}}, {"key": "c", "value": {"person": {"age": 40}}}]`,
+	))
+	h.daprd.HTTPPost2xx(t, ctx, "/v1.0/state/other/transaction", strings.NewReader(
+		`{"operations": [{"operation": "upsert", "request": {"key": "d", "value": {"person": {"age": 25}}}}]}`,
+	))
+
+	type queryResponse struct {
+		Results []struct {
+			Key       string          `json:"key"`
+			Data      json.RawMessage `json:"data"`
+			ETag      string          `json:"etag"`
+			StoreName string          `json:"storeName"`
+		} `json:"results"`
+		Token string `json:"token"`
+	}
+
+	query := func(t *testing.T, path, body string, expectedCode int) (queryResponse, string) {
+		t.Helper()
+		url := fmt.Sprintf("http://%s/v1.0-beta1/state/%s", h.daprd.HTTPAddress(), path)
+		req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, url, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, expectedCode, resp.StatusCode, string(b))
+
+		var qresp queryResponse
+		if expectedCode == nethttp.StatusOK {
+			require.NoError(t, json.Unmarshal(b, &qresp))
+		}
+		return qresp, string(b)
+	}
+
+	t.Run("sort and paginate", func(t *testing.T) {
+		body := `{"sort": [{"key": "person.age", "order": "DESC"}], "page": {"limit": 2}}`
+		resp, _ := query(t, "mystore/query", body, nethttp.StatusOK)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "c", resp.Results[0].Key)
+		assert.Equal(t, "mystore", resp.Results[0].StoreName)
+		assert.JSONEq(t, `{"person": {"age": 40}}`, string(resp.Results[0].Data))
+		assert.NotEmpty(t, resp.Results[0].ETag)
+		assert.Equal(t, "a", resp.Results[1].Key)
+		require.NotEmpty(t, resp.Token)
+
+		body = `{"sort": [{"key": "person.age", "order": "DESC"}], "page": {"limit": 2, "token": "` + resp.Token + `"}}`
+		resp, _ = query(t, "mystore/query", body, nethttp.StatusOK)
+		require.Len(t, resp.Results, 1)
+		assert.Equal(t, "b", resp.Results[0].Key)
+		assert.Empty(t, resp.Token)
+	})
+
+	t.Run("federate", func(t *testing.T) {
+		resp, _ := query(t, "mystore/query?federate=other", `{"filter": {"LT": {"person.age": 30}}, "sort": [{"key": "person.age"}]}`, nethttp.StatusOK)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "b", resp.Results[0].Key)
+		assert.Equal(t, "mystore", resp.Results[0].StoreName)
+		assert.Equal(t, "d", resp.Results[1].Key)
+		assert.Equal(t, "other", resp.Results[1].StoreName)
+	})
+
+	t.Run("empty page", func(t *testing.T) {
+		resp, _ := query(t, "mystore/query", `{"filter": {"EQ": {"person.age": 99}}}`, nethttp.StatusOK)
+		assert.Empty(t, resp.Results)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, body := query(t, "mystore/query", `{"filter": {"EQ": {"person.age": 30}}, "limit": 1}`, nethttp.StatusBadRequest)
+		assert.Contains(t, body, "DAPR_STATE_QUERY_INVALID")
+	})
+
+	t.Run("deleted key", func(t *testing.T) {
+		h.daprd.HTTPDelete2xx(t, ctx, "/v1.0/state/mystore/c", nil)
+		resp, _ := query(t, "mystore/query", `{"sort": [{"key": "person.age"}]}`, nethttp.StatusOK)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "b", resp.Results[0].Key)
+		assert.Equal(t, "a", resp.Results[1].Key)
+	})
+}
@@ -14,6 +14,11 @@ limitations under the License.
 package state
 
 import (
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/state/cache"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/state/grpc"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/state/http"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/state/keyprefix"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/state/migration"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/state/query"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/state/watch"
 )
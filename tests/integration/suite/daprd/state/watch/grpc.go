@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(grpc))
+}
+
+// grpc tests watching keys of a state store for changes over gRPC.
+type grpc struct {
+	daprd *daprd.Daprd
+}
+
+func (g *grpc) Setup(t *testing.T) []framework.Option {
+	g.daprd = daprd.New(t, daprd.WithResourceFiles(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.in-memory
+  version: v1
+  metadata:
+  - name: queryIndex
+    value: "true"
+  - name: watchPollInterval
+    value: 100ms
+---
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: noindex
+spec:
+  type: state.in-memory
+  version: v1
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(g.daprd),
+	}
+}
+
+func (g *grpc) Run(t *testing.T, ctx context.Context) {
+	g.daprd.WaitUntilRunning(t, ctx)
+
+	client := g.daprd.GRPCClient(t, ctx)
+
+	_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+		StoreName: "mystore",
+		States: []*commonv1.StateItem{
+			{Key: "key1", Value: []byte("value1")},
+			{Key: "order-1", Value: []byte("value1")},
+		},
+	})
+	require.NoError(t, err)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	stream, err := client.WatchStateAlpha1(watchCtx, &rtv1.WatchStateRequest{
+		StoreName:   "mystore",
+		Keys:        []string{"key1", "key2"},
+		KeyPrefixes: []string{"order-"},
+	})
+	require.NoError(t, err)
+
+	// The watch is established.
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetChanges())
+
+	changes := make(map[string]*rtv1.StateChange)
+	recv := func(t *testing.T, n int) {
+		t.Helper()
+		clear(changes)
+		for len(changes) < n {
+			resp, err := stream.Recv()
+			require.NoError(t, err)
+			for _, change := range resp.GetChanges() {
+				changes[change.GetKey()] = change
+			}
+		}
+	}
+
+	t.Run("upserts", func(t *testing.T) {
+		_, err := client.SaveState(ctx, &rtv1.SaveStateRequest{
+			StoreName: "mystore",
+			States: []*commonv1.StateItem{
+				{Key: "key2", Value: []byte("value2")},
+				{Key: "order-2", Value: []byte("value2")},
+				{Key: "other", Value: []byte("value2")},
+			},
+		})
+		require.NoError(t, err)
+
+		recv(t, 2)
+		require.Contains(t, changes, "key2")
+		assert.Equal(t, "upsert", changes["key2"].GetOperation())
+		assert.Equal(t, "value2", string(changes["key2"].GetData()))
+		assert.NotEmpty(t, changes["key2"].GetEtag())
+		require.Contains(t, changes, "order-2")
+		assert.NotContains(t, changes, "other")
+	})
+
+	t.Run("deletes", func(t *testing.T) {
+		_, err := client.DeleteState(ctx, &rtv1.DeleteStateRequest{StoreName: "mystore", Key: "key1"})
+		require.NoError(t, err)
+		_, err = client.DeleteState(ctx, &rtv1.DeleteStateRequest{StoreName: "mystore", Key: "order-1"})
+		require.NoError(t, err)
+
+		recv(t, 2)
+		assert.Equal(t, "delete", changes["key1"].GetOperation())
+		assert.Empty(t, changes["key1"].GetData())
+		assert.Equal(t, "delete", changes["order-1"].GetOperation())
+	})
+
+	t.Run("stream ends when canceled", func(t *testing.T) {
+		cancel()
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			_, err := stream.Recv()
+			assert.Equal(c, codes.Canceled, status.Code(err))
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, tc := range []struct {
+			req  *rtv1.WatchStateRequest
+			code codes.Code
+		}{
+			{&rtv1.WatchStateRequest{StoreName: "mystore"}, codes.InvalidArgument},
+			{&rtv1.WatchStateRequest{StoreName: "mystore", Keys: []string{"a||b"}}, codes.InvalidArgument},
+			{&rtv1.WatchStateRequest{StoreName: "noindex", KeyPrefixes: []string{"order-"}}, codes.Unimplemented},
+			{&rtv1.WatchStateRequest{StoreName: "doesnotexist", Keys: []string{"key1"}}, codes.InvalidArgument},
+		} {
+			stream, err := client.WatchStateAlpha1(ctx, tc.req)
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			assert.Equal(t, tc.code, status.Code(err), tc.req.String())
+		}
+	})
+}
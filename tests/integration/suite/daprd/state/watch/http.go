@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(http))
+}
+
+// http tests watching keys of a state store for changes over server-sent
+// events.
+type http struct {
+	daprd *daprd.Daprd
+}
+
+func (h *http) Setup(t *testing.T) []framework.Option {
+	h.daprd = daprd.New(t, daprd.WithResourceFiles(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.in-memory
+  version: v1
+  metadata:
+  - name: watchPollInterval
+    value: 100ms
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(h.daprd),
+	}
+}
+
+func (h *http) Run(t *testing.T, ctx context.Context) {
+	h.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	h.daprd.HTTPPost2xx(t, ctx, "/v1.0/state/mystore", strings.NewReader(`[{"key": "key1", "value": {"n": 1}}]`))
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	url := fmt.Sprintf("http://%s/v1.0-alpha1/state/mystore/watch?key=key1&key=key2", h.daprd.HTTPAddress())
+	req, err := nethttp.NewRequestWithContext(watchCtx, nethttp.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	require.Equal(t, nethttp.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	type change struct {
+		Key       string          `json:"key"`
+		Operation string          `json:"operation"`
+		Data      json.RawMessage `json:"data"`
+		ETag      string          `json:"etag"`
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	next := func(t *testing.T) []change {
+		t.Helper()
+		for scanner.Scan() {
+			data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+			if !ok {
+				continue
+			}
+			var event struct {
+				Changes []change `json:"changes"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(data), &event))
+			return event.Changes
+		}
+		require.FailNow(t, "stream ended", scanner.Err())
+		return nil
+	}
+
+	// The watch is established.
+	assert.Empty(t, next(t))
+
+	t.Run("upsert", func(t *testing.T) {
+		h.daprd.HTTPPost2xx(t, ctx, "/v1.0/state/mystore", strings.NewReader(`[{"key": "key2", "value": {"n": 2}}, {"key": "other", "value": {"n": 2}}]`))
+		changes := next(t)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "key2", changes[0].Key)
+		assert.Equal(t, "upsert", changes[0].Operation)
+		assert.JSONEq(t, `{"n": 2}`, string(changes[0].Data))
+		assert.NotEmpty(t, changes[0].ETag)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		h.daprd.HTTPDelete2xx(t, ctx, "/v1.0/state/mystore/key1", nil)
+		changes := next(t)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "key1", changes[0].Key)
+		assert.Equal(t, "delete", changes[0].Operation)
+		assert.Empty(t, changes[0].Data)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		url := fmt.Sprintf("http://%s/v1.0-alpha1/state/mystore/watch?prefix=key", h.daprd.HTTPAddress())
+		req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, url, nil)
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, nethttp.StatusNotImplemented, resp.StatusCode)
+		assert.Contains(t, string(b), "DAPR_STATE_WATCH_NOT_SUPPORTED")
+	})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(grpcclient))
+}
+
+type grpcclient struct {
+	workflow *workflow.Workflow
+}
+
+func (g *grpcclient) Setup(t *testing.T) []framework.Option {
+	g.workflow = workflow.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(g.workflow),
+	}
+}
+
+func (g *grpcclient) Run(t *testing.T, ctx context.Context) {
+	g.workflow.WaitUntilRunning(t, ctx)
+
+	g.workflow.Registry().AddWorkflowN("waiter", func(ctx *task.WorkflowContext) (any, error) {
+		ctx.WaitForSingleEvent("abc", time.Minute).Await(nil)
+		return nil, nil
+	})
+
+	cl := g.workflow.BackendClient(t, ctx)
+	id, err := cl.ScheduleNewWorkflow(ctx, "waiter", api.WithInstanceID("audited"))
+	require.NoError(t, err)
+	_, err = cl.WaitForWorkflowStart(ctx, id)
+	require.NoError(t, err)
+
+	gclient := g.workflow.Dapr().GRPCClient(t, ctx)
+
+	_, err = gclient.PauseWorkflowBeta1(ctx, &rtv1.PauseWorkflowRequest{
+		InstanceId:        id.String(),
+		WorkflowComponent: "dapr",
+		Reason:            "incident 42",
+		Actor:             "alice",
+	})
+	require.NoError(t, err)
+
+	_, err = gclient.ResumeWorkflowBeta1(ctx, &rtv1.ResumeWorkflowRequest{
+		InstanceId:        id.String(),
+		WorkflowComponent: "dapr",
+	})
+	require.NoError(t, err)
+
+	_, err = gclient.TerminateWorkflowBeta1(ctx, &rtv1.TerminateWorkflowRequest{
+		InstanceId:        id.String(),
+		WorkflowComponent: "dapr",
+		Reason:            "duplicate order",
+		Actor:             "bob",
+	})
+	require.NoError(t, err)
+
+	meta, err := cl.WaitForWorkflowCompletion(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.RUNTIME_STATUS_TERMINATED, meta.GetRuntimeStatus())
+
+	resp, err := gclient.GetWorkflowBeta1(ctx, &rtv1.GetWorkflowRequest{
+		InstanceId:        id.String(),
+		WorkflowComponent: "dapr",
+	})
+	require.NoError(t, err)
+
+	interventions := resp.GetInterventions()
+	require.Len(t, interventions, 3)
+	assert.Equal(t, "pause", interventions[0].GetType())
+	assert.Equal(t, "incident 42", interventions[0].GetReason())
+	assert.Equal(t, "alice", interventions[0].GetActor())
+	assert.Equal(t, "resume", interventions[1].GetType())
+	assert.Empty(t, interventions[1].GetReason())
+	assert.Empty(t, interventions[1].GetActor())
+	assert.Equal(t, "terminate", interventions[2].GetType())
+	assert.Equal(t, "duplicate order", interventions[2].GetReason())
+	assert.Equal(t, "bob", interventions[2].GetActor())
+
+	for i := 1; i < len(interventions); i++ {
+		assert.False(t, interventions[i].GetTimestamp().AsTime().Before(interventions[i-1].GetTimestamp().AsTime()))
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(httpclient))
+}
+
+type httpclient struct {
+	workflow *workflow.Workflow
+}
+
+func (h *httpclient) Setup(t *testing.T) []framework.Option {
+	h.workflow = workflow.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(h.workflow),
+	}
+}
+
+func (h *httpclient) Run(t *testing.T, ctx context.Context) {
+	h.workflow.WaitUntilRunning(t, ctx)
+
+	h.workflow.Registry().AddWorkflowN("waiter", func(ctx *task.WorkflowContext) (any, error) {
+		ctx.WaitForSingleEvent("abc", time.Minute).Await(nil)
+		return nil, nil
+	})
+
+	cl := h.workflow.BackendClient(t, ctx)
+	id, err := cl.ScheduleNewWorkflow(ctx, "waiter", api.WithInstanceID("audited"))
+	require.NoError(t, err)
+	_, err = cl.WaitForWorkflowStart(ctx, id)
+	require.NoError(t, err)
+
+	httpClient := client.HTTP(t)
+	baseURL := fmt.Sprintf("http://localhost:%d/v1.0-beta1/workflows/dapr/%s", h.workflow.Dapr().HTTPPort(), id)
+
+	post := func(path, body string) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/"+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	}
+
+	post("pause", `{"reason":"incident 42","actor":"alice"}`)
+	post("resume", `{"reason":"fixed","actor":"alice"}`)
+	post("terminate", `{"actor":"bob"}`)
+
+	meta, err := cl.WaitForWorkflowCompletion(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.RUNTIME_STATUS_TERMINATED, meta.GetRuntimeStatus())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL, nil)
+	require.NoError(t, err)
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var got struct {
+		Interventions []struct {
+			Type      string    `json:"type"`
+			Reason    string    `json:"reason"`
+			Actor     string    `json:"actor"`
+			Timestamp time.Time `json:"timestamp"`
+		} `json:"interventions"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+
+	require.Len(t, got.Interventions, 3)
+	assert.Equal(t, "pause", got.Interventions[0].Type)
+	assert.Equal(t, "incident 42", got.Interventions[0].Reason)
+	assert.Equal(t, "alice", got.Interventions[0].Actor)
+	assert.Equal(t, "resume", got.Interventions[1].Type)
+	assert.Equal(t, "fixed", got.Interventions[1].Reason)
+	assert.Equal(t, "alice", got.Interventions[1].Actor)
+	assert.Equal(t, "terminate", got.Interventions[2].Type)
+	assert.Empty(t, got.Interventions[2].Reason)
+	assert.Equal(t, "bob", got.Interventions[2].Actor)
+	for _, i := range got.Interventions {
+		assert.False(t, i.Timestamp.IsZero())
+	}
+}
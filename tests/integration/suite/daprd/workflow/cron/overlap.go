@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(overlap))
+}
+
+// overlap tests the overlap policies of a scheduled workflow whose previous
+// run is still running when the next run is due.
+type overlap struct {
+	workflow *workflow.Workflow
+}
+
+func (o *overlap) Setup(t *testing.T) []framework.Option {
+	o.workflow = workflow.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(o.workflow),
+	}
+}
+
+func (o *overlap) Run(t *testing.T, ctx context.Context) {
+	o.workflow.WaitUntilRunning(t, ctx)
+
+	startedCh := make(chan string, 10)
+	o.workflow.Registry().AddWorkflowN("stuck", func(ctx *task.WorkflowContext) (any, error) {
+		if !ctx.IsReplaying {
+			startedCh <- string(ctx.ID)
+		}
+		return nil, ctx.WaitForSingleEvent("done", -1).Await(nil)
+	})
+
+	client := o.workflow.BackendClient(t, ctx)
+	gclient := o.workflow.GRPCClient(t, ctx)
+
+	schedule := func(t *testing.T, id, policy string) {
+		t.Helper()
+		_, err := gclient.StartWorkflowBeta1(ctx, &rtv1.StartWorkflowRequest{
+			WorkflowComponent: "dapr",
+			WorkflowName:      "stuck",
+			InstanceId:        id,
+			Options: map[string]string{
+				"dapr.workflow.cron":           "@every 1s",
+				"dapr.workflow.overlap_policy": policy,
+			},
+		})
+		require.NoError(t, err)
+
+		select {
+		case got := <-startedCh:
+			assert.Equal(t, id, got)
+		case <-time.After(time.Second * 10):
+			require.Fail(t, "scheduled run did not start")
+		}
+	}
+
+	stop := func(t *testing.T, id string) {
+		t.Helper()
+		_, err := gclient.PurgeWorkflowBeta1(ctx, &rtv1.PurgeWorkflowRequest{
+			WorkflowComponent: "dapr",
+			InstanceId:        id,
+		})
+		require.Error(t, err, "a running workflow cannot be purged")
+		require.NoError(t, client.TerminateWorkflow(ctx, api.InstanceID(id)))
+		_, err = client.WaitForWorkflowCompletion(ctx, api.InstanceID(id))
+		require.NoError(t, err)
+	}
+
+	t.Run("skip", func(t *testing.T) {
+		const id = "overlap-skip"
+		schedule(t, id, "skip")
+
+		select {
+		case got := <-startedCh:
+			assert.Fail(t, "run started while the previous run was running", got)
+		case <-time.After(time.Second * 3):
+		}
+
+		meta, err := client.FetchWorkflowMetadata(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING, meta.GetRuntimeStatus())
+		stop(t, id)
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		const id = "overlap-queue"
+		schedule(t, id, "queue")
+
+		select {
+		case got := <-startedCh:
+			assert.Fail(t, "run started while the previous run was running", got)
+		case <-time.After(time.Second * 3):
+		}
+
+		require.NoError(t, client.RaiseEvent(ctx, id, "done"))
+
+		select {
+		case got := <-startedCh:
+			assert.Equal(t, id, got)
+		case <-time.After(time.Second * 10):
+			require.Fail(t, "queued run did not start once the previous run completed")
+		}
+		stop(t, id)
+	})
+
+	t.Run("terminate previous", func(t *testing.T) {
+		const id = "overlap-terminate"
+		schedule(t, id, "terminate_previous")
+
+		select {
+		case got := <-startedCh:
+			assert.Equal(t, id, got)
+		case <-time.After(time.Second * 10):
+			require.Fail(t, "next run did not replace the previous run")
+		}
+		stop(t, id)
+	})
+}
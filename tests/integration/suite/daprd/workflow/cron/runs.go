@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(runs))
+}
+
+// runs tests that a workflow started with a cron schedule runs repeatedly
+// under the same instance ID with the start input, until it is purged.
+type runs struct {
+	workflow *workflow.Workflow
+}
+
+func (r *runs) Setup(t *testing.T) []framework.Option {
+	r.workflow = workflow.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(r.workflow),
+	}
+}
+
+func (r *runs) Run(t *testing.T, ctx context.Context) {
+	r.workflow.WaitUntilRunning(t, ctx)
+
+	type run struct {
+		id    string
+		input string
+	}
+	runCh := make(chan run, 10)
+	r.workflow.Registry().AddWorkflowN("report", func(ctx *task.WorkflowContext) (any, error) {
+		var input string
+		if err := ctx.GetInput(&input); err != nil {
+			return nil, err
+		}
+		if !ctx.IsReplaying {
+			runCh <- run{id: string(ctx.ID), input: input}
+		}
+		return nil, nil
+	})
+
+	r.workflow.BackendClient(t, ctx)
+	gclient := r.workflow.GRPCClient(t, ctx)
+
+	resp, err := gclient.StartWorkflowBeta1(ctx, &rtv1.StartWorkflowRequest{
+		WorkflowComponent: "dapr",
+		WorkflowName:      "report",
+		InstanceId:        "daily-report",
+		Input:             []byte(`"daily"`),
+		Options: map[string]string{
+			"dapr.workflow.cron": "@every 1s",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "daily-report", resp.GetInstanceId())
+
+	for range 3 {
+		select {
+		case got := <-runCh:
+			assert.Equal(t, run{id: "daily-report", input: "daily"}, got)
+		case <-time.After(time.Second * 10):
+			require.Fail(t, "scheduled run did not start")
+		}
+	}
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		_, err = gclient.PurgeWorkflowBeta1(ctx, &rtv1.PurgeWorkflowRequest{
+			WorkflowComponent: "dapr",
+			InstanceId:        "daily-report",
+		})
+		assert.NoError(c, err)
+	}, time.Second*10, time.Millisecond*100)
+
+	// Drain a run which was started before the schedule was deleted.
+	select {
+	case <-runCh:
+	case <-time.After(time.Second):
+	}
+
+	select {
+	case got := <-runCh:
+		assert.Fail(t, "scheduled run started after purge", got)
+	case <-time.After(time.Second * 3):
+	}
+
+	t.Run("invalid options", func(t *testing.T) {
+		for _, opts := range []map[string]string{
+			{"dapr.workflow.cron": ""},
+			{"dapr.workflow.cron": "@every 1s", "dapr.workflow.overlap_policy": "sometimes"},
+			{"dapr.workflow.cron": "@every 1s", "dapr.workflow.start_time": time.Now().Format(time.RFC3339)},
+		} {
+			_, err := gclient.StartWorkflowBeta1(ctx, &rtv1.StartWorkflowRequest{
+				WorkflowComponent: "dapr",
+				WorkflowName:      "report",
+				InstanceId:        "invalid-report",
+				Options:           opts,
+			})
+			require.Error(t, err, opts)
+		}
+	})
+}
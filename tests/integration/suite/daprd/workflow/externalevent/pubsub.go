@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalevent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(pubsub))
+}
+
+// pubsub tests raising external events on workflow instances from the
+// messages of a workflow event subscription, without an app to receive them.
+type pubsub struct {
+	workflow *workflow.Workflow
+}
+
+func (p *pubsub) Setup(t *testing.T) []framework.Option {
+	p.workflow = workflow.New(t,
+		workflow.WithDaprdOptions(0, daprd.WithResourceFiles(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mypub
+spec:
+  type: pubsub.in-memory
+  version: v1
+---
+apiVersion: dapr.io/v2alpha1
+kind: Subscription
+metadata:
+  name: approvals
+spec:
+  pubsubname: mypub
+  topic: approvals
+  routes: {}
+  workflowEvent:
+    eventName: approval
+    instanceIDField: data.orderId
+`)),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(p.workflow),
+	}
+}
+
+func (p *pubsub) Run(t *testing.T, ctx context.Context) {
+	p.workflow.WaitUntilRunning(t, ctx)
+
+	p.workflow.Registry().AddWorkflowN("order", func(ctx *task.WorkflowContext) (any, error) {
+		var approval map[string]any
+		if err := ctx.WaitForSingleEvent("approval", time.Minute).Await(&approval); err != nil {
+			return nil, err
+		}
+		return approval["approved"], nil
+	})
+
+	client := p.workflow.BackendClient(t, ctx)
+	gclient := p.workflow.GRPCClient(t, ctx)
+
+	publish := func(data string) {
+		t.Helper()
+		_, err := gclient.PublishEvent(ctx, &rtv1.PublishEventRequest{
+			PubsubName:      "mypub",
+			Topic:           "approvals",
+			Data:            []byte(data),
+			DataContentType: "application/json",
+		})
+		require.NoError(t, err)
+	}
+
+	// Messages for instances which do not exist, or without an instance ID,
+	// are dropped.
+	publish(`{"orderId":"unknown","approved":false}`)
+	publish(`{"approved":false}`)
+
+	id, err := client.ScheduleNewWorkflow(ctx, "order", api.WithInstanceID("order-1"))
+	require.NoError(t, err)
+	_, err = client.WaitForWorkflowStart(ctx, id)
+	require.NoError(t, err)
+
+	publish(`{"orderId":"order-1","approved":true}`)
+
+	meta, err := client.WaitForWorkflowCompletion(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.RUNTIME_STATUS_COMPLETED, meta.GetRuntimeStatus())
+	assert.JSONEq(t, `true`, meta.GetOutput().GetValue())
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fanoutlimit
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(activities))
+}
+
+// activities tests that no more than the configured number of activities of
+// a workflow instance run at once, that the activities beyond the limit are
+// queued until earlier ones complete, and that workflows without a limit are
+// not affected.
+type activities struct {
+	workflow *workflow.Workflow
+}
+
+func (a *activities) Setup(t *testing.T) []framework.Option {
+	a.workflow = workflow.New(t,
+		workflow.WithDaprdOptions(0, daprd.WithConfigManifests(t, `apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: fanoutlimit
+spec:
+  workflow:
+    fanOutLimits:
+    - name: limited
+      maxConcurrentActivities: 2
+`)),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(a.workflow),
+	}
+}
+
+func (a *activities) Run(t *testing.T, ctx context.Context) {
+	a.workflow.WaitUntilRunning(t, ctx)
+
+	const n = 6
+
+	var running, maxRunning, started atomic.Int64
+	releaseCh := make(chan struct{}, n)
+	fanOut := func(ctx *task.WorkflowContext) (any, error) {
+		tasks := make([]task.Task, n)
+		for i := range n {
+			tasks[i] = ctx.CallActivity("work")
+		}
+		for _, tsk := range tasks {
+			if err := tsk.Await(nil); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	a.workflow.Registry().AddWorkflowN("limited", fanOut)
+	a.workflow.Registry().AddWorkflowN("unlimited", fanOut)
+	a.workflow.Registry().AddActivityN("work", func(ctx task.ActivityContext) (any, error) {
+		cur := running.Add(1)
+		defer running.Add(-1)
+		started.Add(1)
+		for {
+			prev := maxRunning.Load()
+			if cur <= prev || maxRunning.CompareAndSwap(prev, cur) {
+				break
+			}
+		}
+		<-releaseCh
+		return nil, nil
+	})
+
+	client := a.workflow.BackendClient(t, ctx)
+
+	id, err := client.ScheduleNewWorkflow(ctx, "limited", api.WithInstanceID("limited"))
+	require.NoError(t, err)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, int64(2), running.Load())
+	}, time.Second*20, time.Millisecond*10)
+	// The queued activities must not be dispatched while the limit is reached.
+	time.Sleep(time.Second)
+	assert.Equal(t, int64(2), started.Load())
+
+	for range n {
+		releaseCh <- struct{}{}
+	}
+
+	meta, err := client.WaitForWorkflowCompletion(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.RUNTIME_STATUS_COMPLETED, meta.GetRuntimeStatus())
+	assert.Equal(t, int64(n), started.Load())
+	assert.Equal(t, int64(2), maxRunning.Load())
+
+	started.Store(0)
+	id, err = client.ScheduleNewWorkflow(ctx, "unlimited", api.WithInstanceID("unlimited"))
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, int64(n), running.Load())
+	}, time.Second*20, time.Millisecond*10)
+	for range n {
+		releaseCh <- struct{}{}
+	}
+	meta, err = client.WaitForWorkflowCompletion(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.RUNTIME_STATUS_COMPLETED, meta.GetRuntimeStatus())
+}
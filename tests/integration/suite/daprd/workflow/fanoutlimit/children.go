@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fanoutlimit
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(children))
+}
+
+// children tests that child workflows beyond the configured limit are
+// created only once earlier child workflows of the instance complete, in the
+// order they were scheduled.
+type children struct {
+	workflow *workflow.Workflow
+}
+
+func (c *children) Setup(t *testing.T) []framework.Option {
+	c.workflow = workflow.New(t,
+		workflow.WithDaprdOptions(0, daprd.WithConfigManifests(t, `apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: fanoutlimit
+spec:
+  workflow:
+    fanOutLimits:
+    - name: parent
+      maxConcurrentChildWorkflows: 1
+`)),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(c.workflow),
+	}
+}
+
+func (c *children) Run(t *testing.T, ctx context.Context) {
+	c.workflow.WaitUntilRunning(t, ctx)
+
+	const n = 3
+
+	var running, maxRunning atomic.Int64
+	var order []string
+	orderCh := make(chan string, n)
+	c.workflow.Registry().AddWorkflowN("parent", func(ctx *task.WorkflowContext) (any, error) {
+		tasks := make([]task.Task, n)
+		for i := range n {
+			tasks[i] = ctx.CallChildWorkflow("child", task.WithChildWorkflowInstanceID("child-"+string(rune('a'+i))))
+		}
+		for _, tsk := range tasks {
+			if err := tsk.Await(nil); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	c.workflow.Registry().AddWorkflowN("child", func(ctx *task.WorkflowContext) (any, error) {
+		if !ctx.IsReplaying {
+			cur := running.Add(1)
+			for {
+				prev := maxRunning.Load()
+				if cur <= prev || maxRunning.CompareAndSwap(prev, cur) {
+					break
+				}
+			}
+			orderCh <- ctx.ID.String()
+		}
+		if err := ctx.CreateTimer(time.Millisecond * 500).Await(nil); err != nil {
+			return nil, err
+		}
+		if !ctx.IsReplaying {
+			running.Add(-1)
+		}
+		return nil, nil
+	})
+
+	client := c.workflow.BackendClient(t, ctx)
+
+	id, err := client.ScheduleNewWorkflow(ctx, "parent", api.WithInstanceID("parent"))
+	require.NoError(t, err)
+	meta, err := client.WaitForWorkflowCompletion(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.RUNTIME_STATUS_COMPLETED, meta.GetRuntimeStatus())
+
+	close(orderCh)
+	for id := range orderCh {
+		order = append(order, id)
+	}
+	assert.Equal(t, []string{"child-a", "child-b", "child-c"}, order)
+	assert.Equal(t, int64(1), maxRunning.Load())
+}
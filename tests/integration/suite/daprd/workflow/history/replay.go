@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	fclient "github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(replay))
+}
+
+// replay tests exporting the history of a workflow instance, and replaying it
+// against changed workflow code to detect non-determinism.
+type replay struct {
+	workflow *workflow.Workflow
+}
+
+func (r *replay) Setup(t *testing.T) []framework.Option {
+	r.workflow = workflow.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(r.workflow),
+	}
+}
+
+func (r *replay) Run(t *testing.T, ctx context.Context) {
+	r.workflow.WaitUntilRunning(t, ctx)
+
+	var changed atomic.Bool
+	r.workflow.Registry().AddWorkflowN("order", func(ctx *task.WorkflowContext) (any, error) {
+		activity := "pay"
+		if changed.Load() {
+			activity = "ship"
+		}
+		var out string
+		if err := ctx.CallActivity(activity, task.WithActivityInput("order1")).Await(&out); err != nil {
+			return nil, err
+		}
+		return out, nil
+	})
+	for _, name := range []string{"pay", "ship"} {
+		r.workflow.Registry().AddActivityN(name, func(ctx task.ActivityContext) (any, error) {
+			return name + "ed", nil
+		})
+	}
+
+	client := r.workflow.BackendClient(t, ctx)
+	id, err := client.ScheduleNewWorkflow(ctx, "order")
+	require.NoError(t, err)
+	_, err = client.WaitForWorkflowCompletion(ctx, id)
+	require.NoError(t, err)
+
+	gclient := r.workflow.GRPCClient(t, ctx)
+
+	t.Run("history", func(t *testing.T) {
+		resp, err := gclient.GetWorkflowHistoryBeta1(ctx, &rtv1.GetWorkflowHistoryRequest{
+			InstanceId:        string(id),
+			WorkflowComponent: "dapr",
+		})
+		require.NoError(t, err)
+
+		var types []string
+		for _, e := range resp.GetEvents() {
+			types = append(types, e.GetEventType())
+			assert.NotNil(t, e.GetTimestamp())
+
+			var event protos.HistoryEvent
+			require.NoError(t, proto.Unmarshal(e.GetEvent(), &event))
+			assert.Equal(t, e.GetEventId(), event.GetEventId())
+
+			if e.GetEventType() == "taskScheduled" {
+				assert.Equal(t, "pay", e.GetName())
+				assert.NotEmpty(t, e.GetPayloadHash())
+			}
+		}
+		assert.Contains(t, types, "executionStarted")
+		assert.Contains(t, types, "taskScheduled")
+		assert.Contains(t, types, "taskCompleted")
+		assert.Contains(t, types, "executionCompleted")
+	})
+
+	t.Run("history not found", func(t *testing.T) {
+		_, err := gclient.GetWorkflowHistoryBeta1(ctx, &rtv1.GetWorkflowHistoryRequest{
+			InstanceId:        "notexist",
+			WorkflowComponent: "dapr",
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("deterministic replay", func(t *testing.T) {
+		resp, err := gclient.ReplayWorkflowBeta1(ctx, &rtv1.ReplayWorkflowRequest{
+			InstanceId:        string(id),
+			WorkflowComponent: "dapr",
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.GetDeterministic(), resp.GetDivergences())
+		assert.Equal(t, "COMPLETED", resp.GetRecordedStatus())
+		assert.Equal(t, "COMPLETED", resp.GetReplayedStatus())
+		assert.JSONEq(t, `"payed"`, resp.GetReplayedOutput())
+	})
+
+	t.Run("non-deterministic replay", func(t *testing.T) {
+		changed.Store(true)
+		t.Cleanup(func() { changed.Store(false) })
+
+		req, err := http.NewRequestWithContext(ctx,
+			http.MethodPost,
+			fmt.Sprintf("http://%s/v1.0-beta1/workflows/dapr/%s/replay", r.workflow.Dapr().HTTPAddress(), id),
+			nil,
+		)
+		require.NoError(t, err)
+
+		resp, err := fclient.HTTP(t).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body struct {
+			Deterministic  bool     `json:"deterministic"`
+			RecordedStatus string   `json:"recordedStatus"`
+			ReplayedStatus string   `json:"replayedStatus"`
+			Divergences    []string `json:"divergences"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		require.NoError(t, resp.Body.Close())
+
+		assert.False(t, body.Deterministic)
+		assert.Equal(t, "COMPLETED", body.RecordedStatus)
+		assert.Empty(t, body.ReplayedStatus)
+		assert.Equal(t, []string{
+			"replay created taskScheduled 'ship' with ID 0, recorded history has taskScheduled 'pay'",
+		}, body.Divergences)
+	})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	fclient "github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(instances))
+}
+
+// instances tests listing workflow instances through the Dapr workflow API,
+// filtered by runtime status, workflow name and creation time.
+type instances struct {
+	workflow *workflow.Workflow
+}
+
+func (i *instances) Setup(t *testing.T) []framework.Option {
+	i.workflow = workflow.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(i.workflow),
+	}
+}
+
+func (i *instances) Run(t *testing.T, ctx context.Context) {
+	i.workflow.WaitUntilRunning(t, ctx)
+
+	i.workflow.Registry().AddWorkflowN("completes", func(ctx *task.WorkflowContext) (any, error) {
+		return nil, nil
+	})
+	i.workflow.Registry().AddWorkflowN("fails", func(ctx *task.WorkflowContext) (any, error) {
+		return nil, errors.New("failed on purpose")
+	})
+
+	client := i.workflow.BackendClient(t, ctx)
+
+	ids := make(map[string]string)
+	for _, name := range []string{"completes", "completes", "fails"} {
+		id, err := client.ScheduleNewWorkflow(ctx, name)
+		require.NoError(t, err)
+		_, err = client.WaitForWorkflowCompletion(ctx, id)
+		require.NoError(t, err)
+		ids[string(id)] = name
+	}
+
+	gclient := i.workflow.GRPCClient(t, ctx)
+	list := func(t *testing.T, req *rtv1.ListWorkflowInstancesRequest) map[string]string {
+		t.Helper()
+		req.WorkflowComponent = "dapr"
+		resp, err := gclient.ListWorkflowInstancesBeta1(ctx, req)
+		require.NoError(t, err)
+		got := make(map[string]string)
+		for _, wf := range resp.GetInstances() {
+			got[wf.GetInstanceId()] = wf.GetWorkflowName()
+		}
+		return got
+	}
+
+	t.Run("all", func(t *testing.T) {
+		assert.Equal(t, ids, list(t, new(rtv1.ListWorkflowInstancesRequest)))
+	})
+
+	t.Run("status", func(t *testing.T) {
+		got := list(t, &rtv1.ListWorkflowInstancesRequest{RuntimeStatuses: []string{"FAILED"}})
+		require.Len(t, got, 1)
+		for _, name := range got {
+			assert.Equal(t, "fails", name)
+		}
+	})
+
+	t.Run("name", func(t *testing.T) {
+		assert.Len(t, list(t, &rtv1.ListWorkflowInstancesRequest{WorkflowName: new("completes")}), 2)
+		assert.Empty(t, list(t, &rtv1.ListWorkflowInstancesRequest{WorkflowName: new("unknown")}))
+	})
+
+	t.Run("created time", func(t *testing.T) {
+		assert.Empty(t, list(t, &rtv1.ListWorkflowInstancesRequest{CreatedAfter: timestamppb.Now()}))
+		assert.Len(t, list(t, &rtv1.ListWorkflowInstancesRequest{CreatedBefore: timestamppb.Now()}), 3)
+	})
+
+	t.Run("http", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx,
+			http.MethodGet,
+			fmt.Sprintf("http://%s/v1.0-beta1/workflows/dapr?runtimeStatus=COMPLETED&runtimeStatus=FAILED&workflowName=completes", i.workflow.Dapr().HTTPAddress()),
+			nil,
+		)
+		require.NoError(t, err)
+
+		resp, err := fclient.HTTP(t).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body struct {
+			Instances []struct {
+				InstanceID    string `json:"instanceID"`
+				WorkflowName  string `json:"workflowName"`
+				RuntimeStatus string `json:"runtimeStatus"`
+			} `json:"instances"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		require.NoError(t, resp.Body.Close())
+
+		require.Len(t, body.Instances, 2)
+		for _, wf := range body.Instances {
+			assert.Equal(t, "completes", wf.WorkflowName)
+			assert.Equal(t, "COMPLETED", wf.RuntimeStatus)
+		}
+	})
+}
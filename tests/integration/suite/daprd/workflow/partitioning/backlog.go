@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package partitioning
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(backlog))
+}
+
+// backlog tests that workflow work items waiting to be dispatched are
+// reported per workflow partition, and that every partition is drained.
+type backlog struct {
+	workflow *workflow.Workflow
+}
+
+func (b *backlog) Setup(t *testing.T) []framework.Option {
+	b.workflow = workflow.New(t,
+		workflow.WithDaprdOptions(0, daprd.WithConfigManifests(t, `apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: partitioning
+spec:
+  workflow:
+    maxConcurrentWorkflowInvocations: 1
+    partitioning:
+      partitions: 4
+      rebalanceStrategy: backlog
+`)),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(b.workflow),
+	}
+}
+
+func (b *backlog) Run(t *testing.T, ctx context.Context) {
+	b.workflow.WaitUntilRunning(t, ctx)
+
+	var blocking atomic.Bool
+	releaseCh := make(chan struct{})
+	b.workflow.Registry().AddWorkflowN("blocker", func(ctx *task.WorkflowContext) (any, error) {
+		blocking.Store(true)
+		<-releaseCh
+		return nil, nil
+	})
+	b.workflow.Registry().AddWorkflowN("quick", func(ctx *task.WorkflowContext) (any, error) {
+		return nil, nil
+	})
+
+	client := b.workflow.BackendClient(t, ctx)
+
+	_, err := client.ScheduleNewWorkflow(ctx, "blocker", api.WithInstanceID("blocker"), api.WithStartTime(time.Now()))
+	require.NoError(t, err)
+	// The blocker holds the only workflow invocation slot, so every following
+	// work item stays queued in its partition.
+	require.Eventually(t, blocking.Load, time.Second*10, time.Millisecond*10)
+
+	const n = 12
+	ids := make([]api.InstanceID, n)
+	for i := range n {
+		ids[i], err = client.ScheduleNewWorkflow(ctx, "quick", api.WithInstanceID(api.InstanceID("quick-"+strconv.Itoa(i))), api.WithStartTime(time.Now()))
+		require.NoError(t, err)
+	}
+
+	backlogs := func(c *assert.CollectT) map[string]int {
+		backlogs := make(map[string]int)
+		for k, v := range b.workflow.Dapr().Metrics(c, ctx).All() {
+			if strings.HasPrefix(k, "dapr_runtime_workflow_partition_backlog|") {
+				backlogs[k[strings.LastIndex(k, "partition:")+len("partition:"):]] = int(v)
+			}
+		}
+		return backlogs
+	}
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		got := backlogs(c)
+		var total int
+		for partition, v := range got {
+			p, err := strconv.Atoi(partition)
+			assert.NoError(c, err)
+			assert.Less(c, p, 4)
+			total += v
+		}
+		assert.Equal(c, n, total)
+		assert.Greater(c, len(got), 1, "work items should be spread across partitions")
+	}, time.Second*20, time.Millisecond*100)
+
+	close(releaseCh)
+
+	for _, id := range ids {
+		_, err = client.WaitForWorkflowCompletion(ctx, id)
+		require.NoError(t, err)
+	}
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		for partition, v := range backlogs(c) {
+			assert.Zero(c, v, partition)
+		}
+	}, time.Second*10, time.Millisecond*100)
+}
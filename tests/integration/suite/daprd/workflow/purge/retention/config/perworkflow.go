@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://wwb.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	dworkflow "github.com/dapr/durabletask-go/workflow"
+)
+
+func init() {
+	suite.Register(new(perworkflow))
+}
+
+// perworkflow tests that the retention policy of a workflow name takes
+// precedence over the retention policy of the app, and that purges by the
+// retention policy are recorded in the metrics.
+type perworkflow struct {
+	workflow *workflow.Workflow
+}
+
+func (p *perworkflow) Setup(t *testing.T) []framework.Option {
+	p.workflow = workflow.New(t,
+		workflow.WithDaprdOptions(0, daprd.WithConfigManifests(t, `apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: wfpolicy
+spec:
+  workflow:
+    stateRetentionPolicy:
+      anyTerminal: "1h"
+      workflows:
+      - name: short
+        completed: "1s"
+`)),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(p.workflow),
+	}
+}
+
+func (p *perworkflow) Run(t *testing.T, ctx context.Context) {
+	p.workflow.WaitUntilRunning(t, ctx)
+
+	reg := dworkflow.NewRegistry()
+	noop := func(ctx *dworkflow.WorkflowContext) (any, error) {
+		return nil, nil
+	}
+	reg.AddWorkflowN("short", noop)
+	reg.AddWorkflowN("long", noop)
+
+	client := dworkflow.NewClient(p.workflow.Dapr().GRPCConn(t, ctx))
+	require.NoError(t, client.StartWorker(ctx, reg))
+
+	appID := p.workflow.Dapr().AppID()
+	retentionKeys := func(instanceID string) []string {
+		return p.workflow.Scheduler().ListAllKeys(t, ctx, fmt.Sprintf(
+			"dapr/jobs/actorreminder||default||dapr.internal.default.%s.retentioner||%s||",
+			appID, instanceID,
+		))
+	}
+
+	_, err := client.ScheduleWorkflow(ctx, "long", dworkflow.WithInstanceID("long-1"))
+	require.NoError(t, err)
+	_, err = client.WaitForWorkflowCompletion(ctx, "long-1")
+	require.NoError(t, err)
+	_, err = client.ScheduleWorkflow(ctx, "short", dworkflow.WithInstanceID("short-1"))
+	require.NoError(t, err)
+
+	purgedMetric := fmt.Sprintf(
+		"dapr_runtime_workflow_retention_purged_count|app_id:%s|namespace:|status:success|workflow_name:short",
+		appID,
+	)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		_, err := client.FetchWorkflowMetadata(ctx, "short-1")
+		assert.Error(c, err)
+		assert.Empty(c, retentionKeys("short-1"))
+		assert.Equal(c, 1, int(p.workflow.Dapr().Metrics(t, ctx).All()[purgedMetric]))
+	}, time.Second*20, time.Millisecond*10)
+
+	// The workflow without its own policy is retained for the app's TTL.
+	assert.Len(t, retentionKeys("long-1"), 1)
+	meta, err := client.FetchWorkflowMetadata(ctx, "long-1")
+	require.NoError(t, err)
+	assert.Equal(t, dworkflow.StatusCompleted, meta.RuntimeStatus)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timeout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/workflow"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/task"
+)
+
+func init() {
+	suite.Register(new(compensation))
+}
+
+// compensation tests that a workflow started with a max duration is
+// terminated once it has run for longer, and that its compensation activity
+// is invoked with the workflow input.
+type compensation struct {
+	workflow *workflow.Workflow
+}
+
+func (c *compensation) Setup(t *testing.T) []framework.Option {
+	c.workflow = workflow.New(t)
+
+	return []framework.Option{
+		framework.WithProcesses(c.workflow),
+	}
+}
+
+func (c *compensation) Run(t *testing.T, ctx context.Context) {
+	c.workflow.WaitUntilRunning(t, ctx)
+
+	refunded := make(chan string, 2)
+	c.workflow.Registry().AddWorkflowN("stuck", func(ctx *task.WorkflowContext) (any, error) {
+		return nil, ctx.WaitForSingleEvent("approval", -1).Await(nil)
+	})
+	c.workflow.Registry().AddWorkflowN("quick", func(ctx *task.WorkflowContext) (any, error) {
+		return "done", nil
+	})
+	c.workflow.Registry().AddActivityN("refund", func(ctx task.ActivityContext) (any, error) {
+		var order string
+		if err := ctx.GetInput(&order); err != nil {
+			return nil, err
+		}
+		refunded <- order
+		return nil, nil
+	})
+
+	client := c.workflow.BackendClient(t, ctx)
+	gclient := c.workflow.GRPCClient(t, ctx)
+
+	t.Run("timed out", func(t *testing.T) {
+		resp, err := gclient.StartWorkflowBeta1(ctx, &rtv1.StartWorkflowRequest{
+			WorkflowComponent: "dapr",
+			WorkflowName:      "stuck",
+			Input:             []byte(`"order1"`),
+			Options: map[string]string{
+				"dapr.workflow.max_duration":          "3s",
+				"dapr.workflow.compensation_activity": "refund",
+			},
+		})
+		require.NoError(t, err)
+
+		id := api.InstanceID(resp.GetInstanceId())
+		meta, err := client.WaitForWorkflowCompletion(ctx, id, api.WithFetchPayloads(true))
+		require.NoError(t, err)
+		assert.Equal(t, protos.OrchestrationStatus_ORCHESTRATION_STATUS_TERMINATED, meta.GetRuntimeStatus())
+		assert.JSONEq(t, `"workflow exceeded its max duration"`, meta.GetOutput().GetValue())
+		assert.GreaterOrEqual(t, meta.GetLastUpdatedAt().AsTime().Sub(meta.GetCreatedAt().AsTime()), 3*time.Second)
+
+		select {
+		case order := <-refunded:
+			assert.Equal(t, "order1", order)
+		case <-time.After(time.Second * 10):
+			assert.Fail(t, "compensation activity was not invoked")
+		}
+	})
+
+	t.Run("completed in time", func(t *testing.T) {
+		resp, err := gclient.StartWorkflowBeta1(ctx, &rtv1.StartWorkflowRequest{
+			WorkflowComponent: "dapr",
+			WorkflowName:      "quick",
+			Options: map[string]string{
+				"dapr.workflow.max_duration":          "2s",
+				"dapr.workflow.compensation_activity": "refund",
+			},
+		})
+		require.NoError(t, err)
+
+		meta, err := client.WaitForWorkflowCompletion(ctx, api.InstanceID(resp.GetInstanceId()))
+		require.NoError(t, err)
+		assert.Equal(t, protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED, meta.GetRuntimeStatus())
+
+		select {
+		case order := <-refunded:
+			assert.Fail(t, "compensation activity invoked for a completed workflow", order)
+		case <-time.After(time.Second * 4):
+		}
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		for _, opts := range []map[string]string{
+			{"dapr.workflow.max_duration": "soon"},
+			{"dapr.workflow.max_duration": "-1s"},
+			{"dapr.workflow.compensation_activity": "refund"},
+		} {
+			_, err := gclient.StartWorkflowBeta1(ctx, &rtv1.StartWorkflowRequest{
+				WorkflowComponent: "dapr",
+				WorkflowName:      "quick",
+				Options:           opts,
+			})
+			require.Error(t, err, opts)
+		}
+	})
+}
@@ -17,16 +17,20 @@ import (
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/accesspolicy"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/apphealth"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/attestation"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/audit"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/basic"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/chaos"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/continueasnew"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/cron"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/crossapp"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/dedup"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/detached"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/disk"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/externalevent"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/fanoutlimit"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/get"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/globalmaxconcurrent"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/history"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/hotreload"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/list"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/listener"
@@ -34,6 +38,7 @@ import (
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/maxconcurrent"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/memory"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/nostatestore"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/partitioning"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/patching"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/payloadsize"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/propagation"
@@ -51,6 +56,7 @@ import (
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/starttime"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/taskexecutionid"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/terminate"
+	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/timeout"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/timer"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/tracing"
 	_ "github.com/dapr/dapr/tests/integration/suite/daprd/workflow/versioning"